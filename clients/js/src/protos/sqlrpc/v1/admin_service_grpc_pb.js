@@ -10,6 +10,28 @@ var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/time
 var sqlrpc_v1_enums_pb = require('../../sqlrpc/v1/enums_pb.js');
 var sqlrpc_v1_types_pb = require('../../sqlrpc/v1/types_pb.js');
 
+function serialize_sqlrpc_v1_CancelQueryRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.CancelQueryRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.CancelQueryRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_CancelQueryRequest(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.CancelQueryRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_CancelQueryResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.CancelQueryResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.CancelQueryResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_CancelQueryResponse(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.CancelQueryResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_sqlrpc_v1_CreateAPIKeyRequest(arg) {
   if (!(arg instanceof sqlrpc_v1_admin_service_pb.CreateAPIKeyRequest)) {
     throw new Error('Expected argument of type sqlrpc.v1.CreateAPIKeyRequest');
@@ -54,6 +76,28 @@ function deserialize_sqlrpc_v1_CreateDatabaseResponse(buffer_arg) {
   return sqlrpc_v1_admin_service_pb.CreateDatabaseResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_sqlrpc_v1_CreateRowPolicyRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.CreateRowPolicyRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.CreateRowPolicyRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_CreateRowPolicyRequest(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.CreateRowPolicyRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_CreateRowPolicyResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.CreateRowPolicyResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.CreateRowPolicyResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_CreateRowPolicyResponse(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.CreateRowPolicyResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_sqlrpc_v1_CreateUserRequest(arg) {
   if (!(arg instanceof sqlrpc_v1_admin_service_pb.CreateUserRequest)) {
     throw new Error('Expected argument of type sqlrpc.v1.CreateUserRequest');
@@ -98,6 +142,28 @@ function deserialize_sqlrpc_v1_DeleteAPIKeyResponse(buffer_arg) {
   return sqlrpc_v1_admin_service_pb.DeleteAPIKeyResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_sqlrpc_v1_DeleteColumnPolicyRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.DeleteColumnPolicyRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.DeleteColumnPolicyRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_DeleteColumnPolicyRequest(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.DeleteColumnPolicyRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_DeleteColumnPolicyResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.DeleteColumnPolicyResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.DeleteColumnPolicyResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_DeleteColumnPolicyResponse(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.DeleteColumnPolicyResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_sqlrpc_v1_DeleteDatabaseRequest(arg) {
   if (!(arg instanceof sqlrpc_v1_admin_service_pb.DeleteDatabaseRequest)) {
     throw new Error('Expected argument of type sqlrpc.v1.DeleteDatabaseRequest');
@@ -120,6 +186,50 @@ function deserialize_sqlrpc_v1_DeleteDatabaseResponse(buffer_arg) {
   return sqlrpc_v1_admin_service_pb.DeleteDatabaseResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_sqlrpc_v1_DeleteRateLimitRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.DeleteRateLimitRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.DeleteRateLimitRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_DeleteRateLimitRequest(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.DeleteRateLimitRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_DeleteRateLimitResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.DeleteRateLimitResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.DeleteRateLimitResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_DeleteRateLimitResponse(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.DeleteRateLimitResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_DeleteRowPolicyRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.DeleteRowPolicyRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.DeleteRowPolicyRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_DeleteRowPolicyRequest(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.DeleteRowPolicyRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_DeleteRowPolicyResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.DeleteRowPolicyResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.DeleteRowPolicyResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_DeleteRowPolicyResponse(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.DeleteRowPolicyResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_sqlrpc_v1_DeleteUserRequest(arg) {
   if (!(arg instanceof sqlrpc_v1_admin_service_pb.DeleteUserRequest)) {
     throw new Error('Expected argument of type sqlrpc.v1.DeleteUserRequest');
@@ -142,6 +252,50 @@ function deserialize_sqlrpc_v1_DeleteUserResponse(buffer_arg) {
   return sqlrpc_v1_admin_service_pb.DeleteUserResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_sqlrpc_v1_ForceRollbackTransactionRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.ForceRollbackTransactionRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.ForceRollbackTransactionRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_ForceRollbackTransactionRequest(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.ForceRollbackTransactionRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_ForceRollbackTransactionResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.ForceRollbackTransactionResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.ForceRollbackTransactionResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_ForceRollbackTransactionResponse(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.ForceRollbackTransactionResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_GetLogLevelsRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.GetLogLevelsRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.GetLogLevelsRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_GetLogLevelsRequest(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.GetLogLevelsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_GetLogLevelsResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.GetLogLevelsResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.GetLogLevelsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_GetLogLevelsResponse(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.GetLogLevelsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_sqlrpc_v1_GetServerInfoRequest(arg) {
   if (!(arg instanceof sqlrpc_v1_admin_service_pb.GetServerInfoRequest)) {
     throw new Error('Expected argument of type sqlrpc.v1.GetServerInfoRequest');
@@ -153,6 +307,28 @@ function deserialize_sqlrpc_v1_GetServerInfoRequest(buffer_arg) {
   return sqlrpc_v1_admin_service_pb.GetServerInfoRequest.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_sqlrpc_v1_GrantDatabaseAccessRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.GrantDatabaseAccessRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.GrantDatabaseAccessRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_GrantDatabaseAccessRequest(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.GrantDatabaseAccessRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_GrantDatabaseAccessResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.GrantDatabaseAccessResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.GrantDatabaseAccessResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_GrantDatabaseAccessResponse(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.GrantDatabaseAccessResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_sqlrpc_v1_ListAPIKeysRequest(arg) {
   if (!(arg instanceof sqlrpc_v1_admin_service_pb.ListAPIKeysRequest)) {
     throw new Error('Expected argument of type sqlrpc.v1.ListAPIKeysRequest');
@@ -175,6 +351,94 @@ function deserialize_sqlrpc_v1_ListAPIKeysResponse(buffer_arg) {
   return sqlrpc_v1_admin_service_pb.ListAPIKeysResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_sqlrpc_v1_ListActiveOperationsRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.ListActiveOperationsRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.ListActiveOperationsRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_ListActiveOperationsRequest(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.ListActiveOperationsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_ListActiveOperationsResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.ListActiveOperationsResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.ListActiveOperationsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_ListActiveOperationsResponse(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.ListActiveOperationsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_ListAuditEventsRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.ListAuditEventsRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.ListAuditEventsRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_ListAuditEventsRequest(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.ListAuditEventsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_ListAuditEventsResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.ListAuditEventsResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.ListAuditEventsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_ListAuditEventsResponse(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.ListAuditEventsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_ListColumnPoliciesRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.ListColumnPoliciesRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.ListColumnPoliciesRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_ListColumnPoliciesRequest(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.ListColumnPoliciesRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_ListColumnPoliciesResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.ListColumnPoliciesResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.ListColumnPoliciesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_ListColumnPoliciesResponse(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.ListColumnPoliciesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_ListDatabaseGrantsRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.ListDatabaseGrantsRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.ListDatabaseGrantsRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_ListDatabaseGrantsRequest(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.ListDatabaseGrantsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_ListDatabaseGrantsResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.ListDatabaseGrantsResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.ListDatabaseGrantsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_ListDatabaseGrantsResponse(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.ListDatabaseGrantsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_sqlrpc_v1_ListDatabasesRequest(arg) {
   if (!(arg instanceof sqlrpc_v1_admin_service_pb.ListDatabasesRequest)) {
     throw new Error('Expected argument of type sqlrpc.v1.ListDatabasesRequest');
@@ -197,6 +461,72 @@ function deserialize_sqlrpc_v1_ListDatabasesResponse(buffer_arg) {
   return sqlrpc_v1_admin_service_pb.ListDatabasesResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_sqlrpc_v1_ListMaintenanceRunsRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.ListMaintenanceRunsRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.ListMaintenanceRunsRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_ListMaintenanceRunsRequest(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.ListMaintenanceRunsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_ListMaintenanceRunsResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.ListMaintenanceRunsResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.ListMaintenanceRunsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_ListMaintenanceRunsResponse(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.ListMaintenanceRunsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_ListRateLimitsRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.ListRateLimitsRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.ListRateLimitsRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_ListRateLimitsRequest(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.ListRateLimitsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_ListRateLimitsResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.ListRateLimitsResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.ListRateLimitsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_ListRateLimitsResponse(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.ListRateLimitsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_ListRowPoliciesRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.ListRowPoliciesRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.ListRowPoliciesRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_ListRowPoliciesRequest(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.ListRowPoliciesRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_ListRowPoliciesResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.ListRowPoliciesResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.ListRowPoliciesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_ListRowPoliciesResponse(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.ListRowPoliciesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_sqlrpc_v1_ListUsersRequest(arg) {
   if (!(arg instanceof sqlrpc_v1_admin_service_pb.ListUsersRequest)) {
     throw new Error('Expected argument of type sqlrpc.v1.ListUsersRequest');
@@ -285,6 +615,39 @@ function deserialize_sqlrpc_v1_MountDatabaseResponse(buffer_arg) {
   return sqlrpc_v1_admin_service_pb.MountDatabaseResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_sqlrpc_v1_ReplicationFrame(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.ReplicationFrame)) {
+    throw new Error('Expected argument of type sqlrpc.v1.ReplicationFrame');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_ReplicationFrame(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.ReplicationFrame.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_RevokeDatabaseAccessRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.RevokeDatabaseAccessRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.RevokeDatabaseAccessRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_RevokeDatabaseAccessRequest(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.RevokeDatabaseAccessRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_RevokeDatabaseAccessResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.RevokeDatabaseAccessResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.RevokeDatabaseAccessResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_RevokeDatabaseAccessResponse(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.RevokeDatabaseAccessResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_sqlrpc_v1_ServerInfo(arg) {
   if (!(arg instanceof sqlrpc_v1_admin_service_pb.ServerInfo)) {
     throw new Error('Expected argument of type sqlrpc.v1.ServerInfo');
@@ -296,6 +659,83 @@ function deserialize_sqlrpc_v1_ServerInfo(buffer_arg) {
   return sqlrpc_v1_admin_service_pb.ServerInfo.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_sqlrpc_v1_SetColumnPolicyRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.SetColumnPolicyRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.SetColumnPolicyRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_SetColumnPolicyRequest(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.SetColumnPolicyRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_SetColumnPolicyResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.SetColumnPolicyResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.SetColumnPolicyResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_SetColumnPolicyResponse(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.SetColumnPolicyResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_SetLogLevelRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.SetLogLevelRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.SetLogLevelRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_SetLogLevelRequest(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.SetLogLevelRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_SetLogLevelResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.SetLogLevelResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.SetLogLevelResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_SetLogLevelResponse(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.SetLogLevelResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_SetRateLimitRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.SetRateLimitRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.SetRateLimitRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_SetRateLimitRequest(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.SetRateLimitRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_SetRateLimitResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.SetRateLimitResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.SetRateLimitResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_SetRateLimitResponse(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.SetRateLimitResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_StreamReplicationRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.StreamReplicationRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.StreamReplicationRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_StreamReplicationRequest(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.StreamReplicationRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_sqlrpc_v1_UnMountDatabaseRequest(arg) {
   if (!(arg instanceof sqlrpc_v1_admin_service_pb.UnMountDatabaseRequest)) {
     throw new Error('Expected argument of type sqlrpc.v1.UnMountDatabaseRequest');
@@ -318,6 +758,28 @@ function deserialize_sqlrpc_v1_UnMountDatabaseResponse(buffer_arg) {
   return sqlrpc_v1_admin_service_pb.UnMountDatabaseResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_sqlrpc_v1_UnlockUserRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.UnlockUserRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.UnlockUserRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_UnlockUserRequest(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.UnlockUserRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_UnlockUserResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_admin_service_pb.UnlockUserResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.UnlockUserResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_UnlockUserResponse(buffer_arg) {
+  return sqlrpc_v1_admin_service_pb.UnlockUserResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_sqlrpc_v1_UpdateDatabaseRequest(arg) {
   if (!(arg instanceof sqlrpc_v1_admin_service_pb.UpdateDatabaseRequest)) {
     throw new Error('Expected argument of type sqlrpc.v1.UpdateDatabaseRequest');
@@ -449,6 +911,21 @@ deleteUser: {
     responseSerialize: serialize_sqlrpc_v1_DeleteUserResponse,
     responseDeserialize: deserialize_sqlrpc_v1_DeleteUserResponse,
   },
+  // *
+// User Management: Unlock.
+// Clears the failed login attempts and lockout of a username and, optionally,
+// of a client IP address.
+unlockUser: {
+    path: '/sqlrpc.v1.AdminService/UnlockUser',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_admin_service_pb.UnlockUserRequest,
+    responseType: sqlrpc_v1_admin_service_pb.UnlockUserResponse,
+    requestSerialize: serialize_sqlrpc_v1_UnlockUserRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_UnlockUserRequest,
+    responseSerialize: serialize_sqlrpc_v1_UnlockUserResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_UnlockUserResponse,
+  },
   // --- API Key Management ---
 //
 // *
@@ -493,6 +970,186 @@ deleteAPIKey: {
     responseSerialize: serialize_sqlrpc_v1_DeleteAPIKeyResponse,
     responseDeserialize: deserialize_sqlrpc_v1_DeleteAPIKeyResponse,
   },
+  // --- Database Access Control ---
+//
+// *
+// Access Control: Grant.
+// Assigns a per-database role to a user or a single API key. Granting the
+// same database pattern again replaces the previous role.
+grantDatabaseAccess: {
+    path: '/sqlrpc.v1.AdminService/GrantDatabaseAccess',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_admin_service_pb.GrantDatabaseAccessRequest,
+    responseType: sqlrpc_v1_admin_service_pb.GrantDatabaseAccessResponse,
+    requestSerialize: serialize_sqlrpc_v1_GrantDatabaseAccessRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_GrantDatabaseAccessRequest,
+    responseSerialize: serialize_sqlrpc_v1_GrantDatabaseAccessResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_GrantDatabaseAccessResponse,
+  },
+  // *
+// Access Control: Revoke.
+// Removes a per-database grant immediately.
+revokeDatabaseAccess: {
+    path: '/sqlrpc.v1.AdminService/RevokeDatabaseAccess',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_admin_service_pb.RevokeDatabaseAccessRequest,
+    responseType: sqlrpc_v1_admin_service_pb.RevokeDatabaseAccessResponse,
+    requestSerialize: serialize_sqlrpc_v1_RevokeDatabaseAccessRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_RevokeDatabaseAccessRequest,
+    responseSerialize: serialize_sqlrpc_v1_RevokeDatabaseAccessResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_RevokeDatabaseAccessResponse,
+  },
+  // *
+// Access Control: List.
+// Returns the per-database grants, optionally filtered by user or database.
+listDatabaseGrants: {
+    path: '/sqlrpc.v1.AdminService/ListDatabaseGrants',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_admin_service_pb.ListDatabaseGrantsRequest,
+    responseType: sqlrpc_v1_admin_service_pb.ListDatabaseGrantsResponse,
+    requestSerialize: serialize_sqlrpc_v1_ListDatabaseGrantsRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_ListDatabaseGrantsRequest,
+    responseSerialize: serialize_sqlrpc_v1_ListDatabaseGrantsResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_ListDatabaseGrantsResponse,
+  },
+  // --- Rate Limits ---
+//
+// *
+// Rate Limits: Set.
+// Caps the requests, concurrent streams and transactions, and returned rows
+// and bytes of a user or a single API key on matching databases. Setting the
+// same database pattern again replaces the previous limits.
+setRateLimit: {
+    path: '/sqlrpc.v1.AdminService/SetRateLimit',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_admin_service_pb.SetRateLimitRequest,
+    responseType: sqlrpc_v1_admin_service_pb.SetRateLimitResponse,
+    requestSerialize: serialize_sqlrpc_v1_SetRateLimitRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_SetRateLimitRequest,
+    responseSerialize: serialize_sqlrpc_v1_SetRateLimitResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_SetRateLimitResponse,
+  },
+  // *
+// Rate Limits: Delete.
+// Removes a rate limit immediately.
+deleteRateLimit: {
+    path: '/sqlrpc.v1.AdminService/DeleteRateLimit',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_admin_service_pb.DeleteRateLimitRequest,
+    responseType: sqlrpc_v1_admin_service_pb.DeleteRateLimitResponse,
+    requestSerialize: serialize_sqlrpc_v1_DeleteRateLimitRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_DeleteRateLimitRequest,
+    responseSerialize: serialize_sqlrpc_v1_DeleteRateLimitResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_DeleteRateLimitResponse,
+  },
+  // *
+// Rate Limits: List.
+// Returns the rate limits, optionally filtered by user or database.
+listRateLimits: {
+    path: '/sqlrpc.v1.AdminService/ListRateLimits',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_admin_service_pb.ListRateLimitsRequest,
+    responseType: sqlrpc_v1_admin_service_pb.ListRateLimitsResponse,
+    requestSerialize: serialize_sqlrpc_v1_ListRateLimitsRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_ListRateLimitsRequest,
+    responseSerialize: serialize_sqlrpc_v1_ListRateLimitsResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_ListRateLimitsResponse,
+  },
+  // --- Row and Column Security ---
+//
+// *
+// Row Policies: Create.
+// Restricts the rows of a table that non-admin callers can read, update and
+// delete to those matching a predicate, which may call current_user() and
+// current_role(). A row is visible when any policy of its table allows it.
+createRowPolicy: {
+    path: '/sqlrpc.v1.AdminService/CreateRowPolicy',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_admin_service_pb.CreateRowPolicyRequest,
+    responseType: sqlrpc_v1_admin_service_pb.CreateRowPolicyResponse,
+    requestSerialize: serialize_sqlrpc_v1_CreateRowPolicyRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_CreateRowPolicyRequest,
+    responseSerialize: serialize_sqlrpc_v1_CreateRowPolicyResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_CreateRowPolicyResponse,
+  },
+  // *
+// Row Policies: Delete.
+// Removes a row policy immediately.
+deleteRowPolicy: {
+    path: '/sqlrpc.v1.AdminService/DeleteRowPolicy',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_admin_service_pb.DeleteRowPolicyRequest,
+    responseType: sqlrpc_v1_admin_service_pb.DeleteRowPolicyResponse,
+    requestSerialize: serialize_sqlrpc_v1_DeleteRowPolicyRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_DeleteRowPolicyRequest,
+    responseSerialize: serialize_sqlrpc_v1_DeleteRowPolicyResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_DeleteRowPolicyResponse,
+  },
+  // *
+// Row Policies: List.
+// Returns the row policies, optionally of a single database.
+listRowPolicies: {
+    path: '/sqlrpc.v1.AdminService/ListRowPolicies',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_admin_service_pb.ListRowPoliciesRequest,
+    responseType: sqlrpc_v1_admin_service_pb.ListRowPoliciesResponse,
+    requestSerialize: serialize_sqlrpc_v1_ListRowPoliciesRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_ListRowPoliciesRequest,
+    responseSerialize: serialize_sqlrpc_v1_ListRowPoliciesResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_ListRowPoliciesResponse,
+  },
+  // *
+// Column Policies: Set.
+// Denies or masks reads of a table column for a role or a single user on a
+// database. Setting the same column and subject again replaces the action.
+setColumnPolicy: {
+    path: '/sqlrpc.v1.AdminService/SetColumnPolicy',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_admin_service_pb.SetColumnPolicyRequest,
+    responseType: sqlrpc_v1_admin_service_pb.SetColumnPolicyResponse,
+    requestSerialize: serialize_sqlrpc_v1_SetColumnPolicyRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_SetColumnPolicyRequest,
+    responseSerialize: serialize_sqlrpc_v1_SetColumnPolicyResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_SetColumnPolicyResponse,
+  },
+  // *
+// Column Policies: Delete.
+// Removes a column policy immediately.
+deleteColumnPolicy: {
+    path: '/sqlrpc.v1.AdminService/DeleteColumnPolicy',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_admin_service_pb.DeleteColumnPolicyRequest,
+    responseType: sqlrpc_v1_admin_service_pb.DeleteColumnPolicyResponse,
+    requestSerialize: serialize_sqlrpc_v1_DeleteColumnPolicyRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_DeleteColumnPolicyRequest,
+    responseSerialize: serialize_sqlrpc_v1_DeleteColumnPolicyResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_DeleteColumnPolicyResponse,
+  },
+  // *
+// Column Policies: List.
+// Returns the column policies, optionally of a single database.
+listColumnPolicies: {
+    path: '/sqlrpc.v1.AdminService/ListColumnPolicies',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_admin_service_pb.ListColumnPoliciesRequest,
+    responseType: sqlrpc_v1_admin_service_pb.ListColumnPoliciesResponse,
+    requestSerialize: serialize_sqlrpc_v1_ListColumnPoliciesRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_ListColumnPoliciesRequest,
+    responseSerialize: serialize_sqlrpc_v1_ListColumnPoliciesResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_ListColumnPoliciesResponse,
+  },
   // --- Database Control Plane ---
 //
 // *
@@ -580,6 +1237,84 @@ unMountDatabase: {
     responseSerialize: serialize_sqlrpc_v1_UnMountDatabaseResponse,
     responseDeserialize: deserialize_sqlrpc_v1_UnMountDatabaseResponse,
   },
+  // *
+// Maintenance: List runs.
+// Returns the results of scheduled maintenance jobs, newest first.
+listMaintenanceRuns: {
+    path: '/sqlrpc.v1.AdminService/ListMaintenanceRuns',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_admin_service_pb.ListMaintenanceRunsRequest,
+    responseType: sqlrpc_v1_admin_service_pb.ListMaintenanceRunsResponse,
+    requestSerialize: serialize_sqlrpc_v1_ListMaintenanceRunsRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_ListMaintenanceRunsRequest,
+    responseSerialize: serialize_sqlrpc_v1_ListMaintenanceRunsResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_ListMaintenanceRunsResponse,
+  },
+  // --- Queries ---
+//
+// *
+// Queries: Cancel.
+// Interrupts the in-flight statements started by the request with the given
+// X-Request-Id. The interrupted call fails with CANCELED.
+cancelQuery: {
+    path: '/sqlrpc.v1.AdminService/CancelQuery',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_admin_service_pb.CancelQueryRequest,
+    responseType: sqlrpc_v1_admin_service_pb.CancelQueryResponse,
+    requestSerialize: serialize_sqlrpc_v1_CancelQueryRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_CancelQueryRequest,
+    responseSerialize: serialize_sqlrpc_v1_CancelQueryResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_CancelQueryResponse,
+  },
+  // *
+// Queries: List active operations.
+// Returns the statements running right now and every open transaction, both
+// ID-based and bidirectional streams.
+listActiveOperations: {
+    path: '/sqlrpc.v1.AdminService/ListActiveOperations',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_admin_service_pb.ListActiveOperationsRequest,
+    responseType: sqlrpc_v1_admin_service_pb.ListActiveOperationsResponse,
+    requestSerialize: serialize_sqlrpc_v1_ListActiveOperationsRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_ListActiveOperationsRequest,
+    responseSerialize: serialize_sqlrpc_v1_ListActiveOperationsResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_ListActiveOperationsResponse,
+  },
+  // *
+// Queries: Force rollback.
+// Rolls back another caller's open transaction. Stream transactions end with
+// ABORTED.
+forceRollbackTransaction: {
+    path: '/sqlrpc.v1.AdminService/ForceRollbackTransaction',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_admin_service_pb.ForceRollbackTransactionRequest,
+    responseType: sqlrpc_v1_admin_service_pb.ForceRollbackTransactionResponse,
+    requestSerialize: serialize_sqlrpc_v1_ForceRollbackTransactionRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_ForceRollbackTransactionRequest,
+    responseSerialize: serialize_sqlrpc_v1_ForceRollbackTransactionResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_ForceRollbackTransactionResponse,
+  },
+  // --- Audit ---
+//
+// *
+// Audit: List events.
+// Returns recorded administrative and data-changing operations, newest
+// first.
+listAuditEvents: {
+    path: '/sqlrpc.v1.AdminService/ListAuditEvents',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_admin_service_pb.ListAuditEventsRequest,
+    responseType: sqlrpc_v1_admin_service_pb.ListAuditEventsResponse,
+    requestSerialize: serialize_sqlrpc_v1_ListAuditEventsRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_ListAuditEventsRequest,
+    responseSerialize: serialize_sqlrpc_v1_ListAuditEventsResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_ListAuditEventsResponse,
+  },
   // --- Platform Utilities ---
 //
 // *
@@ -597,6 +1332,35 @@ getServerInfo: {
     responseDeserialize: deserialize_sqlrpc_v1_ServerInfo,
   },
   // *
+// Logging: Get levels.
+// Returns the default log level and the effective level of every subsystem.
+getLogLevels: {
+    path: '/sqlrpc.v1.AdminService/GetLogLevels',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_admin_service_pb.GetLogLevelsRequest,
+    responseType: sqlrpc_v1_admin_service_pb.GetLogLevelsResponse,
+    requestSerialize: serialize_sqlrpc_v1_GetLogLevelsRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_GetLogLevelsRequest,
+    responseSerialize: serialize_sqlrpc_v1_GetLogLevelsResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_GetLogLevelsResponse,
+  },
+  // *
+// Logging: Set level.
+// Changes the default log level or overrides it for one subsystem. Changes
+// take effect immediately and last until the server restarts.
+setLogLevel: {
+    path: '/sqlrpc.v1.AdminService/SetLogLevel',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_admin_service_pb.SetLogLevelRequest,
+    responseType: sqlrpc_v1_admin_service_pb.SetLogLevelResponse,
+    requestSerialize: serialize_sqlrpc_v1_SetLogLevelRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_SetLogLevelRequest,
+    responseSerialize: serialize_sqlrpc_v1_SetLogLevelResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_SetLogLevelResponse,
+  },
+  // *
 // Authentication: Login.
 // Authenticates credentials and yields a session token/API key.
 login: {
@@ -638,6 +1402,24 @@ updatePassword: {
     responseSerialize: serialize_sqlrpc_v1_UpdatePasswordResponse,
     responseDeserialize: deserialize_sqlrpc_v1_UpdatePasswordResponse,
   },
+  // --- Replication ---
+//
+// *
+// Replication: Stream changes.
+// Streams page-level changes of every mounted database to a follower
+// started with --role=follower. The first frame per database is a full
+// snapshot; later frames only carry pages that changed.
+streamReplication: {
+    path: '/sqlrpc.v1.AdminService/StreamReplication',
+    requestStream: false,
+    responseStream: true,
+    requestType: sqlrpc_v1_admin_service_pb.StreamReplicationRequest,
+    responseType: sqlrpc_v1_admin_service_pb.ReplicationFrame,
+    requestSerialize: serialize_sqlrpc_v1_StreamReplicationRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_StreamReplicationRequest,
+    responseSerialize: serialize_sqlrpc_v1_ReplicationFrame,
+    responseDeserialize: deserialize_sqlrpc_v1_ReplicationFrame,
+  },
 };
 
 exports.AdminServiceClient = grpc.makeGenericClientConstructor(AdminServiceService, 'AdminService');
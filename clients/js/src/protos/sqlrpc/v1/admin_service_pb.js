@@ -28,34 +28,92 @@ goog.object.extend(proto, sqlrpc_v1_enums_pb);
 var sqlrpc_v1_types_pb = require('../../sqlrpc/v1/types_pb.js');
 goog.object.extend(proto, sqlrpc_v1_types_pb);
 goog.exportSymbol('proto.sqlrpc.v1.APIKey', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.APIKeyScope', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ActiveStatement', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ActiveTransaction', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.AuditEvent', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.CancelQueryRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.CancelQueryResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ColumnPolicy', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.CreateAPIKeyRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.CreateAPIKeyResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.CreateDatabaseRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.CreateDatabaseResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.CreateRowPolicyRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.CreateRowPolicyResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.CreateUserRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.CreateUserResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.DatabaseGrant', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.DatabaseReplicationStatus', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.DeleteAPIKeyRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.DeleteAPIKeyResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.DeleteColumnPolicyRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.DeleteColumnPolicyResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.DeleteDatabaseRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.DeleteDatabaseResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.DeleteRateLimitRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.DeleteRateLimitResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.DeleteRowPolicyRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.DeleteRowPolicyResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.DeleteUserRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.DeleteUserResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ForceRollbackTransactionRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ForceRollbackTransactionResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.GetLogLevelsRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.GetLogLevelsResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.GetServerInfoRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.GrantDatabaseAccessRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.GrantDatabaseAccessRequest.GranteeCase', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.GrantDatabaseAccessResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.ListAPIKeysRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.ListAPIKeysResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ListActiveOperationsRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ListActiveOperationsResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ListAuditEventsRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ListAuditEventsResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ListColumnPoliciesRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ListColumnPoliciesResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ListDatabaseGrantsRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ListDatabaseGrantsResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.ListDatabasesRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.ListDatabasesResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ListMaintenanceRunsRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ListMaintenanceRunsResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ListRateLimitsRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ListRateLimitsResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ListRowPoliciesRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ListRowPoliciesResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.ListUsersRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.ListUsersResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.LoginRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.LoginResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.LogoutRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.LogoutResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.MaintenanceRun', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.MountDatabaseRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.MountDatabaseResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.RateLimit', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ReplicationFrame', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ReplicationPage', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ReplicationStatus', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.RevokeDatabaseAccessRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.RevokeDatabaseAccessResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.RowPolicy', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.ServerInfo', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.SetColumnPolicyRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.SetColumnPolicyRequest.SubjectCase', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.SetColumnPolicyResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.SetLogLevelRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.SetLogLevelResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.SetRateLimitRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.SetRateLimitRequest.SubjectCase', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.SetRateLimitResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.StreamReplicationRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.SubsystemLogLevel', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.UnMountDatabaseRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.UnMountDatabaseResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.UnlockUserRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.UnlockUserResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.UpdateDatabaseRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.UpdateDatabaseResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.UpdatePasswordRequest', null, global);
@@ -230,6 +288,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.sqlrpc.v1.DeleteUserResponse.displayName = 'proto.sqlrpc.v1.DeleteUserResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.UnlockUserRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.UnlockUserRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.UnlockUserRequest.displayName = 'proto.sqlrpc.v1.UnlockUserRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.UnlockUserResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.UnlockUserResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.UnlockUserResponse.displayName = 'proto.sqlrpc.v1.UnlockUserResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -335,6 +435,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.sqlrpc.v1.APIKey.displayName = 'proto.sqlrpc.v1.APIKey';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.APIKeyScope = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.APIKeyScope.repeatedFields_, null);
+};
+goog.inherits(proto.sqlrpc.v1.APIKeyScope, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.APIKeyScope.displayName = 'proto.sqlrpc.v1.APIKeyScope';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -429,16 +550,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.ListDatabasesRequest = function(opt_data) {
+proto.sqlrpc.v1.DatabaseGrant = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.ListDatabasesRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.DatabaseGrant, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.ListDatabasesRequest.displayName = 'proto.sqlrpc.v1.ListDatabasesRequest';
+  proto.sqlrpc.v1.DatabaseGrant.displayName = 'proto.sqlrpc.v1.DatabaseGrant';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -450,16 +571,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.ListDatabasesResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.ListDatabasesResponse.repeatedFields_, null);
+proto.sqlrpc.v1.GrantDatabaseAccessRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.sqlrpc.v1.GrantDatabaseAccessRequest.oneofGroups_);
 };
-goog.inherits(proto.sqlrpc.v1.ListDatabasesResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.GrantDatabaseAccessRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.ListDatabasesResponse.displayName = 'proto.sqlrpc.v1.ListDatabasesResponse';
+  proto.sqlrpc.v1.GrantDatabaseAccessRequest.displayName = 'proto.sqlrpc.v1.GrantDatabaseAccessRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -471,16 +592,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.CreateDatabaseRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.CreateDatabaseRequest.repeatedFields_, null);
+proto.sqlrpc.v1.GrantDatabaseAccessResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.CreateDatabaseRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.GrantDatabaseAccessResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.CreateDatabaseRequest.displayName = 'proto.sqlrpc.v1.CreateDatabaseRequest';
+  proto.sqlrpc.v1.GrantDatabaseAccessResponse.displayName = 'proto.sqlrpc.v1.GrantDatabaseAccessResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -492,16 +613,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.CreateDatabaseResponse = function(opt_data) {
+proto.sqlrpc.v1.RevokeDatabaseAccessRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.CreateDatabaseResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.RevokeDatabaseAccessRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.CreateDatabaseResponse.displayName = 'proto.sqlrpc.v1.CreateDatabaseResponse';
+  proto.sqlrpc.v1.RevokeDatabaseAccessRequest.displayName = 'proto.sqlrpc.v1.RevokeDatabaseAccessRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -513,16 +634,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.UpdateDatabaseRequest = function(opt_data) {
+proto.sqlrpc.v1.RevokeDatabaseAccessResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.UpdateDatabaseRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.RevokeDatabaseAccessResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.UpdateDatabaseRequest.displayName = 'proto.sqlrpc.v1.UpdateDatabaseRequest';
+  proto.sqlrpc.v1.RevokeDatabaseAccessResponse.displayName = 'proto.sqlrpc.v1.RevokeDatabaseAccessResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -534,16 +655,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.UpdateDatabaseResponse = function(opt_data) {
+proto.sqlrpc.v1.ListDatabaseGrantsRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.UpdateDatabaseResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.ListDatabaseGrantsRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.UpdateDatabaseResponse.displayName = 'proto.sqlrpc.v1.UpdateDatabaseResponse';
+  proto.sqlrpc.v1.ListDatabaseGrantsRequest.displayName = 'proto.sqlrpc.v1.ListDatabaseGrantsRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -555,16 +676,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.DeleteDatabaseRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.sqlrpc.v1.ListDatabaseGrantsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.ListDatabaseGrantsResponse.repeatedFields_, null);
 };
-goog.inherits(proto.sqlrpc.v1.DeleteDatabaseRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.ListDatabaseGrantsResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.DeleteDatabaseRequest.displayName = 'proto.sqlrpc.v1.DeleteDatabaseRequest';
+  proto.sqlrpc.v1.ListDatabaseGrantsResponse.displayName = 'proto.sqlrpc.v1.ListDatabaseGrantsResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -576,16 +697,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.DeleteDatabaseResponse = function(opt_data) {
+proto.sqlrpc.v1.RateLimit = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.DeleteDatabaseResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.RateLimit, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.DeleteDatabaseResponse.displayName = 'proto.sqlrpc.v1.DeleteDatabaseResponse';
+  proto.sqlrpc.v1.RateLimit.displayName = 'proto.sqlrpc.v1.RateLimit';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -597,16 +718,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.MountDatabaseRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.MountDatabaseRequest.repeatedFields_, null);
+proto.sqlrpc.v1.SetRateLimitRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.sqlrpc.v1.SetRateLimitRequest.oneofGroups_);
 };
-goog.inherits(proto.sqlrpc.v1.MountDatabaseRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.SetRateLimitRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.MountDatabaseRequest.displayName = 'proto.sqlrpc.v1.MountDatabaseRequest';
+  proto.sqlrpc.v1.SetRateLimitRequest.displayName = 'proto.sqlrpc.v1.SetRateLimitRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -618,16 +739,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.MountDatabaseResponse = function(opt_data) {
+proto.sqlrpc.v1.SetRateLimitResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.MountDatabaseResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.SetRateLimitResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.MountDatabaseResponse.displayName = 'proto.sqlrpc.v1.MountDatabaseResponse';
+  proto.sqlrpc.v1.SetRateLimitResponse.displayName = 'proto.sqlrpc.v1.SetRateLimitResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -639,16 +760,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.UnMountDatabaseRequest = function(opt_data) {
+proto.sqlrpc.v1.DeleteRateLimitRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.UnMountDatabaseRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.DeleteRateLimitRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.UnMountDatabaseRequest.displayName = 'proto.sqlrpc.v1.UnMountDatabaseRequest';
+  proto.sqlrpc.v1.DeleteRateLimitRequest.displayName = 'proto.sqlrpc.v1.DeleteRateLimitRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -660,16 +781,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.UnMountDatabaseResponse = function(opt_data) {
+proto.sqlrpc.v1.DeleteRateLimitResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.UnMountDatabaseResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.DeleteRateLimitResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.UnMountDatabaseResponse.displayName = 'proto.sqlrpc.v1.UnMountDatabaseResponse';
+  proto.sqlrpc.v1.DeleteRateLimitResponse.displayName = 'proto.sqlrpc.v1.DeleteRateLimitResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -681,16 +802,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.GetServerInfoRequest = function(opt_data) {
+proto.sqlrpc.v1.ListRateLimitsRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.GetServerInfoRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.ListRateLimitsRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.GetServerInfoRequest.displayName = 'proto.sqlrpc.v1.GetServerInfoRequest';
+  proto.sqlrpc.v1.ListRateLimitsRequest.displayName = 'proto.sqlrpc.v1.ListRateLimitsRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -702,16 +823,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.ServerInfo = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.sqlrpc.v1.ListRateLimitsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.ListRateLimitsResponse.repeatedFields_, null);
 };
-goog.inherits(proto.sqlrpc.v1.ServerInfo, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.ListRateLimitsResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.ServerInfo.displayName = 'proto.sqlrpc.v1.ServerInfo';
+  proto.sqlrpc.v1.ListRateLimitsResponse.displayName = 'proto.sqlrpc.v1.ListRateLimitsResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -723,16 +844,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.LoginRequest = function(opt_data) {
+proto.sqlrpc.v1.RowPolicy = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.LoginRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.RowPolicy, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.LoginRequest.displayName = 'proto.sqlrpc.v1.LoginRequest';
+  proto.sqlrpc.v1.RowPolicy.displayName = 'proto.sqlrpc.v1.RowPolicy';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -744,16 +865,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.LoginResponse = function(opt_data) {
+proto.sqlrpc.v1.CreateRowPolicyRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.LoginResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.CreateRowPolicyRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.LoginResponse.displayName = 'proto.sqlrpc.v1.LoginResponse';
+  proto.sqlrpc.v1.CreateRowPolicyRequest.displayName = 'proto.sqlrpc.v1.CreateRowPolicyRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -765,16 +886,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.LogoutRequest = function(opt_data) {
+proto.sqlrpc.v1.CreateRowPolicyResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.LogoutRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.CreateRowPolicyResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.LogoutRequest.displayName = 'proto.sqlrpc.v1.LogoutRequest';
+  proto.sqlrpc.v1.CreateRowPolicyResponse.displayName = 'proto.sqlrpc.v1.CreateRowPolicyResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -786,125 +907,12506 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.LogoutResponse = function(opt_data) {
+proto.sqlrpc.v1.DeleteRowPolicyRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.LogoutResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.DeleteRowPolicyRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.LogoutResponse.displayName = 'proto.sqlrpc.v1.LogoutResponse';
+  proto.sqlrpc.v1.DeleteRowPolicyRequest.displayName = 'proto.sqlrpc.v1.DeleteRowPolicyRequest';
 }
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.sqlrpc.v1.ListUsersRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.ListUsersRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.DeleteRowPolicyResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.sqlrpc.v1.DeleteRowPolicyResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.DeleteRowPolicyResponse.displayName = 'proto.sqlrpc.v1.DeleteRowPolicyResponse';
+}
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.ListUsersRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.sqlrpc.v1.ListUsersRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.sqlrpc.v1.ListRowPoliciesRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
+goog.inherits(proto.sqlrpc.v1.ListRowPoliciesRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.ListRowPoliciesRequest.displayName = 'proto.sqlrpc.v1.ListRowPoliciesRequest';
 }
-
-
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.ListUsersRequest}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.sqlrpc.v1.ListUsersRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.ListUsersRequest;
-  return proto.sqlrpc.v1.ListUsersRequest.deserializeBinaryFromReader(msg, reader);
+proto.sqlrpc.v1.ListRowPoliciesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.ListRowPoliciesResponse.repeatedFields_, null);
 };
-
-
+goog.inherits(proto.sqlrpc.v1.ListRowPoliciesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.ListRowPoliciesResponse.displayName = 'proto.sqlrpc.v1.ListRowPoliciesResponse';
+}
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.ListUsersRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.ListUsersRequest}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.sqlrpc.v1.ListUsersRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.sqlrpc.v1.ColumnPolicy = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.sqlrpc.v1.ColumnPolicy, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.ColumnPolicy.displayName = 'proto.sqlrpc.v1.ColumnPolicy';
+}
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.sqlrpc.v1.ListUsersRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.ListUsersRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.sqlrpc.v1.SetColumnPolicyRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.sqlrpc.v1.SetColumnPolicyRequest.oneofGroups_);
 };
-
-
-/**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.ListUsersRequest} message
+goog.inherits(proto.sqlrpc.v1.SetColumnPolicyRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.SetColumnPolicyRequest.displayName = 'proto.sqlrpc.v1.SetColumnPolicyRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.SetColumnPolicyResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.SetColumnPolicyResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.SetColumnPolicyResponse.displayName = 'proto.sqlrpc.v1.SetColumnPolicyResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.DeleteColumnPolicyRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.DeleteColumnPolicyRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.DeleteColumnPolicyRequest.displayName = 'proto.sqlrpc.v1.DeleteColumnPolicyRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.DeleteColumnPolicyResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.DeleteColumnPolicyResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.DeleteColumnPolicyResponse.displayName = 'proto.sqlrpc.v1.DeleteColumnPolicyResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.ListColumnPoliciesRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.ListColumnPoliciesRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.ListColumnPoliciesRequest.displayName = 'proto.sqlrpc.v1.ListColumnPoliciesRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.ListColumnPoliciesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.ListColumnPoliciesResponse.repeatedFields_, null);
+};
+goog.inherits(proto.sqlrpc.v1.ListColumnPoliciesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.ListColumnPoliciesResponse.displayName = 'proto.sqlrpc.v1.ListColumnPoliciesResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.ListDatabasesRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.ListDatabasesRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.ListDatabasesRequest.displayName = 'proto.sqlrpc.v1.ListDatabasesRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.ListDatabasesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.ListDatabasesResponse.repeatedFields_, null);
+};
+goog.inherits(proto.sqlrpc.v1.ListDatabasesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.ListDatabasesResponse.displayName = 'proto.sqlrpc.v1.ListDatabasesResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.CreateDatabaseRequest.repeatedFields_, null);
+};
+goog.inherits(proto.sqlrpc.v1.CreateDatabaseRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.CreateDatabaseRequest.displayName = 'proto.sqlrpc.v1.CreateDatabaseRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.CreateDatabaseResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.CreateDatabaseResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.CreateDatabaseResponse.displayName = 'proto.sqlrpc.v1.CreateDatabaseResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.UpdateDatabaseRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.UpdateDatabaseRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.UpdateDatabaseRequest.displayName = 'proto.sqlrpc.v1.UpdateDatabaseRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.UpdateDatabaseResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.UpdateDatabaseResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.UpdateDatabaseResponse.displayName = 'proto.sqlrpc.v1.UpdateDatabaseResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.DeleteDatabaseRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.DeleteDatabaseRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.DeleteDatabaseRequest.displayName = 'proto.sqlrpc.v1.DeleteDatabaseRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.DeleteDatabaseResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.DeleteDatabaseResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.DeleteDatabaseResponse.displayName = 'proto.sqlrpc.v1.DeleteDatabaseResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.MountDatabaseRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.MountDatabaseRequest.repeatedFields_, null);
+};
+goog.inherits(proto.sqlrpc.v1.MountDatabaseRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.MountDatabaseRequest.displayName = 'proto.sqlrpc.v1.MountDatabaseRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.MountDatabaseResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.MountDatabaseResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.MountDatabaseResponse.displayName = 'proto.sqlrpc.v1.MountDatabaseResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.UnMountDatabaseRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.UnMountDatabaseRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.UnMountDatabaseRequest.displayName = 'proto.sqlrpc.v1.UnMountDatabaseRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.UnMountDatabaseResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.UnMountDatabaseResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.UnMountDatabaseResponse.displayName = 'proto.sqlrpc.v1.UnMountDatabaseResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.MaintenanceRun = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.MaintenanceRun, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.MaintenanceRun.displayName = 'proto.sqlrpc.v1.MaintenanceRun';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.ListMaintenanceRunsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.ListMaintenanceRunsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.ListMaintenanceRunsRequest.displayName = 'proto.sqlrpc.v1.ListMaintenanceRunsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.ListMaintenanceRunsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.ListMaintenanceRunsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.sqlrpc.v1.ListMaintenanceRunsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.ListMaintenanceRunsResponse.displayName = 'proto.sqlrpc.v1.ListMaintenanceRunsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.CancelQueryRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.CancelQueryRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.CancelQueryRequest.displayName = 'proto.sqlrpc.v1.CancelQueryRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.CancelQueryResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.CancelQueryResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.CancelQueryResponse.displayName = 'proto.sqlrpc.v1.CancelQueryResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.ListActiveOperationsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.ListActiveOperationsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.ListActiveOperationsRequest.displayName = 'proto.sqlrpc.v1.ListActiveOperationsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.ActiveStatement = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.ActiveStatement, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.ActiveStatement.displayName = 'proto.sqlrpc.v1.ActiveStatement';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.ActiveTransaction = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.ActiveTransaction, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.ActiveTransaction.displayName = 'proto.sqlrpc.v1.ActiveTransaction';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.ListActiveOperationsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.ListActiveOperationsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.sqlrpc.v1.ListActiveOperationsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.ListActiveOperationsResponse.displayName = 'proto.sqlrpc.v1.ListActiveOperationsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.ForceRollbackTransactionRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.ForceRollbackTransactionRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.ForceRollbackTransactionRequest.displayName = 'proto.sqlrpc.v1.ForceRollbackTransactionRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.ForceRollbackTransactionResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.ForceRollbackTransactionResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.ForceRollbackTransactionResponse.displayName = 'proto.sqlrpc.v1.ForceRollbackTransactionResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.AuditEvent = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.AuditEvent, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.AuditEvent.displayName = 'proto.sqlrpc.v1.AuditEvent';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.ListAuditEventsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.ListAuditEventsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.ListAuditEventsRequest.displayName = 'proto.sqlrpc.v1.ListAuditEventsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.ListAuditEventsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.ListAuditEventsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.sqlrpc.v1.ListAuditEventsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.ListAuditEventsResponse.displayName = 'proto.sqlrpc.v1.ListAuditEventsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.GetServerInfoRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.GetServerInfoRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.GetServerInfoRequest.displayName = 'proto.sqlrpc.v1.GetServerInfoRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.ServerInfo = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.ServerInfo, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.ServerInfo.displayName = 'proto.sqlrpc.v1.ServerInfo';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.GetLogLevelsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.GetLogLevelsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.GetLogLevelsRequest.displayName = 'proto.sqlrpc.v1.GetLogLevelsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.SubsystemLogLevel = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.SubsystemLogLevel, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.SubsystemLogLevel.displayName = 'proto.sqlrpc.v1.SubsystemLogLevel';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.GetLogLevelsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.GetLogLevelsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.sqlrpc.v1.GetLogLevelsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.GetLogLevelsResponse.displayName = 'proto.sqlrpc.v1.GetLogLevelsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.SetLogLevelRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.SetLogLevelRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.SetLogLevelRequest.displayName = 'proto.sqlrpc.v1.SetLogLevelRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.SetLogLevelResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.SetLogLevelResponse.repeatedFields_, null);
+};
+goog.inherits(proto.sqlrpc.v1.SetLogLevelResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.SetLogLevelResponse.displayName = 'proto.sqlrpc.v1.SetLogLevelResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.LoginRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.LoginRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.LoginRequest.displayName = 'proto.sqlrpc.v1.LoginRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.LoginResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.LoginResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.LoginResponse.displayName = 'proto.sqlrpc.v1.LoginResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.LogoutRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.LogoutRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.LogoutRequest.displayName = 'proto.sqlrpc.v1.LogoutRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.LogoutResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.LogoutResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.LogoutResponse.displayName = 'proto.sqlrpc.v1.LogoutResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.StreamReplicationRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.StreamReplicationRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.StreamReplicationRequest.displayName = 'proto.sqlrpc.v1.StreamReplicationRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.ReplicationPage = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.ReplicationPage, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.ReplicationPage.displayName = 'proto.sqlrpc.v1.ReplicationPage';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.ReplicationFrame = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.ReplicationFrame.repeatedFields_, null);
+};
+goog.inherits(proto.sqlrpc.v1.ReplicationFrame, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.ReplicationFrame.displayName = 'proto.sqlrpc.v1.ReplicationFrame';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.DatabaseReplicationStatus = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.DatabaseReplicationStatus, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.DatabaseReplicationStatus.displayName = 'proto.sqlrpc.v1.DatabaseReplicationStatus';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.ReplicationStatus = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.ReplicationStatus.repeatedFields_, null);
+};
+goog.inherits(proto.sqlrpc.v1.ReplicationStatus, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.ReplicationStatus.displayName = 'proto.sqlrpc.v1.ReplicationStatus';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.ListUsersRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ListUsersRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.ListUsersRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListUsersRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.ListUsersRequest}
+ */
+proto.sqlrpc.v1.ListUsersRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.ListUsersRequest;
+  return proto.sqlrpc.v1.ListUsersRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.ListUsersRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.ListUsersRequest}
+ */
+proto.sqlrpc.v1.ListUsersRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.ListUsersRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ListUsersRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ListUsersRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListUsersRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.ListUsersResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.ListUsersResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ListUsersResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.ListUsersResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListUsersResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+usersList: jspb.Message.toObjectList(msg.getUsersList(),
+    sqlrpc_v1_types_pb.User.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.ListUsersResponse}
+ */
+proto.sqlrpc.v1.ListUsersResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.ListUsersResponse;
+  return proto.sqlrpc.v1.ListUsersResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.ListUsersResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.ListUsersResponse}
+ */
+proto.sqlrpc.v1.ListUsersResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new sqlrpc_v1_types_pb.User;
+      reader.readMessage(value,sqlrpc_v1_types_pb.User.deserializeBinaryFromReader);
+      msg.addUsers(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.ListUsersResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ListUsersResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ListUsersResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListUsersResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUsersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      sqlrpc_v1_types_pb.User.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated User users = 1;
+ * @return {!Array<!proto.sqlrpc.v1.User>}
+ */
+proto.sqlrpc.v1.ListUsersResponse.prototype.getUsersList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.User>} */ (
+    jspb.Message.getRepeatedWrapperField(this, sqlrpc_v1_types_pb.User, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.sqlrpc.v1.User>} value
+ * @return {!proto.sqlrpc.v1.ListUsersResponse} returns this
+*/
+proto.sqlrpc.v1.ListUsersResponse.prototype.setUsersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.User=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.User}
+ */
+proto.sqlrpc.v1.ListUsersResponse.prototype.addUsers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.sqlrpc.v1.User, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.ListUsersResponse} returns this
+ */
+proto.sqlrpc.v1.ListUsersResponse.prototype.clearUsersList = function() {
+  return this.setUsersList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.CreateUserRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.CreateUserRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.CreateUserRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.CreateUserRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+username: jspb.Message.getFieldWithDefault(msg, 1, ""),
+role: jspb.Message.getFieldWithDefault(msg, 2, 0),
+password: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.CreateUserRequest}
+ */
+proto.sqlrpc.v1.CreateUserRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.CreateUserRequest;
+  return proto.sqlrpc.v1.CreateUserRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.CreateUserRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.CreateUserRequest}
+ */
+proto.sqlrpc.v1.CreateUserRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setUsername(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.sqlrpc.v1.Role} */ (reader.readEnum());
+      msg.setRole(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setPassword(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.CreateUserRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.CreateUserRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.CreateUserRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.CreateUserRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getRole();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getPassword();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string username = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.CreateUserRequest.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.CreateUserRequest} returns this
+ */
+proto.sqlrpc.v1.CreateUserRequest.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional Role role = 2;
+ * @return {!proto.sqlrpc.v1.Role}
+ */
+proto.sqlrpc.v1.CreateUserRequest.prototype.getRole = function() {
+  return /** @type {!proto.sqlrpc.v1.Role} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.Role} value
+ * @return {!proto.sqlrpc.v1.CreateUserRequest} returns this
+ */
+proto.sqlrpc.v1.CreateUserRequest.prototype.setRole = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * optional string password = 3;
+ * @return {string}
+ */
+proto.sqlrpc.v1.CreateUserRequest.prototype.getPassword = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.CreateUserRequest} returns this
+ */
+proto.sqlrpc.v1.CreateUserRequest.prototype.setPassword = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.CreateUserResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.CreateUserResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.CreateUserResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.CreateUserResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+userId: jspb.Message.getFieldWithDefault(msg, 1, 0),
+username: jspb.Message.getFieldWithDefault(msg, 2, ""),
+createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.CreateUserResponse}
+ */
+proto.sqlrpc.v1.CreateUserResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.CreateUserResponse;
+  return proto.sqlrpc.v1.CreateUserResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.CreateUserResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.CreateUserResponse}
+ */
+proto.sqlrpc.v1.CreateUserResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setUserId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setUsername(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreatedAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.CreateUserResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.CreateUserResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.CreateUserResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.CreateUserResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUserId();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getCreatedAt();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional int64 user_id = 1;
+ * @return {number}
+ */
+proto.sqlrpc.v1.CreateUserResponse.prototype.getUserId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.CreateUserResponse} returns this
+ */
+proto.sqlrpc.v1.CreateUserResponse.prototype.setUserId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string username = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.CreateUserResponse.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.CreateUserResponse} returns this
+ */
+proto.sqlrpc.v1.CreateUserResponse.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp created_at = 3;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.sqlrpc.v1.CreateUserResponse.prototype.getCreatedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.sqlrpc.v1.CreateUserResponse} returns this
+*/
+proto.sqlrpc.v1.CreateUserResponse.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.CreateUserResponse} returns this
+ */
+proto.sqlrpc.v1.CreateUserResponse.prototype.clearCreatedAt = function() {
+  return this.setCreatedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.CreateUserResponse.prototype.hasCreatedAt = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.UpdateUserRoleRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.UpdateUserRoleRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.UpdateUserRoleRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.UpdateUserRoleRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+username: jspb.Message.getFieldWithDefault(msg, 1, ""),
+role: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.UpdateUserRoleRequest}
+ */
+proto.sqlrpc.v1.UpdateUserRoleRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.UpdateUserRoleRequest;
+  return proto.sqlrpc.v1.UpdateUserRoleRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.UpdateUserRoleRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.UpdateUserRoleRequest}
+ */
+proto.sqlrpc.v1.UpdateUserRoleRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setUsername(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.sqlrpc.v1.Role} */ (reader.readEnum());
+      msg.setRole(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.UpdateUserRoleRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.UpdateUserRoleRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.UpdateUserRoleRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.UpdateUserRoleRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getRole();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string username = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.UpdateUserRoleRequest.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.UpdateUserRoleRequest} returns this
+ */
+proto.sqlrpc.v1.UpdateUserRoleRequest.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional Role role = 2;
+ * @return {!proto.sqlrpc.v1.Role}
+ */
+proto.sqlrpc.v1.UpdateUserRoleRequest.prototype.getRole = function() {
+  return /** @type {!proto.sqlrpc.v1.Role} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.Role} value
+ * @return {!proto.sqlrpc.v1.UpdateUserRoleRequest} returns this
+ */
+proto.sqlrpc.v1.UpdateUserRoleRequest.prototype.setRole = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.UpdateUserRoleResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.UpdateUserRoleResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.UpdateUserRoleResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.UpdateUserRoleResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.UpdateUserRoleResponse}
+ */
+proto.sqlrpc.v1.UpdateUserRoleResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.UpdateUserRoleResponse;
+  return proto.sqlrpc.v1.UpdateUserRoleResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.UpdateUserRoleResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.UpdateUserRoleResponse}
+ */
+proto.sqlrpc.v1.UpdateUserRoleResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.UpdateUserRoleResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.UpdateUserRoleResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.UpdateUserRoleResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.UpdateUserRoleResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool success = 1;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.UpdateUserRoleResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.UpdateUserRoleResponse} returns this
+ */
+proto.sqlrpc.v1.UpdateUserRoleResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.DeleteUserRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.DeleteUserRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.DeleteUserRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DeleteUserRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+username: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.DeleteUserRequest}
+ */
+proto.sqlrpc.v1.DeleteUserRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.DeleteUserRequest;
+  return proto.sqlrpc.v1.DeleteUserRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.DeleteUserRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.DeleteUserRequest}
+ */
+proto.sqlrpc.v1.DeleteUserRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setUsername(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.DeleteUserRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.DeleteUserRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.DeleteUserRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DeleteUserRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string username = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.DeleteUserRequest.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.DeleteUserRequest} returns this
+ */
+proto.sqlrpc.v1.DeleteUserRequest.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.DeleteUserResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.DeleteUserResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.DeleteUserResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DeleteUserResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.DeleteUserResponse}
+ */
+proto.sqlrpc.v1.DeleteUserResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.DeleteUserResponse;
+  return proto.sqlrpc.v1.DeleteUserResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.DeleteUserResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.DeleteUserResponse}
+ */
+proto.sqlrpc.v1.DeleteUserResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.DeleteUserResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.DeleteUserResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.DeleteUserResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DeleteUserResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool success = 1;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.DeleteUserResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.DeleteUserResponse} returns this
+ */
+proto.sqlrpc.v1.DeleteUserResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.UnlockUserRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.UnlockUserRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.UnlockUserRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.UnlockUserRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+username: jspb.Message.getFieldWithDefault(msg, 1, ""),
+clientIp: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.UnlockUserRequest}
+ */
+proto.sqlrpc.v1.UnlockUserRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.UnlockUserRequest;
+  return proto.sqlrpc.v1.UnlockUserRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.UnlockUserRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.UnlockUserRequest}
+ */
+proto.sqlrpc.v1.UnlockUserRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setUsername(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setClientIp(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.UnlockUserRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.UnlockUserRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.UnlockUserRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.UnlockUserRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getClientIp();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string username = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.UnlockUserRequest.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.UnlockUserRequest} returns this
+ */
+proto.sqlrpc.v1.UnlockUserRequest.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string client_ip = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.UnlockUserRequest.prototype.getClientIp = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.UnlockUserRequest} returns this
+ */
+proto.sqlrpc.v1.UnlockUserRequest.prototype.setClientIp = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.UnlockUserResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.UnlockUserResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.UnlockUserResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.UnlockUserResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+unlocked: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.UnlockUserResponse}
+ */
+proto.sqlrpc.v1.UnlockUserResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.UnlockUserResponse;
+  return proto.sqlrpc.v1.UnlockUserResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.UnlockUserResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.UnlockUserResponse}
+ */
+proto.sqlrpc.v1.UnlockUserResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setUnlocked(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.UnlockUserResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.UnlockUserResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.UnlockUserResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.UnlockUserResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUnlocked();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool unlocked = 1;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.UnlockUserResponse.prototype.getUnlocked = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.UnlockUserResponse} returns this
+ */
+proto.sqlrpc.v1.UnlockUserResponse.prototype.setUnlocked = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.UpdatePasswordRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.UpdatePasswordRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.UpdatePasswordRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.UpdatePasswordRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+username: jspb.Message.getFieldWithDefault(msg, 1, ""),
+newPassword: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.UpdatePasswordRequest}
+ */
+proto.sqlrpc.v1.UpdatePasswordRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.UpdatePasswordRequest;
+  return proto.sqlrpc.v1.UpdatePasswordRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.UpdatePasswordRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.UpdatePasswordRequest}
+ */
+proto.sqlrpc.v1.UpdatePasswordRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setUsername(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setNewPassword(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.UpdatePasswordRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.UpdatePasswordRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.UpdatePasswordRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.UpdatePasswordRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getNewPassword();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string username = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.UpdatePasswordRequest.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.UpdatePasswordRequest} returns this
+ */
+proto.sqlrpc.v1.UpdatePasswordRequest.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string new_password = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.UpdatePasswordRequest.prototype.getNewPassword = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.UpdatePasswordRequest} returns this
+ */
+proto.sqlrpc.v1.UpdatePasswordRequest.prototype.setNewPassword = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.UpdatePasswordResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.UpdatePasswordResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.UpdatePasswordResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.UpdatePasswordResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.UpdatePasswordResponse}
+ */
+proto.sqlrpc.v1.UpdatePasswordResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.UpdatePasswordResponse;
+  return proto.sqlrpc.v1.UpdatePasswordResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.UpdatePasswordResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.UpdatePasswordResponse}
+ */
+proto.sqlrpc.v1.UpdatePasswordResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.UpdatePasswordResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.UpdatePasswordResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.UpdatePasswordResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.UpdatePasswordResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool success = 1;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.UpdatePasswordResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.UpdatePasswordResponse} returns this
+ */
+proto.sqlrpc.v1.UpdatePasswordResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.ListAPIKeysRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ListAPIKeysRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.ListAPIKeysRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListAPIKeysRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+username: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.ListAPIKeysRequest}
+ */
+proto.sqlrpc.v1.ListAPIKeysRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.ListAPIKeysRequest;
+  return proto.sqlrpc.v1.ListAPIKeysRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.ListAPIKeysRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.ListAPIKeysRequest}
+ */
+proto.sqlrpc.v1.ListAPIKeysRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setUsername(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.ListAPIKeysRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ListAPIKeysRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ListAPIKeysRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListAPIKeysRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string username = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.ListAPIKeysRequest.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ListAPIKeysRequest} returns this
+ */
+proto.sqlrpc.v1.ListAPIKeysRequest.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.ListAPIKeysResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.ListAPIKeysResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ListAPIKeysResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.ListAPIKeysResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListAPIKeysResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+keysList: jspb.Message.toObjectList(msg.getKeysList(),
+    proto.sqlrpc.v1.APIKey.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.ListAPIKeysResponse}
+ */
+proto.sqlrpc.v1.ListAPIKeysResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.ListAPIKeysResponse;
+  return proto.sqlrpc.v1.ListAPIKeysResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.ListAPIKeysResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.ListAPIKeysResponse}
+ */
+proto.sqlrpc.v1.ListAPIKeysResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.sqlrpc.v1.APIKey;
+      reader.readMessage(value,proto.sqlrpc.v1.APIKey.deserializeBinaryFromReader);
+      msg.addKeys(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.ListAPIKeysResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ListAPIKeysResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ListAPIKeysResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListAPIKeysResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getKeysList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.sqlrpc.v1.APIKey.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated APIKey keys = 1;
+ * @return {!Array<!proto.sqlrpc.v1.APIKey>}
+ */
+proto.sqlrpc.v1.ListAPIKeysResponse.prototype.getKeysList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.APIKey>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.sqlrpc.v1.APIKey, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.sqlrpc.v1.APIKey>} value
+ * @return {!proto.sqlrpc.v1.ListAPIKeysResponse} returns this
+*/
+proto.sqlrpc.v1.ListAPIKeysResponse.prototype.setKeysList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.APIKey=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.APIKey}
+ */
+proto.sqlrpc.v1.ListAPIKeysResponse.prototype.addKeys = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.sqlrpc.v1.APIKey, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.ListAPIKeysResponse} returns this
+ */
+proto.sqlrpc.v1.ListAPIKeysResponse.prototype.clearKeysList = function() {
+  return this.setKeysList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.APIKey.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.APIKey.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.APIKey} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.APIKey.toObject = function(includeInstance, msg) {
+  var f, obj = {
+id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+keyPreview: jspb.Message.getFieldWithDefault(msg, 2, ""),
+name: jspb.Message.getFieldWithDefault(msg, 3, ""),
+createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+expiresAt: (f = msg.getExpiresAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+scope: (f = msg.getScope()) && proto.sqlrpc.v1.APIKeyScope.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.APIKey}
+ */
+proto.sqlrpc.v1.APIKey.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.APIKey;
+  return proto.sqlrpc.v1.APIKey.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.APIKey} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.APIKey}
+ */
+proto.sqlrpc.v1.APIKey.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setKeyPreview(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setName(value);
+      break;
+    case 4:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreatedAt(value);
+      break;
+    case 5:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setExpiresAt(value);
+      break;
+    case 6:
+      var value = new proto.sqlrpc.v1.APIKeyScope;
+      reader.readMessage(value,proto.sqlrpc.v1.APIKeyScope.deserializeBinaryFromReader);
+      msg.setScope(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.APIKey.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.APIKey.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.APIKey} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.APIKey.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getKeyPreview();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getCreatedAt();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getExpiresAt();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getScope();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.sqlrpc.v1.APIKeyScope.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.APIKey.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.APIKey} returns this
+ */
+proto.sqlrpc.v1.APIKey.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string key_preview = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.APIKey.prototype.getKeyPreview = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.APIKey} returns this
+ */
+proto.sqlrpc.v1.APIKey.prototype.setKeyPreview = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string name = 3;
+ * @return {string}
+ */
+proto.sqlrpc.v1.APIKey.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.APIKey} returns this
+ */
+proto.sqlrpc.v1.APIKey.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp created_at = 4;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.sqlrpc.v1.APIKey.prototype.getCreatedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.sqlrpc.v1.APIKey} returns this
+*/
+proto.sqlrpc.v1.APIKey.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.APIKey} returns this
+ */
+proto.sqlrpc.v1.APIKey.prototype.clearCreatedAt = function() {
+  return this.setCreatedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.APIKey.prototype.hasCreatedAt = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp expires_at = 5;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.sqlrpc.v1.APIKey.prototype.getExpiresAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 5));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.sqlrpc.v1.APIKey} returns this
+*/
+proto.sqlrpc.v1.APIKey.prototype.setExpiresAt = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.APIKey} returns this
+ */
+proto.sqlrpc.v1.APIKey.prototype.clearExpiresAt = function() {
+  return this.setExpiresAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.APIKey.prototype.hasExpiresAt = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional APIKeyScope scope = 6;
+ * @return {?proto.sqlrpc.v1.APIKeyScope}
+ */
+proto.sqlrpc.v1.APIKey.prototype.getScope = function() {
+  return /** @type{?proto.sqlrpc.v1.APIKeyScope} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.APIKeyScope, 6));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.APIKeyScope|undefined} value
+ * @return {!proto.sqlrpc.v1.APIKey} returns this
+*/
+proto.sqlrpc.v1.APIKey.prototype.setScope = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.APIKey} returns this
+ */
+proto.sqlrpc.v1.APIKey.prototype.clearScope = function() {
+  return this.setScope(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.APIKey.prototype.hasScope = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.APIKeyScope.repeatedFields_ = [1,3,4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.APIKeyScope.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.APIKeyScope.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.APIKeyScope} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.APIKeyScope.toObject = function(includeInstance, msg) {
+  var f, obj = {
+databasesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+maxRole: jspb.Message.getFieldWithDefault(msg, 2, 0),
+familiesList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+channelsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.APIKeyScope}
+ */
+proto.sqlrpc.v1.APIKeyScope.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.APIKeyScope;
+  return proto.sqlrpc.v1.APIKeyScope.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.APIKeyScope} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.APIKeyScope}
+ */
+proto.sqlrpc.v1.APIKeyScope.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.addDatabases(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.sqlrpc.v1.Role} */ (reader.readEnum());
+      msg.setMaxRole(value);
+      break;
+    case 3:
+      reader.readPackableEnumInto(msg.getFamiliesList());
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.addChannels(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.APIKeyScope.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.APIKeyScope.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.APIKeyScope} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.APIKeyScope.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDatabasesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+  f = message.getMaxRole();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getFamiliesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      3,
+      f
+    );
+  }
+  f = message.getChannelsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string databases = 1;
+ * @return {!Array<string>}
+ */
+proto.sqlrpc.v1.APIKeyScope.prototype.getDatabasesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.sqlrpc.v1.APIKeyScope} returns this
+ */
+proto.sqlrpc.v1.APIKeyScope.prototype.setDatabasesList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.APIKeyScope} returns this
+ */
+proto.sqlrpc.v1.APIKeyScope.prototype.addDatabases = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.APIKeyScope} returns this
+ */
+proto.sqlrpc.v1.APIKeyScope.prototype.clearDatabasesList = function() {
+  return this.setDatabasesList([]);
+};
+
+
+/**
+ * optional Role max_role = 2;
+ * @return {!proto.sqlrpc.v1.Role}
+ */
+proto.sqlrpc.v1.APIKeyScope.prototype.getMaxRole = function() {
+  return /** @type {!proto.sqlrpc.v1.Role} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.Role} value
+ * @return {!proto.sqlrpc.v1.APIKeyScope} returns this
+ */
+proto.sqlrpc.v1.APIKeyScope.prototype.setMaxRole = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * repeated RpcFamily families = 3;
+ * @return {!Array<!proto.sqlrpc.v1.RpcFamily>}
+ */
+proto.sqlrpc.v1.APIKeyScope.prototype.getFamiliesList = function() {
+  return /** @type {!Array<!proto.sqlrpc.v1.RpcFamily>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.sqlrpc.v1.RpcFamily>} value
+ * @return {!proto.sqlrpc.v1.APIKeyScope} returns this
+ */
+proto.sqlrpc.v1.APIKeyScope.prototype.setFamiliesList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.RpcFamily} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.APIKeyScope} returns this
+ */
+proto.sqlrpc.v1.APIKeyScope.prototype.addFamilies = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.APIKeyScope} returns this
+ */
+proto.sqlrpc.v1.APIKeyScope.prototype.clearFamiliesList = function() {
+  return this.setFamiliesList([]);
+};
+
+
+/**
+ * repeated string channels = 4;
+ * @return {!Array<string>}
+ */
+proto.sqlrpc.v1.APIKeyScope.prototype.getChannelsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.sqlrpc.v1.APIKeyScope} returns this
+ */
+proto.sqlrpc.v1.APIKeyScope.prototype.setChannelsList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.APIKeyScope} returns this
+ */
+proto.sqlrpc.v1.APIKeyScope.prototype.addChannels = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.APIKeyScope} returns this
+ */
+proto.sqlrpc.v1.APIKeyScope.prototype.clearChannelsList = function() {
+  return this.setChannelsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.CreateAPIKeyRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.CreateAPIKeyRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.CreateAPIKeyRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+username: jspb.Message.getFieldWithDefault(msg, 1, ""),
+name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+expiresAt: (f = msg.getExpiresAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+scope: (f = msg.getScope()) && proto.sqlrpc.v1.APIKeyScope.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.CreateAPIKeyRequest}
+ */
+proto.sqlrpc.v1.CreateAPIKeyRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.CreateAPIKeyRequest;
+  return proto.sqlrpc.v1.CreateAPIKeyRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.CreateAPIKeyRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.CreateAPIKeyRequest}
+ */
+proto.sqlrpc.v1.CreateAPIKeyRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setUsername(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setExpiresAt(value);
+      break;
+    case 4:
+      var value = new proto.sqlrpc.v1.APIKeyScope;
+      reader.readMessage(value,proto.sqlrpc.v1.APIKeyScope.deserializeBinaryFromReader);
+      msg.setScope(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.CreateAPIKeyRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.CreateAPIKeyRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.CreateAPIKeyRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getExpiresAt();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getScope();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.sqlrpc.v1.APIKeyScope.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string username = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.CreateAPIKeyRequest} returns this
+ */
+proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.CreateAPIKeyRequest} returns this
+ */
+proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp expires_at = 3;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.getExpiresAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.sqlrpc.v1.CreateAPIKeyRequest} returns this
+*/
+proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.setExpiresAt = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.CreateAPIKeyRequest} returns this
+ */
+proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.clearExpiresAt = function() {
+  return this.setExpiresAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.hasExpiresAt = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional APIKeyScope scope = 4;
+ * @return {?proto.sqlrpc.v1.APIKeyScope}
+ */
+proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.getScope = function() {
+  return /** @type{?proto.sqlrpc.v1.APIKeyScope} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.APIKeyScope, 4));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.APIKeyScope|undefined} value
+ * @return {!proto.sqlrpc.v1.CreateAPIKeyRequest} returns this
+*/
+proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.setScope = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.CreateAPIKeyRequest} returns this
+ */
+proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.clearScope = function() {
+  return this.setScope(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.hasScope = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.CreateAPIKeyResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.CreateAPIKeyResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.CreateAPIKeyResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.CreateAPIKeyResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+apiKey: jspb.Message.getFieldWithDefault(msg, 1, ""),
+keyId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+metadata: (f = msg.getMetadata()) && proto.sqlrpc.v1.APIKey.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.CreateAPIKeyResponse}
+ */
+proto.sqlrpc.v1.CreateAPIKeyResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.CreateAPIKeyResponse;
+  return proto.sqlrpc.v1.CreateAPIKeyResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.CreateAPIKeyResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.CreateAPIKeyResponse}
+ */
+proto.sqlrpc.v1.CreateAPIKeyResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setApiKey(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setKeyId(value);
+      break;
+    case 3:
+      var value = new proto.sqlrpc.v1.APIKey;
+      reader.readMessage(value,proto.sqlrpc.v1.APIKey.deserializeBinaryFromReader);
+      msg.setMetadata(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.CreateAPIKeyResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.CreateAPIKeyResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.CreateAPIKeyResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.CreateAPIKeyResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getApiKey();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getKeyId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getMetadata();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.sqlrpc.v1.APIKey.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string api_key = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.CreateAPIKeyResponse.prototype.getApiKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.CreateAPIKeyResponse} returns this
+ */
+proto.sqlrpc.v1.CreateAPIKeyResponse.prototype.setApiKey = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string key_id = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.CreateAPIKeyResponse.prototype.getKeyId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.CreateAPIKeyResponse} returns this
+ */
+proto.sqlrpc.v1.CreateAPIKeyResponse.prototype.setKeyId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional APIKey metadata = 3;
+ * @return {?proto.sqlrpc.v1.APIKey}
+ */
+proto.sqlrpc.v1.CreateAPIKeyResponse.prototype.getMetadata = function() {
+  return /** @type{?proto.sqlrpc.v1.APIKey} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.APIKey, 3));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.APIKey|undefined} value
+ * @return {!proto.sqlrpc.v1.CreateAPIKeyResponse} returns this
+*/
+proto.sqlrpc.v1.CreateAPIKeyResponse.prototype.setMetadata = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.CreateAPIKeyResponse} returns this
+ */
+proto.sqlrpc.v1.CreateAPIKeyResponse.prototype.clearMetadata = function() {
+  return this.setMetadata(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.CreateAPIKeyResponse.prototype.hasMetadata = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.DeleteAPIKeyRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.DeleteAPIKeyRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.DeleteAPIKeyRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DeleteAPIKeyRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+username: jspb.Message.getFieldWithDefault(msg, 1, ""),
+keyId: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.DeleteAPIKeyRequest}
+ */
+proto.sqlrpc.v1.DeleteAPIKeyRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.DeleteAPIKeyRequest;
+  return proto.sqlrpc.v1.DeleteAPIKeyRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.DeleteAPIKeyRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.DeleteAPIKeyRequest}
+ */
+proto.sqlrpc.v1.DeleteAPIKeyRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setUsername(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setKeyId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.DeleteAPIKeyRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.DeleteAPIKeyRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.DeleteAPIKeyRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DeleteAPIKeyRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getKeyId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string username = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.DeleteAPIKeyRequest.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.DeleteAPIKeyRequest} returns this
+ */
+proto.sqlrpc.v1.DeleteAPIKeyRequest.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string key_id = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.DeleteAPIKeyRequest.prototype.getKeyId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.DeleteAPIKeyRequest} returns this
+ */
+proto.sqlrpc.v1.DeleteAPIKeyRequest.prototype.setKeyId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.DeleteAPIKeyResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.DeleteAPIKeyResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.DeleteAPIKeyResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DeleteAPIKeyResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.DeleteAPIKeyResponse}
+ */
+proto.sqlrpc.v1.DeleteAPIKeyResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.DeleteAPIKeyResponse;
+  return proto.sqlrpc.v1.DeleteAPIKeyResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.DeleteAPIKeyResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.DeleteAPIKeyResponse}
+ */
+proto.sqlrpc.v1.DeleteAPIKeyResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.DeleteAPIKeyResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.DeleteAPIKeyResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.DeleteAPIKeyResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DeleteAPIKeyResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool success = 1;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.DeleteAPIKeyResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.DeleteAPIKeyResponse} returns this
+ */
+proto.sqlrpc.v1.DeleteAPIKeyResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.DatabaseGrant.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.DatabaseGrant.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.DatabaseGrant} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DatabaseGrant.toObject = function(includeInstance, msg) {
+  var f, obj = {
+id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+username: jspb.Message.getFieldWithDefault(msg, 2, ""),
+apiKeyId: jspb.Message.getFieldWithDefault(msg, 3, ""),
+database: jspb.Message.getFieldWithDefault(msg, 4, ""),
+role: jspb.Message.getFieldWithDefault(msg, 5, 0),
+createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.DatabaseGrant}
+ */
+proto.sqlrpc.v1.DatabaseGrant.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.DatabaseGrant;
+  return proto.sqlrpc.v1.DatabaseGrant.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.DatabaseGrant} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.DatabaseGrant}
+ */
+proto.sqlrpc.v1.DatabaseGrant.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setUsername(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setApiKeyId(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.sqlrpc.v1.Role} */ (reader.readEnum());
+      msg.setRole(value);
+      break;
+    case 6:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreatedAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.DatabaseGrant.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.DatabaseGrant.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.DatabaseGrant} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DatabaseGrant.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getApiKeyId();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getRole();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+  f = message.getCreatedAt();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional int64 id = 1;
+ * @return {number}
+ */
+proto.sqlrpc.v1.DatabaseGrant.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.DatabaseGrant} returns this
+ */
+proto.sqlrpc.v1.DatabaseGrant.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string username = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.DatabaseGrant.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.DatabaseGrant} returns this
+ */
+proto.sqlrpc.v1.DatabaseGrant.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string api_key_id = 3;
+ * @return {string}
+ */
+proto.sqlrpc.v1.DatabaseGrant.prototype.getApiKeyId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.DatabaseGrant} returns this
+ */
+proto.sqlrpc.v1.DatabaseGrant.prototype.setApiKeyId = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string database = 4;
+ * @return {string}
+ */
+proto.sqlrpc.v1.DatabaseGrant.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.DatabaseGrant} returns this
+ */
+proto.sqlrpc.v1.DatabaseGrant.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional Role role = 5;
+ * @return {!proto.sqlrpc.v1.Role}
+ */
+proto.sqlrpc.v1.DatabaseGrant.prototype.getRole = function() {
+  return /** @type {!proto.sqlrpc.v1.Role} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.Role} value
+ * @return {!proto.sqlrpc.v1.DatabaseGrant} returns this
+ */
+proto.sqlrpc.v1.DatabaseGrant.prototype.setRole = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp created_at = 6;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.sqlrpc.v1.DatabaseGrant.prototype.getCreatedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 6));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.sqlrpc.v1.DatabaseGrant} returns this
+*/
+proto.sqlrpc.v1.DatabaseGrant.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.DatabaseGrant} returns this
+ */
+proto.sqlrpc.v1.DatabaseGrant.prototype.clearCreatedAt = function() {
+  return this.setCreatedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.DatabaseGrant.prototype.hasCreatedAt = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessRequest.oneofGroups_ = [[1,2]];
+
+/**
+ * @enum {number}
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessRequest.GranteeCase = {
+  GRANTEE_NOT_SET: 0,
+  USERNAME: 1,
+  API_KEY_ID: 2
+};
+
+/**
+ * @return {proto.sqlrpc.v1.GrantDatabaseAccessRequest.GranteeCase}
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessRequest.prototype.getGranteeCase = function() {
+  return /** @type {proto.sqlrpc.v1.GrantDatabaseAccessRequest.GranteeCase} */(jspb.Message.computeOneofCase(this, proto.sqlrpc.v1.GrantDatabaseAccessRequest.oneofGroups_[0]));
+};
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.GrantDatabaseAccessRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.GrantDatabaseAccessRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+username: (f = jspb.Message.getField(msg, 1)) == null ? undefined : f,
+apiKeyId: (f = jspb.Message.getField(msg, 2)) == null ? undefined : f,
+database: jspb.Message.getFieldWithDefault(msg, 3, ""),
+role: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.GrantDatabaseAccessRequest}
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.GrantDatabaseAccessRequest;
+  return proto.sqlrpc.v1.GrantDatabaseAccessRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.GrantDatabaseAccessRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.GrantDatabaseAccessRequest}
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setUsername(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setApiKeyId(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.sqlrpc.v1.Role} */ (reader.readEnum());
+      msg.setRole(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.GrantDatabaseAccessRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.GrantDatabaseAccessRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = /** @type {string} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getRole();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string username = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessRequest.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.GrantDatabaseAccessRequest} returns this
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessRequest.prototype.setUsername = function(value) {
+  return jspb.Message.setOneofField(this, 1, proto.sqlrpc.v1.GrantDatabaseAccessRequest.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.sqlrpc.v1.GrantDatabaseAccessRequest} returns this
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessRequest.prototype.clearUsername = function() {
+  return jspb.Message.setOneofField(this, 1, proto.sqlrpc.v1.GrantDatabaseAccessRequest.oneofGroups_[0], undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessRequest.prototype.hasUsername = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string api_key_id = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessRequest.prototype.getApiKeyId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.GrantDatabaseAccessRequest} returns this
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessRequest.prototype.setApiKeyId = function(value) {
+  return jspb.Message.setOneofField(this, 2, proto.sqlrpc.v1.GrantDatabaseAccessRequest.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.sqlrpc.v1.GrantDatabaseAccessRequest} returns this
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessRequest.prototype.clearApiKeyId = function() {
+  return jspb.Message.setOneofField(this, 2, proto.sqlrpc.v1.GrantDatabaseAccessRequest.oneofGroups_[0], undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessRequest.prototype.hasApiKeyId = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional string database = 3;
+ * @return {string}
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessRequest.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.GrantDatabaseAccessRequest} returns this
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessRequest.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional Role role = 4;
+ * @return {!proto.sqlrpc.v1.Role}
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessRequest.prototype.getRole = function() {
+  return /** @type {!proto.sqlrpc.v1.Role} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.Role} value
+ * @return {!proto.sqlrpc.v1.GrantDatabaseAccessRequest} returns this
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessRequest.prototype.setRole = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.GrantDatabaseAccessResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.GrantDatabaseAccessResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+grant: (f = msg.getGrant()) && proto.sqlrpc.v1.DatabaseGrant.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.GrantDatabaseAccessResponse}
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.GrantDatabaseAccessResponse;
+  return proto.sqlrpc.v1.GrantDatabaseAccessResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.GrantDatabaseAccessResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.GrantDatabaseAccessResponse}
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.sqlrpc.v1.DatabaseGrant;
+      reader.readMessage(value,proto.sqlrpc.v1.DatabaseGrant.deserializeBinaryFromReader);
+      msg.setGrant(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.GrantDatabaseAccessResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.GrantDatabaseAccessResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getGrant();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.sqlrpc.v1.DatabaseGrant.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional DatabaseGrant grant = 1;
+ * @return {?proto.sqlrpc.v1.DatabaseGrant}
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessResponse.prototype.getGrant = function() {
+  return /** @type{?proto.sqlrpc.v1.DatabaseGrant} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.DatabaseGrant, 1));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.DatabaseGrant|undefined} value
+ * @return {!proto.sqlrpc.v1.GrantDatabaseAccessResponse} returns this
+*/
+proto.sqlrpc.v1.GrantDatabaseAccessResponse.prototype.setGrant = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.GrantDatabaseAccessResponse} returns this
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessResponse.prototype.clearGrant = function() {
+  return this.setGrant(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.GrantDatabaseAccessResponse.prototype.hasGrant = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.RevokeDatabaseAccessRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.RevokeDatabaseAccessRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.RevokeDatabaseAccessRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.RevokeDatabaseAccessRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+grantId: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.RevokeDatabaseAccessRequest}
+ */
+proto.sqlrpc.v1.RevokeDatabaseAccessRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.RevokeDatabaseAccessRequest;
+  return proto.sqlrpc.v1.RevokeDatabaseAccessRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.RevokeDatabaseAccessRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.RevokeDatabaseAccessRequest}
+ */
+proto.sqlrpc.v1.RevokeDatabaseAccessRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setGrantId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.RevokeDatabaseAccessRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.RevokeDatabaseAccessRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.RevokeDatabaseAccessRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.RevokeDatabaseAccessRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getGrantId();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 grant_id = 1;
+ * @return {number}
+ */
+proto.sqlrpc.v1.RevokeDatabaseAccessRequest.prototype.getGrantId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.RevokeDatabaseAccessRequest} returns this
+ */
+proto.sqlrpc.v1.RevokeDatabaseAccessRequest.prototype.setGrantId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.RevokeDatabaseAccessResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.RevokeDatabaseAccessResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.RevokeDatabaseAccessResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.RevokeDatabaseAccessResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.RevokeDatabaseAccessResponse}
+ */
+proto.sqlrpc.v1.RevokeDatabaseAccessResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.RevokeDatabaseAccessResponse;
+  return proto.sqlrpc.v1.RevokeDatabaseAccessResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.RevokeDatabaseAccessResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.RevokeDatabaseAccessResponse}
+ */
+proto.sqlrpc.v1.RevokeDatabaseAccessResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.RevokeDatabaseAccessResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.RevokeDatabaseAccessResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.RevokeDatabaseAccessResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.RevokeDatabaseAccessResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool success = 1;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.RevokeDatabaseAccessResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.RevokeDatabaseAccessResponse} returns this
+ */
+proto.sqlrpc.v1.RevokeDatabaseAccessResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.ListDatabaseGrantsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ListDatabaseGrantsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.ListDatabaseGrantsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListDatabaseGrantsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+username: jspb.Message.getFieldWithDefault(msg, 1, ""),
+database: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.ListDatabaseGrantsRequest}
+ */
+proto.sqlrpc.v1.ListDatabaseGrantsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.ListDatabaseGrantsRequest;
+  return proto.sqlrpc.v1.ListDatabaseGrantsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.ListDatabaseGrantsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.ListDatabaseGrantsRequest}
+ */
+proto.sqlrpc.v1.ListDatabaseGrantsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setUsername(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.ListDatabaseGrantsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ListDatabaseGrantsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ListDatabaseGrantsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListDatabaseGrantsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string username = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.ListDatabaseGrantsRequest.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ListDatabaseGrantsRequest} returns this
+ */
+proto.sqlrpc.v1.ListDatabaseGrantsRequest.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string database = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.ListDatabaseGrantsRequest.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ListDatabaseGrantsRequest} returns this
+ */
+proto.sqlrpc.v1.ListDatabaseGrantsRequest.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.ListDatabaseGrantsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.ListDatabaseGrantsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ListDatabaseGrantsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.ListDatabaseGrantsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListDatabaseGrantsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+grantsList: jspb.Message.toObjectList(msg.getGrantsList(),
+    proto.sqlrpc.v1.DatabaseGrant.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.ListDatabaseGrantsResponse}
+ */
+proto.sqlrpc.v1.ListDatabaseGrantsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.ListDatabaseGrantsResponse;
+  return proto.sqlrpc.v1.ListDatabaseGrantsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.ListDatabaseGrantsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.ListDatabaseGrantsResponse}
+ */
+proto.sqlrpc.v1.ListDatabaseGrantsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.sqlrpc.v1.DatabaseGrant;
+      reader.readMessage(value,proto.sqlrpc.v1.DatabaseGrant.deserializeBinaryFromReader);
+      msg.addGrants(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.ListDatabaseGrantsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ListDatabaseGrantsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ListDatabaseGrantsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListDatabaseGrantsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getGrantsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.sqlrpc.v1.DatabaseGrant.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated DatabaseGrant grants = 1;
+ * @return {!Array<!proto.sqlrpc.v1.DatabaseGrant>}
+ */
+proto.sqlrpc.v1.ListDatabaseGrantsResponse.prototype.getGrantsList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.DatabaseGrant>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.sqlrpc.v1.DatabaseGrant, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.sqlrpc.v1.DatabaseGrant>} value
+ * @return {!proto.sqlrpc.v1.ListDatabaseGrantsResponse} returns this
+*/
+proto.sqlrpc.v1.ListDatabaseGrantsResponse.prototype.setGrantsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.DatabaseGrant=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.DatabaseGrant}
+ */
+proto.sqlrpc.v1.ListDatabaseGrantsResponse.prototype.addGrants = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.sqlrpc.v1.DatabaseGrant, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.ListDatabaseGrantsResponse} returns this
+ */
+proto.sqlrpc.v1.ListDatabaseGrantsResponse.prototype.clearGrantsList = function() {
+  return this.setGrantsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.RateLimit.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.RateLimit.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.RateLimit} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.RateLimit.toObject = function(includeInstance, msg) {
+  var f, obj = {
+id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+username: jspb.Message.getFieldWithDefault(msg, 2, ""),
+apiKeyId: jspb.Message.getFieldWithDefault(msg, 3, ""),
+database: jspb.Message.getFieldWithDefault(msg, 4, ""),
+requestsPerSecond: jspb.Message.getFloatingPointFieldWithDefault(msg, 5, 0.0),
+burst: jspb.Message.getFieldWithDefault(msg, 6, 0),
+maxStreams: jspb.Message.getFieldWithDefault(msg, 7, 0),
+maxTransactions: jspb.Message.getFieldWithDefault(msg, 8, 0),
+rowsPerMinute: jspb.Message.getFieldWithDefault(msg, 9, 0),
+bytesPerMinute: jspb.Message.getFieldWithDefault(msg, 10, 0),
+createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.RateLimit}
+ */
+proto.sqlrpc.v1.RateLimit.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.RateLimit;
+  return proto.sqlrpc.v1.RateLimit.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.RateLimit} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.RateLimit}
+ */
+proto.sqlrpc.v1.RateLimit.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setUsername(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setApiKeyId(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setRequestsPerSecond(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setBurst(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxStreams(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxTransactions(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setRowsPerMinute(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setBytesPerMinute(value);
+      break;
+    case 11:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreatedAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.RateLimit.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.RateLimit.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.RateLimit} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.RateLimit.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getApiKeyId();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getRequestsPerSecond();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      5,
+      f
+    );
+  }
+  f = message.getBurst();
+  if (f !== 0) {
+    writer.writeInt32(
+      6,
+      f
+    );
+  }
+  f = message.getMaxStreams();
+  if (f !== 0) {
+    writer.writeInt32(
+      7,
+      f
+    );
+  }
+  f = message.getMaxTransactions();
+  if (f !== 0) {
+    writer.writeInt32(
+      8,
+      f
+    );
+  }
+  f = message.getRowsPerMinute();
+  if (f !== 0) {
+    writer.writeInt64(
+      9,
+      f
+    );
+  }
+  f = message.getBytesPerMinute();
+  if (f !== 0) {
+    writer.writeInt64(
+      10,
+      f
+    );
+  }
+  f = message.getCreatedAt();
+  if (f != null) {
+    writer.writeMessage(
+      11,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional int64 id = 1;
+ * @return {number}
+ */
+proto.sqlrpc.v1.RateLimit.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.RateLimit} returns this
+ */
+proto.sqlrpc.v1.RateLimit.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string username = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.RateLimit.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.RateLimit} returns this
+ */
+proto.sqlrpc.v1.RateLimit.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string api_key_id = 3;
+ * @return {string}
+ */
+proto.sqlrpc.v1.RateLimit.prototype.getApiKeyId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.RateLimit} returns this
+ */
+proto.sqlrpc.v1.RateLimit.prototype.setApiKeyId = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string database = 4;
+ * @return {string}
+ */
+proto.sqlrpc.v1.RateLimit.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.RateLimit} returns this
+ */
+proto.sqlrpc.v1.RateLimit.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional double requests_per_second = 5;
+ * @return {number}
+ */
+proto.sqlrpc.v1.RateLimit.prototype.getRequestsPerSecond = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 5, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.RateLimit} returns this
+ */
+proto.sqlrpc.v1.RateLimit.prototype.setRequestsPerSecond = function(value) {
+  return jspb.Message.setProto3FloatField(this, 5, value);
+};
+
+
+/**
+ * optional int32 burst = 6;
+ * @return {number}
+ */
+proto.sqlrpc.v1.RateLimit.prototype.getBurst = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.RateLimit} returns this
+ */
+proto.sqlrpc.v1.RateLimit.prototype.setBurst = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional int32 max_streams = 7;
+ * @return {number}
+ */
+proto.sqlrpc.v1.RateLimit.prototype.getMaxStreams = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.RateLimit} returns this
+ */
+proto.sqlrpc.v1.RateLimit.prototype.setMaxStreams = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional int32 max_transactions = 8;
+ * @return {number}
+ */
+proto.sqlrpc.v1.RateLimit.prototype.getMaxTransactions = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.RateLimit} returns this
+ */
+proto.sqlrpc.v1.RateLimit.prototype.setMaxTransactions = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+/**
+ * optional int64 rows_per_minute = 9;
+ * @return {number}
+ */
+proto.sqlrpc.v1.RateLimit.prototype.getRowsPerMinute = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.RateLimit} returns this
+ */
+proto.sqlrpc.v1.RateLimit.prototype.setRowsPerMinute = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
+};
+
+
+/**
+ * optional int64 bytes_per_minute = 10;
+ * @return {number}
+ */
+proto.sqlrpc.v1.RateLimit.prototype.getBytesPerMinute = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.RateLimit} returns this
+ */
+proto.sqlrpc.v1.RateLimit.prototype.setBytesPerMinute = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp created_at = 11;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.sqlrpc.v1.RateLimit.prototype.getCreatedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 11));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.sqlrpc.v1.RateLimit} returns this
+*/
+proto.sqlrpc.v1.RateLimit.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 11, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.RateLimit} returns this
+ */
+proto.sqlrpc.v1.RateLimit.prototype.clearCreatedAt = function() {
+  return this.setCreatedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.RateLimit.prototype.hasCreatedAt = function() {
+  return jspb.Message.getField(this, 11) != null;
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.oneofGroups_ = [[1,2]];
+
+/**
+ * @enum {number}
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.SubjectCase = {
+  SUBJECT_NOT_SET: 0,
+  USERNAME: 1,
+  API_KEY_ID: 2
+};
+
+/**
+ * @return {proto.sqlrpc.v1.SetRateLimitRequest.SubjectCase}
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.getSubjectCase = function() {
+  return /** @type {proto.sqlrpc.v1.SetRateLimitRequest.SubjectCase} */(jspb.Message.computeOneofCase(this, proto.sqlrpc.v1.SetRateLimitRequest.oneofGroups_[0]));
+};
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.SetRateLimitRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.SetRateLimitRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+username: (f = jspb.Message.getField(msg, 1)) == null ? undefined : f,
+apiKeyId: (f = jspb.Message.getField(msg, 2)) == null ? undefined : f,
+database: jspb.Message.getFieldWithDefault(msg, 3, ""),
+requestsPerSecond: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0),
+burst: jspb.Message.getFieldWithDefault(msg, 5, 0),
+maxStreams: jspb.Message.getFieldWithDefault(msg, 6, 0),
+maxTransactions: jspb.Message.getFieldWithDefault(msg, 7, 0),
+rowsPerMinute: jspb.Message.getFieldWithDefault(msg, 8, 0),
+bytesPerMinute: jspb.Message.getFieldWithDefault(msg, 9, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.SetRateLimitRequest}
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.SetRateLimitRequest;
+  return proto.sqlrpc.v1.SetRateLimitRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.SetRateLimitRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.SetRateLimitRequest}
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setUsername(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setApiKeyId(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setRequestsPerSecond(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setBurst(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxStreams(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxTransactions(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setRowsPerMinute(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setBytesPerMinute(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.SetRateLimitRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.SetRateLimitRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = /** @type {string} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getRequestsPerSecond();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      4,
+      f
+    );
+  }
+  f = message.getBurst();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+  f = message.getMaxStreams();
+  if (f !== 0) {
+    writer.writeInt32(
+      6,
+      f
+    );
+  }
+  f = message.getMaxTransactions();
+  if (f !== 0) {
+    writer.writeInt32(
+      7,
+      f
+    );
+  }
+  f = message.getRowsPerMinute();
+  if (f !== 0) {
+    writer.writeInt64(
+      8,
+      f
+    );
+  }
+  f = message.getBytesPerMinute();
+  if (f !== 0) {
+    writer.writeInt64(
+      9,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string username = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.SetRateLimitRequest} returns this
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.setUsername = function(value) {
+  return jspb.Message.setOneofField(this, 1, proto.sqlrpc.v1.SetRateLimitRequest.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.sqlrpc.v1.SetRateLimitRequest} returns this
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.clearUsername = function() {
+  return jspb.Message.setOneofField(this, 1, proto.sqlrpc.v1.SetRateLimitRequest.oneofGroups_[0], undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.hasUsername = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string api_key_id = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.getApiKeyId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.SetRateLimitRequest} returns this
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.setApiKeyId = function(value) {
+  return jspb.Message.setOneofField(this, 2, proto.sqlrpc.v1.SetRateLimitRequest.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.sqlrpc.v1.SetRateLimitRequest} returns this
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.clearApiKeyId = function() {
+  return jspb.Message.setOneofField(this, 2, proto.sqlrpc.v1.SetRateLimitRequest.oneofGroups_[0], undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.hasApiKeyId = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional string database = 3;
+ * @return {string}
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.SetRateLimitRequest} returns this
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional double requests_per_second = 4;
+ * @return {number}
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.getRequestsPerSecond = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.SetRateLimitRequest} returns this
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.setRequestsPerSecond = function(value) {
+  return jspb.Message.setProto3FloatField(this, 4, value);
+};
+
+
+/**
+ * optional int32 burst = 5;
+ * @return {number}
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.getBurst = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.SetRateLimitRequest} returns this
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.setBurst = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional int32 max_streams = 6;
+ * @return {number}
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.getMaxStreams = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.SetRateLimitRequest} returns this
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.setMaxStreams = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional int32 max_transactions = 7;
+ * @return {number}
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.getMaxTransactions = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.SetRateLimitRequest} returns this
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.setMaxTransactions = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional int64 rows_per_minute = 8;
+ * @return {number}
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.getRowsPerMinute = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.SetRateLimitRequest} returns this
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.setRowsPerMinute = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+/**
+ * optional int64 bytes_per_minute = 9;
+ * @return {number}
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.getBytesPerMinute = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.SetRateLimitRequest} returns this
+ */
+proto.sqlrpc.v1.SetRateLimitRequest.prototype.setBytesPerMinute = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.SetRateLimitResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.SetRateLimitResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.SetRateLimitResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.SetRateLimitResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+limit: (f = msg.getLimit()) && proto.sqlrpc.v1.RateLimit.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.SetRateLimitResponse}
+ */
+proto.sqlrpc.v1.SetRateLimitResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.SetRateLimitResponse;
+  return proto.sqlrpc.v1.SetRateLimitResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.SetRateLimitResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.SetRateLimitResponse}
+ */
+proto.sqlrpc.v1.SetRateLimitResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.sqlrpc.v1.RateLimit;
+      reader.readMessage(value,proto.sqlrpc.v1.RateLimit.deserializeBinaryFromReader);
+      msg.setLimit(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.SetRateLimitResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.SetRateLimitResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.SetRateLimitResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.SetRateLimitResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getLimit();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.sqlrpc.v1.RateLimit.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional RateLimit limit = 1;
+ * @return {?proto.sqlrpc.v1.RateLimit}
+ */
+proto.sqlrpc.v1.SetRateLimitResponse.prototype.getLimit = function() {
+  return /** @type{?proto.sqlrpc.v1.RateLimit} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.RateLimit, 1));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.RateLimit|undefined} value
+ * @return {!proto.sqlrpc.v1.SetRateLimitResponse} returns this
+*/
+proto.sqlrpc.v1.SetRateLimitResponse.prototype.setLimit = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.SetRateLimitResponse} returns this
+ */
+proto.sqlrpc.v1.SetRateLimitResponse.prototype.clearLimit = function() {
+  return this.setLimit(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.SetRateLimitResponse.prototype.hasLimit = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.DeleteRateLimitRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.DeleteRateLimitRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.DeleteRateLimitRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DeleteRateLimitRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+limitId: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.DeleteRateLimitRequest}
+ */
+proto.sqlrpc.v1.DeleteRateLimitRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.DeleteRateLimitRequest;
+  return proto.sqlrpc.v1.DeleteRateLimitRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.DeleteRateLimitRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.DeleteRateLimitRequest}
+ */
+proto.sqlrpc.v1.DeleteRateLimitRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLimitId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.DeleteRateLimitRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.DeleteRateLimitRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.DeleteRateLimitRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DeleteRateLimitRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getLimitId();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 limit_id = 1;
+ * @return {number}
+ */
+proto.sqlrpc.v1.DeleteRateLimitRequest.prototype.getLimitId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.DeleteRateLimitRequest} returns this
+ */
+proto.sqlrpc.v1.DeleteRateLimitRequest.prototype.setLimitId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.DeleteRateLimitResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.DeleteRateLimitResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.DeleteRateLimitResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DeleteRateLimitResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.DeleteRateLimitResponse}
+ */
+proto.sqlrpc.v1.DeleteRateLimitResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.DeleteRateLimitResponse;
+  return proto.sqlrpc.v1.DeleteRateLimitResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.DeleteRateLimitResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.DeleteRateLimitResponse}
+ */
+proto.sqlrpc.v1.DeleteRateLimitResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.DeleteRateLimitResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.DeleteRateLimitResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.DeleteRateLimitResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DeleteRateLimitResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool success = 1;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.DeleteRateLimitResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.DeleteRateLimitResponse} returns this
+ */
+proto.sqlrpc.v1.DeleteRateLimitResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.ListRateLimitsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ListRateLimitsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.ListRateLimitsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListRateLimitsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+username: jspb.Message.getFieldWithDefault(msg, 1, ""),
+database: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.ListRateLimitsRequest}
+ */
+proto.sqlrpc.v1.ListRateLimitsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.ListRateLimitsRequest;
+  return proto.sqlrpc.v1.ListRateLimitsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.ListRateLimitsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.ListRateLimitsRequest}
+ */
+proto.sqlrpc.v1.ListRateLimitsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setUsername(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.ListRateLimitsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ListRateLimitsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ListRateLimitsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListRateLimitsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string username = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.ListRateLimitsRequest.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ListRateLimitsRequest} returns this
+ */
+proto.sqlrpc.v1.ListRateLimitsRequest.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string database = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.ListRateLimitsRequest.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ListRateLimitsRequest} returns this
+ */
+proto.sqlrpc.v1.ListRateLimitsRequest.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.ListRateLimitsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.ListRateLimitsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ListRateLimitsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.ListRateLimitsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListRateLimitsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+limitsList: jspb.Message.toObjectList(msg.getLimitsList(),
+    proto.sqlrpc.v1.RateLimit.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.ListRateLimitsResponse}
+ */
+proto.sqlrpc.v1.ListRateLimitsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.ListRateLimitsResponse;
+  return proto.sqlrpc.v1.ListRateLimitsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.ListRateLimitsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.ListRateLimitsResponse}
+ */
+proto.sqlrpc.v1.ListRateLimitsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.sqlrpc.v1.RateLimit;
+      reader.readMessage(value,proto.sqlrpc.v1.RateLimit.deserializeBinaryFromReader);
+      msg.addLimits(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.ListRateLimitsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ListRateLimitsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ListRateLimitsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListRateLimitsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getLimitsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.sqlrpc.v1.RateLimit.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated RateLimit limits = 1;
+ * @return {!Array<!proto.sqlrpc.v1.RateLimit>}
+ */
+proto.sqlrpc.v1.ListRateLimitsResponse.prototype.getLimitsList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.RateLimit>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.sqlrpc.v1.RateLimit, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.sqlrpc.v1.RateLimit>} value
+ * @return {!proto.sqlrpc.v1.ListRateLimitsResponse} returns this
+*/
+proto.sqlrpc.v1.ListRateLimitsResponse.prototype.setLimitsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.RateLimit=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.RateLimit}
+ */
+proto.sqlrpc.v1.ListRateLimitsResponse.prototype.addLimits = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.sqlrpc.v1.RateLimit, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.ListRateLimitsResponse} returns this
+ */
+proto.sqlrpc.v1.ListRateLimitsResponse.prototype.clearLimitsList = function() {
+  return this.setLimitsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.RowPolicy.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.RowPolicy.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.RowPolicy} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.RowPolicy.toObject = function(includeInstance, msg) {
+  var f, obj = {
+id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+database: jspb.Message.getFieldWithDefault(msg, 2, ""),
+table: jspb.Message.getFieldWithDefault(msg, 3, ""),
+name: jspb.Message.getFieldWithDefault(msg, 4, ""),
+using: jspb.Message.getFieldWithDefault(msg, 5, ""),
+createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.RowPolicy}
+ */
+proto.sqlrpc.v1.RowPolicy.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.RowPolicy;
+  return proto.sqlrpc.v1.RowPolicy.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.RowPolicy} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.RowPolicy}
+ */
+proto.sqlrpc.v1.RowPolicy.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setTable(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setName(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setUsing(value);
+      break;
+    case 6:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreatedAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.RowPolicy.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.RowPolicy.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.RowPolicy} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.RowPolicy.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getTable();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getUsing();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getCreatedAt();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional int64 id = 1;
+ * @return {number}
+ */
+proto.sqlrpc.v1.RowPolicy.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.RowPolicy} returns this
+ */
+proto.sqlrpc.v1.RowPolicy.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string database = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.RowPolicy.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.RowPolicy} returns this
+ */
+proto.sqlrpc.v1.RowPolicy.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string table = 3;
+ * @return {string}
+ */
+proto.sqlrpc.v1.RowPolicy.prototype.getTable = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.RowPolicy} returns this
+ */
+proto.sqlrpc.v1.RowPolicy.prototype.setTable = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string name = 4;
+ * @return {string}
+ */
+proto.sqlrpc.v1.RowPolicy.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.RowPolicy} returns this
+ */
+proto.sqlrpc.v1.RowPolicy.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string using = 5;
+ * @return {string}
+ */
+proto.sqlrpc.v1.RowPolicy.prototype.getUsing = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.RowPolicy} returns this
+ */
+proto.sqlrpc.v1.RowPolicy.prototype.setUsing = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp created_at = 6;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.sqlrpc.v1.RowPolicy.prototype.getCreatedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 6));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.sqlrpc.v1.RowPolicy} returns this
+*/
+proto.sqlrpc.v1.RowPolicy.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.RowPolicy} returns this
+ */
+proto.sqlrpc.v1.RowPolicy.prototype.clearCreatedAt = function() {
+  return this.setCreatedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.RowPolicy.prototype.hasCreatedAt = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.CreateRowPolicyRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.CreateRowPolicyRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.CreateRowPolicyRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.CreateRowPolicyRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+database: jspb.Message.getFieldWithDefault(msg, 1, ""),
+table: jspb.Message.getFieldWithDefault(msg, 2, ""),
+name: jspb.Message.getFieldWithDefault(msg, 3, ""),
+using: jspb.Message.getFieldWithDefault(msg, 4, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.CreateRowPolicyRequest}
+ */
+proto.sqlrpc.v1.CreateRowPolicyRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.CreateRowPolicyRequest;
+  return proto.sqlrpc.v1.CreateRowPolicyRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.CreateRowPolicyRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.CreateRowPolicyRequest}
+ */
+proto.sqlrpc.v1.CreateRowPolicyRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setTable(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setName(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setUsing(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.CreateRowPolicyRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.CreateRowPolicyRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.CreateRowPolicyRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.CreateRowPolicyRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getTable();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getUsing();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string database = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.CreateRowPolicyRequest.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.CreateRowPolicyRequest} returns this
+ */
+proto.sqlrpc.v1.CreateRowPolicyRequest.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string table = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.CreateRowPolicyRequest.prototype.getTable = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.CreateRowPolicyRequest} returns this
+ */
+proto.sqlrpc.v1.CreateRowPolicyRequest.prototype.setTable = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string name = 3;
+ * @return {string}
+ */
+proto.sqlrpc.v1.CreateRowPolicyRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.CreateRowPolicyRequest} returns this
+ */
+proto.sqlrpc.v1.CreateRowPolicyRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string using = 4;
+ * @return {string}
+ */
+proto.sqlrpc.v1.CreateRowPolicyRequest.prototype.getUsing = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.CreateRowPolicyRequest} returns this
+ */
+proto.sqlrpc.v1.CreateRowPolicyRequest.prototype.setUsing = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.CreateRowPolicyResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.CreateRowPolicyResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.CreateRowPolicyResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.CreateRowPolicyResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+policy: (f = msg.getPolicy()) && proto.sqlrpc.v1.RowPolicy.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.CreateRowPolicyResponse}
+ */
+proto.sqlrpc.v1.CreateRowPolicyResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.CreateRowPolicyResponse;
+  return proto.sqlrpc.v1.CreateRowPolicyResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.CreateRowPolicyResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.CreateRowPolicyResponse}
+ */
+proto.sqlrpc.v1.CreateRowPolicyResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.sqlrpc.v1.RowPolicy;
+      reader.readMessage(value,proto.sqlrpc.v1.RowPolicy.deserializeBinaryFromReader);
+      msg.setPolicy(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.CreateRowPolicyResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.CreateRowPolicyResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.CreateRowPolicyResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.CreateRowPolicyResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPolicy();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.sqlrpc.v1.RowPolicy.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional RowPolicy policy = 1;
+ * @return {?proto.sqlrpc.v1.RowPolicy}
+ */
+proto.sqlrpc.v1.CreateRowPolicyResponse.prototype.getPolicy = function() {
+  return /** @type{?proto.sqlrpc.v1.RowPolicy} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.RowPolicy, 1));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.RowPolicy|undefined} value
+ * @return {!proto.sqlrpc.v1.CreateRowPolicyResponse} returns this
+*/
+proto.sqlrpc.v1.CreateRowPolicyResponse.prototype.setPolicy = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.CreateRowPolicyResponse} returns this
+ */
+proto.sqlrpc.v1.CreateRowPolicyResponse.prototype.clearPolicy = function() {
+  return this.setPolicy(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.CreateRowPolicyResponse.prototype.hasPolicy = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.DeleteRowPolicyRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.DeleteRowPolicyRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.DeleteRowPolicyRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DeleteRowPolicyRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+policyId: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.DeleteRowPolicyRequest}
+ */
+proto.sqlrpc.v1.DeleteRowPolicyRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.DeleteRowPolicyRequest;
+  return proto.sqlrpc.v1.DeleteRowPolicyRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.DeleteRowPolicyRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.DeleteRowPolicyRequest}
+ */
+proto.sqlrpc.v1.DeleteRowPolicyRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setPolicyId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.DeleteRowPolicyRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.DeleteRowPolicyRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.DeleteRowPolicyRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DeleteRowPolicyRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPolicyId();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 policy_id = 1;
+ * @return {number}
+ */
+proto.sqlrpc.v1.DeleteRowPolicyRequest.prototype.getPolicyId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.DeleteRowPolicyRequest} returns this
+ */
+proto.sqlrpc.v1.DeleteRowPolicyRequest.prototype.setPolicyId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.DeleteRowPolicyResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.DeleteRowPolicyResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.DeleteRowPolicyResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DeleteRowPolicyResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.DeleteRowPolicyResponse}
+ */
+proto.sqlrpc.v1.DeleteRowPolicyResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.DeleteRowPolicyResponse;
+  return proto.sqlrpc.v1.DeleteRowPolicyResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.DeleteRowPolicyResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.DeleteRowPolicyResponse}
+ */
+proto.sqlrpc.v1.DeleteRowPolicyResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.DeleteRowPolicyResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.DeleteRowPolicyResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.DeleteRowPolicyResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DeleteRowPolicyResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool success = 1;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.DeleteRowPolicyResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.DeleteRowPolicyResponse} returns this
+ */
+proto.sqlrpc.v1.DeleteRowPolicyResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.ListRowPoliciesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ListRowPoliciesRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.ListRowPoliciesRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListRowPoliciesRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+database: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.ListRowPoliciesRequest}
+ */
+proto.sqlrpc.v1.ListRowPoliciesRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.ListRowPoliciesRequest;
+  return proto.sqlrpc.v1.ListRowPoliciesRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.ListRowPoliciesRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.ListRowPoliciesRequest}
+ */
+proto.sqlrpc.v1.ListRowPoliciesRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.ListRowPoliciesRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ListRowPoliciesRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ListRowPoliciesRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListRowPoliciesRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string database = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.ListRowPoliciesRequest.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ListRowPoliciesRequest} returns this
+ */
+proto.sqlrpc.v1.ListRowPoliciesRequest.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.ListRowPoliciesResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.ListRowPoliciesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ListRowPoliciesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.ListRowPoliciesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListRowPoliciesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+policiesList: jspb.Message.toObjectList(msg.getPoliciesList(),
+    proto.sqlrpc.v1.RowPolicy.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.ListRowPoliciesResponse}
+ */
+proto.sqlrpc.v1.ListRowPoliciesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.ListRowPoliciesResponse;
+  return proto.sqlrpc.v1.ListRowPoliciesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.ListRowPoliciesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.ListRowPoliciesResponse}
+ */
+proto.sqlrpc.v1.ListRowPoliciesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.sqlrpc.v1.RowPolicy;
+      reader.readMessage(value,proto.sqlrpc.v1.RowPolicy.deserializeBinaryFromReader);
+      msg.addPolicies(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.ListRowPoliciesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ListRowPoliciesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ListRowPoliciesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListRowPoliciesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPoliciesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.sqlrpc.v1.RowPolicy.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated RowPolicy policies = 1;
+ * @return {!Array<!proto.sqlrpc.v1.RowPolicy>}
+ */
+proto.sqlrpc.v1.ListRowPoliciesResponse.prototype.getPoliciesList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.RowPolicy>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.sqlrpc.v1.RowPolicy, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.sqlrpc.v1.RowPolicy>} value
+ * @return {!proto.sqlrpc.v1.ListRowPoliciesResponse} returns this
+*/
+proto.sqlrpc.v1.ListRowPoliciesResponse.prototype.setPoliciesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.RowPolicy=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.RowPolicy}
+ */
+proto.sqlrpc.v1.ListRowPoliciesResponse.prototype.addPolicies = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.sqlrpc.v1.RowPolicy, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.ListRowPoliciesResponse} returns this
+ */
+proto.sqlrpc.v1.ListRowPoliciesResponse.prototype.clearPoliciesList = function() {
+  return this.setPoliciesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.ColumnPolicy.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ColumnPolicy.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.ColumnPolicy} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ColumnPolicy.toObject = function(includeInstance, msg) {
+  var f, obj = {
+id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+database: jspb.Message.getFieldWithDefault(msg, 2, ""),
+table: jspb.Message.getFieldWithDefault(msg, 3, ""),
+column: jspb.Message.getFieldWithDefault(msg, 4, ""),
+action: jspb.Message.getFieldWithDefault(msg, 5, 0),
+role: jspb.Message.getFieldWithDefault(msg, 6, 0),
+username: jspb.Message.getFieldWithDefault(msg, 7, ""),
+createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.ColumnPolicy}
+ */
+proto.sqlrpc.v1.ColumnPolicy.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.ColumnPolicy;
+  return proto.sqlrpc.v1.ColumnPolicy.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.ColumnPolicy} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.ColumnPolicy}
+ */
+proto.sqlrpc.v1.ColumnPolicy.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setTable(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setColumn(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.sqlrpc.v1.ColumnAction} */ (reader.readEnum());
+      msg.setAction(value);
+      break;
+    case 6:
+      var value = /** @type {!proto.sqlrpc.v1.Role} */ (reader.readEnum());
+      msg.setRole(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setUsername(value);
+      break;
+    case 8:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreatedAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.ColumnPolicy.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ColumnPolicy.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ColumnPolicy} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ColumnPolicy.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getTable();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getColumn();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getAction();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+  f = message.getRole();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      6,
+      f
+    );
+  }
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getCreatedAt();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional int64 id = 1;
+ * @return {number}
+ */
+proto.sqlrpc.v1.ColumnPolicy.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.ColumnPolicy} returns this
+ */
+proto.sqlrpc.v1.ColumnPolicy.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string database = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.ColumnPolicy.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ColumnPolicy} returns this
+ */
+proto.sqlrpc.v1.ColumnPolicy.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string table = 3;
+ * @return {string}
+ */
+proto.sqlrpc.v1.ColumnPolicy.prototype.getTable = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ColumnPolicy} returns this
+ */
+proto.sqlrpc.v1.ColumnPolicy.prototype.setTable = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string column = 4;
+ * @return {string}
+ */
+proto.sqlrpc.v1.ColumnPolicy.prototype.getColumn = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ColumnPolicy} returns this
+ */
+proto.sqlrpc.v1.ColumnPolicy.prototype.setColumn = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional ColumnAction action = 5;
+ * @return {!proto.sqlrpc.v1.ColumnAction}
+ */
+proto.sqlrpc.v1.ColumnPolicy.prototype.getAction = function() {
+  return /** @type {!proto.sqlrpc.v1.ColumnAction} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.ColumnAction} value
+ * @return {!proto.sqlrpc.v1.ColumnPolicy} returns this
+ */
+proto.sqlrpc.v1.ColumnPolicy.prototype.setAction = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
+};
+
+
+/**
+ * optional Role role = 6;
+ * @return {!proto.sqlrpc.v1.Role}
+ */
+proto.sqlrpc.v1.ColumnPolicy.prototype.getRole = function() {
+  return /** @type {!proto.sqlrpc.v1.Role} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.Role} value
+ * @return {!proto.sqlrpc.v1.ColumnPolicy} returns this
+ */
+proto.sqlrpc.v1.ColumnPolicy.prototype.setRole = function(value) {
+  return jspb.Message.setProto3EnumField(this, 6, value);
+};
+
+
+/**
+ * optional string username = 7;
+ * @return {string}
+ */
+proto.sqlrpc.v1.ColumnPolicy.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ColumnPolicy} returns this
+ */
+proto.sqlrpc.v1.ColumnPolicy.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp created_at = 8;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.sqlrpc.v1.ColumnPolicy.prototype.getCreatedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 8));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.sqlrpc.v1.ColumnPolicy} returns this
+*/
+proto.sqlrpc.v1.ColumnPolicy.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 8, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.ColumnPolicy} returns this
+ */
+proto.sqlrpc.v1.ColumnPolicy.prototype.clearCreatedAt = function() {
+  return this.setCreatedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.ColumnPolicy.prototype.hasCreatedAt = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.oneofGroups_ = [[5,6]];
+
+/**
+ * @enum {number}
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.SubjectCase = {
+  SUBJECT_NOT_SET: 0,
+  ROLE: 5,
+  USERNAME: 6
+};
+
+/**
+ * @return {proto.sqlrpc.v1.SetColumnPolicyRequest.SubjectCase}
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.prototype.getSubjectCase = function() {
+  return /** @type {proto.sqlrpc.v1.SetColumnPolicyRequest.SubjectCase} */(jspb.Message.computeOneofCase(this, proto.sqlrpc.v1.SetColumnPolicyRequest.oneofGroups_[0]));
+};
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.SetColumnPolicyRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.SetColumnPolicyRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+database: jspb.Message.getFieldWithDefault(msg, 1, ""),
+table: jspb.Message.getFieldWithDefault(msg, 2, ""),
+column: jspb.Message.getFieldWithDefault(msg, 3, ""),
+action: jspb.Message.getFieldWithDefault(msg, 4, 0),
+role: (f = jspb.Message.getField(msg, 5)) == null ? undefined : f,
+username: (f = jspb.Message.getField(msg, 6)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.SetColumnPolicyRequest}
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.SetColumnPolicyRequest;
+  return proto.sqlrpc.v1.SetColumnPolicyRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.SetColumnPolicyRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.SetColumnPolicyRequest}
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setTable(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setColumn(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.sqlrpc.v1.ColumnAction} */ (reader.readEnum());
+      msg.setAction(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.sqlrpc.v1.Role} */ (reader.readEnum());
+      msg.setRole(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setUsername(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.SetColumnPolicyRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.SetColumnPolicyRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getTable();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getColumn();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getAction();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
+  f = /** @type {!proto.sqlrpc.v1.Role} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string database = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.SetColumnPolicyRequest} returns this
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string table = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.prototype.getTable = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.SetColumnPolicyRequest} returns this
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.prototype.setTable = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string column = 3;
+ * @return {string}
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.prototype.getColumn = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.SetColumnPolicyRequest} returns this
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.prototype.setColumn = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional ColumnAction action = 4;
+ * @return {!proto.sqlrpc.v1.ColumnAction}
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.prototype.getAction = function() {
+  return /** @type {!proto.sqlrpc.v1.ColumnAction} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.ColumnAction} value
+ * @return {!proto.sqlrpc.v1.SetColumnPolicyRequest} returns this
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.prototype.setAction = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
+};
+
+
+/**
+ * optional Role role = 5;
+ * @return {!proto.sqlrpc.v1.Role}
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.prototype.getRole = function() {
+  return /** @type {!proto.sqlrpc.v1.Role} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.Role} value
+ * @return {!proto.sqlrpc.v1.SetColumnPolicyRequest} returns this
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.prototype.setRole = function(value) {
+  return jspb.Message.setOneofField(this, 5, proto.sqlrpc.v1.SetColumnPolicyRequest.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.sqlrpc.v1.SetColumnPolicyRequest} returns this
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.prototype.clearRole = function() {
+  return jspb.Message.setOneofField(this, 5, proto.sqlrpc.v1.SetColumnPolicyRequest.oneofGroups_[0], undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.prototype.hasRole = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional string username = 6;
+ * @return {string}
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.SetColumnPolicyRequest} returns this
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.prototype.setUsername = function(value) {
+  return jspb.Message.setOneofField(this, 6, proto.sqlrpc.v1.SetColumnPolicyRequest.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.sqlrpc.v1.SetColumnPolicyRequest} returns this
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.prototype.clearUsername = function() {
+  return jspb.Message.setOneofField(this, 6, proto.sqlrpc.v1.SetColumnPolicyRequest.oneofGroups_[0], undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.SetColumnPolicyRequest.prototype.hasUsername = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.SetColumnPolicyResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.SetColumnPolicyResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.SetColumnPolicyResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.SetColumnPolicyResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+policy: (f = msg.getPolicy()) && proto.sqlrpc.v1.ColumnPolicy.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.SetColumnPolicyResponse}
+ */
+proto.sqlrpc.v1.SetColumnPolicyResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.SetColumnPolicyResponse;
+  return proto.sqlrpc.v1.SetColumnPolicyResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.SetColumnPolicyResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.SetColumnPolicyResponse}
+ */
+proto.sqlrpc.v1.SetColumnPolicyResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.sqlrpc.v1.ColumnPolicy;
+      reader.readMessage(value,proto.sqlrpc.v1.ColumnPolicy.deserializeBinaryFromReader);
+      msg.setPolicy(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.SetColumnPolicyResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.SetColumnPolicyResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.SetColumnPolicyResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.SetColumnPolicyResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPolicy();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.sqlrpc.v1.ColumnPolicy.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional ColumnPolicy policy = 1;
+ * @return {?proto.sqlrpc.v1.ColumnPolicy}
+ */
+proto.sqlrpc.v1.SetColumnPolicyResponse.prototype.getPolicy = function() {
+  return /** @type{?proto.sqlrpc.v1.ColumnPolicy} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.ColumnPolicy, 1));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.ColumnPolicy|undefined} value
+ * @return {!proto.sqlrpc.v1.SetColumnPolicyResponse} returns this
+*/
+proto.sqlrpc.v1.SetColumnPolicyResponse.prototype.setPolicy = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.SetColumnPolicyResponse} returns this
+ */
+proto.sqlrpc.v1.SetColumnPolicyResponse.prototype.clearPolicy = function() {
+  return this.setPolicy(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.SetColumnPolicyResponse.prototype.hasPolicy = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.DeleteColumnPolicyRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.DeleteColumnPolicyRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.DeleteColumnPolicyRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DeleteColumnPolicyRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+policyId: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.DeleteColumnPolicyRequest}
+ */
+proto.sqlrpc.v1.DeleteColumnPolicyRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.DeleteColumnPolicyRequest;
+  return proto.sqlrpc.v1.DeleteColumnPolicyRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.DeleteColumnPolicyRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.DeleteColumnPolicyRequest}
+ */
+proto.sqlrpc.v1.DeleteColumnPolicyRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setPolicyId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.DeleteColumnPolicyRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.DeleteColumnPolicyRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.DeleteColumnPolicyRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DeleteColumnPolicyRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPolicyId();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 policy_id = 1;
+ * @return {number}
+ */
+proto.sqlrpc.v1.DeleteColumnPolicyRequest.prototype.getPolicyId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.DeleteColumnPolicyRequest} returns this
+ */
+proto.sqlrpc.v1.DeleteColumnPolicyRequest.prototype.setPolicyId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.DeleteColumnPolicyResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.DeleteColumnPolicyResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.DeleteColumnPolicyResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DeleteColumnPolicyResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.DeleteColumnPolicyResponse}
+ */
+proto.sqlrpc.v1.DeleteColumnPolicyResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.DeleteColumnPolicyResponse;
+  return proto.sqlrpc.v1.DeleteColumnPolicyResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.DeleteColumnPolicyResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.DeleteColumnPolicyResponse}
+ */
+proto.sqlrpc.v1.DeleteColumnPolicyResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.DeleteColumnPolicyResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.DeleteColumnPolicyResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.DeleteColumnPolicyResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DeleteColumnPolicyResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool success = 1;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.DeleteColumnPolicyResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.DeleteColumnPolicyResponse} returns this
+ */
+proto.sqlrpc.v1.DeleteColumnPolicyResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.ListColumnPoliciesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ListColumnPoliciesRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.ListColumnPoliciesRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListColumnPoliciesRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+database: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.ListColumnPoliciesRequest}
+ */
+proto.sqlrpc.v1.ListColumnPoliciesRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.ListColumnPoliciesRequest;
+  return proto.sqlrpc.v1.ListColumnPoliciesRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.ListColumnPoliciesRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.ListColumnPoliciesRequest}
+ */
+proto.sqlrpc.v1.ListColumnPoliciesRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.ListColumnPoliciesRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ListColumnPoliciesRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ListColumnPoliciesRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListColumnPoliciesRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string database = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.ListColumnPoliciesRequest.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ListColumnPoliciesRequest} returns this
+ */
+proto.sqlrpc.v1.ListColumnPoliciesRequest.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.ListColumnPoliciesResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.ListColumnPoliciesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ListColumnPoliciesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.ListColumnPoliciesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListColumnPoliciesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+policiesList: jspb.Message.toObjectList(msg.getPoliciesList(),
+    proto.sqlrpc.v1.ColumnPolicy.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.ListColumnPoliciesResponse}
+ */
+proto.sqlrpc.v1.ListColumnPoliciesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.ListColumnPoliciesResponse;
+  return proto.sqlrpc.v1.ListColumnPoliciesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.ListColumnPoliciesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.ListColumnPoliciesResponse}
+ */
+proto.sqlrpc.v1.ListColumnPoliciesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.sqlrpc.v1.ColumnPolicy;
+      reader.readMessage(value,proto.sqlrpc.v1.ColumnPolicy.deserializeBinaryFromReader);
+      msg.addPolicies(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.ListColumnPoliciesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ListColumnPoliciesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ListColumnPoliciesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListColumnPoliciesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPoliciesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.sqlrpc.v1.ColumnPolicy.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated ColumnPolicy policies = 1;
+ * @return {!Array<!proto.sqlrpc.v1.ColumnPolicy>}
+ */
+proto.sqlrpc.v1.ListColumnPoliciesResponse.prototype.getPoliciesList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.ColumnPolicy>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.sqlrpc.v1.ColumnPolicy, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.sqlrpc.v1.ColumnPolicy>} value
+ * @return {!proto.sqlrpc.v1.ListColumnPoliciesResponse} returns this
+*/
+proto.sqlrpc.v1.ListColumnPoliciesResponse.prototype.setPoliciesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.ColumnPolicy=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.ColumnPolicy}
+ */
+proto.sqlrpc.v1.ListColumnPoliciesResponse.prototype.addPolicies = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.sqlrpc.v1.ColumnPolicy, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.ListColumnPoliciesResponse} returns this
+ */
+proto.sqlrpc.v1.ListColumnPoliciesResponse.prototype.clearPoliciesList = function() {
+  return this.setPoliciesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.ListDatabasesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ListDatabasesRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.ListDatabasesRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListDatabasesRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.ListDatabasesRequest}
+ */
+proto.sqlrpc.v1.ListDatabasesRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.ListDatabasesRequest;
+  return proto.sqlrpc.v1.ListDatabasesRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.ListDatabasesRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.ListDatabasesRequest}
+ */
+proto.sqlrpc.v1.ListDatabasesRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.ListDatabasesRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ListDatabasesRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ListDatabasesRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListDatabasesRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.ListDatabasesResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.ListDatabasesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ListDatabasesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.ListDatabasesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListDatabasesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+databasesList: jspb.Message.toObjectList(msg.getDatabasesList(),
+    sqlrpc_v1_types_pb.DatabaseInfo.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.ListDatabasesResponse}
+ */
+proto.sqlrpc.v1.ListDatabasesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.ListDatabasesResponse;
+  return proto.sqlrpc.v1.ListDatabasesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.ListDatabasesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.ListDatabasesResponse}
+ */
+proto.sqlrpc.v1.ListDatabasesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new sqlrpc_v1_types_pb.DatabaseInfo;
+      reader.readMessage(value,sqlrpc_v1_types_pb.DatabaseInfo.deserializeBinaryFromReader);
+      msg.addDatabases(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.ListDatabasesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ListDatabasesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ListDatabasesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ListDatabasesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDatabasesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      sqlrpc_v1_types_pb.DatabaseInfo.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated DatabaseInfo databases = 1;
+ * @return {!Array<!proto.sqlrpc.v1.DatabaseInfo>}
+ */
+proto.sqlrpc.v1.ListDatabasesResponse.prototype.getDatabasesList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.DatabaseInfo>} */ (
+    jspb.Message.getRepeatedWrapperField(this, sqlrpc_v1_types_pb.DatabaseInfo, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.sqlrpc.v1.DatabaseInfo>} value
+ * @return {!proto.sqlrpc.v1.ListDatabasesResponse} returns this
+*/
+proto.sqlrpc.v1.ListDatabasesResponse.prototype.setDatabasesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.DatabaseInfo=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.DatabaseInfo}
+ */
+proto.sqlrpc.v1.ListDatabasesResponse.prototype.addDatabases = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.sqlrpc.v1.DatabaseInfo, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.ListDatabasesResponse} returns this
+ */
+proto.sqlrpc.v1.ListDatabasesResponse.prototype.clearDatabasesList = function() {
+  return this.setDatabasesList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.repeatedFields_ = [4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.CreateDatabaseRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.CreateDatabaseRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+isEncrypted: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+key: jspb.Message.getFieldWithDefault(msg, 3, ""),
+extensionsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+pragmasMap: (f = msg.getPragmasMap()) ? f.toObject(includeInstance, undefined) : [],
+maxOpenConns: jspb.Message.getFieldWithDefault(msg, 6, 0),
+maxIdleConns: jspb.Message.getFieldWithDefault(msg, 7, 0),
+connMaxLifetimeMs: jspb.Message.getFieldWithDefault(msg, 8, 0),
+maxExecutionTimeMs: jspb.Message.getFieldWithDefault(msg, 9, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.CreateDatabaseRequest}
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.CreateDatabaseRequest;
+  return proto.sqlrpc.v1.CreateDatabaseRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.CreateDatabaseRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.CreateDatabaseRequest}
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsEncrypted(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setKey(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.addExtensions(value);
+      break;
+    case 5:
+      var value = msg.getPragmasMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readStringRequireUtf8, jspb.BinaryReader.prototype.readStringRequireUtf8, null, "", "");
+         });
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxOpenConns(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxIdleConns(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setConnMaxLifetimeMs(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxExecutionTimeMs(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.CreateDatabaseRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.CreateDatabaseRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getIsEncrypted();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getKey();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getExtensionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      4,
+      f
+    );
+  }
+  f = message.getPragmasMap(true);
+  if (f && f.getLength() > 0) {
+jspb.internal.public_for_gencode.serializeMapToBinary(
+    message.getPragmasMap(true),
+    5,
+    writer,
+    jspb.BinaryWriter.prototype.writeString,
+    jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getMaxOpenConns();
+  if (f !== 0) {
+    writer.writeInt32(
+      6,
+      f
+    );
+  }
+  f = message.getMaxIdleConns();
+  if (f !== 0) {
+    writer.writeInt32(
+      7,
+      f
+    );
+  }
+  f = message.getConnMaxLifetimeMs();
+  if (f !== 0) {
+    writer.writeInt32(
+      8,
+      f
+    );
+  }
+  f = message.getMaxExecutionTimeMs();
+  if (f !== 0) {
+    writer.writeInt32(
+      9,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.CreateDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bool is_encrypted = 2;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.prototype.getIsEncrypted = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.CreateDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.prototype.setIsEncrypted = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional string key = 3;
+ * @return {string}
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.prototype.getKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.CreateDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.prototype.setKey = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * repeated string extensions = 4;
+ * @return {!Array<string>}
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.prototype.getExtensionsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.sqlrpc.v1.CreateDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.prototype.setExtensionsList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.CreateDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.prototype.addExtensions = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.CreateDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.prototype.clearExtensionsList = function() {
+  return this.setExtensionsList([]);
+};
+
+
+/**
+ * map<string, string> pragmas = 5;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.prototype.getPragmasMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 5, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.sqlrpc.v1.CreateDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.prototype.clearPragmasMap = function() {
+  this.getPragmasMap().clear();
+  return this;
+};
+
+
+/**
+ * optional int32 max_open_conns = 6;
+ * @return {number}
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.prototype.getMaxOpenConns = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.CreateDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.prototype.setMaxOpenConns = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional int32 max_idle_conns = 7;
+ * @return {number}
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.prototype.getMaxIdleConns = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.CreateDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.prototype.setMaxIdleConns = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional int32 conn_max_lifetime_ms = 8;
+ * @return {number}
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.prototype.getConnMaxLifetimeMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.CreateDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.prototype.setConnMaxLifetimeMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+/**
+ * optional int32 max_execution_time_ms = 9;
+ * @return {number}
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.prototype.getMaxExecutionTimeMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.CreateDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.CreateDatabaseRequest.prototype.setMaxExecutionTimeMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.CreateDatabaseResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.CreateDatabaseResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.CreateDatabaseResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.CreateDatabaseResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+message: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.CreateDatabaseResponse}
+ */
+proto.sqlrpc.v1.CreateDatabaseResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.CreateDatabaseResponse;
+  return proto.sqlrpc.v1.CreateDatabaseResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.CreateDatabaseResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.CreateDatabaseResponse}
+ */
+proto.sqlrpc.v1.CreateDatabaseResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.CreateDatabaseResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.CreateDatabaseResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.CreateDatabaseResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.CreateDatabaseResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool success = 1;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.CreateDatabaseResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.CreateDatabaseResponse} returns this
+ */
+proto.sqlrpc.v1.CreateDatabaseResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+/**
+ * optional string message = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.CreateDatabaseResponse.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.CreateDatabaseResponse} returns this
+ */
+proto.sqlrpc.v1.CreateDatabaseResponse.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.UpdateDatabaseRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.UpdateDatabaseRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.UpdateDatabaseRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.UpdateDatabaseRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+config: (f = msg.getConfig()) && sqlrpc_v1_types_pb.UpdateDatabaseConfig.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.UpdateDatabaseRequest}
+ */
+proto.sqlrpc.v1.UpdateDatabaseRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.UpdateDatabaseRequest;
+  return proto.sqlrpc.v1.UpdateDatabaseRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.UpdateDatabaseRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.UpdateDatabaseRequest}
+ */
+proto.sqlrpc.v1.UpdateDatabaseRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = new sqlrpc_v1_types_pb.UpdateDatabaseConfig;
+      reader.readMessage(value,sqlrpc_v1_types_pb.UpdateDatabaseConfig.deserializeBinaryFromReader);
+      msg.setConfig(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.UpdateDatabaseRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.UpdateDatabaseRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.UpdateDatabaseRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.UpdateDatabaseRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getConfig();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      sqlrpc_v1_types_pb.UpdateDatabaseConfig.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.UpdateDatabaseRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.UpdateDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.UpdateDatabaseRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional UpdateDatabaseConfig config = 2;
+ * @return {?proto.sqlrpc.v1.UpdateDatabaseConfig}
+ */
+proto.sqlrpc.v1.UpdateDatabaseRequest.prototype.getConfig = function() {
+  return /** @type{?proto.sqlrpc.v1.UpdateDatabaseConfig} */ (
+    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.UpdateDatabaseConfig, 2));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.UpdateDatabaseConfig|undefined} value
+ * @return {!proto.sqlrpc.v1.UpdateDatabaseRequest} returns this
+*/
+proto.sqlrpc.v1.UpdateDatabaseRequest.prototype.setConfig = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.UpdateDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.UpdateDatabaseRequest.prototype.clearConfig = function() {
+  return this.setConfig(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.UpdateDatabaseRequest.prototype.hasConfig = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.UpdateDatabaseResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.UpdateDatabaseResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.UpdateDatabaseResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.UpdateDatabaseResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+message: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.UpdateDatabaseResponse}
+ */
+proto.sqlrpc.v1.UpdateDatabaseResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.UpdateDatabaseResponse;
+  return proto.sqlrpc.v1.UpdateDatabaseResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.UpdateDatabaseResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.UpdateDatabaseResponse}
+ */
+proto.sqlrpc.v1.UpdateDatabaseResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.UpdateDatabaseResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.UpdateDatabaseResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.UpdateDatabaseResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.UpdateDatabaseResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool success = 1;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.UpdateDatabaseResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.UpdateDatabaseResponse} returns this
+ */
+proto.sqlrpc.v1.UpdateDatabaseResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+/**
+ * optional string message = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.UpdateDatabaseResponse.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.UpdateDatabaseResponse} returns this
+ */
+proto.sqlrpc.v1.UpdateDatabaseResponse.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.DeleteDatabaseRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.DeleteDatabaseRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.DeleteDatabaseRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DeleteDatabaseRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+name: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.DeleteDatabaseRequest}
+ */
+proto.sqlrpc.v1.DeleteDatabaseRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.DeleteDatabaseRequest;
+  return proto.sqlrpc.v1.DeleteDatabaseRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.DeleteDatabaseRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.DeleteDatabaseRequest}
+ */
+proto.sqlrpc.v1.DeleteDatabaseRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setName(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.DeleteDatabaseRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.DeleteDatabaseRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.DeleteDatabaseRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DeleteDatabaseRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.DeleteDatabaseRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.DeleteDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.DeleteDatabaseRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.DeleteDatabaseResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.DeleteDatabaseResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.DeleteDatabaseResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DeleteDatabaseResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+message: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.DeleteDatabaseResponse}
+ */
+proto.sqlrpc.v1.DeleteDatabaseResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.DeleteDatabaseResponse;
+  return proto.sqlrpc.v1.DeleteDatabaseResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.DeleteDatabaseResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.DeleteDatabaseResponse}
+ */
+proto.sqlrpc.v1.DeleteDatabaseResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.DeleteDatabaseResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.DeleteDatabaseResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.DeleteDatabaseResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DeleteDatabaseResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool success = 1;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.DeleteDatabaseResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.DeleteDatabaseResponse} returns this
+ */
+proto.sqlrpc.v1.DeleteDatabaseResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+/**
+ * optional string message = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.DeleteDatabaseResponse.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.DeleteDatabaseResponse} returns this
+ */
+proto.sqlrpc.v1.DeleteDatabaseResponse.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.repeatedFields_ = [6];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.MountDatabaseRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.MountDatabaseRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+path: jspb.Message.getFieldWithDefault(msg, 2, ""),
+readOnly: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+isEncrypted: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+key: jspb.Message.getFieldWithDefault(msg, 5, ""),
+extensionsList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
+pragmasMap: (f = msg.getPragmasMap()) ? f.toObject(includeInstance, undefined) : [],
+maxOpenConns: jspb.Message.getFieldWithDefault(msg, 8, 0),
+maxIdleConns: jspb.Message.getFieldWithDefault(msg, 9, 0),
+connMaxLifetimeMs: jspb.Message.getFieldWithDefault(msg, 10, 0),
+maxExecutionTimeMs: jspb.Message.getFieldWithDefault(msg, 11, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.MountDatabaseRequest}
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.MountDatabaseRequest;
+  return proto.sqlrpc.v1.MountDatabaseRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.MountDatabaseRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.MountDatabaseRequest}
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setPath(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReadOnly(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsEncrypted(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setKey(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.addExtensions(value);
+      break;
+    case 7:
+      var value = msg.getPragmasMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readStringRequireUtf8, jspb.BinaryReader.prototype.readStringRequireUtf8, null, "", "");
+         });
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxOpenConns(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxIdleConns(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setConnMaxLifetimeMs(value);
+      break;
+    case 11:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxExecutionTimeMs(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.MountDatabaseRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.MountDatabaseRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPath();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getReadOnly();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getIsEncrypted();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getKey();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getExtensionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      6,
+      f
+    );
+  }
+  f = message.getPragmasMap(true);
+  if (f && f.getLength() > 0) {
+jspb.internal.public_for_gencode.serializeMapToBinary(
+    message.getPragmasMap(true),
+    7,
+    writer,
+    jspb.BinaryWriter.prototype.writeString,
+    jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getMaxOpenConns();
+  if (f !== 0) {
+    writer.writeInt32(
+      8,
+      f
+    );
+  }
+  f = message.getMaxIdleConns();
+  if (f !== 0) {
+    writer.writeInt32(
+      9,
+      f
+    );
+  }
+  f = message.getConnMaxLifetimeMs();
+  if (f !== 0) {
+    writer.writeInt32(
+      10,
+      f
+    );
+  }
+  f = message.getMaxExecutionTimeMs();
+  if (f !== 0) {
+    writer.writeInt32(
+      11,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string path = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.getPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.setPath = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional bool read_only = 3;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.getReadOnly = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.setReadOnly = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional bool is_encrypted = 4;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.getIsEncrypted = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.setIsEncrypted = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+/**
+ * optional string key = 5;
+ * @return {string}
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.getKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.setKey = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * repeated string extensions = 6;
+ * @return {!Array<string>}
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.getExtensionsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 6));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.setExtensionsList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.addExtensions = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.clearExtensionsList = function() {
+  return this.setExtensionsList([]);
+};
+
+
+/**
+ * map<string, string> pragmas = 7;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.getPragmasMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 7, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.clearPragmasMap = function() {
+  this.getPragmasMap().clear();
+  return this;
+};
+
+
+/**
+ * optional int32 max_open_conns = 8;
+ * @return {number}
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.getMaxOpenConns = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.setMaxOpenConns = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+/**
+ * optional int32 max_idle_conns = 9;
+ * @return {number}
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.getMaxIdleConns = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.setMaxIdleConns = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
+};
+
+
+/**
+ * optional int32 conn_max_lifetime_ms = 10;
+ * @return {number}
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.getConnMaxLifetimeMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.setConnMaxLifetimeMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
+};
+
+
+/**
+ * optional int32 max_execution_time_ms = 11;
+ * @return {number}
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.getMaxExecutionTimeMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.MountDatabaseRequest.prototype.setMaxExecutionTimeMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 11, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.MountDatabaseResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.MountDatabaseResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.MountDatabaseResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.MountDatabaseResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+message: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.MountDatabaseResponse}
+ */
+proto.sqlrpc.v1.MountDatabaseResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.MountDatabaseResponse;
+  return proto.sqlrpc.v1.MountDatabaseResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.MountDatabaseResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.MountDatabaseResponse}
+ */
+proto.sqlrpc.v1.MountDatabaseResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.MountDatabaseResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.MountDatabaseResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.MountDatabaseResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.MountDatabaseResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool success = 1;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.MountDatabaseResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.MountDatabaseResponse} returns this
+ */
+proto.sqlrpc.v1.MountDatabaseResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+/**
+ * optional string message = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.MountDatabaseResponse.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.MountDatabaseResponse} returns this
+ */
+proto.sqlrpc.v1.MountDatabaseResponse.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.UnMountDatabaseRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.UnMountDatabaseRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.UnMountDatabaseRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.UnMountDatabaseRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+name: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.UnMountDatabaseRequest}
+ */
+proto.sqlrpc.v1.UnMountDatabaseRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.UnMountDatabaseRequest;
+  return proto.sqlrpc.v1.UnMountDatabaseRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.UnMountDatabaseRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.UnMountDatabaseRequest}
+ */
+proto.sqlrpc.v1.UnMountDatabaseRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setName(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.UnMountDatabaseRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.UnMountDatabaseRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.UnMountDatabaseRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.UnMountDatabaseRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.UnMountDatabaseRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.UnMountDatabaseRequest} returns this
+ */
+proto.sqlrpc.v1.UnMountDatabaseRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.UnMountDatabaseResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.UnMountDatabaseResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.UnMountDatabaseResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.UnMountDatabaseResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+message: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.UnMountDatabaseResponse}
+ */
+proto.sqlrpc.v1.UnMountDatabaseResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.UnMountDatabaseResponse;
+  return proto.sqlrpc.v1.UnMountDatabaseResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.UnMountDatabaseResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.UnMountDatabaseResponse}
+ */
+proto.sqlrpc.v1.UnMountDatabaseResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.UnMountDatabaseResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.UnMountDatabaseResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.UnMountDatabaseResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.ListUsersRequest.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.UnMountDatabaseResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
+/**
+ * optional bool success = 1;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.UnMountDatabaseResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.UnMountDatabaseResponse} returns this
  */
-proto.sqlrpc.v1.ListUsersResponse.repeatedFields_ = [1];
+proto.sqlrpc.v1.UnMountDatabaseResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+/**
+ * optional string message = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.UnMountDatabaseResponse.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.UnMountDatabaseResponse} returns this
+ */
+proto.sqlrpc.v1.UnMountDatabaseResponse.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
 
 
 
@@ -921,8 +13423,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.ListUsersResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.ListUsersResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.MaintenanceRun.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.MaintenanceRun.toObject(opt_includeInstance, this);
 };
 
 
@@ -931,14 +13433,19 @@ proto.sqlrpc.v1.ListUsersResponse.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.ListUsersResponse} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.MaintenanceRun} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.ListUsersResponse.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.MaintenanceRun.toObject = function(includeInstance, msg) {
   var f, obj = {
-usersList: jspb.Message.toObjectList(msg.getUsersList(),
-    sqlrpc_v1_types_pb.User.toObject, includeInstance)
+id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+database: jspb.Message.getFieldWithDefault(msg, 2, ""),
+task: jspb.Message.getFieldWithDefault(msg, 3, 0),
+startedAt: (f = msg.getStartedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+duration: (f = msg.getDuration()) && google_protobuf_duration_pb.Duration.toObject(includeInstance, f),
+success: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
+message: jspb.Message.getFieldWithDefault(msg, 7, "")
   };
 
   if (includeInstance) {
@@ -952,23 +13459,23 @@ usersList: jspb.Message.toObjectList(msg.getUsersList(),
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.ListUsersResponse}
+ * @return {!proto.sqlrpc.v1.MaintenanceRun}
  */
-proto.sqlrpc.v1.ListUsersResponse.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.MaintenanceRun.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.ListUsersResponse;
-  return proto.sqlrpc.v1.ListUsersResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.MaintenanceRun;
+  return proto.sqlrpc.v1.MaintenanceRun.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.ListUsersResponse} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.MaintenanceRun} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.ListUsersResponse}
+ * @return {!proto.sqlrpc.v1.MaintenanceRun}
  */
-proto.sqlrpc.v1.ListUsersResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.MaintenanceRun.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -976,9 +13483,34 @@ proto.sqlrpc.v1.ListUsersResponse.deserializeBinaryFromReader = function(msg, re
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new sqlrpc_v1_types_pb.User;
-      reader.readMessage(value,sqlrpc_v1_types_pb.User.deserializeBinaryFromReader);
-      msg.addUsers(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.sqlrpc.v1.MaintenanceTask} */ (reader.readEnum());
+      msg.setTask(value);
+      break;
+    case 4:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setStartedAt(value);
+      break;
+    case 5:
+      var value = new google_protobuf_duration_pb.Duration;
+      reader.readMessage(value,google_protobuf_duration_pb.Duration.deserializeBinaryFromReader);
+      msg.setDuration(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setMessage(value);
       break;
     default:
       reader.skipField();
@@ -990,71 +13522,240 @@ proto.sqlrpc.v1.ListUsersResponse.deserializeBinaryFromReader = function(msg, re
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.MaintenanceRun.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.MaintenanceRun.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.MaintenanceRun} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.MaintenanceRun.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getTask();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+  f = message.getStartedAt();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getDuration();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_duration_pb.Duration.serializeBinaryToWriter
+    );
+  }
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 id = 1;
+ * @return {number}
+ */
+proto.sqlrpc.v1.MaintenanceRun.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.MaintenanceRun} returns this
+ */
+proto.sqlrpc.v1.MaintenanceRun.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string database = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.MaintenanceRun.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.MaintenanceRun} returns this
+ */
+proto.sqlrpc.v1.MaintenanceRun.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional MaintenanceTask task = 3;
+ * @return {!proto.sqlrpc.v1.MaintenanceTask}
+ */
+proto.sqlrpc.v1.MaintenanceRun.prototype.getTask = function() {
+  return /** @type {!proto.sqlrpc.v1.MaintenanceTask} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.MaintenanceTask} value
+ * @return {!proto.sqlrpc.v1.MaintenanceRun} returns this
+ */
+proto.sqlrpc.v1.MaintenanceRun.prototype.setTask = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp started_at = 4;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.sqlrpc.v1.MaintenanceRun.prototype.getStartedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.sqlrpc.v1.MaintenanceRun} returns this
+*/
+proto.sqlrpc.v1.MaintenanceRun.prototype.setStartedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.MaintenanceRun} returns this
+ */
+proto.sqlrpc.v1.MaintenanceRun.prototype.clearStartedAt = function() {
+  return this.setStartedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.MaintenanceRun.prototype.hasStartedAt = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional google.protobuf.Duration duration = 5;
+ * @return {?proto.google.protobuf.Duration}
+ */
+proto.sqlrpc.v1.MaintenanceRun.prototype.getDuration = function() {
+  return /** @type{?proto.google.protobuf.Duration} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_duration_pb.Duration, 5));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Duration|undefined} value
+ * @return {!proto.sqlrpc.v1.MaintenanceRun} returns this
+*/
+proto.sqlrpc.v1.MaintenanceRun.prototype.setDuration = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.MaintenanceRun} returns this
  */
-proto.sqlrpc.v1.ListUsersResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.ListUsersResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.sqlrpc.v1.MaintenanceRun.prototype.clearDuration = function() {
+  return this.setDuration(undefined);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.ListUsersResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.ListUsersResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getUsersList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      1,
-      f,
-      sqlrpc_v1_types_pb.User.serializeBinaryToWriter
-    );
-  }
+proto.sqlrpc.v1.MaintenanceRun.prototype.hasDuration = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
 /**
- * repeated User users = 1;
- * @return {!Array<!proto.sqlrpc.v1.User>}
+ * optional bool success = 6;
+ * @return {boolean}
  */
-proto.sqlrpc.v1.ListUsersResponse.prototype.getUsersList = function() {
-  return /** @type{!Array<!proto.sqlrpc.v1.User>} */ (
-    jspb.Message.getRepeatedWrapperField(this, sqlrpc_v1_types_pb.User, 1));
+proto.sqlrpc.v1.MaintenanceRun.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
 };
 
 
 /**
- * @param {!Array<!proto.sqlrpc.v1.User>} value
- * @return {!proto.sqlrpc.v1.ListUsersResponse} returns this
-*/
-proto.sqlrpc.v1.ListUsersResponse.prototype.setUsersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.MaintenanceRun} returns this
+ */
+proto.sqlrpc.v1.MaintenanceRun.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
 };
 
 
 /**
- * @param {!proto.sqlrpc.v1.User=} opt_value
- * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.User}
+ * optional string message = 7;
+ * @return {string}
  */
-proto.sqlrpc.v1.ListUsersResponse.prototype.addUsers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.sqlrpc.v1.User, opt_index);
+proto.sqlrpc.v1.MaintenanceRun.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.ListUsersResponse} returns this
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.MaintenanceRun} returns this
  */
-proto.sqlrpc.v1.ListUsersResponse.prototype.clearUsersList = function() {
-  return this.setUsersList([]);
+proto.sqlrpc.v1.MaintenanceRun.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
 };
 
 
@@ -1074,8 +13775,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.CreateUserRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.CreateUserRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ListMaintenanceRunsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ListMaintenanceRunsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1084,15 +13785,15 @@ proto.sqlrpc.v1.CreateUserRequest.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.CreateUserRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.ListMaintenanceRunsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.CreateUserRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.ListMaintenanceRunsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-username: jspb.Message.getFieldWithDefault(msg, 1, ""),
-role: jspb.Message.getFieldWithDefault(msg, 2, 0),
-password: jspb.Message.getFieldWithDefault(msg, 3, "")
+database: jspb.Message.getFieldWithDefault(msg, 1, ""),
+task: jspb.Message.getFieldWithDefault(msg, 2, 0),
+limit: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -1106,23 +13807,23 @@ password: jspb.Message.getFieldWithDefault(msg, 3, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.CreateUserRequest}
+ * @return {!proto.sqlrpc.v1.ListMaintenanceRunsRequest}
  */
-proto.sqlrpc.v1.CreateUserRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.ListMaintenanceRunsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.CreateUserRequest;
-  return proto.sqlrpc.v1.CreateUserRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.ListMaintenanceRunsRequest;
+  return proto.sqlrpc.v1.ListMaintenanceRunsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.CreateUserRequest} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.ListMaintenanceRunsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.CreateUserRequest}
+ * @return {!proto.sqlrpc.v1.ListMaintenanceRunsRequest}
  */
-proto.sqlrpc.v1.CreateUserRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.ListMaintenanceRunsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1131,15 +13832,15 @@ proto.sqlrpc.v1.CreateUserRequest.deserializeBinaryFromReader = function(msg, re
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setUsername(value);
+      msg.setDatabase(value);
       break;
     case 2:
-      var value = /** @type {!proto.sqlrpc.v1.Role} */ (reader.readEnum());
-      msg.setRole(value);
+      var value = /** @type {!proto.sqlrpc.v1.MaintenanceTask} */ (reader.readEnum());
+      msg.setTask(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setPassword(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLimit(value);
       break;
     default:
       reader.skipField();
@@ -1154,9 +13855,9 @@ proto.sqlrpc.v1.CreateUserRequest.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.CreateUserRequest.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.ListMaintenanceRunsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.CreateUserRequest.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.ListMaintenanceRunsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1164,29 +13865,29 @@ proto.sqlrpc.v1.CreateUserRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.CreateUserRequest} message
+ * @param {!proto.sqlrpc.v1.ListMaintenanceRunsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.CreateUserRequest.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.ListMaintenanceRunsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUsername();
+  f = message.getDatabase();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getRole();
+  f = message.getTask();
   if (f !== 0.0) {
     writer.writeEnum(
       2,
       f
     );
   }
-  f = message.getPassword();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getLimit();
+  if (f !== 0) {
+    writer.writeInt32(
       3,
       f
     );
@@ -1195,60 +13896,67 @@ proto.sqlrpc.v1.CreateUserRequest.serializeBinaryToWriter = function(message, wr
 
 
 /**
- * optional string username = 1;
+ * optional string database = 1;
  * @return {string}
  */
-proto.sqlrpc.v1.CreateUserRequest.prototype.getUsername = function() {
+proto.sqlrpc.v1.ListMaintenanceRunsRequest.prototype.getDatabase = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.CreateUserRequest} returns this
+ * @return {!proto.sqlrpc.v1.ListMaintenanceRunsRequest} returns this
  */
-proto.sqlrpc.v1.CreateUserRequest.prototype.setUsername = function(value) {
+proto.sqlrpc.v1.ListMaintenanceRunsRequest.prototype.setDatabase = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional Role role = 2;
- * @return {!proto.sqlrpc.v1.Role}
+ * optional MaintenanceTask task = 2;
+ * @return {!proto.sqlrpc.v1.MaintenanceTask}
  */
-proto.sqlrpc.v1.CreateUserRequest.prototype.getRole = function() {
-  return /** @type {!proto.sqlrpc.v1.Role} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.sqlrpc.v1.ListMaintenanceRunsRequest.prototype.getTask = function() {
+  return /** @type {!proto.sqlrpc.v1.MaintenanceTask} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {!proto.sqlrpc.v1.Role} value
- * @return {!proto.sqlrpc.v1.CreateUserRequest} returns this
+ * @param {!proto.sqlrpc.v1.MaintenanceTask} value
+ * @return {!proto.sqlrpc.v1.ListMaintenanceRunsRequest} returns this
  */
-proto.sqlrpc.v1.CreateUserRequest.prototype.setRole = function(value) {
+proto.sqlrpc.v1.ListMaintenanceRunsRequest.prototype.setTask = function(value) {
   return jspb.Message.setProto3EnumField(this, 2, value);
 };
 
 
 /**
- * optional string password = 3;
- * @return {string}
+ * optional int32 limit = 3;
+ * @return {number}
  */
-proto.sqlrpc.v1.CreateUserRequest.prototype.getPassword = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.sqlrpc.v1.ListMaintenanceRunsRequest.prototype.getLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.CreateUserRequest} returns this
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.ListMaintenanceRunsRequest} returns this
  */
-proto.sqlrpc.v1.CreateUserRequest.prototype.setPassword = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.sqlrpc.v1.ListMaintenanceRunsRequest.prototype.setLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.ListMaintenanceRunsResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1264,8 +13972,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.CreateUserResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.CreateUserResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ListMaintenanceRunsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ListMaintenanceRunsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1274,15 +13982,14 @@ proto.sqlrpc.v1.CreateUserResponse.prototype.toObject = function(opt_includeInst
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.CreateUserResponse} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.ListMaintenanceRunsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.CreateUserResponse.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.ListMaintenanceRunsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-userId: jspb.Message.getFieldWithDefault(msg, 1, 0),
-username: jspb.Message.getFieldWithDefault(msg, 2, ""),
-createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+runsList: jspb.Message.toObjectList(msg.getRunsList(),
+    proto.sqlrpc.v1.MaintenanceRun.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -1296,23 +14003,23 @@ createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.to
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.CreateUserResponse}
+ * @return {!proto.sqlrpc.v1.ListMaintenanceRunsResponse}
  */
-proto.sqlrpc.v1.CreateUserResponse.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.ListMaintenanceRunsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.CreateUserResponse;
-  return proto.sqlrpc.v1.CreateUserResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.ListMaintenanceRunsResponse;
+  return proto.sqlrpc.v1.ListMaintenanceRunsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.CreateUserResponse} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.ListMaintenanceRunsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.CreateUserResponse}
+ * @return {!proto.sqlrpc.v1.ListMaintenanceRunsResponse}
  */
-proto.sqlrpc.v1.CreateUserResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.ListMaintenanceRunsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1320,17 +14027,9 @@ proto.sqlrpc.v1.CreateUserResponse.deserializeBinaryFromReader = function(msg, r
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setUserId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setUsername(value);
-      break;
-    case 3:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setCreatedAt(value);
+      var value = new proto.sqlrpc.v1.MaintenanceRun;
+      reader.readMessage(value,proto.sqlrpc.v1.MaintenanceRun.deserializeBinaryFromReader);
+      msg.addRuns(value);
       break;
     default:
       reader.skipField();
@@ -1345,9 +14044,9 @@ proto.sqlrpc.v1.CreateUserResponse.deserializeBinaryFromReader = function(msg, r
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.CreateUserResponse.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.ListMaintenanceRunsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.CreateUserResponse.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.ListMaintenanceRunsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1355,107 +14054,188 @@ proto.sqlrpc.v1.CreateUserResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.CreateUserResponse} message
+ * @param {!proto.sqlrpc.v1.ListMaintenanceRunsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.CreateUserResponse.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.ListMaintenanceRunsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUserId();
-  if (f !== 0) {
-    writer.writeInt64(
-      1,
-      f
-    );
-  }
-  f = message.getUsername();
+  f = message.getRunsList();
   if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getCreatedAt();
-  if (f != null) {
-    writer.writeMessage(
-      3,
+    writer.writeRepeatedMessage(
+      1,
       f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+      proto.sqlrpc.v1.MaintenanceRun.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional int64 user_id = 1;
- * @return {number}
+ * repeated MaintenanceRun runs = 1;
+ * @return {!Array<!proto.sqlrpc.v1.MaintenanceRun>}
  */
-proto.sqlrpc.v1.CreateUserResponse.prototype.getUserId = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.sqlrpc.v1.ListMaintenanceRunsResponse.prototype.getRunsList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.MaintenanceRun>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.sqlrpc.v1.MaintenanceRun, 1));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.sqlrpc.v1.CreateUserResponse} returns this
+ * @param {!Array<!proto.sqlrpc.v1.MaintenanceRun>} value
+ * @return {!proto.sqlrpc.v1.ListMaintenanceRunsResponse} returns this
+*/
+proto.sqlrpc.v1.ListMaintenanceRunsResponse.prototype.setRunsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.MaintenanceRun=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.MaintenanceRun}
  */
-proto.sqlrpc.v1.CreateUserResponse.prototype.setUserId = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.sqlrpc.v1.ListMaintenanceRunsResponse.prototype.addRuns = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.sqlrpc.v1.MaintenanceRun, opt_index);
 };
 
 
 /**
- * optional string username = 2;
- * @return {string}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.ListMaintenanceRunsResponse} returns this
  */
-proto.sqlrpc.v1.CreateUserResponse.prototype.getUsername = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.sqlrpc.v1.ListMaintenanceRunsResponse.prototype.clearRunsList = function() {
+  return this.setRunsList([]);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.CreateUserResponse} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.sqlrpc.v1.CreateUserResponse.prototype.setUsername = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.sqlrpc.v1.CancelQueryRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.CancelQueryRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional google.protobuf.Timestamp created_at = 3;
- * @return {?proto.google.protobuf.Timestamp}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.CancelQueryRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.CreateUserResponse.prototype.getCreatedAt = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+proto.sqlrpc.v1.CancelQueryRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+requestId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.CancelQueryRequest}
+ */
+proto.sqlrpc.v1.CancelQueryRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.CancelQueryRequest;
+  return proto.sqlrpc.v1.CancelQueryRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.CancelQueryRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.CancelQueryRequest}
+ */
+proto.sqlrpc.v1.CancelQueryRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setRequestId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.CancelQueryRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.CancelQueryRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.sqlrpc.v1.CreateUserResponse} returns this
-*/
-proto.sqlrpc.v1.CreateUserResponse.prototype.setCreatedAt = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.CancelQueryRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.CancelQueryRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRequestId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.CreateUserResponse} returns this
+ * optional string request_id = 1;
+ * @return {string}
  */
-proto.sqlrpc.v1.CreateUserResponse.prototype.clearCreatedAt = function() {
-  return this.setCreatedAt(undefined);
+proto.sqlrpc.v1.CancelQueryRequest.prototype.getRequestId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.CancelQueryRequest} returns this
  */
-proto.sqlrpc.v1.CreateUserResponse.prototype.hasCreatedAt = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.sqlrpc.v1.CancelQueryRequest.prototype.setRequestId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -1475,8 +14255,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.UpdateUserRoleRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.UpdateUserRoleRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.CancelQueryResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.CancelQueryResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1485,14 +14265,13 @@ proto.sqlrpc.v1.UpdateUserRoleRequest.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.UpdateUserRoleRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.CancelQueryResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.UpdateUserRoleRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.CancelQueryResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-username: jspb.Message.getFieldWithDefault(msg, 1, ""),
-role: jspb.Message.getFieldWithDefault(msg, 2, 0)
+cancelled: jspb.Message.getFieldWithDefault(msg, 1, 0)
   };
 
   if (includeInstance) {
@@ -1506,23 +14285,23 @@ role: jspb.Message.getFieldWithDefault(msg, 2, 0)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.UpdateUserRoleRequest}
+ * @return {!proto.sqlrpc.v1.CancelQueryResponse}
  */
-proto.sqlrpc.v1.UpdateUserRoleRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.CancelQueryResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.UpdateUserRoleRequest;
-  return proto.sqlrpc.v1.UpdateUserRoleRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.CancelQueryResponse;
+  return proto.sqlrpc.v1.CancelQueryResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.UpdateUserRoleRequest} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.CancelQueryResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.UpdateUserRoleRequest}
+ * @return {!proto.sqlrpc.v1.CancelQueryResponse}
  */
-proto.sqlrpc.v1.UpdateUserRoleRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.CancelQueryResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1530,12 +14309,8 @@ proto.sqlrpc.v1.UpdateUserRoleRequest.deserializeBinaryFromReader = function(msg
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setUsername(value);
-      break;
-    case 2:
-      var value = /** @type {!proto.sqlrpc.v1.Role} */ (reader.readEnum());
-      msg.setRole(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setCancelled(value);
       break;
     default:
       reader.skipField();
@@ -1550,9 +14325,9 @@ proto.sqlrpc.v1.UpdateUserRoleRequest.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.UpdateUserRoleRequest.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.CancelQueryResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.UpdateUserRoleRequest.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.CancelQueryResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1560,62 +14335,37 @@ proto.sqlrpc.v1.UpdateUserRoleRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.UpdateUserRoleRequest} message
+ * @param {!proto.sqlrpc.v1.CancelQueryResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.UpdateUserRoleRequest.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.CancelQueryResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUsername();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getCancelled();
+  if (f !== 0) {
+    writer.writeInt32(
       1,
       f
     );
   }
-  f = message.getRole();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      2,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string username = 1;
- * @return {string}
- */
-proto.sqlrpc.v1.UpdateUserRoleRequest.prototype.getUsername = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.UpdateUserRoleRequest} returns this
- */
-proto.sqlrpc.v1.UpdateUserRoleRequest.prototype.setUsername = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional Role role = 2;
- * @return {!proto.sqlrpc.v1.Role}
+ * optional int32 cancelled = 1;
+ * @return {number}
  */
-proto.sqlrpc.v1.UpdateUserRoleRequest.prototype.getRole = function() {
-  return /** @type {!proto.sqlrpc.v1.Role} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.sqlrpc.v1.CancelQueryResponse.prototype.getCancelled = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!proto.sqlrpc.v1.Role} value
- * @return {!proto.sqlrpc.v1.UpdateUserRoleRequest} returns this
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.CancelQueryResponse} returns this
  */
-proto.sqlrpc.v1.UpdateUserRoleRequest.prototype.setRole = function(value) {
-  return jspb.Message.setProto3EnumField(this, 2, value);
+proto.sqlrpc.v1.CancelQueryResponse.prototype.setCancelled = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
@@ -1635,8 +14385,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.UpdateUserRoleResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.UpdateUserRoleResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ListActiveOperationsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ListActiveOperationsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1645,13 +14395,13 @@ proto.sqlrpc.v1.UpdateUserRoleResponse.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.UpdateUserRoleResponse} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.ListActiveOperationsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.UpdateUserRoleResponse.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.ListActiveOperationsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+database: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -1665,23 +14415,23 @@ success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.UpdateUserRoleResponse}
+ * @return {!proto.sqlrpc.v1.ListActiveOperationsRequest}
  */
-proto.sqlrpc.v1.UpdateUserRoleResponse.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.ListActiveOperationsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.UpdateUserRoleResponse;
-  return proto.sqlrpc.v1.UpdateUserRoleResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.ListActiveOperationsRequest;
+  return proto.sqlrpc.v1.ListActiveOperationsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.UpdateUserRoleResponse} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.ListActiveOperationsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.UpdateUserRoleResponse}
+ * @return {!proto.sqlrpc.v1.ListActiveOperationsRequest}
  */
-proto.sqlrpc.v1.UpdateUserRoleResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.ListActiveOperationsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1689,8 +14439,8 @@ proto.sqlrpc.v1.UpdateUserRoleResponse.deserializeBinaryFromReader = function(ms
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSuccess(value);
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
       break;
     default:
       reader.skipField();
@@ -1705,9 +14455,9 @@ proto.sqlrpc.v1.UpdateUserRoleResponse.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.UpdateUserRoleResponse.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.ListActiveOperationsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.UpdateUserRoleResponse.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.ListActiveOperationsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1715,15 +14465,15 @@ proto.sqlrpc.v1.UpdateUserRoleResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.UpdateUserRoleResponse} message
+ * @param {!proto.sqlrpc.v1.ListActiveOperationsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.UpdateUserRoleResponse.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.ListActiveOperationsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSuccess();
-  if (f) {
-    writer.writeBool(
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
@@ -1732,20 +14482,20 @@ proto.sqlrpc.v1.UpdateUserRoleResponse.serializeBinaryToWriter = function(messag
 
 
 /**
- * optional bool success = 1;
- * @return {boolean}
+ * optional string database = 1;
+ * @return {string}
  */
-proto.sqlrpc.v1.UpdateUserRoleResponse.prototype.getSuccess = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.sqlrpc.v1.ListActiveOperationsRequest.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.sqlrpc.v1.UpdateUserRoleResponse} returns this
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ListActiveOperationsRequest} returns this
  */
-proto.sqlrpc.v1.UpdateUserRoleResponse.prototype.setSuccess = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.sqlrpc.v1.ListActiveOperationsRequest.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -1765,8 +14515,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.DeleteUserRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.DeleteUserRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ActiveStatement.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ActiveStatement.toObject(opt_includeInstance, this);
 };
 
 
@@ -1775,13 +14525,19 @@ proto.sqlrpc.v1.DeleteUserRequest.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.DeleteUserRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.ActiveStatement} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.DeleteUserRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.ActiveStatement.toObject = function(includeInstance, msg) {
   var f, obj = {
-username: jspb.Message.getFieldWithDefault(msg, 1, "")
+requestId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+username: jspb.Message.getFieldWithDefault(msg, 2, ""),
+database: jspb.Message.getFieldWithDefault(msg, 3, ""),
+sql: jspb.Message.getFieldWithDefault(msg, 4, ""),
+startedAt: (f = msg.getStartedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+elapsed: (f = msg.getElapsed()) && google_protobuf_duration_pb.Duration.toObject(includeInstance, f),
+rowsStreamed: jspb.Message.getFieldWithDefault(msg, 7, 0)
   };
 
   if (includeInstance) {
@@ -1795,23 +14551,23 @@ username: jspb.Message.getFieldWithDefault(msg, 1, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.DeleteUserRequest}
+ * @return {!proto.sqlrpc.v1.ActiveStatement}
  */
-proto.sqlrpc.v1.DeleteUserRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.ActiveStatement.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.DeleteUserRequest;
-  return proto.sqlrpc.v1.DeleteUserRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.ActiveStatement;
+  return proto.sqlrpc.v1.ActiveStatement.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.DeleteUserRequest} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.ActiveStatement} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.DeleteUserRequest}
+ * @return {!proto.sqlrpc.v1.ActiveStatement}
  */
-proto.sqlrpc.v1.DeleteUserRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.ActiveStatement.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1819,9 +14575,35 @@ proto.sqlrpc.v1.DeleteUserRequest.deserializeBinaryFromReader = function(msg, re
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setRequestId(value);
+      break;
+    case 2:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
       msg.setUsername(value);
       break;
+    case 3:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setSql(value);
+      break;
+    case 5:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setStartedAt(value);
+      break;
+    case 6:
+      var value = new google_protobuf_duration_pb.Duration;
+      reader.readMessage(value,google_protobuf_duration_pb.Duration.deserializeBinaryFromReader);
+      msg.setElapsed(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setRowsStreamed(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1835,177 +14617,237 @@ proto.sqlrpc.v1.DeleteUserRequest.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.DeleteUserRequest.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.ActiveStatement.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.DeleteUserRequest.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.ActiveStatement.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.DeleteUserRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ActiveStatement} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ActiveStatement.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRequestId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getSql();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getStartedAt();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getElapsed();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      google_protobuf_duration_pb.Duration.serializeBinaryToWriter
+    );
+  }
+  f = message.getRowsStreamed();
+  if (f !== 0) {
+    writer.writeInt64(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string request_id = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.ActiveStatement.prototype.getRequestId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ActiveStatement} returns this
+ */
+proto.sqlrpc.v1.ActiveStatement.prototype.setRequestId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string username = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.ActiveStatement.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ActiveStatement} returns this
+ */
+proto.sqlrpc.v1.ActiveStatement.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string database = 3;
+ * @return {string}
+ */
+proto.sqlrpc.v1.ActiveStatement.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ActiveStatement} returns this
  */
-proto.sqlrpc.v1.DeleteUserRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getUsername();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.sqlrpc.v1.ActiveStatement.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional string username = 1;
+ * optional string sql = 4;
  * @return {string}
  */
-proto.sqlrpc.v1.DeleteUserRequest.prototype.getUsername = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.sqlrpc.v1.ActiveStatement.prototype.getSql = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.DeleteUserRequest} returns this
+ * @return {!proto.sqlrpc.v1.ActiveStatement} returns this
  */
-proto.sqlrpc.v1.DeleteUserRequest.prototype.setUsername = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.sqlrpc.v1.ActiveStatement.prototype.setSql = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
+/**
+ * optional google.protobuf.Timestamp started_at = 5;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.sqlrpc.v1.ActiveStatement.prototype.getStartedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 5));
+};
+
 
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.sqlrpc.v1.ActiveStatement} returns this
+*/
+proto.sqlrpc.v1.ActiveStatement.prototype.setStartedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.ActiveStatement} returns this
  */
-proto.sqlrpc.v1.DeleteUserResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.DeleteUserResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ActiveStatement.prototype.clearStartedAt = function() {
+  return this.setStartedAt(undefined);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.DeleteUserResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.DeleteUserResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.sqlrpc.v1.ActiveStatement.prototype.hasStartedAt = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.DeleteUserResponse}
+ * optional google.protobuf.Duration elapsed = 6;
+ * @return {?proto.google.protobuf.Duration}
  */
-proto.sqlrpc.v1.DeleteUserResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.DeleteUserResponse;
-  return proto.sqlrpc.v1.DeleteUserResponse.deserializeBinaryFromReader(msg, reader);
+proto.sqlrpc.v1.ActiveStatement.prototype.getElapsed = function() {
+  return /** @type{?proto.google.protobuf.Duration} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_duration_pb.Duration, 6));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.DeleteUserResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.DeleteUserResponse}
- */
-proto.sqlrpc.v1.DeleteUserResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSuccess(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+ * @param {?proto.google.protobuf.Duration|undefined} value
+ * @return {!proto.sqlrpc.v1.ActiveStatement} returns this
+*/
+proto.sqlrpc.v1.ActiveStatement.prototype.setElapsed = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.ActiveStatement} returns this
  */
-proto.sqlrpc.v1.DeleteUserResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.DeleteUserResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.sqlrpc.v1.ActiveStatement.prototype.clearElapsed = function() {
+  return this.setElapsed(undefined);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.DeleteUserResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.DeleteUserResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getSuccess();
-  if (f) {
-    writer.writeBool(
-      1,
-      f
-    );
-  }
+proto.sqlrpc.v1.ActiveStatement.prototype.hasElapsed = function() {
+  return jspb.Message.getField(this, 6) != null;
 };
 
 
 /**
- * optional bool success = 1;
- * @return {boolean}
+ * optional int64 rows_streamed = 7;
+ * @return {number}
  */
-proto.sqlrpc.v1.DeleteUserResponse.prototype.getSuccess = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.sqlrpc.v1.ActiveStatement.prototype.getRowsStreamed = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.sqlrpc.v1.DeleteUserResponse} returns this
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.ActiveStatement} returns this
  */
-proto.sqlrpc.v1.DeleteUserResponse.prototype.setSuccess = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.sqlrpc.v1.ActiveStatement.prototype.setRowsStreamed = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
 };
 
 
@@ -2025,8 +14867,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.UpdatePasswordRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.UpdatePasswordRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ActiveTransaction.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ActiveTransaction.toObject(opt_includeInstance, this);
 };
 
 
@@ -2035,14 +14877,20 @@ proto.sqlrpc.v1.UpdatePasswordRequest.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.UpdatePasswordRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.ActiveTransaction} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.UpdatePasswordRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.ActiveTransaction.toObject = function(includeInstance, msg) {
   var f, obj = {
-username: jspb.Message.getFieldWithDefault(msg, 1, ""),
-newPassword: jspb.Message.getFieldWithDefault(msg, 2, "")
+transactionId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+kind: jspb.Message.getFieldWithDefault(msg, 2, 0),
+username: jspb.Message.getFieldWithDefault(msg, 3, ""),
+database: jspb.Message.getFieldWithDefault(msg, 4, ""),
+startedAt: (f = msg.getStartedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+age: (f = msg.getAge()) && google_protobuf_duration_pb.Duration.toObject(includeInstance, f),
+expiresAt: (f = msg.getExpiresAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+lastSql: jspb.Message.getFieldWithDefault(msg, 8, "")
   };
 
   if (includeInstance) {
@@ -2056,23 +14904,23 @@ newPassword: jspb.Message.getFieldWithDefault(msg, 2, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.UpdatePasswordRequest}
+ * @return {!proto.sqlrpc.v1.ActiveTransaction}
  */
-proto.sqlrpc.v1.UpdatePasswordRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.ActiveTransaction.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.UpdatePasswordRequest;
-  return proto.sqlrpc.v1.UpdatePasswordRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.ActiveTransaction;
+  return proto.sqlrpc.v1.ActiveTransaction.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.UpdatePasswordRequest} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.ActiveTransaction} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.UpdatePasswordRequest}
+ * @return {!proto.sqlrpc.v1.ActiveTransaction}
  */
-proto.sqlrpc.v1.UpdatePasswordRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.ActiveTransaction.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2081,11 +14929,38 @@ proto.sqlrpc.v1.UpdatePasswordRequest.deserializeBinaryFromReader = function(msg
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setUsername(value);
+      msg.setTransactionId(value);
       break;
     case 2:
+      var value = /** @type {!proto.sqlrpc.v1.TransactionKind} */ (reader.readEnum());
+      msg.setKind(value);
+      break;
+    case 3:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setNewPassword(value);
+      msg.setUsername(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
+      break;
+    case 5:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setStartedAt(value);
+      break;
+    case 6:
+      var value = new google_protobuf_duration_pb.Duration;
+      reader.readMessage(value,google_protobuf_duration_pb.Duration.deserializeBinaryFromReader);
+      msg.setAge(value);
+      break;
+    case 7:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setExpiresAt(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setLastSql(value);
       break;
     default:
       reader.skipField();
@@ -2100,9 +14975,9 @@ proto.sqlrpc.v1.UpdatePasswordRequest.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.UpdatePasswordRequest.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.ActiveTransaction.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.UpdatePasswordRequest.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.ActiveTransaction.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2110,23 +14985,68 @@ proto.sqlrpc.v1.UpdatePasswordRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.UpdatePasswordRequest} message
+ * @param {!proto.sqlrpc.v1.ActiveTransaction} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.UpdatePasswordRequest.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.ActiveTransaction.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUsername();
+  f = message.getTransactionId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getNewPassword();
+  f = message.getKind();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getUsername();
   if (f.length > 0) {
     writer.writeString(
-      2,
+      3,
+      f
+    );
+  }
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getStartedAt();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getAge();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      google_protobuf_duration_pb.Duration.serializeBinaryToWriter
+    );
+  }
+  f = message.getExpiresAt();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getLastSql();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
       f
     );
   }
@@ -2134,298 +15054,203 @@ proto.sqlrpc.v1.UpdatePasswordRequest.serializeBinaryToWriter = function(message
 
 
 /**
- * optional string username = 1;
+ * optional string transaction_id = 1;
  * @return {string}
  */
-proto.sqlrpc.v1.UpdatePasswordRequest.prototype.getUsername = function() {
+proto.sqlrpc.v1.ActiveTransaction.prototype.getTransactionId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.UpdatePasswordRequest} returns this
+ * @return {!proto.sqlrpc.v1.ActiveTransaction} returns this
  */
-proto.sqlrpc.v1.UpdatePasswordRequest.prototype.setUsername = function(value) {
+proto.sqlrpc.v1.ActiveTransaction.prototype.setTransactionId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string new_password = 2;
- * @return {string}
+ * optional TransactionKind kind = 2;
+ * @return {!proto.sqlrpc.v1.TransactionKind}
  */
-proto.sqlrpc.v1.UpdatePasswordRequest.prototype.getNewPassword = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.sqlrpc.v1.ActiveTransaction.prototype.getKind = function() {
+  return /** @type {!proto.sqlrpc.v1.TransactionKind} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.UpdatePasswordRequest} returns this
+ * @param {!proto.sqlrpc.v1.TransactionKind} value
+ * @return {!proto.sqlrpc.v1.ActiveTransaction} returns this
  */
-proto.sqlrpc.v1.UpdatePasswordRequest.prototype.setNewPassword = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.sqlrpc.v1.ActiveTransaction.prototype.setKind = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional string username = 3;
+ * @return {string}
  */
-proto.sqlrpc.v1.UpdatePasswordResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.UpdatePasswordResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ActiveTransaction.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.UpdatePasswordResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ActiveTransaction} returns this
  */
-proto.sqlrpc.v1.UpdatePasswordResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.sqlrpc.v1.ActiveTransaction.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.UpdatePasswordResponse}
+ * optional string database = 4;
+ * @return {string}
  */
-proto.sqlrpc.v1.UpdatePasswordResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.UpdatePasswordResponse;
-  return proto.sqlrpc.v1.UpdatePasswordResponse.deserializeBinaryFromReader(msg, reader);
+proto.sqlrpc.v1.ActiveTransaction.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.UpdatePasswordResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.UpdatePasswordResponse}
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ActiveTransaction} returns this
  */
-proto.sqlrpc.v1.UpdatePasswordResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSuccess(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.sqlrpc.v1.ActiveTransaction.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional google.protobuf.Timestamp started_at = 5;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.sqlrpc.v1.UpdatePasswordResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.UpdatePasswordResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.sqlrpc.v1.ActiveTransaction.prototype.getStartedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 5));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.UpdatePasswordResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.sqlrpc.v1.ActiveTransaction} returns this
+*/
+proto.sqlrpc.v1.ActiveTransaction.prototype.setStartedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.ActiveTransaction} returns this
  */
-proto.sqlrpc.v1.UpdatePasswordResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getSuccess();
-  if (f) {
-    writer.writeBool(
-      1,
-      f
-    );
-  }
+proto.sqlrpc.v1.ActiveTransaction.prototype.clearStartedAt = function() {
+  return this.setStartedAt(undefined);
 };
 
 
 /**
- * optional bool success = 1;
+ * Returns whether this field is set.
  * @return {boolean}
  */
-proto.sqlrpc.v1.UpdatePasswordResponse.prototype.getSuccess = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.sqlrpc.v1.ActiveTransaction.prototype.hasStartedAt = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.sqlrpc.v1.UpdatePasswordResponse} returns this
+ * optional google.protobuf.Duration age = 6;
+ * @return {?proto.google.protobuf.Duration}
  */
-proto.sqlrpc.v1.UpdatePasswordResponse.prototype.setSuccess = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.sqlrpc.v1.ActiveTransaction.prototype.getAge = function() {
+  return /** @type{?proto.google.protobuf.Duration} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_duration_pb.Duration, 6));
 };
 
 
+/**
+ * @param {?proto.google.protobuf.Duration|undefined} value
+ * @return {!proto.sqlrpc.v1.ActiveTransaction} returns this
+*/
+proto.sqlrpc.v1.ActiveTransaction.prototype.setAge = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.ActiveTransaction} returns this
  */
-proto.sqlrpc.v1.ListAPIKeysRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.ListAPIKeysRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ActiveTransaction.prototype.clearAge = function() {
+  return this.setAge(undefined);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.ListAPIKeysRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.ListAPIKeysRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-username: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.sqlrpc.v1.ActiveTransaction.prototype.hasAge = function() {
+  return jspb.Message.getField(this, 6) != null;
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.ListAPIKeysRequest}
+ * optional google.protobuf.Timestamp expires_at = 7;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.sqlrpc.v1.ListAPIKeysRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.ListAPIKeysRequest;
-  return proto.sqlrpc.v1.ListAPIKeysRequest.deserializeBinaryFromReader(msg, reader);
+proto.sqlrpc.v1.ActiveTransaction.prototype.getExpiresAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 7));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.ListAPIKeysRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.ListAPIKeysRequest}
- */
-proto.sqlrpc.v1.ListAPIKeysRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setUsername(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.sqlrpc.v1.ActiveTransaction} returns this
+*/
+proto.sqlrpc.v1.ActiveTransaction.prototype.setExpiresAt = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.ActiveTransaction} returns this
  */
-proto.sqlrpc.v1.ListAPIKeysRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.ListAPIKeysRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.sqlrpc.v1.ActiveTransaction.prototype.clearExpiresAt = function() {
+  return this.setExpiresAt(undefined);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.ListAPIKeysRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.ListAPIKeysRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getUsername();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.sqlrpc.v1.ActiveTransaction.prototype.hasExpiresAt = function() {
+  return jspb.Message.getField(this, 7) != null;
 };
 
 
 /**
- * optional string username = 1;
+ * optional string last_sql = 8;
  * @return {string}
  */
-proto.sqlrpc.v1.ListAPIKeysRequest.prototype.getUsername = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.sqlrpc.v1.ActiveTransaction.prototype.getLastSql = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.ListAPIKeysRequest} returns this
+ * @return {!proto.sqlrpc.v1.ActiveTransaction} returns this
  */
-proto.sqlrpc.v1.ListAPIKeysRequest.prototype.setUsername = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.sqlrpc.v1.ActiveTransaction.prototype.setLastSql = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
 };
 
 
@@ -2435,7 +15260,7 @@ proto.sqlrpc.v1.ListAPIKeysRequest.prototype.setUsername = function(value) {
  * @private {!Array<number>}
  * @const
  */
-proto.sqlrpc.v1.ListAPIKeysResponse.repeatedFields_ = [1];
+proto.sqlrpc.v1.ListActiveOperationsResponse.repeatedFields_ = [1,2];
 
 
 
@@ -2452,8 +15277,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.ListAPIKeysResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.ListAPIKeysResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ListActiveOperationsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ListActiveOperationsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2462,14 +15287,16 @@ proto.sqlrpc.v1.ListAPIKeysResponse.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.ListAPIKeysResponse} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.ListActiveOperationsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.ListAPIKeysResponse.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.ListActiveOperationsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-keysList: jspb.Message.toObjectList(msg.getKeysList(),
-    proto.sqlrpc.v1.APIKey.toObject, includeInstance)
+statementsList: jspb.Message.toObjectList(msg.getStatementsList(),
+    proto.sqlrpc.v1.ActiveStatement.toObject, includeInstance),
+transactionsList: jspb.Message.toObjectList(msg.getTransactionsList(),
+    proto.sqlrpc.v1.ActiveTransaction.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -2483,23 +15310,23 @@ keysList: jspb.Message.toObjectList(msg.getKeysList(),
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.ListAPIKeysResponse}
+ * @return {!proto.sqlrpc.v1.ListActiveOperationsResponse}
  */
-proto.sqlrpc.v1.ListAPIKeysResponse.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.ListActiveOperationsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.ListAPIKeysResponse;
-  return proto.sqlrpc.v1.ListAPIKeysResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.ListActiveOperationsResponse;
+  return proto.sqlrpc.v1.ListActiveOperationsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.ListAPIKeysResponse} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.ListActiveOperationsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.ListAPIKeysResponse}
+ * @return {!proto.sqlrpc.v1.ListActiveOperationsResponse}
  */
-proto.sqlrpc.v1.ListAPIKeysResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.ListActiveOperationsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2507,9 +15334,14 @@ proto.sqlrpc.v1.ListAPIKeysResponse.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.sqlrpc.v1.APIKey;
-      reader.readMessage(value,proto.sqlrpc.v1.APIKey.deserializeBinaryFromReader);
-      msg.addKeys(value);
+      var value = new proto.sqlrpc.v1.ActiveStatement;
+      reader.readMessage(value,proto.sqlrpc.v1.ActiveStatement.deserializeBinaryFromReader);
+      msg.addStatements(value);
+      break;
+    case 2:
+      var value = new proto.sqlrpc.v1.ActiveTransaction;
+      reader.readMessage(value,proto.sqlrpc.v1.ActiveTransaction.deserializeBinaryFromReader);
+      msg.addTransactions(value);
       break;
     default:
       reader.skipField();
@@ -2524,9 +15356,9 @@ proto.sqlrpc.v1.ListAPIKeysResponse.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.ListAPIKeysResponse.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.ListActiveOperationsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.ListAPIKeysResponse.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.ListActiveOperationsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2534,58 +15366,104 @@ proto.sqlrpc.v1.ListAPIKeysResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.ListAPIKeysResponse} message
+ * @param {!proto.sqlrpc.v1.ListActiveOperationsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.ListAPIKeysResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getKeysList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      1,
-      f,
-      proto.sqlrpc.v1.APIKey.serializeBinaryToWriter
-    );
-  }
+proto.sqlrpc.v1.ListActiveOperationsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatementsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.sqlrpc.v1.ActiveStatement.serializeBinaryToWriter
+    );
+  }
+  f = message.getTransactionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.sqlrpc.v1.ActiveTransaction.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated ActiveStatement statements = 1;
+ * @return {!Array<!proto.sqlrpc.v1.ActiveStatement>}
+ */
+proto.sqlrpc.v1.ListActiveOperationsResponse.prototype.getStatementsList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.ActiveStatement>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.sqlrpc.v1.ActiveStatement, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.sqlrpc.v1.ActiveStatement>} value
+ * @return {!proto.sqlrpc.v1.ListActiveOperationsResponse} returns this
+*/
+proto.sqlrpc.v1.ListActiveOperationsResponse.prototype.setStatementsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.ActiveStatement=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.ActiveStatement}
+ */
+proto.sqlrpc.v1.ListActiveOperationsResponse.prototype.addStatements = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.sqlrpc.v1.ActiveStatement, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.ListActiveOperationsResponse} returns this
+ */
+proto.sqlrpc.v1.ListActiveOperationsResponse.prototype.clearStatementsList = function() {
+  return this.setStatementsList([]);
 };
 
 
 /**
- * repeated APIKey keys = 1;
- * @return {!Array<!proto.sqlrpc.v1.APIKey>}
+ * repeated ActiveTransaction transactions = 2;
+ * @return {!Array<!proto.sqlrpc.v1.ActiveTransaction>}
  */
-proto.sqlrpc.v1.ListAPIKeysResponse.prototype.getKeysList = function() {
-  return /** @type{!Array<!proto.sqlrpc.v1.APIKey>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.sqlrpc.v1.APIKey, 1));
+proto.sqlrpc.v1.ListActiveOperationsResponse.prototype.getTransactionsList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.ActiveTransaction>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.sqlrpc.v1.ActiveTransaction, 2));
 };
 
 
 /**
- * @param {!Array<!proto.sqlrpc.v1.APIKey>} value
- * @return {!proto.sqlrpc.v1.ListAPIKeysResponse} returns this
+ * @param {!Array<!proto.sqlrpc.v1.ActiveTransaction>} value
+ * @return {!proto.sqlrpc.v1.ListActiveOperationsResponse} returns this
 */
-proto.sqlrpc.v1.ListAPIKeysResponse.prototype.setKeysList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+proto.sqlrpc.v1.ListActiveOperationsResponse.prototype.setTransactionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
 };
 
 
 /**
- * @param {!proto.sqlrpc.v1.APIKey=} opt_value
+ * @param {!proto.sqlrpc.v1.ActiveTransaction=} opt_value
  * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.APIKey}
+ * @return {!proto.sqlrpc.v1.ActiveTransaction}
  */
-proto.sqlrpc.v1.ListAPIKeysResponse.prototype.addKeys = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.sqlrpc.v1.APIKey, opt_index);
+proto.sqlrpc.v1.ListActiveOperationsResponse.prototype.addTransactions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.sqlrpc.v1.ActiveTransaction, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.ListAPIKeysResponse} returns this
+ * @return {!proto.sqlrpc.v1.ListActiveOperationsResponse} returns this
  */
-proto.sqlrpc.v1.ListAPIKeysResponse.prototype.clearKeysList = function() {
-  return this.setKeysList([]);
+proto.sqlrpc.v1.ListActiveOperationsResponse.prototype.clearTransactionsList = function() {
+  return this.setTransactionsList([]);
 };
 
 
@@ -2605,8 +15483,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.APIKey.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.APIKey.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ForceRollbackTransactionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ForceRollbackTransactionRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2615,17 +15493,13 @@ proto.sqlrpc.v1.APIKey.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.APIKey} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.ForceRollbackTransactionRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.APIKey.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.ForceRollbackTransactionRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-keyPreview: jspb.Message.getFieldWithDefault(msg, 2, ""),
-name: jspb.Message.getFieldWithDefault(msg, 3, ""),
-createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-expiresAt: (f = msg.getExpiresAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+transactionId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -2639,23 +15513,23 @@ expiresAt: (f = msg.getExpiresAt()) && google_protobuf_timestamp_pb.Timestamp.to
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.APIKey}
+ * @return {!proto.sqlrpc.v1.ForceRollbackTransactionRequest}
  */
-proto.sqlrpc.v1.APIKey.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.ForceRollbackTransactionRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.APIKey;
-  return proto.sqlrpc.v1.APIKey.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.ForceRollbackTransactionRequest;
+  return proto.sqlrpc.v1.ForceRollbackTransactionRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.APIKey} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.ForceRollbackTransactionRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.APIKey}
+ * @return {!proto.sqlrpc.v1.ForceRollbackTransactionRequest}
  */
-proto.sqlrpc.v1.APIKey.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.ForceRollbackTransactionRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2664,25 +15538,7 @@ proto.sqlrpc.v1.APIKey.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setKeyPreview(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setName(value);
-      break;
-    case 4:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setCreatedAt(value);
-      break;
-    case 5:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setExpiresAt(value);
+      msg.setTransactionId(value);
       break;
     default:
       reader.skipField();
@@ -2697,9 +15553,9 @@ proto.sqlrpc.v1.APIKey.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.APIKey.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.ForceRollbackTransactionRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.APIKey.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.ForceRollbackTransactionRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2707,177 +15563,167 @@ proto.sqlrpc.v1.APIKey.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.APIKey} message
+ * @param {!proto.sqlrpc.v1.ForceRollbackTransactionRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.APIKey.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.ForceRollbackTransactionRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getTransactionId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getKeyPreview();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getCreatedAt();
-  if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
-    );
-  }
-  f = message.getExpiresAt();
-  if (f != null) {
-    writer.writeMessage(
-      5,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
-    );
-  }
 };
 
 
 /**
- * optional string id = 1;
+ * optional string transaction_id = 1;
  * @return {string}
  */
-proto.sqlrpc.v1.APIKey.prototype.getId = function() {
+proto.sqlrpc.v1.ForceRollbackTransactionRequest.prototype.getTransactionId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.APIKey} returns this
+ * @return {!proto.sqlrpc.v1.ForceRollbackTransactionRequest} returns this
  */
-proto.sqlrpc.v1.APIKey.prototype.setId = function(value) {
+proto.sqlrpc.v1.ForceRollbackTransactionRequest.prototype.setTransactionId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * optional string key_preview = 2;
- * @return {string}
- */
-proto.sqlrpc.v1.APIKey.prototype.getKeyPreview = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.APIKey} returns this
- */
-proto.sqlrpc.v1.APIKey.prototype.setKeyPreview = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
 
-/**
- * optional string name = 3;
- * @return {string}
- */
-proto.sqlrpc.v1.APIKey.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.APIKey} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.sqlrpc.v1.APIKey.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.sqlrpc.v1.ForceRollbackTransactionResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ForceRollbackTransactionResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional google.protobuf.Timestamp created_at = 4;
- * @return {?proto.google.protobuf.Timestamp}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.ForceRollbackTransactionResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.APIKey.prototype.getCreatedAt = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
-};
-
+proto.sqlrpc.v1.ForceRollbackTransactionResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+kind: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
 
-/**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.sqlrpc.v1.APIKey} returns this
-*/
-proto.sqlrpc.v1.APIKey.prototype.setCreatedAt = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.APIKey} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.ForceRollbackTransactionResponse}
  */
-proto.sqlrpc.v1.APIKey.prototype.clearCreatedAt = function() {
-  return this.setCreatedAt(undefined);
+proto.sqlrpc.v1.ForceRollbackTransactionResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.ForceRollbackTransactionResponse;
+  return proto.sqlrpc.v1.ForceRollbackTransactionResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.ForceRollbackTransactionResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.ForceRollbackTransactionResponse}
  */
-proto.sqlrpc.v1.APIKey.prototype.hasCreatedAt = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.sqlrpc.v1.ForceRollbackTransactionResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.sqlrpc.v1.TransactionKind} */ (reader.readEnum());
+      msg.setKind(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional google.protobuf.Timestamp expires_at = 5;
- * @return {?proto.google.protobuf.Timestamp}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.APIKey.prototype.getExpiresAt = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 5));
+proto.sqlrpc.v1.ForceRollbackTransactionResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ForceRollbackTransactionResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.sqlrpc.v1.APIKey} returns this
-*/
-proto.sqlrpc.v1.APIKey.prototype.setExpiresAt = function(value) {
-  return jspb.Message.setWrapperField(this, 5, value);
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ForceRollbackTransactionResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ForceRollbackTransactionResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getKind();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.APIKey} returns this
+ * optional TransactionKind kind = 1;
+ * @return {!proto.sqlrpc.v1.TransactionKind}
  */
-proto.sqlrpc.v1.APIKey.prototype.clearExpiresAt = function() {
-  return this.setExpiresAt(undefined);
+proto.sqlrpc.v1.ForceRollbackTransactionResponse.prototype.getKind = function() {
+  return /** @type {!proto.sqlrpc.v1.TransactionKind} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {!proto.sqlrpc.v1.TransactionKind} value
+ * @return {!proto.sqlrpc.v1.ForceRollbackTransactionResponse} returns this
  */
-proto.sqlrpc.v1.APIKey.prototype.hasExpiresAt = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.sqlrpc.v1.ForceRollbackTransactionResponse.prototype.setKind = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
 };
 
 
@@ -2897,8 +15743,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.CreateAPIKeyRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.AuditEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.AuditEvent.toObject(opt_includeInstance, this);
 };
 
 
@@ -2907,15 +15753,24 @@ proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.CreateAPIKeyRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.AuditEvent} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.CreateAPIKeyRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.AuditEvent.toObject = function(includeInstance, msg) {
   var f, obj = {
-username: jspb.Message.getFieldWithDefault(msg, 1, ""),
-name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-expiresAt: (f = msg.getExpiresAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+time: (f = msg.getTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+actor: jspb.Message.getFieldWithDefault(msg, 3, ""),
+apiKeyId: jspb.Message.getFieldWithDefault(msg, 4, ""),
+rpc: jspb.Message.getFieldWithDefault(msg, 5, ""),
+database: jspb.Message.getFieldWithDefault(msg, 6, ""),
+sql: jspb.Message.getFieldWithDefault(msg, 7, ""),
+parameters: jspb.Message.getFieldWithDefault(msg, 8, ""),
+details: jspb.Message.getFieldWithDefault(msg, 9, ""),
+code: jspb.Message.getFieldWithDefault(msg, 10, ""),
+error: jspb.Message.getFieldWithDefault(msg, 11, ""),
+requestId: jspb.Message.getFieldWithDefault(msg, 12, "")
   };
 
   if (includeInstance) {
@@ -2929,41 +15784,77 @@ expiresAt: (f = msg.getExpiresAt()) && google_protobuf_timestamp_pb.Timestamp.to
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.CreateAPIKeyRequest}
+ * @return {!proto.sqlrpc.v1.AuditEvent}
  */
-proto.sqlrpc.v1.CreateAPIKeyRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.AuditEvent.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.CreateAPIKeyRequest;
-  return proto.sqlrpc.v1.CreateAPIKeyRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.AuditEvent;
+  return proto.sqlrpc.v1.AuditEvent.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.CreateAPIKeyRequest} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.AuditEvent} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.CreateAPIKeyRequest}
+ * @return {!proto.sqlrpc.v1.AuditEvent}
  */
-proto.sqlrpc.v1.CreateAPIKeyRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.AuditEvent.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTime(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setActor(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setApiKeyId(value);
+      break;
+    case 5:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setUsername(value);
+      msg.setRpc(value);
       break;
-    case 2:
+    case 6:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setName(value);
+      msg.setDatabase(value);
       break;
-    case 3:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setExpiresAt(value);
+    case 7:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setSql(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setParameters(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDetails(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setCode(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setError(value);
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setRequestId(value);
       break;
     default:
       reader.skipField();
@@ -2978,9 +15869,9 @@ proto.sqlrpc.v1.CreateAPIKeyRequest.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.AuditEvent.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.CreateAPIKeyRequest.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.AuditEvent.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2988,318 +15879,332 @@ proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.CreateAPIKeyRequest} message
+ * @param {!proto.sqlrpc.v1.AuditEvent} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.CreateAPIKeyRequest.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.AuditEvent.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUsername();
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getTime();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getActor();
   if (f.length > 0) {
     writer.writeString(
-      1,
+      3,
       f
     );
   }
-  f = message.getName();
+  f = message.getApiKeyId();
   if (f.length > 0) {
     writer.writeString(
-      2,
+      4,
       f
     );
   }
-  f = message.getExpiresAt();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+  f = message.getRpc();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getSql();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getParameters();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = message.getDetails();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getCode();
+  if (f.length > 0) {
+    writer.writeString(
+      10,
+      f
+    );
+  }
+  f = message.getError();
+  if (f.length > 0) {
+    writer.writeString(
+      11,
+      f
+    );
+  }
+  f = message.getRequestId();
+  if (f.length > 0) {
+    writer.writeString(
+      12,
+      f
     );
   }
 };
 
 
 /**
- * optional string username = 1;
- * @return {string}
+ * optional int64 id = 1;
+ * @return {number}
  */
-proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.getUsername = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.sqlrpc.v1.AuditEvent.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.CreateAPIKeyRequest} returns this
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.AuditEvent} returns this
  */
-proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.setUsername = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.sqlrpc.v1.AuditEvent.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
- * @return {string}
+ * optional google.protobuf.Timestamp time = 2;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.sqlrpc.v1.AuditEvent.prototype.getTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.CreateAPIKeyRequest} returns this
- */
-proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.sqlrpc.v1.AuditEvent} returns this
+*/
+proto.sqlrpc.v1.AuditEvent.prototype.setTime = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
- * optional google.protobuf.Timestamp expires_at = 3;
- * @return {?proto.google.protobuf.Timestamp}
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.AuditEvent} returns this
  */
-proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.getExpiresAt = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+proto.sqlrpc.v1.AuditEvent.prototype.clearTime = function() {
+  return this.setTime(undefined);
 };
 
 
 /**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.sqlrpc.v1.CreateAPIKeyRequest} returns this
-*/
-proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.setExpiresAt = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.AuditEvent.prototype.hasTime = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.CreateAPIKeyRequest} returns this
+ * optional string actor = 3;
+ * @return {string}
  */
-proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.clearExpiresAt = function() {
-  return this.setExpiresAt(undefined);
+proto.sqlrpc.v1.AuditEvent.prototype.getActor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.AuditEvent} returns this
  */
-proto.sqlrpc.v1.CreateAPIKeyRequest.prototype.hasExpiresAt = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.sqlrpc.v1.AuditEvent.prototype.setActor = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
+/**
+ * optional string api_key_id = 4;
+ * @return {string}
+ */
+proto.sqlrpc.v1.AuditEvent.prototype.getApiKeyId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
 
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.AuditEvent} returns this
+ */
+proto.sqlrpc.v1.AuditEvent.prototype.setApiKeyId = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional string rpc = 5;
+ * @return {string}
  */
-proto.sqlrpc.v1.CreateAPIKeyResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.CreateAPIKeyResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.AuditEvent.prototype.getRpc = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.CreateAPIKeyResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.AuditEvent} returns this
  */
-proto.sqlrpc.v1.CreateAPIKeyResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-apiKey: jspb.Message.getFieldWithDefault(msg, 1, ""),
-keyId: jspb.Message.getFieldWithDefault(msg, 2, ""),
-metadata: (f = msg.getMetadata()) && proto.sqlrpc.v1.APIKey.toObject(includeInstance, f)
-  };
+proto.sqlrpc.v1.AuditEvent.prototype.setRpc = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * optional string database = 6;
+ * @return {string}
+ */
+proto.sqlrpc.v1.AuditEvent.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.CreateAPIKeyResponse}
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.AuditEvent} returns this
  */
-proto.sqlrpc.v1.CreateAPIKeyResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.CreateAPIKeyResponse;
-  return proto.sqlrpc.v1.CreateAPIKeyResponse.deserializeBinaryFromReader(msg, reader);
+proto.sqlrpc.v1.AuditEvent.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.CreateAPIKeyResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.CreateAPIKeyResponse}
+ * optional string sql = 7;
+ * @return {string}
  */
-proto.sqlrpc.v1.CreateAPIKeyResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setApiKey(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setKeyId(value);
-      break;
-    case 3:
-      var value = new proto.sqlrpc.v1.APIKey;
-      reader.readMessage(value,proto.sqlrpc.v1.APIKey.deserializeBinaryFromReader);
-      msg.setMetadata(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.sqlrpc.v1.AuditEvent.prototype.getSql = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.AuditEvent} returns this
+ */
+proto.sqlrpc.v1.AuditEvent.prototype.setSql = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional string parameters = 8;
+ * @return {string}
  */
-proto.sqlrpc.v1.CreateAPIKeyResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.CreateAPIKeyResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.sqlrpc.v1.AuditEvent.prototype.getParameters = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.CreateAPIKeyResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.AuditEvent} returns this
  */
-proto.sqlrpc.v1.CreateAPIKeyResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getApiKey();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getKeyId();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getMetadata();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      proto.sqlrpc.v1.APIKey.serializeBinaryToWriter
-    );
-  }
+proto.sqlrpc.v1.AuditEvent.prototype.setParameters = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
 };
 
 
 /**
- * optional string api_key = 1;
+ * optional string details = 9;
  * @return {string}
  */
-proto.sqlrpc.v1.CreateAPIKeyResponse.prototype.getApiKey = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.sqlrpc.v1.AuditEvent.prototype.getDetails = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.CreateAPIKeyResponse} returns this
+ * @return {!proto.sqlrpc.v1.AuditEvent} returns this
  */
-proto.sqlrpc.v1.CreateAPIKeyResponse.prototype.setApiKey = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.sqlrpc.v1.AuditEvent.prototype.setDetails = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
 };
 
 
 /**
- * optional string key_id = 2;
+ * optional string code = 10;
  * @return {string}
  */
-proto.sqlrpc.v1.CreateAPIKeyResponse.prototype.getKeyId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.sqlrpc.v1.AuditEvent.prototype.getCode = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.CreateAPIKeyResponse} returns this
+ * @return {!proto.sqlrpc.v1.AuditEvent} returns this
  */
-proto.sqlrpc.v1.CreateAPIKeyResponse.prototype.setKeyId = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.sqlrpc.v1.AuditEvent.prototype.setCode = function(value) {
+  return jspb.Message.setProto3StringField(this, 10, value);
 };
 
 
 /**
- * optional APIKey metadata = 3;
- * @return {?proto.sqlrpc.v1.APIKey}
+ * optional string error = 11;
+ * @return {string}
  */
-proto.sqlrpc.v1.CreateAPIKeyResponse.prototype.getMetadata = function() {
-  return /** @type{?proto.sqlrpc.v1.APIKey} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.APIKey, 3));
+proto.sqlrpc.v1.AuditEvent.prototype.getError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.APIKey|undefined} value
- * @return {!proto.sqlrpc.v1.CreateAPIKeyResponse} returns this
-*/
-proto.sqlrpc.v1.CreateAPIKeyResponse.prototype.setMetadata = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.AuditEvent} returns this
+ */
+proto.sqlrpc.v1.AuditEvent.prototype.setError = function(value) {
+  return jspb.Message.setProto3StringField(this, 11, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.CreateAPIKeyResponse} returns this
+ * optional string request_id = 12;
+ * @return {string}
  */
-proto.sqlrpc.v1.CreateAPIKeyResponse.prototype.clearMetadata = function() {
-  return this.setMetadata(undefined);
+proto.sqlrpc.v1.AuditEvent.prototype.getRequestId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.AuditEvent} returns this
  */
-proto.sqlrpc.v1.CreateAPIKeyResponse.prototype.hasMetadata = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.sqlrpc.v1.AuditEvent.prototype.setRequestId = function(value) {
+  return jspb.Message.setProto3StringField(this, 12, value);
 };
 
 
@@ -3319,8 +16224,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.DeleteAPIKeyRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.DeleteAPIKeyRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ListAuditEventsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ListAuditEventsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3329,14 +16234,20 @@ proto.sqlrpc.v1.DeleteAPIKeyRequest.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.DeleteAPIKeyRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.ListAuditEventsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.DeleteAPIKeyRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.ListAuditEventsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-username: jspb.Message.getFieldWithDefault(msg, 1, ""),
-keyId: jspb.Message.getFieldWithDefault(msg, 2, "")
+actor: jspb.Message.getFieldWithDefault(msg, 1, ""),
+database: jspb.Message.getFieldWithDefault(msg, 2, ""),
+rpc: jspb.Message.getFieldWithDefault(msg, 3, ""),
+since: (f = msg.getSince()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+until: (f = msg.getUntil()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+failedOnly: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
+beforeId: jspb.Message.getFieldWithDefault(msg, 7, 0),
+limit: jspb.Message.getFieldWithDefault(msg, 8, 0)
   };
 
   if (includeInstance) {
@@ -3350,23 +16261,23 @@ keyId: jspb.Message.getFieldWithDefault(msg, 2, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.DeleteAPIKeyRequest}
+ * @return {!proto.sqlrpc.v1.ListAuditEventsRequest}
  */
-proto.sqlrpc.v1.DeleteAPIKeyRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.ListAuditEventsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.DeleteAPIKeyRequest;
-  return proto.sqlrpc.v1.DeleteAPIKeyRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.ListAuditEventsRequest;
+  return proto.sqlrpc.v1.ListAuditEventsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.DeleteAPIKeyRequest} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.ListAuditEventsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.DeleteAPIKeyRequest}
+ * @return {!proto.sqlrpc.v1.ListAuditEventsRequest}
  */
-proto.sqlrpc.v1.DeleteAPIKeyRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.ListAuditEventsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3375,11 +16286,37 @@ proto.sqlrpc.v1.DeleteAPIKeyRequest.deserializeBinaryFromReader = function(msg,
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setUsername(value);
+      msg.setActor(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setKeyId(value);
+      msg.setDatabase(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setRpc(value);
+      break;
+    case 4:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setSince(value);
+      break;
+    case 5:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setUntil(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setFailedOnly(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setBeforeId(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLimit(value);
       break;
     default:
       reader.skipField();
@@ -3394,9 +16331,9 @@ proto.sqlrpc.v1.DeleteAPIKeyRequest.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.DeleteAPIKeyRequest.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.ListAuditEventsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.DeleteAPIKeyRequest.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.ListAuditEventsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3404,196 +16341,263 @@ proto.sqlrpc.v1.DeleteAPIKeyRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.DeleteAPIKeyRequest} message
+ * @param {!proto.sqlrpc.v1.ListAuditEventsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.DeleteAPIKeyRequest.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.ListAuditEventsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUsername();
+  f = message.getActor();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getKeyId();
+  f = message.getDatabase();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
+  f = message.getRpc();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getSince();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getUntil();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getFailedOnly();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
+  f = message.getBeforeId();
+  if (f !== 0) {
+    writer.writeInt64(
+      7,
+      f
+    );
+  }
+  f = message.getLimit();
+  if (f !== 0) {
+    writer.writeInt32(
+      8,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string username = 1;
+ * optional string actor = 1;
  * @return {string}
  */
-proto.sqlrpc.v1.DeleteAPIKeyRequest.prototype.getUsername = function() {
+proto.sqlrpc.v1.ListAuditEventsRequest.prototype.getActor = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.DeleteAPIKeyRequest} returns this
+ * @return {!proto.sqlrpc.v1.ListAuditEventsRequest} returns this
  */
-proto.sqlrpc.v1.DeleteAPIKeyRequest.prototype.setUsername = function(value) {
+proto.sqlrpc.v1.ListAuditEventsRequest.prototype.setActor = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string key_id = 2;
+ * optional string database = 2;
  * @return {string}
  */
-proto.sqlrpc.v1.DeleteAPIKeyRequest.prototype.getKeyId = function() {
+proto.sqlrpc.v1.ListAuditEventsRequest.prototype.getDatabase = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.DeleteAPIKeyRequest} returns this
+ * @return {!proto.sqlrpc.v1.ListAuditEventsRequest} returns this
  */
-proto.sqlrpc.v1.DeleteAPIKeyRequest.prototype.setKeyId = function(value) {
+proto.sqlrpc.v1.ListAuditEventsRequest.prototype.setDatabase = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+/**
+ * optional string rpc = 3;
+ * @return {string}
+ */
+proto.sqlrpc.v1.ListAuditEventsRequest.prototype.getRpc = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
 
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ListAuditEventsRequest} returns this
+ */
+proto.sqlrpc.v1.ListAuditEventsRequest.prototype.setRpc = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional google.protobuf.Timestamp since = 4;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.sqlrpc.v1.DeleteAPIKeyResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.DeleteAPIKeyResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ListAuditEventsRequest.prototype.getSince = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.DeleteAPIKeyResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.sqlrpc.v1.ListAuditEventsRequest} returns this
+*/
+proto.sqlrpc.v1.ListAuditEventsRequest.prototype.setSince = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.ListAuditEventsRequest} returns this
  */
-proto.sqlrpc.v1.DeleteAPIKeyResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
-  };
+proto.sqlrpc.v1.ListAuditEventsRequest.prototype.clearSince = function() {
+  return this.setSince(undefined);
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.ListAuditEventsRequest.prototype.hasSince = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.DeleteAPIKeyResponse}
+ * optional google.protobuf.Timestamp until = 5;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.sqlrpc.v1.DeleteAPIKeyResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.DeleteAPIKeyResponse;
-  return proto.sqlrpc.v1.DeleteAPIKeyResponse.deserializeBinaryFromReader(msg, reader);
+proto.sqlrpc.v1.ListAuditEventsRequest.prototype.getUntil = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 5));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.DeleteAPIKeyResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.DeleteAPIKeyResponse}
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.sqlrpc.v1.ListAuditEventsRequest} returns this
+*/
+proto.sqlrpc.v1.ListAuditEventsRequest.prototype.setUntil = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.ListAuditEventsRequest} returns this
+ */
+proto.sqlrpc.v1.ListAuditEventsRequest.prototype.clearUntil = function() {
+  return this.setUntil(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.ListAuditEventsRequest.prototype.hasUntil = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional bool failed_only = 6;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.ListAuditEventsRequest.prototype.getFailedOnly = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.ListAuditEventsRequest} returns this
  */
-proto.sqlrpc.v1.DeleteAPIKeyResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSuccess(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.sqlrpc.v1.ListAuditEventsRequest.prototype.setFailedOnly = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional int64 before_id = 7;
+ * @return {number}
  */
-proto.sqlrpc.v1.DeleteAPIKeyResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.DeleteAPIKeyResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.sqlrpc.v1.ListAuditEventsRequest.prototype.getBeforeId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.DeleteAPIKeyResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.ListAuditEventsRequest} returns this
  */
-proto.sqlrpc.v1.DeleteAPIKeyResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getSuccess();
-  if (f) {
-    writer.writeBool(
-      1,
-      f
-    );
-  }
+proto.sqlrpc.v1.ListAuditEventsRequest.prototype.setBeforeId = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
 };
 
 
 /**
- * optional bool success = 1;
- * @return {boolean}
+ * optional int32 limit = 8;
+ * @return {number}
  */
-proto.sqlrpc.v1.DeleteAPIKeyResponse.prototype.getSuccess = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.sqlrpc.v1.ListAuditEventsRequest.prototype.getLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.sqlrpc.v1.DeleteAPIKeyResponse} returns this
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.ListAuditEventsRequest} returns this
  */
-proto.sqlrpc.v1.DeleteAPIKeyResponse.prototype.setSuccess = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.sqlrpc.v1.ListAuditEventsRequest.prototype.setLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.ListAuditEventsResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -3609,8 +16613,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.ListDatabasesRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.ListDatabasesRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ListAuditEventsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ListAuditEventsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3619,13 +16623,14 @@ proto.sqlrpc.v1.ListDatabasesRequest.prototype.toObject = function(opt_includeIn
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.ListDatabasesRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.ListAuditEventsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.ListDatabasesRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.ListAuditEventsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+eventsList: jspb.Message.toObjectList(msg.getEventsList(),
+    proto.sqlrpc.v1.AuditEvent.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3639,29 +16644,34 @@ proto.sqlrpc.v1.ListDatabasesRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.ListDatabasesRequest}
+ * @return {!proto.sqlrpc.v1.ListAuditEventsResponse}
  */
-proto.sqlrpc.v1.ListDatabasesRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.ListAuditEventsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.ListDatabasesRequest;
-  return proto.sqlrpc.v1.ListDatabasesRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.ListAuditEventsResponse;
+  return proto.sqlrpc.v1.ListAuditEventsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.ListDatabasesRequest} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.ListAuditEventsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.ListDatabasesRequest}
+ * @return {!proto.sqlrpc.v1.ListAuditEventsResponse}
  */
-proto.sqlrpc.v1.ListDatabasesRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.ListAuditEventsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = new proto.sqlrpc.v1.AuditEvent;
+      reader.readMessage(value,proto.sqlrpc.v1.AuditEvent.deserializeBinaryFromReader);
+      msg.addEvents(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -3675,9 +16685,9 @@ proto.sqlrpc.v1.ListDatabasesRequest.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.ListDatabasesRequest.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.ListAuditEventsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.ListDatabasesRequest.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.ListAuditEventsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3685,22 +16695,61 @@ proto.sqlrpc.v1.ListDatabasesRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.ListDatabasesRequest} message
+ * @param {!proto.sqlrpc.v1.ListAuditEventsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.ListDatabasesRequest.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.ListAuditEventsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getEventsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.sqlrpc.v1.AuditEvent.serializeBinaryToWriter
+    );
+  }
 };
 
 
+/**
+ * repeated AuditEvent events = 1;
+ * @return {!Array<!proto.sqlrpc.v1.AuditEvent>}
+ */
+proto.sqlrpc.v1.ListAuditEventsResponse.prototype.getEventsList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.AuditEvent>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.sqlrpc.v1.AuditEvent, 1));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {!Array<!proto.sqlrpc.v1.AuditEvent>} value
+ * @return {!proto.sqlrpc.v1.ListAuditEventsResponse} returns this
+*/
+proto.sqlrpc.v1.ListAuditEventsResponse.prototype.setEventsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.AuditEvent=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.AuditEvent}
  */
-proto.sqlrpc.v1.ListDatabasesResponse.repeatedFields_ = [1];
+proto.sqlrpc.v1.ListAuditEventsResponse.prototype.addEvents = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.sqlrpc.v1.AuditEvent, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.ListAuditEventsResponse} returns this
+ */
+proto.sqlrpc.v1.ListAuditEventsResponse.prototype.clearEventsList = function() {
+  return this.setEventsList([]);
+};
+
+
 
 
 
@@ -3717,8 +16766,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.ListDatabasesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.ListDatabasesResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.GetServerInfoRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.GetServerInfoRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3727,14 +16776,13 @@ proto.sqlrpc.v1.ListDatabasesResponse.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.ListDatabasesResponse} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.GetServerInfoRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.ListDatabasesResponse.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.GetServerInfoRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-databasesList: jspb.Message.toObjectList(msg.getDatabasesList(),
-    sqlrpc_v1_types_pb.DatabaseInfo.toObject, includeInstance)
+
   };
 
   if (includeInstance) {
@@ -3748,34 +16796,29 @@ databasesList: jspb.Message.toObjectList(msg.getDatabasesList(),
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.ListDatabasesResponse}
+ * @return {!proto.sqlrpc.v1.GetServerInfoRequest}
  */
-proto.sqlrpc.v1.ListDatabasesResponse.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.GetServerInfoRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.ListDatabasesResponse;
-  return proto.sqlrpc.v1.ListDatabasesResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.GetServerInfoRequest;
+  return proto.sqlrpc.v1.GetServerInfoRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.ListDatabasesResponse} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.GetServerInfoRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.ListDatabasesResponse}
+ * @return {!proto.sqlrpc.v1.GetServerInfoRequest}
  */
-proto.sqlrpc.v1.ListDatabasesResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.GetServerInfoRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = new sqlrpc_v1_types_pb.DatabaseInfo;
-      reader.readMessage(value,sqlrpc_v1_types_pb.DatabaseInfo.deserializeBinaryFromReader);
-      msg.addDatabases(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -3789,9 +16832,9 @@ proto.sqlrpc.v1.ListDatabasesResponse.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.ListDatabasesResponse.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.GetServerInfoRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.ListDatabasesResponse.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.GetServerInfoRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3799,69 +16842,16 @@ proto.sqlrpc.v1.ListDatabasesResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.ListDatabasesResponse} message
+ * @param {!proto.sqlrpc.v1.GetServerInfoRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.ListDatabasesResponse.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.GetServerInfoRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getDatabasesList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      1,
-      f,
-      sqlrpc_v1_types_pb.DatabaseInfo.serializeBinaryToWriter
-    );
-  }
-};
-
-
-/**
- * repeated DatabaseInfo databases = 1;
- * @return {!Array<!proto.sqlrpc.v1.DatabaseInfo>}
- */
-proto.sqlrpc.v1.ListDatabasesResponse.prototype.getDatabasesList = function() {
-  return /** @type{!Array<!proto.sqlrpc.v1.DatabaseInfo>} */ (
-    jspb.Message.getRepeatedWrapperField(this, sqlrpc_v1_types_pb.DatabaseInfo, 1));
-};
-
-
-/**
- * @param {!Array<!proto.sqlrpc.v1.DatabaseInfo>} value
- * @return {!proto.sqlrpc.v1.ListDatabasesResponse} returns this
-*/
-proto.sqlrpc.v1.ListDatabasesResponse.prototype.setDatabasesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.sqlrpc.v1.DatabaseInfo=} opt_value
- * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.DatabaseInfo}
- */
-proto.sqlrpc.v1.ListDatabasesResponse.prototype.addDatabases = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.sqlrpc.v1.DatabaseInfo, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.ListDatabasesResponse} returns this
- */
-proto.sqlrpc.v1.ListDatabasesResponse.prototype.clearDatabasesList = function() {
-  return this.setDatabasesList([]);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.sqlrpc.v1.CreateDatabaseRequest.repeatedFields_ = [4];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -3877,8 +16867,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.CreateDatabaseRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.CreateDatabaseRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ServerInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ServerInfo.toObject(opt_includeInstance, this);
 };
 
 
@@ -3887,20 +16877,21 @@ proto.sqlrpc.v1.CreateDatabaseRequest.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.CreateDatabaseRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.ServerInfo} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.CreateDatabaseRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.ServerInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
-name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-isEncrypted: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
-key: jspb.Message.getFieldWithDefault(msg, 3, ""),
-extensionsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
-pragmasMap: (f = msg.getPragmasMap()) ? f.toObject(includeInstance, undefined) : [],
-maxOpenConns: jspb.Message.getFieldWithDefault(msg, 6, 0),
-maxIdleConns: jspb.Message.getFieldWithDefault(msg, 7, 0),
-connMaxLifetimeMs: jspb.Message.getFieldWithDefault(msg, 8, 0)
+version: jspb.Message.getFieldWithDefault(msg, 1, ""),
+serverTime: (f = msg.getServerTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+architecture: jspb.Message.getFieldWithDefault(msg, 3, ""),
+goVersion: jspb.Message.getFieldWithDefault(msg, 4, ""),
+sqliteVersion: jspb.Message.getFieldWithDefault(msg, 5, ""),
+databaseCount: jspb.Message.getFieldWithDefault(msg, 6, 0),
+uptimeSeconds: jspb.Message.getFieldWithDefault(msg, 7, 0),
+authDisabled: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
+replication: (f = msg.getReplication()) && proto.sqlrpc.v1.ReplicationStatus.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3914,23 +16905,23 @@ connMaxLifetimeMs: jspb.Message.getFieldWithDefault(msg, 8, 0)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.CreateDatabaseRequest}
+ * @return {!proto.sqlrpc.v1.ServerInfo}
  */
-proto.sqlrpc.v1.CreateDatabaseRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.ServerInfo.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.CreateDatabaseRequest;
-  return proto.sqlrpc.v1.CreateDatabaseRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.ServerInfo;
+  return proto.sqlrpc.v1.ServerInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.CreateDatabaseRequest} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.ServerInfo} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.CreateDatabaseRequest}
+ * @return {!proto.sqlrpc.v1.ServerInfo}
  */
-proto.sqlrpc.v1.CreateDatabaseRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.ServerInfo.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3939,37 +16930,41 @@ proto.sqlrpc.v1.CreateDatabaseRequest.deserializeBinaryFromReader = function(msg
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setName(value);
+      msg.setVersion(value);
       break;
     case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsEncrypted(value);
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setServerTime(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setKey(value);
+      msg.setArchitecture(value);
       break;
     case 4:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.addExtensions(value);
+      msg.setGoVersion(value);
       break;
     case 5:
-      var value = msg.getPragmasMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readStringRequireUtf8, jspb.BinaryReader.prototype.readStringRequireUtf8, null, "", "");
-         });
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setSqliteVersion(value);
       break;
     case 6:
       var value = /** @type {number} */ (reader.readInt32());
-      msg.setMaxOpenConns(value);
+      msg.setDatabaseCount(value);
       break;
     case 7:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setMaxIdleConns(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setUptimeSeconds(value);
       break;
     case 8:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setConnMaxLifetimeMs(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAuthDisabled(value);
+      break;
+    case 9:
+      var value = new proto.sqlrpc.v1.ReplicationStatus;
+      reader.readMessage(value,proto.sqlrpc.v1.ReplicationStatus.deserializeBinaryFromReader);
+      msg.setReplication(value);
       break;
     default:
       reader.skipField();
@@ -3984,9 +16979,9 @@ proto.sqlrpc.v1.CreateDatabaseRequest.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.CreateDatabaseRequest.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.ServerInfo.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.CreateDatabaseRequest.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.ServerInfo.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3994,238 +16989,378 @@ proto.sqlrpc.v1.CreateDatabaseRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.CreateDatabaseRequest} message
+ * @param {!proto.sqlrpc.v1.ServerInfo} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.CreateDatabaseRequest.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.ServerInfo.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getVersion();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getIsEncrypted();
-  if (f) {
-    writer.writeBool(
+  f = message.getServerTime();
+  if (f != null) {
+    writer.writeMessage(
       2,
-      f
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
-  f = message.getKey();
+  f = message.getArchitecture();
   if (f.length > 0) {
     writer.writeString(
       3,
       f
     );
   }
-  f = message.getExtensionsList();
+  f = message.getGoVersion();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeString(
       4,
       f
     );
   }
-  f = message.getPragmasMap(true);
-  if (f && f.getLength() > 0) {
-jspb.internal.public_for_gencode.serializeMapToBinary(
-    message.getPragmasMap(true),
-    5,
-    writer,
-    jspb.BinaryWriter.prototype.writeString,
-    jspb.BinaryWriter.prototype.writeString);
+  f = message.getSqliteVersion();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
   }
-  f = message.getMaxOpenConns();
+  f = message.getDatabaseCount();
   if (f !== 0) {
     writer.writeInt32(
       6,
       f
     );
   }
-  f = message.getMaxIdleConns();
+  f = message.getUptimeSeconds();
   if (f !== 0) {
-    writer.writeInt32(
+    writer.writeInt64(
       7,
       f
     );
   }
-  f = message.getConnMaxLifetimeMs();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getAuthDisabled();
+  if (f) {
+    writer.writeBool(
       8,
       f
     );
   }
+  f = message.getReplication();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      proto.sqlrpc.v1.ReplicationStatus.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional string name = 1;
+ * optional string version = 1;
  * @return {string}
  */
-proto.sqlrpc.v1.CreateDatabaseRequest.prototype.getName = function() {
+proto.sqlrpc.v1.ServerInfo.prototype.getVersion = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.CreateDatabaseRequest} returns this
+ * @return {!proto.sqlrpc.v1.ServerInfo} returns this
  */
-proto.sqlrpc.v1.CreateDatabaseRequest.prototype.setName = function(value) {
+proto.sqlrpc.v1.ServerInfo.prototype.setVersion = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional bool is_encrypted = 2;
- * @return {boolean}
+ * optional google.protobuf.Timestamp server_time = 2;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.sqlrpc.v1.CreateDatabaseRequest.prototype.getIsEncrypted = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+proto.sqlrpc.v1.ServerInfo.prototype.getServerTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.sqlrpc.v1.CreateDatabaseRequest} returns this
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.sqlrpc.v1.ServerInfo} returns this
+*/
+proto.sqlrpc.v1.ServerInfo.prototype.setServerTime = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.ServerInfo} returns this
  */
-proto.sqlrpc.v1.CreateDatabaseRequest.prototype.setIsEncrypted = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
+proto.sqlrpc.v1.ServerInfo.prototype.clearServerTime = function() {
+  return this.setServerTime(undefined);
 };
 
 
 /**
- * optional string key = 3;
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.ServerInfo.prototype.hasServerTime = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional string architecture = 3;
  * @return {string}
  */
-proto.sqlrpc.v1.CreateDatabaseRequest.prototype.getKey = function() {
+proto.sqlrpc.v1.ServerInfo.prototype.getArchitecture = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.CreateDatabaseRequest} returns this
+ * @return {!proto.sqlrpc.v1.ServerInfo} returns this
  */
-proto.sqlrpc.v1.CreateDatabaseRequest.prototype.setKey = function(value) {
+proto.sqlrpc.v1.ServerInfo.prototype.setArchitecture = function(value) {
   return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * repeated string extensions = 4;
- * @return {!Array<string>}
+ * optional string go_version = 4;
+ * @return {string}
  */
-proto.sqlrpc.v1.CreateDatabaseRequest.prototype.getExtensionsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+proto.sqlrpc.v1.ServerInfo.prototype.getGoVersion = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.sqlrpc.v1.CreateDatabaseRequest} returns this
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ServerInfo} returns this
  */
-proto.sqlrpc.v1.CreateDatabaseRequest.prototype.setExtensionsList = function(value) {
-  return jspb.Message.setField(this, 4, value || []);
+proto.sqlrpc.v1.ServerInfo.prototype.setGoVersion = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.CreateDatabaseRequest} returns this
+ * optional string sqlite_version = 5;
+ * @return {string}
  */
-proto.sqlrpc.v1.CreateDatabaseRequest.prototype.addExtensions = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+proto.sqlrpc.v1.ServerInfo.prototype.getSqliteVersion = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.CreateDatabaseRequest} returns this
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ServerInfo} returns this
  */
-proto.sqlrpc.v1.CreateDatabaseRequest.prototype.clearExtensionsList = function() {
-  return this.setExtensionsList([]);
+proto.sqlrpc.v1.ServerInfo.prototype.setSqliteVersion = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * map<string, string> pragmas = 5;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * optional int32 database_count = 6;
+ * @return {number}
  */
-proto.sqlrpc.v1.CreateDatabaseRequest.prototype.getPragmasMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 5, opt_noLazyCreate,
-      null));
+proto.sqlrpc.v1.ServerInfo.prototype.getDatabaseCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.sqlrpc.v1.CreateDatabaseRequest} returns this
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.ServerInfo} returns this
  */
-proto.sqlrpc.v1.CreateDatabaseRequest.prototype.clearPragmasMap = function() {
-  this.getPragmasMap().clear();
-  return this;
+proto.sqlrpc.v1.ServerInfo.prototype.setDatabaseCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
 };
 
 
 /**
- * optional int32 max_open_conns = 6;
+ * optional int64 uptime_seconds = 7;
  * @return {number}
  */
-proto.sqlrpc.v1.CreateDatabaseRequest.prototype.getMaxOpenConns = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.sqlrpc.v1.ServerInfo.prototype.getUptimeSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.sqlrpc.v1.CreateDatabaseRequest} returns this
+ * @return {!proto.sqlrpc.v1.ServerInfo} returns this
  */
-proto.sqlrpc.v1.CreateDatabaseRequest.prototype.setMaxOpenConns = function(value) {
-  return jspb.Message.setProto3IntField(this, 6, value);
+proto.sqlrpc.v1.ServerInfo.prototype.setUptimeSeconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional bool auth_disabled = 8;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.ServerInfo.prototype.getAuthDisabled = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.ServerInfo} returns this
+ */
+proto.sqlrpc.v1.ServerInfo.prototype.setAuthDisabled = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 8, value);
+};
+
+
+/**
+ * optional ReplicationStatus replication = 9;
+ * @return {?proto.sqlrpc.v1.ReplicationStatus}
+ */
+proto.sqlrpc.v1.ServerInfo.prototype.getReplication = function() {
+  return /** @type{?proto.sqlrpc.v1.ReplicationStatus} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.ReplicationStatus, 9));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.ReplicationStatus|undefined} value
+ * @return {!proto.sqlrpc.v1.ServerInfo} returns this
+*/
+proto.sqlrpc.v1.ServerInfo.prototype.setReplication = function(value) {
+  return jspb.Message.setWrapperField(this, 9, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.ServerInfo} returns this
+ */
+proto.sqlrpc.v1.ServerInfo.prototype.clearReplication = function() {
+  return this.setReplication(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.ServerInfo.prototype.hasReplication = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.GetLogLevelsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.GetLogLevelsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.GetLogLevelsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.GetLogLevelsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional int32 max_idle_conns = 7;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.GetLogLevelsRequest}
  */
-proto.sqlrpc.v1.CreateDatabaseRequest.prototype.getMaxIdleConns = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.sqlrpc.v1.GetLogLevelsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.GetLogLevelsRequest;
+  return proto.sqlrpc.v1.GetLogLevelsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.sqlrpc.v1.CreateDatabaseRequest} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.GetLogLevelsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.GetLogLevelsRequest}
  */
-proto.sqlrpc.v1.CreateDatabaseRequest.prototype.setMaxIdleConns = function(value) {
-  return jspb.Message.setProto3IntField(this, 7, value);
+proto.sqlrpc.v1.GetLogLevelsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional int32 conn_max_lifetime_ms = 8;
- * @return {number}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.CreateDatabaseRequest.prototype.getConnMaxLifetimeMs = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+proto.sqlrpc.v1.GetLogLevelsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.GetLogLevelsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.sqlrpc.v1.CreateDatabaseRequest} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.GetLogLevelsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.CreateDatabaseRequest.prototype.setConnMaxLifetimeMs = function(value) {
-  return jspb.Message.setProto3IntField(this, 8, value);
+proto.sqlrpc.v1.GetLogLevelsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
 };
 
 
@@ -4245,8 +17380,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.CreateDatabaseResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.CreateDatabaseResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.SubsystemLogLevel.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.SubsystemLogLevel.toObject(opt_includeInstance, this);
 };
 
 
@@ -4255,14 +17390,15 @@ proto.sqlrpc.v1.CreateDatabaseResponse.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.CreateDatabaseResponse} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.SubsystemLogLevel} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.CreateDatabaseResponse.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.SubsystemLogLevel.toObject = function(includeInstance, msg) {
   var f, obj = {
-success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
-message: jspb.Message.getFieldWithDefault(msg, 2, "")
+subsystem: jspb.Message.getFieldWithDefault(msg, 1, ""),
+level: jspb.Message.getFieldWithDefault(msg, 2, 0),
+overridden: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -4276,23 +17412,23 @@ message: jspb.Message.getFieldWithDefault(msg, 2, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.CreateDatabaseResponse}
+ * @return {!proto.sqlrpc.v1.SubsystemLogLevel}
  */
-proto.sqlrpc.v1.CreateDatabaseResponse.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.SubsystemLogLevel.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.CreateDatabaseResponse;
-  return proto.sqlrpc.v1.CreateDatabaseResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.SubsystemLogLevel;
+  return proto.sqlrpc.v1.SubsystemLogLevel.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.CreateDatabaseResponse} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.SubsystemLogLevel} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.CreateDatabaseResponse}
+ * @return {!proto.sqlrpc.v1.SubsystemLogLevel}
  */
-proto.sqlrpc.v1.CreateDatabaseResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.SubsystemLogLevel.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4300,12 +17436,16 @@ proto.sqlrpc.v1.CreateDatabaseResponse.deserializeBinaryFromReader = function(ms
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSuccess(value);
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setSubsystem(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setMessage(value);
+      var value = /** @type {!proto.sqlrpc.v1.LogLevel} */ (reader.readEnum());
+      msg.setLevel(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setOverridden(value);
       break;
     default:
       reader.skipField();
@@ -4320,9 +17460,9 @@ proto.sqlrpc.v1.CreateDatabaseResponse.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.CreateDatabaseResponse.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.SubsystemLogLevel.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.CreateDatabaseResponse.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.SubsystemLogLevel.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4330,66 +17470,98 @@ proto.sqlrpc.v1.CreateDatabaseResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.CreateDatabaseResponse} message
+ * @param {!proto.sqlrpc.v1.SubsystemLogLevel} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.CreateDatabaseResponse.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.SubsystemLogLevel.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSuccess();
-  if (f) {
-    writer.writeBool(
+  f = message.getSubsystem();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getMessage();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getLevel();
+  if (f !== 0.0) {
+    writer.writeEnum(
       2,
       f
     );
   }
+  f = message.getOverridden();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional bool success = 1;
- * @return {boolean}
+ * optional string subsystem = 1;
+ * @return {string}
  */
-proto.sqlrpc.v1.CreateDatabaseResponse.prototype.getSuccess = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.sqlrpc.v1.SubsystemLogLevel.prototype.getSubsystem = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.sqlrpc.v1.CreateDatabaseResponse} returns this
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.SubsystemLogLevel} returns this
  */
-proto.sqlrpc.v1.CreateDatabaseResponse.prototype.setSuccess = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.sqlrpc.v1.SubsystemLogLevel.prototype.setSubsystem = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string message = 2;
- * @return {string}
+ * optional LogLevel level = 2;
+ * @return {!proto.sqlrpc.v1.LogLevel}
  */
-proto.sqlrpc.v1.CreateDatabaseResponse.prototype.getMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.sqlrpc.v1.SubsystemLogLevel.prototype.getLevel = function() {
+  return /** @type {!proto.sqlrpc.v1.LogLevel} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.CreateDatabaseResponse} returns this
+ * @param {!proto.sqlrpc.v1.LogLevel} value
+ * @return {!proto.sqlrpc.v1.SubsystemLogLevel} returns this
  */
-proto.sqlrpc.v1.CreateDatabaseResponse.prototype.setMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.sqlrpc.v1.SubsystemLogLevel.prototype.setLevel = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * optional bool overridden = 3;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.SubsystemLogLevel.prototype.getOverridden = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.SubsystemLogLevel} returns this
+ */
+proto.sqlrpc.v1.SubsystemLogLevel.prototype.setOverridden = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.GetLogLevelsResponse.repeatedFields_ = [2];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -4405,8 +17577,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.UpdateDatabaseRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.UpdateDatabaseRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.GetLogLevelsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.GetLogLevelsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -4415,14 +17587,15 @@ proto.sqlrpc.v1.UpdateDatabaseRequest.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.UpdateDatabaseRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.GetLogLevelsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.UpdateDatabaseRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.GetLogLevelsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-config: (f = msg.getConfig()) && sqlrpc_v1_types_pb.UpdateDatabaseConfig.toObject(includeInstance, f)
+defaultLevel: jspb.Message.getFieldWithDefault(msg, 1, 0),
+subsystemsList: jspb.Message.toObjectList(msg.getSubsystemsList(),
+    proto.sqlrpc.v1.SubsystemLogLevel.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -4436,23 +17609,23 @@ config: (f = msg.getConfig()) && sqlrpc_v1_types_pb.UpdateDatabaseConfig.toObjec
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.UpdateDatabaseRequest}
+ * @return {!proto.sqlrpc.v1.GetLogLevelsResponse}
  */
-proto.sqlrpc.v1.UpdateDatabaseRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.GetLogLevelsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.UpdateDatabaseRequest;
-  return proto.sqlrpc.v1.UpdateDatabaseRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.GetLogLevelsResponse;
+  return proto.sqlrpc.v1.GetLogLevelsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.UpdateDatabaseRequest} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.GetLogLevelsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.UpdateDatabaseRequest}
+ * @return {!proto.sqlrpc.v1.GetLogLevelsResponse}
  */
-proto.sqlrpc.v1.UpdateDatabaseRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.GetLogLevelsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4460,13 +17633,13 @@ proto.sqlrpc.v1.UpdateDatabaseRequest.deserializeBinaryFromReader = function(msg
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setName(value);
+      var value = /** @type {!proto.sqlrpc.v1.LogLevel} */ (reader.readEnum());
+      msg.setDefaultLevel(value);
       break;
     case 2:
-      var value = new sqlrpc_v1_types_pb.UpdateDatabaseConfig;
-      reader.readMessage(value,sqlrpc_v1_types_pb.UpdateDatabaseConfig.deserializeBinaryFromReader);
-      msg.setConfig(value);
+      var value = new proto.sqlrpc.v1.SubsystemLogLevel;
+      reader.readMessage(value,proto.sqlrpc.v1.SubsystemLogLevel.deserializeBinaryFromReader);
+      msg.addSubsystems(value);
       break;
     default:
       reader.skipField();
@@ -4481,9 +17654,9 @@ proto.sqlrpc.v1.UpdateDatabaseRequest.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.UpdateDatabaseRequest.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.GetLogLevelsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.UpdateDatabaseRequest.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.GetLogLevelsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4491,82 +17664,83 @@ proto.sqlrpc.v1.UpdateDatabaseRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.UpdateDatabaseRequest} message
+ * @param {!proto.sqlrpc.v1.GetLogLevelsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.UpdateDatabaseRequest.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.GetLogLevelsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getDefaultLevel();
+  if (f !== 0.0) {
+    writer.writeEnum(
       1,
       f
     );
   }
-  f = message.getConfig();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getSubsystemsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       2,
       f,
-      sqlrpc_v1_types_pb.UpdateDatabaseConfig.serializeBinaryToWriter
+      proto.sqlrpc.v1.SubsystemLogLevel.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string name = 1;
- * @return {string}
+ * optional LogLevel default_level = 1;
+ * @return {!proto.sqlrpc.v1.LogLevel}
  */
-proto.sqlrpc.v1.UpdateDatabaseRequest.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.sqlrpc.v1.GetLogLevelsResponse.prototype.getDefaultLevel = function() {
+  return /** @type {!proto.sqlrpc.v1.LogLevel} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.UpdateDatabaseRequest} returns this
+ * @param {!proto.sqlrpc.v1.LogLevel} value
+ * @return {!proto.sqlrpc.v1.GetLogLevelsResponse} returns this
  */
-proto.sqlrpc.v1.UpdateDatabaseRequest.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.sqlrpc.v1.GetLogLevelsResponse.prototype.setDefaultLevel = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
 };
 
 
 /**
- * optional UpdateDatabaseConfig config = 2;
- * @return {?proto.sqlrpc.v1.UpdateDatabaseConfig}
+ * repeated SubsystemLogLevel subsystems = 2;
+ * @return {!Array<!proto.sqlrpc.v1.SubsystemLogLevel>}
  */
-proto.sqlrpc.v1.UpdateDatabaseRequest.prototype.getConfig = function() {
-  return /** @type{?proto.sqlrpc.v1.UpdateDatabaseConfig} */ (
-    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.UpdateDatabaseConfig, 2));
+proto.sqlrpc.v1.GetLogLevelsResponse.prototype.getSubsystemsList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.SubsystemLogLevel>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.sqlrpc.v1.SubsystemLogLevel, 2));
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.UpdateDatabaseConfig|undefined} value
- * @return {!proto.sqlrpc.v1.UpdateDatabaseRequest} returns this
+ * @param {!Array<!proto.sqlrpc.v1.SubsystemLogLevel>} value
+ * @return {!proto.sqlrpc.v1.GetLogLevelsResponse} returns this
 */
-proto.sqlrpc.v1.UpdateDatabaseRequest.prototype.setConfig = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+proto.sqlrpc.v1.GetLogLevelsResponse.prototype.setSubsystemsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.UpdateDatabaseRequest} returns this
+ * @param {!proto.sqlrpc.v1.SubsystemLogLevel=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.SubsystemLogLevel}
  */
-proto.sqlrpc.v1.UpdateDatabaseRequest.prototype.clearConfig = function() {
-  return this.setConfig(undefined);
+proto.sqlrpc.v1.GetLogLevelsResponse.prototype.addSubsystems = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.sqlrpc.v1.SubsystemLogLevel, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.GetLogLevelsResponse} returns this
  */
-proto.sqlrpc.v1.UpdateDatabaseRequest.prototype.hasConfig = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.sqlrpc.v1.GetLogLevelsResponse.prototype.clearSubsystemsList = function() {
+  return this.setSubsystemsList([]);
 };
 
 
@@ -4586,8 +17760,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.UpdateDatabaseResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.UpdateDatabaseResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.SetLogLevelRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.SetLogLevelRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4596,14 +17770,14 @@ proto.sqlrpc.v1.UpdateDatabaseResponse.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.UpdateDatabaseResponse} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.SetLogLevelRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.UpdateDatabaseResponse.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.SetLogLevelRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
-message: jspb.Message.getFieldWithDefault(msg, 2, "")
+subsystem: jspb.Message.getFieldWithDefault(msg, 1, ""),
+level: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -4617,23 +17791,23 @@ message: jspb.Message.getFieldWithDefault(msg, 2, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.UpdateDatabaseResponse}
+ * @return {!proto.sqlrpc.v1.SetLogLevelRequest}
  */
-proto.sqlrpc.v1.UpdateDatabaseResponse.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.SetLogLevelRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.UpdateDatabaseResponse;
-  return proto.sqlrpc.v1.UpdateDatabaseResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.SetLogLevelRequest;
+  return proto.sqlrpc.v1.SetLogLevelRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.UpdateDatabaseResponse} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.SetLogLevelRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.UpdateDatabaseResponse}
+ * @return {!proto.sqlrpc.v1.SetLogLevelRequest}
  */
-proto.sqlrpc.v1.UpdateDatabaseResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.SetLogLevelRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4641,12 +17815,12 @@ proto.sqlrpc.v1.UpdateDatabaseResponse.deserializeBinaryFromReader = function(ms
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSuccess(value);
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setSubsystem(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setMessage(value);
+      var value = /** @type {!proto.sqlrpc.v1.LogLevel} */ (reader.readEnum());
+      msg.setLevel(value);
       break;
     default:
       reader.skipField();
@@ -4661,9 +17835,9 @@ proto.sqlrpc.v1.UpdateDatabaseResponse.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.UpdateDatabaseResponse.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.SetLogLevelRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.UpdateDatabaseResponse.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.SetLogLevelRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4671,22 +17845,22 @@ proto.sqlrpc.v1.UpdateDatabaseResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.UpdateDatabaseResponse} message
+ * @param {!proto.sqlrpc.v1.SetLogLevelRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.UpdateDatabaseResponse.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.SetLogLevelRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSuccess();
-  if (f) {
-    writer.writeBool(
+  f = message.getSubsystem();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getMessage();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getLevel();
+  if (f !== 0.0) {
+    writer.writeEnum(
       2,
       f
     );
@@ -4695,42 +17869,49 @@ proto.sqlrpc.v1.UpdateDatabaseResponse.serializeBinaryToWriter = function(messag
 
 
 /**
- * optional bool success = 1;
- * @return {boolean}
+ * optional string subsystem = 1;
+ * @return {string}
  */
-proto.sqlrpc.v1.UpdateDatabaseResponse.prototype.getSuccess = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.sqlrpc.v1.SetLogLevelRequest.prototype.getSubsystem = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.sqlrpc.v1.UpdateDatabaseResponse} returns this
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.SetLogLevelRequest} returns this
  */
-proto.sqlrpc.v1.UpdateDatabaseResponse.prototype.setSuccess = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.sqlrpc.v1.SetLogLevelRequest.prototype.setSubsystem = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string message = 2;
- * @return {string}
+ * optional LogLevel level = 2;
+ * @return {!proto.sqlrpc.v1.LogLevel}
  */
-proto.sqlrpc.v1.UpdateDatabaseResponse.prototype.getMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.sqlrpc.v1.SetLogLevelRequest.prototype.getLevel = function() {
+  return /** @type {!proto.sqlrpc.v1.LogLevel} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.UpdateDatabaseResponse} returns this
+ * @param {!proto.sqlrpc.v1.LogLevel} value
+ * @return {!proto.sqlrpc.v1.SetLogLevelRequest} returns this
  */
-proto.sqlrpc.v1.UpdateDatabaseResponse.prototype.setMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.sqlrpc.v1.SetLogLevelRequest.prototype.setLevel = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.SetLogLevelResponse.repeatedFields_ = [2];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -4746,8 +17927,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.DeleteDatabaseRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.DeleteDatabaseRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.SetLogLevelResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.SetLogLevelResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -4756,13 +17937,15 @@ proto.sqlrpc.v1.DeleteDatabaseRequest.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.DeleteDatabaseRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.SetLogLevelResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.DeleteDatabaseRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.SetLogLevelResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-name: jspb.Message.getFieldWithDefault(msg, 1, "")
+defaultLevel: jspb.Message.getFieldWithDefault(msg, 1, 0),
+subsystemsList: jspb.Message.toObjectList(msg.getSubsystemsList(),
+    proto.sqlrpc.v1.SubsystemLogLevel.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -4776,23 +17959,23 @@ name: jspb.Message.getFieldWithDefault(msg, 1, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.DeleteDatabaseRequest}
+ * @return {!proto.sqlrpc.v1.SetLogLevelResponse}
  */
-proto.sqlrpc.v1.DeleteDatabaseRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.SetLogLevelResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.DeleteDatabaseRequest;
-  return proto.sqlrpc.v1.DeleteDatabaseRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.SetLogLevelResponse;
+  return proto.sqlrpc.v1.SetLogLevelResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.DeleteDatabaseRequest} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.SetLogLevelResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.DeleteDatabaseRequest}
+ * @return {!proto.sqlrpc.v1.SetLogLevelResponse}
  */
-proto.sqlrpc.v1.DeleteDatabaseRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.SetLogLevelResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4800,8 +17983,13 @@ proto.sqlrpc.v1.DeleteDatabaseRequest.deserializeBinaryFromReader = function(msg
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setName(value);
+      var value = /** @type {!proto.sqlrpc.v1.LogLevel} */ (reader.readEnum());
+      msg.setDefaultLevel(value);
+      break;
+    case 2:
+      var value = new proto.sqlrpc.v1.SubsystemLogLevel;
+      reader.readMessage(value,proto.sqlrpc.v1.SubsystemLogLevel.deserializeBinaryFromReader);
+      msg.addSubsystems(value);
       break;
     default:
       reader.skipField();
@@ -4816,9 +18004,9 @@ proto.sqlrpc.v1.DeleteDatabaseRequest.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.DeleteDatabaseRequest.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.SetLogLevelResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.DeleteDatabaseRequest.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.SetLogLevelResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4826,37 +18014,83 @@ proto.sqlrpc.v1.DeleteDatabaseRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.DeleteDatabaseRequest} message
+ * @param {!proto.sqlrpc.v1.SetLogLevelResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.DeleteDatabaseRequest.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.SetLogLevelResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getDefaultLevel();
+  if (f !== 0.0) {
+    writer.writeEnum(
       1,
       f
     );
   }
+  f = message.getSubsystemsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.sqlrpc.v1.SubsystemLogLevel.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional string name = 1;
- * @return {string}
+ * optional LogLevel default_level = 1;
+ * @return {!proto.sqlrpc.v1.LogLevel}
  */
-proto.sqlrpc.v1.DeleteDatabaseRequest.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.sqlrpc.v1.SetLogLevelResponse.prototype.getDefaultLevel = function() {
+  return /** @type {!proto.sqlrpc.v1.LogLevel} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.DeleteDatabaseRequest} returns this
+ * @param {!proto.sqlrpc.v1.LogLevel} value
+ * @return {!proto.sqlrpc.v1.SetLogLevelResponse} returns this
  */
-proto.sqlrpc.v1.DeleteDatabaseRequest.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.sqlrpc.v1.SetLogLevelResponse.prototype.setDefaultLevel = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * repeated SubsystemLogLevel subsystems = 2;
+ * @return {!Array<!proto.sqlrpc.v1.SubsystemLogLevel>}
+ */
+proto.sqlrpc.v1.SetLogLevelResponse.prototype.getSubsystemsList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.SubsystemLogLevel>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.sqlrpc.v1.SubsystemLogLevel, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.sqlrpc.v1.SubsystemLogLevel>} value
+ * @return {!proto.sqlrpc.v1.SetLogLevelResponse} returns this
+*/
+proto.sqlrpc.v1.SetLogLevelResponse.prototype.setSubsystemsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.SubsystemLogLevel=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.SubsystemLogLevel}
+ */
+proto.sqlrpc.v1.SetLogLevelResponse.prototype.addSubsystems = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.sqlrpc.v1.SubsystemLogLevel, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.SetLogLevelResponse} returns this
+ */
+proto.sqlrpc.v1.SetLogLevelResponse.prototype.clearSubsystemsList = function() {
+  return this.setSubsystemsList([]);
 };
 
 
@@ -4876,8 +18110,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.DeleteDatabaseResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.DeleteDatabaseResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.LoginRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.LoginRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4886,14 +18120,15 @@ proto.sqlrpc.v1.DeleteDatabaseResponse.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.DeleteDatabaseResponse} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.LoginRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.DeleteDatabaseResponse.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.LoginRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
-message: jspb.Message.getFieldWithDefault(msg, 2, "")
+username: jspb.Message.getFieldWithDefault(msg, 1, ""),
+password: jspb.Message.getFieldWithDefault(msg, 2, ""),
+sessionDuration: (f = msg.getSessionDuration()) && google_protobuf_duration_pb.Duration.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4907,23 +18142,23 @@ message: jspb.Message.getFieldWithDefault(msg, 2, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.DeleteDatabaseResponse}
+ * @return {!proto.sqlrpc.v1.LoginRequest}
  */
-proto.sqlrpc.v1.DeleteDatabaseResponse.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.LoginRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.DeleteDatabaseResponse;
-  return proto.sqlrpc.v1.DeleteDatabaseResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.LoginRequest;
+  return proto.sqlrpc.v1.LoginRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.DeleteDatabaseResponse} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.LoginRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.DeleteDatabaseResponse}
+ * @return {!proto.sqlrpc.v1.LoginRequest}
  */
-proto.sqlrpc.v1.DeleteDatabaseResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.LoginRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4931,12 +18166,17 @@ proto.sqlrpc.v1.DeleteDatabaseResponse.deserializeBinaryFromReader = function(ms
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSuccess(value);
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setUsername(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setMessage(value);
+      msg.setPassword(value);
+      break;
+    case 3:
+      var value = new google_protobuf_duration_pb.Duration;
+      reader.readMessage(value,google_protobuf_duration_pb.Duration.deserializeBinaryFromReader);
+      msg.setSessionDuration(value);
       break;
     default:
       reader.skipField();
@@ -4951,9 +18191,9 @@ proto.sqlrpc.v1.DeleteDatabaseResponse.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.DeleteDatabaseResponse.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.LoginRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.DeleteDatabaseResponse.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.LoginRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4961,72 +18201,110 @@ proto.sqlrpc.v1.DeleteDatabaseResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.DeleteDatabaseResponse} message
+ * @param {!proto.sqlrpc.v1.LoginRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.DeleteDatabaseResponse.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.LoginRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSuccess();
-  if (f) {
-    writer.writeBool(
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getMessage();
+  f = message.getPassword();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
+  f = message.getSessionDuration();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_duration_pb.Duration.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional bool success = 1;
- * @return {boolean}
+ * optional string username = 1;
+ * @return {string}
  */
-proto.sqlrpc.v1.DeleteDatabaseResponse.prototype.getSuccess = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.sqlrpc.v1.LoginRequest.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.sqlrpc.v1.DeleteDatabaseResponse} returns this
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.LoginRequest} returns this
  */
-proto.sqlrpc.v1.DeleteDatabaseResponse.prototype.setSuccess = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.sqlrpc.v1.LoginRequest.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string message = 2;
+ * optional string password = 2;
  * @return {string}
  */
-proto.sqlrpc.v1.DeleteDatabaseResponse.prototype.getMessage = function() {
+proto.sqlrpc.v1.LoginRequest.prototype.getPassword = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.DeleteDatabaseResponse} returns this
+ * @return {!proto.sqlrpc.v1.LoginRequest} returns this
  */
-proto.sqlrpc.v1.DeleteDatabaseResponse.prototype.setMessage = function(value) {
+proto.sqlrpc.v1.LoginRequest.prototype.setPassword = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+/**
+ * optional google.protobuf.Duration session_duration = 3;
+ * @return {?proto.google.protobuf.Duration}
+ */
+proto.sqlrpc.v1.LoginRequest.prototype.getSessionDuration = function() {
+  return /** @type{?proto.google.protobuf.Duration} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_duration_pb.Duration, 3));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {?proto.google.protobuf.Duration|undefined} value
+ * @return {!proto.sqlrpc.v1.LoginRequest} returns this
+*/
+proto.sqlrpc.v1.LoginRequest.prototype.setSessionDuration = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.LoginRequest} returns this
  */
-proto.sqlrpc.v1.MountDatabaseRequest.repeatedFields_ = [6];
+proto.sqlrpc.v1.LoginRequest.prototype.clearSessionDuration = function() {
+  return this.setSessionDuration(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.LoginRequest.prototype.hasSessionDuration = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
 
 
 
@@ -5043,8 +18321,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.MountDatabaseRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.MountDatabaseRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.LoginResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.LoginResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -5053,22 +18331,16 @@ proto.sqlrpc.v1.MountDatabaseRequest.prototype.toObject = function(opt_includeIn
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.MountDatabaseRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.LoginResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.MountDatabaseRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.LoginResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-path: jspb.Message.getFieldWithDefault(msg, 2, ""),
-readOnly: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-isEncrypted: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
-key: jspb.Message.getFieldWithDefault(msg, 5, ""),
-extensionsList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
-pragmasMap: (f = msg.getPragmasMap()) ? f.toObject(includeInstance, undefined) : [],
-maxOpenConns: jspb.Message.getFieldWithDefault(msg, 8, 0),
-maxIdleConns: jspb.Message.getFieldWithDefault(msg, 9, 0),
-connMaxLifetimeMs: jspb.Message.getFieldWithDefault(msg, 10, 0)
+apiKey: jspb.Message.getFieldWithDefault(msg, 1, ""),
+keyId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+expiresAt: (f = msg.getExpiresAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+user: (f = msg.getUser()) && sqlrpc_v1_types_pb.User.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -5082,23 +18354,23 @@ connMaxLifetimeMs: jspb.Message.getFieldWithDefault(msg, 10, 0)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.MountDatabaseRequest}
+ * @return {!proto.sqlrpc.v1.LoginResponse}
  */
-proto.sqlrpc.v1.MountDatabaseRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.LoginResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.MountDatabaseRequest;
-  return proto.sqlrpc.v1.MountDatabaseRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.LoginResponse;
+  return proto.sqlrpc.v1.LoginResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.MountDatabaseRequest} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.LoginResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.MountDatabaseRequest}
+ * @return {!proto.sqlrpc.v1.LoginResponse}
  */
-proto.sqlrpc.v1.MountDatabaseRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.LoginResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5107,45 +18379,21 @@ proto.sqlrpc.v1.MountDatabaseRequest.deserializeBinaryFromReader = function(msg,
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setName(value);
+      msg.setApiKey(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setPath(value);
+      msg.setKeyId(value);
       break;
     case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setReadOnly(value);
-      break;
-    case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsEncrypted(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setKey(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.addExtensions(value);
-      break;
-    case 7:
-      var value = msg.getPragmasMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readStringRequireUtf8, jspb.BinaryReader.prototype.readStringRequireUtf8, null, "", "");
-         });
-      break;
-    case 8:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setMaxOpenConns(value);
-      break;
-    case 9:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setMaxIdleConns(value);
-      break;
-    case 10:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setConnMaxLifetimeMs(value);
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setExpiresAt(value);
+      break;
+    case 4:
+      var value = new sqlrpc_v1_types_pb.User;
+      reader.readMessage(value,sqlrpc_v1_types_pb.User.deserializeBinaryFromReader);
+      msg.setUser(value);
       break;
     default:
       reader.skipField();
@@ -5160,9 +18408,9 @@ proto.sqlrpc.v1.MountDatabaseRequest.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.MountDatabaseRequest.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.LoginResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.MountDatabaseRequest.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.LoginResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5170,288 +18418,152 @@ proto.sqlrpc.v1.MountDatabaseRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.MountDatabaseRequest} message
+ * @param {!proto.sqlrpc.v1.LoginResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.MountDatabaseRequest.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.LoginResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getApiKey();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getPath();
+  f = message.getKeyId();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getReadOnly();
-  if (f) {
-    writer.writeBool(
+  f = message.getExpiresAt();
+  if (f != null) {
+    writer.writeMessage(
       3,
-      f
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
-  f = message.getIsEncrypted();
-  if (f) {
-    writer.writeBool(
+  f = message.getUser();
+  if (f != null) {
+    writer.writeMessage(
       4,
-      f
-    );
-  }
-  f = message.getKey();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-  f = message.getExtensionsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      6,
-      f
-    );
-  }
-  f = message.getPragmasMap(true);
-  if (f && f.getLength() > 0) {
-jspb.internal.public_for_gencode.serializeMapToBinary(
-    message.getPragmasMap(true),
-    7,
-    writer,
-    jspb.BinaryWriter.prototype.writeString,
-    jspb.BinaryWriter.prototype.writeString);
-  }
-  f = message.getMaxOpenConns();
-  if (f !== 0) {
-    writer.writeInt32(
-      8,
-      f
-    );
-  }
-  f = message.getMaxIdleConns();
-  if (f !== 0) {
-    writer.writeInt32(
-      9,
-      f
-    );
-  }
-  f = message.getConnMaxLifetimeMs();
-  if (f !== 0) {
-    writer.writeInt32(
-      10,
-      f
+      f,
+      sqlrpc_v1_types_pb.User.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string name = 1;
+ * optional string api_key = 1;
  * @return {string}
  */
-proto.sqlrpc.v1.MountDatabaseRequest.prototype.getName = function() {
+proto.sqlrpc.v1.LoginResponse.prototype.getApiKey = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
+ * @return {!proto.sqlrpc.v1.LoginResponse} returns this
  */
-proto.sqlrpc.v1.MountDatabaseRequest.prototype.setName = function(value) {
+proto.sqlrpc.v1.LoginResponse.prototype.setApiKey = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string path = 2;
+ * optional string key_id = 2;
  * @return {string}
  */
-proto.sqlrpc.v1.MountDatabaseRequest.prototype.getPath = function() {
+proto.sqlrpc.v1.LoginResponse.prototype.getKeyId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
+ * @return {!proto.sqlrpc.v1.LoginResponse} returns this
  */
-proto.sqlrpc.v1.MountDatabaseRequest.prototype.setPath = function(value) {
+proto.sqlrpc.v1.LoginResponse.prototype.setKeyId = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional bool read_only = 3;
- * @return {boolean}
- */
-proto.sqlrpc.v1.MountDatabaseRequest.prototype.getReadOnly = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
- */
-proto.sqlrpc.v1.MountDatabaseRequest.prototype.setReadOnly = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
-};
-
-
-/**
- * optional bool is_encrypted = 4;
- * @return {boolean}
- */
-proto.sqlrpc.v1.MountDatabaseRequest.prototype.getIsEncrypted = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
- */
-proto.sqlrpc.v1.MountDatabaseRequest.prototype.setIsEncrypted = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 4, value);
-};
-
-
-/**
- * optional string key = 5;
- * @return {string}
- */
-proto.sqlrpc.v1.MountDatabaseRequest.prototype.getKey = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
- */
-proto.sqlrpc.v1.MountDatabaseRequest.prototype.setKey = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
-};
-
-
-/**
- * repeated string extensions = 6;
- * @return {!Array<string>}
- */
-proto.sqlrpc.v1.MountDatabaseRequest.prototype.getExtensionsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 6));
-};
-
-
-/**
- * @param {!Array<string>} value
- * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
- */
-proto.sqlrpc.v1.MountDatabaseRequest.prototype.setExtensionsList = function(value) {
-  return jspb.Message.setField(this, 6, value || []);
-};
-
-
-/**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
- */
-proto.sqlrpc.v1.MountDatabaseRequest.prototype.addExtensions = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
- */
-proto.sqlrpc.v1.MountDatabaseRequest.prototype.clearExtensionsList = function() {
-  return this.setExtensionsList([]);
-};
-
-
-/**
- * map<string, string> pragmas = 7;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * optional google.protobuf.Timestamp expires_at = 3;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.sqlrpc.v1.MountDatabaseRequest.prototype.getPragmasMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 7, opt_noLazyCreate,
-      null));
+proto.sqlrpc.v1.LoginResponse.prototype.getExpiresAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
- */
-proto.sqlrpc.v1.MountDatabaseRequest.prototype.clearPragmasMap = function() {
-  this.getPragmasMap().clear();
-  return this;
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.sqlrpc.v1.LoginResponse} returns this
+*/
+proto.sqlrpc.v1.LoginResponse.prototype.setExpiresAt = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
- * optional int32 max_open_conns = 8;
- * @return {number}
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.LoginResponse} returns this
  */
-proto.sqlrpc.v1.MountDatabaseRequest.prototype.getMaxOpenConns = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+proto.sqlrpc.v1.LoginResponse.prototype.clearExpiresAt = function() {
+  return this.setExpiresAt(undefined);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.MountDatabaseRequest.prototype.setMaxOpenConns = function(value) {
-  return jspb.Message.setProto3IntField(this, 8, value);
+proto.sqlrpc.v1.LoginResponse.prototype.hasExpiresAt = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * optional int32 max_idle_conns = 9;
- * @return {number}
+ * optional User user = 4;
+ * @return {?proto.sqlrpc.v1.User}
  */
-proto.sqlrpc.v1.MountDatabaseRequest.prototype.getMaxIdleConns = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+proto.sqlrpc.v1.LoginResponse.prototype.getUser = function() {
+  return /** @type{?proto.sqlrpc.v1.User} */ (
+    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.User, 4));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
- */
-proto.sqlrpc.v1.MountDatabaseRequest.prototype.setMaxIdleConns = function(value) {
-  return jspb.Message.setProto3IntField(this, 9, value);
+ * @param {?proto.sqlrpc.v1.User|undefined} value
+ * @return {!proto.sqlrpc.v1.LoginResponse} returns this
+*/
+proto.sqlrpc.v1.LoginResponse.prototype.setUser = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
 };
 
 
 /**
- * optional int32 conn_max_lifetime_ms = 10;
- * @return {number}
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.LoginResponse} returns this
  */
-proto.sqlrpc.v1.MountDatabaseRequest.prototype.getConnMaxLifetimeMs = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+proto.sqlrpc.v1.LoginResponse.prototype.clearUser = function() {
+  return this.setUser(undefined);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.sqlrpc.v1.MountDatabaseRequest} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.MountDatabaseRequest.prototype.setConnMaxLifetimeMs = function(value) {
-  return jspb.Message.setProto3IntField(this, 10, value);
+proto.sqlrpc.v1.LoginResponse.prototype.hasUser = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
@@ -5471,8 +18583,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.MountDatabaseResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.MountDatabaseResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.LogoutRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.LogoutRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -5481,14 +18593,14 @@ proto.sqlrpc.v1.MountDatabaseResponse.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.MountDatabaseResponse} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.LogoutRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.MountDatabaseResponse.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.LogoutRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
-message: jspb.Message.getFieldWithDefault(msg, 2, "")
+username: jspb.Message.getFieldWithDefault(msg, 1, ""),
+keyId: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -5502,23 +18614,23 @@ message: jspb.Message.getFieldWithDefault(msg, 2, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.MountDatabaseResponse}
+ * @return {!proto.sqlrpc.v1.LogoutRequest}
  */
-proto.sqlrpc.v1.MountDatabaseResponse.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.LogoutRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.MountDatabaseResponse;
-  return proto.sqlrpc.v1.MountDatabaseResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.LogoutRequest;
+  return proto.sqlrpc.v1.LogoutRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.MountDatabaseResponse} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.LogoutRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.MountDatabaseResponse}
+ * @return {!proto.sqlrpc.v1.LogoutRequest}
  */
-proto.sqlrpc.v1.MountDatabaseResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.LogoutRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5526,12 +18638,12 @@ proto.sqlrpc.v1.MountDatabaseResponse.deserializeBinaryFromReader = function(msg
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSuccess(value);
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setUsername(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setMessage(value);
+      msg.setKeyId(value);
       break;
     default:
       reader.skipField();
@@ -5546,9 +18658,9 @@ proto.sqlrpc.v1.MountDatabaseResponse.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.MountDatabaseResponse.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.LogoutRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.MountDatabaseResponse.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.LogoutRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5556,20 +18668,20 @@ proto.sqlrpc.v1.MountDatabaseResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.MountDatabaseResponse} message
+ * @param {!proto.sqlrpc.v1.LogoutRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.MountDatabaseResponse.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.LogoutRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSuccess();
-  if (f) {
-    writer.writeBool(
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getMessage();
+  f = message.getKeyId();
   if (f.length > 0) {
     writer.writeString(
       2,
@@ -5580,37 +18692,37 @@ proto.sqlrpc.v1.MountDatabaseResponse.serializeBinaryToWriter = function(message
 
 
 /**
- * optional bool success = 1;
- * @return {boolean}
+ * optional string username = 1;
+ * @return {string}
  */
-proto.sqlrpc.v1.MountDatabaseResponse.prototype.getSuccess = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.sqlrpc.v1.LogoutRequest.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.sqlrpc.v1.MountDatabaseResponse} returns this
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.LogoutRequest} returns this
  */
-proto.sqlrpc.v1.MountDatabaseResponse.prototype.setSuccess = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.sqlrpc.v1.LogoutRequest.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string message = 2;
+ * optional string key_id = 2;
  * @return {string}
  */
-proto.sqlrpc.v1.MountDatabaseResponse.prototype.getMessage = function() {
+proto.sqlrpc.v1.LogoutRequest.prototype.getKeyId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.MountDatabaseResponse} returns this
+ * @return {!proto.sqlrpc.v1.LogoutRequest} returns this
  */
-proto.sqlrpc.v1.MountDatabaseResponse.prototype.setMessage = function(value) {
+proto.sqlrpc.v1.LogoutRequest.prototype.setKeyId = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
@@ -5631,8 +18743,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.UnMountDatabaseRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.UnMountDatabaseRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.LogoutResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.LogoutResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -5641,13 +18753,13 @@ proto.sqlrpc.v1.UnMountDatabaseRequest.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.UnMountDatabaseRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.LogoutResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.UnMountDatabaseRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.LogoutResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-name: jspb.Message.getFieldWithDefault(msg, 1, "")
+success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
   };
 
   if (includeInstance) {
@@ -5661,23 +18773,23 @@ name: jspb.Message.getFieldWithDefault(msg, 1, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.UnMountDatabaseRequest}
+ * @return {!proto.sqlrpc.v1.LogoutResponse}
  */
-proto.sqlrpc.v1.UnMountDatabaseRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.LogoutResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.UnMountDatabaseRequest;
-  return proto.sqlrpc.v1.UnMountDatabaseRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.LogoutResponse;
+  return proto.sqlrpc.v1.LogoutResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.UnMountDatabaseRequest} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.LogoutResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.UnMountDatabaseRequest}
+ * @return {!proto.sqlrpc.v1.LogoutResponse}
  */
-proto.sqlrpc.v1.UnMountDatabaseRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.LogoutResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5685,8 +18797,8 @@ proto.sqlrpc.v1.UnMountDatabaseRequest.deserializeBinaryFromReader = function(ms
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setName(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
       break;
     default:
       reader.skipField();
@@ -5701,9 +18813,9 @@ proto.sqlrpc.v1.UnMountDatabaseRequest.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.UnMountDatabaseRequest.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.LogoutResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.UnMountDatabaseRequest.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.LogoutResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5711,15 +18823,15 @@ proto.sqlrpc.v1.UnMountDatabaseRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.UnMountDatabaseRequest} message
+ * @param {!proto.sqlrpc.v1.LogoutResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.UnMountDatabaseRequest.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.LogoutResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
       1,
       f
     );
@@ -5728,20 +18840,20 @@ proto.sqlrpc.v1.UnMountDatabaseRequest.serializeBinaryToWriter = function(messag
 
 
 /**
- * optional string name = 1;
- * @return {string}
+ * optional bool success = 1;
+ * @return {boolean}
  */
-proto.sqlrpc.v1.UnMountDatabaseRequest.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.sqlrpc.v1.LogoutResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.UnMountDatabaseRequest} returns this
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.LogoutResponse} returns this
  */
-proto.sqlrpc.v1.UnMountDatabaseRequest.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.sqlrpc.v1.LogoutResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
 
@@ -5761,8 +18873,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.UnMountDatabaseResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.UnMountDatabaseResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.StreamReplicationRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.StreamReplicationRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -5771,14 +18883,13 @@ proto.sqlrpc.v1.UnMountDatabaseResponse.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.UnMountDatabaseResponse} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.StreamReplicationRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.UnMountDatabaseResponse.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.StreamReplicationRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
-message: jspb.Message.getFieldWithDefault(msg, 2, "")
+followerId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -5792,23 +18903,23 @@ message: jspb.Message.getFieldWithDefault(msg, 2, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.UnMountDatabaseResponse}
+ * @return {!proto.sqlrpc.v1.StreamReplicationRequest}
  */
-proto.sqlrpc.v1.UnMountDatabaseResponse.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.StreamReplicationRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.UnMountDatabaseResponse;
-  return proto.sqlrpc.v1.UnMountDatabaseResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.StreamReplicationRequest;
+  return proto.sqlrpc.v1.StreamReplicationRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.UnMountDatabaseResponse} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.StreamReplicationRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.UnMountDatabaseResponse}
+ * @return {!proto.sqlrpc.v1.StreamReplicationRequest}
  */
-proto.sqlrpc.v1.UnMountDatabaseResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.StreamReplicationRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5816,12 +18927,8 @@ proto.sqlrpc.v1.UnMountDatabaseResponse.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSuccess(value);
-      break;
-    case 2:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setMessage(value);
+      msg.setFollowerId(value);
       break;
     default:
       reader.skipField();
@@ -5836,9 +18943,9 @@ proto.sqlrpc.v1.UnMountDatabaseResponse.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.UnMountDatabaseResponse.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.StreamReplicationRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.UnMountDatabaseResponse.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.StreamReplicationRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5846,23 +18953,16 @@ proto.sqlrpc.v1.UnMountDatabaseResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.UnMountDatabaseResponse} message
+ * @param {!proto.sqlrpc.v1.StreamReplicationRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.UnMountDatabaseResponse.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.StreamReplicationRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSuccess();
-  if (f) {
-    writer.writeBool(
-      1,
-      f
-    );
-  }
-  f = message.getMessage();
+  f = message.getFollowerId();
   if (f.length > 0) {
     writer.writeString(
-      2,
+      1,
       f
     );
   }
@@ -5870,38 +18970,20 @@ proto.sqlrpc.v1.UnMountDatabaseResponse.serializeBinaryToWriter = function(messa
 
 
 /**
- * optional bool success = 1;
- * @return {boolean}
- */
-proto.sqlrpc.v1.UnMountDatabaseResponse.prototype.getSuccess = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.sqlrpc.v1.UnMountDatabaseResponse} returns this
- */
-proto.sqlrpc.v1.UnMountDatabaseResponse.prototype.setSuccess = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
-};
-
-
-/**
- * optional string message = 2;
+ * optional string follower_id = 1;
  * @return {string}
  */
-proto.sqlrpc.v1.UnMountDatabaseResponse.prototype.getMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.sqlrpc.v1.StreamReplicationRequest.prototype.getFollowerId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.UnMountDatabaseResponse} returns this
+ * @return {!proto.sqlrpc.v1.StreamReplicationRequest} returns this
  */
-proto.sqlrpc.v1.UnMountDatabaseResponse.prototype.setMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.sqlrpc.v1.StreamReplicationRequest.prototype.setFollowerId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -5921,8 +19003,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.GetServerInfoRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.GetServerInfoRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ReplicationPage.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ReplicationPage.toObject(opt_includeInstance, this);
 };
 
 
@@ -5931,13 +19013,14 @@ proto.sqlrpc.v1.GetServerInfoRequest.prototype.toObject = function(opt_includeIn
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.GetServerInfoRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.ReplicationPage} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.GetServerInfoRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.ReplicationPage.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+pageNumber: jspb.Message.getFieldWithDefault(msg, 1, 0),
+data: msg.getData_asB64()
   };
 
   if (includeInstance) {
@@ -5951,29 +19034,37 @@ proto.sqlrpc.v1.GetServerInfoRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.GetServerInfoRequest}
+ * @return {!proto.sqlrpc.v1.ReplicationPage}
  */
-proto.sqlrpc.v1.GetServerInfoRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.ReplicationPage.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.GetServerInfoRequest;
-  return proto.sqlrpc.v1.GetServerInfoRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.ReplicationPage;
+  return proto.sqlrpc.v1.ReplicationPage.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.GetServerInfoRequest} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.ReplicationPage} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.GetServerInfoRequest}
+ * @return {!proto.sqlrpc.v1.ReplicationPage}
  */
-proto.sqlrpc.v1.GetServerInfoRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.ReplicationPage.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPageNumber(value);
+      break;
+    case 2:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -5987,9 +19078,9 @@ proto.sqlrpc.v1.GetServerInfoRequest.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.GetServerInfoRequest.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.ReplicationPage.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.GetServerInfoRequest.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.ReplicationPage.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5997,16 +19088,97 @@ proto.sqlrpc.v1.GetServerInfoRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.GetServerInfoRequest} message
+ * @param {!proto.sqlrpc.v1.ReplicationPage} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.GetServerInfoRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
+proto.sqlrpc.v1.ReplicationPage.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPageNumber();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+  f = message.getData_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint32 page_number = 1;
+ * @return {number}
+ */
+proto.sqlrpc.v1.ReplicationPage.prototype.getPageNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.ReplicationPage} returns this
+ */
+proto.sqlrpc.v1.ReplicationPage.prototype.setPageNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional bytes data = 2;
+ * @return {!(string|Uint8Array)}
+ */
+proto.sqlrpc.v1.ReplicationPage.prototype.getData = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * optional bytes data = 2;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
+ */
+proto.sqlrpc.v1.ReplicationPage.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
+};
+
+
+/**
+ * optional bytes data = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.ReplicationPage.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.sqlrpc.v1.ReplicationPage} returns this
+ */
+proto.sqlrpc.v1.ReplicationPage.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.ReplicationFrame.repeatedFields_ = [4];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -6022,8 +19194,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.ServerInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.ServerInfo.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ReplicationFrame.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ReplicationFrame.toObject(opt_includeInstance, this);
 };
 
 
@@ -6032,20 +19204,21 @@ proto.sqlrpc.v1.ServerInfo.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.ServerInfo} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.ReplicationFrame} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.ServerInfo.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.ReplicationFrame.toObject = function(includeInstance, msg) {
   var f, obj = {
-version: jspb.Message.getFieldWithDefault(msg, 1, ""),
-serverTime: (f = msg.getServerTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-architecture: jspb.Message.getFieldWithDefault(msg, 3, ""),
-goVersion: jspb.Message.getFieldWithDefault(msg, 4, ""),
-sqliteVersion: jspb.Message.getFieldWithDefault(msg, 5, ""),
-databaseCount: jspb.Message.getFieldWithDefault(msg, 6, 0),
-uptimeSeconds: jspb.Message.getFieldWithDefault(msg, 7, 0),
-authDisabled: jspb.Message.getBooleanFieldWithDefault(msg, 8, false)
+database: jspb.Message.getFieldWithDefault(msg, 1, ""),
+pageSize: jspb.Message.getFieldWithDefault(msg, 2, 0),
+pageCount: jspb.Message.getFieldWithDefault(msg, 3, 0),
+pagesList: jspb.Message.toObjectList(msg.getPagesList(),
+    proto.sqlrpc.v1.ReplicationPage.toObject, includeInstance),
+fullSnapshot: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+sequence: jspb.Message.getFieldWithDefault(msg, 6, 0),
+leaderTime: (f = msg.getLeaderTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+partial: jspb.Message.getBooleanFieldWithDefault(msg, 8, false)
   };
 
   if (includeInstance) {
@@ -6059,23 +19232,23 @@ authDisabled: jspb.Message.getBooleanFieldWithDefault(msg, 8, false)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.ServerInfo}
+ * @return {!proto.sqlrpc.v1.ReplicationFrame}
  */
-proto.sqlrpc.v1.ServerInfo.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.ReplicationFrame.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.ServerInfo;
-  return proto.sqlrpc.v1.ServerInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.ReplicationFrame;
+  return proto.sqlrpc.v1.ReplicationFrame.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.ServerInfo} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.ReplicationFrame} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.ServerInfo}
+ * @return {!proto.sqlrpc.v1.ReplicationFrame}
  */
-proto.sqlrpc.v1.ServerInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.ReplicationFrame.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6084,36 +19257,37 @@ proto.sqlrpc.v1.ServerInfo.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setVersion(value);
+      msg.setDatabase(value);
       break;
     case 2:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setServerTime(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPageSize(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setArchitecture(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPageCount(value);
       break;
     case 4:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setGoVersion(value);
+      var value = new proto.sqlrpc.v1.ReplicationPage;
+      reader.readMessage(value,proto.sqlrpc.v1.ReplicationPage.deserializeBinaryFromReader);
+      msg.addPages(value);
       break;
     case 5:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setSqliteVersion(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setFullSnapshot(value);
       break;
     case 6:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setDatabaseCount(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSequence(value);
       break;
     case 7:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setUptimeSeconds(value);
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setLeaderTime(value);
       break;
     case 8:
       var value = /** @type {boolean} */ (reader.readBool());
-      msg.setAuthDisabled(value);
+      msg.setPartial(value);
       break;
     default:
       reader.skipField();
@@ -6128,9 +19302,9 @@ proto.sqlrpc.v1.ServerInfo.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.ServerInfo.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.ReplicationFrame.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.ServerInfo.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.ReplicationFrame.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6138,63 +19312,64 @@ proto.sqlrpc.v1.ServerInfo.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.ServerInfo} message
+ * @param {!proto.sqlrpc.v1.ReplicationFrame} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.ServerInfo.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.ReplicationFrame.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getVersion();
+  f = message.getDatabase();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getServerTime();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getPageSize();
+  if (f !== 0) {
+    writer.writeUint32(
       2,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getArchitecture();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getPageCount();
+  if (f !== 0) {
+    writer.writeUint32(
       3,
       f
     );
   }
-  f = message.getGoVersion();
+  f = message.getPagesList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       4,
-      f
+      f,
+      proto.sqlrpc.v1.ReplicationPage.serializeBinaryToWriter
     );
   }
-  f = message.getSqliteVersion();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getFullSnapshot();
+  if (f) {
+    writer.writeBool(
       5,
       f
     );
   }
-  f = message.getDatabaseCount();
+  f = message.getSequence();
   if (f !== 0) {
-    writer.writeInt32(
+    writer.writeUint64(
       6,
       f
     );
   }
-  f = message.getUptimeSeconds();
-  if (f !== 0) {
-    writer.writeInt64(
+  f = message.getLeaderTime();
+  if (f != null) {
+    writer.writeMessage(
       7,
-      f
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
-  f = message.getAuthDisabled();
+  f = message.getPartial();
   if (f) {
     writer.writeBool(
       8,
@@ -6205,367 +19380,158 @@ proto.sqlrpc.v1.ServerInfo.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional string version = 1;
+ * optional string database = 1;
  * @return {string}
  */
-proto.sqlrpc.v1.ServerInfo.prototype.getVersion = function() {
+proto.sqlrpc.v1.ReplicationFrame.prototype.getDatabase = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.ServerInfo} returns this
+ * @return {!proto.sqlrpc.v1.ReplicationFrame} returns this
  */
-proto.sqlrpc.v1.ServerInfo.prototype.setVersion = function(value) {
+proto.sqlrpc.v1.ReplicationFrame.prototype.setDatabase = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional google.protobuf.Timestamp server_time = 2;
- * @return {?proto.google.protobuf.Timestamp}
- */
-proto.sqlrpc.v1.ServerInfo.prototype.getServerTime = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
-};
-
-
-/**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.sqlrpc.v1.ServerInfo} returns this
-*/
-proto.sqlrpc.v1.ServerInfo.prototype.setServerTime = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.ServerInfo} returns this
- */
-proto.sqlrpc.v1.ServerInfo.prototype.clearServerTime = function() {
-  return this.setServerTime(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.sqlrpc.v1.ServerInfo.prototype.hasServerTime = function() {
-  return jspb.Message.getField(this, 2) != null;
-};
-
-
-/**
- * optional string architecture = 3;
- * @return {string}
- */
-proto.sqlrpc.v1.ServerInfo.prototype.getArchitecture = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.ServerInfo} returns this
- */
-proto.sqlrpc.v1.ServerInfo.prototype.setArchitecture = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
-};
-
-
-/**
- * optional string go_version = 4;
- * @return {string}
- */
-proto.sqlrpc.v1.ServerInfo.prototype.getGoVersion = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.ServerInfo} returns this
- */
-proto.sqlrpc.v1.ServerInfo.prototype.setGoVersion = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
-};
-
-
-/**
- * optional string sqlite_version = 5;
- * @return {string}
- */
-proto.sqlrpc.v1.ServerInfo.prototype.getSqliteVersion = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.ServerInfo} returns this
- */
-proto.sqlrpc.v1.ServerInfo.prototype.setSqliteVersion = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
-};
-
-
-/**
- * optional int32 database_count = 6;
+ * optional uint32 page_size = 2;
  * @return {number}
  */
-proto.sqlrpc.v1.ServerInfo.prototype.getDatabaseCount = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.sqlrpc.v1.ReplicationFrame.prototype.getPageSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.sqlrpc.v1.ServerInfo} returns this
+ * @return {!proto.sqlrpc.v1.ReplicationFrame} returns this
  */
-proto.sqlrpc.v1.ServerInfo.prototype.setDatabaseCount = function(value) {
-  return jspb.Message.setProto3IntField(this, 6, value);
+proto.sqlrpc.v1.ReplicationFrame.prototype.setPageSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional int64 uptime_seconds = 7;
+ * optional uint32 page_count = 3;
  * @return {number}
  */
-proto.sqlrpc.v1.ServerInfo.prototype.getUptimeSeconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.sqlrpc.v1.ServerInfo} returns this
- */
-proto.sqlrpc.v1.ServerInfo.prototype.setUptimeSeconds = function(value) {
-  return jspb.Message.setProto3IntField(this, 7, value);
-};
-
-
-/**
- * optional bool auth_disabled = 8;
- * @return {boolean}
- */
-proto.sqlrpc.v1.ServerInfo.prototype.getAuthDisabled = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.sqlrpc.v1.ServerInfo} returns this
- */
-proto.sqlrpc.v1.ServerInfo.prototype.setAuthDisabled = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 8, value);
-};
-
-
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
-/**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
- */
-proto.sqlrpc.v1.LoginRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.LoginRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ReplicationFrame.prototype.getPageCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.LoginRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.sqlrpc.v1.LoginRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-username: jspb.Message.getFieldWithDefault(msg, 1, ""),
-password: jspb.Message.getFieldWithDefault(msg, 2, ""),
-sessionDuration: (f = msg.getSessionDuration()) && google_protobuf_duration_pb.Duration.toObject(includeInstance, f)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.ReplicationFrame} returns this
+ */
+proto.sqlrpc.v1.ReplicationFrame.prototype.setPageCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.LoginRequest}
+ * repeated ReplicationPage pages = 4;
+ * @return {!Array<!proto.sqlrpc.v1.ReplicationPage>}
  */
-proto.sqlrpc.v1.LoginRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.LoginRequest;
-  return proto.sqlrpc.v1.LoginRequest.deserializeBinaryFromReader(msg, reader);
+proto.sqlrpc.v1.ReplicationFrame.prototype.getPagesList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.ReplicationPage>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.sqlrpc.v1.ReplicationPage, 4));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.LoginRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.LoginRequest}
- */
-proto.sqlrpc.v1.LoginRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setUsername(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setPassword(value);
-      break;
-    case 3:
-      var value = new google_protobuf_duration_pb.Duration;
-      reader.readMessage(value,google_protobuf_duration_pb.Duration.deserializeBinaryFromReader);
-      msg.setSessionDuration(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+ * @param {!Array<!proto.sqlrpc.v1.ReplicationPage>} value
+ * @return {!proto.sqlrpc.v1.ReplicationFrame} returns this
+*/
+proto.sqlrpc.v1.ReplicationFrame.prototype.setPagesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {!proto.sqlrpc.v1.ReplicationPage=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.ReplicationPage}
  */
-proto.sqlrpc.v1.LoginRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.LoginRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.sqlrpc.v1.ReplicationFrame.prototype.addPages = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.sqlrpc.v1.ReplicationPage, opt_index);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.LoginRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.ReplicationFrame} returns this
  */
-proto.sqlrpc.v1.LoginRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getUsername();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getPassword();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getSessionDuration();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      google_protobuf_duration_pb.Duration.serializeBinaryToWriter
-    );
-  }
+proto.sqlrpc.v1.ReplicationFrame.prototype.clearPagesList = function() {
+  return this.setPagesList([]);
 };
 
 
 /**
- * optional string username = 1;
- * @return {string}
+ * optional bool full_snapshot = 5;
+ * @return {boolean}
  */
-proto.sqlrpc.v1.LoginRequest.prototype.getUsername = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.sqlrpc.v1.ReplicationFrame.prototype.getFullSnapshot = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.LoginRequest} returns this
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.ReplicationFrame} returns this
  */
-proto.sqlrpc.v1.LoginRequest.prototype.setUsername = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.sqlrpc.v1.ReplicationFrame.prototype.setFullSnapshot = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
 };
 
 
 /**
- * optional string password = 2;
- * @return {string}
+ * optional uint64 sequence = 6;
+ * @return {number}
  */
-proto.sqlrpc.v1.LoginRequest.prototype.getPassword = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.sqlrpc.v1.ReplicationFrame.prototype.getSequence = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.LoginRequest} returns this
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.ReplicationFrame} returns this
  */
-proto.sqlrpc.v1.LoginRequest.prototype.setPassword = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.sqlrpc.v1.ReplicationFrame.prototype.setSequence = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
 };
 
 
 /**
- * optional google.protobuf.Duration session_duration = 3;
- * @return {?proto.google.protobuf.Duration}
+ * optional google.protobuf.Timestamp leader_time = 7;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.sqlrpc.v1.LoginRequest.prototype.getSessionDuration = function() {
-  return /** @type{?proto.google.protobuf.Duration} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_duration_pb.Duration, 3));
+proto.sqlrpc.v1.ReplicationFrame.prototype.getLeaderTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 7));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Duration|undefined} value
- * @return {!proto.sqlrpc.v1.LoginRequest} returns this
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.sqlrpc.v1.ReplicationFrame} returns this
 */
-proto.sqlrpc.v1.LoginRequest.prototype.setSessionDuration = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+proto.sqlrpc.v1.ReplicationFrame.prototype.setLeaderTime = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.LoginRequest} returns this
+ * @return {!proto.sqlrpc.v1.ReplicationFrame} returns this
  */
-proto.sqlrpc.v1.LoginRequest.prototype.clearSessionDuration = function() {
-  return this.setSessionDuration(undefined);
+proto.sqlrpc.v1.ReplicationFrame.prototype.clearLeaderTime = function() {
+  return this.setLeaderTime(undefined);
 };
 
 
@@ -6573,8 +19539,26 @@ proto.sqlrpc.v1.LoginRequest.prototype.clearSessionDuration = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.sqlrpc.v1.LoginRequest.prototype.hasSessionDuration = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.sqlrpc.v1.ReplicationFrame.prototype.hasLeaderTime = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional bool partial = 8;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.ReplicationFrame.prototype.getPartial = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.ReplicationFrame} returns this
+ */
+proto.sqlrpc.v1.ReplicationFrame.prototype.setPartial = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 8, value);
 };
 
 
@@ -6594,8 +19578,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.LoginResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.LoginResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.DatabaseReplicationStatus.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.DatabaseReplicationStatus.toObject(opt_includeInstance, this);
 };
 
 
@@ -6604,16 +19588,15 @@ proto.sqlrpc.v1.LoginResponse.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.LoginResponse} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.DatabaseReplicationStatus} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.LoginResponse.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.DatabaseReplicationStatus.toObject = function(includeInstance, msg) {
   var f, obj = {
-apiKey: jspb.Message.getFieldWithDefault(msg, 1, ""),
-keyId: jspb.Message.getFieldWithDefault(msg, 2, ""),
-expiresAt: (f = msg.getExpiresAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-user: (f = msg.getUser()) && sqlrpc_v1_types_pb.User.toObject(includeInstance, f)
+database: jspb.Message.getFieldWithDefault(msg, 1, ""),
+sequence: jspb.Message.getFieldWithDefault(msg, 2, 0),
+pageCount: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -6627,23 +19610,23 @@ user: (f = msg.getUser()) && sqlrpc_v1_types_pb.User.toObject(includeInstance, f
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.LoginResponse}
+ * @return {!proto.sqlrpc.v1.DatabaseReplicationStatus}
  */
-proto.sqlrpc.v1.LoginResponse.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.DatabaseReplicationStatus.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.LoginResponse;
-  return proto.sqlrpc.v1.LoginResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.DatabaseReplicationStatus;
+  return proto.sqlrpc.v1.DatabaseReplicationStatus.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.LoginResponse} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.DatabaseReplicationStatus} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.LoginResponse}
+ * @return {!proto.sqlrpc.v1.DatabaseReplicationStatus}
  */
-proto.sqlrpc.v1.LoginResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.DatabaseReplicationStatus.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6652,21 +19635,15 @@ proto.sqlrpc.v1.LoginResponse.deserializeBinaryFromReader = function(msg, reader
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setApiKey(value);
+      msg.setDatabase(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setKeyId(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSequence(value);
       break;
     case 3:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setExpiresAt(value);
-      break;
-    case 4:
-      var value = new sqlrpc_v1_types_pb.User;
-      reader.readMessage(value,sqlrpc_v1_types_pb.User.deserializeBinaryFromReader);
-      msg.setUser(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPageCount(value);
       break;
     default:
       reader.skipField();
@@ -6681,9 +19658,9 @@ proto.sqlrpc.v1.LoginResponse.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.LoginResponse.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.DatabaseReplicationStatus.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.LoginResponse.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.DatabaseReplicationStatus.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6691,156 +19668,98 @@ proto.sqlrpc.v1.LoginResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.LoginResponse} message
+ * @param {!proto.sqlrpc.v1.DatabaseReplicationStatus} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.LoginResponse.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.DatabaseReplicationStatus.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getApiKey();
+  f = message.getDatabase();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getKeyId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getSequence();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getExpiresAt();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getPageCount();
+  if (f !== 0) {
+    writer.writeUint32(
       3,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
-    );
-  }
-  f = message.getUser();
-  if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      sqlrpc_v1_types_pb.User.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * optional string api_key = 1;
+ * optional string database = 1;
  * @return {string}
  */
-proto.sqlrpc.v1.LoginResponse.prototype.getApiKey = function() {
+proto.sqlrpc.v1.DatabaseReplicationStatus.prototype.getDatabase = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.LoginResponse} returns this
+ * @return {!proto.sqlrpc.v1.DatabaseReplicationStatus} returns this
  */
-proto.sqlrpc.v1.LoginResponse.prototype.setApiKey = function(value) {
+proto.sqlrpc.v1.DatabaseReplicationStatus.prototype.setDatabase = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string key_id = 2;
- * @return {string}
- */
-proto.sqlrpc.v1.LoginResponse.prototype.getKeyId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.LoginResponse} returns this
- */
-proto.sqlrpc.v1.LoginResponse.prototype.setKeyId = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * optional google.protobuf.Timestamp expires_at = 3;
- * @return {?proto.google.protobuf.Timestamp}
- */
-proto.sqlrpc.v1.LoginResponse.prototype.getExpiresAt = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
-};
-
-
-/**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.sqlrpc.v1.LoginResponse} returns this
-*/
-proto.sqlrpc.v1.LoginResponse.prototype.setExpiresAt = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.LoginResponse} returns this
- */
-proto.sqlrpc.v1.LoginResponse.prototype.clearExpiresAt = function() {
-  return this.setExpiresAt(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional uint64 sequence = 2;
+ * @return {number}
  */
-proto.sqlrpc.v1.LoginResponse.prototype.hasExpiresAt = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.sqlrpc.v1.DatabaseReplicationStatus.prototype.getSequence = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * optional User user = 4;
- * @return {?proto.sqlrpc.v1.User}
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.DatabaseReplicationStatus} returns this
  */
-proto.sqlrpc.v1.LoginResponse.prototype.getUser = function() {
-  return /** @type{?proto.sqlrpc.v1.User} */ (
-    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.User, 4));
-};
-
-
-/**
- * @param {?proto.sqlrpc.v1.User|undefined} value
- * @return {!proto.sqlrpc.v1.LoginResponse} returns this
-*/
-proto.sqlrpc.v1.LoginResponse.prototype.setUser = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+proto.sqlrpc.v1.DatabaseReplicationStatus.prototype.setSequence = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
-/**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.LoginResponse} returns this
+/**
+ * optional uint32 page_count = 3;
+ * @return {number}
  */
-proto.sqlrpc.v1.LoginResponse.prototype.clearUser = function() {
-  return this.setUser(undefined);
+proto.sqlrpc.v1.DatabaseReplicationStatus.prototype.getPageCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.DatabaseReplicationStatus} returns this
  */
-proto.sqlrpc.v1.LoginResponse.prototype.hasUser = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.sqlrpc.v1.DatabaseReplicationStatus.prototype.setPageCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.ReplicationStatus.repeatedFields_ = [7];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -6856,8 +19775,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.LogoutRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.LogoutRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ReplicationStatus.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ReplicationStatus.toObject(opt_includeInstance, this);
 };
 
 
@@ -6866,14 +19785,20 @@ proto.sqlrpc.v1.LogoutRequest.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.LogoutRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.ReplicationStatus} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.LogoutRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.ReplicationStatus.toObject = function(includeInstance, msg) {
   var f, obj = {
-username: jspb.Message.getFieldWithDefault(msg, 1, ""),
-keyId: jspb.Message.getFieldWithDefault(msg, 2, "")
+role: jspb.Message.getFieldWithDefault(msg, 1, 0),
+leader: jspb.Message.getFieldWithDefault(msg, 2, ""),
+connected: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+lag: (f = msg.getLag()) && google_protobuf_duration_pb.Duration.toObject(includeInstance, f),
+lastSyncTime: (f = msg.getLastSyncTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+followerCount: jspb.Message.getFieldWithDefault(msg, 6, 0),
+databasesList: jspb.Message.toObjectList(msg.getDatabasesList(),
+    proto.sqlrpc.v1.DatabaseReplicationStatus.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -6887,23 +19812,23 @@ keyId: jspb.Message.getFieldWithDefault(msg, 2, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.LogoutRequest}
+ * @return {!proto.sqlrpc.v1.ReplicationStatus}
  */
-proto.sqlrpc.v1.LogoutRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.ReplicationStatus.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.LogoutRequest;
-  return proto.sqlrpc.v1.LogoutRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.ReplicationStatus;
+  return proto.sqlrpc.v1.ReplicationStatus.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.LogoutRequest} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.ReplicationStatus} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.LogoutRequest}
+ * @return {!proto.sqlrpc.v1.ReplicationStatus}
  */
-proto.sqlrpc.v1.LogoutRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.ReplicationStatus.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6911,12 +19836,35 @@ proto.sqlrpc.v1.LogoutRequest.deserializeBinaryFromReader = function(msg, reader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setUsername(value);
+      var value = /** @type {!proto.sqlrpc.v1.ReplicationRole} */ (reader.readEnum());
+      msg.setRole(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setKeyId(value);
+      msg.setLeader(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setConnected(value);
+      break;
+    case 4:
+      var value = new google_protobuf_duration_pb.Duration;
+      reader.readMessage(value,google_protobuf_duration_pb.Duration.deserializeBinaryFromReader);
+      msg.setLag(value);
+      break;
+    case 5:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setLastSyncTime(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setFollowerCount(value);
+      break;
+    case 7:
+      var value = new proto.sqlrpc.v1.DatabaseReplicationStatus;
+      reader.readMessage(value,proto.sqlrpc.v1.DatabaseReplicationStatus.deserializeBinaryFromReader);
+      msg.addDatabases(value);
       break;
     default:
       reader.skipField();
@@ -6931,9 +19879,9 @@ proto.sqlrpc.v1.LogoutRequest.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.LogoutRequest.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.ReplicationStatus.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.LogoutRequest.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.ReplicationStatus.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6941,192 +19889,248 @@ proto.sqlrpc.v1.LogoutRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.LogoutRequest} message
+ * @param {!proto.sqlrpc.v1.ReplicationStatus} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.LogoutRequest.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.ReplicationStatus.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUsername();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getRole();
+  if (f !== 0.0) {
+    writer.writeEnum(
       1,
       f
     );
   }
-  f = message.getKeyId();
+  f = message.getLeader();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
+  f = message.getConnected();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getLag();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_duration_pb.Duration.serializeBinaryToWriter
+    );
+  }
+  f = message.getLastSyncTime();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getFollowerCount();
+  if (f !== 0) {
+    writer.writeInt32(
+      6,
+      f
+    );
+  }
+  f = message.getDatabasesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      7,
+      f,
+      proto.sqlrpc.v1.DatabaseReplicationStatus.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional string username = 1;
- * @return {string}
+ * optional ReplicationRole role = 1;
+ * @return {!proto.sqlrpc.v1.ReplicationRole}
  */
-proto.sqlrpc.v1.LogoutRequest.prototype.getUsername = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.sqlrpc.v1.ReplicationStatus.prototype.getRole = function() {
+  return /** @type {!proto.sqlrpc.v1.ReplicationRole} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.LogoutRequest} returns this
+ * @param {!proto.sqlrpc.v1.ReplicationRole} value
+ * @return {!proto.sqlrpc.v1.ReplicationStatus} returns this
  */
-proto.sqlrpc.v1.LogoutRequest.prototype.setUsername = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.sqlrpc.v1.ReplicationStatus.prototype.setRole = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
 };
 
 
 /**
- * optional string key_id = 2;
+ * optional string leader = 2;
  * @return {string}
  */
-proto.sqlrpc.v1.LogoutRequest.prototype.getKeyId = function() {
+proto.sqlrpc.v1.ReplicationStatus.prototype.getLeader = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.LogoutRequest} returns this
+ * @return {!proto.sqlrpc.v1.ReplicationStatus} returns this
  */
-proto.sqlrpc.v1.LogoutRequest.prototype.setKeyId = function(value) {
+proto.sqlrpc.v1.ReplicationStatus.prototype.setLeader = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+/**
+ * optional bool connected = 3;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.ReplicationStatus.prototype.getConnected = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.ReplicationStatus} returns this
  */
-proto.sqlrpc.v1.LogoutResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.LogoutResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ReplicationStatus.prototype.setConnected = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.LogoutResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional google.protobuf.Duration lag = 4;
+ * @return {?proto.google.protobuf.Duration}
  */
-proto.sqlrpc.v1.LogoutResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
-  };
+proto.sqlrpc.v1.ReplicationStatus.prototype.getLag = function() {
+  return /** @type{?proto.google.protobuf.Duration} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_duration_pb.Duration, 4));
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * @param {?proto.google.protobuf.Duration|undefined} value
+ * @return {!proto.sqlrpc.v1.ReplicationStatus} returns this
+*/
+proto.sqlrpc.v1.ReplicationStatus.prototype.setLag = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.LogoutResponse}
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.ReplicationStatus} returns this
  */
-proto.sqlrpc.v1.LogoutResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.LogoutResponse;
-  return proto.sqlrpc.v1.LogoutResponse.deserializeBinaryFromReader(msg, reader);
+proto.sqlrpc.v1.ReplicationStatus.prototype.clearLag = function() {
+  return this.setLag(undefined);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.LogoutResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.LogoutResponse}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.LogoutResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSuccess(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.sqlrpc.v1.ReplicationStatus.prototype.hasLag = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional google.protobuf.Timestamp last_sync_time = 5;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.sqlrpc.v1.LogoutResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.LogoutResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.sqlrpc.v1.ReplicationStatus.prototype.getLastSyncTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 5));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.LogoutResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.sqlrpc.v1.ReplicationStatus} returns this
+*/
+proto.sqlrpc.v1.ReplicationStatus.prototype.setLastSyncTime = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.ReplicationStatus} returns this
  */
-proto.sqlrpc.v1.LogoutResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getSuccess();
-  if (f) {
-    writer.writeBool(
-      1,
-      f
-    );
-  }
+proto.sqlrpc.v1.ReplicationStatus.prototype.clearLastSyncTime = function() {
+  return this.setLastSyncTime(undefined);
 };
 
 
 /**
- * optional bool success = 1;
+ * Returns whether this field is set.
  * @return {boolean}
  */
-proto.sqlrpc.v1.LogoutResponse.prototype.getSuccess = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.sqlrpc.v1.ReplicationStatus.prototype.hasLastSyncTime = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.sqlrpc.v1.LogoutResponse} returns this
+ * optional int32 follower_count = 6;
+ * @return {number}
  */
-proto.sqlrpc.v1.LogoutResponse.prototype.setSuccess = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.sqlrpc.v1.ReplicationStatus.prototype.getFollowerCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.ReplicationStatus} returns this
+ */
+proto.sqlrpc.v1.ReplicationStatus.prototype.setFollowerCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * repeated DatabaseReplicationStatus databases = 7;
+ * @return {!Array<!proto.sqlrpc.v1.DatabaseReplicationStatus>}
+ */
+proto.sqlrpc.v1.ReplicationStatus.prototype.getDatabasesList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.DatabaseReplicationStatus>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.sqlrpc.v1.DatabaseReplicationStatus, 7));
+};
+
+
+/**
+ * @param {!Array<!proto.sqlrpc.v1.DatabaseReplicationStatus>} value
+ * @return {!proto.sqlrpc.v1.ReplicationStatus} returns this
+*/
+proto.sqlrpc.v1.ReplicationStatus.prototype.setDatabasesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 7, value);
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.DatabaseReplicationStatus=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.DatabaseReplicationStatus}
+ */
+proto.sqlrpc.v1.ReplicationStatus.prototype.addDatabases = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.sqlrpc.v1.DatabaseReplicationStatus, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.ReplicationStatus} returns this
+ */
+proto.sqlrpc.v1.ReplicationStatus.prototype.clearDatabasesList = function() {
+  return this.setDatabasesList([]);
 };
 
 
@@ -33,6 +33,50 @@ function deserialize_sqlrpc_v1_AttachDatabaseResponse(buffer_arg) {
   return sqlrpc_v1_db_service_pb.AttachDatabaseResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_sqlrpc_v1_BackupDatabaseRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_db_service_pb.BackupDatabaseRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.BackupDatabaseRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_BackupDatabaseRequest(buffer_arg) {
+  return sqlrpc_v1_db_service_pb.BackupDatabaseRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_BackupDatabaseResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_db_service_pb.BackupDatabaseResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.BackupDatabaseResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_BackupDatabaseResponse(buffer_arg) {
+  return sqlrpc_v1_db_service_pb.BackupDatabaseResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_BatchExecRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_db_service_pb.BatchExecRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.BatchExecRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_BatchExecRequest(buffer_arg) {
+  return sqlrpc_v1_db_service_pb.BatchExecRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_BatchExecResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_db_service_pb.BatchExecResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.BatchExecResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_BatchExecResponse(buffer_arg) {
+  return sqlrpc_v1_db_service_pb.BatchExecResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_sqlrpc_v1_BeginTransactionRequest(arg) {
   if (!(arg instanceof sqlrpc_v1_db_service_pb.BeginTransactionRequest)) {
     throw new Error('Expected argument of type sqlrpc.v1.BeginTransactionRequest');
@@ -77,6 +121,28 @@ function deserialize_sqlrpc_v1_CheckpointResponse(buffer_arg) {
   return sqlrpc_v1_db_service_pb.CheckpointResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_sqlrpc_v1_CloseCursorRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_db_service_pb.CloseCursorRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.CloseCursorRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_CloseCursorRequest(buffer_arg) {
+  return sqlrpc_v1_db_service_pb.CloseCursorRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_CloseCursorResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_db_service_pb.CloseCursorResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.CloseCursorResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_CloseCursorResponse(buffer_arg) {
+  return sqlrpc_v1_db_service_pb.CloseCursorResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_sqlrpc_v1_DatabaseSchema(arg) {
   if (!(arg instanceof sqlrpc_v1_types_pb.DatabaseSchema)) {
     throw new Error('Expected argument of type sqlrpc.v1.DatabaseSchema');
@@ -110,6 +176,50 @@ function deserialize_sqlrpc_v1_DetachDatabaseResponse(buffer_arg) {
   return sqlrpc_v1_db_service_pb.DetachDatabaseResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_sqlrpc_v1_DownloadBackupRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_db_service_pb.DownloadBackupRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.DownloadBackupRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_DownloadBackupRequest(buffer_arg) {
+  return sqlrpc_v1_db_service_pb.DownloadBackupRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_DownloadBackupResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_db_service_pb.DownloadBackupResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.DownloadBackupResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_DownloadBackupResponse(buffer_arg) {
+  return sqlrpc_v1_db_service_pb.DownloadBackupResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_DumpDatabaseRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_db_service_pb.DumpDatabaseRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.DumpDatabaseRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_DumpDatabaseRequest(buffer_arg) {
+  return sqlrpc_v1_db_service_pb.DumpDatabaseRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_DumpDatabaseResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_db_service_pb.DumpDatabaseResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.DumpDatabaseResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_DumpDatabaseResponse(buffer_arg) {
+  return sqlrpc_v1_db_service_pb.DumpDatabaseResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_sqlrpc_v1_ExecResponse(arg) {
   if (!(arg instanceof sqlrpc_v1_db_service_pb.ExecResponse)) {
     throw new Error('Expected argument of type sqlrpc.v1.ExecResponse');
@@ -154,6 +264,28 @@ function deserialize_sqlrpc_v1_ExplainResponse(buffer_arg) {
   return sqlrpc_v1_db_service_pb.ExplainResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_sqlrpc_v1_FetchCursorRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_db_service_pb.FetchCursorRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.FetchCursorRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_FetchCursorRequest(buffer_arg) {
+  return sqlrpc_v1_db_service_pb.FetchCursorRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_FetchCursorResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_db_service_pb.FetchCursorResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.FetchCursorResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_FetchCursorResponse(buffer_arg) {
+  return sqlrpc_v1_db_service_pb.FetchCursorResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_sqlrpc_v1_GetDatabaseSchemaRequest(arg) {
   if (!(arg instanceof sqlrpc_v1_db_service_pb.GetDatabaseSchemaRequest)) {
     throw new Error('Expected argument of type sqlrpc.v1.GetDatabaseSchemaRequest');
@@ -176,6 +308,28 @@ function deserialize_sqlrpc_v1_GetTableSchemaRequest(buffer_arg) {
   return sqlrpc_v1_db_service_pb.GetTableSchemaRequest.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_sqlrpc_v1_ImportRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_db_service_pb.ImportRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.ImportRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_ImportRequest(buffer_arg) {
+  return sqlrpc_v1_db_service_pb.ImportRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_ImportResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_db_service_pb.ImportResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.ImportResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_ImportResponse(buffer_arg) {
+  return sqlrpc_v1_db_service_pb.ImportResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_sqlrpc_v1_IntegrityCheckRequest(arg) {
   if (!(arg instanceof sqlrpc_v1_db_service_pb.IntegrityCheckRequest)) {
     throw new Error('Expected argument of type sqlrpc.v1.IntegrityCheckRequest');
@@ -198,6 +352,28 @@ function deserialize_sqlrpc_v1_IntegrityCheckResponse(buffer_arg) {
   return sqlrpc_v1_db_service_pb.IntegrityCheckResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_sqlrpc_v1_ListBackupsRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_db_service_pb.ListBackupsRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.ListBackupsRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_ListBackupsRequest(buffer_arg) {
+  return sqlrpc_v1_db_service_pb.ListBackupsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_ListBackupsResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_db_service_pb.ListBackupsResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.ListBackupsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_ListBackupsResponse(buffer_arg) {
+  return sqlrpc_v1_db_service_pb.ListBackupsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_sqlrpc_v1_ListExtensionsRequest(arg) {
   if (!(arg instanceof sqlrpc_v1_db_service_pb.ListExtensionsRequest)) {
     throw new Error('Expected argument of type sqlrpc.v1.ListExtensionsRequest');
@@ -264,6 +440,28 @@ function deserialize_sqlrpc_v1_LoadExtensionResponse(buffer_arg) {
   return sqlrpc_v1_db_service_pb.LoadExtensionResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_sqlrpc_v1_OpenCursorRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_db_service_pb.OpenCursorRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.OpenCursorRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_OpenCursorRequest(buffer_arg) {
+  return sqlrpc_v1_db_service_pb.OpenCursorRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_OpenCursorResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_db_service_pb.OpenCursorResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.OpenCursorResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_OpenCursorResponse(buffer_arg) {
+  return sqlrpc_v1_db_service_pb.OpenCursorResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_sqlrpc_v1_PublishBatchRequest(arg) {
   if (!(arg instanceof sqlrpc_v1_db_service_pb.PublishBatchRequest)) {
     throw new Error('Expected argument of type sqlrpc.v1.PublishBatchRequest');
@@ -341,6 +539,28 @@ function deserialize_sqlrpc_v1_QueryResult(buffer_arg) {
   return sqlrpc_v1_db_service_pb.QueryResult.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_sqlrpc_v1_RestoreDatabaseRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_db_service_pb.RestoreDatabaseRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.RestoreDatabaseRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_RestoreDatabaseRequest(buffer_arg) {
+  return sqlrpc_v1_db_service_pb.RestoreDatabaseRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_sqlrpc_v1_RestoreDatabaseResponse(arg) {
+  if (!(arg instanceof sqlrpc_v1_db_service_pb.RestoreDatabaseResponse)) {
+    throw new Error('Expected argument of type sqlrpc.v1.RestoreDatabaseResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_RestoreDatabaseResponse(buffer_arg) {
+  return sqlrpc_v1_db_service_pb.RestoreDatabaseResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_sqlrpc_v1_SavepointResponse(arg) {
   if (!(arg instanceof sqlrpc_v1_db_service_pb.SavepointResponse)) {
     throw new Error('Expected argument of type sqlrpc.v1.SavepointResponse');
@@ -451,6 +671,17 @@ function deserialize_sqlrpc_v1_TransactionSavepointRequest(buffer_arg) {
   return sqlrpc_v1_db_service_pb.TransactionSavepointRequest.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_sqlrpc_v1_TypedBatchExecRequest(arg) {
+  if (!(arg instanceof sqlrpc_v1_db_service_pb.TypedBatchExecRequest)) {
+    throw new Error('Expected argument of type sqlrpc.v1.TypedBatchExecRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_sqlrpc_v1_TypedBatchExecRequest(buffer_arg) {
+  return sqlrpc_v1_db_service_pb.TypedBatchExecRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_sqlrpc_v1_TypedQueryRequest(arg) {
   if (!(arg instanceof sqlrpc_v1_db_service_pb.TypedQueryRequest)) {
     throw new Error('Expected argument of type sqlrpc.v1.TypedQueryRequest');
@@ -699,6 +930,53 @@ rollbackTransaction: {
     responseSerialize: serialize_sqlrpc_v1_TransactionControlResponse,
     responseDeserialize: deserialize_sqlrpc_v1_TransactionControlResponse,
   },
+  // --- Cursors ---
+//
+// *
+// Cursor Open.
+// Runs a read-only query in a read transaction on the Read-Only pool and
+// keeps its result open, pinned to that snapshot, for FetchCursor. Idle
+// cursors are closed like ID-based transactions.
+openCursor: {
+    path: '/sqlrpc.v1.DatabaseService/OpenCursor',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_db_service_pb.OpenCursorRequest,
+    responseType: sqlrpc_v1_db_service_pb.OpenCursorResponse,
+    requestSerialize: serialize_sqlrpc_v1_OpenCursorRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_OpenCursorRequest,
+    responseSerialize: serialize_sqlrpc_v1_OpenCursorResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_OpenCursorResponse,
+  },
+  // *
+// Cursor Fetch.
+// Returns the next rows of a cursor and extends its idle timeout. The cursor
+// is closed once its last row has been fetched.
+fetchCursor: {
+    path: '/sqlrpc.v1.DatabaseService/FetchCursor',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_db_service_pb.FetchCursorRequest,
+    responseType: sqlrpc_v1_db_service_pb.FetchCursorResponse,
+    requestSerialize: serialize_sqlrpc_v1_FetchCursorRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_FetchCursorRequest,
+    responseSerialize: serialize_sqlrpc_v1_FetchCursorResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_FetchCursorResponse,
+  },
+  // *
+// Cursor Close.
+// Closes a cursor, ending its read transaction.
+closeCursor: {
+    path: '/sqlrpc.v1.DatabaseService/CloseCursor',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_db_service_pb.CloseCursorRequest,
+    responseType: sqlrpc_v1_db_service_pb.CloseCursorResponse,
+    requestSerialize: serialize_sqlrpc_v1_CloseCursorRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_CloseCursorRequest,
+    responseSerialize: serialize_sqlrpc_v1_CloseCursorResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_CloseCursorResponse,
+  },
   // --- Utility Operations ---
 //
 // *
@@ -718,6 +996,40 @@ executeTransaction: {
     responseDeserialize: deserialize_sqlrpc_v1_ExecuteTransactionResponse,
   },
   // *
+// Bulk Write Endpoint.
+// Prepares one statement and executes it once per parameter set inside a
+// single implicit transaction. Either every set is applied or, when one
+// fails, none is and the error reports the failing set's index.
+batchExec: {
+    path: '/sqlrpc.v1.DatabaseService/BatchExec',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_db_service_pb.BatchExecRequest,
+    responseType: sqlrpc_v1_db_service_pb.BatchExecResponse,
+    requestSerialize: serialize_sqlrpc_v1_BatchExecRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_BatchExecRequest,
+    responseSerialize: serialize_sqlrpc_v1_BatchExecResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_BatchExecResponse,
+  },
+  // *
+// Bulk Import (Client Stream).
+// The first message names the target table and the format; the following
+// ones carry CSV or NDJSON chunks, or SqlRow batches. Records are inserted
+// through one prepared statement and committed every batch_size records.
+// Records that cannot be parsed or inserted are rejected and counted without
+// stopping the import.
+import: {
+    path: '/sqlrpc.v1.DatabaseService/Import',
+    requestStream: true,
+    responseStream: false,
+    requestType: sqlrpc_v1_db_service_pb.ImportRequest,
+    responseType: sqlrpc_v1_db_service_pb.ImportResponse,
+    requestSerialize: serialize_sqlrpc_v1_ImportRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_ImportRequest,
+    responseSerialize: serialize_sqlrpc_v1_ImportResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_ImportResponse,
+  },
+  // *
 // Typed Read Endpoint (Unary).
 // Automatically routed to a Read-Only replica connection for ROLE_READ_ONLY
 // users.
@@ -748,6 +1060,20 @@ typedExec: {
     responseDeserialize: deserialize_sqlrpc_v1_ExecResponse,
   },
   // *
+// Typed Bulk Write Endpoint.
+// BatchExec with strictly-typed parameter sets.
+typedBatchExec: {
+    path: '/sqlrpc.v1.DatabaseService/TypedBatchExec',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_db_service_pb.TypedBatchExecRequest,
+    responseType: sqlrpc_v1_db_service_pb.BatchExecResponse,
+    requestSerialize: serialize_sqlrpc_v1_TypedBatchExecRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_TypedBatchExecRequest,
+    responseSerialize: serialize_sqlrpc_v1_BatchExecResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_BatchExecResponse,
+  },
+  // *
 // Typed Read Endpoint (Streaming).
 // Routes to the global Read-Only or Read-Write LRU connection depending on
 // user role.
@@ -953,6 +1279,84 @@ detachDatabase: {
     responseSerialize: serialize_sqlrpc_v1_DetachDatabaseResponse,
     responseDeserialize: deserialize_sqlrpc_v1_DetachDatabaseResponse,
   },
+  // --- Backup & Restore ---
+//
+// *
+// Backup: Create.
+// Takes a consistent snapshot with the SQLite online backup API and stores
+// it in the server's backups directory, optionally gzip-compressed, along
+// with a SHA-256 checksum.
+backupDatabase: {
+    path: '/sqlrpc.v1.DatabaseService/BackupDatabase',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_db_service_pb.BackupDatabaseRequest,
+    responseType: sqlrpc_v1_db_service_pb.BackupDatabaseResponse,
+    requestSerialize: serialize_sqlrpc_v1_BackupDatabaseRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_BackupDatabaseRequest,
+    responseSerialize: serialize_sqlrpc_v1_BackupDatabaseResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_BackupDatabaseResponse,
+  },
+  // *
+// Backup: List.
+// Lists the stored backups of a database, newest first.
+listBackups: {
+    path: '/sqlrpc.v1.DatabaseService/ListBackups',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_db_service_pb.ListBackupsRequest,
+    responseType: sqlrpc_v1_db_service_pb.ListBackupsResponse,
+    requestSerialize: serialize_sqlrpc_v1_ListBackupsRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_ListBackupsRequest,
+    responseSerialize: serialize_sqlrpc_v1_ListBackupsResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_ListBackupsResponse,
+  },
+  // *
+// Backup: Restore.
+// Verifies a stored backup, quiesces the database, swaps the file and drops
+// all cached connections. Fails while transactions are open on the database.
+restoreDatabase: {
+    path: '/sqlrpc.v1.DatabaseService/RestoreDatabase',
+    requestStream: false,
+    responseStream: false,
+    requestType: sqlrpc_v1_db_service_pb.RestoreDatabaseRequest,
+    responseType: sqlrpc_v1_db_service_pb.RestoreDatabaseResponse,
+    requestSerialize: serialize_sqlrpc_v1_RestoreDatabaseRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_RestoreDatabaseRequest,
+    responseSerialize: serialize_sqlrpc_v1_RestoreDatabaseResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_RestoreDatabaseResponse,
+  },
+  // *
+// Backup: Download.
+// Streams a stored backup, or a fresh snapshot when no backup is named, in
+// chunks. The first message carries the backup metadata.
+downloadBackup: {
+    path: '/sqlrpc.v1.DatabaseService/DownloadBackup',
+    requestStream: false,
+    responseStream: true,
+    requestType: sqlrpc_v1_db_service_pb.DownloadBackupRequest,
+    responseType: sqlrpc_v1_db_service_pb.DownloadBackupResponse,
+    requestSerialize: serialize_sqlrpc_v1_DownloadBackupRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_DownloadBackupRequest,
+    responseSerialize: serialize_sqlrpc_v1_DownloadBackupResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_DownloadBackupResponse,
+  },
+  // *
+// Backup: Dump.
+// Streams a `.dump`-style SQL script recreating the schema and data of a
+// database, or of some of its tables. Row and column security applies to
+// the data read.
+dumpDatabase: {
+    path: '/sqlrpc.v1.DatabaseService/DumpDatabase',
+    requestStream: false,
+    responseStream: true,
+    requestType: sqlrpc_v1_db_service_pb.DumpDatabaseRequest,
+    responseType: sqlrpc_v1_db_service_pb.DumpDatabaseResponse,
+    requestSerialize: serialize_sqlrpc_v1_DumpDatabaseRequest,
+    requestDeserialize: deserialize_sqlrpc_v1_DumpDatabaseRequest,
+    responseSerialize: serialize_sqlrpc_v1_DumpDatabaseResponse,
+    responseDeserialize: deserialize_sqlrpc_v1_DumpDatabaseResponse,
+  },
   // --- Extension Management ---
 //
 // *
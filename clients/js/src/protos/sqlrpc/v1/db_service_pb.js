@@ -31,31 +31,54 @@ var sqlrpc_v1_types_pb = require('../../sqlrpc/v1/types_pb.js');
 goog.object.extend(proto, sqlrpc_v1_types_pb);
 goog.exportSymbol('proto.sqlrpc.v1.AttachDatabaseRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.AttachDatabaseResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.BackupDatabaseRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.BackupDatabaseResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.BackupInfo', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.BatchExecRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.BatchExecResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.BeginRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.BeginResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.BeginTransactionRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.BeginTransactionResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.CheckpointRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.CheckpointResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.CloseCursorRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.CloseCursorResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.CommitResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.DMLResult', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.DetachDatabaseRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.DetachDatabaseResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.DownloadBackupRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.DownloadBackupResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.DumpDatabaseRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.DumpDatabaseResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.ErrorResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.ExecResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.ExecuteTransactionRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.ExecuteTransactionResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.ExplainResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.FetchCursorRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.FetchCursorResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.GetDatabaseSchemaRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.GetTableSchemaRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ImportRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ImportRequest.PayloadCase', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ImportResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ImportRowBatch', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ImportRowError', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ImportStart', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.IntegrityCheckRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.IntegrityCheckResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ListBackupsRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ListBackupsResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.ListExtensionsRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.ListExtensionsResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.ListTablesRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.ListTablesResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.LoadExtensionRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.LoadExtensionResponse', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.OpenCursorRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.OpenCursorResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.PublishBatchRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.PublishBatchResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.PublishItem', null, global);
@@ -68,6 +91,8 @@ goog.exportSymbol('proto.sqlrpc.v1.QueryResponse.ResponseCase', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.QueryResult', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.QueryResultHeader', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.QueryResultRowBatch', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.RestoreDatabaseRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.RestoreDatabaseResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.RollbackResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.SavepointAction', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.SavepointRequest', null, global);
@@ -83,6 +108,7 @@ goog.exportSymbol('proto.sqlrpc.v1.TransactionResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.TransactionResponse.ResponseCase', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.TransactionSavepointRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.TransactionalQueryRequest', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.TypedBatchExecRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.TypedQueryRequest', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.TypedQueryResponse', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.TypedQueryResponse.ResponseCase', null, global);
@@ -376,16 +402,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.TypedQueryRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.sqlrpc.v1.BatchExecRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.BatchExecRequest.repeatedFields_, null);
 };
-goog.inherits(proto.sqlrpc.v1.TypedQueryRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.BatchExecRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.TypedQueryRequest.displayName = 'proto.sqlrpc.v1.TypedQueryRequest';
+  proto.sqlrpc.v1.BatchExecRequest.displayName = 'proto.sqlrpc.v1.BatchExecRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -397,16 +423,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.TypedTransactionQueryRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.sqlrpc.v1.BatchExecResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.BatchExecResponse.repeatedFields_, null);
 };
-goog.inherits(proto.sqlrpc.v1.TypedTransactionQueryRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.BatchExecResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.TypedTransactionQueryRequest.displayName = 'proto.sqlrpc.v1.TypedTransactionQueryRequest';
+  proto.sqlrpc.v1.BatchExecResponse.displayName = 'proto.sqlrpc.v1.BatchExecResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -418,16 +444,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.TypedQueryResult = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.TypedQueryResult.repeatedFields_, null);
+proto.sqlrpc.v1.OpenCursorRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.TypedQueryResult, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.OpenCursorRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.TypedQueryResult.displayName = 'proto.sqlrpc.v1.TypedQueryResult';
+  proto.sqlrpc.v1.OpenCursorRequest.displayName = 'proto.sqlrpc.v1.OpenCursorRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -439,16 +465,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.QueryResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.sqlrpc.v1.QueryResponse.oneofGroups_);
+proto.sqlrpc.v1.OpenCursorResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.QueryResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.OpenCursorResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.QueryResponse.displayName = 'proto.sqlrpc.v1.QueryResponse';
+  proto.sqlrpc.v1.OpenCursorResponse.displayName = 'proto.sqlrpc.v1.OpenCursorResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -460,16 +486,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.TypedQueryResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.sqlrpc.v1.TypedQueryResponse.oneofGroups_);
+proto.sqlrpc.v1.FetchCursorRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.TypedQueryResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.FetchCursorRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.TypedQueryResponse.displayName = 'proto.sqlrpc.v1.TypedQueryResponse';
+  proto.sqlrpc.v1.FetchCursorRequest.displayName = 'proto.sqlrpc.v1.FetchCursorRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -481,16 +507,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.QueryResultHeader = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.QueryResultHeader.repeatedFields_, null);
+proto.sqlrpc.v1.FetchCursorResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.FetchCursorResponse.repeatedFields_, null);
 };
-goog.inherits(proto.sqlrpc.v1.QueryResultHeader, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.FetchCursorResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.QueryResultHeader.displayName = 'proto.sqlrpc.v1.QueryResultHeader';
+  proto.sqlrpc.v1.FetchCursorResponse.displayName = 'proto.sqlrpc.v1.FetchCursorResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -502,16 +528,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.QueryResultRowBatch = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.QueryResultRowBatch.repeatedFields_, null);
+proto.sqlrpc.v1.CloseCursorRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.QueryResultRowBatch, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.CloseCursorRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.QueryResultRowBatch.displayName = 'proto.sqlrpc.v1.QueryResultRowBatch';
+  proto.sqlrpc.v1.CloseCursorRequest.displayName = 'proto.sqlrpc.v1.CloseCursorRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -523,16 +549,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.TypedQueryResultHeader = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.TypedQueryResultHeader.repeatedFields_, null);
+proto.sqlrpc.v1.CloseCursorResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.TypedQueryResultHeader, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.CloseCursorResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.TypedQueryResultHeader.displayName = 'proto.sqlrpc.v1.TypedQueryResultHeader';
+  proto.sqlrpc.v1.CloseCursorResponse.displayName = 'proto.sqlrpc.v1.CloseCursorResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -544,16 +570,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.TypedQueryResultRowBatch = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.TypedQueryResultRowBatch.repeatedFields_, null);
+proto.sqlrpc.v1.TypedQueryRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.TypedQueryResultRowBatch, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.TypedQueryRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.TypedQueryResultRowBatch.displayName = 'proto.sqlrpc.v1.TypedQueryResultRowBatch';
+  proto.sqlrpc.v1.TypedQueryRequest.displayName = 'proto.sqlrpc.v1.TypedQueryRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -565,16 +591,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.QueryComplete = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.sqlrpc.v1.ImportRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.sqlrpc.v1.ImportRequest.oneofGroups_);
 };
-goog.inherits(proto.sqlrpc.v1.QueryComplete, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.ImportRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.QueryComplete.displayName = 'proto.sqlrpc.v1.QueryComplete';
+  proto.sqlrpc.v1.ImportRequest.displayName = 'proto.sqlrpc.v1.ImportRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -586,16 +612,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.ErrorResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.sqlrpc.v1.ImportStart = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.ImportStart.repeatedFields_, null);
 };
-goog.inherits(proto.sqlrpc.v1.ErrorResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.ImportStart, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.ErrorResponse.displayName = 'proto.sqlrpc.v1.ErrorResponse';
+  proto.sqlrpc.v1.ImportStart.displayName = 'proto.sqlrpc.v1.ImportStart';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -607,16 +633,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.TransactionRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.sqlrpc.v1.TransactionRequest.oneofGroups_);
+proto.sqlrpc.v1.ImportRowBatch = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.ImportRowBatch.repeatedFields_, null);
 };
-goog.inherits(proto.sqlrpc.v1.TransactionRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.ImportRowBatch, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.TransactionRequest.displayName = 'proto.sqlrpc.v1.TransactionRequest';
+  proto.sqlrpc.v1.ImportRowBatch.displayName = 'proto.sqlrpc.v1.ImportRowBatch';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -628,16 +654,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.TransactionResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.sqlrpc.v1.TransactionResponse.oneofGroups_);
+proto.sqlrpc.v1.ImportRowError = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.TransactionResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.ImportRowError, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.TransactionResponse.displayName = 'proto.sqlrpc.v1.TransactionResponse';
+  proto.sqlrpc.v1.ImportRowError.displayName = 'proto.sqlrpc.v1.ImportRowError';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -649,16 +675,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.ExplainResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.ExplainResponse.repeatedFields_, null);
+proto.sqlrpc.v1.ImportResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.ImportResponse.repeatedFields_, null);
 };
-goog.inherits(proto.sqlrpc.v1.ExplainResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.ImportResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.ExplainResponse.displayName = 'proto.sqlrpc.v1.ExplainResponse';
+  proto.sqlrpc.v1.ImportResponse.displayName = 'proto.sqlrpc.v1.ImportResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -670,16 +696,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.ListTablesRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.sqlrpc.v1.TypedBatchExecRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.TypedBatchExecRequest.repeatedFields_, null);
 };
-goog.inherits(proto.sqlrpc.v1.ListTablesRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.TypedBatchExecRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.ListTablesRequest.displayName = 'proto.sqlrpc.v1.ListTablesRequest';
+  proto.sqlrpc.v1.TypedBatchExecRequest.displayName = 'proto.sqlrpc.v1.TypedBatchExecRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -691,16 +717,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.ListTablesResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.ListTablesResponse.repeatedFields_, null);
+proto.sqlrpc.v1.TypedTransactionQueryRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.ListTablesResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.TypedTransactionQueryRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.ListTablesResponse.displayName = 'proto.sqlrpc.v1.ListTablesResponse';
+  proto.sqlrpc.v1.TypedTransactionQueryRequest.displayName = 'proto.sqlrpc.v1.TypedTransactionQueryRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -712,16 +738,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.GetTableSchemaRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.sqlrpc.v1.TypedQueryResult = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.TypedQueryResult.repeatedFields_, null);
 };
-goog.inherits(proto.sqlrpc.v1.GetTableSchemaRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.TypedQueryResult, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.GetTableSchemaRequest.displayName = 'proto.sqlrpc.v1.GetTableSchemaRequest';
+  proto.sqlrpc.v1.TypedQueryResult.displayName = 'proto.sqlrpc.v1.TypedQueryResult';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -733,16 +759,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.GetDatabaseSchemaRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.sqlrpc.v1.QueryResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.sqlrpc.v1.QueryResponse.oneofGroups_);
 };
-goog.inherits(proto.sqlrpc.v1.GetDatabaseSchemaRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.QueryResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.GetDatabaseSchemaRequest.displayName = 'proto.sqlrpc.v1.GetDatabaseSchemaRequest';
+  proto.sqlrpc.v1.QueryResponse.displayName = 'proto.sqlrpc.v1.QueryResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -754,16 +780,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.VacuumRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.sqlrpc.v1.TypedQueryResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.sqlrpc.v1.TypedQueryResponse.oneofGroups_);
 };
-goog.inherits(proto.sqlrpc.v1.VacuumRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.TypedQueryResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.VacuumRequest.displayName = 'proto.sqlrpc.v1.VacuumRequest';
+  proto.sqlrpc.v1.TypedQueryResponse.displayName = 'proto.sqlrpc.v1.TypedQueryResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -775,16 +801,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.VacuumResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.sqlrpc.v1.QueryResultHeader = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.QueryResultHeader.repeatedFields_, null);
 };
-goog.inherits(proto.sqlrpc.v1.VacuumResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.QueryResultHeader, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.VacuumResponse.displayName = 'proto.sqlrpc.v1.VacuumResponse';
+  proto.sqlrpc.v1.QueryResultHeader.displayName = 'proto.sqlrpc.v1.QueryResultHeader';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -796,16 +822,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.CheckpointRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.sqlrpc.v1.QueryResultRowBatch = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.QueryResultRowBatch.repeatedFields_, null);
 };
-goog.inherits(proto.sqlrpc.v1.CheckpointRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.QueryResultRowBatch, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.CheckpointRequest.displayName = 'proto.sqlrpc.v1.CheckpointRequest';
+  proto.sqlrpc.v1.QueryResultRowBatch.displayName = 'proto.sqlrpc.v1.QueryResultRowBatch';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -817,16 +843,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.CheckpointResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.sqlrpc.v1.TypedQueryResultHeader = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.TypedQueryResultHeader.repeatedFields_, null);
 };
-goog.inherits(proto.sqlrpc.v1.CheckpointResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.TypedQueryResultHeader, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.CheckpointResponse.displayName = 'proto.sqlrpc.v1.CheckpointResponse';
+  proto.sqlrpc.v1.TypedQueryResultHeader.displayName = 'proto.sqlrpc.v1.TypedQueryResultHeader';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -838,16 +864,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.IntegrityCheckRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.sqlrpc.v1.TypedQueryResultRowBatch = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.TypedQueryResultRowBatch.repeatedFields_, null);
 };
-goog.inherits(proto.sqlrpc.v1.IntegrityCheckRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.TypedQueryResultRowBatch, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.IntegrityCheckRequest.displayName = 'proto.sqlrpc.v1.IntegrityCheckRequest';
+  proto.sqlrpc.v1.TypedQueryResultRowBatch.displayName = 'proto.sqlrpc.v1.TypedQueryResultRowBatch';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -859,16 +885,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.IntegrityCheckResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.IntegrityCheckResponse.repeatedFields_, null);
+proto.sqlrpc.v1.QueryComplete = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.IntegrityCheckResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.QueryComplete, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.IntegrityCheckResponse.displayName = 'proto.sqlrpc.v1.IntegrityCheckResponse';
+  proto.sqlrpc.v1.QueryComplete.displayName = 'proto.sqlrpc.v1.QueryComplete';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -880,16 +906,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.AttachDatabaseRequest = function(opt_data) {
+proto.sqlrpc.v1.ErrorResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.AttachDatabaseRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.ErrorResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.AttachDatabaseRequest.displayName = 'proto.sqlrpc.v1.AttachDatabaseRequest';
+  proto.sqlrpc.v1.ErrorResponse.displayName = 'proto.sqlrpc.v1.ErrorResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -901,16 +927,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.AttachDatabaseResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.sqlrpc.v1.TransactionRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.sqlrpc.v1.TransactionRequest.oneofGroups_);
 };
-goog.inherits(proto.sqlrpc.v1.AttachDatabaseResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.TransactionRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.AttachDatabaseResponse.displayName = 'proto.sqlrpc.v1.AttachDatabaseResponse';
+  proto.sqlrpc.v1.TransactionRequest.displayName = 'proto.sqlrpc.v1.TransactionRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -922,16 +948,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.DetachDatabaseRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.sqlrpc.v1.TransactionResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.sqlrpc.v1.TransactionResponse.oneofGroups_);
 };
-goog.inherits(proto.sqlrpc.v1.DetachDatabaseRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.TransactionResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.DetachDatabaseRequest.displayName = 'proto.sqlrpc.v1.DetachDatabaseRequest';
+  proto.sqlrpc.v1.TransactionResponse.displayName = 'proto.sqlrpc.v1.TransactionResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -943,16 +969,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.DetachDatabaseResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.sqlrpc.v1.ExplainResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.ExplainResponse.repeatedFields_, null);
 };
-goog.inherits(proto.sqlrpc.v1.DetachDatabaseResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.ExplainResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.DetachDatabaseResponse.displayName = 'proto.sqlrpc.v1.DetachDatabaseResponse';
+  proto.sqlrpc.v1.ExplainResponse.displayName = 'proto.sqlrpc.v1.ExplainResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -964,16 +990,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.BeginRequest = function(opt_data) {
+proto.sqlrpc.v1.ListTablesRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.BeginRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.ListTablesRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.BeginRequest.displayName = 'proto.sqlrpc.v1.BeginRequest';
+  proto.sqlrpc.v1.ListTablesRequest.displayName = 'proto.sqlrpc.v1.ListTablesRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -985,16 +1011,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.TransactionalQueryRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.sqlrpc.v1.ListTablesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.ListTablesResponse.repeatedFields_, null);
 };
-goog.inherits(proto.sqlrpc.v1.TransactionalQueryRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.ListTablesResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.TransactionalQueryRequest.displayName = 'proto.sqlrpc.v1.TransactionalQueryRequest';
+  proto.sqlrpc.v1.ListTablesResponse.displayName = 'proto.sqlrpc.v1.ListTablesResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1006,16 +1032,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.SavepointRequest = function(opt_data) {
+proto.sqlrpc.v1.GetTableSchemaRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.SavepointRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.GetTableSchemaRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.SavepointRequest.displayName = 'proto.sqlrpc.v1.SavepointRequest';
+  proto.sqlrpc.v1.GetTableSchemaRequest.displayName = 'proto.sqlrpc.v1.GetTableSchemaRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1027,16 +1053,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.BeginResponse = function(opt_data) {
+proto.sqlrpc.v1.GetDatabaseSchemaRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.BeginResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.GetDatabaseSchemaRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.BeginResponse.displayName = 'proto.sqlrpc.v1.BeginResponse';
+  proto.sqlrpc.v1.GetDatabaseSchemaRequest.displayName = 'proto.sqlrpc.v1.GetDatabaseSchemaRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1048,16 +1074,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.CommitResponse = function(opt_data) {
+proto.sqlrpc.v1.VacuumRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.CommitResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.VacuumRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.CommitResponse.displayName = 'proto.sqlrpc.v1.CommitResponse';
+  proto.sqlrpc.v1.VacuumRequest.displayName = 'proto.sqlrpc.v1.VacuumRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1069,16 +1095,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.RollbackResponse = function(opt_data) {
+proto.sqlrpc.v1.VacuumResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.RollbackResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.VacuumResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.RollbackResponse.displayName = 'proto.sqlrpc.v1.RollbackResponse';
+  proto.sqlrpc.v1.VacuumResponse.displayName = 'proto.sqlrpc.v1.VacuumResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1090,16 +1116,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.TypedTransactionalQueryRequest = function(opt_data) {
+proto.sqlrpc.v1.CheckpointRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.TypedTransactionalQueryRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.CheckpointRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.TypedTransactionalQueryRequest.displayName = 'proto.sqlrpc.v1.TypedTransactionalQueryRequest';
+  proto.sqlrpc.v1.CheckpointRequest.displayName = 'proto.sqlrpc.v1.CheckpointRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1111,16 +1137,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.ListExtensionsRequest = function(opt_data) {
+proto.sqlrpc.v1.CheckpointResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.ListExtensionsRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.CheckpointResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.ListExtensionsRequest.displayName = 'proto.sqlrpc.v1.ListExtensionsRequest';
+  proto.sqlrpc.v1.CheckpointResponse.displayName = 'proto.sqlrpc.v1.CheckpointResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1132,16 +1158,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.ListExtensionsResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.ListExtensionsResponse.repeatedFields_, null);
+proto.sqlrpc.v1.IntegrityCheckRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.ListExtensionsResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.IntegrityCheckRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.ListExtensionsResponse.displayName = 'proto.sqlrpc.v1.ListExtensionsResponse';
+  proto.sqlrpc.v1.IntegrityCheckRequest.displayName = 'proto.sqlrpc.v1.IntegrityCheckRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1153,16 +1179,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.LoadExtensionRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.sqlrpc.v1.IntegrityCheckResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.IntegrityCheckResponse.repeatedFields_, null);
 };
-goog.inherits(proto.sqlrpc.v1.LoadExtensionRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.IntegrityCheckResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.LoadExtensionRequest.displayName = 'proto.sqlrpc.v1.LoadExtensionRequest';
+  proto.sqlrpc.v1.IntegrityCheckResponse.displayName = 'proto.sqlrpc.v1.IntegrityCheckResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1174,16 +1200,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.LoadExtensionResponse = function(opt_data) {
+proto.sqlrpc.v1.BackupInfo = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.LoadExtensionResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.BackupInfo, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.LoadExtensionResponse.displayName = 'proto.sqlrpc.v1.LoadExtensionResponse';
+  proto.sqlrpc.v1.BackupInfo.displayName = 'proto.sqlrpc.v1.BackupInfo';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1195,16 +1221,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.PublishItem = function(opt_data) {
+proto.sqlrpc.v1.BackupDatabaseRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.PublishItem, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.BackupDatabaseRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.PublishItem.displayName = 'proto.sqlrpc.v1.PublishItem';
+  proto.sqlrpc.v1.BackupDatabaseRequest.displayName = 'proto.sqlrpc.v1.BackupDatabaseRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1216,16 +1242,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.PublishRequest = function(opt_data) {
+proto.sqlrpc.v1.BackupDatabaseResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.PublishRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.BackupDatabaseResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.PublishRequest.displayName = 'proto.sqlrpc.v1.PublishRequest';
+  proto.sqlrpc.v1.BackupDatabaseResponse.displayName = 'proto.sqlrpc.v1.BackupDatabaseResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1237,16 +1263,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.PublishBatchRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.PublishBatchRequest.repeatedFields_, null);
+proto.sqlrpc.v1.ListBackupsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.PublishBatchRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.ListBackupsRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.PublishBatchRequest.displayName = 'proto.sqlrpc.v1.PublishBatchRequest';
+  proto.sqlrpc.v1.ListBackupsRequest.displayName = 'proto.sqlrpc.v1.ListBackupsRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1258,16 +1284,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.PublishResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.sqlrpc.v1.ListBackupsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.ListBackupsResponse.repeatedFields_, null);
 };
-goog.inherits(proto.sqlrpc.v1.PublishResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.ListBackupsResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.PublishResponse.displayName = 'proto.sqlrpc.v1.PublishResponse';
+  proto.sqlrpc.v1.ListBackupsResponse.displayName = 'proto.sqlrpc.v1.ListBackupsResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1279,16 +1305,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.PublishBatchResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.PublishBatchResponse.repeatedFields_, null);
+proto.sqlrpc.v1.RestoreDatabaseRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.PublishBatchResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.RestoreDatabaseRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.PublishBatchResponse.displayName = 'proto.sqlrpc.v1.PublishBatchResponse';
+  proto.sqlrpc.v1.RestoreDatabaseRequest.displayName = 'proto.sqlrpc.v1.RestoreDatabaseRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1300,16 +1326,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.SubscribeRequest = function(opt_data) {
+proto.sqlrpc.v1.RestoreDatabaseResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.SubscribeRequest, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.RestoreDatabaseResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.SubscribeRequest.displayName = 'proto.sqlrpc.v1.SubscribeRequest';
+  proto.sqlrpc.v1.RestoreDatabaseResponse.displayName = 'proto.sqlrpc.v1.RestoreDatabaseResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1321,170 +1347,4997 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.sqlrpc.v1.SubscribeResponse = function(opt_data) {
+proto.sqlrpc.v1.DownloadBackupRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.sqlrpc.v1.SubscribeResponse, jspb.Message);
+goog.inherits(proto.sqlrpc.v1.DownloadBackupRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.sqlrpc.v1.SubscribeResponse.displayName = 'proto.sqlrpc.v1.SubscribeResponse';
+  proto.sqlrpc.v1.DownloadBackupRequest.displayName = 'proto.sqlrpc.v1.DownloadBackupRequest';
 }
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.sqlrpc.v1.QueryRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.QueryRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.DownloadBackupResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.sqlrpc.v1.DownloadBackupResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.DownloadBackupResponse.displayName = 'proto.sqlrpc.v1.DownloadBackupResponse';
+}
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.QueryRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.sqlrpc.v1.QueryRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-database: jspb.Message.getFieldWithDefault(msg, 1, ""),
-sql: jspb.Message.getFieldWithDefault(msg, 2, ""),
-parameters: (f = msg.getParameters()) && sqlrpc_v1_types_pb.Parameters.toObject(includeInstance, f)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.sqlrpc.v1.DumpDatabaseRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.DumpDatabaseRequest.repeatedFields_, null);
 };
+goog.inherits(proto.sqlrpc.v1.DumpDatabaseRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.DumpDatabaseRequest.displayName = 'proto.sqlrpc.v1.DumpDatabaseRequest';
 }
-
-
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.QueryRequest}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.sqlrpc.v1.QueryRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.QueryRequest;
-  return proto.sqlrpc.v1.QueryRequest.deserializeBinaryFromReader(msg, reader);
+proto.sqlrpc.v1.DumpDatabaseResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.sqlrpc.v1.DumpDatabaseResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.DumpDatabaseResponse.displayName = 'proto.sqlrpc.v1.DumpDatabaseResponse';
+}
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.QueryRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.QueryRequest}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.sqlrpc.v1.QueryRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setDatabase(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setSql(value);
-      break;
-    case 4:
-      var value = new sqlrpc_v1_types_pb.Parameters;
-      reader.readMessage(value,sqlrpc_v1_types_pb.Parameters.deserializeBinaryFromReader);
-      msg.setParameters(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.sqlrpc.v1.AttachDatabaseRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.sqlrpc.v1.AttachDatabaseRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.AttachDatabaseRequest.displayName = 'proto.sqlrpc.v1.AttachDatabaseRequest';
+}
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.sqlrpc.v1.QueryRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.QueryRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.sqlrpc.v1.AttachDatabaseResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.sqlrpc.v1.AttachDatabaseResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.AttachDatabaseResponse.displayName = 'proto.sqlrpc.v1.AttachDatabaseResponse';
+}
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.QueryRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.sqlrpc.v1.QueryRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getDatabase();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getSql();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getParameters();
-  if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      sqlrpc_v1_types_pb.Parameters.serializeBinaryToWriter
-    );
-  }
+proto.sqlrpc.v1.DetachDatabaseRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.sqlrpc.v1.DetachDatabaseRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.DetachDatabaseRequest.displayName = 'proto.sqlrpc.v1.DetachDatabaseRequest';
+}
 /**
- * optional string database = 1;
- * @return {string}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.sqlrpc.v1.QueryRequest.prototype.getDatabase = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.sqlrpc.v1.DetachDatabaseResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.sqlrpc.v1.DetachDatabaseResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.DetachDatabaseResponse.displayName = 'proto.sqlrpc.v1.DetachDatabaseResponse';
+}
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.QueryRequest} returns this
- */
-proto.sqlrpc.v1.QueryRequest.prototype.setDatabase = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.BeginRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.BeginRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.BeginRequest.displayName = 'proto.sqlrpc.v1.BeginRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.TransactionalQueryRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.TransactionalQueryRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.TransactionalQueryRequest.displayName = 'proto.sqlrpc.v1.TransactionalQueryRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.SavepointRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.SavepointRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.SavepointRequest.displayName = 'proto.sqlrpc.v1.SavepointRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.BeginResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.BeginResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.BeginResponse.displayName = 'proto.sqlrpc.v1.BeginResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.CommitResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.CommitResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.CommitResponse.displayName = 'proto.sqlrpc.v1.CommitResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.RollbackResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.RollbackResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.RollbackResponse.displayName = 'proto.sqlrpc.v1.RollbackResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.TypedTransactionalQueryRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.TypedTransactionalQueryRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.TypedTransactionalQueryRequest.displayName = 'proto.sqlrpc.v1.TypedTransactionalQueryRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.ListExtensionsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.ListExtensionsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.ListExtensionsRequest.displayName = 'proto.sqlrpc.v1.ListExtensionsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.ListExtensionsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.ListExtensionsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.sqlrpc.v1.ListExtensionsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.ListExtensionsResponse.displayName = 'proto.sqlrpc.v1.ListExtensionsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.LoadExtensionRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.LoadExtensionRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.LoadExtensionRequest.displayName = 'proto.sqlrpc.v1.LoadExtensionRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.LoadExtensionResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.LoadExtensionResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.LoadExtensionResponse.displayName = 'proto.sqlrpc.v1.LoadExtensionResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.PublishItem = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.PublishItem, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.PublishItem.displayName = 'proto.sqlrpc.v1.PublishItem';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.PublishRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.PublishRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.PublishRequest.displayName = 'proto.sqlrpc.v1.PublishRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.PublishBatchRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.PublishBatchRequest.repeatedFields_, null);
+};
+goog.inherits(proto.sqlrpc.v1.PublishBatchRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.PublishBatchRequest.displayName = 'proto.sqlrpc.v1.PublishBatchRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.PublishResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.PublishResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.PublishResponse.displayName = 'proto.sqlrpc.v1.PublishResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.PublishBatchResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.sqlrpc.v1.PublishBatchResponse.repeatedFields_, null);
+};
+goog.inherits(proto.sqlrpc.v1.PublishBatchResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.PublishBatchResponse.displayName = 'proto.sqlrpc.v1.PublishBatchResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.SubscribeRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.SubscribeRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.SubscribeRequest.displayName = 'proto.sqlrpc.v1.SubscribeRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.SubscribeResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.SubscribeResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.SubscribeResponse.displayName = 'proto.sqlrpc.v1.SubscribeResponse';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.QueryRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.QueryRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.QueryRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.QueryRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+database: jspb.Message.getFieldWithDefault(msg, 1, ""),
+sql: jspb.Message.getFieldWithDefault(msg, 2, ""),
+parameters: (f = msg.getParameters()) && sqlrpc_v1_types_pb.Parameters.toObject(includeInstance, f),
+maxExecutionTimeMs: jspb.Message.getFieldWithDefault(msg, 5, 0),
+resumable: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
+resumeToken: jspb.Message.getFieldWithDefault(msg, 7, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.QueryRequest}
+ */
+proto.sqlrpc.v1.QueryRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.QueryRequest;
+  return proto.sqlrpc.v1.QueryRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.QueryRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.QueryRequest}
+ */
+proto.sqlrpc.v1.QueryRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setSql(value);
+      break;
+    case 4:
+      var value = new sqlrpc_v1_types_pb.Parameters;
+      reader.readMessage(value,sqlrpc_v1_types_pb.Parameters.deserializeBinaryFromReader);
+      msg.setParameters(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxExecutionTimeMs(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setResumable(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setResumeToken(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.QueryRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.QueryRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.QueryRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.QueryRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSql();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getParameters();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      sqlrpc_v1_types_pb.Parameters.serializeBinaryToWriter
+    );
+  }
+  f = message.getMaxExecutionTimeMs();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+  f = message.getResumable();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
+  f = message.getResumeToken();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string database = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.QueryRequest.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.QueryRequest} returns this
+ */
+proto.sqlrpc.v1.QueryRequest.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string sql = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.QueryRequest.prototype.getSql = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.QueryRequest} returns this
+ */
+proto.sqlrpc.v1.QueryRequest.prototype.setSql = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional Parameters parameters = 4;
+ * @return {?proto.sqlrpc.v1.Parameters}
+ */
+proto.sqlrpc.v1.QueryRequest.prototype.getParameters = function() {
+  return /** @type{?proto.sqlrpc.v1.Parameters} */ (
+    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.Parameters, 4));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.Parameters|undefined} value
+ * @return {!proto.sqlrpc.v1.QueryRequest} returns this
+*/
+proto.sqlrpc.v1.QueryRequest.prototype.setParameters = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.QueryRequest} returns this
+ */
+proto.sqlrpc.v1.QueryRequest.prototype.clearParameters = function() {
+  return this.setParameters(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.QueryRequest.prototype.hasParameters = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional int32 max_execution_time_ms = 5;
+ * @return {number}
+ */
+proto.sqlrpc.v1.QueryRequest.prototype.getMaxExecutionTimeMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.QueryRequest} returns this
+ */
+proto.sqlrpc.v1.QueryRequest.prototype.setMaxExecutionTimeMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional bool resumable = 6;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.QueryRequest.prototype.getResumable = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.QueryRequest} returns this
+ */
+proto.sqlrpc.v1.QueryRequest.prototype.setResumable = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
+};
+
+
+/**
+ * optional string resume_token = 7;
+ * @return {string}
+ */
+proto.sqlrpc.v1.QueryRequest.prototype.getResumeToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.QueryRequest} returns this
+ */
+proto.sqlrpc.v1.QueryRequest.prototype.setResumeToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.QueryResult.repeatedFields_ = [1,2,3,4,5];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.QueryResult.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.QueryResult.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.QueryResult} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.QueryResult.toObject = function(includeInstance, msg) {
+  var f, obj = {
+columnsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+columnAffinitiesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+columnDeclaredTypesList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+columnRawTypesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+rowsList: jspb.Message.toObjectList(msg.getRowsList(),
+    google_protobuf_struct_pb.ListValue.toObject, includeInstance),
+stats: (f = msg.getStats()) && sqlrpc_v1_types_pb.ExecutionStats.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.QueryResult}
+ */
+proto.sqlrpc.v1.QueryResult.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.QueryResult;
+  return proto.sqlrpc.v1.QueryResult.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.QueryResult} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.QueryResult}
+ */
+proto.sqlrpc.v1.QueryResult.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.addColumns(value);
+      break;
+    case 2:
+      reader.readPackableEnumInto(msg.getColumnAffinitiesList());
+      break;
+    case 3:
+      reader.readPackableEnumInto(msg.getColumnDeclaredTypesList());
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.addColumnRawTypes(value);
+      break;
+    case 5:
+      var value = new google_protobuf_struct_pb.ListValue;
+      reader.readMessage(value,google_protobuf_struct_pb.ListValue.deserializeBinaryFromReader);
+      msg.addRows(value);
+      break;
+    case 6:
+      var value = new sqlrpc_v1_types_pb.ExecutionStats;
+      reader.readMessage(value,sqlrpc_v1_types_pb.ExecutionStats.deserializeBinaryFromReader);
+      msg.setStats(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.QueryResult.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.QueryResult.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.QueryResult} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.QueryResult.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getColumnsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+  f = message.getColumnAffinitiesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      2,
+      f
+    );
+  }
+  f = message.getColumnDeclaredTypesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      3,
+      f
+    );
+  }
+  f = message.getColumnRawTypesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      4,
+      f
+    );
+  }
+  f = message.getRowsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      5,
+      f,
+      google_protobuf_struct_pb.ListValue.serializeBinaryToWriter
+    );
+  }
+  f = message.getStats();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      sqlrpc_v1_types_pb.ExecutionStats.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated string columns = 1;
+ * @return {!Array<string>}
+ */
+proto.sqlrpc.v1.QueryResult.prototype.getColumnsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ */
+proto.sqlrpc.v1.QueryResult.prototype.setColumnsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ */
+proto.sqlrpc.v1.QueryResult.prototype.addColumns = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ */
+proto.sqlrpc.v1.QueryResult.prototype.clearColumnsList = function() {
+  return this.setColumnsList([]);
+};
+
+
+/**
+ * repeated ColumnAffinity column_affinities = 2;
+ * @return {!Array<!proto.sqlrpc.v1.ColumnAffinity>}
+ */
+proto.sqlrpc.v1.QueryResult.prototype.getColumnAffinitiesList = function() {
+  return /** @type {!Array<!proto.sqlrpc.v1.ColumnAffinity>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.sqlrpc.v1.ColumnAffinity>} value
+ * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ */
+proto.sqlrpc.v1.QueryResult.prototype.setColumnAffinitiesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.ColumnAffinity} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ */
+proto.sqlrpc.v1.QueryResult.prototype.addColumnAffinities = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ */
+proto.sqlrpc.v1.QueryResult.prototype.clearColumnAffinitiesList = function() {
+  return this.setColumnAffinitiesList([]);
+};
+
+
+/**
+ * repeated DeclaredType column_declared_types = 3;
+ * @return {!Array<!proto.sqlrpc.v1.DeclaredType>}
+ */
+proto.sqlrpc.v1.QueryResult.prototype.getColumnDeclaredTypesList = function() {
+  return /** @type {!Array<!proto.sqlrpc.v1.DeclaredType>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.sqlrpc.v1.DeclaredType>} value
+ * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ */
+proto.sqlrpc.v1.QueryResult.prototype.setColumnDeclaredTypesList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.DeclaredType} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ */
+proto.sqlrpc.v1.QueryResult.prototype.addColumnDeclaredTypes = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ */
+proto.sqlrpc.v1.QueryResult.prototype.clearColumnDeclaredTypesList = function() {
+  return this.setColumnDeclaredTypesList([]);
+};
+
+
+/**
+ * repeated string column_raw_types = 4;
+ * @return {!Array<string>}
+ */
+proto.sqlrpc.v1.QueryResult.prototype.getColumnRawTypesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ */
+proto.sqlrpc.v1.QueryResult.prototype.setColumnRawTypesList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ */
+proto.sqlrpc.v1.QueryResult.prototype.addColumnRawTypes = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ */
+proto.sqlrpc.v1.QueryResult.prototype.clearColumnRawTypesList = function() {
+  return this.setColumnRawTypesList([]);
+};
+
+
+/**
+ * repeated google.protobuf.ListValue rows = 5;
+ * @return {!Array<!proto.google.protobuf.ListValue>}
+ */
+proto.sqlrpc.v1.QueryResult.prototype.getRowsList = function() {
+  return /** @type{!Array<!proto.google.protobuf.ListValue>} */ (
+    jspb.Message.getRepeatedWrapperField(this, google_protobuf_struct_pb.ListValue, 5));
+};
+
+
+/**
+ * @param {!Array<!proto.google.protobuf.ListValue>} value
+ * @return {!proto.sqlrpc.v1.QueryResult} returns this
+*/
+proto.sqlrpc.v1.QueryResult.prototype.setRowsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 5, value);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.ListValue=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.google.protobuf.ListValue}
+ */
+proto.sqlrpc.v1.QueryResult.prototype.addRows = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.google.protobuf.ListValue, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ */
+proto.sqlrpc.v1.QueryResult.prototype.clearRowsList = function() {
+  return this.setRowsList([]);
+};
+
+
+/**
+ * optional ExecutionStats stats = 6;
+ * @return {?proto.sqlrpc.v1.ExecutionStats}
+ */
+proto.sqlrpc.v1.QueryResult.prototype.getStats = function() {
+  return /** @type{?proto.sqlrpc.v1.ExecutionStats} */ (
+    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.ExecutionStats, 6));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.ExecutionStats|undefined} value
+ * @return {!proto.sqlrpc.v1.QueryResult} returns this
+*/
+proto.sqlrpc.v1.QueryResult.prototype.setStats = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ */
+proto.sqlrpc.v1.QueryResult.prototype.clearStats = function() {
+  return this.setStats(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.QueryResult.prototype.hasStats = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.ExecResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ExecResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.ExecResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ExecResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+dml: (f = msg.getDml()) && proto.sqlrpc.v1.DMLResult.toObject(includeInstance, f),
+stats: (f = msg.getStats()) && sqlrpc_v1_types_pb.ExecutionStats.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.ExecResponse}
+ */
+proto.sqlrpc.v1.ExecResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.ExecResponse;
+  return proto.sqlrpc.v1.ExecResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.ExecResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.ExecResponse}
+ */
+proto.sqlrpc.v1.ExecResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.sqlrpc.v1.DMLResult;
+      reader.readMessage(value,proto.sqlrpc.v1.DMLResult.deserializeBinaryFromReader);
+      msg.setDml(value);
+      break;
+    case 2:
+      var value = new sqlrpc_v1_types_pb.ExecutionStats;
+      reader.readMessage(value,sqlrpc_v1_types_pb.ExecutionStats.deserializeBinaryFromReader);
+      msg.setStats(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.ExecResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ExecResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ExecResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ExecResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDml();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.sqlrpc.v1.DMLResult.serializeBinaryToWriter
+    );
+  }
+  f = message.getStats();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      sqlrpc_v1_types_pb.ExecutionStats.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional DMLResult dml = 1;
+ * @return {?proto.sqlrpc.v1.DMLResult}
+ */
+proto.sqlrpc.v1.ExecResponse.prototype.getDml = function() {
+  return /** @type{?proto.sqlrpc.v1.DMLResult} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.DMLResult, 1));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.DMLResult|undefined} value
+ * @return {!proto.sqlrpc.v1.ExecResponse} returns this
+*/
+proto.sqlrpc.v1.ExecResponse.prototype.setDml = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.ExecResponse} returns this
+ */
+proto.sqlrpc.v1.ExecResponse.prototype.clearDml = function() {
+  return this.setDml(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.ExecResponse.prototype.hasDml = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional ExecutionStats stats = 2;
+ * @return {?proto.sqlrpc.v1.ExecutionStats}
+ */
+proto.sqlrpc.v1.ExecResponse.prototype.getStats = function() {
+  return /** @type{?proto.sqlrpc.v1.ExecutionStats} */ (
+    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.ExecutionStats, 2));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.ExecutionStats|undefined} value
+ * @return {!proto.sqlrpc.v1.ExecResponse} returns this
+*/
+proto.sqlrpc.v1.ExecResponse.prototype.setStats = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.ExecResponse} returns this
+ */
+proto.sqlrpc.v1.ExecResponse.prototype.clearStats = function() {
+  return this.setStats(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.ExecResponse.prototype.hasStats = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.DMLResult.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.DMLResult.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.DMLResult} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DMLResult.toObject = function(includeInstance, msg) {
+  var f, obj = {
+rowsAffected: jspb.Message.getFieldWithDefault(msg, 1, 0),
+lastInsertId: jspb.Message.getFieldWithDefault(msg, 2, 0),
+stats: (f = msg.getStats()) && sqlrpc_v1_types_pb.ExecutionStats.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.DMLResult}
+ */
+proto.sqlrpc.v1.DMLResult.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.DMLResult;
+  return proto.sqlrpc.v1.DMLResult.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.DMLResult} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.DMLResult}
+ */
+proto.sqlrpc.v1.DMLResult.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setRowsAffected(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLastInsertId(value);
+      break;
+    case 3:
+      var value = new sqlrpc_v1_types_pb.ExecutionStats;
+      reader.readMessage(value,sqlrpc_v1_types_pb.ExecutionStats.deserializeBinaryFromReader);
+      msg.setStats(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.DMLResult.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.DMLResult.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.DMLResult} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.DMLResult.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRowsAffected();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getLastInsertId();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = message.getStats();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      sqlrpc_v1_types_pb.ExecutionStats.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional int64 rows_affected = 1;
+ * @return {number}
+ */
+proto.sqlrpc.v1.DMLResult.prototype.getRowsAffected = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.DMLResult} returns this
+ */
+proto.sqlrpc.v1.DMLResult.prototype.setRowsAffected = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional int64 last_insert_id = 2;
+ * @return {number}
+ */
+proto.sqlrpc.v1.DMLResult.prototype.getLastInsertId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.DMLResult} returns this
+ */
+proto.sqlrpc.v1.DMLResult.prototype.setLastInsertId = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional ExecutionStats stats = 3;
+ * @return {?proto.sqlrpc.v1.ExecutionStats}
+ */
+proto.sqlrpc.v1.DMLResult.prototype.getStats = function() {
+  return /** @type{?proto.sqlrpc.v1.ExecutionStats} */ (
+    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.ExecutionStats, 3));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.ExecutionStats|undefined} value
+ * @return {!proto.sqlrpc.v1.DMLResult} returns this
+*/
+proto.sqlrpc.v1.DMLResult.prototype.setStats = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.DMLResult} returns this
+ */
+proto.sqlrpc.v1.DMLResult.prototype.clearStats = function() {
+  return this.setStats(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.DMLResult.prototype.hasStats = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.BeginTransactionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.BeginTransactionRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.BeginTransactionRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.BeginTransactionRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+database: jspb.Message.getFieldWithDefault(msg, 1, ""),
+timeout: (f = msg.getTimeout()) && google_protobuf_duration_pb.Duration.toObject(includeInstance, f),
+mode: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.BeginTransactionRequest}
+ */
+proto.sqlrpc.v1.BeginTransactionRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.BeginTransactionRequest;
+  return proto.sqlrpc.v1.BeginTransactionRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.BeginTransactionRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.BeginTransactionRequest}
+ */
+proto.sqlrpc.v1.BeginTransactionRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
+      break;
+    case 2:
+      var value = new google_protobuf_duration_pb.Duration;
+      reader.readMessage(value,google_protobuf_duration_pb.Duration.deserializeBinaryFromReader);
+      msg.setTimeout(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.sqlrpc.v1.TransactionLockMode} */ (reader.readEnum());
+      msg.setMode(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.BeginTransactionRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.BeginTransactionRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.BeginTransactionRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.BeginTransactionRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getTimeout();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_duration_pb.Duration.serializeBinaryToWriter
+    );
+  }
+  f = message.getMode();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string database = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.BeginTransactionRequest.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.BeginTransactionRequest} returns this
+ */
+proto.sqlrpc.v1.BeginTransactionRequest.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Duration timeout = 2;
+ * @return {?proto.google.protobuf.Duration}
+ */
+proto.sqlrpc.v1.BeginTransactionRequest.prototype.getTimeout = function() {
+  return /** @type{?proto.google.protobuf.Duration} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_duration_pb.Duration, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Duration|undefined} value
+ * @return {!proto.sqlrpc.v1.BeginTransactionRequest} returns this
+*/
+proto.sqlrpc.v1.BeginTransactionRequest.prototype.setTimeout = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.BeginTransactionRequest} returns this
+ */
+proto.sqlrpc.v1.BeginTransactionRequest.prototype.clearTimeout = function() {
+  return this.setTimeout(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.BeginTransactionRequest.prototype.hasTimeout = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional TransactionLockMode mode = 3;
+ * @return {!proto.sqlrpc.v1.TransactionLockMode}
+ */
+proto.sqlrpc.v1.BeginTransactionRequest.prototype.getMode = function() {
+  return /** @type {!proto.sqlrpc.v1.TransactionLockMode} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.TransactionLockMode} value
+ * @return {!proto.sqlrpc.v1.BeginTransactionRequest} returns this
+ */
+proto.sqlrpc.v1.BeginTransactionRequest.prototype.setMode = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.BeginTransactionResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.BeginTransactionResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.BeginTransactionResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.BeginTransactionResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+transactionId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+expiresAt: (f = msg.getExpiresAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.BeginTransactionResponse}
+ */
+proto.sqlrpc.v1.BeginTransactionResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.BeginTransactionResponse;
+  return proto.sqlrpc.v1.BeginTransactionResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.BeginTransactionResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.BeginTransactionResponse}
+ */
+proto.sqlrpc.v1.BeginTransactionResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setTransactionId(value);
+      break;
+    case 2:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setExpiresAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.BeginTransactionResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.BeginTransactionResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.BeginTransactionResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.BeginTransactionResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTransactionId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getExpiresAt();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string transaction_id = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.BeginTransactionResponse.prototype.getTransactionId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.BeginTransactionResponse} returns this
+ */
+proto.sqlrpc.v1.BeginTransactionResponse.prototype.setTransactionId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp expires_at = 2;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.sqlrpc.v1.BeginTransactionResponse.prototype.getExpiresAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.sqlrpc.v1.BeginTransactionResponse} returns this
+*/
+proto.sqlrpc.v1.BeginTransactionResponse.prototype.setExpiresAt = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.BeginTransactionResponse} returns this
+ */
+proto.sqlrpc.v1.BeginTransactionResponse.prototype.clearExpiresAt = function() {
+  return this.setExpiresAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.BeginTransactionResponse.prototype.hasExpiresAt = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.TransactionQueryRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.TransactionQueryRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.TransactionQueryRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.TransactionQueryRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+transactionId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+sql: jspb.Message.getFieldWithDefault(msg, 2, ""),
+parameters: (f = msg.getParameters()) && sqlrpc_v1_types_pb.Parameters.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.TransactionQueryRequest}
+ */
+proto.sqlrpc.v1.TransactionQueryRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.TransactionQueryRequest;
+  return proto.sqlrpc.v1.TransactionQueryRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.TransactionQueryRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.TransactionQueryRequest}
+ */
+proto.sqlrpc.v1.TransactionQueryRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setTransactionId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setSql(value);
+      break;
+    case 3:
+      var value = new sqlrpc_v1_types_pb.Parameters;
+      reader.readMessage(value,sqlrpc_v1_types_pb.Parameters.deserializeBinaryFromReader);
+      msg.setParameters(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.TransactionQueryRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.TransactionQueryRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.TransactionQueryRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.TransactionQueryRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTransactionId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSql();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getParameters();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      sqlrpc_v1_types_pb.Parameters.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string transaction_id = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.TransactionQueryRequest.prototype.getTransactionId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.TransactionQueryRequest} returns this
+ */
+proto.sqlrpc.v1.TransactionQueryRequest.prototype.setTransactionId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string sql = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.TransactionQueryRequest.prototype.getSql = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.TransactionQueryRequest} returns this
+ */
+proto.sqlrpc.v1.TransactionQueryRequest.prototype.setSql = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional Parameters parameters = 3;
+ * @return {?proto.sqlrpc.v1.Parameters}
+ */
+proto.sqlrpc.v1.TransactionQueryRequest.prototype.getParameters = function() {
+  return /** @type{?proto.sqlrpc.v1.Parameters} */ (
+    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.Parameters, 3));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.Parameters|undefined} value
+ * @return {!proto.sqlrpc.v1.TransactionQueryRequest} returns this
+*/
+proto.sqlrpc.v1.TransactionQueryRequest.prototype.setParameters = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.TransactionQueryRequest} returns this
+ */
+proto.sqlrpc.v1.TransactionQueryRequest.prototype.clearParameters = function() {
+  return this.setParameters(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.TransactionQueryRequest.prototype.hasParameters = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.TransactionSavepointRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.TransactionSavepointRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.TransactionSavepointRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.TransactionSavepointRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+transactionId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+savepoint: (f = msg.getSavepoint()) && proto.sqlrpc.v1.SavepointRequest.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.TransactionSavepointRequest}
+ */
+proto.sqlrpc.v1.TransactionSavepointRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.TransactionSavepointRequest;
+  return proto.sqlrpc.v1.TransactionSavepointRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.TransactionSavepointRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.TransactionSavepointRequest}
+ */
+proto.sqlrpc.v1.TransactionSavepointRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setTransactionId(value);
+      break;
+    case 2:
+      var value = new proto.sqlrpc.v1.SavepointRequest;
+      reader.readMessage(value,proto.sqlrpc.v1.SavepointRequest.deserializeBinaryFromReader);
+      msg.setSavepoint(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.TransactionSavepointRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.TransactionSavepointRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.TransactionSavepointRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.TransactionSavepointRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTransactionId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSavepoint();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.sqlrpc.v1.SavepointRequest.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string transaction_id = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.TransactionSavepointRequest.prototype.getTransactionId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.TransactionSavepointRequest} returns this
+ */
+proto.sqlrpc.v1.TransactionSavepointRequest.prototype.setTransactionId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional SavepointRequest savepoint = 2;
+ * @return {?proto.sqlrpc.v1.SavepointRequest}
+ */
+proto.sqlrpc.v1.TransactionSavepointRequest.prototype.getSavepoint = function() {
+  return /** @type{?proto.sqlrpc.v1.SavepointRequest} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.SavepointRequest, 2));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.SavepointRequest|undefined} value
+ * @return {!proto.sqlrpc.v1.TransactionSavepointRequest} returns this
+*/
+proto.sqlrpc.v1.TransactionSavepointRequest.prototype.setSavepoint = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.TransactionSavepointRequest} returns this
+ */
+proto.sqlrpc.v1.TransactionSavepointRequest.prototype.clearSavepoint = function() {
+  return this.setSavepoint(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.TransactionSavepointRequest.prototype.hasSavepoint = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.SavepointResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.SavepointResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.SavepointResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.SavepointResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+action: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.SavepointResponse}
+ */
+proto.sqlrpc.v1.SavepointResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.SavepointResponse;
+  return proto.sqlrpc.v1.SavepointResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.SavepointResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.SavepointResponse}
+ */
+proto.sqlrpc.v1.SavepointResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.sqlrpc.v1.SavepointAction} */ (reader.readEnum());
+      msg.setAction(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.SavepointResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.SavepointResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.SavepointResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.SavepointResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getAction();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool success = 1;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.SavepointResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.SavepointResponse} returns this
+ */
+proto.sqlrpc.v1.SavepointResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.SavepointResponse.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.SavepointResponse} returns this
+ */
+proto.sqlrpc.v1.SavepointResponse.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional SavepointAction action = 3;
+ * @return {!proto.sqlrpc.v1.SavepointAction}
+ */
+proto.sqlrpc.v1.SavepointResponse.prototype.getAction = function() {
+  return /** @type {!proto.sqlrpc.v1.SavepointAction} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.SavepointAction} value
+ * @return {!proto.sqlrpc.v1.SavepointResponse} returns this
+ */
+proto.sqlrpc.v1.SavepointResponse.prototype.setAction = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.TransactionControlRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.TransactionControlRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.TransactionControlRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.TransactionControlRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+transactionId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.TransactionControlRequest}
+ */
+proto.sqlrpc.v1.TransactionControlRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.TransactionControlRequest;
+  return proto.sqlrpc.v1.TransactionControlRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.TransactionControlRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.TransactionControlRequest}
+ */
+proto.sqlrpc.v1.TransactionControlRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setTransactionId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.TransactionControlRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.TransactionControlRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.TransactionControlRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.TransactionControlRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTransactionId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string transaction_id = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.TransactionControlRequest.prototype.getTransactionId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.TransactionControlRequest} returns this
+ */
+proto.sqlrpc.v1.TransactionControlRequest.prototype.setTransactionId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.TransactionControlResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.TransactionControlResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.TransactionControlResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.TransactionControlResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.TransactionControlResponse}
+ */
+proto.sqlrpc.v1.TransactionControlResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.TransactionControlResponse;
+  return proto.sqlrpc.v1.TransactionControlResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.TransactionControlResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.TransactionControlResponse}
+ */
+proto.sqlrpc.v1.TransactionControlResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.TransactionControlResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.TransactionControlResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.TransactionControlResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.TransactionControlResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool success = 1;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.TransactionControlResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.TransactionControlResponse} returns this
+ */
+proto.sqlrpc.v1.TransactionControlResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.ExecuteTransactionRequest.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.ExecuteTransactionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ExecuteTransactionRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.ExecuteTransactionRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ExecuteTransactionRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+requestsList: jspb.Message.toObjectList(msg.getRequestsList(),
+    proto.sqlrpc.v1.TransactionRequest.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.ExecuteTransactionRequest}
+ */
+proto.sqlrpc.v1.ExecuteTransactionRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.ExecuteTransactionRequest;
+  return proto.sqlrpc.v1.ExecuteTransactionRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.ExecuteTransactionRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.ExecuteTransactionRequest}
+ */
+proto.sqlrpc.v1.ExecuteTransactionRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.sqlrpc.v1.TransactionRequest;
+      reader.readMessage(value,proto.sqlrpc.v1.TransactionRequest.deserializeBinaryFromReader);
+      msg.addRequests(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.ExecuteTransactionRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ExecuteTransactionRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ExecuteTransactionRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ExecuteTransactionRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRequestsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.sqlrpc.v1.TransactionRequest.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated TransactionRequest requests = 1;
+ * @return {!Array<!proto.sqlrpc.v1.TransactionRequest>}
+ */
+proto.sqlrpc.v1.ExecuteTransactionRequest.prototype.getRequestsList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.TransactionRequest>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.sqlrpc.v1.TransactionRequest, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.sqlrpc.v1.TransactionRequest>} value
+ * @return {!proto.sqlrpc.v1.ExecuteTransactionRequest} returns this
+*/
+proto.sqlrpc.v1.ExecuteTransactionRequest.prototype.setRequestsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.TransactionRequest=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.TransactionRequest}
+ */
+proto.sqlrpc.v1.ExecuteTransactionRequest.prototype.addRequests = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.sqlrpc.v1.TransactionRequest, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.ExecuteTransactionRequest} returns this
+ */
+proto.sqlrpc.v1.ExecuteTransactionRequest.prototype.clearRequestsList = function() {
+  return this.setRequestsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.ExecuteTransactionResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.ExecuteTransactionResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ExecuteTransactionResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.ExecuteTransactionResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ExecuteTransactionResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+responsesList: jspb.Message.toObjectList(msg.getResponsesList(),
+    proto.sqlrpc.v1.TransactionResponse.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.ExecuteTransactionResponse}
+ */
+proto.sqlrpc.v1.ExecuteTransactionResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.ExecuteTransactionResponse;
+  return proto.sqlrpc.v1.ExecuteTransactionResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.ExecuteTransactionResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.ExecuteTransactionResponse}
+ */
+proto.sqlrpc.v1.ExecuteTransactionResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.sqlrpc.v1.TransactionResponse;
+      reader.readMessage(value,proto.sqlrpc.v1.TransactionResponse.deserializeBinaryFromReader);
+      msg.addResponses(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.ExecuteTransactionResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ExecuteTransactionResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ExecuteTransactionResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ExecuteTransactionResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getResponsesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.sqlrpc.v1.TransactionResponse.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated TransactionResponse responses = 1;
+ * @return {!Array<!proto.sqlrpc.v1.TransactionResponse>}
+ */
+proto.sqlrpc.v1.ExecuteTransactionResponse.prototype.getResponsesList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.TransactionResponse>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.sqlrpc.v1.TransactionResponse, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.sqlrpc.v1.TransactionResponse>} value
+ * @return {!proto.sqlrpc.v1.ExecuteTransactionResponse} returns this
+*/
+proto.sqlrpc.v1.ExecuteTransactionResponse.prototype.setResponsesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.TransactionResponse=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.TransactionResponse}
+ */
+proto.sqlrpc.v1.ExecuteTransactionResponse.prototype.addResponses = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.sqlrpc.v1.TransactionResponse, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.ExecuteTransactionResponse} returns this
+ */
+proto.sqlrpc.v1.ExecuteTransactionResponse.prototype.clearResponsesList = function() {
+  return this.setResponsesList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.BatchExecRequest.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.BatchExecRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.BatchExecRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.BatchExecRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.BatchExecRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+database: jspb.Message.getFieldWithDefault(msg, 1, ""),
+sql: jspb.Message.getFieldWithDefault(msg, 2, ""),
+parameterSetsList: jspb.Message.toObjectList(msg.getParameterSetsList(),
+    sqlrpc_v1_types_pb.Parameters.toObject, includeInstance),
+maxExecutionTimeMs: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.BatchExecRequest}
+ */
+proto.sqlrpc.v1.BatchExecRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.BatchExecRequest;
+  return proto.sqlrpc.v1.BatchExecRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.BatchExecRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.BatchExecRequest}
+ */
+proto.sqlrpc.v1.BatchExecRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setSql(value);
+      break;
+    case 3:
+      var value = new sqlrpc_v1_types_pb.Parameters;
+      reader.readMessage(value,sqlrpc_v1_types_pb.Parameters.deserializeBinaryFromReader);
+      msg.addParameterSets(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxExecutionTimeMs(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.BatchExecRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.BatchExecRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.BatchExecRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.BatchExecRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSql();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getParameterSetsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      sqlrpc_v1_types_pb.Parameters.serializeBinaryToWriter
+    );
+  }
+  f = message.getMaxExecutionTimeMs();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string database = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.BatchExecRequest.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.BatchExecRequest} returns this
+ */
+proto.sqlrpc.v1.BatchExecRequest.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string sql = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.BatchExecRequest.prototype.getSql = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.BatchExecRequest} returns this
+ */
+proto.sqlrpc.v1.BatchExecRequest.prototype.setSql = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated Parameters parameter_sets = 3;
+ * @return {!Array<!proto.sqlrpc.v1.Parameters>}
+ */
+proto.sqlrpc.v1.BatchExecRequest.prototype.getParameterSetsList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.Parameters>} */ (
+    jspb.Message.getRepeatedWrapperField(this, sqlrpc_v1_types_pb.Parameters, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.sqlrpc.v1.Parameters>} value
+ * @return {!proto.sqlrpc.v1.BatchExecRequest} returns this
+*/
+proto.sqlrpc.v1.BatchExecRequest.prototype.setParameterSetsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.Parameters=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.Parameters}
+ */
+proto.sqlrpc.v1.BatchExecRequest.prototype.addParameterSets = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.sqlrpc.v1.Parameters, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.BatchExecRequest} returns this
+ */
+proto.sqlrpc.v1.BatchExecRequest.prototype.clearParameterSetsList = function() {
+  return this.setParameterSetsList([]);
+};
+
+
+/**
+ * optional int32 max_execution_time_ms = 4;
+ * @return {number}
+ */
+proto.sqlrpc.v1.BatchExecRequest.prototype.getMaxExecutionTimeMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.BatchExecRequest} returns this
+ */
+proto.sqlrpc.v1.BatchExecRequest.prototype.setMaxExecutionTimeMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.BatchExecResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.BatchExecResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.BatchExecResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.BatchExecResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.BatchExecResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+resultsList: jspb.Message.toObjectList(msg.getResultsList(),
+    proto.sqlrpc.v1.DMLResult.toObject, includeInstance),
+stats: (f = msg.getStats()) && sqlrpc_v1_types_pb.ExecutionStats.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.BatchExecResponse}
+ */
+proto.sqlrpc.v1.BatchExecResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.BatchExecResponse;
+  return proto.sqlrpc.v1.BatchExecResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.BatchExecResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.BatchExecResponse}
+ */
+proto.sqlrpc.v1.BatchExecResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.sqlrpc.v1.DMLResult;
+      reader.readMessage(value,proto.sqlrpc.v1.DMLResult.deserializeBinaryFromReader);
+      msg.addResults(value);
+      break;
+    case 2:
+      var value = new sqlrpc_v1_types_pb.ExecutionStats;
+      reader.readMessage(value,sqlrpc_v1_types_pb.ExecutionStats.deserializeBinaryFromReader);
+      msg.setStats(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.BatchExecResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.BatchExecResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.BatchExecResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.BatchExecResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getResultsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.sqlrpc.v1.DMLResult.serializeBinaryToWriter
+    );
+  }
+  f = message.getStats();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      sqlrpc_v1_types_pb.ExecutionStats.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated DMLResult results = 1;
+ * @return {!Array<!proto.sqlrpc.v1.DMLResult>}
+ */
+proto.sqlrpc.v1.BatchExecResponse.prototype.getResultsList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.DMLResult>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.sqlrpc.v1.DMLResult, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.sqlrpc.v1.DMLResult>} value
+ * @return {!proto.sqlrpc.v1.BatchExecResponse} returns this
+*/
+proto.sqlrpc.v1.BatchExecResponse.prototype.setResultsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.DMLResult=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.DMLResult}
+ */
+proto.sqlrpc.v1.BatchExecResponse.prototype.addResults = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.sqlrpc.v1.DMLResult, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.BatchExecResponse} returns this
+ */
+proto.sqlrpc.v1.BatchExecResponse.prototype.clearResultsList = function() {
+  return this.setResultsList([]);
+};
+
+
+/**
+ * optional ExecutionStats stats = 2;
+ * @return {?proto.sqlrpc.v1.ExecutionStats}
+ */
+proto.sqlrpc.v1.BatchExecResponse.prototype.getStats = function() {
+  return /** @type{?proto.sqlrpc.v1.ExecutionStats} */ (
+    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.ExecutionStats, 2));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.ExecutionStats|undefined} value
+ * @return {!proto.sqlrpc.v1.BatchExecResponse} returns this
+*/
+proto.sqlrpc.v1.BatchExecResponse.prototype.setStats = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.BatchExecResponse} returns this
+ */
+proto.sqlrpc.v1.BatchExecResponse.prototype.clearStats = function() {
+  return this.setStats(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.BatchExecResponse.prototype.hasStats = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.OpenCursorRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.OpenCursorRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.OpenCursorRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.OpenCursorRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+database: jspb.Message.getFieldWithDefault(msg, 1, ""),
+sql: jspb.Message.getFieldWithDefault(msg, 2, ""),
+parameters: (f = msg.getParameters()) && sqlrpc_v1_types_pb.Parameters.toObject(includeInstance, f),
+timeout: (f = msg.getTimeout()) && google_protobuf_duration_pb.Duration.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.OpenCursorRequest}
+ */
+proto.sqlrpc.v1.OpenCursorRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.OpenCursorRequest;
+  return proto.sqlrpc.v1.OpenCursorRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.OpenCursorRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.OpenCursorRequest}
+ */
+proto.sqlrpc.v1.OpenCursorRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setSql(value);
+      break;
+    case 3:
+      var value = new sqlrpc_v1_types_pb.Parameters;
+      reader.readMessage(value,sqlrpc_v1_types_pb.Parameters.deserializeBinaryFromReader);
+      msg.setParameters(value);
+      break;
+    case 4:
+      var value = new google_protobuf_duration_pb.Duration;
+      reader.readMessage(value,google_protobuf_duration_pb.Duration.deserializeBinaryFromReader);
+      msg.setTimeout(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.OpenCursorRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.OpenCursorRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.OpenCursorRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.OpenCursorRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSql();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getParameters();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      sqlrpc_v1_types_pb.Parameters.serializeBinaryToWriter
+    );
+  }
+  f = message.getTimeout();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_duration_pb.Duration.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string database = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.OpenCursorRequest.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.OpenCursorRequest} returns this
+ */
+proto.sqlrpc.v1.OpenCursorRequest.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string sql = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.OpenCursorRequest.prototype.getSql = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.OpenCursorRequest} returns this
+ */
+proto.sqlrpc.v1.OpenCursorRequest.prototype.setSql = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional Parameters parameters = 3;
+ * @return {?proto.sqlrpc.v1.Parameters}
+ */
+proto.sqlrpc.v1.OpenCursorRequest.prototype.getParameters = function() {
+  return /** @type{?proto.sqlrpc.v1.Parameters} */ (
+    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.Parameters, 3));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.Parameters|undefined} value
+ * @return {!proto.sqlrpc.v1.OpenCursorRequest} returns this
+*/
+proto.sqlrpc.v1.OpenCursorRequest.prototype.setParameters = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.OpenCursorRequest} returns this
+ */
+proto.sqlrpc.v1.OpenCursorRequest.prototype.clearParameters = function() {
+  return this.setParameters(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.OpenCursorRequest.prototype.hasParameters = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional google.protobuf.Duration timeout = 4;
+ * @return {?proto.google.protobuf.Duration}
+ */
+proto.sqlrpc.v1.OpenCursorRequest.prototype.getTimeout = function() {
+  return /** @type{?proto.google.protobuf.Duration} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_duration_pb.Duration, 4));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Duration|undefined} value
+ * @return {!proto.sqlrpc.v1.OpenCursorRequest} returns this
+*/
+proto.sqlrpc.v1.OpenCursorRequest.prototype.setTimeout = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.OpenCursorRequest} returns this
+ */
+proto.sqlrpc.v1.OpenCursorRequest.prototype.clearTimeout = function() {
+  return this.setTimeout(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.OpenCursorRequest.prototype.hasTimeout = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.OpenCursorResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.OpenCursorResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.OpenCursorResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.OpenCursorResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+cursorId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+header: (f = msg.getHeader()) && proto.sqlrpc.v1.QueryResultHeader.toObject(includeInstance, f),
+expiresAt: (f = msg.getExpiresAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.OpenCursorResponse}
+ */
+proto.sqlrpc.v1.OpenCursorResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.OpenCursorResponse;
+  return proto.sqlrpc.v1.OpenCursorResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.OpenCursorResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.OpenCursorResponse}
+ */
+proto.sqlrpc.v1.OpenCursorResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setCursorId(value);
+      break;
+    case 2:
+      var value = new proto.sqlrpc.v1.QueryResultHeader;
+      reader.readMessage(value,proto.sqlrpc.v1.QueryResultHeader.deserializeBinaryFromReader);
+      msg.setHeader(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setExpiresAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.OpenCursorResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.OpenCursorResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.OpenCursorResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.OpenCursorResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCursorId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getHeader();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.sqlrpc.v1.QueryResultHeader.serializeBinaryToWriter
+    );
+  }
+  f = message.getExpiresAt();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string cursor_id = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.OpenCursorResponse.prototype.getCursorId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.OpenCursorResponse} returns this
+ */
+proto.sqlrpc.v1.OpenCursorResponse.prototype.setCursorId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional QueryResultHeader header = 2;
+ * @return {?proto.sqlrpc.v1.QueryResultHeader}
+ */
+proto.sqlrpc.v1.OpenCursorResponse.prototype.getHeader = function() {
+  return /** @type{?proto.sqlrpc.v1.QueryResultHeader} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.QueryResultHeader, 2));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.QueryResultHeader|undefined} value
+ * @return {!proto.sqlrpc.v1.OpenCursorResponse} returns this
+*/
+proto.sqlrpc.v1.OpenCursorResponse.prototype.setHeader = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.OpenCursorResponse} returns this
+ */
+proto.sqlrpc.v1.OpenCursorResponse.prototype.clearHeader = function() {
+  return this.setHeader(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.OpenCursorResponse.prototype.hasHeader = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp expires_at = 3;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.sqlrpc.v1.OpenCursorResponse.prototype.getExpiresAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.sqlrpc.v1.OpenCursorResponse} returns this
+*/
+proto.sqlrpc.v1.OpenCursorResponse.prototype.setExpiresAt = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.OpenCursorResponse} returns this
+ */
+proto.sqlrpc.v1.OpenCursorResponse.prototype.clearExpiresAt = function() {
+  return this.setExpiresAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.OpenCursorResponse.prototype.hasExpiresAt = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.FetchCursorRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.FetchCursorRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.FetchCursorRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.FetchCursorRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+cursorId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+maxRows: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.FetchCursorRequest}
+ */
+proto.sqlrpc.v1.FetchCursorRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.FetchCursorRequest;
+  return proto.sqlrpc.v1.FetchCursorRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.FetchCursorRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.FetchCursorRequest}
+ */
+proto.sqlrpc.v1.FetchCursorRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setCursorId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setMaxRows(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.FetchCursorRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.FetchCursorRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.FetchCursorRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.FetchCursorRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCursorId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getMaxRows();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string cursor_id = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.FetchCursorRequest.prototype.getCursorId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.FetchCursorRequest} returns this
+ */
+proto.sqlrpc.v1.FetchCursorRequest.prototype.setCursorId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional uint32 max_rows = 2;
+ * @return {number}
+ */
+proto.sqlrpc.v1.FetchCursorRequest.prototype.getMaxRows = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.FetchCursorRequest} returns this
+ */
+proto.sqlrpc.v1.FetchCursorRequest.prototype.setMaxRows = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.FetchCursorResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.FetchCursorResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.FetchCursorResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.FetchCursorResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.FetchCursorResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+rowsList: jspb.Message.toObjectList(msg.getRowsList(),
+    google_protobuf_struct_pb.ListValue.toObject, includeInstance),
+done: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+position: jspb.Message.getFieldWithDefault(msg, 3, 0),
+expiresAt: (f = msg.getExpiresAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.FetchCursorResponse}
+ */
+proto.sqlrpc.v1.FetchCursorResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.FetchCursorResponse;
+  return proto.sqlrpc.v1.FetchCursorResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.FetchCursorResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.FetchCursorResponse}
+ */
+proto.sqlrpc.v1.FetchCursorResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new google_protobuf_struct_pb.ListValue;
+      reader.readMessage(value,google_protobuf_struct_pb.ListValue.deserializeBinaryFromReader);
+      msg.addRows(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDone(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setPosition(value);
+      break;
+    case 4:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setExpiresAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.FetchCursorResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.FetchCursorResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.FetchCursorResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.FetchCursorResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRowsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      google_protobuf_struct_pb.ListValue.serializeBinaryToWriter
+    );
+  }
+  f = message.getDone();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getPosition();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = message.getExpiresAt();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated google.protobuf.ListValue rows = 1;
+ * @return {!Array<!proto.google.protobuf.ListValue>}
+ */
+proto.sqlrpc.v1.FetchCursorResponse.prototype.getRowsList = function() {
+  return /** @type{!Array<!proto.google.protobuf.ListValue>} */ (
+    jspb.Message.getRepeatedWrapperField(this, google_protobuf_struct_pb.ListValue, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.google.protobuf.ListValue>} value
+ * @return {!proto.sqlrpc.v1.FetchCursorResponse} returns this
+*/
+proto.sqlrpc.v1.FetchCursorResponse.prototype.setRowsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.ListValue=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.google.protobuf.ListValue}
+ */
+proto.sqlrpc.v1.FetchCursorResponse.prototype.addRows = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.google.protobuf.ListValue, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.FetchCursorResponse} returns this
+ */
+proto.sqlrpc.v1.FetchCursorResponse.prototype.clearRowsList = function() {
+  return this.setRowsList([]);
+};
+
+
+/**
+ * optional bool done = 2;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.FetchCursorResponse.prototype.getDone = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.FetchCursorResponse} returns this
+ */
+proto.sqlrpc.v1.FetchCursorResponse.prototype.setDone = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional int64 position = 3;
+ * @return {number}
+ */
+proto.sqlrpc.v1.FetchCursorResponse.prototype.getPosition = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.FetchCursorResponse} returns this
+ */
+proto.sqlrpc.v1.FetchCursorResponse.prototype.setPosition = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp expires_at = 4;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.sqlrpc.v1.FetchCursorResponse.prototype.getExpiresAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.sqlrpc.v1.FetchCursorResponse} returns this
+*/
+proto.sqlrpc.v1.FetchCursorResponse.prototype.setExpiresAt = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.FetchCursorResponse} returns this
+ */
+proto.sqlrpc.v1.FetchCursorResponse.prototype.clearExpiresAt = function() {
+  return this.setExpiresAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.FetchCursorResponse.prototype.hasExpiresAt = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.CloseCursorRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.CloseCursorRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.CloseCursorRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.CloseCursorRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+cursorId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.CloseCursorRequest}
+ */
+proto.sqlrpc.v1.CloseCursorRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.CloseCursorRequest;
+  return proto.sqlrpc.v1.CloseCursorRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.CloseCursorRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.CloseCursorRequest}
+ */
+proto.sqlrpc.v1.CloseCursorRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setCursorId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.CloseCursorRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.CloseCursorRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.CloseCursorRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.CloseCursorRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCursorId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string cursor_id = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.CloseCursorRequest.prototype.getCursorId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.CloseCursorRequest} returns this
+ */
+proto.sqlrpc.v1.CloseCursorRequest.prototype.setCursorId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.CloseCursorResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.CloseCursorResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.CloseCursorResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.CloseCursorResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.CloseCursorResponse}
+ */
+proto.sqlrpc.v1.CloseCursorResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.CloseCursorResponse;
+  return proto.sqlrpc.v1.CloseCursorResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.CloseCursorResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.CloseCursorResponse}
+ */
+proto.sqlrpc.v1.CloseCursorResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.CloseCursorResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.CloseCursorResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.CloseCursorResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.CloseCursorResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.TypedQueryRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.TypedQueryRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.TypedQueryRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.TypedQueryRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+database: jspb.Message.getFieldWithDefault(msg, 1, ""),
+sql: jspb.Message.getFieldWithDefault(msg, 2, ""),
+parameters: (f = msg.getParameters()) && sqlrpc_v1_types_pb.TypedParameters.toObject(includeInstance, f),
+maxExecutionTimeMs: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.TypedQueryRequest}
+ */
+proto.sqlrpc.v1.TypedQueryRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.TypedQueryRequest;
+  return proto.sqlrpc.v1.TypedQueryRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.TypedQueryRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.TypedQueryRequest}
+ */
+proto.sqlrpc.v1.TypedQueryRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setSql(value);
+      break;
+    case 4:
+      var value = new sqlrpc_v1_types_pb.TypedParameters;
+      reader.readMessage(value,sqlrpc_v1_types_pb.TypedParameters.deserializeBinaryFromReader);
+      msg.setParameters(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxExecutionTimeMs(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.TypedQueryRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.TypedQueryRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.TypedQueryRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.TypedQueryRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSql();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getParameters();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      sqlrpc_v1_types_pb.TypedParameters.serializeBinaryToWriter
+    );
+  }
+  f = message.getMaxExecutionTimeMs();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string database = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.TypedQueryRequest.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.TypedQueryRequest} returns this
+ */
+proto.sqlrpc.v1.TypedQueryRequest.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -1492,45 +6345,364 @@ proto.sqlrpc.v1.QueryRequest.prototype.setDatabase = function(value) {
  * optional string sql = 2;
  * @return {string}
  */
-proto.sqlrpc.v1.QueryRequest.prototype.getSql = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.sqlrpc.v1.TypedQueryRequest.prototype.getSql = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.TypedQueryRequest} returns this
+ */
+proto.sqlrpc.v1.TypedQueryRequest.prototype.setSql = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional TypedParameters parameters = 4;
+ * @return {?proto.sqlrpc.v1.TypedParameters}
+ */
+proto.sqlrpc.v1.TypedQueryRequest.prototype.getParameters = function() {
+  return /** @type{?proto.sqlrpc.v1.TypedParameters} */ (
+    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.TypedParameters, 4));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.TypedParameters|undefined} value
+ * @return {!proto.sqlrpc.v1.TypedQueryRequest} returns this
+*/
+proto.sqlrpc.v1.TypedQueryRequest.prototype.setParameters = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.TypedQueryRequest} returns this
+ */
+proto.sqlrpc.v1.TypedQueryRequest.prototype.clearParameters = function() {
+  return this.setParameters(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.TypedQueryRequest.prototype.hasParameters = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional int32 max_execution_time_ms = 5;
+ * @return {number}
+ */
+proto.sqlrpc.v1.TypedQueryRequest.prototype.getMaxExecutionTimeMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.TypedQueryRequest} returns this
+ */
+proto.sqlrpc.v1.TypedQueryRequest.prototype.setMaxExecutionTimeMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.sqlrpc.v1.ImportRequest.oneofGroups_ = [[1,2,3]];
+
+/**
+ * @enum {number}
+ */
+proto.sqlrpc.v1.ImportRequest.PayloadCase = {
+  PAYLOAD_NOT_SET: 0,
+  START: 1,
+  DATA: 2,
+  ROWS: 3
+};
+
+/**
+ * @return {proto.sqlrpc.v1.ImportRequest.PayloadCase}
+ */
+proto.sqlrpc.v1.ImportRequest.prototype.getPayloadCase = function() {
+  return /** @type {proto.sqlrpc.v1.ImportRequest.PayloadCase} */(jspb.Message.computeOneofCase(this, proto.sqlrpc.v1.ImportRequest.oneofGroups_[0]));
+};
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.ImportRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ImportRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.ImportRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ImportRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+start: (f = msg.getStart()) && proto.sqlrpc.v1.ImportStart.toObject(includeInstance, f),
+data: msg.getData_asB64(),
+rows: (f = msg.getRows()) && proto.sqlrpc.v1.ImportRowBatch.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.ImportRequest}
+ */
+proto.sqlrpc.v1.ImportRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.ImportRequest;
+  return proto.sqlrpc.v1.ImportRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.ImportRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.ImportRequest}
+ */
+proto.sqlrpc.v1.ImportRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.sqlrpc.v1.ImportStart;
+      reader.readMessage(value,proto.sqlrpc.v1.ImportStart.deserializeBinaryFromReader);
+      msg.setStart(value);
+      break;
+    case 2:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
+      break;
+    case 3:
+      var value = new proto.sqlrpc.v1.ImportRowBatch;
+      reader.readMessage(value,proto.sqlrpc.v1.ImportRowBatch.deserializeBinaryFromReader);
+      msg.setRows(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.ImportRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ImportRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ImportRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ImportRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStart();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.sqlrpc.v1.ImportStart.serializeBinaryToWriter
+    );
+  }
+  f = /** @type {!(string|Uint8Array)} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeBytes(
+      2,
+      f
+    );
+  }
+  f = message.getRows();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.sqlrpc.v1.ImportRowBatch.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional ImportStart start = 1;
+ * @return {?proto.sqlrpc.v1.ImportStart}
+ */
+proto.sqlrpc.v1.ImportRequest.prototype.getStart = function() {
+  return /** @type{?proto.sqlrpc.v1.ImportStart} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.ImportStart, 1));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.ImportStart|undefined} value
+ * @return {!proto.sqlrpc.v1.ImportRequest} returns this
+*/
+proto.sqlrpc.v1.ImportRequest.prototype.setStart = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 1, proto.sqlrpc.v1.ImportRequest.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.ImportRequest} returns this
+ */
+proto.sqlrpc.v1.ImportRequest.prototype.clearStart = function() {
+  return this.setStart(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.ImportRequest.prototype.hasStart = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional bytes data = 2;
+ * @return {!(string|Uint8Array)}
+ */
+proto.sqlrpc.v1.ImportRequest.prototype.getData = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * optional bytes data = 2;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
+ */
+proto.sqlrpc.v1.ImportRequest.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
+};
+
+
+/**
+ * optional bytes data = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.ImportRequest.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.sqlrpc.v1.ImportRequest} returns this
+ */
+proto.sqlrpc.v1.ImportRequest.prototype.setData = function(value) {
+  return jspb.Message.setOneofField(this, 2, proto.sqlrpc.v1.ImportRequest.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.sqlrpc.v1.ImportRequest} returns this
+ */
+proto.sqlrpc.v1.ImportRequest.prototype.clearData = function() {
+  return jspb.Message.setOneofField(this, 2, proto.sqlrpc.v1.ImportRequest.oneofGroups_[0], undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.QueryRequest} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.QueryRequest.prototype.setSql = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.sqlrpc.v1.ImportRequest.prototype.hasData = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * optional Parameters parameters = 4;
- * @return {?proto.sqlrpc.v1.Parameters}
+ * optional ImportRowBatch rows = 3;
+ * @return {?proto.sqlrpc.v1.ImportRowBatch}
  */
-proto.sqlrpc.v1.QueryRequest.prototype.getParameters = function() {
-  return /** @type{?proto.sqlrpc.v1.Parameters} */ (
-    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.Parameters, 4));
+proto.sqlrpc.v1.ImportRequest.prototype.getRows = function() {
+  return /** @type{?proto.sqlrpc.v1.ImportRowBatch} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.ImportRowBatch, 3));
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.Parameters|undefined} value
- * @return {!proto.sqlrpc.v1.QueryRequest} returns this
+ * @param {?proto.sqlrpc.v1.ImportRowBatch|undefined} value
+ * @return {!proto.sqlrpc.v1.ImportRequest} returns this
 */
-proto.sqlrpc.v1.QueryRequest.prototype.setParameters = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+proto.sqlrpc.v1.ImportRequest.prototype.setRows = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 3, proto.sqlrpc.v1.ImportRequest.oneofGroups_[0], value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.QueryRequest} returns this
+ * @return {!proto.sqlrpc.v1.ImportRequest} returns this
  */
-proto.sqlrpc.v1.QueryRequest.prototype.clearParameters = function() {
-  return this.setParameters(undefined);
+proto.sqlrpc.v1.ImportRequest.prototype.clearRows = function() {
+  return this.setRows(undefined);
 };
 
 
@@ -1538,8 +6710,8 @@ proto.sqlrpc.v1.QueryRequest.prototype.clearParameters = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.sqlrpc.v1.QueryRequest.prototype.hasParameters = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.sqlrpc.v1.ImportRequest.prototype.hasRows = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
@@ -1549,7 +6721,7 @@ proto.sqlrpc.v1.QueryRequest.prototype.hasParameters = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.sqlrpc.v1.QueryResult.repeatedFields_ = [1,2,3,4,5];
+proto.sqlrpc.v1.ImportStart.repeatedFields_ = [4];
 
 
 
@@ -1566,8 +6738,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.QueryResult.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.QueryResult.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ImportStart.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ImportStart.toObject(opt_includeInstance, this);
 };
 
 
@@ -1576,19 +6748,23 @@ proto.sqlrpc.v1.QueryResult.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.QueryResult} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.ImportStart} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.QueryResult.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.ImportStart.toObject = function(includeInstance, msg) {
   var f, obj = {
-columnsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
-columnAffinitiesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
-columnDeclaredTypesList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
-columnRawTypesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
-rowsList: jspb.Message.toObjectList(msg.getRowsList(),
-    google_protobuf_struct_pb.ListValue.toObject, includeInstance),
-stats: (f = msg.getStats()) && sqlrpc_v1_types_pb.ExecutionStats.toObject(includeInstance, f)
+database: jspb.Message.getFieldWithDefault(msg, 1, ""),
+table: jspb.Message.getFieldWithDefault(msg, 2, ""),
+format: jspb.Message.getFieldWithDefault(msg, 3, 0),
+fieldsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+columnMappingMap: (f = msg.getColumnMappingMap()) ? f.toObject(includeInstance, undefined) : [],
+csvHeader: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
+csvDelimiter: jspb.Message.getFieldWithDefault(msg, 7, ""),
+createTable: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
+onConflict: jspb.Message.getFieldWithDefault(msg, 9, 0),
+batchSize: jspb.Message.getFieldWithDefault(msg, 10, 0),
+maxErrorSamples: jspb.Message.getFieldWithDefault(msg, 11, 0)
   };
 
   if (includeInstance) {
@@ -1602,23 +6778,23 @@ stats: (f = msg.getStats()) && sqlrpc_v1_types_pb.ExecutionStats.toObject(includ
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.QueryResult}
+ * @return {!proto.sqlrpc.v1.ImportStart}
  */
-proto.sqlrpc.v1.QueryResult.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.ImportStart.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.QueryResult;
-  return proto.sqlrpc.v1.QueryResult.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.ImportStart;
+  return proto.sqlrpc.v1.ImportStart.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.QueryResult} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.ImportStart} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.QueryResult}
+ * @return {!proto.sqlrpc.v1.ImportStart}
  */
-proto.sqlrpc.v1.QueryResult.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.ImportStart.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1627,27 +6803,49 @@ proto.sqlrpc.v1.QueryResult.deserializeBinaryFromReader = function(msg, reader)
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.addColumns(value);
+      msg.setDatabase(value);
       break;
     case 2:
-      reader.readPackableEnumInto(msg.getColumnAffinitiesList());
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setTable(value);
       break;
     case 3:
-      reader.readPackableEnumInto(msg.getColumnDeclaredTypesList());
+      var value = /** @type {!proto.sqlrpc.v1.ImportFormat} */ (reader.readEnum());
+      msg.setFormat(value);
       break;
     case 4:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.addColumnRawTypes(value);
+      msg.addFields(value);
       break;
     case 5:
-      var value = new google_protobuf_struct_pb.ListValue;
-      reader.readMessage(value,google_protobuf_struct_pb.ListValue.deserializeBinaryFromReader);
-      msg.addRows(value);
+      var value = msg.getColumnMappingMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readStringRequireUtf8, jspb.BinaryReader.prototype.readStringRequireUtf8, null, "", "");
+         });
       break;
     case 6:
-      var value = new sqlrpc_v1_types_pb.ExecutionStats;
-      reader.readMessage(value,sqlrpc_v1_types_pb.ExecutionStats.deserializeBinaryFromReader);
-      msg.setStats(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setCsvHeader(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setCsvDelimiter(value);
+      break;
+    case 8:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setCreateTable(value);
+      break;
+    case 9:
+      var value = /** @type {!proto.sqlrpc.v1.ImportConflict} */ (reader.readEnum());
+      msg.setOnConflict(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setBatchSize(value);
+      break;
+    case 11:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxErrorSamples(value);
       break;
     default:
       reader.skipField();
@@ -1662,9 +6860,9 @@ proto.sqlrpc.v1.QueryResult.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.QueryResult.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.ImportStart.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.QueryResult.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.ImportStart.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1672,282 +6870,643 @@ proto.sqlrpc.v1.QueryResult.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.QueryResult} message
+ * @param {!proto.sqlrpc.v1.ImportStart} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.QueryResult.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.ImportStart.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getColumnsList();
+  f = message.getDatabase();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getColumnAffinitiesList();
+  f = message.getTable();
   if (f.length > 0) {
-    writer.writePackedEnum(
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getColumnDeclaredTypesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
+  f = message.getFormat();
+  if (f !== 0.0) {
+    writer.writeEnum(
       3,
       f
     );
   }
-  f = message.getColumnRawTypesList();
+  f = message.getFieldsList();
   if (f.length > 0) {
     writer.writeRepeatedString(
       4,
       f
     );
   }
-  f = message.getRowsList();
+  f = message.getColumnMappingMap(true);
+  if (f && f.getLength() > 0) {
+jspb.internal.public_for_gencode.serializeMapToBinary(
+    message.getColumnMappingMap(true),
+    5,
+    writer,
+    jspb.BinaryWriter.prototype.writeString,
+    jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getCsvHeader();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
+  f = message.getCsvDelimiter();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      5,
-      f,
-      google_protobuf_struct_pb.ListValue.serializeBinaryToWriter
+    writer.writeString(
+      7,
+      f
     );
   }
-  f = message.getStats();
-  if (f != null) {
-    writer.writeMessage(
-      6,
-      f,
-      sqlrpc_v1_types_pb.ExecutionStats.serializeBinaryToWriter
+  f = message.getCreateTable();
+  if (f) {
+    writer.writeBool(
+      8,
+      f
+    );
+  }
+  f = message.getOnConflict();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      9,
+      f
+    );
+  }
+  f = message.getBatchSize();
+  if (f !== 0) {
+    writer.writeInt32(
+      10,
+      f
+    );
+  }
+  f = message.getMaxErrorSamples();
+  if (f !== 0) {
+    writer.writeInt32(
+      11,
+      f
     );
   }
 };
 
 
 /**
- * repeated string columns = 1;
- * @return {!Array<string>}
+ * optional string database = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.ImportStart.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ImportStart} returns this
+ */
+proto.sqlrpc.v1.ImportStart.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string table = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.ImportStart.prototype.getTable = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ImportStart} returns this
+ */
+proto.sqlrpc.v1.ImportStart.prototype.setTable = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional ImportFormat format = 3;
+ * @return {!proto.sqlrpc.v1.ImportFormat}
+ */
+proto.sqlrpc.v1.ImportStart.prototype.getFormat = function() {
+  return /** @type {!proto.sqlrpc.v1.ImportFormat} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.ImportFormat} value
+ * @return {!proto.sqlrpc.v1.ImportStart} returns this
+ */
+proto.sqlrpc.v1.ImportStart.prototype.setFormat = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
+/**
+ * repeated string fields = 4;
+ * @return {!Array<string>}
+ */
+proto.sqlrpc.v1.ImportStart.prototype.getFieldsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.sqlrpc.v1.ImportStart} returns this
+ */
+proto.sqlrpc.v1.ImportStart.prototype.setFieldsList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.ImportStart} returns this
+ */
+proto.sqlrpc.v1.ImportStart.prototype.addFields = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.ImportStart} returns this
+ */
+proto.sqlrpc.v1.ImportStart.prototype.clearFieldsList = function() {
+  return this.setFieldsList([]);
+};
+
+
+/**
+ * map<string, string> column_mapping = 5;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.sqlrpc.v1.ImportStart.prototype.getColumnMappingMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 5, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.sqlrpc.v1.ImportStart} returns this
+ */
+proto.sqlrpc.v1.ImportStart.prototype.clearColumnMappingMap = function() {
+  this.getColumnMappingMap().clear();
+  return this;
+};
+
+
+/**
+ * optional bool csv_header = 6;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.ImportStart.prototype.getCsvHeader = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.ImportStart} returns this
+ */
+proto.sqlrpc.v1.ImportStart.prototype.setCsvHeader = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
+};
+
+
+/**
+ * optional string csv_delimiter = 7;
+ * @return {string}
+ */
+proto.sqlrpc.v1.ImportStart.prototype.getCsvDelimiter = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ImportStart} returns this
+ */
+proto.sqlrpc.v1.ImportStart.prototype.setCsvDelimiter = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional bool create_table = 8;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.ImportStart.prototype.getCreateTable = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.ImportStart} returns this
+ */
+proto.sqlrpc.v1.ImportStart.prototype.setCreateTable = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 8, value);
+};
+
+
+/**
+ * optional ImportConflict on_conflict = 9;
+ * @return {!proto.sqlrpc.v1.ImportConflict}
+ */
+proto.sqlrpc.v1.ImportStart.prototype.getOnConflict = function() {
+  return /** @type {!proto.sqlrpc.v1.ImportConflict} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.ImportConflict} value
+ * @return {!proto.sqlrpc.v1.ImportStart} returns this
+ */
+proto.sqlrpc.v1.ImportStart.prototype.setOnConflict = function(value) {
+  return jspb.Message.setProto3EnumField(this, 9, value);
+};
+
+
+/**
+ * optional int32 batch_size = 10;
+ * @return {number}
  */
-proto.sqlrpc.v1.QueryResult.prototype.getColumnsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.sqlrpc.v1.ImportStart.prototype.getBatchSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.ImportStart} returns this
  */
-proto.sqlrpc.v1.QueryResult.prototype.setColumnsList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.sqlrpc.v1.ImportStart.prototype.setBatchSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ * optional int32 max_error_samples = 11;
+ * @return {number}
  */
-proto.sqlrpc.v1.QueryResult.prototype.addColumns = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.sqlrpc.v1.ImportStart.prototype.getMaxErrorSamples = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.ImportStart} returns this
  */
-proto.sqlrpc.v1.QueryResult.prototype.clearColumnsList = function() {
-  return this.setColumnsList([]);
+proto.sqlrpc.v1.ImportStart.prototype.setMaxErrorSamples = function(value) {
+  return jspb.Message.setProto3IntField(this, 11, value);
 };
 
 
+
 /**
- * repeated ColumnAffinity column_affinities = 2;
- * @return {!Array<!proto.sqlrpc.v1.ColumnAffinity>}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.sqlrpc.v1.QueryResult.prototype.getColumnAffinitiesList = function() {
-  return /** @type {!Array<!proto.sqlrpc.v1.ColumnAffinity>} */ (jspb.Message.getRepeatedField(this, 2));
+proto.sqlrpc.v1.ImportRowBatch.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.ImportRowBatch.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ImportRowBatch.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {!Array<!proto.sqlrpc.v1.ColumnAffinity>} value
- * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.ImportRowBatch} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.QueryResult.prototype.setColumnAffinitiesList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
+proto.sqlrpc.v1.ImportRowBatch.toObject = function(includeInstance, msg) {
+  var f, obj = {
+rowsList: jspb.Message.toObjectList(msg.getRowsList(),
+    sqlrpc_v1_types_pb.SqlRow.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {!proto.sqlrpc.v1.ColumnAffinity} value
- * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.ImportRowBatch}
  */
-proto.sqlrpc.v1.QueryResult.prototype.addColumnAffinities = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+proto.sqlrpc.v1.ImportRowBatch.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.ImportRowBatch;
+  return proto.sqlrpc.v1.ImportRowBatch.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.ImportRowBatch} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.ImportRowBatch}
  */
-proto.sqlrpc.v1.QueryResult.prototype.clearColumnAffinitiesList = function() {
-  return this.setColumnAffinitiesList([]);
+proto.sqlrpc.v1.ImportRowBatch.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new sqlrpc_v1_types_pb.SqlRow;
+      reader.readMessage(value,sqlrpc_v1_types_pb.SqlRow.deserializeBinaryFromReader);
+      msg.addRows(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * repeated DeclaredType column_declared_types = 3;
- * @return {!Array<!proto.sqlrpc.v1.DeclaredType>}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.QueryResult.prototype.getColumnDeclaredTypesList = function() {
-  return /** @type {!Array<!proto.sqlrpc.v1.DeclaredType>} */ (jspb.Message.getRepeatedField(this, 3));
+proto.sqlrpc.v1.ImportRowBatch.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ImportRowBatch.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {!Array<!proto.sqlrpc.v1.DeclaredType>} value
- * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ImportRowBatch} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.QueryResult.prototype.setColumnDeclaredTypesList = function(value) {
-  return jspb.Message.setField(this, 3, value || []);
+proto.sqlrpc.v1.ImportRowBatch.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRowsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      sqlrpc_v1_types_pb.SqlRow.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * @param {!proto.sqlrpc.v1.DeclaredType} value
- * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ * repeated SqlRow rows = 1;
+ * @return {!Array<!proto.sqlrpc.v1.SqlRow>}
  */
-proto.sqlrpc.v1.QueryResult.prototype.addColumnDeclaredTypes = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+proto.sqlrpc.v1.ImportRowBatch.prototype.getRowsList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.SqlRow>} */ (
+    jspb.Message.getRepeatedWrapperField(this, sqlrpc_v1_types_pb.SqlRow, 1));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ * @param {!Array<!proto.sqlrpc.v1.SqlRow>} value
+ * @return {!proto.sqlrpc.v1.ImportRowBatch} returns this
+*/
+proto.sqlrpc.v1.ImportRowBatch.prototype.setRowsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.SqlRow=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.SqlRow}
  */
-proto.sqlrpc.v1.QueryResult.prototype.clearColumnDeclaredTypesList = function() {
-  return this.setColumnDeclaredTypesList([]);
+proto.sqlrpc.v1.ImportRowBatch.prototype.addRows = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.sqlrpc.v1.SqlRow, opt_index);
 };
 
 
 /**
- * repeated string column_raw_types = 4;
- * @return {!Array<string>}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.ImportRowBatch} returns this
  */
-proto.sqlrpc.v1.QueryResult.prototype.getColumnRawTypesList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+proto.sqlrpc.v1.ImportRowBatch.prototype.clearRowsList = function() {
+  return this.setRowsList([]);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {!Array<string>} value
- * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.sqlrpc.v1.QueryResult.prototype.setColumnRawTypesList = function(value) {
-  return jspb.Message.setField(this, 4, value || []);
+proto.sqlrpc.v1.ImportRowError.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ImportRowError.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.ImportRowError} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.QueryResult.prototype.addColumnRawTypes = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+proto.sqlrpc.v1.ImportRowError.toObject = function(includeInstance, msg) {
+  var f, obj = {
+record: jspb.Message.getFieldWithDefault(msg, 1, 0),
+message: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.ImportRowError}
  */
-proto.sqlrpc.v1.QueryResult.prototype.clearColumnRawTypesList = function() {
-  return this.setColumnRawTypesList([]);
+proto.sqlrpc.v1.ImportRowError.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.ImportRowError;
+  return proto.sqlrpc.v1.ImportRowError.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * repeated google.protobuf.ListValue rows = 5;
- * @return {!Array<!proto.google.protobuf.ListValue>}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.ImportRowError} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.ImportRowError}
  */
-proto.sqlrpc.v1.QueryResult.prototype.getRowsList = function() {
-  return /** @type{!Array<!proto.google.protobuf.ListValue>} */ (
-    jspb.Message.getRepeatedWrapperField(this, google_protobuf_struct_pb.ListValue, 5));
+proto.sqlrpc.v1.ImportRowError.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setRecord(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {!Array<!proto.google.protobuf.ListValue>} value
- * @return {!proto.sqlrpc.v1.QueryResult} returns this
-*/
-proto.sqlrpc.v1.QueryResult.prototype.setRowsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 5, value);
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.ImportRowError.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ImportRowError.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {!proto.google.protobuf.ListValue=} opt_value
- * @param {number=} opt_index
- * @return {!proto.google.protobuf.ListValue}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ImportRowError} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.QueryResult.prototype.addRows = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.google.protobuf.ListValue, opt_index);
+proto.sqlrpc.v1.ImportRowError.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRecord();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ * optional int64 record = 1;
+ * @return {number}
  */
-proto.sqlrpc.v1.QueryResult.prototype.clearRowsList = function() {
-  return this.setRowsList([]);
+proto.sqlrpc.v1.ImportRowError.prototype.getRecord = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * optional ExecutionStats stats = 6;
- * @return {?proto.sqlrpc.v1.ExecutionStats}
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.ImportRowError} returns this
  */
-proto.sqlrpc.v1.QueryResult.prototype.getStats = function() {
-  return /** @type{?proto.sqlrpc.v1.ExecutionStats} */ (
-    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.ExecutionStats, 6));
+proto.sqlrpc.v1.ImportRowError.prototype.setRecord = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.ExecutionStats|undefined} value
- * @return {!proto.sqlrpc.v1.QueryResult} returns this
-*/
-proto.sqlrpc.v1.QueryResult.prototype.setStats = function(value) {
-  return jspb.Message.setWrapperField(this, 6, value);
+ * optional string message = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.ImportRowError.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.QueryResult} returns this
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ImportRowError} returns this
  */
-proto.sqlrpc.v1.QueryResult.prototype.clearStats = function() {
-  return this.setStats(undefined);
+proto.sqlrpc.v1.ImportRowError.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.sqlrpc.v1.QueryResult.prototype.hasStats = function() {
-  return jspb.Message.getField(this, 6) != null;
-};
-
-
+proto.sqlrpc.v1.ImportResponse.repeatedFields_ = [4,6,7];
 
 
 
@@ -1964,8 +7523,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.ExecResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.ExecResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ImportResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ImportResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1974,13 +7533,21 @@ proto.sqlrpc.v1.ExecResponse.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.ExecResponse} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.ImportResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.ExecResponse.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.ImportResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-dml: (f = msg.getDml()) && proto.sqlrpc.v1.DMLResult.toObject(includeInstance, f),
+rowsAccepted: jspb.Message.getFieldWithDefault(msg, 1, 0),
+rowsRejected: jspb.Message.getFieldWithDefault(msg, 2, 0),
+rowsIgnored: jspb.Message.getFieldWithDefault(msg, 3, 0),
+errorsList: jspb.Message.toObjectList(msg.getErrorsList(),
+    proto.sqlrpc.v1.ImportRowError.toObject, includeInstance),
+tableCreated: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+columnsList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
+columnDeclaredTypesList: (f = jspb.Message.getRepeatedField(msg, 7)) == null ? undefined : f,
+batchesCommitted: jspb.Message.getFieldWithDefault(msg, 8, 0),
 stats: (f = msg.getStats()) && sqlrpc_v1_types_pb.ExecutionStats.toObject(includeInstance, f)
   };
 
@@ -1995,23 +7562,23 @@ stats: (f = msg.getStats()) && sqlrpc_v1_types_pb.ExecutionStats.toObject(includ
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.ExecResponse}
+ * @return {!proto.sqlrpc.v1.ImportResponse}
  */
-proto.sqlrpc.v1.ExecResponse.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.ImportResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.ExecResponse;
-  return proto.sqlrpc.v1.ExecResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.ImportResponse;
+  return proto.sqlrpc.v1.ImportResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.ExecResponse} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.ImportResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.ExecResponse}
+ * @return {!proto.sqlrpc.v1.ImportResponse}
  */
-proto.sqlrpc.v1.ExecResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.ImportResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2019,11 +7586,38 @@ proto.sqlrpc.v1.ExecResponse.deserializeBinaryFromReader = function(msg, reader)
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.sqlrpc.v1.DMLResult;
-      reader.readMessage(value,proto.sqlrpc.v1.DMLResult.deserializeBinaryFromReader);
-      msg.setDml(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setRowsAccepted(value);
       break;
     case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setRowsRejected(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setRowsIgnored(value);
+      break;
+    case 4:
+      var value = new proto.sqlrpc.v1.ImportRowError;
+      reader.readMessage(value,proto.sqlrpc.v1.ImportRowError.deserializeBinaryFromReader);
+      msg.addErrors(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setTableCreated(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.addColumns(value);
+      break;
+    case 7:
+      reader.readPackableEnumInto(msg.getColumnDeclaredTypesList());
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setBatchesCommitted(value);
+      break;
+    case 9:
       var value = new sqlrpc_v1_types_pb.ExecutionStats;
       reader.readMessage(value,sqlrpc_v1_types_pb.ExecutionStats.deserializeBinaryFromReader);
       msg.setStats(value);
@@ -2038,105 +7632,319 @@ proto.sqlrpc.v1.ExecResponse.deserializeBinaryFromReader = function(msg, reader)
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.ImportResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ImportResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ImportResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.ImportResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRowsAccepted();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getRowsRejected();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = message.getRowsIgnored();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = message.getErrorsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.sqlrpc.v1.ImportRowError.serializeBinaryToWriter
+    );
+  }
+  f = message.getTableCreated();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getColumnsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      6,
+      f
+    );
+  }
+  f = message.getColumnDeclaredTypesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      7,
+      f
+    );
+  }
+  f = message.getBatchesCommitted();
+  if (f !== 0) {
+    writer.writeInt64(
+      8,
+      f
+    );
+  }
+  f = message.getStats();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      sqlrpc_v1_types_pb.ExecutionStats.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional int64 rows_accepted = 1;
+ * @return {number}
+ */
+proto.sqlrpc.v1.ImportResponse.prototype.getRowsAccepted = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.ImportResponse} returns this
+ */
+proto.sqlrpc.v1.ImportResponse.prototype.setRowsAccepted = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional int64 rows_rejected = 2;
+ * @return {number}
+ */
+proto.sqlrpc.v1.ImportResponse.prototype.getRowsRejected = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.ImportResponse} returns this
+ */
+proto.sqlrpc.v1.ImportResponse.prototype.setRowsRejected = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int64 rows_ignored = 3;
+ * @return {number}
+ */
+proto.sqlrpc.v1.ImportResponse.prototype.getRowsIgnored = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.ImportResponse} returns this
+ */
+proto.sqlrpc.v1.ImportResponse.prototype.setRowsIgnored = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * repeated ImportRowError errors = 4;
+ * @return {!Array<!proto.sqlrpc.v1.ImportRowError>}
+ */
+proto.sqlrpc.v1.ImportResponse.prototype.getErrorsList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.ImportRowError>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.sqlrpc.v1.ImportRowError, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.sqlrpc.v1.ImportRowError>} value
+ * @return {!proto.sqlrpc.v1.ImportResponse} returns this
+*/
+proto.sqlrpc.v1.ImportResponse.prototype.setErrorsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.ImportRowError=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.ImportRowError}
+ */
+proto.sqlrpc.v1.ImportResponse.prototype.addErrors = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.sqlrpc.v1.ImportRowError, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.ImportResponse} returns this
+ */
+proto.sqlrpc.v1.ImportResponse.prototype.clearErrorsList = function() {
+  return this.setErrorsList([]);
+};
+
+
+/**
+ * optional bool table_created = 5;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.ImportResponse.prototype.getTableCreated = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.ImportResponse} returns this
+ */
+proto.sqlrpc.v1.ImportResponse.prototype.setTableCreated = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+/**
+ * repeated string columns = 6;
+ * @return {!Array<string>}
+ */
+proto.sqlrpc.v1.ImportResponse.prototype.getColumnsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 6));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.sqlrpc.v1.ImportResponse} returns this
+ */
+proto.sqlrpc.v1.ImportResponse.prototype.setColumnsList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.ImportResponse} returns this
+ */
+proto.sqlrpc.v1.ImportResponse.prototype.addColumns = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.ImportResponse} returns this
+ */
+proto.sqlrpc.v1.ImportResponse.prototype.clearColumnsList = function() {
+  return this.setColumnsList([]);
+};
+
+
+/**
+ * repeated DeclaredType column_declared_types = 7;
+ * @return {!Array<!proto.sqlrpc.v1.DeclaredType>}
  */
-proto.sqlrpc.v1.ExecResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.ExecResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.sqlrpc.v1.ImportResponse.prototype.getColumnDeclaredTypesList = function() {
+  return /** @type {!Array<!proto.sqlrpc.v1.DeclaredType>} */ (jspb.Message.getRepeatedField(this, 7));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.ExecResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {!Array<!proto.sqlrpc.v1.DeclaredType>} value
+ * @return {!proto.sqlrpc.v1.ImportResponse} returns this
  */
-proto.sqlrpc.v1.ExecResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getDml();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      proto.sqlrpc.v1.DMLResult.serializeBinaryToWriter
-    );
-  }
-  f = message.getStats();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      sqlrpc_v1_types_pb.ExecutionStats.serializeBinaryToWriter
-    );
-  }
+proto.sqlrpc.v1.ImportResponse.prototype.setColumnDeclaredTypesList = function(value) {
+  return jspb.Message.setField(this, 7, value || []);
 };
 
 
 /**
- * optional DMLResult dml = 1;
- * @return {?proto.sqlrpc.v1.DMLResult}
+ * @param {!proto.sqlrpc.v1.DeclaredType} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.ImportResponse} returns this
  */
-proto.sqlrpc.v1.ExecResponse.prototype.getDml = function() {
-  return /** @type{?proto.sqlrpc.v1.DMLResult} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.DMLResult, 1));
+proto.sqlrpc.v1.ImportResponse.prototype.addColumnDeclaredTypes = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 7, value, opt_index);
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.DMLResult|undefined} value
- * @return {!proto.sqlrpc.v1.ExecResponse} returns this
-*/
-proto.sqlrpc.v1.ExecResponse.prototype.setDml = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.ImportResponse} returns this
+ */
+proto.sqlrpc.v1.ImportResponse.prototype.clearColumnDeclaredTypesList = function() {
+  return this.setColumnDeclaredTypesList([]);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.ExecResponse} returns this
+ * optional int64 batches_committed = 8;
+ * @return {number}
  */
-proto.sqlrpc.v1.ExecResponse.prototype.clearDml = function() {
-  return this.setDml(undefined);
+proto.sqlrpc.v1.ImportResponse.prototype.getBatchesCommitted = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.ImportResponse} returns this
  */
-proto.sqlrpc.v1.ExecResponse.prototype.hasDml = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.sqlrpc.v1.ImportResponse.prototype.setBatchesCommitted = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
 };
 
 
 /**
- * optional ExecutionStats stats = 2;
+ * optional ExecutionStats stats = 9;
  * @return {?proto.sqlrpc.v1.ExecutionStats}
  */
-proto.sqlrpc.v1.ExecResponse.prototype.getStats = function() {
+proto.sqlrpc.v1.ImportResponse.prototype.getStats = function() {
   return /** @type{?proto.sqlrpc.v1.ExecutionStats} */ (
-    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.ExecutionStats, 2));
+    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.ExecutionStats, 9));
 };
 
 
 /**
  * @param {?proto.sqlrpc.v1.ExecutionStats|undefined} value
- * @return {!proto.sqlrpc.v1.ExecResponse} returns this
+ * @return {!proto.sqlrpc.v1.ImportResponse} returns this
 */
-proto.sqlrpc.v1.ExecResponse.prototype.setStats = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+proto.sqlrpc.v1.ImportResponse.prototype.setStats = function(value) {
+  return jspb.Message.setWrapperField(this, 9, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.ExecResponse} returns this
+ * @return {!proto.sqlrpc.v1.ImportResponse} returns this
  */
-proto.sqlrpc.v1.ExecResponse.prototype.clearStats = function() {
+proto.sqlrpc.v1.ImportResponse.prototype.clearStats = function() {
   return this.setStats(undefined);
 };
 
@@ -2145,12 +7953,19 @@ proto.sqlrpc.v1.ExecResponse.prototype.clearStats = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.sqlrpc.v1.ExecResponse.prototype.hasStats = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.sqlrpc.v1.ImportResponse.prototype.hasStats = function() {
+  return jspb.Message.getField(this, 9) != null;
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.TypedBatchExecRequest.repeatedFields_ = [3];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2166,8 +7981,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.DMLResult.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.DMLResult.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.TypedBatchExecRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.TypedBatchExecRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2176,15 +7991,17 @@ proto.sqlrpc.v1.DMLResult.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.DMLResult} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.TypedBatchExecRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.DMLResult.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.TypedBatchExecRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-rowsAffected: jspb.Message.getFieldWithDefault(msg, 1, 0),
-lastInsertId: jspb.Message.getFieldWithDefault(msg, 2, 0),
-stats: (f = msg.getStats()) && sqlrpc_v1_types_pb.ExecutionStats.toObject(includeInstance, f)
+database: jspb.Message.getFieldWithDefault(msg, 1, ""),
+sql: jspb.Message.getFieldWithDefault(msg, 2, ""),
+parameterSetsList: jspb.Message.toObjectList(msg.getParameterSetsList(),
+    sqlrpc_v1_types_pb.TypedParameters.toObject, includeInstance),
+maxExecutionTimeMs: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -2198,23 +8015,23 @@ stats: (f = msg.getStats()) && sqlrpc_v1_types_pb.ExecutionStats.toObject(includ
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.DMLResult}
+ * @return {!proto.sqlrpc.v1.TypedBatchExecRequest}
  */
-proto.sqlrpc.v1.DMLResult.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.TypedBatchExecRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.DMLResult;
-  return proto.sqlrpc.v1.DMLResult.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.TypedBatchExecRequest;
+  return proto.sqlrpc.v1.TypedBatchExecRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.DMLResult} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.TypedBatchExecRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.DMLResult}
+ * @return {!proto.sqlrpc.v1.TypedBatchExecRequest}
  */
-proto.sqlrpc.v1.DMLResult.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.TypedBatchExecRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2222,17 +8039,21 @@ proto.sqlrpc.v1.DMLResult.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setRowsAffected(value);
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setLastInsertId(value);
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setSql(value);
       break;
     case 3:
-      var value = new sqlrpc_v1_types_pb.ExecutionStats;
-      reader.readMessage(value,sqlrpc_v1_types_pb.ExecutionStats.deserializeBinaryFromReader);
-      msg.setStats(value);
+      var value = new sqlrpc_v1_types_pb.TypedParameters;
+      reader.readMessage(value,sqlrpc_v1_types_pb.TypedParameters.deserializeBinaryFromReader);
+      msg.addParameterSets(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxExecutionTimeMs(value);
       break;
     default:
       reader.skipField();
@@ -2247,9 +8068,9 @@ proto.sqlrpc.v1.DMLResult.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.DMLResult.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.TypedBatchExecRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.DMLResult.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.TypedBatchExecRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2257,107 +8078,133 @@ proto.sqlrpc.v1.DMLResult.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.DMLResult} message
+ * @param {!proto.sqlrpc.v1.TypedBatchExecRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.DMLResult.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.TypedBatchExecRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getRowsAffected();
-  if (f !== 0) {
-    writer.writeInt64(
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getLastInsertId();
-  if (f !== 0) {
-    writer.writeInt64(
+  f = message.getSql();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getStats();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getParameterSetsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       3,
       f,
-      sqlrpc_v1_types_pb.ExecutionStats.serializeBinaryToWriter
+      sqlrpc_v1_types_pb.TypedParameters.serializeBinaryToWriter
+    );
+  }
+  f = message.getMaxExecutionTimeMs();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
     );
   }
 };
 
 
 /**
- * optional int64 rows_affected = 1;
- * @return {number}
+ * optional string database = 1;
+ * @return {string}
  */
-proto.sqlrpc.v1.DMLResult.prototype.getRowsAffected = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.sqlrpc.v1.TypedBatchExecRequest.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.sqlrpc.v1.DMLResult} returns this
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.TypedBatchExecRequest} returns this
  */
-proto.sqlrpc.v1.DMLResult.prototype.setRowsAffected = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.sqlrpc.v1.TypedBatchExecRequest.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional int64 last_insert_id = 2;
- * @return {number}
+ * optional string sql = 2;
+ * @return {string}
  */
-proto.sqlrpc.v1.DMLResult.prototype.getLastInsertId = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.sqlrpc.v1.TypedBatchExecRequest.prototype.getSql = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.sqlrpc.v1.DMLResult} returns this
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.TypedBatchExecRequest} returns this
  */
-proto.sqlrpc.v1.DMLResult.prototype.setLastInsertId = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.sqlrpc.v1.TypedBatchExecRequest.prototype.setSql = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional ExecutionStats stats = 3;
- * @return {?proto.sqlrpc.v1.ExecutionStats}
+ * repeated TypedParameters parameter_sets = 3;
+ * @return {!Array<!proto.sqlrpc.v1.TypedParameters>}
  */
-proto.sqlrpc.v1.DMLResult.prototype.getStats = function() {
-  return /** @type{?proto.sqlrpc.v1.ExecutionStats} */ (
-    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.ExecutionStats, 3));
+proto.sqlrpc.v1.TypedBatchExecRequest.prototype.getParameterSetsList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.TypedParameters>} */ (
+    jspb.Message.getRepeatedWrapperField(this, sqlrpc_v1_types_pb.TypedParameters, 3));
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.ExecutionStats|undefined} value
- * @return {!proto.sqlrpc.v1.DMLResult} returns this
+ * @param {!Array<!proto.sqlrpc.v1.TypedParameters>} value
+ * @return {!proto.sqlrpc.v1.TypedBatchExecRequest} returns this
 */
-proto.sqlrpc.v1.DMLResult.prototype.setStats = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+proto.sqlrpc.v1.TypedBatchExecRequest.prototype.setParameterSetsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.DMLResult} returns this
+ * @param {!proto.sqlrpc.v1.TypedParameters=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.TypedParameters}
  */
-proto.sqlrpc.v1.DMLResult.prototype.clearStats = function() {
-  return this.setStats(undefined);
+proto.sqlrpc.v1.TypedBatchExecRequest.prototype.addParameterSets = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.sqlrpc.v1.TypedParameters, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.TypedBatchExecRequest} returns this
  */
-proto.sqlrpc.v1.DMLResult.prototype.hasStats = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.sqlrpc.v1.TypedBatchExecRequest.prototype.clearParameterSetsList = function() {
+  return this.setParameterSetsList([]);
+};
+
+
+/**
+ * optional int32 max_execution_time_ms = 4;
+ * @return {number}
+ */
+proto.sqlrpc.v1.TypedBatchExecRequest.prototype.getMaxExecutionTimeMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.TypedBatchExecRequest} returns this
+ */
+proto.sqlrpc.v1.TypedBatchExecRequest.prototype.setMaxExecutionTimeMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
@@ -2377,8 +8224,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.BeginTransactionRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.BeginTransactionRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.TypedTransactionQueryRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.TypedTransactionQueryRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2387,15 +8234,15 @@ proto.sqlrpc.v1.BeginTransactionRequest.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.BeginTransactionRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.TypedTransactionQueryRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.BeginTransactionRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.TypedTransactionQueryRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-database: jspb.Message.getFieldWithDefault(msg, 1, ""),
-timeout: (f = msg.getTimeout()) && google_protobuf_duration_pb.Duration.toObject(includeInstance, f),
-mode: jspb.Message.getFieldWithDefault(msg, 3, 0)
+transactionId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+sql: jspb.Message.getFieldWithDefault(msg, 2, ""),
+parameters: (f = msg.getParameters()) && sqlrpc_v1_types_pb.TypedParameters.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -2409,23 +8256,23 @@ mode: jspb.Message.getFieldWithDefault(msg, 3, 0)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.BeginTransactionRequest}
+ * @return {!proto.sqlrpc.v1.TypedTransactionQueryRequest}
  */
-proto.sqlrpc.v1.BeginTransactionRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.TypedTransactionQueryRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.BeginTransactionRequest;
-  return proto.sqlrpc.v1.BeginTransactionRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.TypedTransactionQueryRequest;
+  return proto.sqlrpc.v1.TypedTransactionQueryRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.BeginTransactionRequest} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.TypedTransactionQueryRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.BeginTransactionRequest}
+ * @return {!proto.sqlrpc.v1.TypedTransactionQueryRequest}
  */
-proto.sqlrpc.v1.BeginTransactionRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.TypedTransactionQueryRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2434,16 +8281,16 @@ proto.sqlrpc.v1.BeginTransactionRequest.deserializeBinaryFromReader = function(m
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setDatabase(value);
+      msg.setTransactionId(value);
       break;
     case 2:
-      var value = new google_protobuf_duration_pb.Duration;
-      reader.readMessage(value,google_protobuf_duration_pb.Duration.deserializeBinaryFromReader);
-      msg.setTimeout(value);
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setSql(value);
       break;
     case 3:
-      var value = /** @type {!proto.sqlrpc.v1.TransactionLockMode} */ (reader.readEnum());
-      msg.setMode(value);
+      var value = new sqlrpc_v1_types_pb.TypedParameters;
+      reader.readMessage(value,sqlrpc_v1_types_pb.TypedParameters.deserializeBinaryFromReader);
+      msg.setParameters(value);
       break;
     default:
       reader.skipField();
@@ -2458,9 +8305,9 @@ proto.sqlrpc.v1.BeginTransactionRequest.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.BeginTransactionRequest.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.TypedTransactionQueryRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.BeginTransactionRequest.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.TypedTransactionQueryRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2468,111 +8315,118 @@ proto.sqlrpc.v1.BeginTransactionRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.BeginTransactionRequest} message
+ * @param {!proto.sqlrpc.v1.TypedTransactionQueryRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.BeginTransactionRequest.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.TypedTransactionQueryRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getDatabase();
+  f = message.getTransactionId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getTimeout();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      google_protobuf_duration_pb.Duration.serializeBinaryToWriter
+  f = message.getSql();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
     );
   }
-  f = message.getMode();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getParameters();
+  if (f != null) {
+    writer.writeMessage(
       3,
-      f
+      f,
+      sqlrpc_v1_types_pb.TypedParameters.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string database = 1;
+ * optional string transaction_id = 1;
  * @return {string}
  */
-proto.sqlrpc.v1.BeginTransactionRequest.prototype.getDatabase = function() {
+proto.sqlrpc.v1.TypedTransactionQueryRequest.prototype.getTransactionId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.BeginTransactionRequest} returns this
+ * @return {!proto.sqlrpc.v1.TypedTransactionQueryRequest} returns this
  */
-proto.sqlrpc.v1.BeginTransactionRequest.prototype.setDatabase = function(value) {
+proto.sqlrpc.v1.TypedTransactionQueryRequest.prototype.setTransactionId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional google.protobuf.Duration timeout = 2;
- * @return {?proto.google.protobuf.Duration}
+ * optional string sql = 2;
+ * @return {string}
  */
-proto.sqlrpc.v1.BeginTransactionRequest.prototype.getTimeout = function() {
-  return /** @type{?proto.google.protobuf.Duration} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_duration_pb.Duration, 2));
+proto.sqlrpc.v1.TypedTransactionQueryRequest.prototype.getSql = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Duration|undefined} value
- * @return {!proto.sqlrpc.v1.BeginTransactionRequest} returns this
-*/
-proto.sqlrpc.v1.BeginTransactionRequest.prototype.setTimeout = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.TypedTransactionQueryRequest} returns this
+ */
+proto.sqlrpc.v1.TypedTransactionQueryRequest.prototype.setSql = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.BeginTransactionRequest} returns this
+ * optional TypedParameters parameters = 3;
+ * @return {?proto.sqlrpc.v1.TypedParameters}
  */
-proto.sqlrpc.v1.BeginTransactionRequest.prototype.clearTimeout = function() {
-  return this.setTimeout(undefined);
+proto.sqlrpc.v1.TypedTransactionQueryRequest.prototype.getParameters = function() {
+  return /** @type{?proto.sqlrpc.v1.TypedParameters} */ (
+    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.TypedParameters, 3));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.sqlrpc.v1.BeginTransactionRequest.prototype.hasTimeout = function() {
-  return jspb.Message.getField(this, 2) != null;
+ * @param {?proto.sqlrpc.v1.TypedParameters|undefined} value
+ * @return {!proto.sqlrpc.v1.TypedTransactionQueryRequest} returns this
+*/
+proto.sqlrpc.v1.TypedTransactionQueryRequest.prototype.setParameters = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
- * optional TransactionLockMode mode = 3;
- * @return {!proto.sqlrpc.v1.TransactionLockMode}
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.TypedTransactionQueryRequest} returns this
  */
-proto.sqlrpc.v1.BeginTransactionRequest.prototype.getMode = function() {
-  return /** @type {!proto.sqlrpc.v1.TransactionLockMode} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.sqlrpc.v1.TypedTransactionQueryRequest.prototype.clearParameters = function() {
+  return this.setParameters(undefined);
 };
 
 
 /**
- * @param {!proto.sqlrpc.v1.TransactionLockMode} value
- * @return {!proto.sqlrpc.v1.BeginTransactionRequest} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.BeginTransactionRequest.prototype.setMode = function(value) {
-  return jspb.Message.setProto3EnumField(this, 3, value);
+proto.sqlrpc.v1.TypedTransactionQueryRequest.prototype.hasParameters = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.TypedQueryResult.repeatedFields_ = [1,2,3,4,5];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2588,8 +8442,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.BeginTransactionResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.BeginTransactionResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.TypedQueryResult.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.TypedQueryResult.toObject(opt_includeInstance, this);
 };
 
 
@@ -2598,14 +8452,19 @@ proto.sqlrpc.v1.BeginTransactionResponse.prototype.toObject = function(opt_inclu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.BeginTransactionResponse} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.TypedQueryResult} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.BeginTransactionResponse.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.TypedQueryResult.toObject = function(includeInstance, msg) {
   var f, obj = {
-transactionId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-expiresAt: (f = msg.getExpiresAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+columnsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+columnAffinitiesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+columnDeclaredTypesList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+columnRawTypesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+rowsList: jspb.Message.toObjectList(msg.getRowsList(),
+    sqlrpc_v1_types_pb.SqlRow.toObject, includeInstance),
+stats: (f = msg.getStats()) && sqlrpc_v1_types_pb.ExecutionStats.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -2619,23 +8478,23 @@ expiresAt: (f = msg.getExpiresAt()) && google_protobuf_timestamp_pb.Timestamp.to
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.BeginTransactionResponse}
+ * @return {!proto.sqlrpc.v1.TypedQueryResult}
  */
-proto.sqlrpc.v1.BeginTransactionResponse.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.TypedQueryResult.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.BeginTransactionResponse;
-  return proto.sqlrpc.v1.BeginTransactionResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.TypedQueryResult;
+  return proto.sqlrpc.v1.TypedQueryResult.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.BeginTransactionResponse} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.TypedQueryResult} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.BeginTransactionResponse}
+ * @return {!proto.sqlrpc.v1.TypedQueryResult}
  */
-proto.sqlrpc.v1.BeginTransactionResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.TypedQueryResult.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2644,12 +8503,27 @@ proto.sqlrpc.v1.BeginTransactionResponse.deserializeBinaryFromReader = function(
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setTransactionId(value);
+      msg.addColumns(value);
       break;
     case 2:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setExpiresAt(value);
+      reader.readPackableEnumInto(msg.getColumnAffinitiesList());
+      break;
+    case 3:
+      reader.readPackableEnumInto(msg.getColumnDeclaredTypesList());
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.addColumnRawTypes(value);
+      break;
+    case 5:
+      var value = new sqlrpc_v1_types_pb.SqlRow;
+      reader.readMessage(value,sqlrpc_v1_types_pb.SqlRow.deserializeBinaryFromReader);
+      msg.addRows(value);
+      break;
+    case 6:
+      var value = new sqlrpc_v1_types_pb.ExecutionStats;
+      reader.readMessage(value,sqlrpc_v1_types_pb.ExecutionStats.deserializeBinaryFromReader);
+      msg.setStats(value);
       break;
     default:
       reader.skipField();
@@ -2664,9 +8538,9 @@ proto.sqlrpc.v1.BeginTransactionResponse.deserializeBinaryFromReader = function(
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.BeginTransactionResponse.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.TypedQueryResult.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.BeginTransactionResponse.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.TypedQueryResult.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2674,284 +8548,270 @@ proto.sqlrpc.v1.BeginTransactionResponse.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.BeginTransactionResponse} message
+ * @param {!proto.sqlrpc.v1.TypedQueryResult} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.BeginTransactionResponse.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.TypedQueryResult.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTransactionId();
+  f = message.getColumnsList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedString(
       1,
       f
     );
   }
-  f = message.getExpiresAt();
+  f = message.getColumnAffinitiesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      2,
+      f
+    );
+  }
+  f = message.getColumnDeclaredTypesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      3,
+      f
+    );
+  }
+  f = message.getColumnRawTypesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      4,
+      f
+    );
+  }
+  f = message.getRowsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      5,
+      f,
+      sqlrpc_v1_types_pb.SqlRow.serializeBinaryToWriter
+    );
+  }
+  f = message.getStats();
   if (f != null) {
     writer.writeMessage(
-      2,
+      6,
       f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+      sqlrpc_v1_types_pb.ExecutionStats.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string transaction_id = 1;
- * @return {string}
+ * repeated string columns = 1;
+ * @return {!Array<string>}
  */
-proto.sqlrpc.v1.BeginTransactionResponse.prototype.getTransactionId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.sqlrpc.v1.TypedQueryResult.prototype.getColumnsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
+ */
+proto.sqlrpc.v1.TypedQueryResult.prototype.setColumnsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.BeginTransactionResponse} returns this
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
  */
-proto.sqlrpc.v1.BeginTransactionResponse.prototype.setTransactionId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.sqlrpc.v1.TypedQueryResult.prototype.addColumns = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
- * optional google.protobuf.Timestamp expires_at = 2;
- * @return {?proto.google.protobuf.Timestamp}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
  */
-proto.sqlrpc.v1.BeginTransactionResponse.prototype.getExpiresAt = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+proto.sqlrpc.v1.TypedQueryResult.prototype.clearColumnsList = function() {
+  return this.setColumnsList([]);
 };
 
 
 /**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.sqlrpc.v1.BeginTransactionResponse} returns this
-*/
-proto.sqlrpc.v1.BeginTransactionResponse.prototype.setExpiresAt = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+ * repeated ColumnAffinity column_affinities = 2;
+ * @return {!Array<!proto.sqlrpc.v1.ColumnAffinity>}
+ */
+proto.sqlrpc.v1.TypedQueryResult.prototype.getColumnAffinitiesList = function() {
+  return /** @type {!Array<!proto.sqlrpc.v1.ColumnAffinity>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.BeginTransactionResponse} returns this
+ * @param {!Array<!proto.sqlrpc.v1.ColumnAffinity>} value
+ * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
+ */
+proto.sqlrpc.v1.TypedQueryResult.prototype.setColumnAffinitiesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.ColumnAffinity} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
+ */
+proto.sqlrpc.v1.TypedQueryResult.prototype.addColumnAffinities = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
+ */
+proto.sqlrpc.v1.TypedQueryResult.prototype.clearColumnAffinitiesList = function() {
+  return this.setColumnAffinitiesList([]);
+};
+
+
+/**
+ * repeated DeclaredType column_declared_types = 3;
+ * @return {!Array<!proto.sqlrpc.v1.DeclaredType>}
  */
-proto.sqlrpc.v1.BeginTransactionResponse.prototype.clearExpiresAt = function() {
-  return this.setExpiresAt(undefined);
+proto.sqlrpc.v1.TypedQueryResult.prototype.getColumnDeclaredTypesList = function() {
+  return /** @type {!Array<!proto.sqlrpc.v1.DeclaredType>} */ (jspb.Message.getRepeatedField(this, 3));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {!Array<!proto.sqlrpc.v1.DeclaredType>} value
+ * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
  */
-proto.sqlrpc.v1.BeginTransactionResponse.prototype.hasExpiresAt = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.sqlrpc.v1.TypedQueryResult.prototype.setColumnDeclaredTypesList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {!proto.sqlrpc.v1.DeclaredType} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
  */
-proto.sqlrpc.v1.TransactionQueryRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.TransactionQueryRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.TypedQueryResult.prototype.addColumnDeclaredTypes = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.TransactionQueryRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
  */
-proto.sqlrpc.v1.TransactionQueryRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-transactionId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-sql: jspb.Message.getFieldWithDefault(msg, 2, ""),
-parameters: (f = msg.getParameters()) && sqlrpc_v1_types_pb.Parameters.toObject(includeInstance, f)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.sqlrpc.v1.TypedQueryResult.prototype.clearColumnDeclaredTypesList = function() {
+  return this.setColumnDeclaredTypesList([]);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.TransactionQueryRequest}
+ * repeated string column_raw_types = 4;
+ * @return {!Array<string>}
  */
-proto.sqlrpc.v1.TransactionQueryRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.TransactionQueryRequest;
-  return proto.sqlrpc.v1.TransactionQueryRequest.deserializeBinaryFromReader(msg, reader);
+proto.sqlrpc.v1.TypedQueryResult.prototype.getColumnRawTypesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.TransactionQueryRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.TransactionQueryRequest}
+ * @param {!Array<string>} value
+ * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
  */
-proto.sqlrpc.v1.TransactionQueryRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setTransactionId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setSql(value);
-      break;
-    case 3:
-      var value = new sqlrpc_v1_types_pb.Parameters;
-      reader.readMessage(value,sqlrpc_v1_types_pb.Parameters.deserializeBinaryFromReader);
-      msg.setParameters(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.sqlrpc.v1.TypedQueryResult.prototype.setColumnRawTypesList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
  */
-proto.sqlrpc.v1.TransactionQueryRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.TransactionQueryRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.sqlrpc.v1.TypedQueryResult.prototype.addColumnRawTypes = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.TransactionQueryRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
  */
-proto.sqlrpc.v1.TransactionQueryRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getTransactionId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getSql();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getParameters();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      sqlrpc_v1_types_pb.Parameters.serializeBinaryToWriter
-    );
-  }
+proto.sqlrpc.v1.TypedQueryResult.prototype.clearColumnRawTypesList = function() {
+  return this.setColumnRawTypesList([]);
 };
 
 
 /**
- * optional string transaction_id = 1;
- * @return {string}
+ * repeated SqlRow rows = 5;
+ * @return {!Array<!proto.sqlrpc.v1.SqlRow>}
  */
-proto.sqlrpc.v1.TransactionQueryRequest.prototype.getTransactionId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.sqlrpc.v1.TypedQueryResult.prototype.getRowsList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.SqlRow>} */ (
+    jspb.Message.getRepeatedWrapperField(this, sqlrpc_v1_types_pb.SqlRow, 5));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.TransactionQueryRequest} returns this
- */
-proto.sqlrpc.v1.TransactionQueryRequest.prototype.setTransactionId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+ * @param {!Array<!proto.sqlrpc.v1.SqlRow>} value
+ * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
+*/
+proto.sqlrpc.v1.TypedQueryResult.prototype.setRowsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 5, value);
 };
 
 
 /**
- * optional string sql = 2;
- * @return {string}
+ * @param {!proto.sqlrpc.v1.SqlRow=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.SqlRow}
  */
-proto.sqlrpc.v1.TransactionQueryRequest.prototype.getSql = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.sqlrpc.v1.TypedQueryResult.prototype.addRows = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.sqlrpc.v1.SqlRow, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.TransactionQueryRequest} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
  */
-proto.sqlrpc.v1.TransactionQueryRequest.prototype.setSql = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.sqlrpc.v1.TypedQueryResult.prototype.clearRowsList = function() {
+  return this.setRowsList([]);
 };
 
 
 /**
- * optional Parameters parameters = 3;
- * @return {?proto.sqlrpc.v1.Parameters}
+ * optional ExecutionStats stats = 6;
+ * @return {?proto.sqlrpc.v1.ExecutionStats}
  */
-proto.sqlrpc.v1.TransactionQueryRequest.prototype.getParameters = function() {
-  return /** @type{?proto.sqlrpc.v1.Parameters} */ (
-    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.Parameters, 3));
+proto.sqlrpc.v1.TypedQueryResult.prototype.getStats = function() {
+  return /** @type{?proto.sqlrpc.v1.ExecutionStats} */ (
+    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.ExecutionStats, 6));
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.Parameters|undefined} value
- * @return {!proto.sqlrpc.v1.TransactionQueryRequest} returns this
+ * @param {?proto.sqlrpc.v1.ExecutionStats|undefined} value
+ * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
 */
-proto.sqlrpc.v1.TransactionQueryRequest.prototype.setParameters = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+proto.sqlrpc.v1.TypedQueryResult.prototype.setStats = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TransactionQueryRequest} returns this
+ * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
  */
-proto.sqlrpc.v1.TransactionQueryRequest.prototype.clearParameters = function() {
-  return this.setParameters(undefined);
+proto.sqlrpc.v1.TypedQueryResult.prototype.clearStats = function() {
+  return this.setStats(undefined);
 };
 
 
@@ -2959,12 +8819,40 @@ proto.sqlrpc.v1.TransactionQueryRequest.prototype.clearParameters = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.sqlrpc.v1.TransactionQueryRequest.prototype.hasParameters = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.sqlrpc.v1.TypedQueryResult.prototype.hasStats = function() {
+  return jspb.Message.getField(this, 6) != null;
 };
 
 
 
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.sqlrpc.v1.QueryResponse.oneofGroups_ = [[1,2,3,5]];
+
+/**
+ * @enum {number}
+ */
+proto.sqlrpc.v1.QueryResponse.ResponseCase = {
+  RESPONSE_NOT_SET: 0,
+  HEADER: 1,
+  BATCH: 2,
+  COMPLETE: 3,
+  ERROR: 5
+};
+
+/**
+ * @return {proto.sqlrpc.v1.QueryResponse.ResponseCase}
+ */
+proto.sqlrpc.v1.QueryResponse.prototype.getResponseCase = function() {
+  return /** @type {proto.sqlrpc.v1.QueryResponse.ResponseCase} */(jspb.Message.computeOneofCase(this, proto.sqlrpc.v1.QueryResponse.oneofGroups_[0]));
+};
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2980,8 +8868,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.TransactionSavepointRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.TransactionSavepointRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.QueryResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.QueryResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2990,14 +8878,16 @@ proto.sqlrpc.v1.TransactionSavepointRequest.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.TransactionSavepointRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.QueryResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TransactionSavepointRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.QueryResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-transactionId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-savepoint: (f = msg.getSavepoint()) && proto.sqlrpc.v1.SavepointRequest.toObject(includeInstance, f)
+header: (f = msg.getHeader()) && proto.sqlrpc.v1.QueryResultHeader.toObject(includeInstance, f),
+batch: (f = msg.getBatch()) && proto.sqlrpc.v1.QueryResultRowBatch.toObject(includeInstance, f),
+complete: (f = msg.getComplete()) && proto.sqlrpc.v1.QueryComplete.toObject(includeInstance, f),
+error: (f = msg.getError()) && proto.sqlrpc.v1.ErrorResponse.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3011,23 +8901,23 @@ savepoint: (f = msg.getSavepoint()) && proto.sqlrpc.v1.SavepointRequest.toObject
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.TransactionSavepointRequest}
+ * @return {!proto.sqlrpc.v1.QueryResponse}
  */
-proto.sqlrpc.v1.TransactionSavepointRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.QueryResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.TransactionSavepointRequest;
-  return proto.sqlrpc.v1.TransactionSavepointRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.QueryResponse;
+  return proto.sqlrpc.v1.QueryResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.TransactionSavepointRequest} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.QueryResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.TransactionSavepointRequest}
+ * @return {!proto.sqlrpc.v1.QueryResponse}
  */
-proto.sqlrpc.v1.TransactionSavepointRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.QueryResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3035,13 +8925,24 @@ proto.sqlrpc.v1.TransactionSavepointRequest.deserializeBinaryFromReader = functi
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setTransactionId(value);
+      var value = new proto.sqlrpc.v1.QueryResultHeader;
+      reader.readMessage(value,proto.sqlrpc.v1.QueryResultHeader.deserializeBinaryFromReader);
+      msg.setHeader(value);
       break;
     case 2:
-      var value = new proto.sqlrpc.v1.SavepointRequest;
-      reader.readMessage(value,proto.sqlrpc.v1.SavepointRequest.deserializeBinaryFromReader);
-      msg.setSavepoint(value);
+      var value = new proto.sqlrpc.v1.QueryResultRowBatch;
+      reader.readMessage(value,proto.sqlrpc.v1.QueryResultRowBatch.deserializeBinaryFromReader);
+      msg.setBatch(value);
+      break;
+    case 3:
+      var value = new proto.sqlrpc.v1.QueryComplete;
+      reader.readMessage(value,proto.sqlrpc.v1.QueryComplete.deserializeBinaryFromReader);
+      msg.setComplete(value);
+      break;
+    case 5:
+      var value = new proto.sqlrpc.v1.ErrorResponse;
+      reader.readMessage(value,proto.sqlrpc.v1.ErrorResponse.deserializeBinaryFromReader);
+      msg.setError(value);
       break;
     default:
       reader.skipField();
@@ -3056,9 +8957,9 @@ proto.sqlrpc.v1.TransactionSavepointRequest.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.TransactionSavepointRequest.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.QueryResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.TransactionSavepointRequest.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.QueryResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3066,73 +8967,109 @@ proto.sqlrpc.v1.TransactionSavepointRequest.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.TransactionSavepointRequest} message
+ * @param {!proto.sqlrpc.v1.QueryResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TransactionSavepointRequest.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.QueryResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTransactionId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getHeader();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.sqlrpc.v1.QueryResultHeader.serializeBinaryToWriter
     );
   }
-  f = message.getSavepoint();
+  f = message.getBatch();
   if (f != null) {
     writer.writeMessage(
       2,
       f,
-      proto.sqlrpc.v1.SavepointRequest.serializeBinaryToWriter
+      proto.sqlrpc.v1.QueryResultRowBatch.serializeBinaryToWriter
+    );
+  }
+  f = message.getComplete();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.sqlrpc.v1.QueryComplete.serializeBinaryToWriter
+    );
+  }
+  f = message.getError();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.sqlrpc.v1.ErrorResponse.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string transaction_id = 1;
- * @return {string}
+ * optional QueryResultHeader header = 1;
+ * @return {?proto.sqlrpc.v1.QueryResultHeader}
  */
-proto.sqlrpc.v1.TransactionSavepointRequest.prototype.getTransactionId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.sqlrpc.v1.QueryResponse.prototype.getHeader = function() {
+  return /** @type{?proto.sqlrpc.v1.QueryResultHeader} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.QueryResultHeader, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.TransactionSavepointRequest} returns this
+ * @param {?proto.sqlrpc.v1.QueryResultHeader|undefined} value
+ * @return {!proto.sqlrpc.v1.QueryResponse} returns this
+*/
+proto.sqlrpc.v1.QueryResponse.prototype.setHeader = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 1, proto.sqlrpc.v1.QueryResponse.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.QueryResponse} returns this
  */
-proto.sqlrpc.v1.TransactionSavepointRequest.prototype.setTransactionId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.sqlrpc.v1.QueryResponse.prototype.clearHeader = function() {
+  return this.setHeader(undefined);
 };
 
 
 /**
- * optional SavepointRequest savepoint = 2;
- * @return {?proto.sqlrpc.v1.SavepointRequest}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.TransactionSavepointRequest.prototype.getSavepoint = function() {
-  return /** @type{?proto.sqlrpc.v1.SavepointRequest} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.SavepointRequest, 2));
+proto.sqlrpc.v1.QueryResponse.prototype.hasHeader = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.SavepointRequest|undefined} value
- * @return {!proto.sqlrpc.v1.TransactionSavepointRequest} returns this
+ * optional QueryResultRowBatch batch = 2;
+ * @return {?proto.sqlrpc.v1.QueryResultRowBatch}
+ */
+proto.sqlrpc.v1.QueryResponse.prototype.getBatch = function() {
+  return /** @type{?proto.sqlrpc.v1.QueryResultRowBatch} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.QueryResultRowBatch, 2));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.QueryResultRowBatch|undefined} value
+ * @return {!proto.sqlrpc.v1.QueryResponse} returns this
 */
-proto.sqlrpc.v1.TransactionSavepointRequest.prototype.setSavepoint = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+proto.sqlrpc.v1.QueryResponse.prototype.setBatch = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 2, proto.sqlrpc.v1.QueryResponse.oneofGroups_[0], value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TransactionSavepointRequest} returns this
+ * @return {!proto.sqlrpc.v1.QueryResponse} returns this
  */
-proto.sqlrpc.v1.TransactionSavepointRequest.prototype.clearSavepoint = function() {
-  return this.setSavepoint(undefined);
+proto.sqlrpc.v1.QueryResponse.prototype.clearBatch = function() {
+  return this.setBatch(undefined);
 };
 
 
@@ -3140,11 +9077,113 @@ proto.sqlrpc.v1.TransactionSavepointRequest.prototype.clearSavepoint = function(
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.sqlrpc.v1.TransactionSavepointRequest.prototype.hasSavepoint = function() {
+proto.sqlrpc.v1.QueryResponse.prototype.hasBatch = function() {
   return jspb.Message.getField(this, 2) != null;
 };
 
 
+/**
+ * optional QueryComplete complete = 3;
+ * @return {?proto.sqlrpc.v1.QueryComplete}
+ */
+proto.sqlrpc.v1.QueryResponse.prototype.getComplete = function() {
+  return /** @type{?proto.sqlrpc.v1.QueryComplete} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.QueryComplete, 3));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.QueryComplete|undefined} value
+ * @return {!proto.sqlrpc.v1.QueryResponse} returns this
+*/
+proto.sqlrpc.v1.QueryResponse.prototype.setComplete = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 3, proto.sqlrpc.v1.QueryResponse.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.QueryResponse} returns this
+ */
+proto.sqlrpc.v1.QueryResponse.prototype.clearComplete = function() {
+  return this.setComplete(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.QueryResponse.prototype.hasComplete = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional ErrorResponse error = 5;
+ * @return {?proto.sqlrpc.v1.ErrorResponse}
+ */
+proto.sqlrpc.v1.QueryResponse.prototype.getError = function() {
+  return /** @type{?proto.sqlrpc.v1.ErrorResponse} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.ErrorResponse, 5));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.ErrorResponse|undefined} value
+ * @return {!proto.sqlrpc.v1.QueryResponse} returns this
+*/
+proto.sqlrpc.v1.QueryResponse.prototype.setError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 5, proto.sqlrpc.v1.QueryResponse.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.QueryResponse} returns this
+ */
+proto.sqlrpc.v1.QueryResponse.prototype.clearError = function() {
+  return this.setError(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.QueryResponse.prototype.hasError = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.sqlrpc.v1.TypedQueryResponse.oneofGroups_ = [[1,2,3,5]];
+
+/**
+ * @enum {number}
+ */
+proto.sqlrpc.v1.TypedQueryResponse.ResponseCase = {
+  RESPONSE_NOT_SET: 0,
+  HEADER: 1,
+  BATCH: 2,
+  COMPLETE: 3,
+  ERROR: 5
+};
+
+/**
+ * @return {proto.sqlrpc.v1.TypedQueryResponse.ResponseCase}
+ */
+proto.sqlrpc.v1.TypedQueryResponse.prototype.getResponseCase = function() {
+  return /** @type {proto.sqlrpc.v1.TypedQueryResponse.ResponseCase} */(jspb.Message.computeOneofCase(this, proto.sqlrpc.v1.TypedQueryResponse.oneofGroups_[0]));
+};
 
 
 
@@ -3161,8 +9200,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.SavepointResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.SavepointResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.TypedQueryResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.TypedQueryResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3171,15 +9210,16 @@ proto.sqlrpc.v1.SavepointResponse.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.SavepointResponse} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.TypedQueryResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.SavepointResponse.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.TypedQueryResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
-name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-action: jspb.Message.getFieldWithDefault(msg, 3, 0)
+header: (f = msg.getHeader()) && proto.sqlrpc.v1.TypedQueryResultHeader.toObject(includeInstance, f),
+batch: (f = msg.getBatch()) && proto.sqlrpc.v1.TypedQueryResultRowBatch.toObject(includeInstance, f),
+complete: (f = msg.getComplete()) && proto.sqlrpc.v1.QueryComplete.toObject(includeInstance, f),
+error: (f = msg.getError()) && proto.sqlrpc.v1.ErrorResponse.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3193,23 +9233,23 @@ action: jspb.Message.getFieldWithDefault(msg, 3, 0)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.SavepointResponse}
+ * @return {!proto.sqlrpc.v1.TypedQueryResponse}
  */
-proto.sqlrpc.v1.SavepointResponse.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.TypedQueryResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.SavepointResponse;
-  return proto.sqlrpc.v1.SavepointResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.TypedQueryResponse;
+  return proto.sqlrpc.v1.TypedQueryResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.SavepointResponse} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.TypedQueryResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.SavepointResponse}
+ * @return {!proto.sqlrpc.v1.TypedQueryResponse}
  */
-proto.sqlrpc.v1.SavepointResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.TypedQueryResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3217,16 +9257,24 @@ proto.sqlrpc.v1.SavepointResponse.deserializeBinaryFromReader = function(msg, re
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSuccess(value);
+      var value = new proto.sqlrpc.v1.TypedQueryResultHeader;
+      reader.readMessage(value,proto.sqlrpc.v1.TypedQueryResultHeader.deserializeBinaryFromReader);
+      msg.setHeader(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setName(value);
+      var value = new proto.sqlrpc.v1.TypedQueryResultRowBatch;
+      reader.readMessage(value,proto.sqlrpc.v1.TypedQueryResultRowBatch.deserializeBinaryFromReader);
+      msg.setBatch(value);
       break;
     case 3:
-      var value = /** @type {!proto.sqlrpc.v1.SavepointAction} */ (reader.readEnum());
-      msg.setAction(value);
+      var value = new proto.sqlrpc.v1.QueryComplete;
+      reader.readMessage(value,proto.sqlrpc.v1.QueryComplete.deserializeBinaryFromReader);
+      msg.setComplete(value);
+      break;
+    case 5:
+      var value = new proto.sqlrpc.v1.ErrorResponse;
+      reader.readMessage(value,proto.sqlrpc.v1.ErrorResponse.deserializeBinaryFromReader);
+      msg.setError(value);
       break;
     default:
       reader.skipField();
@@ -3241,9 +9289,9 @@ proto.sqlrpc.v1.SavepointResponse.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.SavepointResponse.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.TypedQueryResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.SavepointResponse.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.TypedQueryResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3251,220 +9299,202 @@ proto.sqlrpc.v1.SavepointResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.SavepointResponse} message
+ * @param {!proto.sqlrpc.v1.TypedQueryResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.SavepointResponse.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.TypedQueryResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSuccess();
-  if (f) {
-    writer.writeBool(
+  f = message.getHeader();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.sqlrpc.v1.TypedQueryResultHeader.serializeBinaryToWriter
     );
   }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getBatch();
+  if (f != null) {
+    writer.writeMessage(
       2,
-      f
+      f,
+      proto.sqlrpc.v1.TypedQueryResultRowBatch.serializeBinaryToWriter
     );
   }
-  f = message.getAction();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getComplete();
+  if (f != null) {
+    writer.writeMessage(
       3,
-      f
+      f,
+      proto.sqlrpc.v1.QueryComplete.serializeBinaryToWriter
+    );
+  }
+  f = message.getError();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.sqlrpc.v1.ErrorResponse.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional bool success = 1;
- * @return {boolean}
+ * optional TypedQueryResultHeader header = 1;
+ * @return {?proto.sqlrpc.v1.TypedQueryResultHeader}
  */
-proto.sqlrpc.v1.SavepointResponse.prototype.getSuccess = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.sqlrpc.v1.TypedQueryResponse.prototype.getHeader = function() {
+  return /** @type{?proto.sqlrpc.v1.TypedQueryResultHeader} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.TypedQueryResultHeader, 1));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.sqlrpc.v1.SavepointResponse} returns this
- */
-proto.sqlrpc.v1.SavepointResponse.prototype.setSuccess = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+ * @param {?proto.sqlrpc.v1.TypedQueryResultHeader|undefined} value
+ * @return {!proto.sqlrpc.v1.TypedQueryResponse} returns this
+*/
+proto.sqlrpc.v1.TypedQueryResponse.prototype.setHeader = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 1, proto.sqlrpc.v1.TypedQueryResponse.oneofGroups_[0], value);
 };
 
 
 /**
- * optional string name = 2;
- * @return {string}
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.TypedQueryResponse} returns this
  */
-proto.sqlrpc.v1.SavepointResponse.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.sqlrpc.v1.TypedQueryResponse.prototype.clearHeader = function() {
+  return this.setHeader(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.SavepointResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.SavepointResponse.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.sqlrpc.v1.TypedQueryResponse.prototype.hasHeader = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * optional SavepointAction action = 3;
- * @return {!proto.sqlrpc.v1.SavepointAction}
+ * optional TypedQueryResultRowBatch batch = 2;
+ * @return {?proto.sqlrpc.v1.TypedQueryResultRowBatch}
  */
-proto.sqlrpc.v1.SavepointResponse.prototype.getAction = function() {
-  return /** @type {!proto.sqlrpc.v1.SavepointAction} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.sqlrpc.v1.TypedQueryResponse.prototype.getBatch = function() {
+  return /** @type{?proto.sqlrpc.v1.TypedQueryResultRowBatch} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.TypedQueryResultRowBatch, 2));
 };
 
 
 /**
- * @param {!proto.sqlrpc.v1.SavepointAction} value
- * @return {!proto.sqlrpc.v1.SavepointResponse} returns this
- */
-proto.sqlrpc.v1.SavepointResponse.prototype.setAction = function(value) {
-  return jspb.Message.setProto3EnumField(this, 3, value);
+ * @param {?proto.sqlrpc.v1.TypedQueryResultRowBatch|undefined} value
+ * @return {!proto.sqlrpc.v1.TypedQueryResponse} returns this
+*/
+proto.sqlrpc.v1.TypedQueryResponse.prototype.setBatch = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 2, proto.sqlrpc.v1.TypedQueryResponse.oneofGroups_[0], value);
 };
 
 
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.TypedQueryResponse} returns this
+ */
+proto.sqlrpc.v1.TypedQueryResponse.prototype.clearBatch = function() {
+  return this.setBatch(undefined);
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.TransactionControlRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.TransactionControlRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.TypedQueryResponse.prototype.hasBatch = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.TransactionControlRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional QueryComplete complete = 3;
+ * @return {?proto.sqlrpc.v1.QueryComplete}
  */
-proto.sqlrpc.v1.TransactionControlRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-transactionId: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
+proto.sqlrpc.v1.TypedQueryResponse.prototype.getComplete = function() {
+  return /** @type{?proto.sqlrpc.v1.QueryComplete} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.QueryComplete, 3));
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * @param {?proto.sqlrpc.v1.QueryComplete|undefined} value
+ * @return {!proto.sqlrpc.v1.TypedQueryResponse} returns this
+*/
+proto.sqlrpc.v1.TypedQueryResponse.prototype.setComplete = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 3, proto.sqlrpc.v1.TypedQueryResponse.oneofGroups_[0], value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.TransactionControlRequest}
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.TypedQueryResponse} returns this
  */
-proto.sqlrpc.v1.TransactionControlRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.TransactionControlRequest;
-  return proto.sqlrpc.v1.TransactionControlRequest.deserializeBinaryFromReader(msg, reader);
+proto.sqlrpc.v1.TypedQueryResponse.prototype.clearComplete = function() {
+  return this.setComplete(undefined);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.TransactionControlRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.TransactionControlRequest}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.TransactionControlRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setTransactionId(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.sqlrpc.v1.TypedQueryResponse.prototype.hasComplete = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional ErrorResponse error = 5;
+ * @return {?proto.sqlrpc.v1.ErrorResponse}
  */
-proto.sqlrpc.v1.TransactionControlRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.TransactionControlRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.sqlrpc.v1.TypedQueryResponse.prototype.getError = function() {
+  return /** @type{?proto.sqlrpc.v1.ErrorResponse} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.ErrorResponse, 5));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.TransactionControlRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {?proto.sqlrpc.v1.ErrorResponse|undefined} value
+ * @return {!proto.sqlrpc.v1.TypedQueryResponse} returns this
+*/
+proto.sqlrpc.v1.TypedQueryResponse.prototype.setError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 5, proto.sqlrpc.v1.TypedQueryResponse.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.TypedQueryResponse} returns this
  */
-proto.sqlrpc.v1.TransactionControlRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getTransactionId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.sqlrpc.v1.TypedQueryResponse.prototype.clearError = function() {
+  return this.setError(undefined);
 };
 
 
 /**
- * optional string transaction_id = 1;
- * @return {string}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.TransactionControlRequest.prototype.getTransactionId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.sqlrpc.v1.TypedQueryResponse.prototype.hasError = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
+
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.TransactionControlRequest} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.sqlrpc.v1.TransactionControlRequest.prototype.setTransactionId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
+proto.sqlrpc.v1.QueryResultHeader.repeatedFields_ = [1,2,3,4];
 
 
 
@@ -3481,8 +9511,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.TransactionControlResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.TransactionControlResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.QueryResultHeader.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.QueryResultHeader.toObject(opt_includeInstance, this);
 };
 
 
@@ -3491,13 +9521,16 @@ proto.sqlrpc.v1.TransactionControlResponse.prototype.toObject = function(opt_inc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.TransactionControlResponse} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.QueryResultHeader} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TransactionControlResponse.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.QueryResultHeader.toObject = function(includeInstance, msg) {
   var f, obj = {
-success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+columnsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+columnAffinitiesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+columnDeclaredTypesList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+columnRawTypesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -3511,23 +9544,23 @@ success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.TransactionControlResponse}
+ * @return {!proto.sqlrpc.v1.QueryResultHeader}
  */
-proto.sqlrpc.v1.TransactionControlResponse.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.QueryResultHeader.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.TransactionControlResponse;
-  return proto.sqlrpc.v1.TransactionControlResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.QueryResultHeader;
+  return proto.sqlrpc.v1.QueryResultHeader.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.TransactionControlResponse} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.QueryResultHeader} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.TransactionControlResponse}
+ * @return {!proto.sqlrpc.v1.QueryResultHeader}
  */
-proto.sqlrpc.v1.TransactionControlResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.QueryResultHeader.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3535,8 +9568,18 @@ proto.sqlrpc.v1.TransactionControlResponse.deserializeBinaryFromReader = functio
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSuccess(value);
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.addColumns(value);
+      break;
+    case 2:
+      reader.readPackableEnumInto(msg.getColumnAffinitiesList());
+      break;
+    case 3:
+      reader.readPackableEnumInto(msg.getColumnDeclaredTypesList());
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.addColumnRawTypes(value);
       break;
     default:
       reader.skipField();
@@ -3551,9 +9594,9 @@ proto.sqlrpc.v1.TransactionControlResponse.deserializeBinaryFromReader = functio
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.TransactionControlResponse.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.QueryResultHeader.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.TransactionControlResponse.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.QueryResultHeader.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3561,197 +9604,188 @@ proto.sqlrpc.v1.TransactionControlResponse.prototype.serializeBinary = function(
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.TransactionControlResponse} message
+ * @param {!proto.sqlrpc.v1.QueryResultHeader} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TransactionControlResponse.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.QueryResultHeader.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSuccess();
-  if (f) {
-    writer.writeBool(
+  f = message.getColumnsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
       1,
       f
     );
   }
+  f = message.getColumnAffinitiesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      2,
+      f
+    );
+  }
+  f = message.getColumnDeclaredTypesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      3,
+      f
+    );
+  }
+  f = message.getColumnRawTypesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      4,
+      f
+    );
+  }
 };
 
 
 /**
- * optional bool success = 1;
- * @return {boolean}
+ * repeated string columns = 1;
+ * @return {!Array<string>}
  */
-proto.sqlrpc.v1.TransactionControlResponse.prototype.getSuccess = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.sqlrpc.v1.QueryResultHeader.prototype.getColumnsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.sqlrpc.v1.TransactionControlResponse} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.sqlrpc.v1.QueryResultHeader} returns this
  */
-proto.sqlrpc.v1.TransactionControlResponse.prototype.setSuccess = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.sqlrpc.v1.QueryResultHeader.prototype.setColumnsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.QueryResultHeader} returns this
+ */
+proto.sqlrpc.v1.QueryResultHeader.prototype.addColumns = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.QueryResultHeader} returns this
  */
-proto.sqlrpc.v1.ExecuteTransactionRequest.repeatedFields_ = [1];
+proto.sqlrpc.v1.QueryResultHeader.prototype.clearColumnsList = function() {
+  return this.setColumnsList([]);
+};
 
 
+/**
+ * repeated ColumnAffinity column_affinities = 2;
+ * @return {!Array<!proto.sqlrpc.v1.ColumnAffinity>}
+ */
+proto.sqlrpc.v1.QueryResultHeader.prototype.getColumnAffinitiesList = function() {
+  return /** @type {!Array<!proto.sqlrpc.v1.ColumnAffinity>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {!Array<!proto.sqlrpc.v1.ColumnAffinity>} value
+ * @return {!proto.sqlrpc.v1.QueryResultHeader} returns this
  */
-proto.sqlrpc.v1.ExecuteTransactionRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.ExecuteTransactionRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.QueryResultHeader.prototype.setColumnAffinitiesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.ExecuteTransactionRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {!proto.sqlrpc.v1.ColumnAffinity} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.QueryResultHeader} returns this
  */
-proto.sqlrpc.v1.ExecuteTransactionRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-requestsList: jspb.Message.toObjectList(msg.getRequestsList(),
-    proto.sqlrpc.v1.TransactionRequest.toObject, includeInstance)
-  };
+proto.sqlrpc.v1.QueryResultHeader.prototype.addColumnAffinities = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.QueryResultHeader} returns this
+ */
+proto.sqlrpc.v1.QueryResultHeader.prototype.clearColumnAffinitiesList = function() {
+  return this.setColumnAffinitiesList([]);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.ExecuteTransactionRequest}
+ * repeated DeclaredType column_declared_types = 3;
+ * @return {!Array<!proto.sqlrpc.v1.DeclaredType>}
  */
-proto.sqlrpc.v1.ExecuteTransactionRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.ExecuteTransactionRequest;
-  return proto.sqlrpc.v1.ExecuteTransactionRequest.deserializeBinaryFromReader(msg, reader);
+proto.sqlrpc.v1.QueryResultHeader.prototype.getColumnDeclaredTypesList = function() {
+  return /** @type {!Array<!proto.sqlrpc.v1.DeclaredType>} */ (jspb.Message.getRepeatedField(this, 3));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.ExecuteTransactionRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.ExecuteTransactionRequest}
+ * @param {!Array<!proto.sqlrpc.v1.DeclaredType>} value
+ * @return {!proto.sqlrpc.v1.QueryResultHeader} returns this
  */
-proto.sqlrpc.v1.ExecuteTransactionRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = new proto.sqlrpc.v1.TransactionRequest;
-      reader.readMessage(value,proto.sqlrpc.v1.TransactionRequest.deserializeBinaryFromReader);
-      msg.addRequests(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.sqlrpc.v1.QueryResultHeader.prototype.setColumnDeclaredTypesList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {!proto.sqlrpc.v1.DeclaredType} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.QueryResultHeader} returns this
  */
-proto.sqlrpc.v1.ExecuteTransactionRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.ExecuteTransactionRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.sqlrpc.v1.QueryResultHeader.prototype.addColumnDeclaredTypes = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.ExecuteTransactionRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.QueryResultHeader} returns this
  */
-proto.sqlrpc.v1.ExecuteTransactionRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getRequestsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      1,
-      f,
-      proto.sqlrpc.v1.TransactionRequest.serializeBinaryToWriter
-    );
-  }
+proto.sqlrpc.v1.QueryResultHeader.prototype.clearColumnDeclaredTypesList = function() {
+  return this.setColumnDeclaredTypesList([]);
 };
 
 
 /**
- * repeated TransactionRequest requests = 1;
- * @return {!Array<!proto.sqlrpc.v1.TransactionRequest>}
+ * repeated string column_raw_types = 4;
+ * @return {!Array<string>}
  */
-proto.sqlrpc.v1.ExecuteTransactionRequest.prototype.getRequestsList = function() {
-  return /** @type{!Array<!proto.sqlrpc.v1.TransactionRequest>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.sqlrpc.v1.TransactionRequest, 1));
+proto.sqlrpc.v1.QueryResultHeader.prototype.getColumnRawTypesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
 };
 
 
 /**
- * @param {!Array<!proto.sqlrpc.v1.TransactionRequest>} value
- * @return {!proto.sqlrpc.v1.ExecuteTransactionRequest} returns this
-*/
-proto.sqlrpc.v1.ExecuteTransactionRequest.prototype.setRequestsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {!Array<string>} value
+ * @return {!proto.sqlrpc.v1.QueryResultHeader} returns this
+ */
+proto.sqlrpc.v1.QueryResultHeader.prototype.setColumnRawTypesList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
 };
 
 
 /**
- * @param {!proto.sqlrpc.v1.TransactionRequest=} opt_value
+ * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.TransactionRequest}
+ * @return {!proto.sqlrpc.v1.QueryResultHeader} returns this
  */
-proto.sqlrpc.v1.ExecuteTransactionRequest.prototype.addRequests = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.sqlrpc.v1.TransactionRequest, opt_index);
+proto.sqlrpc.v1.QueryResultHeader.prototype.addColumnRawTypes = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.ExecuteTransactionRequest} returns this
+ * @return {!proto.sqlrpc.v1.QueryResultHeader} returns this
  */
-proto.sqlrpc.v1.ExecuteTransactionRequest.prototype.clearRequestsList = function() {
-  return this.setRequestsList([]);
+proto.sqlrpc.v1.QueryResultHeader.prototype.clearColumnRawTypesList = function() {
+  return this.setColumnRawTypesList([]);
 };
 
 
@@ -3761,7 +9795,7 @@ proto.sqlrpc.v1.ExecuteTransactionRequest.prototype.clearRequestsList = function
  * @private {!Array<number>}
  * @const
  */
-proto.sqlrpc.v1.ExecuteTransactionResponse.repeatedFields_ = [1];
+proto.sqlrpc.v1.QueryResultRowBatch.repeatedFields_ = [1];
 
 
 
@@ -3778,8 +9812,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.ExecuteTransactionResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.ExecuteTransactionResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.QueryResultRowBatch.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.QueryResultRowBatch.toObject(opt_includeInstance, this);
 };
 
 
@@ -3788,14 +9822,15 @@ proto.sqlrpc.v1.ExecuteTransactionResponse.prototype.toObject = function(opt_inc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.ExecuteTransactionResponse} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.QueryResultRowBatch} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.ExecuteTransactionResponse.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.QueryResultRowBatch.toObject = function(includeInstance, msg) {
   var f, obj = {
-responsesList: jspb.Message.toObjectList(msg.getResponsesList(),
-    proto.sqlrpc.v1.TransactionResponse.toObject, includeInstance)
+rowsList: jspb.Message.toObjectList(msg.getRowsList(),
+    google_protobuf_struct_pb.ListValue.toObject, includeInstance),
+resumeToken: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -3809,23 +9844,23 @@ responsesList: jspb.Message.toObjectList(msg.getResponsesList(),
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.ExecuteTransactionResponse}
+ * @return {!proto.sqlrpc.v1.QueryResultRowBatch}
  */
-proto.sqlrpc.v1.ExecuteTransactionResponse.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.QueryResultRowBatch.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.ExecuteTransactionResponse;
-  return proto.sqlrpc.v1.ExecuteTransactionResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.QueryResultRowBatch;
+  return proto.sqlrpc.v1.QueryResultRowBatch.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.ExecuteTransactionResponse} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.QueryResultRowBatch} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.ExecuteTransactionResponse}
+ * @return {!proto.sqlrpc.v1.QueryResultRowBatch}
  */
-proto.sqlrpc.v1.ExecuteTransactionResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.QueryResultRowBatch.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3833,9 +9868,13 @@ proto.sqlrpc.v1.ExecuteTransactionResponse.deserializeBinaryFromReader = functio
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.sqlrpc.v1.TransactionResponse;
-      reader.readMessage(value,proto.sqlrpc.v1.TransactionResponse.deserializeBinaryFromReader);
-      msg.addResponses(value);
+      var value = new google_protobuf_struct_pb.ListValue;
+      reader.readMessage(value,google_protobuf_struct_pb.ListValue.deserializeBinaryFromReader);
+      msg.addRows(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setResumeToken(value);
       break;
     default:
       reader.skipField();
@@ -3850,9 +9889,9 @@ proto.sqlrpc.v1.ExecuteTransactionResponse.deserializeBinaryFromReader = functio
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.ExecuteTransactionResponse.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.QueryResultRowBatch.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.ExecuteTransactionResponse.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.QueryResultRowBatch.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3860,62 +9899,94 @@ proto.sqlrpc.v1.ExecuteTransactionResponse.prototype.serializeBinary = function(
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.ExecuteTransactionResponse} message
+ * @param {!proto.sqlrpc.v1.QueryResultRowBatch} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.ExecuteTransactionResponse.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.QueryResultRowBatch.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getResponsesList();
+  f = message.getRowsList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       1,
       f,
-      proto.sqlrpc.v1.TransactionResponse.serializeBinaryToWriter
+      google_protobuf_struct_pb.ListValue.serializeBinaryToWriter
+    );
+  }
+  f = message.getResumeToken();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
     );
   }
 };
 
 
 /**
- * repeated TransactionResponse responses = 1;
- * @return {!Array<!proto.sqlrpc.v1.TransactionResponse>}
+ * repeated google.protobuf.ListValue rows = 1;
+ * @return {!Array<!proto.google.protobuf.ListValue>}
  */
-proto.sqlrpc.v1.ExecuteTransactionResponse.prototype.getResponsesList = function() {
-  return /** @type{!Array<!proto.sqlrpc.v1.TransactionResponse>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.sqlrpc.v1.TransactionResponse, 1));
+proto.sqlrpc.v1.QueryResultRowBatch.prototype.getRowsList = function() {
+  return /** @type{!Array<!proto.google.protobuf.ListValue>} */ (
+    jspb.Message.getRepeatedWrapperField(this, google_protobuf_struct_pb.ListValue, 1));
 };
 
 
 /**
- * @param {!Array<!proto.sqlrpc.v1.TransactionResponse>} value
- * @return {!proto.sqlrpc.v1.ExecuteTransactionResponse} returns this
+ * @param {!Array<!proto.google.protobuf.ListValue>} value
+ * @return {!proto.sqlrpc.v1.QueryResultRowBatch} returns this
 */
-proto.sqlrpc.v1.ExecuteTransactionResponse.prototype.setResponsesList = function(value) {
+proto.sqlrpc.v1.QueryResultRowBatch.prototype.setRowsList = function(value) {
   return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.sqlrpc.v1.TransactionResponse=} opt_value
+ * @param {!proto.google.protobuf.ListValue=} opt_value
  * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.TransactionResponse}
+ * @return {!proto.google.protobuf.ListValue}
  */
-proto.sqlrpc.v1.ExecuteTransactionResponse.prototype.addResponses = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.sqlrpc.v1.TransactionResponse, opt_index);
+proto.sqlrpc.v1.QueryResultRowBatch.prototype.addRows = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.google.protobuf.ListValue, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.ExecuteTransactionResponse} returns this
+ * @return {!proto.sqlrpc.v1.QueryResultRowBatch} returns this
  */
-proto.sqlrpc.v1.ExecuteTransactionResponse.prototype.clearResponsesList = function() {
-  return this.setResponsesList([]);
+proto.sqlrpc.v1.QueryResultRowBatch.prototype.clearRowsList = function() {
+  return this.setRowsList([]);
+};
+
+
+/**
+ * optional string resume_token = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.QueryResultRowBatch.prototype.getResumeToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.QueryResultRowBatch} returns this
+ */
+proto.sqlrpc.v1.QueryResultRowBatch.prototype.setResumeToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.TypedQueryResultHeader.repeatedFields_ = [1,2,3,4];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -3931,8 +10002,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.TypedQueryRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.TypedQueryRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.TypedQueryResultHeader.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.TypedQueryResultHeader.toObject(opt_includeInstance, this);
 };
 
 
@@ -3941,15 +10012,16 @@ proto.sqlrpc.v1.TypedQueryRequest.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.TypedQueryRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.TypedQueryResultHeader} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TypedQueryRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.TypedQueryResultHeader.toObject = function(includeInstance, msg) {
   var f, obj = {
-database: jspb.Message.getFieldWithDefault(msg, 1, ""),
-sql: jspb.Message.getFieldWithDefault(msg, 2, ""),
-parameters: (f = msg.getParameters()) && sqlrpc_v1_types_pb.TypedParameters.toObject(includeInstance, f)
+columnsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+columnAffinitiesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+columnDeclaredTypesList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+columnRawTypesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -3963,23 +10035,23 @@ parameters: (f = msg.getParameters()) && sqlrpc_v1_types_pb.TypedParameters.toOb
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.TypedQueryRequest}
+ * @return {!proto.sqlrpc.v1.TypedQueryResultHeader}
  */
-proto.sqlrpc.v1.TypedQueryRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.TypedQueryResultHeader.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.TypedQueryRequest;
-  return proto.sqlrpc.v1.TypedQueryRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.TypedQueryResultHeader;
+  return proto.sqlrpc.v1.TypedQueryResultHeader.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.TypedQueryRequest} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.TypedQueryResultHeader} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.TypedQueryRequest}
+ * @return {!proto.sqlrpc.v1.TypedQueryResultHeader}
  */
-proto.sqlrpc.v1.TypedQueryRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.TypedQueryResultHeader.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3988,16 +10060,17 @@ proto.sqlrpc.v1.TypedQueryRequest.deserializeBinaryFromReader = function(msg, re
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setDatabase(value);
+      msg.addColumns(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setSql(value);
+      reader.readPackableEnumInto(msg.getColumnAffinitiesList());
+      break;
+    case 3:
+      reader.readPackableEnumInto(msg.getColumnDeclaredTypesList());
       break;
     case 4:
-      var value = new sqlrpc_v1_types_pb.TypedParameters;
-      reader.readMessage(value,sqlrpc_v1_types_pb.TypedParameters.deserializeBinaryFromReader);
-      msg.setParameters(value);
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.addColumnRawTypes(value);
       break;
     default:
       reader.skipField();
@@ -4009,124 +10082,212 @@ proto.sqlrpc.v1.TypedQueryRequest.deserializeBinaryFromReader = function(msg, re
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.TypedQueryResultHeader.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.TypedQueryResultHeader.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.TypedQueryResultHeader} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.TypedQueryResultHeader.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getColumnsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+  f = message.getColumnAffinitiesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      2,
+      f
+    );
+  }
+  f = message.getColumnDeclaredTypesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      3,
+      f
+    );
+  }
+  f = message.getColumnRawTypesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string columns = 1;
+ * @return {!Array<string>}
+ */
+proto.sqlrpc.v1.TypedQueryResultHeader.prototype.getColumnsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.sqlrpc.v1.TypedQueryResultHeader} returns this
+ */
+proto.sqlrpc.v1.TypedQueryResultHeader.prototype.setColumnsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.TypedQueryResultHeader} returns this
+ */
+proto.sqlrpc.v1.TypedQueryResultHeader.prototype.addColumns = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.TypedQueryResultHeader} returns this
+ */
+proto.sqlrpc.v1.TypedQueryResultHeader.prototype.clearColumnsList = function() {
+  return this.setColumnsList([]);
+};
+
+
+/**
+ * repeated ColumnAffinity column_affinities = 2;
+ * @return {!Array<!proto.sqlrpc.v1.ColumnAffinity>}
  */
-proto.sqlrpc.v1.TypedQueryRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.TypedQueryRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.sqlrpc.v1.TypedQueryResultHeader.prototype.getColumnAffinitiesList = function() {
+  return /** @type {!Array<!proto.sqlrpc.v1.ColumnAffinity>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.TypedQueryRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {!Array<!proto.sqlrpc.v1.ColumnAffinity>} value
+ * @return {!proto.sqlrpc.v1.TypedQueryResultHeader} returns this
  */
-proto.sqlrpc.v1.TypedQueryRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getDatabase();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getSql();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getParameters();
-  if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      sqlrpc_v1_types_pb.TypedParameters.serializeBinaryToWriter
-    );
-  }
+proto.sqlrpc.v1.TypedQueryResultHeader.prototype.setColumnAffinitiesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
- * optional string database = 1;
- * @return {string}
+ * @param {!proto.sqlrpc.v1.ColumnAffinity} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.TypedQueryResultHeader} returns this
  */
-proto.sqlrpc.v1.TypedQueryRequest.prototype.getDatabase = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.sqlrpc.v1.TypedQueryResultHeader.prototype.addColumnAffinities = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.TypedQueryRequest} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.TypedQueryResultHeader} returns this
  */
-proto.sqlrpc.v1.TypedQueryRequest.prototype.setDatabase = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.sqlrpc.v1.TypedQueryResultHeader.prototype.clearColumnAffinitiesList = function() {
+  return this.setColumnAffinitiesList([]);
 };
 
 
 /**
- * optional string sql = 2;
- * @return {string}
+ * repeated DeclaredType column_declared_types = 3;
+ * @return {!Array<!proto.sqlrpc.v1.DeclaredType>}
  */
-proto.sqlrpc.v1.TypedQueryRequest.prototype.getSql = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.sqlrpc.v1.TypedQueryResultHeader.prototype.getColumnDeclaredTypesList = function() {
+  return /** @type {!Array<!proto.sqlrpc.v1.DeclaredType>} */ (jspb.Message.getRepeatedField(this, 3));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.TypedQueryRequest} returns this
+ * @param {!Array<!proto.sqlrpc.v1.DeclaredType>} value
+ * @return {!proto.sqlrpc.v1.TypedQueryResultHeader} returns this
  */
-proto.sqlrpc.v1.TypedQueryRequest.prototype.setSql = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.sqlrpc.v1.TypedQueryResultHeader.prototype.setColumnDeclaredTypesList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
 };
 
 
 /**
- * optional TypedParameters parameters = 4;
- * @return {?proto.sqlrpc.v1.TypedParameters}
+ * @param {!proto.sqlrpc.v1.DeclaredType} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.TypedQueryResultHeader} returns this
  */
-proto.sqlrpc.v1.TypedQueryRequest.prototype.getParameters = function() {
-  return /** @type{?proto.sqlrpc.v1.TypedParameters} */ (
-    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.TypedParameters, 4));
+proto.sqlrpc.v1.TypedQueryResultHeader.prototype.addColumnDeclaredTypes = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.TypedParameters|undefined} value
- * @return {!proto.sqlrpc.v1.TypedQueryRequest} returns this
-*/
-proto.sqlrpc.v1.TypedQueryRequest.prototype.setParameters = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.TypedQueryResultHeader} returns this
+ */
+proto.sqlrpc.v1.TypedQueryResultHeader.prototype.clearColumnDeclaredTypesList = function() {
+  return this.setColumnDeclaredTypesList([]);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TypedQueryRequest} returns this
+ * repeated string column_raw_types = 4;
+ * @return {!Array<string>}
  */
-proto.sqlrpc.v1.TypedQueryRequest.prototype.clearParameters = function() {
-  return this.setParameters(undefined);
+proto.sqlrpc.v1.TypedQueryResultHeader.prototype.getColumnRawTypesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {!Array<string>} value
+ * @return {!proto.sqlrpc.v1.TypedQueryResultHeader} returns this
  */
-proto.sqlrpc.v1.TypedQueryRequest.prototype.hasParameters = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.sqlrpc.v1.TypedQueryResultHeader.prototype.setColumnRawTypesList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.TypedQueryResultHeader} returns this
+ */
+proto.sqlrpc.v1.TypedQueryResultHeader.prototype.addColumnRawTypes = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.TypedQueryResultHeader} returns this
+ */
+proto.sqlrpc.v1.TypedQueryResultHeader.prototype.clearColumnRawTypesList = function() {
+  return this.setColumnRawTypesList([]);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.sqlrpc.v1.TypedQueryResultRowBatch.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -4142,8 +10303,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.TypedTransactionQueryRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.TypedTransactionQueryRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.TypedQueryResultRowBatch.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.TypedQueryResultRowBatch.toObject(opt_includeInstance, this);
 };
 
 
@@ -4152,15 +10313,14 @@ proto.sqlrpc.v1.TypedTransactionQueryRequest.prototype.toObject = function(opt_i
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.TypedTransactionQueryRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.TypedQueryResultRowBatch} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TypedTransactionQueryRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.TypedQueryResultRowBatch.toObject = function(includeInstance, msg) {
   var f, obj = {
-transactionId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-sql: jspb.Message.getFieldWithDefault(msg, 2, ""),
-parameters: (f = msg.getParameters()) && sqlrpc_v1_types_pb.TypedParameters.toObject(includeInstance, f)
+rowsList: jspb.Message.toObjectList(msg.getRowsList(),
+    sqlrpc_v1_types_pb.SqlRow.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -4174,177 +10334,113 @@ parameters: (f = msg.getParameters()) && sqlrpc_v1_types_pb.TypedParameters.toOb
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.TypedTransactionQueryRequest}
+ * @return {!proto.sqlrpc.v1.TypedQueryResultRowBatch}
  */
-proto.sqlrpc.v1.TypedTransactionQueryRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.TypedQueryResultRowBatch.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.TypedTransactionQueryRequest;
-  return proto.sqlrpc.v1.TypedTransactionQueryRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.TypedQueryResultRowBatch;
+  return proto.sqlrpc.v1.TypedQueryResultRowBatch.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.TypedTransactionQueryRequest} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.TypedQueryResultRowBatch} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.TypedTransactionQueryRequest}
+ * @return {!proto.sqlrpc.v1.TypedQueryResultRowBatch}
  */
-proto.sqlrpc.v1.TypedTransactionQueryRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.TypedQueryResultRowBatch.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setTransactionId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setSql(value);
-      break;
-    case 3:
-      var value = new sqlrpc_v1_types_pb.TypedParameters;
-      reader.readMessage(value,sqlrpc_v1_types_pb.TypedParameters.deserializeBinaryFromReader);
-      msg.setParameters(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
-};
-
-
-/**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
- */
-proto.sqlrpc.v1.TypedTransactionQueryRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.TypedTransactionQueryRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
-};
-
-
-/**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.TypedTransactionQueryRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.sqlrpc.v1.TypedTransactionQueryRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getTransactionId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getSql();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getParameters();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      sqlrpc_v1_types_pb.TypedParameters.serializeBinaryToWriter
-    );
-  }
-};
-
-
-/**
- * optional string transaction_id = 1;
- * @return {string}
- */
-proto.sqlrpc.v1.TypedTransactionQueryRequest.prototype.getTransactionId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.TypedTransactionQueryRequest} returns this
- */
-proto.sqlrpc.v1.TypedTransactionQueryRequest.prototype.setTransactionId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new sqlrpc_v1_types_pb.SqlRow;
+      reader.readMessage(value,sqlrpc_v1_types_pb.SqlRow.deserializeBinaryFromReader);
+      msg.addRows(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional string sql = 2;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.TypedTransactionQueryRequest.prototype.getSql = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.sqlrpc.v1.TypedQueryResultRowBatch.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.TypedQueryResultRowBatch.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.TypedTransactionQueryRequest} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.TypedQueryResultRowBatch} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TypedTransactionQueryRequest.prototype.setSql = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.sqlrpc.v1.TypedQueryResultRowBatch.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRowsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      sqlrpc_v1_types_pb.SqlRow.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional TypedParameters parameters = 3;
- * @return {?proto.sqlrpc.v1.TypedParameters}
+ * repeated SqlRow rows = 1;
+ * @return {!Array<!proto.sqlrpc.v1.SqlRow>}
  */
-proto.sqlrpc.v1.TypedTransactionQueryRequest.prototype.getParameters = function() {
-  return /** @type{?proto.sqlrpc.v1.TypedParameters} */ (
-    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.TypedParameters, 3));
+proto.sqlrpc.v1.TypedQueryResultRowBatch.prototype.getRowsList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.SqlRow>} */ (
+    jspb.Message.getRepeatedWrapperField(this, sqlrpc_v1_types_pb.SqlRow, 1));
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.TypedParameters|undefined} value
- * @return {!proto.sqlrpc.v1.TypedTransactionQueryRequest} returns this
+ * @param {!Array<!proto.sqlrpc.v1.SqlRow>} value
+ * @return {!proto.sqlrpc.v1.TypedQueryResultRowBatch} returns this
 */
-proto.sqlrpc.v1.TypedTransactionQueryRequest.prototype.setParameters = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+proto.sqlrpc.v1.TypedQueryResultRowBatch.prototype.setRowsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TypedTransactionQueryRequest} returns this
+ * @param {!proto.sqlrpc.v1.SqlRow=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.SqlRow}
  */
-proto.sqlrpc.v1.TypedTransactionQueryRequest.prototype.clearParameters = function() {
-  return this.setParameters(undefined);
+proto.sqlrpc.v1.TypedQueryResultRowBatch.prototype.addRows = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.sqlrpc.v1.SqlRow, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.TypedQueryResultRowBatch} returns this
  */
-proto.sqlrpc.v1.TypedTransactionQueryRequest.prototype.hasParameters = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.sqlrpc.v1.TypedQueryResultRowBatch.prototype.clearRowsList = function() {
+  return this.setRowsList([]);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.sqlrpc.v1.TypedQueryResult.repeatedFields_ = [1,2,3,4,5];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -4360,8 +10456,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.TypedQueryResult.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.TypedQueryResult.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.QueryComplete.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.QueryComplete.toObject(opt_includeInstance, this);
 };
 
 
@@ -4370,18 +10466,12 @@ proto.sqlrpc.v1.TypedQueryResult.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.TypedQueryResult} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.QueryComplete} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TypedQueryResult.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.QueryComplete.toObject = function(includeInstance, msg) {
   var f, obj = {
-columnsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
-columnAffinitiesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
-columnDeclaredTypesList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
-columnRawTypesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
-rowsList: jspb.Message.toObjectList(msg.getRowsList(),
-    sqlrpc_v1_types_pb.SqlRow.toObject, includeInstance),
 stats: (f = msg.getStats()) && sqlrpc_v1_types_pb.ExecutionStats.toObject(includeInstance, f)
   };
 
@@ -4396,23 +10486,23 @@ stats: (f = msg.getStats()) && sqlrpc_v1_types_pb.ExecutionStats.toObject(includ
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.TypedQueryResult}
+ * @return {!proto.sqlrpc.v1.QueryComplete}
  */
-proto.sqlrpc.v1.TypedQueryResult.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.QueryComplete.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.TypedQueryResult;
-  return proto.sqlrpc.v1.TypedQueryResult.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.QueryComplete;
+  return proto.sqlrpc.v1.QueryComplete.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.TypedQueryResult} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.QueryComplete} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.TypedQueryResult}
+ * @return {!proto.sqlrpc.v1.QueryComplete}
  */
-proto.sqlrpc.v1.TypedQueryResult.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.QueryComplete.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4420,25 +10510,6 @@ proto.sqlrpc.v1.TypedQueryResult.deserializeBinaryFromReader = function(msg, rea
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.addColumns(value);
-      break;
-    case 2:
-      reader.readPackableEnumInto(msg.getColumnAffinitiesList());
-      break;
-    case 3:
-      reader.readPackableEnumInto(msg.getColumnDeclaredTypesList());
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.addColumnRawTypes(value);
-      break;
-    case 5:
-      var value = new sqlrpc_v1_types_pb.SqlRow;
-      reader.readMessage(value,sqlrpc_v1_types_pb.SqlRow.deserializeBinaryFromReader);
-      msg.addRows(value);
-      break;
-    case 6:
       var value = new sqlrpc_v1_types_pb.ExecutionStats;
       reader.readMessage(value,sqlrpc_v1_types_pb.ExecutionStats.deserializeBinaryFromReader);
       msg.setStats(value);
@@ -4456,9 +10527,9 @@ proto.sqlrpc.v1.TypedQueryResult.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.TypedQueryResult.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.QueryComplete.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.TypedQueryResult.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.QueryComplete.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4466,52 +10537,16 @@ proto.sqlrpc.v1.TypedQueryResult.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.TypedQueryResult} message
+ * @param {!proto.sqlrpc.v1.QueryComplete} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TypedQueryResult.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.QueryComplete.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getColumnsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      1,
-      f
-    );
-  }
-  f = message.getColumnAffinitiesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
-      2,
-      f
-    );
-  }
-  f = message.getColumnDeclaredTypesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
-      3,
-      f
-    );
-  }
-  f = message.getColumnRawTypesList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      4,
-      f
-    );
-  }
-  f = message.getRowsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      5,
-      f,
-      sqlrpc_v1_types_pb.SqlRow.serializeBinaryToWriter
-    );
-  }
   f = message.getStats();
   if (f != null) {
     writer.writeMessage(
-      6,
+      1,
       f,
       sqlrpc_v1_types_pb.ExecutionStats.serializeBinaryToWriter
     );
@@ -4520,216 +10555,268 @@ proto.sqlrpc.v1.TypedQueryResult.serializeBinaryToWriter = function(message, wri
 
 
 /**
- * repeated string columns = 1;
- * @return {!Array<string>}
- */
-proto.sqlrpc.v1.TypedQueryResult.prototype.getColumnsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
-};
-
-
-/**
- * @param {!Array<string>} value
- * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
+ * optional ExecutionStats stats = 1;
+ * @return {?proto.sqlrpc.v1.ExecutionStats}
  */
-proto.sqlrpc.v1.TypedQueryResult.prototype.setColumnsList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.sqlrpc.v1.QueryComplete.prototype.getStats = function() {
+  return /** @type{?proto.sqlrpc.v1.ExecutionStats} */ (
+    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.ExecutionStats, 1));
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
- */
-proto.sqlrpc.v1.TypedQueryResult.prototype.addColumns = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+ * @param {?proto.sqlrpc.v1.ExecutionStats|undefined} value
+ * @return {!proto.sqlrpc.v1.QueryComplete} returns this
+*/
+proto.sqlrpc.v1.QueryComplete.prototype.setStats = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.QueryComplete} returns this
  */
-proto.sqlrpc.v1.TypedQueryResult.prototype.clearColumnsList = function() {
-  return this.setColumnsList([]);
+proto.sqlrpc.v1.QueryComplete.prototype.clearStats = function() {
+  return this.setStats(undefined);
 };
 
 
 /**
- * repeated ColumnAffinity column_affinities = 2;
- * @return {!Array<!proto.sqlrpc.v1.ColumnAffinity>}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.TypedQueryResult.prototype.getColumnAffinitiesList = function() {
-  return /** @type {!Array<!proto.sqlrpc.v1.ColumnAffinity>} */ (jspb.Message.getRepeatedField(this, 2));
+proto.sqlrpc.v1.QueryComplete.prototype.hasStats = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
-/**
- * @param {!Array<!proto.sqlrpc.v1.ColumnAffinity>} value
- * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
- */
-proto.sqlrpc.v1.TypedQueryResult.prototype.setColumnAffinitiesList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
-};
-
 
-/**
- * @param {!proto.sqlrpc.v1.ColumnAffinity} value
- * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
- */
-proto.sqlrpc.v1.TypedQueryResult.prototype.addColumnAffinities = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.sqlrpc.v1.TypedQueryResult.prototype.clearColumnAffinitiesList = function() {
-  return this.setColumnAffinitiesList([]);
+proto.sqlrpc.v1.ErrorResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ErrorResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * repeated DeclaredType column_declared_types = 3;
- * @return {!Array<!proto.sqlrpc.v1.DeclaredType>}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.ErrorResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TypedQueryResult.prototype.getColumnDeclaredTypesList = function() {
-  return /** @type {!Array<!proto.sqlrpc.v1.DeclaredType>} */ (jspb.Message.getRepeatedField(this, 3));
+proto.sqlrpc.v1.ErrorResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+message: jspb.Message.getFieldWithDefault(msg, 1, ""),
+failedSql: jspb.Message.getFieldWithDefault(msg, 2, ""),
+sqliteErrorCode: jspb.Message.getFieldWithDefault(msg, 3, 0),
+batchIndex: (f = jspb.Message.getField(msg, 4)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {!Array<!proto.sqlrpc.v1.DeclaredType>} value
- * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.ErrorResponse}
  */
-proto.sqlrpc.v1.TypedQueryResult.prototype.setColumnDeclaredTypesList = function(value) {
-  return jspb.Message.setField(this, 3, value || []);
+proto.sqlrpc.v1.ErrorResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.ErrorResponse;
+  return proto.sqlrpc.v1.ErrorResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {!proto.sqlrpc.v1.DeclaredType} value
- * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.ErrorResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.ErrorResponse}
  */
-proto.sqlrpc.v1.TypedQueryResult.prototype.addColumnDeclaredTypes = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+proto.sqlrpc.v1.ErrorResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setMessage(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setFailedSql(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.sqlrpc.v1.SqliteCode} */ (reader.readEnum());
+      msg.setSqliteErrorCode(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setBatchIndex(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.TypedQueryResult.prototype.clearColumnDeclaredTypesList = function() {
-  return this.setColumnDeclaredTypesList([]);
+proto.sqlrpc.v1.ErrorResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ErrorResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * repeated string column_raw_types = 4;
- * @return {!Array<string>}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ErrorResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TypedQueryResult.prototype.getColumnRawTypesList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+proto.sqlrpc.v1.ErrorResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getFailedSql();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getSqliteErrorCode();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
+ * optional string message = 1;
+ * @return {string}
  */
-proto.sqlrpc.v1.TypedQueryResult.prototype.setColumnRawTypesList = function(value) {
-  return jspb.Message.setField(this, 4, value || []);
+proto.sqlrpc.v1.ErrorResponse.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
+ * @return {!proto.sqlrpc.v1.ErrorResponse} returns this
  */
-proto.sqlrpc.v1.TypedQueryResult.prototype.addColumnRawTypes = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+proto.sqlrpc.v1.ErrorResponse.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
+ * optional string failed_sql = 2;
+ * @return {string}
  */
-proto.sqlrpc.v1.TypedQueryResult.prototype.clearColumnRawTypesList = function() {
-  return this.setColumnRawTypesList([]);
+proto.sqlrpc.v1.ErrorResponse.prototype.getFailedSql = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * repeated SqlRow rows = 5;
- * @return {!Array<!proto.sqlrpc.v1.SqlRow>}
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ErrorResponse} returns this
  */
-proto.sqlrpc.v1.TypedQueryResult.prototype.getRowsList = function() {
-  return /** @type{!Array<!proto.sqlrpc.v1.SqlRow>} */ (
-    jspb.Message.getRepeatedWrapperField(this, sqlrpc_v1_types_pb.SqlRow, 5));
-};
-
-
-/**
- * @param {!Array<!proto.sqlrpc.v1.SqlRow>} value
- * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
-*/
-proto.sqlrpc.v1.TypedQueryResult.prototype.setRowsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 5, value);
+proto.sqlrpc.v1.ErrorResponse.prototype.setFailedSql = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * @param {!proto.sqlrpc.v1.SqlRow=} opt_value
- * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.SqlRow}
+ * optional SqliteCode sqlite_error_code = 3;
+ * @return {!proto.sqlrpc.v1.SqliteCode}
  */
-proto.sqlrpc.v1.TypedQueryResult.prototype.addRows = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.sqlrpc.v1.SqlRow, opt_index);
+proto.sqlrpc.v1.ErrorResponse.prototype.getSqliteErrorCode = function() {
+  return /** @type {!proto.sqlrpc.v1.SqliteCode} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
+ * @param {!proto.sqlrpc.v1.SqliteCode} value
+ * @return {!proto.sqlrpc.v1.ErrorResponse} returns this
  */
-proto.sqlrpc.v1.TypedQueryResult.prototype.clearRowsList = function() {
-  return this.setRowsList([]);
+proto.sqlrpc.v1.ErrorResponse.prototype.setSqliteErrorCode = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
 };
 
 
 /**
- * optional ExecutionStats stats = 6;
- * @return {?proto.sqlrpc.v1.ExecutionStats}
+ * optional int32 batch_index = 4;
+ * @return {number}
  */
-proto.sqlrpc.v1.TypedQueryResult.prototype.getStats = function() {
-  return /** @type{?proto.sqlrpc.v1.ExecutionStats} */ (
-    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.ExecutionStats, 6));
+proto.sqlrpc.v1.ErrorResponse.prototype.getBatchIndex = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.ExecutionStats|undefined} value
- * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
-*/
-proto.sqlrpc.v1.TypedQueryResult.prototype.setStats = function(value) {
-  return jspb.Message.setWrapperField(this, 6, value);
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.ErrorResponse} returns this
+ */
+proto.sqlrpc.v1.ErrorResponse.prototype.setBatchIndex = function(value) {
+  return jspb.Message.setField(this, 4, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TypedQueryResult} returns this
+ * Clears the field making it undefined.
+ * @return {!proto.sqlrpc.v1.ErrorResponse} returns this
  */
-proto.sqlrpc.v1.TypedQueryResult.prototype.clearStats = function() {
-  return this.setStats(undefined);
+proto.sqlrpc.v1.ErrorResponse.prototype.clearBatchIndex = function() {
+  return jspb.Message.setField(this, 4, undefined);
 };
 
 
@@ -4737,8 +10824,8 @@ proto.sqlrpc.v1.TypedQueryResult.prototype.clearStats = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.sqlrpc.v1.TypedQueryResult.prototype.hasStats = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.sqlrpc.v1.ErrorResponse.prototype.hasBatchIndex = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
@@ -4751,24 +10838,30 @@ proto.sqlrpc.v1.TypedQueryResult.prototype.hasStats = function() {
  * @private {!Array<!Array<number>>}
  * @const
  */
-proto.sqlrpc.v1.QueryResponse.oneofGroups_ = [[1,2,3,5]];
+proto.sqlrpc.v1.TransactionRequest.oneofGroups_ = [[1,2,3,4,5,6,7,8,9,10]];
 
 /**
  * @enum {number}
  */
-proto.sqlrpc.v1.QueryResponse.ResponseCase = {
-  RESPONSE_NOT_SET: 0,
-  HEADER: 1,
-  BATCH: 2,
-  COMPLETE: 3,
-  ERROR: 5
+proto.sqlrpc.v1.TransactionRequest.CommandCase = {
+  COMMAND_NOT_SET: 0,
+  BEGIN: 1,
+  QUERY: 2,
+  QUERY_STREAM: 3,
+  TYPED_QUERY: 4,
+  TYPED_QUERY_STREAM: 5,
+  EXEC: 6,
+  TYPED_EXEC: 7,
+  SAVEPOINT: 8,
+  COMMIT: 9,
+  ROLLBACK: 10
 };
 
 /**
- * @return {proto.sqlrpc.v1.QueryResponse.ResponseCase}
+ * @return {proto.sqlrpc.v1.TransactionRequest.CommandCase}
  */
-proto.sqlrpc.v1.QueryResponse.prototype.getResponseCase = function() {
-  return /** @type {proto.sqlrpc.v1.QueryResponse.ResponseCase} */(jspb.Message.computeOneofCase(this, proto.sqlrpc.v1.QueryResponse.oneofGroups_[0]));
+proto.sqlrpc.v1.TransactionRequest.prototype.getCommandCase = function() {
+  return /** @type {proto.sqlrpc.v1.TransactionRequest.CommandCase} */(jspb.Message.computeOneofCase(this, proto.sqlrpc.v1.TransactionRequest.oneofGroups_[0]));
 };
 
 
@@ -4786,8 +10879,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.QueryResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.QueryResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.TransactionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.TransactionRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4796,16 +10889,22 @@ proto.sqlrpc.v1.QueryResponse.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.QueryResponse} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.TransactionRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.QueryResponse.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.TransactionRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-header: (f = msg.getHeader()) && proto.sqlrpc.v1.QueryResultHeader.toObject(includeInstance, f),
-batch: (f = msg.getBatch()) && proto.sqlrpc.v1.QueryResultRowBatch.toObject(includeInstance, f),
-complete: (f = msg.getComplete()) && proto.sqlrpc.v1.QueryComplete.toObject(includeInstance, f),
-error: (f = msg.getError()) && proto.sqlrpc.v1.ErrorResponse.toObject(includeInstance, f)
+begin: (f = msg.getBegin()) && proto.sqlrpc.v1.BeginRequest.toObject(includeInstance, f),
+query: (f = msg.getQuery()) && proto.sqlrpc.v1.TransactionalQueryRequest.toObject(includeInstance, f),
+queryStream: (f = msg.getQueryStream()) && proto.sqlrpc.v1.TransactionalQueryRequest.toObject(includeInstance, f),
+typedQuery: (f = msg.getTypedQuery()) && proto.sqlrpc.v1.TypedTransactionalQueryRequest.toObject(includeInstance, f),
+typedQueryStream: (f = msg.getTypedQueryStream()) && proto.sqlrpc.v1.TypedTransactionalQueryRequest.toObject(includeInstance, f),
+exec: (f = msg.getExec()) && proto.sqlrpc.v1.TransactionalQueryRequest.toObject(includeInstance, f),
+typedExec: (f = msg.getTypedExec()) && proto.sqlrpc.v1.TypedTransactionalQueryRequest.toObject(includeInstance, f),
+savepoint: (f = msg.getSavepoint()) && proto.sqlrpc.v1.SavepointRequest.toObject(includeInstance, f),
+commit: (f = msg.getCommit()) && google_protobuf_empty_pb.Empty.toObject(includeInstance, f),
+rollback: (f = msg.getRollback()) && google_protobuf_empty_pb.Empty.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4819,23 +10918,23 @@ error: (f = msg.getError()) && proto.sqlrpc.v1.ErrorResponse.toObject(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.QueryResponse}
+ * @return {!proto.sqlrpc.v1.TransactionRequest}
  */
-proto.sqlrpc.v1.QueryResponse.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.TransactionRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.QueryResponse;
-  return proto.sqlrpc.v1.QueryResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.TransactionRequest;
+  return proto.sqlrpc.v1.TransactionRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.QueryResponse} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.TransactionRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.QueryResponse}
+ * @return {!proto.sqlrpc.v1.TransactionRequest}
  */
-proto.sqlrpc.v1.QueryResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.TransactionRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4843,24 +10942,54 @@ proto.sqlrpc.v1.QueryResponse.deserializeBinaryFromReader = function(msg, reader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.sqlrpc.v1.QueryResultHeader;
-      reader.readMessage(value,proto.sqlrpc.v1.QueryResultHeader.deserializeBinaryFromReader);
-      msg.setHeader(value);
+      var value = new proto.sqlrpc.v1.BeginRequest;
+      reader.readMessage(value,proto.sqlrpc.v1.BeginRequest.deserializeBinaryFromReader);
+      msg.setBegin(value);
       break;
     case 2:
-      var value = new proto.sqlrpc.v1.QueryResultRowBatch;
-      reader.readMessage(value,proto.sqlrpc.v1.QueryResultRowBatch.deserializeBinaryFromReader);
-      msg.setBatch(value);
+      var value = new proto.sqlrpc.v1.TransactionalQueryRequest;
+      reader.readMessage(value,proto.sqlrpc.v1.TransactionalQueryRequest.deserializeBinaryFromReader);
+      msg.setQuery(value);
       break;
     case 3:
-      var value = new proto.sqlrpc.v1.QueryComplete;
-      reader.readMessage(value,proto.sqlrpc.v1.QueryComplete.deserializeBinaryFromReader);
-      msg.setComplete(value);
+      var value = new proto.sqlrpc.v1.TransactionalQueryRequest;
+      reader.readMessage(value,proto.sqlrpc.v1.TransactionalQueryRequest.deserializeBinaryFromReader);
+      msg.setQueryStream(value);
+      break;
+    case 4:
+      var value = new proto.sqlrpc.v1.TypedTransactionalQueryRequest;
+      reader.readMessage(value,proto.sqlrpc.v1.TypedTransactionalQueryRequest.deserializeBinaryFromReader);
+      msg.setTypedQuery(value);
       break;
     case 5:
-      var value = new proto.sqlrpc.v1.ErrorResponse;
-      reader.readMessage(value,proto.sqlrpc.v1.ErrorResponse.deserializeBinaryFromReader);
-      msg.setError(value);
+      var value = new proto.sqlrpc.v1.TypedTransactionalQueryRequest;
+      reader.readMessage(value,proto.sqlrpc.v1.TypedTransactionalQueryRequest.deserializeBinaryFromReader);
+      msg.setTypedQueryStream(value);
+      break;
+    case 6:
+      var value = new proto.sqlrpc.v1.TransactionalQueryRequest;
+      reader.readMessage(value,proto.sqlrpc.v1.TransactionalQueryRequest.deserializeBinaryFromReader);
+      msg.setExec(value);
+      break;
+    case 7:
+      var value = new proto.sqlrpc.v1.TypedTransactionalQueryRequest;
+      reader.readMessage(value,proto.sqlrpc.v1.TypedTransactionalQueryRequest.deserializeBinaryFromReader);
+      msg.setTypedExec(value);
+      break;
+    case 8:
+      var value = new proto.sqlrpc.v1.SavepointRequest;
+      reader.readMessage(value,proto.sqlrpc.v1.SavepointRequest.deserializeBinaryFromReader);
+      msg.setSavepoint(value);
+      break;
+    case 9:
+      var value = new google_protobuf_empty_pb.Empty;
+      reader.readMessage(value,google_protobuf_empty_pb.Empty.deserializeBinaryFromReader);
+      msg.setCommit(value);
+      break;
+    case 10:
+      var value = new google_protobuf_empty_pb.Empty;
+      reader.readMessage(value,google_protobuf_empty_pb.Empty.deserializeBinaryFromReader);
+      msg.setRollback(value);
       break;
     default:
       reader.skipField();
@@ -4875,9 +11004,9 @@ proto.sqlrpc.v1.QueryResponse.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.QueryResponse.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.TransactionRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.QueryResponse.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.TransactionRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4885,72 +11014,120 @@ proto.sqlrpc.v1.QueryResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.QueryResponse} message
+ * @param {!proto.sqlrpc.v1.TransactionRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.QueryResponse.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.TransactionRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getHeader();
+  f = message.getBegin();
   if (f != null) {
     writer.writeMessage(
       1,
       f,
-      proto.sqlrpc.v1.QueryResultHeader.serializeBinaryToWriter
+      proto.sqlrpc.v1.BeginRequest.serializeBinaryToWriter
     );
   }
-  f = message.getBatch();
+  f = message.getQuery();
   if (f != null) {
     writer.writeMessage(
       2,
       f,
-      proto.sqlrpc.v1.QueryResultRowBatch.serializeBinaryToWriter
+      proto.sqlrpc.v1.TransactionalQueryRequest.serializeBinaryToWriter
     );
   }
-  f = message.getComplete();
+  f = message.getQueryStream();
   if (f != null) {
     writer.writeMessage(
       3,
       f,
-      proto.sqlrpc.v1.QueryComplete.serializeBinaryToWriter
+      proto.sqlrpc.v1.TransactionalQueryRequest.serializeBinaryToWriter
     );
   }
-  f = message.getError();
+  f = message.getTypedQuery();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.sqlrpc.v1.TypedTransactionalQueryRequest.serializeBinaryToWriter
+    );
+  }
+  f = message.getTypedQueryStream();
   if (f != null) {
     writer.writeMessage(
       5,
       f,
-      proto.sqlrpc.v1.ErrorResponse.serializeBinaryToWriter
+      proto.sqlrpc.v1.TypedTransactionalQueryRequest.serializeBinaryToWriter
+    );
+  }
+  f = message.getExec();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.sqlrpc.v1.TransactionalQueryRequest.serializeBinaryToWriter
+    );
+  }
+  f = message.getTypedExec();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.sqlrpc.v1.TypedTransactionalQueryRequest.serializeBinaryToWriter
+    );
+  }
+  f = message.getSavepoint();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      proto.sqlrpc.v1.SavepointRequest.serializeBinaryToWriter
+    );
+  }
+  f = message.getCommit();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      google_protobuf_empty_pb.Empty.serializeBinaryToWriter
+    );
+  }
+  f = message.getRollback();
+  if (f != null) {
+    writer.writeMessage(
+      10,
+      f,
+      google_protobuf_empty_pb.Empty.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional QueryResultHeader header = 1;
- * @return {?proto.sqlrpc.v1.QueryResultHeader}
+ * optional BeginRequest begin = 1;
+ * @return {?proto.sqlrpc.v1.BeginRequest}
  */
-proto.sqlrpc.v1.QueryResponse.prototype.getHeader = function() {
-  return /** @type{?proto.sqlrpc.v1.QueryResultHeader} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.QueryResultHeader, 1));
+proto.sqlrpc.v1.TransactionRequest.prototype.getBegin = function() {
+  return /** @type{?proto.sqlrpc.v1.BeginRequest} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.BeginRequest, 1));
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.QueryResultHeader|undefined} value
- * @return {!proto.sqlrpc.v1.QueryResponse} returns this
+ * @param {?proto.sqlrpc.v1.BeginRequest|undefined} value
+ * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
 */
-proto.sqlrpc.v1.QueryResponse.prototype.setHeader = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 1, proto.sqlrpc.v1.QueryResponse.oneofGroups_[0], value);
+proto.sqlrpc.v1.TransactionRequest.prototype.setBegin = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 1, proto.sqlrpc.v1.TransactionRequest.oneofGroups_[0], value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.QueryResponse} returns this
+ * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
  */
-proto.sqlrpc.v1.QueryResponse.prototype.clearHeader = function() {
-  return this.setHeader(undefined);
+proto.sqlrpc.v1.TransactionRequest.prototype.clearBegin = function() {
+  return this.setBegin(undefined);
 };
 
 
@@ -4958,36 +11135,36 @@ proto.sqlrpc.v1.QueryResponse.prototype.clearHeader = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.sqlrpc.v1.QueryResponse.prototype.hasHeader = function() {
+proto.sqlrpc.v1.TransactionRequest.prototype.hasBegin = function() {
   return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * optional QueryResultRowBatch batch = 2;
- * @return {?proto.sqlrpc.v1.QueryResultRowBatch}
+ * optional TransactionalQueryRequest query = 2;
+ * @return {?proto.sqlrpc.v1.TransactionalQueryRequest}
  */
-proto.sqlrpc.v1.QueryResponse.prototype.getBatch = function() {
-  return /** @type{?proto.sqlrpc.v1.QueryResultRowBatch} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.QueryResultRowBatch, 2));
+proto.sqlrpc.v1.TransactionRequest.prototype.getQuery = function() {
+  return /** @type{?proto.sqlrpc.v1.TransactionalQueryRequest} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.TransactionalQueryRequest, 2));
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.QueryResultRowBatch|undefined} value
- * @return {!proto.sqlrpc.v1.QueryResponse} returns this
+ * @param {?proto.sqlrpc.v1.TransactionalQueryRequest|undefined} value
+ * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
 */
-proto.sqlrpc.v1.QueryResponse.prototype.setBatch = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 2, proto.sqlrpc.v1.QueryResponse.oneofGroups_[0], value);
+proto.sqlrpc.v1.TransactionRequest.prototype.setQuery = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 2, proto.sqlrpc.v1.TransactionRequest.oneofGroups_[0], value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.QueryResponse} returns this
+ * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
  */
-proto.sqlrpc.v1.QueryResponse.prototype.clearBatch = function() {
-  return this.setBatch(undefined);
+proto.sqlrpc.v1.TransactionRequest.prototype.clearQuery = function() {
+  return this.setQuery(undefined);
 };
 
 
@@ -4995,36 +11172,36 @@ proto.sqlrpc.v1.QueryResponse.prototype.clearBatch = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.sqlrpc.v1.QueryResponse.prototype.hasBatch = function() {
+proto.sqlrpc.v1.TransactionRequest.prototype.hasQuery = function() {
   return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * optional QueryComplete complete = 3;
- * @return {?proto.sqlrpc.v1.QueryComplete}
+ * optional TransactionalQueryRequest query_stream = 3;
+ * @return {?proto.sqlrpc.v1.TransactionalQueryRequest}
  */
-proto.sqlrpc.v1.QueryResponse.prototype.getComplete = function() {
-  return /** @type{?proto.sqlrpc.v1.QueryComplete} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.QueryComplete, 3));
+proto.sqlrpc.v1.TransactionRequest.prototype.getQueryStream = function() {
+  return /** @type{?proto.sqlrpc.v1.TransactionalQueryRequest} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.TransactionalQueryRequest, 3));
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.QueryComplete|undefined} value
- * @return {!proto.sqlrpc.v1.QueryResponse} returns this
+ * @param {?proto.sqlrpc.v1.TransactionalQueryRequest|undefined} value
+ * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
 */
-proto.sqlrpc.v1.QueryResponse.prototype.setComplete = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 3, proto.sqlrpc.v1.QueryResponse.oneofGroups_[0], value);
+proto.sqlrpc.v1.TransactionRequest.prototype.setQueryStream = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 3, proto.sqlrpc.v1.TransactionRequest.oneofGroups_[0], value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.QueryResponse} returns this
+ * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
  */
-proto.sqlrpc.v1.QueryResponse.prototype.clearComplete = function() {
-  return this.setComplete(undefined);
+proto.sqlrpc.v1.TransactionRequest.prototype.clearQueryStream = function() {
+  return this.setQueryStream(undefined);
 };
 
 
@@ -5032,257 +11209,147 @@ proto.sqlrpc.v1.QueryResponse.prototype.clearComplete = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.sqlrpc.v1.QueryResponse.prototype.hasComplete = function() {
+proto.sqlrpc.v1.TransactionRequest.prototype.hasQueryStream = function() {
   return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * optional ErrorResponse error = 5;
- * @return {?proto.sqlrpc.v1.ErrorResponse}
+ * optional TypedTransactionalQueryRequest typed_query = 4;
+ * @return {?proto.sqlrpc.v1.TypedTransactionalQueryRequest}
  */
-proto.sqlrpc.v1.QueryResponse.prototype.getError = function() {
-  return /** @type{?proto.sqlrpc.v1.ErrorResponse} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.ErrorResponse, 5));
+proto.sqlrpc.v1.TransactionRequest.prototype.getTypedQuery = function() {
+  return /** @type{?proto.sqlrpc.v1.TypedTransactionalQueryRequest} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.TypedTransactionalQueryRequest, 4));
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.ErrorResponse|undefined} value
- * @return {!proto.sqlrpc.v1.QueryResponse} returns this
+ * @param {?proto.sqlrpc.v1.TypedTransactionalQueryRequest|undefined} value
+ * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
 */
-proto.sqlrpc.v1.QueryResponse.prototype.setError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 5, proto.sqlrpc.v1.QueryResponse.oneofGroups_[0], value);
+proto.sqlrpc.v1.TransactionRequest.prototype.setTypedQuery = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 4, proto.sqlrpc.v1.TransactionRequest.oneofGroups_[0], value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.QueryResponse} returns this
+ * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
  */
-proto.sqlrpc.v1.QueryResponse.prototype.clearError = function() {
-  return this.setError(undefined);
+proto.sqlrpc.v1.TransactionRequest.prototype.clearTypedQuery = function() {
+  return this.setTypedQuery(undefined);
 };
 
 
 /**
  * Returns whether this field is set.
- * @return {boolean}
- */
-proto.sqlrpc.v1.QueryResponse.prototype.hasError = function() {
-  return jspb.Message.getField(this, 5) != null;
-};
-
-
-
-/**
- * Oneof group definitions for this message. Each group defines the field
- * numbers belonging to that group. When of these fields' value is set, all
- * other fields in the group are cleared. During deserialization, if multiple
- * fields are encountered for a group, only the last value seen will be kept.
- * @private {!Array<!Array<number>>}
- * @const
- */
-proto.sqlrpc.v1.TypedQueryResponse.oneofGroups_ = [[1,2,3,5]];
-
-/**
- * @enum {number}
+ * @return {boolean}
  */
-proto.sqlrpc.v1.TypedQueryResponse.ResponseCase = {
-  RESPONSE_NOT_SET: 0,
-  HEADER: 1,
-  BATCH: 2,
-  COMPLETE: 3,
-  ERROR: 5
+proto.sqlrpc.v1.TransactionRequest.prototype.hasTypedQuery = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
+
 /**
- * @return {proto.sqlrpc.v1.TypedQueryResponse.ResponseCase}
+ * optional TypedTransactionalQueryRequest typed_query_stream = 5;
+ * @return {?proto.sqlrpc.v1.TypedTransactionalQueryRequest}
  */
-proto.sqlrpc.v1.TypedQueryResponse.prototype.getResponseCase = function() {
-  return /** @type {proto.sqlrpc.v1.TypedQueryResponse.ResponseCase} */(jspb.Message.computeOneofCase(this, proto.sqlrpc.v1.TypedQueryResponse.oneofGroups_[0]));
+proto.sqlrpc.v1.TransactionRequest.prototype.getTypedQueryStream = function() {
+  return /** @type{?proto.sqlrpc.v1.TypedTransactionalQueryRequest} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.TypedTransactionalQueryRequest, 5));
 };
 
 
+/**
+ * @param {?proto.sqlrpc.v1.TypedTransactionalQueryRequest|undefined} value
+ * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
+*/
+proto.sqlrpc.v1.TransactionRequest.prototype.setTypedQueryStream = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 5, proto.sqlrpc.v1.TransactionRequest.oneofGroups_[0], value);
+};
+
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
  */
-proto.sqlrpc.v1.TypedQueryResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.TypedQueryResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.TransactionRequest.prototype.clearTypedQueryStream = function() {
+  return this.setTypedQueryStream(undefined);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.TypedQueryResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.TypedQueryResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-header: (f = msg.getHeader()) && proto.sqlrpc.v1.TypedQueryResultHeader.toObject(includeInstance, f),
-batch: (f = msg.getBatch()) && proto.sqlrpc.v1.TypedQueryResultRowBatch.toObject(includeInstance, f),
-complete: (f = msg.getComplete()) && proto.sqlrpc.v1.QueryComplete.toObject(includeInstance, f),
-error: (f = msg.getError()) && proto.sqlrpc.v1.ErrorResponse.toObject(includeInstance, f)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.sqlrpc.v1.TransactionRequest.prototype.hasTypedQueryStream = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.TypedQueryResponse}
+ * optional TransactionalQueryRequest exec = 6;
+ * @return {?proto.sqlrpc.v1.TransactionalQueryRequest}
  */
-proto.sqlrpc.v1.TypedQueryResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.TypedQueryResponse;
-  return proto.sqlrpc.v1.TypedQueryResponse.deserializeBinaryFromReader(msg, reader);
+proto.sqlrpc.v1.TransactionRequest.prototype.getExec = function() {
+  return /** @type{?proto.sqlrpc.v1.TransactionalQueryRequest} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.TransactionalQueryRequest, 6));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.TypedQueryResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.TypedQueryResponse}
- */
-proto.sqlrpc.v1.TypedQueryResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = new proto.sqlrpc.v1.TypedQueryResultHeader;
-      reader.readMessage(value,proto.sqlrpc.v1.TypedQueryResultHeader.deserializeBinaryFromReader);
-      msg.setHeader(value);
-      break;
-    case 2:
-      var value = new proto.sqlrpc.v1.TypedQueryResultRowBatch;
-      reader.readMessage(value,proto.sqlrpc.v1.TypedQueryResultRowBatch.deserializeBinaryFromReader);
-      msg.setBatch(value);
-      break;
-    case 3:
-      var value = new proto.sqlrpc.v1.QueryComplete;
-      reader.readMessage(value,proto.sqlrpc.v1.QueryComplete.deserializeBinaryFromReader);
-      msg.setComplete(value);
-      break;
-    case 5:
-      var value = new proto.sqlrpc.v1.ErrorResponse;
-      reader.readMessage(value,proto.sqlrpc.v1.ErrorResponse.deserializeBinaryFromReader);
-      msg.setError(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+ * @param {?proto.sqlrpc.v1.TransactionalQueryRequest|undefined} value
+ * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
+*/
+proto.sqlrpc.v1.TransactionRequest.prototype.setExec = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 6, proto.sqlrpc.v1.TransactionRequest.oneofGroups_[0], value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
  */
-proto.sqlrpc.v1.TypedQueryResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.TypedQueryResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.sqlrpc.v1.TransactionRequest.prototype.clearExec = function() {
+  return this.setExec(undefined);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.TypedQueryResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.TypedQueryResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getHeader();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      proto.sqlrpc.v1.TypedQueryResultHeader.serializeBinaryToWriter
-    );
-  }
-  f = message.getBatch();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      proto.sqlrpc.v1.TypedQueryResultRowBatch.serializeBinaryToWriter
-    );
-  }
-  f = message.getComplete();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      proto.sqlrpc.v1.QueryComplete.serializeBinaryToWriter
-    );
-  }
-  f = message.getError();
-  if (f != null) {
-    writer.writeMessage(
-      5,
-      f,
-      proto.sqlrpc.v1.ErrorResponse.serializeBinaryToWriter
-    );
-  }
+proto.sqlrpc.v1.TransactionRequest.prototype.hasExec = function() {
+  return jspb.Message.getField(this, 6) != null;
 };
 
 
 /**
- * optional TypedQueryResultHeader header = 1;
- * @return {?proto.sqlrpc.v1.TypedQueryResultHeader}
+ * optional TypedTransactionalQueryRequest typed_exec = 7;
+ * @return {?proto.sqlrpc.v1.TypedTransactionalQueryRequest}
  */
-proto.sqlrpc.v1.TypedQueryResponse.prototype.getHeader = function() {
-  return /** @type{?proto.sqlrpc.v1.TypedQueryResultHeader} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.TypedQueryResultHeader, 1));
+proto.sqlrpc.v1.TransactionRequest.prototype.getTypedExec = function() {
+  return /** @type{?proto.sqlrpc.v1.TypedTransactionalQueryRequest} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.TypedTransactionalQueryRequest, 7));
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.TypedQueryResultHeader|undefined} value
- * @return {!proto.sqlrpc.v1.TypedQueryResponse} returns this
+ * @param {?proto.sqlrpc.v1.TypedTransactionalQueryRequest|undefined} value
+ * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
 */
-proto.sqlrpc.v1.TypedQueryResponse.prototype.setHeader = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 1, proto.sqlrpc.v1.TypedQueryResponse.oneofGroups_[0], value);
+proto.sqlrpc.v1.TransactionRequest.prototype.setTypedExec = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 7, proto.sqlrpc.v1.TransactionRequest.oneofGroups_[0], value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TypedQueryResponse} returns this
+ * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
  */
-proto.sqlrpc.v1.TypedQueryResponse.prototype.clearHeader = function() {
-  return this.setHeader(undefined);
+proto.sqlrpc.v1.TransactionRequest.prototype.clearTypedExec = function() {
+  return this.setTypedExec(undefined);
 };
 
 
@@ -5290,36 +11357,36 @@ proto.sqlrpc.v1.TypedQueryResponse.prototype.clearHeader = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.sqlrpc.v1.TypedQueryResponse.prototype.hasHeader = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.sqlrpc.v1.TransactionRequest.prototype.hasTypedExec = function() {
+  return jspb.Message.getField(this, 7) != null;
 };
 
 
 /**
- * optional TypedQueryResultRowBatch batch = 2;
- * @return {?proto.sqlrpc.v1.TypedQueryResultRowBatch}
+ * optional SavepointRequest savepoint = 8;
+ * @return {?proto.sqlrpc.v1.SavepointRequest}
  */
-proto.sqlrpc.v1.TypedQueryResponse.prototype.getBatch = function() {
-  return /** @type{?proto.sqlrpc.v1.TypedQueryResultRowBatch} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.TypedQueryResultRowBatch, 2));
+proto.sqlrpc.v1.TransactionRequest.prototype.getSavepoint = function() {
+  return /** @type{?proto.sqlrpc.v1.SavepointRequest} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.SavepointRequest, 8));
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.TypedQueryResultRowBatch|undefined} value
- * @return {!proto.sqlrpc.v1.TypedQueryResponse} returns this
+ * @param {?proto.sqlrpc.v1.SavepointRequest|undefined} value
+ * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
 */
-proto.sqlrpc.v1.TypedQueryResponse.prototype.setBatch = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 2, proto.sqlrpc.v1.TypedQueryResponse.oneofGroups_[0], value);
+proto.sqlrpc.v1.TransactionRequest.prototype.setSavepoint = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 8, proto.sqlrpc.v1.TransactionRequest.oneofGroups_[0], value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TypedQueryResponse} returns this
+ * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
  */
-proto.sqlrpc.v1.TypedQueryResponse.prototype.clearBatch = function() {
-  return this.setBatch(undefined);
+proto.sqlrpc.v1.TransactionRequest.prototype.clearSavepoint = function() {
+  return this.setSavepoint(undefined);
 };
 
 
@@ -5327,36 +11394,36 @@ proto.sqlrpc.v1.TypedQueryResponse.prototype.clearBatch = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.sqlrpc.v1.TypedQueryResponse.prototype.hasBatch = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.sqlrpc.v1.TransactionRequest.prototype.hasSavepoint = function() {
+  return jspb.Message.getField(this, 8) != null;
 };
 
 
 /**
- * optional QueryComplete complete = 3;
- * @return {?proto.sqlrpc.v1.QueryComplete}
+ * optional google.protobuf.Empty commit = 9;
+ * @return {?proto.google.protobuf.Empty}
  */
-proto.sqlrpc.v1.TypedQueryResponse.prototype.getComplete = function() {
-  return /** @type{?proto.sqlrpc.v1.QueryComplete} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.QueryComplete, 3));
+proto.sqlrpc.v1.TransactionRequest.prototype.getCommit = function() {
+  return /** @type{?proto.google.protobuf.Empty} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_empty_pb.Empty, 9));
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.QueryComplete|undefined} value
- * @return {!proto.sqlrpc.v1.TypedQueryResponse} returns this
+ * @param {?proto.google.protobuf.Empty|undefined} value
+ * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
 */
-proto.sqlrpc.v1.TypedQueryResponse.prototype.setComplete = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 3, proto.sqlrpc.v1.TypedQueryResponse.oneofGroups_[0], value);
+proto.sqlrpc.v1.TransactionRequest.prototype.setCommit = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 9, proto.sqlrpc.v1.TransactionRequest.oneofGroups_[0], value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TypedQueryResponse} returns this
+ * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
  */
-proto.sqlrpc.v1.TypedQueryResponse.prototype.clearComplete = function() {
-  return this.setComplete(undefined);
+proto.sqlrpc.v1.TransactionRequest.prototype.clearCommit = function() {
+  return this.setCommit(undefined);
 };
 
 
@@ -5364,36 +11431,36 @@ proto.sqlrpc.v1.TypedQueryResponse.prototype.clearComplete = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.sqlrpc.v1.TypedQueryResponse.prototype.hasComplete = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.sqlrpc.v1.TransactionRequest.prototype.hasCommit = function() {
+  return jspb.Message.getField(this, 9) != null;
 };
 
 
 /**
- * optional ErrorResponse error = 5;
- * @return {?proto.sqlrpc.v1.ErrorResponse}
+ * optional google.protobuf.Empty rollback = 10;
+ * @return {?proto.google.protobuf.Empty}
  */
-proto.sqlrpc.v1.TypedQueryResponse.prototype.getError = function() {
-  return /** @type{?proto.sqlrpc.v1.ErrorResponse} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.ErrorResponse, 5));
+proto.sqlrpc.v1.TransactionRequest.prototype.getRollback = function() {
+  return /** @type{?proto.google.protobuf.Empty} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_empty_pb.Empty, 10));
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.ErrorResponse|undefined} value
- * @return {!proto.sqlrpc.v1.TypedQueryResponse} returns this
+ * @param {?proto.google.protobuf.Empty|undefined} value
+ * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
 */
-proto.sqlrpc.v1.TypedQueryResponse.prototype.setError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 5, proto.sqlrpc.v1.TypedQueryResponse.oneofGroups_[0], value);
+proto.sqlrpc.v1.TransactionRequest.prototype.setRollback = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 10, proto.sqlrpc.v1.TransactionRequest.oneofGroups_[0], value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TypedQueryResponse} returns this
+ * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
  */
-proto.sqlrpc.v1.TypedQueryResponse.prototype.clearError = function() {
-  return this.setError(undefined);
+proto.sqlrpc.v1.TransactionRequest.prototype.clearRollback = function() {
+  return this.setRollback(undefined);
 };
 
 
@@ -5401,18 +11468,45 @@ proto.sqlrpc.v1.TypedQueryResponse.prototype.clearError = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.sqlrpc.v1.TypedQueryResponse.prototype.hasError = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.sqlrpc.v1.TransactionRequest.prototype.hasRollback = function() {
+  return jspb.Message.getField(this, 10) != null;
 };
 
 
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
  * @const
  */
-proto.sqlrpc.v1.QueryResultHeader.repeatedFields_ = [1,2,3,4];
+proto.sqlrpc.v1.TransactionResponse.oneofGroups_ = [[1,2,3,4,5,7,8,9,11,10]];
+
+/**
+ * @enum {number}
+ */
+proto.sqlrpc.v1.TransactionResponse.ResponseCase = {
+  RESPONSE_NOT_SET: 0,
+  BEGIN: 1,
+  QUERY_RESULT: 2,
+  STREAM_RESULT: 3,
+  TYPED_QUERY_RESULT: 4,
+  TYPED_STREAM_RESULT: 5,
+  SAVEPOINT: 7,
+  COMMIT: 8,
+  ROLLBACK: 9,
+  EXEC_RESULT: 11,
+  ERROR: 10
+};
+
+/**
+ * @return {proto.sqlrpc.v1.TransactionResponse.ResponseCase}
+ */
+proto.sqlrpc.v1.TransactionResponse.prototype.getResponseCase = function() {
+  return /** @type {proto.sqlrpc.v1.TransactionResponse.ResponseCase} */(jspb.Message.computeOneofCase(this, proto.sqlrpc.v1.TransactionResponse.oneofGroups_[0]));
+};
 
 
 
@@ -5429,8 +11523,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.QueryResultHeader.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.QueryResultHeader.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.TransactionResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.TransactionResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -5439,16 +11533,22 @@ proto.sqlrpc.v1.QueryResultHeader.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.QueryResultHeader} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.TransactionResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.QueryResultHeader.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.TransactionResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-columnsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
-columnAffinitiesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
-columnDeclaredTypesList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
-columnRawTypesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f
+begin: (f = msg.getBegin()) && proto.sqlrpc.v1.BeginResponse.toObject(includeInstance, f),
+queryResult: (f = msg.getQueryResult()) && proto.sqlrpc.v1.QueryResult.toObject(includeInstance, f),
+streamResult: (f = msg.getStreamResult()) && proto.sqlrpc.v1.QueryResponse.toObject(includeInstance, f),
+typedQueryResult: (f = msg.getTypedQueryResult()) && proto.sqlrpc.v1.TypedQueryResult.toObject(includeInstance, f),
+typedStreamResult: (f = msg.getTypedStreamResult()) && proto.sqlrpc.v1.TypedQueryResponse.toObject(includeInstance, f),
+savepoint: (f = msg.getSavepoint()) && proto.sqlrpc.v1.SavepointResponse.toObject(includeInstance, f),
+commit: (f = msg.getCommit()) && proto.sqlrpc.v1.CommitResponse.toObject(includeInstance, f),
+rollback: (f = msg.getRollback()) && proto.sqlrpc.v1.RollbackResponse.toObject(includeInstance, f),
+execResult: (f = msg.getExecResult()) && proto.sqlrpc.v1.ExecResponse.toObject(includeInstance, f),
+error: (f = msg.getError()) && proto.sqlrpc.v1.ErrorResponse.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -5462,23 +11562,23 @@ columnRawTypesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefi
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.QueryResultHeader}
+ * @return {!proto.sqlrpc.v1.TransactionResponse}
  */
-proto.sqlrpc.v1.QueryResultHeader.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.TransactionResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.QueryResultHeader;
-  return proto.sqlrpc.v1.QueryResultHeader.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.TransactionResponse;
+  return proto.sqlrpc.v1.TransactionResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.QueryResultHeader} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.TransactionResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.QueryResultHeader}
+ * @return {!proto.sqlrpc.v1.TransactionResponse}
  */
-proto.sqlrpc.v1.QueryResultHeader.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.TransactionResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5486,18 +11586,54 @@ proto.sqlrpc.v1.QueryResultHeader.deserializeBinaryFromReader = function(msg, re
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.addColumns(value);
+      var value = new proto.sqlrpc.v1.BeginResponse;
+      reader.readMessage(value,proto.sqlrpc.v1.BeginResponse.deserializeBinaryFromReader);
+      msg.setBegin(value);
       break;
     case 2:
-      reader.readPackableEnumInto(msg.getColumnAffinitiesList());
+      var value = new proto.sqlrpc.v1.QueryResult;
+      reader.readMessage(value,proto.sqlrpc.v1.QueryResult.deserializeBinaryFromReader);
+      msg.setQueryResult(value);
       break;
     case 3:
-      reader.readPackableEnumInto(msg.getColumnDeclaredTypesList());
+      var value = new proto.sqlrpc.v1.QueryResponse;
+      reader.readMessage(value,proto.sqlrpc.v1.QueryResponse.deserializeBinaryFromReader);
+      msg.setStreamResult(value);
       break;
     case 4:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.addColumnRawTypes(value);
+      var value = new proto.sqlrpc.v1.TypedQueryResult;
+      reader.readMessage(value,proto.sqlrpc.v1.TypedQueryResult.deserializeBinaryFromReader);
+      msg.setTypedQueryResult(value);
+      break;
+    case 5:
+      var value = new proto.sqlrpc.v1.TypedQueryResponse;
+      reader.readMessage(value,proto.sqlrpc.v1.TypedQueryResponse.deserializeBinaryFromReader);
+      msg.setTypedStreamResult(value);
+      break;
+    case 7:
+      var value = new proto.sqlrpc.v1.SavepointResponse;
+      reader.readMessage(value,proto.sqlrpc.v1.SavepointResponse.deserializeBinaryFromReader);
+      msg.setSavepoint(value);
+      break;
+    case 8:
+      var value = new proto.sqlrpc.v1.CommitResponse;
+      reader.readMessage(value,proto.sqlrpc.v1.CommitResponse.deserializeBinaryFromReader);
+      msg.setCommit(value);
+      break;
+    case 9:
+      var value = new proto.sqlrpc.v1.RollbackResponse;
+      reader.readMessage(value,proto.sqlrpc.v1.RollbackResponse.deserializeBinaryFromReader);
+      msg.setRollback(value);
+      break;
+    case 11:
+      var value = new proto.sqlrpc.v1.ExecResponse;
+      reader.readMessage(value,proto.sqlrpc.v1.ExecResponse.deserializeBinaryFromReader);
+      msg.setExecResult(value);
+      break;
+    case 10:
+      var value = new proto.sqlrpc.v1.ErrorResponse;
+      reader.readMessage(value,proto.sqlrpc.v1.ErrorResponse.deserializeBinaryFromReader);
+      msg.setError(value);
       break;
     default:
       reader.skipField();
@@ -5512,9 +11648,9 @@ proto.sqlrpc.v1.QueryResultHeader.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.QueryResultHeader.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.TransactionResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.QueryResultHeader.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.TransactionResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5522,660 +11658,626 @@ proto.sqlrpc.v1.QueryResultHeader.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.QueryResultHeader} message
+ * @param {!proto.sqlrpc.v1.TransactionResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.QueryResultHeader.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.TransactionResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getColumnsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
+  f = message.getBegin();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.sqlrpc.v1.BeginResponse.serializeBinaryToWriter
     );
   }
-  f = message.getColumnAffinitiesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
+  f = message.getQueryResult();
+  if (f != null) {
+    writer.writeMessage(
       2,
-      f
+      f,
+      proto.sqlrpc.v1.QueryResult.serializeBinaryToWriter
     );
   }
-  f = message.getColumnDeclaredTypesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
+  f = message.getStreamResult();
+  if (f != null) {
+    writer.writeMessage(
       3,
-      f
+      f,
+      proto.sqlrpc.v1.QueryResponse.serializeBinaryToWriter
     );
   }
-  f = message.getColumnRawTypesList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
+  f = message.getTypedQueryResult();
+  if (f != null) {
+    writer.writeMessage(
       4,
-      f
+      f,
+      proto.sqlrpc.v1.TypedQueryResult.serializeBinaryToWriter
+    );
+  }
+  f = message.getTypedStreamResult();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.sqlrpc.v1.TypedQueryResponse.serializeBinaryToWriter
+    );
+  }
+  f = message.getSavepoint();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.sqlrpc.v1.SavepointResponse.serializeBinaryToWriter
+    );
+  }
+  f = message.getCommit();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      proto.sqlrpc.v1.CommitResponse.serializeBinaryToWriter
+    );
+  }
+  f = message.getRollback();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      proto.sqlrpc.v1.RollbackResponse.serializeBinaryToWriter
+    );
+  }
+  f = message.getExecResult();
+  if (f != null) {
+    writer.writeMessage(
+      11,
+      f,
+      proto.sqlrpc.v1.ExecResponse.serializeBinaryToWriter
+    );
+  }
+  f = message.getError();
+  if (f != null) {
+    writer.writeMessage(
+      10,
+      f,
+      proto.sqlrpc.v1.ErrorResponse.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated string columns = 1;
- * @return {!Array<string>}
+ * optional BeginResponse begin = 1;
+ * @return {?proto.sqlrpc.v1.BeginResponse}
  */
-proto.sqlrpc.v1.QueryResultHeader.prototype.getColumnsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.sqlrpc.v1.TransactionResponse.prototype.getBegin = function() {
+  return /** @type{?proto.sqlrpc.v1.BeginResponse} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.BeginResponse, 1));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.BeginResponse|undefined} value
+ * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
+*/
+proto.sqlrpc.v1.TransactionResponse.prototype.setBegin = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 1, proto.sqlrpc.v1.TransactionResponse.oneofGroups_[0], value);
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.sqlrpc.v1.QueryResultHeader} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
  */
-proto.sqlrpc.v1.QueryResultHeader.prototype.setColumnsList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.sqlrpc.v1.TransactionResponse.prototype.clearBegin = function() {
+  return this.setBegin(undefined);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.QueryResultHeader} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.QueryResultHeader.prototype.addColumns = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.sqlrpc.v1.TransactionResponse.prototype.hasBegin = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.QueryResultHeader} returns this
+ * optional QueryResult query_result = 2;
+ * @return {?proto.sqlrpc.v1.QueryResult}
  */
-proto.sqlrpc.v1.QueryResultHeader.prototype.clearColumnsList = function() {
-  return this.setColumnsList([]);
+proto.sqlrpc.v1.TransactionResponse.prototype.getQueryResult = function() {
+  return /** @type{?proto.sqlrpc.v1.QueryResult} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.QueryResult, 2));
 };
 
 
 /**
- * repeated ColumnAffinity column_affinities = 2;
- * @return {!Array<!proto.sqlrpc.v1.ColumnAffinity>}
- */
-proto.sqlrpc.v1.QueryResultHeader.prototype.getColumnAffinitiesList = function() {
-  return /** @type {!Array<!proto.sqlrpc.v1.ColumnAffinity>} */ (jspb.Message.getRepeatedField(this, 2));
+ * @param {?proto.sqlrpc.v1.QueryResult|undefined} value
+ * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
+*/
+proto.sqlrpc.v1.TransactionResponse.prototype.setQueryResult = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 2, proto.sqlrpc.v1.TransactionResponse.oneofGroups_[0], value);
 };
 
 
 /**
- * @param {!Array<!proto.sqlrpc.v1.ColumnAffinity>} value
- * @return {!proto.sqlrpc.v1.QueryResultHeader} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
  */
-proto.sqlrpc.v1.QueryResultHeader.prototype.setColumnAffinitiesList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
+proto.sqlrpc.v1.TransactionResponse.prototype.clearQueryResult = function() {
+  return this.setQueryResult(undefined);
 };
 
 
 /**
- * @param {!proto.sqlrpc.v1.ColumnAffinity} value
- * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.QueryResultHeader} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.QueryResultHeader.prototype.addColumnAffinities = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+proto.sqlrpc.v1.TransactionResponse.prototype.hasQueryResult = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.QueryResultHeader} returns this
+ * optional QueryResponse stream_result = 3;
+ * @return {?proto.sqlrpc.v1.QueryResponse}
  */
-proto.sqlrpc.v1.QueryResultHeader.prototype.clearColumnAffinitiesList = function() {
-  return this.setColumnAffinitiesList([]);
+proto.sqlrpc.v1.TransactionResponse.prototype.getStreamResult = function() {
+  return /** @type{?proto.sqlrpc.v1.QueryResponse} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.QueryResponse, 3));
 };
 
 
 /**
- * repeated DeclaredType column_declared_types = 3;
- * @return {!Array<!proto.sqlrpc.v1.DeclaredType>}
- */
-proto.sqlrpc.v1.QueryResultHeader.prototype.getColumnDeclaredTypesList = function() {
-  return /** @type {!Array<!proto.sqlrpc.v1.DeclaredType>} */ (jspb.Message.getRepeatedField(this, 3));
+ * @param {?proto.sqlrpc.v1.QueryResponse|undefined} value
+ * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
+*/
+proto.sqlrpc.v1.TransactionResponse.prototype.setStreamResult = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 3, proto.sqlrpc.v1.TransactionResponse.oneofGroups_[0], value);
 };
 
 
 /**
- * @param {!Array<!proto.sqlrpc.v1.DeclaredType>} value
- * @return {!proto.sqlrpc.v1.QueryResultHeader} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
  */
-proto.sqlrpc.v1.QueryResultHeader.prototype.setColumnDeclaredTypesList = function(value) {
-  return jspb.Message.setField(this, 3, value || []);
+proto.sqlrpc.v1.TransactionResponse.prototype.clearStreamResult = function() {
+  return this.setStreamResult(undefined);
 };
 
 
 /**
- * @param {!proto.sqlrpc.v1.DeclaredType} value
- * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.QueryResultHeader} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.QueryResultHeader.prototype.addColumnDeclaredTypes = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+proto.sqlrpc.v1.TransactionResponse.prototype.hasStreamResult = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.QueryResultHeader} returns this
+ * optional TypedQueryResult typed_query_result = 4;
+ * @return {?proto.sqlrpc.v1.TypedQueryResult}
  */
-proto.sqlrpc.v1.QueryResultHeader.prototype.clearColumnDeclaredTypesList = function() {
-  return this.setColumnDeclaredTypesList([]);
+proto.sqlrpc.v1.TransactionResponse.prototype.getTypedQueryResult = function() {
+  return /** @type{?proto.sqlrpc.v1.TypedQueryResult} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.TypedQueryResult, 4));
 };
 
 
 /**
- * repeated string column_raw_types = 4;
- * @return {!Array<string>}
- */
-proto.sqlrpc.v1.QueryResultHeader.prototype.getColumnRawTypesList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+ * @param {?proto.sqlrpc.v1.TypedQueryResult|undefined} value
+ * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
+*/
+proto.sqlrpc.v1.TransactionResponse.prototype.setTypedQueryResult = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 4, proto.sqlrpc.v1.TransactionResponse.oneofGroups_[0], value);
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.sqlrpc.v1.QueryResultHeader} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
  */
-proto.sqlrpc.v1.QueryResultHeader.prototype.setColumnRawTypesList = function(value) {
-  return jspb.Message.setField(this, 4, value || []);
+proto.sqlrpc.v1.TransactionResponse.prototype.clearTypedQueryResult = function() {
+  return this.setTypedQueryResult(undefined);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.QueryResultHeader} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.QueryResultHeader.prototype.addColumnRawTypes = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+proto.sqlrpc.v1.TransactionResponse.prototype.hasTypedQueryResult = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.QueryResultHeader} returns this
+ * optional TypedQueryResponse typed_stream_result = 5;
+ * @return {?proto.sqlrpc.v1.TypedQueryResponse}
  */
-proto.sqlrpc.v1.QueryResultHeader.prototype.clearColumnRawTypesList = function() {
-  return this.setColumnRawTypesList([]);
+proto.sqlrpc.v1.TransactionResponse.prototype.getTypedStreamResult = function() {
+  return /** @type{?proto.sqlrpc.v1.TypedQueryResponse} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.TypedQueryResponse, 5));
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.sqlrpc.v1.QueryResultRowBatch.repeatedFields_ = [1];
-
+ * @param {?proto.sqlrpc.v1.TypedQueryResponse|undefined} value
+ * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
+*/
+proto.sqlrpc.v1.TransactionResponse.prototype.setTypedStreamResult = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 5, proto.sqlrpc.v1.TransactionResponse.oneofGroups_[0], value);
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
  */
-proto.sqlrpc.v1.QueryResultRowBatch.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.QueryResultRowBatch.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.TransactionResponse.prototype.clearTypedStreamResult = function() {
+  return this.setTypedStreamResult(undefined);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.QueryResultRowBatch} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.QueryResultRowBatch.toObject = function(includeInstance, msg) {
-  var f, obj = {
-rowsList: jspb.Message.toObjectList(msg.getRowsList(),
-    google_protobuf_struct_pb.ListValue.toObject, includeInstance)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.sqlrpc.v1.TransactionResponse.prototype.hasTypedStreamResult = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.QueryResultRowBatch}
+ * optional SavepointResponse savepoint = 7;
+ * @return {?proto.sqlrpc.v1.SavepointResponse}
  */
-proto.sqlrpc.v1.QueryResultRowBatch.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.QueryResultRowBatch;
-  return proto.sqlrpc.v1.QueryResultRowBatch.deserializeBinaryFromReader(msg, reader);
+proto.sqlrpc.v1.TransactionResponse.prototype.getSavepoint = function() {
+  return /** @type{?proto.sqlrpc.v1.SavepointResponse} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.SavepointResponse, 7));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.QueryResultRowBatch} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.QueryResultRowBatch}
- */
-proto.sqlrpc.v1.QueryResultRowBatch.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = new google_protobuf_struct_pb.ListValue;
-      reader.readMessage(value,google_protobuf_struct_pb.ListValue.deserializeBinaryFromReader);
-      msg.addRows(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+ * @param {?proto.sqlrpc.v1.SavepointResponse|undefined} value
+ * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
+*/
+proto.sqlrpc.v1.TransactionResponse.prototype.setSavepoint = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 7, proto.sqlrpc.v1.TransactionResponse.oneofGroups_[0], value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
  */
-proto.sqlrpc.v1.QueryResultRowBatch.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.QueryResultRowBatch.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.sqlrpc.v1.TransactionResponse.prototype.clearSavepoint = function() {
+  return this.setSavepoint(undefined);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.QueryResultRowBatch} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.QueryResultRowBatch.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getRowsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      1,
-      f,
-      google_protobuf_struct_pb.ListValue.serializeBinaryToWriter
-    );
-  }
+proto.sqlrpc.v1.TransactionResponse.prototype.hasSavepoint = function() {
+  return jspb.Message.getField(this, 7) != null;
 };
 
 
 /**
- * repeated google.protobuf.ListValue rows = 1;
- * @return {!Array<!proto.google.protobuf.ListValue>}
+ * optional CommitResponse commit = 8;
+ * @return {?proto.sqlrpc.v1.CommitResponse}
  */
-proto.sqlrpc.v1.QueryResultRowBatch.prototype.getRowsList = function() {
-  return /** @type{!Array<!proto.google.protobuf.ListValue>} */ (
-    jspb.Message.getRepeatedWrapperField(this, google_protobuf_struct_pb.ListValue, 1));
+proto.sqlrpc.v1.TransactionResponse.prototype.getCommit = function() {
+  return /** @type{?proto.sqlrpc.v1.CommitResponse} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.CommitResponse, 8));
 };
 
 
 /**
- * @param {!Array<!proto.google.protobuf.ListValue>} value
- * @return {!proto.sqlrpc.v1.QueryResultRowBatch} returns this
+ * @param {?proto.sqlrpc.v1.CommitResponse|undefined} value
+ * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
 */
-proto.sqlrpc.v1.QueryResultRowBatch.prototype.setRowsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+proto.sqlrpc.v1.TransactionResponse.prototype.setCommit = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 8, proto.sqlrpc.v1.TransactionResponse.oneofGroups_[0], value);
 };
 
 
 /**
- * @param {!proto.google.protobuf.ListValue=} opt_value
- * @param {number=} opt_index
- * @return {!proto.google.protobuf.ListValue}
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
  */
-proto.sqlrpc.v1.QueryResultRowBatch.prototype.addRows = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.google.protobuf.ListValue, opt_index);
+proto.sqlrpc.v1.TransactionResponse.prototype.clearCommit = function() {
+  return this.setCommit(undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.QueryResultRowBatch} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.QueryResultRowBatch.prototype.clearRowsList = function() {
-  return this.setRowsList([]);
+proto.sqlrpc.v1.TransactionResponse.prototype.hasCommit = function() {
+  return jspb.Message.getField(this, 8) != null;
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * optional RollbackResponse rollback = 9;
+ * @return {?proto.sqlrpc.v1.RollbackResponse}
  */
-proto.sqlrpc.v1.TypedQueryResultHeader.repeatedFields_ = [1,2,3,4];
-
+proto.sqlrpc.v1.TransactionResponse.prototype.getRollback = function() {
+  return /** @type{?proto.sqlrpc.v1.RollbackResponse} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.RollbackResponse, 9));
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
- */
-proto.sqlrpc.v1.TypedQueryResultHeader.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.TypedQueryResultHeader.toObject(opt_includeInstance, this);
+ * @param {?proto.sqlrpc.v1.RollbackResponse|undefined} value
+ * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
+*/
+proto.sqlrpc.v1.TransactionResponse.prototype.setRollback = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 9, proto.sqlrpc.v1.TransactionResponse.oneofGroups_[0], value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.TypedQueryResultHeader} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
  */
-proto.sqlrpc.v1.TypedQueryResultHeader.toObject = function(includeInstance, msg) {
-  var f, obj = {
-columnsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
-columnAffinitiesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
-columnDeclaredTypesList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
-columnRawTypesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.sqlrpc.v1.TransactionResponse.prototype.clearRollback = function() {
+  return this.setRollback(undefined);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.TypedQueryResultHeader}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.TypedQueryResultHeader.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.TypedQueryResultHeader;
-  return proto.sqlrpc.v1.TypedQueryResultHeader.deserializeBinaryFromReader(msg, reader);
+proto.sqlrpc.v1.TransactionResponse.prototype.hasRollback = function() {
+  return jspb.Message.getField(this, 9) != null;
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.TypedQueryResultHeader} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.TypedQueryResultHeader}
+ * optional ExecResponse exec_result = 11;
+ * @return {?proto.sqlrpc.v1.ExecResponse}
  */
-proto.sqlrpc.v1.TypedQueryResultHeader.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.addColumns(value);
-      break;
-    case 2:
-      reader.readPackableEnumInto(msg.getColumnAffinitiesList());
-      break;
-    case 3:
-      reader.readPackableEnumInto(msg.getColumnDeclaredTypesList());
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.addColumnRawTypes(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.sqlrpc.v1.TransactionResponse.prototype.getExecResult = function() {
+  return /** @type{?proto.sqlrpc.v1.ExecResponse} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.ExecResponse, 11));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
- */
-proto.sqlrpc.v1.TypedQueryResultHeader.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.TypedQueryResultHeader.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+ * @param {?proto.sqlrpc.v1.ExecResponse|undefined} value
+ * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
+*/
+proto.sqlrpc.v1.TransactionResponse.prototype.setExecResult = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 11, proto.sqlrpc.v1.TransactionResponse.oneofGroups_[0], value);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.TypedQueryResultHeader} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
  */
-proto.sqlrpc.v1.TypedQueryResultHeader.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getColumnsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      1,
-      f
-    );
-  }
-  f = message.getColumnAffinitiesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
-      2,
-      f
-    );
-  }
-  f = message.getColumnDeclaredTypesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
-      3,
-      f
-    );
-  }
-  f = message.getColumnRawTypesList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      4,
-      f
-    );
-  }
+proto.sqlrpc.v1.TransactionResponse.prototype.clearExecResult = function() {
+  return this.setExecResult(undefined);
 };
 
 
 /**
- * repeated string columns = 1;
- * @return {!Array<string>}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.TypedQueryResultHeader.prototype.getColumnsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.sqlrpc.v1.TransactionResponse.prototype.hasExecResult = function() {
+  return jspb.Message.getField(this, 11) != null;
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.sqlrpc.v1.TypedQueryResultHeader} returns this
+ * optional ErrorResponse error = 10;
+ * @return {?proto.sqlrpc.v1.ErrorResponse}
  */
-proto.sqlrpc.v1.TypedQueryResultHeader.prototype.setColumnsList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.sqlrpc.v1.TransactionResponse.prototype.getError = function() {
+  return /** @type{?proto.sqlrpc.v1.ErrorResponse} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.ErrorResponse, 10));
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.TypedQueryResultHeader} returns this
- */
-proto.sqlrpc.v1.TypedQueryResultHeader.prototype.addColumns = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+ * @param {?proto.sqlrpc.v1.ErrorResponse|undefined} value
+ * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
+*/
+proto.sqlrpc.v1.TransactionResponse.prototype.setError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 10, proto.sqlrpc.v1.TransactionResponse.oneofGroups_[0], value);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.TypedQueryResultHeader} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
  */
-proto.sqlrpc.v1.TypedQueryResultHeader.prototype.clearColumnsList = function() {
-  return this.setColumnsList([]);
+proto.sqlrpc.v1.TransactionResponse.prototype.clearError = function() {
+  return this.setError(undefined);
 };
 
 
 /**
- * repeated ColumnAffinity column_affinities = 2;
- * @return {!Array<!proto.sqlrpc.v1.ColumnAffinity>}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.TypedQueryResultHeader.prototype.getColumnAffinitiesList = function() {
-  return /** @type {!Array<!proto.sqlrpc.v1.ColumnAffinity>} */ (jspb.Message.getRepeatedField(this, 2));
+proto.sqlrpc.v1.TransactionResponse.prototype.hasError = function() {
+  return jspb.Message.getField(this, 10) != null;
 };
 
 
+
 /**
- * @param {!Array<!proto.sqlrpc.v1.ColumnAffinity>} value
- * @return {!proto.sqlrpc.v1.TypedQueryResultHeader} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.sqlrpc.v1.TypedQueryResultHeader.prototype.setColumnAffinitiesList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
-};
+proto.sqlrpc.v1.ExplainResponse.repeatedFields_ = [1];
+
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {!proto.sqlrpc.v1.ColumnAffinity} value
- * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.TypedQueryResultHeader} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.sqlrpc.v1.TypedQueryResultHeader.prototype.addColumnAffinities = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+proto.sqlrpc.v1.ExplainResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ExplainResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.TypedQueryResultHeader} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.ExplainResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TypedQueryResultHeader.prototype.clearColumnAffinitiesList = function() {
-  return this.setColumnAffinitiesList([]);
+proto.sqlrpc.v1.ExplainResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+nodesList: jspb.Message.toObjectList(msg.getNodesList(),
+    sqlrpc_v1_types_pb.QueryPlanNode.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * repeated DeclaredType column_declared_types = 3;
- * @return {!Array<!proto.sqlrpc.v1.DeclaredType>}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.ExplainResponse}
  */
-proto.sqlrpc.v1.TypedQueryResultHeader.prototype.getColumnDeclaredTypesList = function() {
-  return /** @type {!Array<!proto.sqlrpc.v1.DeclaredType>} */ (jspb.Message.getRepeatedField(this, 3));
+proto.sqlrpc.v1.ExplainResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.ExplainResponse;
+  return proto.sqlrpc.v1.ExplainResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {!Array<!proto.sqlrpc.v1.DeclaredType>} value
- * @return {!proto.sqlrpc.v1.TypedQueryResultHeader} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.ExplainResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.ExplainResponse}
  */
-proto.sqlrpc.v1.TypedQueryResultHeader.prototype.setColumnDeclaredTypesList = function(value) {
-  return jspb.Message.setField(this, 3, value || []);
+proto.sqlrpc.v1.ExplainResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new sqlrpc_v1_types_pb.QueryPlanNode;
+      reader.readMessage(value,sqlrpc_v1_types_pb.QueryPlanNode.deserializeBinaryFromReader);
+      msg.addNodes(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {!proto.sqlrpc.v1.DeclaredType} value
- * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.TypedQueryResultHeader} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.TypedQueryResultHeader.prototype.addColumnDeclaredTypes = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+proto.sqlrpc.v1.ExplainResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.ExplainResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.TypedQueryResultHeader} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.ExplainResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TypedQueryResultHeader.prototype.clearColumnDeclaredTypesList = function() {
-  return this.setColumnDeclaredTypesList([]);
+proto.sqlrpc.v1.ExplainResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNodesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      sqlrpc_v1_types_pb.QueryPlanNode.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * repeated string column_raw_types = 4;
- * @return {!Array<string>}
+ * repeated QueryPlanNode nodes = 1;
+ * @return {!Array<!proto.sqlrpc.v1.QueryPlanNode>}
  */
-proto.sqlrpc.v1.TypedQueryResultHeader.prototype.getColumnRawTypesList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+proto.sqlrpc.v1.ExplainResponse.prototype.getNodesList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.QueryPlanNode>} */ (
+    jspb.Message.getRepeatedWrapperField(this, sqlrpc_v1_types_pb.QueryPlanNode, 1));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.sqlrpc.v1.TypedQueryResultHeader} returns this
- */
-proto.sqlrpc.v1.TypedQueryResultHeader.prototype.setColumnRawTypesList = function(value) {
-  return jspb.Message.setField(this, 4, value || []);
+ * @param {!Array<!proto.sqlrpc.v1.QueryPlanNode>} value
+ * @return {!proto.sqlrpc.v1.ExplainResponse} returns this
+*/
+proto.sqlrpc.v1.ExplainResponse.prototype.setNodesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {string} value
+ * @param {!proto.sqlrpc.v1.QueryPlanNode=} opt_value
  * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.TypedQueryResultHeader} returns this
+ * @return {!proto.sqlrpc.v1.QueryPlanNode}
  */
-proto.sqlrpc.v1.TypedQueryResultHeader.prototype.addColumnRawTypes = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+proto.sqlrpc.v1.ExplainResponse.prototype.addNodes = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.sqlrpc.v1.QueryPlanNode, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.TypedQueryResultHeader} returns this
+ * @return {!proto.sqlrpc.v1.ExplainResponse} returns this
  */
-proto.sqlrpc.v1.TypedQueryResultHeader.prototype.clearColumnRawTypesList = function() {
-  return this.setColumnRawTypesList([]);
+proto.sqlrpc.v1.ExplainResponse.prototype.clearNodesList = function() {
+  return this.setNodesList([]);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.sqlrpc.v1.TypedQueryResultRowBatch.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -6191,8 +12293,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.TypedQueryResultRowBatch.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.TypedQueryResultRowBatch.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ListTablesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ListTablesRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -6201,14 +12303,13 @@ proto.sqlrpc.v1.TypedQueryResultRowBatch.prototype.toObject = function(opt_inclu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.TypedQueryResultRowBatch} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.ListTablesRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TypedQueryResultRowBatch.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.ListTablesRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-rowsList: jspb.Message.toObjectList(msg.getRowsList(),
-    sqlrpc_v1_types_pb.SqlRow.toObject, includeInstance)
+database: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -6222,23 +12323,23 @@ rowsList: jspb.Message.toObjectList(msg.getRowsList(),
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.TypedQueryResultRowBatch}
+ * @return {!proto.sqlrpc.v1.ListTablesRequest}
  */
-proto.sqlrpc.v1.TypedQueryResultRowBatch.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.ListTablesRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.TypedQueryResultRowBatch;
-  return proto.sqlrpc.v1.TypedQueryResultRowBatch.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.ListTablesRequest;
+  return proto.sqlrpc.v1.ListTablesRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.TypedQueryResultRowBatch} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.ListTablesRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.TypedQueryResultRowBatch}
+ * @return {!proto.sqlrpc.v1.ListTablesRequest}
  */
-proto.sqlrpc.v1.TypedQueryResultRowBatch.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.ListTablesRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6246,9 +12347,8 @@ proto.sqlrpc.v1.TypedQueryResultRowBatch.deserializeBinaryFromReader = function(
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new sqlrpc_v1_types_pb.SqlRow;
-      reader.readMessage(value,sqlrpc_v1_types_pb.SqlRow.deserializeBinaryFromReader);
-      msg.addRows(value);
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
       break;
     default:
       reader.skipField();
@@ -6263,9 +12363,9 @@ proto.sqlrpc.v1.TypedQueryResultRowBatch.deserializeBinaryFromReader = function(
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.TypedQueryResultRowBatch.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.ListTablesRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.TypedQueryResultRowBatch.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.ListTablesRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6273,61 +12373,47 @@ proto.sqlrpc.v1.TypedQueryResultRowBatch.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.TypedQueryResultRowBatch} message
+ * @param {!proto.sqlrpc.v1.ListTablesRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TypedQueryResultRowBatch.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.ListTablesRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getRowsList();
+  f = message.getDatabase();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      sqlrpc_v1_types_pb.SqlRow.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * repeated SqlRow rows = 1;
- * @return {!Array<!proto.sqlrpc.v1.SqlRow>}
+ * optional string database = 1;
+ * @return {string}
  */
-proto.sqlrpc.v1.TypedQueryResultRowBatch.prototype.getRowsList = function() {
-  return /** @type{!Array<!proto.sqlrpc.v1.SqlRow>} */ (
-    jspb.Message.getRepeatedWrapperField(this, sqlrpc_v1_types_pb.SqlRow, 1));
-};
-
-
-/**
- * @param {!Array<!proto.sqlrpc.v1.SqlRow>} value
- * @return {!proto.sqlrpc.v1.TypedQueryResultRowBatch} returns this
-*/
-proto.sqlrpc.v1.TypedQueryResultRowBatch.prototype.setRowsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+proto.sqlrpc.v1.ListTablesRequest.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!proto.sqlrpc.v1.SqlRow=} opt_value
- * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.SqlRow}
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.ListTablesRequest} returns this
  */
-proto.sqlrpc.v1.TypedQueryResultRowBatch.prototype.addRows = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.sqlrpc.v1.SqlRow, opt_index);
+proto.sqlrpc.v1.ListTablesRequest.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.TypedQueryResultRowBatch} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.sqlrpc.v1.TypedQueryResultRowBatch.prototype.clearRowsList = function() {
-  return this.setRowsList([]);
-};
-
-
+proto.sqlrpc.v1.ListTablesResponse.repeatedFields_ = [1];
 
 
 
@@ -6344,8 +12430,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.QueryComplete.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.QueryComplete.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ListTablesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ListTablesResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -6354,13 +12440,13 @@ proto.sqlrpc.v1.QueryComplete.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.QueryComplete} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.ListTablesResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.QueryComplete.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.ListTablesResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-stats: (f = msg.getStats()) && sqlrpc_v1_types_pb.ExecutionStats.toObject(includeInstance, f)
+tableNamesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -6374,23 +12460,23 @@ stats: (f = msg.getStats()) && sqlrpc_v1_types_pb.ExecutionStats.toObject(includ
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.QueryComplete}
+ * @return {!proto.sqlrpc.v1.ListTablesResponse}
  */
-proto.sqlrpc.v1.QueryComplete.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.ListTablesResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.QueryComplete;
-  return proto.sqlrpc.v1.QueryComplete.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.ListTablesResponse;
+  return proto.sqlrpc.v1.ListTablesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.QueryComplete} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.ListTablesResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.QueryComplete}
+ * @return {!proto.sqlrpc.v1.ListTablesResponse}
  */
-proto.sqlrpc.v1.QueryComplete.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.ListTablesResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6398,9 +12484,8 @@ proto.sqlrpc.v1.QueryComplete.deserializeBinaryFromReader = function(msg, reader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new sqlrpc_v1_types_pb.ExecutionStats;
-      reader.readMessage(value,sqlrpc_v1_types_pb.ExecutionStats.deserializeBinaryFromReader);
-      msg.setStats(value);
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.addTableNames(value);
       break;
     default:
       reader.skipField();
@@ -6415,9 +12500,9 @@ proto.sqlrpc.v1.QueryComplete.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.QueryComplete.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.ListTablesResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.QueryComplete.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.ListTablesResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6425,57 +12510,56 @@ proto.sqlrpc.v1.QueryComplete.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.QueryComplete} message
+ * @param {!proto.sqlrpc.v1.ListTablesResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.QueryComplete.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.ListTablesResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getStats();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getTableNamesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
       1,
-      f,
-      sqlrpc_v1_types_pb.ExecutionStats.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * optional ExecutionStats stats = 1;
- * @return {?proto.sqlrpc.v1.ExecutionStats}
+ * repeated string table_names = 1;
+ * @return {!Array<string>}
  */
-proto.sqlrpc.v1.QueryComplete.prototype.getStats = function() {
-  return /** @type{?proto.sqlrpc.v1.ExecutionStats} */ (
-    jspb.Message.getWrapperField(this, sqlrpc_v1_types_pb.ExecutionStats, 1));
+proto.sqlrpc.v1.ListTablesResponse.prototype.getTableNamesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.ExecutionStats|undefined} value
- * @return {!proto.sqlrpc.v1.QueryComplete} returns this
-*/
-proto.sqlrpc.v1.QueryComplete.prototype.setStats = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * @param {!Array<string>} value
+ * @return {!proto.sqlrpc.v1.ListTablesResponse} returns this
+ */
+proto.sqlrpc.v1.ListTablesResponse.prototype.setTableNamesList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.QueryComplete} returns this
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.ListTablesResponse} returns this
  */
-proto.sqlrpc.v1.QueryComplete.prototype.clearStats = function() {
-  return this.setStats(undefined);
+proto.sqlrpc.v1.ListTablesResponse.prototype.addTableNames = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.ListTablesResponse} returns this
  */
-proto.sqlrpc.v1.QueryComplete.prototype.hasStats = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.sqlrpc.v1.ListTablesResponse.prototype.clearTableNamesList = function() {
+  return this.setTableNamesList([]);
 };
 
 
@@ -6495,8 +12579,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.ErrorResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.ErrorResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.GetTableSchemaRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.GetTableSchemaRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -6505,15 +12589,14 @@ proto.sqlrpc.v1.ErrorResponse.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.ErrorResponse} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.GetTableSchemaRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.ErrorResponse.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.GetTableSchemaRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-message: jspb.Message.getFieldWithDefault(msg, 1, ""),
-failedSql: jspb.Message.getFieldWithDefault(msg, 2, ""),
-sqliteErrorCode: jspb.Message.getFieldWithDefault(msg, 3, 0)
+database: jspb.Message.getFieldWithDefault(msg, 1, ""),
+tableName: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -6527,23 +12610,23 @@ sqliteErrorCode: jspb.Message.getFieldWithDefault(msg, 3, 0)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.ErrorResponse}
+ * @return {!proto.sqlrpc.v1.GetTableSchemaRequest}
  */
-proto.sqlrpc.v1.ErrorResponse.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.GetTableSchemaRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.ErrorResponse;
-  return proto.sqlrpc.v1.ErrorResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.GetTableSchemaRequest;
+  return proto.sqlrpc.v1.GetTableSchemaRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.ErrorResponse} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.GetTableSchemaRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.ErrorResponse}
+ * @return {!proto.sqlrpc.v1.GetTableSchemaRequest}
  */
-proto.sqlrpc.v1.ErrorResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.GetTableSchemaRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6552,15 +12635,11 @@ proto.sqlrpc.v1.ErrorResponse.deserializeBinaryFromReader = function(msg, reader
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setMessage(value);
+      msg.setDatabase(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setFailedSql(value);
-      break;
-    case 3:
-      var value = /** @type {!proto.sqlrpc.v1.SqliteCode} */ (reader.readEnum());
-      msg.setSqliteErrorCode(value);
+      msg.setTableName(value);
       break;
     default:
       reader.skipField();
@@ -6575,9 +12654,9 @@ proto.sqlrpc.v1.ErrorResponse.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.ErrorResponse.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.GetTableSchemaRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.ErrorResponse.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.GetTableSchemaRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6585,124 +12664,65 @@ proto.sqlrpc.v1.ErrorResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.ErrorResponse} message
+ * @param {!proto.sqlrpc.v1.GetTableSchemaRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.ErrorResponse.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.GetTableSchemaRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getMessage();
+  f = message.getDatabase();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getFailedSql();
+  f = message.getTableName();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getSqliteErrorCode();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      3,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string message = 1;
+ * optional string database = 1;
  * @return {string}
  */
-proto.sqlrpc.v1.ErrorResponse.prototype.getMessage = function() {
+proto.sqlrpc.v1.GetTableSchemaRequest.prototype.getDatabase = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.ErrorResponse} returns this
+ * @return {!proto.sqlrpc.v1.GetTableSchemaRequest} returns this
  */
-proto.sqlrpc.v1.ErrorResponse.prototype.setMessage = function(value) {
+proto.sqlrpc.v1.GetTableSchemaRequest.prototype.setDatabase = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string failed_sql = 2;
+ * optional string table_name = 2;
  * @return {string}
  */
-proto.sqlrpc.v1.ErrorResponse.prototype.getFailedSql = function() {
+proto.sqlrpc.v1.GetTableSchemaRequest.prototype.getTableName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.ErrorResponse} returns this
+ * @return {!proto.sqlrpc.v1.GetTableSchemaRequest} returns this
  */
-proto.sqlrpc.v1.ErrorResponse.prototype.setFailedSql = function(value) {
+proto.sqlrpc.v1.GetTableSchemaRequest.prototype.setTableName = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
-/**
- * optional SqliteCode sqlite_error_code = 3;
- * @return {!proto.sqlrpc.v1.SqliteCode}
- */
-proto.sqlrpc.v1.ErrorResponse.prototype.getSqliteErrorCode = function() {
-  return /** @type {!proto.sqlrpc.v1.SqliteCode} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {!proto.sqlrpc.v1.SqliteCode} value
- * @return {!proto.sqlrpc.v1.ErrorResponse} returns this
- */
-proto.sqlrpc.v1.ErrorResponse.prototype.setSqliteErrorCode = function(value) {
-  return jspb.Message.setProto3EnumField(this, 3, value);
-};
-
-
-
-/**
- * Oneof group definitions for this message. Each group defines the field
- * numbers belonging to that group. When of these fields' value is set, all
- * other fields in the group are cleared. During deserialization, if multiple
- * fields are encountered for a group, only the last value seen will be kept.
- * @private {!Array<!Array<number>>}
- * @const
- */
-proto.sqlrpc.v1.TransactionRequest.oneofGroups_ = [[1,2,3,4,5,6,7,8,9,10]];
-
-/**
- * @enum {number}
- */
-proto.sqlrpc.v1.TransactionRequest.CommandCase = {
-  COMMAND_NOT_SET: 0,
-  BEGIN: 1,
-  QUERY: 2,
-  QUERY_STREAM: 3,
-  TYPED_QUERY: 4,
-  TYPED_QUERY_STREAM: 5,
-  EXEC: 6,
-  TYPED_EXEC: 7,
-  SAVEPOINT: 8,
-  COMMIT: 9,
-  ROLLBACK: 10
-};
-
-/**
- * @return {proto.sqlrpc.v1.TransactionRequest.CommandCase}
- */
-proto.sqlrpc.v1.TransactionRequest.prototype.getCommandCase = function() {
-  return /** @type {proto.sqlrpc.v1.TransactionRequest.CommandCase} */(jspb.Message.computeOneofCase(this, proto.sqlrpc.v1.TransactionRequest.oneofGroups_[0]));
-};
 
 
 
@@ -6719,8 +12739,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.TransactionRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.GetDatabaseSchemaRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.GetDatabaseSchemaRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -6729,22 +12749,13 @@ proto.sqlrpc.v1.TransactionRequest.prototype.toObject = function(opt_includeInst
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.TransactionRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.GetDatabaseSchemaRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TransactionRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.GetDatabaseSchemaRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-begin: (f = msg.getBegin()) && proto.sqlrpc.v1.BeginRequest.toObject(includeInstance, f),
-query: (f = msg.getQuery()) && proto.sqlrpc.v1.TransactionalQueryRequest.toObject(includeInstance, f),
-queryStream: (f = msg.getQueryStream()) && proto.sqlrpc.v1.TransactionalQueryRequest.toObject(includeInstance, f),
-typedQuery: (f = msg.getTypedQuery()) && proto.sqlrpc.v1.TypedTransactionalQueryRequest.toObject(includeInstance, f),
-typedQueryStream: (f = msg.getTypedQueryStream()) && proto.sqlrpc.v1.TypedTransactionalQueryRequest.toObject(includeInstance, f),
-exec: (f = msg.getExec()) && proto.sqlrpc.v1.TransactionalQueryRequest.toObject(includeInstance, f),
-typedExec: (f = msg.getTypedExec()) && proto.sqlrpc.v1.TypedTransactionalQueryRequest.toObject(includeInstance, f),
-savepoint: (f = msg.getSavepoint()) && proto.sqlrpc.v1.SavepointRequest.toObject(includeInstance, f),
-commit: (f = msg.getCommit()) && google_protobuf_empty_pb.Empty.toObject(includeInstance, f),
-rollback: (f = msg.getRollback()) && google_protobuf_empty_pb.Empty.toObject(includeInstance, f)
+database: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -6758,78 +12769,32 @@ rollback: (f = msg.getRollback()) && google_protobuf_empty_pb.Empty.toObject(inc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.TransactionRequest}
+ * @return {!proto.sqlrpc.v1.GetDatabaseSchemaRequest}
  */
-proto.sqlrpc.v1.TransactionRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.GetDatabaseSchemaRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.TransactionRequest;
-  return proto.sqlrpc.v1.TransactionRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.GetDatabaseSchemaRequest;
+  return proto.sqlrpc.v1.GetDatabaseSchemaRequest.deserializeBinaryFromReader(msg, reader);
 };
-
-
-/**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.TransactionRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.TransactionRequest}
- */
-proto.sqlrpc.v1.TransactionRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = new proto.sqlrpc.v1.BeginRequest;
-      reader.readMessage(value,proto.sqlrpc.v1.BeginRequest.deserializeBinaryFromReader);
-      msg.setBegin(value);
-      break;
-    case 2:
-      var value = new proto.sqlrpc.v1.TransactionalQueryRequest;
-      reader.readMessage(value,proto.sqlrpc.v1.TransactionalQueryRequest.deserializeBinaryFromReader);
-      msg.setQuery(value);
-      break;
-    case 3:
-      var value = new proto.sqlrpc.v1.TransactionalQueryRequest;
-      reader.readMessage(value,proto.sqlrpc.v1.TransactionalQueryRequest.deserializeBinaryFromReader);
-      msg.setQueryStream(value);
-      break;
-    case 4:
-      var value = new proto.sqlrpc.v1.TypedTransactionalQueryRequest;
-      reader.readMessage(value,proto.sqlrpc.v1.TypedTransactionalQueryRequest.deserializeBinaryFromReader);
-      msg.setTypedQuery(value);
-      break;
-    case 5:
-      var value = new proto.sqlrpc.v1.TypedTransactionalQueryRequest;
-      reader.readMessage(value,proto.sqlrpc.v1.TypedTransactionalQueryRequest.deserializeBinaryFromReader);
-      msg.setTypedQueryStream(value);
-      break;
-    case 6:
-      var value = new proto.sqlrpc.v1.TransactionalQueryRequest;
-      reader.readMessage(value,proto.sqlrpc.v1.TransactionalQueryRequest.deserializeBinaryFromReader);
-      msg.setExec(value);
-      break;
-    case 7:
-      var value = new proto.sqlrpc.v1.TypedTransactionalQueryRequest;
-      reader.readMessage(value,proto.sqlrpc.v1.TypedTransactionalQueryRequest.deserializeBinaryFromReader);
-      msg.setTypedExec(value);
-      break;
-    case 8:
-      var value = new proto.sqlrpc.v1.SavepointRequest;
-      reader.readMessage(value,proto.sqlrpc.v1.SavepointRequest.deserializeBinaryFromReader);
-      msg.setSavepoint(value);
-      break;
-    case 9:
-      var value = new google_protobuf_empty_pb.Empty;
-      reader.readMessage(value,google_protobuf_empty_pb.Empty.deserializeBinaryFromReader);
-      msg.setCommit(value);
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.GetDatabaseSchemaRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.GetDatabaseSchemaRequest}
+ */
+proto.sqlrpc.v1.GetDatabaseSchemaRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
       break;
-    case 10:
-      var value = new google_protobuf_empty_pb.Empty;
-      reader.readMessage(value,google_protobuf_empty_pb.Empty.deserializeBinaryFromReader);
-      msg.setRollback(value);
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
       break;
     default:
       reader.skipField();
@@ -6844,9 +12809,9 @@ proto.sqlrpc.v1.TransactionRequest.deserializeBinaryFromReader = function(msg, r
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.GetDatabaseSchemaRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.TransactionRequest.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.GetDatabaseSchemaRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6854,268 +12819,206 @@ proto.sqlrpc.v1.TransactionRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.TransactionRequest} message
+ * @param {!proto.sqlrpc.v1.GetDatabaseSchemaRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TransactionRequest.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.GetDatabaseSchemaRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getBegin();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      proto.sqlrpc.v1.BeginRequest.serializeBinaryToWriter
-    );
-  }
-  f = message.getQuery();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      proto.sqlrpc.v1.TransactionalQueryRequest.serializeBinaryToWriter
-    );
-  }
-  f = message.getQueryStream();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      proto.sqlrpc.v1.TransactionalQueryRequest.serializeBinaryToWriter
-    );
-  }
-  f = message.getTypedQuery();
-  if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      proto.sqlrpc.v1.TypedTransactionalQueryRequest.serializeBinaryToWriter
-    );
-  }
-  f = message.getTypedQueryStream();
-  if (f != null) {
-    writer.writeMessage(
-      5,
-      f,
-      proto.sqlrpc.v1.TypedTransactionalQueryRequest.serializeBinaryToWriter
-    );
-  }
-  f = message.getExec();
-  if (f != null) {
-    writer.writeMessage(
-      6,
-      f,
-      proto.sqlrpc.v1.TransactionalQueryRequest.serializeBinaryToWriter
-    );
-  }
-  f = message.getTypedExec();
-  if (f != null) {
-    writer.writeMessage(
-      7,
-      f,
-      proto.sqlrpc.v1.TypedTransactionalQueryRequest.serializeBinaryToWriter
-    );
-  }
-  f = message.getSavepoint();
-  if (f != null) {
-    writer.writeMessage(
-      8,
-      f,
-      proto.sqlrpc.v1.SavepointRequest.serializeBinaryToWriter
-    );
-  }
-  f = message.getCommit();
-  if (f != null) {
-    writer.writeMessage(
-      9,
-      f,
-      google_protobuf_empty_pb.Empty.serializeBinaryToWriter
-    );
-  }
-  f = message.getRollback();
-  if (f != null) {
-    writer.writeMessage(
-      10,
-      f,
-      google_protobuf_empty_pb.Empty.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * optional BeginRequest begin = 1;
- * @return {?proto.sqlrpc.v1.BeginRequest}
- */
-proto.sqlrpc.v1.TransactionRequest.prototype.getBegin = function() {
-  return /** @type{?proto.sqlrpc.v1.BeginRequest} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.BeginRequest, 1));
-};
-
-
-/**
- * @param {?proto.sqlrpc.v1.BeginRequest|undefined} value
- * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
-*/
-proto.sqlrpc.v1.TransactionRequest.prototype.setBegin = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 1, proto.sqlrpc.v1.TransactionRequest.oneofGroups_[0], value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
- */
-proto.sqlrpc.v1.TransactionRequest.prototype.clearBegin = function() {
-  return this.setBegin(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional string database = 1;
+ * @return {string}
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.hasBegin = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.sqlrpc.v1.GetDatabaseSchemaRequest.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * optional TransactionalQueryRequest query = 2;
- * @return {?proto.sqlrpc.v1.TransactionalQueryRequest}
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.GetDatabaseSchemaRequest} returns this
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.getQuery = function() {
-  return /** @type{?proto.sqlrpc.v1.TransactionalQueryRequest} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.TransactionalQueryRequest, 2));
+proto.sqlrpc.v1.GetDatabaseSchemaRequest.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * @param {?proto.sqlrpc.v1.TransactionalQueryRequest|undefined} value
- * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
-*/
-proto.sqlrpc.v1.TransactionRequest.prototype.setQuery = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 2, proto.sqlrpc.v1.TransactionRequest.oneofGroups_[0], value);
-};
-
 
-/**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
- */
-proto.sqlrpc.v1.TransactionRequest.prototype.clearQuery = function() {
-  return this.setQuery(undefined);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.hasQuery = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.sqlrpc.v1.VacuumRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.VacuumRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional TransactionalQueryRequest query_stream = 3;
- * @return {?proto.sqlrpc.v1.TransactionalQueryRequest}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.VacuumRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.getQueryStream = function() {
-  return /** @type{?proto.sqlrpc.v1.TransactionalQueryRequest} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.TransactionalQueryRequest, 3));
-};
-
+proto.sqlrpc.v1.VacuumRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+database: jspb.Message.getFieldWithDefault(msg, 1, ""),
+intoFile: (f = jspb.Message.getField(msg, 2)) == null ? undefined : f
+  };
 
-/**
- * @param {?proto.sqlrpc.v1.TransactionalQueryRequest|undefined} value
- * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
-*/
-proto.sqlrpc.v1.TransactionRequest.prototype.setQueryStream = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 3, proto.sqlrpc.v1.TransactionRequest.oneofGroups_[0], value);
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.VacuumRequest}
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.clearQueryStream = function() {
-  return this.setQueryStream(undefined);
+proto.sqlrpc.v1.VacuumRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.VacuumRequest;
+  return proto.sqlrpc.v1.VacuumRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.VacuumRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.VacuumRequest}
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.hasQueryStream = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.sqlrpc.v1.VacuumRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setIntoFile(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional TypedTransactionalQueryRequest typed_query = 4;
- * @return {?proto.sqlrpc.v1.TypedTransactionalQueryRequest}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.getTypedQuery = function() {
-  return /** @type{?proto.sqlrpc.v1.TypedTransactionalQueryRequest} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.TypedTransactionalQueryRequest, 4));
+proto.sqlrpc.v1.VacuumRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.VacuumRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.TypedTransactionalQueryRequest|undefined} value
- * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
-*/
-proto.sqlrpc.v1.TransactionRequest.prototype.setTypedQuery = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 4, proto.sqlrpc.v1.TransactionRequest.oneofGroups_[0], value);
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.VacuumRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.VacuumRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
+ * optional string database = 1;
+ * @return {string}
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.clearTypedQuery = function() {
-  return this.setTypedQuery(undefined);
+proto.sqlrpc.v1.VacuumRequest.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.VacuumRequest} returns this
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.hasTypedQuery = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.sqlrpc.v1.VacuumRequest.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional TypedTransactionalQueryRequest typed_query_stream = 5;
- * @return {?proto.sqlrpc.v1.TypedTransactionalQueryRequest}
+ * optional string into_file = 2;
+ * @return {string}
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.getTypedQueryStream = function() {
-  return /** @type{?proto.sqlrpc.v1.TypedTransactionalQueryRequest} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.TypedTransactionalQueryRequest, 5));
+proto.sqlrpc.v1.VacuumRequest.prototype.getIntoFile = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.TypedTransactionalQueryRequest|undefined} value
- * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
-*/
-proto.sqlrpc.v1.TransactionRequest.prototype.setTypedQueryStream = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 5, proto.sqlrpc.v1.TransactionRequest.oneofGroups_[0], value);
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.VacuumRequest} returns this
+ */
+proto.sqlrpc.v1.VacuumRequest.prototype.setIntoFile = function(value) {
+  return jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
+ * Clears the field making it undefined.
+ * @return {!proto.sqlrpc.v1.VacuumRequest} returns this
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.clearTypedQueryStream = function() {
-  return this.setTypedQueryStream(undefined);
+proto.sqlrpc.v1.VacuumRequest.prototype.clearIntoFile = function() {
+  return jspb.Message.setField(this, 2, undefined);
 };
 
 
@@ -7123,233 +13026,334 @@ proto.sqlrpc.v1.TransactionRequest.prototype.clearTypedQueryStream = function()
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.hasTypedQueryStream = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.sqlrpc.v1.VacuumRequest.prototype.hasIntoFile = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
-/**
- * optional TransactionalQueryRequest exec = 6;
- * @return {?proto.sqlrpc.v1.TransactionalQueryRequest}
- */
-proto.sqlrpc.v1.TransactionRequest.prototype.getExec = function() {
-  return /** @type{?proto.sqlrpc.v1.TransactionalQueryRequest} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.TransactionalQueryRequest, 6));
-};
-
 
-/**
- * @param {?proto.sqlrpc.v1.TransactionalQueryRequest|undefined} value
- * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
-*/
-proto.sqlrpc.v1.TransactionRequest.prototype.setExec = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 6, proto.sqlrpc.v1.TransactionRequest.oneofGroups_[0], value);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.clearExec = function() {
-  return this.setExec(undefined);
+proto.sqlrpc.v1.VacuumResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.VacuumResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.VacuumResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.hasExec = function() {
-  return jspb.Message.getField(this, 6) != null;
-};
-
+proto.sqlrpc.v1.VacuumResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+message: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
 
-/**
- * optional TypedTransactionalQueryRequest typed_exec = 7;
- * @return {?proto.sqlrpc.v1.TypedTransactionalQueryRequest}
- */
-proto.sqlrpc.v1.TransactionRequest.prototype.getTypedExec = function() {
-  return /** @type{?proto.sqlrpc.v1.TypedTransactionalQueryRequest} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.TypedTransactionalQueryRequest, 7));
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {?proto.sqlrpc.v1.TypedTransactionalQueryRequest|undefined} value
- * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
-*/
-proto.sqlrpc.v1.TransactionRequest.prototype.setTypedExec = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 7, proto.sqlrpc.v1.TransactionRequest.oneofGroups_[0], value);
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.VacuumResponse}
+ */
+proto.sqlrpc.v1.VacuumResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.VacuumResponse;
+  return proto.sqlrpc.v1.VacuumResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.VacuumResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.VacuumResponse}
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.clearTypedExec = function() {
-  return this.setTypedExec(undefined);
+proto.sqlrpc.v1.VacuumResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.hasTypedExec = function() {
-  return jspb.Message.getField(this, 7) != null;
+proto.sqlrpc.v1.VacuumResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.VacuumResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * optional SavepointRequest savepoint = 8;
- * @return {?proto.sqlrpc.v1.SavepointRequest}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.VacuumResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.getSavepoint = function() {
-  return /** @type{?proto.sqlrpc.v1.SavepointRequest} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.SavepointRequest, 8));
+proto.sqlrpc.v1.VacuumResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.SavepointRequest|undefined} value
- * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
-*/
-proto.sqlrpc.v1.TransactionRequest.prototype.setSavepoint = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 8, proto.sqlrpc.v1.TransactionRequest.oneofGroups_[0], value);
+ * optional bool success = 1;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.VacuumResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.VacuumResponse} returns this
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.clearSavepoint = function() {
-  return this.setSavepoint(undefined);
+proto.sqlrpc.v1.VacuumResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional string message = 2;
+ * @return {string}
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.hasSavepoint = function() {
-  return jspb.Message.getField(this, 8) != null;
+proto.sqlrpc.v1.VacuumResponse.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * optional google.protobuf.Empty commit = 9;
- * @return {?proto.google.protobuf.Empty}
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.VacuumResponse} returns this
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.getCommit = function() {
-  return /** @type{?proto.google.protobuf.Empty} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_empty_pb.Empty, 9));
+proto.sqlrpc.v1.VacuumResponse.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {?proto.google.protobuf.Empty|undefined} value
- * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
-*/
-proto.sqlrpc.v1.TransactionRequest.prototype.setCommit = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 9, proto.sqlrpc.v1.TransactionRequest.oneofGroups_[0], value);
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.CheckpointRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.CheckpointRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.CheckpointRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.clearCommit = function() {
-  return this.setCommit(undefined);
+proto.sqlrpc.v1.CheckpointRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+database: jspb.Message.getFieldWithDefault(msg, 1, ""),
+mode: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.CheckpointRequest}
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.hasCommit = function() {
-  return jspb.Message.getField(this, 9) != null;
+proto.sqlrpc.v1.CheckpointRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.CheckpointRequest;
+  return proto.sqlrpc.v1.CheckpointRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional google.protobuf.Empty rollback = 10;
- * @return {?proto.google.protobuf.Empty}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.CheckpointRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.CheckpointRequest}
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.getRollback = function() {
-  return /** @type{?proto.google.protobuf.Empty} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_empty_pb.Empty, 10));
+proto.sqlrpc.v1.CheckpointRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.sqlrpc.v1.CheckpointMode} */ (reader.readEnum());
+      msg.setMode(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {?proto.google.protobuf.Empty|undefined} value
- * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
-*/
-proto.sqlrpc.v1.TransactionRequest.prototype.setRollback = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 10, proto.sqlrpc.v1.TransactionRequest.oneofGroups_[0], value);
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.CheckpointRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.CheckpointRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TransactionRequest} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.CheckpointRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.clearRollback = function() {
-  return this.setRollback(undefined);
+proto.sqlrpc.v1.CheckpointRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getMode();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional string database = 1;
+ * @return {string}
  */
-proto.sqlrpc.v1.TransactionRequest.prototype.hasRollback = function() {
-  return jspb.Message.getField(this, 10) != null;
+proto.sqlrpc.v1.CheckpointRequest.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
-
 /**
- * Oneof group definitions for this message. Each group defines the field
- * numbers belonging to that group. When of these fields' value is set, all
- * other fields in the group are cleared. During deserialization, if multiple
- * fields are encountered for a group, only the last value seen will be kept.
- * @private {!Array<!Array<number>>}
- * @const
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.CheckpointRequest} returns this
  */
-proto.sqlrpc.v1.TransactionResponse.oneofGroups_ = [[1,2,3,4,5,7,8,9,11,10]];
+proto.sqlrpc.v1.CheckpointRequest.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
 
 /**
- * @enum {number}
+ * optional CheckpointMode mode = 2;
+ * @return {!proto.sqlrpc.v1.CheckpointMode}
  */
-proto.sqlrpc.v1.TransactionResponse.ResponseCase = {
-  RESPONSE_NOT_SET: 0,
-  BEGIN: 1,
-  QUERY_RESULT: 2,
-  STREAM_RESULT: 3,
-  TYPED_QUERY_RESULT: 4,
-  TYPED_STREAM_RESULT: 5,
-  SAVEPOINT: 7,
-  COMMIT: 8,
-  ROLLBACK: 9,
-  EXEC_RESULT: 11,
-  ERROR: 10
+proto.sqlrpc.v1.CheckpointRequest.prototype.getMode = function() {
+  return /** @type {!proto.sqlrpc.v1.CheckpointMode} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
+
 /**
- * @return {proto.sqlrpc.v1.TransactionResponse.ResponseCase}
+ * @param {!proto.sqlrpc.v1.CheckpointMode} value
+ * @return {!proto.sqlrpc.v1.CheckpointRequest} returns this
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.getResponseCase = function() {
-  return /** @type {proto.sqlrpc.v1.TransactionResponse.ResponseCase} */(jspb.Message.computeOneofCase(this, proto.sqlrpc.v1.TransactionResponse.oneofGroups_[0]));
+proto.sqlrpc.v1.CheckpointRequest.prototype.setMode = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
 };
 
 
 
+
+
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -7363,8 +13367,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.TransactionResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.CheckpointResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.CheckpointResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -7373,22 +13377,17 @@ proto.sqlrpc.v1.TransactionResponse.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.TransactionResponse} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.CheckpointResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TransactionResponse.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.CheckpointResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-begin: (f = msg.getBegin()) && proto.sqlrpc.v1.BeginResponse.toObject(includeInstance, f),
-queryResult: (f = msg.getQueryResult()) && proto.sqlrpc.v1.QueryResult.toObject(includeInstance, f),
-streamResult: (f = msg.getStreamResult()) && proto.sqlrpc.v1.QueryResponse.toObject(includeInstance, f),
-typedQueryResult: (f = msg.getTypedQueryResult()) && proto.sqlrpc.v1.TypedQueryResult.toObject(includeInstance, f),
-typedStreamResult: (f = msg.getTypedStreamResult()) && proto.sqlrpc.v1.TypedQueryResponse.toObject(includeInstance, f),
-savepoint: (f = msg.getSavepoint()) && proto.sqlrpc.v1.SavepointResponse.toObject(includeInstance, f),
-commit: (f = msg.getCommit()) && proto.sqlrpc.v1.CommitResponse.toObject(includeInstance, f),
-rollback: (f = msg.getRollback()) && proto.sqlrpc.v1.RollbackResponse.toObject(includeInstance, f),
-execResult: (f = msg.getExecResult()) && proto.sqlrpc.v1.ExecResponse.toObject(includeInstance, f),
-error: (f = msg.getError()) && proto.sqlrpc.v1.ErrorResponse.toObject(includeInstance, f)
+success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+message: jspb.Message.getFieldWithDefault(msg, 2, ""),
+busyCheckpoints: jspb.Message.getFieldWithDefault(msg, 3, 0),
+logCheckpoints: jspb.Message.getFieldWithDefault(msg, 4, 0),
+checkpointedPages: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -7402,23 +13401,23 @@ error: (f = msg.getError()) && proto.sqlrpc.v1.ErrorResponse.toObject(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.TransactionResponse}
+ * @return {!proto.sqlrpc.v1.CheckpointResponse}
  */
-proto.sqlrpc.v1.TransactionResponse.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.CheckpointResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.TransactionResponse;
-  return proto.sqlrpc.v1.TransactionResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.CheckpointResponse;
+  return proto.sqlrpc.v1.CheckpointResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.TransactionResponse} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.CheckpointResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.TransactionResponse}
+ * @return {!proto.sqlrpc.v1.CheckpointResponse}
  */
-proto.sqlrpc.v1.TransactionResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.CheckpointResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7426,54 +13425,24 @@ proto.sqlrpc.v1.TransactionResponse.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.sqlrpc.v1.BeginResponse;
-      reader.readMessage(value,proto.sqlrpc.v1.BeginResponse.deserializeBinaryFromReader);
-      msg.setBegin(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
       break;
     case 2:
-      var value = new proto.sqlrpc.v1.QueryResult;
-      reader.readMessage(value,proto.sqlrpc.v1.QueryResult.deserializeBinaryFromReader);
-      msg.setQueryResult(value);
-      break;
-    case 3:
-      var value = new proto.sqlrpc.v1.QueryResponse;
-      reader.readMessage(value,proto.sqlrpc.v1.QueryResponse.deserializeBinaryFromReader);
-      msg.setStreamResult(value);
-      break;
-    case 4:
-      var value = new proto.sqlrpc.v1.TypedQueryResult;
-      reader.readMessage(value,proto.sqlrpc.v1.TypedQueryResult.deserializeBinaryFromReader);
-      msg.setTypedQueryResult(value);
-      break;
-    case 5:
-      var value = new proto.sqlrpc.v1.TypedQueryResponse;
-      reader.readMessage(value,proto.sqlrpc.v1.TypedQueryResponse.deserializeBinaryFromReader);
-      msg.setTypedStreamResult(value);
-      break;
-    case 7:
-      var value = new proto.sqlrpc.v1.SavepointResponse;
-      reader.readMessage(value,proto.sqlrpc.v1.SavepointResponse.deserializeBinaryFromReader);
-      msg.setSavepoint(value);
-      break;
-    case 8:
-      var value = new proto.sqlrpc.v1.CommitResponse;
-      reader.readMessage(value,proto.sqlrpc.v1.CommitResponse.deserializeBinaryFromReader);
-      msg.setCommit(value);
-      break;
-    case 9:
-      var value = new proto.sqlrpc.v1.RollbackResponse;
-      reader.readMessage(value,proto.sqlrpc.v1.RollbackResponse.deserializeBinaryFromReader);
-      msg.setRollback(value);
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setMessage(value);
       break;
-    case 11:
-      var value = new proto.sqlrpc.v1.ExecResponse;
-      reader.readMessage(value,proto.sqlrpc.v1.ExecResponse.deserializeBinaryFromReader);
-      msg.setExecResult(value);
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setBusyCheckpoints(value);
       break;
-    case 10:
-      var value = new proto.sqlrpc.v1.ErrorResponse;
-      reader.readMessage(value,proto.sqlrpc.v1.ErrorResponse.deserializeBinaryFromReader);
-      msg.setError(value);
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLogCheckpoints(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCheckpointedPages(value);
       break;
     default:
       reader.skipField();
@@ -7488,9 +13457,9 @@ proto.sqlrpc.v1.TransactionResponse.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.CheckpointResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.TransactionResponse.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.CheckpointResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7498,231 +13467,306 @@ proto.sqlrpc.v1.TransactionResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.TransactionResponse} message
+ * @param {!proto.sqlrpc.v1.CheckpointResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TransactionResponse.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.CheckpointResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getBegin();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
       1,
-      f,
-      proto.sqlrpc.v1.BeginResponse.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getQueryResult();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
       2,
-      f,
-      proto.sqlrpc.v1.QueryResult.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getStreamResult();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getBusyCheckpoints();
+  if (f !== 0) {
+    writer.writeInt64(
       3,
-      f,
-      proto.sqlrpc.v1.QueryResponse.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getTypedQueryResult();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getLogCheckpoints();
+  if (f !== 0) {
+    writer.writeInt64(
       4,
-      f,
-      proto.sqlrpc.v1.TypedQueryResult.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getTypedStreamResult();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getCheckpointedPages();
+  if (f !== 0) {
+    writer.writeInt64(
       5,
-      f,
-      proto.sqlrpc.v1.TypedQueryResponse.serializeBinaryToWriter
-    );
-  }
-  f = message.getSavepoint();
-  if (f != null) {
-    writer.writeMessage(
-      7,
-      f,
-      proto.sqlrpc.v1.SavepointResponse.serializeBinaryToWriter
-    );
-  }
-  f = message.getCommit();
-  if (f != null) {
-    writer.writeMessage(
-      8,
-      f,
-      proto.sqlrpc.v1.CommitResponse.serializeBinaryToWriter
-    );
-  }
-  f = message.getRollback();
-  if (f != null) {
-    writer.writeMessage(
-      9,
-      f,
-      proto.sqlrpc.v1.RollbackResponse.serializeBinaryToWriter
-    );
-  }
-  f = message.getExecResult();
-  if (f != null) {
-    writer.writeMessage(
-      11,
-      f,
-      proto.sqlrpc.v1.ExecResponse.serializeBinaryToWriter
-    );
-  }
-  f = message.getError();
-  if (f != null) {
-    writer.writeMessage(
-      10,
-      f,
-      proto.sqlrpc.v1.ErrorResponse.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * optional BeginResponse begin = 1;
- * @return {?proto.sqlrpc.v1.BeginResponse}
+ * optional bool success = 1;
+ * @return {boolean}
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.getBegin = function() {
-  return /** @type{?proto.sqlrpc.v1.BeginResponse} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.BeginResponse, 1));
+proto.sqlrpc.v1.CheckpointResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.BeginResponse|undefined} value
- * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
-*/
-proto.sqlrpc.v1.TransactionResponse.prototype.setBegin = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 1, proto.sqlrpc.v1.TransactionResponse.oneofGroups_[0], value);
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.CheckpointResponse} returns this
+ */
+proto.sqlrpc.v1.CheckpointResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
+ * optional string message = 2;
+ * @return {string}
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.clearBegin = function() {
-  return this.setBegin(undefined);
+proto.sqlrpc.v1.CheckpointResponse.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.CheckpointResponse} returns this
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.hasBegin = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.sqlrpc.v1.CheckpointResponse.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional QueryResult query_result = 2;
- * @return {?proto.sqlrpc.v1.QueryResult}
+ * optional int64 busy_checkpoints = 3;
+ * @return {number}
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.getQueryResult = function() {
-  return /** @type{?proto.sqlrpc.v1.QueryResult} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.QueryResult, 2));
+proto.sqlrpc.v1.CheckpointResponse.prototype.getBusyCheckpoints = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.QueryResult|undefined} value
- * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
-*/
-proto.sqlrpc.v1.TransactionResponse.prototype.setQueryResult = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 2, proto.sqlrpc.v1.TransactionResponse.oneofGroups_[0], value);
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.CheckpointResponse} returns this
+ */
+proto.sqlrpc.v1.CheckpointResponse.prototype.setBusyCheckpoints = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
+ * optional int64 log_checkpoints = 4;
+ * @return {number}
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.clearQueryResult = function() {
-  return this.setQueryResult(undefined);
+proto.sqlrpc.v1.CheckpointResponse.prototype.getLogCheckpoints = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.CheckpointResponse} returns this
+ */
+proto.sqlrpc.v1.CheckpointResponse.prototype.setLogCheckpoints = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional int64 checkpointed_pages = 5;
+ * @return {number}
+ */
+proto.sqlrpc.v1.CheckpointResponse.prototype.getCheckpointedPages = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.CheckpointResponse} returns this
+ */
+proto.sqlrpc.v1.CheckpointResponse.prototype.setCheckpointedPages = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.IntegrityCheckRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.IntegrityCheckRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.IntegrityCheckRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.IntegrityCheckRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+database: jspb.Message.getFieldWithDefault(msg, 1, ""),
+maxErrors: (f = jspb.Message.getField(msg, 2)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.IntegrityCheckRequest}
+ */
+proto.sqlrpc.v1.IntegrityCheckRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.IntegrityCheckRequest;
+  return proto.sqlrpc.v1.IntegrityCheckRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.IntegrityCheckRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.IntegrityCheckRequest}
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.hasQueryResult = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.sqlrpc.v1.IntegrityCheckRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxErrors(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional QueryResponse stream_result = 3;
- * @return {?proto.sqlrpc.v1.QueryResponse}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.getStreamResult = function() {
-  return /** @type{?proto.sqlrpc.v1.QueryResponse} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.QueryResponse, 3));
+proto.sqlrpc.v1.IntegrityCheckRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.IntegrityCheckRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.QueryResponse|undefined} value
- * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
-*/
-proto.sqlrpc.v1.TransactionResponse.prototype.setStreamResult = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 3, proto.sqlrpc.v1.TransactionResponse.oneofGroups_[0], value);
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.IntegrityCheckRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.IntegrityCheckRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
+ * optional string database = 1;
+ * @return {string}
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.clearStreamResult = function() {
-  return this.setStreamResult(undefined);
+proto.sqlrpc.v1.IntegrityCheckRequest.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.IntegrityCheckRequest} returns this
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.hasStreamResult = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.sqlrpc.v1.IntegrityCheckRequest.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional TypedQueryResult typed_query_result = 4;
- * @return {?proto.sqlrpc.v1.TypedQueryResult}
+ * optional int32 max_errors = 2;
+ * @return {number}
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.getTypedQueryResult = function() {
-  return /** @type{?proto.sqlrpc.v1.TypedQueryResult} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.TypedQueryResult, 4));
+proto.sqlrpc.v1.IntegrityCheckRequest.prototype.getMaxErrors = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.TypedQueryResult|undefined} value
- * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
-*/
-proto.sqlrpc.v1.TransactionResponse.prototype.setTypedQueryResult = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 4, proto.sqlrpc.v1.TransactionResponse.oneofGroups_[0], value);
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.IntegrityCheckRequest} returns this
+ */
+proto.sqlrpc.v1.IntegrityCheckRequest.prototype.setMaxErrors = function(value) {
+  return jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
+ * Clears the field making it undefined.
+ * @return {!proto.sqlrpc.v1.IntegrityCheckRequest} returns this
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.clearTypedQueryResult = function() {
-  return this.setTypedQueryResult(undefined);
+proto.sqlrpc.v1.IntegrityCheckRequest.prototype.clearMaxErrors = function() {
+  return jspb.Message.setField(this, 2, undefined);
 };
 
 
@@ -7730,390 +13774,525 @@ proto.sqlrpc.v1.TransactionResponse.prototype.clearTypedQueryResult = function()
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.hasTypedQueryResult = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.sqlrpc.v1.IntegrityCheckRequest.prototype.hasMaxErrors = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
+
 /**
- * optional TypedQueryResponse typed_stream_result = 5;
- * @return {?proto.sqlrpc.v1.TypedQueryResponse}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.getTypedStreamResult = function() {
-  return /** @type{?proto.sqlrpc.v1.TypedQueryResponse} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.TypedQueryResponse, 5));
-};
+proto.sqlrpc.v1.IntegrityCheckResponse.repeatedFields_ = [3];
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {?proto.sqlrpc.v1.TypedQueryResponse|undefined} value
- * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
-*/
-proto.sqlrpc.v1.TransactionResponse.prototype.setTypedStreamResult = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 5, proto.sqlrpc.v1.TransactionResponse.oneofGroups_[0], value);
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.IntegrityCheckResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.IntegrityCheckResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.IntegrityCheckResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.clearTypedStreamResult = function() {
-  return this.setTypedStreamResult(undefined);
+proto.sqlrpc.v1.IntegrityCheckResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+message: jspb.Message.getFieldWithDefault(msg, 2, ""),
+errorsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.IntegrityCheckResponse}
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.hasTypedStreamResult = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.sqlrpc.v1.IntegrityCheckResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.IntegrityCheckResponse;
+  return proto.sqlrpc.v1.IntegrityCheckResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional SavepointResponse savepoint = 7;
- * @return {?proto.sqlrpc.v1.SavepointResponse}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.IntegrityCheckResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.IntegrityCheckResponse}
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.getSavepoint = function() {
-  return /** @type{?proto.sqlrpc.v1.SavepointResponse} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.SavepointResponse, 7));
+proto.sqlrpc.v1.IntegrityCheckResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setMessage(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.addErrors(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.SavepointResponse|undefined} value
- * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
-*/
-proto.sqlrpc.v1.TransactionResponse.prototype.setSavepoint = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 7, proto.sqlrpc.v1.TransactionResponse.oneofGroups_[0], value);
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.IntegrityCheckResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.IntegrityCheckResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.IntegrityCheckResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.clearSavepoint = function() {
-  return this.setSavepoint(undefined);
+proto.sqlrpc.v1.IntegrityCheckResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getErrorsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * Returns whether this field is set.
+ * optional bool success = 1;
  * @return {boolean}
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.hasSavepoint = function() {
-  return jspb.Message.getField(this, 7) != null;
+proto.sqlrpc.v1.IntegrityCheckResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * optional CommitResponse commit = 8;
- * @return {?proto.sqlrpc.v1.CommitResponse}
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.IntegrityCheckResponse} returns this
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.getCommit = function() {
-  return /** @type{?proto.sqlrpc.v1.CommitResponse} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.CommitResponse, 8));
+proto.sqlrpc.v1.IntegrityCheckResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.CommitResponse|undefined} value
- * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
-*/
-proto.sqlrpc.v1.TransactionResponse.prototype.setCommit = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 8, proto.sqlrpc.v1.TransactionResponse.oneofGroups_[0], value);
+ * optional string message = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.IntegrityCheckResponse.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.IntegrityCheckResponse} returns this
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.clearCommit = function() {
-  return this.setCommit(undefined);
+proto.sqlrpc.v1.IntegrityCheckResponse.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * repeated string errors = 3;
+ * @return {!Array<string>}
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.hasCommit = function() {
-  return jspb.Message.getField(this, 8) != null;
+proto.sqlrpc.v1.IntegrityCheckResponse.prototype.getErrorsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
 };
 
 
 /**
- * optional RollbackResponse rollback = 9;
- * @return {?proto.sqlrpc.v1.RollbackResponse}
+ * @param {!Array<string>} value
+ * @return {!proto.sqlrpc.v1.IntegrityCheckResponse} returns this
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.getRollback = function() {
-  return /** @type{?proto.sqlrpc.v1.RollbackResponse} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.RollbackResponse, 9));
+proto.sqlrpc.v1.IntegrityCheckResponse.prototype.setErrorsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.RollbackResponse|undefined} value
- * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
-*/
-proto.sqlrpc.v1.TransactionResponse.prototype.setRollback = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 9, proto.sqlrpc.v1.TransactionResponse.oneofGroups_[0], value);
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.IntegrityCheckResponse} returns this
+ */
+proto.sqlrpc.v1.IntegrityCheckResponse.prototype.addErrors = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.IntegrityCheckResponse} returns this
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.clearRollback = function() {
-  return this.setRollback(undefined);
+proto.sqlrpc.v1.IntegrityCheckResponse.prototype.clearErrorsList = function() {
+  return this.setErrorsList([]);
 };
 
 
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.sqlrpc.v1.TransactionResponse.prototype.hasRollback = function() {
-  return jspb.Message.getField(this, 9) != null;
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional ExecResponse exec_result = 11;
- * @return {?proto.sqlrpc.v1.ExecResponse}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.getExecResult = function() {
-  return /** @type{?proto.sqlrpc.v1.ExecResponse} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.ExecResponse, 11));
+proto.sqlrpc.v1.BackupInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.BackupInfo.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.ExecResponse|undefined} value
- * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
-*/
-proto.sqlrpc.v1.TransactionResponse.prototype.setExecResult = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 11, proto.sqlrpc.v1.TransactionResponse.oneofGroups_[0], value);
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.BackupInfo} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.BackupInfo.toObject = function(includeInstance, msg) {
+  var f, obj = {
+backupId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+database: jspb.Message.getFieldWithDefault(msg, 2, ""),
+createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+sizeBytes: jspb.Message.getFieldWithDefault(msg, 4, 0),
+compression: jspb.Message.getFieldWithDefault(msg, 5, 0),
+sha256: jspb.Message.getFieldWithDefault(msg, 6, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.BackupInfo}
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.clearExecResult = function() {
-  return this.setExecResult(undefined);
+proto.sqlrpc.v1.BackupInfo.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.BackupInfo;
+  return proto.sqlrpc.v1.BackupInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.BackupInfo} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.BackupInfo}
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.hasExecResult = function() {
-  return jspb.Message.getField(this, 11) != null;
+proto.sqlrpc.v1.BackupInfo.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setBackupId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDatabase(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreatedAt(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setSizeBytes(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.sqlrpc.v1.BackupCompression} */ (reader.readEnum());
+      msg.setCompression(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setSha256(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional ErrorResponse error = 10;
- * @return {?proto.sqlrpc.v1.ErrorResponse}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.getError = function() {
-  return /** @type{?proto.sqlrpc.v1.ErrorResponse} */ (
-    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.ErrorResponse, 10));
+proto.sqlrpc.v1.BackupInfo.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.BackupInfo.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {?proto.sqlrpc.v1.ErrorResponse|undefined} value
- * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
-*/
-proto.sqlrpc.v1.TransactionResponse.prototype.setError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 10, proto.sqlrpc.v1.TransactionResponse.oneofGroups_[0], value);
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.BackupInfo} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.BackupInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getBackupId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDatabase();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getCreatedAt();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getSizeBytes();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+  f = message.getCompression();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+  f = message.getSha256();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.sqlrpc.v1.TransactionResponse} returns this
+ * optional string backup_id = 1;
+ * @return {string}
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.clearError = function() {
-  return this.setError(undefined);
+proto.sqlrpc.v1.BackupInfo.prototype.getBackupId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.BackupInfo} returns this
  */
-proto.sqlrpc.v1.TransactionResponse.prototype.hasError = function() {
-  return jspb.Message.getField(this, 10) != null;
+proto.sqlrpc.v1.BackupInfo.prototype.setBackupId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * optional string database = 2;
+ * @return {string}
  */
-proto.sqlrpc.v1.ExplainResponse.repeatedFields_ = [1];
-
+proto.sqlrpc.v1.BackupInfo.prototype.getDatabase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
-/**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.BackupInfo} returns this
  */
-proto.sqlrpc.v1.ExplainResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.ExplainResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.BackupInfo.prototype.setDatabase = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.ExplainResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional google.protobuf.Timestamp created_at = 3;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.sqlrpc.v1.ExplainResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-nodesList: jspb.Message.toObjectList(msg.getNodesList(),
-    sqlrpc_v1_types_pb.QueryPlanNode.toObject, includeInstance)
-  };
+proto.sqlrpc.v1.BackupInfo.prototype.getCreatedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.sqlrpc.v1.BackupInfo} returns this
+*/
+proto.sqlrpc.v1.BackupInfo.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.ExplainResponse}
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.BackupInfo} returns this
  */
-proto.sqlrpc.v1.ExplainResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.ExplainResponse;
-  return proto.sqlrpc.v1.ExplainResponse.deserializeBinaryFromReader(msg, reader);
+proto.sqlrpc.v1.BackupInfo.prototype.clearCreatedAt = function() {
+  return this.setCreatedAt(undefined);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.ExplainResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.ExplainResponse}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.ExplainResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = new sqlrpc_v1_types_pb.QueryPlanNode;
-      reader.readMessage(value,sqlrpc_v1_types_pb.QueryPlanNode.deserializeBinaryFromReader);
-      msg.addNodes(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.sqlrpc.v1.BackupInfo.prototype.hasCreatedAt = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional int64 size_bytes = 4;
+ * @return {number}
  */
-proto.sqlrpc.v1.ExplainResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.ExplainResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.sqlrpc.v1.BackupInfo.prototype.getSizeBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.ExplainResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.BackupInfo} returns this
  */
-proto.sqlrpc.v1.ExplainResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getNodesList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      1,
-      f,
-      sqlrpc_v1_types_pb.QueryPlanNode.serializeBinaryToWriter
-    );
-  }
+proto.sqlrpc.v1.BackupInfo.prototype.setSizeBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * repeated QueryPlanNode nodes = 1;
- * @return {!Array<!proto.sqlrpc.v1.QueryPlanNode>}
+ * optional BackupCompression compression = 5;
+ * @return {!proto.sqlrpc.v1.BackupCompression}
  */
-proto.sqlrpc.v1.ExplainResponse.prototype.getNodesList = function() {
-  return /** @type{!Array<!proto.sqlrpc.v1.QueryPlanNode>} */ (
-    jspb.Message.getRepeatedWrapperField(this, sqlrpc_v1_types_pb.QueryPlanNode, 1));
+proto.sqlrpc.v1.BackupInfo.prototype.getCompression = function() {
+  return /** @type {!proto.sqlrpc.v1.BackupCompression} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * @param {!Array<!proto.sqlrpc.v1.QueryPlanNode>} value
- * @return {!proto.sqlrpc.v1.ExplainResponse} returns this
-*/
-proto.sqlrpc.v1.ExplainResponse.prototype.setNodesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {!proto.sqlrpc.v1.BackupCompression} value
+ * @return {!proto.sqlrpc.v1.BackupInfo} returns this
+ */
+proto.sqlrpc.v1.BackupInfo.prototype.setCompression = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
 };
 
 
 /**
- * @param {!proto.sqlrpc.v1.QueryPlanNode=} opt_value
- * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.QueryPlanNode}
+ * optional string sha256 = 6;
+ * @return {string}
  */
-proto.sqlrpc.v1.ExplainResponse.prototype.addNodes = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.sqlrpc.v1.QueryPlanNode, opt_index);
+proto.sqlrpc.v1.BackupInfo.prototype.getSha256 = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.ExplainResponse} returns this
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.BackupInfo} returns this
  */
-proto.sqlrpc.v1.ExplainResponse.prototype.clearNodesList = function() {
-  return this.setNodesList([]);
+proto.sqlrpc.v1.BackupInfo.prototype.setSha256 = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
@@ -8133,8 +14312,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.ListTablesRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.ListTablesRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.BackupDatabaseRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.BackupDatabaseRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8143,13 +14322,14 @@ proto.sqlrpc.v1.ListTablesRequest.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.ListTablesRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.BackupDatabaseRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.ListTablesRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.BackupDatabaseRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-database: jspb.Message.getFieldWithDefault(msg, 1, "")
+database: jspb.Message.getFieldWithDefault(msg, 1, ""),
+compression: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -8163,23 +14343,23 @@ database: jspb.Message.getFieldWithDefault(msg, 1, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.ListTablesRequest}
+ * @return {!proto.sqlrpc.v1.BackupDatabaseRequest}
  */
-proto.sqlrpc.v1.ListTablesRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.BackupDatabaseRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.ListTablesRequest;
-  return proto.sqlrpc.v1.ListTablesRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.BackupDatabaseRequest;
+  return proto.sqlrpc.v1.BackupDatabaseRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.ListTablesRequest} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.BackupDatabaseRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.ListTablesRequest}
+ * @return {!proto.sqlrpc.v1.BackupDatabaseRequest}
  */
-proto.sqlrpc.v1.ListTablesRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.BackupDatabaseRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8190,6 +14370,10 @@ proto.sqlrpc.v1.ListTablesRequest.deserializeBinaryFromReader = function(msg, re
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
       msg.setDatabase(value);
       break;
+    case 2:
+      var value = /** @type {!proto.sqlrpc.v1.BackupCompression} */ (reader.readEnum());
+      msg.setCompression(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -8203,9 +14387,9 @@ proto.sqlrpc.v1.ListTablesRequest.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.ListTablesRequest.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.BackupDatabaseRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.ListTablesRequest.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.BackupDatabaseRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8213,11 +14397,11 @@ proto.sqlrpc.v1.ListTablesRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.ListTablesRequest} message
+ * @param {!proto.sqlrpc.v1.BackupDatabaseRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.ListTablesRequest.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.BackupDatabaseRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getDatabase();
   if (f.length > 0) {
@@ -8226,6 +14410,13 @@ proto.sqlrpc.v1.ListTablesRequest.serializeBinaryToWriter = function(message, wr
       f
     );
   }
+  f = message.getCompression();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
 };
 
 
@@ -8233,27 +14424,38 @@ proto.sqlrpc.v1.ListTablesRequest.serializeBinaryToWriter = function(message, wr
  * optional string database = 1;
  * @return {string}
  */
-proto.sqlrpc.v1.ListTablesRequest.prototype.getDatabase = function() {
+proto.sqlrpc.v1.BackupDatabaseRequest.prototype.getDatabase = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.ListTablesRequest} returns this
+ * @return {!proto.sqlrpc.v1.BackupDatabaseRequest} returns this
  */
-proto.sqlrpc.v1.ListTablesRequest.prototype.setDatabase = function(value) {
+proto.sqlrpc.v1.BackupDatabaseRequest.prototype.setDatabase = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional BackupCompression compression = 2;
+ * @return {!proto.sqlrpc.v1.BackupCompression}
+ */
+proto.sqlrpc.v1.BackupDatabaseRequest.prototype.getCompression = function() {
+  return /** @type {!proto.sqlrpc.v1.BackupCompression} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {!proto.sqlrpc.v1.BackupCompression} value
+ * @return {!proto.sqlrpc.v1.BackupDatabaseRequest} returns this
  */
-proto.sqlrpc.v1.ListTablesResponse.repeatedFields_ = [1];
+proto.sqlrpc.v1.BackupDatabaseRequest.prototype.setCompression = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
 
 
 
@@ -8270,8 +14472,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.ListTablesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.ListTablesResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.BackupDatabaseResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.BackupDatabaseResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -8280,13 +14482,13 @@ proto.sqlrpc.v1.ListTablesResponse.prototype.toObject = function(opt_includeInst
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.ListTablesResponse} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.BackupDatabaseResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.ListTablesResponse.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.BackupDatabaseResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-tableNamesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+backup: (f = msg.getBackup()) && proto.sqlrpc.v1.BackupInfo.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -8300,23 +14502,23 @@ tableNamesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.ListTablesResponse}
+ * @return {!proto.sqlrpc.v1.BackupDatabaseResponse}
  */
-proto.sqlrpc.v1.ListTablesResponse.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.BackupDatabaseResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.ListTablesResponse;
-  return proto.sqlrpc.v1.ListTablesResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.BackupDatabaseResponse;
+  return proto.sqlrpc.v1.BackupDatabaseResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.ListTablesResponse} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.BackupDatabaseResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.ListTablesResponse}
+ * @return {!proto.sqlrpc.v1.BackupDatabaseResponse}
  */
-proto.sqlrpc.v1.ListTablesResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.BackupDatabaseResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8324,8 +14526,9 @@ proto.sqlrpc.v1.ListTablesResponse.deserializeBinaryFromReader = function(msg, r
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.addTableNames(value);
+      var value = new proto.sqlrpc.v1.BackupInfo;
+      reader.readMessage(value,proto.sqlrpc.v1.BackupInfo.deserializeBinaryFromReader);
+      msg.setBackup(value);
       break;
     default:
       reader.skipField();
@@ -8340,9 +14543,9 @@ proto.sqlrpc.v1.ListTablesResponse.deserializeBinaryFromReader = function(msg, r
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.ListTablesResponse.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.BackupDatabaseResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.ListTablesResponse.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.BackupDatabaseResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8350,56 +14553,57 @@ proto.sqlrpc.v1.ListTablesResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.ListTablesResponse} message
+ * @param {!proto.sqlrpc.v1.BackupDatabaseResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.ListTablesResponse.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.BackupDatabaseResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTableNamesList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
+  f = message.getBackup();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.sqlrpc.v1.BackupInfo.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated string table_names = 1;
- * @return {!Array<string>}
+ * optional BackupInfo backup = 1;
+ * @return {?proto.sqlrpc.v1.BackupInfo}
  */
-proto.sqlrpc.v1.ListTablesResponse.prototype.getTableNamesList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.sqlrpc.v1.BackupDatabaseResponse.prototype.getBackup = function() {
+  return /** @type{?proto.sqlrpc.v1.BackupInfo} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.BackupInfo, 1));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.sqlrpc.v1.ListTablesResponse} returns this
- */
-proto.sqlrpc.v1.ListTablesResponse.prototype.setTableNamesList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+ * @param {?proto.sqlrpc.v1.BackupInfo|undefined} value
+ * @return {!proto.sqlrpc.v1.BackupDatabaseResponse} returns this
+*/
+proto.sqlrpc.v1.BackupDatabaseResponse.prototype.setBackup = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.ListTablesResponse} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.BackupDatabaseResponse} returns this
  */
-proto.sqlrpc.v1.ListTablesResponse.prototype.addTableNames = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.sqlrpc.v1.BackupDatabaseResponse.prototype.clearBackup = function() {
+  return this.setBackup(undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.ListTablesResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.ListTablesResponse.prototype.clearTableNamesList = function() {
-  return this.setTableNamesList([]);
+proto.sqlrpc.v1.BackupDatabaseResponse.prototype.hasBackup = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
@@ -8419,8 +14623,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.GetTableSchemaRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.GetTableSchemaRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ListBackupsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ListBackupsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8429,14 +14633,13 @@ proto.sqlrpc.v1.GetTableSchemaRequest.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.GetTableSchemaRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.ListBackupsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.GetTableSchemaRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.ListBackupsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-database: jspb.Message.getFieldWithDefault(msg, 1, ""),
-tableName: jspb.Message.getFieldWithDefault(msg, 2, "")
+database: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -8450,23 +14653,23 @@ tableName: jspb.Message.getFieldWithDefault(msg, 2, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.GetTableSchemaRequest}
+ * @return {!proto.sqlrpc.v1.ListBackupsRequest}
  */
-proto.sqlrpc.v1.GetTableSchemaRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.ListBackupsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.GetTableSchemaRequest;
-  return proto.sqlrpc.v1.GetTableSchemaRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.ListBackupsRequest;
+  return proto.sqlrpc.v1.ListBackupsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.GetTableSchemaRequest} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.ListBackupsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.GetTableSchemaRequest}
+ * @return {!proto.sqlrpc.v1.ListBackupsRequest}
  */
-proto.sqlrpc.v1.GetTableSchemaRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.ListBackupsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8477,10 +14680,6 @@ proto.sqlrpc.v1.GetTableSchemaRequest.deserializeBinaryFromReader = function(msg
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
       msg.setDatabase(value);
       break;
-    case 2:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setTableName(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -8494,9 +14693,9 @@ proto.sqlrpc.v1.GetTableSchemaRequest.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.GetTableSchemaRequest.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.ListBackupsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.GetTableSchemaRequest.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.ListBackupsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8504,11 +14703,11 @@ proto.sqlrpc.v1.GetTableSchemaRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.GetTableSchemaRequest} message
+ * @param {!proto.sqlrpc.v1.ListBackupsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.GetTableSchemaRequest.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.ListBackupsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getDatabase();
   if (f.length > 0) {
@@ -8517,13 +14716,6 @@ proto.sqlrpc.v1.GetTableSchemaRequest.serializeBinaryToWriter = function(message
       f
     );
   }
-  f = message.getTableName();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
 };
 
 
@@ -8531,38 +14723,27 @@ proto.sqlrpc.v1.GetTableSchemaRequest.serializeBinaryToWriter = function(message
  * optional string database = 1;
  * @return {string}
  */
-proto.sqlrpc.v1.GetTableSchemaRequest.prototype.getDatabase = function() {
+proto.sqlrpc.v1.ListBackupsRequest.prototype.getDatabase = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.GetTableSchemaRequest} returns this
+ * @return {!proto.sqlrpc.v1.ListBackupsRequest} returns this
  */
-proto.sqlrpc.v1.GetTableSchemaRequest.prototype.setDatabase = function(value) {
+proto.sqlrpc.v1.ListBackupsRequest.prototype.setDatabase = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * optional string table_name = 2;
- * @return {string}
- */
-proto.sqlrpc.v1.GetTableSchemaRequest.prototype.getTableName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
 
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.GetTableSchemaRequest} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.sqlrpc.v1.GetTableSchemaRequest.prototype.setTableName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
+proto.sqlrpc.v1.ListBackupsResponse.repeatedFields_ = [1];
 
 
 
@@ -8579,8 +14760,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.GetDatabaseSchemaRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.GetDatabaseSchemaRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.ListBackupsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.ListBackupsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -8589,13 +14770,14 @@ proto.sqlrpc.v1.GetDatabaseSchemaRequest.prototype.toObject = function(opt_inclu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.GetDatabaseSchemaRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.ListBackupsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.GetDatabaseSchemaRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.ListBackupsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-database: jspb.Message.getFieldWithDefault(msg, 1, "")
+backupsList: jspb.Message.toObjectList(msg.getBackupsList(),
+    proto.sqlrpc.v1.BackupInfo.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -8609,23 +14791,23 @@ database: jspb.Message.getFieldWithDefault(msg, 1, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.GetDatabaseSchemaRequest}
+ * @return {!proto.sqlrpc.v1.ListBackupsResponse}
  */
-proto.sqlrpc.v1.GetDatabaseSchemaRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.ListBackupsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.GetDatabaseSchemaRequest;
-  return proto.sqlrpc.v1.GetDatabaseSchemaRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.ListBackupsResponse;
+  return proto.sqlrpc.v1.ListBackupsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.GetDatabaseSchemaRequest} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.ListBackupsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.GetDatabaseSchemaRequest}
+ * @return {!proto.sqlrpc.v1.ListBackupsResponse}
  */
-proto.sqlrpc.v1.GetDatabaseSchemaRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.ListBackupsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8633,8 +14815,9 @@ proto.sqlrpc.v1.GetDatabaseSchemaRequest.deserializeBinaryFromReader = function(
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setDatabase(value);
+      var value = new proto.sqlrpc.v1.BackupInfo;
+      reader.readMessage(value,proto.sqlrpc.v1.BackupInfo.deserializeBinaryFromReader);
+      msg.addBackups(value);
       break;
     default:
       reader.skipField();
@@ -8649,9 +14832,9 @@ proto.sqlrpc.v1.GetDatabaseSchemaRequest.deserializeBinaryFromReader = function(
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.GetDatabaseSchemaRequest.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.ListBackupsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.GetDatabaseSchemaRequest.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.ListBackupsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8659,37 +14842,58 @@ proto.sqlrpc.v1.GetDatabaseSchemaRequest.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.GetDatabaseSchemaRequest} message
+ * @param {!proto.sqlrpc.v1.ListBackupsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.GetDatabaseSchemaRequest.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.ListBackupsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getDatabase();
+  f = message.getBackupsList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
-      f
+      f,
+      proto.sqlrpc.v1.BackupInfo.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string database = 1;
- * @return {string}
+ * repeated BackupInfo backups = 1;
+ * @return {!Array<!proto.sqlrpc.v1.BackupInfo>}
  */
-proto.sqlrpc.v1.GetDatabaseSchemaRequest.prototype.getDatabase = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.sqlrpc.v1.ListBackupsResponse.prototype.getBackupsList = function() {
+  return /** @type{!Array<!proto.sqlrpc.v1.BackupInfo>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.sqlrpc.v1.BackupInfo, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.GetDatabaseSchemaRequest} returns this
+ * @param {!Array<!proto.sqlrpc.v1.BackupInfo>} value
+ * @return {!proto.sqlrpc.v1.ListBackupsResponse} returns this
+*/
+proto.sqlrpc.v1.ListBackupsResponse.prototype.setBackupsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.BackupInfo=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.BackupInfo}
  */
-proto.sqlrpc.v1.GetDatabaseSchemaRequest.prototype.setDatabase = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.sqlrpc.v1.ListBackupsResponse.prototype.addBackups = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.sqlrpc.v1.BackupInfo, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.ListBackupsResponse} returns this
+ */
+proto.sqlrpc.v1.ListBackupsResponse.prototype.clearBackupsList = function() {
+  return this.setBackupsList([]);
 };
 
 
@@ -8709,8 +14913,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.VacuumRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.VacuumRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.RestoreDatabaseRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.RestoreDatabaseRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8719,14 +14923,14 @@ proto.sqlrpc.v1.VacuumRequest.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.VacuumRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.RestoreDatabaseRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.VacuumRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.RestoreDatabaseRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
 database: jspb.Message.getFieldWithDefault(msg, 1, ""),
-intoFile: (f = jspb.Message.getField(msg, 2)) == null ? undefined : f
+backupId: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -8740,23 +14944,23 @@ intoFile: (f = jspb.Message.getField(msg, 2)) == null ? undefined : f
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.VacuumRequest}
+ * @return {!proto.sqlrpc.v1.RestoreDatabaseRequest}
  */
-proto.sqlrpc.v1.VacuumRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.RestoreDatabaseRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.VacuumRequest;
-  return proto.sqlrpc.v1.VacuumRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.RestoreDatabaseRequest;
+  return proto.sqlrpc.v1.RestoreDatabaseRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.VacuumRequest} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.RestoreDatabaseRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.VacuumRequest}
+ * @return {!proto.sqlrpc.v1.RestoreDatabaseRequest}
  */
-proto.sqlrpc.v1.VacuumRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.RestoreDatabaseRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8769,7 +14973,7 @@ proto.sqlrpc.v1.VacuumRequest.deserializeBinaryFromReader = function(msg, reader
       break;
     case 2:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setIntoFile(value);
+      msg.setBackupId(value);
       break;
     default:
       reader.skipField();
@@ -8784,9 +14988,9 @@ proto.sqlrpc.v1.VacuumRequest.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.VacuumRequest.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.RestoreDatabaseRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.VacuumRequest.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.RestoreDatabaseRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8794,11 +14998,11 @@ proto.sqlrpc.v1.VacuumRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.VacuumRequest} message
+ * @param {!proto.sqlrpc.v1.RestoreDatabaseRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.VacuumRequest.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.RestoreDatabaseRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getDatabase();
   if (f.length > 0) {
@@ -8807,8 +15011,8 @@ proto.sqlrpc.v1.VacuumRequest.serializeBinaryToWriter = function(message, writer
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
+  f = message.getBackupId();
+  if (f.length > 0) {
     writer.writeString(
       2,
       f
@@ -8821,53 +15025,35 @@ proto.sqlrpc.v1.VacuumRequest.serializeBinaryToWriter = function(message, writer
  * optional string database = 1;
  * @return {string}
  */
-proto.sqlrpc.v1.VacuumRequest.prototype.getDatabase = function() {
+proto.sqlrpc.v1.RestoreDatabaseRequest.prototype.getDatabase = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.VacuumRequest} returns this
+ * @return {!proto.sqlrpc.v1.RestoreDatabaseRequest} returns this
  */
-proto.sqlrpc.v1.VacuumRequest.prototype.setDatabase = function(value) {
+proto.sqlrpc.v1.RestoreDatabaseRequest.prototype.setDatabase = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string into_file = 2;
+ * optional string backup_id = 2;
  * @return {string}
  */
-proto.sqlrpc.v1.VacuumRequest.prototype.getIntoFile = function() {
+proto.sqlrpc.v1.RestoreDatabaseRequest.prototype.getBackupId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.VacuumRequest} returns this
- */
-proto.sqlrpc.v1.VacuumRequest.prototype.setIntoFile = function(value) {
-  return jspb.Message.setField(this, 2, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.sqlrpc.v1.VacuumRequest} returns this
- */
-proto.sqlrpc.v1.VacuumRequest.prototype.clearIntoFile = function() {
-  return jspb.Message.setField(this, 2, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
+ * @return {!proto.sqlrpc.v1.RestoreDatabaseRequest} returns this
  */
-proto.sqlrpc.v1.VacuumRequest.prototype.hasIntoFile = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.sqlrpc.v1.RestoreDatabaseRequest.prototype.setBackupId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -8887,8 +15073,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.VacuumResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.VacuumResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.RestoreDatabaseResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.RestoreDatabaseResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -8897,14 +15083,15 @@ proto.sqlrpc.v1.VacuumResponse.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.VacuumResponse} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.RestoreDatabaseResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.VacuumResponse.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.RestoreDatabaseResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
 success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
-message: jspb.Message.getFieldWithDefault(msg, 2, "")
+message: jspb.Message.getFieldWithDefault(msg, 2, ""),
+backup: (f = msg.getBackup()) && proto.sqlrpc.v1.BackupInfo.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -8918,23 +15105,23 @@ message: jspb.Message.getFieldWithDefault(msg, 2, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.VacuumResponse}
+ * @return {!proto.sqlrpc.v1.RestoreDatabaseResponse}
  */
-proto.sqlrpc.v1.VacuumResponse.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.RestoreDatabaseResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.VacuumResponse;
-  return proto.sqlrpc.v1.VacuumResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.RestoreDatabaseResponse;
+  return proto.sqlrpc.v1.RestoreDatabaseResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.VacuumResponse} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.RestoreDatabaseResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.VacuumResponse}
+ * @return {!proto.sqlrpc.v1.RestoreDatabaseResponse}
  */
-proto.sqlrpc.v1.VacuumResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.RestoreDatabaseResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8949,6 +15136,11 @@ proto.sqlrpc.v1.VacuumResponse.deserializeBinaryFromReader = function(msg, reade
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
       msg.setMessage(value);
       break;
+    case 3:
+      var value = new proto.sqlrpc.v1.BackupInfo;
+      reader.readMessage(value,proto.sqlrpc.v1.BackupInfo.deserializeBinaryFromReader);
+      msg.setBackup(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -8962,9 +15154,9 @@ proto.sqlrpc.v1.VacuumResponse.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.VacuumResponse.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.RestoreDatabaseResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.VacuumResponse.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.RestoreDatabaseResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8972,11 +15164,11 @@ proto.sqlrpc.v1.VacuumResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.VacuumResponse} message
+ * @param {!proto.sqlrpc.v1.RestoreDatabaseResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.VacuumResponse.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.RestoreDatabaseResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getSuccess();
   if (f) {
@@ -8992,6 +15184,14 @@ proto.sqlrpc.v1.VacuumResponse.serializeBinaryToWriter = function(message, write
       f
     );
   }
+  f = message.getBackup();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.sqlrpc.v1.BackupInfo.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -8999,16 +15199,16 @@ proto.sqlrpc.v1.VacuumResponse.serializeBinaryToWriter = function(message, write
  * optional bool success = 1;
  * @return {boolean}
  */
-proto.sqlrpc.v1.VacuumResponse.prototype.getSuccess = function() {
+proto.sqlrpc.v1.RestoreDatabaseResponse.prototype.getSuccess = function() {
   return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
  * @param {boolean} value
- * @return {!proto.sqlrpc.v1.VacuumResponse} returns this
+ * @return {!proto.sqlrpc.v1.RestoreDatabaseResponse} returns this
  */
-proto.sqlrpc.v1.VacuumResponse.prototype.setSuccess = function(value) {
+proto.sqlrpc.v1.RestoreDatabaseResponse.prototype.setSuccess = function(value) {
   return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
@@ -9017,20 +15217,57 @@ proto.sqlrpc.v1.VacuumResponse.prototype.setSuccess = function(value) {
  * optional string message = 2;
  * @return {string}
  */
-proto.sqlrpc.v1.VacuumResponse.prototype.getMessage = function() {
+proto.sqlrpc.v1.RestoreDatabaseResponse.prototype.getMessage = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.VacuumResponse} returns this
+ * @return {!proto.sqlrpc.v1.RestoreDatabaseResponse} returns this
  */
-proto.sqlrpc.v1.VacuumResponse.prototype.setMessage = function(value) {
+proto.sqlrpc.v1.RestoreDatabaseResponse.prototype.setMessage = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+/**
+ * optional BackupInfo backup = 3;
+ * @return {?proto.sqlrpc.v1.BackupInfo}
+ */
+proto.sqlrpc.v1.RestoreDatabaseResponse.prototype.getBackup = function() {
+  return /** @type{?proto.sqlrpc.v1.BackupInfo} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.BackupInfo, 3));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.BackupInfo|undefined} value
+ * @return {!proto.sqlrpc.v1.RestoreDatabaseResponse} returns this
+*/
+proto.sqlrpc.v1.RestoreDatabaseResponse.prototype.setBackup = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.RestoreDatabaseResponse} returns this
+ */
+proto.sqlrpc.v1.RestoreDatabaseResponse.prototype.clearBackup = function() {
+  return this.setBackup(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.RestoreDatabaseResponse.prototype.hasBackup = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
 
 
 
@@ -9047,8 +15284,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.CheckpointRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.CheckpointRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.DownloadBackupRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.DownloadBackupRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -9057,14 +15294,14 @@ proto.sqlrpc.v1.CheckpointRequest.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.CheckpointRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.DownloadBackupRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.CheckpointRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.DownloadBackupRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
 database: jspb.Message.getFieldWithDefault(msg, 1, ""),
-mode: jspb.Message.getFieldWithDefault(msg, 2, 0)
+backupId: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -9078,23 +15315,23 @@ mode: jspb.Message.getFieldWithDefault(msg, 2, 0)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.CheckpointRequest}
+ * @return {!proto.sqlrpc.v1.DownloadBackupRequest}
  */
-proto.sqlrpc.v1.CheckpointRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.DownloadBackupRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.CheckpointRequest;
-  return proto.sqlrpc.v1.CheckpointRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.DownloadBackupRequest;
+  return proto.sqlrpc.v1.DownloadBackupRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.CheckpointRequest} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.DownloadBackupRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.CheckpointRequest}
+ * @return {!proto.sqlrpc.v1.DownloadBackupRequest}
  */
-proto.sqlrpc.v1.CheckpointRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.DownloadBackupRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9106,8 +15343,8 @@ proto.sqlrpc.v1.CheckpointRequest.deserializeBinaryFromReader = function(msg, re
       msg.setDatabase(value);
       break;
     case 2:
-      var value = /** @type {!proto.sqlrpc.v1.CheckpointMode} */ (reader.readEnum());
-      msg.setMode(value);
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setBackupId(value);
       break;
     default:
       reader.skipField();
@@ -9122,9 +15359,9 @@ proto.sqlrpc.v1.CheckpointRequest.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.CheckpointRequest.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.DownloadBackupRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.CheckpointRequest.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.DownloadBackupRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9132,11 +15369,11 @@ proto.sqlrpc.v1.CheckpointRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.CheckpointRequest} message
+ * @param {!proto.sqlrpc.v1.DownloadBackupRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.CheckpointRequest.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.DownloadBackupRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getDatabase();
   if (f.length > 0) {
@@ -9145,9 +15382,9 @@ proto.sqlrpc.v1.CheckpointRequest.serializeBinaryToWriter = function(message, wr
       f
     );
   }
-  f = message.getMode();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getBackupId();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
@@ -9159,35 +15396,35 @@ proto.sqlrpc.v1.CheckpointRequest.serializeBinaryToWriter = function(message, wr
  * optional string database = 1;
  * @return {string}
  */
-proto.sqlrpc.v1.CheckpointRequest.prototype.getDatabase = function() {
+proto.sqlrpc.v1.DownloadBackupRequest.prototype.getDatabase = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.CheckpointRequest} returns this
+ * @return {!proto.sqlrpc.v1.DownloadBackupRequest} returns this
  */
-proto.sqlrpc.v1.CheckpointRequest.prototype.setDatabase = function(value) {
+proto.sqlrpc.v1.DownloadBackupRequest.prototype.setDatabase = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional CheckpointMode mode = 2;
- * @return {!proto.sqlrpc.v1.CheckpointMode}
+ * optional string backup_id = 2;
+ * @return {string}
  */
-proto.sqlrpc.v1.CheckpointRequest.prototype.getMode = function() {
-  return /** @type {!proto.sqlrpc.v1.CheckpointMode} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.sqlrpc.v1.DownloadBackupRequest.prototype.getBackupId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {!proto.sqlrpc.v1.CheckpointMode} value
- * @return {!proto.sqlrpc.v1.CheckpointRequest} returns this
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.DownloadBackupRequest} returns this
  */
-proto.sqlrpc.v1.CheckpointRequest.prototype.setMode = function(value) {
-  return jspb.Message.setProto3EnumField(this, 2, value);
+proto.sqlrpc.v1.DownloadBackupRequest.prototype.setBackupId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -9207,8 +15444,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.CheckpointResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.CheckpointResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.DownloadBackupResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.DownloadBackupResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -9217,17 +15454,14 @@ proto.sqlrpc.v1.CheckpointResponse.prototype.toObject = function(opt_includeInst
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.CheckpointResponse} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.DownloadBackupResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.CheckpointResponse.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.DownloadBackupResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
-message: jspb.Message.getFieldWithDefault(msg, 2, ""),
-busyCheckpoints: jspb.Message.getFieldWithDefault(msg, 3, 0),
-logCheckpoints: jspb.Message.getFieldWithDefault(msg, 4, 0),
-checkpointedPages: jspb.Message.getFieldWithDefault(msg, 5, 0)
+backup: (f = msg.getBackup()) && proto.sqlrpc.v1.BackupInfo.toObject(includeInstance, f),
+chunk: msg.getChunk_asB64()
   };
 
   if (includeInstance) {
@@ -9241,23 +15475,23 @@ checkpointedPages: jspb.Message.getFieldWithDefault(msg, 5, 0)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.CheckpointResponse}
+ * @return {!proto.sqlrpc.v1.DownloadBackupResponse}
  */
-proto.sqlrpc.v1.CheckpointResponse.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.DownloadBackupResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.CheckpointResponse;
-  return proto.sqlrpc.v1.CheckpointResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.DownloadBackupResponse;
+  return proto.sqlrpc.v1.DownloadBackupResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.CheckpointResponse} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.DownloadBackupResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.CheckpointResponse}
+ * @return {!proto.sqlrpc.v1.DownloadBackupResponse}
  */
-proto.sqlrpc.v1.CheckpointResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.DownloadBackupResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9265,24 +15499,13 @@ proto.sqlrpc.v1.CheckpointResponse.deserializeBinaryFromReader = function(msg, r
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSuccess(value);
+      var value = new proto.sqlrpc.v1.BackupInfo;
+      reader.readMessage(value,proto.sqlrpc.v1.BackupInfo.deserializeBinaryFromReader);
+      msg.setBackup(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setMessage(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setBusyCheckpoints(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setLogCheckpoints(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setCheckpointedPages(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setChunk(value);
       break;
     default:
       reader.skipField();
@@ -9297,9 +15520,9 @@ proto.sqlrpc.v1.CheckpointResponse.deserializeBinaryFromReader = function(msg, r
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.CheckpointResponse.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.DownloadBackupResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.CheckpointResponse.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.DownloadBackupResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9307,140 +15530,116 @@ proto.sqlrpc.v1.CheckpointResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.CheckpointResponse} message
+ * @param {!proto.sqlrpc.v1.DownloadBackupResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.CheckpointResponse.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.DownloadBackupResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSuccess();
-  if (f) {
-    writer.writeBool(
+  f = message.getBackup();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.sqlrpc.v1.BackupInfo.serializeBinaryToWriter
     );
   }
-  f = message.getMessage();
+  f = message.getChunk_asU8();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeBytes(
       2,
       f
     );
   }
-  f = message.getBusyCheckpoints();
-  if (f !== 0) {
-    writer.writeInt64(
-      3,
-      f
-    );
-  }
-  f = message.getLogCheckpoints();
-  if (f !== 0) {
-    writer.writeInt64(
-      4,
-      f
-    );
-  }
-  f = message.getCheckpointedPages();
-  if (f !== 0) {
-    writer.writeInt64(
-      5,
-      f
-    );
-  }
-};
-
-
-/**
- * optional bool success = 1;
- * @return {boolean}
- */
-proto.sqlrpc.v1.CheckpointResponse.prototype.getSuccess = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.sqlrpc.v1.CheckpointResponse} returns this
+ * optional BackupInfo backup = 1;
+ * @return {?proto.sqlrpc.v1.BackupInfo}
  */
-proto.sqlrpc.v1.CheckpointResponse.prototype.setSuccess = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.sqlrpc.v1.DownloadBackupResponse.prototype.getBackup = function() {
+  return /** @type{?proto.sqlrpc.v1.BackupInfo} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.BackupInfo, 1));
 };
 
 
 /**
- * optional string message = 2;
- * @return {string}
- */
-proto.sqlrpc.v1.CheckpointResponse.prototype.getMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+ * @param {?proto.sqlrpc.v1.BackupInfo|undefined} value
+ * @return {!proto.sqlrpc.v1.DownloadBackupResponse} returns this
+*/
+proto.sqlrpc.v1.DownloadBackupResponse.prototype.setBackup = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.CheckpointResponse} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.DownloadBackupResponse} returns this
  */
-proto.sqlrpc.v1.CheckpointResponse.prototype.setMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.sqlrpc.v1.DownloadBackupResponse.prototype.clearBackup = function() {
+  return this.setBackup(undefined);
 };
 
 
 /**
- * optional int64 busy_checkpoints = 3;
- * @return {number}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.sqlrpc.v1.CheckpointResponse.prototype.getBusyCheckpoints = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.sqlrpc.v1.DownloadBackupResponse.prototype.hasBackup = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.sqlrpc.v1.CheckpointResponse} returns this
+ * optional bytes chunk = 2;
+ * @return {!(string|Uint8Array)}
  */
-proto.sqlrpc.v1.CheckpointResponse.prototype.setBusyCheckpoints = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.sqlrpc.v1.DownloadBackupResponse.prototype.getChunk = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * optional int64 log_checkpoints = 4;
- * @return {number}
+ * optional bytes chunk = 2;
+ * This is a type-conversion wrapper around `getChunk()`
+ * @return {string}
  */
-proto.sqlrpc.v1.CheckpointResponse.prototype.getLogCheckpoints = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.sqlrpc.v1.DownloadBackupResponse.prototype.getChunk_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getChunk()));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.sqlrpc.v1.CheckpointResponse} returns this
+ * optional bytes chunk = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getChunk()`
+ * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.CheckpointResponse.prototype.setLogCheckpoints = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+proto.sqlrpc.v1.DownloadBackupResponse.prototype.getChunk_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getChunk()));
 };
 
 
 /**
- * optional int64 checkpointed_pages = 5;
- * @return {number}
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.sqlrpc.v1.DownloadBackupResponse} returns this
  */
-proto.sqlrpc.v1.CheckpointResponse.prototype.getCheckpointedPages = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.sqlrpc.v1.DownloadBackupResponse.prototype.setChunk = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
 };
 
 
+
 /**
- * @param {number} value
- * @return {!proto.sqlrpc.v1.CheckpointResponse} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.sqlrpc.v1.CheckpointResponse.prototype.setCheckpointedPages = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
-};
-
-
+proto.sqlrpc.v1.DumpDatabaseRequest.repeatedFields_ = [2];
 
 
 
@@ -9457,8 +15656,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.IntegrityCheckRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.IntegrityCheckRequest.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.DumpDatabaseRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.DumpDatabaseRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -9467,14 +15666,15 @@ proto.sqlrpc.v1.IntegrityCheckRequest.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.IntegrityCheckRequest} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.DumpDatabaseRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.IntegrityCheckRequest.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.DumpDatabaseRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
 database: jspb.Message.getFieldWithDefault(msg, 1, ""),
-maxErrors: (f = jspb.Message.getField(msg, 2)) == null ? undefined : f
+tablesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+schemaOnly: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -9488,23 +15688,23 @@ maxErrors: (f = jspb.Message.getField(msg, 2)) == null ? undefined : f
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.IntegrityCheckRequest}
+ * @return {!proto.sqlrpc.v1.DumpDatabaseRequest}
  */
-proto.sqlrpc.v1.IntegrityCheckRequest.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.DumpDatabaseRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.IntegrityCheckRequest;
-  return proto.sqlrpc.v1.IntegrityCheckRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.DumpDatabaseRequest;
+  return proto.sqlrpc.v1.DumpDatabaseRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.IntegrityCheckRequest} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.DumpDatabaseRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.IntegrityCheckRequest}
+ * @return {!proto.sqlrpc.v1.DumpDatabaseRequest}
  */
-proto.sqlrpc.v1.IntegrityCheckRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.DumpDatabaseRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9516,8 +15716,12 @@ proto.sqlrpc.v1.IntegrityCheckRequest.deserializeBinaryFromReader = function(msg
       msg.setDatabase(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setMaxErrors(value);
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.addTables(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSchemaOnly(value);
       break;
     default:
       reader.skipField();
@@ -9532,9 +15736,9 @@ proto.sqlrpc.v1.IntegrityCheckRequest.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.IntegrityCheckRequest.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.DumpDatabaseRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.IntegrityCheckRequest.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.DumpDatabaseRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9542,11 +15746,11 @@ proto.sqlrpc.v1.IntegrityCheckRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.IntegrityCheckRequest} message
+ * @param {!proto.sqlrpc.v1.DumpDatabaseRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.IntegrityCheckRequest.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.DumpDatabaseRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getDatabase();
   if (f.length > 0) {
@@ -9555,13 +15759,20 @@ proto.sqlrpc.v1.IntegrityCheckRequest.serializeBinaryToWriter = function(message
       f
     );
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
-    writer.writeInt32(
+  f = message.getTablesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
       2,
       f
     );
   }
+  f = message.getSchemaOnly();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -9569,63 +15780,75 @@ proto.sqlrpc.v1.IntegrityCheckRequest.serializeBinaryToWriter = function(message
  * optional string database = 1;
  * @return {string}
  */
-proto.sqlrpc.v1.IntegrityCheckRequest.prototype.getDatabase = function() {
+proto.sqlrpc.v1.DumpDatabaseRequest.prototype.getDatabase = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.sqlrpc.v1.IntegrityCheckRequest} returns this
+ * @return {!proto.sqlrpc.v1.DumpDatabaseRequest} returns this
  */
-proto.sqlrpc.v1.IntegrityCheckRequest.prototype.setDatabase = function(value) {
+proto.sqlrpc.v1.DumpDatabaseRequest.prototype.setDatabase = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional int32 max_errors = 2;
- * @return {number}
+ * repeated string tables = 2;
+ * @return {!Array<string>}
  */
-proto.sqlrpc.v1.IntegrityCheckRequest.prototype.getMaxErrors = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.sqlrpc.v1.DumpDatabaseRequest.prototype.getTablesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.sqlrpc.v1.IntegrityCheckRequest} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.sqlrpc.v1.DumpDatabaseRequest} returns this
  */
-proto.sqlrpc.v1.IntegrityCheckRequest.prototype.setMaxErrors = function(value) {
-  return jspb.Message.setField(this, 2, value);
+proto.sqlrpc.v1.DumpDatabaseRequest.prototype.setTablesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.sqlrpc.v1.IntegrityCheckRequest} returns this
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.sqlrpc.v1.DumpDatabaseRequest} returns this
  */
-proto.sqlrpc.v1.IntegrityCheckRequest.prototype.clearMaxErrors = function() {
-  return jspb.Message.setField(this, 2, undefined);
+proto.sqlrpc.v1.DumpDatabaseRequest.prototype.addTables = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.sqlrpc.v1.DumpDatabaseRequest} returns this
  */
-proto.sqlrpc.v1.IntegrityCheckRequest.prototype.hasMaxErrors = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.sqlrpc.v1.DumpDatabaseRequest.prototype.clearTablesList = function() {
+  return this.setTablesList([]);
 };
 
 
+/**
+ * optional bool schema_only = 3;
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.DumpDatabaseRequest.prototype.getSchemaOnly = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {boolean} value
+ * @return {!proto.sqlrpc.v1.DumpDatabaseRequest} returns this
  */
-proto.sqlrpc.v1.IntegrityCheckResponse.repeatedFields_ = [3];
+proto.sqlrpc.v1.DumpDatabaseRequest.prototype.setSchemaOnly = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
 
 
 
@@ -9642,8 +15865,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.sqlrpc.v1.IntegrityCheckResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.sqlrpc.v1.IntegrityCheckResponse.toObject(opt_includeInstance, this);
+proto.sqlrpc.v1.DumpDatabaseResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.DumpDatabaseResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -9652,15 +15875,13 @@ proto.sqlrpc.v1.IntegrityCheckResponse.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.sqlrpc.v1.IntegrityCheckResponse} msg The msg instance to transform.
+ * @param {!proto.sqlrpc.v1.DumpDatabaseResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.IntegrityCheckResponse.toObject = function(includeInstance, msg) {
+proto.sqlrpc.v1.DumpDatabaseResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
-message: jspb.Message.getFieldWithDefault(msg, 2, ""),
-errorsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f
+sql: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -9674,23 +15895,23 @@ errorsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.sqlrpc.v1.IntegrityCheckResponse}
+ * @return {!proto.sqlrpc.v1.DumpDatabaseResponse}
  */
-proto.sqlrpc.v1.IntegrityCheckResponse.deserializeBinary = function(bytes) {
+proto.sqlrpc.v1.DumpDatabaseResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.sqlrpc.v1.IntegrityCheckResponse;
-  return proto.sqlrpc.v1.IntegrityCheckResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.sqlrpc.v1.DumpDatabaseResponse;
+  return proto.sqlrpc.v1.DumpDatabaseResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.sqlrpc.v1.IntegrityCheckResponse} msg The message object to deserialize into.
+ * @param {!proto.sqlrpc.v1.DumpDatabaseResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.sqlrpc.v1.IntegrityCheckResponse}
+ * @return {!proto.sqlrpc.v1.DumpDatabaseResponse}
  */
-proto.sqlrpc.v1.IntegrityCheckResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.sqlrpc.v1.DumpDatabaseResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9698,16 +15919,8 @@ proto.sqlrpc.v1.IntegrityCheckResponse.deserializeBinaryFromReader = function(ms
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSuccess(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setMessage(value);
-      break;
-    case 3:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.addErrors(value);
+      msg.setSql(value);
       break;
     default:
       reader.skipField();
@@ -9722,9 +15935,9 @@ proto.sqlrpc.v1.IntegrityCheckResponse.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.sqlrpc.v1.IntegrityCheckResponse.prototype.serializeBinary = function() {
+proto.sqlrpc.v1.DumpDatabaseResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.sqlrpc.v1.IntegrityCheckResponse.serializeBinaryToWriter(this, writer);
+  proto.sqlrpc.v1.DumpDatabaseResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9732,30 +15945,16 @@ proto.sqlrpc.v1.IntegrityCheckResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.sqlrpc.v1.IntegrityCheckResponse} message
+ * @param {!proto.sqlrpc.v1.DumpDatabaseResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.sqlrpc.v1.IntegrityCheckResponse.serializeBinaryToWriter = function(message, writer) {
+proto.sqlrpc.v1.DumpDatabaseResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSuccess();
-  if (f) {
-    writer.writeBool(
-      1,
-      f
-    );
-  }
-  f = message.getMessage();
+  f = message.getSql();
   if (f.length > 0) {
     writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getErrorsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      3,
+      1,
       f
     );
   }
@@ -9763,75 +15962,20 @@ proto.sqlrpc.v1.IntegrityCheckResponse.serializeBinaryToWriter = function(messag
 
 
 /**
- * optional bool success = 1;
- * @return {boolean}
- */
-proto.sqlrpc.v1.IntegrityCheckResponse.prototype.getSuccess = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.sqlrpc.v1.IntegrityCheckResponse} returns this
- */
-proto.sqlrpc.v1.IntegrityCheckResponse.prototype.setSuccess = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
-};
-
-
-/**
- * optional string message = 2;
+ * optional string sql = 1;
  * @return {string}
  */
-proto.sqlrpc.v1.IntegrityCheckResponse.prototype.getMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.sqlrpc.v1.IntegrityCheckResponse} returns this
- */
-proto.sqlrpc.v1.IntegrityCheckResponse.prototype.setMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * repeated string errors = 3;
- * @return {!Array<string>}
- */
-proto.sqlrpc.v1.IntegrityCheckResponse.prototype.getErrorsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
-};
-
-
-/**
- * @param {!Array<string>} value
- * @return {!proto.sqlrpc.v1.IntegrityCheckResponse} returns this
- */
-proto.sqlrpc.v1.IntegrityCheckResponse.prototype.setErrorsList = function(value) {
-  return jspb.Message.setField(this, 3, value || []);
+proto.sqlrpc.v1.DumpDatabaseResponse.prototype.getSql = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.sqlrpc.v1.IntegrityCheckResponse} returns this
- */
-proto.sqlrpc.v1.IntegrityCheckResponse.prototype.addErrors = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.sqlrpc.v1.IntegrityCheckResponse} returns this
+ * @return {!proto.sqlrpc.v1.DumpDatabaseResponse} returns this
  */
-proto.sqlrpc.v1.IntegrityCheckResponse.prototype.clearErrorsList = function() {
-  return this.setErrorsList([]);
+proto.sqlrpc.v1.DumpDatabaseResponse.prototype.setSql = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -13277,7 +19421,8 @@ database: jspb.Message.getFieldWithDefault(msg, 1, ""),
 channel: jspb.Message.getFieldWithDefault(msg, 2, ""),
 payload: jspb.Message.getFieldWithDefault(msg, 3, ""),
 messageId: jspb.Message.getFieldWithDefault(msg, 4, 0),
-createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+traceparent: jspb.Message.getFieldWithDefault(msg, 6, "")
   };
 
   if (includeInstance) {
@@ -13335,6 +19480,10 @@ proto.sqlrpc.v1.SubscribeResponse.deserializeBinaryFromReader = function(msg, re
       reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
       msg.setCreatedAt(value);
       break;
+    case 6:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setTraceparent(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -13400,6 +19549,13 @@ proto.sqlrpc.v1.SubscribeResponse.serializeBinaryToWriter = function(message, wr
       google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
+  f = message.getTraceparent();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
 };
 
 
@@ -13512,6 +19668,24 @@ proto.sqlrpc.v1.SubscribeResponse.prototype.hasCreatedAt = function() {
 };
 
 
+/**
+ * optional string traceparent = 6;
+ * @return {string}
+ */
+proto.sqlrpc.v1.SubscribeResponse.prototype.getTraceparent = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.SubscribeResponse} returns this
+ */
+proto.sqlrpc.v1.SubscribeResponse.prototype.setTraceparent = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
 /**
  * @enum {number}
  */
@@ -15,11 +15,20 @@ var jspb = require('google-protobuf');
 var goog = jspb;
 var global = globalThis;
 
+goog.exportSymbol('proto.sqlrpc.v1.BackupCompression', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.CheckpointMode', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ColumnAction', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.ColumnAffinity', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.DeclaredType', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ImportConflict', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ImportFormat', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.LogLevel', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.MaintenanceTask', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.ReplicationRole', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.Role', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.RpcFamily', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.SqliteCode', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.TransactionKind', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.TransactionLockMode', null, global);
 /**
  * @enum {number}
@@ -143,4 +152,93 @@ proto.sqlrpc.v1.Role = {
   ROLE_ADMIN: 40
 };
 
+/**
+ * @enum {number}
+ */
+proto.sqlrpc.v1.RpcFamily = {
+  RPC_FAMILY_UNSPECIFIED: 0,
+  RPC_FAMILY_QUERY: 1,
+  RPC_FAMILY_PUBLISH: 2,
+  RPC_FAMILY_SUBSCRIBE: 3,
+  RPC_FAMILY_ADMIN: 4
+};
+
+/**
+ * @enum {number}
+ */
+proto.sqlrpc.v1.BackupCompression = {
+  BACKUP_COMPRESSION_UNSPECIFIED: 0,
+  BACKUP_COMPRESSION_NONE: 1,
+  BACKUP_COMPRESSION_GZIP: 2
+};
+
+/**
+ * @enum {number}
+ */
+proto.sqlrpc.v1.MaintenanceTask = {
+  MAINTENANCE_TASK_UNSPECIFIED: 0,
+  MAINTENANCE_TASK_CHECKPOINT: 1,
+  MAINTENANCE_TASK_VACUUM: 2,
+  MAINTENANCE_TASK_INTEGRITY_CHECK: 3,
+  MAINTENANCE_TASK_BACKUP: 4
+};
+
+/**
+ * @enum {number}
+ */
+proto.sqlrpc.v1.ReplicationRole = {
+  REPLICATION_ROLE_UNSPECIFIED: 0,
+  REPLICATION_ROLE_LEADER: 1,
+  REPLICATION_ROLE_FOLLOWER: 2
+};
+
+/**
+ * @enum {number}
+ */
+proto.sqlrpc.v1.ColumnAction = {
+  COLUMN_ACTION_UNSPECIFIED: 0,
+  COLUMN_ACTION_DENY: 1,
+  COLUMN_ACTION_MASK: 2
+};
+
+/**
+ * @enum {number}
+ */
+proto.sqlrpc.v1.TransactionKind = {
+  TRANSACTION_KIND_UNSPECIFIED: 0,
+  TRANSACTION_KIND_ID_BASED: 1,
+  TRANSACTION_KIND_STREAM: 2
+};
+
+/**
+ * @enum {number}
+ */
+proto.sqlrpc.v1.LogLevel = {
+  LOG_LEVEL_UNSPECIFIED: 0,
+  LOG_LEVEL_DEBUG: 1,
+  LOG_LEVEL_INFO: 2,
+  LOG_LEVEL_WARN: 3,
+  LOG_LEVEL_ERROR: 4
+};
+
+/**
+ * @enum {number}
+ */
+proto.sqlrpc.v1.ImportFormat = {
+  IMPORT_FORMAT_UNSPECIFIED: 0,
+  IMPORT_FORMAT_CSV: 1,
+  IMPORT_FORMAT_NDJSON: 2,
+  IMPORT_FORMAT_ROWS: 3
+};
+
+/**
+ * @enum {number}
+ */
+proto.sqlrpc.v1.ImportConflict = {
+  IMPORT_CONFLICT_UNSPECIFIED: 0,
+  IMPORT_CONFLICT_ABORT: 1,
+  IMPORT_CONFLICT_IGNORE: 2,
+  IMPORT_CONFLICT_REPLACE: 3
+};
+
 goog.object.extend(exports, proto.sqlrpc.v1);
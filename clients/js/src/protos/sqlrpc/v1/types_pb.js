@@ -33,6 +33,7 @@ goog.exportSymbol('proto.sqlrpc.v1.ExtensionList', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.ForeignKeySchema', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.IndexSchema', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.InitCommandList', null, global);
+goog.exportSymbol('proto.sqlrpc.v1.MaintenanceSchedule', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.Parameters', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.PragmaMap', null, global);
 goog.exportSymbol('proto.sqlrpc.v1.QueryPlanNode', null, global);
@@ -149,6 +150,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.sqlrpc.v1.DatabaseConfig.displayName = 'proto.sqlrpc.v1.DatabaseConfig';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.sqlrpc.v1.MaintenanceSchedule = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.sqlrpc.v1.MaintenanceSchedule, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.sqlrpc.v1.MaintenanceSchedule.displayName = 'proto.sqlrpc.v1.MaintenanceSchedule';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -1059,7 +1081,9 @@ proto.sqlrpc.v1.ExecutionStats.toObject = function(includeInstance, msg) {
   var f, obj = {
 durationMs: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0),
 rowsRead: jspb.Message.getFieldWithDefault(msg, 2, 0),
-rowsWritten: jspb.Message.getFieldWithDefault(msg, 3, 0)
+rowsWritten: jspb.Message.getFieldWithDefault(msg, 3, 0),
+rowsQuotaRemaining: (f = jspb.Message.getField(msg, 4)) == null ? undefined : f,
+bytesQuotaRemaining: (f = jspb.Message.getField(msg, 5)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -1108,6 +1132,14 @@ proto.sqlrpc.v1.ExecutionStats.deserializeBinaryFromReader = function(msg, reade
       var value = /** @type {number} */ (reader.readInt64());
       msg.setRowsWritten(value);
       break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setRowsQuotaRemaining(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setBytesQuotaRemaining(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1158,6 +1190,20 @@ proto.sqlrpc.v1.ExecutionStats.serializeBinaryToWriter = function(message, write
       f
     );
   }
+  f = /** @type {number} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
 };
 
 
@@ -1215,6 +1261,78 @@ proto.sqlrpc.v1.ExecutionStats.prototype.setRowsWritten = function(value) {
 };
 
 
+/**
+ * optional int64 rows_quota_remaining = 4;
+ * @return {number}
+ */
+proto.sqlrpc.v1.ExecutionStats.prototype.getRowsQuotaRemaining = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.ExecutionStats} returns this
+ */
+proto.sqlrpc.v1.ExecutionStats.prototype.setRowsQuotaRemaining = function(value) {
+  return jspb.Message.setField(this, 4, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.sqlrpc.v1.ExecutionStats} returns this
+ */
+proto.sqlrpc.v1.ExecutionStats.prototype.clearRowsQuotaRemaining = function() {
+  return jspb.Message.setField(this, 4, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.ExecutionStats.prototype.hasRowsQuotaRemaining = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional int64 bytes_quota_remaining = 5;
+ * @return {number}
+ */
+proto.sqlrpc.v1.ExecutionStats.prototype.getBytesQuotaRemaining = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.ExecutionStats} returns this
+ */
+proto.sqlrpc.v1.ExecutionStats.prototype.setBytesQuotaRemaining = function(value) {
+  return jspb.Message.setField(this, 5, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.sqlrpc.v1.ExecutionStats} returns this
+ */
+proto.sqlrpc.v1.ExecutionStats.prototype.clearBytesQuotaRemaining = function() {
+  return jspb.Message.setField(this, 5, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.ExecutionStats.prototype.hasBytesQuotaRemaining = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
 
 
 
@@ -1576,7 +1694,9 @@ maxIdleConns: jspb.Message.getFieldWithDefault(msg, 9, 0),
 connMaxLifetimeMs: jspb.Message.getFieldWithDefault(msg, 10, 0),
 initCommandsList: (f = jspb.Message.getRepeatedField(msg, 11)) == null ? undefined : f,
 attachmentsList: jspb.Message.toObjectList(msg.getAttachmentsList(),
-    proto.sqlrpc.v1.Attachment.toObject, includeInstance)
+    proto.sqlrpc.v1.Attachment.toObject, includeInstance),
+maintenance: (f = msg.getMaintenance()) && proto.sqlrpc.v1.MaintenanceSchedule.toObject(includeInstance, f),
+maxExecutionTimeMs: jspb.Message.getFieldWithDefault(msg, 14, 0)
   };
 
   if (includeInstance) {
@@ -1664,6 +1784,15 @@ proto.sqlrpc.v1.DatabaseConfig.deserializeBinaryFromReader = function(msg, reade
       reader.readMessage(value,proto.sqlrpc.v1.Attachment.deserializeBinaryFromReader);
       msg.addAttachments(value);
       break;
+    case 13:
+      var value = new proto.sqlrpc.v1.MaintenanceSchedule;
+      reader.readMessage(value,proto.sqlrpc.v1.MaintenanceSchedule.deserializeBinaryFromReader);
+      msg.setMaintenance(value);
+      break;
+    case 14:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxExecutionTimeMs(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1780,6 +1909,21 @@ jspb.internal.public_for_gencode.serializeMapToBinary(
       proto.sqlrpc.v1.Attachment.serializeBinaryToWriter
     );
   }
+  f = message.getMaintenance();
+  if (f != null) {
+    writer.writeMessage(
+      13,
+      f,
+      proto.sqlrpc.v1.MaintenanceSchedule.serializeBinaryToWriter
+    );
+  }
+  f = message.getMaxExecutionTimeMs();
+  if (f !== 0) {
+    writer.writeInt32(
+      14,
+      f
+    );
+  }
 };
 
 
@@ -2062,6 +2206,341 @@ proto.sqlrpc.v1.DatabaseConfig.prototype.clearAttachmentsList = function() {
 };
 
 
+/**
+ * optional MaintenanceSchedule maintenance = 13;
+ * @return {?proto.sqlrpc.v1.MaintenanceSchedule}
+ */
+proto.sqlrpc.v1.DatabaseConfig.prototype.getMaintenance = function() {
+  return /** @type{?proto.sqlrpc.v1.MaintenanceSchedule} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.MaintenanceSchedule, 13));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.MaintenanceSchedule|undefined} value
+ * @return {!proto.sqlrpc.v1.DatabaseConfig} returns this
+*/
+proto.sqlrpc.v1.DatabaseConfig.prototype.setMaintenance = function(value) {
+  return jspb.Message.setWrapperField(this, 13, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.DatabaseConfig} returns this
+ */
+proto.sqlrpc.v1.DatabaseConfig.prototype.clearMaintenance = function() {
+  return this.setMaintenance(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.DatabaseConfig.prototype.hasMaintenance = function() {
+  return jspb.Message.getField(this, 13) != null;
+};
+
+
+/**
+ * optional int32 max_execution_time_ms = 14;
+ * @return {number}
+ */
+proto.sqlrpc.v1.DatabaseConfig.prototype.getMaxExecutionTimeMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 14, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.DatabaseConfig} returns this
+ */
+proto.sqlrpc.v1.DatabaseConfig.prototype.setMaxExecutionTimeMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 14, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.sqlrpc.v1.MaintenanceSchedule.prototype.toObject = function(opt_includeInstance) {
+  return proto.sqlrpc.v1.MaintenanceSchedule.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.sqlrpc.v1.MaintenanceSchedule} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.MaintenanceSchedule.toObject = function(includeInstance, msg) {
+  var f, obj = {
+checkpoint: jspb.Message.getFieldWithDefault(msg, 1, ""),
+vacuum: jspb.Message.getFieldWithDefault(msg, 2, ""),
+integrityCheck: jspb.Message.getFieldWithDefault(msg, 3, ""),
+backup: jspb.Message.getFieldWithDefault(msg, 4, ""),
+backupCompression: jspb.Message.getFieldWithDefault(msg, 5, 0),
+backupRetention: jspb.Message.getFieldWithDefault(msg, 6, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.sqlrpc.v1.MaintenanceSchedule}
+ */
+proto.sqlrpc.v1.MaintenanceSchedule.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.sqlrpc.v1.MaintenanceSchedule;
+  return proto.sqlrpc.v1.MaintenanceSchedule.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.sqlrpc.v1.MaintenanceSchedule} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.sqlrpc.v1.MaintenanceSchedule}
+ */
+proto.sqlrpc.v1.MaintenanceSchedule.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setCheckpoint(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setVacuum(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setIntegrityCheck(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setBackup(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.sqlrpc.v1.BackupCompression} */ (reader.readEnum());
+      msg.setBackupCompression(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setBackupRetention(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.sqlrpc.v1.MaintenanceSchedule.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.sqlrpc.v1.MaintenanceSchedule.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.sqlrpc.v1.MaintenanceSchedule} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.sqlrpc.v1.MaintenanceSchedule.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCheckpoint();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getVacuum();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getIntegrityCheck();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getBackup();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getBackupCompression();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+  f = message.getBackupRetention();
+  if (f !== 0) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string checkpoint = 1;
+ * @return {string}
+ */
+proto.sqlrpc.v1.MaintenanceSchedule.prototype.getCheckpoint = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.MaintenanceSchedule} returns this
+ */
+proto.sqlrpc.v1.MaintenanceSchedule.prototype.setCheckpoint = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string vacuum = 2;
+ * @return {string}
+ */
+proto.sqlrpc.v1.MaintenanceSchedule.prototype.getVacuum = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.MaintenanceSchedule} returns this
+ */
+proto.sqlrpc.v1.MaintenanceSchedule.prototype.setVacuum = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string integrity_check = 3;
+ * @return {string}
+ */
+proto.sqlrpc.v1.MaintenanceSchedule.prototype.getIntegrityCheck = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.MaintenanceSchedule} returns this
+ */
+proto.sqlrpc.v1.MaintenanceSchedule.prototype.setIntegrityCheck = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string backup = 4;
+ * @return {string}
+ */
+proto.sqlrpc.v1.MaintenanceSchedule.prototype.getBackup = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.sqlrpc.v1.MaintenanceSchedule} returns this
+ */
+proto.sqlrpc.v1.MaintenanceSchedule.prototype.setBackup = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional BackupCompression backup_compression = 5;
+ * @return {!proto.sqlrpc.v1.BackupCompression}
+ */
+proto.sqlrpc.v1.MaintenanceSchedule.prototype.getBackupCompression = function() {
+  return /** @type {!proto.sqlrpc.v1.BackupCompression} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {!proto.sqlrpc.v1.BackupCompression} value
+ * @return {!proto.sqlrpc.v1.MaintenanceSchedule} returns this
+ */
+proto.sqlrpc.v1.MaintenanceSchedule.prototype.setBackupCompression = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
+};
+
+
+/**
+ * optional uint32 backup_retention = 6;
+ * @return {number}
+ */
+proto.sqlrpc.v1.MaintenanceSchedule.prototype.getBackupRetention = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.MaintenanceSchedule} returns this
+ */
+proto.sqlrpc.v1.MaintenanceSchedule.prototype.setBackupRetention = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
 
 
 
@@ -2101,7 +2580,9 @@ maxOpenConns: (f = jspb.Message.getField(msg, 4)) == null ? undefined : f,
 maxIdleConns: (f = jspb.Message.getField(msg, 5)) == null ? undefined : f,
 connMaxLifetimeMs: (f = jspb.Message.getField(msg, 6)) == null ? undefined : f,
 initCommands: (f = msg.getInitCommands()) && proto.sqlrpc.v1.InitCommandList.toObject(includeInstance, f),
-attachments: (f = msg.getAttachments()) && proto.sqlrpc.v1.AttachmentList.toObject(includeInstance, f)
+attachments: (f = msg.getAttachments()) && proto.sqlrpc.v1.AttachmentList.toObject(includeInstance, f),
+maintenance: (f = msg.getMaintenance()) && proto.sqlrpc.v1.MaintenanceSchedule.toObject(includeInstance, f),
+maxExecutionTimeMs: (f = jspb.Message.getField(msg, 10)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -2174,6 +2655,15 @@ proto.sqlrpc.v1.UpdateDatabaseConfig.deserializeBinaryFromReader = function(msg,
       reader.readMessage(value,proto.sqlrpc.v1.AttachmentList.deserializeBinaryFromReader);
       msg.setAttachments(value);
       break;
+    case 9:
+      var value = new proto.sqlrpc.v1.MaintenanceSchedule;
+      reader.readMessage(value,proto.sqlrpc.v1.MaintenanceSchedule.deserializeBinaryFromReader);
+      msg.setMaintenance(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxExecutionTimeMs(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2263,6 +2753,21 @@ proto.sqlrpc.v1.UpdateDatabaseConfig.serializeBinaryToWriter = function(message,
       proto.sqlrpc.v1.AttachmentList.serializeBinaryToWriter
     );
   }
+  f = message.getMaintenance();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      proto.sqlrpc.v1.MaintenanceSchedule.serializeBinaryToWriter
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 10));
+  if (f != null) {
+    writer.writeInt32(
+      10,
+      f
+    );
+  }
 };
 
 
@@ -2558,6 +3063,79 @@ proto.sqlrpc.v1.UpdateDatabaseConfig.prototype.hasAttachments = function() {
 };
 
 
+/**
+ * optional MaintenanceSchedule maintenance = 9;
+ * @return {?proto.sqlrpc.v1.MaintenanceSchedule}
+ */
+proto.sqlrpc.v1.UpdateDatabaseConfig.prototype.getMaintenance = function() {
+  return /** @type{?proto.sqlrpc.v1.MaintenanceSchedule} */ (
+    jspb.Message.getWrapperField(this, proto.sqlrpc.v1.MaintenanceSchedule, 9));
+};
+
+
+/**
+ * @param {?proto.sqlrpc.v1.MaintenanceSchedule|undefined} value
+ * @return {!proto.sqlrpc.v1.UpdateDatabaseConfig} returns this
+*/
+proto.sqlrpc.v1.UpdateDatabaseConfig.prototype.setMaintenance = function(value) {
+  return jspb.Message.setWrapperField(this, 9, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.sqlrpc.v1.UpdateDatabaseConfig} returns this
+ */
+proto.sqlrpc.v1.UpdateDatabaseConfig.prototype.clearMaintenance = function() {
+  return this.setMaintenance(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.UpdateDatabaseConfig.prototype.hasMaintenance = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+/**
+ * optional int32 max_execution_time_ms = 10;
+ * @return {number}
+ */
+proto.sqlrpc.v1.UpdateDatabaseConfig.prototype.getMaxExecutionTimeMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.sqlrpc.v1.UpdateDatabaseConfig} returns this
+ */
+proto.sqlrpc.v1.UpdateDatabaseConfig.prototype.setMaxExecutionTimeMs = function(value) {
+  return jspb.Message.setField(this, 10, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.sqlrpc.v1.UpdateDatabaseConfig} returns this
+ */
+proto.sqlrpc.v1.UpdateDatabaseConfig.prototype.clearMaxExecutionTimeMs = function() {
+  return jspb.Message.setField(this, 10, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.sqlrpc.v1.UpdateDatabaseConfig.prototype.hasMaxExecutionTimeMs = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
 
 
 
@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"log"
 	"os"
 	"os/signal"
 	"strconv"
@@ -12,7 +11,9 @@ import (
 	"syscall"
 	"time"
 
+	"sqlite-server/internal/auth"
 	"sqlite-server/internal/extensions/extensiondownloader"
+	"sqlite-server/internal/logging"
 	"sqlite-server/internal/server"
 )
 
@@ -20,6 +21,11 @@ func main() {
 	// 1. Initial configuration and flag parsing
 	cfg := parseFlags()
 
+	if err := logging.Setup(os.Stderr, logging.Config{Format: cfg.LogFormat, Level: cfg.LogLevel, Levels: cfg.LogLevels}); err != nil {
+		fmt.Fprintf(os.Stderr, "Fatal: %v\n", err)
+		os.Exit(2)
+	}
+
 	if cfg.ShowVersion {
 		fmt.Printf("sqlite-server version %s\n", server.Version)
 		os.Exit(0)
@@ -27,19 +33,19 @@ func main() {
 
 	// 1.5. Download extensions if requested (exit after completion)
 	if cfg.DownloadAllExtensions {
-		log.Println("Downloading all extensions...")
+		logger.Info("Downloading all extensions...")
 		if err := extensiondownloader.DownloadExtensions(cfg.ExtDir, []string{"all"}); err != nil {
-			log.Fatalf("Fatal: failed to download extensions: %v", err)
+			fatal("Fatal: failed to download extensions", err)
 		}
-		log.Println("All extensions downloaded successfully.")
+		logger.Info("All extensions downloaded successfully.")
 		os.Exit(0)
 	} else if cfg.DownloadExtensions != "" {
-		log.Println("Downloading extensions...")
+		logger.Info("Downloading extensions...")
 		list := strings.Split(cfg.DownloadExtensions, ",")
 		if err := extensiondownloader.DownloadExtensions(cfg.ExtDir, list); err != nil {
-			log.Fatalf("Fatal: failed to download extensions: %v", err)
+			fatal("Fatal: failed to download extensions", err)
 		}
-		log.Println("Extensions downloaded successfully.")
+		logger.Info("Extensions downloaded successfully.")
 		os.Exit(0)
 	}
 
@@ -49,7 +55,7 @@ func main() {
 	// 3. Start the Server in a background goroutine
 	go func() {
 		if err := srv.Start(); err != nil {
-			log.Fatalf("Fatal: server failed: %v", err)
+			fatal("Fatal: server failed", err)
 		}
 	}()
 
@@ -57,6 +63,14 @@ func main() {
 	handleGracefulShutdown(srv, cfg.ShutdownTimeout)
 }
 
+var logger = logging.For(logging.Server)
+
+// fatal logs err and exits.
+func fatal(msg string, err error) {
+	logger.Error(msg, logging.Err(err))
+	os.Exit(1)
+}
+
 // parseFlags initializes the FlagSet and parses CLI arguments/environment variables.
 func parseFlags() *server.Config {
 	cfg := &server.Config{}
@@ -64,13 +78,14 @@ func parseFlags() *server.Config {
 
 	// Define flags with both environment variable fallbacks and default values
 	fs.StringVar(&cfg.Mounts, "mounts", getEnv("SQLITE_SERVER_MOUNTS", ""), "Path to the JSON database mount configurations file")
-	fs.IntVar(&cfg.Port, "port", getEnvInt("SQLITE_SERVER_PORT", 50173), "Port to listen on")
+	fs.IntVar(&cfg.Port, "port", getEnvInt("SQLITE_SERVER_PORT", 50173), "Port to listen on (-1 disables TCP when --unix-socket is set)")
 	fs.StringVar(&cfg.Host, "host", getEnv("SQLITE_SERVER_HOST", "localhost"), "Host to bind to")
 	fs.StringVar(&cfg.ExtDir, "extensions", getEnv("SQLITE_SERVER_EXTENSIONS", "./extensions"), "Path to the extensions directory")
 	fs.BoolVar(&cfg.AuthDisabled, "auth-disabled", getEnvBool("SQLITE_SERVER_AUTH_ENABLED", true) == false, "Disable authentication")
 	fs.StringVar(&cfg.CorsOrigin, "cors-origin", getEnv("SQLITE_SERVER_CORS_ORIGIN", ""), "Allowed CORS origin")
 	fs.StringVar(&cfg.MetaDB, "meta-db", getEnv("SQLITE_SERVER_META_DB", "_meta.db"), "Path to the metadata database")
 	fs.StringVar(&cfg.DbDir, "db-dir", getEnv("SQLITE_SERVER_DB_DIR", "./databases"), "Base directory for all database files")
+	fs.StringVar(&cfg.BackupDir, "backup-dir", getEnv("SQLITE_SERVER_BACKUP_DIR", "./backups"), "Directory for database backups")
 	fs.BoolVar(&cfg.MountsOverwrite, "mounts-overwrite", false, "Overwrite existing database configurations in metadata with mounts file")
 	fs.StringVar(&cfg.InitialAdmin, "initial-admin", getEnv("SQLITE_SERVER_INITIAL_ADMIN", "admin"), "Username for the initial admin user")
 	fs.StringVar(&cfg.InitialPassword, "initial-password", getEnv("SQLITE_SERVER_INITIAL_PASSWORD", ""), "Password for the initial admin user (if not set, random will be generated)")
@@ -85,6 +100,60 @@ func parseFlags() *server.Config {
 	fs.IntVar(&cfg.PubSubTTL, "pubsub-ttl", getEnvInt("SQLITE_SERVER_PUB_SUB_TTL", 24), "Message retention period in hours")
 	fs.StringVar(&cfg.PubSubDB, "pubsub-db", getEnv("SQLITE_SERVER_PUB_SUB_DB", "_system_broker.db"), "Path to the Pub/Sub broker database file")
 
+	// Replication Settings
+	fs.StringVar(&cfg.Role, "role", getEnv("SQLITE_SERVER_ROLE", "leader"), "Replication role: 'leader' or 'follower'")
+	fs.StringVar(&cfg.Leader, "leader", getEnv("SQLITE_SERVER_LEADER", ""), "Leader address (host:port) to replicate from when --role=follower")
+	fs.StringVar(&cfg.LeaderToken, "leader-token", getEnv("SQLITE_SERVER_LEADER_TOKEN", ""), "Admin API key used to authenticate against the leader")
+	fs.StringVar(&cfg.LeaderCA, "leader-ca", getEnv("SQLITE_SERVER_LEADER_CA", ""), "PEM CA bundle that verifies an https leader (defaults to the system roots)")
+
+	// Observability Settings
+	fs.BoolVar(&cfg.MetricsEnabled, "metrics-enabled", getEnvBool("SQLITE_SERVER_METRICS_ENABLED", true), "Expose Prometheus metrics on /metrics")
+	fs.StringVar(&cfg.TraceExporter, "trace-exporter", getEnv("SQLITE_SERVER_TRACE_EXPORTER", "none"), "OpenTelemetry span exporter: 'none', 'otlp', 'stdout' or 'file'")
+	fs.StringVar(&cfg.TraceEndpoint, "trace-endpoint", getEnv("SQLITE_SERVER_TRACE_ENDPOINT", ""), "OTLP/HTTP endpoint URL (defaults to the OTEL_EXPORTER_OTLP_* environment)")
+	fs.StringVar(&cfg.TraceFile, "trace-file", getEnv("SQLITE_SERVER_TRACE_FILE", "traces.jsonl"), "Output file for --trace-exporter=file")
+	fs.Float64Var(&cfg.TraceSampleRatio, "trace-sample-ratio", getEnvFloat("SQLITE_SERVER_TRACE_SAMPLE_RATIO", 1), "Fraction of new traces to sample (0-1]")
+	fs.StringVar(&cfg.LogFormat, "log-format", getEnv("SQLITE_SERVER_LOG_FORMAT", "text"), "Log output format: 'text' or 'json'")
+	fs.StringVar(&cfg.LogLevel, "log-level", getEnv("SQLITE_SERVER_LOG_LEVEL", "info"), "Default log level: 'debug', 'info', 'warn' or 'error'")
+	fs.StringVar(&cfg.LogLevels, "log-levels", getEnv("SQLITE_SERVER_LOG_LEVELS", ""), "Per-subsystem log levels (e.g. 'pubsub=debug,auth=warn')")
+
+	// Audit Settings
+	fs.BoolVar(&cfg.AuditEnabled, "audit-enabled", getEnvBool("SQLITE_SERVER_AUDIT_ENABLED", true), "Record administrative and data-changing operations in the audit log")
+	fs.BoolVar(&cfg.AuditRedactParams, "audit-redact-params", getEnvBool("SQLITE_SERVER_AUDIT_REDACT_PARAMS", false), "Leave SQL parameters out of audit events")
+
+	// Password Hashing Settings (argon2id)
+	fs.IntVar(&cfg.PasswordMemory, "password-memory", getEnvInt("SQLITE_SERVER_PASSWORD_MEMORY", int(auth.DefaultPasswordParams.Memory)), "argon2id memory per password hash in KiB")
+	fs.IntVar(&cfg.PasswordIterations, "password-iterations", getEnvInt("SQLITE_SERVER_PASSWORD_ITERATIONS", int(auth.DefaultPasswordParams.Iterations)), "argon2id passes per password hash")
+	fs.IntVar(&cfg.PasswordParallelism, "password-parallelism", getEnvInt("SQLITE_SERVER_PASSWORD_PARALLELISM", int(auth.DefaultPasswordParams.Parallelism)), "argon2id lanes per password hash")
+
+	// Login Lockout Settings
+	fs.IntVar(&cfg.LockoutAttempts, "lockout-attempts", getEnvInt("SQLITE_SERVER_LOCKOUT_ATTEMPTS", auth.DefaultLockoutPolicy.UserAttempts), "Failed logins per username before backoff starts (-1 disables)")
+	fs.IntVar(&cfg.LockoutIPAttempts, "lockout-ip-attempts", getEnvInt("SQLITE_SERVER_LOCKOUT_IP_ATTEMPTS", auth.DefaultLockoutPolicy.IPAttempts), "Failed logins per client IP before backoff starts (-1 disables)")
+	fs.IntVar(&cfg.LockoutMaxSeconds, "lockout-max-seconds", getEnvInt("SQLITE_SERVER_LOCKOUT_MAX_SECONDS", int(auth.DefaultLockoutPolicy.MaxDelay.Seconds())), "Longest login lockout in seconds")
+
+	// JWT Bearer Token Settings (OIDC)
+	fs.StringVar(&cfg.JWTJWKS, "jwt-jwks", getEnv("SQLITE_SERVER_JWT_JWKS", ""), "JWKS file or URL used to verify JWT bearer tokens (enables JWT auth)")
+	fs.StringVar(&cfg.JWTIssuer, "jwt-issuer", getEnv("SQLITE_SERVER_JWT_ISSUER", ""), "Required issuer (iss) of JWT bearer tokens")
+	fs.StringVar(&cfg.JWTAudience, "jwt-audience", getEnv("SQLITE_SERVER_JWT_AUDIENCE", ""), "Required audience (aud) of JWT bearer tokens")
+	fs.StringVar(&cfg.JWTUsernameClaim, "jwt-username-claim", getEnv("SQLITE_SERVER_JWT_USERNAME_CLAIM", "sub"), "JWT claim used as the username")
+	fs.StringVar(&cfg.JWTRoleClaim, "jwt-role-claim", getEnv("SQLITE_SERVER_JWT_ROLE_CLAIM", "role"), "JWT claim holding the role or roles (dots reach nested claims)")
+	fs.StringVar(&cfg.JWTRoleMap, "jwt-role-map", getEnv("SQLITE_SERVER_JWT_ROLE_MAP", ""), "Role claim values to roles (e.g. 'db-admins=admin,analysts=read_only'); empty matches role names")
+	fs.StringVar(&cfg.JWTDatabasesClaim, "jwt-databases-claim", getEnv("SQLITE_SERVER_JWT_DATABASES_CLAIM", ""), "Optional JWT claim listing the databases a token may use")
+
+	// TLS Settings
+	fs.StringVar(&cfg.TLSCert, "tls-cert", getEnv("SQLITE_SERVER_TLS_CERT", ""), "PEM certificate file (serves HTTPS with --tls-key; reloaded on SIGHUP)")
+	fs.StringVar(&cfg.TLSKey, "tls-key", getEnv("SQLITE_SERVER_TLS_KEY", ""), "PEM private key file")
+	fs.StringVar(&cfg.TLSClientCA, "tls-client-ca", getEnv("SQLITE_SERVER_TLS_CLIENT_CA", ""), "PEM CA bundle for client certificates (enables mTLS login)")
+	fs.BoolVar(&cfg.TLSRequireClientCert, "tls-require-client-cert", getEnvBool("SQLITE_SERVER_TLS_REQUIRE_CLIENT_CERT", false), "Reject TLS clients without a valid client certificate")
+	fs.StringVar(&cfg.TLSClientIdentity, "tls-client-identity", getEnv("SQLITE_SERVER_TLS_CLIENT_IDENTITY", "cn"), "Client certificate field naming the user: cn, dns, email or uri")
+
+	// Unix Domain Socket Settings
+	fs.StringVar(&cfg.UnixSocket, "unix-socket", getEnv("SQLITE_SERVER_UNIX_SOCKET", ""), "Also serve on this Unix domain socket (--port=-1 serves only the socket)")
+	fs.StringVar(&cfg.UnixSocketMode, "unix-socket-mode", getEnv("SQLITE_SERVER_UNIX_SOCKET_MODE", "0660"), "Octal permissions of the Unix socket file")
+	fs.StringVar(&cfg.UnixPeerMap, "unix-peer-map", getEnv("SQLITE_SERVER_UNIX_PEER_MAP", ""), "Unix peer UIDs to users or roles (e.g. '1000=app,33=role:read_only'); Linux only")
+
+	// Query Limits
+	fs.StringVar(&cfg.RoleMaxExecutionTime, "role-max-execution-time", getEnv("SQLITE_SERVER_ROLE_MAX_EXECUTION_TIME", ""), "Longest a statement may run per role (e.g. 'read_only=5s,read_write=1m'); databases can set their own cap")
+
 	// Custom Usage to support --flag style and clean documentation
 	fs.Usage = func() {
 		fmt.Fprintf(os.Stderr, "Usage: %s [flags]\n\nFlags:\n", os.Args[0])
@@ -103,30 +172,35 @@ func parseFlags() *server.Config {
 	}
 
 	if err := fs.Parse(os.Args[1:]); err != nil {
-		log.Fatalf("Fatal: failed to parse flags: %v", err)
+		fatal("Fatal: failed to parse flags", err)
 	}
 
 	if fs.NArg() > 0 {
-		log.Fatalf("Fatal: unexpected positional arguments: %v", fs.Args())
+		fatal("Fatal: unexpected positional arguments", fmt.Errorf("%v", fs.Args()))
 	}
 
 	return cfg
 }
 
 // handleGracefulShutdown waits for termination signals and shuts down the server cleanly.
+// SIGHUP reloads the TLS certificates instead.
 func handleGracefulShutdown(srv *server.Server, timeout int) {
 	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
 
-	<-quit
-	log.Println("Shutting down server...")
+	for sig := <-quit; sig == syscall.SIGHUP; sig = <-quit {
+		if err := srv.ReloadTLS(); err != nil {
+			logger.Error("TLS reload failed", logging.Err(err))
+		}
+	}
+	logger.Info("Received shutdown signal")
 
 	// Create a context with timeout for the graceful shutdown period
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeout)*time.Second)
 	defer cancel()
 
 	if err := srv.Stop(ctx); err != nil {
-		log.Printf("Shutdown error: %v", err)
+		logger.Error("Shutdown error", logging.Err(err))
 	}
 }
 
@@ -151,6 +225,19 @@ func getEnvInt(key string, fallback int) int {
 	return value
 }
 
+// getEnvFloat retrieves an environment variable as a float or returns a fallback.
+func getEnvFloat(key string, fallback float64) float64 {
+	valueStr, ok := os.LookupEnv(key)
+	if !ok {
+		return fallback
+	}
+	value, err := strconv.ParseFloat(valueStr, 64)
+	if err != nil {
+		return fallback
+	}
+	return value
+}
+
 // getEnvBool retrieves an environment variable as a boolean or returns a fallback.
 func getEnvBool(key string, fallback bool) bool {
 	valueStr, ok := os.LookupEnv(key)
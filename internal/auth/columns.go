@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+)
+
+// ============================================================================
+// Column Policy Operations
+// ============================================================================
+
+const columnPolicySelect = `
+	SELECT id, database_name, table_name, column_name, action, role, username, created_at
+	FROM column_policies
+`
+
+// SetColumnPolicy stores a column policy for either a role or a user. Exactly one of
+// policy.Role and policy.Username must be set. Setting the same column and subject
+// again replaces the action.
+func (s *MetaStore) SetColumnPolicy(ctx context.Context, policy ColumnPolicy) (*ColumnPolicy, error) {
+	if (policy.Role == sqlrpcv1.Role_ROLE_UNSPECIFIED) == (policy.Username == "") {
+		return nil, fmt.Errorf("exactly one of role or username is required")
+	}
+	if policy.Database == "" || policy.Table == "" || policy.Column == "" {
+		return nil, fmt.Errorf("invalid column policy: database, table and column are required")
+	}
+	if policy.Action == sqlrpcv1.ColumnAction_COLUMN_ACTION_UNSPECIFIED {
+		return nil, fmt.Errorf("invalid column policy: action is required")
+	}
+
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO column_policies (database_name, table_name, column_name, action, role, username)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT(database_name, table_name, column_name, role, username)
+		DO UPDATE SET action = excluded.action
+	`, policy.Database, policy.Table, policy.Column, policy.Action, policy.Role, policy.Username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set column policy: %w", err)
+	}
+
+	policies, err := s.queryColumnPolicies(ctx,
+		"database_name = ? AND table_name = ? AND column_name = ? AND role = ? AND username = ?",
+		policy.Database, policy.Table, policy.Column, policy.Role, policy.Username)
+	if err != nil {
+		return nil, err
+	}
+	if len(policies) == 0 {
+		return nil, fmt.Errorf("failed to set column policy: policy not persisted")
+	}
+	return &policies[0], nil
+}
+
+// DeleteColumnPolicy removes a column policy by ID.
+func (s *MetaStore) DeleteColumnPolicy(ctx context.Context, id int64) error {
+	result, err := s.db.ExecContext(ctx, "DELETE FROM column_policies WHERE id = ?", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete column policy: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return fmt.Errorf("column policy not found: %d", id)
+	}
+	return nil
+}
+
+// ListColumnPolicies returns the column policies of database, or of every database
+// when database is empty.
+func (s *MetaStore) ListColumnPolicies(ctx context.Context, database string) ([]ColumnPolicy, error) {
+	return s.queryColumnPolicies(ctx, "? = '' OR database_name = ?", database, database)
+}
+
+func (s *MetaStore) queryColumnPolicies(ctx context.Context, where string, args ...any) ([]ColumnPolicy, error) {
+	rows, err := s.db.QueryContext(ctx, columnPolicySelect+" WHERE "+where+" ORDER BY database_name, table_name, column_name, id", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list column policies: %w", err)
+	}
+	defer rows.Close()
+
+	var policies []ColumnPolicy
+	for rows.Next() {
+		var p ColumnPolicy
+		if err := rows.Scan(&p.ID, &p.Database, &p.Table, &p.Column, &p.Action, &p.Role, &p.Username, &p.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan column policy: %w", err)
+		}
+		policies = append(policies, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list column policies iteration failed: %w", err)
+	}
+
+	return policies, nil
+}
@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+)
+
+func TestMetaStore_ColumnPolicies(t *testing.T) {
+	store, err := NewMetaStore(filepath.Join(t.TempDir(), "test_columns.db"))
+	require.NoError(t, err)
+	defer func() { store.Close() }()
+	ctx := context.Background()
+
+	readers, err := store.SetColumnPolicy(ctx, ColumnPolicy{
+		Database: "app", Table: "users", Column: "password_hash",
+		Action: sqlrpcv1.ColumnAction_COLUMN_ACTION_DENY, Role: sqlrpcv1.Role_ROLE_READ_ONLY,
+	})
+	require.NoError(t, err)
+	assert.Positive(t, readers.ID)
+	assert.Empty(t, readers.Username)
+	assert.False(t, readers.CreatedAt.IsZero())
+
+	// The same column and subject replaces the action in place
+	masked, err := store.SetColumnPolicy(ctx, ColumnPolicy{
+		Database: "app", Table: "USERS", Column: "Password_Hash",
+		Action: sqlrpcv1.ColumnAction_COLUMN_ACTION_MASK, Role: sqlrpcv1.Role_ROLE_READ_ONLY,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, readers.ID, masked.ID)
+	assert.Equal(t, sqlrpcv1.ColumnAction_COLUMN_ACTION_MASK, masked.Action)
+
+	alice, err := store.SetColumnPolicy(ctx, ColumnPolicy{
+		Database: "app", Table: "customers", Column: "ssn",
+		Action: sqlrpcv1.ColumnAction_COLUMN_ACTION_MASK, Username: "alice",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, sqlrpcv1.Role_ROLE_UNSPECIFIED, alice.Role)
+
+	_, err = store.SetColumnPolicy(ctx, ColumnPolicy{
+		Database: "other", Table: "t", Column: "c",
+		Action: sqlrpcv1.ColumnAction_COLUMN_ACTION_DENY, Username: "alice",
+	})
+	require.NoError(t, err)
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := store.SetColumnPolicy(ctx, ColumnPolicy{Database: "app", Table: "t", Column: "c", Action: sqlrpcv1.ColumnAction_COLUMN_ACTION_DENY})
+		assert.ErrorContains(t, err, "exactly one")
+		_, err = store.SetColumnPolicy(ctx, ColumnPolicy{Database: "app", Table: "t", Column: "c", Action: sqlrpcv1.ColumnAction_COLUMN_ACTION_DENY,
+			Role: sqlrpcv1.Role_ROLE_READ_ONLY, Username: "alice"})
+		assert.ErrorContains(t, err, "exactly one")
+		_, err = store.SetColumnPolicy(ctx, ColumnPolicy{Database: "app", Table: "t", Column: "c", Username: "alice"})
+		assert.ErrorContains(t, err, "action is required")
+	})
+
+	policies, err := store.ListColumnPolicies(ctx, "app")
+	require.NoError(t, err)
+	require.Len(t, policies, 2)
+	assert.Equal(t, "customers", policies[0].Table)
+	assert.Equal(t, "users", policies[1].Table)
+
+	all, err := store.ListColumnPolicies(ctx, "")
+	require.NoError(t, err)
+	assert.Len(t, all, 3)
+
+	require.NoError(t, store.DeleteColumnPolicy(ctx, alice.ID))
+	assert.ErrorContains(t, store.DeleteColumnPolicy(ctx, alice.ID), "column policy not found")
+}
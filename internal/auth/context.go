@@ -20,12 +20,13 @@ func FromContext(ctx context.Context) (*UserClaims, bool) {
 	return claims, ok
 }
 
-// IsReadOnly checks if the user in context has the ReadOnly role.
-// Returns true if unauthenticated (fail-safe) or if explicit ReadOnly role.
-func IsReadOnly(ctx context.Context) bool {
+// IsReadOnly checks if the user in context lacks write access to the database.
+// Returns true if unauthenticated (fail-safe) or if the effective role on the
+// database (see UserClaims.RoleFor) is below ReadWrite.
+func IsReadOnly(ctx context.Context, database string) bool {
 	claims, ok := FromContext(ctx)
 	if !ok {
 		return true
 	}
-	return claims.Role == sqlrpcv1.Role_ROLE_READ_ONLY
+	return claims.RoleFor(database) < sqlrpcv1.Role_ROLE_READ_WRITE
 }
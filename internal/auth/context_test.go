@@ -12,24 +12,36 @@ import (
 func TestIsReadOnly(t *testing.T) {
 	t.Run("returns true when unauthenticated", func(t *testing.T) {
 		ctx := context.Background()
-		assert.True(t, IsReadOnly(ctx))
+		assert.True(t, IsReadOnly(ctx, "main"))
 	})
 
 	t.Run("returns true for ROLE_READ_ONLY", func(t *testing.T) {
 		claims := &UserClaims{Role: sqlrpcv1.Role_ROLE_READ_ONLY}
 		ctx := NewContext(context.Background(), claims)
-		assert.True(t, IsReadOnly(ctx))
+		assert.True(t, IsReadOnly(ctx, "main"))
 	})
 
 	t.Run("returns false for ROLE_READ_WRITE", func(t *testing.T) {
 		claims := &UserClaims{Role: sqlrpcv1.Role_ROLE_READ_WRITE}
 		ctx := NewContext(context.Background(), claims)
-		assert.False(t, IsReadOnly(ctx))
+		assert.False(t, IsReadOnly(ctx, "main"))
 	})
 
 	t.Run("returns false for ROLE_ADMIN", func(t *testing.T) {
 		claims := &UserClaims{Role: sqlrpcv1.Role_ROLE_ADMIN}
 		ctx := NewContext(context.Background(), claims)
-		assert.False(t, IsReadOnly(ctx))
+		assert.False(t, IsReadOnly(ctx, "main"))
+	})
+
+	t.Run("uses the per-database grant", func(t *testing.T) {
+		claims := &UserClaims{
+			Role: sqlrpcv1.Role_ROLE_READ_ONLY,
+			Grants: []DatabaseGrant{
+				{Database: "tenant_a", Role: sqlrpcv1.Role_ROLE_READ_WRITE},
+			},
+		}
+		ctx := NewContext(context.Background(), claims)
+		assert.False(t, IsReadOnly(ctx, "tenant_a"))
+		assert.True(t, IsReadOnly(ctx, "tenant_b"))
 	})
 }
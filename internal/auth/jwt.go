@@ -0,0 +1,501 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	_ "crypto/sha256" // Registers SHA-256 for crypto.Hash
+	_ "crypto/sha512" // Registers SHA-384 and SHA-512 for crypto.Hash
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"sqlite-server/internal/logging"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+)
+
+// JWKS keys are reloaded when a token names an unknown key ID, at most once per
+// jwksMinRefresh so that forged key IDs cannot hammer the identity provider, and in any
+// case once they are older than jwksMaxAge to pick up rotations.
+const (
+	jwksMinRefresh = time.Minute
+	jwksMaxAge     = time.Hour
+	jwksMaxSize    = 1 << 20
+)
+
+// JWTConfig configures the verification of JWT bearer tokens issued by an external
+// identity provider.
+type JWTConfig struct {
+	JWKS           string                   // Path to a JWKS file or http(s) URL of a JWKS endpoint
+	Issuer         string                   // Required value of the "iss" claim
+	Audience       string                   // Value the "aud" claim must contain
+	UsernameClaim  string                   // Claim used as the username (default "sub")
+	RoleClaim      string                   // Claim holding the role or roles (default "role")
+	RoleMap        map[string]sqlrpcv1.Role // Claim values to roles; nil matches role names like "read_write"
+	DatabasesClaim string                   // Optional claim listing the databases (names or globs) the token may use
+	Leeway         time.Duration            // Clock skew tolerated on "exp" and "nbf"
+	HTTPClient     *http.Client             // Client for JWKS URLs (default: 10 second timeout)
+}
+
+// JWTVerifier validates JWT bearer tokens against a JWKS and maps their claims to
+// UserClaims. Token identities have no row in users.
+type JWTVerifier struct {
+	cfg JWTConfig
+
+	mu       sync.RWMutex
+	keys     []jwk
+	loadedAt time.Time
+
+	// refreshMu serializes JWKS reloads.
+	refreshMu sync.Mutex
+}
+
+// jwk is one usable signing key of a JWKS.
+type jwk struct {
+	kid string
+	alg string // Empty if the key does not pin an algorithm
+	key crypto.PublicKey
+}
+
+// NewJWTVerifier checks cfg and loads the JWKS.
+func NewJWTVerifier(ctx context.Context, cfg JWTConfig) (*JWTVerifier, error) {
+	if cfg.JWKS == "" {
+		return nil, errors.New("jwks file or url is required")
+	}
+	if cfg.Issuer == "" {
+		return nil, errors.New("jwt issuer is required")
+	}
+	if cfg.Audience == "" {
+		return nil, errors.New("jwt audience is required")
+	}
+	if cfg.UsernameClaim == "" {
+		cfg.UsernameClaim = "sub"
+	}
+	if cfg.RoleClaim == "" {
+		cfg.RoleClaim = "role"
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	v := &JWTVerifier{cfg: cfg}
+	if err := v.refresh(ctx); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// LooksLikeJWT reports whether a bearer token has the shape of a compact JWS, as
+// opposed to an API key.
+func LooksLikeJWT(token string) bool {
+	return strings.HasPrefix(token, "eyJ") && strings.Count(token, ".") == 2
+}
+
+// ParseRoleMap parses "value=role" pairs separated by commas, e.g.
+// "db-admins=admin,analysts=read_only". Roles use their stored names.
+func ParseRoleMap(spec string) (map[string]sqlrpcv1.Role, error) {
+	if strings.TrimSpace(spec) == "" {
+		return nil, nil
+	}
+	roles := make(map[string]sqlrpcv1.Role)
+	for pair := range strings.SplitSeq(spec, ",") {
+		value, name, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		role := ParseRole(dbRole(strings.TrimSpace(name)))
+		if !ok || strings.TrimSpace(value) == "" || role == sqlrpcv1.Role_ROLE_UNSPECIFIED {
+			return nil, fmt.Errorf("invalid role mapping %q, want value=role", pair)
+		}
+		roles[strings.TrimSpace(value)] = role
+	}
+	return roles, nil
+}
+
+// Verify checks the token signature, issuer, audience and validity period and returns
+// the identity it carries. Errors describe why the token was rejected.
+func (v *JWTVerifier) Verify(ctx context.Context, token string) (*UserClaims, error) {
+	headerPart, rest, _ := strings.Cut(token, ".")
+	payloadPart, sigPart, ok := strings.Cut(rest, ".")
+	if !ok {
+		return nil, errors.New("malformed jwt")
+	}
+
+	var header struct {
+		Alg  string   `json:"alg"`
+		Kid  string   `json:"kid"`
+		Crit []string `json:"crit"`
+	}
+	if err := decodeSegment(headerPart, &header); err != nil {
+		return nil, fmt.Errorf("malformed jwt header: %w", err)
+	}
+	if _, ok := jwsHashes[header.Alg]; !ok {
+		return nil, fmt.Errorf("unsupported jwt alg %q", header.Alg)
+	}
+	if len(header.Crit) > 0 {
+		return nil, fmt.Errorf("unsupported critical jwt headers %v", header.Crit)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(sigPart)
+	if err != nil {
+		return nil, fmt.Errorf("malformed jwt signature: %w", err)
+	}
+
+	keys := v.signingKeys(ctx, header.Kid)
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no jwks key matches kid %q", header.Kid)
+	}
+	signed := []byte(token[:len(headerPart)+1+len(payloadPart)])
+	verified := false
+	for _, k := range keys {
+		if k.alg != "" && k.alg != header.Alg {
+			continue
+		}
+		if err = verifySignature(header.Alg, k.key, signed, sig); err == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		if err == nil {
+			err = fmt.Errorf("no jwks key allows alg %q", header.Alg)
+		}
+		return nil, err
+	}
+
+	var claims map[string]any
+	if err := decodeSegment(payloadPart, &claims); err != nil {
+		return nil, fmt.Errorf("malformed jwt claims: %w", err)
+	}
+	return v.userClaims(claims, time.Now())
+}
+
+// userClaims validates the registered claims and maps the rest to an identity.
+func (v *JWTVerifier) userClaims(claims map[string]any, now time.Time) (*UserClaims, error) {
+	if iss, _ := claims["iss"].(string); iss != v.cfg.Issuer {
+		return nil, fmt.Errorf("unexpected jwt issuer %q", iss)
+	}
+	if !slices.Contains(claimStrings(claims["aud"]), v.cfg.Audience) {
+		return nil, fmt.Errorf("jwt audience does not include %q", v.cfg.Audience)
+	}
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return nil, errors.New("jwt has no expiry")
+	}
+	expiresAt := time.Unix(int64(exp), 0)
+	if !now.Before(expiresAt.Add(v.cfg.Leeway)) {
+		return nil, errors.New("jwt expired")
+	}
+	if nbf, ok := claims["nbf"].(float64); ok && now.Add(v.cfg.Leeway).Before(time.Unix(int64(nbf), 0)) {
+		return nil, errors.New("jwt not valid yet")
+	}
+
+	username, _ := claimValue(claims, v.cfg.UsernameClaim).(string)
+	if username == "" {
+		return nil, fmt.Errorf("jwt has no %q claim", v.cfg.UsernameClaim)
+	}
+
+	role := sqlrpcv1.Role_ROLE_UNSPECIFIED
+	for _, value := range claimStrings(claimValue(claims, v.cfg.RoleClaim)) {
+		mapped := ParseRole(dbRole(value))
+		if v.cfg.RoleMap != nil {
+			mapped = v.cfg.RoleMap[value]
+		}
+		role = max(role, mapped)
+	}
+	if role == sqlrpcv1.Role_ROLE_UNSPECIFIED {
+		return nil, fmt.Errorf("jwt %q claim maps to no role", v.cfg.RoleClaim)
+	}
+
+	user := &UserClaims{
+		Username:  username,
+		Role:      role,
+		Issuer:    v.cfg.Issuer,
+		ExpiresAt: expiresAt,
+	}
+	if v.cfg.DatabasesClaim != "" {
+		for _, db := range claimStrings(claimValue(claims, v.cfg.DatabasesClaim)) {
+			user.Grants = append(user.Grants, DatabaseGrant{Username: username, Database: db, Role: role})
+		}
+	}
+	return user, nil
+}
+
+// claimValue looks up a claim by name, falling back to a dotted path through nested
+// objects (e.g. "realm_access.roles") when no top-level claim has that name.
+func claimValue(claims map[string]any, name string) any {
+	if value, ok := claims[name]; ok {
+		return value
+	}
+	var value any = claims
+	for part := range strings.SplitSeq(name, ".") {
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = obj[part]
+	}
+	return value
+}
+
+// claimStrings returns a string claim or the strings of an array claim.
+func claimStrings(value any) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		var out []string
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+// signingKeys returns the keys a token with the given key ID may be signed with,
+// reloading the JWKS when the ID is unknown or the keys are stale.
+func (v *JWTVerifier) signingKeys(ctx context.Context, kid string) []jwk {
+	keys, fresh := v.matchingKeys(kid)
+	if len(keys) == 0 || !fresh {
+		if err := v.refresh(ctx); err != nil {
+			logging.For(logging.Auth).Warn("Failed to reload JWKS", "jwks", v.cfg.JWKS, logging.Err(err))
+		}
+		keys, _ = v.matchingKeys(kid)
+	}
+	return keys
+}
+
+// matchingKeys returns the loaded keys for kid (all keys if kid is empty) and whether
+// they are younger than jwksMaxAge.
+func (v *JWTVerifier) matchingKeys(kid string) ([]jwk, bool) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	var keys []jwk
+	for _, k := range v.keys {
+		if kid == "" || k.kid == kid {
+			keys = append(keys, k)
+		}
+	}
+	return keys, time.Since(v.loadedAt) < jwksMaxAge
+}
+
+// refresh reloads the JWKS unless it was loaded less than jwksMinRefresh ago.
+func (v *JWTVerifier) refresh(ctx context.Context) error {
+	v.refreshMu.Lock()
+	defer v.refreshMu.Unlock()
+	v.mu.RLock()
+	recent := !v.loadedAt.IsZero() && time.Since(v.loadedAt) < jwksMinRefresh
+	v.mu.RUnlock()
+	if recent {
+		return nil
+	}
+
+	data, err := v.readJWKS(ctx)
+	if err != nil {
+		return err
+	}
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return err
+	}
+
+	v.mu.Lock()
+	v.keys, v.loadedAt = keys, time.Now()
+	v.mu.Unlock()
+	return nil
+}
+
+// readJWKS fetches the raw JWKS document from the configured file or URL.
+func (v *JWTVerifier) readJWKS(ctx context.Context) ([]byte, error) {
+	src := v.cfg.JWKS
+	if !strings.HasPrefix(src, "https://") && !strings.HasPrefix(src, "http://") {
+		data, err := os.ReadFile(src)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read jwks: %w", err)
+		}
+		return data, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, src, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch jwks: %w", err)
+	}
+	res, err := v.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch jwks: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch jwks: %s", res.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(res.Body, jwksMaxSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch jwks: %w", err)
+	}
+	return data, nil
+}
+
+// parseJWKS extracts the signing keys of a JWKS document. Encryption keys and key
+// types other than RSA, EC and Ed25519 are skipped.
+func parseJWKS(data []byte) ([]jwk, error) {
+	var set struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			Alg string `json:"alg"`
+			Crv string `json:"crv"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		} `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("malformed jwks: %w", err)
+	}
+
+	var keys []jwk
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		var key crypto.PublicKey
+		var err error
+		switch k.Kty {
+		case "RSA":
+			key, err = rsaKey(k.N, k.E)
+		case "EC":
+			key, err = ecKey(k.Crv, k.X, k.Y)
+		case "OKP":
+			if k.Crv != "Ed25519" {
+				continue
+			}
+			var x []byte
+			if x, err = base64.RawURLEncoding.DecodeString(k.X); err == nil && len(x) != ed25519.PublicKeySize {
+				err = errors.New("bad Ed25519 key length")
+			}
+			key = ed25519.PublicKey(x)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("malformed jwks key %q: %w", k.Kid, err)
+		}
+		keys = append(keys, jwk{kid: k.Kid, alg: k.Alg, key: key})
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("jwks contains no signing keys")
+	}
+	return keys, nil
+}
+
+func rsaKey(n, e string) (*rsa.PublicKey, error) {
+	nb, err := base64.RawURLEncoding.DecodeString(n)
+	if err != nil {
+		return nil, err
+	}
+	eb, err := base64.RawURLEncoding.DecodeString(e)
+	if err != nil {
+		return nil, err
+	}
+	exp := new(big.Int).SetBytes(eb)
+	if len(nb) < 256 || !exp.IsInt64() || exp.Int64() < 3 || exp.Int64() > 1<<31-1 {
+		return nil, errors.New("unsupported RSA key size or exponent")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(nb), E: int(exp.Int64())}, nil
+}
+
+func ecKey(crv, x, y string) (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve %q", crv)
+	}
+	xb, err := base64.RawURLEncoding.DecodeString(x)
+	if err != nil {
+		return nil, err
+	}
+	yb, err := base64.RawURLEncoding.DecodeString(y)
+	if err != nil {
+		return nil, err
+	}
+	size := (curve.Params().BitSize + 7) / 8
+	if len(xb) != size || len(yb) != size {
+		return nil, errors.New("bad EC coordinate length")
+	}
+	// Rejects points that are not on the curve
+	return ecdsa.ParseUncompressedPublicKey(curve, append(append([]byte{4}, xb...), yb...))
+}
+
+// jwsHashes are the digests of the supported asymmetric JWS algorithms. EdDSA signs
+// the message itself.
+var jwsHashes = map[string]crypto.Hash{
+	"RS256": crypto.SHA256, "RS384": crypto.SHA384, "RS512": crypto.SHA512,
+	"PS256": crypto.SHA256, "PS384": crypto.SHA384, "PS512": crypto.SHA512,
+	"ES256": crypto.SHA256, "ES384": crypto.SHA384, "ES512": crypto.SHA512,
+	"EdDSA": 0,
+}
+
+// ecdsaKeySizes binds each ECDSA algorithm to its curve, by coordinate size in bytes.
+var ecdsaKeySizes = map[string]int{"ES256": 32, "ES384": 48, "ES512": 66}
+
+// verifySignature checks a JWS signature made with a supported algorithm.
+func verifySignature(alg string, key crypto.PublicKey, signed, sig []byte) error {
+	var digest []byte
+	hash := jwsHashes[alg]
+	if hash != 0 {
+		h := hash.New()
+		h.Write(signed)
+		digest = h.Sum(nil)
+	}
+
+	valid := false
+	switch pub := key.(type) {
+	case ed25519.PublicKey:
+		valid = alg == "EdDSA" && ed25519.Verify(pub, signed, sig)
+	case *rsa.PublicKey:
+		switch alg[:2] {
+		case "RS":
+			valid = rsa.VerifyPKCS1v15(pub, hash, digest, sig) == nil
+		case "PS":
+			valid = rsa.VerifyPSS(pub, hash, digest, sig, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}) == nil
+		}
+	case *ecdsa.PublicKey:
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if ecdsaKeySizes[alg] == size && len(sig) == 2*size {
+			r, s := new(big.Int).SetBytes(sig[:size]), new(big.Int).SetBytes(sig[size:])
+			valid = ecdsa.Verify(pub, digest, r, s)
+		}
+	}
+	if !valid {
+		return errors.New("invalid jwt signature")
+	}
+	return nil
+}
+
+// decodeSegment decodes a base64url JSON segment of a JWT.
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
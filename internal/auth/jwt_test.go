@@ -0,0 +1,316 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+)
+
+const (
+	testIssuer   = "https://idp.example.com"
+	testAudience = "sqlite-server"
+)
+
+// testKeys holds one signing key per supported key type.
+type testKeys struct {
+	kidPrefix string
+	rsa       *rsa.PrivateKey
+	ec        *ecdsa.PrivateKey
+	ed        ed25519.PrivateKey
+}
+
+func newTestKeys(t *testing.T) testKeys {
+	t.Helper()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return testKeys{rsa: rsaKey, ec: ecKey, ed: edKey}
+}
+
+// jwks renders the public keys as a JWKS document with kids "rsa", "ec" and "ed" after
+// the kid prefix.
+func (k testKeys) jwks(t *testing.T) []byte {
+	t.Helper()
+	b64 := base64.RawURLEncoding.EncodeToString
+	ecPoint, err := k.ec.PublicKey.Bytes()
+	require.NoError(t, err)
+	data, err := json.Marshal(map[string]any{"keys": []map[string]string{
+		{"kty": "RSA", "kid": k.kidPrefix + "rsa", "use": "sig", "n": b64(k.rsa.N.Bytes()), "e": b64(big.NewInt(int64(k.rsa.E)).Bytes())},
+		{"kty": "EC", "kid": k.kidPrefix + "ec", "crv": "P-256", "x": b64(ecPoint[1:33]), "y": b64(ecPoint[33:])},
+		{"kty": "OKP", "kid": k.kidPrefix + "ed", "crv": "Ed25519", "alg": "EdDSA", "x": b64(k.ed.Public().(ed25519.PublicKey))},
+		{"kty": "RSA", "kid": k.kidPrefix + "enc", "use": "enc", "n": b64(k.rsa.N.Bytes()), "e": "AQAB"},
+	}})
+	require.NoError(t, err)
+	return data
+}
+
+// sign builds a compact JWS over claims.
+func (k testKeys) sign(t *testing.T, alg, kid string, claims map[string]any) string {
+	t.Helper()
+	header, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	digest := func(h crypto.Hash) []byte {
+		hh := h.New()
+		hh.Write([]byte(signed))
+		return hh.Sum(nil)
+	}
+	var sig []byte
+	switch alg {
+	case "RS256":
+		sig, err = rsa.SignPKCS1v15(rand.Reader, k.rsa, crypto.SHA256, digest(crypto.SHA256))
+	case "PS384":
+		sig, err = rsa.SignPSS(rand.Reader, k.rsa, crypto.SHA384, digest(crypto.SHA384), &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+	case "ES256":
+		var r, s *big.Int
+		r, s, err = ecdsa.Sign(rand.Reader, k.ec, digest(crypto.SHA256))
+		sig = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	case "EdDSA":
+		sig = ed25519.Sign(k.ed, []byte(signed))
+	default:
+		sig = []byte("signature")
+	}
+	require.NoError(t, err)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+// validClaims returns claims the default test verifier accepts, with overrides applied.
+func validClaims(overrides map[string]any) map[string]any {
+	claims := map[string]any{
+		"iss":  testIssuer,
+		"aud":  []string{"other", testAudience},
+		"sub":  "alice",
+		"role": "read_write",
+		"exp":  time.Now().Add(time.Hour).Unix(),
+	}
+	for k, v := range overrides {
+		if v == nil {
+			delete(claims, k)
+		} else {
+			claims[k] = v
+		}
+	}
+	return claims
+}
+
+func newTestVerifier(t *testing.T, keys testKeys, cfg JWTConfig) *JWTVerifier {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, keys.jwks(t), 0o600))
+	cfg.JWKS, cfg.Issuer, cfg.Audience = path, testIssuer, testAudience
+	v, err := NewJWTVerifier(context.Background(), cfg)
+	require.NoError(t, err)
+	return v
+}
+
+func TestJWTVerifier_Algorithms(t *testing.T) {
+	keys := newTestKeys(t)
+	v := newTestVerifier(t, keys, JWTConfig{})
+	ctx := context.Background()
+
+	for _, tc := range []struct{ alg, kid string }{
+		{"RS256", "rsa"},
+		{"PS384", "rsa"},
+		{"ES256", "ec"},
+		{"EdDSA", "ed"},
+		{"EdDSA", ""}, // No kid: every key is tried
+	} {
+		t.Run(tc.alg+"/"+tc.kid, func(t *testing.T) {
+			user, err := v.Verify(ctx, keys.sign(t, tc.alg, tc.kid, validClaims(nil)))
+			require.NoError(t, err)
+			assert.Equal(t, "alice", user.Username)
+			assert.Equal(t, sqlrpcv1.Role_ROLE_READ_WRITE, user.Role)
+			assert.Equal(t, testIssuer, user.Issuer)
+			assert.Zero(t, user.UserID)
+			assert.WithinDuration(t, time.Now().Add(time.Hour), user.ExpiresAt, 5*time.Second)
+		})
+	}
+
+	t.Run("rejected signatures", func(t *testing.T) {
+		token := keys.sign(t, "RS256", "rsa", validClaims(nil))
+		parts := strings.Split(token, ".")
+		forged := parts[0] + "." + base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"mallory"}`)) + "." + parts[2]
+
+		for name, token := range map[string]string{
+			"tampered payload":      forged,
+			"alg none":              keys.sign(t, "none", "rsa", validClaims(nil)),
+			"symmetric alg":         keys.sign(t, "HS256", "rsa", validClaims(nil)),
+			"alg of another key":    keys.sign(t, "ES256", "rsa", validClaims(nil)),
+			"alg pinned by the key": keys.sign(t, "RS256", "ed", validClaims(nil)),
+			"unknown kid":           keys.sign(t, "RS256", "missing", validClaims(nil)),
+			"encryption key":        keys.sign(t, "RS256", "enc", validClaims(nil)),
+			"not a jwt":             "abc.def",
+		} {
+			_, err := v.Verify(ctx, token)
+			assert.Error(t, err, name)
+		}
+	})
+}
+
+func TestJWTVerifier_Claims(t *testing.T) {
+	keys := newTestKeys(t)
+	ctx := context.Background()
+	v := newTestVerifier(t, keys, JWTConfig{Leeway: time.Minute})
+	verify := func(overrides map[string]any) (*UserClaims, error) {
+		return v.Verify(ctx, keys.sign(t, "EdDSA", "ed", validClaims(overrides)))
+	}
+
+	for name, overrides := range map[string]map[string]any{
+		"wrong issuer":    {"iss": "https://evil.example.com"},
+		"wrong audience":  {"aud": "other"},
+		"no audience":     {"aud": nil},
+		"expired":         {"exp": time.Now().Add(-2 * time.Minute).Unix()},
+		"no expiry":       {"exp": nil},
+		"not yet valid":   {"nbf": time.Now().Add(2 * time.Minute).Unix()},
+		"no subject":      {"sub": nil},
+		"no role":         {"role": nil},
+		"unknown role":    {"role": "superuser"},
+		"role not string": {"role": 40},
+	} {
+		_, err := verify(overrides)
+		assert.Error(t, err, name)
+	}
+
+	t.Run("leeway", func(t *testing.T) {
+		_, err := verify(map[string]any{"exp": time.Now().Add(-30 * time.Second).Unix(), "nbf": time.Now().Add(30 * time.Second).Unix()})
+		assert.NoError(t, err)
+	})
+
+	t.Run("highest of several roles", func(t *testing.T) {
+		user, err := verify(map[string]any{"aud": testAudience, "role": []string{"read_only", "database_manager", "unknown"}})
+		require.NoError(t, err)
+		assert.Equal(t, sqlrpcv1.Role_ROLE_DATABASE_MANAGER, user.Role)
+	})
+}
+
+func TestJWTVerifier_ClaimMapping(t *testing.T) {
+	keys := newTestKeys(t)
+	v := newTestVerifier(t, keys, JWTConfig{
+		UsernameClaim:  "preferred_username",
+		RoleClaim:      "realm_access.roles",
+		RoleMap:        map[string]sqlrpcv1.Role{"analysts": sqlrpcv1.Role_ROLE_READ_ONLY, "dbas": sqlrpcv1.Role_ROLE_ADMIN},
+		DatabasesClaim: "https://example.com/databases",
+	})
+	token := keys.sign(t, "ES256", "ec", validClaims(map[string]any{
+		"preferred_username":            "bob",
+		"role":                          "admin", // Ignored: not the configured claim
+		"realm_access":                  map[string]any{"roles": []string{"offline_access", "analysts"}},
+		"https://example.com/databases": []string{"sales", "tenant_*"},
+	}))
+
+	user, err := v.Verify(context.Background(), token)
+	require.NoError(t, err)
+	assert.Equal(t, "bob", user.Username)
+	assert.Equal(t, sqlrpcv1.Role_ROLE_READ_ONLY, user.Role)
+	assert.Equal(t, sqlrpcv1.Role_ROLE_READ_ONLY, user.RoleFor("sales"))
+	assert.Equal(t, sqlrpcv1.Role_ROLE_READ_ONLY, user.RoleFor("tenant_42"))
+	assert.Equal(t, sqlrpcv1.Role_ROLE_UNSPECIFIED, user.RoleFor("billing"), "confined to the listed databases")
+}
+
+func TestJWTVerifier_KeyRotation(t *testing.T) {
+	keys := newTestKeys(t)
+	var served atomic.Value
+	var fetches atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		w.Write(served.Load().([]byte))
+	}))
+	defer srv.Close()
+
+	served.Store(keys.jwks(t))
+	v, err := NewJWTVerifier(context.Background(), JWTConfig{JWKS: srv.URL, Issuer: testIssuer, Audience: testAudience})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, fetches.Load())
+
+	rotated := newTestKeys(t)
+	rotated.kidPrefix = "v2-"
+	served.Store(rotated.jwks(t))
+	token := rotated.sign(t, "EdDSA", "v2-ed", validClaims(nil))
+
+	_, err = v.Verify(context.Background(), token)
+	assert.Error(t, err, "the new kid is not loaded yet")
+	assert.EqualValues(t, 1, fetches.Load(), "reloads are rate limited")
+
+	v.loadedAt = v.loadedAt.Add(-jwksMinRefresh)
+	_, err = v.Verify(context.Background(), token)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, fetches.Load())
+
+	t.Run("stale keys are reloaded", func(t *testing.T) {
+		v.loadedAt = v.loadedAt.Add(-jwksMaxAge)
+		_, err = v.Verify(context.Background(), token)
+		require.NoError(t, err)
+		assert.EqualValues(t, 3, fetches.Load())
+	})
+
+	t.Run("failed reloads keep the current keys", func(t *testing.T) {
+		served.Store([]byte("not json"))
+		v.loadedAt = v.loadedAt.Add(-jwksMaxAge)
+		_, err = v.Verify(context.Background(), token)
+		require.NoError(t, err)
+	})
+}
+
+func TestNewJWTVerifier_Errors(t *testing.T) {
+	dir := t.TempDir()
+	empty := filepath.Join(dir, "empty.json")
+	require.NoError(t, os.WriteFile(empty, []byte(`{"keys":[]}`), 0o600))
+
+	for name, cfg := range map[string]JWTConfig{
+		"no jwks":        {Issuer: testIssuer, Audience: testAudience},
+		"no issuer":      {JWKS: empty, Audience: testAudience},
+		"no audience":    {JWKS: empty, Issuer: testIssuer},
+		"missing file":   {JWKS: filepath.Join(dir, "missing.json"), Issuer: testIssuer, Audience: testAudience},
+		"no usable keys": {JWKS: empty, Issuer: testIssuer, Audience: testAudience},
+	} {
+		_, err := NewJWTVerifier(context.Background(), cfg)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestParseRoleMap(t *testing.T) {
+	roles, err := ParseRoleMap(" db-admins=admin, analysts = read_only ")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]sqlrpcv1.Role{"db-admins": sqlrpcv1.Role_ROLE_ADMIN, "analysts": sqlrpcv1.Role_ROLE_READ_ONLY}, roles)
+
+	roles, err = ParseRoleMap("")
+	require.NoError(t, err)
+	assert.Nil(t, roles)
+
+	for _, bad := range []string{"admins", "=admin", "admins=root"} {
+		_, err := ParseRoleMap(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestLooksLikeJWT(t *testing.T) {
+	assert.True(t, LooksLikeJWT("eyJhbGciOiJFZERTQSJ9.eyJzdWIiOiJhIn0.c2ln"))
+	assert.False(t, LooksLikeJWT("sk_0194a1b2c3d4"))
+	assert.False(t, LooksLikeJWT("eyJhbGciOiJFZERTQSJ9.eyJzdWIiOiJhIn0"))
+}
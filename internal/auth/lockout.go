@@ -0,0 +1,180 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// Failure counters are kept per username and per client IP. Once a counter passes its
+// free attempts, every further failure locks it for BaseDelay, doubled on each failure
+// up to MaxDelay. A login is refused while either counter is locked, even with the
+// right password, and failures are forgotten after ResetAfter without new ones.
+const (
+	lockoutKindUser = "user"
+	lockoutKindIP   = "ip"
+)
+
+// LockoutPolicy controls how failed password logins are throttled.
+type LockoutPolicy struct {
+	UserAttempts int           // Failures per username before backoff starts (0 disables)
+	IPAttempts   int           // Failures per client IP before backoff starts (0 disables)
+	BaseDelay    time.Duration // First lockout, doubled on every further failure
+	MaxDelay     time.Duration // Longest lockout
+	ResetAfter   time.Duration // Quiet period after which failures are forgotten
+}
+
+// DefaultLockoutPolicy allows 5 failures per user and 20 per client IP, then backs off
+// from 1 second to a 15 minute lockout.
+var DefaultLockoutPolicy = LockoutPolicy{
+	UserAttempts: 5,
+	IPAttempts:   20,
+	BaseDelay:    time.Second,
+	MaxDelay:     15 * time.Minute,
+	ResetAfter:   time.Hour,
+}
+
+// delay returns the lockout imposed after the given number of consecutive failures.
+func (p LockoutPolicy) delay(failures, freeAttempts int) time.Duration {
+	if freeAttempts <= 0 || failures <= freeAttempts {
+		return 0
+	}
+	d := p.BaseDelay
+	for i := freeAttempts + 1; i < failures && d < p.MaxDelay; i++ {
+		d *= 2
+	}
+	return min(d, p.MaxDelay)
+}
+
+// LockedOutError reports a login refused or failed while the username or client IP is
+// locked out.
+type LockedOutError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LockedOutError) Error() string {
+	return fmt.Sprintf("too many failed login attempts, retry in %s", e.RetryAfter.Round(time.Second))
+}
+
+// SetLockoutPolicy changes how failed password logins are throttled.
+func (s *MetaStore) SetLockoutPolicy(p LockoutPolicy) {
+	s.lockout = &p
+}
+
+func (s *MetaStore) lockoutPolicy() LockoutPolicy {
+	if s.lockout == nil {
+		return DefaultLockoutPolicy
+	}
+	return *s.lockout
+}
+
+// Authenticate validates a password login from clientIP (empty if unknown) under the
+// lockout policy. It returns a *LockedOutError while the username or client IP is locked
+// out, including for the failure that triggers a lockout. Like ValidateUser, it returns
+// (nil, nil) for invalid credentials.
+func (s *MetaStore) Authenticate(ctx context.Context, username, password, clientIP string) (*UserClaims, error) {
+	now := time.Now()
+	if wait, err := s.lockedFor(ctx, username, clientIP, now); err != nil {
+		return nil, err
+	} else if wait > 0 {
+		return nil, &LockedOutError{RetryAfter: wait}
+	}
+
+	user, err := s.ValidateUser(ctx, username, password)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		wait, err := s.recordLoginFailure(ctx, username, clientIP, now)
+		if err != nil {
+			return nil, err
+		}
+		if wait > 0 {
+			return nil, &LockedOutError{RetryAfter: wait}
+		}
+		return nil, nil
+	}
+
+	// Only the username counter is cleared: a valid login must not reset the counter of
+	// an address that is guessing other accounts.
+	if _, err := s.db.ExecContext(ctx, "DELETE FROM login_failures WHERE kind = ? AND key = ?", lockoutKindUser, username); err != nil {
+		return nil, fmt.Errorf("failed to clear login failures: %w", err)
+	}
+	return user, nil
+}
+
+// lockedFor returns how long the username or client IP stays locked out.
+func (s *MetaStore) lockedFor(ctx context.Context, username, clientIP string, now time.Time) (time.Duration, error) {
+	var lockedUntil sql.NullInt64
+	err := s.db.QueryRowContext(ctx, `
+		SELECT MAX(locked_until) FROM login_failures
+		WHERE (kind = ? AND key = ?) OR (kind = ? AND key = ?)
+	`, lockoutKindUser, username, lockoutKindIP, clientIP).Scan(&lockedUntil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check login lockout: %w", err)
+	}
+	if wait := time.UnixMilli(lockedUntil.Int64).Sub(now); wait > 0 {
+		return wait, nil
+	}
+	return 0, nil
+}
+
+// recordLoginFailure counts a failed login against the username and the client IP and
+// returns the resulting lockout, if any.
+func (s *MetaStore) recordLoginFailure(ctx context.Context, username, clientIP string, now time.Time) (time.Duration, error) {
+	policy := s.lockoutPolicy()
+	var wait time.Duration
+	for _, c := range []struct {
+		kind, key string
+		attempts  int
+	}{
+		{lockoutKindUser, username, policy.UserAttempts},
+		{lockoutKindIP, clientIP, policy.IPAttempts},
+	} {
+		if c.key == "" || c.attempts <= 0 {
+			continue
+		}
+		var failures int
+		err := s.db.QueryRowContext(ctx, `
+			INSERT INTO login_failures (kind, key, failures, last_failure_at) VALUES (?, ?, 1, ?)
+			ON CONFLICT (kind, key) DO UPDATE SET
+				failures = IIF(last_failure_at < ?, 1, failures + 1),
+				last_failure_at = excluded.last_failure_at
+			RETURNING failures
+		`, c.kind, c.key, now.UnixMilli(), now.Add(-policy.ResetAfter).UnixMilli()).Scan(&failures)
+		if err != nil {
+			return 0, fmt.Errorf("failed to record login failure: %w", err)
+		}
+
+		if d := policy.delay(failures, c.attempts); d > 0 {
+			_, err := s.db.ExecContext(ctx, "UPDATE login_failures SET locked_until = ? WHERE kind = ? AND key = ?",
+				now.Add(d).UnixMilli(), c.kind, c.key)
+			if err != nil {
+				return 0, fmt.Errorf("failed to lock out login: %w", err)
+			}
+			wait = max(wait, d)
+		}
+	}
+
+	// Forget counters that went quiet so guessing random usernames cannot grow the table
+	_, err := s.db.ExecContext(ctx, "DELETE FROM login_failures WHERE last_failure_at < ? AND locked_until < ?",
+		now.Add(-policy.ResetAfter).UnixMilli(), now.UnixMilli())
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune login failures: %w", err)
+	}
+	return wait, nil
+}
+
+// UnlockUser clears the failed logins and lockout of a username and, if clientIP is
+// set, of that client IP. It reports whether anything was locked out or counted.
+func (s *MetaStore) UnlockUser(ctx context.Context, username, clientIP string) (bool, error) {
+	res, err := s.db.ExecContext(ctx, `
+		DELETE FROM login_failures WHERE (kind = ? AND key = ?) OR (kind = ? AND key = ?)
+	`, lockoutKindUser, username, lockoutKindIP, clientIP)
+	if err != nil {
+		return false, fmt.Errorf("failed to unlock user: %w", err)
+	}
+	rows, _ := res.RowsAffected()
+	return rows > 0, nil
+}
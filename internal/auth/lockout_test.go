@@ -0,0 +1,142 @@
+package auth
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+)
+
+func TestLockoutPolicy_Delay(t *testing.T) {
+	p := LockoutPolicy{BaseDelay: time.Second, MaxDelay: 10 * time.Second}
+	for failures, want := range map[int]time.Duration{
+		1:  0,
+		3:  0,
+		4:  time.Second,
+		5:  2 * time.Second,
+		7:  8 * time.Second,
+		8:  10 * time.Second,
+		60: 10 * time.Second,
+	} {
+		assert.Equal(t, want, p.delay(failures, 3), "failures=%d", failures)
+	}
+	assert.Zero(t, p.delay(100, 0), "disabled")
+}
+
+// lockedOut asserts that err is a lockout and returns its retry delay.
+func lockedOut(t *testing.T, err error) time.Duration {
+	t.Helper()
+	var locked *LockedOutError
+	require.ErrorAs(t, err, &locked)
+	return locked.RetryAfter
+}
+
+func TestMetaStore_Authenticate(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "test_lockout.db")
+	store, err := NewMetaStore(dbPath)
+	require.NoError(t, err)
+	defer func() { store.Close() }()
+	ctx := context.Background()
+
+	require.NoError(t, store.SetPasswordParams(testPasswordParams))
+	policy := LockoutPolicy{UserAttempts: 2, IPAttempts: 5, BaseDelay: 200 * time.Millisecond, MaxDelay: 400 * time.Millisecond, ResetAfter: time.Hour}
+	store.SetLockoutPolicy(policy)
+	for _, name := range []string{"alice", "bob", "carol"} {
+		_, err := store.CreateUser(ctx, name, name+"-pass", sqlrpcv1.Role_ROLE_READ_ONLY)
+		require.NoError(t, err)
+	}
+
+	t.Run("free attempts", func(t *testing.T) {
+		for range policy.UserAttempts {
+			claims, err := store.Authenticate(ctx, "alice", "wrong", "10.0.0.1")
+			require.NoError(t, err)
+			assert.Nil(t, claims)
+		}
+	})
+
+	t.Run("backoff locks out the right password too", func(t *testing.T) {
+		_, err := store.Authenticate(ctx, "alice", "wrong", "10.0.0.1")
+		assert.Equal(t, 200*time.Millisecond, lockedOut(t, err))
+
+		_, err = store.Authenticate(ctx, "alice", "alice-pass", "10.0.0.2")
+		wait := lockedOut(t, err)
+		assert.True(t, wait > 0 && wait <= 200*time.Millisecond, wait)
+	})
+
+	t.Run("lockout survives a restart", func(t *testing.T) {
+		require.NoError(t, store.Close())
+		store, err = NewMetaStore(dbPath)
+		require.NoError(t, err)
+		require.NoError(t, store.SetPasswordParams(testPasswordParams))
+		store.SetLockoutPolicy(policy)
+
+		_, err = store.Authenticate(ctx, "alice", "alice-pass", "")
+		lockedOut(t, err)
+	})
+
+	t.Run("delay doubles up to the maximum", func(t *testing.T) {
+		time.Sleep(200 * time.Millisecond)
+		_, err := store.Authenticate(ctx, "alice", "wrong", "10.0.0.1")
+		assert.Equal(t, 400*time.Millisecond, lockedOut(t, err))
+
+		time.Sleep(400 * time.Millisecond)
+		_, err = store.Authenticate(ctx, "alice", "wrong", "10.0.0.3")
+		assert.Equal(t, 400*time.Millisecond, lockedOut(t, err))
+	})
+
+	t.Run("client IP is throttled across usernames", func(t *testing.T) {
+		// 10.0.0.1 has 4 failures from alice; one more is free, the next locks it
+		claims, err := store.Authenticate(ctx, "bob", "wrong", "10.0.0.1")
+		require.NoError(t, err)
+		assert.Nil(t, claims)
+		_, err = store.Authenticate(ctx, "carol", "wrong", "10.0.0.1")
+		lockedOut(t, err)
+
+		_, err = store.Authenticate(ctx, "carol", "carol-pass", "10.0.0.1")
+		lockedOut(t, err)
+		claims, err = store.Authenticate(ctx, "carol", "carol-pass", "10.0.0.9")
+		require.NoError(t, err)
+		assert.NotNil(t, claims, "other addresses are not affected")
+	})
+
+	t.Run("unlock", func(t *testing.T) {
+		unlocked, err := store.UnlockUser(ctx, "alice", "10.0.0.1")
+		require.NoError(t, err)
+		assert.True(t, unlocked)
+
+		claims, err := store.Authenticate(ctx, "alice", "alice-pass", "10.0.0.1")
+		require.NoError(t, err)
+		assert.NotNil(t, claims)
+
+		unlocked, err = store.UnlockUser(ctx, "alice", "")
+		require.NoError(t, err)
+		assert.False(t, unlocked, "nothing left to clear")
+	})
+
+	t.Run("successful login clears the user counter", func(t *testing.T) {
+		_, err := store.Authenticate(ctx, "bob", "wrong", "")
+		require.NoError(t, err)
+		claims, err := store.Authenticate(ctx, "bob", "bob-pass", "")
+		require.NoError(t, err)
+		require.NotNil(t, claims)
+
+		for range policy.UserAttempts {
+			_, err := store.Authenticate(ctx, "bob", "wrong", "")
+			require.NoError(t, err, "the counter restarted")
+		}
+	})
+
+	t.Run("quiet counters are forgotten", func(t *testing.T) {
+		store.SetLockoutPolicy(LockoutPolicy{UserAttempts: 1, BaseDelay: time.Second, MaxDelay: time.Second, ResetAfter: 50 * time.Millisecond})
+		_, err := store.Authenticate(ctx, "carol", "wrong", "")
+		require.NoError(t, err)
+		time.Sleep(60 * time.Millisecond)
+		_, err = store.Authenticate(ctx, "carol", "wrong", "")
+		require.NoError(t, err, "the earlier failure expired")
+	})
+}
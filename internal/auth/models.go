@@ -7,20 +7,124 @@ import (
 )
 
 type User struct {
-	ID           int64     `json:"id"`
-	Username     string    `json:"username"`
-	PasswordHash string    `json:"-"` // Never export hash
+	ID           int64         `json:"id"`
+	Username     string        `json:"username"`
+	PasswordHash string        `json:"-"` // Never export hash
 	Role         sqlrpcv1.Role `json:"role"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	CreatedAt    time.Time     `json:"created_at"`
+	UpdatedAt    time.Time     `json:"updated_at"`
 }
 
 type ApiKey struct {
-	ID        string    `json:"id"` // UUID v7
-	UserID    int64     `json:"user_id"`
-	KeyPrefix string    `json:"key_prefix"`
-	KeyHash   string    `json:"-"`
+	ID        string       `json:"id"` // UUID v7
+	UserID    int64        `json:"user_id"`
+	KeyPrefix string       `json:"key_prefix"`
+	KeyHash   string       `json:"-"`
+	Name      string       `json:"name"`
+	ExpiresAt time.Time    `json:"expires_at"` // Zero if never
+	CreatedAt time.Time    `json:"created_at"`
+	Scope     *ApiKeyScope `json:"scope,omitempty"` // Nil if unrestricted
+}
+
+// ApiKeyScope narrows what a single API key may do on top of its owner's role.
+// Empty fields leave that dimension unrestricted.
+type ApiKeyScope struct {
+	Databases []string             `json:"databases,omitempty"` // Names or path.Match globs
+	Channels  []string             `json:"channels,omitempty"`  // Pub/Sub channel names or globs
+	MaxRole   sqlrpcv1.Role        `json:"max_role,omitempty"`
+	Families  []sqlrpcv1.RpcFamily `json:"families,omitempty"`
+}
+
+// DatabaseGrant assigns a role on one database (or glob of databases) to a user
+// or to a single API key. APIKeyID is empty for user-wide grants.
+type DatabaseGrant struct {
+	ID        int64         `json:"id"`
+	UserID    int64         `json:"user_id"`
+	Username  string        `json:"username"`
+	APIKeyID  string        `json:"api_key_id"`
+	Database  string        `json:"database"` // Exact name or path.Match glob
+	Role      sqlrpcv1.Role `json:"role"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+// RateLimit caps the traffic of a user or of a single API key on the databases matching
+// Database. APIKeyID is empty for user-wide limits. Zero fields are unlimited.
+type RateLimit struct {
+	ID                int64     `json:"id"`
+	UserID            int64     `json:"user_id"` // 0 when Issuer is set
+	Username          string    `json:"username"`
+	APIKeyID          string    `json:"api_key_id"`
+	Issuer            string    `json:"issuer"`   // Set for identities without a local user, see UserClaims.Issuer
+	Database          string    `json:"database"` // Exact name or path.Match glob; "*" matches every call
+	RequestsPerSecond float64   `json:"requests_per_second"`
+	Burst             int       `json:"burst"`
+	MaxStreams        int       `json:"max_streams"`
+	MaxTransactions   int       `json:"max_transactions"`
+	RowsPerMinute     int64     `json:"rows_per_minute"`
+	BytesPerMinute    int64     `json:"bytes_per_minute"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// RowPolicy limits the rows of Table in Database that non-admin callers can read,
+// update and delete to those for which Using is true. Like permissive policies in
+// PostgreSQL, a row is visible when any of its table's policies allows it.
+type RowPolicy struct {
+	ID        int64     `json:"id"`
+	Database  string    `json:"database"`
+	Table     string    `json:"table"`
 	Name      string    `json:"name"`
-	ExpiresAt time.Time `json:"expires_at"` // Zero if never
+	Using     string    `json:"using"` // SQL expression; may call current_user() and current_role()
 	CreatedAt time.Time `json:"created_at"`
 }
+
+// ColumnPolicy denies or masks reads of Table.Column in Database for the callers with
+// exactly Role on the database, or for Username. Exactly one of them is set.
+type ColumnPolicy struct {
+	ID        int64                 `json:"id"`
+	Database  string                `json:"database"`
+	Table     string                `json:"table"`
+	Column    string                `json:"column"`
+	Action    sqlrpcv1.ColumnAction `json:"action"`
+	Role      sqlrpcv1.Role         `json:"role"`
+	Username  string                `json:"username"`
+	CreatedAt time.Time             `json:"created_at"`
+}
+
+// MaintenanceRun is the recorded result of one scheduled maintenance job.
+type MaintenanceRun struct {
+	ID        int64                    `json:"id"`
+	Database  string                   `json:"database"`
+	Task      sqlrpcv1.MaintenanceTask `json:"task"`
+	StartedAt time.Time                `json:"started_at"`
+	Duration  time.Duration            `json:"duration"`
+	Success   bool                     `json:"success"`
+	Message   string                   `json:"message"`
+}
+
+// AuditEvent is one recorded administrative or data-changing operation.
+type AuditEvent struct {
+	ID        int64     `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	Actor     string    `json:"actor"`
+	APIKeyID  string    `json:"api_key_id"`
+	RPC       string    `json:"rpc"` // Full procedure, e.g. /sqlrpc.v1.AdminService/CreateUser
+	Database  string    `json:"database"`
+	SQL       string    `json:"sql"`
+	Params    string    `json:"params"`  // JSON parameters; empty when redacted
+	Details   string    `json:"details"` // JSON request payload with secrets redacted
+	Code      string    `json:"code"`    // "ok" or the connect error code
+	Error     string    `json:"error"`
+	RequestID string    `json:"request_id"`
+}
+
+// AuditFilter selects audit events. Zero fields match everything.
+type AuditFilter struct {
+	Actor    string
+	Database string
+	RPC      string // Full procedure or bare method name
+	Since    time.Time
+	Until    time.Time
+	Failed   bool  // Only events whose code is not "ok"
+	BeforeID int64 // Only events older than this ID (pagination)
+	Limit    int
+}
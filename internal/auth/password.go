@@ -0,0 +1,117 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// Password hashes are stored as self-describing PHC strings:
+//
+//	$argon2id$v=19$m=19456,t=2,p=1$<salt>$<hash>
+//
+// Salt and hash are unpadded standard base64. Hashes written before argon2id was
+// introduced use the legacy "salt:sha256" format (both hex encoded); they still
+// verify and are upgraded on the next successful login.
+const (
+	passwordSaltLength = 16
+	passwordKeyLength  = 32
+)
+
+// PasswordParams are the argon2id cost settings applied to new password hashes.
+type PasswordParams struct {
+	Memory      uint32 // Memory in KiB
+	Iterations  uint32 // Number of passes over the memory
+	Parallelism uint8  // Number of lanes
+}
+
+// DefaultPasswordParams follow the OWASP recommendation for argon2id (19 MiB, 2 passes,
+// 1 lane), which takes a few tens of milliseconds per hash on current hardware.
+var DefaultPasswordParams = PasswordParams{Memory: 19 * 1024, Iterations: 2, Parallelism: 1}
+
+// Validate rejects settings argon2id cannot run with.
+func (p PasswordParams) Validate() error {
+	if p.Iterations < 1 {
+		return fmt.Errorf("password hash iterations must be at least 1")
+	}
+	if p.Parallelism < 1 {
+		return fmt.Errorf("password hash parallelism must be at least 1")
+	}
+	if p.Memory < 8*uint32(p.Parallelism) {
+		return fmt.Errorf("password hash memory must be at least %d KiB for parallelism %d", 8*uint32(p.Parallelism), p.Parallelism)
+	}
+	return nil
+}
+
+// hashPassword creates an argon2id hash of the password with a random salt.
+func hashPassword(password string, p PasswordParams) (string, error) {
+	salt := make([]byte, passwordSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+	key := argon2.IDKey([]byte(password), salt, p.Iterations, p.Memory, p.Parallelism, passwordKeyLength)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, p.Memory, p.Iterations, p.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// verifyPassword checks if password matches the stored hash. rehash reports that the
+// hash is valid but was not created with the current params and should be replaced.
+func verifyPassword(storedHash, password string, current PasswordParams) (ok, rehash bool) {
+	if !strings.HasPrefix(storedHash, "$") {
+		return verifyLegacyPassword(storedHash, password), true
+	}
+
+	p, salt, key, err := decodePasswordHash(storedHash)
+	if err != nil {
+		return false, false
+	}
+	actual := argon2.IDKey([]byte(password), salt, p.Iterations, p.Memory, p.Parallelism, uint32(len(key)))
+	if subtle.ConstantTimeCompare(actual, key) != 1 {
+		return false, false
+	}
+	return true, p != current || len(salt) != passwordSaltLength || len(key) != passwordKeyLength
+}
+
+// verifyLegacyPassword checks a "salt:sha256" hash.
+func verifyLegacyPassword(storedHash, password string) bool {
+	saltHex, expectedHash, found := strings.Cut(storedHash, ":")
+	if !found {
+		return false
+	}
+	hash := sha256.Sum256([]byte(saltHex + password))
+	return subtle.ConstantTimeCompare([]byte(hex.EncodeToString(hash[:])), []byte(expectedHash)) == 1
+}
+
+// decodePasswordHash parses an argon2id PHC string.
+func decodePasswordHash(encoded string) (p PasswordParams, salt, key []byte, err error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[0] != "" {
+		return p, nil, nil, fmt.Errorf("malformed password hash")
+	}
+	if parts[1] != "argon2id" {
+		return p, nil, nil, fmt.Errorf("unsupported password hash algorithm %q", parts[1])
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return p, nil, nil, fmt.Errorf("unsupported argon2 version %q", parts[2])
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.Memory, &p.Iterations, &p.Parallelism); err != nil {
+		return p, nil, nil, fmt.Errorf("malformed argon2 parameters: %w", err)
+	}
+	if err := p.Validate(); err != nil {
+		return p, nil, nil, err
+	}
+	if salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return p, nil, nil, fmt.Errorf("malformed password salt: %w", err)
+	}
+	if key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(key) == 0 {
+		return p, nil, nil, fmt.Errorf("malformed password hash value")
+	}
+	return p, salt, key, nil
+}
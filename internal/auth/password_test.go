@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPasswordParams keep the tests fast.
+var testPasswordParams = PasswordParams{Memory: 64, Iterations: 1, Parallelism: 1}
+
+// legacyHash builds a hash in the pre-argon2id "salt:sha256" format.
+func legacyHash(salt, password string) string {
+	hash := sha256.Sum256([]byte(salt + password))
+	return salt + ":" + hex.EncodeToString(hash[:])
+}
+
+func TestHashPassword(t *testing.T) {
+	hash, err := hashPassword("s3cret", testPasswordParams)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=64,t=1,p=1$"), hash)
+
+	other, err := hashPassword("s3cret", testPasswordParams)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, other, "salted")
+
+	ok, rehash := verifyPassword(hash, "s3cret", testPasswordParams)
+	assert.True(t, ok)
+	assert.False(t, rehash)
+
+	ok, _ = verifyPassword(hash, "wrong", testPasswordParams)
+	assert.False(t, ok)
+
+	t.Run("changed params request a rehash", func(t *testing.T) {
+		ok, rehash := verifyPassword(hash, "s3cret", PasswordParams{Memory: 128, Iterations: 1, Parallelism: 1})
+		assert.True(t, ok)
+		assert.True(t, rehash)
+	})
+
+	t.Run("legacy hashes verify and request a rehash", func(t *testing.T) {
+		legacy := legacyHash("0011aabb", "s3cret")
+		ok, rehash := verifyPassword(legacy, "s3cret", testPasswordParams)
+		assert.True(t, ok)
+		assert.True(t, rehash)
+
+		ok, _ = verifyPassword(legacy, "wrong", testPasswordParams)
+		assert.False(t, ok)
+	})
+
+	t.Run("malformed hashes never verify", func(t *testing.T) {
+		for _, bad := range []string{
+			"",
+			"nocolon",
+			"$argon2id$v=19$m=64,t=1,p=1$c2FsdA",
+			"$argon2i$v=19$m=64,t=1,p=1$c2FsdA$aGFzaA",
+			"$argon2id$v=16$m=64,t=1,p=1$c2FsdA$aGFzaA",
+			"$argon2id$v=19$m=64,t=0,p=1$c2FsdA$aGFzaA",
+			"$argon2id$v=19$m=64,t=1,p=1$!!$aGFzaA",
+			"$argon2id$v=19$m=64,t=1,p=1$c2FsdA$",
+		} {
+			ok, _ := verifyPassword(bad, "s3cret", testPasswordParams)
+			assert.False(t, ok, bad)
+		}
+	})
+}
+
+func TestPasswordParams_Validate(t *testing.T) {
+	assert.NoError(t, DefaultPasswordParams.Validate())
+	assert.NoError(t, testPasswordParams.Validate())
+	assert.ErrorContains(t, PasswordParams{Memory: 64, Parallelism: 1}.Validate(), "iterations")
+	assert.ErrorContains(t, PasswordParams{Memory: 64, Iterations: 1}.Validate(), "parallelism")
+	assert.ErrorContains(t, PasswordParams{Memory: 16, Iterations: 1, Parallelism: 4}.Validate(), "memory")
+}
@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ============================================================================
+// Row Policy Operations
+// ============================================================================
+
+const rowPolicySelect = `
+	SELECT id, database_name, table_name, name, using_expr, created_at
+	FROM row_policies
+`
+
+// CreateRowPolicy stores a row-level security policy. The predicate is stored as
+// given; callers are expected to have checked that it compiles against the table.
+func (s *MetaStore) CreateRowPolicy(ctx context.Context, policy RowPolicy) (*RowPolicy, error) {
+	if policy.Database == "" || policy.Table == "" || policy.Name == "" || strings.TrimSpace(policy.Using) == "" {
+		return nil, fmt.Errorf("database, table, name and predicate are required")
+	}
+
+	result, err := s.db.ExecContext(ctx,
+		"INSERT INTO row_policies (database_name, table_name, name, using_expr) VALUES (?, ?, ?, ?)",
+		policy.Database, policy.Table, policy.Name, policy.Using)
+	if err != nil {
+		if strings.Contains(err.Error(), "UNIQUE constraint failed") {
+			return nil, fmt.Errorf("row policy %q already exists on %s.%s", policy.Name, policy.Database, policy.Table)
+		}
+		return nil, fmt.Errorf("failed to create row policy: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get row policy id: %w", err)
+	}
+	policies, err := s.queryRowPolicies(ctx, "id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(policies) == 0 {
+		return nil, fmt.Errorf("failed to create row policy: policy not persisted")
+	}
+	return &policies[0], nil
+}
+
+// DeleteRowPolicy removes a row policy by ID.
+func (s *MetaStore) DeleteRowPolicy(ctx context.Context, id int64) error {
+	result, err := s.db.ExecContext(ctx, "DELETE FROM row_policies WHERE id = ?", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete row policy: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return fmt.Errorf("row policy not found: %d", id)
+	}
+	return nil
+}
+
+// ListRowPolicies returns the row policies of database, or of every database when
+// database is empty.
+func (s *MetaStore) ListRowPolicies(ctx context.Context, database string) ([]RowPolicy, error) {
+	return s.queryRowPolicies(ctx, "? = '' OR database_name = ?", database, database)
+}
+
+func (s *MetaStore) queryRowPolicies(ctx context.Context, where string, args ...any) ([]RowPolicy, error) {
+	rows, err := s.db.QueryContext(ctx, rowPolicySelect+" WHERE "+where+" ORDER BY database_name, table_name, id", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list row policies: %w", err)
+	}
+	defer rows.Close()
+
+	var policies []RowPolicy
+	for rows.Next() {
+		var p RowPolicy
+		if err := rows.Scan(&p.ID, &p.Database, &p.Table, &p.Name, &p.Using, &p.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan row policy: %w", err)
+		}
+		policies = append(policies, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list row policies iteration failed: %w", err)
+	}
+
+	return policies, nil
+}
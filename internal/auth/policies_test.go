@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetaStore_RowPolicies(t *testing.T) {
+	store, err := NewMetaStore(filepath.Join(t.TempDir(), "test_policies.db"))
+	require.NoError(t, err)
+	defer func() { store.Close() }()
+	ctx := context.Background()
+
+	own, err := store.CreateRowPolicy(ctx, RowPolicy{Database: "app", Table: "orders", Name: "own", Using: "owner = current_user()"})
+	require.NoError(t, err)
+	assert.Positive(t, own.ID)
+	assert.Equal(t, "orders", own.Table)
+	assert.Equal(t, "owner = current_user()", own.Using)
+	assert.False(t, own.CreatedAt.IsZero())
+
+	_, err = store.CreateRowPolicy(ctx, RowPolicy{Database: "app", Table: "ORDERS", Name: "own", Using: "1"})
+	assert.ErrorContains(t, err, "already exists", "table names are case-insensitive")
+	_, err = store.CreateRowPolicy(ctx, RowPolicy{Database: "app", Table: "orders", Name: "empty", Using: "  "})
+	assert.Error(t, err)
+
+	_, err = store.CreateRowPolicy(ctx, RowPolicy{Database: "app", Table: "orders", Name: "shared", Using: "shared"})
+	require.NoError(t, err)
+	_, err = store.CreateRowPolicy(ctx, RowPolicy{Database: "other", Table: "notes", Name: "own", Using: "author = current_user()"})
+	require.NoError(t, err)
+
+	policies, err := store.ListRowPolicies(ctx, "app")
+	require.NoError(t, err)
+	require.Len(t, policies, 2)
+	assert.Equal(t, "own", policies[0].Name)
+	assert.Equal(t, "shared", policies[1].Name)
+
+	all, err := store.ListRowPolicies(ctx, "")
+	require.NoError(t, err)
+	assert.Len(t, all, 3)
+
+	require.NoError(t, store.DeleteRowPolicy(ctx, own.ID))
+	assert.ErrorContains(t, store.DeleteRowPolicy(ctx, own.ID), "row policy not found")
+	policies, err = store.ListRowPolicies(ctx, "app")
+	require.NoError(t, err)
+	assert.Len(t, policies, 1)
+}
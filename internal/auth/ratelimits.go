@@ -0,0 +1,171 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+)
+
+// ============================================================================
+// Rate Limit Operations
+// ============================================================================
+
+// rateLimitSelect resolves the owning user for both user-wide and key-scoped limits.
+// Limits of identities without a local user keep the username of their issuer.
+const rateLimitSelect = `
+	SELECT r.id, COALESCE(u.id, 0), COALESCE(u.username, r.username), COALESCE(r.api_key_id, ''),
+		COALESCE(r.issuer, ''), r.db_pattern,
+		r.requests_per_second, r.burst, r.max_streams, r.max_transactions,
+		r.rows_per_minute, r.bytes_per_minute, r.created_at
+	FROM rate_limits r
+	LEFT JOIN api_keys k ON k.id = r.api_key_id
+	LEFT JOIN users u ON u.id = COALESCE(r.user_id, k.user_id)
+`
+
+// Matches reports whether the limit applies to a call on database. Calls without a
+// database are only covered by "*".
+func (l *RateLimit) Matches(database string) bool {
+	if l.Database == "*" {
+		return true
+	}
+	return database != "" && grantSpecificity(l.Database, database) > 0
+}
+
+// SetRateLimit stores the limits of either a user (username) or a single API key
+// (keyID) on a database pattern. Exactly one of them must be set. With limit.Issuer,
+// username is the name that issuer gives an identity without a local user, such as the
+// subject of a JWT, and is not looked up. Setting the same pattern again replaces the
+// previous limits.
+func (s *MetaStore) SetRateLimit(ctx context.Context, username, keyID string, limit RateLimit) (*RateLimit, error) {
+	if (username == "") == (keyID == "") {
+		return nil, fmt.Errorf("exactly one of username or api key id is required")
+	}
+	if limit.Issuer != "" && keyID != "" {
+		return nil, fmt.Errorf("invalid rate limit: an issuer applies to a username, not an api key")
+	}
+	if limit.Database == "" {
+		limit.Database = "*"
+	}
+	if _, err := path.Match(limit.Database, ""); err != nil {
+		return nil, fmt.Errorf("invalid database pattern %q: %w", limit.Database, err)
+	}
+	if limit.RequestsPerSecond < 0 || limit.Burst < 0 || limit.MaxStreams < 0 || limit.MaxTransactions < 0 ||
+		limit.RowsPerMinute < 0 || limit.BytesPerMinute < 0 {
+		return nil, fmt.Errorf("invalid rate limit: values cannot be negative")
+	}
+
+	// The owner columns and the partial unique index they fall under
+	var ownerColumns, indexColumn, ownerWhere string
+	var owner []any
+	switch {
+	case limit.Issuer != "":
+		ownerColumns, indexColumn, ownerWhere = "issuer, username", "issuer", "r.issuer = ? AND r.username = ?"
+		owner = []any{limit.Issuer, username}
+	case username != "":
+		var userID int64
+		err := s.db.QueryRowContext(ctx, "SELECT id FROM users WHERE username = ?", username).Scan(&userID)
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("user not found: %s", username)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to set rate limit: %w", err)
+		}
+		ownerColumns, indexColumn, ownerWhere = "user_id", "user_id", "r.user_id = ?"
+		owner = []any{userID}
+	default:
+		var exists int
+		err := s.db.QueryRowContext(ctx, "SELECT 1 FROM api_keys WHERE id = ?", keyID).Scan(&exists)
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("api key not found: %s", keyID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to set rate limit: %w", err)
+		}
+		ownerColumns, indexColumn, ownerWhere = "api_key_id", "api_key_id", "r.api_key_id = ?"
+		owner = []any{keyID}
+	}
+
+	// The owner columns are constants, never user input
+	args := append(slices.Clone(owner), limit.Database, limit.RequestsPerSecond, limit.Burst,
+		limit.MaxStreams, limit.MaxTransactions, limit.RowsPerMinute, limit.BytesPerMinute)
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO rate_limits (`+ownerColumns+`, db_pattern, requests_per_second, burst,
+			max_streams, max_transactions, rows_per_minute, bytes_per_minute)
+		VALUES (`+strings.Repeat("?, ", len(owner))+`?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(`+ownerColumns+`, db_pattern) WHERE `+indexColumn+` IS NOT NULL
+		DO UPDATE SET requests_per_second = excluded.requests_per_second, burst = excluded.burst,
+			max_streams = excluded.max_streams, max_transactions = excluded.max_transactions,
+			rows_per_minute = excluded.rows_per_minute, bytes_per_minute = excluded.bytes_per_minute
+	`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set rate limit: %w", err)
+	}
+
+	limits, err := s.queryRateLimits(ctx, ownerWhere+" AND r.db_pattern = ?", append(owner, limit.Database)...)
+	if err != nil {
+		return nil, err
+	}
+	if len(limits) == 0 {
+		return nil, fmt.Errorf("failed to set rate limit: limit not persisted")
+	}
+	return &limits[0], nil
+}
+
+// DeleteRateLimit removes a rate limit by ID.
+func (s *MetaStore) DeleteRateLimit(ctx context.Context, id int64) error {
+	result, err := s.db.ExecContext(ctx, "DELETE FROM rate_limits WHERE id = ?", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete rate limit: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return fmt.Errorf("rate limit not found: %d", id)
+	}
+	return nil
+}
+
+// ListRateLimits returns rate limits, optionally filtered by owning username and exact
+// database pattern. Key-scoped limits are listed under the key's owner.
+func (s *MetaStore) ListRateLimits(ctx context.Context, username, database string) ([]RateLimit, error) {
+	return s.queryRateLimits(ctx, "(? = '' OR COALESCE(u.username, r.username) = ?) AND (? = '' OR r.db_pattern = ?)",
+		username, username, database, database)
+}
+
+// RateLimitsFor returns the limits that apply to claims. For identities without a local
+// user, those set for their issuer and username; otherwise the user-wide limits of the
+// user plus, if it authenticated with an API key, the limits of that key.
+func (s *MetaStore) RateLimitsFor(ctx context.Context, claims *UserClaims) ([]RateLimit, error) {
+	if claims.Issuer != "" {
+		return s.queryRateLimits(ctx, "r.issuer = ? AND r.username = ?", claims.Issuer, claims.Username)
+	}
+	return s.queryRateLimits(ctx, "r.user_id = ? OR (? != '' AND r.api_key_id = ?)", claims.UserID, claims.KeyID, claims.KeyID)
+}
+
+func (s *MetaStore) queryRateLimits(ctx context.Context, where string, args ...any) ([]RateLimit, error) {
+	rows, err := s.db.QueryContext(ctx, rateLimitSelect+" WHERE "+where+" ORDER BY r.id", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rate limits: %w", err)
+	}
+	defer rows.Close()
+
+	var limits []RateLimit
+	for rows.Next() {
+		var l RateLimit
+		if err := rows.Scan(&l.ID, &l.UserID, &l.Username, &l.APIKeyID, &l.Issuer, &l.Database,
+			&l.RequestsPerSecond, &l.Burst, &l.MaxStreams, &l.MaxTransactions,
+			&l.RowsPerMinute, &l.BytesPerMinute, &l.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan rate limit: %w", err)
+		}
+		limits = append(limits, l)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list rate limits iteration failed: %w", err)
+	}
+
+	return limits, nil
+}
@@ -0,0 +1,141 @@
+package auth
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+)
+
+func TestRateLimit_Matches(t *testing.T) {
+	all := RateLimit{Database: "*"}
+	assert.True(t, all.Matches("app"))
+	assert.True(t, all.Matches(""), "calls without a database count against *")
+
+	tenants := RateLimit{Database: "tenant_*"}
+	assert.True(t, tenants.Matches("tenant_a"))
+	assert.False(t, tenants.Matches("app"))
+	assert.False(t, tenants.Matches(""))
+}
+
+func TestMetaStore_RateLimits(t *testing.T) {
+	store, err := NewMetaStore(filepath.Join(t.TempDir(), "test_ratelimits.db"))
+	require.NoError(t, err)
+	defer func() { store.Close() }()
+	ctx := context.Background()
+
+	aliceID, err := store.CreateUser(ctx, "alice", "alice-pass", sqlrpcv1.Role_ROLE_READ_WRITE)
+	require.NoError(t, err)
+	bobID, err := store.CreateUser(ctx, "bob", "bob-pass", sqlrpcv1.Role_ROLE_READ_WRITE)
+	require.NoError(t, err)
+	_, keyID, err := store.CreateApiKey(ctx, aliceID, "ci", nil)
+	require.NoError(t, err)
+
+	userLimit, err := store.SetRateLimit(ctx, "alice", "", RateLimit{RequestsPerSecond: 5, MaxStreams: 2})
+	require.NoError(t, err)
+	assert.Equal(t, "*", userLimit.Database)
+	assert.Equal(t, aliceID, userLimit.UserID)
+	assert.Equal(t, "alice", userLimit.Username)
+	assert.Empty(t, userLimit.APIKeyID)
+
+	// Setting the same pattern again replaces the limit in place
+	updated, err := store.SetRateLimit(ctx, "alice", "", RateLimit{Database: "*", RowsPerMinute: 100})
+	require.NoError(t, err)
+	assert.Equal(t, userLimit.ID, updated.ID)
+	assert.Zero(t, updated.RequestsPerSecond)
+	assert.Equal(t, int64(100), updated.RowsPerMinute)
+
+	keyLimit, err := store.SetRateLimit(ctx, "", keyID, RateLimit{Database: "tenant_*", BytesPerMinute: 1 << 20})
+	require.NoError(t, err)
+	assert.Equal(t, keyID, keyLimit.APIKeyID)
+	assert.Equal(t, "alice", keyLimit.Username, "key limits resolve to the key's owner")
+
+	_, err = store.SetRateLimit(ctx, "bob", "", RateLimit{Database: "app", MaxTransactions: 1})
+	require.NoError(t, err)
+
+	t.Run("invalid", func(t *testing.T) {
+		for name, call := range map[string]func() error{
+			"no subject":   func() error { _, err := store.SetRateLimit(ctx, "", "", RateLimit{}); return err },
+			"two subjects": func() error { _, err := store.SetRateLimit(ctx, "alice", keyID, RateLimit{}); return err },
+			"unknown user": func() error { _, err := store.SetRateLimit(ctx, "ghost", "", RateLimit{}); return err },
+			"unknown key":  func() error { _, err := store.SetRateLimit(ctx, "", "missing", RateLimit{}); return err },
+			"bad pattern":  func() error { _, err := store.SetRateLimit(ctx, "alice", "", RateLimit{Database: "["}); return err },
+			"negative":     func() error { _, err := store.SetRateLimit(ctx, "alice", "", RateLimit{Burst: -1}); return err },
+		} {
+			assert.Error(t, call(), name)
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		limits, err := store.ListRateLimits(ctx, "", "")
+		require.NoError(t, err)
+		assert.Len(t, limits, 3)
+
+		limits, err = store.ListRateLimits(ctx, "alice", "")
+		require.NoError(t, err)
+		assert.Len(t, limits, 2)
+
+		limits, err = store.ListRateLimits(ctx, "", "app")
+		require.NoError(t, err)
+		require.Len(t, limits, 1)
+		assert.Equal(t, bobID, limits[0].UserID)
+	})
+
+	t.Run("for credentials", func(t *testing.T) {
+		limits, err := store.RateLimitsFor(ctx, &UserClaims{UserID: aliceID, Username: "alice"})
+		require.NoError(t, err)
+		require.Len(t, limits, 1, "password logins only get user-wide limits")
+		assert.Equal(t, userLimit.ID, limits[0].ID)
+
+		limits, err = store.RateLimitsFor(ctx, &UserClaims{UserID: aliceID, Username: "alice", KeyID: keyID})
+		require.NoError(t, err)
+		assert.Len(t, limits, 2)
+
+		limits, err = store.RateLimitsFor(ctx, &UserClaims{Username: "alice", Issuer: "https://idp.example.com"})
+		require.NoError(t, err)
+		assert.Empty(t, limits, "a token subject is not the local user of the same name")
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, store.DeleteRateLimit(ctx, keyLimit.ID))
+		assert.ErrorContains(t, store.DeleteRateLimit(ctx, keyLimit.ID), "not found")
+
+		// Deleting the user drops their limits
+		require.NoError(t, store.DeleteUser(ctx, "bob"))
+		limits, err := store.ListRateLimits(ctx, "", "")
+		require.NoError(t, err)
+		assert.Len(t, limits, 1)
+	})
+
+	t.Run("identities without a local user", func(t *testing.T) {
+		const issuer = "https://idp.example.com"
+		tokenLimit, err := store.SetRateLimit(ctx, "bob", "", RateLimit{Issuer: issuer, RequestsPerSecond: 2})
+		require.NoError(t, err, "the username is not looked up")
+		assert.Zero(t, tokenLimit.UserID)
+		assert.Equal(t, "bob", tokenLimit.Username)
+		assert.Equal(t, issuer, tokenLimit.Issuer)
+
+		updated, err := store.SetRateLimit(ctx, "bob", "", RateLimit{Issuer: issuer, RequestsPerSecond: 3})
+		require.NoError(t, err)
+		assert.Equal(t, tokenLimit.ID, updated.ID)
+		peerLimit, err := store.SetRateLimit(ctx, "bob", "", RateLimit{Issuer: "unix", MaxStreams: 1})
+		require.NoError(t, err)
+		assert.NotEqual(t, tokenLimit.ID, peerLimit.ID, "issuers have separate limits")
+
+		limits, err := store.RateLimitsFor(ctx, &UserClaims{Username: "bob", Issuer: issuer})
+		require.NoError(t, err)
+		require.Len(t, limits, 1)
+		assert.Equal(t, float64(3), limits[0].RequestsPerSecond)
+
+		limits, err = store.ListRateLimits(ctx, "bob", "")
+		require.NoError(t, err)
+		assert.Len(t, limits, 2)
+
+		_, err = store.SetRateLimit(ctx, "", keyID, RateLimit{Issuer: issuer})
+		assert.Error(t, err, "keys belong to local users")
+	})
+}
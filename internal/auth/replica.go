@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"slices"
+)
+
+// replicatedTables hold the access control a read replica takes from its leader, each
+// after the tables it references. Database configs, maintenance runs, audit events and
+// login failures stay local.
+var replicatedTables = []string{
+	"users",
+	"api_keys",
+	"api_key_scopes",
+	"database_grants",
+	"rate_limits",
+	"row_policies",
+	"column_policies",
+}
+
+// ReplaceAccessControl replaces the users, API keys, grants, rate limits and row and
+// column policies of the store with those of the metadata database at path, in one
+// transaction. The file must not change while it is read.
+func (s *MetaStore) ReplaceAccessControl(ctx context.Context, path string) error {
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "ATTACH DATABASE ? AS source", "file:"+path+"?mode=ro&immutable=1"); err != nil {
+		return fmt.Errorf("failed to open metadata copy: %w", err)
+	}
+	defer conn.ExecContext(context.WithoutCancel(ctx), "DETACH DATABASE source")
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, table := range slices.Backward(replicatedTables) {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM main."+table); err != nil {
+			return fmt.Errorf("failed to clear %s: %w", table, err)
+		}
+	}
+	for _, table := range replicatedTables {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO main."+table+" SELECT * FROM source."+table); err != nil {
+			return fmt.Errorf("failed to copy %s: %w", table, err)
+		}
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+)
+
+func TestMetaStore_ReplaceAccessControl(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	leader, err := NewMetaStore(filepath.Join(dir, "leader.db"))
+	require.NoError(t, err)
+	aliceID, err := leader.CreateUser(ctx, "alice", "secret", sqlrpcv1.Role_ROLE_READ_ONLY)
+	require.NoError(t, err)
+	token, _, err := leader.CreateApiKey(ctx, aliceID, "ci", nil)
+	require.NoError(t, err)
+	_, err = leader.GrantDatabaseAccess(ctx, "alice", "", "app", sqlrpcv1.Role_ROLE_READ_WRITE)
+	require.NoError(t, err)
+	_, err = leader.CreateRowPolicy(ctx, RowPolicy{Database: "app", Table: "orders", Name: "own", Using: "owner = current_user()"})
+	require.NoError(t, err)
+	_, err = leader.SetColumnPolicy(ctx, ColumnPolicy{Database: "app", Table: "orders", Column: "total", Action: sqlrpcv1.ColumnAction_COLUMN_ACTION_MASK, Username: "alice"})
+	require.NoError(t, err)
+	require.NoError(t, leader.Close())
+
+	// Like a follower's staged image: a rollback journal file opened immutable
+	image, err := os.OpenFile(filepath.Join(dir, "leader.db"), os.O_RDWR, 0)
+	require.NoError(t, err)
+	_, err = image.WriteAt([]byte{1, 1}, 18)
+	require.NoError(t, err)
+	require.NoError(t, image.Close())
+
+	follower, err := NewMetaStore(filepath.Join(dir, "follower.db"))
+	require.NoError(t, err)
+	defer follower.Close()
+	_, err = follower.CreateUser(ctx, "local", "secret", sqlrpcv1.Role_ROLE_ADMIN)
+	require.NoError(t, err)
+	require.NoError(t, follower.UpsertDatabaseConfig(ctx, "app", "/data/app.db", true, "{}"))
+
+	require.NoError(t, follower.ReplaceAccessControl(ctx, filepath.Join(dir, "leader.db")))
+
+	local, err := follower.GetUserByUsername(ctx, "local")
+	require.NoError(t, err)
+	assert.Nil(t, local, "local users are replaced")
+	claims, err := follower.ValidateUser(ctx, "alice", "secret")
+	require.NoError(t, err)
+	assert.Equal(t, aliceID, claims.UserID)
+	assert.Equal(t, sqlrpcv1.Role_ROLE_READ_WRITE, claims.RoleFor("app"))
+	_, err = follower.ValidateApiKeyImpl(ctx, token)
+	assert.NoError(t, err)
+
+	policies, err := follower.ListRowPolicies(ctx, "app")
+	require.NoError(t, err)
+	assert.Len(t, policies, 1)
+	columns, err := follower.ListColumnPolicies(ctx, "app")
+	require.NoError(t, err)
+	assert.Len(t, columns, 1)
+
+	config, err := follower.GetDatabaseConfig(ctx, "app")
+	require.NoError(t, err)
+	assert.Equal(t, "/data/app.db", config.Path, "database configs stay local")
+
+	// Copying again replaces rather than duplicates
+	require.NoError(t, follower.ReplaceAccessControl(ctx, filepath.Join(dir, "leader.db")))
+	users, err := follower.ListUsers(ctx)
+	require.NoError(t, err)
+	assert.Len(t, users, 1)
+}
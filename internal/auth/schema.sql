@@ -2,7 +2,7 @@
 CREATE TABLE IF NOT EXISTS users (
     id INTEGER PRIMARY KEY AUTOINCREMENT,
     username TEXT UNIQUE NOT NULL,
-    password_hash TEXT NOT NULL, -- bcrypt hash
+    password_hash TEXT NOT NULL, -- argon2id PHC string (legacy: salt:sha256 hex)
     role TEXT NOT NULL CHECK(role IN ('admin', 'database_manager', 'read_write', 'read_only')),
     created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
     updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
@@ -22,6 +22,16 @@ CREATE TABLE IF NOT EXISTS api_keys (
 -- Create a partial index for active keys if needed, but simple index is fine.
 CREATE INDEX IF NOT EXISTS idx_api_keys_hash ON api_keys(key_hash);
 
+-- API Key Scopes Table
+-- Optional restrictions for a single key. NULL/empty columns are unrestricted.
+CREATE TABLE IF NOT EXISTS api_key_scopes (
+    api_key_id TEXT PRIMARY KEY REFERENCES api_keys(id) ON DELETE CASCADE,
+    databases TEXT, -- JSON array of database names or globs
+    channels TEXT, -- JSON array of Pub/Sub channel names or globs
+    max_role TEXT CHECK(max_role IN ('admin', 'database_manager', 'read_write', 'read_only')),
+    families TEXT -- JSON array of RPC families (query, publish, subscribe, admin)
+);
+
 -- Databases Table
 CREATE TABLE IF NOT EXISTS databases (
     id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -31,3 +41,127 @@ CREATE TABLE IF NOT EXISTS databases (
     settings TEXT, -- JSON blob for configs
     created_at DATETIME DEFAULT CURRENT_TIMESTAMP
 );
+
+-- Database Grants Table
+-- Per-database roles for a user (user_id) or a single API key (api_key_id).
+CREATE TABLE IF NOT EXISTS database_grants (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    api_key_id TEXT REFERENCES api_keys(id) ON DELETE CASCADE,
+    db_pattern TEXT NOT NULL, -- Exact database name or glob (e.g. tenant_*)
+    role TEXT NOT NULL CHECK(role IN ('database_manager', 'read_write', 'read_only')),
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((user_id IS NULL) <> (api_key_id IS NULL))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_database_grants_user ON database_grants(user_id, db_pattern) WHERE user_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_database_grants_key ON database_grants(api_key_id, db_pattern) WHERE api_key_id IS NOT NULL;
+
+-- Maintenance Runs Table
+-- Results of scheduled maintenance jobs (checkpoint, vacuum, integrity_check, backup).
+CREATE TABLE IF NOT EXISTS maintenance_runs (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    database TEXT NOT NULL,
+    task TEXT NOT NULL CHECK(task IN ('checkpoint', 'vacuum', 'integrity_check', 'backup')),
+    started_at DATETIME NOT NULL,
+    duration_ms INTEGER NOT NULL,
+    success BOOLEAN NOT NULL,
+    message TEXT
+);
+
+CREATE INDEX IF NOT EXISTS idx_maintenance_runs_database ON maintenance_runs(database, task, id);
+
+-- Audit Events Table
+-- Append-only record of administrative and data-changing operations.
+CREATE TABLE IF NOT EXISTS audit_events (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    created_at DATETIME NOT NULL,
+    actor TEXT NOT NULL, -- Username (kept after the user is deleted)
+    api_key_id TEXT, -- API key used to authenticate, if any
+    rpc TEXT NOT NULL, -- Full procedure name
+    database TEXT,
+    sql_text TEXT,
+    params TEXT, -- JSON parameters, NULL when redacted
+    details TEXT, -- JSON request payload with secrets redacted
+    code TEXT NOT NULL, -- 'ok' or the error code
+    error TEXT,
+    request_id TEXT
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor ON audit_events(actor, id);
+CREATE INDEX IF NOT EXISTS idx_audit_events_database ON audit_events(database, id);
+
+CREATE TRIGGER IF NOT EXISTS audit_events_no_update BEFORE UPDATE ON audit_events
+BEGIN
+    SELECT RAISE(ABORT, 'audit_events is append-only');
+END;
+
+CREATE TRIGGER IF NOT EXISTS audit_events_no_delete BEFORE DELETE ON audit_events
+BEGIN
+    SELECT RAISE(ABORT, 'audit_events is append-only');
+END;
+
+-- Login Failures Table
+-- Failed password attempts per username and per client IP, for exponential backoff
+-- and temporary lockout. Times are Unix milliseconds.
+CREATE TABLE IF NOT EXISTS login_failures (
+    kind TEXT NOT NULL CHECK(kind IN ('user', 'ip')),
+    key TEXT NOT NULL, -- Username or client IP
+    failures INTEGER NOT NULL,
+    last_failure_at INTEGER NOT NULL,
+    locked_until INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (kind, key)
+);
+
+-- Rate Limits Table
+-- Quotas for a user (user_id), a single API key (api_key_id) or an identity without a local
+-- user (issuer, username) on the databases matching db_pattern ('*' also covers calls
+-- without a database). Zero columns are unlimited.
+CREATE TABLE IF NOT EXISTS rate_limits (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    api_key_id TEXT REFERENCES api_keys(id) ON DELETE CASCADE,
+    issuer TEXT, -- JWT issuer or 'unix' for identities without a local user
+    username TEXT, -- Username reported by issuer
+    db_pattern TEXT NOT NULL DEFAULT '*', -- Exact database name or glob (e.g. tenant_*)
+    requests_per_second REAL NOT NULL DEFAULT 0,
+    burst INTEGER NOT NULL DEFAULT 0, -- Token bucket size; 0 means one second's worth
+    max_streams INTEGER NOT NULL DEFAULT 0, -- Concurrent streaming calls
+    max_transactions INTEGER NOT NULL DEFAULT 0, -- Concurrent transaction sessions and streams
+    rows_per_minute INTEGER NOT NULL DEFAULT 0, -- Result rows returned per minute
+    bytes_per_minute INTEGER NOT NULL DEFAULT 0, -- Response bytes returned per minute
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((user_id IS NOT NULL) + (api_key_id IS NOT NULL) + (issuer IS NOT NULL) = 1),
+    CHECK ((issuer IS NULL) = (username IS NULL))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_rate_limits_user ON rate_limits(user_id, db_pattern) WHERE user_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_rate_limits_key ON rate_limits(api_key_id, db_pattern) WHERE api_key_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_rate_limits_issuer ON rate_limits(issuer, username, db_pattern) WHERE issuer IS NOT NULL;
+
+-- Row Policies Table
+-- Row-level security predicates per database table, applied to non-admin callers.
+CREATE TABLE IF NOT EXISTS row_policies (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    database_name TEXT NOT NULL,
+    table_name TEXT NOT NULL COLLATE NOCASE,
+    name TEXT NOT NULL,
+    using_expr TEXT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(database_name, table_name, name)
+);
+
+-- Column Policies Table
+-- Denied or masked column reads for a role (role) or a single user (username) on a database.
+CREATE TABLE IF NOT EXISTS column_policies (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    database_name TEXT NOT NULL,
+    table_name TEXT NOT NULL COLLATE NOCASE,
+    column_name TEXT NOT NULL COLLATE NOCASE,
+    action INTEGER NOT NULL, -- sqlrpcv1.ColumnAction
+    role INTEGER NOT NULL DEFAULT 0,
+    username TEXT NOT NULL DEFAULT '',
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((role = 0) <> (username = '')),
+    UNIQUE(database_name, table_name, column_name, role, username)
+);
@@ -7,51 +7,36 @@ import (
 	"database/sql"
 	_ "embed"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
-	"log"
+	"math"
 	"math/big"
 	"os"
+	"path"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	_ "github.com/mattn/go-sqlite3"
 
+	"sqlite-server/internal/logging"
 	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
 )
 
-// hashPassword creates a salted SHA256 hash of the password
-// Format: salt:hash (both hex encoded)
-func hashPassword(password string) string {
-	salt := make([]byte, 16)
-	rand.Read(salt)
-	saltHex := hex.EncodeToString(salt)
-
-	hash := sha256.Sum256([]byte(saltHex + password))
-	hashHex := hex.EncodeToString(hash[:])
-
-	return saltHex + ":" + hashHex
-}
-
-// verifyPassword checks if password matches the stored hash
-func verifyPassword(storedHash, password string) bool {
-	parts := strings.SplitN(storedHash, ":", 2)
-	if len(parts) != 2 {
-		return false
-	}
-	saltHex, expectedHash := parts[0], parts[1]
-
-	hash := sha256.Sum256([]byte(saltHex + password))
-	actualHash := hex.EncodeToString(hash[:])
-
-	return actualHash == expectedHash
-}
-
 //go:embed schema.sql
 var schemaSQL string
 
 type MetaStore struct {
-	db *sql.DB
+	db   *sql.DB
+	path string
+
+	// passwordParams are the argon2id settings for new password hashes. The zero
+	// value means DefaultPasswordParams.
+	passwordParams PasswordParams
+
+	// lockout throttles failed password logins. Nil means DefaultLockoutPolicy.
+	lockout *LockoutPolicy
 }
 
 // DatabaseConfig represents a persisted database configuration
@@ -69,6 +54,131 @@ type UserClaims struct {
 	UserID   int64
 	Username string
 	Role     sqlrpcv1.Role
+
+	// KeyID is set when the caller authenticated with an API key.
+	KeyID string
+
+	// Grants holds the per-database grants of the user and, when KeyID is set,
+	// the grants scoped to that key. Resolved through RoleFor.
+	Grants []DatabaseGrant
+
+	// Scope holds the restrictions of the API key used to authenticate, if any.
+	// Role is already capped to Scope.MaxRole.
+	Scope *ApiKeyScope
+
+	// Issuer is set for identities taken from a verified JWT or a Unix peer mapped to a
+	// bare role. They have no row in users, so UserID is 0 and Username does not name a
+	// local account.
+	Issuer string
+
+	// ExpiresAt is when the credential stops being valid. Zero if it does not expire
+	// or is checked on every lookup.
+	ExpiresAt time.Time
+}
+
+// RoleFor resolves the effective role of the caller on a specific database.
+//
+// An empty database name (control plane operations) yields the global Role.
+// User grants, when present, replace the global role and confine the user to
+// the databases they match; global admins are exempt. Key grants can only
+// narrow the result further, never widen it.
+func (c *UserClaims) RoleFor(database string) sqlrpcv1.Role {
+	role := c.grantRole(database)
+	if c.Scope != nil {
+		role = c.Scope.limit(database, role)
+	}
+	return role
+}
+
+// AllowsFamily reports whether the credential may call RPCs of the given family.
+func (c *UserClaims) AllowsFamily(family sqlrpcv1.RpcFamily) bool {
+	if c.Scope == nil || len(c.Scope.Families) == 0 {
+		return true
+	}
+	return slices.Contains(c.Scope.Families, family)
+}
+
+// AllowsChannel reports whether the credential may publish or subscribe to a Pub/Sub channel.
+func (c *UserClaims) AllowsChannel(channel string) bool {
+	if c.Scope == nil || len(c.Scope.Channels) == 0 {
+		return true
+	}
+	return matchesAny(c.Scope.Channels, channel)
+}
+
+// grantRole resolves the role on a database from the global role and the grants.
+func (c *UserClaims) grantRole(database string) sqlrpcv1.Role {
+	if database == "" || len(c.Grants) == 0 {
+		return c.Role
+	}
+
+	var userGrant, keyGrant *DatabaseGrant
+	var hasUserGrants, hasKeyGrants bool
+	userScore, keyScore := 0, 0
+	for i := range c.Grants {
+		g := &c.Grants[i]
+		score := grantSpecificity(g.Database, database)
+		if g.APIKeyID == "" {
+			hasUserGrants = true
+			if score > userScore {
+				userGrant, userScore = g, score
+			}
+		} else if g.APIKeyID == c.KeyID {
+			hasKeyGrants = true
+			if score > keyScore {
+				keyGrant, keyScore = g, score
+			}
+		}
+	}
+
+	role := c.Role
+	if hasUserGrants && role != sqlrpcv1.Role_ROLE_ADMIN {
+		role = sqlrpcv1.Role_ROLE_UNSPECIFIED
+		if userGrant != nil {
+			role = userGrant.Role
+		}
+	}
+	if hasKeyGrants {
+		if keyGrant == nil {
+			return sqlrpcv1.Role_ROLE_UNSPECIFIED
+		}
+		role = min(role, keyGrant.Role)
+	}
+	return role
+}
+
+// limit applies the key scope to a resolved role: databases outside the scope get
+// no access and everything else is capped at MaxRole.
+func (s *ApiKeyScope) limit(database string, role sqlrpcv1.Role) sqlrpcv1.Role {
+	if database != "" && len(s.Databases) > 0 && !matchesAny(s.Databases, database) {
+		return sqlrpcv1.Role_ROLE_UNSPECIFIED
+	}
+	if s.MaxRole != sqlrpcv1.Role_ROLE_UNSPECIFIED {
+		role = min(role, s.MaxRole)
+	}
+	return role
+}
+
+// matchesAny reports whether name equals or glob-matches one of the patterns.
+func matchesAny(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if grantSpecificity(p, name) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// grantSpecificity reports how closely a grant pattern matches a database name.
+// Exact names beat globs and longer globs beat shorter ones. Zero means no match.
+func grantSpecificity(pattern, database string) int {
+	if pattern == database {
+		return math.MaxInt
+	}
+	if ok, _ := path.Match(pattern, database); ok {
+		return len(pattern)
+	}
+	return 0
 }
 
 // dbRole is the string representation of a role in the database
@@ -129,15 +239,51 @@ func (s *MetaStore) ValidateUser(ctx context.Context, username, password string)
 		return nil, err
 	}
 
-	// Compare Hash (fast SHA256)
-	if !verifyPassword(hash, password) {
+	ok, rehash := verifyPassword(hash, password, s.hashParams())
+	if !ok {
 		return nil, nil // Invalid password
 	}
+	if rehash {
+		s.rehashPassword(ctx, id, hash, password)
+	}
+
+	grants, err := s.loadGrants(ctx, id, "")
+	if err != nil {
+		return nil, err
+	}
 
 	return &UserClaims{
 		UserID:   id,
 		Username: username,
 		Role:     ParseRole(role),
+		Grants:   grants,
+	}, nil
+}
+
+// ValidateTrustedUser returns the identity of a user whose credentials were verified
+// outside the store, such as a TLS client certificate or Unix peer credentials. Like
+// ValidateUser, it returns (nil, nil) if there is no such user.
+func (s *MetaStore) ValidateTrustedUser(ctx context.Context, username string) (*UserClaims, error) {
+	var id int64
+	var role dbRole
+	err := s.db.QueryRowContext(ctx, "SELECT id, role FROM users WHERE username = ?", username).Scan(&id, &role)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	grants, err := s.loadGrants(ctx, id, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserClaims{
+		UserID:   id,
+		Username: username,
+		Role:     ParseRole(role),
+		Grants:   grants,
 	}, nil
 }
 
@@ -168,7 +314,7 @@ func NewMetaStore(dbPath string) (*MetaStore, error) {
 		return nil, fmt.Errorf("failed to ping meta db: %w", err)
 	}
 
-	store := &MetaStore{db: db}
+	store := &MetaStore{db: db, path: dbPath}
 	if err := store.migrate(); err != nil {
 		db.Close()
 		return nil, err
@@ -177,6 +323,40 @@ func NewMetaStore(dbPath string) (*MetaStore, error) {
 	return store, nil
 }
 
+// SetPasswordParams changes the argon2id settings used for new password hashes.
+// Existing hashes made with other settings are upgraded on the next successful login.
+func (s *MetaStore) SetPasswordParams(p PasswordParams) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	s.passwordParams = p
+	return nil
+}
+
+// hashParams returns the argon2id settings for new password hashes.
+func (s *MetaStore) hashParams() PasswordParams {
+	if s.passwordParams == (PasswordParams{}) {
+		return DefaultPasswordParams
+	}
+	return s.passwordParams
+}
+
+// rehashPassword replaces a legacy or outdated hash after a successful login. The
+// update only applies if the hash is unchanged, so a concurrent password change wins.
+// Failures are logged and otherwise ignored: the login itself is valid.
+func (s *MetaStore) rehashPassword(ctx context.Context, userID int64, oldHash, password string) {
+	logger := logging.For(logging.Auth)
+	newHash, err := hashPassword(password, s.hashParams())
+	if err == nil {
+		_, err = s.db.ExecContext(ctx, "UPDATE users SET password_hash = ? WHERE id = ? AND password_hash = ?", newHash, userID, oldHash)
+	}
+	if err != nil {
+		logger.WarnContext(ctx, "Failed to upgrade password hash", "user_id", userID, logging.Err(err))
+		return
+	}
+	logger.DebugContext(ctx, "Upgraded password hash", "user_id", userID)
+}
+
 func (s *MetaStore) migrate() error {
 	_, err := s.db.Exec(schemaSQL)
 	if err != nil {
@@ -194,6 +374,11 @@ func (s *MetaStore) GetDB() *sql.DB {
 	return s.db
 }
 
+// Path returns the file path of the metadata database.
+func (s *MetaStore) Path() string {
+	return s.path
+}
+
 // EnsureDefaultAdmin creates an admin user if no users exist.
 // Returns the password used (either from env or generated).
 func (s *MetaStore) EnsureDefaultAdmin(initialUsername, initialPassword string) (string, error) {
@@ -224,8 +409,10 @@ func (s *MetaStore) EnsureDefaultAdmin(initialUsername, initialPassword string)
 		generated = true
 	}
 
-	// Hash the password (fast SHA256+salt)
-	passwordHash := hashPassword(password)
+	passwordHash, err := hashPassword(password, s.hashParams())
+	if err != nil {
+		return "", err
+	}
 
 	_, err = s.db.Exec(`
 		INSERT INTO users (username, password_hash, role)
@@ -236,10 +423,11 @@ func (s *MetaStore) EnsureDefaultAdmin(initialUsername, initialPassword string)
 		return "", fmt.Errorf("failed to create admin user: %w", err)
 	}
 
+	logger := logging.For(logging.Auth)
 	if generated {
-		log.Printf("\n[AUTH] Created default '%s' user.\n[AUTH] Password: %s\n[AUTH] Please change this immediately!\n", username, password)
+		logger.Warn("Created default admin user with a generated password. Please change it immediately!", "username", username, "password", password)
 	} else {
-		log.Printf("\n[AUTH] Created default '%s' user.\n", username)
+		logger.Info("Created default admin user", "username", username)
 	}
 
 	return password, nil
@@ -270,8 +458,10 @@ func (s *MetaStore) CreateUser(ctx context.Context, username, password string, r
 		return 0, fmt.Errorf("invalid role: UNSPECIFIED")
 	}
 
-	// Hash password (fast SHA256+salt)
-	passwordHash := hashPassword(password)
+	passwordHash, err := hashPassword(password, s.hashParams())
+	if err != nil {
+		return 0, err
+	}
 	roleStr := FormatRole(role)
 
 	result, err := s.db.ExecContext(ctx, `
@@ -301,8 +491,10 @@ func (s *MetaStore) DeleteUser(ctx context.Context, username string) error {
 
 // UpdatePassword changes a user's password
 func (s *MetaStore) UpdatePassword(ctx context.Context, username, newPassword string) error {
-	// Hash password (fast SHA256+salt)
-	passwordHash := hashPassword(newPassword)
+	passwordHash, err := hashPassword(newPassword, s.hashParams())
+	if err != nil {
+		return err
+	}
 
 	result, err := s.db.ExecContext(ctx, `
 		UPDATE users SET password_hash = ?, updated_at = CURRENT_TIMESTAMP
@@ -395,6 +587,16 @@ func (s *MetaStore) UpdateUserRole(ctx context.Context, username string, role sq
 // CreateApiKey generates a new API key for a user
 // Returns the raw key (only shown once) and the key ID (UUID v7)
 func (s *MetaStore) CreateApiKey(ctx context.Context, userID int64, name string, expiresAt *time.Time) (string, string, error) {
+	return s.CreateScopedApiKey(ctx, userID, name, expiresAt, nil)
+}
+
+// CreateScopedApiKey generates a new API key restricted by scope. A nil scope
+// creates an unrestricted key, like CreateApiKey.
+func (s *MetaStore) CreateScopedApiKey(ctx context.Context, userID int64, name string, expiresAt *time.Time, scope *ApiKeyScope) (string, string, error) {
+	if err := validateScope(scope); err != nil {
+		return "", "", err
+	}
+
 	// Generate UUID v7 for time-sortable, unique key
 	uuidV7, err := uuid.NewV7()
 	if err != nil {
@@ -421,7 +623,13 @@ func (s *MetaStore) CreateApiKey(ctx context.Context, userID int64, name string,
 		expiresAtStr = expiresAt.Format(time.RFC3339)
 	}
 
-	_, err = s.db.ExecContext(ctx, `
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create api key: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
 		INSERT INTO api_keys (id, user_id, key_prefix, key_hash, name, expires_at)
 		VALUES (?, ?, ?, ?, ?, ?)
 	`, keyID, userID, prefix, keyHash, name, expiresAtStr)
@@ -429,14 +637,100 @@ func (s *MetaStore) CreateApiKey(ctx context.Context, userID int64, name string,
 		return "", "", fmt.Errorf("failed to create api key: %w", err)
 	}
 
+	if scope != nil {
+		databases, channels, maxRole, families := encodeScope(scope)
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO api_key_scopes (api_key_id, databases, channels, max_role, families)
+			VALUES (?, ?, ?, ?, ?)
+		`, keyID, databases, channels, maxRole, families)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to store api key scope: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", "", fmt.Errorf("failed to create api key: %w", err)
+	}
+
 	return rawKey, keyID, nil
 }
 
+// validateScope rejects scopes that could never match anything.
+func validateScope(scope *ApiKeyScope) error {
+	if scope == nil {
+		return nil
+	}
+	for _, p := range slices.Concat(scope.Databases, scope.Channels) {
+		if p == "" {
+			return fmt.Errorf("invalid scope pattern: empty")
+		}
+		if _, err := path.Match(p, ""); err != nil {
+			return fmt.Errorf("invalid scope pattern %q: %w", p, err)
+		}
+	}
+	for _, f := range scope.Families {
+		if f == sqlrpcv1.RpcFamily_RPC_FAMILY_UNSPECIFIED {
+			return fmt.Errorf("invalid scope family: UNSPECIFIED")
+		}
+		if _, ok := sqlrpcv1.RpcFamily_name[int32(f)]; !ok {
+			return fmt.Errorf("invalid scope family: %d", f)
+		}
+	}
+	return nil
+}
+
+// encodeScope converts a scope into its api_key_scopes column values.
+// Empty lists and an unspecified role are stored as NULL.
+func encodeScope(scope *ApiKeyScope) (databases, channels, maxRole, families any) {
+	encodeList := func(values []string) any {
+		if len(values) == 0 {
+			return nil
+		}
+		b, _ := json.Marshal(values)
+		return string(b)
+	}
+	names := make([]string, len(scope.Families))
+	for i, f := range scope.Families {
+		names[i] = strings.ToLower(strings.TrimPrefix(f.String(), "RPC_FAMILY_"))
+	}
+	if scope.MaxRole != sqlrpcv1.Role_ROLE_UNSPECIFIED {
+		maxRole = string(FormatRole(scope.MaxRole))
+	}
+	return encodeList(scope.Databases), encodeList(scope.Channels), maxRole, encodeList(names)
+}
+
+// decodeScope rebuilds a scope from its api_key_scopes column values.
+func decodeScope(databases, channels, maxRole, families sql.NullString) (*ApiKeyScope, error) {
+	scope := &ApiKeyScope{MaxRole: ParseRole(dbRole(maxRole.String))}
+	for _, col := range []struct {
+		raw sql.NullString
+		dst *[]string
+	}{{databases, &scope.Databases}, {channels, &scope.Channels}} {
+		if col.raw.Valid {
+			if err := json.Unmarshal([]byte(col.raw.String), col.dst); err != nil {
+				return nil, fmt.Errorf("failed to decode api key scope: %w", err)
+			}
+		}
+	}
+	if families.Valid {
+		var names []string
+		if err := json.Unmarshal([]byte(families.String), &names); err != nil {
+			return nil, fmt.Errorf("failed to decode api key scope: %w", err)
+		}
+		for _, name := range names {
+			scope.Families = append(scope.Families, sqlrpcv1.RpcFamily(sqlrpcv1.RpcFamily_value["RPC_FAMILY_"+strings.ToUpper(name)]))
+		}
+	}
+	return scope, nil
+}
+
 // ListApiKeys returns all API keys for a user (without the secret)
 func (s *MetaStore) ListApiKeys(ctx context.Context, userID int64) ([]ApiKey, error) {
 	rows, err := s.db.QueryContext(ctx, `
-		SELECT id, user_id, key_prefix, name, expires_at, created_at
-		FROM api_keys WHERE user_id = ?
+		SELECT k.id, k.user_id, k.key_prefix, k.name, k.expires_at, k.created_at,
+			s.api_key_id IS NOT NULL, s.databases, s.channels, s.max_role, s.families
+		FROM api_keys k LEFT JOIN api_key_scopes s ON s.api_key_id = k.id
+		WHERE k.user_id = ?
 	`, userID)
 	if err != nil {
 		return nil, err
@@ -447,13 +741,21 @@ func (s *MetaStore) ListApiKeys(ctx context.Context, userID int64) ([]ApiKey, er
 	for rows.Next() {
 		var key ApiKey
 		var expiresAt sql.NullTime
-		err := rows.Scan(&key.ID, &key.UserID, &key.KeyPrefix, &key.Name, &expiresAt, &key.CreatedAt)
+		var scoped bool
+		var databases, channels, maxRole, families sql.NullString
+		err := rows.Scan(&key.ID, &key.UserID, &key.KeyPrefix, &key.Name, &expiresAt, &key.CreatedAt,
+			&scoped, &databases, &channels, &maxRole, &families)
 		if err != nil {
 			return nil, err
 		}
 		if expiresAt.Valid {
 			key.ExpiresAt = expiresAt.Time
 		}
+		if scoped {
+			if key.Scope, err = decodeScope(databases, channels, maxRole, families); err != nil {
+				return nil, err
+			}
+		}
 		keys = append(keys, key)
 	}
 
@@ -505,11 +807,17 @@ func (s *MetaStore) ValidateApiKeyImpl(ctx context.Context, token string) (*User
 	hash := sha256.Sum256([]byte(token))
 	keyHash := hex.EncodeToString(hash[:])
 
+	var keyID string
 	var userID int64
 	var expiresAt sql.NullTime
+	var scoped bool
+	var databases, channels, maxRole, families sql.NullString
 	err := s.db.QueryRowContext(ctx, `
-		SELECT user_id, expires_at FROM api_keys WHERE key_hash = ?
-	`, keyHash).Scan(&userID, &expiresAt)
+		SELECT k.id, k.user_id, k.expires_at,
+			s.api_key_id IS NOT NULL, s.databases, s.channels, s.max_role, s.families
+		FROM api_keys k LEFT JOIN api_key_scopes s ON s.api_key_id = k.id
+		WHERE k.key_hash = ?
+	`, keyHash).Scan(&keyID, &userID, &expiresAt, &scoped, &databases, &channels, &maxRole, &families)
 	if err == sql.ErrNoRows {
 		return nil, nil // Invalid key
 	}
@@ -532,11 +840,159 @@ func (s *MetaStore) ValidateApiKeyImpl(ctx context.Context, token string) (*User
 		return nil, err
 	}
 
-	return &UserClaims{
+	grants, err := s.loadGrants(ctx, userID, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := &UserClaims{
 		UserID:   userID,
 		Username: username,
 		Role:     ParseRole(role),
-	}, nil
+		KeyID:    keyID,
+		Grants:   grants,
+	}
+	if scoped {
+		if claims.Scope, err = decodeScope(databases, channels, maxRole, families); err != nil {
+			return nil, err
+		}
+		// Cap the global role so control plane checks honour the scope too
+		claims.Role = claims.Scope.limit("", claims.Role)
+	}
+	return claims, nil
+}
+
+// ============================================================================
+// Database Grant Operations
+// ============================================================================
+
+// grantSelect resolves the owning user for both user-wide and key-scoped grants.
+const grantSelect = `
+	SELECT g.id, u.id, u.username, COALESCE(g.api_key_id, ''), g.db_pattern, g.role, g.created_at
+	FROM database_grants g
+	LEFT JOIN api_keys k ON k.id = g.api_key_id
+	JOIN users u ON u.id = COALESCE(g.user_id, k.user_id)
+`
+
+// GrantDatabaseAccess assigns a role on a database name or glob to either a user
+// (username) or a single API key (keyID). Exactly one of them must be set.
+// Granting the same pattern again replaces the previous role.
+func (s *MetaStore) GrantDatabaseAccess(ctx context.Context, username, keyID, database string, role sqlrpcv1.Role) (*DatabaseGrant, error) {
+	if (username == "") == (keyID == "") {
+		return nil, fmt.Errorf("exactly one of username or api key id is required")
+	}
+	if database == "" {
+		return nil, fmt.Errorf("database pattern cannot be empty")
+	}
+	if _, err := path.Match(database, ""); err != nil {
+		return nil, fmt.Errorf("invalid database pattern %q: %w", database, err)
+	}
+	switch role {
+	case sqlrpcv1.Role_ROLE_READ_ONLY, sqlrpcv1.Role_ROLE_READ_WRITE, sqlrpcv1.Role_ROLE_DATABASE_MANAGER:
+	default:
+		return nil, fmt.Errorf("invalid grant role: %s", role)
+	}
+	roleStr := FormatRole(role)
+
+	var err error
+	var where string
+	var owner any
+	if username != "" {
+		var userID int64
+		err = s.db.QueryRowContext(ctx, "SELECT id FROM users WHERE username = ?", username).Scan(&userID)
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("user not found: %s", username)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to grant database access: %w", err)
+		}
+		_, err = s.db.ExecContext(ctx, `
+			INSERT INTO database_grants (user_id, db_pattern, role)
+			VALUES (?, ?, ?)
+			ON CONFLICT(user_id, db_pattern) WHERE user_id IS NOT NULL
+			DO UPDATE SET role = excluded.role
+		`, userID, database, roleStr)
+		where, owner = "g.user_id = ?", userID
+	} else {
+		var exists int
+		err = s.db.QueryRowContext(ctx, "SELECT 1 FROM api_keys WHERE id = ?", keyID).Scan(&exists)
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("api key not found: %s", keyID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to grant database access: %w", err)
+		}
+		_, err = s.db.ExecContext(ctx, `
+			INSERT INTO database_grants (api_key_id, db_pattern, role)
+			VALUES (?, ?, ?)
+			ON CONFLICT(api_key_id, db_pattern) WHERE api_key_id IS NOT NULL
+			DO UPDATE SET role = excluded.role
+		`, keyID, database, roleStr)
+		where, owner = "g.api_key_id = ?", keyID
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to grant database access: %w", err)
+	}
+
+	grants, err := s.queryGrants(ctx, where+" AND g.db_pattern = ?", owner, database)
+	if err != nil {
+		return nil, err
+	}
+	if len(grants) == 0 {
+		return nil, fmt.Errorf("failed to grant database access: grant not persisted")
+	}
+	return &grants[0], nil
+}
+
+// RevokeDatabaseAccess removes a grant by ID.
+func (s *MetaStore) RevokeDatabaseAccess(ctx context.Context, grantID int64) error {
+	result, err := s.db.ExecContext(ctx, "DELETE FROM database_grants WHERE id = ?", grantID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke database access: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return fmt.Errorf("grant not found: %d", grantID)
+	}
+	return nil
+}
+
+// ListDatabaseGrants returns grants, optionally filtered by owning username and
+// exact database pattern. Key-scoped grants are listed under the key's owner.
+func (s *MetaStore) ListDatabaseGrants(ctx context.Context, username, database string) ([]DatabaseGrant, error) {
+	return s.queryGrants(ctx, "(? = '' OR u.username = ?) AND (? = '' OR g.db_pattern = ?)", username, username, database, database)
+}
+
+// loadGrants returns the user-wide grants of userID plus, if keyID is set,
+// the grants scoped to that key.
+func (s *MetaStore) loadGrants(ctx context.Context, userID int64, keyID string) ([]DatabaseGrant, error) {
+	return s.queryGrants(ctx, "g.user_id = ? OR (? != '' AND g.api_key_id = ?)", userID, keyID, keyID)
+}
+
+func (s *MetaStore) queryGrants(ctx context.Context, where string, args ...any) ([]DatabaseGrant, error) {
+	rows, err := s.db.QueryContext(ctx, grantSelect+" WHERE "+where+" ORDER BY g.id", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list database grants: %w", err)
+	}
+	defer rows.Close()
+
+	var grants []DatabaseGrant
+	for rows.Next() {
+		var g DatabaseGrant
+		var role dbRole
+		if err := rows.Scan(&g.ID, &g.UserID, &g.Username, &g.APIKeyID, &g.Database, &role, &g.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan database grant: %w", err)
+		}
+		g.Role = ParseRole(role)
+		grants = append(grants, g)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list database grants iteration failed: %w", err)
+	}
+
+	return grants, nil
 }
 
 // ============================================================================
@@ -612,3 +1068,177 @@ func (s *MetaStore) ListDatabaseConfigs(ctx context.Context) ([]DatabaseConfig,
 
 	return configs, nil
 }
+
+// ============================================================================
+// Maintenance Run Operations
+// ============================================================================
+
+// maintenanceRunHistory is the number of runs kept per database and task.
+const maintenanceRunHistory = 500
+
+// maintenanceTaskName returns the string stored for a task (e.g. "integrity_check").
+func maintenanceTaskName(task sqlrpcv1.MaintenanceTask) string {
+	return strings.ToLower(strings.TrimPrefix(task.String(), "MAINTENANCE_TASK_"))
+}
+
+// RecordMaintenanceRun stores the result of a maintenance job and prunes the
+// oldest runs of the same database and task beyond the kept history.
+func (s *MetaStore) RecordMaintenanceRun(ctx context.Context, run *MaintenanceRun) error {
+	task := maintenanceTaskName(run.Task)
+	res, err := s.db.ExecContext(ctx, `
+		INSERT INTO maintenance_runs (database, task, started_at, duration_ms, success, message)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`, run.Database, task, run.StartedAt.UTC(), run.Duration.Milliseconds(), run.Success, run.Message)
+	if err != nil {
+		return fmt.Errorf("failed to record maintenance run: %w", err)
+	}
+	if run.ID, err = res.LastInsertId(); err != nil {
+		return fmt.Errorf("failed to get maintenance run id: %w", err)
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+		DELETE FROM maintenance_runs
+		WHERE database = ? AND task = ? AND id <= (
+			SELECT id FROM maintenance_runs WHERE database = ? AND task = ?
+			ORDER BY id DESC LIMIT 1 OFFSET ?
+		)
+	`, run.Database, task, run.Database, task, maintenanceRunHistory)
+	if err != nil {
+		return fmt.Errorf("failed to prune maintenance runs: %w", err)
+	}
+	return nil
+}
+
+// ListMaintenanceRuns returns recorded maintenance runs, newest first. An empty
+// database or unspecified task matches all.
+func (s *MetaStore) ListMaintenanceRuns(ctx context.Context, database string, task sqlrpcv1.MaintenanceTask, limit int) ([]MaintenanceRun, error) {
+	taskName := ""
+	if task != sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_UNSPECIFIED {
+		taskName = maintenanceTaskName(task)
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, database, task, started_at, duration_ms, success, COALESCE(message, '')
+		FROM maintenance_runs
+		WHERE (? = '' OR database = ?) AND (? = '' OR task = ?)
+		ORDER BY id DESC LIMIT ?
+	`, database, database, taskName, taskName, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list maintenance runs: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []MaintenanceRun
+	for rows.Next() {
+		var run MaintenanceRun
+		var task string
+		var durationMs int64
+		if err := rows.Scan(&run.ID, &run.Database, &task, &run.StartedAt, &durationMs, &run.Success, &run.Message); err != nil {
+			return nil, fmt.Errorf("failed to scan maintenance run: %w", err)
+		}
+		run.Task = sqlrpcv1.MaintenanceTask(sqlrpcv1.MaintenanceTask_value["MAINTENANCE_TASK_"+strings.ToUpper(task)])
+		run.Duration = time.Duration(durationMs) * time.Millisecond
+		runs = append(runs, run)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list maintenance runs iteration failed: %w", err)
+	}
+
+	return runs, nil
+}
+
+// ============================================================================
+// Audit Event Operations
+// ============================================================================
+
+// RecordAuditEvent appends an event to the audit log. The table rejects updates
+// and deletes, so recorded events cannot be altered through the store.
+func (s *MetaStore) RecordAuditEvent(ctx context.Context, event *AuditEvent) error {
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+	res, err := s.db.ExecContext(ctx, `
+		INSERT INTO audit_events (created_at, actor, api_key_id, rpc, database, sql_text, params, details, code, error, request_id)
+		VALUES (?, ?, NULLIF(?, ''), ?, NULLIF(?, ''), NULLIF(?, ''), NULLIF(?, ''), NULLIF(?, ''), ?, NULLIF(?, ''), NULLIF(?, ''))
+	`, event.CreatedAt.UTC(), event.Actor, event.APIKeyID, event.RPC, event.Database, event.SQL,
+		event.Params, event.Details, event.Code, event.Error, event.RequestID)
+	if err != nil {
+		return fmt.Errorf("failed to record audit event: %w", err)
+	}
+	if event.ID, err = res.LastInsertId(); err != nil {
+		return fmt.Errorf("failed to get audit event id: %w", err)
+	}
+	return nil
+}
+
+// ListAuditEvents returns the audit events matching filter, newest first.
+func (s *MetaStore) ListAuditEvents(ctx context.Context, filter AuditFilter) ([]AuditEvent, error) {
+	var where []string
+	var args []any
+	if filter.Actor != "" {
+		where = append(where, "actor = ?")
+		args = append(args, filter.Actor)
+	}
+	if filter.Database != "" {
+		where = append(where, "database = ?")
+		args = append(args, filter.Database)
+	}
+	if filter.RPC != "" {
+		if strings.HasPrefix(filter.RPC, "/") {
+			where = append(where, "rpc = ?")
+			args = append(args, filter.RPC)
+		} else {
+			where = append(where, "substr(rpc, -length(?) - 1) = '/' || ?")
+			args = append(args, filter.RPC, filter.RPC)
+		}
+	}
+	if !filter.Since.IsZero() {
+		where = append(where, "created_at >= ?")
+		args = append(args, filter.Since.UTC())
+	}
+	if !filter.Until.IsZero() {
+		where = append(where, "created_at < ?")
+		args = append(args, filter.Until.UTC())
+	}
+	if filter.Failed {
+		where = append(where, "code <> 'ok'")
+	}
+	if filter.BeforeID > 0 {
+		where = append(where, "id < ?")
+		args = append(args, filter.BeforeID)
+	}
+
+	query := `
+		SELECT id, created_at, actor, COALESCE(api_key_id, ''), rpc, COALESCE(database, ''),
+			COALESCE(sql_text, ''), COALESCE(params, ''), COALESCE(details, ''), code,
+			COALESCE(error, ''), COALESCE(request_id, '')
+		FROM audit_events`
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY id DESC LIMIT ?"
+	args = append(args, filter.Limit)
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []AuditEvent
+	for rows.Next() {
+		var e AuditEvent
+		if err := rows.Scan(&e.ID, &e.CreatedAt, &e.Actor, &e.APIKeyID, &e.RPC, &e.Database,
+			&e.SQL, &e.Params, &e.Details, &e.Code, &e.Error, &e.RequestID); err != nil {
+			return nil, fmt.Errorf("failed to scan audit event: %w", err)
+		}
+		events = append(events, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list audit events iteration failed: %w", err)
+	}
+
+	return events, nil
+}
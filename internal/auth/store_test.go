@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -912,3 +913,436 @@ func TestMetaStore_ListApiKeys_Error(t *testing.T) {
 	_, err := store.ListApiKeys(context.Background(), 1)
 	assert.Error(t, err)
 }
+
+func TestMetaStore_DatabaseGrants(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test_grants.db")
+	store, err := NewMetaStore(dbPath)
+	require.NoError(t, err)
+	defer store.Close()
+
+	ctx := context.Background()
+	userID, err := store.CreateUser(ctx, "tenant_user", "password123", sqlrpcv1.Role_ROLE_READ_ONLY)
+	require.NoError(t, err)
+	rawKey, keyID, err := store.CreateApiKey(ctx, userID, "scoped", nil)
+	require.NoError(t, err)
+
+	t.Run("grant to user and upsert", func(t *testing.T) {
+		g, err := store.GrantDatabaseAccess(ctx, "tenant_user", "", "tenant_*", sqlrpcv1.Role_ROLE_READ_ONLY)
+		require.NoError(t, err)
+		assert.Equal(t, userID, g.UserID)
+		assert.Equal(t, "tenant_user", g.Username)
+		assert.Empty(t, g.APIKeyID)
+
+		again, err := store.GrantDatabaseAccess(ctx, "tenant_user", "", "tenant_*", sqlrpcv1.Role_ROLE_READ_WRITE)
+		require.NoError(t, err)
+		assert.Equal(t, g.ID, again.ID)
+		assert.Equal(t, sqlrpcv1.Role_ROLE_READ_WRITE, again.Role)
+	})
+
+	t.Run("grant to api key", func(t *testing.T) {
+		g, err := store.GrantDatabaseAccess(ctx, "", keyID, "tenant_a", sqlrpcv1.Role_ROLE_READ_ONLY)
+		require.NoError(t, err)
+		assert.Equal(t, keyID, g.APIKeyID)
+		assert.Equal(t, "tenant_user", g.Username)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		_, err := store.GrantDatabaseAccess(ctx, "", "", "db", sqlrpcv1.Role_ROLE_READ_ONLY)
+		assert.Error(t, err)
+		_, err = store.GrantDatabaseAccess(ctx, "tenant_user", keyID, "db", sqlrpcv1.Role_ROLE_READ_ONLY)
+		assert.Error(t, err)
+		_, err = store.GrantDatabaseAccess(ctx, "tenant_user", "", "db", sqlrpcv1.Role_ROLE_ADMIN)
+		assert.ErrorContains(t, err, "invalid grant role")
+		_, err = store.GrantDatabaseAccess(ctx, "tenant_user", "", "[", sqlrpcv1.Role_ROLE_READ_ONLY)
+		assert.ErrorContains(t, err, "invalid database pattern")
+		_, err = store.GrantDatabaseAccess(ctx, "ghost", "", "db", sqlrpcv1.Role_ROLE_READ_ONLY)
+		assert.ErrorContains(t, err, "user not found")
+		_, err = store.GrantDatabaseAccess(ctx, "", "missing-key", "db", sqlrpcv1.Role_ROLE_READ_ONLY)
+		assert.ErrorContains(t, err, "api key not found")
+	})
+
+	t.Run("claims carry grants", func(t *testing.T) {
+		claims, err := store.ValidateUser(ctx, "tenant_user", "password123")
+		require.NoError(t, err)
+		assert.Len(t, claims.Grants, 1)
+		assert.Empty(t, claims.KeyID)
+
+		claims, err = store.ValidateApiKeyImpl(ctx, rawKey)
+		require.NoError(t, err)
+		assert.Len(t, claims.Grants, 2)
+		assert.Equal(t, keyID, claims.KeyID)
+		assert.Equal(t, sqlrpcv1.Role_ROLE_READ_ONLY, claims.RoleFor("tenant_a"))
+		assert.Equal(t, sqlrpcv1.Role_ROLE_UNSPECIFIED, claims.RoleFor("tenant_b"))
+	})
+
+	t.Run("list with filters", func(t *testing.T) {
+		all, err := store.ListDatabaseGrants(ctx, "", "")
+		require.NoError(t, err)
+		assert.Len(t, all, 2)
+
+		byDB, err := store.ListDatabaseGrants(ctx, "tenant_user", "tenant_a")
+		require.NoError(t, err)
+		require.Len(t, byDB, 1)
+		assert.Equal(t, keyID, byDB[0].APIKeyID)
+
+		none, err := store.ListDatabaseGrants(ctx, "other", "")
+		require.NoError(t, err)
+		assert.Empty(t, none)
+	})
+
+	t.Run("revoking the key cascades", func(t *testing.T) {
+		require.NoError(t, store.RevokeApiKey(ctx, keyID, ""))
+		all, err := store.ListDatabaseGrants(ctx, "", "")
+		require.NoError(t, err)
+		assert.Len(t, all, 1)
+	})
+
+	t.Run("revoke grant", func(t *testing.T) {
+		all, err := store.ListDatabaseGrants(ctx, "", "")
+		require.NoError(t, err)
+		require.Len(t, all, 1)
+
+		require.NoError(t, store.RevokeDatabaseAccess(ctx, all[0].ID))
+		err = store.RevokeDatabaseAccess(ctx, all[0].ID)
+		assert.ErrorContains(t, err, "grant not found")
+	})
+
+	t.Run("deleting the user cascades", func(t *testing.T) {
+		_, err := store.GrantDatabaseAccess(ctx, "tenant_user", "", "tenant_c", sqlrpcv1.Role_ROLE_READ_ONLY)
+		require.NoError(t, err)
+		require.NoError(t, store.DeleteUser(ctx, "tenant_user"))
+		all, err := store.ListDatabaseGrants(ctx, "", "")
+		require.NoError(t, err)
+		assert.Empty(t, all)
+	})
+}
+
+func TestUserClaims_RoleFor(t *testing.T) {
+	grant := func(db string, role sqlrpcv1.Role) DatabaseGrant {
+		return DatabaseGrant{Database: db, Role: role}
+	}
+	keyGrant := func(key, db string, role sqlrpcv1.Role) DatabaseGrant {
+		return DatabaseGrant{APIKeyID: key, Database: db, Role: role}
+	}
+
+	t.Run("no grants uses global role", func(t *testing.T) {
+		c := &UserClaims{Role: sqlrpcv1.Role_ROLE_READ_WRITE}
+		assert.Equal(t, sqlrpcv1.Role_ROLE_READ_WRITE, c.RoleFor("anything"))
+	})
+
+	t.Run("empty database uses global role", func(t *testing.T) {
+		c := &UserClaims{Role: sqlrpcv1.Role_ROLE_READ_WRITE, Grants: []DatabaseGrant{grant("a", sqlrpcv1.Role_ROLE_READ_ONLY)}}
+		assert.Equal(t, sqlrpcv1.Role_ROLE_READ_WRITE, c.RoleFor(""))
+	})
+
+	t.Run("user grants confine and override", func(t *testing.T) {
+		c := &UserClaims{Role: sqlrpcv1.Role_ROLE_READ_WRITE, Grants: []DatabaseGrant{
+			grant("tenant_*", sqlrpcv1.Role_ROLE_READ_ONLY),
+			grant("tenant_main", sqlrpcv1.Role_ROLE_DATABASE_MANAGER),
+			grant("tenant_ma*", sqlrpcv1.Role_ROLE_READ_WRITE),
+		}}
+		assert.Equal(t, sqlrpcv1.Role_ROLE_DATABASE_MANAGER, c.RoleFor("tenant_main"))
+		assert.Equal(t, sqlrpcv1.Role_ROLE_READ_WRITE, c.RoleFor("tenant_mail"))
+		assert.Equal(t, sqlrpcv1.Role_ROLE_READ_ONLY, c.RoleFor("tenant_x"))
+		assert.Equal(t, sqlrpcv1.Role_ROLE_UNSPECIFIED, c.RoleFor("other"))
+	})
+
+	t.Run("admins ignore user grants", func(t *testing.T) {
+		c := &UserClaims{Role: sqlrpcv1.Role_ROLE_ADMIN, Grants: []DatabaseGrant{grant("a", sqlrpcv1.Role_ROLE_READ_ONLY)}}
+		assert.Equal(t, sqlrpcv1.Role_ROLE_ADMIN, c.RoleFor("b"))
+	})
+
+	t.Run("key grants only narrow", func(t *testing.T) {
+		c := &UserClaims{Role: sqlrpcv1.Role_ROLE_READ_ONLY, KeyID: "k1", Grants: []DatabaseGrant{
+			keyGrant("k1", "a", sqlrpcv1.Role_ROLE_READ_WRITE),
+			keyGrant("k1", "b", sqlrpcv1.Role_ROLE_READ_ONLY),
+			keyGrant("k2", "c", sqlrpcv1.Role_ROLE_READ_WRITE),
+		}}
+		assert.Equal(t, sqlrpcv1.Role_ROLE_READ_ONLY, c.RoleFor("a"))
+		assert.Equal(t, sqlrpcv1.Role_ROLE_READ_ONLY, c.RoleFor("b"))
+		assert.Equal(t, sqlrpcv1.Role_ROLE_UNSPECIFIED, c.RoleFor("c"))
+	})
+
+	t.Run("key grants restrict admins", func(t *testing.T) {
+		c := &UserClaims{Role: sqlrpcv1.Role_ROLE_ADMIN, KeyID: "k1", Grants: []DatabaseGrant{
+			keyGrant("k1", "a", sqlrpcv1.Role_ROLE_READ_WRITE),
+		}}
+		assert.Equal(t, sqlrpcv1.Role_ROLE_READ_WRITE, c.RoleFor("a"))
+		assert.Equal(t, sqlrpcv1.Role_ROLE_UNSPECIFIED, c.RoleFor("b"))
+	})
+}
+
+func TestMetaStore_ScopedApiKeys(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test_scopes.db")
+	store, err := NewMetaStore(dbPath)
+	require.NoError(t, err)
+	defer store.Close()
+
+	ctx := context.Background()
+	userID, err := store.CreateUser(ctx, "ci", "password123", sqlrpcv1.Role_ROLE_ADMIN)
+	require.NoError(t, err)
+
+	scope := &ApiKeyScope{
+		Databases: []string{"tenant_a"},
+		Channels:  []string{"deploys"},
+		MaxRole:   sqlrpcv1.Role_ROLE_READ_ONLY,
+		Families:  []sqlrpcv1.RpcFamily{sqlrpcv1.RpcFamily_RPC_FAMILY_SUBSCRIBE},
+	}
+	rawKey, keyID, err := store.CreateScopedApiKey(ctx, userID, "ci-key", nil, scope)
+	require.NoError(t, err)
+	_, plainID, err := store.CreateApiKey(ctx, userID, "plain", nil)
+	require.NoError(t, err)
+
+	t.Run("validate loads scope and caps role", func(t *testing.T) {
+		claims, err := store.ValidateApiKeyImpl(ctx, rawKey)
+		require.NoError(t, err)
+		require.NotNil(t, claims.Scope)
+		assert.Equal(t, scope, claims.Scope)
+		assert.Equal(t, sqlrpcv1.Role_ROLE_READ_ONLY, claims.Role)
+		assert.Equal(t, sqlrpcv1.Role_ROLE_UNSPECIFIED, claims.RoleFor("tenant_b"))
+		assert.True(t, claims.AllowsFamily(sqlrpcv1.RpcFamily_RPC_FAMILY_SUBSCRIBE))
+		assert.False(t, claims.AllowsFamily(sqlrpcv1.RpcFamily_RPC_FAMILY_ADMIN))
+		assert.True(t, claims.AllowsChannel("deploys"))
+		assert.False(t, claims.AllowsChannel("billing"))
+	})
+
+	t.Run("list returns scope", func(t *testing.T) {
+		keys, err := store.ListApiKeys(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, keys, 2)
+		for _, k := range keys {
+			switch k.ID {
+			case keyID:
+				assert.Equal(t, scope, k.Scope)
+			case plainID:
+				assert.Nil(t, k.Scope)
+			}
+		}
+	})
+
+	t.Run("invalid scopes are rejected", func(t *testing.T) {
+		_, _, err := store.CreateScopedApiKey(ctx, userID, "bad", nil, &ApiKeyScope{Databases: []string{"["}})
+		assert.ErrorContains(t, err, "invalid scope pattern")
+		_, _, err = store.CreateScopedApiKey(ctx, userID, "bad", nil, &ApiKeyScope{Channels: []string{""}})
+		assert.ErrorContains(t, err, "invalid scope pattern")
+		_, _, err = store.CreateScopedApiKey(ctx, userID, "bad", nil, &ApiKeyScope{Families: []sqlrpcv1.RpcFamily{0}})
+		assert.ErrorContains(t, err, "invalid scope family")
+	})
+
+	t.Run("scope is removed with the key", func(t *testing.T) {
+		require.NoError(t, store.RevokeApiKey(ctx, keyID, "ci"))
+		var n int
+		require.NoError(t, store.db.QueryRow("SELECT COUNT(*) FROM api_key_scopes").Scan(&n))
+		assert.Zero(t, n)
+	})
+}
+
+func TestUserClaims_Scope(t *testing.T) {
+	c := &UserClaims{
+		Role: sqlrpcv1.Role_ROLE_READ_WRITE,
+		Grants: []DatabaseGrant{
+			{Database: "tenant_a", Role: sqlrpcv1.Role_ROLE_DATABASE_MANAGER},
+			{Database: "tenant_b", Role: sqlrpcv1.Role_ROLE_READ_WRITE},
+		},
+		Scope: &ApiKeyScope{Databases: []string{"tenant_*"}, MaxRole: sqlrpcv1.Role_ROLE_READ_WRITE},
+	}
+	assert.Equal(t, sqlrpcv1.Role_ROLE_READ_WRITE, c.RoleFor("tenant_a"), "grant capped at max role")
+	assert.Equal(t, sqlrpcv1.Role_ROLE_READ_WRITE, c.RoleFor("tenant_b"))
+	assert.Equal(t, sqlrpcv1.Role_ROLE_UNSPECIFIED, c.RoleFor("other"))
+
+	unscoped := &UserClaims{Role: sqlrpcv1.Role_ROLE_READ_ONLY}
+	assert.True(t, unscoped.AllowsFamily(sqlrpcv1.RpcFamily_RPC_FAMILY_ADMIN))
+	assert.True(t, unscoped.AllowsChannel("anything"))
+
+	globbed := &UserClaims{Scope: &ApiKeyScope{Channels: []string{"build_*"}}}
+	assert.True(t, globbed.AllowsChannel("build_42"))
+	assert.False(t, globbed.AllowsChannel("deploy_42"))
+	assert.True(t, globbed.AllowsFamily(sqlrpcv1.RpcFamily_RPC_FAMILY_QUERY))
+}
+
+func TestMetaStore_MaintenanceRuns(t *testing.T) {
+	store, err := NewMetaStore(filepath.Join(t.TempDir(), "test_maintenance.db"))
+	require.NoError(t, err)
+	defer store.Close()
+	ctx := context.Background()
+
+	started := time.Date(2025, 1, 15, 3, 0, 0, 0, time.UTC)
+	runs := []*MaintenanceRun{
+		{Database: "app", Task: sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_CHECKPOINT, StartedAt: started, Duration: 15 * time.Millisecond, Success: true, Message: "ok"},
+		{Database: "app", Task: sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_INTEGRITY_CHECK, StartedAt: started.Add(time.Minute), Success: false, Message: "corrupt"},
+		{Database: "other", Task: sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_BACKUP, StartedAt: started.Add(2 * time.Minute), Success: true},
+	}
+	for _, run := range runs {
+		require.NoError(t, store.RecordMaintenanceRun(ctx, run))
+		assert.NotZero(t, run.ID)
+	}
+
+	all, err := store.ListMaintenanceRuns(ctx, "", sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_UNSPECIFIED, 10)
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	assert.Equal(t, "other", all[0].Database, "newest first")
+	assert.Equal(t, runs[0].ID, all[2].ID)
+	assert.Equal(t, sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_CHECKPOINT, all[2].Task)
+	assert.True(t, all[2].StartedAt.Equal(started))
+	assert.Equal(t, 15*time.Millisecond, all[2].Duration)
+	assert.True(t, all[2].Success)
+	assert.Equal(t, "ok", all[2].Message)
+
+	filtered, err := store.ListMaintenanceRuns(ctx, "app", sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_INTEGRITY_CHECK, 10)
+	require.NoError(t, err)
+	require.Len(t, filtered, 1)
+	assert.False(t, filtered[0].Success)
+	assert.Equal(t, "corrupt", filtered[0].Message)
+
+	limited, err := store.ListMaintenanceRuns(ctx, "", sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_UNSPECIFIED, 1)
+	require.NoError(t, err)
+	assert.Len(t, limited, 1)
+
+	t.Run("history is pruned", func(t *testing.T) {
+		for i := 0; i < maintenanceRunHistory+5; i++ {
+			require.NoError(t, store.RecordMaintenanceRun(ctx, &MaintenanceRun{
+				Database: "busy", Task: sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_VACUUM, StartedAt: started, Success: true,
+			}))
+		}
+		kept, err := store.ListMaintenanceRuns(ctx, "busy", sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_VACUUM, 1000)
+		require.NoError(t, err)
+		assert.Len(t, kept, maintenanceRunHistory)
+	})
+}
+
+func TestMetaStore_AuditEvents(t *testing.T) {
+	store, err := NewMetaStore(filepath.Join(t.TempDir(), "test_audit.db"))
+	require.NoError(t, err)
+	defer store.Close()
+	ctx := context.Background()
+
+	at := time.Date(2025, 1, 15, 3, 0, 0, 0, time.UTC)
+	events := []*AuditEvent{
+		{CreatedAt: at, Actor: "admin", RPC: "/sqlrpc.v1.AdminService/CreateUser", Details: `{"username":"bob"}`, Code: "ok", RequestID: "req-1"},
+		{CreatedAt: at.Add(time.Minute), Actor: "bob", APIKeyID: "key-1", RPC: "/sqlrpc.v1.DatabaseService/Exec", Database: "app", SQL: "DELETE FROM t WHERE id = ?", Params: `{"positional":[1]}`, Code: "ok"},
+		{CreatedAt: at.Add(2 * time.Minute), Actor: "bob", RPC: "/sqlrpc.v1.DatabaseService/Query", Database: "other", SQL: "DROP TABLE t", Code: "permission_denied", Error: "insufficient permissions"},
+	}
+	for _, e := range events {
+		require.NoError(t, store.RecordAuditEvent(ctx, e))
+		assert.NotZero(t, e.ID)
+	}
+
+	all, err := store.ListAuditEvents(ctx, AuditFilter{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	assert.Equal(t, events[2].ID, all[0].ID, "newest first")
+	assert.Equal(t, *events[1], all[1])
+	assert.True(t, all[2].CreatedAt.Equal(at))
+
+	tests := []struct {
+		name   string
+		filter AuditFilter
+		want   []int64
+	}{
+		{"actor", AuditFilter{Actor: "bob"}, []int64{events[2].ID, events[1].ID}},
+		{"database", AuditFilter{Database: "app"}, []int64{events[1].ID}},
+		{"method name", AuditFilter{RPC: "Exec"}, []int64{events[1].ID}},
+		{"procedure", AuditFilter{RPC: "/sqlrpc.v1.AdminService/CreateUser"}, []int64{events[0].ID}},
+		{"time range", AuditFilter{Since: at.Add(time.Minute), Until: at.Add(2 * time.Minute)}, []int64{events[1].ID}},
+		{"failed", AuditFilter{Failed: true}, []int64{events[2].ID}},
+		{"before id", AuditFilter{BeforeID: events[2].ID, Limit: 1}, []int64{events[1].ID}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.filter.Limit == 0 {
+				tt.filter.Limit = 10
+			}
+			got, err := store.ListAuditEvents(ctx, tt.filter)
+			require.NoError(t, err)
+			var ids []int64
+			for _, e := range got {
+				ids = append(ids, e.ID)
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+
+	t.Run("append only", func(t *testing.T) {
+		_, err := store.GetDB().Exec("UPDATE audit_events SET actor = 'nobody'")
+		assert.ErrorContains(t, err, "append-only")
+		_, err = store.GetDB().Exec("DELETE FROM audit_events")
+		assert.ErrorContains(t, err, "append-only")
+	})
+}
+
+func TestMetaStore_PasswordHashMigration(t *testing.T) {
+	store, err := NewMetaStore(filepath.Join(t.TempDir(), "test_password_migration.db"))
+	require.NoError(t, err)
+	defer store.Close()
+	ctx := context.Background()
+	require.NoError(t, store.SetPasswordParams(testPasswordParams))
+	assert.Error(t, store.SetPasswordParams(PasswordParams{}))
+
+	// A users table written by older versions mixes legacy and argon2id hashes
+	outdated, err := hashPassword("modern-pass", PasswordParams{Memory: 32, Iterations: 1, Parallelism: 1})
+	require.NoError(t, err)
+	current, err := hashPassword("current-pass", testPasswordParams)
+	require.NoError(t, err)
+	users := map[string]string{
+		"legacy":   legacyHash("8f3a2c1d", "legacy-pass"),
+		"outdated": outdated,
+		"current":  current,
+	}
+	for name, hash := range users {
+		_, err := store.GetDB().Exec("INSERT INTO users (username, password_hash, role) VALUES (?, ?, 'read_only')", name, hash)
+		require.NoError(t, err)
+	}
+	storedHash := func(username string) string {
+		u, err := store.GetUserByUsername(ctx, username)
+		require.NoError(t, err)
+		return u.PasswordHash
+	}
+
+	t.Run("failed logins keep the hash", func(t *testing.T) {
+		claims, err := store.ValidateUser(ctx, "legacy", "wrong")
+		require.NoError(t, err)
+		assert.Nil(t, claims)
+		assert.Equal(t, users["legacy"], storedHash("legacy"))
+	})
+
+	t.Run("legacy hashes are upgraded on login", func(t *testing.T) {
+		claims, err := store.ValidateUser(ctx, "legacy", "legacy-pass")
+		require.NoError(t, err)
+		require.NotNil(t, claims)
+
+		upgraded := storedHash("legacy")
+		assert.True(t, strings.HasPrefix(upgraded, "$argon2id$v=19$m=64,t=1,p=1$"), upgraded)
+
+		claims, err = store.ValidateUser(ctx, "legacy", "legacy-pass")
+		require.NoError(t, err)
+		assert.NotNil(t, claims, "the upgraded hash still verifies")
+		assert.Equal(t, upgraded, storedHash("legacy"))
+	})
+
+	t.Run("outdated parameters are upgraded on login", func(t *testing.T) {
+		claims, err := store.ValidateUser(ctx, "outdated", "modern-pass")
+		require.NoError(t, err)
+		require.NotNil(t, claims)
+		assert.True(t, strings.HasPrefix(storedHash("outdated"), "$argon2id$v=19$m=64,t=1,p=1$"))
+	})
+
+	t.Run("current hashes are left alone", func(t *testing.T) {
+		claims, err := store.ValidateUser(ctx, "current", "current-pass")
+		require.NoError(t, err)
+		require.NotNil(t, claims)
+		assert.Equal(t, current, storedHash("current"))
+	})
+
+	t.Run("new passwords use argon2id", func(t *testing.T) {
+		_, err := store.CreateUser(ctx, "fresh", "fresh-pass", sqlrpcv1.Role_ROLE_READ_ONLY)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(storedHash("fresh"), "$argon2id$"))
+
+		require.NoError(t, store.UpdatePassword(ctx, "legacy", "rotated-pass"))
+		claims, err := store.ValidateUser(ctx, "legacy", "rotated-pass")
+		require.NoError(t, err)
+		assert.NotNil(t, claims)
+	})
+}
@@ -0,0 +1,224 @@
+package docs
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+)
+
+// RESTTable describes a table of the REST gateway.
+type RESTTable struct {
+	Schema *sqlrpcv1.TableSchema
+	// Key is the column the /{table}/{key} routes look rows up by, or empty if the
+	// table has no single-column key.
+	Key string
+}
+
+// RESTSpec returns the OpenAPI document of the REST gateway of database as JSON. It
+// lists the row routes of every table, with a schema per table built from its columns.
+func RESTSpec(database string, tables []RESTTable) ([]byte, error) {
+	paths := map[string]any{}
+	schemas := map[string]any{}
+	for _, t := range tables {
+		name := t.Schema.Name
+		ref := map[string]any{"$ref": "#/components/schemas/" + jsonPointerEscape(name)}
+		rows := map[string]any{"type": "array", "items": ref}
+		schemas[name] = rowSchema(t.Schema.Columns)
+
+		filters := []any{}
+		for _, c := range t.Schema.Columns {
+			filters = append(filters, map[string]any{
+				"name":        c.Name,
+				"in":          "query",
+				"description": "Filter on " + c.Name + ", as operator.value: eq, neq, gt, gte, lt, lte, like, ilike, is or in, optionally prefixed with not.",
+				"schema":      map[string]any{"type": "string"},
+			})
+		}
+		read := append([]any{
+			map[string]any{"$ref": "#/components/parameters/select"},
+			map[string]any{"$ref": "#/components/parameters/order"},
+			map[string]any{"$ref": "#/components/parameters/limit"},
+			map[string]any{"$ref": "#/components/parameters/offset"},
+		}, filters...)
+		tags := []string{name}
+
+		paths["/"+url.PathEscape(name)] = map[string]any{
+			"get": map[string]any{
+				"tags":       tags,
+				"summary":    "List the rows of " + name,
+				"parameters": read,
+				"responses":  responses("200", "The matching rows", rows),
+			},
+			"post": map[string]any{
+				"tags":        tags,
+				"summary":     "Insert rows into " + name,
+				"requestBody": body(map[string]any{"oneOf": []any{ref, rows}}),
+				"responses":   responses("201", "The inserted rows", rows),
+			},
+			"patch": map[string]any{
+				"tags":        tags,
+				"summary":     "Update the rows of " + name + " matching the filters",
+				"description": "At least one filter is required.",
+				"parameters":  filters,
+				"requestBody": body(ref),
+				"responses":   responses("200", "The updated rows", rows),
+			},
+			"delete": map[string]any{
+				"tags":        tags,
+				"summary":     "Delete the rows of " + name + " matching the filters",
+				"description": "At least one filter is required.",
+				"parameters":  filters,
+				"responses":   responses("200", "The deleted rows", rows),
+			},
+		}
+
+		if t.Key == "" {
+			continue
+		}
+		key := []any{map[string]any{
+			"name":        "key",
+			"in":          "path",
+			"required":    true,
+			"description": "The " + t.Key + " of the row",
+			"schema":      keySchema(t),
+		}}
+		paths["/"+url.PathEscape(name)+"/{key}"] = map[string]any{
+			"get": map[string]any{
+				"tags":       tags,
+				"summary":    "Get a row of " + name + " by " + t.Key,
+				"parameters": append(key, map[string]any{"$ref": "#/components/parameters/select"}),
+				"responses":  responses("200", "The row", ref),
+			},
+			"patch": map[string]any{
+				"tags":        tags,
+				"summary":     "Update a row of " + name + " by " + t.Key,
+				"parameters":  key,
+				"requestBody": body(ref),
+				"responses":   responses("200", "The updated row", ref),
+			},
+			"delete": map[string]any{
+				"tags":       tags,
+				"summary":    "Delete a row of " + name + " by " + t.Key,
+				"parameters": key,
+				"responses":  responses("200", "The deleted row", ref),
+			},
+		}
+	}
+
+	return json.Marshal(map[string]any{
+		"openapi": "3.1.0",
+		"info": map[string]any{
+			"title":       "SQLite Server REST: " + database,
+			"description": "Row routes generated from the schema of the " + database + " database. Reads need read access to it, writes need write access.",
+			"version":     "1",
+		},
+		"servers":  []any{map[string]any{"url": "/rest/" + url.PathEscape(database)}},
+		"security": []any{map[string]any{"bearerAuth": []string{}}, map[string]any{"basicAuth": []string{}}},
+		"paths":    paths,
+		"components": map[string]any{
+			"schemas": schemas,
+			"parameters": map[string]any{
+				"select": queryParameter("select", "Comma-separated columns to return (default all)", "string"),
+				"order":  queryParameter("order", "Comma-separated column.asc or column.desc, optionally followed by .nullsfirst or .nullslast", "string"),
+				"limit":  queryParameter("limit", "The maximum number of rows to return", "integer"),
+				"offset": queryParameter("offset", "The number of rows to skip", "integer"),
+			},
+			"securitySchemes": map[string]any{
+				"bearerAuth": map[string]any{"type": "http", "scheme": "bearer", "bearerFormat": "API Key"},
+				"basicAuth":  map[string]any{"type": "http", "scheme": "basic"},
+			},
+		},
+	})
+}
+
+// rowSchema is the JSON schema of a row with the given columns.
+func rowSchema(columns []*sqlrpcv1.ColumnSchema) map[string]any {
+	properties := map[string]any{}
+	for _, c := range columns {
+		schema := columnSchema(c.Type)
+		if !c.NotNull && !c.PrimaryKey {
+			if t, ok := schema["type"].(string); ok {
+				schema["type"] = []string{t, "null"}
+			}
+		}
+		if c.DefaultValue != "" {
+			schema["description"] = "Defaults to " + c.DefaultValue
+		}
+		properties[c.Name] = schema
+	}
+	return map[string]any{"type": "object", "properties": properties}
+}
+
+// keySchema is the JSON schema of the key of t.
+func keySchema(t RESTTable) map[string]any {
+	for _, c := range t.Schema.Columns {
+		if c.Name == t.Key {
+			return columnSchema(c.Type)
+		}
+	}
+	return map[string]any{"type": "integer"} // rowid
+}
+
+// columnSchema maps a declared column type to a JSON schema, with SQLite's rules for
+// column affinity. BLOBs are sent base64 encoded; untyped columns take any value.
+func columnSchema(declared string) map[string]any {
+	t := strings.ToUpper(declared)
+	switch {
+	case strings.Contains(t, "BOOL"):
+		return map[string]any{"type": "boolean"}
+	case strings.Contains(t, "INT"):
+		return map[string]any{"type": "integer"}
+	case strings.Contains(t, "CHAR"), strings.Contains(t, "CLOB"), strings.Contains(t, "TEXT"):
+		return map[string]any{"type": "string"}
+	case strings.Contains(t, "BLOB"):
+		return map[string]any{"type": "string", "contentEncoding": "base64"}
+	case t == "":
+		return map[string]any{}
+	}
+	return map[string]any{"type": "number"}
+}
+
+// queryParameter is an optional query parameter of the given type.
+func queryParameter(name, description, typ string) map[string]any {
+	return map[string]any{
+		"name":        name,
+		"in":          "query",
+		"description": description,
+		"schema":      map[string]any{"type": typ},
+	}
+}
+
+// body is a required JSON request body.
+func body(schema any) map[string]any {
+	return map[string]any{
+		"required": true,
+		"content":  map[string]any{"application/json": map[string]any{"schema": schema}},
+	}
+}
+
+// responses is the success response with the given status, and the errors.
+func responses(status, description string, schema any) map[string]any {
+	return map[string]any{
+		status: map[string]any{
+			"description": description,
+			"content":     map[string]any{"application/json": map[string]any{"schema": schema}},
+		},
+		"default": map[string]any{
+			"description": "Error",
+			"content": map[string]any{"application/json": map[string]any{"schema": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"code":    map[string]any{"type": "string"},
+					"message": map[string]any{"type": "string"},
+				},
+			}}},
+		},
+	}
+}
+
+// jsonPointerEscape escapes a name for use as a JSON pointer token.
+func jsonPointerEscape(name string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(name, "~", "~0"), "/", "~1")
+}
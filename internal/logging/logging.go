@@ -0,0 +1,355 @@
+// Package logging routes every server log record through log/slog.
+//
+// Each part of the server logs through a subsystem logger obtained from For. A record
+// is written when its level reaches the subsystem's override, or the default level
+// when the subsystem has none. Both can be changed at runtime without rebuilding the
+// loggers, so package-level loggers created before Setup pick up the final format.
+//
+// Request-scoped attributes (request ID, user, database, ...) are attached to a
+// context with NewContext and AddAttrs and added to every record logged with it.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Subsystems of the server.
+const (
+	Server      = "server"
+	Auth        = "auth"
+	RPC         = "rpc"
+	Tx          = "tx"
+	DB          = "db"
+	PubSub      = "pubsub"
+	Replication = "replication"
+	Maintenance = "maintenance"
+	SQLite      = "sqlite"
+)
+
+// Attribute keys shared by all subsystems.
+const (
+	KeySubsystem = "subsystem"
+	KeyRequestID = "request_id"
+	KeyRPC       = "rpc"
+	KeyDatabase  = "database"
+	KeyUser      = "user"
+	KeyTxID      = "tx_id"
+	KeyDuration  = "duration"
+	KeyError     = "error"
+)
+
+// Output formats accepted by Config.Format.
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Config selects the output format and levels.
+type Config struct {
+	Format string // FormatText or FormatJSON
+	Level  string // Default level: debug, info, warn or error
+	Levels string // Per-subsystem overrides, e.g. "pubsub=debug,auth=warn"
+}
+
+// output is the handler records are finally written to. Subsystem handlers rebuild
+// their attribute chain whenever it changes.
+type output struct {
+	handler slog.Handler
+	gen     uint64
+}
+
+var (
+	current      atomic.Pointer[output]
+	defaultLevel slog.LevelVar
+
+	mu         sync.Mutex
+	subsystems = map[string]*subsystem{}
+)
+
+func init() {
+	current.Store(&output{handler: newHandler(os.Stderr, FormatText)})
+}
+
+// subsystem holds the level override of one subsystem.
+type subsystem struct {
+	name       string
+	level      slog.LevelVar
+	overridden atomic.Bool
+}
+
+func (s *subsystem) minLevel() slog.Level {
+	if s.overridden.Load() {
+		return s.level.Level()
+	}
+	return defaultLevel.Level()
+}
+
+func lookup(name string) *subsystem {
+	mu.Lock()
+	defer mu.Unlock()
+	s, ok := subsystems[name]
+	if !ok {
+		s = &subsystem{name: name}
+		subsystems[name] = s
+	}
+	return s
+}
+
+// Setup switches all loggers to cfg and writes records to w. It also installs the
+// server logger as the slog default, which routes the standard log package through it.
+func Setup(w io.Writer, cfg Config) error {
+	format := cfg.Format
+	if format == "" {
+		format = FormatText
+	}
+	if format != FormatText && format != FormatJSON {
+		return fmt.Errorf("unknown log format %q (expected text or json)", cfg.Format)
+	}
+	level := slog.LevelInfo
+	if cfg.Level != "" {
+		var err error
+		if level, err = ParseLevel(cfg.Level); err != nil {
+			return err
+		}
+	}
+	overrides, err := parseLevels(cfg.Levels)
+	if err != nil {
+		return err
+	}
+
+	for name := range overrides {
+		if !Known(name) {
+			return fmt.Errorf("unknown log subsystem %q", name)
+		}
+	}
+
+	defaultLevel.Set(level)
+	for name, l := range overrides {
+		SetLevel(name, l)
+	}
+	prev := current.Load()
+	current.Store(&output{handler: newHandler(w, format), gen: prev.gen + 1})
+
+	slog.SetDefault(For(Server))
+	return nil
+}
+
+func newHandler(w io.Writer, format string) slog.Handler {
+	// Levels are enforced per subsystem; the output handler writes everything
+	opts := &slog.HandlerOptions{Level: slog.LevelDebug}
+	if format == FormatJSON {
+		return slog.NewJSONHandler(w, opts)
+	}
+	return slog.NewTextHandler(w, opts)
+}
+
+// For returns the logger of a subsystem. Loggers are cheap and may be stored in
+// package-level variables.
+func For(name string) *slog.Logger {
+	return slog.New(&handler{sub: lookup(name)})
+}
+
+// ParseLevel parses debug, info, warn or error (case-insensitive).
+func ParseLevel(s string) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(strings.TrimSpace(s))); err != nil {
+		return 0, fmt.Errorf("invalid log level %q (expected debug, info, warn or error)", s)
+	}
+	return level, nil
+}
+
+// parseLevels parses a comma-separated list of subsystem=level pairs.
+func parseLevels(s string) (map[string]slog.Level, error) {
+	levels := make(map[string]slog.Level)
+	for pair := range strings.SplitSeq(s, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(pair, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid log level override %q (expected subsystem=level)", pair)
+		}
+		level, err := ParseLevel(value)
+		if err != nil {
+			return nil, err
+		}
+		levels[name] = level
+	}
+	return levels, nil
+}
+
+// DefaultLevel returns the level of subsystems without an override.
+func DefaultLevel() slog.Level {
+	return defaultLevel.Level()
+}
+
+// SetDefaultLevel changes the level of subsystems without an override.
+func SetDefaultLevel(level slog.Level) {
+	defaultLevel.Set(level)
+}
+
+// Known reports whether a logger was ever created for the subsystem.
+func Known(name string) bool {
+	mu.Lock()
+	defer mu.Unlock()
+	_, ok := subsystems[name]
+	return ok
+}
+
+// SetLevel overrides the level of a subsystem.
+func SetLevel(name string, level slog.Level) {
+	s := lookup(name)
+	s.level.Set(level)
+	s.overridden.Store(true)
+}
+
+// ClearLevel makes a subsystem follow the default level again.
+func ClearLevel(name string) {
+	lookup(name).overridden.Store(false)
+}
+
+// SubsystemLevel is the effective level of one subsystem.
+type SubsystemLevel struct {
+	Name       string
+	Level      slog.Level
+	Overridden bool
+}
+
+// Levels returns the effective level of every known subsystem, sorted by name.
+func Levels() []SubsystemLevel {
+	mu.Lock()
+	defer mu.Unlock()
+	levels := make([]SubsystemLevel, 0, len(subsystems))
+	for _, s := range subsystems {
+		levels = append(levels, SubsystemLevel{Name: s.name, Level: s.minLevel(), Overridden: s.overridden.Load()})
+	}
+	slices.SortFunc(levels, func(a, b SubsystemLevel) int { return strings.Compare(a.Name, b.Name) })
+	return levels
+}
+
+// Err returns the attribute used for errors.
+func Err(err error) slog.Attr {
+	return slog.Any(KeyError, err)
+}
+
+// ---------------------------------------------------------
+// Request-scoped attributes
+// ---------------------------------------------------------
+
+type scopeKey struct{}
+
+// scope collects the attributes of one request. Inner layers (e.g. authentication)
+// add to it so outer layers see them when logging the outcome.
+type scope struct {
+	mu    sync.Mutex
+	attrs []slog.Attr
+}
+
+func (s *scope) snapshot() []slog.Attr {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clip(s.attrs)
+}
+
+// NewContext returns ctx with a new attribute scope holding args (slog key-value
+// pairs or Attrs) in addition to the attributes of any enclosing scope.
+func NewContext(ctx context.Context, args ...any) context.Context {
+	s := &scope{}
+	if parent, ok := ctx.Value(scopeKey{}).(*scope); ok {
+		s.attrs = parent.snapshot()
+	}
+	s.attrs = append(s.attrs, argsToAttrs(args)...)
+	return context.WithValue(ctx, scopeKey{}, s)
+}
+
+// AddAttrs adds args to the scope of ctx. It does nothing if ctx has no scope.
+func AddAttrs(ctx context.Context, args ...any) {
+	s, ok := ctx.Value(scopeKey{}).(*scope)
+	if !ok {
+		return
+	}
+	attrs := argsToAttrs(args)
+	s.mu.Lock()
+	s.attrs = append(s.attrs, attrs...)
+	s.mu.Unlock()
+}
+
+func argsToAttrs(args []any) []slog.Attr {
+	var r slog.Record
+	r.Add(args...)
+	attrs := make([]slog.Attr, 0, r.NumAttrs())
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, a)
+		return true
+	})
+	return attrs
+}
+
+// ---------------------------------------------------------
+// Handler
+// ---------------------------------------------------------
+
+// handler filters records by the subsystem level and forwards them to the current
+// output with the subsystem, the logger attributes and the request scope attached.
+type handler struct {
+	sub *subsystem
+	ops []func(slog.Handler) slog.Handler // WithAttrs/WithGroup calls, in order
+
+	cache atomic.Pointer[output] // current output with ops applied
+}
+
+func (h *handler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.sub.minLevel()
+}
+
+func (h *handler) Handle(ctx context.Context, r slog.Record) error {
+	if s, ok := ctx.Value(scopeKey{}).(*scope); ok {
+		if attrs := s.snapshot(); len(attrs) > 0 {
+			r = r.Clone()
+			r.AddAttrs(attrs...)
+		}
+	}
+	return h.resolve().Handle(ctx, r)
+}
+
+// resolve returns the output handler with the subsystem and logger attributes applied,
+// rebuilding it after Setup changed the output.
+func (h *handler) resolve() slog.Handler {
+	out := current.Load()
+	if cached := h.cache.Load(); cached != nil && cached.gen == out.gen {
+		return cached.handler
+	}
+	next := out.handler.WithAttrs([]slog.Attr{slog.String(KeySubsystem, h.sub.name)})
+	for _, op := range h.ops {
+		next = op(next)
+	}
+	h.cache.Store(&output{handler: next, gen: out.gen})
+	return next
+}
+
+func (h *handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+	return h.with(func(next slog.Handler) slog.Handler { return next.WithAttrs(attrs) })
+}
+
+func (h *handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return h.with(func(next slog.Handler) slog.Handler { return next.WithGroup(name) })
+}
+
+func (h *handler) with(op func(slog.Handler) slog.Handler) *handler {
+	return &handler{sub: h.sub, ops: append(slices.Clip(h.ops), op)}
+}
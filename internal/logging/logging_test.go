@@ -0,0 +1,114 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// capture switches the output to a buffer for the duration of the test.
+func capture(t *testing.T, cfg Config) *bytes.Buffer {
+	var buf bytes.Buffer
+	require.NoError(t, Setup(&buf, cfg))
+	t.Cleanup(func() {
+		for _, s := range Levels() {
+			ClearLevel(s.Name)
+		}
+		require.NoError(t, Setup(os.Stderr, Config{}))
+	})
+	return &buf
+}
+
+func records(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	var out []map[string]any
+	for line := range strings.Lines(buf.String()) {
+		var rec map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &rec), line)
+		out = append(out, rec)
+	}
+	return out
+}
+
+func TestSetup(t *testing.T) {
+	assert.ErrorContains(t, Setup(os.Stderr, Config{Format: "xml"}), "unknown log format")
+	assert.ErrorContains(t, Setup(os.Stderr, Config{Level: "loud"}), "invalid log level")
+	assert.ErrorContains(t, Setup(os.Stderr, Config{Levels: "pubsub"}), "expected subsystem=level")
+	assert.ErrorContains(t, Setup(os.Stderr, Config{Levels: "nosuch=debug"}), "unknown log subsystem")
+}
+
+func TestSubsystemLevels(t *testing.T) {
+	logger := For("early") // Created before Setup, like package-level loggers
+	pubsub := For(PubSub)
+	buf := capture(t, Config{Format: FormatJSON, Level: "warn", Levels: "pubsub=debug"})
+
+	logger.Info("dropped")
+	logger.Warn("kept", "n", 1)
+	pubsub.Debug("flush", KeyDatabase, "app")
+
+	recs := records(t, buf)
+	require.Len(t, recs, 2)
+	assert.Equal(t, "kept", recs[0]["msg"])
+	assert.Equal(t, "early", recs[0][KeySubsystem])
+	assert.Equal(t, "DEBUG", recs[1]["level"])
+	assert.Equal(t, "pubsub", recs[1][KeySubsystem])
+	assert.Equal(t, "app", recs[1][KeyDatabase])
+
+	t.Run("runtime changes", func(t *testing.T) {
+		buf.Reset()
+		SetLevel("early", slog.LevelDebug)
+		ClearLevel(PubSub)
+		logger.Debug("now visible")
+		pubsub.Info("now hidden")
+
+		recs := records(t, buf)
+		require.Len(t, recs, 1)
+		assert.Equal(t, "now visible", recs[0]["msg"])
+
+		levels := map[string]SubsystemLevel{}
+		for _, s := range Levels() {
+			levels[s.Name] = s
+		}
+		assert.Equal(t, SubsystemLevel{Name: "early", Level: slog.LevelDebug, Overridden: true}, levels["early"])
+		assert.Equal(t, SubsystemLevel{Name: PubSub, Level: slog.LevelWarn}, levels[PubSub])
+	})
+}
+
+func TestScope(t *testing.T) {
+	buf := capture(t, Config{Format: FormatJSON})
+	logger := For(RPC).With("component", "test")
+
+	ctx := NewContext(context.Background(), KeyRequestID, "req-1")
+	AddAttrs(ctx, KeyUser, "alice")
+	logger.InfoContext(ctx, "handled")
+
+	inner := NewContext(ctx, KeyTxID, "tx-1")
+	logger.InfoContext(inner, "in transaction")
+	AddAttrs(context.Background(), KeyUser, "ignored")
+
+	recs := records(t, buf)
+	require.Len(t, recs, 2)
+	assert.Equal(t, "test", recs[0]["component"])
+	assert.Equal(t, "req-1", recs[0][KeyRequestID])
+	assert.Equal(t, "alice", recs[0][KeyUser])
+	assert.Nil(t, recs[0][KeyTxID])
+	assert.Equal(t, "alice", recs[1][KeyUser])
+	assert.Equal(t, "tx-1", recs[1][KeyTxID])
+}
+
+func TestStandardLogRedirect(t *testing.T) {
+	buf := capture(t, Config{Format: FormatJSON})
+	log.Printf("legacy %d", 1)
+
+	recs := records(t, buf)
+	require.Len(t, recs, 1)
+	assert.Equal(t, "legacy 1", recs[0]["msg"])
+	assert.Equal(t, Server, recs[0][KeySubsystem])
+}
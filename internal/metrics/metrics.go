@@ -0,0 +1,400 @@
+// Package metrics is a small, dependency-free implementation of Prometheus counters,
+// gauges and histograms, exposed in the Prometheus text format or, when the scraper asks
+// for it, in the OpenMetrics text format.
+//
+// Instruments are registered once on a Registry and then updated from hot paths
+// (atomic adds for counters and gauges, a short mutex for histograms). Values owned by
+// other components (pool sizes, queue depths) are read at scrape time through
+// GaugeFunc and CounterFunc collectors instead of being mirrored into gauges.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Content types of the two supported exposition formats.
+const (
+	ContentTypeText        = "text/plain; version=0.0.4; charset=utf-8"
+	ContentTypeOpenMetrics = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+)
+
+// LatencyBuckets are histogram buckets for request durations in seconds.
+var LatencyBuckets = []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// Registry holds metric families and writes them in registration order.
+type Registry struct {
+	mu       sync.Mutex
+	families []family
+	names    map[string]bool
+}
+
+// family is a named group of samples sharing HELP and TYPE metadata.
+type family interface {
+	metadata() *desc
+	// collect calls emit for every sample. suffix is appended to the family name
+	// ("_bucket", "_sum", ...); extra holds additional label pairs such as le.
+	collect(emit func(suffix string, labelValues []string, extra []string, value float64))
+}
+
+type desc struct {
+	name       string
+	help       string
+	kind       string // counter, gauge or histogram
+	labelNames []string
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{names: make(map[string]bool)}
+}
+
+func (r *Registry) register(f family) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	name := f.metadata().name
+	if r.names[name] {
+		panic(fmt.Sprintf("metrics: duplicate metric %q", name))
+	}
+	r.names[name] = true
+	r.families = append(r.families, f)
+}
+
+// ---------------------------------------------------------
+// Label Vectors
+// ---------------------------------------------------------
+
+// vec maps label values to a series.
+type vec[T any] struct {
+	desc
+	mu     sync.RWMutex
+	series map[string]*labeled[T]
+	newT   func() *T
+}
+
+type labeled[T any] struct {
+	values []string
+	metric *T
+}
+
+func newVec[T any](d desc, newT func() *T) *vec[T] {
+	return &vec[T]{desc: d, series: make(map[string]*labeled[T]), newT: newT}
+}
+
+func (v *vec[T]) with(labelValues []string) *T {
+	if len(labelValues) != len(v.labelNames) {
+		panic(fmt.Sprintf("metrics: %s expects %d label values, got %d", v.name, len(v.labelNames), len(labelValues)))
+	}
+	key := strings.Join(labelValues, "\xff")
+
+	v.mu.RLock()
+	s, ok := v.series[key]
+	v.mu.RUnlock()
+	if ok {
+		return s.metric
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if s, ok := v.series[key]; ok {
+		return s.metric
+	}
+	s = &labeled[T]{values: slices.Clone(labelValues), metric: v.newT()}
+	v.series[key] = s
+	return s.metric
+}
+
+// sorted returns the series ordered by label values, for stable output.
+func (v *vec[T]) sorted() []*labeled[T] {
+	v.mu.RLock()
+	out := make([]*labeled[T], 0, len(v.series))
+	for _, s := range v.series {
+		out = append(out, s)
+	}
+	v.mu.RUnlock()
+	slices.SortFunc(out, func(a, b *labeled[T]) int { return slices.Compare(a.values, b.values) })
+	return out
+}
+
+// ---------------------------------------------------------
+// Counters & Gauges
+// ---------------------------------------------------------
+
+// Counter is a monotonically increasing value.
+type Counter struct{ bits atomic.Uint64 }
+
+// Inc adds one.
+func (c *Counter) Inc() { c.Add(1) }
+
+// Add adds v, which must not be negative.
+func (c *Counter) Add(v float64) { addFloat(&c.bits, v) }
+
+// Value returns the current value.
+func (c *Counter) Value() float64 { return math.Float64frombits(c.bits.Load()) }
+
+// Gauge is a value that can go up and down.
+type Gauge struct{ bits atomic.Uint64 }
+
+// Set replaces the value.
+func (g *Gauge) Set(v float64) { g.bits.Store(math.Float64bits(v)) }
+
+// Add adds v, which may be negative.
+func (g *Gauge) Add(v float64) { addFloat(&g.bits, v) }
+
+// Value returns the current value.
+func (g *Gauge) Value() float64 { return math.Float64frombits(g.bits.Load()) }
+
+func addFloat(bits *atomic.Uint64, v float64) {
+	for {
+		old := bits.Load()
+		if bits.CompareAndSwap(old, math.Float64bits(math.Float64frombits(old)+v)) {
+			return
+		}
+	}
+}
+
+// CounterVec is a set of counters partitioned by labels.
+type CounterVec struct{ v *vec[Counter] }
+
+// NewCounterVec registers a counter family. By convention the name ends in "_total".
+func (r *Registry) NewCounterVec(name, help string, labelNames ...string) *CounterVec {
+	cv := &CounterVec{v: newVec(desc{name, help, "counter", labelNames}, func() *Counter { return &Counter{} })}
+	r.register(cv)
+	return cv
+}
+
+// With returns the counter for the given label values, creating it on first use.
+func (cv *CounterVec) With(labelValues ...string) *Counter { return cv.v.with(labelValues) }
+
+func (cv *CounterVec) metadata() *desc { return &cv.v.desc }
+
+func (cv *CounterVec) collect(emit func(string, []string, []string, float64)) {
+	for _, s := range cv.v.sorted() {
+		emit("", s.values, nil, s.metric.Value())
+	}
+}
+
+// GaugeVec is a set of gauges partitioned by labels.
+type GaugeVec struct{ v *vec[Gauge] }
+
+// NewGaugeVec registers a gauge family.
+func (r *Registry) NewGaugeVec(name, help string, labelNames ...string) *GaugeVec {
+	gv := &GaugeVec{v: newVec(desc{name, help, "gauge", labelNames}, func() *Gauge { return &Gauge{} })}
+	r.register(gv)
+	return gv
+}
+
+// With returns the gauge for the given label values, creating it on first use.
+func (gv *GaugeVec) With(labelValues ...string) *Gauge { return gv.v.with(labelValues) }
+
+func (gv *GaugeVec) metadata() *desc { return &gv.v.desc }
+
+func (gv *GaugeVec) collect(emit func(string, []string, []string, float64)) {
+	for _, s := range gv.v.sorted() {
+		emit("", s.values, nil, s.metric.Value())
+	}
+}
+
+// ---------------------------------------------------------
+// Histograms
+// ---------------------------------------------------------
+
+// Histogram counts observations into cumulative buckets.
+type Histogram struct {
+	mu      sync.Mutex
+	buckets []float64 // Upper bounds, ascending; +Inf is implicit
+	counts  []uint64
+	sum     float64
+	count   uint64
+}
+
+// Observe records one value.
+func (h *Histogram) Observe(v float64) {
+	i, _ := slices.BinarySearch(h.buckets, v)
+	h.mu.Lock()
+	if i < len(h.counts) {
+		h.counts[i]++
+	}
+	h.sum += v
+	h.count++
+	h.mu.Unlock()
+}
+
+// HistogramVec is a set of histograms partitioned by labels.
+type HistogramVec struct {
+	v       *vec[Histogram]
+	buckets []float64
+}
+
+// NewHistogramVec registers a histogram family with the given bucket upper bounds.
+func (r *Registry) NewHistogramVec(name, help string, buckets []float64, labelNames ...string) *HistogramVec {
+	buckets = slices.Clone(buckets)
+	slices.Sort(buckets)
+	hv := &HistogramVec{buckets: buckets}
+	hv.v = newVec(desc{name, help, "histogram", labelNames}, func() *Histogram {
+		return &Histogram{buckets: buckets, counts: make([]uint64, len(buckets))}
+	})
+	r.register(hv)
+	return hv
+}
+
+// With returns the histogram for the given label values, creating it on first use.
+func (hv *HistogramVec) With(labelValues ...string) *Histogram { return hv.v.with(labelValues) }
+
+func (hv *HistogramVec) metadata() *desc { return &hv.v.desc }
+
+func (hv *HistogramVec) collect(emit func(string, []string, []string, float64)) {
+	for _, s := range hv.v.sorted() {
+		h := s.metric
+		h.mu.Lock()
+		counts := slices.Clone(h.counts)
+		sum, count := h.sum, h.count
+		h.mu.Unlock()
+
+		var cumulative uint64
+		for i, upper := range hv.buckets {
+			cumulative += counts[i]
+			emit("_bucket", s.values, []string{"le", formatFloat(upper)}, float64(cumulative))
+		}
+		emit("_bucket", s.values, []string{"le", "+Inf"}, float64(count))
+		emit("_sum", s.values, nil, sum)
+		emit("_count", s.values, nil, float64(count))
+	}
+}
+
+// ---------------------------------------------------------
+// Scrape-Time Collectors
+// ---------------------------------------------------------
+
+// funcFamily reports values computed by a callback on every scrape.
+type funcFamily struct {
+	desc
+	fn func(emit func(value float64, labelValues ...string))
+}
+
+// NewGaugeFunc registers a gauge family whose samples are produced by fn at scrape
+// time. fn calls emit once per series.
+func (r *Registry) NewGaugeFunc(name, help string, labelNames []string, fn func(emit func(value float64, labelValues ...string))) {
+	r.register(&funcFamily{desc{name, help, "gauge", labelNames}, fn})
+}
+
+// NewCounterFunc is NewGaugeFunc for values that only increase.
+func (r *Registry) NewCounterFunc(name, help string, labelNames []string, fn func(emit func(value float64, labelValues ...string))) {
+	r.register(&funcFamily{desc{name, help, "counter", labelNames}, fn})
+}
+
+func (f *funcFamily) metadata() *desc { return &f.desc }
+
+func (f *funcFamily) collect(emit func(string, []string, []string, float64)) {
+	type sample struct {
+		values []string
+		value  float64
+	}
+	var samples []sample
+	f.fn(func(value float64, labelValues ...string) {
+		if len(labelValues) != len(f.labelNames) {
+			panic(fmt.Sprintf("metrics: %s expects %d label values, got %d", f.name, len(f.labelNames), len(labelValues)))
+		}
+		samples = append(samples, sample{slices.Clone(labelValues), value})
+	})
+	slices.SortFunc(samples, func(a, b sample) int { return slices.Compare(a.values, b.values) })
+	for _, s := range samples {
+		emit("", s.values, nil, s.value)
+	}
+}
+
+// ---------------------------------------------------------
+// Exposition
+// ---------------------------------------------------------
+
+// Write writes every family in the Prometheus text format, or in the OpenMetrics
+// text format if openMetrics is set.
+func (r *Registry) Write(w *bufio.Writer, openMetrics bool) error {
+	r.mu.Lock()
+	families := slices.Clone(r.families)
+	r.mu.Unlock()
+
+	for _, f := range families {
+		d := f.metadata()
+		metaName := d.name
+		if openMetrics && d.kind == "counter" {
+			// OpenMetrics names the counter family without the _total sample suffix
+			metaName = strings.TrimSuffix(d.name, "_total")
+		}
+		fmt.Fprintf(w, "# HELP %s %s\n", metaName, escapeHelp(d.help))
+		fmt.Fprintf(w, "# TYPE %s %s\n", metaName, d.kind)
+
+		f.collect(func(suffix string, labelValues, extra []string, value float64) {
+			w.WriteString(d.name)
+			w.WriteString(suffix)
+			if len(labelValues) > 0 || len(extra) > 0 {
+				w.WriteByte('{')
+				for i, name := range d.labelNames {
+					if i > 0 {
+						w.WriteByte(',')
+					}
+					writeLabel(w, name, labelValues[i])
+				}
+				for i := 0; i < len(extra); i += 2 {
+					if i > 0 || len(labelValues) > 0 {
+						w.WriteByte(',')
+					}
+					writeLabel(w, extra[i], extra[i+1])
+				}
+				w.WriteByte('}')
+			}
+			w.WriteByte(' ')
+			w.WriteString(formatFloat(value))
+			w.WriteByte('\n')
+		})
+	}
+	if openMetrics {
+		w.WriteString("# EOF\n")
+	}
+	return w.Flush()
+}
+
+// Handler serves the registry, negotiating the format from the Accept header.
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		openMetrics := strings.Contains(req.Header.Get("Accept"), "application/openmetrics-text")
+		if openMetrics {
+			w.Header().Set("Content-Type", ContentTypeOpenMetrics)
+		} else {
+			w.Header().Set("Content-Type", ContentTypeText)
+		}
+		r.Write(bufio.NewWriter(w), openMetrics)
+	})
+}
+
+func writeLabel(w *bufio.Writer, name, value string) {
+	w.WriteString(name)
+	w.WriteString(`="`)
+	w.WriteString(labelEscaper.Replace(value))
+	w.WriteByte('"')
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeHelp(help string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(help)
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
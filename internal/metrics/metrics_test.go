@@ -0,0 +1,109 @@
+package metrics
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func render(t *testing.T, r *Registry, openMetrics bool) string {
+	var sb strings.Builder
+	require.NoError(t, r.Write(bufio.NewWriter(&sb), openMetrics))
+	return sb.String()
+}
+
+func TestRegistry_Write(t *testing.T) {
+	r := NewRegistry()
+	requests := r.NewCounterVec("app_requests_total", "Requests handled.", "method", "code")
+	inflight := r.NewGaugeVec("app_inflight", "Requests in flight.")
+	latency := r.NewHistogramVec("app_latency_seconds", "Request latency.", []float64{0.5, 0.1}, "method")
+	r.NewGaugeFunc("app_pool_open", "Open connections.", []string{"pool"}, func(emit func(float64, ...string)) {
+		emit(3, "rw")
+		emit(1, `quo"te`)
+	})
+
+	requests.With("Query", "ok").Add(2)
+	requests.With("Exec", "ok").Inc()
+	inflight.With().Set(4)
+	inflight.With().Add(-1)
+	latency.With("Query").Observe(0.05)
+	latency.With("Query").Observe(0.3)
+	latency.With("Query").Observe(7)
+
+	want := `# HELP app_requests_total Requests handled.
+# TYPE app_requests_total counter
+app_requests_total{method="Exec",code="ok"} 1
+app_requests_total{method="Query",code="ok"} 2
+# HELP app_inflight Requests in flight.
+# TYPE app_inflight gauge
+app_inflight 3
+# HELP app_latency_seconds Request latency.
+# TYPE app_latency_seconds histogram
+app_latency_seconds_bucket{method="Query",le="0.1"} 1
+app_latency_seconds_bucket{method="Query",le="0.5"} 2
+app_latency_seconds_bucket{method="Query",le="+Inf"} 3
+app_latency_seconds_sum{method="Query"} 7.35
+app_latency_seconds_count{method="Query"} 3
+# HELP app_pool_open Open connections.
+# TYPE app_pool_open gauge
+app_pool_open{pool="quo\"te"} 1
+app_pool_open{pool="rw"} 3
+`
+	assert.Equal(t, want, render(t, r, false))
+
+	om := render(t, r, true)
+	assert.Contains(t, om, "# TYPE app_requests counter\n")
+	assert.Contains(t, om, `app_requests_total{method="Query",code="ok"} 2`)
+	assert.True(t, strings.HasSuffix(om, "# EOF\n"))
+}
+
+func TestRegistry_Handler(t *testing.T) {
+	r := NewRegistry()
+	r.NewCounterVec("hits_total", "Hits.").With().Inc()
+
+	rec := httptest.NewRecorder()
+	r.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, ContentTypeText, rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Body.String(), "hits_total 1\n")
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	req.Header.Set("Accept", "application/openmetrics-text; version=1.0.0")
+	rec = httptest.NewRecorder()
+	r.Handler().ServeHTTP(rec, req)
+	assert.Equal(t, ContentTypeOpenMetrics, rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Body.String(), "# EOF\n")
+}
+
+func TestRegistry_Misuse(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounterVec("dup_total", "Dup.", "a")
+	assert.Panics(t, func() { r.NewGaugeVec("dup_total", "Dup.") })
+	assert.Panics(t, func() { c.With("x", "y") })
+}
+
+func TestCounter_Concurrent(t *testing.T) {
+	r := NewRegistry()
+	cv := r.NewCounterVec("c_total", "C.", "k")
+	hv := r.NewHistogramVec("h", "H.", LatencyBuckets, "k")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				cv.With("a").Inc()
+				hv.With("a").Observe(0.002)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 8000.0, cv.With("a").Value())
+	assert.Contains(t, render(t, r, false), `h_count{k="a"} 8000`)
+}
@@ -92,7 +92,17 @@ type QueryRequest struct {
 	// SQL statement to execute.
 	Sql string `protobuf:"bytes,2,opt,name=sql,proto3" json:"sql,omitempty"`
 	// Parameters to bind to the statement.
-	Parameters    *Parameters `protobuf:"bytes,4,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	Parameters *Parameters `protobuf:"bytes,4,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	// Execution time limit for this call in milliseconds. Capped by the limits
+	// of the database and the caller's role; 0 uses the cap.
+	MaxExecutionTimeMs int32 `protobuf:"varint,5,opt,name=max_execution_time_ms,json=maxExecutionTimeMs,proto3" json:"max_execution_time_ms,omitempty"`
+	// QueryStream only: read the result through a cursor, so that every row
+	// batch carries a resume_token. The query must be read-only.
+	Resumable bool `protobuf:"varint,6,opt,name=resumable,proto3" json:"resumable,omitempty"`
+	// QueryStream only: continue a resumable stream that broke off, after the
+	// batch that carried this token. The database, sql and parameters must be
+	// those of the original request. The stream starts over with the header.
+	ResumeToken   string `protobuf:"bytes,7,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -148,6 +158,27 @@ func (x *QueryRequest) GetParameters() *Parameters {
 	return nil
 }
 
+func (x *QueryRequest) GetMaxExecutionTimeMs() int32 {
+	if x != nil {
+		return x.MaxExecutionTimeMs
+	}
+	return 0
+}
+
+func (x *QueryRequest) GetResumable() bool {
+	if x != nil {
+		return x.Resumable
+	}
+	return false
+}
+
+func (x *QueryRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
 // *
 // QueryResult contains the results and telemetry for a standard query.
 type QueryResult struct {
@@ -855,33 +886,36 @@ func (x *ExecuteTransactionResponse) GetResponses() []*TransactionResponse {
 }
 
 // *
-// TypedQueryRequest executes a query using strictly-typed parameters.
-type TypedQueryRequest struct {
+// BatchExecRequest executes one statement once per parameter set.
+type BatchExecRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Tenant target identifier for connection routing.
 	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	// SQL statement to execute.
+	// The single SQL statement to execute.
 	Sql string `protobuf:"bytes,2,opt,name=sql,proto3" json:"sql,omitempty"`
-	// Strictly-typed parameters.
-	Parameters    *TypedParameters `protobuf:"bytes,4,opt,name=parameters,proto3" json:"parameters,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Parameters of each execution, in order.
+	ParameterSets []*Parameters `protobuf:"bytes,3,rep,name=parameter_sets,json=parameterSets,proto3" json:"parameter_sets,omitempty"`
+	// Execution time limit for the whole batch in milliseconds. Capped by the
+	// limits of the database and the caller's role; 0 uses the cap.
+	MaxExecutionTimeMs int32 `protobuf:"varint,4,opt,name=max_execution_time_ms,json=maxExecutionTimeMs,proto3" json:"max_execution_time_ms,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *TypedQueryRequest) Reset() {
-	*x = TypedQueryRequest{}
+func (x *BatchExecRequest) Reset() {
+	*x = BatchExecRequest{}
 	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TypedQueryRequest) String() string {
+func (x *BatchExecRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TypedQueryRequest) ProtoMessage() {}
+func (*BatchExecRequest) ProtoMessage() {}
 
-func (x *TypedQueryRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchExecRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -893,61 +927,65 @@ func (x *TypedQueryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TypedQueryRequest.ProtoReflect.Descriptor instead.
-func (*TypedQueryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchExecRequest.ProtoReflect.Descriptor instead.
+func (*BatchExecRequest) Descriptor() ([]byte, []int) {
 	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *TypedQueryRequest) GetDatabase() string {
+func (x *BatchExecRequest) GetDatabase() string {
 	if x != nil {
 		return x.Database
 	}
 	return ""
 }
 
-func (x *TypedQueryRequest) GetSql() string {
+func (x *BatchExecRequest) GetSql() string {
 	if x != nil {
 		return x.Sql
 	}
 	return ""
 }
 
-func (x *TypedQueryRequest) GetParameters() *TypedParameters {
+func (x *BatchExecRequest) GetParameterSets() []*Parameters {
 	if x != nil {
-		return x.Parameters
+		return x.ParameterSets
 	}
 	return nil
 }
 
+func (x *BatchExecRequest) GetMaxExecutionTimeMs() int32 {
+	if x != nil {
+		return x.MaxExecutionTimeMs
+	}
+	return 0
+}
+
 // *
-// TypedTransactionQueryRequest executes a typed query within an active
-// transaction.
-type TypedTransactionQueryRequest struct {
+// BatchExecResponse holds the outcome of every execution of a committed batch.
+type BatchExecResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Absolute identifier for the active transaction.
-	TransactionId string `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	// SQL statement to execute.
-	Sql string `protobuf:"bytes,2,opt,name=sql,proto3" json:"sql,omitempty"`
-	// Strictly-typed parameters.
-	Parameters    *TypedParameters `protobuf:"bytes,3,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	// One result per parameter set, in request order.
+	Results []*DMLResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Telemetry for the whole batch.
+	Stats         *ExecutionStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TypedTransactionQueryRequest) Reset() {
-	*x = TypedTransactionQueryRequest{}
+func (x *BatchExecResponse) Reset() {
+	*x = BatchExecResponse{}
 	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TypedTransactionQueryRequest) String() string {
+func (x *BatchExecResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TypedTransactionQueryRequest) ProtoMessage() {}
+func (*BatchExecResponse) ProtoMessage() {}
 
-func (x *TypedTransactionQueryRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchExecResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -959,65 +997,55 @@ func (x *TypedTransactionQueryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TypedTransactionQueryRequest.ProtoReflect.Descriptor instead.
-func (*TypedTransactionQueryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchExecResponse.ProtoReflect.Descriptor instead.
+func (*BatchExecResponse) Descriptor() ([]byte, []int) {
 	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *TypedTransactionQueryRequest) GetTransactionId() string {
-	if x != nil {
-		return x.TransactionId
-	}
-	return ""
-}
-
-func (x *TypedTransactionQueryRequest) GetSql() string {
+func (x *BatchExecResponse) GetResults() []*DMLResult {
 	if x != nil {
-		return x.Sql
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-func (x *TypedTransactionQueryRequest) GetParameters() *TypedParameters {
+func (x *BatchExecResponse) GetStats() *ExecutionStats {
 	if x != nil {
-		return x.Parameters
+		return x.Stats
 	}
 	return nil
 }
 
 // *
-// TypedQueryResult contains strictly-typed results and telemetry.
-type TypedQueryResult struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	Columns []string               `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
-	// Affinities determined by the SQLite engine for each column.
-	ColumnAffinities []ColumnAffinity `protobuf:"varint,2,rep,packed,name=column_affinities,json=columnAffinities,proto3,enum=sqlrpc.v1.ColumnAffinity" json:"column_affinities,omitempty"`
-	// Declared types from the table schema for each column.
-	ColumnDeclaredTypes []DeclaredType `protobuf:"varint,3,rep,packed,name=column_declared_types,json=columnDeclaredTypes,proto3,enum=sqlrpc.v1.DeclaredType" json:"column_declared_types,omitempty"`
-	// Raw type strings returned by the underlying driver.
-	ColumnRawTypes []string `protobuf:"bytes,4,rep,name=column_raw_types,json=columnRawTypes,proto3" json:"column_raw_types,omitempty"`
-	// Ordered list of typed rows.
-	Rows []*SqlRow `protobuf:"bytes,5,rep,name=rows,proto3" json:"rows,omitempty"`
-	// Performance and execution telemetry.
-	Stats         *ExecutionStats `protobuf:"bytes,6,opt,name=stats,proto3" json:"stats,omitempty"`
+// OpenCursorRequest opens a cursor over the result of a read-only query.
+type OpenCursorRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tenant target identifier for connection routing.
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	// Read-only SQL statement to run.
+	Sql string `protobuf:"bytes,2,opt,name=sql,proto3" json:"sql,omitempty"`
+	// Parameters to bind to the statement.
+	Parameters *Parameters `protobuf:"bytes,3,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	// Optional time the cursor stays open without being fetched from.
+	Timeout       *durationpb.Duration `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TypedQueryResult) Reset() {
-	*x = TypedQueryResult{}
+func (x *OpenCursorRequest) Reset() {
+	*x = OpenCursorRequest{}
 	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TypedQueryResult) String() string {
+func (x *OpenCursorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TypedQueryResult) ProtoMessage() {}
+func (*OpenCursorRequest) ProtoMessage() {}
 
-func (x *TypedQueryResult) ProtoReflect() protoreflect.Message {
+func (x *OpenCursorRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1029,82 +1057,67 @@ func (x *TypedQueryResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TypedQueryResult.ProtoReflect.Descriptor instead.
-func (*TypedQueryResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use OpenCursorRequest.ProtoReflect.Descriptor instead.
+func (*OpenCursorRequest) Descriptor() ([]byte, []int) {
 	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *TypedQueryResult) GetColumns() []string {
-	if x != nil {
-		return x.Columns
-	}
-	return nil
-}
-
-func (x *TypedQueryResult) GetColumnAffinities() []ColumnAffinity {
-	if x != nil {
-		return x.ColumnAffinities
-	}
-	return nil
-}
-
-func (x *TypedQueryResult) GetColumnDeclaredTypes() []DeclaredType {
+func (x *OpenCursorRequest) GetDatabase() string {
 	if x != nil {
-		return x.ColumnDeclaredTypes
+		return x.Database
 	}
-	return nil
+	return ""
 }
 
-func (x *TypedQueryResult) GetColumnRawTypes() []string {
+func (x *OpenCursorRequest) GetSql() string {
 	if x != nil {
-		return x.ColumnRawTypes
+		return x.Sql
 	}
-	return nil
+	return ""
 }
 
-func (x *TypedQueryResult) GetRows() []*SqlRow {
+func (x *OpenCursorRequest) GetParameters() *Parameters {
 	if x != nil {
-		return x.Rows
+		return x.Parameters
 	}
 	return nil
 }
 
-func (x *TypedQueryResult) GetStats() *ExecutionStats {
+func (x *OpenCursorRequest) GetTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.Stats
+		return x.Timeout
 	}
 	return nil
 }
 
 // *
-// QueryResponse defines a chunked result from a streaming query.
-type QueryResponse struct {
+// OpenCursorResponse identifies an open cursor and describes its columns.
+type OpenCursorResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Response:
-	//
-	//	*QueryResponse_Header
-	//	*QueryResponse_Batch
-	//	*QueryResponse_Complete
-	//	*QueryResponse_Error
-	Response      isQueryResponse_Response `protobuf_oneof:"response"`
+	// Unique cursor identifier (UUID-v7 based).
+	CursorId string `protobuf:"bytes,1,opt,name=cursor_id,json=cursorId,proto3" json:"cursor_id,omitempty"`
+	// Column metadata of the result.
+	Header *QueryResultHeader `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+	// Timestamp when the cursor is closed if it is not fetched from.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryResponse) Reset() {
-	*x = QueryResponse{}
+func (x *OpenCursorResponse) Reset() {
+	*x = OpenCursorResponse{}
 	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryResponse) String() string {
+func (x *OpenCursorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryResponse) ProtoMessage() {}
+func (*OpenCursorResponse) ProtoMessage() {}
 
-func (x *QueryResponse) ProtoReflect() protoreflect.Message {
+func (x *OpenCursorResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1116,112 +1129,119 @@ func (x *QueryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryResponse.ProtoReflect.Descriptor instead.
-func (*QueryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OpenCursorResponse.ProtoReflect.Descriptor instead.
+func (*OpenCursorResponse) Descriptor() ([]byte, []int) {
 	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *QueryResponse) GetResponse() isQueryResponse_Response {
+func (x *OpenCursorResponse) GetCursorId() string {
 	if x != nil {
-		return x.Response
+		return x.CursorId
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryResponse) GetHeader() *QueryResultHeader {
+func (x *OpenCursorResponse) GetHeader() *QueryResultHeader {
 	if x != nil {
-		if x, ok := x.Response.(*QueryResponse_Header); ok {
-			return x.Header
-		}
+		return x.Header
 	}
 	return nil
 }
 
-func (x *QueryResponse) GetBatch() *QueryResultRowBatch {
+func (x *OpenCursorResponse) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		if x, ok := x.Response.(*QueryResponse_Batch); ok {
-			return x.Batch
-		}
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-func (x *QueryResponse) GetComplete() *QueryComplete {
-	if x != nil {
-		if x, ok := x.Response.(*QueryResponse_Complete); ok {
-			return x.Complete
-		}
-	}
-	return nil
+// *
+// FetchCursorRequest reads the next rows of a cursor.
+type FetchCursorRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifier of the open cursor.
+	CursorId string `protobuf:"bytes,1,opt,name=cursor_id,json=cursorId,proto3" json:"cursor_id,omitempty"`
+	// Maximum number of rows to return; 0 returns up to 500.
+	MaxRows       uint32 `protobuf:"varint,2,opt,name=max_rows,json=maxRows,proto3" json:"max_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryResponse) GetError() *ErrorResponse {
-	if x != nil {
-		if x, ok := x.Response.(*QueryResponse_Error); ok {
-			return x.Error
-		}
-	}
-	return nil
+func (x *FetchCursorRequest) Reset() {
+	*x = FetchCursorRequest{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type isQueryResponse_Response interface {
-	isQueryResponse_Response()
+func (x *FetchCursorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type QueryResponse_Header struct {
-	Header *QueryResultHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
-}
+func (*FetchCursorRequest) ProtoMessage() {}
 
-type QueryResponse_Batch struct {
-	Batch *QueryResultRowBatch `protobuf:"bytes,2,opt,name=batch,proto3,oneof"`
+func (x *FetchCursorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type QueryResponse_Complete struct {
-	Complete *QueryComplete `protobuf:"bytes,3,opt,name=complete,proto3,oneof"`
+// Deprecated: Use FetchCursorRequest.ProtoReflect.Descriptor instead.
+func (*FetchCursorRequest) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{17}
 }
 
-type QueryResponse_Error struct {
-	Error *ErrorResponse `protobuf:"bytes,5,opt,name=error,proto3,oneof"`
+func (x *FetchCursorRequest) GetCursorId() string {
+	if x != nil {
+		return x.CursorId
+	}
+	return ""
 }
 
-func (*QueryResponse_Header) isQueryResponse_Response() {}
-
-func (*QueryResponse_Batch) isQueryResponse_Response() {}
-
-func (*QueryResponse_Complete) isQueryResponse_Response() {}
-
-func (*QueryResponse_Error) isQueryResponse_Response() {}
+func (x *FetchCursorRequest) GetMaxRows() uint32 {
+	if x != nil {
+		return x.MaxRows
+	}
+	return 0
+}
 
 // *
-// TypedQueryResponse defines a chunked result from a typed streaming query.
-type TypedQueryResponse struct {
+// FetchCursorResponse holds the next rows of a cursor.
+type FetchCursorResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Response:
-	//
-	//	*TypedQueryResponse_Header
-	//	*TypedQueryResponse_Batch
-	//	*TypedQueryResponse_Complete
-	//	*TypedQueryResponse_Error
-	Response      isTypedQueryResponse_Response `protobuf_oneof:"response"`
+	// Collection of rows, where each row is a ListValue.
+	Rows []*structpb.ListValue `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	// Set once the result is exhausted; the cursor is then closed.
+	Done bool `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	// Number of rows fetched from the cursor so far, these included.
+	Position int64 `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	// Timestamp when the cursor is closed if it is not fetched from again.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TypedQueryResponse) Reset() {
-	*x = TypedQueryResponse{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[17]
+func (x *FetchCursorResponse) Reset() {
+	*x = FetchCursorResponse{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TypedQueryResponse) String() string {
+func (x *FetchCursorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TypedQueryResponse) ProtoMessage() {}
+func (*FetchCursorResponse) ProtoMessage() {}
 
-func (x *TypedQueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[17]
+func (x *FetchCursorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1232,111 +1252,64 @@ func (x *TypedQueryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TypedQueryResponse.ProtoReflect.Descriptor instead.
-func (*TypedQueryResponse) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use FetchCursorResponse.ProtoReflect.Descriptor instead.
+func (*FetchCursorResponse) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *TypedQueryResponse) GetResponse() isTypedQueryResponse_Response {
+func (x *FetchCursorResponse) GetRows() []*structpb.ListValue {
 	if x != nil {
-		return x.Response
+		return x.Rows
 	}
 	return nil
 }
 
-func (x *TypedQueryResponse) GetHeader() *TypedQueryResultHeader {
+func (x *FetchCursorResponse) GetDone() bool {
 	if x != nil {
-		if x, ok := x.Response.(*TypedQueryResponse_Header); ok {
-			return x.Header
-		}
+		return x.Done
 	}
-	return nil
+	return false
 }
 
-func (x *TypedQueryResponse) GetBatch() *TypedQueryResultRowBatch {
+func (x *FetchCursorResponse) GetPosition() int64 {
 	if x != nil {
-		if x, ok := x.Response.(*TypedQueryResponse_Batch); ok {
-			return x.Batch
-		}
+		return x.Position
 	}
-	return nil
+	return 0
 }
 
-func (x *TypedQueryResponse) GetComplete() *QueryComplete {
+func (x *FetchCursorResponse) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		if x, ok := x.Response.(*TypedQueryResponse_Complete); ok {
-			return x.Complete
-		}
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-func (x *TypedQueryResponse) GetError() *ErrorResponse {
-	if x != nil {
-		if x, ok := x.Response.(*TypedQueryResponse_Error); ok {
-			return x.Error
-		}
-	}
-	return nil
-}
-
-type isTypedQueryResponse_Response interface {
-	isTypedQueryResponse_Response()
-}
-
-type TypedQueryResponse_Header struct {
-	// Initial response containing column metadata.
-	Header *TypedQueryResultHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
-}
-
-type TypedQueryResponse_Batch struct {
-	// Batch of typed result rows.
-	Batch *TypedQueryResultRowBatch `protobuf:"bytes,2,opt,name=batch,proto3,oneof"`
-}
-
-type TypedQueryResponse_Complete struct {
-	// Final response indicating stream completion and stats.
-	Complete *QueryComplete `protobuf:"bytes,3,opt,name=complete,proto3,oneof"`
-}
-
-type TypedQueryResponse_Error struct {
-	// Error information if the query failed during streaming.
-	Error *ErrorResponse `protobuf:"bytes,5,opt,name=error,proto3,oneof"`
-}
-
-func (*TypedQueryResponse_Header) isTypedQueryResponse_Response() {}
-
-func (*TypedQueryResponse_Batch) isTypedQueryResponse_Response() {}
-
-func (*TypedQueryResponse_Complete) isTypedQueryResponse_Response() {}
-
-func (*TypedQueryResponse_Error) isTypedQueryResponse_Response() {}
-
-type QueryResultHeader struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Columns             []string               `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
-	ColumnAffinities    []ColumnAffinity       `protobuf:"varint,2,rep,packed,name=column_affinities,json=columnAffinities,proto3,enum=sqlrpc.v1.ColumnAffinity" json:"column_affinities,omitempty"`
-	ColumnDeclaredTypes []DeclaredType         `protobuf:"varint,3,rep,packed,name=column_declared_types,json=columnDeclaredTypes,proto3,enum=sqlrpc.v1.DeclaredType" json:"column_declared_types,omitempty"`
-	ColumnRawTypes      []string               `protobuf:"bytes,4,rep,name=column_raw_types,json=columnRawTypes,proto3" json:"column_raw_types,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+// *
+// CloseCursorRequest closes a cursor.
+type CloseCursorRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifier of the open cursor.
+	CursorId      string `protobuf:"bytes,1,opt,name=cursor_id,json=cursorId,proto3" json:"cursor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryResultHeader) Reset() {
-	*x = QueryResultHeader{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[18]
+func (x *CloseCursorRequest) Reset() {
+	*x = CloseCursorRequest{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryResultHeader) String() string {
+func (x *CloseCursorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryResultHeader) ProtoMessage() {}
+func (*CloseCursorRequest) ProtoMessage() {}
 
-func (x *QueryResultHeader) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[18]
+func (x *CloseCursorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1347,64 +1320,41 @@ func (x *QueryResultHeader) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryResultHeader.ProtoReflect.Descriptor instead.
-func (*QueryResultHeader) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *QueryResultHeader) GetColumns() []string {
-	if x != nil {
-		return x.Columns
-	}
-	return nil
-}
-
-func (x *QueryResultHeader) GetColumnAffinities() []ColumnAffinity {
-	if x != nil {
-		return x.ColumnAffinities
-	}
-	return nil
-}
-
-func (x *QueryResultHeader) GetColumnDeclaredTypes() []DeclaredType {
-	if x != nil {
-		return x.ColumnDeclaredTypes
-	}
-	return nil
+// Deprecated: Use CloseCursorRequest.ProtoReflect.Descriptor instead.
+func (*CloseCursorRequest) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *QueryResultHeader) GetColumnRawTypes() []string {
+func (x *CloseCursorRequest) GetCursorId() string {
 	if x != nil {
-		return x.ColumnRawTypes
+		return x.CursorId
 	}
-	return nil
+	return ""
 }
 
 // *
-// QueryResultRowBatch contains a collection of rows for an untyped query.
-type QueryResultRowBatch struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Collection of rows, where each row is a ListValue.
-	Rows          []*structpb.ListValue `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+// CloseCursorResponse confirms that a cursor was closed.
+type CloseCursorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryResultRowBatch) Reset() {
-	*x = QueryResultRowBatch{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[19]
+func (x *CloseCursorResponse) Reset() {
+	*x = CloseCursorResponse{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryResultRowBatch) String() string {
+func (x *CloseCursorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryResultRowBatch) ProtoMessage() {}
+func (*CloseCursorResponse) ProtoMessage() {}
 
-func (x *QueryResultRowBatch) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[19]
+func (x *CloseCursorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1415,49 +1365,43 @@ func (x *QueryResultRowBatch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryResultRowBatch.ProtoReflect.Descriptor instead.
-func (*QueryResultRowBatch) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *QueryResultRowBatch) GetRows() []*structpb.ListValue {
-	if x != nil {
-		return x.Rows
-	}
-	return nil
+// Deprecated: Use CloseCursorResponse.ProtoReflect.Descriptor instead.
+func (*CloseCursorResponse) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{20}
 }
 
 // *
-// TypedQueryResultHeader provides metadata for a typed streaming query.
-type TypedQueryResultHeader struct {
+// TypedQueryRequest executes a query using strictly-typed parameters.
+type TypedQueryRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Ordered list of column names.
-	Columns []string `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
-	// Affinities determined by the SQLite engine for each column.
-	ColumnAffinities []ColumnAffinity `protobuf:"varint,2,rep,packed,name=column_affinities,json=columnAffinities,proto3,enum=sqlrpc.v1.ColumnAffinity" json:"column_affinities,omitempty"`
-	// Declared types from the table schema for each column.
-	ColumnDeclaredTypes []DeclaredType `protobuf:"varint,3,rep,packed,name=column_declared_types,json=columnDeclaredTypes,proto3,enum=sqlrpc.v1.DeclaredType" json:"column_declared_types,omitempty"`
-	// Raw type strings returned by the underlying driver.
-	ColumnRawTypes []string `protobuf:"bytes,4,rep,name=column_raw_types,json=columnRawTypes,proto3" json:"column_raw_types,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Tenant target identifier for connection routing.
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	// SQL statement to execute.
+	Sql string `protobuf:"bytes,2,opt,name=sql,proto3" json:"sql,omitempty"`
+	// Strictly-typed parameters.
+	Parameters *TypedParameters `protobuf:"bytes,4,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	// Execution time limit for this call in milliseconds. Capped by the limits
+	// of the database and the caller's role; 0 uses the cap.
+	MaxExecutionTimeMs int32 `protobuf:"varint,5,opt,name=max_execution_time_ms,json=maxExecutionTimeMs,proto3" json:"max_execution_time_ms,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *TypedQueryResultHeader) Reset() {
-	*x = TypedQueryResultHeader{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[20]
+func (x *TypedQueryRequest) Reset() {
+	*x = TypedQueryRequest{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TypedQueryResultHeader) String() string {
+func (x *TypedQueryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TypedQueryResultHeader) ProtoMessage() {}
+func (*TypedQueryRequest) ProtoMessage() {}
 
-func (x *TypedQueryResultHeader) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[20]
+func (x *TypedQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1468,64 +1412,68 @@ func (x *TypedQueryResultHeader) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TypedQueryResultHeader.ProtoReflect.Descriptor instead.
-func (*TypedQueryResultHeader) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use TypedQueryRequest.ProtoReflect.Descriptor instead.
+func (*TypedQueryRequest) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *TypedQueryResultHeader) GetColumns() []string {
+func (x *TypedQueryRequest) GetDatabase() string {
 	if x != nil {
-		return x.Columns
+		return x.Database
 	}
-	return nil
+	return ""
 }
 
-func (x *TypedQueryResultHeader) GetColumnAffinities() []ColumnAffinity {
+func (x *TypedQueryRequest) GetSql() string {
 	if x != nil {
-		return x.ColumnAffinities
+		return x.Sql
 	}
-	return nil
+	return ""
 }
 
-func (x *TypedQueryResultHeader) GetColumnDeclaredTypes() []DeclaredType {
+func (x *TypedQueryRequest) GetParameters() *TypedParameters {
 	if x != nil {
-		return x.ColumnDeclaredTypes
+		return x.Parameters
 	}
 	return nil
 }
 
-func (x *TypedQueryResultHeader) GetColumnRawTypes() []string {
+func (x *TypedQueryRequest) GetMaxExecutionTimeMs() int32 {
 	if x != nil {
-		return x.ColumnRawTypes
+		return x.MaxExecutionTimeMs
 	}
-	return nil
+	return 0
 }
 
 // *
-// TypedQueryResultRowBatch contains a collection of rows for a typed query.
-type TypedQueryResultRowBatch struct {
+// ImportRequest is one message of an Import stream.
+type ImportRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Collection of strictly-typed rows.
-	Rows          []*SqlRow `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportRequest_Start
+	//	*ImportRequest_Data
+	//	*ImportRequest_Rows
+	Payload       isImportRequest_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TypedQueryResultRowBatch) Reset() {
-	*x = TypedQueryResultRowBatch{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[21]
+func (x *ImportRequest) Reset() {
+	*x = ImportRequest{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TypedQueryResultRowBatch) String() string {
+func (x *ImportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TypedQueryResultRowBatch) ProtoMessage() {}
+func (*ImportRequest) ProtoMessage() {}
 
-func (x *TypedQueryResultRowBatch) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[21]
+func (x *ImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1536,43 +1484,119 @@ func (x *TypedQueryResultRowBatch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TypedQueryResultRowBatch.ProtoReflect.Descriptor instead.
-func (*TypedQueryResultRowBatch) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ImportRequest.ProtoReflect.Descriptor instead.
+func (*ImportRequest) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *TypedQueryResultRowBatch) GetRows() []*SqlRow {
+func (x *ImportRequest) GetPayload() isImportRequest_Payload {
 	if x != nil {
-		return x.Rows
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportRequest) GetStart() *ImportStart {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportRequest_Start); ok {
+			return x.Start
+		}
+	}
+	return nil
+}
+
+func (x *ImportRequest) GetData() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportRequest_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+func (x *ImportRequest) GetRows() *ImportRowBatch {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportRequest_Rows); ok {
+			return x.Rows
+		}
 	}
 	return nil
 }
 
+type isImportRequest_Payload interface {
+	isImportRequest_Payload()
+}
+
+type ImportRequest_Start struct {
+	// Target and options. Must be the first message and only sent once.
+	Start *ImportStart `protobuf:"bytes,1,opt,name=start,proto3,oneof"`
+}
+
+type ImportRequest_Data struct {
+	// Next chunk of CSV or NDJSON text. Chunks may split records anywhere.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+type ImportRequest_Rows struct {
+	// Next records of an IMPORT_FORMAT_ROWS import.
+	Rows *ImportRowBatch `protobuf:"bytes,3,opt,name=rows,proto3,oneof"`
+}
+
+func (*ImportRequest_Start) isImportRequest_Payload() {}
+
+func (*ImportRequest_Data) isImportRequest_Payload() {}
+
+func (*ImportRequest_Rows) isImportRequest_Payload() {}
+
 // *
-// QueryComplete signals the successful end of a streaming operation.
-type QueryComplete struct {
+// ImportStart describes where and how Import inserts the records.
+type ImportStart struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Final execution telemetry.
-	Stats         *ExecutionStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Tenant target identifier for connection routing.
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	// Table the records are inserted into.
+	Table string `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	// Encoding of the records.
+	Format ImportFormat `protobuf:"varint,3,opt,name=format,proto3,enum=sqlrpc.v1.ImportFormat" json:"format,omitempty"`
+	// Names of the source fields: the CSV columns or SqlRow values in order, or
+	// the NDJSON keys to read. Defaults to the CSV header, the table's columns,
+	// or the keys of the first NDJSON object when the table does not exist yet.
+	Fields []string `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	// Renames source fields to table columns. Unmapped fields are inserted into
+	// the column of the same name.
+	ColumnMapping map[string]string `protobuf:"bytes,5,rep,name=column_mapping,json=columnMapping,proto3" json:"column_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The first CSV record names the fields instead of holding data.
+	CsvHeader bool `protobuf:"varint,6,opt,name=csv_header,json=csvHeader,proto3" json:"csv_header,omitempty"`
+	// CSV field delimiter. Defaults to a comma.
+	CsvDelimiter string `protobuf:"bytes,7,opt,name=csv_delimiter,json=csvDelimiter,proto3" json:"csv_delimiter,omitempty"`
+	// Creates the table when it does not exist, with column types inferred
+	// from the first batch of records.
+	CreateTable bool `protobuf:"varint,8,opt,name=create_table,json=createTable,proto3" json:"create_table,omitempty"`
+	// Handling of records that violate a uniqueness constraint.
+	OnConflict ImportConflict `protobuf:"varint,9,opt,name=on_conflict,json=onConflict,proto3,enum=sqlrpc.v1.ImportConflict" json:"on_conflict,omitempty"`
+	// Records per committed transaction. Defaults to 1000.
+	BatchSize int32 `protobuf:"varint,10,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// Rejected records reported with their error. Defaults to 10.
+	MaxErrorSamples int32 `protobuf:"varint,11,opt,name=max_error_samples,json=maxErrorSamples,proto3" json:"max_error_samples,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *QueryComplete) Reset() {
-	*x = QueryComplete{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[22]
+func (x *ImportStart) Reset() {
+	*x = ImportStart{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryComplete) String() string {
+func (x *ImportStart) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryComplete) ProtoMessage() {}
+func (*ImportStart) ProtoMessage() {}
 
-func (x *QueryComplete) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[22]
+func (x *ImportStart) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1583,116 +1607,111 @@ func (x *QueryComplete) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryComplete.ProtoReflect.Descriptor instead.
-func (*QueryComplete) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ImportStart.ProtoReflect.Descriptor instead.
+func (*ImportStart) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *QueryComplete) GetStats() *ExecutionStats {
+func (x *ImportStart) GetDatabase() string {
 	if x != nil {
-		return x.Stats
+		return x.Database
 	}
-	return nil
+	return ""
 }
 
-type ErrorResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Human-readable error message.
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	// The SQL statement that failed execution.
-	FailedSql string `protobuf:"bytes,2,opt,name=failed_sql,json=failedSql,proto3" json:"failed_sql,omitempty"`
-	// Corresponding SQLite error code.
-	SqliteErrorCode SqliteCode `protobuf:"varint,3,opt,name=sqlite_error_code,json=sqliteErrorCode,proto3,enum=sqlrpc.v1.SqliteCode" json:"sqlite_error_code,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *ImportStart) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
 }
 
-func (x *ErrorResponse) Reset() {
-	*x = ErrorResponse{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[23]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ImportStart) GetFormat() ImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImportFormat_IMPORT_FORMAT_UNSPECIFIED
 }
 
-func (x *ErrorResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ImportStart) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
 }
 
-func (*ErrorResponse) ProtoMessage() {}
-
-func (x *ErrorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[23]
+func (x *ImportStart) GetColumnMapping() map[string]string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ColumnMapping
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ErrorResponse.ProtoReflect.Descriptor instead.
-func (*ErrorResponse) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{23}
+func (x *ImportStart) GetCsvHeader() bool {
+	if x != nil {
+		return x.CsvHeader
+	}
+	return false
 }
 
-func (x *ErrorResponse) GetMessage() string {
+func (x *ImportStart) GetCsvDelimiter() string {
 	if x != nil {
-		return x.Message
+		return x.CsvDelimiter
 	}
 	return ""
 }
 
-func (x *ErrorResponse) GetFailedSql() string {
+func (x *ImportStart) GetCreateTable() bool {
 	if x != nil {
-		return x.FailedSql
+		return x.CreateTable
 	}
-	return ""
+	return false
 }
 
-func (x *ErrorResponse) GetSqliteErrorCode() SqliteCode {
+func (x *ImportStart) GetOnConflict() ImportConflict {
 	if x != nil {
-		return x.SqliteErrorCode
+		return x.OnConflict
 	}
-	return SqliteCode_SQLITE_OK
+	return ImportConflict_IMPORT_CONFLICT_UNSPECIFIED
+}
+
+func (x *ImportStart) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *ImportStart) GetMaxErrorSamples() int32 {
+	if x != nil {
+		return x.MaxErrorSamples
+	}
+	return 0
 }
 
 // *
-// TransactionRequest defines a command in a multiplexed transaction stream.
-type TransactionRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Command:
-	//
-	//	*TransactionRequest_Begin
-	//	*TransactionRequest_Query
-	//	*TransactionRequest_QueryStream
-	//	*TransactionRequest_TypedQuery
-	//	*TransactionRequest_TypedQueryStream
-	//	*TransactionRequest_Exec
-	//	*TransactionRequest_TypedExec
-	//	*TransactionRequest_Savepoint
-	//	*TransactionRequest_Commit
-	//	*TransactionRequest_Rollback
-	Command       isTransactionRequest_Command `protobuf_oneof:"command"`
+// ImportRowBatch carries records of an IMPORT_FORMAT_ROWS import.
+type ImportRowBatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          []*SqlRow              `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransactionRequest) Reset() {
-	*x = TransactionRequest{}
+func (x *ImportRowBatch) Reset() {
+	*x = ImportRowBatch{}
 	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransactionRequest) String() string {
+func (x *ImportRowBatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionRequest) ProtoMessage() {}
+func (*ImportRowBatch) ProtoMessage() {}
 
-func (x *TransactionRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportRowBatch) ProtoReflect() protoreflect.Message {
 	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1704,218 +1723,368 @@ func (x *TransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionRequest.ProtoReflect.Descriptor instead.
-func (*TransactionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportRowBatch.ProtoReflect.Descriptor instead.
+func (*ImportRowBatch) Descriptor() ([]byte, []int) {
 	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *TransactionRequest) GetCommand() isTransactionRequest_Command {
+func (x *ImportRowBatch) GetRows() []*SqlRow {
 	if x != nil {
-		return x.Command
+		return x.Rows
 	}
 	return nil
 }
 
-func (x *TransactionRequest) GetBegin() *BeginRequest {
+// *
+// ImportRowError reports a rejected record.
+type ImportRowError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 1-based position of the record in the input, not counting a CSV header.
+	Record int64 `protobuf:"varint,1,opt,name=record,proto3" json:"record,omitempty"`
+	// Why the record was rejected.
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[25]
 	if x != nil {
-		if x, ok := x.Command.(*TransactionRequest_Begin); ok {
-			return x.Begin
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *TransactionRequest) GetQuery() *TransactionalQueryRequest {
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ImportRowError) GetRecord() int64 {
 	if x != nil {
-		if x, ok := x.Command.(*TransactionRequest_Query); ok {
-			return x.Query
-		}
+		return x.Record
 	}
-	return nil
+	return 0
 }
 
-func (x *TransactionRequest) GetQueryStream() *TransactionalQueryRequest {
+func (x *ImportRowError) GetMessage() string {
 	if x != nil {
-		if x, ok := x.Command.(*TransactionRequest_QueryStream); ok {
-			return x.QueryStream
-		}
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-func (x *TransactionRequest) GetTypedQuery() *TypedTransactionalQueryRequest {
+// *
+// ImportResponse summarizes a finished import.
+type ImportResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Records inserted (or replacing an existing row).
+	RowsAccepted int64 `protobuf:"varint,1,opt,name=rows_accepted,json=rowsAccepted,proto3" json:"rows_accepted,omitempty"`
+	// Records that could not be parsed or inserted.
+	RowsRejected int64 `protobuf:"varint,2,opt,name=rows_rejected,json=rowsRejected,proto3" json:"rows_rejected,omitempty"`
+	// Records skipped by IMPORT_CONFLICT_IGNORE.
+	RowsIgnored int64 `protobuf:"varint,3,opt,name=rows_ignored,json=rowsIgnored,proto3" json:"rows_ignored,omitempty"`
+	// The first rejected records, up to max_error_samples.
+	Errors []*ImportRowError `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	// Whether the table was created by this import.
+	TableCreated bool `protobuf:"varint,5,opt,name=table_created,json=tableCreated,proto3" json:"table_created,omitempty"`
+	// Table columns the fields were inserted into, in field order.
+	Columns []string `protobuf:"bytes,6,rep,name=columns,proto3" json:"columns,omitempty"`
+	// Inferred types of the columns of a created table, parallel to columns.
+	ColumnDeclaredTypes []DeclaredType `protobuf:"varint,7,rep,packed,name=column_declared_types,json=columnDeclaredTypes,proto3,enum=sqlrpc.v1.DeclaredType" json:"column_declared_types,omitempty"`
+	// Transactions committed.
+	BatchesCommitted int64 `protobuf:"varint,8,opt,name=batches_committed,json=batchesCommitted,proto3" json:"batches_committed,omitempty"`
+	// Telemetry for the whole import.
+	Stats         *ExecutionStats `protobuf:"bytes,9,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportResponse) Reset() {
+	*x = ImportResponse{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResponse) ProtoMessage() {}
+
+func (x *ImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[26]
 	if x != nil {
-		if x, ok := x.Command.(*TransactionRequest_TypedQuery); ok {
-			return x.TypedQuery
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *TransactionRequest) GetTypedQueryStream() *TypedTransactionalQueryRequest {
+// Deprecated: Use ImportResponse.ProtoReflect.Descriptor instead.
+func (*ImportResponse) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ImportResponse) GetRowsAccepted() int64 {
 	if x != nil {
-		if x, ok := x.Command.(*TransactionRequest_TypedQueryStream); ok {
-			return x.TypedQueryStream
-		}
+		return x.RowsAccepted
 	}
-	return nil
+	return 0
 }
 
-func (x *TransactionRequest) GetExec() *TransactionalQueryRequest {
+func (x *ImportResponse) GetRowsRejected() int64 {
 	if x != nil {
-		if x, ok := x.Command.(*TransactionRequest_Exec); ok {
-			return x.Exec
-		}
+		return x.RowsRejected
 	}
-	return nil
+	return 0
 }
 
-func (x *TransactionRequest) GetTypedExec() *TypedTransactionalQueryRequest {
+func (x *ImportResponse) GetRowsIgnored() int64 {
 	if x != nil {
-		if x, ok := x.Command.(*TransactionRequest_TypedExec); ok {
-			return x.TypedExec
-		}
+		return x.RowsIgnored
 	}
-	return nil
+	return 0
 }
 
-func (x *TransactionRequest) GetSavepoint() *SavepointRequest {
+func (x *ImportResponse) GetErrors() []*ImportRowError {
 	if x != nil {
-		if x, ok := x.Command.(*TransactionRequest_Savepoint); ok {
-			return x.Savepoint
-		}
+		return x.Errors
 	}
 	return nil
 }
 
-func (x *TransactionRequest) GetCommit() *emptypb.Empty {
+func (x *ImportResponse) GetTableCreated() bool {
 	if x != nil {
-		if x, ok := x.Command.(*TransactionRequest_Commit); ok {
-			return x.Commit
-		}
+		return x.TableCreated
 	}
-	return nil
+	return false
 }
 
-func (x *TransactionRequest) GetRollback() *emptypb.Empty {
+func (x *ImportResponse) GetColumns() []string {
 	if x != nil {
-		if x, ok := x.Command.(*TransactionRequest_Rollback); ok {
-			return x.Rollback
-		}
+		return x.Columns
 	}
 	return nil
 }
 
-type isTransactionRequest_Command interface {
-	isTransactionRequest_Command()
+func (x *ImportResponse) GetColumnDeclaredTypes() []DeclaredType {
+	if x != nil {
+		return x.ColumnDeclaredTypes
+	}
+	return nil
 }
 
-type TransactionRequest_Begin struct {
-	// Start the transaction (must be first).
-	Begin *BeginRequest `protobuf:"bytes,1,opt,name=begin,proto3,oneof"`
+func (x *ImportResponse) GetBatchesCommitted() int64 {
+	if x != nil {
+		return x.BatchesCommitted
+	}
+	return 0
 }
 
-type TransactionRequest_Query struct {
-	// Untyped query execution within the transaction.
-	Query *TransactionalQueryRequest `protobuf:"bytes,2,opt,name=query,proto3,oneof"`
+func (x *ImportResponse) GetStats() *ExecutionStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
 }
 
-type TransactionRequest_QueryStream struct {
-	// Untyped streaming query execution within the transaction.
-	QueryStream *TransactionalQueryRequest `protobuf:"bytes,3,opt,name=query_stream,json=queryStream,proto3,oneof"`
+// *
+// TypedBatchExecRequest executes one statement once per typed parameter set.
+type TypedBatchExecRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tenant target identifier for connection routing.
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	// The single SQL statement to execute.
+	Sql string `protobuf:"bytes,2,opt,name=sql,proto3" json:"sql,omitempty"`
+	// Strictly-typed parameters of each execution, in order.
+	ParameterSets []*TypedParameters `protobuf:"bytes,3,rep,name=parameter_sets,json=parameterSets,proto3" json:"parameter_sets,omitempty"`
+	// Execution time limit for the whole batch in milliseconds. Capped by the
+	// limits of the database and the caller's role; 0 uses the cap.
+	MaxExecutionTimeMs int32 `protobuf:"varint,4,opt,name=max_execution_time_ms,json=maxExecutionTimeMs,proto3" json:"max_execution_time_ms,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-type TransactionRequest_TypedQuery struct {
-	// Strictly-typed query execution within the transaction.
-	TypedQuery *TypedTransactionalQueryRequest `protobuf:"bytes,4,opt,name=typed_query,json=typedQuery,proto3,oneof"`
+func (x *TypedBatchExecRequest) Reset() {
+	*x = TypedBatchExecRequest{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type TransactionRequest_TypedQueryStream struct {
-	// Strictly-typed streaming query execution within the transaction.
-	TypedQueryStream *TypedTransactionalQueryRequest `protobuf:"bytes,5,opt,name=typed_query_stream,json=typedQueryStream,proto3,oneof"`
+func (x *TypedBatchExecRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type TransactionRequest_Exec struct {
-	// Unary mutation operation (DML).
-	Exec *TransactionalQueryRequest `protobuf:"bytes,6,opt,name=exec,proto3,oneof"`
-}
+func (*TypedBatchExecRequest) ProtoMessage() {}
 
-type TransactionRequest_TypedExec struct {
-	// Strictly-typed mutation operation (DML).
-	TypedExec *TypedTransactionalQueryRequest `protobuf:"bytes,7,opt,name=typed_exec,json=typedExec,proto3,oneof"`
+func (x *TypedBatchExecRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type TransactionRequest_Savepoint struct {
-	// Transactional control: create/manage savepoints.
-	Savepoint *SavepointRequest `protobuf:"bytes,8,opt,name=savepoint,proto3,oneof"`
+// Deprecated: Use TypedBatchExecRequest.ProtoReflect.Descriptor instead.
+func (*TypedBatchExecRequest) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{27}
 }
 
-type TransactionRequest_Commit struct {
-	// Transactional control: commit the transaction.
-	Commit *emptypb.Empty `protobuf:"bytes,9,opt,name=commit,proto3,oneof"`
+func (x *TypedBatchExecRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
 }
 
-type TransactionRequest_Rollback struct {
-	// Transactional control: rollback the transaction.
-	Rollback *emptypb.Empty `protobuf:"bytes,10,opt,name=rollback,proto3,oneof"`
+func (x *TypedBatchExecRequest) GetSql() string {
+	if x != nil {
+		return x.Sql
+	}
+	return ""
 }
 
-func (*TransactionRequest_Begin) isTransactionRequest_Command() {}
+func (x *TypedBatchExecRequest) GetParameterSets() []*TypedParameters {
+	if x != nil {
+		return x.ParameterSets
+	}
+	return nil
+}
 
-func (*TransactionRequest_Query) isTransactionRequest_Command() {}
+func (x *TypedBatchExecRequest) GetMaxExecutionTimeMs() int32 {
+	if x != nil {
+		return x.MaxExecutionTimeMs
+	}
+	return 0
+}
 
-func (*TransactionRequest_QueryStream) isTransactionRequest_Command() {}
+// *
+// TypedTransactionQueryRequest executes a typed query within an active
+// transaction.
+type TypedTransactionQueryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Absolute identifier for the active transaction.
+	TransactionId string `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// SQL statement to execute.
+	Sql string `protobuf:"bytes,2,opt,name=sql,proto3" json:"sql,omitempty"`
+	// Strictly-typed parameters.
+	Parameters    *TypedParameters `protobuf:"bytes,3,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-func (*TransactionRequest_TypedQuery) isTransactionRequest_Command() {}
+func (x *TypedTransactionQueryRequest) Reset() {
+	*x = TypedTransactionQueryRequest{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-func (*TransactionRequest_TypedQueryStream) isTransactionRequest_Command() {}
+func (x *TypedTransactionQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func (*TransactionRequest_Exec) isTransactionRequest_Command() {}
+func (*TypedTransactionQueryRequest) ProtoMessage() {}
 
-func (*TransactionRequest_TypedExec) isTransactionRequest_Command() {}
+func (x *TypedTransactionQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-func (*TransactionRequest_Savepoint) isTransactionRequest_Command() {}
+// Deprecated: Use TypedTransactionQueryRequest.ProtoReflect.Descriptor instead.
+func (*TypedTransactionQueryRequest) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{28}
+}
 
-func (*TransactionRequest_Commit) isTransactionRequest_Command() {}
+func (x *TypedTransactionQueryRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
 
-func (*TransactionRequest_Rollback) isTransactionRequest_Command() {}
+func (x *TypedTransactionQueryRequest) GetSql() string {
+	if x != nil {
+		return x.Sql
+	}
+	return ""
+}
+
+func (x *TypedTransactionQueryRequest) GetParameters() *TypedParameters {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
 
 // *
-// TransactionResponse defines a result in a multiplexed transaction stream.
-type TransactionResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Response:
-	//
-	//	*TransactionResponse_Begin
-	//	*TransactionResponse_QueryResult
-	//	*TransactionResponse_StreamResult
-	//	*TransactionResponse_TypedQueryResult
-	//	*TransactionResponse_TypedStreamResult
-	//	*TransactionResponse_Savepoint
-	//	*TransactionResponse_Commit
-	//	*TransactionResponse_Rollback
-	//	*TransactionResponse_ExecResult
-	//	*TransactionResponse_Error
-	Response      isTransactionResponse_Response `protobuf_oneof:"response"`
+// TypedQueryResult contains strictly-typed results and telemetry.
+type TypedQueryResult struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Columns []string               `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	// Affinities determined by the SQLite engine for each column.
+	ColumnAffinities []ColumnAffinity `protobuf:"varint,2,rep,packed,name=column_affinities,json=columnAffinities,proto3,enum=sqlrpc.v1.ColumnAffinity" json:"column_affinities,omitempty"`
+	// Declared types from the table schema for each column.
+	ColumnDeclaredTypes []DeclaredType `protobuf:"varint,3,rep,packed,name=column_declared_types,json=columnDeclaredTypes,proto3,enum=sqlrpc.v1.DeclaredType" json:"column_declared_types,omitempty"`
+	// Raw type strings returned by the underlying driver.
+	ColumnRawTypes []string `protobuf:"bytes,4,rep,name=column_raw_types,json=columnRawTypes,proto3" json:"column_raw_types,omitempty"`
+	// Ordered list of typed rows.
+	Rows []*SqlRow `protobuf:"bytes,5,rep,name=rows,proto3" json:"rows,omitempty"`
+	// Performance and execution telemetry.
+	Stats         *ExecutionStats `protobuf:"bytes,6,opt,name=stats,proto3" json:"stats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransactionResponse) Reset() {
-	*x = TransactionResponse{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[25]
+func (x *TypedQueryResult) Reset() {
+	*x = TypedQueryResult{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransactionResponse) String() string {
+func (x *TypedQueryResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionResponse) ProtoMessage() {}
+func (*TypedQueryResult) ProtoMessage() {}
 
-func (x *TransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[25]
+func (x *TypedQueryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1926,253 +2095,1821 @@ func (x *TransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionResponse.ProtoReflect.Descriptor instead.
-func (*TransactionResponse) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use TypedQueryResult.ProtoReflect.Descriptor instead.
+func (*TypedQueryResult) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *TransactionResponse) GetResponse() isTransactionResponse_Response {
+func (x *TypedQueryResult) GetColumns() []string {
 	if x != nil {
-		return x.Response
+		return x.Columns
 	}
 	return nil
 }
 
-func (x *TransactionResponse) GetBegin() *BeginResponse {
+func (x *TypedQueryResult) GetColumnAffinities() []ColumnAffinity {
 	if x != nil {
-		if x, ok := x.Response.(*TransactionResponse_Begin); ok {
-			return x.Begin
-		}
+		return x.ColumnAffinities
 	}
 	return nil
 }
 
-func (x *TransactionResponse) GetQueryResult() *QueryResult {
+func (x *TypedQueryResult) GetColumnDeclaredTypes() []DeclaredType {
 	if x != nil {
-		if x, ok := x.Response.(*TransactionResponse_QueryResult); ok {
-			return x.QueryResult
-		}
+		return x.ColumnDeclaredTypes
 	}
 	return nil
 }
 
-func (x *TransactionResponse) GetStreamResult() *QueryResponse {
+func (x *TypedQueryResult) GetColumnRawTypes() []string {
 	if x != nil {
-		if x, ok := x.Response.(*TransactionResponse_StreamResult); ok {
-			return x.StreamResult
-		}
+		return x.ColumnRawTypes
 	}
 	return nil
 }
 
-func (x *TransactionResponse) GetTypedQueryResult() *TypedQueryResult {
+func (x *TypedQueryResult) GetRows() []*SqlRow {
 	if x != nil {
-		if x, ok := x.Response.(*TransactionResponse_TypedQueryResult); ok {
-			return x.TypedQueryResult
-		}
+		return x.Rows
 	}
 	return nil
 }
 
-func (x *TransactionResponse) GetTypedStreamResult() *TypedQueryResponse {
+func (x *TypedQueryResult) GetStats() *ExecutionStats {
 	if x != nil {
-		if x, ok := x.Response.(*TransactionResponse_TypedStreamResult); ok {
-			return x.TypedStreamResult
-		}
+		return x.Stats
 	}
 	return nil
 }
 
-func (x *TransactionResponse) GetSavepoint() *SavepointResponse {
+// *
+// QueryResponse defines a chunked result from a streaming query.
+type QueryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Response:
+	//
+	//	*QueryResponse_Header
+	//	*QueryResponse_Batch
+	//	*QueryResponse_Complete
+	//	*QueryResponse_Error
+	Response      isQueryResponse_Response `protobuf_oneof:"response"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryResponse) Reset() {
+	*x = QueryResponse{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryResponse) ProtoMessage() {}
+
+func (x *QueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[30]
 	if x != nil {
-		if x, ok := x.Response.(*TransactionResponse_Savepoint); ok {
-			return x.Savepoint
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryResponse.ProtoReflect.Descriptor instead.
+func (*QueryResponse) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *QueryResponse) GetResponse() isQueryResponse_Response {
+	if x != nil {
+		return x.Response
 	}
 	return nil
 }
 
-func (x *TransactionResponse) GetCommit() *CommitResponse {
+func (x *QueryResponse) GetHeader() *QueryResultHeader {
 	if x != nil {
-		if x, ok := x.Response.(*TransactionResponse_Commit); ok {
-			return x.Commit
+		if x, ok := x.Response.(*QueryResponse_Header); ok {
+			return x.Header
 		}
 	}
 	return nil
 }
 
-func (x *TransactionResponse) GetRollback() *RollbackResponse {
+func (x *QueryResponse) GetBatch() *QueryResultRowBatch {
 	if x != nil {
-		if x, ok := x.Response.(*TransactionResponse_Rollback); ok {
-			return x.Rollback
+		if x, ok := x.Response.(*QueryResponse_Batch); ok {
+			return x.Batch
 		}
 	}
 	return nil
 }
 
-func (x *TransactionResponse) GetExecResult() *ExecResponse {
+func (x *QueryResponse) GetComplete() *QueryComplete {
 	if x != nil {
-		if x, ok := x.Response.(*TransactionResponse_ExecResult); ok {
-			return x.ExecResult
+		if x, ok := x.Response.(*QueryResponse_Complete); ok {
+			return x.Complete
 		}
 	}
 	return nil
 }
 
-func (x *TransactionResponse) GetError() *ErrorResponse {
+func (x *QueryResponse) GetError() *ErrorResponse {
 	if x != nil {
-		if x, ok := x.Response.(*TransactionResponse_Error); ok {
+		if x, ok := x.Response.(*QueryResponse_Error); ok {
 			return x.Error
 		}
 	}
 	return nil
 }
 
-type isTransactionResponse_Response interface {
-	isTransactionResponse_Response()
+type isQueryResponse_Response interface {
+	isQueryResponse_Response()
 }
 
-type TransactionResponse_Begin struct {
-	// Confirmation of transaction start.
-	Begin *BeginResponse `protobuf:"bytes,1,opt,name=begin,proto3,oneof"`
+type QueryResponse_Header struct {
+	Header *QueryResultHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
 }
 
-type TransactionResponse_QueryResult struct {
-	// Result for an untyped query.
-	QueryResult *QueryResult `protobuf:"bytes,2,opt,name=query_result,json=queryResult,proto3,oneof"`
+type QueryResponse_Batch struct {
+	Batch *QueryResultRowBatch `protobuf:"bytes,2,opt,name=batch,proto3,oneof"`
 }
 
-type TransactionResponse_StreamResult struct {
-	// Partial response for a streaming untyped query.
-	StreamResult *QueryResponse `protobuf:"bytes,3,opt,name=stream_result,json=streamResult,proto3,oneof"`
+type QueryResponse_Complete struct {
+	Complete *QueryComplete `protobuf:"bytes,3,opt,name=complete,proto3,oneof"`
 }
 
-type TransactionResponse_TypedQueryResult struct {
-	// Result for a strictly-typed query.
-	TypedQueryResult *TypedQueryResult `protobuf:"bytes,4,opt,name=typed_query_result,json=typedQueryResult,proto3,oneof"`
+type QueryResponse_Error struct {
+	Error *ErrorResponse `protobuf:"bytes,5,opt,name=error,proto3,oneof"`
 }
 
-type TransactionResponse_TypedStreamResult struct {
+func (*QueryResponse_Header) isQueryResponse_Response() {}
+
+func (*QueryResponse_Batch) isQueryResponse_Response() {}
+
+func (*QueryResponse_Complete) isQueryResponse_Response() {}
+
+func (*QueryResponse_Error) isQueryResponse_Response() {}
+
+// *
+// TypedQueryResponse defines a chunked result from a typed streaming query.
+type TypedQueryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Response:
+	//
+	//	*TypedQueryResponse_Header
+	//	*TypedQueryResponse_Batch
+	//	*TypedQueryResponse_Complete
+	//	*TypedQueryResponse_Error
+	Response      isTypedQueryResponse_Response `protobuf_oneof:"response"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TypedQueryResponse) Reset() {
+	*x = TypedQueryResponse{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypedQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypedQueryResponse) ProtoMessage() {}
+
+func (x *TypedQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypedQueryResponse.ProtoReflect.Descriptor instead.
+func (*TypedQueryResponse) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TypedQueryResponse) GetResponse() isTypedQueryResponse_Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *TypedQueryResponse) GetHeader() *TypedQueryResultHeader {
+	if x != nil {
+		if x, ok := x.Response.(*TypedQueryResponse_Header); ok {
+			return x.Header
+		}
+	}
+	return nil
+}
+
+func (x *TypedQueryResponse) GetBatch() *TypedQueryResultRowBatch {
+	if x != nil {
+		if x, ok := x.Response.(*TypedQueryResponse_Batch); ok {
+			return x.Batch
+		}
+	}
+	return nil
+}
+
+func (x *TypedQueryResponse) GetComplete() *QueryComplete {
+	if x != nil {
+		if x, ok := x.Response.(*TypedQueryResponse_Complete); ok {
+			return x.Complete
+		}
+	}
+	return nil
+}
+
+func (x *TypedQueryResponse) GetError() *ErrorResponse {
+	if x != nil {
+		if x, ok := x.Response.(*TypedQueryResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isTypedQueryResponse_Response interface {
+	isTypedQueryResponse_Response()
+}
+
+type TypedQueryResponse_Header struct {
+	// Initial response containing column metadata.
+	Header *TypedQueryResultHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type TypedQueryResponse_Batch struct {
+	// Batch of typed result rows.
+	Batch *TypedQueryResultRowBatch `protobuf:"bytes,2,opt,name=batch,proto3,oneof"`
+}
+
+type TypedQueryResponse_Complete struct {
+	// Final response indicating stream completion and stats.
+	Complete *QueryComplete `protobuf:"bytes,3,opt,name=complete,proto3,oneof"`
+}
+
+type TypedQueryResponse_Error struct {
+	// Error information if the query failed during streaming.
+	Error *ErrorResponse `protobuf:"bytes,5,opt,name=error,proto3,oneof"`
+}
+
+func (*TypedQueryResponse_Header) isTypedQueryResponse_Response() {}
+
+func (*TypedQueryResponse_Batch) isTypedQueryResponse_Response() {}
+
+func (*TypedQueryResponse_Complete) isTypedQueryResponse_Response() {}
+
+func (*TypedQueryResponse_Error) isTypedQueryResponse_Response() {}
+
+type QueryResultHeader struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Columns             []string               `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	ColumnAffinities    []ColumnAffinity       `protobuf:"varint,2,rep,packed,name=column_affinities,json=columnAffinities,proto3,enum=sqlrpc.v1.ColumnAffinity" json:"column_affinities,omitempty"`
+	ColumnDeclaredTypes []DeclaredType         `protobuf:"varint,3,rep,packed,name=column_declared_types,json=columnDeclaredTypes,proto3,enum=sqlrpc.v1.DeclaredType" json:"column_declared_types,omitempty"`
+	ColumnRawTypes      []string               `protobuf:"bytes,4,rep,name=column_raw_types,json=columnRawTypes,proto3" json:"column_raw_types,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *QueryResultHeader) Reset() {
+	*x = QueryResultHeader{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryResultHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryResultHeader) ProtoMessage() {}
+
+func (x *QueryResultHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryResultHeader.ProtoReflect.Descriptor instead.
+func (*QueryResultHeader) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *QueryResultHeader) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *QueryResultHeader) GetColumnAffinities() []ColumnAffinity {
+	if x != nil {
+		return x.ColumnAffinities
+	}
+	return nil
+}
+
+func (x *QueryResultHeader) GetColumnDeclaredTypes() []DeclaredType {
+	if x != nil {
+		return x.ColumnDeclaredTypes
+	}
+	return nil
+}
+
+func (x *QueryResultHeader) GetColumnRawTypes() []string {
+	if x != nil {
+		return x.ColumnRawTypes
+	}
+	return nil
+}
+
+// *
+// QueryResultRowBatch contains a collection of rows for an untyped query.
+type QueryResultRowBatch struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Collection of rows, where each row is a ListValue.
+	Rows []*structpb.ListValue `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	// Token that resumes a resumable QueryStream after this batch.
+	ResumeToken   string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryResultRowBatch) Reset() {
+	*x = QueryResultRowBatch{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryResultRowBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryResultRowBatch) ProtoMessage() {}
+
+func (x *QueryResultRowBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryResultRowBatch.ProtoReflect.Descriptor instead.
+func (*QueryResultRowBatch) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *QueryResultRowBatch) GetRows() []*structpb.ListValue {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *QueryResultRowBatch) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// *
+// TypedQueryResultHeader provides metadata for a typed streaming query.
+type TypedQueryResultHeader struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ordered list of column names.
+	Columns []string `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	// Affinities determined by the SQLite engine for each column.
+	ColumnAffinities []ColumnAffinity `protobuf:"varint,2,rep,packed,name=column_affinities,json=columnAffinities,proto3,enum=sqlrpc.v1.ColumnAffinity" json:"column_affinities,omitempty"`
+	// Declared types from the table schema for each column.
+	ColumnDeclaredTypes []DeclaredType `protobuf:"varint,3,rep,packed,name=column_declared_types,json=columnDeclaredTypes,proto3,enum=sqlrpc.v1.DeclaredType" json:"column_declared_types,omitempty"`
+	// Raw type strings returned by the underlying driver.
+	ColumnRawTypes []string `protobuf:"bytes,4,rep,name=column_raw_types,json=columnRawTypes,proto3" json:"column_raw_types,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TypedQueryResultHeader) Reset() {
+	*x = TypedQueryResultHeader{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypedQueryResultHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypedQueryResultHeader) ProtoMessage() {}
+
+func (x *TypedQueryResultHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypedQueryResultHeader.ProtoReflect.Descriptor instead.
+func (*TypedQueryResultHeader) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TypedQueryResultHeader) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *TypedQueryResultHeader) GetColumnAffinities() []ColumnAffinity {
+	if x != nil {
+		return x.ColumnAffinities
+	}
+	return nil
+}
+
+func (x *TypedQueryResultHeader) GetColumnDeclaredTypes() []DeclaredType {
+	if x != nil {
+		return x.ColumnDeclaredTypes
+	}
+	return nil
+}
+
+func (x *TypedQueryResultHeader) GetColumnRawTypes() []string {
+	if x != nil {
+		return x.ColumnRawTypes
+	}
+	return nil
+}
+
+// *
+// TypedQueryResultRowBatch contains a collection of rows for a typed query.
+type TypedQueryResultRowBatch struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Collection of strictly-typed rows.
+	Rows          []*SqlRow `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TypedQueryResultRowBatch) Reset() {
+	*x = TypedQueryResultRowBatch{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypedQueryResultRowBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypedQueryResultRowBatch) ProtoMessage() {}
+
+func (x *TypedQueryResultRowBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypedQueryResultRowBatch.ProtoReflect.Descriptor instead.
+func (*TypedQueryResultRowBatch) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *TypedQueryResultRowBatch) GetRows() []*SqlRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// *
+// QueryComplete signals the successful end of a streaming operation.
+type QueryComplete struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Final execution telemetry.
+	Stats         *ExecutionStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryComplete) Reset() {
+	*x = QueryComplete{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryComplete) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryComplete) ProtoMessage() {}
+
+func (x *QueryComplete) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryComplete.ProtoReflect.Descriptor instead.
+func (*QueryComplete) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *QueryComplete) GetStats() *ExecutionStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type ErrorResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Human-readable error message.
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// The SQL statement that failed execution.
+	FailedSql string `protobuf:"bytes,2,opt,name=failed_sql,json=failedSql,proto3" json:"failed_sql,omitempty"`
+	// Corresponding SQLite error code.
+	SqliteErrorCode SqliteCode `protobuf:"varint,3,opt,name=sqlite_error_code,json=sqliteErrorCode,proto3,enum=sqlrpc.v1.SqliteCode" json:"sqlite_error_code,omitempty"`
+	// Index of the failing parameter set of a BatchExec.
+	BatchIndex    *int32 `protobuf:"varint,4,opt,name=batch_index,json=batchIndex,proto3,oneof" json:"batch_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErrorResponse) Reset() {
+	*x = ErrorResponse{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorResponse) ProtoMessage() {}
+
+func (x *ErrorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorResponse.ProtoReflect.Descriptor instead.
+func (*ErrorResponse) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ErrorResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ErrorResponse) GetFailedSql() string {
+	if x != nil {
+		return x.FailedSql
+	}
+	return ""
+}
+
+func (x *ErrorResponse) GetSqliteErrorCode() SqliteCode {
+	if x != nil {
+		return x.SqliteErrorCode
+	}
+	return SqliteCode_SQLITE_OK
+}
+
+func (x *ErrorResponse) GetBatchIndex() int32 {
+	if x != nil && x.BatchIndex != nil {
+		return *x.BatchIndex
+	}
+	return 0
+}
+
+// *
+// TransactionRequest defines a command in a multiplexed transaction stream.
+type TransactionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Command:
+	//
+	//	*TransactionRequest_Begin
+	//	*TransactionRequest_Query
+	//	*TransactionRequest_QueryStream
+	//	*TransactionRequest_TypedQuery
+	//	*TransactionRequest_TypedQueryStream
+	//	*TransactionRequest_Exec
+	//	*TransactionRequest_TypedExec
+	//	*TransactionRequest_Savepoint
+	//	*TransactionRequest_Commit
+	//	*TransactionRequest_Rollback
+	Command       isTransactionRequest_Command `protobuf_oneof:"command"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionRequest) Reset() {
+	*x = TransactionRequest{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionRequest) ProtoMessage() {}
+
+func (x *TransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionRequest.ProtoReflect.Descriptor instead.
+func (*TransactionRequest) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TransactionRequest) GetCommand() isTransactionRequest_Command {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *TransactionRequest) GetBegin() *BeginRequest {
+	if x != nil {
+		if x, ok := x.Command.(*TransactionRequest_Begin); ok {
+			return x.Begin
+		}
+	}
+	return nil
+}
+
+func (x *TransactionRequest) GetQuery() *TransactionalQueryRequest {
+	if x != nil {
+		if x, ok := x.Command.(*TransactionRequest_Query); ok {
+			return x.Query
+		}
+	}
+	return nil
+}
+
+func (x *TransactionRequest) GetQueryStream() *TransactionalQueryRequest {
+	if x != nil {
+		if x, ok := x.Command.(*TransactionRequest_QueryStream); ok {
+			return x.QueryStream
+		}
+	}
+	return nil
+}
+
+func (x *TransactionRequest) GetTypedQuery() *TypedTransactionalQueryRequest {
+	if x != nil {
+		if x, ok := x.Command.(*TransactionRequest_TypedQuery); ok {
+			return x.TypedQuery
+		}
+	}
+	return nil
+}
+
+func (x *TransactionRequest) GetTypedQueryStream() *TypedTransactionalQueryRequest {
+	if x != nil {
+		if x, ok := x.Command.(*TransactionRequest_TypedQueryStream); ok {
+			return x.TypedQueryStream
+		}
+	}
+	return nil
+}
+
+func (x *TransactionRequest) GetExec() *TransactionalQueryRequest {
+	if x != nil {
+		if x, ok := x.Command.(*TransactionRequest_Exec); ok {
+			return x.Exec
+		}
+	}
+	return nil
+}
+
+func (x *TransactionRequest) GetTypedExec() *TypedTransactionalQueryRequest {
+	if x != nil {
+		if x, ok := x.Command.(*TransactionRequest_TypedExec); ok {
+			return x.TypedExec
+		}
+	}
+	return nil
+}
+
+func (x *TransactionRequest) GetSavepoint() *SavepointRequest {
+	if x != nil {
+		if x, ok := x.Command.(*TransactionRequest_Savepoint); ok {
+			return x.Savepoint
+		}
+	}
+	return nil
+}
+
+func (x *TransactionRequest) GetCommit() *emptypb.Empty {
+	if x != nil {
+		if x, ok := x.Command.(*TransactionRequest_Commit); ok {
+			return x.Commit
+		}
+	}
+	return nil
+}
+
+func (x *TransactionRequest) GetRollback() *emptypb.Empty {
+	if x != nil {
+		if x, ok := x.Command.(*TransactionRequest_Rollback); ok {
+			return x.Rollback
+		}
+	}
+	return nil
+}
+
+type isTransactionRequest_Command interface {
+	isTransactionRequest_Command()
+}
+
+type TransactionRequest_Begin struct {
+	// Start the transaction (must be first).
+	Begin *BeginRequest `protobuf:"bytes,1,opt,name=begin,proto3,oneof"`
+}
+
+type TransactionRequest_Query struct {
+	// Untyped query execution within the transaction.
+	Query *TransactionalQueryRequest `protobuf:"bytes,2,opt,name=query,proto3,oneof"`
+}
+
+type TransactionRequest_QueryStream struct {
+	// Untyped streaming query execution within the transaction.
+	QueryStream *TransactionalQueryRequest `protobuf:"bytes,3,opt,name=query_stream,json=queryStream,proto3,oneof"`
+}
+
+type TransactionRequest_TypedQuery struct {
+	// Strictly-typed query execution within the transaction.
+	TypedQuery *TypedTransactionalQueryRequest `protobuf:"bytes,4,opt,name=typed_query,json=typedQuery,proto3,oneof"`
+}
+
+type TransactionRequest_TypedQueryStream struct {
+	// Strictly-typed streaming query execution within the transaction.
+	TypedQueryStream *TypedTransactionalQueryRequest `protobuf:"bytes,5,opt,name=typed_query_stream,json=typedQueryStream,proto3,oneof"`
+}
+
+type TransactionRequest_Exec struct {
+	// Unary mutation operation (DML).
+	Exec *TransactionalQueryRequest `protobuf:"bytes,6,opt,name=exec,proto3,oneof"`
+}
+
+type TransactionRequest_TypedExec struct {
+	// Strictly-typed mutation operation (DML).
+	TypedExec *TypedTransactionalQueryRequest `protobuf:"bytes,7,opt,name=typed_exec,json=typedExec,proto3,oneof"`
+}
+
+type TransactionRequest_Savepoint struct {
+	// Transactional control: create/manage savepoints.
+	Savepoint *SavepointRequest `protobuf:"bytes,8,opt,name=savepoint,proto3,oneof"`
+}
+
+type TransactionRequest_Commit struct {
+	// Transactional control: commit the transaction.
+	Commit *emptypb.Empty `protobuf:"bytes,9,opt,name=commit,proto3,oneof"`
+}
+
+type TransactionRequest_Rollback struct {
+	// Transactional control: rollback the transaction.
+	Rollback *emptypb.Empty `protobuf:"bytes,10,opt,name=rollback,proto3,oneof"`
+}
+
+func (*TransactionRequest_Begin) isTransactionRequest_Command() {}
+
+func (*TransactionRequest_Query) isTransactionRequest_Command() {}
+
+func (*TransactionRequest_QueryStream) isTransactionRequest_Command() {}
+
+func (*TransactionRequest_TypedQuery) isTransactionRequest_Command() {}
+
+func (*TransactionRequest_TypedQueryStream) isTransactionRequest_Command() {}
+
+func (*TransactionRequest_Exec) isTransactionRequest_Command() {}
+
+func (*TransactionRequest_TypedExec) isTransactionRequest_Command() {}
+
+func (*TransactionRequest_Savepoint) isTransactionRequest_Command() {}
+
+func (*TransactionRequest_Commit) isTransactionRequest_Command() {}
+
+func (*TransactionRequest_Rollback) isTransactionRequest_Command() {}
+
+// *
+// TransactionResponse defines a result in a multiplexed transaction stream.
+type TransactionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Response:
+	//
+	//	*TransactionResponse_Begin
+	//	*TransactionResponse_QueryResult
+	//	*TransactionResponse_StreamResult
+	//	*TransactionResponse_TypedQueryResult
+	//	*TransactionResponse_TypedStreamResult
+	//	*TransactionResponse_Savepoint
+	//	*TransactionResponse_Commit
+	//	*TransactionResponse_Rollback
+	//	*TransactionResponse_ExecResult
+	//	*TransactionResponse_Error
+	Response      isTransactionResponse_Response `protobuf_oneof:"response"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionResponse) Reset() {
+	*x = TransactionResponse{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionResponse) ProtoMessage() {}
+
+func (x *TransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionResponse.ProtoReflect.Descriptor instead.
+func (*TransactionResponse) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TransactionResponse) GetResponse() isTransactionResponse_Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *TransactionResponse) GetBegin() *BeginResponse {
+	if x != nil {
+		if x, ok := x.Response.(*TransactionResponse_Begin); ok {
+			return x.Begin
+		}
+	}
+	return nil
+}
+
+func (x *TransactionResponse) GetQueryResult() *QueryResult {
+	if x != nil {
+		if x, ok := x.Response.(*TransactionResponse_QueryResult); ok {
+			return x.QueryResult
+		}
+	}
+	return nil
+}
+
+func (x *TransactionResponse) GetStreamResult() *QueryResponse {
+	if x != nil {
+		if x, ok := x.Response.(*TransactionResponse_StreamResult); ok {
+			return x.StreamResult
+		}
+	}
+	return nil
+}
+
+func (x *TransactionResponse) GetTypedQueryResult() *TypedQueryResult {
+	if x != nil {
+		if x, ok := x.Response.(*TransactionResponse_TypedQueryResult); ok {
+			return x.TypedQueryResult
+		}
+	}
+	return nil
+}
+
+func (x *TransactionResponse) GetTypedStreamResult() *TypedQueryResponse {
+	if x != nil {
+		if x, ok := x.Response.(*TransactionResponse_TypedStreamResult); ok {
+			return x.TypedStreamResult
+		}
+	}
+	return nil
+}
+
+func (x *TransactionResponse) GetSavepoint() *SavepointResponse {
+	if x != nil {
+		if x, ok := x.Response.(*TransactionResponse_Savepoint); ok {
+			return x.Savepoint
+		}
+	}
+	return nil
+}
+
+func (x *TransactionResponse) GetCommit() *CommitResponse {
+	if x != nil {
+		if x, ok := x.Response.(*TransactionResponse_Commit); ok {
+			return x.Commit
+		}
+	}
+	return nil
+}
+
+func (x *TransactionResponse) GetRollback() *RollbackResponse {
+	if x != nil {
+		if x, ok := x.Response.(*TransactionResponse_Rollback); ok {
+			return x.Rollback
+		}
+	}
+	return nil
+}
+
+func (x *TransactionResponse) GetExecResult() *ExecResponse {
+	if x != nil {
+		if x, ok := x.Response.(*TransactionResponse_ExecResult); ok {
+			return x.ExecResult
+		}
+	}
+	return nil
+}
+
+func (x *TransactionResponse) GetError() *ErrorResponse {
+	if x != nil {
+		if x, ok := x.Response.(*TransactionResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isTransactionResponse_Response interface {
+	isTransactionResponse_Response()
+}
+
+type TransactionResponse_Begin struct {
+	// Confirmation of transaction start.
+	Begin *BeginResponse `protobuf:"bytes,1,opt,name=begin,proto3,oneof"`
+}
+
+type TransactionResponse_QueryResult struct {
+	// Result for an untyped query.
+	QueryResult *QueryResult `protobuf:"bytes,2,opt,name=query_result,json=queryResult,proto3,oneof"`
+}
+
+type TransactionResponse_StreamResult struct {
+	// Partial response for a streaming untyped query.
+	StreamResult *QueryResponse `protobuf:"bytes,3,opt,name=stream_result,json=streamResult,proto3,oneof"`
+}
+
+type TransactionResponse_TypedQueryResult struct {
+	// Result for a strictly-typed query.
+	TypedQueryResult *TypedQueryResult `protobuf:"bytes,4,opt,name=typed_query_result,json=typedQueryResult,proto3,oneof"`
+}
+
+type TransactionResponse_TypedStreamResult struct {
 	// Partial response for a streaming strictly-typed query.
 	TypedStreamResult *TypedQueryResponse `protobuf:"bytes,5,opt,name=typed_stream_result,json=typedStreamResult,proto3,oneof"`
 }
 
-type TransactionResponse_Savepoint struct {
-	// Savepoint operation confirmation.
-	Savepoint *SavepointResponse `protobuf:"bytes,7,opt,name=savepoint,proto3,oneof"`
+type TransactionResponse_Savepoint struct {
+	// Savepoint operation confirmation.
+	Savepoint *SavepointResponse `protobuf:"bytes,7,opt,name=savepoint,proto3,oneof"`
+}
+
+type TransactionResponse_Commit struct {
+	// Commit operation confirmation.
+	Commit *CommitResponse `protobuf:"bytes,8,opt,name=commit,proto3,oneof"`
+}
+
+type TransactionResponse_Rollback struct {
+	// Rollback operation confirmation.
+	Rollback *RollbackResponse `protobuf:"bytes,9,opt,name=rollback,proto3,oneof"`
+}
+
+type TransactionResponse_ExecResult struct {
+	// Result for a mutation operation.
+	ExecResult *ExecResponse `protobuf:"bytes,11,opt,name=exec_result,json=execResult,proto3,oneof"`
+}
+
+type TransactionResponse_Error struct {
+	// Encapsulated error message if a command fails.
+	Error *ErrorResponse `protobuf:"bytes,10,opt,name=error,proto3,oneof"`
+}
+
+func (*TransactionResponse_Begin) isTransactionResponse_Response() {}
+
+func (*TransactionResponse_QueryResult) isTransactionResponse_Response() {}
+
+func (*TransactionResponse_StreamResult) isTransactionResponse_Response() {}
+
+func (*TransactionResponse_TypedQueryResult) isTransactionResponse_Response() {}
+
+func (*TransactionResponse_TypedStreamResult) isTransactionResponse_Response() {}
+
+func (*TransactionResponse_Savepoint) isTransactionResponse_Response() {}
+
+func (*TransactionResponse_Commit) isTransactionResponse_Response() {}
+
+func (*TransactionResponse_Rollback) isTransactionResponse_Response() {}
+
+func (*TransactionResponse_ExecResult) isTransactionResponse_Response() {}
+
+func (*TransactionResponse_Error) isTransactionResponse_Response() {}
+
+// *
+// ExplainResponse provides the breakdown of the query execution plan.
+type ExplainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*QueryPlanNode       `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExplainResponse) Reset() {
+	*x = ExplainResponse{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainResponse) ProtoMessage() {}
+
+func (x *ExplainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainResponse.ProtoReflect.Descriptor instead.
+func (*ExplainResponse) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ExplainResponse) GetNodes() []*QueryPlanNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+// *
+// ListTablesRequest lists all tables in a tenant database.
+type ListTablesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tenant target identifier for connection routing.
+	Database      string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTablesRequest) Reset() {
+	*x = ListTablesRequest{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTablesRequest) ProtoMessage() {}
+
+func (x *ListTablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTablesRequest.ProtoReflect.Descriptor instead.
+func (*ListTablesRequest) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListTablesRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+// *
+// ListTablesResponse contains the names of discovered tables.
+type ListTablesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableNames    []string               `protobuf:"bytes,1,rep,name=table_names,json=tableNames,proto3" json:"table_names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTablesResponse) Reset() {
+	*x = ListTablesResponse{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTablesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTablesResponse) ProtoMessage() {}
+
+func (x *ListTablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTablesResponse.ProtoReflect.Descriptor instead.
+func (*ListTablesResponse) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListTablesResponse) GetTableNames() []string {
+	if x != nil {
+		return x.TableNames
+	}
+	return nil
+}
+
+// *
+// GetTableSchemaRequest retrieves structure for a specific table.
+type GetTableSchemaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tenant target identifier for connection routing.
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	// Target table name to inspect.
+	TableName     string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTableSchemaRequest) Reset() {
+	*x = GetTableSchemaRequest{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTableSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTableSchemaRequest) ProtoMessage() {}
+
+func (x *GetTableSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTableSchemaRequest.ProtoReflect.Descriptor instead.
+func (*GetTableSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetTableSchemaRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *GetTableSchemaRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+// *
+// GetDatabaseSchemaRequest retrieves structure for the entire database.
+type GetDatabaseSchemaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tenant target identifier for connection routing.
+	Database      string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDatabaseSchemaRequest) Reset() {
+	*x = GetDatabaseSchemaRequest{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDatabaseSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDatabaseSchemaRequest) ProtoMessage() {}
+
+func (x *GetDatabaseSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDatabaseSchemaRequest.ProtoReflect.Descriptor instead.
+func (*GetDatabaseSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetDatabaseSchemaRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+// *
+// VacuumRequest rebuilt the database to reclaim space.
+type VacuumRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tenant target identifier for connection routing.
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	// Optional target file to vacuum into.
+	IntoFile      *string `protobuf:"bytes,2,opt,name=into_file,json=intoFile,proto3,oneof" json:"into_file,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VacuumRequest) Reset() {
+	*x = VacuumRequest{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VacuumRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VacuumRequest) ProtoMessage() {}
+
+func (x *VacuumRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VacuumRequest.ProtoReflect.Descriptor instead.
+func (*VacuumRequest) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *VacuumRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *VacuumRequest) GetIntoFile() string {
+	if x != nil && x.IntoFile != nil {
+		return *x.IntoFile
+	}
+	return ""
+}
+
+// *
+// VacuumResponse confirms the database rebuild completion.
+type VacuumResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VacuumResponse) Reset() {
+	*x = VacuumResponse{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VacuumResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VacuumResponse) ProtoMessage() {}
+
+func (x *VacuumResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VacuumResponse.ProtoReflect.Descriptor instead.
+func (*VacuumResponse) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *VacuumResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *VacuumResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// *
+// CheckpointRequest synchronizes WAL with the main database file.
+type CheckpointRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tenant target identifier for connection routing.
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	// Synchronize strategy.
+	Mode          CheckpointMode `protobuf:"varint,2,opt,name=mode,proto3,enum=sqlrpc.v1.CheckpointMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckpointRequest) Reset() {
+	*x = CheckpointRequest{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckpointRequest) ProtoMessage() {}
+
+func (x *CheckpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckpointRequest.ProtoReflect.Descriptor instead.
+func (*CheckpointRequest) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CheckpointRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *CheckpointRequest) GetMode() CheckpointMode {
+	if x != nil {
+		return x.Mode
+	}
+	return CheckpointMode_CHECKPOINT_MODE_UNSPECIFIED
 }
 
-type TransactionResponse_Commit struct {
-	// Commit operation confirmation.
-	Commit *CommitResponse `protobuf:"bytes,8,opt,name=commit,proto3,oneof"`
+// *
+// CheckpointResponse provides statistics about the WAL synchronization.
+type CheckpointResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Success           bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message           string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	BusyCheckpoints   int64                  `protobuf:"varint,3,opt,name=busy_checkpoints,json=busyCheckpoints,proto3" json:"busy_checkpoints,omitempty"`
+	LogCheckpoints    int64                  `protobuf:"varint,4,opt,name=log_checkpoints,json=logCheckpoints,proto3" json:"log_checkpoints,omitempty"`
+	CheckpointedPages int64                  `protobuf:"varint,5,opt,name=checkpointed_pages,json=checkpointedPages,proto3" json:"checkpointed_pages,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-type TransactionResponse_Rollback struct {
-	// Rollback operation confirmation.
-	Rollback *RollbackResponse `protobuf:"bytes,9,opt,name=rollback,proto3,oneof"`
+func (x *CheckpointResponse) Reset() {
+	*x = CheckpointResponse{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type TransactionResponse_ExecResult struct {
-	// Result for a mutation operation.
-	ExecResult *ExecResponse `protobuf:"bytes,11,opt,name=exec_result,json=execResult,proto3,oneof"`
+func (x *CheckpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type TransactionResponse_Error struct {
-	// Encapsulated error message if a command fails.
-	Error *ErrorResponse `protobuf:"bytes,10,opt,name=error,proto3,oneof"`
+func (*CheckpointResponse) ProtoMessage() {}
+
+func (x *CheckpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*TransactionResponse_Begin) isTransactionResponse_Response() {}
+// Deprecated: Use CheckpointResponse.ProtoReflect.Descriptor instead.
+func (*CheckpointResponse) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{48}
+}
 
-func (*TransactionResponse_QueryResult) isTransactionResponse_Response() {}
+func (x *CheckpointResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
 
-func (*TransactionResponse_StreamResult) isTransactionResponse_Response() {}
+func (x *CheckpointResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
 
-func (*TransactionResponse_TypedQueryResult) isTransactionResponse_Response() {}
+func (x *CheckpointResponse) GetBusyCheckpoints() int64 {
+	if x != nil {
+		return x.BusyCheckpoints
+	}
+	return 0
+}
 
-func (*TransactionResponse_TypedStreamResult) isTransactionResponse_Response() {}
+func (x *CheckpointResponse) GetLogCheckpoints() int64 {
+	if x != nil {
+		return x.LogCheckpoints
+	}
+	return 0
+}
 
-func (*TransactionResponse_Savepoint) isTransactionResponse_Response() {}
+func (x *CheckpointResponse) GetCheckpointedPages() int64 {
+	if x != nil {
+		return x.CheckpointedPages
+	}
+	return 0
+}
 
-func (*TransactionResponse_Commit) isTransactionResponse_Response() {}
+// *
+// IntegrityCheckRequest searches for physical corruption in the database.
+type IntegrityCheckRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tenant target identifier for connection routing.
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	// Limits the number of error strings returned.
+	MaxErrors     *int32 `protobuf:"varint,2,opt,name=max_errors,json=maxErrors,proto3,oneof" json:"max_errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-func (*TransactionResponse_Rollback) isTransactionResponse_Response() {}
+func (x *IntegrityCheckRequest) Reset() {
+	*x = IntegrityCheckRequest{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-func (*TransactionResponse_ExecResult) isTransactionResponse_Response() {}
+func (x *IntegrityCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func (*TransactionResponse_Error) isTransactionResponse_Response() {}
+func (*IntegrityCheckRequest) ProtoMessage() {}
+
+func (x *IntegrityCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrityCheckRequest.ProtoReflect.Descriptor instead.
+func (*IntegrityCheckRequest) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *IntegrityCheckRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *IntegrityCheckRequest) GetMaxErrors() int32 {
+	if x != nil && x.MaxErrors != nil {
+		return *x.MaxErrors
+	}
+	return 0
+}
 
 // *
-// ExplainResponse provides the breakdown of the query execution plan.
-type ExplainResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Nodes         []*QueryPlanNode       `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+// IntegrityCheckResponse identifies any corruption found.
+type IntegrityCheckResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// List of physical or logical structural errors found.
+	Errors        []string `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExplainResponse) Reset() {
-	*x = ExplainResponse{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[26]
+func (x *IntegrityCheckResponse) Reset() {
+	*x = IntegrityCheckResponse{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExplainResponse) String() string {
+func (x *IntegrityCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrityCheckResponse) ProtoMessage() {}
+
+func (x *IntegrityCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrityCheckResponse.ProtoReflect.Descriptor instead.
+func (*IntegrityCheckResponse) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *IntegrityCheckResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *IntegrityCheckResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *IntegrityCheckResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// *
+// BackupInfo describes a stored backup.
+type BackupInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Backup identifier, unique per database and ordered by creation time.
+	BackupId string `protobuf:"bytes,1,opt,name=backup_id,json=backupId,proto3" json:"backup_id,omitempty"`
+	// Database the backup was taken from.
+	Database string `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	// Time the snapshot was taken.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Size of the stored file in bytes (compressed size if compressed).
+	SizeBytes int64 `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Compression applied to the stored file.
+	Compression BackupCompression `protobuf:"varint,5,opt,name=compression,proto3,enum=sqlrpc.v1.BackupCompression" json:"compression,omitempty"`
+	// Hex-encoded SHA-256 of the stored file.
+	Sha256        string `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackupInfo) Reset() {
+	*x = BackupInfo{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackupInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExplainResponse) ProtoMessage() {}
-
-func (x *ExplainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[26]
+func (*BackupInfo) ProtoMessage() {}
+
+func (x *BackupInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupInfo.ProtoReflect.Descriptor instead.
+func (*BackupInfo) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *BackupInfo) GetBackupId() string {
+	if x != nil {
+		return x.BackupId
+	}
+	return ""
+}
+
+func (x *BackupInfo) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *BackupInfo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *BackupInfo) GetSizeBytes() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.SizeBytes
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ExplainResponse.ProtoReflect.Descriptor instead.
-func (*ExplainResponse) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{26}
+func (x *BackupInfo) GetCompression() BackupCompression {
+	if x != nil {
+		return x.Compression
+	}
+	return BackupCompression_BACKUP_COMPRESSION_UNSPECIFIED
 }
 
-func (x *ExplainResponse) GetNodes() []*QueryPlanNode {
+func (x *BackupInfo) GetSha256() string {
 	if x != nil {
-		return x.Nodes
+		return x.Sha256
 	}
-	return nil
+	return ""
 }
 
 // *
-// ListTablesRequest lists all tables in a tenant database.
-type ListTablesRequest struct {
+// BackupDatabaseRequest takes a new backup.
+type BackupDatabaseRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Tenant target identifier for connection routing.
-	Database      string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	// Compression to apply to the stored file.
+	Compression   BackupCompression `protobuf:"varint,2,opt,name=compression,proto3,enum=sqlrpc.v1.BackupCompression" json:"compression,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTablesRequest) Reset() {
-	*x = ListTablesRequest{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[27]
+func (x *BackupDatabaseRequest) Reset() {
+	*x = BackupDatabaseRequest{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTablesRequest) String() string {
+func (x *BackupDatabaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTablesRequest) ProtoMessage() {}
+func (*BackupDatabaseRequest) ProtoMessage() {}
 
-func (x *ListTablesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[27]
+func (x *BackupDatabaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2183,42 +3920,49 @@ func (x *ListTablesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTablesRequest.ProtoReflect.Descriptor instead.
-func (*ListTablesRequest) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use BackupDatabaseRequest.ProtoReflect.Descriptor instead.
+func (*BackupDatabaseRequest) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ListTablesRequest) GetDatabase() string {
+func (x *BackupDatabaseRequest) GetDatabase() string {
 	if x != nil {
 		return x.Database
 	}
 	return ""
 }
 
+func (x *BackupDatabaseRequest) GetCompression() BackupCompression {
+	if x != nil {
+		return x.Compression
+	}
+	return BackupCompression_BACKUP_COMPRESSION_UNSPECIFIED
+}
+
 // *
-// ListTablesResponse contains the names of discovered tables.
-type ListTablesResponse struct {
+// BackupDatabaseResponse returns the stored backup.
+type BackupDatabaseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TableNames    []string               `protobuf:"bytes,1,rep,name=table_names,json=tableNames,proto3" json:"table_names,omitempty"`
+	Backup        *BackupInfo            `protobuf:"bytes,1,opt,name=backup,proto3" json:"backup,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTablesResponse) Reset() {
-	*x = ListTablesResponse{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[28]
+func (x *BackupDatabaseResponse) Reset() {
+	*x = BackupDatabaseResponse{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTablesResponse) String() string {
+func (x *BackupDatabaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTablesResponse) ProtoMessage() {}
+func (*BackupDatabaseResponse) ProtoMessage() {}
 
-func (x *ListTablesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[28]
+func (x *BackupDatabaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2229,45 +3973,43 @@ func (x *ListTablesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTablesResponse.ProtoReflect.Descriptor instead.
-func (*ListTablesResponse) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use BackupDatabaseResponse.ProtoReflect.Descriptor instead.
+func (*BackupDatabaseResponse) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ListTablesResponse) GetTableNames() []string {
+func (x *BackupDatabaseResponse) GetBackup() *BackupInfo {
 	if x != nil {
-		return x.TableNames
+		return x.Backup
 	}
 	return nil
 }
 
 // *
-// GetTableSchemaRequest retrieves structure for a specific table.
-type GetTableSchemaRequest struct {
+// ListBackupsRequest lists the backups of a database.
+type ListBackupsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Tenant target identifier for connection routing.
-	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	// Target table name to inspect.
-	TableName     string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	Database      string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTableSchemaRequest) Reset() {
-	*x = GetTableSchemaRequest{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[29]
+func (x *ListBackupsRequest) Reset() {
+	*x = ListBackupsRequest{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTableSchemaRequest) String() string {
+func (x *ListBackupsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTableSchemaRequest) ProtoMessage() {}
+func (*ListBackupsRequest) ProtoMessage() {}
 
-func (x *GetTableSchemaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[29]
+func (x *ListBackupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2278,50 +4020,42 @@ func (x *GetTableSchemaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTableSchemaRequest.ProtoReflect.Descriptor instead.
-func (*GetTableSchemaRequest) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ListBackupsRequest.ProtoReflect.Descriptor instead.
+func (*ListBackupsRequest) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetTableSchemaRequest) GetDatabase() string {
+func (x *ListBackupsRequest) GetDatabase() string {
 	if x != nil {
 		return x.Database
 	}
 	return ""
 }
 
-func (x *GetTableSchemaRequest) GetTableName() string {
-	if x != nil {
-		return x.TableName
-	}
-	return ""
-}
-
 // *
-// GetDatabaseSchemaRequest retrieves structure for the entire database.
-type GetDatabaseSchemaRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Tenant target identifier for connection routing.
-	Database      string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+// ListBackupsResponse holds the stored backups, newest first.
+type ListBackupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Backups       []*BackupInfo          `protobuf:"bytes,1,rep,name=backups,proto3" json:"backups,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDatabaseSchemaRequest) Reset() {
-	*x = GetDatabaseSchemaRequest{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[30]
+func (x *ListBackupsResponse) Reset() {
+	*x = ListBackupsResponse{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDatabaseSchemaRequest) String() string {
+func (x *ListBackupsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDatabaseSchemaRequest) ProtoMessage() {}
+func (*ListBackupsResponse) ProtoMessage() {}
 
-func (x *GetDatabaseSchemaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[30]
+func (x *ListBackupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2332,45 +4066,45 @@ func (x *GetDatabaseSchemaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDatabaseSchemaRequest.ProtoReflect.Descriptor instead.
-func (*GetDatabaseSchemaRequest) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ListBackupsResponse.ProtoReflect.Descriptor instead.
+func (*ListBackupsResponse) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetDatabaseSchemaRequest) GetDatabase() string {
+func (x *ListBackupsResponse) GetBackups() []*BackupInfo {
 	if x != nil {
-		return x.Database
+		return x.Backups
 	}
-	return ""
+	return nil
 }
 
 // *
-// VacuumRequest rebuilt the database to reclaim space.
-type VacuumRequest struct {
+// RestoreDatabaseRequest replaces a database with a stored backup.
+type RestoreDatabaseRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Tenant target identifier for connection routing.
 	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	// Optional target file to vacuum into.
-	IntoFile      *string `protobuf:"bytes,2,opt,name=into_file,json=intoFile,proto3,oneof" json:"into_file,omitempty"`
+	// Backup to restore, as returned by BackupDatabase or ListBackups.
+	BackupId      string `protobuf:"bytes,2,opt,name=backup_id,json=backupId,proto3" json:"backup_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VacuumRequest) Reset() {
-	*x = VacuumRequest{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[31]
+func (x *RestoreDatabaseRequest) Reset() {
+	*x = RestoreDatabaseRequest{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VacuumRequest) String() string {
+func (x *RestoreDatabaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VacuumRequest) ProtoMessage() {}
+func (*RestoreDatabaseRequest) ProtoMessage() {}
 
-func (x *VacuumRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[31]
+func (x *RestoreDatabaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2381,50 +4115,52 @@ func (x *VacuumRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VacuumRequest.ProtoReflect.Descriptor instead.
-func (*VacuumRequest) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use RestoreDatabaseRequest.ProtoReflect.Descriptor instead.
+func (*RestoreDatabaseRequest) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *VacuumRequest) GetDatabase() string {
+func (x *RestoreDatabaseRequest) GetDatabase() string {
 	if x != nil {
 		return x.Database
 	}
 	return ""
 }
 
-func (x *VacuumRequest) GetIntoFile() string {
-	if x != nil && x.IntoFile != nil {
-		return *x.IntoFile
+func (x *RestoreDatabaseRequest) GetBackupId() string {
+	if x != nil {
+		return x.BackupId
 	}
 	return ""
 }
 
 // *
-// VacuumResponse confirms the database rebuild completion.
-type VacuumResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+// RestoreDatabaseResponse confirms the restore.
+type RestoreDatabaseResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// The backup that was restored.
+	Backup        *BackupInfo `protobuf:"bytes,3,opt,name=backup,proto3" json:"backup,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VacuumResponse) Reset() {
-	*x = VacuumResponse{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[32]
+func (x *RestoreDatabaseResponse) Reset() {
+	*x = RestoreDatabaseResponse{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VacuumResponse) String() string {
+func (x *RestoreDatabaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VacuumResponse) ProtoMessage() {}
+func (*RestoreDatabaseResponse) ProtoMessage() {}
 
-func (x *VacuumResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[32]
+func (x *RestoreDatabaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2435,52 +4171,60 @@ func (x *VacuumResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VacuumResponse.ProtoReflect.Descriptor instead.
-func (*VacuumResponse) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use RestoreDatabaseResponse.ProtoReflect.Descriptor instead.
+func (*RestoreDatabaseResponse) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *VacuumResponse) GetSuccess() bool {
+func (x *RestoreDatabaseResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *VacuumResponse) GetMessage() string {
+func (x *RestoreDatabaseResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
+func (x *RestoreDatabaseResponse) GetBackup() *BackupInfo {
+	if x != nil {
+		return x.Backup
+	}
+	return nil
+}
+
 // *
-// CheckpointRequest synchronizes WAL with the main database file.
-type CheckpointRequest struct {
+// DownloadBackupRequest selects the backup to download.
+type DownloadBackupRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Tenant target identifier for connection routing.
 	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	// Synchronize strategy.
-	Mode          CheckpointMode `protobuf:"varint,2,opt,name=mode,proto3,enum=sqlrpc.v1.CheckpointMode" json:"mode,omitempty"`
+	// Stored backup to download. If empty, a fresh uncompressed snapshot is
+	// taken and streamed without being stored.
+	BackupId      string `protobuf:"bytes,2,opt,name=backup_id,json=backupId,proto3" json:"backup_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckpointRequest) Reset() {
-	*x = CheckpointRequest{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[33]
+func (x *DownloadBackupRequest) Reset() {
+	*x = DownloadBackupRequest{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckpointRequest) String() string {
+func (x *DownloadBackupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckpointRequest) ProtoMessage() {}
+func (*DownloadBackupRequest) ProtoMessage() {}
 
-func (x *CheckpointRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[33]
+func (x *DownloadBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2491,53 +4235,52 @@ func (x *CheckpointRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckpointRequest.ProtoReflect.Descriptor instead.
-func (*CheckpointRequest) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use DownloadBackupRequest.ProtoReflect.Descriptor instead.
+func (*DownloadBackupRequest) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *CheckpointRequest) GetDatabase() string {
+func (x *DownloadBackupRequest) GetDatabase() string {
 	if x != nil {
 		return x.Database
 	}
 	return ""
 }
 
-func (x *CheckpointRequest) GetMode() CheckpointMode {
+func (x *DownloadBackupRequest) GetBackupId() string {
 	if x != nil {
-		return x.Mode
+		return x.BackupId
 	}
-	return CheckpointMode_CHECKPOINT_MODE_UNSPECIFIED
+	return ""
 }
 
 // *
-// CheckpointResponse provides statistics about the WAL synchronization.
-type CheckpointResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Success           bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message           string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	BusyCheckpoints   int64                  `protobuf:"varint,3,opt,name=busy_checkpoints,json=busyCheckpoints,proto3" json:"busy_checkpoints,omitempty"`
-	LogCheckpoints    int64                  `protobuf:"varint,4,opt,name=log_checkpoints,json=logCheckpoints,proto3" json:"log_checkpoints,omitempty"`
-	CheckpointedPages int64                  `protobuf:"varint,5,opt,name=checkpointed_pages,json=checkpointedPages,proto3" json:"checkpointed_pages,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// DownloadBackupResponse carries one chunk of the backup file.
+type DownloadBackupResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Backup metadata, set on the first message only.
+	Backup *BackupInfo `protobuf:"bytes,1,opt,name=backup,proto3" json:"backup,omitempty"`
+	// Next chunk of the file.
+	Chunk         []byte `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckpointResponse) Reset() {
-	*x = CheckpointResponse{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[34]
+func (x *DownloadBackupResponse) Reset() {
+	*x = DownloadBackupResponse{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckpointResponse) String() string {
+func (x *DownloadBackupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckpointResponse) ProtoMessage() {}
+func (*DownloadBackupResponse) ProtoMessage() {}
 
-func (x *CheckpointResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[34]
+func (x *DownloadBackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2548,73 +4291,55 @@ func (x *CheckpointResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckpointResponse.ProtoReflect.Descriptor instead.
-func (*CheckpointResponse) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *CheckpointResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *CheckpointResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *CheckpointResponse) GetBusyCheckpoints() int64 {
-	if x != nil {
-		return x.BusyCheckpoints
-	}
-	return 0
+// Deprecated: Use DownloadBackupResponse.ProtoReflect.Descriptor instead.
+func (*DownloadBackupResponse) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *CheckpointResponse) GetLogCheckpoints() int64 {
+func (x *DownloadBackupResponse) GetBackup() *BackupInfo {
 	if x != nil {
-		return x.LogCheckpoints
+		return x.Backup
 	}
-	return 0
+	return nil
 }
 
-func (x *CheckpointResponse) GetCheckpointedPages() int64 {
+func (x *DownloadBackupResponse) GetChunk() []byte {
 	if x != nil {
-		return x.CheckpointedPages
+		return x.Chunk
 	}
-	return 0
+	return nil
 }
 
 // *
-// IntegrityCheckRequest searches for physical corruption in the database.
-type IntegrityCheckRequest struct {
+// DumpDatabaseRequest selects what DumpDatabase writes.
+type DumpDatabaseRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Tenant target identifier for connection routing.
 	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	// Limits the number of error strings returned.
-	MaxErrors     *int32 `protobuf:"varint,2,opt,name=max_errors,json=maxErrors,proto3,oneof" json:"max_errors,omitempty"`
+	// Tables to dump, with their indexes and triggers. If empty, the whole
+	// schema is dumped, views included.
+	Tables []string `protobuf:"bytes,2,rep,name=tables,proto3" json:"tables,omitempty"`
+	// Writes the CREATE statements only.
+	SchemaOnly    bool `protobuf:"varint,3,opt,name=schema_only,json=schemaOnly,proto3" json:"schema_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IntegrityCheckRequest) Reset() {
-	*x = IntegrityCheckRequest{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[35]
+func (x *DumpDatabaseRequest) Reset() {
+	*x = DumpDatabaseRequest{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IntegrityCheckRequest) String() string {
+func (x *DumpDatabaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IntegrityCheckRequest) ProtoMessage() {}
+func (*DumpDatabaseRequest) ProtoMessage() {}
 
-func (x *IntegrityCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[35]
+func (x *DumpDatabaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2625,52 +4350,57 @@ func (x *IntegrityCheckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IntegrityCheckRequest.ProtoReflect.Descriptor instead.
-func (*IntegrityCheckRequest) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use DumpDatabaseRequest.ProtoReflect.Descriptor instead.
+func (*DumpDatabaseRequest) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *IntegrityCheckRequest) GetDatabase() string {
+func (x *DumpDatabaseRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *DumpDatabaseRequest) GetTables() []string {
 	if x != nil {
-		return x.Database
+		return x.Tables
 	}
-	return ""
+	return nil
 }
 
-func (x *IntegrityCheckRequest) GetMaxErrors() int32 {
-	if x != nil && x.MaxErrors != nil {
-		return *x.MaxErrors
+func (x *DumpDatabaseRequest) GetSchemaOnly() bool {
+	if x != nil {
+		return x.SchemaOnly
 	}
-	return 0
+	return false
 }
 
 // *
-// IntegrityCheckResponse identifies any corruption found.
-type IntegrityCheckResponse struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// List of physical or logical structural errors found.
-	Errors        []string `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+// DumpDatabaseResponse carries the next statements of the script.
+type DumpDatabaseResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One or more complete statements, each ending in ";\n".
+	Sql           string `protobuf:"bytes,1,opt,name=sql,proto3" json:"sql,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IntegrityCheckResponse) Reset() {
-	*x = IntegrityCheckResponse{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[36]
+func (x *DumpDatabaseResponse) Reset() {
+	*x = DumpDatabaseResponse{}
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IntegrityCheckResponse) String() string {
+func (x *DumpDatabaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IntegrityCheckResponse) ProtoMessage() {}
+func (*DumpDatabaseResponse) ProtoMessage() {}
 
-func (x *IntegrityCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[36]
+func (x *DumpDatabaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2681,32 +4411,18 @@ func (x *IntegrityCheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IntegrityCheckResponse.ProtoReflect.Descriptor instead.
-func (*IntegrityCheckResponse) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{36}
-}
-
-func (x *IntegrityCheckResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use DumpDatabaseResponse.ProtoReflect.Descriptor instead.
+func (*DumpDatabaseResponse) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *IntegrityCheckResponse) GetMessage() string {
+func (x *DumpDatabaseResponse) GetSql() string {
 	if x != nil {
-		return x.Message
+		return x.Sql
 	}
 	return ""
 }
 
-func (x *IntegrityCheckResponse) GetErrors() []string {
-	if x != nil {
-		return x.Errors
-	}
-	return nil
-}
-
 // *
 // AttachDatabaseRequest dynamic mounts a secondary tenant database.
 type AttachDatabaseRequest struct {
@@ -2721,7 +4437,7 @@ type AttachDatabaseRequest struct {
 
 func (x *AttachDatabaseRequest) Reset() {
 	*x = AttachDatabaseRequest{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[37]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2733,7 +4449,7 @@ func (x *AttachDatabaseRequest) String() string {
 func (*AttachDatabaseRequest) ProtoMessage() {}
 
 func (x *AttachDatabaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[37]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2746,7 +4462,7 @@ func (x *AttachDatabaseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttachDatabaseRequest.ProtoReflect.Descriptor instead.
 func (*AttachDatabaseRequest) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{37}
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *AttachDatabaseRequest) GetParentDatabase() string {
@@ -2775,7 +4491,7 @@ type AttachDatabaseResponse struct {
 
 func (x *AttachDatabaseResponse) Reset() {
 	*x = AttachDatabaseResponse{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[38]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2787,7 +4503,7 @@ func (x *AttachDatabaseResponse) String() string {
 func (*AttachDatabaseResponse) ProtoMessage() {}
 
 func (x *AttachDatabaseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[38]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2800,7 +4516,7 @@ func (x *AttachDatabaseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttachDatabaseResponse.ProtoReflect.Descriptor instead.
 func (*AttachDatabaseResponse) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{38}
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *AttachDatabaseResponse) GetSuccess() bool {
@@ -2831,7 +4547,7 @@ type DetachDatabaseRequest struct {
 
 func (x *DetachDatabaseRequest) Reset() {
 	*x = DetachDatabaseRequest{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[39]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2843,7 +4559,7 @@ func (x *DetachDatabaseRequest) String() string {
 func (*DetachDatabaseRequest) ProtoMessage() {}
 
 func (x *DetachDatabaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[39]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2856,7 +4572,7 @@ func (x *DetachDatabaseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetachDatabaseRequest.ProtoReflect.Descriptor instead.
 func (*DetachDatabaseRequest) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{39}
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *DetachDatabaseRequest) GetParentDatabase() string {
@@ -2885,7 +4601,7 @@ type DetachDatabaseResponse struct {
 
 func (x *DetachDatabaseResponse) Reset() {
 	*x = DetachDatabaseResponse{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[40]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2897,7 +4613,7 @@ func (x *DetachDatabaseResponse) String() string {
 func (*DetachDatabaseResponse) ProtoMessage() {}
 
 func (x *DetachDatabaseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[40]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2910,7 +4626,7 @@ func (x *DetachDatabaseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetachDatabaseResponse.ProtoReflect.Descriptor instead.
 func (*DetachDatabaseResponse) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{40}
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *DetachDatabaseResponse) GetSuccess() bool {
@@ -2941,7 +4657,7 @@ type BeginRequest struct {
 
 func (x *BeginRequest) Reset() {
 	*x = BeginRequest{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[41]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2953,7 +4669,7 @@ func (x *BeginRequest) String() string {
 func (*BeginRequest) ProtoMessage() {}
 
 func (x *BeginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[41]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2966,7 +4682,7 @@ func (x *BeginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BeginRequest.ProtoReflect.Descriptor instead.
 func (*BeginRequest) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{41}
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *BeginRequest) GetDatabase() string {
@@ -2997,7 +4713,7 @@ type TransactionalQueryRequest struct {
 
 func (x *TransactionalQueryRequest) Reset() {
 	*x = TransactionalQueryRequest{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[42]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3009,7 +4725,7 @@ func (x *TransactionalQueryRequest) String() string {
 func (*TransactionalQueryRequest) ProtoMessage() {}
 
 func (x *TransactionalQueryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[42]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3022,7 +4738,7 @@ func (x *TransactionalQueryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionalQueryRequest.ProtoReflect.Descriptor instead.
 func (*TransactionalQueryRequest) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{42}
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *TransactionalQueryRequest) GetSql() string {
@@ -3053,7 +4769,7 @@ type SavepointRequest struct {
 
 func (x *SavepointRequest) Reset() {
 	*x = SavepointRequest{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[43]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3065,7 +4781,7 @@ func (x *SavepointRequest) String() string {
 func (*SavepointRequest) ProtoMessage() {}
 
 func (x *SavepointRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[43]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3078,7 +4794,7 @@ func (x *SavepointRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SavepointRequest.ProtoReflect.Descriptor instead.
 func (*SavepointRequest) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{43}
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *SavepointRequest) GetName() string {
@@ -3109,7 +4825,7 @@ type BeginResponse struct {
 
 func (x *BeginResponse) Reset() {
 	*x = BeginResponse{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[44]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3121,7 +4837,7 @@ func (x *BeginResponse) String() string {
 func (*BeginResponse) ProtoMessage() {}
 
 func (x *BeginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[44]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3134,7 +4850,7 @@ func (x *BeginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BeginResponse.ProtoReflect.Descriptor instead.
 func (*BeginResponse) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{44}
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *BeginResponse) GetSuccess() bool {
@@ -3163,7 +4879,7 @@ type CommitResponse struct {
 
 func (x *CommitResponse) Reset() {
 	*x = CommitResponse{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[45]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3175,7 +4891,7 @@ func (x *CommitResponse) String() string {
 func (*CommitResponse) ProtoMessage() {}
 
 func (x *CommitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[45]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3188,7 +4904,7 @@ func (x *CommitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommitResponse.ProtoReflect.Descriptor instead.
 func (*CommitResponse) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{45}
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *CommitResponse) GetSuccess() bool {
@@ -3210,7 +4926,7 @@ type RollbackResponse struct {
 
 func (x *RollbackResponse) Reset() {
 	*x = RollbackResponse{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[46]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3222,7 +4938,7 @@ func (x *RollbackResponse) String() string {
 func (*RollbackResponse) ProtoMessage() {}
 
 func (x *RollbackResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[46]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3235,7 +4951,7 @@ func (x *RollbackResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RollbackResponse.ProtoReflect.Descriptor instead.
 func (*RollbackResponse) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{46}
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *RollbackResponse) GetSuccess() bool {
@@ -3260,7 +4976,7 @@ type TypedTransactionalQueryRequest struct {
 
 func (x *TypedTransactionalQueryRequest) Reset() {
 	*x = TypedTransactionalQueryRequest{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[47]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3272,7 +4988,7 @@ func (x *TypedTransactionalQueryRequest) String() string {
 func (*TypedTransactionalQueryRequest) ProtoMessage() {}
 
 func (x *TypedTransactionalQueryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[47]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3285,7 +5001,7 @@ func (x *TypedTransactionalQueryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TypedTransactionalQueryRequest.ProtoReflect.Descriptor instead.
 func (*TypedTransactionalQueryRequest) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{47}
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *TypedTransactionalQueryRequest) GetSql() string {
@@ -3314,7 +5030,7 @@ type ListExtensionsRequest struct {
 
 func (x *ListExtensionsRequest) Reset() {
 	*x = ListExtensionsRequest{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[48]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3326,7 +5042,7 @@ func (x *ListExtensionsRequest) String() string {
 func (*ListExtensionsRequest) ProtoMessage() {}
 
 func (x *ListExtensionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[48]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3339,7 +5055,7 @@ func (x *ListExtensionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListExtensionsRequest.ProtoReflect.Descriptor instead.
 func (*ListExtensionsRequest) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{48}
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *ListExtensionsRequest) GetDatabase() string {
@@ -3361,7 +5077,7 @@ type ListExtensionsResponse struct {
 
 func (x *ListExtensionsResponse) Reset() {
 	*x = ListExtensionsResponse{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[49]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3373,7 +5089,7 @@ func (x *ListExtensionsResponse) String() string {
 func (*ListExtensionsResponse) ProtoMessage() {}
 
 func (x *ListExtensionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[49]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3386,7 +5102,7 @@ func (x *ListExtensionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListExtensionsResponse.ProtoReflect.Descriptor instead.
 func (*ListExtensionsResponse) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{49}
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *ListExtensionsResponse) GetExtensions() []*ExtensionInfo {
@@ -3410,7 +5126,7 @@ type LoadExtensionRequest struct {
 
 func (x *LoadExtensionRequest) Reset() {
 	*x = LoadExtensionRequest{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[50]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3422,7 +5138,7 @@ func (x *LoadExtensionRequest) String() string {
 func (*LoadExtensionRequest) ProtoMessage() {}
 
 func (x *LoadExtensionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[50]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3435,7 +5151,7 @@ func (x *LoadExtensionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoadExtensionRequest.ProtoReflect.Descriptor instead.
 func (*LoadExtensionRequest) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{50}
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *LoadExtensionRequest) GetDatabase() string {
@@ -3464,7 +5180,7 @@ type LoadExtensionResponse struct {
 
 func (x *LoadExtensionResponse) Reset() {
 	*x = LoadExtensionResponse{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[51]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3476,7 +5192,7 @@ func (x *LoadExtensionResponse) String() string {
 func (*LoadExtensionResponse) ProtoMessage() {}
 
 func (x *LoadExtensionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[51]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3489,7 +5205,7 @@ func (x *LoadExtensionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoadExtensionResponse.ProtoReflect.Descriptor instead.
 func (*LoadExtensionResponse) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{51}
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *LoadExtensionResponse) GetSuccess() bool {
@@ -3518,7 +5234,7 @@ type PublishItem struct {
 
 func (x *PublishItem) Reset() {
 	*x = PublishItem{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[52]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3530,7 +5246,7 @@ func (x *PublishItem) String() string {
 func (*PublishItem) ProtoMessage() {}
 
 func (x *PublishItem) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[52]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3543,7 +5259,7 @@ func (x *PublishItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishItem.ProtoReflect.Descriptor instead.
 func (*PublishItem) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{52}
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *PublishItem) GetChannel() string {
@@ -3573,7 +5289,7 @@ type PublishRequest struct {
 
 func (x *PublishRequest) Reset() {
 	*x = PublishRequest{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[53]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3585,7 +5301,7 @@ func (x *PublishRequest) String() string {
 func (*PublishRequest) ProtoMessage() {}
 
 func (x *PublishRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[53]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3598,7 +5314,7 @@ func (x *PublishRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishRequest.ProtoReflect.Descriptor instead.
 func (*PublishRequest) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{53}
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *PublishRequest) GetDatabase() string {
@@ -3634,7 +5350,7 @@ type PublishBatchRequest struct {
 
 func (x *PublishBatchRequest) Reset() {
 	*x = PublishBatchRequest{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[54]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3646,7 +5362,7 @@ func (x *PublishBatchRequest) String() string {
 func (*PublishBatchRequest) ProtoMessage() {}
 
 func (x *PublishBatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[54]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3659,7 +5375,7 @@ func (x *PublishBatchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishBatchRequest.ProtoReflect.Descriptor instead.
 func (*PublishBatchRequest) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{54}
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *PublishBatchRequest) GetDatabase() string {
@@ -3687,7 +5403,7 @@ type PublishResponse struct {
 
 func (x *PublishResponse) Reset() {
 	*x = PublishResponse{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[55]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3699,7 +5415,7 @@ func (x *PublishResponse) String() string {
 func (*PublishResponse) ProtoMessage() {}
 
 func (x *PublishResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[55]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3712,7 +5428,7 @@ func (x *PublishResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishResponse.ProtoReflect.Descriptor instead.
 func (*PublishResponse) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{55}
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *PublishResponse) GetMessageId() int64 {
@@ -3733,7 +5449,7 @@ type PublishBatchResponse struct {
 
 func (x *PublishBatchResponse) Reset() {
 	*x = PublishBatchResponse{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[56]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3745,7 +5461,7 @@ func (x *PublishBatchResponse) String() string {
 func (*PublishBatchResponse) ProtoMessage() {}
 
 func (x *PublishBatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[56]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3758,7 +5474,7 @@ func (x *PublishBatchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishBatchResponse.ProtoReflect.Descriptor instead.
 func (*PublishBatchResponse) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{56}
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *PublishBatchResponse) GetMessageIds() []int64 {
@@ -3781,7 +5497,7 @@ type SubscribeRequest struct {
 
 func (x *SubscribeRequest) Reset() {
 	*x = SubscribeRequest{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[57]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3793,7 +5509,7 @@ func (x *SubscribeRequest) String() string {
 func (*SubscribeRequest) ProtoMessage() {}
 
 func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[57]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3806,7 +5522,7 @@ func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeRequest) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{57}
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *SubscribeRequest) GetDatabase() string {
@@ -3833,19 +5549,22 @@ func (x *SubscribeRequest) GetSubscriptionName() string {
 // *
 // SubscribeResponse delivers a message to a subscriber.
 type SubscribeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Database      string                 `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	Channel       string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
-	Payload       string                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
-	MessageId     int64                  `protobuf:"varint,4,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Database  string                 `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Channel   string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Payload   string                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	MessageId int64                  `protobuf:"varint,4,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// W3C traceparent of the server's delivery span, continuing the publisher's
+	// trace. Empty when the message was published without trace context.
+	Traceparent   string `protobuf:"bytes,6,opt,name=traceparent,proto3" json:"traceparent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SubscribeResponse) Reset() {
 	*x = SubscribeResponse{}
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[58]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3857,7 +5576,7 @@ func (x *SubscribeResponse) String() string {
 func (*SubscribeResponse) ProtoMessage() {}
 
 func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[58]
+	mi := &file_sqlrpc_v1_db_service_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3870,7 +5589,7 @@ func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeResponse.ProtoReflect.Descriptor instead.
 func (*SubscribeResponse) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{58}
+	return file_sqlrpc_v1_db_service_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *SubscribeResponse) GetDatabase() string {
@@ -3908,6 +5627,13 @@ func (x *SubscribeResponse) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *SubscribeResponse) GetTraceparent() string {
+	if x != nil {
+		return x.Traceparent
+	}
+	return ""
+}
+
 var File_sqlrpc_v1_db_service_proto protoreflect.FileDescriptor
 
 var file_sqlrpc_v1_db_service_proto_rawDesc = []byte{
@@ -3925,8 +5651,8 @@ var file_sqlrpc_v1_db_service_proto_rawDesc = []byte{
 	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x15, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x75, 0x6d,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2f,
-	0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9c,
-	0x01, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa3,
+	0x02, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x37, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b,
 	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08,
@@ -3935,224 +5661,17 @@ var file_sqlrpc_v1_db_service_proto_rawDesc = []byte{
 	0x50, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65,
 	0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x71, 0x6c,
 	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
-	0x73, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0xc7, 0x02,
-	0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x46, 0x0a, 0x11, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x5f, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x10, 0x63,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
-	0x4b, 0x0a, 0x15, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72,
-	0x65, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x17,
-	0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x61,
-	0x72, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x13, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44,
-	0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10,
-	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x61,
-	0x77, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x67, 0x0a, 0x0c, 0x45, 0x78, 0x65, 0x63, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x03, 0x64, 0x6d, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x4d, 0x4c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x03, 0x64, 0x6d, 0x6c, 0x12,
-	0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
-	0x22, 0x87, 0x01, 0x0a, 0x09, 0x44, 0x4d, 0x4c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x23,
-	0x0a, 0x0d, 0x72, 0x6f, 0x77, 0x73, 0x5f, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x41, 0x66, 0x66, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x65,
-	0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73,
-	0x74, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70,
-	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x17, 0x42,
-	0x65, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10,
-	0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
-	0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12,
-	0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x12, 0x3c, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x4d, 0x6f,
-	0x64, 0x65, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x6f,
-	0x64, 0x65, 0x22, 0x7c, 0x0a, 0x18, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25,
-	0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
-	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
-	0x22, 0xa1, 0x01, 0x0a, 0x17, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0e,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01,
-	0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
-	0x1c, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48,
-	0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x50, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x35, 0x0a,
-	0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65,
-	0x74, 0x65, 0x72, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x1b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48,
-	0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x09, 0x73, 0x61, 0x76, 0x65, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x71, 0x6c,
-	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
-	0x09, 0x73, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x75, 0x0a, 0x11, 0x53, 0x61,
-	0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a,
-	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
-	0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x4e, 0x0a, 0x19, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31,
-	0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18,
-	0x80, 0x01, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x22, 0x36, 0x0a, 0x1a, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xff, 0x02, 0x0a, 0x19, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0xe1, 0x02, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x71, 0x6c,
-	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0xa5, 0x02, 0xba, 0x48, 0xa1, 0x02,
-	0xba, 0x01, 0x73, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
-	0x41, 0x54, 0x68, 0x65, 0x20, 0x66, 0x69, 0x72, 0x73, 0x74, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x6e, 0x20, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x27, 0x62, 0x65, 0x67, 0x69,
-	0x6e, 0x27, 0x1a, 0x12, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x5b, 0x30, 0x5d, 0x2e,
-	0x62, 0x65, 0x67, 0x69, 0x6e, 0x29, 0xba, 0x01, 0xa0, 0x01, 0x0a, 0x18, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
-	0x5f, 0x65, 0x6e, 0x64, 0x12, 0x3a, 0x54, 0x68, 0x65, 0x20, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x6c, 0x79,
-	0x20, 0x65, 0x6e, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x27, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x27, 0x20, 0x6f, 0x72, 0x20, 0x27, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x27,
-	0x1a, 0x48, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x5b, 0x74, 0x68, 0x69, 0x73, 0x2e,
-	0x73, 0x69, 0x7a, 0x65, 0x28, 0x29, 0x20, 0x2d, 0x20, 0x31, 0x5d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x29, 0x20, 0x7c, 0x7c, 0x20, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x5b,
-	0x74, 0x68, 0x69, 0x73, 0x2e, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x29, 0x20, 0x2d, 0x20, 0x31, 0x5d,
-	0x2e, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x29, 0x92, 0x01, 0x04, 0x08, 0x02, 0x10,
-	0x64, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x5a, 0x0a, 0x1a, 0x45,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73,
-	0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x11, 0x54, 0x79, 0x70, 0x65,
-	0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a,
-	0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d,
-	0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x64, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x50, 0x52,
-	0x03, 0x73, 0x71, 0x6c, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
-	0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70,
-	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
-	0x74, 0x65, 0x72, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
-	0x22, 0xab, 0x01, 0x0a, 0x1c, 0x54, 0x79, 0x70, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x31, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05,
-	0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x50, 0x52, 0x03, 0x73,
-	0x71, 0x6c, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
-	0x72, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0xc3,
-	0x02, 0x0a, 0x10, 0x54, 0x79, 0x70, 0x65, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x46, 0x0a,
-	0x11, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70,
-	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x66, 0x66, 0x69, 0x6e,
-	0x69, 0x74, 0x79, 0x52, 0x10, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x66, 0x66, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x15, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f,
-	0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x13, 0x63,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x54, 0x79, 0x70,
-	0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x72, 0x61, 0x77,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x61, 0x77, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x04,
-	0x72, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x71, 0x6c,
-	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x71, 0x6c, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72,
-	0x6f, 0x77, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x73, 0x22, 0xf5, 0x01, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x36,
-	0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52,
-	0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x36, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
-	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70,
-	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
-	0x74, 0x65, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x30,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x84, 0x02, 0x0a,
-	0x12, 0x54, 0x79, 0x70, 0x65, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
-	0x54, 0x79, 0x70, 0x65, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x12, 0x3b, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x23, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65,
-	0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x77, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x36, 0x0a,
-	0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6d,
-	0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0xec, 0x01, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x73, 0x12, 0x46, 0x0a, 0x11, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x61, 0x66,
-	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19,
-	0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x10, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x15, 0x63,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x71, 0x6c,
-	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x13, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x65, 0x63, 0x6c, 0x61,
-	0x72, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x61, 0x77, 0x54, 0x79, 0x70,
-	0x65, 0x73, 0x22, 0x45, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x52, 0x6f, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2e, 0x0a, 0x04, 0x72, 0x6f, 0x77,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0xf1, 0x01, 0x0a, 0x16, 0x54, 0x79,
-	0x70, 0x65, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18,
+	0x73, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3a, 0x0a,
+	0x15, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48,
+	0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba,
+	0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x02, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xc7, 0x02, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18,
 	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x46,
 	0x0a, 0x11, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74,
 	0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x71, 0x6c, 0x72,
@@ -4165,514 +5684,1047 @@ var file_sqlrpc_v1_db_service_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x54, 0x79,
 	0x70, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x72, 0x61,
 	0x77, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x61, 0x77, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x41, 0x0a,
-	0x18, 0x54, 0x79, 0x70, 0x65, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x52, 0x6f, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x6f, 0x77,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x71, 0x6c, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73,
-	0x22, 0x40, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
-	0x65, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x0d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x71, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x71, 0x6c, 0x12, 0x41, 0x0a,
-	0x11, 0x73, 0x71, 0x6c, 0x69, 0x74, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70,
-	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x71, 0x6c, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52,
-	0x0f, 0x73, 0x71, 0x6c, 0x69, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
-	0x22, 0xb6, 0x05, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x76, 0x31, 0x2e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
-	0x00, 0x52, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x3c, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63,
-	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
-	0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
-	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x49, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73,
-	0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x12, 0x4c, 0x0a, 0x0b, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
-	0x59, 0x0a, 0x12, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x71,
-	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x10, 0x74, 0x79, 0x70, 0x65, 0x64, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x3a, 0x0a, 0x04, 0x65, 0x78,
-	0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70,
-	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x61, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
-	0x52, 0x04, 0x65, 0x78, 0x65, 0x63, 0x12, 0x4a, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f,
-	0x65, 0x78, 0x65, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x71, 0x6c,
-	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x09, 0x74, 0x79, 0x70, 0x65, 0x64, 0x45, 0x78,
-	0x65, 0x63, 0x12, 0x3b, 0x0a, 0x09, 0x73, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x48, 0x00, 0x52, 0x09, 0x73, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
-	0x30, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x08, 0x72,
-	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x42, 0x10, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x12, 0x05, 0xba, 0x48, 0x02, 0x08, 0x01, 0x22, 0x8b, 0x05, 0x0a, 0x13, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x30, 0x0a, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x65, 0x67,
-	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x05, 0x62, 0x65,
-	0x67, 0x69, 0x6e, 0x12, 0x3b, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x71, 0x6c, 0x72,
-	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x48, 0x00, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x3f, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x12, 0x4b, 0x0a, 0x12, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x10, 0x74, 0x79,
-	0x70, 0x65, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4f,
-	0x0a, 0x13, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x71,
-	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x11, 0x74, 0x79,
-	0x70, 0x65, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
-	0x3c, 0x0a, 0x09, 0x73, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x48, 0x00, 0x52, 0x09, 0x73, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x33, 0x0a,
-	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x3a, 0x0a,
-	0x0b, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x65,
-	0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70,
-	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6c, 0x61,
-	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x6e, 0x6f,
-	0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x71, 0x6c, 0x72,
-	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6c, 0x61, 0x6e, 0x4e,
-	0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x4c, 0x0a, 0x11, 0x4c, 0x69,
-	0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x37, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b,
-	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08,
-	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0x35, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f,
-	0x0a, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22,
-	0x7b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72,
-	0x16, 0x10, 0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30,
-	0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x12, 0x29, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80,
-	0x02, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x53, 0x0a, 0x18,
-	0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x61, 0x77, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x2e, 0x0a,
+	0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x2f, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
+	0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x67,
+	0x0a, 0x0c, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26,
+	0x0a, 0x03, 0x64, 0x6d, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x71,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x4d, 0x4c, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x03, 0x64, 0x6d, 0x6c, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x09, 0x44, 0x4d, 0x4c, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x6f, 0x77, 0x73, 0x5f, 0x61, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x6f,
+	0x77, 0x73, 0x41, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x49, 0x64,
+	0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x22, 0xc5, 0x01, 0x0a, 0x17, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a,
+	0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d,
+	0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x3c, 0x0a, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x73, 0x71, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x7c, 0x0a, 0x18, 0x42, 0x65, 0x67,
+	0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x17, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07,
+	0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x50, 0x52,
+	0x03, 0x73, 0x71, 0x6c, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x1b,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x76, 0x65, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0e, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52,
+	0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x41,
+	0x0a, 0x09, 0x73, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61,
+	0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x06,
+	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x09, 0x73, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x22, 0x75, 0x0a, 0x11, 0x53, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4e, 0x0a, 0x19, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba,
+	0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x36, 0x0a, 0x1a, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x22, 0xff, 0x02, 0x0a, 0x19, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0xe1,
+	0x02, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x42, 0xa5, 0x02, 0xba, 0x48, 0xa1, 0x02, 0xba, 0x01, 0x73, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x41, 0x54, 0x68, 0x65, 0x20, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x6e, 0x20,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x20, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62,
+	0x65, 0x20, 0x27, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x27, 0x1a, 0x12, 0x68, 0x61, 0x73, 0x28, 0x74,
+	0x68, 0x69, 0x73, 0x5b, 0x30, 0x5d, 0x2e, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x29, 0xba, 0x01, 0xa0,
+	0x01, 0x0a, 0x18, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x12, 0x3a, 0x54, 0x68, 0x65,
+	0x20, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x65, 0x78, 0x70,
+	0x6c, 0x69, 0x63, 0x69, 0x74, 0x6c, 0x79, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68,
+	0x20, 0x27, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x27, 0x20, 0x6f, 0x72, 0x20, 0x27, 0x72, 0x6f,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x27, 0x1a, 0x48, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69,
+	0x73, 0x5b, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x29, 0x20, 0x2d, 0x20,
+	0x31, 0x5d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x29, 0x20, 0x7c, 0x7c, 0x20, 0x68, 0x61,
+	0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x5b, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x73, 0x69, 0x7a, 0x65,
+	0x28, 0x29, 0x20, 0x2d, 0x20, 0x31, 0x5d, 0x2e, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x29, 0x92, 0x01, 0x04, 0x08, 0x02, 0x10, 0x64, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x22, 0x5a, 0x0a, 0x1a, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0xf0,
+	0x01, 0x0a, 0x10, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x03, 0x18, 0x40,
+	0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d,
+	0x2b, 0x24, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x03,
+	0x73, 0x71, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05,
+	0x10, 0x01, 0x18, 0x80, 0x50, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x49, 0x0a, 0x0e, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x92, 0x01,
+	0x05, 0x08, 0x01, 0x10, 0x90, 0x4e, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x53, 0x65, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x12, 0x6d,
+	0x61, 0x78, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x4d,
+	0x73, 0x22, 0x74, 0x0a, 0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x4d, 0x4c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x6e,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a,
+	0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d,
+	0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x50, 0x52,
+	0x03, 0x73, 0x71, 0x6c, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x22, 0xa2, 0x01, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x62, 0x0a, 0x12, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x09, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
+	0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x08, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x77, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0xba, 0x48, 0x05, 0x2a, 0x03, 0x18, 0x90, 0x4e,
+	0x52, 0x07, 0x6d, 0x61, 0x78, 0x52, 0x6f, 0x77, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x13, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x77,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x3d, 0x0a, 0x12,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x27, 0x0a, 0x09, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80,
+	0x01, 0x52, 0x08, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xe2, 0x01, 0x0a, 0x11, 0x54, 0x79, 0x70, 0x65, 0x64, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61,
 	0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72,
 	0x16, 0x10, 0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30,
 	0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x22, 0x78, 0x0a, 0x0d, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01,
+	0x65, 0x12, 0x1c, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
+	0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x50, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12,
+	0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x79, 0x70, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x15, 0x6d,
+	0x61, 0x78, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a,
+	0x02, 0x28, 0x00, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x2f, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x6f, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73,
+	0x42, 0x10, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x05, 0xba, 0x48, 0x02,
+	0x08, 0x01, 0x22, 0x8d, 0x05, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x03, 0x18, 0x40, 0x32,
 	0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b,
-	0x24, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x09, 0x69,
-	0x6e, 0x74, 0x6f, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x08, 0x69, 0x6e, 0x74, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a,
-	0x0a, 0x5f, 0x69, 0x6e, 0x74, 0x6f, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x44, 0x0a, 0x0e, 0x56,
-	0x61, 0x63, 0x75, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x85, 0x01, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x24, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72,
+	0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x3b, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
+	0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01,
+	0x20, 0x00, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x92,
+	0x01, 0x03, 0x10, 0xd0, 0x0f, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x67, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x2e, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x42, 0x15, 0xba, 0x48, 0x12, 0x9a, 0x01, 0x0f, 0x10, 0xd0, 0x0f, 0x22, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x2a, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x73, 0x76, 0x5f, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x73, 0x76, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x0d, 0x63, 0x73, 0x76, 0x5f, 0x64, 0x65, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48,
+	0x04, 0x72, 0x02, 0x18, 0x01, 0x52, 0x0c, 0x63, 0x73, 0x76, 0x44, 0x65, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x71,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01,
+	0x52, 0x0a, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x2a, 0x0a, 0x0a,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x0b, 0xba, 0x48, 0x08, 0x1a, 0x06, 0x18, 0xa0, 0x8d, 0x06, 0x28, 0x00, 0x52, 0x09, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x36, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00, 0x52,
+	0x0f, 0x6d, 0x61, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73,
+	0x1a, 0x40, 0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x37, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x71, 0x6c, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x42, 0x0a, 0x0e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x9a, 0x03, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x6f, 0x77, 0x73, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x6f, 0x77, 0x73, 0x5f,
+	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x72, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x6f, 0x77, 0x73, 0x5f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x72, 0x6f, 0x77, 0x73, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x12,
+	0x31, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x73, 0x12, 0x4b, 0x0a, 0x15, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x64, 0x65, 0x63, 0x6c,
+	0x61, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63,
+	0x6c, 0x61, 0x72, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x13, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x44, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x2b,
+	0x0a, 0x11, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x71, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xfa, 0x01, 0x0a,
+	0x15, 0x54, 0x79, 0x70, 0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x78, 0x65, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10,
+	0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
+	0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12,
+	0x1c, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x50, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x4e, 0x0a,
+	0x0e, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x92, 0x01, 0x05, 0x08, 0x01, 0x10, 0x90, 0x4e, 0x52, 0x0d,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x53, 0x65, 0x74, 0x73, 0x12, 0x3a, 0x0a,
+	0x15, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48,
+	0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x1c, 0x54, 0x79,
+	0x70, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0e, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x0d,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a,
+	0x03, 0x73, 0x71, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72,
+	0x05, 0x10, 0x01, 0x18, 0x80, 0x50, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x3a, 0x0a, 0x0a, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65,
+	0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0xc3, 0x02, 0x0a, 0x10, 0x54, 0x79, 0x70, 0x65,
+	0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x46, 0x0a, 0x11, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x5f, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x10, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x4b,
+	0x0a, 0x15, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65,
+	0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x17, 0x2e,
+	0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x61, 0x72,
+	0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x13, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x65,
+	0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x61, 0x77,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x71, 0x6c, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x2f, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x71,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xf5, 0x01,
+	0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x36, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x6f,
+	0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x36, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x00, 0x52, 0x08, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x84, 0x02, 0x0a, 0x12, 0x54, 0x79, 0x70, 0x65, 0x64, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73,
+	0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48,
+	0x00, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x05, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52,
+	0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x36, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x30,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xec, 0x01, 0x0a,
+	0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x46, 0x0a, 0x11,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x79, 0x52, 0x10, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x15, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x64,
+	0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x13, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x72, 0x61, 0x77, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x52, 0x61, 0x77, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x13, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x77, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x2e, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x72, 0x6f,
+	0x77, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xf1, 0x01, 0x0a, 0x16, 0x54, 0x79, 0x70, 0x65, 0x64, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x46, 0x0a, 0x11, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79,
+	0x52, 0x10, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x4b, 0x0a, 0x15, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x64, 0x65, 0x63,
+	0x6c, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x13, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x44, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x28, 0x0a, 0x10, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x52, 0x61, 0x77, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x41, 0x0a, 0x18, 0x54, 0x79, 0x70,
+	0x65, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x77,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x71, 0x6c, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x40, 0x0a, 0x0d,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x2f, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
+	0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xc1,
+	0x01, 0x0a, 0x0d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x71, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x71, 0x6c, 0x12, 0x41, 0x0a, 0x11, 0x73, 0x71, 0x6c,
+	0x69, 0x74, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x71, 0x6c, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0f, 0x73, 0x71, 0x6c,
+	0x69, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0b,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x00, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x88,
+	0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x22, 0xb6, 0x05, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x62, 0x65, 0x67,
+	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x3c, 0x0a, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x71, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x49, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x12, 0x4c, 0x0a, 0x0b, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x64, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x59, 0x0a, 0x12, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x10, 0x74, 0x79, 0x70, 0x65,
+	0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x3a, 0x0a, 0x04,
+	0x65, 0x78, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x71, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x04, 0x65, 0x78, 0x65, 0x63, 0x12, 0x4a, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65,
+	0x64, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73,
+	0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x09, 0x74, 0x79, 0x70, 0x65, 0x64,
+	0x45, 0x78, 0x65, 0x63, 0x12, 0x3b, 0x0a, 0x09, 0x73, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x09, 0x73, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x30, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52,
+	0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x42, 0x10, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x05, 0xba, 0x48, 0x02, 0x08, 0x01, 0x22, 0x8b, 0x05, 0x0a, 0x13,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x05,
+	0x62, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x3b, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x71,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x3f, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x71, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x4b, 0x0a, 0x12, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65,
+	0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x10,
+	0x74, 0x79, 0x70, 0x65, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x4f, 0x0a, 0x13, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x11,
+	0x74, 0x79, 0x70, 0x65, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x3c, 0x0a, 0x09, 0x73, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x09, 0x73, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x33, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12,
+	0x3a, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x0a, 0x65, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x71, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x0a, 0x0f, 0x45, 0x78, 0x70,
+	0x6c, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05,
+	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x71,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6c, 0x61,
+	0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x4c, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x03, 0x18, 0x40, 0x32, 0x10,
+	0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24,
+	0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0x35, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x22, 0x7b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48,
+	0x18, 0x72, 0x16, 0x10, 0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01,
+	0x18, 0x80, 0x02, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x53,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48,
+	0x18, 0x72, 0x16, 0x10, 0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x22, 0x78, 0x0a, 0x0d, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x03, 0x18,
+	0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d,
+	0x5d, 0x2b, 0x24, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x20, 0x0a,
+	0x09, 0x69, 0x6e, 0x74, 0x6f, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x6e, 0x74, 0x6f, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x44, 0x0a,
+	0x0e, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18,
+	0x72, 0x16, 0x10, 0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
+	0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x08, 0xba, 0x48, 0x05,
+	0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x12,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x75, 0x73, 0x79, 0x5f, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x62, 0x75, 0x73, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x67, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x6f, 0x67, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x65, 0x64, 0x50, 0x61, 0x67, 0x65, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x15, 0x49, 0x6e,
+	0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x03, 0x18, 0x40,
+	0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d,
+	0x2b, 0x24, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0a,
+	0x6d, 0x61, 0x78, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x00, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x88, 0x01, 0x01,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22,
+	0x64, 0x0a, 0x16, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xf7, 0x01, 0x0a, 0x0a, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69,
+	0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x73,
+	0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x43,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35,
+	0x36, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x22,
+	0x9a, 0x01, 0x0a, 0x15, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18,
+	0x72, 0x16, 0x10, 0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
+	0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x47, 0x0a, 0x16,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x62,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x22, 0x4d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x64,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba,
+	0x48, 0x18, 0x72, 0x16, 0x10, 0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
+	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x62,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
+	0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x22, 0x89, 0x01, 0x0a,
+	0x16, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62,
 	0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16,
 	0x10, 0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d,
 	0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
-	0x12, 0x37, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
-	0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01,
-	0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x12, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x75, 0x73, 0x79, 0x5f, 0x63, 0x68, 0x65,
-	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
-	0x62, 0x75, 0x73, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
-	0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x67, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x6f, 0x67, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x65, 0x64, 0x50, 0x61, 0x67, 0x65, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x15, 0x49, 0x6e, 0x74, 0x65,
-	0x67, 0x72, 0x69, 0x74, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x03, 0x18, 0x40, 0x32, 0x10,
-	0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24,
-	0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x61,
-	0x78, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00,
-	0x52, 0x09, 0x6d, 0x61, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0d,
-	0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x64, 0x0a,
-	0x16, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52,
+	0x12, 0x36, 0x0a, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x19, 0xba, 0x48, 0x16, 0x72, 0x14, 0x10, 0x01, 0x18, 0x40, 0x32, 0x0e,
+	0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x24, 0x52, 0x08,
+	0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x49, 0x64, 0x22, 0x7c, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06,
+	0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x22, 0x86, 0x01, 0x0a, 0x15, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x37, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x03, 0x18, 0x40, 0x32, 0x10, 0x5e,
+	0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52,
+	0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x62, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xba, 0x48,
+	0x14, 0x72, 0x12, 0x18, 0x40, 0x32, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30,
+	0x2d, 0x39, 0x5d, 0x2a, 0x24, 0x52, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x49, 0x64, 0x22,
+	0x5d, 0x0a, 0x16, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x62, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x71, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x92,
+	0x01, 0x0a, 0x13, 0x44, 0x75, 0x6d, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10,
+	0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
+	0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12,
+	0x21, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x09, 0xba, 0x48, 0x06, 0x92, 0x01, 0x03, 0x10, 0xe8, 0x07, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6f, 0x6e, 0x6c,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4f,
+	0x6e, 0x6c, 0x79, 0x22, 0x28, 0x0a, 0x14, 0x44, 0x75, 0x6d, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x71, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x22, 0x9c, 0x01,
+	0x0a, 0x15, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61,
+	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x0e, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x3d, 0x0a,
+	0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01,
+	0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x4c, 0x0a, 0x16,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7e, 0x0a, 0x15, 0x44, 0x65,
+	0x74, 0x61, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48,
+	0x18, 0x72, 0x16, 0x10, 0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10,
+	0x01, 0x18, 0x40, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x4c, 0x0a, 0x16, 0x44, 0x65,
+	0x74, 0x61, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x0c, 0x42, 0x65, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18,
+	0x72, 0x16, 0x10, 0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
+	0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x12, 0x3c, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1e, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x4d, 0x6f, 0x64, 0x65,
+	0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x22, 0x70, 0x0a, 0x19, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x03, 0x73, 0x71, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72,
+	0x05, 0x10, 0x01, 0x18, 0x80, 0x50, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x35, 0x0a, 0x0a, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x10, 0x53, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x01, 0x18, 0x40,
+	0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d,
+	0x2b, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5a, 0x0a, 0x0d, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x2f, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03,
+	0xb0, 0x01, 0x01, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x22, 0x2a, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x2c,
+	0x0a, 0x10, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x7a, 0x0a, 0x1e,
+	0x54, 0x79, 0x70, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07,
+	0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x50, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x3a, 0x0a, 0x0a,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0a, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x45, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x88,
+	0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x22,
+	0x52, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x79, 0x0a, 0x14, 0x4c, 0x6f, 0x61, 0x64, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x64,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba,
+	0x48, 0x18, 0x72, 0x16, 0x10, 0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
+	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4b,
+	0x0a, 0x15, 0x4c, 0x6f, 0x61, 0x64, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
 	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
 	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x15, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x44, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a,
-	0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x03, 0x18,
-	0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d,
-	0x5d, 0x2b, 0x24, 0x52, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62,
-	0x61, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x06,
-	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
-	0x6e, 0x74, 0x22, 0x4c, 0x0a, 0x16, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x7e, 0x0a, 0x15, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x0f, 0x70, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x66, 0x0a, 0x0b, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xba, 0x48, 0x17,
+	0x72, 0x15, 0x10, 0x01, 0x18, 0x40, 0x32, 0x0f, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
+	0x30, 0x2d, 0x39, 0x5f, 0x5d, 0x2b, 0x24, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x12, 0x21, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x22, 0xa2, 0x01, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10,
+	0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
+	0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12,
+	0x34, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1a, 0xba, 0x48, 0x17, 0x72, 0x15, 0x10, 0x01, 0x18, 0x40, 0x32, 0x0f, 0x5e, 0x5b, 0x61,
+	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x5d, 0x2b, 0x24, 0x52, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x21, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x13, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x37, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x03, 0x18, 0x40, 0x32, 0x10, 0x5e,
 	0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52,
-	0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12,
-	0x1f, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
-	0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73,
-	0x22, 0x4c, 0x0a, 0x16, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x85,
-	0x01, 0x0a, 0x0c, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x37, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b,
-	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08,
-	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f,
-	0x63, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01,
-	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x70, 0x0a, 0x19, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x50, 0x52, 0x03, 0x73, 0x71,
-	0x6c, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0a, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x10, 0x53, 0x61, 0x76,
-	0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18,
-	0x72, 0x16, 0x10, 0x01, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
-	0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c,
-	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a,
-	0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82,
-	0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5a, 0x0a, 0x0d,
-	0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x2a, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x22, 0x2c, 0x0a, 0x10, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x22, 0x7a, 0x0a, 0x1e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x50, 0x52, 0x03, 0x73,
-	0x71, 0x6c, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
-	0x72, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x45,
-	0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62,
-	0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x64, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0x52, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x74,
-	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x38, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x65,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x79, 0x0a, 0x14, 0x4c, 0x6f, 0x61,
-	0x64, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x49, 0x74, 0x65, 0x6d,
+	0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x22, 0x30, 0x0a, 0x0f, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x14, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x73, 0x22, 0xcb, 0x01, 0x0a,
+	0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x03, 0x18, 0x40, 0x32, 0x10,
 	0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24,
-	0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0b, 0x66, 0x6f,
-	0x6c, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4b, 0x0a, 0x15, 0x4c, 0x6f, 0x61, 0x64, 0x45, 0x78, 0x74, 0x65,
-	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x66, 0x0a, 0x0b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x49, 0x74, 0x65, 0x6d,
-	0x12, 0x34, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x1a, 0xba, 0x48, 0x17, 0x72, 0x15, 0x10, 0x01, 0x18, 0x40, 0x32, 0x0f, 0x5e, 0x5b,
-	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x5d, 0x2b, 0x24, 0x52, 0x07, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x21, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01,
-	0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xa2, 0x01, 0x0a, 0x0e, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08,
-	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b,
-	0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
-	0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x64, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xba, 0x48, 0x17, 0x72, 0x15, 0x10, 0x01, 0x18,
-	0x40, 0x32, 0x0f, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x5d,
-	0x2b, 0x24, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x21, 0x0a, 0x07, 0x70,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48,
-	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x86,
-	0x01, 0x0a, 0x13, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10,
-	0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
-	0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12,
-	0x36, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01,
-	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x30, 0x0a, 0x0f, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x14, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x73, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49,
-	0x64, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62,
-	0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16,
-	0x10, 0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d,
-	0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
-	0x12, 0x34, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x1a, 0xba, 0x48, 0x17, 0x72, 0x15, 0x10, 0x01, 0x18, 0x40, 0x32, 0x0f, 0x5e, 0x5b,
-	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x5d, 0x2b, 0x24, 0x52, 0x07, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x48, 0x0a, 0x11, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x01, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b,
-	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x10,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0xbd, 0x01, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x2a, 0x8d, 0x01, 0x0a, 0x0f, 0x53, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x41, 0x56, 0x45, 0x50, 0x4f, 0x49, 0x4e,
-	0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x41, 0x56, 0x45, 0x50, 0x4f,
-	0x49, 0x4e, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54,
-	0x45, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x41, 0x56, 0x45, 0x50, 0x4f, 0x49, 0x4e, 0x54,
-	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x10,
-	0x02, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x41, 0x56, 0x45, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x41,
-	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x03,
-	0x32, 0x88, 0x15, 0x0a, 0x0f, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x2e,
-	0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x38,
-	0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x17, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x17, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x17, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x50, 0x0a, 0x0b,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x73, 0x71,
+	0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xba, 0x48, 0x17,
+	0x72, 0x15, 0x10, 0x01, 0x18, 0x40, 0x32, 0x0f, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
+	0x30, 0x2d, 0x39, 0x5f, 0x5d, 0x2b, 0x24, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x12, 0x48, 0x0a, 0x11, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18,
+	0x72, 0x16, 0x10, 0x01, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
+	0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x10, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xdf, 0x01, 0x0a, 0x11, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x2a, 0x8d, 0x01, 0x0a,
+	0x0f, 0x53, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x20, 0x0a, 0x1c, 0x53, 0x41, 0x56, 0x45, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x41, 0x56, 0x45, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12,
+	0x1c, 0x0a, 0x18, 0x53, 0x41, 0x56, 0x45, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x10, 0x02, 0x12, 0x1d, 0x0a,
+	0x19, 0x53, 0x41, 0x56, 0x45, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x03, 0x32, 0xf5, 0x1b, 0x0a,
+	0x0f, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x38, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x2e, 0x73, 0x71, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x45, 0x78,
+	0x65, 0x63, 0x12, 0x17, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x71,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x12, 0x17, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73,
+	0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x50, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x5b, 0x0a, 0x10, 0x42, 0x65,
+	0x67, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22,
+	0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x65, 0x67, 0x69, 0x6e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x65, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x22, 0x2e, 0x73, 0x71,
 	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x71, 0x6c,
+	0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4e, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x12, 0x22, 0x2e, 0x73, 0x71, 0x6c,
 	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x5b,
-	0x0a, 0x10, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x42,
-	0x65, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x76, 0x31, 0x2e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x10, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
-	0x22, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4e, 0x0a, 0x0f, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x12, 0x22,
-	0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x16, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x22, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x71, 0x6c, 0x72,
-	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x5c, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x26, 0x2e,
-	0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61,
+	0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x12, 0x22, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30,
+	0x01, 0x12, 0x5c, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x26, 0x2e, 0x73, 0x71, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x61, 0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61,
+	0x76, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x60, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x71, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x62, 0x0a, 0x13, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x54, 0x72, 0x61,
 	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70,
 	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
 	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
 	0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
 	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x13, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63,
-	0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x73,
-	0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x12, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x24, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a,
-	0x54, 0x79, 0x70, 0x65, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1c, 0x2e, 0x73, 0x71, 0x6c,
-	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70,
-	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x42, 0x0a, 0x09, 0x54, 0x79, 0x70, 0x65, 0x64, 0x45, 0x78,
-	0x65, 0x63, 0x12, 0x1c, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x79, 0x70, 0x65, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x17, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65,
-	0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x10, 0x54, 0x79, 0x70,
-	0x65, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1c, 0x2e,
-	0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x71,
-	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x5d, 0x0a, 0x15,
-	0x54, 0x79, 0x70, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x27, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x1c, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4c, 0x0a, 0x0b, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+	0x1d, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63,
+	0x68, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c,
+	0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1d, 0x2e,
+	0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73,
+	0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x12,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x46, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x78, 0x65, 0x63, 0x12, 0x1b, 0x2e, 0x73,
+	0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x78,
+	0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x71, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x78, 0x65, 0x63, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x18, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x71,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x47, 0x0a, 0x0a, 0x54, 0x79, 0x70, 0x65,
+	0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1c, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x42, 0x0a, 0x09, 0x54, 0x79, 0x70, 0x65, 0x64, 0x45, 0x78, 0x65, 0x63, 0x12, 0x1c,
 	0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x58, 0x0a, 0x14, 0x54,
-	0x79, 0x70, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45,
-	0x78, 0x65, 0x63, 0x12, 0x27, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
-	0x54, 0x79, 0x70, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
 	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73,
 	0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x1b, 0x54, 0x79, 0x70, 0x65, 0x64, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x12, 0x27, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
-	0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x3e,
-	0x0a, 0x07, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x12, 0x17, 0x2e, 0x73, 0x71, 0x6c, 0x72,
-	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48,
-	0x0a, 0x0c, 0x54, 0x79, 0x70, 0x65, 0x64, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x12, 0x1c,
-	0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73,
-	0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x20, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63,
-	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12,
-	0x53, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x12, 0x23, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x71, 0x6c, 0x72,
-	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x12, 0x3d, 0x0a, 0x06, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x12, 0x18,
-	0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x63, 0x75, 0x75,
-	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70,
-	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x12, 0x1c, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1d, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55,
-	0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x12, 0x20, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74,
-	0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49,
-	0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x44,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x20, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x45, 0x78, 0x65, 0x63, 0x12, 0x20, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x78,
+	0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x71, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x78, 0x65, 0x63, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x10, 0x54, 0x79, 0x70, 0x65, 0x64,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1c, 0x2e, 0x73, 0x71,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x71, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x5d, 0x0a, 0x15, 0x54, 0x79,
+	0x70, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x27, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x79, 0x70, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73,
+	0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x58, 0x0a, 0x14, 0x54, 0x79, 0x70,
+	0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65,
+	0x63, 0x12, 0x27, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79,
+	0x70, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x71, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x1b, 0x54, 0x79, 0x70, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x12, 0x27, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x71,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x3e, 0x0a, 0x07,
+	0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x12, 0x17, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70,
+	0x6c, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0c,
+	0x54, 0x79, 0x70, 0x65, 0x64, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x12, 0x1c, 0x2e, 0x73,
+	0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x71, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x12, 0x20, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x53, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x12, 0x23, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x12, 0x3d, 0x0a, 0x06, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x12, 0x18, 0x2e, 0x73,
+	0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x49, 0x0a, 0x0a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x1c, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0e,
+	0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x20,
+	0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67,
+	0x72, 0x69, 0x74, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74,
+	0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x20, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63,
 	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x71, 0x6c, 0x72,
-	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0e,
-	0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x20,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0e, 0x44, 0x65,
+	0x74, 0x61, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x20, 0x2e, 0x73,
+	0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
 	0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x63,
-	0x68, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x21, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74,
-	0x61, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x4c, 0x6f,
-	0x61, 0x64, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x73, 0x71,
-	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x45, 0x78, 0x74, 0x65,
-	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73,
-	0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x45, 0x78, 0x74,
-	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
-	0x0a, 0x07, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x19, 0x2e, 0x73, 0x71, 0x6c, 0x72,
-	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x4f, 0x0a, 0x0c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x12, 0x1e, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x73, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1f, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x73, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x48, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x1b,
-	0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x71,
-	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x32, 0x5a, 0x30, 0x73,
-	0x71, 0x6c, 0x69, 0x74, 0x65, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x73, 0x71, 0x6c,
-	0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x76, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x68, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x55, 0x0a, 0x0e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x12, 0x20, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x21, 0x2e, 0x73, 0x71, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73,
+	0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x57, 0x0a, 0x0e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x12, 0x20, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x51, 0x0a, 0x0c, 0x44, 0x75, 0x6d,
+	0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x71, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x71, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x55, 0x0a, 0x0e,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20,
+	0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x4c, 0x6f, 0x61, 0x64, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x12, 0x19, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1e, 0x2e, 0x73, 0x71, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x71, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x30, 0x01, 0x42, 0x32, 0x5a, 0x30, 0x73, 0x71, 0x6c, 0x69, 0x74, 0x65, 0x2d, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x3b,
+	0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4688,7 +6740,7 @@ func file_sqlrpc_v1_db_service_proto_rawDescGZIP() []byte {
 }
 
 var file_sqlrpc_v1_db_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_sqlrpc_v1_db_service_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
+var file_sqlrpc_v1_db_service_proto_msgTypes = make([]protoimpl.MessageInfo, 85)
 var file_sqlrpc_v1_db_service_proto_goTypes = []any{
 	(SavepointAction)(0),                   // 0: sqlrpc.v1.SavepointAction
 	(*QueryRequest)(nil),                   // 1: sqlrpc.v1.QueryRequest
@@ -4704,211 +6756,288 @@ var file_sqlrpc_v1_db_service_proto_goTypes = []any{
 	(*TransactionControlResponse)(nil),     // 11: sqlrpc.v1.TransactionControlResponse
 	(*ExecuteTransactionRequest)(nil),      // 12: sqlrpc.v1.ExecuteTransactionRequest
 	(*ExecuteTransactionResponse)(nil),     // 13: sqlrpc.v1.ExecuteTransactionResponse
-	(*TypedQueryRequest)(nil),              // 14: sqlrpc.v1.TypedQueryRequest
-	(*TypedTransactionQueryRequest)(nil),   // 15: sqlrpc.v1.TypedTransactionQueryRequest
-	(*TypedQueryResult)(nil),               // 16: sqlrpc.v1.TypedQueryResult
-	(*QueryResponse)(nil),                  // 17: sqlrpc.v1.QueryResponse
-	(*TypedQueryResponse)(nil),             // 18: sqlrpc.v1.TypedQueryResponse
-	(*QueryResultHeader)(nil),              // 19: sqlrpc.v1.QueryResultHeader
-	(*QueryResultRowBatch)(nil),            // 20: sqlrpc.v1.QueryResultRowBatch
-	(*TypedQueryResultHeader)(nil),         // 21: sqlrpc.v1.TypedQueryResultHeader
-	(*TypedQueryResultRowBatch)(nil),       // 22: sqlrpc.v1.TypedQueryResultRowBatch
-	(*QueryComplete)(nil),                  // 23: sqlrpc.v1.QueryComplete
-	(*ErrorResponse)(nil),                  // 24: sqlrpc.v1.ErrorResponse
-	(*TransactionRequest)(nil),             // 25: sqlrpc.v1.TransactionRequest
-	(*TransactionResponse)(nil),            // 26: sqlrpc.v1.TransactionResponse
-	(*ExplainResponse)(nil),                // 27: sqlrpc.v1.ExplainResponse
-	(*ListTablesRequest)(nil),              // 28: sqlrpc.v1.ListTablesRequest
-	(*ListTablesResponse)(nil),             // 29: sqlrpc.v1.ListTablesResponse
-	(*GetTableSchemaRequest)(nil),          // 30: sqlrpc.v1.GetTableSchemaRequest
-	(*GetDatabaseSchemaRequest)(nil),       // 31: sqlrpc.v1.GetDatabaseSchemaRequest
-	(*VacuumRequest)(nil),                  // 32: sqlrpc.v1.VacuumRequest
-	(*VacuumResponse)(nil),                 // 33: sqlrpc.v1.VacuumResponse
-	(*CheckpointRequest)(nil),              // 34: sqlrpc.v1.CheckpointRequest
-	(*CheckpointResponse)(nil),             // 35: sqlrpc.v1.CheckpointResponse
-	(*IntegrityCheckRequest)(nil),          // 36: sqlrpc.v1.IntegrityCheckRequest
-	(*IntegrityCheckResponse)(nil),         // 37: sqlrpc.v1.IntegrityCheckResponse
-	(*AttachDatabaseRequest)(nil),          // 38: sqlrpc.v1.AttachDatabaseRequest
-	(*AttachDatabaseResponse)(nil),         // 39: sqlrpc.v1.AttachDatabaseResponse
-	(*DetachDatabaseRequest)(nil),          // 40: sqlrpc.v1.DetachDatabaseRequest
-	(*DetachDatabaseResponse)(nil),         // 41: sqlrpc.v1.DetachDatabaseResponse
-	(*BeginRequest)(nil),                   // 42: sqlrpc.v1.BeginRequest
-	(*TransactionalQueryRequest)(nil),      // 43: sqlrpc.v1.TransactionalQueryRequest
-	(*SavepointRequest)(nil),               // 44: sqlrpc.v1.SavepointRequest
-	(*BeginResponse)(nil),                  // 45: sqlrpc.v1.BeginResponse
-	(*CommitResponse)(nil),                 // 46: sqlrpc.v1.CommitResponse
-	(*RollbackResponse)(nil),               // 47: sqlrpc.v1.RollbackResponse
-	(*TypedTransactionalQueryRequest)(nil), // 48: sqlrpc.v1.TypedTransactionalQueryRequest
-	(*ListExtensionsRequest)(nil),          // 49: sqlrpc.v1.ListExtensionsRequest
-	(*ListExtensionsResponse)(nil),         // 50: sqlrpc.v1.ListExtensionsResponse
-	(*LoadExtensionRequest)(nil),           // 51: sqlrpc.v1.LoadExtensionRequest
-	(*LoadExtensionResponse)(nil),          // 52: sqlrpc.v1.LoadExtensionResponse
-	(*PublishItem)(nil),                    // 53: sqlrpc.v1.PublishItem
-	(*PublishRequest)(nil),                 // 54: sqlrpc.v1.PublishRequest
-	(*PublishBatchRequest)(nil),            // 55: sqlrpc.v1.PublishBatchRequest
-	(*PublishResponse)(nil),                // 56: sqlrpc.v1.PublishResponse
-	(*PublishBatchResponse)(nil),           // 57: sqlrpc.v1.PublishBatchResponse
-	(*SubscribeRequest)(nil),               // 58: sqlrpc.v1.SubscribeRequest
-	(*SubscribeResponse)(nil),              // 59: sqlrpc.v1.SubscribeResponse
-	(*Parameters)(nil),                     // 60: sqlrpc.v1.Parameters
-	(ColumnAffinity)(0),                    // 61: sqlrpc.v1.ColumnAffinity
-	(DeclaredType)(0),                      // 62: sqlrpc.v1.DeclaredType
-	(*structpb.ListValue)(nil),             // 63: google.protobuf.ListValue
-	(*ExecutionStats)(nil),                 // 64: sqlrpc.v1.ExecutionStats
-	(*durationpb.Duration)(nil),            // 65: google.protobuf.Duration
-	(TransactionLockMode)(0),               // 66: sqlrpc.v1.TransactionLockMode
-	(*timestamppb.Timestamp)(nil),          // 67: google.protobuf.Timestamp
-	(*TypedParameters)(nil),                // 68: sqlrpc.v1.TypedParameters
-	(*SqlRow)(nil),                         // 69: sqlrpc.v1.SqlRow
-	(SqliteCode)(0),                        // 70: sqlrpc.v1.SqliteCode
-	(*emptypb.Empty)(nil),                  // 71: google.protobuf.Empty
-	(*QueryPlanNode)(nil),                  // 72: sqlrpc.v1.QueryPlanNode
-	(CheckpointMode)(0),                    // 73: sqlrpc.v1.CheckpointMode
-	(*Attachment)(nil),                     // 74: sqlrpc.v1.Attachment
-	(*ExtensionInfo)(nil),                  // 75: sqlrpc.v1.ExtensionInfo
-	(*TableSchema)(nil),                    // 76: sqlrpc.v1.TableSchema
-	(*DatabaseSchema)(nil),                 // 77: sqlrpc.v1.DatabaseSchema
+	(*BatchExecRequest)(nil),               // 14: sqlrpc.v1.BatchExecRequest
+	(*BatchExecResponse)(nil),              // 15: sqlrpc.v1.BatchExecResponse
+	(*OpenCursorRequest)(nil),              // 16: sqlrpc.v1.OpenCursorRequest
+	(*OpenCursorResponse)(nil),             // 17: sqlrpc.v1.OpenCursorResponse
+	(*FetchCursorRequest)(nil),             // 18: sqlrpc.v1.FetchCursorRequest
+	(*FetchCursorResponse)(nil),            // 19: sqlrpc.v1.FetchCursorResponse
+	(*CloseCursorRequest)(nil),             // 20: sqlrpc.v1.CloseCursorRequest
+	(*CloseCursorResponse)(nil),            // 21: sqlrpc.v1.CloseCursorResponse
+	(*TypedQueryRequest)(nil),              // 22: sqlrpc.v1.TypedQueryRequest
+	(*ImportRequest)(nil),                  // 23: sqlrpc.v1.ImportRequest
+	(*ImportStart)(nil),                    // 24: sqlrpc.v1.ImportStart
+	(*ImportRowBatch)(nil),                 // 25: sqlrpc.v1.ImportRowBatch
+	(*ImportRowError)(nil),                 // 26: sqlrpc.v1.ImportRowError
+	(*ImportResponse)(nil),                 // 27: sqlrpc.v1.ImportResponse
+	(*TypedBatchExecRequest)(nil),          // 28: sqlrpc.v1.TypedBatchExecRequest
+	(*TypedTransactionQueryRequest)(nil),   // 29: sqlrpc.v1.TypedTransactionQueryRequest
+	(*TypedQueryResult)(nil),               // 30: sqlrpc.v1.TypedQueryResult
+	(*QueryResponse)(nil),                  // 31: sqlrpc.v1.QueryResponse
+	(*TypedQueryResponse)(nil),             // 32: sqlrpc.v1.TypedQueryResponse
+	(*QueryResultHeader)(nil),              // 33: sqlrpc.v1.QueryResultHeader
+	(*QueryResultRowBatch)(nil),            // 34: sqlrpc.v1.QueryResultRowBatch
+	(*TypedQueryResultHeader)(nil),         // 35: sqlrpc.v1.TypedQueryResultHeader
+	(*TypedQueryResultRowBatch)(nil),       // 36: sqlrpc.v1.TypedQueryResultRowBatch
+	(*QueryComplete)(nil),                  // 37: sqlrpc.v1.QueryComplete
+	(*ErrorResponse)(nil),                  // 38: sqlrpc.v1.ErrorResponse
+	(*TransactionRequest)(nil),             // 39: sqlrpc.v1.TransactionRequest
+	(*TransactionResponse)(nil),            // 40: sqlrpc.v1.TransactionResponse
+	(*ExplainResponse)(nil),                // 41: sqlrpc.v1.ExplainResponse
+	(*ListTablesRequest)(nil),              // 42: sqlrpc.v1.ListTablesRequest
+	(*ListTablesResponse)(nil),             // 43: sqlrpc.v1.ListTablesResponse
+	(*GetTableSchemaRequest)(nil),          // 44: sqlrpc.v1.GetTableSchemaRequest
+	(*GetDatabaseSchemaRequest)(nil),       // 45: sqlrpc.v1.GetDatabaseSchemaRequest
+	(*VacuumRequest)(nil),                  // 46: sqlrpc.v1.VacuumRequest
+	(*VacuumResponse)(nil),                 // 47: sqlrpc.v1.VacuumResponse
+	(*CheckpointRequest)(nil),              // 48: sqlrpc.v1.CheckpointRequest
+	(*CheckpointResponse)(nil),             // 49: sqlrpc.v1.CheckpointResponse
+	(*IntegrityCheckRequest)(nil),          // 50: sqlrpc.v1.IntegrityCheckRequest
+	(*IntegrityCheckResponse)(nil),         // 51: sqlrpc.v1.IntegrityCheckResponse
+	(*BackupInfo)(nil),                     // 52: sqlrpc.v1.BackupInfo
+	(*BackupDatabaseRequest)(nil),          // 53: sqlrpc.v1.BackupDatabaseRequest
+	(*BackupDatabaseResponse)(nil),         // 54: sqlrpc.v1.BackupDatabaseResponse
+	(*ListBackupsRequest)(nil),             // 55: sqlrpc.v1.ListBackupsRequest
+	(*ListBackupsResponse)(nil),            // 56: sqlrpc.v1.ListBackupsResponse
+	(*RestoreDatabaseRequest)(nil),         // 57: sqlrpc.v1.RestoreDatabaseRequest
+	(*RestoreDatabaseResponse)(nil),        // 58: sqlrpc.v1.RestoreDatabaseResponse
+	(*DownloadBackupRequest)(nil),          // 59: sqlrpc.v1.DownloadBackupRequest
+	(*DownloadBackupResponse)(nil),         // 60: sqlrpc.v1.DownloadBackupResponse
+	(*DumpDatabaseRequest)(nil),            // 61: sqlrpc.v1.DumpDatabaseRequest
+	(*DumpDatabaseResponse)(nil),           // 62: sqlrpc.v1.DumpDatabaseResponse
+	(*AttachDatabaseRequest)(nil),          // 63: sqlrpc.v1.AttachDatabaseRequest
+	(*AttachDatabaseResponse)(nil),         // 64: sqlrpc.v1.AttachDatabaseResponse
+	(*DetachDatabaseRequest)(nil),          // 65: sqlrpc.v1.DetachDatabaseRequest
+	(*DetachDatabaseResponse)(nil),         // 66: sqlrpc.v1.DetachDatabaseResponse
+	(*BeginRequest)(nil),                   // 67: sqlrpc.v1.BeginRequest
+	(*TransactionalQueryRequest)(nil),      // 68: sqlrpc.v1.TransactionalQueryRequest
+	(*SavepointRequest)(nil),               // 69: sqlrpc.v1.SavepointRequest
+	(*BeginResponse)(nil),                  // 70: sqlrpc.v1.BeginResponse
+	(*CommitResponse)(nil),                 // 71: sqlrpc.v1.CommitResponse
+	(*RollbackResponse)(nil),               // 72: sqlrpc.v1.RollbackResponse
+	(*TypedTransactionalQueryRequest)(nil), // 73: sqlrpc.v1.TypedTransactionalQueryRequest
+	(*ListExtensionsRequest)(nil),          // 74: sqlrpc.v1.ListExtensionsRequest
+	(*ListExtensionsResponse)(nil),         // 75: sqlrpc.v1.ListExtensionsResponse
+	(*LoadExtensionRequest)(nil),           // 76: sqlrpc.v1.LoadExtensionRequest
+	(*LoadExtensionResponse)(nil),          // 77: sqlrpc.v1.LoadExtensionResponse
+	(*PublishItem)(nil),                    // 78: sqlrpc.v1.PublishItem
+	(*PublishRequest)(nil),                 // 79: sqlrpc.v1.PublishRequest
+	(*PublishBatchRequest)(nil),            // 80: sqlrpc.v1.PublishBatchRequest
+	(*PublishResponse)(nil),                // 81: sqlrpc.v1.PublishResponse
+	(*PublishBatchResponse)(nil),           // 82: sqlrpc.v1.PublishBatchResponse
+	(*SubscribeRequest)(nil),               // 83: sqlrpc.v1.SubscribeRequest
+	(*SubscribeResponse)(nil),              // 84: sqlrpc.v1.SubscribeResponse
+	nil,                                    // 85: sqlrpc.v1.ImportStart.ColumnMappingEntry
+	(*Parameters)(nil),                     // 86: sqlrpc.v1.Parameters
+	(ColumnAffinity)(0),                    // 87: sqlrpc.v1.ColumnAffinity
+	(DeclaredType)(0),                      // 88: sqlrpc.v1.DeclaredType
+	(*structpb.ListValue)(nil),             // 89: google.protobuf.ListValue
+	(*ExecutionStats)(nil),                 // 90: sqlrpc.v1.ExecutionStats
+	(*durationpb.Duration)(nil),            // 91: google.protobuf.Duration
+	(TransactionLockMode)(0),               // 92: sqlrpc.v1.TransactionLockMode
+	(*timestamppb.Timestamp)(nil),          // 93: google.protobuf.Timestamp
+	(*TypedParameters)(nil),                // 94: sqlrpc.v1.TypedParameters
+	(ImportFormat)(0),                      // 95: sqlrpc.v1.ImportFormat
+	(ImportConflict)(0),                    // 96: sqlrpc.v1.ImportConflict
+	(*SqlRow)(nil),                         // 97: sqlrpc.v1.SqlRow
+	(SqliteCode)(0),                        // 98: sqlrpc.v1.SqliteCode
+	(*emptypb.Empty)(nil),                  // 99: google.protobuf.Empty
+	(*QueryPlanNode)(nil),                  // 100: sqlrpc.v1.QueryPlanNode
+	(CheckpointMode)(0),                    // 101: sqlrpc.v1.CheckpointMode
+	(BackupCompression)(0),                 // 102: sqlrpc.v1.BackupCompression
+	(*Attachment)(nil),                     // 103: sqlrpc.v1.Attachment
+	(*ExtensionInfo)(nil),                  // 104: sqlrpc.v1.ExtensionInfo
+	(*TableSchema)(nil),                    // 105: sqlrpc.v1.TableSchema
+	(*DatabaseSchema)(nil),                 // 106: sqlrpc.v1.DatabaseSchema
 }
 var file_sqlrpc_v1_db_service_proto_depIdxs = []int32{
-	60,  // 0: sqlrpc.v1.QueryRequest.parameters:type_name -> sqlrpc.v1.Parameters
-	61,  // 1: sqlrpc.v1.QueryResult.column_affinities:type_name -> sqlrpc.v1.ColumnAffinity
-	62,  // 2: sqlrpc.v1.QueryResult.column_declared_types:type_name -> sqlrpc.v1.DeclaredType
-	63,  // 3: sqlrpc.v1.QueryResult.rows:type_name -> google.protobuf.ListValue
-	64,  // 4: sqlrpc.v1.QueryResult.stats:type_name -> sqlrpc.v1.ExecutionStats
+	86,  // 0: sqlrpc.v1.QueryRequest.parameters:type_name -> sqlrpc.v1.Parameters
+	87,  // 1: sqlrpc.v1.QueryResult.column_affinities:type_name -> sqlrpc.v1.ColumnAffinity
+	88,  // 2: sqlrpc.v1.QueryResult.column_declared_types:type_name -> sqlrpc.v1.DeclaredType
+	89,  // 3: sqlrpc.v1.QueryResult.rows:type_name -> google.protobuf.ListValue
+	90,  // 4: sqlrpc.v1.QueryResult.stats:type_name -> sqlrpc.v1.ExecutionStats
 	4,   // 5: sqlrpc.v1.ExecResponse.dml:type_name -> sqlrpc.v1.DMLResult
-	64,  // 6: sqlrpc.v1.ExecResponse.stats:type_name -> sqlrpc.v1.ExecutionStats
-	64,  // 7: sqlrpc.v1.DMLResult.stats:type_name -> sqlrpc.v1.ExecutionStats
-	65,  // 8: sqlrpc.v1.BeginTransactionRequest.timeout:type_name -> google.protobuf.Duration
-	66,  // 9: sqlrpc.v1.BeginTransactionRequest.mode:type_name -> sqlrpc.v1.TransactionLockMode
-	67,  // 10: sqlrpc.v1.BeginTransactionResponse.expires_at:type_name -> google.protobuf.Timestamp
-	60,  // 11: sqlrpc.v1.TransactionQueryRequest.parameters:type_name -> sqlrpc.v1.Parameters
-	44,  // 12: sqlrpc.v1.TransactionSavepointRequest.savepoint:type_name -> sqlrpc.v1.SavepointRequest
+	90,  // 6: sqlrpc.v1.ExecResponse.stats:type_name -> sqlrpc.v1.ExecutionStats
+	90,  // 7: sqlrpc.v1.DMLResult.stats:type_name -> sqlrpc.v1.ExecutionStats
+	91,  // 8: sqlrpc.v1.BeginTransactionRequest.timeout:type_name -> google.protobuf.Duration
+	92,  // 9: sqlrpc.v1.BeginTransactionRequest.mode:type_name -> sqlrpc.v1.TransactionLockMode
+	93,  // 10: sqlrpc.v1.BeginTransactionResponse.expires_at:type_name -> google.protobuf.Timestamp
+	86,  // 11: sqlrpc.v1.TransactionQueryRequest.parameters:type_name -> sqlrpc.v1.Parameters
+	69,  // 12: sqlrpc.v1.TransactionSavepointRequest.savepoint:type_name -> sqlrpc.v1.SavepointRequest
 	0,   // 13: sqlrpc.v1.SavepointResponse.action:type_name -> sqlrpc.v1.SavepointAction
-	25,  // 14: sqlrpc.v1.ExecuteTransactionRequest.requests:type_name -> sqlrpc.v1.TransactionRequest
-	26,  // 15: sqlrpc.v1.ExecuteTransactionResponse.responses:type_name -> sqlrpc.v1.TransactionResponse
-	68,  // 16: sqlrpc.v1.TypedQueryRequest.parameters:type_name -> sqlrpc.v1.TypedParameters
-	68,  // 17: sqlrpc.v1.TypedTransactionQueryRequest.parameters:type_name -> sqlrpc.v1.TypedParameters
-	61,  // 18: sqlrpc.v1.TypedQueryResult.column_affinities:type_name -> sqlrpc.v1.ColumnAffinity
-	62,  // 19: sqlrpc.v1.TypedQueryResult.column_declared_types:type_name -> sqlrpc.v1.DeclaredType
-	69,  // 20: sqlrpc.v1.TypedQueryResult.rows:type_name -> sqlrpc.v1.SqlRow
-	64,  // 21: sqlrpc.v1.TypedQueryResult.stats:type_name -> sqlrpc.v1.ExecutionStats
-	19,  // 22: sqlrpc.v1.QueryResponse.header:type_name -> sqlrpc.v1.QueryResultHeader
-	20,  // 23: sqlrpc.v1.QueryResponse.batch:type_name -> sqlrpc.v1.QueryResultRowBatch
-	23,  // 24: sqlrpc.v1.QueryResponse.complete:type_name -> sqlrpc.v1.QueryComplete
-	24,  // 25: sqlrpc.v1.QueryResponse.error:type_name -> sqlrpc.v1.ErrorResponse
-	21,  // 26: sqlrpc.v1.TypedQueryResponse.header:type_name -> sqlrpc.v1.TypedQueryResultHeader
-	22,  // 27: sqlrpc.v1.TypedQueryResponse.batch:type_name -> sqlrpc.v1.TypedQueryResultRowBatch
-	23,  // 28: sqlrpc.v1.TypedQueryResponse.complete:type_name -> sqlrpc.v1.QueryComplete
-	24,  // 29: sqlrpc.v1.TypedQueryResponse.error:type_name -> sqlrpc.v1.ErrorResponse
-	61,  // 30: sqlrpc.v1.QueryResultHeader.column_affinities:type_name -> sqlrpc.v1.ColumnAffinity
-	62,  // 31: sqlrpc.v1.QueryResultHeader.column_declared_types:type_name -> sqlrpc.v1.DeclaredType
-	63,  // 32: sqlrpc.v1.QueryResultRowBatch.rows:type_name -> google.protobuf.ListValue
-	61,  // 33: sqlrpc.v1.TypedQueryResultHeader.column_affinities:type_name -> sqlrpc.v1.ColumnAffinity
-	62,  // 34: sqlrpc.v1.TypedQueryResultHeader.column_declared_types:type_name -> sqlrpc.v1.DeclaredType
-	69,  // 35: sqlrpc.v1.TypedQueryResultRowBatch.rows:type_name -> sqlrpc.v1.SqlRow
-	64,  // 36: sqlrpc.v1.QueryComplete.stats:type_name -> sqlrpc.v1.ExecutionStats
-	70,  // 37: sqlrpc.v1.ErrorResponse.sqlite_error_code:type_name -> sqlrpc.v1.SqliteCode
-	42,  // 38: sqlrpc.v1.TransactionRequest.begin:type_name -> sqlrpc.v1.BeginRequest
-	43,  // 39: sqlrpc.v1.TransactionRequest.query:type_name -> sqlrpc.v1.TransactionalQueryRequest
-	43,  // 40: sqlrpc.v1.TransactionRequest.query_stream:type_name -> sqlrpc.v1.TransactionalQueryRequest
-	48,  // 41: sqlrpc.v1.TransactionRequest.typed_query:type_name -> sqlrpc.v1.TypedTransactionalQueryRequest
-	48,  // 42: sqlrpc.v1.TransactionRequest.typed_query_stream:type_name -> sqlrpc.v1.TypedTransactionalQueryRequest
-	43,  // 43: sqlrpc.v1.TransactionRequest.exec:type_name -> sqlrpc.v1.TransactionalQueryRequest
-	48,  // 44: sqlrpc.v1.TransactionRequest.typed_exec:type_name -> sqlrpc.v1.TypedTransactionalQueryRequest
-	44,  // 45: sqlrpc.v1.TransactionRequest.savepoint:type_name -> sqlrpc.v1.SavepointRequest
-	71,  // 46: sqlrpc.v1.TransactionRequest.commit:type_name -> google.protobuf.Empty
-	71,  // 47: sqlrpc.v1.TransactionRequest.rollback:type_name -> google.protobuf.Empty
-	45,  // 48: sqlrpc.v1.TransactionResponse.begin:type_name -> sqlrpc.v1.BeginResponse
-	2,   // 49: sqlrpc.v1.TransactionResponse.query_result:type_name -> sqlrpc.v1.QueryResult
-	17,  // 50: sqlrpc.v1.TransactionResponse.stream_result:type_name -> sqlrpc.v1.QueryResponse
-	16,  // 51: sqlrpc.v1.TransactionResponse.typed_query_result:type_name -> sqlrpc.v1.TypedQueryResult
-	18,  // 52: sqlrpc.v1.TransactionResponse.typed_stream_result:type_name -> sqlrpc.v1.TypedQueryResponse
-	9,   // 53: sqlrpc.v1.TransactionResponse.savepoint:type_name -> sqlrpc.v1.SavepointResponse
-	46,  // 54: sqlrpc.v1.TransactionResponse.commit:type_name -> sqlrpc.v1.CommitResponse
-	47,  // 55: sqlrpc.v1.TransactionResponse.rollback:type_name -> sqlrpc.v1.RollbackResponse
-	3,   // 56: sqlrpc.v1.TransactionResponse.exec_result:type_name -> sqlrpc.v1.ExecResponse
-	24,  // 57: sqlrpc.v1.TransactionResponse.error:type_name -> sqlrpc.v1.ErrorResponse
-	72,  // 58: sqlrpc.v1.ExplainResponse.nodes:type_name -> sqlrpc.v1.QueryPlanNode
-	73,  // 59: sqlrpc.v1.CheckpointRequest.mode:type_name -> sqlrpc.v1.CheckpointMode
-	74,  // 60: sqlrpc.v1.AttachDatabaseRequest.attachment:type_name -> sqlrpc.v1.Attachment
-	66,  // 61: sqlrpc.v1.BeginRequest.mode:type_name -> sqlrpc.v1.TransactionLockMode
-	60,  // 62: sqlrpc.v1.TransactionalQueryRequest.parameters:type_name -> sqlrpc.v1.Parameters
-	0,   // 63: sqlrpc.v1.SavepointRequest.action:type_name -> sqlrpc.v1.SavepointAction
-	68,  // 64: sqlrpc.v1.TypedTransactionalQueryRequest.parameters:type_name -> sqlrpc.v1.TypedParameters
-	75,  // 65: sqlrpc.v1.ListExtensionsResponse.extensions:type_name -> sqlrpc.v1.ExtensionInfo
-	53,  // 66: sqlrpc.v1.PublishBatchRequest.items:type_name -> sqlrpc.v1.PublishItem
-	67,  // 67: sqlrpc.v1.SubscribeResponse.created_at:type_name -> google.protobuf.Timestamp
-	1,   // 68: sqlrpc.v1.DatabaseService.Query:input_type -> sqlrpc.v1.QueryRequest
-	1,   // 69: sqlrpc.v1.DatabaseService.Exec:input_type -> sqlrpc.v1.QueryRequest
-	1,   // 70: sqlrpc.v1.DatabaseService.QueryStream:input_type -> sqlrpc.v1.QueryRequest
-	25,  // 71: sqlrpc.v1.DatabaseService.Transaction:input_type -> sqlrpc.v1.TransactionRequest
-	5,   // 72: sqlrpc.v1.DatabaseService.BeginTransaction:input_type -> sqlrpc.v1.BeginTransactionRequest
-	7,   // 73: sqlrpc.v1.DatabaseService.TransactionQuery:input_type -> sqlrpc.v1.TransactionQueryRequest
-	7,   // 74: sqlrpc.v1.DatabaseService.TransactionExec:input_type -> sqlrpc.v1.TransactionQueryRequest
-	7,   // 75: sqlrpc.v1.DatabaseService.TransactionQueryStream:input_type -> sqlrpc.v1.TransactionQueryRequest
-	8,   // 76: sqlrpc.v1.DatabaseService.TransactionSavepoint:input_type -> sqlrpc.v1.TransactionSavepointRequest
-	10,  // 77: sqlrpc.v1.DatabaseService.CommitTransaction:input_type -> sqlrpc.v1.TransactionControlRequest
-	10,  // 78: sqlrpc.v1.DatabaseService.RollbackTransaction:input_type -> sqlrpc.v1.TransactionControlRequest
-	12,  // 79: sqlrpc.v1.DatabaseService.ExecuteTransaction:input_type -> sqlrpc.v1.ExecuteTransactionRequest
-	14,  // 80: sqlrpc.v1.DatabaseService.TypedQuery:input_type -> sqlrpc.v1.TypedQueryRequest
-	14,  // 81: sqlrpc.v1.DatabaseService.TypedExec:input_type -> sqlrpc.v1.TypedQueryRequest
-	14,  // 82: sqlrpc.v1.DatabaseService.TypedQueryStream:input_type -> sqlrpc.v1.TypedQueryRequest
-	15,  // 83: sqlrpc.v1.DatabaseService.TypedTransactionQuery:input_type -> sqlrpc.v1.TypedTransactionQueryRequest
-	15,  // 84: sqlrpc.v1.DatabaseService.TypedTransactionExec:input_type -> sqlrpc.v1.TypedTransactionQueryRequest
-	15,  // 85: sqlrpc.v1.DatabaseService.TypedTransactionQueryStream:input_type -> sqlrpc.v1.TypedTransactionQueryRequest
-	1,   // 86: sqlrpc.v1.DatabaseService.Explain:input_type -> sqlrpc.v1.QueryRequest
-	14,  // 87: sqlrpc.v1.DatabaseService.TypedExplain:input_type -> sqlrpc.v1.TypedQueryRequest
-	28,  // 88: sqlrpc.v1.DatabaseService.ListTables:input_type -> sqlrpc.v1.ListTablesRequest
-	30,  // 89: sqlrpc.v1.DatabaseService.GetTableSchema:input_type -> sqlrpc.v1.GetTableSchemaRequest
-	31,  // 90: sqlrpc.v1.DatabaseService.GetDatabaseSchema:input_type -> sqlrpc.v1.GetDatabaseSchemaRequest
-	32,  // 91: sqlrpc.v1.DatabaseService.Vacuum:input_type -> sqlrpc.v1.VacuumRequest
-	34,  // 92: sqlrpc.v1.DatabaseService.Checkpoint:input_type -> sqlrpc.v1.CheckpointRequest
-	36,  // 93: sqlrpc.v1.DatabaseService.IntegrityCheck:input_type -> sqlrpc.v1.IntegrityCheckRequest
-	38,  // 94: sqlrpc.v1.DatabaseService.AttachDatabase:input_type -> sqlrpc.v1.AttachDatabaseRequest
-	40,  // 95: sqlrpc.v1.DatabaseService.DetachDatabase:input_type -> sqlrpc.v1.DetachDatabaseRequest
-	49,  // 96: sqlrpc.v1.DatabaseService.ListExtensions:input_type -> sqlrpc.v1.ListExtensionsRequest
-	51,  // 97: sqlrpc.v1.DatabaseService.LoadExtension:input_type -> sqlrpc.v1.LoadExtensionRequest
-	54,  // 98: sqlrpc.v1.DatabaseService.Publish:input_type -> sqlrpc.v1.PublishRequest
-	55,  // 99: sqlrpc.v1.DatabaseService.PublishBatch:input_type -> sqlrpc.v1.PublishBatchRequest
-	58,  // 100: sqlrpc.v1.DatabaseService.Subscribe:input_type -> sqlrpc.v1.SubscribeRequest
-	2,   // 101: sqlrpc.v1.DatabaseService.Query:output_type -> sqlrpc.v1.QueryResult
-	3,   // 102: sqlrpc.v1.DatabaseService.Exec:output_type -> sqlrpc.v1.ExecResponse
-	17,  // 103: sqlrpc.v1.DatabaseService.QueryStream:output_type -> sqlrpc.v1.QueryResponse
-	26,  // 104: sqlrpc.v1.DatabaseService.Transaction:output_type -> sqlrpc.v1.TransactionResponse
-	6,   // 105: sqlrpc.v1.DatabaseService.BeginTransaction:output_type -> sqlrpc.v1.BeginTransactionResponse
-	2,   // 106: sqlrpc.v1.DatabaseService.TransactionQuery:output_type -> sqlrpc.v1.QueryResult
-	3,   // 107: sqlrpc.v1.DatabaseService.TransactionExec:output_type -> sqlrpc.v1.ExecResponse
-	17,  // 108: sqlrpc.v1.DatabaseService.TransactionQueryStream:output_type -> sqlrpc.v1.QueryResponse
-	9,   // 109: sqlrpc.v1.DatabaseService.TransactionSavepoint:output_type -> sqlrpc.v1.SavepointResponse
-	11,  // 110: sqlrpc.v1.DatabaseService.CommitTransaction:output_type -> sqlrpc.v1.TransactionControlResponse
-	11,  // 111: sqlrpc.v1.DatabaseService.RollbackTransaction:output_type -> sqlrpc.v1.TransactionControlResponse
-	13,  // 112: sqlrpc.v1.DatabaseService.ExecuteTransaction:output_type -> sqlrpc.v1.ExecuteTransactionResponse
-	16,  // 113: sqlrpc.v1.DatabaseService.TypedQuery:output_type -> sqlrpc.v1.TypedQueryResult
-	3,   // 114: sqlrpc.v1.DatabaseService.TypedExec:output_type -> sqlrpc.v1.ExecResponse
-	18,  // 115: sqlrpc.v1.DatabaseService.TypedQueryStream:output_type -> sqlrpc.v1.TypedQueryResponse
-	16,  // 116: sqlrpc.v1.DatabaseService.TypedTransactionQuery:output_type -> sqlrpc.v1.TypedQueryResult
-	3,   // 117: sqlrpc.v1.DatabaseService.TypedTransactionExec:output_type -> sqlrpc.v1.ExecResponse
-	18,  // 118: sqlrpc.v1.DatabaseService.TypedTransactionQueryStream:output_type -> sqlrpc.v1.TypedQueryResponse
-	27,  // 119: sqlrpc.v1.DatabaseService.Explain:output_type -> sqlrpc.v1.ExplainResponse
-	27,  // 120: sqlrpc.v1.DatabaseService.TypedExplain:output_type -> sqlrpc.v1.ExplainResponse
-	29,  // 121: sqlrpc.v1.DatabaseService.ListTables:output_type -> sqlrpc.v1.ListTablesResponse
-	76,  // 122: sqlrpc.v1.DatabaseService.GetTableSchema:output_type -> sqlrpc.v1.TableSchema
-	77,  // 123: sqlrpc.v1.DatabaseService.GetDatabaseSchema:output_type -> sqlrpc.v1.DatabaseSchema
-	33,  // 124: sqlrpc.v1.DatabaseService.Vacuum:output_type -> sqlrpc.v1.VacuumResponse
-	35,  // 125: sqlrpc.v1.DatabaseService.Checkpoint:output_type -> sqlrpc.v1.CheckpointResponse
-	37,  // 126: sqlrpc.v1.DatabaseService.IntegrityCheck:output_type -> sqlrpc.v1.IntegrityCheckResponse
-	39,  // 127: sqlrpc.v1.DatabaseService.AttachDatabase:output_type -> sqlrpc.v1.AttachDatabaseResponse
-	41,  // 128: sqlrpc.v1.DatabaseService.DetachDatabase:output_type -> sqlrpc.v1.DetachDatabaseResponse
-	50,  // 129: sqlrpc.v1.DatabaseService.ListExtensions:output_type -> sqlrpc.v1.ListExtensionsResponse
-	52,  // 130: sqlrpc.v1.DatabaseService.LoadExtension:output_type -> sqlrpc.v1.LoadExtensionResponse
-	56,  // 131: sqlrpc.v1.DatabaseService.Publish:output_type -> sqlrpc.v1.PublishResponse
-	57,  // 132: sqlrpc.v1.DatabaseService.PublishBatch:output_type -> sqlrpc.v1.PublishBatchResponse
-	59,  // 133: sqlrpc.v1.DatabaseService.Subscribe:output_type -> sqlrpc.v1.SubscribeResponse
-	101, // [101:134] is the sub-list for method output_type
-	68,  // [68:101] is the sub-list for method input_type
-	68,  // [68:68] is the sub-list for extension type_name
-	68,  // [68:68] is the sub-list for extension extendee
-	0,   // [0:68] is the sub-list for field type_name
+	39,  // 14: sqlrpc.v1.ExecuteTransactionRequest.requests:type_name -> sqlrpc.v1.TransactionRequest
+	40,  // 15: sqlrpc.v1.ExecuteTransactionResponse.responses:type_name -> sqlrpc.v1.TransactionResponse
+	86,  // 16: sqlrpc.v1.BatchExecRequest.parameter_sets:type_name -> sqlrpc.v1.Parameters
+	4,   // 17: sqlrpc.v1.BatchExecResponse.results:type_name -> sqlrpc.v1.DMLResult
+	90,  // 18: sqlrpc.v1.BatchExecResponse.stats:type_name -> sqlrpc.v1.ExecutionStats
+	86,  // 19: sqlrpc.v1.OpenCursorRequest.parameters:type_name -> sqlrpc.v1.Parameters
+	91,  // 20: sqlrpc.v1.OpenCursorRequest.timeout:type_name -> google.protobuf.Duration
+	33,  // 21: sqlrpc.v1.OpenCursorResponse.header:type_name -> sqlrpc.v1.QueryResultHeader
+	93,  // 22: sqlrpc.v1.OpenCursorResponse.expires_at:type_name -> google.protobuf.Timestamp
+	89,  // 23: sqlrpc.v1.FetchCursorResponse.rows:type_name -> google.protobuf.ListValue
+	93,  // 24: sqlrpc.v1.FetchCursorResponse.expires_at:type_name -> google.protobuf.Timestamp
+	94,  // 25: sqlrpc.v1.TypedQueryRequest.parameters:type_name -> sqlrpc.v1.TypedParameters
+	24,  // 26: sqlrpc.v1.ImportRequest.start:type_name -> sqlrpc.v1.ImportStart
+	25,  // 27: sqlrpc.v1.ImportRequest.rows:type_name -> sqlrpc.v1.ImportRowBatch
+	95,  // 28: sqlrpc.v1.ImportStart.format:type_name -> sqlrpc.v1.ImportFormat
+	85,  // 29: sqlrpc.v1.ImportStart.column_mapping:type_name -> sqlrpc.v1.ImportStart.ColumnMappingEntry
+	96,  // 30: sqlrpc.v1.ImportStart.on_conflict:type_name -> sqlrpc.v1.ImportConflict
+	97,  // 31: sqlrpc.v1.ImportRowBatch.rows:type_name -> sqlrpc.v1.SqlRow
+	26,  // 32: sqlrpc.v1.ImportResponse.errors:type_name -> sqlrpc.v1.ImportRowError
+	88,  // 33: sqlrpc.v1.ImportResponse.column_declared_types:type_name -> sqlrpc.v1.DeclaredType
+	90,  // 34: sqlrpc.v1.ImportResponse.stats:type_name -> sqlrpc.v1.ExecutionStats
+	94,  // 35: sqlrpc.v1.TypedBatchExecRequest.parameter_sets:type_name -> sqlrpc.v1.TypedParameters
+	94,  // 36: sqlrpc.v1.TypedTransactionQueryRequest.parameters:type_name -> sqlrpc.v1.TypedParameters
+	87,  // 37: sqlrpc.v1.TypedQueryResult.column_affinities:type_name -> sqlrpc.v1.ColumnAffinity
+	88,  // 38: sqlrpc.v1.TypedQueryResult.column_declared_types:type_name -> sqlrpc.v1.DeclaredType
+	97,  // 39: sqlrpc.v1.TypedQueryResult.rows:type_name -> sqlrpc.v1.SqlRow
+	90,  // 40: sqlrpc.v1.TypedQueryResult.stats:type_name -> sqlrpc.v1.ExecutionStats
+	33,  // 41: sqlrpc.v1.QueryResponse.header:type_name -> sqlrpc.v1.QueryResultHeader
+	34,  // 42: sqlrpc.v1.QueryResponse.batch:type_name -> sqlrpc.v1.QueryResultRowBatch
+	37,  // 43: sqlrpc.v1.QueryResponse.complete:type_name -> sqlrpc.v1.QueryComplete
+	38,  // 44: sqlrpc.v1.QueryResponse.error:type_name -> sqlrpc.v1.ErrorResponse
+	35,  // 45: sqlrpc.v1.TypedQueryResponse.header:type_name -> sqlrpc.v1.TypedQueryResultHeader
+	36,  // 46: sqlrpc.v1.TypedQueryResponse.batch:type_name -> sqlrpc.v1.TypedQueryResultRowBatch
+	37,  // 47: sqlrpc.v1.TypedQueryResponse.complete:type_name -> sqlrpc.v1.QueryComplete
+	38,  // 48: sqlrpc.v1.TypedQueryResponse.error:type_name -> sqlrpc.v1.ErrorResponse
+	87,  // 49: sqlrpc.v1.QueryResultHeader.column_affinities:type_name -> sqlrpc.v1.ColumnAffinity
+	88,  // 50: sqlrpc.v1.QueryResultHeader.column_declared_types:type_name -> sqlrpc.v1.DeclaredType
+	89,  // 51: sqlrpc.v1.QueryResultRowBatch.rows:type_name -> google.protobuf.ListValue
+	87,  // 52: sqlrpc.v1.TypedQueryResultHeader.column_affinities:type_name -> sqlrpc.v1.ColumnAffinity
+	88,  // 53: sqlrpc.v1.TypedQueryResultHeader.column_declared_types:type_name -> sqlrpc.v1.DeclaredType
+	97,  // 54: sqlrpc.v1.TypedQueryResultRowBatch.rows:type_name -> sqlrpc.v1.SqlRow
+	90,  // 55: sqlrpc.v1.QueryComplete.stats:type_name -> sqlrpc.v1.ExecutionStats
+	98,  // 56: sqlrpc.v1.ErrorResponse.sqlite_error_code:type_name -> sqlrpc.v1.SqliteCode
+	67,  // 57: sqlrpc.v1.TransactionRequest.begin:type_name -> sqlrpc.v1.BeginRequest
+	68,  // 58: sqlrpc.v1.TransactionRequest.query:type_name -> sqlrpc.v1.TransactionalQueryRequest
+	68,  // 59: sqlrpc.v1.TransactionRequest.query_stream:type_name -> sqlrpc.v1.TransactionalQueryRequest
+	73,  // 60: sqlrpc.v1.TransactionRequest.typed_query:type_name -> sqlrpc.v1.TypedTransactionalQueryRequest
+	73,  // 61: sqlrpc.v1.TransactionRequest.typed_query_stream:type_name -> sqlrpc.v1.TypedTransactionalQueryRequest
+	68,  // 62: sqlrpc.v1.TransactionRequest.exec:type_name -> sqlrpc.v1.TransactionalQueryRequest
+	73,  // 63: sqlrpc.v1.TransactionRequest.typed_exec:type_name -> sqlrpc.v1.TypedTransactionalQueryRequest
+	69,  // 64: sqlrpc.v1.TransactionRequest.savepoint:type_name -> sqlrpc.v1.SavepointRequest
+	99,  // 65: sqlrpc.v1.TransactionRequest.commit:type_name -> google.protobuf.Empty
+	99,  // 66: sqlrpc.v1.TransactionRequest.rollback:type_name -> google.protobuf.Empty
+	70,  // 67: sqlrpc.v1.TransactionResponse.begin:type_name -> sqlrpc.v1.BeginResponse
+	2,   // 68: sqlrpc.v1.TransactionResponse.query_result:type_name -> sqlrpc.v1.QueryResult
+	31,  // 69: sqlrpc.v1.TransactionResponse.stream_result:type_name -> sqlrpc.v1.QueryResponse
+	30,  // 70: sqlrpc.v1.TransactionResponse.typed_query_result:type_name -> sqlrpc.v1.TypedQueryResult
+	32,  // 71: sqlrpc.v1.TransactionResponse.typed_stream_result:type_name -> sqlrpc.v1.TypedQueryResponse
+	9,   // 72: sqlrpc.v1.TransactionResponse.savepoint:type_name -> sqlrpc.v1.SavepointResponse
+	71,  // 73: sqlrpc.v1.TransactionResponse.commit:type_name -> sqlrpc.v1.CommitResponse
+	72,  // 74: sqlrpc.v1.TransactionResponse.rollback:type_name -> sqlrpc.v1.RollbackResponse
+	3,   // 75: sqlrpc.v1.TransactionResponse.exec_result:type_name -> sqlrpc.v1.ExecResponse
+	38,  // 76: sqlrpc.v1.TransactionResponse.error:type_name -> sqlrpc.v1.ErrorResponse
+	100, // 77: sqlrpc.v1.ExplainResponse.nodes:type_name -> sqlrpc.v1.QueryPlanNode
+	101, // 78: sqlrpc.v1.CheckpointRequest.mode:type_name -> sqlrpc.v1.CheckpointMode
+	93,  // 79: sqlrpc.v1.BackupInfo.created_at:type_name -> google.protobuf.Timestamp
+	102, // 80: sqlrpc.v1.BackupInfo.compression:type_name -> sqlrpc.v1.BackupCompression
+	102, // 81: sqlrpc.v1.BackupDatabaseRequest.compression:type_name -> sqlrpc.v1.BackupCompression
+	52,  // 82: sqlrpc.v1.BackupDatabaseResponse.backup:type_name -> sqlrpc.v1.BackupInfo
+	52,  // 83: sqlrpc.v1.ListBackupsResponse.backups:type_name -> sqlrpc.v1.BackupInfo
+	52,  // 84: sqlrpc.v1.RestoreDatabaseResponse.backup:type_name -> sqlrpc.v1.BackupInfo
+	52,  // 85: sqlrpc.v1.DownloadBackupResponse.backup:type_name -> sqlrpc.v1.BackupInfo
+	103, // 86: sqlrpc.v1.AttachDatabaseRequest.attachment:type_name -> sqlrpc.v1.Attachment
+	92,  // 87: sqlrpc.v1.BeginRequest.mode:type_name -> sqlrpc.v1.TransactionLockMode
+	86,  // 88: sqlrpc.v1.TransactionalQueryRequest.parameters:type_name -> sqlrpc.v1.Parameters
+	0,   // 89: sqlrpc.v1.SavepointRequest.action:type_name -> sqlrpc.v1.SavepointAction
+	94,  // 90: sqlrpc.v1.TypedTransactionalQueryRequest.parameters:type_name -> sqlrpc.v1.TypedParameters
+	104, // 91: sqlrpc.v1.ListExtensionsResponse.extensions:type_name -> sqlrpc.v1.ExtensionInfo
+	78,  // 92: sqlrpc.v1.PublishBatchRequest.items:type_name -> sqlrpc.v1.PublishItem
+	93,  // 93: sqlrpc.v1.SubscribeResponse.created_at:type_name -> google.protobuf.Timestamp
+	1,   // 94: sqlrpc.v1.DatabaseService.Query:input_type -> sqlrpc.v1.QueryRequest
+	1,   // 95: sqlrpc.v1.DatabaseService.Exec:input_type -> sqlrpc.v1.QueryRequest
+	1,   // 96: sqlrpc.v1.DatabaseService.QueryStream:input_type -> sqlrpc.v1.QueryRequest
+	39,  // 97: sqlrpc.v1.DatabaseService.Transaction:input_type -> sqlrpc.v1.TransactionRequest
+	5,   // 98: sqlrpc.v1.DatabaseService.BeginTransaction:input_type -> sqlrpc.v1.BeginTransactionRequest
+	7,   // 99: sqlrpc.v1.DatabaseService.TransactionQuery:input_type -> sqlrpc.v1.TransactionQueryRequest
+	7,   // 100: sqlrpc.v1.DatabaseService.TransactionExec:input_type -> sqlrpc.v1.TransactionQueryRequest
+	7,   // 101: sqlrpc.v1.DatabaseService.TransactionQueryStream:input_type -> sqlrpc.v1.TransactionQueryRequest
+	8,   // 102: sqlrpc.v1.DatabaseService.TransactionSavepoint:input_type -> sqlrpc.v1.TransactionSavepointRequest
+	10,  // 103: sqlrpc.v1.DatabaseService.CommitTransaction:input_type -> sqlrpc.v1.TransactionControlRequest
+	10,  // 104: sqlrpc.v1.DatabaseService.RollbackTransaction:input_type -> sqlrpc.v1.TransactionControlRequest
+	16,  // 105: sqlrpc.v1.DatabaseService.OpenCursor:input_type -> sqlrpc.v1.OpenCursorRequest
+	18,  // 106: sqlrpc.v1.DatabaseService.FetchCursor:input_type -> sqlrpc.v1.FetchCursorRequest
+	20,  // 107: sqlrpc.v1.DatabaseService.CloseCursor:input_type -> sqlrpc.v1.CloseCursorRequest
+	12,  // 108: sqlrpc.v1.DatabaseService.ExecuteTransaction:input_type -> sqlrpc.v1.ExecuteTransactionRequest
+	14,  // 109: sqlrpc.v1.DatabaseService.BatchExec:input_type -> sqlrpc.v1.BatchExecRequest
+	23,  // 110: sqlrpc.v1.DatabaseService.Import:input_type -> sqlrpc.v1.ImportRequest
+	22,  // 111: sqlrpc.v1.DatabaseService.TypedQuery:input_type -> sqlrpc.v1.TypedQueryRequest
+	22,  // 112: sqlrpc.v1.DatabaseService.TypedExec:input_type -> sqlrpc.v1.TypedQueryRequest
+	28,  // 113: sqlrpc.v1.DatabaseService.TypedBatchExec:input_type -> sqlrpc.v1.TypedBatchExecRequest
+	22,  // 114: sqlrpc.v1.DatabaseService.TypedQueryStream:input_type -> sqlrpc.v1.TypedQueryRequest
+	29,  // 115: sqlrpc.v1.DatabaseService.TypedTransactionQuery:input_type -> sqlrpc.v1.TypedTransactionQueryRequest
+	29,  // 116: sqlrpc.v1.DatabaseService.TypedTransactionExec:input_type -> sqlrpc.v1.TypedTransactionQueryRequest
+	29,  // 117: sqlrpc.v1.DatabaseService.TypedTransactionQueryStream:input_type -> sqlrpc.v1.TypedTransactionQueryRequest
+	1,   // 118: sqlrpc.v1.DatabaseService.Explain:input_type -> sqlrpc.v1.QueryRequest
+	22,  // 119: sqlrpc.v1.DatabaseService.TypedExplain:input_type -> sqlrpc.v1.TypedQueryRequest
+	42,  // 120: sqlrpc.v1.DatabaseService.ListTables:input_type -> sqlrpc.v1.ListTablesRequest
+	44,  // 121: sqlrpc.v1.DatabaseService.GetTableSchema:input_type -> sqlrpc.v1.GetTableSchemaRequest
+	45,  // 122: sqlrpc.v1.DatabaseService.GetDatabaseSchema:input_type -> sqlrpc.v1.GetDatabaseSchemaRequest
+	46,  // 123: sqlrpc.v1.DatabaseService.Vacuum:input_type -> sqlrpc.v1.VacuumRequest
+	48,  // 124: sqlrpc.v1.DatabaseService.Checkpoint:input_type -> sqlrpc.v1.CheckpointRequest
+	50,  // 125: sqlrpc.v1.DatabaseService.IntegrityCheck:input_type -> sqlrpc.v1.IntegrityCheckRequest
+	63,  // 126: sqlrpc.v1.DatabaseService.AttachDatabase:input_type -> sqlrpc.v1.AttachDatabaseRequest
+	65,  // 127: sqlrpc.v1.DatabaseService.DetachDatabase:input_type -> sqlrpc.v1.DetachDatabaseRequest
+	53,  // 128: sqlrpc.v1.DatabaseService.BackupDatabase:input_type -> sqlrpc.v1.BackupDatabaseRequest
+	55,  // 129: sqlrpc.v1.DatabaseService.ListBackups:input_type -> sqlrpc.v1.ListBackupsRequest
+	57,  // 130: sqlrpc.v1.DatabaseService.RestoreDatabase:input_type -> sqlrpc.v1.RestoreDatabaseRequest
+	59,  // 131: sqlrpc.v1.DatabaseService.DownloadBackup:input_type -> sqlrpc.v1.DownloadBackupRequest
+	61,  // 132: sqlrpc.v1.DatabaseService.DumpDatabase:input_type -> sqlrpc.v1.DumpDatabaseRequest
+	74,  // 133: sqlrpc.v1.DatabaseService.ListExtensions:input_type -> sqlrpc.v1.ListExtensionsRequest
+	76,  // 134: sqlrpc.v1.DatabaseService.LoadExtension:input_type -> sqlrpc.v1.LoadExtensionRequest
+	79,  // 135: sqlrpc.v1.DatabaseService.Publish:input_type -> sqlrpc.v1.PublishRequest
+	80,  // 136: sqlrpc.v1.DatabaseService.PublishBatch:input_type -> sqlrpc.v1.PublishBatchRequest
+	83,  // 137: sqlrpc.v1.DatabaseService.Subscribe:input_type -> sqlrpc.v1.SubscribeRequest
+	2,   // 138: sqlrpc.v1.DatabaseService.Query:output_type -> sqlrpc.v1.QueryResult
+	3,   // 139: sqlrpc.v1.DatabaseService.Exec:output_type -> sqlrpc.v1.ExecResponse
+	31,  // 140: sqlrpc.v1.DatabaseService.QueryStream:output_type -> sqlrpc.v1.QueryResponse
+	40,  // 141: sqlrpc.v1.DatabaseService.Transaction:output_type -> sqlrpc.v1.TransactionResponse
+	6,   // 142: sqlrpc.v1.DatabaseService.BeginTransaction:output_type -> sqlrpc.v1.BeginTransactionResponse
+	2,   // 143: sqlrpc.v1.DatabaseService.TransactionQuery:output_type -> sqlrpc.v1.QueryResult
+	3,   // 144: sqlrpc.v1.DatabaseService.TransactionExec:output_type -> sqlrpc.v1.ExecResponse
+	31,  // 145: sqlrpc.v1.DatabaseService.TransactionQueryStream:output_type -> sqlrpc.v1.QueryResponse
+	9,   // 146: sqlrpc.v1.DatabaseService.TransactionSavepoint:output_type -> sqlrpc.v1.SavepointResponse
+	11,  // 147: sqlrpc.v1.DatabaseService.CommitTransaction:output_type -> sqlrpc.v1.TransactionControlResponse
+	11,  // 148: sqlrpc.v1.DatabaseService.RollbackTransaction:output_type -> sqlrpc.v1.TransactionControlResponse
+	17,  // 149: sqlrpc.v1.DatabaseService.OpenCursor:output_type -> sqlrpc.v1.OpenCursorResponse
+	19,  // 150: sqlrpc.v1.DatabaseService.FetchCursor:output_type -> sqlrpc.v1.FetchCursorResponse
+	21,  // 151: sqlrpc.v1.DatabaseService.CloseCursor:output_type -> sqlrpc.v1.CloseCursorResponse
+	13,  // 152: sqlrpc.v1.DatabaseService.ExecuteTransaction:output_type -> sqlrpc.v1.ExecuteTransactionResponse
+	15,  // 153: sqlrpc.v1.DatabaseService.BatchExec:output_type -> sqlrpc.v1.BatchExecResponse
+	27,  // 154: sqlrpc.v1.DatabaseService.Import:output_type -> sqlrpc.v1.ImportResponse
+	30,  // 155: sqlrpc.v1.DatabaseService.TypedQuery:output_type -> sqlrpc.v1.TypedQueryResult
+	3,   // 156: sqlrpc.v1.DatabaseService.TypedExec:output_type -> sqlrpc.v1.ExecResponse
+	15,  // 157: sqlrpc.v1.DatabaseService.TypedBatchExec:output_type -> sqlrpc.v1.BatchExecResponse
+	32,  // 158: sqlrpc.v1.DatabaseService.TypedQueryStream:output_type -> sqlrpc.v1.TypedQueryResponse
+	30,  // 159: sqlrpc.v1.DatabaseService.TypedTransactionQuery:output_type -> sqlrpc.v1.TypedQueryResult
+	3,   // 160: sqlrpc.v1.DatabaseService.TypedTransactionExec:output_type -> sqlrpc.v1.ExecResponse
+	32,  // 161: sqlrpc.v1.DatabaseService.TypedTransactionQueryStream:output_type -> sqlrpc.v1.TypedQueryResponse
+	41,  // 162: sqlrpc.v1.DatabaseService.Explain:output_type -> sqlrpc.v1.ExplainResponse
+	41,  // 163: sqlrpc.v1.DatabaseService.TypedExplain:output_type -> sqlrpc.v1.ExplainResponse
+	43,  // 164: sqlrpc.v1.DatabaseService.ListTables:output_type -> sqlrpc.v1.ListTablesResponse
+	105, // 165: sqlrpc.v1.DatabaseService.GetTableSchema:output_type -> sqlrpc.v1.TableSchema
+	106, // 166: sqlrpc.v1.DatabaseService.GetDatabaseSchema:output_type -> sqlrpc.v1.DatabaseSchema
+	47,  // 167: sqlrpc.v1.DatabaseService.Vacuum:output_type -> sqlrpc.v1.VacuumResponse
+	49,  // 168: sqlrpc.v1.DatabaseService.Checkpoint:output_type -> sqlrpc.v1.CheckpointResponse
+	51,  // 169: sqlrpc.v1.DatabaseService.IntegrityCheck:output_type -> sqlrpc.v1.IntegrityCheckResponse
+	64,  // 170: sqlrpc.v1.DatabaseService.AttachDatabase:output_type -> sqlrpc.v1.AttachDatabaseResponse
+	66,  // 171: sqlrpc.v1.DatabaseService.DetachDatabase:output_type -> sqlrpc.v1.DetachDatabaseResponse
+	54,  // 172: sqlrpc.v1.DatabaseService.BackupDatabase:output_type -> sqlrpc.v1.BackupDatabaseResponse
+	56,  // 173: sqlrpc.v1.DatabaseService.ListBackups:output_type -> sqlrpc.v1.ListBackupsResponse
+	58,  // 174: sqlrpc.v1.DatabaseService.RestoreDatabase:output_type -> sqlrpc.v1.RestoreDatabaseResponse
+	60,  // 175: sqlrpc.v1.DatabaseService.DownloadBackup:output_type -> sqlrpc.v1.DownloadBackupResponse
+	62,  // 176: sqlrpc.v1.DatabaseService.DumpDatabase:output_type -> sqlrpc.v1.DumpDatabaseResponse
+	75,  // 177: sqlrpc.v1.DatabaseService.ListExtensions:output_type -> sqlrpc.v1.ListExtensionsResponse
+	77,  // 178: sqlrpc.v1.DatabaseService.LoadExtension:output_type -> sqlrpc.v1.LoadExtensionResponse
+	81,  // 179: sqlrpc.v1.DatabaseService.Publish:output_type -> sqlrpc.v1.PublishResponse
+	82,  // 180: sqlrpc.v1.DatabaseService.PublishBatch:output_type -> sqlrpc.v1.PublishBatchResponse
+	84,  // 181: sqlrpc.v1.DatabaseService.Subscribe:output_type -> sqlrpc.v1.SubscribeResponse
+	138, // [138:182] is the sub-list for method output_type
+	94,  // [94:138] is the sub-list for method input_type
+	94,  // [94:94] is the sub-list for extension type_name
+	94,  // [94:94] is the sub-list for extension extendee
+	0,   // [0:94] is the sub-list for field type_name
 }
 
 func init() { file_sqlrpc_v1_db_service_proto_init() }
@@ -4918,19 +7047,25 @@ func file_sqlrpc_v1_db_service_proto_init() {
 	}
 	file_sqlrpc_v1_enums_proto_init()
 	file_sqlrpc_v1_types_proto_init()
-	file_sqlrpc_v1_db_service_proto_msgTypes[16].OneofWrappers = []any{
+	file_sqlrpc_v1_db_service_proto_msgTypes[22].OneofWrappers = []any{
+		(*ImportRequest_Start)(nil),
+		(*ImportRequest_Data)(nil),
+		(*ImportRequest_Rows)(nil),
+	}
+	file_sqlrpc_v1_db_service_proto_msgTypes[30].OneofWrappers = []any{
 		(*QueryResponse_Header)(nil),
 		(*QueryResponse_Batch)(nil),
 		(*QueryResponse_Complete)(nil),
 		(*QueryResponse_Error)(nil),
 	}
-	file_sqlrpc_v1_db_service_proto_msgTypes[17].OneofWrappers = []any{
+	file_sqlrpc_v1_db_service_proto_msgTypes[31].OneofWrappers = []any{
 		(*TypedQueryResponse_Header)(nil),
 		(*TypedQueryResponse_Batch)(nil),
 		(*TypedQueryResponse_Complete)(nil),
 		(*TypedQueryResponse_Error)(nil),
 	}
-	file_sqlrpc_v1_db_service_proto_msgTypes[24].OneofWrappers = []any{
+	file_sqlrpc_v1_db_service_proto_msgTypes[37].OneofWrappers = []any{}
+	file_sqlrpc_v1_db_service_proto_msgTypes[38].OneofWrappers = []any{
 		(*TransactionRequest_Begin)(nil),
 		(*TransactionRequest_Query)(nil),
 		(*TransactionRequest_QueryStream)(nil),
@@ -4942,7 +7077,7 @@ func file_sqlrpc_v1_db_service_proto_init() {
 		(*TransactionRequest_Commit)(nil),
 		(*TransactionRequest_Rollback)(nil),
 	}
-	file_sqlrpc_v1_db_service_proto_msgTypes[25].OneofWrappers = []any{
+	file_sqlrpc_v1_db_service_proto_msgTypes[39].OneofWrappers = []any{
 		(*TransactionResponse_Begin)(nil),
 		(*TransactionResponse_QueryResult)(nil),
 		(*TransactionResponse_StreamResult)(nil),
@@ -4954,16 +7089,16 @@ func file_sqlrpc_v1_db_service_proto_init() {
 		(*TransactionResponse_ExecResult)(nil),
 		(*TransactionResponse_Error)(nil),
 	}
-	file_sqlrpc_v1_db_service_proto_msgTypes[31].OneofWrappers = []any{}
-	file_sqlrpc_v1_db_service_proto_msgTypes[35].OneofWrappers = []any{}
-	file_sqlrpc_v1_db_service_proto_msgTypes[48].OneofWrappers = []any{}
+	file_sqlrpc_v1_db_service_proto_msgTypes[45].OneofWrappers = []any{}
+	file_sqlrpc_v1_db_service_proto_msgTypes[49].OneofWrappers = []any{}
+	file_sqlrpc_v1_db_service_proto_msgTypes[73].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sqlrpc_v1_db_service_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   59,
+			NumMessages:   85,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -640,6 +640,521 @@ func (Role) EnumDescriptor() ([]byte, []int) {
 	return file_sqlrpc_v1_enums_proto_rawDescGZIP(), []int{5}
 }
 
+// *
+// RpcFamily groups platform RPCs so API keys can be scoped to a subset of
+// the API surface.
+type RpcFamily int32
+
+const (
+	RpcFamily_RPC_FAMILY_UNSPECIFIED RpcFamily = 0
+	// Queries, executions, transactions, introspection and maintenance on
+	// tenant databases.
+	RpcFamily_RPC_FAMILY_QUERY RpcFamily = 1
+	// Pub/Sub message publishing (Publish, PublishBatch).
+	RpcFamily_RPC_FAMILY_PUBLISH RpcFamily = 2
+	// Pub/Sub subscriptions (Subscribe).
+	RpcFamily_RPC_FAMILY_SUBSCRIBE RpcFamily = 3
+	// Control plane calls on the AdminService.
+	RpcFamily_RPC_FAMILY_ADMIN RpcFamily = 4
+)
+
+// Enum value maps for RpcFamily.
+var (
+	RpcFamily_name = map[int32]string{
+		0: "RPC_FAMILY_UNSPECIFIED",
+		1: "RPC_FAMILY_QUERY",
+		2: "RPC_FAMILY_PUBLISH",
+		3: "RPC_FAMILY_SUBSCRIBE",
+		4: "RPC_FAMILY_ADMIN",
+	}
+	RpcFamily_value = map[string]int32{
+		"RPC_FAMILY_UNSPECIFIED": 0,
+		"RPC_FAMILY_QUERY":       1,
+		"RPC_FAMILY_PUBLISH":     2,
+		"RPC_FAMILY_SUBSCRIBE":   3,
+		"RPC_FAMILY_ADMIN":       4,
+	}
+)
+
+func (x RpcFamily) Enum() *RpcFamily {
+	p := new(RpcFamily)
+	*p = x
+	return p
+}
+
+func (x RpcFamily) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RpcFamily) Descriptor() protoreflect.EnumDescriptor {
+	return file_sqlrpc_v1_enums_proto_enumTypes[6].Descriptor()
+}
+
+func (RpcFamily) Type() protoreflect.EnumType {
+	return &file_sqlrpc_v1_enums_proto_enumTypes[6]
+}
+
+func (x RpcFamily) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RpcFamily.Descriptor instead.
+func (RpcFamily) EnumDescriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_enums_proto_rawDescGZIP(), []int{6}
+}
+
+// *
+// BackupCompression is the compression applied to a stored backup file.
+type BackupCompression int32
+
+const (
+	// Default value, stored uncompressed.
+	BackupCompression_BACKUP_COMPRESSION_UNSPECIFIED BackupCompression = 0
+	// Stored as a plain SQLite database file.
+	BackupCompression_BACKUP_COMPRESSION_NONE BackupCompression = 1
+	// Stored gzip-compressed.
+	BackupCompression_BACKUP_COMPRESSION_GZIP BackupCompression = 2
+)
+
+// Enum value maps for BackupCompression.
+var (
+	BackupCompression_name = map[int32]string{
+		0: "BACKUP_COMPRESSION_UNSPECIFIED",
+		1: "BACKUP_COMPRESSION_NONE",
+		2: "BACKUP_COMPRESSION_GZIP",
+	}
+	BackupCompression_value = map[string]int32{
+		"BACKUP_COMPRESSION_UNSPECIFIED": 0,
+		"BACKUP_COMPRESSION_NONE":        1,
+		"BACKUP_COMPRESSION_GZIP":        2,
+	}
+)
+
+func (x BackupCompression) Enum() *BackupCompression {
+	p := new(BackupCompression)
+	*p = x
+	return p
+}
+
+func (x BackupCompression) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BackupCompression) Descriptor() protoreflect.EnumDescriptor {
+	return file_sqlrpc_v1_enums_proto_enumTypes[7].Descriptor()
+}
+
+func (BackupCompression) Type() protoreflect.EnumType {
+	return &file_sqlrpc_v1_enums_proto_enumTypes[7]
+}
+
+func (x BackupCompression) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BackupCompression.Descriptor instead.
+func (BackupCompression) EnumDescriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_enums_proto_rawDescGZIP(), []int{7}
+}
+
+// *
+// MaintenanceTask is a kind of scheduled maintenance job.
+type MaintenanceTask int32
+
+const (
+	MaintenanceTask_MAINTENANCE_TASK_UNSPECIFIED MaintenanceTask = 0
+	// PRAGMA wal_checkpoint(TRUNCATE).
+	MaintenanceTask_MAINTENANCE_TASK_CHECKPOINT MaintenanceTask = 1
+	// VACUUM.
+	MaintenanceTask_MAINTENANCE_TASK_VACUUM MaintenanceTask = 2
+	// PRAGMA integrity_check.
+	MaintenanceTask_MAINTENANCE_TASK_INTEGRITY_CHECK MaintenanceTask = 3
+	// Backup into the server's backup directory.
+	MaintenanceTask_MAINTENANCE_TASK_BACKUP MaintenanceTask = 4
+)
+
+// Enum value maps for MaintenanceTask.
+var (
+	MaintenanceTask_name = map[int32]string{
+		0: "MAINTENANCE_TASK_UNSPECIFIED",
+		1: "MAINTENANCE_TASK_CHECKPOINT",
+		2: "MAINTENANCE_TASK_VACUUM",
+		3: "MAINTENANCE_TASK_INTEGRITY_CHECK",
+		4: "MAINTENANCE_TASK_BACKUP",
+	}
+	MaintenanceTask_value = map[string]int32{
+		"MAINTENANCE_TASK_UNSPECIFIED":     0,
+		"MAINTENANCE_TASK_CHECKPOINT":      1,
+		"MAINTENANCE_TASK_VACUUM":          2,
+		"MAINTENANCE_TASK_INTEGRITY_CHECK": 3,
+		"MAINTENANCE_TASK_BACKUP":          4,
+	}
+)
+
+func (x MaintenanceTask) Enum() *MaintenanceTask {
+	p := new(MaintenanceTask)
+	*p = x
+	return p
+}
+
+func (x MaintenanceTask) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MaintenanceTask) Descriptor() protoreflect.EnumDescriptor {
+	return file_sqlrpc_v1_enums_proto_enumTypes[8].Descriptor()
+}
+
+func (MaintenanceTask) Type() protoreflect.EnumType {
+	return &file_sqlrpc_v1_enums_proto_enumTypes[8]
+}
+
+func (x MaintenanceTask) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MaintenanceTask.Descriptor instead.
+func (MaintenanceTask) EnumDescriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_enums_proto_rawDescGZIP(), []int{8}
+}
+
+// *
+// ReplicationRole is the part a server plays in leader/follower replication.
+type ReplicationRole int32
+
+const (
+	ReplicationRole_REPLICATION_ROLE_UNSPECIFIED ReplicationRole = 0
+	// Accepts writes and streams changes to followers.
+	ReplicationRole_REPLICATION_ROLE_LEADER ReplicationRole = 1
+	// Applies changes streamed from a leader and serves reads only.
+	ReplicationRole_REPLICATION_ROLE_FOLLOWER ReplicationRole = 2
+)
+
+// Enum value maps for ReplicationRole.
+var (
+	ReplicationRole_name = map[int32]string{
+		0: "REPLICATION_ROLE_UNSPECIFIED",
+		1: "REPLICATION_ROLE_LEADER",
+		2: "REPLICATION_ROLE_FOLLOWER",
+	}
+	ReplicationRole_value = map[string]int32{
+		"REPLICATION_ROLE_UNSPECIFIED": 0,
+		"REPLICATION_ROLE_LEADER":      1,
+		"REPLICATION_ROLE_FOLLOWER":    2,
+	}
+)
+
+func (x ReplicationRole) Enum() *ReplicationRole {
+	p := new(ReplicationRole)
+	*p = x
+	return p
+}
+
+func (x ReplicationRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReplicationRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_sqlrpc_v1_enums_proto_enumTypes[9].Descriptor()
+}
+
+func (ReplicationRole) Type() protoreflect.EnumType {
+	return &file_sqlrpc_v1_enums_proto_enumTypes[9]
+}
+
+func (x ReplicationRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReplicationRole.Descriptor instead.
+func (ReplicationRole) EnumDescriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_enums_proto_rawDescGZIP(), []int{9}
+}
+
+// *
+// ColumnAction is what happens when a restricted caller reads a column.
+type ColumnAction int32
+
+const (
+	ColumnAction_COLUMN_ACTION_UNSPECIFIED ColumnAction = 0
+	// Statements that read the column fail with permission_denied.
+	ColumnAction_COLUMN_ACTION_DENY ColumnAction = 1
+	// The column reads as NULL.
+	ColumnAction_COLUMN_ACTION_MASK ColumnAction = 2
+)
+
+// Enum value maps for ColumnAction.
+var (
+	ColumnAction_name = map[int32]string{
+		0: "COLUMN_ACTION_UNSPECIFIED",
+		1: "COLUMN_ACTION_DENY",
+		2: "COLUMN_ACTION_MASK",
+	}
+	ColumnAction_value = map[string]int32{
+		"COLUMN_ACTION_UNSPECIFIED": 0,
+		"COLUMN_ACTION_DENY":        1,
+		"COLUMN_ACTION_MASK":        2,
+	}
+)
+
+func (x ColumnAction) Enum() *ColumnAction {
+	p := new(ColumnAction)
+	*p = x
+	return p
+}
+
+func (x ColumnAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ColumnAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_sqlrpc_v1_enums_proto_enumTypes[10].Descriptor()
+}
+
+func (ColumnAction) Type() protoreflect.EnumType {
+	return &file_sqlrpc_v1_enums_proto_enumTypes[10]
+}
+
+func (x ColumnAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ColumnAction.Descriptor instead.
+func (ColumnAction) EnumDescriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_enums_proto_rawDescGZIP(), []int{10}
+}
+
+// *
+// TransactionKind tells how a client drives a transaction.
+type TransactionKind int32
+
+const (
+	TransactionKind_TRANSACTION_KIND_UNSPECIFIED TransactionKind = 0
+	// BeginTransaction followed by unary calls carrying the transaction ID.
+	TransactionKind_TRANSACTION_KIND_ID_BASED TransactionKind = 1
+	// A bidirectional Transaction stream.
+	TransactionKind_TRANSACTION_KIND_STREAM TransactionKind = 2
+)
+
+// Enum value maps for TransactionKind.
+var (
+	TransactionKind_name = map[int32]string{
+		0: "TRANSACTION_KIND_UNSPECIFIED",
+		1: "TRANSACTION_KIND_ID_BASED",
+		2: "TRANSACTION_KIND_STREAM",
+	}
+	TransactionKind_value = map[string]int32{
+		"TRANSACTION_KIND_UNSPECIFIED": 0,
+		"TRANSACTION_KIND_ID_BASED":    1,
+		"TRANSACTION_KIND_STREAM":      2,
+	}
+)
+
+func (x TransactionKind) Enum() *TransactionKind {
+	p := new(TransactionKind)
+	*p = x
+	return p
+}
+
+func (x TransactionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_sqlrpc_v1_enums_proto_enumTypes[11].Descriptor()
+}
+
+func (TransactionKind) Type() protoreflect.EnumType {
+	return &file_sqlrpc_v1_enums_proto_enumTypes[11]
+}
+
+func (x TransactionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionKind.Descriptor instead.
+func (TransactionKind) EnumDescriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_enums_proto_rawDescGZIP(), []int{11}
+}
+
+// *
+// LogLevel is the minimum severity of server log records.
+type LogLevel int32
+
+const (
+	// Default value. In SetLogLevel it clears a subsystem override.
+	LogLevel_LOG_LEVEL_UNSPECIFIED LogLevel = 0
+	// Verbose diagnostics.
+	LogLevel_LOG_LEVEL_DEBUG LogLevel = 1
+	// Normal operation.
+	LogLevel_LOG_LEVEL_INFO LogLevel = 2
+	// Unexpected but handled conditions.
+	LogLevel_LOG_LEVEL_WARN LogLevel = 3
+	// Failures.
+	LogLevel_LOG_LEVEL_ERROR LogLevel = 4
+)
+
+// Enum value maps for LogLevel.
+var (
+	LogLevel_name = map[int32]string{
+		0: "LOG_LEVEL_UNSPECIFIED",
+		1: "LOG_LEVEL_DEBUG",
+		2: "LOG_LEVEL_INFO",
+		3: "LOG_LEVEL_WARN",
+		4: "LOG_LEVEL_ERROR",
+	}
+	LogLevel_value = map[string]int32{
+		"LOG_LEVEL_UNSPECIFIED": 0,
+		"LOG_LEVEL_DEBUG":       1,
+		"LOG_LEVEL_INFO":        2,
+		"LOG_LEVEL_WARN":        3,
+		"LOG_LEVEL_ERROR":       4,
+	}
+)
+
+func (x LogLevel) Enum() *LogLevel {
+	p := new(LogLevel)
+	*p = x
+	return p
+}
+
+func (x LogLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LogLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_sqlrpc_v1_enums_proto_enumTypes[12].Descriptor()
+}
+
+func (LogLevel) Type() protoreflect.EnumType {
+	return &file_sqlrpc_v1_enums_proto_enumTypes[12]
+}
+
+func (x LogLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LogLevel.Descriptor instead.
+func (LogLevel) EnumDescriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_enums_proto_rawDescGZIP(), []int{12}
+}
+
+// *
+// ImportFormat is the encoding of the records sent to Import.
+type ImportFormat int32
+
+const (
+	ImportFormat_IMPORT_FORMAT_UNSPECIFIED ImportFormat = 0
+	// RFC 4180 CSV text sent in data chunks.
+	ImportFormat_IMPORT_FORMAT_CSV ImportFormat = 1
+	// One JSON object per line, sent in data chunks.
+	ImportFormat_IMPORT_FORMAT_NDJSON ImportFormat = 2
+	// Typed SqlRow batches.
+	ImportFormat_IMPORT_FORMAT_ROWS ImportFormat = 3
+)
+
+// Enum value maps for ImportFormat.
+var (
+	ImportFormat_name = map[int32]string{
+		0: "IMPORT_FORMAT_UNSPECIFIED",
+		1: "IMPORT_FORMAT_CSV",
+		2: "IMPORT_FORMAT_NDJSON",
+		3: "IMPORT_FORMAT_ROWS",
+	}
+	ImportFormat_value = map[string]int32{
+		"IMPORT_FORMAT_UNSPECIFIED": 0,
+		"IMPORT_FORMAT_CSV":         1,
+		"IMPORT_FORMAT_NDJSON":      2,
+		"IMPORT_FORMAT_ROWS":        3,
+	}
+)
+
+func (x ImportFormat) Enum() *ImportFormat {
+	p := new(ImportFormat)
+	*p = x
+	return p
+}
+
+func (x ImportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_sqlrpc_v1_enums_proto_enumTypes[13].Descriptor()
+}
+
+func (ImportFormat) Type() protoreflect.EnumType {
+	return &file_sqlrpc_v1_enums_proto_enumTypes[13]
+}
+
+func (x ImportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportFormat.Descriptor instead.
+func (ImportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_enums_proto_rawDescGZIP(), []int{13}
+}
+
+// *
+// ImportConflict is what Import does with a record that violates a uniqueness
+// constraint.
+type ImportConflict int32
+
+const (
+	// Default value, same as IMPORT_CONFLICT_ABORT.
+	ImportConflict_IMPORT_CONFLICT_UNSPECIFIED ImportConflict = 0
+	// The record is rejected and reported.
+	ImportConflict_IMPORT_CONFLICT_ABORT ImportConflict = 1
+	// The record is skipped silently (INSERT OR IGNORE).
+	ImportConflict_IMPORT_CONFLICT_IGNORE ImportConflict = 2
+	// The record replaces the existing row (INSERT OR REPLACE).
+	ImportConflict_IMPORT_CONFLICT_REPLACE ImportConflict = 3
+)
+
+// Enum value maps for ImportConflict.
+var (
+	ImportConflict_name = map[int32]string{
+		0: "IMPORT_CONFLICT_UNSPECIFIED",
+		1: "IMPORT_CONFLICT_ABORT",
+		2: "IMPORT_CONFLICT_IGNORE",
+		3: "IMPORT_CONFLICT_REPLACE",
+	}
+	ImportConflict_value = map[string]int32{
+		"IMPORT_CONFLICT_UNSPECIFIED": 0,
+		"IMPORT_CONFLICT_ABORT":       1,
+		"IMPORT_CONFLICT_IGNORE":      2,
+		"IMPORT_CONFLICT_REPLACE":     3,
+	}
+)
+
+func (x ImportConflict) Enum() *ImportConflict {
+	p := new(ImportConflict)
+	*p = x
+	return p
+}
+
+func (x ImportConflict) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportConflict) Descriptor() protoreflect.EnumDescriptor {
+	return file_sqlrpc_v1_enums_proto_enumTypes[14].Descriptor()
+}
+
+func (ImportConflict) Type() protoreflect.EnumType {
+	return &file_sqlrpc_v1_enums_proto_enumTypes[14]
+}
+
+func (x ImportConflict) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportConflict.Descriptor instead.
+func (ImportConflict) EnumDescriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_enums_proto_rawDescGZIP(), []int{14}
+}
+
 var File_sqlrpc_v1_enums_proto protoreflect.FileDescriptor
 
 var file_sqlrpc_v1_enums_proto_rawDesc = []byte{
@@ -782,11 +1297,82 @@ var file_sqlrpc_v1_enums_proto_rawDesc = []byte{
 	0x0a, 0x0f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x57, 0x52, 0x49, 0x54,
 	0x45, 0x10, 0x14, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x41,
 	0x42, 0x41, 0x53, 0x45, 0x5f, 0x4d, 0x41, 0x4e, 0x41, 0x47, 0x45, 0x52, 0x10, 0x1e, 0x12, 0x0e,
-	0x0a, 0x0a, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x28, 0x42, 0x32,
-	0x5a, 0x30, 0x73, 0x71, 0x6c, 0x69, 0x74, 0x65, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f,
-	0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63,
-	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0a, 0x0a, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x28, 0x2a, 0x85,
+	0x01, 0x0a, 0x09, 0x52, 0x70, 0x63, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x16,
+	0x52, 0x50, 0x43, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x50, 0x43, 0x5f,
+	0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x10, 0x01, 0x12, 0x16,
+	0x0a, 0x12, 0x52, 0x50, 0x43, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x50, 0x55, 0x42,
+	0x4c, 0x49, 0x53, 0x48, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x50, 0x43, 0x5f, 0x46, 0x41,
+	0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x42, 0x45, 0x10, 0x03,
+	0x12, 0x14, 0x0a, 0x10, 0x52, 0x50, 0x43, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x41,
+	0x44, 0x4d, 0x49, 0x4e, 0x10, 0x04, 0x2a, 0x71, 0x0a, 0x11, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x1e, 0x42,
+	0x41, 0x43, 0x4b, 0x55, 0x50, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1b, 0x0a, 0x17, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x52, 0x45,
+	0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17,
+	0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x52, 0x45, 0x53, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x47, 0x5a, 0x49, 0x50, 0x10, 0x02, 0x2a, 0xb4, 0x01, 0x0a, 0x0f, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x20, 0x0a,
+	0x1c, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x54, 0x41, 0x53,
+	0x4b, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1f, 0x0a, 0x1b, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x54,
+	0x41, 0x53, 0x4b, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x01,
+	0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x5f,
+	0x54, 0x41, 0x53, 0x4b, 0x5f, 0x56, 0x41, 0x43, 0x55, 0x55, 0x4d, 0x10, 0x02, 0x12, 0x24, 0x0a,
+	0x20, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x54, 0x41, 0x53,
+	0x4b, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x43, 0x48, 0x45, 0x43,
+	0x4b, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e,
+	0x43, 0x45, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x10, 0x04,
+	0x2a, 0x6f, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x6f, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4c, 0x45, 0x41, 0x44, 0x45, 0x52,
+	0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x52, 0x10,
+	0x02, 0x2a, 0x5d, 0x0a, 0x0c, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4f, 0x4c, 0x55, 0x4d, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4c, 0x55, 0x4d, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x44, 0x45, 0x4e, 0x59, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4c, 0x55,
+	0x4d, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x53, 0x4b, 0x10, 0x02,
+	0x2a, 0x6f, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4b,
+	0x69, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x49, 0x44, 0x5f, 0x42, 0x41, 0x53,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x10,
+	0x02, 0x2a, 0x77, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x19, 0x0a,
+	0x15, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x4f, 0x47, 0x5f,
+	0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x01, 0x12, 0x12, 0x0a,
+	0x0e, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10,
+	0x02, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x57,
+	0x41, 0x52, 0x4e, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56,
+	0x45, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x04, 0x2a, 0x76, 0x0a, 0x0c, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4d,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4d, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x01,
+	0x12, 0x18, 0x0a, 0x14, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x4e, 0x44, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4d,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x52, 0x4f, 0x57, 0x53,
+	0x10, 0x03, 0x2a, 0x85, 0x01, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x10,
+	0x01, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46,
+	0x4c, 0x49, 0x43, 0x54, 0x5f, 0x49, 0x47, 0x4e, 0x4f, 0x52, 0x45, 0x10, 0x02, 0x12, 0x1b, 0x0a,
+	0x17, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54,
+	0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x10, 0x03, 0x42, 0x32, 0x5a, 0x30, 0x73, 0x71,
+	0x6c, 0x69, 0x74, 0x65, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x73, 0x71, 0x6c, 0x72,
+	0x70, 0x63, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -801,7 +1387,7 @@ func file_sqlrpc_v1_enums_proto_rawDescGZIP() []byte {
 	return file_sqlrpc_v1_enums_proto_rawDescData
 }
 
-var file_sqlrpc_v1_enums_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_sqlrpc_v1_enums_proto_enumTypes = make([]protoimpl.EnumInfo, 15)
 var file_sqlrpc_v1_enums_proto_goTypes = []any{
 	(SqliteCode)(0),          // 0: sqlrpc.v1.SqliteCode
 	(TransactionLockMode)(0), // 1: sqlrpc.v1.TransactionLockMode
@@ -809,6 +1395,15 @@ var file_sqlrpc_v1_enums_proto_goTypes = []any{
 	(ColumnAffinity)(0),      // 3: sqlrpc.v1.ColumnAffinity
 	(DeclaredType)(0),        // 4: sqlrpc.v1.DeclaredType
 	(Role)(0),                // 5: sqlrpc.v1.Role
+	(RpcFamily)(0),           // 6: sqlrpc.v1.RpcFamily
+	(BackupCompression)(0),   // 7: sqlrpc.v1.BackupCompression
+	(MaintenanceTask)(0),     // 8: sqlrpc.v1.MaintenanceTask
+	(ReplicationRole)(0),     // 9: sqlrpc.v1.ReplicationRole
+	(ColumnAction)(0),        // 10: sqlrpc.v1.ColumnAction
+	(TransactionKind)(0),     // 11: sqlrpc.v1.TransactionKind
+	(LogLevel)(0),            // 12: sqlrpc.v1.LogLevel
+	(ImportFormat)(0),        // 13: sqlrpc.v1.ImportFormat
+	(ImportConflict)(0),      // 14: sqlrpc.v1.ImportConflict
 }
 var file_sqlrpc_v1_enums_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -828,7 +1423,7 @@ func file_sqlrpc_v1_enums_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sqlrpc_v1_enums_proto_rawDesc,
-			NumEnums:      6,
+			NumEnums:      15,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -42,6 +42,8 @@ const (
 	AdminServiceUpdateUserRoleProcedure = "/sqlrpc.v1.AdminService/UpdateUserRole"
 	// AdminServiceDeleteUserProcedure is the fully-qualified name of the AdminService's DeleteUser RPC.
 	AdminServiceDeleteUserProcedure = "/sqlrpc.v1.AdminService/DeleteUser"
+	// AdminServiceUnlockUserProcedure is the fully-qualified name of the AdminService's UnlockUser RPC.
+	AdminServiceUnlockUserProcedure = "/sqlrpc.v1.AdminService/UnlockUser"
 	// AdminServiceListAPIKeysProcedure is the fully-qualified name of the AdminService's ListAPIKeys
 	// RPC.
 	AdminServiceListAPIKeysProcedure = "/sqlrpc.v1.AdminService/ListAPIKeys"
@@ -51,6 +53,42 @@ const (
 	// AdminServiceDeleteAPIKeyProcedure is the fully-qualified name of the AdminService's DeleteAPIKey
 	// RPC.
 	AdminServiceDeleteAPIKeyProcedure = "/sqlrpc.v1.AdminService/DeleteAPIKey"
+	// AdminServiceGrantDatabaseAccessProcedure is the fully-qualified name of the AdminService's
+	// GrantDatabaseAccess RPC.
+	AdminServiceGrantDatabaseAccessProcedure = "/sqlrpc.v1.AdminService/GrantDatabaseAccess"
+	// AdminServiceRevokeDatabaseAccessProcedure is the fully-qualified name of the AdminService's
+	// RevokeDatabaseAccess RPC.
+	AdminServiceRevokeDatabaseAccessProcedure = "/sqlrpc.v1.AdminService/RevokeDatabaseAccess"
+	// AdminServiceListDatabaseGrantsProcedure is the fully-qualified name of the AdminService's
+	// ListDatabaseGrants RPC.
+	AdminServiceListDatabaseGrantsProcedure = "/sqlrpc.v1.AdminService/ListDatabaseGrants"
+	// AdminServiceSetRateLimitProcedure is the fully-qualified name of the AdminService's SetRateLimit
+	// RPC.
+	AdminServiceSetRateLimitProcedure = "/sqlrpc.v1.AdminService/SetRateLimit"
+	// AdminServiceDeleteRateLimitProcedure is the fully-qualified name of the AdminService's
+	// DeleteRateLimit RPC.
+	AdminServiceDeleteRateLimitProcedure = "/sqlrpc.v1.AdminService/DeleteRateLimit"
+	// AdminServiceListRateLimitsProcedure is the fully-qualified name of the AdminService's
+	// ListRateLimits RPC.
+	AdminServiceListRateLimitsProcedure = "/sqlrpc.v1.AdminService/ListRateLimits"
+	// AdminServiceCreateRowPolicyProcedure is the fully-qualified name of the AdminService's
+	// CreateRowPolicy RPC.
+	AdminServiceCreateRowPolicyProcedure = "/sqlrpc.v1.AdminService/CreateRowPolicy"
+	// AdminServiceDeleteRowPolicyProcedure is the fully-qualified name of the AdminService's
+	// DeleteRowPolicy RPC.
+	AdminServiceDeleteRowPolicyProcedure = "/sqlrpc.v1.AdminService/DeleteRowPolicy"
+	// AdminServiceListRowPoliciesProcedure is the fully-qualified name of the AdminService's
+	// ListRowPolicies RPC.
+	AdminServiceListRowPoliciesProcedure = "/sqlrpc.v1.AdminService/ListRowPolicies"
+	// AdminServiceSetColumnPolicyProcedure is the fully-qualified name of the AdminService's
+	// SetColumnPolicy RPC.
+	AdminServiceSetColumnPolicyProcedure = "/sqlrpc.v1.AdminService/SetColumnPolicy"
+	// AdminServiceDeleteColumnPolicyProcedure is the fully-qualified name of the AdminService's
+	// DeleteColumnPolicy RPC.
+	AdminServiceDeleteColumnPolicyProcedure = "/sqlrpc.v1.AdminService/DeleteColumnPolicy"
+	// AdminServiceListColumnPoliciesProcedure is the fully-qualified name of the AdminService's
+	// ListColumnPolicies RPC.
+	AdminServiceListColumnPoliciesProcedure = "/sqlrpc.v1.AdminService/ListColumnPolicies"
 	// AdminServiceListDatabasesProcedure is the fully-qualified name of the AdminService's
 	// ListDatabases RPC.
 	AdminServiceListDatabasesProcedure = "/sqlrpc.v1.AdminService/ListDatabases"
@@ -69,9 +107,30 @@ const (
 	// AdminServiceUnMountDatabaseProcedure is the fully-qualified name of the AdminService's
 	// UnMountDatabase RPC.
 	AdminServiceUnMountDatabaseProcedure = "/sqlrpc.v1.AdminService/UnMountDatabase"
+	// AdminServiceListMaintenanceRunsProcedure is the fully-qualified name of the AdminService's
+	// ListMaintenanceRuns RPC.
+	AdminServiceListMaintenanceRunsProcedure = "/sqlrpc.v1.AdminService/ListMaintenanceRuns"
+	// AdminServiceCancelQueryProcedure is the fully-qualified name of the AdminService's CancelQuery
+	// RPC.
+	AdminServiceCancelQueryProcedure = "/sqlrpc.v1.AdminService/CancelQuery"
+	// AdminServiceListActiveOperationsProcedure is the fully-qualified name of the AdminService's
+	// ListActiveOperations RPC.
+	AdminServiceListActiveOperationsProcedure = "/sqlrpc.v1.AdminService/ListActiveOperations"
+	// AdminServiceForceRollbackTransactionProcedure is the fully-qualified name of the AdminService's
+	// ForceRollbackTransaction RPC.
+	AdminServiceForceRollbackTransactionProcedure = "/sqlrpc.v1.AdminService/ForceRollbackTransaction"
+	// AdminServiceListAuditEventsProcedure is the fully-qualified name of the AdminService's
+	// ListAuditEvents RPC.
+	AdminServiceListAuditEventsProcedure = "/sqlrpc.v1.AdminService/ListAuditEvents"
 	// AdminServiceGetServerInfoProcedure is the fully-qualified name of the AdminService's
 	// GetServerInfo RPC.
 	AdminServiceGetServerInfoProcedure = "/sqlrpc.v1.AdminService/GetServerInfo"
+	// AdminServiceGetLogLevelsProcedure is the fully-qualified name of the AdminService's GetLogLevels
+	// RPC.
+	AdminServiceGetLogLevelsProcedure = "/sqlrpc.v1.AdminService/GetLogLevels"
+	// AdminServiceSetLogLevelProcedure is the fully-qualified name of the AdminService's SetLogLevel
+	// RPC.
+	AdminServiceSetLogLevelProcedure = "/sqlrpc.v1.AdminService/SetLogLevel"
 	// AdminServiceLoginProcedure is the fully-qualified name of the AdminService's Login RPC.
 	AdminServiceLoginProcedure = "/sqlrpc.v1.AdminService/Login"
 	// AdminServiceLogoutProcedure is the fully-qualified name of the AdminService's Logout RPC.
@@ -79,6 +138,9 @@ const (
 	// AdminServiceUpdatePasswordProcedure is the fully-qualified name of the AdminService's
 	// UpdatePassword RPC.
 	AdminServiceUpdatePasswordProcedure = "/sqlrpc.v1.AdminService/UpdatePassword"
+	// AdminServiceStreamReplicationProcedure is the fully-qualified name of the AdminService's
+	// StreamReplication RPC.
+	AdminServiceStreamReplicationProcedure = "/sqlrpc.v1.AdminService/StreamReplication"
 )
 
 // AdminServiceClient is a client for the sqlrpc.v1.AdminService service.
@@ -100,6 +162,11 @@ type AdminServiceClient interface {
 	// Permanently removes a user and invalidates all their associated API keys.
 	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error)
 	// *
+	// User Management: Unlock.
+	// Clears the failed login attempts and lockout of a username and, optionally,
+	// of a client IP address.
+	UnlockUser(context.Context, *connect.Request[v1.UnlockUserRequest]) (*connect.Response[v1.UnlockUserResponse], error)
+	// *
 	// API Key Management: List.
 	// Returns all active API keys for a specific user.
 	ListAPIKeys(context.Context, *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error)
@@ -112,6 +179,60 @@ type AdminServiceClient interface {
 	// Revokes a specific API key immediately.
 	DeleteAPIKey(context.Context, *connect.Request[v1.DeleteAPIKeyRequest]) (*connect.Response[v1.DeleteAPIKeyResponse], error)
 	// *
+	// Access Control: Grant.
+	// Assigns a per-database role to a user or a single API key. Granting the
+	// same database pattern again replaces the previous role.
+	GrantDatabaseAccess(context.Context, *connect.Request[v1.GrantDatabaseAccessRequest]) (*connect.Response[v1.GrantDatabaseAccessResponse], error)
+	// *
+	// Access Control: Revoke.
+	// Removes a per-database grant immediately.
+	RevokeDatabaseAccess(context.Context, *connect.Request[v1.RevokeDatabaseAccessRequest]) (*connect.Response[v1.RevokeDatabaseAccessResponse], error)
+	// *
+	// Access Control: List.
+	// Returns the per-database grants, optionally filtered by user or database.
+	ListDatabaseGrants(context.Context, *connect.Request[v1.ListDatabaseGrantsRequest]) (*connect.Response[v1.ListDatabaseGrantsResponse], error)
+	// *
+	// Rate Limits: Set.
+	// Caps the requests, concurrent streams and transactions, and returned rows
+	// and bytes of a user or a single API key on matching databases. Setting the
+	// same database pattern again replaces the previous limits.
+	SetRateLimit(context.Context, *connect.Request[v1.SetRateLimitRequest]) (*connect.Response[v1.SetRateLimitResponse], error)
+	// *
+	// Rate Limits: Delete.
+	// Removes a rate limit immediately.
+	DeleteRateLimit(context.Context, *connect.Request[v1.DeleteRateLimitRequest]) (*connect.Response[v1.DeleteRateLimitResponse], error)
+	// *
+	// Rate Limits: List.
+	// Returns the rate limits, optionally filtered by user or database.
+	ListRateLimits(context.Context, *connect.Request[v1.ListRateLimitsRequest]) (*connect.Response[v1.ListRateLimitsResponse], error)
+	// *
+	// Row Policies: Create.
+	// Restricts the rows of a table that non-admin callers can read, update and
+	// delete to those matching a predicate, which may call current_user() and
+	// current_role(). A row is visible when any policy of its table allows it.
+	CreateRowPolicy(context.Context, *connect.Request[v1.CreateRowPolicyRequest]) (*connect.Response[v1.CreateRowPolicyResponse], error)
+	// *
+	// Row Policies: Delete.
+	// Removes a row policy immediately.
+	DeleteRowPolicy(context.Context, *connect.Request[v1.DeleteRowPolicyRequest]) (*connect.Response[v1.DeleteRowPolicyResponse], error)
+	// *
+	// Row Policies: List.
+	// Returns the row policies, optionally of a single database.
+	ListRowPolicies(context.Context, *connect.Request[v1.ListRowPoliciesRequest]) (*connect.Response[v1.ListRowPoliciesResponse], error)
+	// *
+	// Column Policies: Set.
+	// Denies or masks reads of a table column for a role or a single user on a
+	// database. Setting the same column and subject again replaces the action.
+	SetColumnPolicy(context.Context, *connect.Request[v1.SetColumnPolicyRequest]) (*connect.Response[v1.SetColumnPolicyResponse], error)
+	// *
+	// Column Policies: Delete.
+	// Removes a column policy immediately.
+	DeleteColumnPolicy(context.Context, *connect.Request[v1.DeleteColumnPolicyRequest]) (*connect.Response[v1.DeleteColumnPolicyResponse], error)
+	// *
+	// Column Policies: List.
+	// Returns the column policies, optionally of a single database.
+	ListColumnPolicies(context.Context, *connect.Request[v1.ListColumnPoliciesRequest]) (*connect.Response[v1.ListColumnPoliciesResponse], error)
+	// *
 	// Database Lifecycle: List.
 	// Returns all managed tenant databases in the platform.
 	ListDatabases(context.Context, *connect.Request[v1.ListDatabasesRequest]) (*connect.Response[v1.ListDatabasesResponse], error)
@@ -137,10 +258,43 @@ type AdminServiceClient interface {
 	// file.
 	UnMountDatabase(context.Context, *connect.Request[v1.UnMountDatabaseRequest]) (*connect.Response[v1.UnMountDatabaseResponse], error)
 	// *
+	// Maintenance: List runs.
+	// Returns the results of scheduled maintenance jobs, newest first.
+	ListMaintenanceRuns(context.Context, *connect.Request[v1.ListMaintenanceRunsRequest]) (*connect.Response[v1.ListMaintenanceRunsResponse], error)
+	// *
+	// Queries: Cancel.
+	// Interrupts the in-flight statements started by the request with the given
+	// X-Request-Id. The interrupted call fails with CANCELED.
+	CancelQuery(context.Context, *connect.Request[v1.CancelQueryRequest]) (*connect.Response[v1.CancelQueryResponse], error)
+	// *
+	// Queries: List active operations.
+	// Returns the statements running right now and every open transaction, both
+	// ID-based and bidirectional streams.
+	ListActiveOperations(context.Context, *connect.Request[v1.ListActiveOperationsRequest]) (*connect.Response[v1.ListActiveOperationsResponse], error)
+	// *
+	// Queries: Force rollback.
+	// Rolls back another caller's open transaction. Stream transactions end with
+	// ABORTED.
+	ForceRollbackTransaction(context.Context, *connect.Request[v1.ForceRollbackTransactionRequest]) (*connect.Response[v1.ForceRollbackTransactionResponse], error)
+	// *
+	// Audit: List events.
+	// Returns recorded administrative and data-changing operations, newest
+	// first.
+	ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error)
+	// *
 	// Platform Info.
 	// Returns global system metadata, versioning, and status.
 	GetServerInfo(context.Context, *connect.Request[v1.GetServerInfoRequest]) (*connect.Response[v1.ServerInfo], error)
 	// *
+	// Logging: Get levels.
+	// Returns the default log level and the effective level of every subsystem.
+	GetLogLevels(context.Context, *connect.Request[v1.GetLogLevelsRequest]) (*connect.Response[v1.GetLogLevelsResponse], error)
+	// *
+	// Logging: Set level.
+	// Changes the default log level or overrides it for one subsystem. Changes
+	// take effect immediately and last until the server restarts.
+	SetLogLevel(context.Context, *connect.Request[v1.SetLogLevelRequest]) (*connect.Response[v1.SetLogLevelResponse], error)
+	// *
 	// Authentication: Login.
 	// Authenticates credentials and yields a session token/API key.
 	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error)
@@ -152,6 +306,12 @@ type AdminServiceClient interface {
 	// User Management: Update Password.
 	// Enables a user to modify their own credential or an admin to reset it.
 	UpdatePassword(context.Context, *connect.Request[v1.UpdatePasswordRequest]) (*connect.Response[v1.UpdatePasswordResponse], error)
+	// *
+	// Replication: Stream changes.
+	// Streams page-level changes of every mounted database to a follower
+	// started with --role=follower. The first frame per database is a full
+	// snapshot; later frames only carry pages that changed.
+	StreamReplication(context.Context, *connect.Request[v1.StreamReplicationRequest]) (*connect.ServerStreamForClient[v1.ReplicationFrame], error)
 }
 
 // NewAdminServiceClient constructs a client for the sqlrpc.v1.AdminService service. By default, it
@@ -189,6 +349,12 @@ func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(adminServiceMethods.ByName("DeleteUser")),
 			connect.WithClientOptions(opts...),
 		),
+		unlockUser: connect.NewClient[v1.UnlockUserRequest, v1.UnlockUserResponse](
+			httpClient,
+			baseURL+AdminServiceUnlockUserProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("UnlockUser")),
+			connect.WithClientOptions(opts...),
+		),
 		listAPIKeys: connect.NewClient[v1.ListAPIKeysRequest, v1.ListAPIKeysResponse](
 			httpClient,
 			baseURL+AdminServiceListAPIKeysProcedure,
@@ -207,6 +373,78 @@ func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(adminServiceMethods.ByName("DeleteAPIKey")),
 			connect.WithClientOptions(opts...),
 		),
+		grantDatabaseAccess: connect.NewClient[v1.GrantDatabaseAccessRequest, v1.GrantDatabaseAccessResponse](
+			httpClient,
+			baseURL+AdminServiceGrantDatabaseAccessProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GrantDatabaseAccess")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeDatabaseAccess: connect.NewClient[v1.RevokeDatabaseAccessRequest, v1.RevokeDatabaseAccessResponse](
+			httpClient,
+			baseURL+AdminServiceRevokeDatabaseAccessProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("RevokeDatabaseAccess")),
+			connect.WithClientOptions(opts...),
+		),
+		listDatabaseGrants: connect.NewClient[v1.ListDatabaseGrantsRequest, v1.ListDatabaseGrantsResponse](
+			httpClient,
+			baseURL+AdminServiceListDatabaseGrantsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListDatabaseGrants")),
+			connect.WithClientOptions(opts...),
+		),
+		setRateLimit: connect.NewClient[v1.SetRateLimitRequest, v1.SetRateLimitResponse](
+			httpClient,
+			baseURL+AdminServiceSetRateLimitProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SetRateLimit")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteRateLimit: connect.NewClient[v1.DeleteRateLimitRequest, v1.DeleteRateLimitResponse](
+			httpClient,
+			baseURL+AdminServiceDeleteRateLimitProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("DeleteRateLimit")),
+			connect.WithClientOptions(opts...),
+		),
+		listRateLimits: connect.NewClient[v1.ListRateLimitsRequest, v1.ListRateLimitsResponse](
+			httpClient,
+			baseURL+AdminServiceListRateLimitsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListRateLimits")),
+			connect.WithClientOptions(opts...),
+		),
+		createRowPolicy: connect.NewClient[v1.CreateRowPolicyRequest, v1.CreateRowPolicyResponse](
+			httpClient,
+			baseURL+AdminServiceCreateRowPolicyProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("CreateRowPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteRowPolicy: connect.NewClient[v1.DeleteRowPolicyRequest, v1.DeleteRowPolicyResponse](
+			httpClient,
+			baseURL+AdminServiceDeleteRowPolicyProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("DeleteRowPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		listRowPolicies: connect.NewClient[v1.ListRowPoliciesRequest, v1.ListRowPoliciesResponse](
+			httpClient,
+			baseURL+AdminServiceListRowPoliciesProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListRowPolicies")),
+			connect.WithClientOptions(opts...),
+		),
+		setColumnPolicy: connect.NewClient[v1.SetColumnPolicyRequest, v1.SetColumnPolicyResponse](
+			httpClient,
+			baseURL+AdminServiceSetColumnPolicyProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SetColumnPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteColumnPolicy: connect.NewClient[v1.DeleteColumnPolicyRequest, v1.DeleteColumnPolicyResponse](
+			httpClient,
+			baseURL+AdminServiceDeleteColumnPolicyProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("DeleteColumnPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		listColumnPolicies: connect.NewClient[v1.ListColumnPoliciesRequest, v1.ListColumnPoliciesResponse](
+			httpClient,
+			baseURL+AdminServiceListColumnPoliciesProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListColumnPolicies")),
+			connect.WithClientOptions(opts...),
+		),
 		listDatabases: connect.NewClient[v1.ListDatabasesRequest, v1.ListDatabasesResponse](
 			httpClient,
 			baseURL+AdminServiceListDatabasesProcedure,
@@ -243,12 +481,54 @@ func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(adminServiceMethods.ByName("UnMountDatabase")),
 			connect.WithClientOptions(opts...),
 		),
+		listMaintenanceRuns: connect.NewClient[v1.ListMaintenanceRunsRequest, v1.ListMaintenanceRunsResponse](
+			httpClient,
+			baseURL+AdminServiceListMaintenanceRunsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListMaintenanceRuns")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelQuery: connect.NewClient[v1.CancelQueryRequest, v1.CancelQueryResponse](
+			httpClient,
+			baseURL+AdminServiceCancelQueryProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("CancelQuery")),
+			connect.WithClientOptions(opts...),
+		),
+		listActiveOperations: connect.NewClient[v1.ListActiveOperationsRequest, v1.ListActiveOperationsResponse](
+			httpClient,
+			baseURL+AdminServiceListActiveOperationsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListActiveOperations")),
+			connect.WithClientOptions(opts...),
+		),
+		forceRollbackTransaction: connect.NewClient[v1.ForceRollbackTransactionRequest, v1.ForceRollbackTransactionResponse](
+			httpClient,
+			baseURL+AdminServiceForceRollbackTransactionProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ForceRollbackTransaction")),
+			connect.WithClientOptions(opts...),
+		),
+		listAuditEvents: connect.NewClient[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse](
+			httpClient,
+			baseURL+AdminServiceListAuditEventsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListAuditEvents")),
+			connect.WithClientOptions(opts...),
+		),
 		getServerInfo: connect.NewClient[v1.GetServerInfoRequest, v1.ServerInfo](
 			httpClient,
 			baseURL+AdminServiceGetServerInfoProcedure,
 			connect.WithSchema(adminServiceMethods.ByName("GetServerInfo")),
 			connect.WithClientOptions(opts...),
 		),
+		getLogLevels: connect.NewClient[v1.GetLogLevelsRequest, v1.GetLogLevelsResponse](
+			httpClient,
+			baseURL+AdminServiceGetLogLevelsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetLogLevels")),
+			connect.WithClientOptions(opts...),
+		),
+		setLogLevel: connect.NewClient[v1.SetLogLevelRequest, v1.SetLogLevelResponse](
+			httpClient,
+			baseURL+AdminServiceSetLogLevelProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SetLogLevel")),
+			connect.WithClientOptions(opts...),
+		),
 		login: connect.NewClient[v1.LoginRequest, v1.LoginResponse](
 			httpClient,
 			baseURL+AdminServiceLoginProcedure,
@@ -267,28 +547,55 @@ func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(adminServiceMethods.ByName("UpdatePassword")),
 			connect.WithClientOptions(opts...),
 		),
+		streamReplication: connect.NewClient[v1.StreamReplicationRequest, v1.ReplicationFrame](
+			httpClient,
+			baseURL+AdminServiceStreamReplicationProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("StreamReplication")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // adminServiceClient implements AdminServiceClient.
 type adminServiceClient struct {
-	listUsers       *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
-	createUser      *connect.Client[v1.CreateUserRequest, v1.CreateUserResponse]
-	updateUserRole  *connect.Client[v1.UpdateUserRoleRequest, v1.UpdateUserRoleResponse]
-	deleteUser      *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
-	listAPIKeys     *connect.Client[v1.ListAPIKeysRequest, v1.ListAPIKeysResponse]
-	createAPIKey    *connect.Client[v1.CreateAPIKeyRequest, v1.CreateAPIKeyResponse]
-	deleteAPIKey    *connect.Client[v1.DeleteAPIKeyRequest, v1.DeleteAPIKeyResponse]
-	listDatabases   *connect.Client[v1.ListDatabasesRequest, v1.ListDatabasesResponse]
-	createDatabase  *connect.Client[v1.CreateDatabaseRequest, v1.CreateDatabaseResponse]
-	updateDatabase  *connect.Client[v1.UpdateDatabaseRequest, v1.UpdateDatabaseResponse]
-	deleteDatabase  *connect.Client[v1.DeleteDatabaseRequest, v1.DeleteDatabaseResponse]
-	mountDatabase   *connect.Client[v1.MountDatabaseRequest, v1.MountDatabaseResponse]
-	unMountDatabase *connect.Client[v1.UnMountDatabaseRequest, v1.UnMountDatabaseResponse]
-	getServerInfo   *connect.Client[v1.GetServerInfoRequest, v1.ServerInfo]
-	login           *connect.Client[v1.LoginRequest, v1.LoginResponse]
-	logout          *connect.Client[v1.LogoutRequest, v1.LogoutResponse]
-	updatePassword  *connect.Client[v1.UpdatePasswordRequest, v1.UpdatePasswordResponse]
+	listUsers                *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
+	createUser               *connect.Client[v1.CreateUserRequest, v1.CreateUserResponse]
+	updateUserRole           *connect.Client[v1.UpdateUserRoleRequest, v1.UpdateUserRoleResponse]
+	deleteUser               *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
+	unlockUser               *connect.Client[v1.UnlockUserRequest, v1.UnlockUserResponse]
+	listAPIKeys              *connect.Client[v1.ListAPIKeysRequest, v1.ListAPIKeysResponse]
+	createAPIKey             *connect.Client[v1.CreateAPIKeyRequest, v1.CreateAPIKeyResponse]
+	deleteAPIKey             *connect.Client[v1.DeleteAPIKeyRequest, v1.DeleteAPIKeyResponse]
+	grantDatabaseAccess      *connect.Client[v1.GrantDatabaseAccessRequest, v1.GrantDatabaseAccessResponse]
+	revokeDatabaseAccess     *connect.Client[v1.RevokeDatabaseAccessRequest, v1.RevokeDatabaseAccessResponse]
+	listDatabaseGrants       *connect.Client[v1.ListDatabaseGrantsRequest, v1.ListDatabaseGrantsResponse]
+	setRateLimit             *connect.Client[v1.SetRateLimitRequest, v1.SetRateLimitResponse]
+	deleteRateLimit          *connect.Client[v1.DeleteRateLimitRequest, v1.DeleteRateLimitResponse]
+	listRateLimits           *connect.Client[v1.ListRateLimitsRequest, v1.ListRateLimitsResponse]
+	createRowPolicy          *connect.Client[v1.CreateRowPolicyRequest, v1.CreateRowPolicyResponse]
+	deleteRowPolicy          *connect.Client[v1.DeleteRowPolicyRequest, v1.DeleteRowPolicyResponse]
+	listRowPolicies          *connect.Client[v1.ListRowPoliciesRequest, v1.ListRowPoliciesResponse]
+	setColumnPolicy          *connect.Client[v1.SetColumnPolicyRequest, v1.SetColumnPolicyResponse]
+	deleteColumnPolicy       *connect.Client[v1.DeleteColumnPolicyRequest, v1.DeleteColumnPolicyResponse]
+	listColumnPolicies       *connect.Client[v1.ListColumnPoliciesRequest, v1.ListColumnPoliciesResponse]
+	listDatabases            *connect.Client[v1.ListDatabasesRequest, v1.ListDatabasesResponse]
+	createDatabase           *connect.Client[v1.CreateDatabaseRequest, v1.CreateDatabaseResponse]
+	updateDatabase           *connect.Client[v1.UpdateDatabaseRequest, v1.UpdateDatabaseResponse]
+	deleteDatabase           *connect.Client[v1.DeleteDatabaseRequest, v1.DeleteDatabaseResponse]
+	mountDatabase            *connect.Client[v1.MountDatabaseRequest, v1.MountDatabaseResponse]
+	unMountDatabase          *connect.Client[v1.UnMountDatabaseRequest, v1.UnMountDatabaseResponse]
+	listMaintenanceRuns      *connect.Client[v1.ListMaintenanceRunsRequest, v1.ListMaintenanceRunsResponse]
+	cancelQuery              *connect.Client[v1.CancelQueryRequest, v1.CancelQueryResponse]
+	listActiveOperations     *connect.Client[v1.ListActiveOperationsRequest, v1.ListActiveOperationsResponse]
+	forceRollbackTransaction *connect.Client[v1.ForceRollbackTransactionRequest, v1.ForceRollbackTransactionResponse]
+	listAuditEvents          *connect.Client[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse]
+	getServerInfo            *connect.Client[v1.GetServerInfoRequest, v1.ServerInfo]
+	getLogLevels             *connect.Client[v1.GetLogLevelsRequest, v1.GetLogLevelsResponse]
+	setLogLevel              *connect.Client[v1.SetLogLevelRequest, v1.SetLogLevelResponse]
+	login                    *connect.Client[v1.LoginRequest, v1.LoginResponse]
+	logout                   *connect.Client[v1.LogoutRequest, v1.LogoutResponse]
+	updatePassword           *connect.Client[v1.UpdatePasswordRequest, v1.UpdatePasswordResponse]
+	streamReplication        *connect.Client[v1.StreamReplicationRequest, v1.ReplicationFrame]
 }
 
 // ListUsers calls sqlrpc.v1.AdminService.ListUsers.
@@ -311,6 +618,11 @@ func (c *adminServiceClient) DeleteUser(ctx context.Context, req *connect.Reques
 	return c.deleteUser.CallUnary(ctx, req)
 }
 
+// UnlockUser calls sqlrpc.v1.AdminService.UnlockUser.
+func (c *adminServiceClient) UnlockUser(ctx context.Context, req *connect.Request[v1.UnlockUserRequest]) (*connect.Response[v1.UnlockUserResponse], error) {
+	return c.unlockUser.CallUnary(ctx, req)
+}
+
 // ListAPIKeys calls sqlrpc.v1.AdminService.ListAPIKeys.
 func (c *adminServiceClient) ListAPIKeys(ctx context.Context, req *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error) {
 	return c.listAPIKeys.CallUnary(ctx, req)
@@ -326,6 +638,66 @@ func (c *adminServiceClient) DeleteAPIKey(ctx context.Context, req *connect.Requ
 	return c.deleteAPIKey.CallUnary(ctx, req)
 }
 
+// GrantDatabaseAccess calls sqlrpc.v1.AdminService.GrantDatabaseAccess.
+func (c *adminServiceClient) GrantDatabaseAccess(ctx context.Context, req *connect.Request[v1.GrantDatabaseAccessRequest]) (*connect.Response[v1.GrantDatabaseAccessResponse], error) {
+	return c.grantDatabaseAccess.CallUnary(ctx, req)
+}
+
+// RevokeDatabaseAccess calls sqlrpc.v1.AdminService.RevokeDatabaseAccess.
+func (c *adminServiceClient) RevokeDatabaseAccess(ctx context.Context, req *connect.Request[v1.RevokeDatabaseAccessRequest]) (*connect.Response[v1.RevokeDatabaseAccessResponse], error) {
+	return c.revokeDatabaseAccess.CallUnary(ctx, req)
+}
+
+// ListDatabaseGrants calls sqlrpc.v1.AdminService.ListDatabaseGrants.
+func (c *adminServiceClient) ListDatabaseGrants(ctx context.Context, req *connect.Request[v1.ListDatabaseGrantsRequest]) (*connect.Response[v1.ListDatabaseGrantsResponse], error) {
+	return c.listDatabaseGrants.CallUnary(ctx, req)
+}
+
+// SetRateLimit calls sqlrpc.v1.AdminService.SetRateLimit.
+func (c *adminServiceClient) SetRateLimit(ctx context.Context, req *connect.Request[v1.SetRateLimitRequest]) (*connect.Response[v1.SetRateLimitResponse], error) {
+	return c.setRateLimit.CallUnary(ctx, req)
+}
+
+// DeleteRateLimit calls sqlrpc.v1.AdminService.DeleteRateLimit.
+func (c *adminServiceClient) DeleteRateLimit(ctx context.Context, req *connect.Request[v1.DeleteRateLimitRequest]) (*connect.Response[v1.DeleteRateLimitResponse], error) {
+	return c.deleteRateLimit.CallUnary(ctx, req)
+}
+
+// ListRateLimits calls sqlrpc.v1.AdminService.ListRateLimits.
+func (c *adminServiceClient) ListRateLimits(ctx context.Context, req *connect.Request[v1.ListRateLimitsRequest]) (*connect.Response[v1.ListRateLimitsResponse], error) {
+	return c.listRateLimits.CallUnary(ctx, req)
+}
+
+// CreateRowPolicy calls sqlrpc.v1.AdminService.CreateRowPolicy.
+func (c *adminServiceClient) CreateRowPolicy(ctx context.Context, req *connect.Request[v1.CreateRowPolicyRequest]) (*connect.Response[v1.CreateRowPolicyResponse], error) {
+	return c.createRowPolicy.CallUnary(ctx, req)
+}
+
+// DeleteRowPolicy calls sqlrpc.v1.AdminService.DeleteRowPolicy.
+func (c *adminServiceClient) DeleteRowPolicy(ctx context.Context, req *connect.Request[v1.DeleteRowPolicyRequest]) (*connect.Response[v1.DeleteRowPolicyResponse], error) {
+	return c.deleteRowPolicy.CallUnary(ctx, req)
+}
+
+// ListRowPolicies calls sqlrpc.v1.AdminService.ListRowPolicies.
+func (c *adminServiceClient) ListRowPolicies(ctx context.Context, req *connect.Request[v1.ListRowPoliciesRequest]) (*connect.Response[v1.ListRowPoliciesResponse], error) {
+	return c.listRowPolicies.CallUnary(ctx, req)
+}
+
+// SetColumnPolicy calls sqlrpc.v1.AdminService.SetColumnPolicy.
+func (c *adminServiceClient) SetColumnPolicy(ctx context.Context, req *connect.Request[v1.SetColumnPolicyRequest]) (*connect.Response[v1.SetColumnPolicyResponse], error) {
+	return c.setColumnPolicy.CallUnary(ctx, req)
+}
+
+// DeleteColumnPolicy calls sqlrpc.v1.AdminService.DeleteColumnPolicy.
+func (c *adminServiceClient) DeleteColumnPolicy(ctx context.Context, req *connect.Request[v1.DeleteColumnPolicyRequest]) (*connect.Response[v1.DeleteColumnPolicyResponse], error) {
+	return c.deleteColumnPolicy.CallUnary(ctx, req)
+}
+
+// ListColumnPolicies calls sqlrpc.v1.AdminService.ListColumnPolicies.
+func (c *adminServiceClient) ListColumnPolicies(ctx context.Context, req *connect.Request[v1.ListColumnPoliciesRequest]) (*connect.Response[v1.ListColumnPoliciesResponse], error) {
+	return c.listColumnPolicies.CallUnary(ctx, req)
+}
+
 // ListDatabases calls sqlrpc.v1.AdminService.ListDatabases.
 func (c *adminServiceClient) ListDatabases(ctx context.Context, req *connect.Request[v1.ListDatabasesRequest]) (*connect.Response[v1.ListDatabasesResponse], error) {
 	return c.listDatabases.CallUnary(ctx, req)
@@ -356,11 +728,46 @@ func (c *adminServiceClient) UnMountDatabase(ctx context.Context, req *connect.R
 	return c.unMountDatabase.CallUnary(ctx, req)
 }
 
+// ListMaintenanceRuns calls sqlrpc.v1.AdminService.ListMaintenanceRuns.
+func (c *adminServiceClient) ListMaintenanceRuns(ctx context.Context, req *connect.Request[v1.ListMaintenanceRunsRequest]) (*connect.Response[v1.ListMaintenanceRunsResponse], error) {
+	return c.listMaintenanceRuns.CallUnary(ctx, req)
+}
+
+// CancelQuery calls sqlrpc.v1.AdminService.CancelQuery.
+func (c *adminServiceClient) CancelQuery(ctx context.Context, req *connect.Request[v1.CancelQueryRequest]) (*connect.Response[v1.CancelQueryResponse], error) {
+	return c.cancelQuery.CallUnary(ctx, req)
+}
+
+// ListActiveOperations calls sqlrpc.v1.AdminService.ListActiveOperations.
+func (c *adminServiceClient) ListActiveOperations(ctx context.Context, req *connect.Request[v1.ListActiveOperationsRequest]) (*connect.Response[v1.ListActiveOperationsResponse], error) {
+	return c.listActiveOperations.CallUnary(ctx, req)
+}
+
+// ForceRollbackTransaction calls sqlrpc.v1.AdminService.ForceRollbackTransaction.
+func (c *adminServiceClient) ForceRollbackTransaction(ctx context.Context, req *connect.Request[v1.ForceRollbackTransactionRequest]) (*connect.Response[v1.ForceRollbackTransactionResponse], error) {
+	return c.forceRollbackTransaction.CallUnary(ctx, req)
+}
+
+// ListAuditEvents calls sqlrpc.v1.AdminService.ListAuditEvents.
+func (c *adminServiceClient) ListAuditEvents(ctx context.Context, req *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error) {
+	return c.listAuditEvents.CallUnary(ctx, req)
+}
+
 // GetServerInfo calls sqlrpc.v1.AdminService.GetServerInfo.
 func (c *adminServiceClient) GetServerInfo(ctx context.Context, req *connect.Request[v1.GetServerInfoRequest]) (*connect.Response[v1.ServerInfo], error) {
 	return c.getServerInfo.CallUnary(ctx, req)
 }
 
+// GetLogLevels calls sqlrpc.v1.AdminService.GetLogLevels.
+func (c *adminServiceClient) GetLogLevels(ctx context.Context, req *connect.Request[v1.GetLogLevelsRequest]) (*connect.Response[v1.GetLogLevelsResponse], error) {
+	return c.getLogLevels.CallUnary(ctx, req)
+}
+
+// SetLogLevel calls sqlrpc.v1.AdminService.SetLogLevel.
+func (c *adminServiceClient) SetLogLevel(ctx context.Context, req *connect.Request[v1.SetLogLevelRequest]) (*connect.Response[v1.SetLogLevelResponse], error) {
+	return c.setLogLevel.CallUnary(ctx, req)
+}
+
 // Login calls sqlrpc.v1.AdminService.Login.
 func (c *adminServiceClient) Login(ctx context.Context, req *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error) {
 	return c.login.CallUnary(ctx, req)
@@ -376,6 +783,11 @@ func (c *adminServiceClient) UpdatePassword(ctx context.Context, req *connect.Re
 	return c.updatePassword.CallUnary(ctx, req)
 }
 
+// StreamReplication calls sqlrpc.v1.AdminService.StreamReplication.
+func (c *adminServiceClient) StreamReplication(ctx context.Context, req *connect.Request[v1.StreamReplicationRequest]) (*connect.ServerStreamForClient[v1.ReplicationFrame], error) {
+	return c.streamReplication.CallServerStream(ctx, req)
+}
+
 // AdminServiceHandler is an implementation of the sqlrpc.v1.AdminService service.
 type AdminServiceHandler interface {
 	// *
@@ -395,6 +807,11 @@ type AdminServiceHandler interface {
 	// Permanently removes a user and invalidates all their associated API keys.
 	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error)
 	// *
+	// User Management: Unlock.
+	// Clears the failed login attempts and lockout of a username and, optionally,
+	// of a client IP address.
+	UnlockUser(context.Context, *connect.Request[v1.UnlockUserRequest]) (*connect.Response[v1.UnlockUserResponse], error)
+	// *
 	// API Key Management: List.
 	// Returns all active API keys for a specific user.
 	ListAPIKeys(context.Context, *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error)
@@ -407,6 +824,60 @@ type AdminServiceHandler interface {
 	// Revokes a specific API key immediately.
 	DeleteAPIKey(context.Context, *connect.Request[v1.DeleteAPIKeyRequest]) (*connect.Response[v1.DeleteAPIKeyResponse], error)
 	// *
+	// Access Control: Grant.
+	// Assigns a per-database role to a user or a single API key. Granting the
+	// same database pattern again replaces the previous role.
+	GrantDatabaseAccess(context.Context, *connect.Request[v1.GrantDatabaseAccessRequest]) (*connect.Response[v1.GrantDatabaseAccessResponse], error)
+	// *
+	// Access Control: Revoke.
+	// Removes a per-database grant immediately.
+	RevokeDatabaseAccess(context.Context, *connect.Request[v1.RevokeDatabaseAccessRequest]) (*connect.Response[v1.RevokeDatabaseAccessResponse], error)
+	// *
+	// Access Control: List.
+	// Returns the per-database grants, optionally filtered by user or database.
+	ListDatabaseGrants(context.Context, *connect.Request[v1.ListDatabaseGrantsRequest]) (*connect.Response[v1.ListDatabaseGrantsResponse], error)
+	// *
+	// Rate Limits: Set.
+	// Caps the requests, concurrent streams and transactions, and returned rows
+	// and bytes of a user or a single API key on matching databases. Setting the
+	// same database pattern again replaces the previous limits.
+	SetRateLimit(context.Context, *connect.Request[v1.SetRateLimitRequest]) (*connect.Response[v1.SetRateLimitResponse], error)
+	// *
+	// Rate Limits: Delete.
+	// Removes a rate limit immediately.
+	DeleteRateLimit(context.Context, *connect.Request[v1.DeleteRateLimitRequest]) (*connect.Response[v1.DeleteRateLimitResponse], error)
+	// *
+	// Rate Limits: List.
+	// Returns the rate limits, optionally filtered by user or database.
+	ListRateLimits(context.Context, *connect.Request[v1.ListRateLimitsRequest]) (*connect.Response[v1.ListRateLimitsResponse], error)
+	// *
+	// Row Policies: Create.
+	// Restricts the rows of a table that non-admin callers can read, update and
+	// delete to those matching a predicate, which may call current_user() and
+	// current_role(). A row is visible when any policy of its table allows it.
+	CreateRowPolicy(context.Context, *connect.Request[v1.CreateRowPolicyRequest]) (*connect.Response[v1.CreateRowPolicyResponse], error)
+	// *
+	// Row Policies: Delete.
+	// Removes a row policy immediately.
+	DeleteRowPolicy(context.Context, *connect.Request[v1.DeleteRowPolicyRequest]) (*connect.Response[v1.DeleteRowPolicyResponse], error)
+	// *
+	// Row Policies: List.
+	// Returns the row policies, optionally of a single database.
+	ListRowPolicies(context.Context, *connect.Request[v1.ListRowPoliciesRequest]) (*connect.Response[v1.ListRowPoliciesResponse], error)
+	// *
+	// Column Policies: Set.
+	// Denies or masks reads of a table column for a role or a single user on a
+	// database. Setting the same column and subject again replaces the action.
+	SetColumnPolicy(context.Context, *connect.Request[v1.SetColumnPolicyRequest]) (*connect.Response[v1.SetColumnPolicyResponse], error)
+	// *
+	// Column Policies: Delete.
+	// Removes a column policy immediately.
+	DeleteColumnPolicy(context.Context, *connect.Request[v1.DeleteColumnPolicyRequest]) (*connect.Response[v1.DeleteColumnPolicyResponse], error)
+	// *
+	// Column Policies: List.
+	// Returns the column policies, optionally of a single database.
+	ListColumnPolicies(context.Context, *connect.Request[v1.ListColumnPoliciesRequest]) (*connect.Response[v1.ListColumnPoliciesResponse], error)
+	// *
 	// Database Lifecycle: List.
 	// Returns all managed tenant databases in the platform.
 	ListDatabases(context.Context, *connect.Request[v1.ListDatabasesRequest]) (*connect.Response[v1.ListDatabasesResponse], error)
@@ -432,10 +903,43 @@ type AdminServiceHandler interface {
 	// file.
 	UnMountDatabase(context.Context, *connect.Request[v1.UnMountDatabaseRequest]) (*connect.Response[v1.UnMountDatabaseResponse], error)
 	// *
+	// Maintenance: List runs.
+	// Returns the results of scheduled maintenance jobs, newest first.
+	ListMaintenanceRuns(context.Context, *connect.Request[v1.ListMaintenanceRunsRequest]) (*connect.Response[v1.ListMaintenanceRunsResponse], error)
+	// *
+	// Queries: Cancel.
+	// Interrupts the in-flight statements started by the request with the given
+	// X-Request-Id. The interrupted call fails with CANCELED.
+	CancelQuery(context.Context, *connect.Request[v1.CancelQueryRequest]) (*connect.Response[v1.CancelQueryResponse], error)
+	// *
+	// Queries: List active operations.
+	// Returns the statements running right now and every open transaction, both
+	// ID-based and bidirectional streams.
+	ListActiveOperations(context.Context, *connect.Request[v1.ListActiveOperationsRequest]) (*connect.Response[v1.ListActiveOperationsResponse], error)
+	// *
+	// Queries: Force rollback.
+	// Rolls back another caller's open transaction. Stream transactions end with
+	// ABORTED.
+	ForceRollbackTransaction(context.Context, *connect.Request[v1.ForceRollbackTransactionRequest]) (*connect.Response[v1.ForceRollbackTransactionResponse], error)
+	// *
+	// Audit: List events.
+	// Returns recorded administrative and data-changing operations, newest
+	// first.
+	ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error)
+	// *
 	// Platform Info.
 	// Returns global system metadata, versioning, and status.
 	GetServerInfo(context.Context, *connect.Request[v1.GetServerInfoRequest]) (*connect.Response[v1.ServerInfo], error)
 	// *
+	// Logging: Get levels.
+	// Returns the default log level and the effective level of every subsystem.
+	GetLogLevels(context.Context, *connect.Request[v1.GetLogLevelsRequest]) (*connect.Response[v1.GetLogLevelsResponse], error)
+	// *
+	// Logging: Set level.
+	// Changes the default log level or overrides it for one subsystem. Changes
+	// take effect immediately and last until the server restarts.
+	SetLogLevel(context.Context, *connect.Request[v1.SetLogLevelRequest]) (*connect.Response[v1.SetLogLevelResponse], error)
+	// *
 	// Authentication: Login.
 	// Authenticates credentials and yields a session token/API key.
 	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error)
@@ -447,6 +951,12 @@ type AdminServiceHandler interface {
 	// User Management: Update Password.
 	// Enables a user to modify their own credential or an admin to reset it.
 	UpdatePassword(context.Context, *connect.Request[v1.UpdatePasswordRequest]) (*connect.Response[v1.UpdatePasswordResponse], error)
+	// *
+	// Replication: Stream changes.
+	// Streams page-level changes of every mounted database to a follower
+	// started with --role=follower. The first frame per database is a full
+	// snapshot; later frames only carry pages that changed.
+	StreamReplication(context.Context, *connect.Request[v1.StreamReplicationRequest], *connect.ServerStream[v1.ReplicationFrame]) error
 }
 
 // NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -480,6 +990,12 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(adminServiceMethods.ByName("DeleteUser")),
 		connect.WithHandlerOptions(opts...),
 	)
+	adminServiceUnlockUserHandler := connect.NewUnaryHandler(
+		AdminServiceUnlockUserProcedure,
+		svc.UnlockUser,
+		connect.WithSchema(adminServiceMethods.ByName("UnlockUser")),
+		connect.WithHandlerOptions(opts...),
+	)
 	adminServiceListAPIKeysHandler := connect.NewUnaryHandler(
 		AdminServiceListAPIKeysProcedure,
 		svc.ListAPIKeys,
@@ -498,6 +1014,78 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(adminServiceMethods.ByName("DeleteAPIKey")),
 		connect.WithHandlerOptions(opts...),
 	)
+	adminServiceGrantDatabaseAccessHandler := connect.NewUnaryHandler(
+		AdminServiceGrantDatabaseAccessProcedure,
+		svc.GrantDatabaseAccess,
+		connect.WithSchema(adminServiceMethods.ByName("GrantDatabaseAccess")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceRevokeDatabaseAccessHandler := connect.NewUnaryHandler(
+		AdminServiceRevokeDatabaseAccessProcedure,
+		svc.RevokeDatabaseAccess,
+		connect.WithSchema(adminServiceMethods.ByName("RevokeDatabaseAccess")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListDatabaseGrantsHandler := connect.NewUnaryHandler(
+		AdminServiceListDatabaseGrantsProcedure,
+		svc.ListDatabaseGrants,
+		connect.WithSchema(adminServiceMethods.ByName("ListDatabaseGrants")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSetRateLimitHandler := connect.NewUnaryHandler(
+		AdminServiceSetRateLimitProcedure,
+		svc.SetRateLimit,
+		connect.WithSchema(adminServiceMethods.ByName("SetRateLimit")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDeleteRateLimitHandler := connect.NewUnaryHandler(
+		AdminServiceDeleteRateLimitProcedure,
+		svc.DeleteRateLimit,
+		connect.WithSchema(adminServiceMethods.ByName("DeleteRateLimit")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListRateLimitsHandler := connect.NewUnaryHandler(
+		AdminServiceListRateLimitsProcedure,
+		svc.ListRateLimits,
+		connect.WithSchema(adminServiceMethods.ByName("ListRateLimits")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceCreateRowPolicyHandler := connect.NewUnaryHandler(
+		AdminServiceCreateRowPolicyProcedure,
+		svc.CreateRowPolicy,
+		connect.WithSchema(adminServiceMethods.ByName("CreateRowPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDeleteRowPolicyHandler := connect.NewUnaryHandler(
+		AdminServiceDeleteRowPolicyProcedure,
+		svc.DeleteRowPolicy,
+		connect.WithSchema(adminServiceMethods.ByName("DeleteRowPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListRowPoliciesHandler := connect.NewUnaryHandler(
+		AdminServiceListRowPoliciesProcedure,
+		svc.ListRowPolicies,
+		connect.WithSchema(adminServiceMethods.ByName("ListRowPolicies")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSetColumnPolicyHandler := connect.NewUnaryHandler(
+		AdminServiceSetColumnPolicyProcedure,
+		svc.SetColumnPolicy,
+		connect.WithSchema(adminServiceMethods.ByName("SetColumnPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDeleteColumnPolicyHandler := connect.NewUnaryHandler(
+		AdminServiceDeleteColumnPolicyProcedure,
+		svc.DeleteColumnPolicy,
+		connect.WithSchema(adminServiceMethods.ByName("DeleteColumnPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListColumnPoliciesHandler := connect.NewUnaryHandler(
+		AdminServiceListColumnPoliciesProcedure,
+		svc.ListColumnPolicies,
+		connect.WithSchema(adminServiceMethods.ByName("ListColumnPolicies")),
+		connect.WithHandlerOptions(opts...),
+	)
 	adminServiceListDatabasesHandler := connect.NewUnaryHandler(
 		AdminServiceListDatabasesProcedure,
 		svc.ListDatabases,
@@ -534,12 +1122,54 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(adminServiceMethods.ByName("UnMountDatabase")),
 		connect.WithHandlerOptions(opts...),
 	)
+	adminServiceListMaintenanceRunsHandler := connect.NewUnaryHandler(
+		AdminServiceListMaintenanceRunsProcedure,
+		svc.ListMaintenanceRuns,
+		connect.WithSchema(adminServiceMethods.ByName("ListMaintenanceRuns")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceCancelQueryHandler := connect.NewUnaryHandler(
+		AdminServiceCancelQueryProcedure,
+		svc.CancelQuery,
+		connect.WithSchema(adminServiceMethods.ByName("CancelQuery")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListActiveOperationsHandler := connect.NewUnaryHandler(
+		AdminServiceListActiveOperationsProcedure,
+		svc.ListActiveOperations,
+		connect.WithSchema(adminServiceMethods.ByName("ListActiveOperations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceForceRollbackTransactionHandler := connect.NewUnaryHandler(
+		AdminServiceForceRollbackTransactionProcedure,
+		svc.ForceRollbackTransaction,
+		connect.WithSchema(adminServiceMethods.ByName("ForceRollbackTransaction")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListAuditEventsHandler := connect.NewUnaryHandler(
+		AdminServiceListAuditEventsProcedure,
+		svc.ListAuditEvents,
+		connect.WithSchema(adminServiceMethods.ByName("ListAuditEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
 	adminServiceGetServerInfoHandler := connect.NewUnaryHandler(
 		AdminServiceGetServerInfoProcedure,
 		svc.GetServerInfo,
 		connect.WithSchema(adminServiceMethods.ByName("GetServerInfo")),
 		connect.WithHandlerOptions(opts...),
 	)
+	adminServiceGetLogLevelsHandler := connect.NewUnaryHandler(
+		AdminServiceGetLogLevelsProcedure,
+		svc.GetLogLevels,
+		connect.WithSchema(adminServiceMethods.ByName("GetLogLevels")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSetLogLevelHandler := connect.NewUnaryHandler(
+		AdminServiceSetLogLevelProcedure,
+		svc.SetLogLevel,
+		connect.WithSchema(adminServiceMethods.ByName("SetLogLevel")),
+		connect.WithHandlerOptions(opts...),
+	)
 	adminServiceLoginHandler := connect.NewUnaryHandler(
 		AdminServiceLoginProcedure,
 		svc.Login,
@@ -558,6 +1188,12 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(adminServiceMethods.ByName("UpdatePassword")),
 		connect.WithHandlerOptions(opts...),
 	)
+	adminServiceStreamReplicationHandler := connect.NewServerStreamHandler(
+		AdminServiceStreamReplicationProcedure,
+		svc.StreamReplication,
+		connect.WithSchema(adminServiceMethods.ByName("StreamReplication")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/sqlrpc.v1.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AdminServiceListUsersProcedure:
@@ -568,12 +1204,38 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 			adminServiceUpdateUserRoleHandler.ServeHTTP(w, r)
 		case AdminServiceDeleteUserProcedure:
 			adminServiceDeleteUserHandler.ServeHTTP(w, r)
+		case AdminServiceUnlockUserProcedure:
+			adminServiceUnlockUserHandler.ServeHTTP(w, r)
 		case AdminServiceListAPIKeysProcedure:
 			adminServiceListAPIKeysHandler.ServeHTTP(w, r)
 		case AdminServiceCreateAPIKeyProcedure:
 			adminServiceCreateAPIKeyHandler.ServeHTTP(w, r)
 		case AdminServiceDeleteAPIKeyProcedure:
 			adminServiceDeleteAPIKeyHandler.ServeHTTP(w, r)
+		case AdminServiceGrantDatabaseAccessProcedure:
+			adminServiceGrantDatabaseAccessHandler.ServeHTTP(w, r)
+		case AdminServiceRevokeDatabaseAccessProcedure:
+			adminServiceRevokeDatabaseAccessHandler.ServeHTTP(w, r)
+		case AdminServiceListDatabaseGrantsProcedure:
+			adminServiceListDatabaseGrantsHandler.ServeHTTP(w, r)
+		case AdminServiceSetRateLimitProcedure:
+			adminServiceSetRateLimitHandler.ServeHTTP(w, r)
+		case AdminServiceDeleteRateLimitProcedure:
+			adminServiceDeleteRateLimitHandler.ServeHTTP(w, r)
+		case AdminServiceListRateLimitsProcedure:
+			adminServiceListRateLimitsHandler.ServeHTTP(w, r)
+		case AdminServiceCreateRowPolicyProcedure:
+			adminServiceCreateRowPolicyHandler.ServeHTTP(w, r)
+		case AdminServiceDeleteRowPolicyProcedure:
+			adminServiceDeleteRowPolicyHandler.ServeHTTP(w, r)
+		case AdminServiceListRowPoliciesProcedure:
+			adminServiceListRowPoliciesHandler.ServeHTTP(w, r)
+		case AdminServiceSetColumnPolicyProcedure:
+			adminServiceSetColumnPolicyHandler.ServeHTTP(w, r)
+		case AdminServiceDeleteColumnPolicyProcedure:
+			adminServiceDeleteColumnPolicyHandler.ServeHTTP(w, r)
+		case AdminServiceListColumnPoliciesProcedure:
+			adminServiceListColumnPoliciesHandler.ServeHTTP(w, r)
 		case AdminServiceListDatabasesProcedure:
 			adminServiceListDatabasesHandler.ServeHTTP(w, r)
 		case AdminServiceCreateDatabaseProcedure:
@@ -586,14 +1248,30 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 			adminServiceMountDatabaseHandler.ServeHTTP(w, r)
 		case AdminServiceUnMountDatabaseProcedure:
 			adminServiceUnMountDatabaseHandler.ServeHTTP(w, r)
+		case AdminServiceListMaintenanceRunsProcedure:
+			adminServiceListMaintenanceRunsHandler.ServeHTTP(w, r)
+		case AdminServiceCancelQueryProcedure:
+			adminServiceCancelQueryHandler.ServeHTTP(w, r)
+		case AdminServiceListActiveOperationsProcedure:
+			adminServiceListActiveOperationsHandler.ServeHTTP(w, r)
+		case AdminServiceForceRollbackTransactionProcedure:
+			adminServiceForceRollbackTransactionHandler.ServeHTTP(w, r)
+		case AdminServiceListAuditEventsProcedure:
+			adminServiceListAuditEventsHandler.ServeHTTP(w, r)
 		case AdminServiceGetServerInfoProcedure:
 			adminServiceGetServerInfoHandler.ServeHTTP(w, r)
+		case AdminServiceGetLogLevelsProcedure:
+			adminServiceGetLogLevelsHandler.ServeHTTP(w, r)
+		case AdminServiceSetLogLevelProcedure:
+			adminServiceSetLogLevelHandler.ServeHTTP(w, r)
 		case AdminServiceLoginProcedure:
 			adminServiceLoginHandler.ServeHTTP(w, r)
 		case AdminServiceLogoutProcedure:
 			adminServiceLogoutHandler.ServeHTTP(w, r)
 		case AdminServiceUpdatePasswordProcedure:
 			adminServiceUpdatePasswordHandler.ServeHTTP(w, r)
+		case AdminServiceStreamReplicationProcedure:
+			adminServiceStreamReplicationHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -619,6 +1297,10 @@ func (UnimplementedAdminServiceHandler) DeleteUser(context.Context, *connect.Req
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.DeleteUser is not implemented"))
 }
 
+func (UnimplementedAdminServiceHandler) UnlockUser(context.Context, *connect.Request[v1.UnlockUserRequest]) (*connect.Response[v1.UnlockUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.UnlockUser is not implemented"))
+}
+
 func (UnimplementedAdminServiceHandler) ListAPIKeys(context.Context, *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.ListAPIKeys is not implemented"))
 }
@@ -631,6 +1313,54 @@ func (UnimplementedAdminServiceHandler) DeleteAPIKey(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.DeleteAPIKey is not implemented"))
 }
 
+func (UnimplementedAdminServiceHandler) GrantDatabaseAccess(context.Context, *connect.Request[v1.GrantDatabaseAccessRequest]) (*connect.Response[v1.GrantDatabaseAccessResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.GrantDatabaseAccess is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) RevokeDatabaseAccess(context.Context, *connect.Request[v1.RevokeDatabaseAccessRequest]) (*connect.Response[v1.RevokeDatabaseAccessResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.RevokeDatabaseAccess is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListDatabaseGrants(context.Context, *connect.Request[v1.ListDatabaseGrantsRequest]) (*connect.Response[v1.ListDatabaseGrantsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.ListDatabaseGrants is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SetRateLimit(context.Context, *connect.Request[v1.SetRateLimitRequest]) (*connect.Response[v1.SetRateLimitResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.SetRateLimit is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DeleteRateLimit(context.Context, *connect.Request[v1.DeleteRateLimitRequest]) (*connect.Response[v1.DeleteRateLimitResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.DeleteRateLimit is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListRateLimits(context.Context, *connect.Request[v1.ListRateLimitsRequest]) (*connect.Response[v1.ListRateLimitsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.ListRateLimits is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) CreateRowPolicy(context.Context, *connect.Request[v1.CreateRowPolicyRequest]) (*connect.Response[v1.CreateRowPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.CreateRowPolicy is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DeleteRowPolicy(context.Context, *connect.Request[v1.DeleteRowPolicyRequest]) (*connect.Response[v1.DeleteRowPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.DeleteRowPolicy is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListRowPolicies(context.Context, *connect.Request[v1.ListRowPoliciesRequest]) (*connect.Response[v1.ListRowPoliciesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.ListRowPolicies is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SetColumnPolicy(context.Context, *connect.Request[v1.SetColumnPolicyRequest]) (*connect.Response[v1.SetColumnPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.SetColumnPolicy is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DeleteColumnPolicy(context.Context, *connect.Request[v1.DeleteColumnPolicyRequest]) (*connect.Response[v1.DeleteColumnPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.DeleteColumnPolicy is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListColumnPolicies(context.Context, *connect.Request[v1.ListColumnPoliciesRequest]) (*connect.Response[v1.ListColumnPoliciesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.ListColumnPolicies is not implemented"))
+}
+
 func (UnimplementedAdminServiceHandler) ListDatabases(context.Context, *connect.Request[v1.ListDatabasesRequest]) (*connect.Response[v1.ListDatabasesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.ListDatabases is not implemented"))
 }
@@ -655,10 +1385,38 @@ func (UnimplementedAdminServiceHandler) UnMountDatabase(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.UnMountDatabase is not implemented"))
 }
 
+func (UnimplementedAdminServiceHandler) ListMaintenanceRuns(context.Context, *connect.Request[v1.ListMaintenanceRunsRequest]) (*connect.Response[v1.ListMaintenanceRunsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.ListMaintenanceRuns is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) CancelQuery(context.Context, *connect.Request[v1.CancelQueryRequest]) (*connect.Response[v1.CancelQueryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.CancelQuery is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListActiveOperations(context.Context, *connect.Request[v1.ListActiveOperationsRequest]) (*connect.Response[v1.ListActiveOperationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.ListActiveOperations is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ForceRollbackTransaction(context.Context, *connect.Request[v1.ForceRollbackTransactionRequest]) (*connect.Response[v1.ForceRollbackTransactionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.ForceRollbackTransaction is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.ListAuditEvents is not implemented"))
+}
+
 func (UnimplementedAdminServiceHandler) GetServerInfo(context.Context, *connect.Request[v1.GetServerInfoRequest]) (*connect.Response[v1.ServerInfo], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.GetServerInfo is not implemented"))
 }
 
+func (UnimplementedAdminServiceHandler) GetLogLevels(context.Context, *connect.Request[v1.GetLogLevelsRequest]) (*connect.Response[v1.GetLogLevelsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.GetLogLevels is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SetLogLevel(context.Context, *connect.Request[v1.SetLogLevelRequest]) (*connect.Response[v1.SetLogLevelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.SetLogLevel is not implemented"))
+}
+
 func (UnimplementedAdminServiceHandler) Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.Login is not implemented"))
 }
@@ -670,3 +1428,7 @@ func (UnimplementedAdminServiceHandler) Logout(context.Context, *connect.Request
 func (UnimplementedAdminServiceHandler) UpdatePassword(context.Context, *connect.Request[v1.UpdatePasswordRequest]) (*connect.Response[v1.UpdatePasswordResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.UpdatePassword is not implemented"))
 }
+
+func (UnimplementedAdminServiceHandler) StreamReplication(context.Context, *connect.Request[v1.StreamReplicationRequest], *connect.ServerStream[v1.ReplicationFrame]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.AdminService.StreamReplication is not implemented"))
+}
@@ -64,15 +64,32 @@ const (
 	// DatabaseServiceRollbackTransactionProcedure is the fully-qualified name of the DatabaseService's
 	// RollbackTransaction RPC.
 	DatabaseServiceRollbackTransactionProcedure = "/sqlrpc.v1.DatabaseService/RollbackTransaction"
+	// DatabaseServiceOpenCursorProcedure is the fully-qualified name of the DatabaseService's
+	// OpenCursor RPC.
+	DatabaseServiceOpenCursorProcedure = "/sqlrpc.v1.DatabaseService/OpenCursor"
+	// DatabaseServiceFetchCursorProcedure is the fully-qualified name of the DatabaseService's
+	// FetchCursor RPC.
+	DatabaseServiceFetchCursorProcedure = "/sqlrpc.v1.DatabaseService/FetchCursor"
+	// DatabaseServiceCloseCursorProcedure is the fully-qualified name of the DatabaseService's
+	// CloseCursor RPC.
+	DatabaseServiceCloseCursorProcedure = "/sqlrpc.v1.DatabaseService/CloseCursor"
 	// DatabaseServiceExecuteTransactionProcedure is the fully-qualified name of the DatabaseService's
 	// ExecuteTransaction RPC.
 	DatabaseServiceExecuteTransactionProcedure = "/sqlrpc.v1.DatabaseService/ExecuteTransaction"
+	// DatabaseServiceBatchExecProcedure is the fully-qualified name of the DatabaseService's BatchExec
+	// RPC.
+	DatabaseServiceBatchExecProcedure = "/sqlrpc.v1.DatabaseService/BatchExec"
+	// DatabaseServiceImportProcedure is the fully-qualified name of the DatabaseService's Import RPC.
+	DatabaseServiceImportProcedure = "/sqlrpc.v1.DatabaseService/Import"
 	// DatabaseServiceTypedQueryProcedure is the fully-qualified name of the DatabaseService's
 	// TypedQuery RPC.
 	DatabaseServiceTypedQueryProcedure = "/sqlrpc.v1.DatabaseService/TypedQuery"
 	// DatabaseServiceTypedExecProcedure is the fully-qualified name of the DatabaseService's TypedExec
 	// RPC.
 	DatabaseServiceTypedExecProcedure = "/sqlrpc.v1.DatabaseService/TypedExec"
+	// DatabaseServiceTypedBatchExecProcedure is the fully-qualified name of the DatabaseService's
+	// TypedBatchExec RPC.
+	DatabaseServiceTypedBatchExecProcedure = "/sqlrpc.v1.DatabaseService/TypedBatchExec"
 	// DatabaseServiceTypedQueryStreamProcedure is the fully-qualified name of the DatabaseService's
 	// TypedQueryStream RPC.
 	DatabaseServiceTypedQueryStreamProcedure = "/sqlrpc.v1.DatabaseService/TypedQueryStream"
@@ -113,6 +130,21 @@ const (
 	// DatabaseServiceDetachDatabaseProcedure is the fully-qualified name of the DatabaseService's
 	// DetachDatabase RPC.
 	DatabaseServiceDetachDatabaseProcedure = "/sqlrpc.v1.DatabaseService/DetachDatabase"
+	// DatabaseServiceBackupDatabaseProcedure is the fully-qualified name of the DatabaseService's
+	// BackupDatabase RPC.
+	DatabaseServiceBackupDatabaseProcedure = "/sqlrpc.v1.DatabaseService/BackupDatabase"
+	// DatabaseServiceListBackupsProcedure is the fully-qualified name of the DatabaseService's
+	// ListBackups RPC.
+	DatabaseServiceListBackupsProcedure = "/sqlrpc.v1.DatabaseService/ListBackups"
+	// DatabaseServiceRestoreDatabaseProcedure is the fully-qualified name of the DatabaseService's
+	// RestoreDatabase RPC.
+	DatabaseServiceRestoreDatabaseProcedure = "/sqlrpc.v1.DatabaseService/RestoreDatabase"
+	// DatabaseServiceDownloadBackupProcedure is the fully-qualified name of the DatabaseService's
+	// DownloadBackup RPC.
+	DatabaseServiceDownloadBackupProcedure = "/sqlrpc.v1.DatabaseService/DownloadBackup"
+	// DatabaseServiceDumpDatabaseProcedure is the fully-qualified name of the DatabaseService's
+	// DumpDatabase RPC.
+	DatabaseServiceDumpDatabaseProcedure = "/sqlrpc.v1.DatabaseService/DumpDatabase"
 	// DatabaseServiceListExtensionsProcedure is the fully-qualified name of the DatabaseService's
 	// ListExtensions RPC.
 	DatabaseServiceListExtensionsProcedure = "/sqlrpc.v1.DatabaseService/ListExtensions"
@@ -185,12 +217,41 @@ type DatabaseServiceClient interface {
 	// back to the shared LRU pool.
 	RollbackTransaction(context.Context, *connect.Request[v1.TransactionControlRequest]) (*connect.Response[v1.TransactionControlResponse], error)
 	// *
+	// Cursor Open.
+	// Runs a read-only query in a read transaction on the Read-Only pool and
+	// keeps its result open, pinned to that snapshot, for FetchCursor. Idle
+	// cursors are closed like ID-based transactions.
+	OpenCursor(context.Context, *connect.Request[v1.OpenCursorRequest]) (*connect.Response[v1.OpenCursorResponse], error)
+	// *
+	// Cursor Fetch.
+	// Returns the next rows of a cursor and extends its idle timeout. The cursor
+	// is closed once its last row has been fetched.
+	FetchCursor(context.Context, *connect.Request[v1.FetchCursorRequest]) (*connect.Response[v1.FetchCursorResponse], error)
+	// *
+	// Cursor Close.
+	// Closes a cursor, ending its read transaction.
+	CloseCursor(context.Context, *connect.Request[v1.CloseCursorRequest]) (*connect.Response[v1.CloseCursorResponse], error)
+	// *
 	// Atomic Script Execution.
 	// Executes a predefined sequence of queries within a single transaction
 	// atomically. Handled completely server-side to minimize network latency
 	// between statements.
 	ExecuteTransaction(context.Context, *connect.Request[v1.ExecuteTransactionRequest]) (*connect.Response[v1.ExecuteTransactionResponse], error)
 	// *
+	// Bulk Write Endpoint.
+	// Prepares one statement and executes it once per parameter set inside a
+	// single implicit transaction. Either every set is applied or, when one
+	// fails, none is and the error reports the failing set's index.
+	BatchExec(context.Context, *connect.Request[v1.BatchExecRequest]) (*connect.Response[v1.BatchExecResponse], error)
+	// *
+	// Bulk Import (Client Stream).
+	// The first message names the target table and the format; the following
+	// ones carry CSV or NDJSON chunks, or SqlRow batches. Records are inserted
+	// through one prepared statement and committed every batch_size records.
+	// Records that cannot be parsed or inserted are rejected and counted without
+	// stopping the import.
+	Import(context.Context) *connect.ClientStreamForClient[v1.ImportRequest, v1.ImportResponse]
+	// *
 	// Typed Read Endpoint (Unary).
 	// Automatically routed to a Read-Only replica connection for ROLE_READ_ONLY
 	// users.
@@ -201,6 +262,10 @@ type DatabaseServiceClient interface {
 	// Read-Write connection.
 	TypedExec(context.Context, *connect.Request[v1.TypedQueryRequest]) (*connect.Response[v1.ExecResponse], error)
 	// *
+	// Typed Bulk Write Endpoint.
+	// BatchExec with strictly-typed parameter sets.
+	TypedBatchExec(context.Context, *connect.Request[v1.TypedBatchExecRequest]) (*connect.Response[v1.BatchExecResponse], error)
+	// *
 	// Typed Read Endpoint (Streaming).
 	// Routes to the global Read-Only or Read-Write LRU connection depending on
 	// user role.
@@ -263,6 +328,32 @@ type DatabaseServiceClient interface {
 	// context.
 	DetachDatabase(context.Context, *connect.Request[v1.DetachDatabaseRequest]) (*connect.Response[v1.DetachDatabaseResponse], error)
 	// *
+	// Backup: Create.
+	// Takes a consistent snapshot with the SQLite online backup API and stores
+	// it in the server's backups directory, optionally gzip-compressed, along
+	// with a SHA-256 checksum.
+	BackupDatabase(context.Context, *connect.Request[v1.BackupDatabaseRequest]) (*connect.Response[v1.BackupDatabaseResponse], error)
+	// *
+	// Backup: List.
+	// Lists the stored backups of a database, newest first.
+	ListBackups(context.Context, *connect.Request[v1.ListBackupsRequest]) (*connect.Response[v1.ListBackupsResponse], error)
+	// *
+	// Backup: Restore.
+	// Verifies a stored backup, quiesces the database, swaps the file and drops
+	// all cached connections. Fails while transactions are open on the database.
+	RestoreDatabase(context.Context, *connect.Request[v1.RestoreDatabaseRequest]) (*connect.Response[v1.RestoreDatabaseResponse], error)
+	// *
+	// Backup: Download.
+	// Streams a stored backup, or a fresh snapshot when no backup is named, in
+	// chunks. The first message carries the backup metadata.
+	DownloadBackup(context.Context, *connect.Request[v1.DownloadBackupRequest]) (*connect.ServerStreamForClient[v1.DownloadBackupResponse], error)
+	// *
+	// Backup: Dump.
+	// Streams a `.dump`-style SQL script recreating the schema and data of a
+	// database, or of some of its tables. Row and column security applies to
+	// the data read.
+	DumpDatabase(context.Context, *connect.Request[v1.DumpDatabaseRequest]) (*connect.ServerStreamForClient[v1.DumpDatabaseResponse], error)
+	// *
 	// Extensions: List.
 	// Returns a catalog of available and loaded SQLite extensions.
 	ListExtensions(context.Context, *connect.Request[v1.ListExtensionsRequest]) (*connect.Response[v1.ListExtensionsResponse], error)
@@ -362,12 +453,42 @@ func NewDatabaseServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(databaseServiceMethods.ByName("RollbackTransaction")),
 			connect.WithClientOptions(opts...),
 		),
+		openCursor: connect.NewClient[v1.OpenCursorRequest, v1.OpenCursorResponse](
+			httpClient,
+			baseURL+DatabaseServiceOpenCursorProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("OpenCursor")),
+			connect.WithClientOptions(opts...),
+		),
+		fetchCursor: connect.NewClient[v1.FetchCursorRequest, v1.FetchCursorResponse](
+			httpClient,
+			baseURL+DatabaseServiceFetchCursorProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("FetchCursor")),
+			connect.WithClientOptions(opts...),
+		),
+		closeCursor: connect.NewClient[v1.CloseCursorRequest, v1.CloseCursorResponse](
+			httpClient,
+			baseURL+DatabaseServiceCloseCursorProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("CloseCursor")),
+			connect.WithClientOptions(opts...),
+		),
 		executeTransaction: connect.NewClient[v1.ExecuteTransactionRequest, v1.ExecuteTransactionResponse](
 			httpClient,
 			baseURL+DatabaseServiceExecuteTransactionProcedure,
 			connect.WithSchema(databaseServiceMethods.ByName("ExecuteTransaction")),
 			connect.WithClientOptions(opts...),
 		),
+		batchExec: connect.NewClient[v1.BatchExecRequest, v1.BatchExecResponse](
+			httpClient,
+			baseURL+DatabaseServiceBatchExecProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("BatchExec")),
+			connect.WithClientOptions(opts...),
+		),
+		_import: connect.NewClient[v1.ImportRequest, v1.ImportResponse](
+			httpClient,
+			baseURL+DatabaseServiceImportProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("Import")),
+			connect.WithClientOptions(opts...),
+		),
 		typedQuery: connect.NewClient[v1.TypedQueryRequest, v1.TypedQueryResult](
 			httpClient,
 			baseURL+DatabaseServiceTypedQueryProcedure,
@@ -380,6 +501,12 @@ func NewDatabaseServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(databaseServiceMethods.ByName("TypedExec")),
 			connect.WithClientOptions(opts...),
 		),
+		typedBatchExec: connect.NewClient[v1.TypedBatchExecRequest, v1.BatchExecResponse](
+			httpClient,
+			baseURL+DatabaseServiceTypedBatchExecProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("TypedBatchExec")),
+			connect.WithClientOptions(opts...),
+		),
 		typedQueryStream: connect.NewClient[v1.TypedQueryRequest, v1.TypedQueryResponse](
 			httpClient,
 			baseURL+DatabaseServiceTypedQueryStreamProcedure,
@@ -464,6 +591,36 @@ func NewDatabaseServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(databaseServiceMethods.ByName("DetachDatabase")),
 			connect.WithClientOptions(opts...),
 		),
+		backupDatabase: connect.NewClient[v1.BackupDatabaseRequest, v1.BackupDatabaseResponse](
+			httpClient,
+			baseURL+DatabaseServiceBackupDatabaseProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("BackupDatabase")),
+			connect.WithClientOptions(opts...),
+		),
+		listBackups: connect.NewClient[v1.ListBackupsRequest, v1.ListBackupsResponse](
+			httpClient,
+			baseURL+DatabaseServiceListBackupsProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("ListBackups")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreDatabase: connect.NewClient[v1.RestoreDatabaseRequest, v1.RestoreDatabaseResponse](
+			httpClient,
+			baseURL+DatabaseServiceRestoreDatabaseProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("RestoreDatabase")),
+			connect.WithClientOptions(opts...),
+		),
+		downloadBackup: connect.NewClient[v1.DownloadBackupRequest, v1.DownloadBackupResponse](
+			httpClient,
+			baseURL+DatabaseServiceDownloadBackupProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("DownloadBackup")),
+			connect.WithClientOptions(opts...),
+		),
+		dumpDatabase: connect.NewClient[v1.DumpDatabaseRequest, v1.DumpDatabaseResponse](
+			httpClient,
+			baseURL+DatabaseServiceDumpDatabaseProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("DumpDatabase")),
+			connect.WithClientOptions(opts...),
+		),
 		listExtensions: connect.NewClient[v1.ListExtensionsRequest, v1.ListExtensionsResponse](
 			httpClient,
 			baseURL+DatabaseServiceListExtensionsProcedure,
@@ -510,9 +667,15 @@ type databaseServiceClient struct {
 	transactionSavepoint        *connect.Client[v1.TransactionSavepointRequest, v1.SavepointResponse]
 	commitTransaction           *connect.Client[v1.TransactionControlRequest, v1.TransactionControlResponse]
 	rollbackTransaction         *connect.Client[v1.TransactionControlRequest, v1.TransactionControlResponse]
+	openCursor                  *connect.Client[v1.OpenCursorRequest, v1.OpenCursorResponse]
+	fetchCursor                 *connect.Client[v1.FetchCursorRequest, v1.FetchCursorResponse]
+	closeCursor                 *connect.Client[v1.CloseCursorRequest, v1.CloseCursorResponse]
 	executeTransaction          *connect.Client[v1.ExecuteTransactionRequest, v1.ExecuteTransactionResponse]
+	batchExec                   *connect.Client[v1.BatchExecRequest, v1.BatchExecResponse]
+	_import                     *connect.Client[v1.ImportRequest, v1.ImportResponse]
 	typedQuery                  *connect.Client[v1.TypedQueryRequest, v1.TypedQueryResult]
 	typedExec                   *connect.Client[v1.TypedQueryRequest, v1.ExecResponse]
+	typedBatchExec              *connect.Client[v1.TypedBatchExecRequest, v1.BatchExecResponse]
 	typedQueryStream            *connect.Client[v1.TypedQueryRequest, v1.TypedQueryResponse]
 	typedTransactionQuery       *connect.Client[v1.TypedTransactionQueryRequest, v1.TypedQueryResult]
 	typedTransactionExec        *connect.Client[v1.TypedTransactionQueryRequest, v1.ExecResponse]
@@ -527,6 +690,11 @@ type databaseServiceClient struct {
 	integrityCheck              *connect.Client[v1.IntegrityCheckRequest, v1.IntegrityCheckResponse]
 	attachDatabase              *connect.Client[v1.AttachDatabaseRequest, v1.AttachDatabaseResponse]
 	detachDatabase              *connect.Client[v1.DetachDatabaseRequest, v1.DetachDatabaseResponse]
+	backupDatabase              *connect.Client[v1.BackupDatabaseRequest, v1.BackupDatabaseResponse]
+	listBackups                 *connect.Client[v1.ListBackupsRequest, v1.ListBackupsResponse]
+	restoreDatabase             *connect.Client[v1.RestoreDatabaseRequest, v1.RestoreDatabaseResponse]
+	downloadBackup              *connect.Client[v1.DownloadBackupRequest, v1.DownloadBackupResponse]
+	dumpDatabase                *connect.Client[v1.DumpDatabaseRequest, v1.DumpDatabaseResponse]
 	listExtensions              *connect.Client[v1.ListExtensionsRequest, v1.ListExtensionsResponse]
 	loadExtension               *connect.Client[v1.LoadExtensionRequest, v1.LoadExtensionResponse]
 	publish                     *connect.Client[v1.PublishRequest, v1.PublishResponse]
@@ -589,11 +757,36 @@ func (c *databaseServiceClient) RollbackTransaction(ctx context.Context, req *co
 	return c.rollbackTransaction.CallUnary(ctx, req)
 }
 
+// OpenCursor calls sqlrpc.v1.DatabaseService.OpenCursor.
+func (c *databaseServiceClient) OpenCursor(ctx context.Context, req *connect.Request[v1.OpenCursorRequest]) (*connect.Response[v1.OpenCursorResponse], error) {
+	return c.openCursor.CallUnary(ctx, req)
+}
+
+// FetchCursor calls sqlrpc.v1.DatabaseService.FetchCursor.
+func (c *databaseServiceClient) FetchCursor(ctx context.Context, req *connect.Request[v1.FetchCursorRequest]) (*connect.Response[v1.FetchCursorResponse], error) {
+	return c.fetchCursor.CallUnary(ctx, req)
+}
+
+// CloseCursor calls sqlrpc.v1.DatabaseService.CloseCursor.
+func (c *databaseServiceClient) CloseCursor(ctx context.Context, req *connect.Request[v1.CloseCursorRequest]) (*connect.Response[v1.CloseCursorResponse], error) {
+	return c.closeCursor.CallUnary(ctx, req)
+}
+
 // ExecuteTransaction calls sqlrpc.v1.DatabaseService.ExecuteTransaction.
 func (c *databaseServiceClient) ExecuteTransaction(ctx context.Context, req *connect.Request[v1.ExecuteTransactionRequest]) (*connect.Response[v1.ExecuteTransactionResponse], error) {
 	return c.executeTransaction.CallUnary(ctx, req)
 }
 
+// BatchExec calls sqlrpc.v1.DatabaseService.BatchExec.
+func (c *databaseServiceClient) BatchExec(ctx context.Context, req *connect.Request[v1.BatchExecRequest]) (*connect.Response[v1.BatchExecResponse], error) {
+	return c.batchExec.CallUnary(ctx, req)
+}
+
+// Import calls sqlrpc.v1.DatabaseService.Import.
+func (c *databaseServiceClient) Import(ctx context.Context) *connect.ClientStreamForClient[v1.ImportRequest, v1.ImportResponse] {
+	return c._import.CallClientStream(ctx)
+}
+
 // TypedQuery calls sqlrpc.v1.DatabaseService.TypedQuery.
 func (c *databaseServiceClient) TypedQuery(ctx context.Context, req *connect.Request[v1.TypedQueryRequest]) (*connect.Response[v1.TypedQueryResult], error) {
 	return c.typedQuery.CallUnary(ctx, req)
@@ -604,6 +797,11 @@ func (c *databaseServiceClient) TypedExec(ctx context.Context, req *connect.Requ
 	return c.typedExec.CallUnary(ctx, req)
 }
 
+// TypedBatchExec calls sqlrpc.v1.DatabaseService.TypedBatchExec.
+func (c *databaseServiceClient) TypedBatchExec(ctx context.Context, req *connect.Request[v1.TypedBatchExecRequest]) (*connect.Response[v1.BatchExecResponse], error) {
+	return c.typedBatchExec.CallUnary(ctx, req)
+}
+
 // TypedQueryStream calls sqlrpc.v1.DatabaseService.TypedQueryStream.
 func (c *databaseServiceClient) TypedQueryStream(ctx context.Context, req *connect.Request[v1.TypedQueryRequest]) (*connect.ServerStreamForClient[v1.TypedQueryResponse], error) {
 	return c.typedQueryStream.CallServerStream(ctx, req)
@@ -674,6 +872,31 @@ func (c *databaseServiceClient) DetachDatabase(ctx context.Context, req *connect
 	return c.detachDatabase.CallUnary(ctx, req)
 }
 
+// BackupDatabase calls sqlrpc.v1.DatabaseService.BackupDatabase.
+func (c *databaseServiceClient) BackupDatabase(ctx context.Context, req *connect.Request[v1.BackupDatabaseRequest]) (*connect.Response[v1.BackupDatabaseResponse], error) {
+	return c.backupDatabase.CallUnary(ctx, req)
+}
+
+// ListBackups calls sqlrpc.v1.DatabaseService.ListBackups.
+func (c *databaseServiceClient) ListBackups(ctx context.Context, req *connect.Request[v1.ListBackupsRequest]) (*connect.Response[v1.ListBackupsResponse], error) {
+	return c.listBackups.CallUnary(ctx, req)
+}
+
+// RestoreDatabase calls sqlrpc.v1.DatabaseService.RestoreDatabase.
+func (c *databaseServiceClient) RestoreDatabase(ctx context.Context, req *connect.Request[v1.RestoreDatabaseRequest]) (*connect.Response[v1.RestoreDatabaseResponse], error) {
+	return c.restoreDatabase.CallUnary(ctx, req)
+}
+
+// DownloadBackup calls sqlrpc.v1.DatabaseService.DownloadBackup.
+func (c *databaseServiceClient) DownloadBackup(ctx context.Context, req *connect.Request[v1.DownloadBackupRequest]) (*connect.ServerStreamForClient[v1.DownloadBackupResponse], error) {
+	return c.downloadBackup.CallServerStream(ctx, req)
+}
+
+// DumpDatabase calls sqlrpc.v1.DatabaseService.DumpDatabase.
+func (c *databaseServiceClient) DumpDatabase(ctx context.Context, req *connect.Request[v1.DumpDatabaseRequest]) (*connect.ServerStreamForClient[v1.DumpDatabaseResponse], error) {
+	return c.dumpDatabase.CallServerStream(ctx, req)
+}
+
 // ListExtensions calls sqlrpc.v1.DatabaseService.ListExtensions.
 func (c *databaseServiceClient) ListExtensions(ctx context.Context, req *connect.Request[v1.ListExtensionsRequest]) (*connect.Response[v1.ListExtensionsResponse], error) {
 	return c.listExtensions.CallUnary(ctx, req)
@@ -755,12 +978,41 @@ type DatabaseServiceHandler interface {
 	// back to the shared LRU pool.
 	RollbackTransaction(context.Context, *connect.Request[v1.TransactionControlRequest]) (*connect.Response[v1.TransactionControlResponse], error)
 	// *
+	// Cursor Open.
+	// Runs a read-only query in a read transaction on the Read-Only pool and
+	// keeps its result open, pinned to that snapshot, for FetchCursor. Idle
+	// cursors are closed like ID-based transactions.
+	OpenCursor(context.Context, *connect.Request[v1.OpenCursorRequest]) (*connect.Response[v1.OpenCursorResponse], error)
+	// *
+	// Cursor Fetch.
+	// Returns the next rows of a cursor and extends its idle timeout. The cursor
+	// is closed once its last row has been fetched.
+	FetchCursor(context.Context, *connect.Request[v1.FetchCursorRequest]) (*connect.Response[v1.FetchCursorResponse], error)
+	// *
+	// Cursor Close.
+	// Closes a cursor, ending its read transaction.
+	CloseCursor(context.Context, *connect.Request[v1.CloseCursorRequest]) (*connect.Response[v1.CloseCursorResponse], error)
+	// *
 	// Atomic Script Execution.
 	// Executes a predefined sequence of queries within a single transaction
 	// atomically. Handled completely server-side to minimize network latency
 	// between statements.
 	ExecuteTransaction(context.Context, *connect.Request[v1.ExecuteTransactionRequest]) (*connect.Response[v1.ExecuteTransactionResponse], error)
 	// *
+	// Bulk Write Endpoint.
+	// Prepares one statement and executes it once per parameter set inside a
+	// single implicit transaction. Either every set is applied or, when one
+	// fails, none is and the error reports the failing set's index.
+	BatchExec(context.Context, *connect.Request[v1.BatchExecRequest]) (*connect.Response[v1.BatchExecResponse], error)
+	// *
+	// Bulk Import (Client Stream).
+	// The first message names the target table and the format; the following
+	// ones carry CSV or NDJSON chunks, or SqlRow batches. Records are inserted
+	// through one prepared statement and committed every batch_size records.
+	// Records that cannot be parsed or inserted are rejected and counted without
+	// stopping the import.
+	Import(context.Context, *connect.ClientStream[v1.ImportRequest]) (*connect.Response[v1.ImportResponse], error)
+	// *
 	// Typed Read Endpoint (Unary).
 	// Automatically routed to a Read-Only replica connection for ROLE_READ_ONLY
 	// users.
@@ -771,6 +1023,10 @@ type DatabaseServiceHandler interface {
 	// Read-Write connection.
 	TypedExec(context.Context, *connect.Request[v1.TypedQueryRequest]) (*connect.Response[v1.ExecResponse], error)
 	// *
+	// Typed Bulk Write Endpoint.
+	// BatchExec with strictly-typed parameter sets.
+	TypedBatchExec(context.Context, *connect.Request[v1.TypedBatchExecRequest]) (*connect.Response[v1.BatchExecResponse], error)
+	// *
 	// Typed Read Endpoint (Streaming).
 	// Routes to the global Read-Only or Read-Write LRU connection depending on
 	// user role.
@@ -833,6 +1089,32 @@ type DatabaseServiceHandler interface {
 	// context.
 	DetachDatabase(context.Context, *connect.Request[v1.DetachDatabaseRequest]) (*connect.Response[v1.DetachDatabaseResponse], error)
 	// *
+	// Backup: Create.
+	// Takes a consistent snapshot with the SQLite online backup API and stores
+	// it in the server's backups directory, optionally gzip-compressed, along
+	// with a SHA-256 checksum.
+	BackupDatabase(context.Context, *connect.Request[v1.BackupDatabaseRequest]) (*connect.Response[v1.BackupDatabaseResponse], error)
+	// *
+	// Backup: List.
+	// Lists the stored backups of a database, newest first.
+	ListBackups(context.Context, *connect.Request[v1.ListBackupsRequest]) (*connect.Response[v1.ListBackupsResponse], error)
+	// *
+	// Backup: Restore.
+	// Verifies a stored backup, quiesces the database, swaps the file and drops
+	// all cached connections. Fails while transactions are open on the database.
+	RestoreDatabase(context.Context, *connect.Request[v1.RestoreDatabaseRequest]) (*connect.Response[v1.RestoreDatabaseResponse], error)
+	// *
+	// Backup: Download.
+	// Streams a stored backup, or a fresh snapshot when no backup is named, in
+	// chunks. The first message carries the backup metadata.
+	DownloadBackup(context.Context, *connect.Request[v1.DownloadBackupRequest], *connect.ServerStream[v1.DownloadBackupResponse]) error
+	// *
+	// Backup: Dump.
+	// Streams a `.dump`-style SQL script recreating the schema and data of a
+	// database, or of some of its tables. Row and column security applies to
+	// the data read.
+	DumpDatabase(context.Context, *connect.Request[v1.DumpDatabaseRequest], *connect.ServerStream[v1.DumpDatabaseResponse]) error
+	// *
 	// Extensions: List.
 	// Returns a catalog of available and loaded SQLite extensions.
 	ListExtensions(context.Context, *connect.Request[v1.ListExtensionsRequest]) (*connect.Response[v1.ListExtensionsResponse], error)
@@ -928,12 +1210,42 @@ func NewDatabaseServiceHandler(svc DatabaseServiceHandler, opts ...connect.Handl
 		connect.WithSchema(databaseServiceMethods.ByName("RollbackTransaction")),
 		connect.WithHandlerOptions(opts...),
 	)
+	databaseServiceOpenCursorHandler := connect.NewUnaryHandler(
+		DatabaseServiceOpenCursorProcedure,
+		svc.OpenCursor,
+		connect.WithSchema(databaseServiceMethods.ByName("OpenCursor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceFetchCursorHandler := connect.NewUnaryHandler(
+		DatabaseServiceFetchCursorProcedure,
+		svc.FetchCursor,
+		connect.WithSchema(databaseServiceMethods.ByName("FetchCursor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceCloseCursorHandler := connect.NewUnaryHandler(
+		DatabaseServiceCloseCursorProcedure,
+		svc.CloseCursor,
+		connect.WithSchema(databaseServiceMethods.ByName("CloseCursor")),
+		connect.WithHandlerOptions(opts...),
+	)
 	databaseServiceExecuteTransactionHandler := connect.NewUnaryHandler(
 		DatabaseServiceExecuteTransactionProcedure,
 		svc.ExecuteTransaction,
 		connect.WithSchema(databaseServiceMethods.ByName("ExecuteTransaction")),
 		connect.WithHandlerOptions(opts...),
 	)
+	databaseServiceBatchExecHandler := connect.NewUnaryHandler(
+		DatabaseServiceBatchExecProcedure,
+		svc.BatchExec,
+		connect.WithSchema(databaseServiceMethods.ByName("BatchExec")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceImportHandler := connect.NewClientStreamHandler(
+		DatabaseServiceImportProcedure,
+		svc.Import,
+		connect.WithSchema(databaseServiceMethods.ByName("Import")),
+		connect.WithHandlerOptions(opts...),
+	)
 	databaseServiceTypedQueryHandler := connect.NewUnaryHandler(
 		DatabaseServiceTypedQueryProcedure,
 		svc.TypedQuery,
@@ -946,6 +1258,12 @@ func NewDatabaseServiceHandler(svc DatabaseServiceHandler, opts ...connect.Handl
 		connect.WithSchema(databaseServiceMethods.ByName("TypedExec")),
 		connect.WithHandlerOptions(opts...),
 	)
+	databaseServiceTypedBatchExecHandler := connect.NewUnaryHandler(
+		DatabaseServiceTypedBatchExecProcedure,
+		svc.TypedBatchExec,
+		connect.WithSchema(databaseServiceMethods.ByName("TypedBatchExec")),
+		connect.WithHandlerOptions(opts...),
+	)
 	databaseServiceTypedQueryStreamHandler := connect.NewServerStreamHandler(
 		DatabaseServiceTypedQueryStreamProcedure,
 		svc.TypedQueryStream,
@@ -1030,6 +1348,36 @@ func NewDatabaseServiceHandler(svc DatabaseServiceHandler, opts ...connect.Handl
 		connect.WithSchema(databaseServiceMethods.ByName("DetachDatabase")),
 		connect.WithHandlerOptions(opts...),
 	)
+	databaseServiceBackupDatabaseHandler := connect.NewUnaryHandler(
+		DatabaseServiceBackupDatabaseProcedure,
+		svc.BackupDatabase,
+		connect.WithSchema(databaseServiceMethods.ByName("BackupDatabase")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceListBackupsHandler := connect.NewUnaryHandler(
+		DatabaseServiceListBackupsProcedure,
+		svc.ListBackups,
+		connect.WithSchema(databaseServiceMethods.ByName("ListBackups")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceRestoreDatabaseHandler := connect.NewUnaryHandler(
+		DatabaseServiceRestoreDatabaseProcedure,
+		svc.RestoreDatabase,
+		connect.WithSchema(databaseServiceMethods.ByName("RestoreDatabase")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceDownloadBackupHandler := connect.NewServerStreamHandler(
+		DatabaseServiceDownloadBackupProcedure,
+		svc.DownloadBackup,
+		connect.WithSchema(databaseServiceMethods.ByName("DownloadBackup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceDumpDatabaseHandler := connect.NewServerStreamHandler(
+		DatabaseServiceDumpDatabaseProcedure,
+		svc.DumpDatabase,
+		connect.WithSchema(databaseServiceMethods.ByName("DumpDatabase")),
+		connect.WithHandlerOptions(opts...),
+	)
 	databaseServiceListExtensionsHandler := connect.NewUnaryHandler(
 		DatabaseServiceListExtensionsProcedure,
 		svc.ListExtensions,
@@ -1084,12 +1432,24 @@ func NewDatabaseServiceHandler(svc DatabaseServiceHandler, opts ...connect.Handl
 			databaseServiceCommitTransactionHandler.ServeHTTP(w, r)
 		case DatabaseServiceRollbackTransactionProcedure:
 			databaseServiceRollbackTransactionHandler.ServeHTTP(w, r)
+		case DatabaseServiceOpenCursorProcedure:
+			databaseServiceOpenCursorHandler.ServeHTTP(w, r)
+		case DatabaseServiceFetchCursorProcedure:
+			databaseServiceFetchCursorHandler.ServeHTTP(w, r)
+		case DatabaseServiceCloseCursorProcedure:
+			databaseServiceCloseCursorHandler.ServeHTTP(w, r)
 		case DatabaseServiceExecuteTransactionProcedure:
 			databaseServiceExecuteTransactionHandler.ServeHTTP(w, r)
+		case DatabaseServiceBatchExecProcedure:
+			databaseServiceBatchExecHandler.ServeHTTP(w, r)
+		case DatabaseServiceImportProcedure:
+			databaseServiceImportHandler.ServeHTTP(w, r)
 		case DatabaseServiceTypedQueryProcedure:
 			databaseServiceTypedQueryHandler.ServeHTTP(w, r)
 		case DatabaseServiceTypedExecProcedure:
 			databaseServiceTypedExecHandler.ServeHTTP(w, r)
+		case DatabaseServiceTypedBatchExecProcedure:
+			databaseServiceTypedBatchExecHandler.ServeHTTP(w, r)
 		case DatabaseServiceTypedQueryStreamProcedure:
 			databaseServiceTypedQueryStreamHandler.ServeHTTP(w, r)
 		case DatabaseServiceTypedTransactionQueryProcedure:
@@ -1118,6 +1478,16 @@ func NewDatabaseServiceHandler(svc DatabaseServiceHandler, opts ...connect.Handl
 			databaseServiceAttachDatabaseHandler.ServeHTTP(w, r)
 		case DatabaseServiceDetachDatabaseProcedure:
 			databaseServiceDetachDatabaseHandler.ServeHTTP(w, r)
+		case DatabaseServiceBackupDatabaseProcedure:
+			databaseServiceBackupDatabaseHandler.ServeHTTP(w, r)
+		case DatabaseServiceListBackupsProcedure:
+			databaseServiceListBackupsHandler.ServeHTTP(w, r)
+		case DatabaseServiceRestoreDatabaseProcedure:
+			databaseServiceRestoreDatabaseHandler.ServeHTTP(w, r)
+		case DatabaseServiceDownloadBackupProcedure:
+			databaseServiceDownloadBackupHandler.ServeHTTP(w, r)
+		case DatabaseServiceDumpDatabaseProcedure:
+			databaseServiceDumpDatabaseHandler.ServeHTTP(w, r)
 		case DatabaseServiceListExtensionsProcedure:
 			databaseServiceListExtensionsHandler.ServeHTTP(w, r)
 		case DatabaseServiceLoadExtensionProcedure:
@@ -1181,10 +1551,30 @@ func (UnimplementedDatabaseServiceHandler) RollbackTransaction(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.DatabaseService.RollbackTransaction is not implemented"))
 }
 
+func (UnimplementedDatabaseServiceHandler) OpenCursor(context.Context, *connect.Request[v1.OpenCursorRequest]) (*connect.Response[v1.OpenCursorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.DatabaseService.OpenCursor is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) FetchCursor(context.Context, *connect.Request[v1.FetchCursorRequest]) (*connect.Response[v1.FetchCursorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.DatabaseService.FetchCursor is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) CloseCursor(context.Context, *connect.Request[v1.CloseCursorRequest]) (*connect.Response[v1.CloseCursorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.DatabaseService.CloseCursor is not implemented"))
+}
+
 func (UnimplementedDatabaseServiceHandler) ExecuteTransaction(context.Context, *connect.Request[v1.ExecuteTransactionRequest]) (*connect.Response[v1.ExecuteTransactionResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.DatabaseService.ExecuteTransaction is not implemented"))
 }
 
+func (UnimplementedDatabaseServiceHandler) BatchExec(context.Context, *connect.Request[v1.BatchExecRequest]) (*connect.Response[v1.BatchExecResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.DatabaseService.BatchExec is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) Import(context.Context, *connect.ClientStream[v1.ImportRequest]) (*connect.Response[v1.ImportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.DatabaseService.Import is not implemented"))
+}
+
 func (UnimplementedDatabaseServiceHandler) TypedQuery(context.Context, *connect.Request[v1.TypedQueryRequest]) (*connect.Response[v1.TypedQueryResult], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.DatabaseService.TypedQuery is not implemented"))
 }
@@ -1193,6 +1583,10 @@ func (UnimplementedDatabaseServiceHandler) TypedExec(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.DatabaseService.TypedExec is not implemented"))
 }
 
+func (UnimplementedDatabaseServiceHandler) TypedBatchExec(context.Context, *connect.Request[v1.TypedBatchExecRequest]) (*connect.Response[v1.BatchExecResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.DatabaseService.TypedBatchExec is not implemented"))
+}
+
 func (UnimplementedDatabaseServiceHandler) TypedQueryStream(context.Context, *connect.Request[v1.TypedQueryRequest], *connect.ServerStream[v1.TypedQueryResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.DatabaseService.TypedQueryStream is not implemented"))
 }
@@ -1249,6 +1643,26 @@ func (UnimplementedDatabaseServiceHandler) DetachDatabase(context.Context, *conn
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.DatabaseService.DetachDatabase is not implemented"))
 }
 
+func (UnimplementedDatabaseServiceHandler) BackupDatabase(context.Context, *connect.Request[v1.BackupDatabaseRequest]) (*connect.Response[v1.BackupDatabaseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.DatabaseService.BackupDatabase is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) ListBackups(context.Context, *connect.Request[v1.ListBackupsRequest]) (*connect.Response[v1.ListBackupsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.DatabaseService.ListBackups is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) RestoreDatabase(context.Context, *connect.Request[v1.RestoreDatabaseRequest]) (*connect.Response[v1.RestoreDatabaseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.DatabaseService.RestoreDatabase is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) DownloadBackup(context.Context, *connect.Request[v1.DownloadBackupRequest], *connect.ServerStream[v1.DownloadBackupResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.DatabaseService.DownloadBackup is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) DumpDatabase(context.Context, *connect.Request[v1.DumpDatabaseRequest], *connect.ServerStream[v1.DumpDatabaseResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.DatabaseService.DumpDatabase is not implemented"))
+}
+
 func (UnimplementedDatabaseServiceHandler) ListExtensions(context.Context, *connect.Request[v1.ListExtensionsRequest]) (*connect.Response[v1.ListExtensionsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sqlrpc.v1.DatabaseService.ListExtensions is not implemented"))
 }
@@ -189,9 +189,15 @@ type ExecutionStats struct {
 	// Number of rows read during query execution.
 	RowsRead int64 `protobuf:"varint,2,opt,name=rows_read,json=rowsRead,proto3" json:"rows_read,omitempty"`
 	// Number of rows modified during mutation execution (Exec).
-	RowsWritten   int64 `protobuf:"varint,3,opt,name=rows_written,json=rowsWritten,proto3" json:"rows_written,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	RowsWritten int64 `protobuf:"varint,3,opt,name=rows_written,json=rowsWritten,proto3" json:"rows_written,omitempty"`
+	// Result rows the caller may still receive this minute. Only set when a
+	// rate limit caps returned rows.
+	RowsQuotaRemaining *int64 `protobuf:"varint,4,opt,name=rows_quota_remaining,json=rowsQuotaRemaining,proto3,oneof" json:"rows_quota_remaining,omitempty"`
+	// Response bytes the caller may still receive this minute. Only set when a
+	// rate limit caps returned bytes.
+	BytesQuotaRemaining *int64 `protobuf:"varint,5,opt,name=bytes_quota_remaining,json=bytesQuotaRemaining,proto3,oneof" json:"bytes_quota_remaining,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *ExecutionStats) Reset() {
@@ -245,6 +251,20 @@ func (x *ExecutionStats) GetRowsWritten() int64 {
 	return 0
 }
 
+func (x *ExecutionStats) GetRowsQuotaRemaining() int64 {
+	if x != nil && x.RowsQuotaRemaining != nil {
+		return *x.RowsQuotaRemaining
+	}
+	return 0
+}
+
+func (x *ExecutionStats) GetBytesQuotaRemaining() int64 {
+	if x != nil && x.BytesQuotaRemaining != nil {
+		return *x.BytesQuotaRemaining
+	}
+	return 0
+}
+
 // *
 // ExtensionInfo describes a discoverable SQLite extension available in the
 // platform.
@@ -375,9 +395,14 @@ type DatabaseConfig struct {
 	// SQL commands to execute upon every connection initialize.
 	InitCommands []string `protobuf:"bytes,11,rep,name=init_commands,json=initCommands,proto3" json:"init_commands,omitempty"`
 	// Persistent database attachments.
-	Attachments   []*Attachment `protobuf:"bytes,12,rep,name=attachments,proto3" json:"attachments,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Attachments []*Attachment `protobuf:"bytes,12,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// Scheduled maintenance jobs.
+	Maintenance *MaintenanceSchedule `protobuf:"bytes,13,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
+	// Longest a stateless statement may run before it is interrupted. 0 means
+	// no limit. Requests can only ask for less.
+	MaxExecutionTimeMs int32 `protobuf:"varint,14,opt,name=max_execution_time_ms,json=maxExecutionTimeMs,proto3" json:"max_execution_time_ms,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *DatabaseConfig) Reset() {
@@ -494,6 +519,116 @@ func (x *DatabaseConfig) GetAttachments() []*Attachment {
 	return nil
 }
 
+func (x *DatabaseConfig) GetMaintenance() *MaintenanceSchedule {
+	if x != nil {
+		return x.Maintenance
+	}
+	return nil
+}
+
+func (x *DatabaseConfig) GetMaxExecutionTimeMs() int32 {
+	if x != nil {
+		return x.MaxExecutionTimeMs
+	}
+	return 0
+}
+
+// *
+// MaintenanceSchedule holds the cron expressions of a database's scheduled
+// maintenance jobs. Expressions use the standard five fields (minute, hour,
+// day of month, month, day of week) in server local time, or a descriptor such
+// as @daily. An empty expression disables the job.
+type MaintenanceSchedule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Schedule for PRAGMA wal_checkpoint(TRUNCATE).
+	Checkpoint string `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	// Schedule for VACUUM.
+	Vacuum string `protobuf:"bytes,2,opt,name=vacuum,proto3" json:"vacuum,omitempty"`
+	// Schedule for PRAGMA integrity_check.
+	IntegrityCheck string `protobuf:"bytes,3,opt,name=integrity_check,json=integrityCheck,proto3" json:"integrity_check,omitempty"`
+	// Schedule for backups into the server's backup directory.
+	Backup string `protobuf:"bytes,4,opt,name=backup,proto3" json:"backup,omitempty"`
+	// Compression of scheduled backups.
+	BackupCompression BackupCompression `protobuf:"varint,5,opt,name=backup_compression,json=backupCompression,proto3,enum=sqlrpc.v1.BackupCompression" json:"backup_compression,omitempty"`
+	// Number of backups to keep; older ones are deleted after each scheduled
+	// backup. Zero keeps every backup.
+	BackupRetention uint32 `protobuf:"varint,6,opt,name=backup_retention,json=backupRetention,proto3" json:"backup_retention,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MaintenanceSchedule) Reset() {
+	*x = MaintenanceSchedule{}
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceSchedule) ProtoMessage() {}
+
+func (x *MaintenanceSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceSchedule.ProtoReflect.Descriptor instead.
+func (*MaintenanceSchedule) Descriptor() ([]byte, []int) {
+	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MaintenanceSchedule) GetCheckpoint() string {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return ""
+}
+
+func (x *MaintenanceSchedule) GetVacuum() string {
+	if x != nil {
+		return x.Vacuum
+	}
+	return ""
+}
+
+func (x *MaintenanceSchedule) GetIntegrityCheck() string {
+	if x != nil {
+		return x.IntegrityCheck
+	}
+	return ""
+}
+
+func (x *MaintenanceSchedule) GetBackup() string {
+	if x != nil {
+		return x.Backup
+	}
+	return ""
+}
+
+func (x *MaintenanceSchedule) GetBackupCompression() BackupCompression {
+	if x != nil {
+		return x.BackupCompression
+	}
+	return BackupCompression_BACKUP_COMPRESSION_UNSPECIFIED
+}
+
+func (x *MaintenanceSchedule) GetBackupRetention() uint32 {
+	if x != nil {
+		return x.BackupRetention
+	}
+	return 0
+}
+
 // *
 // UpdateDatabaseConfig allows for non-destructive updates to a tenant's
 // configuration on the fly.
@@ -514,14 +649,18 @@ type UpdateDatabaseConfig struct {
 	// New list of initialization SQL commands.
 	InitCommands *InitCommandList `protobuf:"bytes,7,opt,name=init_commands,json=initCommands,proto3,oneof" json:"init_commands,omitempty"`
 	// New collection of database attachments.
-	Attachments   *AttachmentList `protobuf:"bytes,8,opt,name=attachments,proto3,oneof" json:"attachments,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Attachments *AttachmentList `protobuf:"bytes,8,opt,name=attachments,proto3,oneof" json:"attachments,omitempty"`
+	// New maintenance schedule, replacing the current one.
+	Maintenance *MaintenanceSchedule `protobuf:"bytes,9,opt,name=maintenance,proto3,oneof" json:"maintenance,omitempty"`
+	// New statement execution time limit in milliseconds (0 removes it).
+	MaxExecutionTimeMs *int32 `protobuf:"varint,10,opt,name=max_execution_time_ms,json=maxExecutionTimeMs,proto3,oneof" json:"max_execution_time_ms,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *UpdateDatabaseConfig) Reset() {
 	*x = UpdateDatabaseConfig{}
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[5]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -533,7 +672,7 @@ func (x *UpdateDatabaseConfig) String() string {
 func (*UpdateDatabaseConfig) ProtoMessage() {}
 
 func (x *UpdateDatabaseConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[5]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -546,7 +685,7 @@ func (x *UpdateDatabaseConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDatabaseConfig.ProtoReflect.Descriptor instead.
 func (*UpdateDatabaseConfig) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{5}
+	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateDatabaseConfig) GetReadOnly() bool {
@@ -605,6 +744,20 @@ func (x *UpdateDatabaseConfig) GetAttachments() *AttachmentList {
 	return nil
 }
 
+func (x *UpdateDatabaseConfig) GetMaintenance() *MaintenanceSchedule {
+	if x != nil {
+		return x.Maintenance
+	}
+	return nil
+}
+
+func (x *UpdateDatabaseConfig) GetMaxExecutionTimeMs() int32 {
+	if x != nil && x.MaxExecutionTimeMs != nil {
+		return *x.MaxExecutionTimeMs
+	}
+	return 0
+}
+
 // *
 // Attachment defines a dynamic mounting of a secondary database into a
 // connection.
@@ -620,7 +773,7 @@ type Attachment struct {
 
 func (x *Attachment) Reset() {
 	*x = Attachment{}
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[6]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -632,7 +785,7 @@ func (x *Attachment) String() string {
 func (*Attachment) ProtoMessage() {}
 
 func (x *Attachment) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[6]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -645,7 +798,7 @@ func (x *Attachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
 func (*Attachment) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{6}
+	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Attachment) GetTargetDatabaseName() string {
@@ -674,7 +827,7 @@ type AttachmentList struct {
 
 func (x *AttachmentList) Reset() {
 	*x = AttachmentList{}
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[7]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -686,7 +839,7 @@ func (x *AttachmentList) String() string {
 func (*AttachmentList) ProtoMessage() {}
 
 func (x *AttachmentList) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[7]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -699,7 +852,7 @@ func (x *AttachmentList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttachmentList.ProtoReflect.Descriptor instead.
 func (*AttachmentList) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{7}
+	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *AttachmentList) GetValues() []*Attachment {
@@ -721,7 +874,7 @@ type ExtensionList struct {
 
 func (x *ExtensionList) Reset() {
 	*x = ExtensionList{}
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[8]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -733,7 +886,7 @@ func (x *ExtensionList) String() string {
 func (*ExtensionList) ProtoMessage() {}
 
 func (x *ExtensionList) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[8]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -746,7 +899,7 @@ func (x *ExtensionList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtensionList.ProtoReflect.Descriptor instead.
 func (*ExtensionList) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{8}
+	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ExtensionList) GetValues() []string {
@@ -768,7 +921,7 @@ type PragmaMap struct {
 
 func (x *PragmaMap) Reset() {
 	*x = PragmaMap{}
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[9]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -780,7 +933,7 @@ func (x *PragmaMap) String() string {
 func (*PragmaMap) ProtoMessage() {}
 
 func (x *PragmaMap) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[9]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -793,7 +946,7 @@ func (x *PragmaMap) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PragmaMap.ProtoReflect.Descriptor instead.
 func (*PragmaMap) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{9}
+	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PragmaMap) GetValues() map[string]string {
@@ -816,7 +969,7 @@ type InitCommandList struct {
 
 func (x *InitCommandList) Reset() {
 	*x = InitCommandList{}
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[10]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -828,7 +981,7 @@ func (x *InitCommandList) String() string {
 func (*InitCommandList) ProtoMessage() {}
 
 func (x *InitCommandList) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[10]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -841,7 +994,7 @@ func (x *InitCommandList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitCommandList.ProtoReflect.Descriptor instead.
 func (*InitCommandList) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{10}
+	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *InitCommandList) GetValues() []string {
@@ -870,7 +1023,7 @@ type SqlValue struct {
 
 func (x *SqlValue) Reset() {
 	*x = SqlValue{}
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[11]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -882,7 +1035,7 @@ func (x *SqlValue) String() string {
 func (*SqlValue) ProtoMessage() {}
 
 func (x *SqlValue) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[11]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -895,7 +1048,7 @@ func (x *SqlValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SqlValue.ProtoReflect.Descriptor instead.
 func (*SqlValue) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{11}
+	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SqlValue) GetValue() isSqlValue_Value {
@@ -1001,7 +1154,7 @@ type SqlRow struct {
 
 func (x *SqlRow) Reset() {
 	*x = SqlRow{}
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[12]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1013,7 +1166,7 @@ func (x *SqlRow) String() string {
 func (*SqlRow) ProtoMessage() {}
 
 func (x *SqlRow) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[12]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1026,7 +1179,7 @@ func (x *SqlRow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SqlRow.ProtoReflect.Descriptor instead.
 func (*SqlRow) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{12}
+	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SqlRow) GetValues() []*SqlValue {
@@ -1050,7 +1203,7 @@ type TypedParameters struct {
 
 func (x *TypedParameters) Reset() {
 	*x = TypedParameters{}
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[13]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1062,7 +1215,7 @@ func (x *TypedParameters) String() string {
 func (*TypedParameters) ProtoMessage() {}
 
 func (x *TypedParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[13]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1075,7 +1228,7 @@ func (x *TypedParameters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TypedParameters.ProtoReflect.Descriptor instead.
 func (*TypedParameters) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{13}
+	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *TypedParameters) GetPositional() []*SqlValue {
@@ -1109,7 +1262,7 @@ type Parameters struct {
 
 func (x *Parameters) Reset() {
 	*x = Parameters{}
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[14]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1121,7 +1274,7 @@ func (x *Parameters) String() string {
 func (*Parameters) ProtoMessage() {}
 
 func (x *Parameters) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[14]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1134,7 +1287,7 @@ func (x *Parameters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Parameters.ProtoReflect.Descriptor instead.
 func (*Parameters) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{14}
+	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Parameters) GetPositional() []*structpb.Value {
@@ -1174,7 +1327,7 @@ type QueryPlanNode struct {
 
 func (x *QueryPlanNode) Reset() {
 	*x = QueryPlanNode{}
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[15]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1186,7 +1339,7 @@ func (x *QueryPlanNode) String() string {
 func (*QueryPlanNode) ProtoMessage() {}
 
 func (x *QueryPlanNode) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[15]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1199,7 +1352,7 @@ func (x *QueryPlanNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryPlanNode.ProtoReflect.Descriptor instead.
 func (*QueryPlanNode) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{15}
+	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *QueryPlanNode) GetId() int32 {
@@ -1245,7 +1398,7 @@ type TableSchema struct {
 
 func (x *TableSchema) Reset() {
 	*x = TableSchema{}
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[16]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1257,7 +1410,7 @@ func (x *TableSchema) String() string {
 func (*TableSchema) ProtoMessage() {}
 
 func (x *TableSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[16]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1270,7 +1423,7 @@ func (x *TableSchema) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TableSchema.ProtoReflect.Descriptor instead.
 func (*TableSchema) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{16}
+	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *TableSchema) GetName() string {
@@ -1335,7 +1488,7 @@ type ColumnSchema struct {
 
 func (x *ColumnSchema) Reset() {
 	*x = ColumnSchema{}
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[17]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1347,7 +1500,7 @@ func (x *ColumnSchema) String() string {
 func (*ColumnSchema) ProtoMessage() {}
 
 func (x *ColumnSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[17]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1360,7 +1513,7 @@ func (x *ColumnSchema) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ColumnSchema.ProtoReflect.Descriptor instead.
 func (*ColumnSchema) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{17}
+	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ColumnSchema) GetName() string {
@@ -1416,7 +1569,7 @@ type IndexSchema struct {
 
 func (x *IndexSchema) Reset() {
 	*x = IndexSchema{}
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[18]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1428,7 +1581,7 @@ func (x *IndexSchema) String() string {
 func (*IndexSchema) ProtoMessage() {}
 
 func (x *IndexSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[18]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1441,7 +1594,7 @@ func (x *IndexSchema) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IndexSchema.ProtoReflect.Descriptor instead.
 func (*IndexSchema) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{18}
+	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *IndexSchema) GetName() string {
@@ -1494,7 +1647,7 @@ type ForeignKeySchema struct {
 
 func (x *ForeignKeySchema) Reset() {
 	*x = ForeignKeySchema{}
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[19]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1506,7 +1659,7 @@ func (x *ForeignKeySchema) String() string {
 func (*ForeignKeySchema) ProtoMessage() {}
 
 func (x *ForeignKeySchema) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[19]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1519,7 +1672,7 @@ func (x *ForeignKeySchema) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForeignKeySchema.ProtoReflect.Descriptor instead.
 func (*ForeignKeySchema) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{19}
+	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ForeignKeySchema) GetId() int32 {
@@ -1578,7 +1731,7 @@ type TriggerSchema struct {
 
 func (x *TriggerSchema) Reset() {
 	*x = TriggerSchema{}
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[20]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1590,7 +1743,7 @@ func (x *TriggerSchema) String() string {
 func (*TriggerSchema) ProtoMessage() {}
 
 func (x *TriggerSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[20]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1603,7 +1756,7 @@ func (x *TriggerSchema) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerSchema.ProtoReflect.Descriptor instead.
 func (*TriggerSchema) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{20}
+	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *TriggerSchema) GetName() string {
@@ -1633,7 +1786,7 @@ type DatabaseSchema struct {
 
 func (x *DatabaseSchema) Reset() {
 	*x = DatabaseSchema{}
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[21]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1645,7 +1798,7 @@ func (x *DatabaseSchema) String() string {
 func (*DatabaseSchema) ProtoMessage() {}
 
 func (x *DatabaseSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_sqlrpc_v1_types_proto_msgTypes[21]
+	mi := &file_sqlrpc_v1_types_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1658,7 +1811,7 @@ func (x *DatabaseSchema) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DatabaseSchema.ProtoReflect.Descriptor instead.
 func (*DatabaseSchema) Descriptor() ([]byte, []int) {
-	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{21}
+	return file_sqlrpc_v1_types_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DatabaseSchema) GetTables() []*TableSchema {
@@ -1698,271 +1851,319 @@ var file_sqlrpc_v1_types_proto_rawDesc = []byte{
 	0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x6c, 0x6f,
 	0x61, 0x64, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
 	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x45, 0x78, 0x74,
-	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x71, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
-	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f,
-	0x77, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72,
-	0x6f, 0x77, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x5f,
-	0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72,
-	0x6f, 0x77, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x22, 0xe7, 0x01, 0x0a, 0x0d, 0x45,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f,
-	0x6c, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69,
-	0x73, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x69, 0x73, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65,
-	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
-	0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x6f, 0x63, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x10, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x55, 0x72, 0x6c, 0x22, 0x8f, 0x06, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2f, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x94, 0x02, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72,
+	0x6f, 0x77, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x72, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x77, 0x73,
+	0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x72, 0x6f, 0x77, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x35, 0x0a, 0x14, 0x72,
+	0x6f, 0x77, 0x73, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x12, 0x72, 0x6f, 0x77,
+	0x73, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x88,
+	0x01, 0x01, 0x12, 0x37, 0x0a, 0x15, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x71, 0x75, 0x6f, 0x74,
+	0x61, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x01, 0x52, 0x13, 0x62, 0x79, 0x74, 0x65, 0x73, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42, 0x17, 0x0a, 0x15, 0x5f,
+	0x72, 0x6f, 0x77, 0x73, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0xe7,
+	0x01, 0x0a, 0x0d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x22, 0x8d, 0x07, 0x0a, 0x0e, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2f, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16,
+	0x10, 0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d,
+	0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x07,
+	0x64, 0x62, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba,
+	0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x64, 0x62, 0x50, 0x61, 0x74, 0x68, 0x12, 0x21,
+	0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79,
+	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x40, 0x0a, 0x07, 0x70, 0x72, 0x61, 0x67, 0x6d, 0x61, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x61,
+	0x67, 0x6d, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x70, 0x72, 0x61, 0x67, 0x6d,
+	0x61, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x63,
+	0x6f, 0x6e, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4f,
+	0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f,
+	0x69, 0x64, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x6d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x12, 0x2f,
+	0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x63, 0x6f,
+	0x6e, 0x6e, 0x4d, 0x61, 0x78, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12,
+	0x83, 0x02, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x42, 0xdd, 0x01, 0xba, 0x48, 0xd9, 0x01, 0x92, 0x01,
+	0xd5, 0x01, 0x22, 0xd2, 0x01, 0xba, 0x01, 0xce, 0x01, 0x0a, 0x23, 0x69, 0x6e, 0x69, 0x74, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x63, 0x68, 0x12, 0x61,
+	0x41, 0x54, 0x54, 0x41, 0x43, 0x48, 0x20, 0x6f, 0x72, 0x20, 0x44, 0x45, 0x54, 0x41, 0x43, 0x48,
+	0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6e, 0x6f,
+	0x74, 0x20, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x69, 0x6e, 0x69,
+	0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x20, 0x55, 0x73, 0x65, 0x20,
+	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x73, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x65, 0x61, 0x64,
+	0x2e, 0x1a, 0x44, 0x21, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x28, 0x27, 0x28, 0x3f, 0x69, 0x29, 0x5e, 0x5c, 0x5c, 0x73, 0x2a, 0x41, 0x54, 0x54, 0x41, 0x43,
+	0x48, 0x27, 0x29, 0x20, 0x26, 0x26, 0x20, 0x21, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x73, 0x28, 0x27, 0x28, 0x3f, 0x69, 0x29, 0x5e, 0x5c, 0x5c, 0x73, 0x2a, 0x44,
+	0x45, 0x54, 0x41, 0x43, 0x48, 0x27, 0x29, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x71, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x40,
+	0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x3a, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x1a, 0x3a, 0x0a, 0x0c,
+	0x50, 0x72, 0x61, 0x67, 0x6d, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xae, 0x02, 0x0a, 0x13, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x28, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x0a,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x06, 0x76, 0x61,
+	0x63, 0x75, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72,
+	0x03, 0x18, 0x80, 0x01, 0x52, 0x06, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x12, 0x31, 0x0a, 0x0f,
+	0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52,
+	0x0e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12,
+	0x20, 0x0a, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x12, 0x4b, 0x0a, 0x12, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
+	0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x62, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29,
+	0x0a, 0x10, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xfc, 0x05, 0x0a, 0x14, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x20, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x01, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x72, 0x61, 0x67, 0x6d, 0x61, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x61, 0x67, 0x6d, 0x61, 0x4d, 0x61, 0x70, 0x48, 0x02, 0x52, 0x07, 0x70, 0x72,
+	0x61, 0x67, 0x6d, 0x61, 0x73, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f,
+	0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f,
+	0x63, 0x6f, 0x6e, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x04, 0x52, 0x0c, 0x6d,
+	0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x34,
+	0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x05, 0x52, 0x11,
+	0x63, 0x6f, 0x6e, 0x6e, 0x4d, 0x61, 0x78, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x4d,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x71,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x06, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x07, 0x52, 0x0b, 0x61, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0b,
+	0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x48, 0x08, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x48, 0x09, 0x52, 0x12, 0x6d,
+	0x61, 0x78, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x4d,
+	0x73, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e,
+	0x6c, 0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x72, 0x61, 0x67, 0x6d, 0x61, 0x73, 0x42, 0x11, 0x0a,
+	0x0f, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x73,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x6e, 0x73, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x18,
+	0x0a, 0x16, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x0a, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x14, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x03, 0x18, 0x40,
 	0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d,
-	0x2b, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02,
-	0x10, 0x01, 0x52, 0x06, 0x64, 0x62, 0x50, 0x61, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73,
-	0x5f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0b, 0x69, 0x73, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1e, 0x0a, 0x0a,
-	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x40, 0x0a, 0x07,
-	0x70, 0x72, 0x61, 0x67, 0x6d, 0x61, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
-	0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x61, 0x67, 0x6d, 0x61, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x70, 0x72, 0x61, 0x67, 0x6d, 0x61, 0x73, 0x12, 0x24,
-	0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x73,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4f, 0x70, 0x65, 0x6e, 0x43,
-	0x6f, 0x6e, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x6c, 0x65,
-	0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61,
-	0x78, 0x49, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x63, 0x6f,
-	0x6e, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x5f,
-	0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x4d, 0x61,
-	0x78, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x83, 0x02, 0x0a, 0x0d,
-	0x69, 0x6e, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x0b, 0x20,
-	0x03, 0x28, 0x09, 0x42, 0xdd, 0x01, 0xba, 0x48, 0xd9, 0x01, 0x92, 0x01, 0xd5, 0x01, 0x22, 0xd2,
-	0x01, 0xba, 0x01, 0xce, 0x01, 0x0a, 0x23, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x74,
-	0x61, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x63, 0x68, 0x12, 0x61, 0x41, 0x54, 0x54, 0x41,
-	0x43, 0x48, 0x20, 0x6f, 0x72, 0x20, 0x44, 0x45, 0x54, 0x41, 0x43, 0x48, 0x20, 0x63, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61, 0x6c,
-	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x20, 0x55, 0x73, 0x65, 0x20, 0x61, 0x74, 0x74, 0x61,
-	0x63, 0x68, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x20, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x65, 0x61, 0x64, 0x2e, 0x1a, 0x44, 0x21,
-	0x74, 0x68, 0x69, 0x73, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x28, 0x27, 0x28, 0x3f,
-	0x69, 0x29, 0x5e, 0x5c, 0x5c, 0x73, 0x2a, 0x41, 0x54, 0x54, 0x41, 0x43, 0x48, 0x27, 0x29, 0x20,
-	0x26, 0x26, 0x20, 0x21, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
-	0x28, 0x27, 0x28, 0x3f, 0x69, 0x29, 0x5e, 0x5c, 0x5c, 0x73, 0x2a, 0x44, 0x45, 0x54, 0x41, 0x43,
-	0x48, 0x27, 0x29, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x73, 0x12, 0x37, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61,
-	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x50, 0x72,
-	0x61, 0x67, 0x6d, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x2b, 0x24, 0x52, 0x12, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x01, 0x18, 0x40,
+	0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d,
+	0x2b, 0x24, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x3f, 0x0a, 0x0e, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x71,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x27, 0x0a, 0x0d, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x09, 0x50, 0x72, 0x61, 0x67, 0x6d, 0x61, 0x4d, 0x61,
+	0x70, 0x12, 0x38, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x61, 0x67, 0x6d, 0x61, 0x4d, 0x61, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
 	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
 	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xca, 0x04, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x20, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x88, 0x01,
-	0x01, 0x12, 0x3d, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x48,
-	0x01, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01,
-	0x12, 0x33, 0x0a, 0x07, 0x70, 0x72, 0x61, 0x67, 0x6d, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
-	0x61, 0x67, 0x6d, 0x61, 0x4d, 0x61, 0x70, 0x48, 0x02, 0x52, 0x07, 0x70, 0x72, 0x61, 0x67, 0x6d,
-	0x61, 0x73, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x70, 0x65,
-	0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52,
-	0x0c, 0x6d, 0x61, 0x78, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x88, 0x01, 0x01,
-	0x12, 0x29, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
-	0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x49,
-	0x64, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x14, 0x63,
-	0x6f, 0x6e, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65,
-	0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x05, 0x52, 0x11, 0x63, 0x6f, 0x6e,
-	0x6e, 0x4d, 0x61, 0x78, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x88, 0x01,
-	0x01, 0x12, 0x44, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70,
-	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x48, 0x06, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
-	0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
-	0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x07, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x65,
-	0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x74, 0x65,
-	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x72, 0x61, 0x67, 0x6d,
-	0x61, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f,
-	0x63, 0x6f, 0x6e, 0x6e, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64,
-	0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x73, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x63, 0x6f, 0x6e,
-	0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d,
-	0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x14, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x03, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61,
-	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x12, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x31, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x01, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61,
-	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x05, 0x61,
-	0x6c, 0x69, 0x61, 0x73, 0x22, 0x3f, 0x0a, 0x0e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
-	0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x27, 0x0a, 0x0d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x80,
-	0x01, 0x0a, 0x09, 0x50, 0x72, 0x61, 0x67, 0x6d, 0x61, 0x4d, 0x61, 0x70, 0x12, 0x38, 0x0a, 0x06,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73,
-	0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x61, 0x67, 0x6d, 0x61, 0x4d,
-	0x61, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x8f, 0x02, 0x0a, 0x0f, 0x49, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0xfb, 0x01, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0xe2, 0x01, 0xba, 0x48, 0xde, 0x01, 0x92, 0x01, 0xda,
-	0x01, 0x22, 0xd7, 0x01, 0xba, 0x01, 0xd3, 0x01, 0x0a, 0x28, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x63,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x74, 0x61,
-	0x63, 0x68, 0x12, 0x61, 0x41, 0x54, 0x54, 0x41, 0x43, 0x48, 0x20, 0x6f, 0x72, 0x20, 0x44, 0x45,
-	0x54, 0x41, 0x43, 0x48, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x20, 0x61, 0x72,
-	0x65, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x20, 0x69, 0x6e,
-	0x20, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x20,
-	0x55, 0x73, 0x65, 0x20, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x69, 0x6e, 0x73,
-	0x74, 0x65, 0x61, 0x64, 0x2e, 0x1a, 0x44, 0x21, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6d, 0x61, 0x74,
-	0x63, 0x68, 0x65, 0x73, 0x28, 0x27, 0x28, 0x3f, 0x69, 0x29, 0x5e, 0x5c, 0x5c, 0x73, 0x2a, 0x41,
-	0x54, 0x54, 0x41, 0x43, 0x48, 0x27, 0x29, 0x20, 0x26, 0x26, 0x20, 0x21, 0x74, 0x68, 0x69, 0x73,
-	0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x28, 0x27, 0x28, 0x3f, 0x69, 0x29, 0x5e, 0x5c,
-	0x5c, 0x73, 0x2a, 0x44, 0x45, 0x54, 0x41, 0x43, 0x48, 0x27, 0x29, 0x52, 0x06, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x08, 0x53, 0x71, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x25, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x67,
-	0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x6c, 0x5f,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x09, 0x72,
-	0x65, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x74, 0x65, 0x78, 0x74,
-	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09,
-	0x74, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
-	0x62, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
-	0x09, 0x62, 0x6c, 0x6f, 0x62, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x6e, 0x75,
-	0x6c, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
-	0x52, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x22, 0x35, 0x0a, 0x06, 0x53, 0x71, 0x6c, 0x52, 0x6f, 0x77, 0x12, 0x2b,
-	0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x71, 0x6c, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xee, 0x01, 0x0a, 0x0f,
-	0x54, 0x79, 0x70, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12,
-	0x3e, 0x0a, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8f, 0x02, 0x0a, 0x0f, 0x49, 0x6e, 0x69, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0xfb, 0x01, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0xe2, 0x01, 0xba, 0x48,
+	0xde, 0x01, 0x92, 0x01, 0xda, 0x01, 0x22, 0xd7, 0x01, 0xba, 0x01, 0xd3, 0x01, 0x0a, 0x28, 0x69,
+	0x6e, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x2e, 0x70, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x5f, 0x64, 0x65, 0x74, 0x61, 0x63, 0x68, 0x12, 0x61, 0x41, 0x54, 0x54, 0x41, 0x43, 0x48, 0x20,
+	0x6f, 0x72, 0x20, 0x44, 0x45, 0x54, 0x41, 0x43, 0x48, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x2e, 0x20, 0x55, 0x73, 0x65, 0x20, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65,
+	0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x20, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x65, 0x61, 0x64, 0x2e, 0x1a, 0x44, 0x21, 0x74, 0x68, 0x69,
+	0x73, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x28, 0x27, 0x28, 0x3f, 0x69, 0x29, 0x5e,
+	0x5c, 0x5c, 0x73, 0x2a, 0x41, 0x54, 0x54, 0x41, 0x43, 0x48, 0x27, 0x29, 0x20, 0x26, 0x26, 0x20,
+	0x21, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x28, 0x27, 0x28,
+	0x3f, 0x69, 0x29, 0x5e, 0x5c, 0x5c, 0x73, 0x2a, 0x44, 0x45, 0x54, 0x41, 0x43, 0x48, 0x27, 0x29,
+	0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x08, 0x53, 0x71, 0x6c,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x25, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0c,
+	0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0a,
+	0x72, 0x65, 0x61, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x00, 0x52, 0x09, 0x72, 0x65, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a,
+	0x0a, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x09, 0x74, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f,
+	0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x62, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1f, 0x0a, 0x0a, 0x6e, 0x75, 0x6c, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x35, 0x0a, 0x06, 0x53, 0x71, 0x6c,
+	0x52, 0x6f, 0x77, 0x12, 0x2b, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20,
 	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x71, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x09, 0xba, 0x48, 0x06, 0x92, 0x01, 0x03,
-	0x10, 0xe8, 0x07, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12,
-	0x4c, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
-	0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0f, 0xba, 0x48, 0x0c, 0x9a, 0x01, 0x09, 0x10, 0xe8, 0x07,
-	0x22, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x1a, 0x4d, 0x0a,
-	0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73,
-	0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x71, 0x6c, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf3, 0x02, 0x0a,
-	0x0a, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x53, 0x71, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x22, 0xee, 0x01, 0x0a, 0x0f, 0x54, 0x79, 0x70, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x3e, 0x0a, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x71, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x09, 0xba,
+	0x48, 0x06, 0x92, 0x01, 0x03, 0x10, 0xe8, 0x07, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x4c, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x79, 0x70, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x2e,
+	0x4e, 0x61, 0x6d, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0f, 0xba, 0x48, 0x0c, 0x9a,
+	0x01, 0x09, 0x10, 0xe8, 0x07, 0x22, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x05, 0x6e, 0x61, 0x6d,
+	0x65, 0x64, 0x1a, 0x4d, 0x0a, 0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x71,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xf3, 0x02, 0x0a, 0x0a, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x36, 0x0a, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x36, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x4e,
+	0x61, 0x6d, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x64,
+	0x12, 0x4e, 0x0a, 0x05, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x16, 0xba, 0x48, 0x13, 0x9a, 0x01, 0x10, 0x10, 0xe8, 0x07, 0x22, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x2a, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x68, 0x69, 0x6e, 0x74, 0x73,
+	0x1a, 0x50, 0x0a, 0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x61, 0x6c, 0x12, 0x36, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x05, 0x68,
-	0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x71, 0x6c,
-	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
-	0x73, 0x2e, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x16, 0xba, 0x48,
-	0x13, 0x9a, 0x01, 0x10, 0x10, 0xe8, 0x07, 0x22, 0x04, 0x72, 0x02, 0x10, 0x01, 0x2a, 0x05, 0x82,
-	0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x1a, 0x50, 0x0a, 0x0a, 0x4e,
-	0x61, 0x6d, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x53, 0x0a,
-	0x0a, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73,
-	0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41,
-	0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x54, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6c, 0x61, 0x6e, 0x4e,
-	0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x8e, 0x02, 0x0a, 0x0b, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x73, 0x71, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x31,
-	0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x73, 0x12, 0x30, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x6b,
-	0x65, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x71, 0x6c, 0x72,
-	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79,
-	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b,
-	0x65, 0x79, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52,
-	0x08, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x0c, 0x43, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x12, 0x23, 0x0a,
-	0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
-	0x4b, 0x65, 0x79, 0x22, 0x65, 0x0a, 0x0b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x22, 0xb0, 0x01, 0x0a, 0x10, 0x46,
-	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d,
-	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x43, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x35, 0x0a,
-	0x0d, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x73, 0x71, 0x6c, 0x22, 0x40, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
-	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x2e, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x06,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x42, 0x32, 0x5a, 0x30, 0x73, 0x71, 0x6c, 0x69, 0x74, 0x65,
-	0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2f, 0x76,
-	0x31, 0x3b, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x53, 0x0a, 0x0a, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x54, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x6c, 0x61, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x8e, 0x02,
+	0x0a, 0x0b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x73, 0x71, 0x6c, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x07, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52,
+	0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x65,
+	0x69, 0x67, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x69,
+	0x67, 0x6e, 0x4b, 0x65, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x0b, 0x66, 0x6f, 0x72,
+	0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x71, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x52, 0x08, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x22, 0x97,
+	0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x5f, 0x6e,
+	0x75, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x4e, 0x75,
+	0x6c, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x6d, 0x61,
+	0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x72,
+	0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x65, 0x0a, 0x0b, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x71, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x22,
+	0xb0, 0x01, 0x0a, 0x10, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x6f, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x6f, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6e, 0x5f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x6e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x22, 0x35, 0x0a, 0x0d, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x22, 0x40, 0x0a, 0x0e, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x2e, 0x0a, 0x06, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x71,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x42, 0x32, 0x5a, 0x30, 0x73,
+	0x71, 0x6c, 0x69, 0x74, 0x65, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x73, 0x71, 0x6c,
+	0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x71, 0x6c, 0x72, 0x70, 0x63, 0x76, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1977,69 +2178,74 @@ func file_sqlrpc_v1_types_proto_rawDescGZIP() []byte {
 	return file_sqlrpc_v1_types_proto_rawDescData
 }
 
-var file_sqlrpc_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_sqlrpc_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_sqlrpc_v1_types_proto_goTypes = []any{
 	(*User)(nil),                 // 0: sqlrpc.v1.User
 	(*DatabaseInfo)(nil),         // 1: sqlrpc.v1.DatabaseInfo
 	(*ExecutionStats)(nil),       // 2: sqlrpc.v1.ExecutionStats
 	(*ExtensionInfo)(nil),        // 3: sqlrpc.v1.ExtensionInfo
 	(*DatabaseConfig)(nil),       // 4: sqlrpc.v1.DatabaseConfig
-	(*UpdateDatabaseConfig)(nil), // 5: sqlrpc.v1.UpdateDatabaseConfig
-	(*Attachment)(nil),           // 6: sqlrpc.v1.Attachment
-	(*AttachmentList)(nil),       // 7: sqlrpc.v1.AttachmentList
-	(*ExtensionList)(nil),        // 8: sqlrpc.v1.ExtensionList
-	(*PragmaMap)(nil),            // 9: sqlrpc.v1.PragmaMap
-	(*InitCommandList)(nil),      // 10: sqlrpc.v1.InitCommandList
-	(*SqlValue)(nil),             // 11: sqlrpc.v1.SqlValue
-	(*SqlRow)(nil),               // 12: sqlrpc.v1.SqlRow
-	(*TypedParameters)(nil),      // 13: sqlrpc.v1.TypedParameters
-	(*Parameters)(nil),           // 14: sqlrpc.v1.Parameters
-	(*QueryPlanNode)(nil),        // 15: sqlrpc.v1.QueryPlanNode
-	(*TableSchema)(nil),          // 16: sqlrpc.v1.TableSchema
-	(*ColumnSchema)(nil),         // 17: sqlrpc.v1.ColumnSchema
-	(*IndexSchema)(nil),          // 18: sqlrpc.v1.IndexSchema
-	(*ForeignKeySchema)(nil),     // 19: sqlrpc.v1.ForeignKeySchema
-	(*TriggerSchema)(nil),        // 20: sqlrpc.v1.TriggerSchema
-	(*DatabaseSchema)(nil),       // 21: sqlrpc.v1.DatabaseSchema
-	nil,                          // 22: sqlrpc.v1.DatabaseConfig.PragmasEntry
-	nil,                          // 23: sqlrpc.v1.PragmaMap.ValuesEntry
-	nil,                          // 24: sqlrpc.v1.TypedParameters.NamedEntry
-	nil,                          // 25: sqlrpc.v1.Parameters.NamedEntry
-	nil,                          // 26: sqlrpc.v1.Parameters.HintsEntry
-	(Role)(0),                    // 27: sqlrpc.v1.Role
-	(*structpb.Value)(nil),       // 28: google.protobuf.Value
-	(ColumnAffinity)(0),          // 29: sqlrpc.v1.ColumnAffinity
+	(*MaintenanceSchedule)(nil),  // 5: sqlrpc.v1.MaintenanceSchedule
+	(*UpdateDatabaseConfig)(nil), // 6: sqlrpc.v1.UpdateDatabaseConfig
+	(*Attachment)(nil),           // 7: sqlrpc.v1.Attachment
+	(*AttachmentList)(nil),       // 8: sqlrpc.v1.AttachmentList
+	(*ExtensionList)(nil),        // 9: sqlrpc.v1.ExtensionList
+	(*PragmaMap)(nil),            // 10: sqlrpc.v1.PragmaMap
+	(*InitCommandList)(nil),      // 11: sqlrpc.v1.InitCommandList
+	(*SqlValue)(nil),             // 12: sqlrpc.v1.SqlValue
+	(*SqlRow)(nil),               // 13: sqlrpc.v1.SqlRow
+	(*TypedParameters)(nil),      // 14: sqlrpc.v1.TypedParameters
+	(*Parameters)(nil),           // 15: sqlrpc.v1.Parameters
+	(*QueryPlanNode)(nil),        // 16: sqlrpc.v1.QueryPlanNode
+	(*TableSchema)(nil),          // 17: sqlrpc.v1.TableSchema
+	(*ColumnSchema)(nil),         // 18: sqlrpc.v1.ColumnSchema
+	(*IndexSchema)(nil),          // 19: sqlrpc.v1.IndexSchema
+	(*ForeignKeySchema)(nil),     // 20: sqlrpc.v1.ForeignKeySchema
+	(*TriggerSchema)(nil),        // 21: sqlrpc.v1.TriggerSchema
+	(*DatabaseSchema)(nil),       // 22: sqlrpc.v1.DatabaseSchema
+	nil,                          // 23: sqlrpc.v1.DatabaseConfig.PragmasEntry
+	nil,                          // 24: sqlrpc.v1.PragmaMap.ValuesEntry
+	nil,                          // 25: sqlrpc.v1.TypedParameters.NamedEntry
+	nil,                          // 26: sqlrpc.v1.Parameters.NamedEntry
+	nil,                          // 27: sqlrpc.v1.Parameters.HintsEntry
+	(Role)(0),                    // 28: sqlrpc.v1.Role
+	(BackupCompression)(0),       // 29: sqlrpc.v1.BackupCompression
+	(*structpb.Value)(nil),       // 30: google.protobuf.Value
+	(ColumnAffinity)(0),          // 31: sqlrpc.v1.ColumnAffinity
 }
 var file_sqlrpc_v1_types_proto_depIdxs = []int32{
-	27, // 0: sqlrpc.v1.User.role:type_name -> sqlrpc.v1.Role
-	6,  // 1: sqlrpc.v1.DatabaseInfo.current_attachments:type_name -> sqlrpc.v1.Attachment
-	22, // 2: sqlrpc.v1.DatabaseConfig.pragmas:type_name -> sqlrpc.v1.DatabaseConfig.PragmasEntry
-	6,  // 3: sqlrpc.v1.DatabaseConfig.attachments:type_name -> sqlrpc.v1.Attachment
-	8,  // 4: sqlrpc.v1.UpdateDatabaseConfig.extensions:type_name -> sqlrpc.v1.ExtensionList
-	9,  // 5: sqlrpc.v1.UpdateDatabaseConfig.pragmas:type_name -> sqlrpc.v1.PragmaMap
-	10, // 6: sqlrpc.v1.UpdateDatabaseConfig.init_commands:type_name -> sqlrpc.v1.InitCommandList
-	7,  // 7: sqlrpc.v1.UpdateDatabaseConfig.attachments:type_name -> sqlrpc.v1.AttachmentList
-	6,  // 8: sqlrpc.v1.AttachmentList.values:type_name -> sqlrpc.v1.Attachment
-	23, // 9: sqlrpc.v1.PragmaMap.values:type_name -> sqlrpc.v1.PragmaMap.ValuesEntry
-	11, // 10: sqlrpc.v1.SqlRow.values:type_name -> sqlrpc.v1.SqlValue
-	11, // 11: sqlrpc.v1.TypedParameters.positional:type_name -> sqlrpc.v1.SqlValue
-	24, // 12: sqlrpc.v1.TypedParameters.named:type_name -> sqlrpc.v1.TypedParameters.NamedEntry
-	28, // 13: sqlrpc.v1.Parameters.positional:type_name -> google.protobuf.Value
-	25, // 14: sqlrpc.v1.Parameters.named:type_name -> sqlrpc.v1.Parameters.NamedEntry
-	26, // 15: sqlrpc.v1.Parameters.hints:type_name -> sqlrpc.v1.Parameters.HintsEntry
-	17, // 16: sqlrpc.v1.TableSchema.columns:type_name -> sqlrpc.v1.ColumnSchema
-	18, // 17: sqlrpc.v1.TableSchema.indexes:type_name -> sqlrpc.v1.IndexSchema
-	19, // 18: sqlrpc.v1.TableSchema.foreign_keys:type_name -> sqlrpc.v1.ForeignKeySchema
-	20, // 19: sqlrpc.v1.TableSchema.triggers:type_name -> sqlrpc.v1.TriggerSchema
-	16, // 20: sqlrpc.v1.DatabaseSchema.tables:type_name -> sqlrpc.v1.TableSchema
-	11, // 21: sqlrpc.v1.TypedParameters.NamedEntry.value:type_name -> sqlrpc.v1.SqlValue
-	28, // 22: sqlrpc.v1.Parameters.NamedEntry.value:type_name -> google.protobuf.Value
-	29, // 23: sqlrpc.v1.Parameters.HintsEntry.value:type_name -> sqlrpc.v1.ColumnAffinity
-	24, // [24:24] is the sub-list for method output_type
-	24, // [24:24] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	28, // 0: sqlrpc.v1.User.role:type_name -> sqlrpc.v1.Role
+	7,  // 1: sqlrpc.v1.DatabaseInfo.current_attachments:type_name -> sqlrpc.v1.Attachment
+	23, // 2: sqlrpc.v1.DatabaseConfig.pragmas:type_name -> sqlrpc.v1.DatabaseConfig.PragmasEntry
+	7,  // 3: sqlrpc.v1.DatabaseConfig.attachments:type_name -> sqlrpc.v1.Attachment
+	5,  // 4: sqlrpc.v1.DatabaseConfig.maintenance:type_name -> sqlrpc.v1.MaintenanceSchedule
+	29, // 5: sqlrpc.v1.MaintenanceSchedule.backup_compression:type_name -> sqlrpc.v1.BackupCompression
+	9,  // 6: sqlrpc.v1.UpdateDatabaseConfig.extensions:type_name -> sqlrpc.v1.ExtensionList
+	10, // 7: sqlrpc.v1.UpdateDatabaseConfig.pragmas:type_name -> sqlrpc.v1.PragmaMap
+	11, // 8: sqlrpc.v1.UpdateDatabaseConfig.init_commands:type_name -> sqlrpc.v1.InitCommandList
+	8,  // 9: sqlrpc.v1.UpdateDatabaseConfig.attachments:type_name -> sqlrpc.v1.AttachmentList
+	5,  // 10: sqlrpc.v1.UpdateDatabaseConfig.maintenance:type_name -> sqlrpc.v1.MaintenanceSchedule
+	7,  // 11: sqlrpc.v1.AttachmentList.values:type_name -> sqlrpc.v1.Attachment
+	24, // 12: sqlrpc.v1.PragmaMap.values:type_name -> sqlrpc.v1.PragmaMap.ValuesEntry
+	12, // 13: sqlrpc.v1.SqlRow.values:type_name -> sqlrpc.v1.SqlValue
+	12, // 14: sqlrpc.v1.TypedParameters.positional:type_name -> sqlrpc.v1.SqlValue
+	25, // 15: sqlrpc.v1.TypedParameters.named:type_name -> sqlrpc.v1.TypedParameters.NamedEntry
+	30, // 16: sqlrpc.v1.Parameters.positional:type_name -> google.protobuf.Value
+	26, // 17: sqlrpc.v1.Parameters.named:type_name -> sqlrpc.v1.Parameters.NamedEntry
+	27, // 18: sqlrpc.v1.Parameters.hints:type_name -> sqlrpc.v1.Parameters.HintsEntry
+	18, // 19: sqlrpc.v1.TableSchema.columns:type_name -> sqlrpc.v1.ColumnSchema
+	19, // 20: sqlrpc.v1.TableSchema.indexes:type_name -> sqlrpc.v1.IndexSchema
+	20, // 21: sqlrpc.v1.TableSchema.foreign_keys:type_name -> sqlrpc.v1.ForeignKeySchema
+	21, // 22: sqlrpc.v1.TableSchema.triggers:type_name -> sqlrpc.v1.TriggerSchema
+	17, // 23: sqlrpc.v1.DatabaseSchema.tables:type_name -> sqlrpc.v1.TableSchema
+	12, // 24: sqlrpc.v1.TypedParameters.NamedEntry.value:type_name -> sqlrpc.v1.SqlValue
+	30, // 25: sqlrpc.v1.Parameters.NamedEntry.value:type_name -> google.protobuf.Value
+	31, // 26: sqlrpc.v1.Parameters.HintsEntry.value:type_name -> sqlrpc.v1.ColumnAffinity
+	27, // [27:27] is the sub-list for method output_type
+	27, // [27:27] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_sqlrpc_v1_types_proto_init() }
@@ -2048,8 +2254,9 @@ func file_sqlrpc_v1_types_proto_init() {
 		return
 	}
 	file_sqlrpc_v1_enums_proto_init()
-	file_sqlrpc_v1_types_proto_msgTypes[5].OneofWrappers = []any{}
-	file_sqlrpc_v1_types_proto_msgTypes[11].OneofWrappers = []any{
+	file_sqlrpc_v1_types_proto_msgTypes[2].OneofWrappers = []any{}
+	file_sqlrpc_v1_types_proto_msgTypes[6].OneofWrappers = []any{}
+	file_sqlrpc_v1_types_proto_msgTypes[12].OneofWrappers = []any{
 		(*SqlValue_IntegerValue)(nil),
 		(*SqlValue_RealValue)(nil),
 		(*SqlValue_TextValue)(nil),
@@ -2062,7 +2269,7 @@ func file_sqlrpc_v1_types_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sqlrpc_v1_types_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   27,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1,23 +1,31 @@
 package pubsub
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
-	"log"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	_ "github.com/mattn/go-sqlite3"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"sqlite-server/internal/logging"
+	"sqlite-server/internal/tracing"
 )
 
 // MsgPayload represents the internal structure of a single Pub/Sub message.
 // It maps to the schema of the 'messages' table in the broker database.
 type MsgPayload struct {
-	ID        int64
-	Channel   string
-	Payload   string
-	DbName    string
-	CreatedAt time.Time
+	ID          int64
+	Channel     string
+	Payload     string
+	DbName      string
+	CreatedAt   time.Time
+	TraceParent string // W3C traceparent of the publishing span, if it was traced
 }
 
 // RequestResult is used to pass the result of a batch publish operation
@@ -32,8 +40,11 @@ type PubRequest struct {
 	DbName string
 	Items  []MsgPayload
 	Result *RequestResult
+	Span   trace.SpanContext // Publishing span, linked from the flush span
 }
 
+var logger = logging.For(logging.PubSub)
+
 var (
 	resultPool  = sync.Pool{New: func() any { return &RequestResult{Done: make(chan []int64, 1)} }}
 	requestPool = sync.Pool{New: func() any { return &PubRequest{Items: make([]MsgPayload, 0, 100)} }}
@@ -42,15 +53,20 @@ var (
 // Broker manages the Pub/Sub system, including persisting messages to SQLite,
 // handling durable subscriptions, and broadcasting live signals to active listeners.
 type Broker struct {
-	database          *sql.DB                      // The dedicated SQLite database connection for the broker
-	waitersMutex      sync.RWMutex                 // Mutex to protect concurrent access to the waiters map
-	waiters           map[string][]chan MsgPayload // Map of subscription keys (dbName:channel) to lists of listener channels
-	publishQueue      chan *PubRequest             // Channel used to queue incoming publish requests for batching
-	messageTTL        time.Duration                // Time-to-live for messages in the database before they are pruned
-	shutdownCh        chan struct{}                // Channel used to signal background goroutines to stop
-	backgroundWorkers sync.WaitGroup               // WaitGroup to ensure background tasks finish
+	database          *sql.DB                       // The dedicated SQLite database connection for the broker
+	waitersMutex      sync.RWMutex                  // Mutex to protect concurrent access to the waiters map
+	waiters           map[string][]chan MsgPayload  // Map of subscription keys (dbName:channel) to lists of listener channels
+	publishQueue      chan *PubRequest              // Channel used to queue incoming publish requests for batching
+	messageTTL        time.Duration                 // Time-to-live for messages in the database before they are pruned
+	shutdownCh        chan struct{}                 // Channel used to signal background goroutines to stop
+	backgroundWorkers sync.WaitGroup                // WaitGroup to ensure background tasks finish
+	flushObserver     atomic.Pointer[FlushObserver] // Optional callback reporting each flushed batch
 }
 
+// FlushObserver is called after every batch flush with the number of publish requests
+// in the batch and the time the flush took.
+type FlushObserver func(batchSize int, elapsed time.Duration)
+
 // NewBroker initializes the Pub/Sub broker with a dedicated SQLite database.
 func NewBroker(path string, ttlHours int) (*Broker, error) {
 	database, err := sql.Open("sqlite3", fmt.Sprintf("file:%s?_busy_timeout=10000&_journal=WAL&parseTime=true", path))
@@ -69,7 +85,8 @@ func NewBroker(path string, ttlHours int) (*Broker, error) {
 			db_source TEXT,
 			channel TEXT,
 			payload TEXT,
-			created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			traceparent TEXT
 		);
 		CREATE TABLE IF NOT EXISTS subscriptions (
 			name TEXT,
@@ -87,6 +104,19 @@ func NewBroker(path string, ttlHours int) (*Broker, error) {
 		return nil, err
 	}
 
+	// Brokers created before trace propagation lack the traceparent column
+	var hasTraceParent bool
+	if err := database.QueryRow("SELECT COUNT(*) > 0 FROM pragma_table_info('messages') WHERE name = 'traceparent'").Scan(&hasTraceParent); err != nil {
+		database.Close()
+		return nil, err
+	}
+	if !hasTraceParent {
+		if _, err := database.Exec("ALTER TABLE messages ADD COLUMN traceparent TEXT"); err != nil {
+			database.Close()
+			return nil, err
+		}
+	}
+
 	broker := &Broker{
 		database:     database,
 		waiters:      make(map[string][]chan MsgPayload),
@@ -103,18 +133,17 @@ func NewBroker(path string, ttlHours int) (*Broker, error) {
 }
 
 func (broker *Broker) Stop() {
-	log.Println("[Broker] Stop() called, shutting down...")
+	logger.Info("Broker shutting down")
 	close(broker.shutdownCh)        // Stop the pruner and signal batcher
 	broker.backgroundWorkers.Wait() // Wait for batcher to drain and pruner to exit
 	broker.database.Close()
-	log.Println("[Broker] Stop() completed.")
+	logger.Info("Broker stopped")
 }
 
 // startBatcher is a background goroutine that processes the publish queue.
 // It groups multiple PubRequests into a single database transaction (batch)
 // to significantly improve write performance to the underlying SQLite database.
 func (broker *Broker) startBatcher() {
-	log.Println("[DEBUG] Batcher goroutine started")
 	defer broker.backgroundWorkers.Done()
 	ticker := time.NewTicker(10 * time.Millisecond)
 	defer ticker.Stop()
@@ -147,13 +176,11 @@ func (broker *Broker) startBatcher() {
 		case req := <-broker.publishQueue:
 			batch = append(batch, req)
 			if len(batch) >= 100 {
-				log.Printf("[DEBUG] Batcher flushing %d requests (batch full)", len(batch))
 				broker.flush(batch)
 				batch = batch[:0]
 			}
 		case <-ticker.C:
 			if len(batch) > 0 {
-				log.Printf("[DEBUG] Batcher flushing %d requests (ticker)", len(batch))
 				broker.flush(batch)
 				batch = batch[:0]
 			}
@@ -165,8 +192,13 @@ func (broker *Broker) startBatcher() {
 // After successfully committing to the database, it broadcasts the new messages
 // to any active live listeners (Signal Hub) and unblocks the original callers.
 func (broker *Broker) flush(batch []*PubRequest) {
-	log.Printf("[DEBUG] Entering flush with %d requests", len(batch))
-	defer log.Printf("[DEBUG] Exicting flush")
+	if observer := broker.flushObserver.Load(); observer != nil {
+		start := time.Now()
+		defer func() { (*observer)(len(batch), time.Since(start)) }()
+	}
+
+	span := broker.startFlushSpan(batch)
+	defer span.End()
 
 	unblockAll := func() {
 		for _, req := range batch {
@@ -176,14 +208,15 @@ func (broker *Broker) flush(batch []*PubRequest) {
 
 	transaction, err := broker.database.Begin()
 	if err != nil {
-		log.Printf("[ERROR] [Broker] Failed to begin transaction: %v", err)
+		logger.Error("Failed to begin publish transaction", logging.Err(err))
+		span.SetStatus(codes.Error, err.Error())
 		unblockAll()
 		return
 	}
-	log.Printf("[DEBUG] Transaction started")
-	insertStatement, err := transaction.Prepare("INSERT INTO messages (db_source, channel, payload, created_at) VALUES (?, ?, ?, ?)")
+	insertStatement, err := transaction.Prepare("INSERT INTO messages (db_source, channel, payload, created_at, traceparent) VALUES (?, ?, ?, ?, NULLIF(?, ''))")
 	if err != nil {
-		log.Printf("[Broker] Failed to prepare statement: %v", err)
+		logger.Error("Failed to prepare publish statement", logging.Err(err))
+		span.SetStatus(codes.Error, err.Error())
 		transaction.Rollback()
 		unblockAll()
 		return
@@ -205,9 +238,9 @@ func (broker *Broker) flush(batch []*PubRequest) {
 				ca = time.Now().UTC()
 				req.Items[i].CreatedAt = ca
 			}
-			sqlResult, err := insertStatement.Exec(req.DbName, req.Items[i].Channel, req.Items[i].Payload, ca.Format("2006-01-02 15:04:05"))
+			sqlResult, err := insertStatement.Exec(req.DbName, req.Items[i].Channel, req.Items[i].Payload, ca.Format("2006-01-02 15:04:05"), req.Items[i].TraceParent)
 			if err != nil {
-				log.Printf("[Broker] Failed to exec: %v", err)
+				logger.Error("Failed to store message", logging.KeyDatabase, req.DbName, "channel", req.Items[i].Channel, logging.Err(err))
 				continue
 			}
 			insertedID, _ := sqlResult.LastInsertId()
@@ -216,13 +249,12 @@ func (broker *Broker) flush(batch []*PubRequest) {
 		signals = append(signals, resultSignal{req: req, result: req.Result, ids: ids})
 	}
 
-	log.Printf("[DEBUG] Committing transaction")
 	if err := transaction.Commit(); err != nil {
-		log.Printf("[ERROR] [Broker] Failed to commit: %v", err)
+		logger.Error("Failed to commit publish transaction", logging.Err(err))
+		span.SetStatus(codes.Error, err.Error())
 		unblockAll()
 		return
 	}
-	log.Printf("[DEBUG] Transaction committed")
 
 	// Unblock all callers AFTER successful commit AND broadcast live signals
 	for _, sig := range signals {
@@ -232,16 +264,49 @@ func (broker *Broker) flush(batch []*PubRequest) {
 		for i, id := range sig.ids {
 			item := sig.req.Items[i]
 			broker.broadcast(sig.req.DbName, item.Channel, MsgPayload{
-				ID:        id,
-				Channel:   item.Channel,
-				Payload:   item.Payload,
-				DbName:    sig.req.DbName,
-				CreatedAt: item.CreatedAt,
+				ID:          id,
+				Channel:     item.Channel,
+				Payload:     item.Payload,
+				DbName:      sig.req.DbName,
+				CreatedAt:   item.CreatedAt,
+				TraceParent: item.TraceParent,
 			})
 		}
 		sig.result.Done <- sig.ids
 	}
-	log.Printf("[DEBUG] Exiting flush")
+	logger.Debug("Flushed publish batch", "requests", len(batch))
+}
+
+// startFlushSpan starts the span covering a batch flush. A batch serves many publishers,
+// so the span is parented to the first traced one and linked to the rest. Batches
+// without traced publishers are not recorded.
+func (broker *Broker) startFlushSpan(batch []*PubRequest) trace.Span {
+	var parent trace.SpanContext
+	var links []trace.Link
+	messages := 0
+	for _, req := range batch {
+		messages += len(req.Items)
+		if !req.Span.IsValid() {
+			continue
+		}
+		if !parent.IsValid() {
+			parent = req.Span
+		} else {
+			links = append(links, trace.Link{SpanContext: req.Span})
+		}
+	}
+	if !parent.IsValid() {
+		return trace.SpanFromContext(context.Background())
+	}
+
+	_, span := tracing.Tracer().Start(trace.ContextWithSpanContext(context.Background(), parent), "pubsub.flush",
+		trace.WithLinks(links...),
+		trace.WithAttributes(
+			attribute.Int("pubsub.batch.requests", len(batch)),
+			attribute.Int("pubsub.batch.messages", messages),
+		),
+	)
+	return span
 }
 
 // broadcast sends a live message to all active listeners currently subscribed
@@ -262,6 +327,27 @@ func (broker *Broker) broadcast(databaseName, channelName string, messageData Ms
 	}
 }
 
+// SetFlushObserver registers a callback invoked after every batch flush.
+func (broker *Broker) SetFlushObserver(observer FlushObserver) {
+	broker.flushObserver.Store(&observer)
+}
+
+// QueueDepth returns the number of publish requests waiting for the batcher.
+func (broker *Broker) QueueDepth() int {
+	return len(broker.publishQueue)
+}
+
+// SubscriberCount returns the number of live listeners across all channels.
+func (broker *Broker) SubscriberCount() int {
+	broker.waitersMutex.RLock()
+	defer broker.waitersMutex.RUnlock()
+	count := 0
+	for _, subscriberList := range broker.waiters {
+		count += len(subscriberList)
+	}
+	return count
+}
+
 // SubscribeSignal adds a live channel listener
 func (broker *Broker) SubscribeSignal(databaseName, channelName string) (chan MsgPayload, func()) {
 	subscriptionKey := fmt.Sprintf("%s:%s", databaseName, channelName)
@@ -289,17 +375,28 @@ func (broker *Broker) SubscribeSignal(databaseName, channelName string) (chan Ms
 // The method blocks until the batch is committed by the background batcher
 // and returns the IDs of the newly inserted messages.
 func (broker *Broker) Publish(databaseName string, items []MsgPayload) []int64 {
-	log.Printf("[DEBUG] Publish called for %s with %d items", databaseName, len(items))
+	return broker.PublishContext(context.Background(), databaseName, items)
+}
+
+// PublishContext is Publish for a traced caller: the span in ctx is linked from the
+// flush span and stamped on every message so subscribers can continue the trace.
+func (broker *Broker) PublishContext(ctx context.Context, databaseName string, items []MsgPayload) []int64 {
 	pubReq := requestPool.Get().(*PubRequest)
 	reqRes := resultPool.Get().(*RequestResult)
 	pubReq.DbName, pubReq.Result = databaseName, reqRes
 	pubReq.Items = pubReq.Items[:0]
 	pubReq.Items = append(pubReq.Items, items...)
+	pubReq.Span = trace.SpanContextFromContext(ctx)
+	if traceParent := tracing.TraceParent(ctx); traceParent != "" {
+		for i := range pubReq.Items {
+			if pubReq.Items[i].TraceParent == "" {
+				pubReq.Items[i].TraceParent = traceParent
+			}
+		}
+	}
 
 	broker.publishQueue <- pubReq
-	log.Printf("[DEBUG] Publish request queued")
 	publishedIDs := <-reqRes.Done
-	log.Printf("[DEBUG] Publish result received")
 	resultPool.Put(reqRes)
 
 	// Fix: Return pubReq to pool
@@ -309,7 +406,6 @@ func (broker *Broker) Publish(databaseName string, items []MsgPayload) []int64 {
 }
 
 func (broker *Broker) startPruner() {
-	log.Println("[DEBUG] Pruner goroutine started")
 	defer broker.backgroundWorkers.Done()
 	ticker := time.NewTicker(1 * time.Hour)
 	defer ticker.Stop()
@@ -328,20 +424,20 @@ func (broker *Broker) prune() {
 	// 1. Prune messages older than TTL
 	deleteResult, err := broker.database.Exec("DELETE FROM messages WHERE created_at < datetime('now', ?)", fmt.Sprintf("-%d hours", int(broker.messageTTL.Hours())))
 	if err != nil {
-		log.Printf("[Broker] Failed to prune messages: %v", err)
+		logger.Error("Failed to prune messages", logging.Err(err))
 	} else {
 		if deletedCount, _ := deleteResult.RowsAffected(); deletedCount > 0 {
-			log.Printf("[Broker] Pruned %d old messages", deletedCount)
+			logger.Info("Pruned old messages", "count", deletedCount)
 		}
 	}
 
 	// 2. Prune old subscriptions (inactive for 30 days)
 	deleteSubResult, err := broker.database.Exec("DELETE FROM subscriptions WHERE last_active < datetime('now', '-30 days')")
 	if err != nil {
-		log.Printf("[Broker] Failed to prune subscriptions: %v", err)
+		logger.Error("Failed to prune subscriptions", logging.Err(err))
 	} else {
 		if deletedCount, _ := deleteSubResult.RowsAffected(); deletedCount > 0 {
-			log.Printf("[Broker] Pruned %d inactive subscriptions", deletedCount)
+			logger.Info("Pruned inactive subscriptions", "count", deletedCount)
 		}
 	}
 }
@@ -363,6 +459,6 @@ func (broker *Broker) UpdateSubscription(name, dbName, channel string, lastID in
 			last_active = CURRENT_TIMESTAMP
 	`, name, dbName, channel, lastID)
 	if err != nil {
-		log.Printf("[Broker] Failed to update subscription: %v", err)
+		logger.Error("Failed to update subscription", "subscription", name, logging.KeyDatabase, dbName, "channel", channel, logging.Err(err))
 	}
 }
@@ -1,6 +1,8 @@
 package pubsub
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -10,6 +12,12 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"sqlite-server/internal/tracing"
 )
 
 func TestNewBroker(t *testing.T) {
@@ -86,6 +94,77 @@ func TestBrokerPublishAndSubscribeSignal(t *testing.T) {
 	assert.Equal(t, ids[1], received[1].ID)
 }
 
+func TestBrokerPublishContext_Tracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+
+	broker, err := NewBroker(filepath.Join(t.TempDir(), "trace.db"), 24)
+	require.NoError(t, err)
+	defer broker.Stop()
+
+	signalChan, cleanup := broker.SubscribeSignal("testdb", "events")
+	defer cleanup()
+
+	ctx, publish := tracing.Tracer().Start(context.Background(), "publish")
+	ids := broker.PublishContext(ctx, "testdb", []MsgPayload{{Channel: "events", Payload: "traced"}})
+	publish.End()
+	require.Len(t, ids, 1)
+
+	traceParent := tracing.TraceParent(ctx)
+	select {
+	case msg := <-signalChan:
+		assert.Equal(t, traceParent, msg.TraceParent)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for signal")
+	}
+
+	var stored string
+	require.NoError(t, broker.GetDB().QueryRow("SELECT traceparent FROM messages WHERE id = ?", ids[0]).Scan(&stored))
+	assert.Equal(t, traceParent, stored)
+
+	// The flush span ends just after publishers are unblocked
+	var flush sdktrace.ReadOnlySpan
+	require.Eventually(t, func() bool {
+		for _, span := range recorder.Ended() {
+			if span.Name() == "pubsub.flush" {
+				flush = span
+			}
+		}
+		return flush != nil
+	}, time.Second, 5*time.Millisecond, "the flush span is recorded for traced publishers")
+	assert.Equal(t, publish.SpanContext().SpanID(), flush.Parent().SpanID())
+
+	// Untraced publishes leave the column empty and record no flush span
+	before := len(recorder.Ended())
+	ids = broker.Publish("testdb", []MsgPayload{{Channel: "events", Payload: "plain"}})
+	var plain sql.NullString
+	require.NoError(t, broker.GetDB().QueryRow("SELECT traceparent FROM messages WHERE id = ?", ids[0]).Scan(&plain))
+	assert.False(t, plain.Valid)
+	assert.Len(t, recorder.Ended(), before)
+}
+
+func TestNewBroker_MigratesTraceParent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "legacy.db")
+	db, err := sql.Open("sqlite3", path)
+	require.NoError(t, err)
+	_, err = db.Exec(`CREATE TABLE messages (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		db_source TEXT,
+		channel TEXT,
+		payload TEXT,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	)`)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	broker, err := NewBroker(path, 24)
+	require.NoError(t, err)
+	defer broker.Stop()
+
+	assert.Len(t, broker.Publish("testdb", []MsgPayload{{Channel: "events", Payload: "after upgrade"}}), 1)
+}
+
 func TestBrokerPrune(t *testing.T) {
 	tmpDir := t.TempDir()
 	broker, err := NewBroker(filepath.Join(tmpDir, "test.db"), 1) // 1 hour TTL
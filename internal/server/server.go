@@ -1,9 +1,10 @@
 package server
 
 import (
+	"cmp"
 	"context"
 	"fmt"
-	"log"
+	"math"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -18,12 +19,15 @@ import (
 	"sqlite-server/internal/auth"
 	"sqlite-server/internal/docs"
 	"sqlite-server/internal/landing"
+	"sqlite-server/internal/logging"
+	"sqlite-server/internal/metrics"
 	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
 	"sqlite-server/internal/protos/sqlrpc/v1/sqlrpcv1connect"
 	"sqlite-server/internal/pubsub" // Added for pubsub.Broker
 	servicesv1 "sqlite-server/internal/services/v1"
 	"sqlite-server/internal/sqldrivers"
 	"sqlite-server/internal/studio"
+	"sqlite-server/internal/tracing"
 
 	_ "embed"
 )
@@ -35,27 +39,66 @@ func init() {
 	Version = strings.TrimSpace(Version)
 }
 
+var logger = logging.For(logging.Server)
+
 // Config holds the configuration for the SQLite server.
 type Config struct {
-	Mounts                string // Path to initial database mounts JSON
-	Port                  int    // Port to listen on
-	Host                  string // Host/IP to bind to
-	ExtDir                string // Directory for SQLite extensions
-	AuthDisabled          bool   // Whether to bypass authentication
-	CorsOrigin            string // Allowed CORS origin
-	MetaDB                string // Path to the metadata SQLite database
-	DbDir                 string // Base directory for database files
-	MountsOverwrite       bool   // Whether to overwrite metadata with mounts file
-	InitialAdmin          string // Default admin username
-	InitialPassword       string // Default admin password
-	IdleTimeout           int    // Idle connection timeout in seconds
-	ShutdownTimeout       int    // Graceful shutdown timeout in seconds
-	ShowVersion           bool   // Whether to show version and exit
-	DownloadExtensions    string // Comma-separated list of extensions to download
-	DownloadAllExtensions bool   // Whether to download all extensions
-	PubSubEnabled         bool   // Whether to enable Pub/Sub messaging
-	PubSubTTL             int    // Message retention TTL in hours
-	PubSubDB              string // Path to the Pub/Sub broker database
+	Mounts                string  // Path to initial database mounts JSON
+	Port                  int     // Port to listen on; negative disables TCP (with UnixSocket)
+	Host                  string  // Host/IP to bind to
+	ExtDir                string  // Directory for SQLite extensions
+	AuthDisabled          bool    // Whether to bypass authentication
+	CorsOrigin            string  // Allowed CORS origin
+	MetaDB                string  // Path to the metadata SQLite database
+	DbDir                 string  // Base directory for database files
+	BackupDir             string  // Directory for database backups
+	MountsOverwrite       bool    // Whether to overwrite metadata with mounts file
+	InitialAdmin          string  // Default admin username
+	InitialPassword       string  // Default admin password
+	IdleTimeout           int     // Idle connection timeout in seconds
+	ShutdownTimeout       int     // Graceful shutdown timeout in seconds
+	ShowVersion           bool    // Whether to show version and exit
+	DownloadExtensions    string  // Comma-separated list of extensions to download
+	DownloadAllExtensions bool    // Whether to download all extensions
+	PubSubEnabled         bool    // Whether to enable Pub/Sub messaging
+	PubSubTTL             int     // Message retention TTL in hours
+	PubSubDB              string  // Path to the Pub/Sub broker database
+	Role                  string  // Replication role: "leader" or "follower"
+	Leader                string  // Leader address (host:port) when running as a follower
+	LeaderToken           string  // API key used to authenticate against the leader
+	LeaderCA              string  // PEM CA bundle that verifies an https leader; system roots when empty
+	MetricsEnabled        bool    // Whether to expose Prometheus metrics on /metrics
+	TraceExporter         string  // Span exporter: "none", "otlp", "stdout" or "file"
+	TraceEndpoint         string  // OTLP/HTTP endpoint URL for the otlp exporter
+	TraceFile             string  // Output file for the file exporter
+	TraceSampleRatio      float64 // Fraction of new traces to sample
+	LogFormat             string  // Log output format: "text" or "json"
+	LogLevel              string  // Default log level
+	LogLevels             string  // Per-subsystem log levels, e.g. "pubsub=debug"
+	AuditEnabled          bool    // Whether to record admin and data-changing operations
+	AuditRedactParams     bool    // Whether to leave SQL parameters out of audit events
+	PasswordMemory        int     // argon2id memory per password hash in KiB (0 = default)
+	PasswordIterations    int     // argon2id passes per password hash (0 = default)
+	PasswordParallelism   int     // argon2id lanes per password hash (0 = default)
+	LockoutAttempts       int     // Failed logins per username before backoff (0 = default, <0 disables)
+	LockoutIPAttempts     int     // Failed logins per client IP before backoff (0 = default, <0 disables)
+	LockoutMaxSeconds     int     // Longest login lockout in seconds (0 = default)
+	JWTJWKS               string  // JWKS file or URL; enables JWT bearer tokens when set
+	JWTIssuer             string  // Required "iss" of JWTs
+	JWTAudience           string  // Required "aud" of JWTs
+	JWTUsernameClaim      string  // JWT claim used as the username
+	JWTRoleClaim          string  // JWT claim holding the role(s)
+	JWTRoleMap            string  // JWT role claim values to roles, e.g. "db-admins=admin"
+	JWTDatabasesClaim     string  // Optional JWT claim listing the allowed databases
+	TLSCert               string  // PEM certificate file; serves HTTPS when set with TLSKey
+	TLSKey                string  // PEM private key file
+	TLSClientCA           string  // PEM CA bundle that verifies client certificates (mTLS)
+	TLSRequireClientCert  bool    // Reject TLS clients without a verified certificate
+	TLSClientIdentity     string  // Client certificate field naming the user: cn, dns, email or uri
+	UnixSocket            string  // Path of a Unix domain socket to serve on as well
+	UnixSocketMode        string  // Octal permissions of the socket file (default 0660)
+	UnixPeerMap           string  // Unix peer UIDs to users or roles, e.g. "1000=app,33=role:read_only"
+	RoleMaxExecutionTime  string  // Statement time limits per role, e.g. "read_only=5s,read_write=1m"
 }
 
 // Server represents the SQLite server instance.
@@ -64,8 +107,15 @@ type Server struct {
 	dbServer   *servicesv1.DbServer
 	authStore  *auth.MetaStore
 	httpServer *http.Server
+	unixServer *http.Server
 	version    string
 	broker     *pubsub.Broker
+	follower   *servicesv1.Follower
+	scheduler  *servicesv1.Scheduler
+	metrics    *metrics.Registry
+	tracing    func(context.Context) error // Flushes and stops the trace exporter
+	tls        *tlsFiles                   // Nil when serving plaintext h2c
+	limiter    *servicesv1.RateLimiter     // Nil when authentication is disabled
 }
 
 // New creates a new Server instance.
@@ -84,7 +134,7 @@ func (s *Server) Start() error {
 	// Load static mounts from the specified JSON file (if any)
 	initialConfigs := s.loadInitialConfigs(s.cfg.Mounts)
 
-	log.Printf("[EXT] Extensions path: %s", s.cfg.ExtDir)
+	logger.Info("Extensions path", "path", s.cfg.ExtDir)
 
 	// Setup Metadata Store & Configuration Synchronization
 	var err error
@@ -93,24 +143,100 @@ func (s *Server) Start() error {
 		return err
 	}
 
+	// Validate the replication role before anything starts listening
+	follower := false
+	switch s.cfg.Role {
+	case "", "leader":
+	case "follower":
+		if s.cfg.Leader == "" {
+			return fmt.Errorf("--leader is required when running with --role=follower")
+		}
+		follower = true
+	default:
+		return fmt.Errorf("invalid replication role %q (expected leader or follower)", s.cfg.Role)
+	}
+
+	// Load the TLS material before anything starts listening
+	if s.cfg.TLSCert != "" || s.cfg.TLSKey != "" {
+		if s.tls, err = newTLSFiles(s.cfg); err != nil {
+			return err
+		}
+	} else if s.cfg.TLSClientCA != "" {
+		return fmt.Errorf("--tls-client-ca needs --tls-cert and --tls-key")
+	}
+	if s.cfg.TLSClientIdentity != "" && !servicesv1.ValidCertIdentity(s.cfg.TLSClientIdentity) {
+		return fmt.Errorf("invalid --tls-client-identity %q (expected cn, dns, email or uri)", s.cfg.TLSClientIdentity)
+	}
+	if s.cfg.UnixSocket == "" && (s.cfg.Port < 0 || s.cfg.UnixPeerMap != "") {
+		return fmt.Errorf("--port=-1 and --unix-peer-map need --unix-socket")
+	}
+	peers, err := servicesv1.ParsePeerMap(s.cfg.UnixPeerMap)
+	if err != nil {
+		return err
+	}
+	roleLimits, err := servicesv1.ParseRoleLimits(s.cfg.RoleMaxExecutionTime)
+	if err != nil {
+		return err
+	}
+
+	// Setup OpenTelemetry before anything starts emitting spans
+	traceCfg := tracing.Config{
+		Exporter:       s.cfg.TraceExporter,
+		Endpoint:       s.cfg.TraceEndpoint,
+		File:           s.cfg.TraceFile,
+		SampleRatio:    s.cfg.TraceSampleRatio,
+		ServiceVersion: s.version,
+	}
+	s.tracing, err = tracing.Setup(context.Background(), traceCfg)
+	if err != nil {
+		return err
+	}
+	if traceCfg.Enabled() {
+		logger.Info("Exporting OpenTelemetry spans", "exporter", s.cfg.TraceExporter)
+	}
+
 	// Setup Middleware/Interceptors layer
-	var interceptors connect.HandlerOption
+	var chain []connect.Interceptor
 	var authInterceptor *servicesv1.AuthInterceptor
 
 	authEnabled := !s.cfg.AuthDisabled
 	if authEnabled {
 		authInterceptor = servicesv1.NewAuthInterceptor(s.authStore)
-		interceptors = connect.WithInterceptors(
+		s.limiter = servicesv1.NewRateLimiter(s.authStore)
+		chain = []connect.Interceptor{
 			servicesv1.LoggingInterceptor(),
 			authInterceptor,
-		)
-		log.Println("[AUTH] Authentication ENABLED")
+			s.limiter,
+		}
+		logger.Info("Authentication enabled")
+
+		if s.cfg.JWTJWKS != "" {
+			verifier, err := jwtVerifier(s.cfg)
+			if err != nil {
+				return fmt.Errorf("invalid jwt settings: %w", err)
+			}
+			authInterceptor.SetJWTVerifier(verifier)
+			logger.Info("JWT bearer tokens enabled", "issuer", s.cfg.JWTIssuer, "jwks", s.cfg.JWTJWKS)
+		}
+		if peers != nil {
+			authInterceptor.SetPeerIdentities(peers)
+			logger.Info("Unix socket peers map to users", "uids", len(peers))
+		}
+		if s.cfg.TLSClientCA != "" {
+			authInterceptor.SetClientCertIdentity(s.cfg.TLSClientIdentity)
+			logger.Info("TLS client certificates map to users", "field", cmp.Or(s.cfg.TLSClientIdentity, servicesv1.CertIdentityCN))
+		}
 	} else {
-		interceptors = connect.WithInterceptors(
+		chain = []connect.Interceptor{
 			servicesv1.LoggingInterceptor(),
 			servicesv1.NewNoAuthInterceptor(),
-		)
-		log.Println("[AUTH] Authentication DISABLED - Running in Anonymous Admin Mode")
+		}
+		logger.Warn("Authentication DISABLED - Running in Anonymous Admin Mode")
+	}
+
+	// Followers are read replicas: writes belong on the leader
+	if follower {
+		chain = append(chain, servicesv1.NewReplicaInterceptor(s.cfg.Leader))
 	}
 
 	// Initialize the Pub/Sub Broker if enabled
@@ -120,7 +246,7 @@ func (s *Server) Start() error {
 			brokerPath = s.cfg.PubSubDB
 		}
 
-		log.Printf("[PUB/SUB] Initializing Broker at %s (TTL: %d hours)", brokerPath, s.cfg.PubSubTTL)
+		logger.Info("Initializing Pub/Sub broker", "path", brokerPath, "ttl_hours", s.cfg.PubSubTTL)
 		var err error
 		s.broker, err = pubsub.NewBroker(brokerPath, s.cfg.PubSubTTL)
 		if err != nil {
@@ -132,30 +258,127 @@ func (s *Server) Start() error {
 
 	// Initialize the Core Database Server
 	s.dbServer = servicesv1.NewDbServer(initialConfigs, s.authStore, s.broker)
+	if s.cfg.BackupDir != "" {
+		s.dbServer.SetBackupDir(s.cfg.BackupDir)
+	}
+	s.dbServer.SetRoleExecutionLimits(roleLimits)
+	if authInterceptor != nil {
+		authInterceptor.SetTransactionResolver(s.dbServer)
+		s.limiter.SetTransactionResolver(s.dbServer)
+		if s.cfg.AuditEnabled {
+			authInterceptor.SetAuditor(servicesv1.NewAuditor(s.authStore, s.cfg.AuditRedactParams))
+			logger.Info("Audit log enabled", "redact_params", s.cfg.AuditRedactParams)
+		}
+	}
+
+	// Tracing and metrics wrap the whole chain so rejected requests are observed too
+	if traceCfg.Enabled() {
+		chain = append([]connect.Interceptor{tracing.NewInterceptor()}, chain...)
+	}
+	if s.cfg.MetricsEnabled {
+		s.metrics = metrics.NewRegistry()
+		m := servicesv1.NewMetrics(s.metrics, s.dbServer, s.broker)
+		chain = append([]connect.Interceptor{m.Interceptor()}, chain...)
+		logger.Info("Prometheus metrics available at /metrics")
+	}
+	interceptors := connect.WithInterceptors(chain...)
+
+	// Start replicating from the leader
+	if follower {
+		leaderTLS, err := leaderTLSConfig(s.cfg.LeaderCA)
+		if err != nil {
+			return err
+		}
+		s.follower = servicesv1.NewFollower(s.dbServer, s.cfg.Leader, s.cfg.LeaderToken, s.cfg.DbDir, leaderTLS)
+		if authInterceptor != nil {
+			// Identities and limits cached from the previous metadata are stale
+			s.follower.SetAccessControlHook(func() {
+				authInterceptor.ClearCache()
+				s.limiter.Invalidate()
+			})
+		}
+		s.follower.Start()
+		logger.Info("Running as read replica", "leader", s.cfg.Leader)
+	}
+
+	// Run scheduled maintenance jobs. Followers skip them: their files mirror the leader.
+	if !follower && s.authStore != nil {
+		s.scheduler = servicesv1.NewScheduler(s.dbServer, s.authStore)
+		s.scheduler.Start()
+	}
 
 	// Setup Routing and Handlers
 	mux := s.setupMux(s.dbServer, s.authStore, authInterceptor, interceptors)
 
 	// Configure HTTP server
 	addr := fmt.Sprintf("%s:%d", s.cfg.Host, s.cfg.Port)
+	handler := s.newCORSHandler(mux, s.cfg.CorsOrigin)
+
+	if s.cfg.UnixSocket != "" {
+		ln, err := listenUnix(s.cfg.UnixSocket, cmp.Or(s.cfg.UnixSocketMode, "0660"))
+		if err != nil {
+			return err
+		}
+		s.unixServer = &http.Server{
+			Handler:           h2c.NewHandler(handler, &http2.Server{}),
+			ReadHeaderTimeout: 5 * time.Second,
+			IdleTimeout:       time.Duration(s.cfg.IdleTimeout) * time.Second,
+			ConnContext:       servicesv1.PeerCredContext,
+		}
+		logger.Info("Starting gRPC/HTTP server", "socket", s.cfg.UnixSocket)
+		if s.cfg.Port < 0 {
+			if err := s.unixServer.Serve(ln); err != nil && err != http.ErrServerClosed {
+				return fmt.Errorf("unix socket failed: %w", err)
+			}
+			return nil
+		}
+		go func() {
+			if err := s.unixServer.Serve(ln); err != nil && err != http.ErrServerClosed {
+				logger.Error("Unix socket server failed", logging.Err(err))
+			}
+		}()
+	}
+
 	s.httpServer = &http.Server{
 		Addr:              addr,
-		Handler:           h2c.NewHandler(s.newCORSHandler(mux, s.cfg.CorsOrigin), &http2.Server{}),
 		ReadHeaderTimeout: 5 * time.Second,
 		IdleTimeout:       time.Duration(s.cfg.IdleTimeout) * time.Second,
 	}
 
-	log.Printf("Starting gRPC/HTTP server on %s...", addr)
-	if err := s.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+	if s.tls != nil {
+		// HTTP/2 is negotiated through ALPN
+		s.httpServer.Handler = servicesv1.ClientCertificateHandler(handler)
+		s.httpServer.TLSConfig = s.tls.Config()
+		logger.Info("Starting gRPC/HTTPS server", "addr", addr, "client_ca", s.cfg.TLSClientCA)
+		err = s.httpServer.ListenAndServeTLS("", "")
+	} else {
+		s.httpServer.Handler = h2c.NewHandler(handler, &http2.Server{})
+		logger.Info("Starting gRPC/HTTP server", "addr", addr)
+		err = s.httpServer.ListenAndServe()
+	}
+	if err != nil && err != http.ErrServerClosed {
 		return fmt.Errorf("listen failed: %w", err)
 	}
 
 	return nil
 }
 
+// ReloadTLS reads the TLS certificate, key and client CA bundle from disk again. New
+// connections use them; established ones keep their session. It is a no-op without TLS.
+func (s *Server) ReloadTLS() error {
+	if s.tls == nil {
+		return nil
+	}
+	if err := s.tls.Reload(); err != nil {
+		return err
+	}
+	logger.Info("TLS certificates reloaded")
+	return nil
+}
+
 // Stop gracefully shuts down the server.
 func (s *Server) Stop(ctx context.Context) error {
-	log.Println("Shutting down server...")
+	logger.Info("Shutting down server...")
 
 	var errs []error
 
@@ -165,6 +388,20 @@ func (s *Server) Stop(ctx context.Context) error {
 		}
 	}
 
+	if s.unixServer != nil {
+		if err := s.unixServer.Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("unix socket server forced to shutdown: %w", err))
+		}
+	}
+
+	if s.follower != nil {
+		s.follower.Stop()
+	}
+
+	if s.scheduler != nil {
+		s.scheduler.Stop()
+	}
+
 	if s.dbServer != nil {
 		s.dbServer.Stop()
 	}
@@ -177,7 +414,14 @@ func (s *Server) Stop(ctx context.Context) error {
 		s.authStore.Close()
 	}
 
-	log.Println("Server exited properly.")
+	// Flush spans last so the shutdown itself is still exported
+	if s.tracing != nil {
+		if err := s.tracing(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to flush traces: %w", err))
+		}
+	}
+
+	logger.Info("Server exited properly.")
 
 	if len(errs) > 0 {
 		return errs[0] // Return the first error for simplicity
@@ -196,11 +440,29 @@ func (s *Server) setupMux(dbServer *servicesv1.DbServer, authStore *auth.MetaSto
 	// 2. Admin Service (gRPC/Connect) - only available when metadata store is active
 	if authStore != nil {
 		adminServer := servicesv1.NewAdminServer(authStore, dbServer, authInterceptor, s.cfg.AuthDisabled, s.version)
+		if s.follower != nil {
+			adminServer.SetFollower(s.follower)
+		}
+		if s.limiter != nil {
+			adminServer.SetRateLimiter(s.limiter)
+		}
 		adminPath, adminHandler := sqlrpcv1connect.NewAdminServiceHandler(adminServer, interceptors)
 		mux.Handle(adminPath, adminHandler)
 	}
 
-	// 3. Static and UI content handlers
+	// 3. Plain HTTP downloads and the REST gateway, authorized with the rules of the
+	// RPCs they stand in for
+	var authorizer servicesv1.HTTPAuthorizer = servicesv1.NewNoAuthInterceptor()
+	if authInterceptor != nil {
+		authorizer = s.limiter.GuardHTTP(authInterceptor)
+	}
+	if s.follower != nil {
+		authorizer = servicesv1.NewReplicaInterceptor(s.cfg.Leader).GuardHTTP(authorizer)
+	}
+	mux.Handle("GET /export/{db}", dbServer.ExportHandler(authorizer))
+	mux.Handle("/rest/", dbServer.RESTHandler(authorizer))
+
+	// 4. Static and UI content handlers
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		if authStore != nil {
 			if err := authStore.GetDB().Ping(); err != nil {
@@ -216,6 +478,10 @@ func (s *Server) setupMux(dbServer *servicesv1.DbServer, authStore *auth.MetaSto
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte(s.version))
 	})
+	if s.metrics != nil {
+		mux.Handle("/metrics", s.metrics.Handler())
+	}
+
 	mux.Handle("/studio/", studio.NewHandler("/studio/"))
 	mux.Handle("/docs/", docs.Handler())
 	mux.HandleFunc("/", landing.Handler(s.cfg.Port))
@@ -229,6 +495,11 @@ func (s *Server) setupMetadata(cfg *Config, initialConfigs []*sqlrpcv1.DatabaseC
 	if err != nil {
 		return nil, nil, fmt.Errorf("could not initialize metadata store: %w", err)
 	}
+	if err := authStore.SetPasswordParams(passwordParams(cfg)); err != nil {
+		authStore.Close()
+		return nil, nil, fmt.Errorf("invalid password hashing settings: %w", err)
+	}
+	authStore.SetLockoutPolicy(lockoutPolicy(cfg))
 
 	// Sync initial mounts to MetaStore
 	syncedNames := make(map[string]bool)
@@ -240,9 +511,9 @@ func (s *Server) setupMetadata(cfg *Config, initialConfigs []*sqlrpcv1.DatabaseC
 
 		existing, err := authStore.GetDatabaseConfig(context.Background(), dbCfg.Name)
 		if err == nil && existing != nil && existing.Path == dbCfg.DbPath && !cfg.MountsOverwrite {
-			log.Printf("Preserving existing metadata settings for mount '%s' (use --mounts-overwrite to update)", dbCfg.Name)
+			logger.Info("Preserving existing metadata settings for mount (use --mounts-overwrite to update)", logging.KeyDatabase, dbCfg.Name)
 			if err := protojson.Unmarshal([]byte(existing.Settings), dbCfg); err != nil {
-				log.Printf("Warning: failed to parse existing settings for '%s': %v", dbCfg.Name, err)
+				logger.Warn("Failed to parse existing settings", logging.KeyDatabase, dbCfg.Name, logging.Err(err))
 			}
 			dbCfg.Name = existing.Name
 			dbCfg.DbPath = existing.Path
@@ -250,7 +521,7 @@ func (s *Server) setupMetadata(cfg *Config, initialConfigs []*sqlrpcv1.DatabaseC
 			settingsBytes, _ := protojson.Marshal(dbCfg)
 			err = authStore.UpsertDatabaseConfig(context.Background(), dbCfg.Name, dbCfg.DbPath, false, string(settingsBytes))
 			if err != nil {
-				log.Printf("Warning: failed to sync config '%s' to metadata: %v", dbCfg.Name, err)
+				logger.Warn("Failed to sync config to metadata", logging.KeyDatabase, dbCfg.Name, logging.Err(err))
 			}
 		}
 		syncedNames[dbCfg.Name] = true
@@ -274,12 +545,64 @@ func (s *Server) setupMetadata(cfg *Config, initialConfigs []*sqlrpcv1.DatabaseC
 
 	// Ensure at least one admin user exists
 	if _, err := authStore.EnsureDefaultAdmin(cfg.InitialAdmin, cfg.InitialPassword); err != nil {
-		log.Printf("Warning: failed to ensure default admin: %v", err)
+		logger.Warn("Failed to ensure default admin", logging.Err(err))
 	}
 
 	return authStore, activeConfigs, nil
 }
 
+// passwordParams returns the argon2id settings of cfg. Unset values keep their default.
+func passwordParams(cfg *Config) auth.PasswordParams {
+	p := auth.DefaultPasswordParams
+	if cfg.PasswordMemory != 0 {
+		p.Memory = uint32(min(max(int64(cfg.PasswordMemory), 0), math.MaxUint32))
+	}
+	if cfg.PasswordIterations != 0 {
+		p.Iterations = uint32(min(max(int64(cfg.PasswordIterations), 0), math.MaxUint32))
+	}
+	if cfg.PasswordParallelism != 0 {
+		p.Parallelism = uint8(min(max(cfg.PasswordParallelism, 0), math.MaxUint8))
+	}
+	return p
+}
+
+// lockoutPolicy returns the login throttling settings of cfg. Unset values keep their
+// default and negative attempt limits disable that counter.
+func lockoutPolicy(cfg *Config) auth.LockoutPolicy {
+	p := auth.DefaultLockoutPolicy
+	if cfg.LockoutAttempts != 0 {
+		p.UserAttempts = max(cfg.LockoutAttempts, 0)
+	}
+	if cfg.LockoutIPAttempts != 0 {
+		p.IPAttempts = max(cfg.LockoutIPAttempts, 0)
+	}
+	if cfg.LockoutMaxSeconds > 0 {
+		p.MaxDelay = time.Duration(cfg.LockoutMaxSeconds) * time.Second
+		p.BaseDelay = min(p.BaseDelay, p.MaxDelay)
+	}
+	return p
+}
+
+// jwtVerifier builds the JWT bearer token verifier of cfg and loads its JWKS.
+func jwtVerifier(cfg *Config) (*auth.JWTVerifier, error) {
+	roleMap, err := auth.ParseRoleMap(cfg.JWTRoleMap)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	return auth.NewJWTVerifier(ctx, auth.JWTConfig{
+		JWKS:           cfg.JWTJWKS,
+		Issuer:         cfg.JWTIssuer,
+		Audience:       cfg.JWTAudience,
+		UsernameClaim:  cfg.JWTUsernameClaim,
+		RoleClaim:      cfg.JWTRoleClaim,
+		RoleMap:        roleMap,
+		DatabasesClaim: cfg.JWTDatabasesClaim,
+		Leeway:         time.Minute,
+	})
+}
+
 // loadInitialConfigs attempts to load database configurations from a JSON mounts file.
 func (s *Server) loadInitialConfigs(mountsFile string) []*sqlrpcv1.DatabaseConfig {
 	var initialConfigs []*sqlrpcv1.DatabaseConfig
@@ -289,10 +612,10 @@ func (s *Server) loadInitialConfigs(mountsFile string) []*sqlrpcv1.DatabaseConfi
 	if _, err := os.Stat(mountsFile); err == nil {
 		configs, err := sqldrivers.LoadJsonDBConfigs(mountsFile)
 		if err != nil {
-			log.Printf("Warning: could not load configurations from '%s': %v", mountsFile, err)
+			logger.Warn("Could not load mount configurations", "file", mountsFile, logging.Err(err))
 		} else {
 			initialConfigs = configs
-			log.Printf("Loaded %d database configurations from: %s", len(initialConfigs), mountsFile)
+			logger.Info("Loaded database configurations", "count", len(initialConfigs), "file", mountsFile)
 		}
 	}
 	return initialConfigs
@@ -336,8 +659,9 @@ func (s *Server) newCORSHandler(h http.Handler, allowedOrigin string) http.Handl
 func initDbDir(path string) {
 	if path != "." {
 		if err := os.MkdirAll(path, 0755); err != nil {
-			log.Fatalf("Fatal: could not create database directory '%s': %v", path, err)
+			logger.Error("Fatal: could not create database directory", "path", path, logging.Err(err))
+			os.Exit(1)
 		}
-		log.Printf("[DB] Base directory: %s", path)
+		logger.Info("Database base directory", "path", path)
 	}
 }
@@ -331,11 +331,44 @@ func TestSetupMetadata_ErrorPaths(t *testing.T) {
 	os.WriteFile(filepath.Join(tmpDir, "not_a_dir"), []byte("x"), 0644)
 	_, _, err = s.setupMetadata(badCfg, nil)
 	require.Error(t, err)
-	// 5. Trigger ListDatabaseConfigs failure (simulated by using a locked DB for the second part)
+	// 5. Invalid password hashing settings
+	_, _, err = s.setupMetadata(&Config{MetaDB: filepath.Join(tmpDir, "meta_params.db"), PasswordParallelism: 4, PasswordMemory: 16}, nil)
+	require.ErrorContains(t, err, "invalid password hashing settings")
+
+	// 6. Trigger ListDatabaseConfigs failure (simulated by using a locked DB for the second part)
 	// This is tricky because setupMetadata opens the DB.
 	// But we can hit the 100% of setupMux and high coverage of others.
 }
 
+func TestPasswordParams(t *testing.T) {
+	require.Equal(t, auth.DefaultPasswordParams, passwordParams(&Config{}))
+	require.Equal(t, auth.PasswordParams{Memory: 65536, Iterations: 3, Parallelism: 4},
+		passwordParams(&Config{PasswordMemory: 65536, PasswordIterations: 3, PasswordParallelism: 4}))
+	require.Error(t, passwordParams(&Config{PasswordIterations: -1}).Validate())
+}
+
+func TestLockoutPolicy(t *testing.T) {
+	require.Equal(t, auth.DefaultLockoutPolicy, lockoutPolicy(&Config{}))
+
+	p := lockoutPolicy(&Config{LockoutAttempts: 3, LockoutIPAttempts: -1, LockoutMaxSeconds: 60})
+	require.Equal(t, 3, p.UserAttempts)
+	require.Zero(t, p.IPAttempts, "disabled")
+	require.Equal(t, time.Minute, p.MaxDelay)
+}
+
+func TestJWTVerifier(t *testing.T) {
+	jwks := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(jwks, []byte(`{"keys":[{"kty":"OKP","crv":"Ed25519","kid":"k1","x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"}]}`), 0o600))
+
+	_, err := jwtVerifier(&Config{JWTJWKS: jwks, JWTIssuer: "https://idp.test", JWTAudience: "sqlite", JWTRoleMap: "dbas=admin"})
+	require.NoError(t, err)
+
+	_, err = jwtVerifier(&Config{JWTJWKS: jwks, JWTAudience: "sqlite"})
+	require.ErrorContains(t, err, "issuer")
+	_, err = jwtVerifier(&Config{JWTJWKS: jwks, JWTIssuer: "https://idp.test", JWTAudience: "sqlite", JWTRoleMap: "dbas=root"})
+	require.ErrorContains(t, err, "role mapping")
+}
+
 func httpRequest(path string) *http.Request {
 	req, _ := http.NewRequest("GET", path, nil)
 	return req
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// tlsFiles serves the certificate, key and optional client CA bundle from disk and
+// swaps them on Reload so certificates can be rotated without a restart.
+type tlsFiles struct {
+	certFile, keyFile, clientCAFile string
+	requireClientCert               bool
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+}
+
+// newTLSFiles loads the TLS material of cfg.
+func newTLSFiles(cfg *Config) (*tlsFiles, error) {
+	if cfg.TLSCert == "" || cfg.TLSKey == "" {
+		return nil, errors.New("--tls-cert and --tls-key must be set together")
+	}
+	if cfg.TLSRequireClientCert && cfg.TLSClientCA == "" {
+		return nil, errors.New("--tls-require-client-cert needs --tls-client-ca")
+	}
+	f := &tlsFiles{
+		certFile:          cfg.TLSCert,
+		keyFile:           cfg.TLSKey,
+		clientCAFile:      cfg.TLSClientCA,
+		requireClientCert: cfg.TLSRequireClientCert,
+	}
+	if err := f.Reload(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Reload reads the files again. On error the current material stays in use.
+func (f *tlsFiles) Reload() error {
+	cert, err := tls.LoadX509KeyPair(f.certFile, f.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load tls certificate: %w", err)
+	}
+
+	var pool *x509.CertPool
+	if f.clientCAFile != "" {
+		pem, err := os.ReadFile(f.clientCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to read tls client ca: %w", err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates found in tls client ca %s", f.clientCAFile)
+		}
+	}
+
+	f.mu.Lock()
+	f.cert, f.clientCAs = &cert, pool
+	f.mu.Unlock()
+	return nil
+}
+
+// Config returns the server TLS configuration. Every handshake picks up the material
+// of the latest successful Reload.
+func (f *tlsFiles) Config() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: []string{"h2", "http/1.1"},
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			f.mu.RLock()
+			defer f.mu.RUnlock()
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				NextProtos:   []string{"h2", "http/1.1"},
+				Certificates: []tls.Certificate{*f.cert},
+			}
+			if f.clientCAs != nil {
+				// Clients without a certificate may still use the other credentials
+				cfg.ClientCAs, cfg.ClientAuth = f.clientCAs, tls.VerifyClientCertIfGiven
+				if f.requireClientCert {
+					cfg.ClientAuth = tls.RequireAndVerifyClientCert
+				}
+			}
+			return cfg, nil
+		},
+	}
+}
+
+// leaderTLSConfig returns the TLS configuration a follower uses to reach its leader.
+// caFile replaces the system roots when set.
+func leaderTLSConfig(caFile string) (*tls.Config, error) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile == "" {
+		return cfg, nil
+	}
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read leader ca: %w", err)
+	}
+	cfg.RootCAs = x509.NewCertPool()
+	if !cfg.RootCAs.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in leader ca %s", caFile)
+	}
+	return cfg, nil
+}
@@ -0,0 +1,199 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCert is a certificate with its key, signed by a CA or self-signed.
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	tls  tls.Certificate
+}
+
+func newTestCert(t *testing.T, cn string, ca *testCert) *testCert {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	parent, signer := tmpl, key
+	if ca == nil {
+		tmpl.IsCA, tmpl.BasicConstraintsValid = true, true
+		tmpl.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature
+	} else {
+		parent, signer = ca.cert, ca.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, signer)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCert{cert: cert, key: key, tls: tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: cert}}
+}
+
+// write stores the certificate and key as PEM files and returns their paths.
+func (c *testCert) write(t *testing.T, dir string) (certFile, keyFile string) {
+	t.Helper()
+	keyDER, err := x509.MarshalECPrivateKey(c.key)
+	require.NoError(t, err)
+	certFile, keyFile = filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.cert.Raw}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+	return certFile, keyFile
+}
+
+// serveTLS serves the common name of the verified client certificate, or "anonymous".
+func serveTLS(t *testing.T, f *tlsFiles) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if len(r.TLS.VerifiedChains) == 0 {
+				io.WriteString(w, "anonymous")
+				return
+			}
+			io.WriteString(w, r.TLS.VerifiedChains[0][0].Subject.CommonName)
+		}),
+		TLSConfig: f.Config(),
+		ErrorLog:  log.New(io.Discard, "", 0),
+	}
+	go srv.ServeTLS(ln, "", "")
+	t.Cleanup(func() { srv.Close() })
+	return "https://" + ln.Addr().String()
+}
+
+// get fetches url trusting roots, presenting client when set, and returns the body and
+// the common name of the server certificate.
+func get(url string, roots *x509.CertPool, client *testCert) (body, serverCN string, err error) {
+	cfg := &tls.Config{RootCAs: roots}
+	if client != nil {
+		// Present the certificate even when the server asks for other CAs
+		cfg.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return &client.tls, nil
+		}
+	}
+	c := &http.Client{Transport: &http.Transport{TLSClientConfig: cfg, ForceAttemptHTTP2: true, DisableKeepAlives: true}}
+	resp, err := c.Get(url)
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	return string(b), resp.TLS.PeerCertificates[0].Subject.CommonName, err
+}
+
+func TestNewTLSFiles_Errors(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := newTestCert(t, "server", nil).write(t, dir)
+
+	_, err := newTLSFiles(&Config{TLSCert: certFile})
+	assert.ErrorContains(t, err, "must be set together")
+	_, err = newTLSFiles(&Config{TLSCert: certFile, TLSKey: keyFile, TLSRequireClientCert: true})
+	assert.ErrorContains(t, err, "needs --tls-client-ca")
+	_, err = newTLSFiles(&Config{TLSCert: certFile, TLSKey: filepath.Join(dir, "missing.pem")})
+	assert.ErrorContains(t, err, "failed to load tls certificate")
+	_, err = newTLSFiles(&Config{TLSCert: certFile, TLSKey: keyFile, TLSClientCA: keyFile})
+	assert.ErrorContains(t, err, "no certificates found")
+}
+
+func TestTLSFiles_Reload(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, "ca", nil)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+
+	certFile, keyFile := newTestCert(t, "server-1", ca).write(t, dir)
+	f, err := newTLSFiles(&Config{TLSCert: certFile, TLSKey: keyFile})
+	require.NoError(t, err)
+	url := serveTLS(t, f)
+
+	body, serverCN, err := get(url, roots, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "server-1", serverCN)
+	assert.Equal(t, "anonymous", body)
+
+	newTestCert(t, "server-2", ca).write(t, dir)
+	require.NoError(t, f.Reload())
+	_, serverCN, err = get(url, roots, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "server-2", serverCN)
+
+	// A broken file leaves the current certificate in place
+	require.NoError(t, os.WriteFile(keyFile, []byte("garbage"), 0o600))
+	require.Error(t, f.Reload())
+	_, serverCN, err = get(url, roots, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "server-2", serverCN)
+}
+
+func TestTLSFiles_ClientCertificates(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, "ca", nil)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	certFile, keyFile := newTestCert(t, "server", ca).write(t, dir)
+	caFile := filepath.Join(dir, "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw}), 0o600))
+
+	alice := newTestCert(t, "alice", ca)
+	stranger := newTestCert(t, "mallory", newTestCert(t, "other-ca", nil))
+
+	t.Run("optional", func(t *testing.T) {
+		f, err := newTLSFiles(&Config{TLSCert: certFile, TLSKey: keyFile, TLSClientCA: caFile})
+		require.NoError(t, err)
+		url := serveTLS(t, f)
+
+		body, _, err := get(url, roots, alice)
+		require.NoError(t, err)
+		assert.Equal(t, "alice", body)
+
+		body, _, err = get(url, roots, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "anonymous", body)
+
+		_, _, err = get(url, roots, stranger)
+		assert.Error(t, err, "certificates from another CA are rejected")
+	})
+
+	t.Run("required", func(t *testing.T) {
+		f, err := newTLSFiles(&Config{TLSCert: certFile, TLSKey: keyFile, TLSClientCA: caFile, TLSRequireClientCert: true})
+		require.NoError(t, err)
+		url := serveTLS(t, f)
+
+		body, _, err := get(url, roots, alice)
+		require.NoError(t, err)
+		assert.Equal(t, "alice", body)
+
+		_, _, err = get(url, roots, nil)
+		assert.Error(t, err)
+	})
+}
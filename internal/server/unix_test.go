@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListenUnix(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "server.sock")
+
+	ln, err := listenUnix(path, "0600")
+	require.NoError(t, err)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, fs.FileMode(0o600), info.Mode().Perm())
+
+	_, err = listenUnix(path, "0600")
+	assert.ErrorContains(t, err, "in use")
+	require.NoError(t, ln.Close())
+
+	// A socket file without a listener is stale and gets replaced
+	stale, err := net.ListenUnix("unix", &net.UnixAddr{Name: path, Net: "unix"})
+	require.NoError(t, err)
+	stale.SetUnlinkOnClose(false)
+	require.NoError(t, stale.Close())
+	ln, err = listenUnix(path, "0660")
+	require.NoError(t, err)
+	ln.Close()
+
+	regular := filepath.Join(dir, "file")
+	require.NoError(t, os.WriteFile(regular, nil, 0o600))
+	_, err = listenUnix(regular, "0660")
+	assert.ErrorContains(t, err, "not a socket")
+
+	_, err = listenUnix(filepath.Join(dir, "other.sock"), "rw")
+	assert.ErrorContains(t, err, "invalid --unix-socket-mode")
+}
+
+func TestServerUnixSocket(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("peer credentials need SO_PEERCRED")
+	}
+	socket := filepath.Join(t.TempDir(), "server.sock")
+	srv := New(&Config{
+		Port:            -1,
+		UnixSocket:      socket,
+		UnixPeerMap:     fmt.Sprintf("%d=admin", os.Getuid()),
+		MetaDB:          ":memory:",
+		DbDir:           t.TempDir(),
+		ShutdownTimeout: 1,
+	})
+	errCh := make(chan error, 1)
+	go func() { errCh <- srv.Start() }()
+	t.Cleanup(func() {
+		require.NoError(t, srv.Stop(context.Background()))
+		require.NoError(t, <-errCh)
+	})
+
+	client := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", socket)
+		},
+	}}
+	var resp *http.Response
+	var err error
+	for range 50 {
+		resp, err = client.Post("http://unix/sqlrpc.v1.AdminService/ListUsers", "application/json", strings.NewReader("{}"))
+		if err == nil {
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "the peer UID logs in as admin")
+}
+
+func TestServerUnixSocket_Config(t *testing.T) {
+	for _, cfg := range []*Config{
+		{Port: -1},
+		{UnixPeerMap: "1000=app"},
+		{UnixSocket: filepath.Join(t.TempDir(), "s.sock"), UnixPeerMap: "app=1000"},
+	} {
+		cfg.MetaDB, cfg.DbDir, cfg.AuthDisabled = ":memory:", t.TempDir(), true
+		assert.Error(t, New(cfg).Start())
+	}
+}
@@ -4,16 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
+	"net"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"sqlite-server/internal/auth"
+	"sqlite-server/internal/logging"
 	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
 	"sqlite-server/internal/protos/sqlrpc/v1/sqlrpcv1connect"
 
+	"buf.build/go/protovalidate"
 	"connectrpc.com/connect"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -27,6 +30,13 @@ type AdminServer struct {
 	cache        AuthCacheInvalidator
 	authDisabled bool
 	version      string
+
+	// follower is set when this server replicates from a leader.
+	follower *Follower
+	// limits is told about rate limit changes. Nil when rate limiting is off.
+	limits *RateLimiter
+	// followerCount is the number of followers streaming from this server.
+	followerCount atomic.Int32
 }
 
 // NewAdminServer creates a new AdminServer instance
@@ -40,6 +50,28 @@ func NewAdminServer(store *auth.MetaStore, dbServer *DbServer, cache AuthCacheIn
 	}
 }
 
+// SetFollower marks the server as a read replica of the given follower's leader.
+func (s *AdminServer) SetFollower(f *Follower) {
+	s.follower = f
+}
+
+// SetRateLimiter lets rate limit changes apply to the next call instead of after the
+// limiter's cache expires.
+func (s *AdminServer) SetRateLimiter(l *RateLimiter) {
+	s.limits = l
+}
+
+// replicationStatus reports the replication role and state of the server.
+func (s *AdminServer) replicationStatus() *sqlrpcv1.ReplicationStatus {
+	if s.follower != nil {
+		return s.follower.Status()
+	}
+	return &sqlrpcv1.ReplicationStatus{
+		Role:          sqlrpcv1.ReplicationRole_REPLICATION_ROLE_LEADER,
+		FollowerCount: s.followerCount.Load(),
+	}
+}
+
 // CreateUser creates a new user account
 func (s *AdminServer) CreateUser(ctx context.Context, req *connect.Request[sqlrpcv1.CreateUserRequest]) (*connect.Response[sqlrpcv1.CreateUserResponse], error) {
 	if s.authDisabled {
@@ -205,6 +237,26 @@ func (s *AdminServer) DeleteUser(ctx context.Context, req *connect.Request[sqlrp
 	}), nil
 }
 
+// UnlockUser clears the failed login attempts and lockout of a username and, if given,
+// of a client IP address.
+func (s *AdminServer) UnlockUser(ctx context.Context, req *connect.Request[sqlrpcv1.UnlockUserRequest]) (*connect.Response[sqlrpcv1.UnlockUserResponse], error) {
+	if s.authDisabled {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("user management is disabled when running in no-auth mode"))
+	}
+	if err := protovalidate.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if ip := req.Msg.ClientIp; ip != "" && net.ParseIP(ip) == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid client ip %q", ip))
+	}
+
+	unlocked, err := s.store.UnlockUser(ctx, req.Msg.Username, req.Msg.ClientIp)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&sqlrpcv1.UnlockUserResponse{Unlocked: unlocked}), nil
+}
+
 // CreateAPIKey generates a new API key for a user
 func (s *AdminServer) CreateAPIKey(ctx context.Context, req *connect.Request[sqlrpcv1.CreateAPIKeyRequest]) (*connect.Response[sqlrpcv1.CreateAPIKeyResponse], error) {
 	if s.authDisabled {
@@ -220,6 +272,11 @@ func (s *AdminServer) CreateAPIKey(ctx context.Context, req *connect.Request[sql
 		expiresAt = &t
 	}
 
+	// A scoped key must not be able to mint itself a broader one
+	if claims, ok := auth.FromContext(ctx); ok && claims.Scope != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("scoped API keys cannot create API keys"))
+	}
+
 	// Allow if admin OR if creating key for self
 	// Look up user by username
 	user, err := s.store.GetUserByUsername(ctx, req.Msg.Username)
@@ -230,8 +287,11 @@ func (s *AdminServer) CreateAPIKey(ctx context.Context, req *connect.Request[sql
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
 	}
 
-	rawKey, keyID, err := s.store.CreateApiKey(ctx, user.ID, req.Msg.Name, expiresAt)
+	rawKey, keyID, err := s.store.CreateScopedApiKey(ctx, user.ID, req.Msg.Name, expiresAt, fromProtoScope(req.Msg.Scope))
 	if err != nil {
+		if strings.HasPrefix(err.Error(), "invalid scope") {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
@@ -269,6 +329,7 @@ func (s *AdminServer) ListAPIKeys(ctx context.Context, req *connect.Request[sqlr
 			Name:       k.Name,
 			KeyPreview: k.KeyPrefix,
 			CreatedAt:  timestamppb.New(k.CreatedAt),
+			Scope:      toProtoScope(k.Scope),
 		})
 	}
 
@@ -277,6 +338,30 @@ func (s *AdminServer) ListAPIKeys(ctx context.Context, req *connect.Request[sqlr
 	}), nil
 }
 
+func fromProtoScope(scope *sqlrpcv1.APIKeyScope) *auth.ApiKeyScope {
+	if scope == nil {
+		return nil
+	}
+	return &auth.ApiKeyScope{
+		Databases: scope.Databases,
+		Channels:  scope.Channels,
+		MaxRole:   scope.MaxRole,
+		Families:  scope.Families,
+	}
+}
+
+func toProtoScope(scope *auth.ApiKeyScope) *sqlrpcv1.APIKeyScope {
+	if scope == nil {
+		return nil
+	}
+	return &sqlrpcv1.APIKeyScope{
+		Databases: scope.Databases,
+		Channels:  scope.Channels,
+		MaxRole:   scope.MaxRole,
+		Families:  scope.Families,
+	}
+}
+
 // DeleteAPIKey deletes an API key
 func (s *AdminServer) DeleteAPIKey(ctx context.Context, req *connect.Request[sqlrpcv1.DeleteAPIKeyRequest]) (*connect.Response[sqlrpcv1.DeleteAPIKeyResponse], error) {
 	if s.authDisabled {
@@ -301,6 +386,181 @@ func (s *AdminServer) DeleteAPIKey(ctx context.Context, req *connect.Request[sql
 	}), nil
 }
 
+// GrantDatabaseAccess assigns a per-database role to a user or a single API key
+func (s *AdminServer) GrantDatabaseAccess(ctx context.Context, req *connect.Request[sqlrpcv1.GrantDatabaseAccessRequest]) (*connect.Response[sqlrpcv1.GrantDatabaseAccessResponse], error) {
+	if s.authDisabled {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("access control is disabled when running in no-auth mode"))
+	}
+
+	grant, err := s.store.GrantDatabaseAccess(ctx, req.Msg.GetUsername(), req.Msg.GetApiKeyId(), req.Msg.Database, req.Msg.Role)
+	if err != nil {
+		return nil, grantError(err)
+	}
+
+	// Invalidate cache so the grant applies to cached identities immediately
+	if s.cache != nil {
+		s.cache.ClearCache()
+	}
+
+	return connect.NewResponse(&sqlrpcv1.GrantDatabaseAccessResponse{
+		Grant: toProtoGrant(grant),
+	}), nil
+}
+
+// RevokeDatabaseAccess removes a per-database grant
+func (s *AdminServer) RevokeDatabaseAccess(ctx context.Context, req *connect.Request[sqlrpcv1.RevokeDatabaseAccessRequest]) (*connect.Response[sqlrpcv1.RevokeDatabaseAccessResponse], error) {
+	if s.authDisabled {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("access control is disabled when running in no-auth mode"))
+	}
+
+	if err := s.store.RevokeDatabaseAccess(ctx, req.Msg.GrantId); err != nil {
+		return nil, grantError(err)
+	}
+
+	// Invalidate cache immediately to revoke access
+	if s.cache != nil {
+		s.cache.ClearCache()
+	}
+
+	return connect.NewResponse(&sqlrpcv1.RevokeDatabaseAccessResponse{
+		Success: true,
+	}), nil
+}
+
+// ListDatabaseGrants returns the per-database grants matching the optional filters
+func (s *AdminServer) ListDatabaseGrants(ctx context.Context, req *connect.Request[sqlrpcv1.ListDatabaseGrantsRequest]) (*connect.Response[sqlrpcv1.ListDatabaseGrantsResponse], error) {
+	if s.authDisabled {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("access control is disabled when running in no-auth mode"))
+	}
+
+	grants, err := s.store.ListDatabaseGrants(ctx, req.Msg.Username, req.Msg.Database)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	pbGrants := make([]*sqlrpcv1.DatabaseGrant, len(grants))
+	for i := range grants {
+		pbGrants[i] = toProtoGrant(&grants[i])
+	}
+
+	return connect.NewResponse(&sqlrpcv1.ListDatabaseGrantsResponse{
+		Grants: pbGrants,
+	}), nil
+}
+
+// SetRateLimit stores the limits of a user, a single API key or an identity without a
+// local user on a database pattern
+func (s *AdminServer) SetRateLimit(ctx context.Context, req *connect.Request[sqlrpcv1.SetRateLimitRequest]) (*connect.Response[sqlrpcv1.SetRateLimitResponse], error) {
+	if s.authDisabled {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("rate limits are disabled when running in no-auth mode"))
+	}
+	if err := protovalidate.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	msg := req.Msg
+	limit, err := s.store.SetRateLimit(ctx, msg.GetUsername(), msg.GetApiKeyId(), auth.RateLimit{
+		Database:          msg.Database,
+		RequestsPerSecond: msg.RequestsPerSecond,
+		Burst:             int(msg.Burst),
+		MaxStreams:        int(msg.MaxStreams),
+		MaxTransactions:   int(msg.MaxTransactions),
+		RowsPerMinute:     msg.RowsPerMinute,
+		BytesPerMinute:    msg.BytesPerMinute,
+		Issuer:            msg.Issuer,
+	})
+	if err != nil {
+		return nil, grantError(err)
+	}
+	if s.limits != nil {
+		s.limits.Invalidate()
+	}
+
+	return connect.NewResponse(&sqlrpcv1.SetRateLimitResponse{
+		Limit: toProtoRateLimit(limit),
+	}), nil
+}
+
+// DeleteRateLimit removes a rate limit
+func (s *AdminServer) DeleteRateLimit(ctx context.Context, req *connect.Request[sqlrpcv1.DeleteRateLimitRequest]) (*connect.Response[sqlrpcv1.DeleteRateLimitResponse], error) {
+	if s.authDisabled {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("rate limits are disabled when running in no-auth mode"))
+	}
+
+	if err := s.store.DeleteRateLimit(ctx, req.Msg.LimitId); err != nil {
+		return nil, grantError(err)
+	}
+	if s.limits != nil {
+		s.limits.Invalidate()
+	}
+
+	return connect.NewResponse(&sqlrpcv1.DeleteRateLimitResponse{
+		Success: true,
+	}), nil
+}
+
+// ListRateLimits returns the rate limits matching the optional filters
+func (s *AdminServer) ListRateLimits(ctx context.Context, req *connect.Request[sqlrpcv1.ListRateLimitsRequest]) (*connect.Response[sqlrpcv1.ListRateLimitsResponse], error) {
+	if s.authDisabled {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("rate limits are disabled when running in no-auth mode"))
+	}
+
+	limits, err := s.store.ListRateLimits(ctx, req.Msg.Username, req.Msg.Database)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	pbLimits := make([]*sqlrpcv1.RateLimit, len(limits))
+	for i := range limits {
+		pbLimits[i] = toProtoRateLimit(&limits[i])
+	}
+
+	return connect.NewResponse(&sqlrpcv1.ListRateLimitsResponse{
+		Limits: pbLimits,
+	}), nil
+}
+
+func toProtoRateLimit(l *auth.RateLimit) *sqlrpcv1.RateLimit {
+	return &sqlrpcv1.RateLimit{
+		Id:                l.ID,
+		Username:          l.Username,
+		ApiKeyId:          l.APIKeyID,
+		Issuer:            l.Issuer,
+		Database:          l.Database,
+		RequestsPerSecond: l.RequestsPerSecond,
+		Burst:             int32(l.Burst),
+		MaxStreams:        int32(l.MaxStreams),
+		MaxTransactions:   int32(l.MaxTransactions),
+		RowsPerMinute:     l.RowsPerMinute,
+		BytesPerMinute:    l.BytesPerMinute,
+		CreatedAt:         timestamppb.New(l.CreatedAt),
+	}
+}
+
+// grantError maps MetaStore grant and rate limit errors onto connect codes
+func grantError(err error) error {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "not found"):
+		return connect.NewError(connect.CodeNotFound, err)
+	case strings.HasPrefix(msg, "invalid"), strings.HasPrefix(msg, "exactly one"), strings.HasPrefix(msg, "database pattern"):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+func toProtoGrant(g *auth.DatabaseGrant) *sqlrpcv1.DatabaseGrant {
+	return &sqlrpcv1.DatabaseGrant{
+		Id:        g.ID,
+		Username:  g.Username,
+		ApiKeyId:  g.APIKeyID,
+		Database:  g.Database,
+		Role:      g.Role,
+		CreatedAt: timestamppb.New(g.CreatedAt),
+	}
+}
+
 // ListDatabases returns all available databases
 func (s *AdminServer) ListDatabases(ctx context.Context, req *connect.Request[sqlrpcv1.ListDatabasesRequest]) (*connect.Response[sqlrpcv1.ListDatabasesResponse], error) {
 	configs, err := s.store.ListDatabaseConfigs(ctx)
@@ -308,8 +568,14 @@ func (s *AdminServer) ListDatabases(ctx context.Context, req *connect.Request[sq
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	infos := make([]*sqlrpcv1.DatabaseInfo, len(configs))
-	for i, cfg := range configs {
+	// Hide databases the caller holds no grant on
+	claims, _ := auth.FromContext(ctx)
+
+	infos := make([]*sqlrpcv1.DatabaseInfo, 0, len(configs))
+	for _, cfg := range configs {
+		if claims != nil && claims.RoleFor(cfg.Name) == sqlrpcv1.Role_ROLE_UNSPECIFIED {
+			continue
+		}
 		info := &sqlrpcv1.DatabaseInfo{
 			Name:      cfg.Name,
 			Path:      cfg.Path,
@@ -325,7 +591,7 @@ func (s *AdminServer) ListDatabases(ctx context.Context, req *connect.Request[sq
 				info.LoadedExtensions = fullCfg.Extensions
 			}
 		}
-		infos[i] = info
+		infos = append(infos, info)
 	}
 
 	return connect.NewResponse(&sqlrpcv1.ListDatabasesResponse{
@@ -341,16 +607,17 @@ func (s *AdminServer) CreateDatabase(ctx context.Context, req *connect.Request[s
 
 	// Build DatabaseConfig from inline request fields
 	config := &sqlrpcv1.DatabaseConfig{
-		Name:              name,
-		DbPath:            dbPath,
-		IsEncrypted:       req.Msg.IsEncrypted,
-		Key:               req.Msg.Key,
-		ReadOnly:          false, // Can't create a read-only database
-		Extensions:        req.Msg.Extensions,
-		Pragmas:           req.Msg.Pragmas,
-		MaxOpenConns:      req.Msg.MaxOpenConns,
-		MaxIdleConns:      req.Msg.MaxIdleConns,
-		ConnMaxLifetimeMs: req.Msg.ConnMaxLifetimeMs,
+		Name:               name,
+		DbPath:             dbPath,
+		IsEncrypted:        req.Msg.IsEncrypted,
+		Key:                req.Msg.Key,
+		ReadOnly:           false, // Can't create a read-only database
+		Extensions:         req.Msg.Extensions,
+		Pragmas:            req.Msg.Pragmas,
+		MaxOpenConns:       req.Msg.MaxOpenConns,
+		MaxIdleConns:       req.Msg.MaxIdleConns,
+		ConnMaxLifetimeMs:  req.Msg.ConnMaxLifetimeMs,
+		MaxExecutionTimeMs: req.Msg.MaxExecutionTimeMs,
 	}
 
 	// Ensure directory exists
@@ -392,7 +659,7 @@ func (s *AdminServer) CreateDatabase(ctx context.Context, req *connect.Request[s
 	}
 
 	// 3. Log success
-	log.Printf("Created database '%s' at '%s'", name, dbPath)
+	dbLog.InfoContext(ctx, "Created database", logging.KeyDatabase, name, "path", dbPath)
 
 	return connect.NewResponse(&sqlrpcv1.CreateDatabaseResponse{
 		Success: true,
@@ -416,16 +683,17 @@ func (s *AdminServer) MountDatabase(ctx context.Context, req *connect.Request[sq
 
 	// 1. Prepare configuration
 	config := &sqlrpcv1.DatabaseConfig{
-		Name:              name,
-		DbPath:            path,
-		ReadOnly:          req.Msg.ReadOnly,
-		IsEncrypted:       req.Msg.IsEncrypted,
-		Key:               req.Msg.Key,
-		Extensions:        req.Msg.Extensions,
-		Pragmas:           req.Msg.Pragmas,
-		MaxOpenConns:      req.Msg.MaxOpenConns,
-		MaxIdleConns:      req.Msg.MaxIdleConns,
-		ConnMaxLifetimeMs: req.Msg.ConnMaxLifetimeMs,
+		Name:               name,
+		DbPath:             path,
+		ReadOnly:           req.Msg.ReadOnly,
+		IsEncrypted:        req.Msg.IsEncrypted,
+		Key:                req.Msg.Key,
+		Extensions:         req.Msg.Extensions,
+		Pragmas:            req.Msg.Pragmas,
+		MaxOpenConns:       req.Msg.MaxOpenConns,
+		MaxIdleConns:       req.Msg.MaxIdleConns,
+		ConnMaxLifetimeMs:  req.Msg.ConnMaxLifetimeMs,
+		MaxExecutionTimeMs: req.Msg.MaxExecutionTimeMs,
 	}
 
 	// 2. Persist config (is_managed=false)
@@ -444,7 +712,7 @@ func (s *AdminServer) MountDatabase(ctx context.Context, req *connect.Request[sq
 	}
 
 	// 4. Log success
-	log.Printf("Mounted database '%s' at '%s'", name, path)
+	dbLog.InfoContext(ctx, "Mounted database", logging.KeyDatabase, name, "path", path)
 
 	return connect.NewResponse(&sqlrpcv1.MountDatabaseResponse{
 		Success: true,
@@ -468,7 +736,7 @@ func (s *AdminServer) UnMountDatabase(ctx context.Context, req *connect.Request[
 	}
 
 	// 3. Log success
-	log.Printf("Unmounted database '%s'", name)
+	dbLog.InfoContext(ctx, "Unmounted database", logging.KeyDatabase, name)
 
 	return connect.NewResponse(&sqlrpcv1.UnMountDatabaseResponse{
 		Success: true,
@@ -509,7 +777,7 @@ func (s *AdminServer) DeleteDatabase(ctx context.Context, req *connect.Request[s
 	}
 
 	// 5. Log success
-	log.Printf("Deleted database '%s' at '%s'", name, config.Path)
+	dbLog.InfoContext(ctx, "Deleted database", logging.KeyDatabase, name, "path", config.Path)
 
 	return connect.NewResponse(&sqlrpcv1.DeleteDatabaseResponse{
 		Success: true,
@@ -523,13 +791,13 @@ func (s *AdminServer) Login(ctx context.Context, req *connect.Request[sqlrpcv1.L
 		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("authentication is disabled on this server"))
 	}
 
-	// 1. Verify credentials from request body
-	user, err := s.store.ValidateUser(ctx, req.Msg.Username, req.Msg.Password)
-	if err != nil {
+	// 1. Verify credentials from request body, throttled per username and client IP
+	user, err := s.store.Authenticate(ctx, req.Msg.Username, req.Msg.Password, peerIP(req.Peer().Addr))
+	if err != nil && !errors.As(err, new(*auth.LockedOutError)) {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 	if user == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid credentials"))
+		return nil, loginError(err)
 	}
 
 	// 3. Determine session duration
@@ -570,9 +838,9 @@ func (s *AdminServer) Logout(ctx context.Context, req *connect.Request[sqlrpcv1.
 	if err := s.store.RevokeApiKey(ctx, req.Msg.KeyId, req.Msg.Username); err != nil {
 		// Even if not found, we return success for idempotency security
 		// But let's log it
-		log.Printf("Warning: failed to revoke key %s during logout: %v", req.Msg.KeyId, err)
+		authLog.WarnContext(ctx, "Failed to revoke key during logout", "key_id", req.Msg.KeyId, logging.Err(err))
 	} else {
-		log.Printf("Logged out session key %s", req.Msg.KeyId)
+		authLog.InfoContext(ctx, "Logged out session key", "key_id", req.Msg.KeyId)
 	}
 
 	return connect.NewResponse(&sqlrpcv1.LogoutResponse{
@@ -631,6 +899,17 @@ func (s *AdminServer) UpdateDatabase(ctx context.Context, req *connect.Request[s
 		currentConfig.Attachments = updates.Attachments.Values
 	}
 
+	if updates.MaxExecutionTimeMs != nil {
+		currentConfig.MaxExecutionTimeMs = *updates.MaxExecutionTimeMs
+	}
+
+	if updates.Maintenance != nil {
+		if err := validateMaintenanceSchedule(updates.Maintenance); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		currentConfig.Maintenance = updates.Maintenance
+	}
+
 	// 4. Persist updated config
 	jsonBytes, err := protojson.Marshal(currentConfig)
 	if err != nil {
@@ -645,11 +924,11 @@ func (s *AdminServer) UpdateDatabase(ctx context.Context, req *connect.Request[s
 	// 5. Reload in DbServer (with the updated proto object)
 	if err := s.dbServer.UpdateDatabase(currentConfig); err != nil {
 		// Log error (config persists but runtime reload failed)
-		log.Printf("ERROR: Failed to reload database '%s' after update: %v", name, err)
+		dbLog.ErrorContext(ctx, "Failed to reload database after update", logging.KeyDatabase, name, logging.Err(err))
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("config saved but reload failed: %w", err))
 	}
 
-	log.Printf("Updated configuration for database '%s'", name)
+	dbLog.InfoContext(ctx, "Updated database configuration", logging.KeyDatabase, name)
 
 	return connect.NewResponse(&sqlrpcv1.UpdateDatabaseResponse{
 		Success: true,
@@ -668,5 +947,6 @@ func (s *AdminServer) GetServerInfo(ctx context.Context, req *connect.Request[sq
 		DatabaseCount: 0, // Mocked
 		UptimeSeconds: 0, // Mocked
 		AuthDisabled:  s.authDisabled,
+		Replication:   s.replicationStatus(),
 	}), nil
 }
@@ -3,6 +3,7 @@ package servicesv1
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -15,7 +16,9 @@ import (
 	"connectrpc.com/connect"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -654,6 +657,90 @@ func TestAdminServer_Login(t *testing.T) {
 	})
 }
 
+func TestAdminServer_LoginLockout(t *testing.T) {
+	server, store, _ := setupAdminTestServer(t)
+	ctx := context.Background()
+	store.SetLockoutPolicy(auth.LockoutPolicy{UserAttempts: 2, IPAttempts: 10, BaseDelay: 1500 * time.Millisecond, MaxDelay: time.Minute, ResetAfter: time.Hour})
+	_, err := store.CreateUser(ctx, "lockme", "password123", sqlrpcv1.Role_ROLE_READ_ONLY)
+	require.NoError(t, err)
+	login := func(password string) error {
+		_, err := server.Login(ctx, connect.NewRequest(&sqlrpcv1.LoginRequest{Username: "lockme", Password: password}))
+		return err
+	}
+
+	for range 2 {
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(login("wrongpassword")))
+	}
+
+	err = login("wrongpassword")
+	require.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+	var connectErr *connect.Error
+	require.ErrorAs(t, err, &connectErr)
+	assert.Equal(t, "2", connectErr.Meta().Get("Retry-After"), "rounded up to whole seconds")
+	require.Len(t, connectErr.Details(), 1)
+	detail, err := connectErr.Details()[0].Value()
+	require.NoError(t, err)
+	require.IsType(t, &errdetails.RetryInfo{}, detail)
+	assert.Equal(t, 1500*time.Millisecond, detail.(*errdetails.RetryInfo).RetryDelay.AsDuration())
+
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(login("password123")), "blocked while locked out")
+}
+
+func TestAdminServer_UnlockUser(t *testing.T) {
+	server, store, _ := setupAdminTestServer(t)
+	ctx := context.Background()
+	store.SetLockoutPolicy(auth.LockoutPolicy{UserAttempts: 1, IPAttempts: 1, BaseDelay: time.Minute, MaxDelay: time.Hour, ResetAfter: time.Hour})
+	_, err := store.CreateUser(ctx, "lockme", "password123", sqlrpcv1.Role_ROLE_READ_ONLY)
+	require.NoError(t, err)
+	for range 2 {
+		_, err := store.Authenticate(ctx, "lockme", "wrongpassword", "198.51.100.7")
+		require.NoError(t, ignoreLockout(err))
+	}
+
+	t.Run("validation", func(t *testing.T) {
+		_, err := server.UnlockUser(ctx, connect.NewRequest(&sqlrpcv1.UnlockUserRequest{}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		_, err = server.UnlockUser(ctx, connect.NewRequest(&sqlrpcv1.UnlockUserRequest{Username: "lockme", ClientIp: "not-an-ip"}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	t.Run("username only", func(t *testing.T) {
+		res, err := server.UnlockUser(ctx, connect.NewRequest(&sqlrpcv1.UnlockUserRequest{Username: "lockme"}))
+		require.NoError(t, err)
+		assert.True(t, res.Msg.Unlocked)
+
+		_, err = store.Authenticate(ctx, "lockme", "password123", "198.51.100.7")
+		var locked *auth.LockedOutError
+		assert.ErrorAs(t, err, &locked, "the client IP is still locked")
+	})
+
+	t.Run("with client ip", func(t *testing.T) {
+		res, err := server.UnlockUser(ctx, connect.NewRequest(&sqlrpcv1.UnlockUserRequest{Username: "lockme", ClientIp: "198.51.100.7"}))
+		require.NoError(t, err)
+		assert.True(t, res.Msg.Unlocked)
+
+		claims, err := store.Authenticate(ctx, "lockme", "password123", "198.51.100.7")
+		require.NoError(t, err)
+		assert.NotNil(t, claims)
+	})
+
+	t.Run("disabled without auth", func(t *testing.T) {
+		server.authDisabled = true
+		defer func() { server.authDisabled = false }()
+		_, err := server.UnlockUser(ctx, connect.NewRequest(&sqlrpcv1.UnlockUserRequest{Username: "lockme"}))
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	})
+}
+
+// ignoreLockout drops the lockout error triggered by a failed login.
+func ignoreLockout(err error) error {
+	var locked *auth.LockedOutError
+	if errors.As(err, &locked) {
+		return nil
+	}
+	return err
+}
+
 func TestAdminServer_Logout(t *testing.T) {
 	server, store, _ := setupAdminTestServer(t)
 	ctx := context.Background()
@@ -1125,6 +1212,202 @@ func TestAdminServer_UpdateDatabase(t *testing.T) {
 	})
 }
 
+func TestAdminServer_DatabaseGrants(t *testing.T) {
+	server, store, _ := setupAdminTestServer(t)
+	cache := &mockCacheInval{}
+	server.cache = cache
+	ctx := adminContext(sqlrpcv1.Role_ROLE_ADMIN)
+
+	userID, err := store.CreateUser(context.Background(), "grantee", "password123", sqlrpcv1.Role_ROLE_READ_WRITE)
+	require.NoError(t, err)
+	_, keyID, err := store.CreateApiKey(context.Background(), userID, "grantee-key", nil)
+	require.NoError(t, err)
+
+	var grantID int64
+	t.Run("grants to a user", func(t *testing.T) {
+		resp, err := server.GrantDatabaseAccess(ctx, connect.NewRequest(&sqlrpcv1.GrantDatabaseAccessRequest{
+			Grantee:  &sqlrpcv1.GrantDatabaseAccessRequest_Username{Username: "grantee"},
+			Database: "tenant_*",
+			Role:     sqlrpcv1.Role_ROLE_READ_ONLY,
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, "grantee", resp.Msg.Grant.Username)
+		assert.Equal(t, "tenant_*", resp.Msg.Grant.Database)
+		assert.True(t, cache.cleared)
+		grantID = resp.Msg.Grant.Id
+	})
+
+	t.Run("grants to an api key", func(t *testing.T) {
+		resp, err := server.GrantDatabaseAccess(ctx, connect.NewRequest(&sqlrpcv1.GrantDatabaseAccessRequest{
+			Grantee:  &sqlrpcv1.GrantDatabaseAccessRequest_ApiKeyId{ApiKeyId: keyID},
+			Database: "tenant_a",
+			Role:     sqlrpcv1.Role_ROLE_READ_WRITE,
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, keyID, resp.Msg.Grant.ApiKeyId)
+	})
+
+	t.Run("grant errors", func(t *testing.T) {
+		_, err := server.GrantDatabaseAccess(ctx, connect.NewRequest(&sqlrpcv1.GrantDatabaseAccessRequest{
+			Grantee:  &sqlrpcv1.GrantDatabaseAccessRequest_Username{Username: "ghost"},
+			Database: "tenant_a",
+			Role:     sqlrpcv1.Role_ROLE_READ_ONLY,
+		}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+		_, err = server.GrantDatabaseAccess(ctx, connect.NewRequest(&sqlrpcv1.GrantDatabaseAccessRequest{
+			Grantee:  &sqlrpcv1.GrantDatabaseAccessRequest_Username{Username: "grantee"},
+			Database: "tenant_a",
+			Role:     sqlrpcv1.Role_ROLE_ADMIN,
+		}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	t.Run("lists grants", func(t *testing.T) {
+		resp, err := server.ListDatabaseGrants(ctx, connect.NewRequest(&sqlrpcv1.ListDatabaseGrantsRequest{Username: "grantee"}))
+		require.NoError(t, err)
+		assert.Len(t, resp.Msg.Grants, 2)
+
+		resp, err = server.ListDatabaseGrants(ctx, connect.NewRequest(&sqlrpcv1.ListDatabaseGrantsRequest{Database: "tenant_a"}))
+		require.NoError(t, err)
+		assert.Len(t, resp.Msg.Grants, 1)
+	})
+
+	t.Run("list databases hides ungranted databases", func(t *testing.T) {
+		require.NoError(t, store.UpsertDatabaseConfig(context.Background(), "tenant_x", "x.db", false, ""))
+		require.NoError(t, store.UpsertDatabaseConfig(context.Background(), "private", "p.db", false, ""))
+
+		claims, err := store.ValidateUser(context.Background(), "grantee", "password123")
+		require.NoError(t, err)
+		resp, err := server.ListDatabases(auth.NewContext(context.Background(), claims), connect.NewRequest(&sqlrpcv1.ListDatabasesRequest{}))
+		require.NoError(t, err)
+		require.Len(t, resp.Msg.Databases, 1)
+		assert.Equal(t, "tenant_x", resp.Msg.Databases[0].Name)
+
+		resp, err = server.ListDatabases(ctx, connect.NewRequest(&sqlrpcv1.ListDatabasesRequest{}))
+		require.NoError(t, err)
+		assert.Len(t, resp.Msg.Databases, 2)
+	})
+
+	t.Run("revokes grants", func(t *testing.T) {
+		resp, err := server.RevokeDatabaseAccess(ctx, connect.NewRequest(&sqlrpcv1.RevokeDatabaseAccessRequest{GrantId: grantID}))
+		require.NoError(t, err)
+		assert.True(t, resp.Msg.Success)
+
+		_, err = server.RevokeDatabaseAccess(ctx, connect.NewRequest(&sqlrpcv1.RevokeDatabaseAccessRequest{GrantId: grantID}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+
+	t.Run("disabled in no-auth mode", func(t *testing.T) {
+		noAuth := NewAdminServer(store, nil, nil, true, "test")
+		_, err := noAuth.GrantDatabaseAccess(ctx, connect.NewRequest(&sqlrpcv1.GrantDatabaseAccessRequest{}))
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+		_, err = noAuth.RevokeDatabaseAccess(ctx, connect.NewRequest(&sqlrpcv1.RevokeDatabaseAccessRequest{}))
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+		_, err = noAuth.ListDatabaseGrants(ctx, connect.NewRequest(&sqlrpcv1.ListDatabaseGrantsRequest{}))
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	})
+}
+
+func TestAdminServer_RateLimits(t *testing.T) {
+	server, store, _ := setupAdminTestServer(t)
+	limiter := NewRateLimiter(store)
+	server.SetRateLimiter(limiter)
+	ctx := adminContext(sqlrpcv1.Role_ROLE_ADMIN)
+
+	userID, err := store.CreateUser(context.Background(), "limited", "password123", sqlrpcv1.Role_ROLE_READ_WRITE)
+	require.NoError(t, err)
+	_, keyID, err := store.CreateApiKey(context.Background(), userID, "limited-key", nil)
+	require.NoError(t, err)
+
+	var limitID int64
+	t.Run("sets a user limit", func(t *testing.T) {
+		resp, err := server.SetRateLimit(ctx, connect.NewRequest(&sqlrpcv1.SetRateLimitRequest{
+			Subject:           &sqlrpcv1.SetRateLimitRequest_Username{Username: "limited"},
+			Database:          "tenant_*",
+			RequestsPerSecond: 10,
+			MaxStreams:        2,
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, "limited", resp.Msg.Limit.Username)
+		assert.Equal(t, "tenant_*", resp.Msg.Limit.Database)
+		assert.Equal(t, int32(2), resp.Msg.Limit.MaxStreams)
+		limitID = resp.Msg.Limit.Id
+	})
+
+	t.Run("sets a key limit", func(t *testing.T) {
+		resp, err := server.SetRateLimit(ctx, connect.NewRequest(&sqlrpcv1.SetRateLimitRequest{
+			Subject:       &sqlrpcv1.SetRateLimitRequest_ApiKeyId{ApiKeyId: keyID},
+			RowsPerMinute: 1000,
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, keyID, resp.Msg.Limit.ApiKeyId)
+		assert.Equal(t, "*", resp.Msg.Limit.Database)
+	})
+
+	t.Run("sets a token subject limit", func(t *testing.T) {
+		resp, err := server.SetRateLimit(ctx, connect.NewRequest(&sqlrpcv1.SetRateLimitRequest{
+			Subject:           &sqlrpcv1.SetRateLimitRequest_Username{Username: "svc|reporting@idp"},
+			Issuer:            "https://idp.test",
+			RequestsPerSecond: 5,
+		}))
+		require.NoError(t, err, "token subjects are not local usernames")
+		assert.Equal(t, "svc|reporting@idp", resp.Msg.Limit.Username)
+		assert.Equal(t, "https://idp.test", resp.Msg.Limit.Issuer)
+	})
+
+	t.Run("set errors", func(t *testing.T) {
+		_, err := server.SetRateLimit(ctx, connect.NewRequest(&sqlrpcv1.SetRateLimitRequest{
+			Subject: &sqlrpcv1.SetRateLimitRequest_Username{Username: "ghost"},
+		}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+		_, err = server.SetRateLimit(ctx, connect.NewRequest(&sqlrpcv1.SetRateLimitRequest{
+			Subject:           &sqlrpcv1.SetRateLimitRequest_Username{Username: "limited"},
+			RequestsPerSecond: -1,
+		}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+		_, err = server.SetRateLimit(ctx, connect.NewRequest(&sqlrpcv1.SetRateLimitRequest{}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+		_, err = server.SetRateLimit(ctx, connect.NewRequest(&sqlrpcv1.SetRateLimitRequest{
+			Subject: &sqlrpcv1.SetRateLimitRequest_ApiKeyId{ApiKeyId: keyID},
+			Issuer:  "https://idp.test",
+		}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	t.Run("lists limits", func(t *testing.T) {
+		resp, err := server.ListRateLimits(ctx, connect.NewRequest(&sqlrpcv1.ListRateLimitsRequest{Username: "limited"}))
+		require.NoError(t, err)
+		assert.Len(t, resp.Msg.Limits, 2)
+
+		resp, err = server.ListRateLimits(ctx, connect.NewRequest(&sqlrpcv1.ListRateLimitsRequest{Database: "tenant_*"}))
+		require.NoError(t, err)
+		assert.Len(t, resp.Msg.Limits, 1)
+	})
+
+	t.Run("deletes limits", func(t *testing.T) {
+		resp, err := server.DeleteRateLimit(ctx, connect.NewRequest(&sqlrpcv1.DeleteRateLimitRequest{LimitId: limitID}))
+		require.NoError(t, err)
+		assert.True(t, resp.Msg.Success)
+
+		_, err = server.DeleteRateLimit(ctx, connect.NewRequest(&sqlrpcv1.DeleteRateLimitRequest{LimitId: limitID}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+
+	t.Run("disabled in no-auth mode", func(t *testing.T) {
+		noAuth := NewAdminServer(store, nil, nil, true, "test")
+		_, err := noAuth.SetRateLimit(ctx, connect.NewRequest(&sqlrpcv1.SetRateLimitRequest{}))
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+		_, err = noAuth.DeleteRateLimit(ctx, connect.NewRequest(&sqlrpcv1.DeleteRateLimitRequest{}))
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+		_, err = noAuth.ListRateLimits(ctx, connect.NewRequest(&sqlrpcv1.ListRateLimitsRequest{}))
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	})
+}
+
 func TestAdminServer_ListUsers(t *testing.T) {
 	server, store, _ := setupAdminTestServer(t)
 	adminCtx := adminContext(sqlrpcv1.Role_ROLE_ADMIN)
@@ -1285,3 +1568,55 @@ func TestAdminServer_TargetedCoverage(t *testing.T) {
 }
 
 func boolPtr(b bool) *bool { return &b }
+
+func TestAdminServer_ScopedAPIKeys(t *testing.T) {
+	server, store, _ := setupAdminTestServer(t)
+	ctx := adminContext(sqlrpcv1.Role_ROLE_ADMIN)
+
+	_, err := store.CreateUser(context.Background(), "ci", "password", sqlrpcv1.Role_ROLE_READ_WRITE)
+	require.NoError(t, err)
+
+	scope := &sqlrpcv1.APIKeyScope{
+		Databases: []string{"tenant_a"},
+		Channels:  []string{"deploys"},
+		MaxRole:   sqlrpcv1.Role_ROLE_READ_ONLY,
+		Families:  []sqlrpcv1.RpcFamily{sqlrpcv1.RpcFamily_RPC_FAMILY_SUBSCRIBE},
+	}
+
+	t.Run("creates and lists a scoped key", func(t *testing.T) {
+		resp, err := server.CreateAPIKey(ctx, connect.NewRequest(&sqlrpcv1.CreateAPIKeyRequest{
+			Username: "ci",
+			Name:     "ci-subscriber",
+			Scope:    scope,
+		}))
+		require.NoError(t, err)
+
+		list, err := server.ListAPIKeys(ctx, connect.NewRequest(&sqlrpcv1.ListAPIKeysRequest{Username: "ci"}))
+		require.NoError(t, err)
+		require.Len(t, list.Msg.Keys, 1)
+		assert.Equal(t, resp.Msg.KeyId, list.Msg.Keys[0].Id)
+		assert.True(t, proto.Equal(scope, list.Msg.Keys[0].Scope))
+	})
+
+	t.Run("rejects invalid scope", func(t *testing.T) {
+		_, err := server.CreateAPIKey(ctx, connect.NewRequest(&sqlrpcv1.CreateAPIKeyRequest{
+			Username: "ci",
+			Name:     "bad",
+			Scope:    &sqlrpcv1.APIKeyScope{Databases: []string{"["}},
+		}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	t.Run("scoped callers cannot mint keys", func(t *testing.T) {
+		scoped := auth.NewContext(context.Background(), &auth.UserClaims{
+			UserID:   1,
+			Username: "testadmin",
+			Role:     sqlrpcv1.Role_ROLE_ADMIN,
+			Scope:    &auth.ApiKeyScope{Families: []sqlrpcv1.RpcFamily{sqlrpcv1.RpcFamily_RPC_FAMILY_ADMIN}},
+		})
+		_, err := server.CreateAPIKey(scoped, connect.NewRequest(&sqlrpcv1.CreateAPIKeyRequest{Username: "ci", Name: "escalate"}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+}
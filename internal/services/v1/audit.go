@@ -0,0 +1,286 @@
+package servicesv1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"sqlite-server/internal/auth"
+	"sqlite-server/internal/logging"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+)
+
+// defaultAuditEventsLimit is the page size of ListAuditEvents without a limit.
+const defaultAuditEventsLimit = 100
+
+// redactedValue replaces secrets in the recorded request payloads.
+const redactedValue = "[REDACTED]"
+
+// auditedDatabaseRoutes lists the DatabaseService RPCs that change data or files
+// without going through raw SQL. Data-changing SQL is detected per statement.
+var auditedDatabaseRoutes = map[string]bool{
+	"/sqlrpc.v1.DatabaseService/Vacuum":          true,
+	"/sqlrpc.v1.DatabaseService/AttachDatabase":  true,
+	"/sqlrpc.v1.DatabaseService/DetachDatabase":  true,
+	"/sqlrpc.v1.DatabaseService/BackupDatabase":  true,
+	"/sqlrpc.v1.DatabaseService/RestoreDatabase": true,
+	"/sqlrpc.v1.DatabaseService/LoadExtension":   true,
+}
+
+// isAdminMutator reports whether an AdminService procedure changes state. Read-only
+// methods are named List* or Get*, so new mutators are audited without registration.
+func isAdminMutator(procedure string) bool {
+	method, ok := strings.CutPrefix(procedure, "/sqlrpc.v1.AdminService/")
+	return ok && !strings.HasPrefix(method, "List") && !strings.HasPrefix(method, "Get")
+}
+
+// Auditor records administrative and data-changing operations in the append-only
+// audit log of the metadata store. The AuthInterceptor reports operations to it once
+// they finish, so every event carries the authenticated caller and the outcome.
+type Auditor struct {
+	store        *auth.MetaStore
+	redactParams bool
+}
+
+// NewAuditor creates an auditor writing to store. With redactParams set, the bound
+// parameters of SQL statements are left out of the recorded events.
+func NewAuditor(store *auth.MetaStore, redactParams bool) *Auditor {
+	return &Auditor{store: store, redactParams: redactParams}
+}
+
+// record stores events with the outcome of the operation. A failure to record is
+// logged but does not fail the request: the operation has already happened.
+func (a *Auditor) record(ctx context.Context, events []auth.AuditEvent, err error) {
+	code, message := "ok", ""
+	if err != nil {
+		code, message = connect.CodeOf(err).String(), err.Error()
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			message = connectErr.Message()
+		}
+	}
+	ctx = context.WithoutCancel(ctx)
+	now := time.Now()
+	for i := range events {
+		event := &events[i]
+		event.CreatedAt, event.Code, event.Error = now, code, message
+		if recErr := a.store.RecordAuditEvent(ctx, event); recErr != nil {
+			authLog.ErrorContext(ctx, "Failed to record audit event", "event_rpc", event.RPC, logging.Err(recErr))
+		}
+	}
+}
+
+// newAuditEvent starts an event for an operation of user.
+func newAuditEvent(user *auth.UserClaims, procedure string, header http.Header, database string) auth.AuditEvent {
+	return auth.AuditEvent{
+		Actor:     user.Username,
+		APIKeyID:  user.KeyID,
+		RPC:       procedure,
+		Database:  database,
+		RequestID: header.Get(headerRequestID),
+	}
+}
+
+// statementEvents returns one event per data-changing SQL statement in msg.
+func (a *Auditor) statementEvents(base auth.AuditEvent, msg any) []auth.AuditEvent {
+	var events []auth.AuditEvent
+	for _, stmt := range messageStatements(msg) {
+		if isWrite, _ := analyzeSQL(stmt.GetSql()); !isWrite {
+			continue
+		}
+		event := base
+		event.SQL = stmt.GetSql()
+		if !a.redactParams {
+			event.Params = statementParams(stmt)
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+// auditUnary records a finished unary request for procedure if it is an administrative
+// mutation or changes data. isWrite is the verdict of the route's SQL analyzer.
+func (a *AuthInterceptor) auditUnary(ctx context.Context, user *auth.UserClaims, procedure string, header http.Header, msg any, isWrite bool, err error) {
+	base := newAuditEvent(user, procedure, header, a.auditDatabase(procedure, msg))
+
+	var events []auth.AuditEvent
+	switch {
+	case isWrite:
+		events = a.auditor.statementEvents(base, msg)
+	case isAdminMutator(procedure) || auditedDatabaseRoutes[procedure]:
+		if m, ok := msg.(proto.Message); ok {
+			base.Details = redactedJSON(m)
+		}
+		events = []auth.AuditEvent{base}
+	}
+	if len(events) > 0 {
+		a.auditor.record(ctx, events, err)
+	}
+}
+
+// auditDatabase returns the database an audited request targets, if any.
+func (a *AuthInterceptor) auditDatabase(procedure string, msg any) string {
+	if strings.HasPrefix(procedure, "/sqlrpc.v1.AdminService/") {
+		if m, ok := msg.(interface{ GetName() string }); ok && m.GetName() != "" {
+			return m.GetName()
+		}
+	}
+	if databases := a.messageDatabases(msg); len(databases) > 0 {
+		return databases[0]
+	}
+	return ""
+}
+
+// collectAudit queues the data-changing statements of a stream message. They are
+// recorded when the stream ends, with its outcome: a failed transaction stream rolls
+// back every statement it ran.
+func (w *authStreamWrapper) collectAudit(msg any) {
+	user, _ := auth.FromContext(w.ctx)
+	database := w.database
+	if databases := w.interceptor.messageDatabases(msg); len(databases) > 0 {
+		database = databases[0]
+	}
+	base := newAuditEvent(user, w.Spec().Procedure, w.RequestHeader(), database)
+	if m, ok := msg.(*sqlrpcv1.ImportRequest); ok {
+		// An import is one event, described by its 'start' message
+		if start := m.GetStart(); start != nil {
+			base.Details = redactedJSON(start)
+			w.audit = append(w.audit, base)
+		}
+		return
+	}
+	w.audit = append(w.audit, w.interceptor.auditor.statementEvents(base, msg)...)
+}
+
+// sqlMessage is a request message carrying one SQL statement.
+type sqlMessage interface {
+	proto.Message
+	GetSql() string
+}
+
+// messageStatements returns the SQL statements carried by a request message, including
+// the commands of transaction streams and batched transactions.
+func messageStatements(msg any) []sqlMessage {
+	switch m := msg.(type) {
+	case *sqlrpcv1.TransactionRequest:
+		r := m.ProtoReflect()
+		field := r.WhichOneof(r.Descriptor().Oneofs().ByName("command"))
+		if field == nil || field.Message() == nil {
+			return nil
+		}
+		if stmt, ok := r.Get(field).Message().Interface().(sqlMessage); ok && stmt.GetSql() != "" {
+			return []sqlMessage{stmt}
+		}
+	case *sqlrpcv1.ExecuteTransactionRequest:
+		var stmts []sqlMessage
+		for _, req := range m.Requests {
+			stmts = append(stmts, messageStatements(req)...)
+		}
+		return stmts
+	case sqlMessage:
+		if m.GetSql() != "" {
+			return []sqlMessage{m}
+		}
+	}
+	return nil
+}
+
+// statementParams returns the bound parameters of a statement as JSON.
+func statementParams(stmt sqlMessage) string {
+	r := stmt.ProtoReflect()
+	field := r.Descriptor().Fields().ByName("parameters")
+	if field == nil || !r.Has(field) {
+		return ""
+	}
+	b, err := protojson.Marshal(r.Get(field).Message().Interface())
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// redactedJSON returns msg as JSON with every password, token and secret replaced.
+func redactedJSON(msg proto.Message) string {
+	clone := proto.Clone(msg)
+	redactSecrets(clone.ProtoReflect())
+	b, err := protojson.Marshal(clone)
+	if err != nil || string(b) == "{}" {
+		return ""
+	}
+	return string(b)
+}
+
+func redactSecrets(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList() || fd.IsMap():
+		case fd.Kind() == protoreflect.StringKind && isSecretField(string(fd.Name())):
+			m.Set(fd, protoreflect.ValueOfString(redactedValue))
+		case fd.Message() != nil:
+			redactSecrets(v.Message())
+		}
+		return true
+	})
+}
+
+func isSecretField(name string) bool {
+	return strings.Contains(name, "password") || strings.Contains(name, "secret") || strings.Contains(name, "token")
+}
+
+// ListAuditEvents returns recorded administrative and data-changing operations.
+func (s *AdminServer) ListAuditEvents(ctx context.Context, req *connect.Request[sqlrpcv1.ListAuditEventsRequest]) (*connect.Response[sqlrpcv1.ListAuditEventsResponse], error) {
+	if err := protovalidate.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	filter := auth.AuditFilter{
+		Actor:    req.Msg.Actor,
+		Database: req.Msg.Database,
+		RPC:      req.Msg.Rpc,
+		Failed:   req.Msg.FailedOnly,
+		BeforeID: req.Msg.BeforeId,
+		Limit:    int(req.Msg.Limit),
+	}
+	if req.Msg.Since != nil {
+		filter.Since = req.Msg.Since.AsTime()
+	}
+	if req.Msg.Until != nil {
+		filter.Until = req.Msg.Until.AsTime()
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultAuditEventsLimit
+	}
+
+	events, err := s.store.ListAuditEvents(ctx, filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res := &sqlrpcv1.ListAuditEventsResponse{}
+	for _, e := range events {
+		res.Events = append(res.Events, &sqlrpcv1.AuditEvent{
+			Id:         e.ID,
+			Time:       timestamppb.New(e.CreatedAt),
+			Actor:      e.Actor,
+			ApiKeyId:   e.APIKeyID,
+			Rpc:        e.RPC,
+			Database:   e.Database,
+			Sql:        e.SQL,
+			Parameters: e.Params,
+			Details:    e.Details,
+			Code:       e.Code,
+			Error:      e.Error,
+			RequestId:  e.RequestID,
+		})
+	}
+	return connect.NewResponse(res), nil
+}
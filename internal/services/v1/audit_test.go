@@ -0,0 +1,217 @@
+package servicesv1
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"sqlite-server/internal/auth"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAuditLog(t *testing.T) {
+	store, _, apiKey := setupStore(t)
+	ctx := context.Background()
+	_, err := store.CreateUser(ctx, "root", "rootpass1", sqlrpcv1.Role_ROLE_ADMIN)
+	require.NoError(t, err)
+	adminKey := "Basic " + base64.StdEncoding.EncodeToString([]byte("root:rootpass1"))
+
+	interceptor := NewAuthInterceptor(store)
+	interceptor.SetAuditor(NewAuditor(store, false))
+	var handlerErr error
+	middleware := interceptor.WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return connect.NewResponse(&struct{}{}), handlerErr
+	})
+	call := func(authHeader, procedure string, msg connect.AnyRequest) error {
+		req := &mockRequest{AnyRequest: msg, spec: connect.Spec{Procedure: procedure}}
+		req.Header().Set("Authorization", authHeader)
+		req.Header().Set(headerRequestID, "req-1")
+		_, err := middleware(ctx, req)
+		return err
+	}
+	// latest returns the events recorded since the previous call, oldest first.
+	var lastID int64
+	latest := func(t *testing.T) []auth.AuditEvent {
+		events, err := store.ListAuditEvents(ctx, auth.AuditFilter{Limit: 100})
+		require.NoError(t, err)
+		var fresh []auth.AuditEvent
+		for i := len(events) - 1; i >= 0; i-- {
+			if events[i].ID > lastID {
+				fresh = append(fresh, events[i])
+			}
+		}
+		if len(fresh) > 0 {
+			lastID = fresh[len(fresh)-1].ID
+		}
+		return fresh
+	}
+
+	t.Run("data-changing statement", func(t *testing.T) {
+		params := &sqlrpcv1.Parameters{Positional: []*structpb.Value{structpb.NewNumberValue(7)}}
+		require.NoError(t, call("Bearer "+apiKey, "/sqlrpc.v1.DatabaseService/Exec",
+			connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "app", Sql: "DELETE FROM t WHERE id = ?", Parameters: params})))
+
+		events := latest(t)
+		require.Len(t, events, 1)
+		e := events[0]
+		assert.Equal(t, "testuser", e.Actor)
+		assert.NotEmpty(t, e.APIKeyID)
+		assert.Equal(t, "/sqlrpc.v1.DatabaseService/Exec", e.RPC)
+		assert.Equal(t, "app", e.Database)
+		assert.Equal(t, "DELETE FROM t WHERE id = ?", e.SQL)
+		assert.JSONEq(t, `{"positional":[7]}`, e.Params)
+		assert.Equal(t, "ok", e.Code)
+		assert.Equal(t, "req-1", e.RequestID)
+	})
+
+	t.Run("reads are not audited", func(t *testing.T) {
+		require.NoError(t, call("Bearer "+apiKey, "/sqlrpc.v1.DatabaseService/Query",
+			connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "app", Sql: "SELECT * FROM t"})))
+		require.NoError(t, call(adminKey, "/sqlrpc.v1.AdminService/ListUsers", connect.NewRequest(&sqlrpcv1.ListUsersRequest{})))
+		assert.Empty(t, latest(t))
+	})
+
+	t.Run("admin mutation redacts secrets", func(t *testing.T) {
+		require.NoError(t, call(adminKey, "/sqlrpc.v1.AdminService/CreateUser",
+			connect.NewRequest(&sqlrpcv1.CreateUserRequest{Username: "bob", Password: "hunter22", Role: sqlrpcv1.Role_ROLE_READ_ONLY})))
+
+		events := latest(t)
+		require.Len(t, events, 1)
+		assert.Equal(t, "root", events[0].Actor)
+		assert.Empty(t, events[0].APIKeyID)
+		assert.JSONEq(t, `{"username":"bob","role":"ROLE_READ_ONLY","password":"[REDACTED]"}`, events[0].Details)
+		assert.NotContains(t, events[0].Details, "hunter22")
+	})
+
+	t.Run("rejected operations", func(t *testing.T) {
+		err := call("Bearer "+apiKey, "/sqlrpc.v1.AdminService/UnMountDatabase", connect.NewRequest(&sqlrpcv1.UnMountDatabaseRequest{Name: "app"}))
+		require.Error(t, err)
+
+		events := latest(t)
+		require.Len(t, events, 1)
+		assert.Equal(t, "app", events[0].Database)
+		assert.Equal(t, connect.CodePermissionDenied.String(), events[0].Code)
+		assert.NotEmpty(t, events[0].Error)
+	})
+
+	t.Run("handler errors", func(t *testing.T) {
+		handlerErr = connect.NewError(connect.CodeNotFound, errors.New("database not found"))
+		defer func() { handlerErr = nil }()
+		require.Error(t, call(adminKey, "/sqlrpc.v1.AdminService/DeleteDatabase", connect.NewRequest(&sqlrpcv1.DeleteDatabaseRequest{Name: "gone"})))
+
+		events := latest(t)
+		require.Len(t, events, 1)
+		assert.Equal(t, "not_found", events[0].Code)
+		assert.Equal(t, "database not found", events[0].Error)
+	})
+
+	t.Run("batched transaction records each write", func(t *testing.T) {
+		require.NoError(t, call("Bearer "+apiKey, "/sqlrpc.v1.DatabaseService/ExecuteTransaction",
+			connect.NewRequest(&sqlrpcv1.ExecuteTransactionRequest{Requests: []*sqlrpcv1.TransactionRequest{
+				{Command: &sqlrpcv1.TransactionRequest_Begin{Begin: &sqlrpcv1.BeginRequest{Database: "app"}}},
+				{Command: &sqlrpcv1.TransactionRequest_Query{Query: &sqlrpcv1.TransactionalQueryRequest{Sql: "SELECT 1"}}},
+				{Command: &sqlrpcv1.TransactionRequest_Query{Query: &sqlrpcv1.TransactionalQueryRequest{Sql: "INSERT INTO t VALUES (1)"}}},
+				{Command: &sqlrpcv1.TransactionRequest_Exec{Exec: &sqlrpcv1.TransactionalQueryRequest{Sql: "UPDATE t SET v = 2"}}},
+			}})))
+
+		events := latest(t)
+		require.Len(t, events, 2)
+		assert.Equal(t, "INSERT INTO t VALUES (1)", events[0].SQL)
+		assert.Equal(t, "UPDATE t SET v = 2", events[1].SQL)
+		assert.Equal(t, "app", events[1].Database)
+	})
+
+	t.Run("transaction stream", func(t *testing.T) {
+		streamErr := errors.New("connection reset")
+		stream := interceptor.WrapStreamingHandler(func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+			for {
+				if err := conn.Receive(&sqlrpcv1.TransactionRequest{}); err != nil {
+					return streamErr
+				}
+			}
+		})
+		err := stream(ctx, &sequenceStreamingConn{
+			mockStreamingConn: mockStreamingConn{
+				header: http.Header{"Authorization": {"Bearer " + apiKey}},
+				spec:   connect.Spec{Procedure: "/sqlrpc.v1.DatabaseService/Transaction"},
+			},
+			msgs: []*sqlrpcv1.TransactionRequest{
+				{Command: &sqlrpcv1.TransactionRequest_Begin{Begin: &sqlrpcv1.BeginRequest{Database: "app"}}},
+				{Command: &sqlrpcv1.TransactionRequest_Exec{Exec: &sqlrpcv1.TransactionalQueryRequest{Sql: "DELETE FROM t"}}},
+			},
+		})
+		require.ErrorIs(t, err, streamErr)
+
+		events := latest(t)
+		require.Len(t, events, 1)
+		assert.Equal(t, "DELETE FROM t", events[0].SQL)
+		assert.Equal(t, "app", events[0].Database)
+		assert.Equal(t, "unknown", events[0].Code, "the stream outcome applies to its statements")
+	})
+
+	t.Run("redacted parameters", func(t *testing.T) {
+		interceptor.SetAuditor(NewAuditor(store, true))
+		defer interceptor.SetAuditor(NewAuditor(store, false))
+		params := &sqlrpcv1.Parameters{Positional: []*structpb.Value{structpb.NewStringValue("secret")}}
+		require.NoError(t, call("Bearer "+apiKey, "/sqlrpc.v1.DatabaseService/Exec",
+			connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "app", Sql: "INSERT INTO t VALUES (?)", Parameters: params})))
+
+		events := latest(t)
+		require.Len(t, events, 1)
+		assert.Equal(t, "INSERT INTO t VALUES (?)", events[0].SQL)
+		assert.Empty(t, events[0].Params)
+	})
+}
+
+func TestIsAdminMutator(t *testing.T) {
+	assert.True(t, isAdminMutator("/sqlrpc.v1.AdminService/CreateAPIKey"))
+	assert.True(t, isAdminMutator("/sqlrpc.v1.AdminService/SetLogLevel"))
+	assert.False(t, isAdminMutator("/sqlrpc.v1.AdminService/ListAuditEvents"))
+	assert.False(t, isAdminMutator("/sqlrpc.v1.AdminService/GetServerInfo"))
+	assert.False(t, isAdminMutator("/sqlrpc.v1.DatabaseService/Exec"))
+}
+
+func TestListAuditEvents(t *testing.T) {
+	admin, store, _ := setupAdminTestServer(t)
+	ctx := context.Background()
+	at := time.Date(2025, 1, 15, 3, 0, 0, 0, time.UTC)
+	for i, actor := range []string{"alice", "bob", "alice"} {
+		require.NoError(t, store.RecordAuditEvent(ctx, &auth.AuditEvent{
+			CreatedAt: at.Add(time.Duration(i) * time.Minute),
+			Actor:     actor,
+			RPC:       "/sqlrpc.v1.AdminService/CreateUser",
+			Code:      "ok",
+		}))
+	}
+
+	res, err := admin.ListAuditEvents(ctx, connect.NewRequest(&sqlrpcv1.ListAuditEventsRequest{Actor: "alice"}))
+	require.NoError(t, err)
+	require.Len(t, res.Msg.Events, 2)
+	assert.Equal(t, "alice", res.Msg.Events[0].Actor)
+	assert.True(t, res.Msg.Events[0].Time.AsTime().Equal(at.Add(2*time.Minute)), "newest first")
+
+	res, err = admin.ListAuditEvents(ctx, connect.NewRequest(&sqlrpcv1.ListAuditEventsRequest{
+		Rpc:   "CreateUser",
+		Since: timestamppb.New(at.Add(time.Minute)),
+		Limit: 1,
+	}))
+	require.NoError(t, err)
+	require.Len(t, res.Msg.Events, 1)
+	assert.Equal(t, "alice", res.Msg.Events[0].Actor)
+
+	res, err = admin.ListAuditEvents(ctx, connect.NewRequest(&sqlrpcv1.ListAuditEventsRequest{BeforeId: res.Msg.Events[0].Id}))
+	require.NoError(t, err)
+	assert.Len(t, res.Msg.Events, 2)
+
+	_, err = admin.ListAuditEvents(ctx, connect.NewRequest(&sqlrpcv1.ListAuditEventsRequest{Limit: 5000}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
@@ -12,16 +12,22 @@ package servicesv1
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
 	"errors"
-	"log"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
 	"os"
 	"strings"
 	"sync"
 	"time"
 
 	"sqlite-server/internal/auth"
+	"sqlite-server/internal/logging"
 	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+	"sqlite-server/internal/sqlclass"
 
 	"connectrpc.com/connect"
 )
@@ -40,6 +46,23 @@ type TargetUserExtractor func(req connect.AnyRequest) string
 // commands like ATTACH/DETACH/VACUUM (isRestricted).
 type PayloadAnalyzer func(req connect.AnyRequest) (isWrite bool, isRestricted bool)
 
+// DatabaseExtractor defines a function signature that parses an incoming protobuf request
+// to extract the tenant databases it targets. This is used for Database-Level Authorization
+// (per-database grants) on routes whose payload does not expose a `database` field.
+type DatabaseExtractor func(req connect.AnyRequest) []string
+
+// TransactionResolver maps an ID-based transaction session to the database it was opened on,
+// so that per-database grants can be enforced on calls that only carry a transaction ID.
+type TransactionResolver interface {
+	TransactionDatabase(id string) (string, bool)
+}
+
+// CursorResolver maps a cursor to the database it was opened on. A TransactionResolver
+// that also implements it resolves calls that only carry a cursor ID.
+type CursorResolver interface {
+	CursorDatabase(id string) (string, bool)
+}
+
 // RouteConfig holds the O(1) pre-computed routing rules and security policies for a specific RPC procedure.
 type RouteConfig struct {
 	// Public determines if the route bypasses all authentication (e.g., Login).
@@ -57,6 +80,10 @@ type RouteConfig struct {
 
 	// Analyzer is invoked on SQL-executing endpoints to dynamically inspect the query intent.
 	Analyzer PayloadAnalyzer
+
+	// Databases is invoked to find the target databases on routes that do not expose a
+	// `database` field. DatabaseService payloads are resolved automatically.
+	Databases DatabaseExtractor
 }
 
 // routes combines all routing logic, payload extraction, and RBAC tiers into a single
@@ -130,12 +157,16 @@ var routes = map[string]RouteConfig{
 	// ==========================================
 	// Level 30: Database Manager (Tenant Admin)
 	// Can manage databases, but cannot manage users.
+	// Per-database grants apply: a manager confined to "tenant_*" can only manage matching names.
 	// ==========================================
-	"/sqlrpc.v1.AdminService/CreateDatabase":  {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_DATABASE_MANAGER},
-	"/sqlrpc.v1.AdminService/DeleteDatabase":  {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_DATABASE_MANAGER},
-	"/sqlrpc.v1.AdminService/UpdateDatabase":  {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_DATABASE_MANAGER},
-	"/sqlrpc.v1.AdminService/MountDatabase":   {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_DATABASE_MANAGER},
-	"/sqlrpc.v1.AdminService/UnMountDatabase": {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_DATABASE_MANAGER},
+	"/sqlrpc.v1.AdminService/CreateDatabase":  {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_DATABASE_MANAGER, Databases: extractDatabaseName},
+	"/sqlrpc.v1.AdminService/DeleteDatabase":  {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_DATABASE_MANAGER, Databases: extractDatabaseName},
+	"/sqlrpc.v1.AdminService/UpdateDatabase":  {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_DATABASE_MANAGER, Databases: extractDatabaseName},
+	"/sqlrpc.v1.AdminService/MountDatabase":   {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_DATABASE_MANAGER, Databases: extractDatabaseName},
+	"/sqlrpc.v1.AdminService/UnMountDatabase": {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_DATABASE_MANAGER, Databases: extractDatabaseName},
+
+	// Scheduled maintenance history. Listing every database requires the global role.
+	"/sqlrpc.v1.AdminService/ListMaintenanceRuns": {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_DATABASE_MANAGER, Databases: extractDatabaseFilter},
 
 	// ==========================================
 	// Level 40: Platform Admin (User Management)
@@ -145,6 +176,40 @@ var routes = map[string]RouteConfig{
 	"/sqlrpc.v1.AdminService/CreateUser":     {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_ADMIN},
 	"/sqlrpc.v1.AdminService/UpdateUserRole": {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_ADMIN},
 	"/sqlrpc.v1.AdminService/DeleteUser":     {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_ADMIN},
+	"/sqlrpc.v1.AdminService/UnlockUser":     {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_ADMIN},
+
+	// Per-database access control
+	"/sqlrpc.v1.AdminService/GrantDatabaseAccess":  {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_ADMIN},
+	"/sqlrpc.v1.AdminService/RevokeDatabaseAccess": {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_ADMIN},
+	"/sqlrpc.v1.AdminService/ListDatabaseGrants":   {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_ADMIN},
+
+	// Rate limits
+	"/sqlrpc.v1.AdminService/SetRateLimit":    {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_ADMIN},
+	"/sqlrpc.v1.AdminService/DeleteRateLimit": {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_ADMIN},
+	"/sqlrpc.v1.AdminService/ListRateLimits":  {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_ADMIN},
+
+	// Row and column security
+	"/sqlrpc.v1.AdminService/CreateRowPolicy":    {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_ADMIN},
+	"/sqlrpc.v1.AdminService/DeleteRowPolicy":    {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_ADMIN},
+	"/sqlrpc.v1.AdminService/ListRowPolicies":    {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_ADMIN},
+	"/sqlrpc.v1.AdminService/SetColumnPolicy":    {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_ADMIN},
+	"/sqlrpc.v1.AdminService/DeleteColumnPolicy": {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_ADMIN},
+	"/sqlrpc.v1.AdminService/ListColumnPolicies": {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_ADMIN},
+
+	// Watching and interrupting other callers' statements and transactions
+	"/sqlrpc.v1.AdminService/CancelQuery":              {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_ADMIN},
+	"/sqlrpc.v1.AdminService/ListActiveOperations":     {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_ADMIN},
+	"/sqlrpc.v1.AdminService/ForceRollbackTransaction": {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_ADMIN},
+
+	// Replication streams carry the full content of every database
+	"/sqlrpc.v1.AdminService/StreamReplication": {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_ADMIN},
+
+	// Runtime log levels can expose request details at debug level
+	"/sqlrpc.v1.AdminService/GetLogLevels": {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_ADMIN},
+	"/sqlrpc.v1.AdminService/SetLogLevel":  {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_ADMIN},
+
+	// The audit log holds SQL text and actors across every database
+	"/sqlrpc.v1.AdminService/ListAuditEvents": {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_ADMIN},
 
 	// ==========================================
 	// Static Database Routes
@@ -155,6 +220,14 @@ var routes = map[string]RouteConfig{
 	"/sqlrpc.v1.DatabaseService/Vacuum":         {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_READ_WRITE},
 	"/sqlrpc.v1.DatabaseService/Checkpoint":     {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_READ_WRITE},
 
+	// Backups hold a full copy of the data; restoring replaces the database outright
+	"/sqlrpc.v1.DatabaseService/ListBackups":     {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_READ_ONLY},
+	"/sqlrpc.v1.DatabaseService/BackupDatabase":  {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_READ_WRITE},
+	"/sqlrpc.v1.DatabaseService/DownloadBackup":  {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_READ_WRITE},
+	"/sqlrpc.v1.DatabaseService/RestoreDatabase": {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_DATABASE_MANAGER},
+	// Dumps read the data through row and column security, like any query
+	"/sqlrpc.v1.DatabaseService/DumpDatabase": {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_READ_ONLY},
+
 	// ==========================================
 	// Dynamic Database SQL Payload Analyzers
 	// Routes that execute arbitrary SQL. They require deep inspection to determine
@@ -173,6 +246,11 @@ var routes = map[string]RouteConfig{
 	"/sqlrpc.v1.DatabaseService/TypedTransactionQueryStream": {Analyzer: analyzeTypedTransactionQueryRequest},
 	"/sqlrpc.v1.DatabaseService/TypedTransactionExec":        {Analyzer: analyzeTypedTransactionQueryRequest},
 	"/sqlrpc.v1.DatabaseService/ExecuteTransaction":          {Analyzer: analyzeExecuteTransactionRequest},
+	"/sqlrpc.v1.DatabaseService/BatchExec":                   {Analyzer: analyzeBatchExecRequest},
+	"/sqlrpc.v1.DatabaseService/TypedBatchExec":              {Analyzer: analyzeBatchExecRequest},
+
+	// Import only writes; it is checked per database on its 'start' message
+	"/sqlrpc.v1.DatabaseService/Import": {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_READ_WRITE},
 
 	// ==========================================
 	// Introspection & Extensions
@@ -193,36 +271,207 @@ var routes = map[string]RouteConfig{
 	"/sqlrpc.v1.DatabaseService/CommitTransaction":    {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_READ_ONLY},
 	"/sqlrpc.v1.DatabaseService/RollbackTransaction":  {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_READ_ONLY},
 	"/sqlrpc.v1.DatabaseService/TransactionSavepoint": {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_READ_ONLY},
+
+	// ==========================================
+	// Cursors (read-only queries)
+	// ==========================================
+	"/sqlrpc.v1.DatabaseService/OpenCursor":  {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_READ_ONLY},
+	"/sqlrpc.v1.DatabaseService/FetchCursor": {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_READ_ONLY},
+	"/sqlrpc.v1.DatabaseService/CloseCursor": {CheckRole: true, MinRole: sqlrpcv1.Role_ROLE_READ_ONLY},
+}
+
+// ---------------------------------------------------------
+// Database Target Extraction (Per-Database Grants)
+// ---------------------------------------------------------
+
+// extractDatabaseName reads the `name` field of the AdminService database lifecycle requests.
+func extractDatabaseName(req connect.AnyRequest) []string {
+	if m, ok := req.Any().(interface{ GetName() string }); ok && m.GetName() != "" {
+		return []string{m.GetName()}
+	}
+	return nil
+}
+
+// extractDatabaseFilter reads the optional `database` filter of AdminService list requests.
+func extractDatabaseFilter(req connect.AnyRequest) []string {
+	if m, ok := req.Any().(interface{ GetDatabase() string }); ok && m.GetDatabase() != "" {
+		return []string{m.GetDatabase()}
+	}
+	return nil
+}
+
+// messageDatabases resolves every tenant database a DatabaseService payload touches.
+// Transaction streams only carry the database on their 'begin' command, and ID-based
+// transaction and cursor calls are resolved through the registered TransactionResolver.
+func (a *AuthInterceptor) messageDatabases(msg any) []string {
+	return messageDatabases(msg, a.txResolver)
+}
+
+// messageDatabases resolves the databases of msg, looking ID-based transactions up in
+// resolver when it is set.
+func messageDatabases(msg any, resolver TransactionResolver) []string {
+	var name string
+	switch m := msg.(type) {
+	case *sqlrpcv1.AttachDatabaseRequest:
+		if target := m.GetAttachment().GetTargetDatabaseName(); target != "" {
+			return []string{m.ParentDatabase, target}
+		}
+		name = m.ParentDatabase
+	case *sqlrpcv1.DetachDatabaseRequest:
+		name = m.ParentDatabase
+	case *sqlrpcv1.TransactionRequest:
+		name = m.GetBegin().GetDatabase()
+	case *sqlrpcv1.ExecuteTransactionRequest:
+		if len(m.Requests) > 0 {
+			name = m.Requests[0].GetBegin().GetDatabase()
+		}
+	case *sqlrpcv1.ImportRequest:
+		name = m.GetStart().GetDatabase()
+	case interface{ GetDatabase() string }:
+		name = m.GetDatabase()
+	case interface{ GetTransactionId() string }:
+		if resolver != nil {
+			name, _ = resolver.TransactionDatabase(m.GetTransactionId())
+		}
+	case interface{ GetCursorId() string }:
+		if r, ok := resolver.(CursorResolver); ok {
+			name, _ = r.CursorDatabase(m.GetCursorId())
+		}
+	}
+	if name == "" {
+		return nil
+	}
+	return []string{name}
+}
+
+// requestDatabases resolves the target databases of a unary request. AdminService routes
+// are only database-scoped when they declare an explicit extractor.
+func (a *AuthInterceptor) requestDatabases(procedure string, spec RouteConfig, req connect.AnyRequest) []string {
+	if spec.Databases != nil {
+		return spec.Databases(req)
+	}
+	if strings.HasPrefix(procedure, "/sqlrpc.v1.AdminService/") {
+		return nil
+	}
+	return a.messageDatabases(req.Any())
+}
+
+// authorizeDatabases enforces minRole against the caller's effective role on each database.
+func authorizeDatabases(user *auth.UserClaims, databases []string, minRole sqlrpcv1.Role) error {
+	for _, name := range databases {
+		if user.RoleFor(name) < minRole {
+			return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("insufficient permissions for database %q", name))
+		}
+	}
+	return nil
+}
+
+// ---------------------------------------------------------
+// API Key Scopes (RPC Families & Pub/Sub Channels)
+// ---------------------------------------------------------
+
+// rpcFamily classifies a procedure into the family an API key scope can allow.
+func rpcFamily(procedure string) sqlrpcv1.RpcFamily {
+	switch procedure {
+	case "/sqlrpc.v1.DatabaseService/Publish", "/sqlrpc.v1.DatabaseService/PublishBatch":
+		return sqlrpcv1.RpcFamily_RPC_FAMILY_PUBLISH
+	case "/sqlrpc.v1.DatabaseService/Subscribe":
+		return sqlrpcv1.RpcFamily_RPC_FAMILY_SUBSCRIBE
+	}
+	if strings.HasPrefix(procedure, "/sqlrpc.v1.AdminService/") {
+		return sqlrpcv1.RpcFamily_RPC_FAMILY_ADMIN
+	}
+	return sqlrpcv1.RpcFamily_RPC_FAMILY_QUERY
+}
+
+// messageChannels lists the Pub/Sub channels a payload publishes or subscribes to.
+func messageChannels(msg any) []string {
+	switch m := msg.(type) {
+	case *sqlrpcv1.PublishRequest:
+		return []string{m.Channel}
+	case *sqlrpcv1.SubscribeRequest:
+		return []string{m.Channel}
+	case *sqlrpcv1.PublishBatchRequest:
+		channels := make([]string, len(m.Items))
+		for i, item := range m.Items {
+			channels[i] = item.Channel
+		}
+		return channels
+	}
+	return nil
+}
+
+// authorizeFamily rejects procedures outside the RPC families of a scoped API key.
+func authorizeFamily(user *auth.UserClaims, procedure string) error {
+	if family := rpcFamily(procedure); !user.AllowsFamily(family) {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("api key scope does not allow %s calls", strings.ToLower(strings.TrimPrefix(family.String(), "RPC_FAMILY_"))))
+	}
+	return nil
+}
+
+// authorizeChannels rejects Pub/Sub payloads targeting channels outside a scoped API key.
+func authorizeChannels(user *auth.UserClaims, msg any) error {
+	for _, channel := range messageChannels(msg) {
+		if !user.AllowsChannel(channel) {
+			return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("api key scope does not allow channel %q", channel))
+		}
+	}
+	return nil
+}
+
+// peerIP returns the host part of a connect peer address, which is what failed logins
+// are counted against. Forwarding headers are not trusted: they are set by the client.
+func peerIP(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
 }
 
 // ---------------------------------------------------------
 // SQL Payload Analyzers (Type-Safe Unpacking)
 // ---------------------------------------------------------
 
+// analyzeSQL classifies a SQL string once and reports (isWrite, isRestricted).
+func analyzeSQL(sql string) (bool, bool) {
+	info := sqlclass.Classify(sql)
+	return info.Write, info.Restricted
+}
+
 func analyzeQueryRequest(req connect.AnyRequest) (bool, bool) {
 	if msg, ok := req.Any().(*sqlrpcv1.QueryRequest); ok {
-		return IsWriteQuery(msg.Sql), IsRestrictedQuery(msg.Sql)
+		return analyzeSQL(msg.Sql)
 	}
 	return false, false
 }
 
 func analyzeTypedQueryRequest(req connect.AnyRequest) (bool, bool) {
 	if msg, ok := req.Any().(*sqlrpcv1.TypedQueryRequest); ok {
-		return IsWriteQuery(msg.Sql), IsRestrictedQuery(msg.Sql)
+		return analyzeSQL(msg.Sql)
 	}
 	return false, false
 }
 
 func analyzeTransactionQueryRequest(req connect.AnyRequest) (bool, bool) {
 	if msg, ok := req.Any().(*sqlrpcv1.TransactionQueryRequest); ok {
-		return IsWriteQuery(msg.Sql), IsRestrictedQuery(msg.Sql)
+		return analyzeSQL(msg.Sql)
 	}
 	return false, false
 }
 
 func analyzeTypedTransactionQueryRequest(req connect.AnyRequest) (bool, bool) {
 	if msg, ok := req.Any().(*sqlrpcv1.TypedTransactionQueryRequest); ok {
-		return IsWriteQuery(msg.Sql), IsRestrictedQuery(msg.Sql)
+		return analyzeSQL(msg.Sql)
+	}
+	return false, false
+}
+
+func analyzeBatchExecRequest(req connect.AnyRequest) (bool, bool) {
+	switch msg := req.Any().(type) {
+	case *sqlrpcv1.BatchExecRequest:
+		return analyzeSQL(msg.Sql)
+	case *sqlrpcv1.TypedBatchExecRequest:
+		return analyzeSQL(msg.Sql)
 	}
 	return false, false
 }
@@ -247,12 +496,9 @@ func analyzeExecuteTransactionRequest(req connect.AnyRequest) (bool, bool) {
 
 			// Empty string check avoids redundant and expensive function calls
 			if sql != "" {
-				if !isWrite && IsWriteQuery(sql) {
-					isWrite = true
-				}
-				if !isRestricted && IsRestrictedQuery(sql) {
-					isRestricted = true
-				}
+				w, r := analyzeSQL(sql)
+				isWrite = isWrite || w
+				isRestricted = isRestricted || r
 
 				// Early exit: Stop parsing the rest of the batch if we've already flagged maximum restrictions
 				if isWrite && isRestricted {
@@ -279,6 +525,16 @@ type AuthInterceptor struct {
 	// authCache maps AuthHeader string -> *authCacheItem.
 	// Uses sync.Map to handle highly concurrent read traffic efficiently.
 	authCache sync.Map
+	// txResolver resolves ID-based transactions to their database. Optional.
+	txResolver TransactionResolver
+	// auditor records administrative and data-changing operations. Optional.
+	auditor *Auditor
+	// jwt verifies JWT bearer tokens from an external identity provider. Optional.
+	jwt *auth.JWTVerifier
+	// certIdentity is the client certificate field that names the user (CertIdentity*).
+	certIdentity string
+	// peers maps the UIDs of Unix socket peers to identities. Nil ignores peer credentials.
+	peers map[uint32]PeerIdentity
 }
 
 // authCacheItem wraps the parsed identity and the Unix timestamp of when it was fetched.
@@ -287,6 +543,12 @@ type authCacheItem struct {
 	timestamp int64
 }
 
+// fresh reports whether the cached identity may still be used at now (Unix seconds):
+// within the 60s TTL and before the credential itself expires.
+func (i *authCacheItem) fresh(now int64) bool {
+	return now-i.timestamp < 60 && (i.user.ExpiresAt.IsZero() || now < i.user.ExpiresAt.Unix())
+}
+
 // NewAuthInterceptor initializes the interceptor and starts the background cache sweeper.
 func NewAuthInterceptor(store *auth.MetaStore) *AuthInterceptor {
 	ai := &AuthInterceptor{store: store}
@@ -315,41 +577,230 @@ func (a *AuthInterceptor) startCacheSweeper() {
 	}
 }
 
+// SetTransactionResolver registers the lookup used to enforce per-database grants on
+// ID-based transaction calls. Must be called before the server starts accepting requests.
+func (a *AuthInterceptor) SetTransactionResolver(resolver TransactionResolver) {
+	a.txResolver = resolver
+}
+
+// SetAuditor registers the audit log that administrative mutations and data-changing
+// statements are recorded in. Must be called before the server starts accepting requests.
+func (a *AuthInterceptor) SetAuditor(auditor *Auditor) {
+	a.auditor = auditor
+}
+
+// SetJWTVerifier enables JWT bearer tokens checked by verifier next to API keys. Must be
+// called before the server starts accepting requests.
+func (a *AuthInterceptor) SetJWTVerifier(verifier *auth.JWTVerifier) {
+	a.jwt = verifier
+}
+
+// SetClientCertIdentity selects the client certificate field (one of the CertIdentity
+// constants) that names the user of a verified TLS client certificate. The default is
+// the subject common name.
+func (a *AuthInterceptor) SetClientCertIdentity(field string) {
+	a.certIdentity = field
+}
+
+// SetPeerIdentities lets Unix socket clients without an Authorization header log in as
+// the identity their UID maps to.
+func (a *AuthInterceptor) SetPeerIdentities(peers map[uint32]PeerIdentity) {
+	a.peers = peers
+}
+
+// connCredentials returns the auth cache key of the credentials carried by the
+// connection itself (a verified TLS client certificate or mapped Unix peer credentials),
+// or nil if there are none.
+func (a *AuthInterceptor) connCredentials(ctx context.Context) any {
+	if cert := clientCertificate(ctx); cert != nil {
+		return certCacheKey(sha256.Sum256(cert.Raw))
+	}
+	if cred, ok := peerCredentials(ctx); ok && a.peers != nil {
+		return peerCacheKey(cred.UID)
+	}
+	return nil
+}
+
+// connClaims resolves the credentials found by connCredentials.
+func (a *AuthInterceptor) connClaims(ctx context.Context) (*auth.UserClaims, error) {
+	if cert := clientCertificate(ctx); cert != nil {
+		return a.certClaims(ctx, cert)
+	}
+	cred, _ := peerCredentials(ctx)
+	return a.peerClaims(ctx, cred)
+}
+
+// bearerClaims resolves a Bearer token. Tokens shaped like a JWT go to the verifier when
+// one is configured; everything else is looked up as an API key.
+func (a *AuthInterceptor) bearerClaims(ctx context.Context, token string) (*auth.UserClaims, error) {
+	if a.jwt == nil || !auth.LooksLikeJWT(token) {
+		return a.store.ValidateApiKeyImpl(ctx, token)
+	}
+	user, err := a.jwt.Verify(ctx, token)
+	if err != nil {
+		authLog.Debug("JWT rejected", logging.Err(err))
+	}
+	return user, err
+}
+
 // ClearCache immediately drops all cached entries. Called automatically by the system
 // when platform secrets, users, or API keys are modified to ensure immediate revocation.
 func (a *AuthInterceptor) ClearCache() {
 	a.authCache.Clear() // Go 1.21+ fast-clear (zero allocations)
-	log.Println("[AUTH] Cache invalidated")
+	authLog.Debug("Cache invalidated")
 }
 
-// LoggingInterceptor creates a connect.UnaryInterceptorFunc that logs request details.
-// It can be disabled in production for max throughput by setting SQLITE_SERVER_DISABLE_REQUEST_LOGGING=true.
-func LoggingInterceptor() connect.UnaryInterceptorFunc {
-	loggingEnabled := os.Getenv("SQLITE_SERVER_DISABLE_REQUEST_LOGGING") != "true"
-	return func(next connect.UnaryFunc) connect.UnaryFunc {
-		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			if !loggingEnabled {
-				return next(ctx, req)
-			}
+// LoggingInterceptor opens the log scope of every request and logs one line when it
+// finishes. Records logged with the handler context carry the request ID, RPC, database
+// and transaction ID, plus the user once authenticated. A missing X-Request-Id is
+// generated here so the handlers and the log agree on it.
+//
+// The completion lines are written by the "rpc" subsystem at info level. They can be
+// disabled in production for max throughput by setting SQLITE_SERVER_DISABLE_REQUEST_LOGGING=true.
+func LoggingInterceptor() connect.Interceptor {
+	return &loggingInterceptor{enabled: os.Getenv("SQLITE_SERVER_DISABLE_REQUEST_LOGGING") != "true"}
+}
 
-			start := time.Now()
-			resp, err := next(ctx, req)
+type loggingInterceptor struct {
+	enabled bool
+}
 
-			status := "OK"
-			if err != nil {
-				status = "ERROR"
-			}
-			log.Printf("[%s] %s (%v)", status, req.Spec().Procedure, time.Since(start))
+func (i *loggingInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		ctx = newRequestScope(ctx, req.Spec().Procedure, req.Header())
+		addMessageAttrs(ctx, req.Any())
+
+		start := time.Now()
+		resp, err := next(ctx, req)
+		i.logCompletion(ctx, start, err)
+		return resp, err
+	}
+}
 
-			return resp, err
+func (i *loggingInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *loggingInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx = newRequestScope(ctx, conn.Spec().Procedure, conn.RequestHeader())
+
+		start := time.Now()
+		err := next(ctx, &loggingStreamWrapper{StreamingHandlerConn: conn, ctx: ctx})
+		i.logCompletion(ctx, start, err)
+		return err
+	}
+}
+
+// loggingStreamWrapper adds the database of the first request message to the scope.
+type loggingStreamWrapper struct {
+	connect.StreamingHandlerConn
+	ctx      context.Context
+	received bool
+}
+
+func (w *loggingStreamWrapper) Receive(msg any) error {
+	if err := w.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	if !w.received {
+		w.received = true
+		addMessageAttrs(w.ctx, msg)
+	}
+	return nil
+}
+
+// newRequestScope opens the log scope of a request, generating its request ID if the
+// client did not send one.
+func newRequestScope(ctx context.Context, procedure string, header http.Header) context.Context {
+	reqID := header.Get(headerRequestID)
+	if reqID == "" {
+		reqID = genRequestID()
+		header.Set(headerRequestID, reqID)
+	}
+	return logging.NewContext(ctx, logging.KeyRequestID, reqID, logging.KeyRPC, procedure)
+}
+
+// addMessageAttrs adds the database and transaction ID named by a request message.
+func addMessageAttrs(ctx context.Context, msg any) {
+	if name := requestDatabaseName(msg); name != "" {
+		logging.AddAttrs(ctx, logging.KeyDatabase, name)
+	}
+	if m, ok := msg.(interface{ GetTransactionId() string }); ok && m.GetTransactionId() != "" {
+		logging.AddAttrs(ctx, logging.KeyTxID, m.GetTransactionId())
+	}
+}
+
+func (i *loggingInterceptor) logCompletion(ctx context.Context, start time.Time, err error) {
+	if !i.enabled {
+		return
+	}
+	if err != nil {
+		rpcLog.WarnContext(ctx, "Request failed", slog.Duration(logging.KeyDuration, time.Since(start)),
+			"code", connect.CodeOf(err).String(), logging.Err(err))
+		return
+	}
+	rpcLog.InfoContext(ctx, "Request handled", slog.Duration(logging.KeyDuration, time.Since(start)), "code", "ok")
+}
+
+// identify resolves the caller of a request from its Authorization header, falling back
+// to the credentials of the connection. Successful lookups are cached for 60 seconds.
+func (authInterceptor *AuthInterceptor) identify(ctx context.Context, header http.Header, peerAddr string) (user *auth.UserClaims, err error) {
+	// An explicit Authorization header wins over the credentials of the connection
+	authHeader := header.Get("Authorization")
+	var cacheKey any = authHeader
+	if authHeader == "" {
+		if cacheKey = authInterceptor.connCredentials(ctx); cacheKey == nil {
+			return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing authorization header"))
+		}
+	}
+
+	now := time.Now().Unix() // Fetch syscall time exactly ONCE per request
+
+	// Fast O(1) Lock-Free Cache Read
+	// Note: We deliberately DO NOT call authCache.Delete() if the token is expired.
+	// Doing so creates a mutex write-lock bottleneck (Thundering Herd).
+	// Instead, we let it fall through, fetch from DB, and seamlessly Store/Overwrite it.
+	if val, ok := authInterceptor.authCache.Load(cacheKey); ok {
+		item := val.(*authCacheItem)
+		if item.fresh(now) { // 60s Time-To-Live
+			return item.user, nil
+		}
+	}
+
+	// Cache Miss - Parse Header & Query Database
+	// Zero-allocation token extraction (Go 1.20+ CutPrefix avoids string allocation)
+	if authHeader == "" {
+		user, err = authInterceptor.connClaims(ctx)
+	} else if token, ok := strings.CutPrefix(authHeader, "Bearer "); ok {
+		user, err = authInterceptor.bearerClaims(ctx, token)
+
+	} else if b64payload, ok := strings.CutPrefix(authHeader, "Basic "); ok {
+		if payload, decodeErr := base64.StdEncoding.DecodeString(b64payload); decodeErr == nil {
+			// bytes.Cut avoids allocating an array of strings on the heap like SplitN does
+			if username, password, found := bytes.Cut(payload, []byte(":")); found {
+				user, err = authInterceptor.store.Authenticate(ctx, string(username), string(password), peerIP(peerAddr))
+			}
 		}
 	}
+
+	// Handle Auth Failure
+	if err != nil || user == nil {
+		return nil, loginError(err)
+	}
+
+	// Store successful validation in cache
+	authInterceptor.authCache.Store(cacheKey, &authCacheItem{user: user, timestamp: now})
+	return user, nil
 }
 
 // WrapUnary enforces Authentication and Authorization on all unary RPC calls.
 // This is the absolute hottest path in the codebase. Every single request flows through here.
 func (authInterceptor *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
-	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+	return func(ctx context.Context, req connect.AnyRequest) (_ connect.AnyResponse, err error) {
 		procedure := req.Spec().Procedure
 		spec, exists := routes[procedure]
 
@@ -363,117 +814,169 @@ func (authInterceptor *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connec
 		// ==========================================
 		// Step 2: Resolve Identity (Authentication)
 		// ==========================================
-		authHeader := req.Header().Get("Authorization")
-		if authHeader == "" {
-			return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing authorization header"))
+		user, err := authInterceptor.identify(ctx, req.Header(), req.Peer().Addr)
+		if err != nil {
+			return nil, err
 		}
 
-		var user *auth.UserClaims
-		var err error
-		now := time.Now().Unix() // Fetch syscall time exactly ONCE per request
+		// Hydrate context with authenticated identity
+		ctx = auth.NewContext(ctx, user)
+		logging.AddAttrs(ctx, logging.KeyUser, user.Username)
 
-		// Fast O(1) Lock-Free Cache Read
-		// Note: We deliberately DO NOT call authCache.Delete() if the token is expired.
-		// Doing so creates a mutex write-lock bottleneck (Thundering Herd).
-		// Instead, we let it fall through, fetch from DB, and seamlessly Store/Overwrite it.
-		if val, ok := authInterceptor.authCache.Load(authHeader); ok {
-			item := val.(*authCacheItem)
-			if now-item.timestamp < 60 { // 60s Time-To-Live
-				user = item.user
-			}
+		// The SQL intent is resolved up front so that rejected writes reach the audit log too
+		var isWrite, isRestrictedSQL bool
+		if exists && spec.Analyzer != nil {
+			isWrite, isRestrictedSQL = spec.Analyzer(req)
+		}
+		if authInterceptor.auditor != nil {
+			defer func() { authInterceptor.auditUnary(ctx, user, procedure, req.Header(), req.Any(), isWrite, err) }()
 		}
 
-		// Cache Miss - Parse Header & Query Database
-		if user == nil {
-			// Zero-allocation token extraction (Go 1.20+ CutPrefix avoids string allocation)
-			if token, ok := strings.CutPrefix(authHeader, "Bearer "); ok {
-				user, err = authInterceptor.store.ValidateApiKeyImpl(ctx, token)
+		// ==========================================
+		// Step 3: Enforce Authorization (AuthZ)
+		// ==========================================
+		if err := authInterceptor.authorizeRequest(ctx, user, procedure, req, isWrite, isRestrictedSQL); err != nil {
+			return nil, err
+		}
 
-			} else if b64payload, ok := strings.CutPrefix(authHeader, "Basic "); ok {
-				if payload, decodeErr := base64.StdEncoding.DecodeString(b64payload); decodeErr == nil {
-					// bytes.Cut avoids allocating an array of strings on the heap like SplitN does
-					if username, password, found := bytes.Cut(payload, []byte(":")); found {
-						user, err = authInterceptor.store.ValidateUser(ctx, string(username), string(password))
-					}
-				}
-			}
+		// Proceed to actual Service Handler
+		return next(ctx, req)
+	}
+}
 
-			// Handle Auth Failure
-			if err != nil || user == nil {
-				return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid credentials"))
-			}
+// HTTPAuthorizer authorizes plain HTTP endpoints, such as the export downloads and the
+// REST gateway, with the rules of the RPC they stand in for. Both AuthInterceptor and
+// NoAuthInterceptor implement it.
+//
+// On success AuthorizeHTTP returns the request context carrying the caller's identity
+// and a function the endpoint calls with its outcome once it is done, which records
+// the request in the audit log like WrapUnary does.
+type HTTPAuthorizer interface {
+	AuthorizeHTTP(r *http.Request, procedure string, req connect.AnyRequest) (context.Context, func(error), error)
+}
 
-			// Store successful validation in cache
-			authInterceptor.authCache.Store(authHeader, &authCacheItem{user: user, timestamp: now})
+// AuthorizeHTTP applies the checks WrapUnary runs for procedure to a plain HTTP request
+// standing in for req. Rejected writes are audited right away.
+func (authInterceptor *AuthInterceptor) AuthorizeHTTP(r *http.Request, procedure string, req connect.AnyRequest) (context.Context, func(error), error) {
+	user, err := authInterceptor.identify(r.Context(), r.Header, r.RemoteAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx := auth.NewContext(r.Context(), user)
+
+	var isWrite, isRestrictedSQL bool
+	if spec, ok := routes[procedure]; ok && spec.Analyzer != nil {
+		isWrite, isRestrictedSQL = spec.Analyzer(req)
+	}
+	finish := func(err error) {
+		if authInterceptor.auditor != nil {
+			authInterceptor.auditUnary(ctx, user, procedure, r.Header, req.Any(), isWrite, err)
 		}
+	}
+	if err := authInterceptor.authorizeRequest(ctx, user, procedure, req, isWrite, isRestrictedSQL); err != nil {
+		finish(err)
+		return nil, nil, err
+	}
+	return ctx, finish, nil
+}
 
-		// Hydrate context with authenticated identity
-		ctx = auth.NewContext(ctx, user)
+// authorizeRequest enforces the route configuration of procedure on an authenticated
+// unary request: API key scopes, ownership, roles and per-database grants, and the
+// write escalation of its SQL.
+func (authInterceptor *AuthInterceptor) authorizeRequest(ctx context.Context, user *auth.UserClaims, procedure string, req connect.AnyRequest, isWrite, isRestrictedSQL bool) error {
+	spec, exists := routes[procedure]
 
-		// ==========================================
-		// Step 3: Enforce Authorization (AuthZ)
-		// ==========================================
-		if exists {
-			// A: Target User Ownership Check (Resource-Level Precedence)
-			// Runs BEFORE strict RBAC to ensure a user attempting to reset someone else's
-			// password gets a proper ownership error, not a generic "admin required" error.
-			if spec.Extractor != nil {
-				if targetUsername := spec.Extractor(req); targetUsername != "" {
-					if err := AuthorizeUser(ctx, targetUsername); err != nil {
-						return nil, err
-					}
+	// Scoped API keys are confined to their RPC families and Pub/Sub channels first
+	if err := authorizeFamily(user, procedure); err != nil {
+		return err
+	}
+	if err := authorizeChannels(user, req.Any()); err != nil {
+		return err
+	}
+
+	databases := authInterceptor.requestDatabases(procedure, spec, req)
+
+	if exists {
+		// A: Target User Ownership Check (Resource-Level Precedence)
+		// Runs BEFORE strict RBAC to ensure a user attempting to reset someone else's
+		// password gets a proper ownership error, not a generic "admin required" error.
+		if spec.Extractor != nil {
+			if targetUsername := spec.Extractor(req); targetUsername != "" {
+				if err := AuthorizeUser(ctx, targetUsername); err != nil {
+					return err
 				}
 			}
+		}
 
-			// B: Role-based Access Control (RBAC)
-			// Fast integer comparison against the Enum Tier Hierarchy
-			if spec.CheckRole && user.Role < spec.MinRole {
-				return nil, connect.NewError(connect.CodePermissionDenied, errors.New("insufficient permissions for this action"))
+		// B: Role-based Access Control (RBAC)
+		// Database-scoped requests are checked against the effective role on every target
+		// database (per-database grants). Everything else uses the global Enum Tier Hierarchy.
+		if len(databases) > 0 {
+			minRole := sqlrpcv1.Role_ROLE_READ_ONLY
+			if spec.CheckRole {
+				minRole = max(minRole, spec.MinRole)
 			}
+			if err := authorizeDatabases(user, databases, minRole); err != nil {
+				return err
+			}
+		} else if spec.CheckRole && user.Role < spec.MinRole {
+			return connect.NewError(connect.CodePermissionDenied, errors.New("insufficient permissions for this action"))
+		}
 
-			// C: Dynamic Payload SQL Analysis (For Database execution endpoints)
-			if spec.Analyzer != nil {
-				isWrite, isRestrictedSQL := spec.Analyzer(req)
+		// C: Dynamic Payload SQL Analysis (For Database execution endpoints)
+		if spec.Analyzer != nil {
+			// Block dangerous raw string commands for security
+			if isRestrictedSQL {
+				return connect.NewError(connect.CodePermissionDenied, errors.New("ATTACH, DETACH, and VACUUM commands are not allowed in raw SQL for security reasons. Please use the dedicated RPC APIs."))
+			}
 
-				// Block dangerous raw string commands for security
-				if isRestrictedSQL {
-					return nil, connect.NewError(connect.CodePermissionDenied, errors.New("ATTACH, DETACH, and VACUUM commands are not allowed in raw SQL for security reasons. Please use the dedicated RPC APIs."))
+			// Escalation to Write-Role validation
+			if isWrite {
+				if len(databases) == 0 {
+					databases = []string{""}
 				}
-
-				// Escalation to Write-Role validation
-				if isWrite {
-					if err := AuthorizeWrite(ctx); err != nil {
-						return nil, err
+				for _, name := range databases {
+					if err := AuthorizeWrite(ctx, name); err != nil {
+						return err
 					}
 				}
 			}
+		}
+	} else {
+		// FALLBACK SECURE DEFAULT:
+		// If a route NOT explicitly listed here is called:
+		// 1. If it's an AdminService route, strictly require ROLE_ADMIN.
+		// 2. For any other route, strictly require at least ROLE_READ_ONLY.
+		if strings.HasPrefix(procedure, "/sqlrpc.v1.AdminService/") {
+			if user.Role < sqlrpcv1.Role_ROLE_ADMIN {
+				return connect.NewError(connect.CodePermissionDenied, errors.New("admin access required"))
+			}
+		} else if len(databases) > 0 {
+			if err := authorizeDatabases(user, databases, sqlrpcv1.Role_ROLE_READ_ONLY); err != nil {
+				return err
+			}
 		} else {
-			// FALLBACK SECURE DEFAULT:
-			// If a route NOT explicitly listed here is called:
-			// 1. If it's an AdminService route, strictly require ROLE_ADMIN.
-			// 2. For any other route, strictly require at least ROLE_READ_ONLY.
-			if strings.HasPrefix(procedure, "/sqlrpc.v1.AdminService/") {
-				if user.Role < sqlrpcv1.Role_ROLE_ADMIN {
-					return nil, connect.NewError(connect.CodePermissionDenied, errors.New("admin access required"))
-				}
-			} else {
-				if user.Role < sqlrpcv1.Role_ROLE_READ_ONLY {
-					return nil, connect.NewError(connect.CodePermissionDenied, errors.New("read-only access required at minimum"))
-				}
+			if user.Role < sqlrpcv1.Role_ROLE_READ_ONLY {
+				return connect.NewError(connect.CodePermissionDenied, errors.New("read-only access required at minimum"))
 			}
 		}
-
-		// Proceed to actual Service Handler
-		return next(ctx, req)
 	}
+	return nil
 }
 
 // authStreamWrapper intercepts stream messages to enforce authorization dynamically mid-stream.
 // Because streams keep a persistent connection open, a user could send a valid READ query initially,
 // and then maliciously send a WRITE query inside the stream. This wrapper analyzes every incoming message.
+//
+// Stream payloads are only visible here, so per-database grants are enforced per message as
+// well. The database named by a transaction's 'begin' command is remembered for later writes.
 type authStreamWrapper struct {
 	connect.StreamingHandlerConn
-	ctx context.Context
+	ctx         context.Context
+	interceptor *AuthInterceptor
+	minRole     sqlrpcv1.Role
+	database    string
+	audit       []auth.AuditEvent // Data-changing statements, recorded when the stream ends
 }
 
 // Receive is called every time the client pushes a message over the open stream.
@@ -481,6 +984,23 @@ func (w *authStreamWrapper) Receive(msg any) error {
 	if err := w.StreamingHandlerConn.Receive(msg); err != nil {
 		return err
 	}
+	if w.interceptor.auditor != nil {
+		w.collectAudit(msg)
+	}
+
+	user, _ := auth.FromContext(w.ctx)
+	if databases := w.interceptor.messageDatabases(msg); len(databases) > 0 {
+		if err := authorizeDatabases(user, databases, w.minRole); err != nil {
+			return err
+		}
+		w.database = databases[0]
+	} else if w.database == "" && user.Role < w.minRole {
+		// Not tied to a database (yet): the global role applies
+		return connect.NewError(connect.CodePermissionDenied, errors.New("insufficient permissions for this action"))
+	}
+	if err := authorizeChannels(user, msg); err != nil {
+		return err
+	}
 
 	isWrite := false
 	isRestrictedSQL := false
@@ -500,8 +1020,7 @@ func (w *authStreamWrapper) Receive(msg any) error {
 			sql = cmd.TypedQueryStream.Sql
 		}
 		if sql != "" {
-			isWrite = IsWriteQuery(sql)
-			isRestrictedSQL = IsRestrictedQuery(sql)
+			isWrite, isRestrictedSQL = analyzeSQL(sql)
 		}
 	}
 
@@ -509,7 +1028,7 @@ func (w *authStreamWrapper) Receive(msg any) error {
 		return connect.NewError(connect.CodePermissionDenied, errors.New("ATTACH, DETACH, and VACUUM commands are not allowed in raw SQL for security reasons. Please use the dedicated RPC APIs."))
 	}
 	if isWrite {
-		if err := AuthorizeWrite(w.ctx); err != nil {
+		if err := AuthorizeWrite(w.ctx, w.database); err != nil {
 			return err
 		}
 	}
@@ -529,63 +1048,42 @@ func (authInterceptor *AuthInterceptor) WrapStreamingHandler(next connect.Stream
 		}
 
 		// 2. Resolve Identity
-		authHeader := conn.RequestHeader().Get("Authorization")
-		if authHeader == "" {
-			return connect.NewError(connect.CodeUnauthenticated, errors.New("missing authorization header"))
-		}
-
-		var user *auth.UserClaims
-		var err error
-		now := time.Now().Unix()
-
-		// Fast Cache Read
-		if val, ok := authInterceptor.authCache.Load(authHeader); ok {
-			item := val.(*authCacheItem)
-			if now-item.timestamp < 60 {
-				user = item.user
-			}
-		}
-
-		// Cache Miss Parsing
-		if user == nil {
-			if token, ok := strings.CutPrefix(authHeader, "Bearer "); ok {
-				user, err = authInterceptor.store.ValidateApiKeyImpl(ctx, token)
-			} else if b64payload, ok := strings.CutPrefix(authHeader, "Basic "); ok {
-				if payload, decodeErr := base64.StdEncoding.DecodeString(b64payload); decodeErr == nil {
-					if username, password, found := bytes.Cut(payload, []byte(":")); found {
-						user, err = authInterceptor.store.ValidateUser(ctx, string(username), string(password))
-					}
-				}
-			}
-			if err != nil || user == nil {
-				return connect.NewError(connect.CodeUnauthenticated, errors.New("invalid credentials"))
-			}
-			authInterceptor.authCache.Store(authHeader, &authCacheItem{user: user, timestamp: now})
+		user, err := authInterceptor.identify(ctx, conn.RequestHeader(), conn.Peer().Addr)
+		if err != nil {
+			return err
 		}
 
 		// Hydrate context
 		ctx = auth.NewContext(ctx, user)
+		logging.AddAttrs(ctx, logging.KeyUser, user.Username)
 
-		// 3. Evaluate Base RBAC roles for establishing the stream
-		if exists {
-			if spec.CheckRole && user.Role < spec.MinRole {
-				return connect.NewError(connect.CodePermissionDenied, errors.New("insufficient permissions for this action"))
+		// 3. Evaluate Base RBAC roles for establishing the stream. AdminService streams
+		// use the global role. The database of other streams is only known from their
+		// first message, so the authStreamWrapper checks the role the caller holds on it.
+		if err := authorizeFamily(user, procedure); err != nil {
+			return err
+		}
+		minRole := sqlrpcv1.Role_ROLE_READ_ONLY
+		if exists && spec.CheckRole {
+			minRole = max(minRole, spec.MinRole)
+		}
+		if strings.HasPrefix(procedure, "/sqlrpc.v1.AdminService/") {
+			if !exists {
+				// FALLBACK SECURE DEFAULT:
+				minRole = sqlrpcv1.Role_ROLE_ADMIN
 			}
-		} else {
-			// FALLBACK SECURE DEFAULT:
-			if strings.HasPrefix(procedure, "/sqlrpc.v1.AdminService/") {
-				if user.Role < sqlrpcv1.Role_ROLE_ADMIN {
-					return connect.NewError(connect.CodePermissionDenied, errors.New("admin access required"))
-				}
-			} else {
-				if user.Role < sqlrpcv1.Role_ROLE_READ_ONLY {
-					return connect.NewError(connect.CodePermissionDenied, errors.New("read-only access required at minimum"))
-				}
+			if user.Role < minRole {
+				return connect.NewError(connect.CodePermissionDenied, errors.New("insufficient permissions for this action"))
 			}
 		}
 
 		// Mount the authStreamWrapper to intercept and scan subsequent messages sent over the stream
-		return next(ctx, &authStreamWrapper{StreamingHandlerConn: conn, ctx: ctx})
+		wrapper := &authStreamWrapper{StreamingHandlerConn: conn, ctx: ctx, interceptor: authInterceptor, minRole: minRole}
+		err = next(ctx, wrapper)
+		if len(wrapper.audit) > 0 {
+			authInterceptor.auditor.record(ctx, wrapper.audit, err)
+		}
+		return err
 	}
 }
 
@@ -606,6 +1104,7 @@ var disabledWhenNoAuth = map[string]bool{
 	"/sqlrpc.v1.AdminService/CreateUser":     true,
 	"/sqlrpc.v1.AdminService/UpdateUserRole": true,
 	"/sqlrpc.v1.AdminService/DeleteUser":     true,
+	"/sqlrpc.v1.AdminService/UnlockUser":     true,
 	"/sqlrpc.v1.AdminService/UpdatePassword": true,
 
 	// API Key Management
@@ -613,6 +1112,24 @@ var disabledWhenNoAuth = map[string]bool{
 	"/sqlrpc.v1.AdminService/CreateAPIKey": true,
 	"/sqlrpc.v1.AdminService/DeleteAPIKey": true,
 
+	// Database Access Control
+	"/sqlrpc.v1.AdminService/GrantDatabaseAccess":  true,
+	"/sqlrpc.v1.AdminService/RevokeDatabaseAccess": true,
+	"/sqlrpc.v1.AdminService/ListDatabaseGrants":   true,
+
+	// Rate Limits
+	"/sqlrpc.v1.AdminService/SetRateLimit":    true,
+	"/sqlrpc.v1.AdminService/DeleteRateLimit": true,
+	"/sqlrpc.v1.AdminService/ListRateLimits":  true,
+
+	// Row and Column Security
+	"/sqlrpc.v1.AdminService/CreateRowPolicy":    true,
+	"/sqlrpc.v1.AdminService/DeleteRowPolicy":    true,
+	"/sqlrpc.v1.AdminService/ListRowPolicies":    true,
+	"/sqlrpc.v1.AdminService/SetColumnPolicy":    true,
+	"/sqlrpc.v1.AdminService/DeleteColumnPolicy": true,
+	"/sqlrpc.v1.AdminService/ListColumnPolicies": true,
+
 	// Authentication / Sessions
 	"/sqlrpc.v1.AdminService/Login":  true,
 	"/sqlrpc.v1.AdminService/Logout": true,
@@ -647,6 +1164,18 @@ func (i *NoAuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc
 	}
 }
 
+// AuthorizeHTTP grants a plain HTTP request the anonymous admin, unless procedure is
+// blocked in no-auth mode.
+func (i *NoAuthInterceptor) AuthorizeHTTP(r *http.Request, procedure string, _ connect.AnyRequest) (context.Context, func(error), error) {
+	if disabledWhenNoAuth[procedure] {
+		return nil, nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("authentication is disabled on this server"))
+	}
+	return auth.NewContext(r.Context(), &auth.UserClaims{
+		Username: "anonymous-admin",
+		Role:     sqlrpcv1.Role_ROLE_ADMIN,
+	}), func(error) {}, nil
+}
+
 // WrapStreamingClient is not implemented on the server side.
 func (i *NoAuthInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
 	return next
@@ -3,17 +3,18 @@ package servicesv1
 import (
 	"context"
 	"errors"
-	"strings"
 
 	"sqlite-server/internal/auth"
 	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+	"sqlite-server/internal/sqlclass"
 
 	"connectrpc.com/connect"
 )
 
-// AuthorizeRead checks if the current user has permission to perform read operations.
-// Returns an error if the user is not authenticated or has an invalid role.
-func AuthorizeRead(ctx context.Context) error {
+// AuthorizeRead checks if the current user has permission to perform read operations
+// on the given database. Returns an error if the user is not authenticated or has no
+// effective role on that database.
+func AuthorizeRead(ctx context.Context, database string) error {
 	claims, ok := auth.FromContext(ctx)
 	if !ok {
 		// No auth context means auth is disabled or anonymous access
@@ -22,26 +23,27 @@ func AuthorizeRead(ctx context.Context) error {
 	}
 
 	// All valid roles (Admin, ReadWrite, ReadOnly) can read.
-	// We just check if role is unspecified.
-	if claims.Role == sqlrpcv1.Role_ROLE_UNSPECIFIED {
+	// We just check if the effective role is unspecified.
+	if claims.RoleFor(database) == sqlrpcv1.Role_ROLE_UNSPECIFIED {
 		return connect.NewError(connect.CodePermissionDenied, nil)
 	}
 
 	return nil
 }
 
-// AuthorizeWrite checks if the current user has permission to perform write operations.
-// Returns an error if the user is read_only.
-func AuthorizeWrite(ctx context.Context) error {
+// AuthorizeWrite checks if the current user has permission to perform write operations
+// on the given database. Returns an error if the effective role is read_only or lower.
+func AuthorizeWrite(ctx context.Context, database string) error {
 	claims, ok := auth.FromContext(ctx)
 	if !ok {
 		return connect.NewError(connect.CodeUnauthenticated, nil)
 	}
 
 	// Admin, DatabaseManager and ReadWrite can write.
-	if claims.Role != sqlrpcv1.Role_ROLE_ADMIN &&
-		claims.Role != sqlrpcv1.Role_ROLE_DATABASE_MANAGER &&
-		claims.Role != sqlrpcv1.Role_ROLE_READ_WRITE {
+	role := claims.RoleFor(database)
+	if role != sqlrpcv1.Role_ROLE_ADMIN &&
+		role != sqlrpcv1.Role_ROLE_DATABASE_MANAGER &&
+		role != sqlrpcv1.Role_ROLE_READ_WRITE {
 		return connect.NewError(connect.CodePermissionDenied, nil)
 	}
 
@@ -78,46 +80,28 @@ func AuthorizeDatabaseManager(ctx context.Context) error {
 }
 
 // AuthorizeUser checks if the current user is an admin or is the target user.
+// JWT identities never own a local account, even one with the same name.
 func AuthorizeUser(ctx context.Context, targetUsername string) error {
 	claims, ok := auth.FromContext(ctx)
 	if !ok {
 		return connect.NewError(connect.CodeUnauthenticated, nil)
 	}
 
-	if claims.Role != sqlrpcv1.Role_ROLE_ADMIN && claims.Username != targetUsername {
+	if claims.Role != sqlrpcv1.Role_ROLE_ADMIN && (claims.Username != targetUsername || claims.Issuer != "") {
 		return connect.NewError(connect.CodePermissionDenied, errors.New("permission denied"))
 	}
 
 	return nil
 }
 
-// IsWriteQuery checks if the SQL statement is a write operation.
-// This is a simple heuristic check for common write statements.
+// IsWriteQuery checks if the SQL string may modify the database. Every statement is
+// classified, so CTE-prefixed DML, leading comments and multi-statement strings count.
 func IsWriteQuery(sql string) bool {
-	trimmed := strings.TrimSpace(strings.ToUpper(sql))
-	writeKeywords := []string{
-		"INSERT", "UPDATE", "DELETE", "DROP", "CREATE", "ALTER",
-		"TRUNCATE", "REPLACE", "UPSERT",
-	}
-	for _, keyword := range writeKeywords {
-		if strings.HasPrefix(trimmed, keyword) {
-			return true
-		}
-	}
-	return IsRestrictedQuery(sql)
+	return sqlclass.Classify(sql).Write
 }
 
-// IsRestrictedQuery checks if the SQL statement is a restricted operation
+// IsRestrictedQuery checks if the SQL string contains a restricted operation
 // like ATTACH, DETACH or VACUUM that must be done via dedicated APIs.
 func IsRestrictedQuery(sql string) bool {
-	trimmed := strings.TrimSpace(strings.ToUpper(sql))
-	restrictedKeywords := []string{
-		"ATTACH", "DETACH", "VACUUM",
-	}
-	for _, keyword := range restrictedKeywords {
-		if strings.HasPrefix(trimmed, keyword) {
-			return true
-		}
-	}
-	return false
+	return sqlclass.Classify(sql).Restricted
 }
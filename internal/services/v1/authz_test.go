@@ -14,7 +14,7 @@ import (
 func TestAuthorizeRead(t *testing.T) {
 	t.Run("unauthenticated context returns error", func(t *testing.T) {
 		ctx := context.Background()
-		err := AuthorizeRead(ctx)
+		err := AuthorizeRead(ctx, "main")
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "unauthenticated")
 	})
@@ -27,7 +27,7 @@ func TestAuthorizeRead(t *testing.T) {
 		}
 		ctx := auth.NewContext(context.Background(), claims)
 
-		err := AuthorizeRead(ctx)
+		err := AuthorizeRead(ctx, "main")
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "permission_denied")
 	})
@@ -38,7 +38,7 @@ func TestAuthorizeRead(t *testing.T) {
 			Role:   sqlrpcv1.Role_ROLE_READ_ONLY,
 		}
 		ctx := auth.NewContext(context.Background(), claims)
-		assert.NoError(t, AuthorizeRead(ctx))
+		assert.NoError(t, AuthorizeRead(ctx, "main"))
 	})
 
 	t.Run("admin user is allowed", func(t *testing.T) {
@@ -47,14 +47,14 @@ func TestAuthorizeRead(t *testing.T) {
 			Role:   sqlrpcv1.Role_ROLE_ADMIN,
 		}
 		ctx := auth.NewContext(context.Background(), claims)
-		assert.NoError(t, AuthorizeRead(ctx))
+		assert.NoError(t, AuthorizeRead(ctx, "main"))
 	})
 }
 
 func TestAuthorizeWrite(t *testing.T) {
 	t.Run("unauthenticated context returns error", func(t *testing.T) {
 		ctx := context.Background()
-		err := AuthorizeWrite(ctx)
+		err := AuthorizeWrite(ctx, "main")
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "unauthenticated")
 	})
@@ -67,7 +67,7 @@ func TestAuthorizeWrite(t *testing.T) {
 		}
 		ctx := auth.NewContext(context.Background(), claims)
 
-		err := AuthorizeWrite(ctx)
+		err := AuthorizeWrite(ctx, "main")
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "permission_denied")
 	})
@@ -80,7 +80,7 @@ func TestAuthorizeWrite(t *testing.T) {
 		}
 		ctx := auth.NewContext(context.Background(), claims)
 
-		err := AuthorizeWrite(ctx)
+		err := AuthorizeWrite(ctx, "main")
 		assert.NoError(t, err)
 	})
 
@@ -92,7 +92,7 @@ func TestAuthorizeWrite(t *testing.T) {
 		}
 		ctx := auth.NewContext(context.Background(), claims)
 
-		err := AuthorizeWrite(ctx)
+		err := AuthorizeWrite(ctx, "main")
 		assert.NoError(t, err)
 	})
 
@@ -104,9 +104,27 @@ func TestAuthorizeWrite(t *testing.T) {
 		}
 		ctx := auth.NewContext(context.Background(), claims)
 
-		err := AuthorizeWrite(ctx)
+		err := AuthorizeWrite(ctx, "main")
 		assert.NoError(t, err)
 	})
+
+	t.Run("database grants are enforced", func(t *testing.T) {
+		claims := &auth.UserClaims{
+			UserID:   5,
+			Username: "tenant_user",
+			Role:     sqlrpcv1.Role_ROLE_READ_WRITE,
+			Grants: []auth.DatabaseGrant{
+				{Database: "tenant_a", Role: sqlrpcv1.Role_ROLE_READ_WRITE},
+				{Database: "shared_*", Role: sqlrpcv1.Role_ROLE_READ_ONLY},
+			},
+		}
+		ctx := auth.NewContext(context.Background(), claims)
+
+		assert.NoError(t, AuthorizeWrite(ctx, "tenant_a"))
+		assert.Error(t, AuthorizeWrite(ctx, "shared_docs"))
+		assert.NoError(t, AuthorizeRead(ctx, "shared_docs"))
+		assert.Error(t, AuthorizeRead(ctx, "tenant_b"))
+	})
 }
 
 func TestAuthorizeAdmin(t *testing.T) {
@@ -218,6 +236,11 @@ func TestIsWriteQuery(t *testing.T) {
 		"DETACH DATABASE foo",
 		"VACUUM",
 		"UPSERT INTO users VALUES (1, 2)", // Not valid SQL per se but checks keyword
+		"WITH doomed AS (SELECT id FROM users) DELETE FROM users WHERE id IN doomed",
+		"/* comment */ DROP TABLE users",
+		"-- comment\nINSERT INTO users VALUES (1, 'test')",
+		"SELECT 1; DELETE FROM users",
+		"PRAGMA user_version = 2",
 	}
 
 	for _, query := range writeQueries {
@@ -237,6 +260,8 @@ func TestIsWriteQuery(t *testing.T) {
 		"PRAGMA table_info(users)",
 		"EXPLAIN SELECT * FROM users",
 		"WITH cte AS (SELECT 1) SELECT * FROM cte",
+		"SELECT 'DROP TABLE users'",
+		"/* DELETE */ SELECT 1",
 	}
 
 	for _, query := range readQueries {
@@ -0,0 +1,589 @@
+package servicesv1
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"github.com/mattn/go-sqlite3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"sqlite-server/internal/logging"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+	"sqlite-server/internal/sqldrivers"
+)
+
+// Backups are stored per database as <backupDir>/<database>/<id>.db (or .db.gz), next
+// to a <file>.sha256 checksum in sha256sum format. Backup IDs are UTC timestamps, so
+// sorting them sorts backups by age.
+
+// backupIDLayout is the timestamp layout of backup IDs, before the dot is removed.
+const backupIDLayout = "20060102T150405.000000000Z"
+
+// backupChunkSize is the size of the chunks streamed by DownloadBackup.
+const backupChunkSize = 256 * 1024
+
+// SetBackupDir sets the directory backups are stored in.
+func (s *DbServer) SetBackupDir(dir string) {
+	s.backupDir = dir
+}
+
+// BackupDatabase takes a consistent snapshot of a database into the backups directory.
+func (s *DbServer) BackupDatabase(ctx context.Context, req *connect.Request[sqlrpcv1.BackupDatabaseRequest]) (*connect.Response[sqlrpcv1.BackupDatabaseResponse], error) {
+	if err := protovalidate.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	dbName := req.Msg.Database
+	if err := s.requireUnrestricted(ctx, dbName); err != nil {
+		return nil, err
+	}
+
+	dir := filepath.Join(s.backupDir, dbName)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create backup directory: %w", err))
+	}
+
+	createdAt := time.Now().UTC()
+	id := strings.Replace(createdAt.Format(backupIDLayout), ".", "", 1)
+	tmpPath := filepath.Join(dir, id+".db.tmp")
+	defer os.Remove(tmpPath)
+
+	maintenanceLog.InfoContext(ctx, "Backing up database", logging.KeyDatabase, dbName, "dir", dir)
+	if err := s.snapshotDatabase(ctx, dbName, tmpPath); err != nil {
+		return nil, backupConnectError(err)
+	}
+
+	compression := req.Msg.Compression
+	if compression == sqlrpcv1.BackupCompression_BACKUP_COMPRESSION_UNSPECIFIED {
+		compression = sqlrpcv1.BackupCompression_BACKUP_COMPRESSION_NONE
+	}
+	path := filepath.Join(dir, id+backupExtension(compression))
+	checksum, err := storeBackup(tmpPath, path, compression)
+	if err != nil {
+		os.Remove(path)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to store backup: %w", err))
+	}
+
+	info, err := readBackupInfo(dbName, path)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	info.Sha256 = checksum
+
+	return connect.NewResponse(&sqlrpcv1.BackupDatabaseResponse{Backup: info}), nil
+}
+
+// ListBackups lists the stored backups of a database, newest first.
+func (s *DbServer) ListBackups(ctx context.Context, req *connect.Request[sqlrpcv1.ListBackupsRequest]) (*connect.Response[sqlrpcv1.ListBackupsResponse], error) {
+	if err := protovalidate.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	backups, err := s.listBackups(req.Msg.Database)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&sqlrpcv1.ListBackupsResponse{Backups: backups}), nil
+}
+
+// RestoreDatabase replaces a database file with a stored backup.
+//
+// FLOW:
+//  1. Verifies the checksum and stages the (decompressed) backup next to the database.
+//  2. Runs a quick_check on the staged copy so a bad backup never replaces good data.
+//  3. Quiesces the database in the DbManager, which closes the RW/RO pools and blocks
+//     new connections, then swaps the file and drops the stale WAL and shared memory.
+func (s *DbServer) RestoreDatabase(ctx context.Context, req *connect.Request[sqlrpcv1.RestoreDatabaseRequest]) (*connect.Response[sqlrpcv1.RestoreDatabaseResponse], error) {
+	if err := protovalidate.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	dbName := req.Msg.Database
+
+	config, ok := s.dbManager.GetConfig(dbName)
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("database not found: %s", dbName))
+	}
+	if s.dbManager.InMemory(dbName) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("in-memory databases cannot be restored"))
+	}
+
+	info, path, err := s.findBackup(dbName, req.Msg.BackupId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if err := verifyBackup(path, info.Sha256); err != nil {
+		return nil, connect.NewError(connect.CodeDataLoss, err)
+	}
+
+	// Stage in the database directory so the final rename stays on one filesystem
+	dbFile := databaseFilePath(config.DbPath)
+	staged, err := os.CreateTemp(filepath.Dir(dbFile), filepath.Base(dbFile)+".restore-*")
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to stage backup: %w", err))
+	}
+	stagedPath := staged.Name()
+	defer os.Remove(stagedPath)
+
+	err = extractBackup(path, info.Compression, staged)
+	if closeErr := staged.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to stage backup: %w", err))
+	}
+	if err := quickCheck(ctx, stagedPath, config); err != nil {
+		return nil, connect.NewError(connect.CodeDataLoss, fmt.Errorf("backup %s failed verification: %w", info.BackupId, err))
+	}
+
+	// Sessions on the database keep reading the old file, so refuse before tearing
+	// its pools down, and again once no new one can start
+	if err := s.checkNoSessions(dbName); err != nil {
+		return nil, err
+	}
+	release, err := s.dbManager.Quiesce(dbName)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeAborted, err)
+	}
+	defer release()
+	if err := s.checkNoSessions(dbName); err != nil {
+		return nil, err
+	}
+
+	maintenanceLog.InfoContext(ctx, "Restoring database", logging.KeyDatabase, dbName, "backup_id", info.BackupId)
+	for _, suffix := range []string{"-wal", "-shm"} {
+		if err := os.Remove(dbFile + suffix); err != nil && !os.IsNotExist(err) {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to remove %s: %w", dbFile+suffix, err))
+		}
+	}
+	if err := os.Rename(stagedPath, dbFile); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to swap database file: %w", err))
+	}
+
+	return connect.NewResponse(&sqlrpcv1.RestoreDatabaseResponse{
+		Success: true,
+		Message: fmt.Sprintf("Database restored from backup %s", info.BackupId),
+		Backup:  info,
+	}), nil
+}
+
+// DownloadBackup streams a stored backup, or a fresh snapshot if no backup ID is given.
+func (s *DbServer) DownloadBackup(ctx context.Context, req *connect.Request[sqlrpcv1.DownloadBackupRequest], stream *connect.ServerStream[sqlrpcv1.DownloadBackupResponse]) error {
+	if err := protovalidate.Validate(req.Msg); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	dbName := req.Msg.Database
+	if err := s.requireUnrestricted(ctx, dbName); err != nil {
+		return err
+	}
+
+	var info *sqlrpcv1.BackupInfo
+	var path string
+	if req.Msg.BackupId != "" {
+		var err error
+		info, path, err = s.findBackup(dbName, req.Msg.BackupId)
+		if err != nil {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+	} else {
+		tmp, err := os.CreateTemp("", "sqlite-server-download-*.db")
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		tmp.Close()
+		path = tmp.Name()
+		defer os.Remove(path)
+
+		createdAt := time.Now().UTC()
+		if err := s.snapshotDatabase(ctx, dbName, path); err != nil {
+			return backupConnectError(err)
+		}
+		checksum, err := fileSha256(path)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		stat, err := os.Stat(path)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		info = &sqlrpcv1.BackupInfo{
+			BackupId:    strings.Replace(createdAt.Format(backupIDLayout), ".", "", 1),
+			Database:    dbName,
+			CreatedAt:   timestamppb.New(createdAt),
+			SizeBytes:   stat.Size(),
+			Compression: sqlrpcv1.BackupCompression_BACKUP_COMPRESSION_NONE,
+			Sha256:      checksum,
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	defer f.Close()
+
+	buf := make([]byte, backupChunkSize)
+	msg := &sqlrpcv1.DownloadBackupResponse{Backup: info}
+	for {
+		n, err := io.ReadFull(f, buf)
+		if n > 0 || msg.Backup != nil {
+			msg.Chunk = buf[:n]
+			if sendErr := stream.Send(msg); sendErr != nil {
+				return sendErr
+			}
+			msg = &sqlrpcv1.DownloadBackupResponse{}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read backup: %w", err))
+		}
+	}
+}
+
+// ---------------------------------------------------------
+// Snapshot & Storage Helpers
+// ---------------------------------------------------------
+
+// errBackupSource marks failures to open the source database.
+var errBackupSource = errors.New("backup source unavailable")
+
+// backupConnectError maps snapshot failures to connect errors.
+func backupConnectError(err error) error {
+	if errors.Is(err, errBackupSource) {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+	return connect.NewError(connect.CodeInternal, fmt.Errorf("backup failed: %w", err))
+}
+
+// snapshotDatabase copies a consistent snapshot of a database into path with the
+// online backup API. Encrypted databases stay encrypted with the same key.
+func (s *DbServer) snapshotDatabase(ctx context.Context, dbName, path string) error {
+	config, ok := s.dbManager.GetConfig(dbName)
+	if !ok {
+		return fmt.Errorf("%w: database not found: %s", errBackupSource, dbName)
+	}
+
+	// Every pool of an in-memory database holds its own copy; the RW pool is the real one
+	mode := ModeRO
+	if s.dbManager.InMemory(dbName) {
+		mode = ModeRW
+	}
+	src, err := s.dbManager.GetConnection(ctx, dbName, mode)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errBackupSource, err)
+	}
+	srcConn, err := src.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer srcConn.Close()
+
+	dest, err := sql.Open("sqlite3", backupDSN(path, config))
+	if err != nil {
+		return err
+	}
+	defer dest.Close()
+	destConn, err := dest.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer destConn.Close()
+
+	return destConn.Raw(func(destDriverConn any) error {
+		return srcConn.Raw(func(srcDriverConn any) error {
+			destSqlite, ok := destDriverConn.(*sqlite3.SQLiteConn)
+			srcSqlite, ok2 := srcDriverConn.(*sqlite3.SQLiteConn)
+			if !ok || !ok2 {
+				return fmt.Errorf("unexpected driver connection %T", srcDriverConn)
+			}
+			backup, err := destSqlite.Backup("main", srcSqlite, "main")
+			if err != nil {
+				return err
+			}
+			if _, err := backup.Step(-1); err != nil {
+				backup.Finish()
+				return err
+			}
+			return backup.Finish()
+		})
+	})
+}
+
+// backupDSN opens a backup file with the key of the database it belongs to.
+func backupDSN(path string, config *sqlrpcv1.DatabaseConfig) string {
+	dsn := "file:" + path
+	if config.IsEncrypted {
+		key := config.Key
+		if key == "" {
+			key = sqldrivers.DefaultEncryptionKey
+		}
+		dsn += "?_key=" + key
+	}
+	return dsn
+}
+
+// backupExtension returns the file extension for a compression mode.
+func backupExtension(compression sqlrpcv1.BackupCompression) string {
+	if compression == sqlrpcv1.BackupCompression_BACKUP_COMPRESSION_GZIP {
+		return ".db.gz"
+	}
+	return ".db"
+}
+
+// storeBackup moves a snapshot to its final path, compressing it if requested, and
+// writes the checksum file. It returns the hex SHA-256 of the stored file.
+func storeBackup(snapshot, path string, compression sqlrpcv1.BackupCompression) (string, error) {
+	if compression == sqlrpcv1.BackupCompression_BACKUP_COMPRESSION_GZIP {
+		if err := gzipFile(snapshot, path); err != nil {
+			return "", err
+		}
+	} else if err := os.Rename(snapshot, path); err != nil {
+		return "", err
+	}
+
+	checksum, err := fileSha256(path)
+	if err != nil {
+		return "", err
+	}
+	line := fmt.Sprintf("%s  %s\n", checksum, filepath.Base(path))
+	if err := os.WriteFile(path+".sha256", []byte(line), 0o644); err != nil {
+		return "", err
+	}
+	return checksum, nil
+}
+
+func gzipFile(src, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	zw := gzip.NewWriter(out)
+	if _, err := io.Copy(zw, in); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	return out.Close()
+}
+
+// extractBackup writes the (decompressed) content of a backup file to w.
+func extractBackup(path string, compression sqlrpcv1.BackupCompression, w io.Writer) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if compression == sqlrpcv1.BackupCompression_BACKUP_COMPRESSION_GZIP {
+		zr, err := gzip.NewReader(f)
+		if err != nil {
+			return err
+		}
+		defer zr.Close()
+		r = zr
+	}
+	_, err = io.Copy(w, r)
+	return err
+}
+
+func fileSha256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// verifyBackup compares a backup file against its recorded checksum.
+func verifyBackup(path, checksum string) error {
+	if checksum == "" {
+		return fmt.Errorf("backup %s has no checksum", filepath.Base(path))
+	}
+	actual, err := fileSha256(path)
+	if err != nil {
+		return err
+	}
+	if actual != checksum {
+		return fmt.Errorf("backup %s is corrupt: checksum mismatch", filepath.Base(path))
+	}
+	return nil
+}
+
+// quickCheck runs PRAGMA quick_check against a standalone database file.
+func quickCheck(ctx context.Context, path string, config *sqlrpcv1.DatabaseConfig) error {
+	db, err := sql.Open("sqlite3", backupDSN(path, config))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	var result string
+	if err := db.QueryRowContext(ctx, "PRAGMA quick_check").Scan(&result); err != nil {
+		return err
+	}
+	if result != "ok" {
+		return errors.New(result)
+	}
+	return nil
+}
+
+// checkNoSessions refuses if a transaction or cursor reads the database, directly or
+// through a database that attaches it.
+func (s *DbServer) checkNoSessions(dbName string) error {
+	names := append(s.dbManager.AttachedBy(dbName), dbName)
+
+	s.txMu.RLock()
+	for _, session := range s.txRegistry {
+		if slices.Contains(names, session.DBName) {
+			s.txMu.RUnlock()
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("database '%s' has open transactions on '%s'", dbName, session.DBName))
+		}
+	}
+	s.txMu.RUnlock()
+
+	s.cursorMu.Lock()
+	defer s.cursorMu.Unlock()
+	for _, c := range s.cursors {
+		if slices.Contains(names, c.database) {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("database '%s' has open cursors on '%s'", dbName, c.database))
+		}
+	}
+	return nil
+}
+
+// ---------------------------------------------------------
+// Backup Catalog
+// ---------------------------------------------------------
+
+// listBackups reads the backups of a database from disk, newest first.
+func (s *DbServer) listBackups(dbName string) ([]*sqlrpcv1.BackupInfo, error) {
+	entries, err := os.ReadDir(filepath.Join(s.backupDir, dbName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup directory: %w", err)
+	}
+
+	var backups []*sqlrpcv1.BackupInfo
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !(strings.HasSuffix(name, ".db") || strings.HasSuffix(name, ".db.gz")) {
+			continue
+		}
+		info, err := readBackupInfo(dbName, filepath.Join(s.backupDir, dbName, name))
+		if err != nil {
+			maintenanceLog.Warn("Skipping unreadable backup", logging.KeyDatabase, dbName, "file", name, logging.Err(err))
+			continue
+		}
+		backups = append(backups, info)
+	}
+
+	slices.SortFunc(backups, func(a, b *sqlrpcv1.BackupInfo) int {
+		return strings.Compare(b.BackupId, a.BackupId)
+	})
+	return backups, nil
+}
+
+// findBackup locates a stored backup by ID.
+func (s *DbServer) findBackup(dbName, id string) (*sqlrpcv1.BackupInfo, string, error) {
+	for _, compression := range []sqlrpcv1.BackupCompression{
+		sqlrpcv1.BackupCompression_BACKUP_COMPRESSION_NONE,
+		sqlrpcv1.BackupCompression_BACKUP_COMPRESSION_GZIP,
+	} {
+		path := filepath.Join(s.backupDir, dbName, id+backupExtension(compression))
+		if _, err := os.Stat(path); err == nil {
+			info, err := readBackupInfo(dbName, path)
+			return info, path, err
+		}
+	}
+	return nil, "", fmt.Errorf("backup '%s' not found for database '%s'", id, dbName)
+}
+
+// pruneBackups deletes all but the newest keep backups of a database and returns
+// how many were removed.
+func (s *DbServer) pruneBackups(dbName string, keep int) (int, error) {
+	backups, err := s.listBackups(dbName)
+	if err != nil || len(backups) <= keep {
+		return 0, err
+	}
+
+	removed := 0
+	for _, info := range backups[keep:] {
+		path := filepath.Join(s.backupDir, dbName, info.BackupId+backupExtension(info.Compression))
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return removed, fmt.Errorf("failed to remove backup %s: %w", info.BackupId, err)
+		}
+		os.Remove(path + ".sha256")
+		removed++
+	}
+	return removed, nil
+}
+
+// readBackupInfo builds the metadata of a stored backup file.
+func readBackupInfo(dbName, path string) (*sqlrpcv1.BackupInfo, error) {
+	name := filepath.Base(path)
+	compression := sqlrpcv1.BackupCompression_BACKUP_COMPRESSION_NONE
+	id := strings.TrimSuffix(name, ".db")
+	if strings.HasSuffix(name, ".db.gz") {
+		compression = sqlrpcv1.BackupCompression_BACKUP_COMPRESSION_GZIP
+		id = strings.TrimSuffix(name, ".db.gz")
+	}
+
+	// IDs are timestamps with the fractional-second dot removed
+	if len(id) < 16 {
+		return nil, fmt.Errorf("invalid backup id '%s'", id)
+	}
+	createdAt, err := time.Parse(backupIDLayout, id[:15]+"."+id[15:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid backup id '%s': %w", id, err)
+	}
+
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &sqlrpcv1.BackupInfo{
+		BackupId:    id,
+		Database:    dbName,
+		CreatedAt:   timestamppb.New(createdAt),
+		SizeBytes:   stat.Size(),
+		Compression: compression,
+	}
+	if line, err := os.ReadFile(path + ".sha256"); err == nil {
+		if fields := strings.Fields(string(line)); len(fields) > 0 {
+			info.Sha256 = fields[0]
+		}
+	}
+	return info, nil
+}
@@ -0,0 +1,280 @@
+package servicesv1
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+)
+
+func TestBackupAndRestore(t *testing.T) {
+	client, server := setupTestServer(t)
+	backupDir := t.TempDir()
+	server.SetBackupDir(backupDir)
+	ctx := context.Background()
+
+	userCount := func(t *testing.T) int {
+		db, err := server.dbManager.GetConnection(ctx, "test", ModeRO)
+		require.NoError(t, err)
+		var n int
+		require.NoError(t, db.QueryRow("SELECT count(*) FROM users").Scan(&n))
+		return n
+	}
+
+	plain, err := server.BackupDatabase(ctx, connect.NewRequest(&sqlrpcv1.BackupDatabaseRequest{Database: "test"}))
+	require.NoError(t, err)
+	compressed, err := server.BackupDatabase(ctx, connect.NewRequest(&sqlrpcv1.BackupDatabaseRequest{
+		Database:    "test",
+		Compression: sqlrpcv1.BackupCompression_BACKUP_COMPRESSION_GZIP,
+	}))
+	require.NoError(t, err)
+
+	t.Run("BackupDatabase stores file and checksum", func(t *testing.T) {
+		info := compressed.Msg.Backup
+		assert.Equal(t, "test", info.Database)
+		assert.Equal(t, sqlrpcv1.BackupCompression_BACKUP_COMPRESSION_GZIP, info.Compression)
+
+		path := filepath.Join(backupDir, "test", info.BackupId+".db.gz")
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		sum := sha256.Sum256(data)
+		assert.Equal(t, hex.EncodeToString(sum[:]), info.Sha256)
+		assert.Equal(t, int64(len(data)), info.SizeBytes)
+
+		sidecar, err := os.ReadFile(path + ".sha256")
+		require.NoError(t, err)
+		assert.Equal(t, info.Sha256+"  "+info.BackupId+".db.gz\n", string(sidecar))
+
+		assert.Equal(t, sqlrpcv1.BackupCompression_BACKUP_COMPRESSION_NONE, plain.Msg.Backup.Compression)
+		assert.FileExists(t, filepath.Join(backupDir, "test", plain.Msg.Backup.BackupId+".db"))
+	})
+
+	t.Run("ListBackups returns newest first", func(t *testing.T) {
+		res, err := server.ListBackups(ctx, connect.NewRequest(&sqlrpcv1.ListBackupsRequest{Database: "test"}))
+		require.NoError(t, err)
+		require.Len(t, res.Msg.Backups, 2)
+		assert.Equal(t, compressed.Msg.Backup.BackupId, res.Msg.Backups[0].BackupId)
+		assert.Equal(t, plain.Msg.Backup.BackupId, res.Msg.Backups[1].BackupId)
+		assert.Equal(t, plain.Msg.Backup.Sha256, res.Msg.Backups[1].Sha256)
+		assert.True(t, res.Msg.Backups[1].CreatedAt.AsTime().Equal(plain.Msg.Backup.CreatedAt.AsTime()))
+
+		res, err = server.ListBackups(ctx, connect.NewRequest(&sqlrpcv1.ListBackupsRequest{Database: "other"}))
+		require.NoError(t, err)
+		assert.Empty(t, res.Msg.Backups)
+	})
+
+	t.Run("RestoreDatabase swaps the file", func(t *testing.T) {
+		db, err := server.dbManager.GetConnection(ctx, "test", ModeRW)
+		require.NoError(t, err)
+		_, err = db.Exec("INSERT INTO users (id, name) VALUES (3, 'Carol')")
+		require.NoError(t, err)
+		require.Equal(t, 3, userCount(t))
+
+		res, err := server.RestoreDatabase(ctx, connect.NewRequest(&sqlrpcv1.RestoreDatabaseRequest{
+			Database: "test",
+			BackupId: compressed.Msg.Backup.BackupId,
+		}))
+		require.NoError(t, err)
+		assert.True(t, res.Msg.Success)
+		assert.Equal(t, 2, userCount(t))
+
+		// The old pools were closed; writes go to the restored file
+		db, err = server.dbManager.GetConnection(ctx, "test", ModeRW)
+		require.NoError(t, err)
+		_, err = db.Exec("INSERT INTO users (id, name) VALUES (3, 'Dave')")
+		require.NoError(t, err)
+		assert.Equal(t, 3, userCount(t))
+	})
+
+	t.Run("RestoreDatabase rejects open transactions", func(t *testing.T) {
+		pool, err := server.dbManager.GetConnection(ctx, "test", ModeRW)
+		require.NoError(t, err)
+		tx, err := server.BeginTransaction(ctx, connect.NewRequest(&sqlrpcv1.BeginTransactionRequest{Database: "test"}))
+		require.NoError(t, err)
+
+		_, err = server.RestoreDatabase(ctx, connect.NewRequest(&sqlrpcv1.RestoreDatabaseRequest{
+			Database: "test",
+			BackupId: plain.Msg.Backup.BackupId,
+		}))
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+		// The refusal comes before the pools are torn down
+		same, err := server.dbManager.GetConnection(ctx, "test", ModeRW)
+		require.NoError(t, err)
+		assert.Same(t, pool, same)
+
+		_, err = server.RollbackTransaction(ctx, connect.NewRequest(&sqlrpcv1.TransactionControlRequest{TransactionId: tx.Msg.TransactionId}))
+		require.NoError(t, err)
+		assert.Equal(t, 3, userCount(t))
+	})
+
+	t.Run("RestoreDatabase rejects open cursors", func(t *testing.T) {
+		cursor, err := server.OpenCursor(ctx, connect.NewRequest(&sqlrpcv1.OpenCursorRequest{Database: "test", Sql: "SELECT * FROM users"}))
+		require.NoError(t, err)
+
+		_, err = server.RestoreDatabase(ctx, connect.NewRequest(&sqlrpcv1.RestoreDatabaseRequest{
+			Database: "test",
+			BackupId: plain.Msg.Backup.BackupId,
+		}))
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+		assert.ErrorContains(t, err, "cursors")
+
+		_, err = server.CloseCursor(ctx, connect.NewRequest(&sqlrpcv1.CloseCursorRequest{CursorId: cursor.Msg.CursorId}))
+		require.NoError(t, err)
+	})
+
+	t.Run("RestoreDatabase rejects corrupt backups", func(t *testing.T) {
+		path := filepath.Join(backupDir, "test", plain.Msg.Backup.BackupId+".db")
+		f, err := os.OpenFile(path, os.O_WRONLY, 0)
+		require.NoError(t, err)
+		_, err = f.WriteAt([]byte("garbage"), 200)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		_, err = server.RestoreDatabase(ctx, connect.NewRequest(&sqlrpcv1.RestoreDatabaseRequest{
+			Database: "test",
+			BackupId: plain.Msg.Backup.BackupId,
+		}))
+		assert.Equal(t, connect.CodeDataLoss, connect.CodeOf(err))
+		assert.Equal(t, 3, userCount(t))
+	})
+
+	t.Run("RestoreDatabase reopens databases that attach it", func(t *testing.T) {
+		require.NoError(t, server.MountDatabase(&sqlrpcv1.DatabaseConfig{Name: "front", DbPath: filepath.Join(t.TempDir(), "front.db")}))
+		require.NoError(t, server.dbManager.AttachDatabase("front", &sqlrpcv1.Attachment{TargetDatabaseName: "test", Alias: "t"}))
+		frontCount := func() int {
+			db, err := server.dbManager.GetConnection(ctx, "front", ModeRO)
+			require.NoError(t, err)
+			var n int
+			require.NoError(t, db.QueryRow("SELECT count(*) FROM t.users").Scan(&n))
+			return n
+		}
+		require.Equal(t, 3, frontCount())
+
+		// A transaction on the parent reads the attached file too
+		tx, err := server.BeginTransaction(ctx, connect.NewRequest(&sqlrpcv1.BeginTransactionRequest{Database: "front"}))
+		require.NoError(t, err)
+		_, err = server.RestoreDatabase(ctx, connect.NewRequest(&sqlrpcv1.RestoreDatabaseRequest{
+			Database: "test",
+			BackupId: compressed.Msg.Backup.BackupId,
+		}))
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+		_, err = server.RollbackTransaction(ctx, connect.NewRequest(&sqlrpcv1.TransactionControlRequest{TransactionId: tx.Msg.TransactionId}))
+		require.NoError(t, err)
+
+		_, err = server.RestoreDatabase(ctx, connect.NewRequest(&sqlrpcv1.RestoreDatabaseRequest{
+			Database: "test",
+			BackupId: compressed.Msg.Backup.BackupId,
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, 2, frontCount(), "the parent reads the restored file")
+
+		db, err := server.dbManager.GetConnection(ctx, "test", ModeRW)
+		require.NoError(t, err)
+		_, err = db.Exec("INSERT INTO users (id, name) VALUES (3, 'Dave')")
+		require.NoError(t, err)
+	})
+
+	t.Run("RestoreDatabase validates input", func(t *testing.T) {
+		_, err := server.RestoreDatabase(ctx, connect.NewRequest(&sqlrpcv1.RestoreDatabaseRequest{
+			Database: "test",
+			BackupId: "../../etc/passwd",
+		}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+		_, err = server.RestoreDatabase(ctx, connect.NewRequest(&sqlrpcv1.RestoreDatabaseRequest{
+			Database: "test",
+			BackupId: "20000101T000000000000000Z",
+		}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+
+	download := func(t *testing.T, backupID string) (*sqlrpcv1.BackupInfo, []byte) {
+		stream, err := client.DownloadBackup(ctx, connect.NewRequest(&sqlrpcv1.DownloadBackupRequest{
+			Database: "test",
+			BackupId: backupID,
+		}))
+		require.NoError(t, err)
+
+		var info *sqlrpcv1.BackupInfo
+		var buf bytes.Buffer
+		for stream.Receive() {
+			if stream.Msg().Backup != nil {
+				info = stream.Msg().Backup
+			}
+			buf.Write(stream.Msg().Chunk)
+		}
+		require.NoError(t, stream.Err())
+		require.NotNil(t, info)
+		return info, buf.Bytes()
+	}
+
+	t.Run("DownloadBackup streams a stored backup", func(t *testing.T) {
+		info, data := download(t, compressed.Msg.Backup.BackupId)
+		sum := sha256.Sum256(data)
+		assert.Equal(t, compressed.Msg.Backup.Sha256, hex.EncodeToString(sum[:]))
+		assert.Equal(t, info.SizeBytes, int64(len(data)))
+	})
+
+	t.Run("DownloadBackup streams a fresh snapshot", func(t *testing.T) {
+		info, data := download(t, "")
+		sum := sha256.Sum256(data)
+		assert.Equal(t, info.Sha256, hex.EncodeToString(sum[:]))
+		assert.Equal(t, sqlrpcv1.BackupCompression_BACKUP_COMPRESSION_NONE, info.Compression)
+
+		path := filepath.Join(t.TempDir(), "download.db")
+		require.NoError(t, os.WriteFile(path, data, 0o644))
+		db, err := sql.Open("sqlite3", path)
+		require.NoError(t, err)
+		defer db.Close()
+		var n int
+		require.NoError(t, db.QueryRow("SELECT count(*) FROM users").Scan(&n))
+		assert.Equal(t, 3, n)
+
+		// Fresh snapshots are not stored
+		res, err := server.ListBackups(ctx, connect.NewRequest(&sqlrpcv1.ListBackupsRequest{Database: "test"}))
+		require.NoError(t, err)
+		assert.Len(t, res.Msg.Backups, 2)
+	})
+}
+
+func TestDbManager_Quiesce(t *testing.T) {
+	dir := t.TempDir()
+	mgr := NewDbManager([]*sqlrpcv1.DatabaseConfig{
+		{Name: "q", DbPath: filepath.Join(dir, "q.db")},
+		{Name: "parent", DbPath: filepath.Join(dir, "parent.db"), Attachments: []*sqlrpcv1.Attachment{{TargetDatabaseName: "q", Alias: "q"}}},
+	})
+	defer mgr.Stop()
+	ctx := context.Background()
+
+	_, err := mgr.GetConnection(ctx, "q", ModeRW)
+	require.NoError(t, err)
+	_, err = mgr.GetConnection(ctx, "parent", ModeRO)
+	require.NoError(t, err)
+
+	release, err := mgr.Quiesce("q")
+	require.NoError(t, err)
+
+	_, err = mgr.Quiesce("q")
+	assert.Error(t, err, "quiescing twice must fail")
+	_, err = mgr.GetConnection(ctx, "q", ModeRO)
+	assert.ErrorContains(t, err, "temporarily unavailable")
+	_, err = mgr.GetConnection(ctx, "parent", ModeRO)
+	assert.ErrorContains(t, err, "temporarily unavailable", "databases attaching it wait too")
+
+	release()
+	_, err = mgr.GetConnection(ctx, "q", ModeRO)
+	assert.NoError(t, err)
+	_, err = mgr.GetConnection(ctx, "parent", ModeRO)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,134 @@
+package servicesv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+
+	"sqlite-server/internal/logging"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+	"sqlite-server/internal/sqlclass"
+	"sqlite-server/internal/tracing"
+)
+
+// BatchExec handles the unary `BatchExec` RPC.
+func (s *DbServer) BatchExec(ctx context.Context, req *connect.Request[sqlrpcv1.BatchExecRequest]) (*connect.Response[sqlrpcv1.BatchExecResponse], error) {
+	reqID := ensureRequestID(req.Header())
+
+	if err := protovalidate.Validate(req.Msg); err != nil {
+		rpcLog.WarnContext(ctx, "Validation failed for BatchExec", logging.Err(err))
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	msg := req.Msg
+	result, err := s.executeBatch(ctx, reqID, msg.Database, msg.Sql, msg.MaxExecutionTimeMs, len(msg.ParameterSets), func(i int) ([]any, error) {
+		return convertParameters(msg.Sql, msg.ParameterSets[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := connect.NewResponse(result)
+	res.Header().Set(headerRequestID, reqID)
+	return res, nil
+}
+
+// TypedBatchExec handles the unary `TypedBatchExec` RPC.
+func (s *DbServer) TypedBatchExec(ctx context.Context, req *connect.Request[sqlrpcv1.TypedBatchExecRequest]) (*connect.Response[sqlrpcv1.BatchExecResponse], error) {
+	reqID := ensureRequestID(req.Header())
+
+	if err := protovalidate.Validate(req.Msg); err != nil {
+		rpcLog.WarnContext(ctx, "Validation failed for TypedBatchExec", logging.Err(err))
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	msg := req.Msg
+	result, err := s.executeBatch(ctx, reqID, msg.Database, msg.Sql, msg.MaxExecutionTimeMs, len(msg.ParameterSets), func(i int) ([]any, error) {
+		return convertTypedParameters(msg.Sql, msg.ParameterSets[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := connect.NewResponse(result)
+	res.Header().Set(headerRequestID, reqID)
+	return res, nil
+}
+
+// executeBatch prepares sqlQuery once and executes it with the arguments of each of
+// the sets parameter sets, all inside one transaction. The first failing set rolls
+// the batch back and is reported by index.
+func (s *DbServer) executeBatch(ctx context.Context, reqID, database, sqlQuery string, maxMs int32, sets int, args func(i int) ([]any, error)) (_ *sqlrpcv1.BatchExecResponse, err error) {
+	if err := ValidateStatelessQuery(sqlQuery); err != nil {
+		return nil, err
+	}
+	if sqlclass.Classify(sqlQuery).Statements != 1 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("a batch executes exactly one statement"))
+	}
+
+	db, err := s.dbManager.GetConnection(ctx, database, s.statementMode(ctx, database, sqlQuery))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	ctx, done := s.startStatement(ctx, reqID, database, sqlQuery, maxMs)
+	defer done()
+	ctx, span := startStatementSpan(ctx, sqlQuery)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	startTime := time.Now()
+	tx, prepared, err := s.beginRestricted(ctx, db, database, sqlQuery)
+	if err != nil {
+		return nil, makeUnaryError(statementError(ctx, err), sqlQuery)
+	}
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	stmt, err := tx.PrepareContext(ctx, prepared)
+	if err != nil {
+		return nil, makeUnaryError(statementError(ctx, err), sqlQuery)
+	}
+	defer stmt.Close()
+
+	results := make([]*sqlrpcv1.DMLResult, sets)
+	var rowsWritten int64
+	for i := range sets {
+		params, err := args(i)
+		if err != nil {
+			return nil, makeBatchError(fmt.Errorf("parameter error: %w", err), sqlQuery, i)
+		}
+		res, err := stmt.ExecContext(ctx, params...)
+		if err != nil {
+			err = statementError(ctx, err)
+			rpcLog.WarnContext(ctx, "BatchExec execution failed", "index", i, logging.Err(err))
+			return nil, makeBatchError(err, sqlQuery, i)
+		}
+		rowsAffected, _ := res.RowsAffected()
+		lastInsertId, _ := res.LastInsertId()
+		results[i] = &sqlrpcv1.DMLResult{
+			RowsAffected: rowsAffected,
+			LastInsertId: lastInsertId,
+		}
+		rowsWritten += rowsAffected
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, makeUnaryError(statementError(ctx, err), sqlQuery)
+	}
+	tx = nil
+
+	return &sqlrpcv1.BatchExecResponse{
+		Results: results,
+		Stats: &sqlrpcv1.ExecutionStats{
+			DurationMs:  float64(time.Since(startTime).Milliseconds()),
+			RowsWritten: rowsWritten,
+		},
+	}, nil
+}
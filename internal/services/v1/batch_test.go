@@ -0,0 +1,121 @@
+package servicesv1
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+)
+
+func TestBatchExec(t *testing.T) {
+	client, _ := setupTestServer(t)
+	ctx := context.Background()
+
+	params := func(values ...any) *sqlrpcv1.Parameters {
+		list, err := structpb.NewList(values)
+		require.NoError(t, err)
+		return &sqlrpcv1.Parameters{Positional: list.Values}
+	}
+	count := func() float64 {
+		t.Helper()
+		res, err := client.Query(ctx, connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "test", Sql: "SELECT count(*) FROM users"}))
+		require.NoError(t, err)
+		return res.Msg.Rows[0].Values[0].GetNumberValue()
+	}
+
+	t.Run("executes every parameter set", func(t *testing.T) {
+		res, err := client.BatchExec(ctx, connect.NewRequest(&sqlrpcv1.BatchExecRequest{
+			Database: "test",
+			Sql:      "INSERT INTO users (id, name, age) VALUES (?, ?, ?)",
+			ParameterSets: []*sqlrpcv1.Parameters{
+				params(10, "Carol", 25),
+				params(11, "Dave", 35),
+				params(12, "Erin", 45),
+			},
+		}))
+		require.NoError(t, err)
+		require.Len(t, res.Msg.Results, 3)
+		for i, r := range res.Msg.Results {
+			assert.Equal(t, int64(1), r.RowsAffected)
+			assert.Equal(t, int64(10+i), r.LastInsertId)
+		}
+		assert.Equal(t, int64(3), res.Msg.Stats.RowsWritten)
+		assert.Equal(t, float64(5), count())
+	})
+
+	t.Run("named parameters and per-set results", func(t *testing.T) {
+		res, err := client.BatchExec(ctx, connect.NewRequest(&sqlrpcv1.BatchExecRequest{
+			Database: "test",
+			Sql:      "UPDATE users SET age = age + 1 WHERE age > :min",
+			ParameterSets: []*sqlrpcv1.Parameters{
+				{Named: map[string]*structpb.Value{":min": structpb.NewNumberValue(38)}},
+				{Named: map[string]*structpb.Value{":min": structpb.NewNumberValue(100)}},
+			},
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), res.Msg.Results[0].RowsAffected)
+		assert.Equal(t, int64(0), res.Msg.Results[1].RowsAffected)
+	})
+
+	t.Run("a failing set rolls the batch back", func(t *testing.T) {
+		_, err := client.BatchExec(ctx, connect.NewRequest(&sqlrpcv1.BatchExecRequest{
+			Database: "test",
+			Sql:      "INSERT INTO users (id, name) VALUES (?, ?)",
+			ParameterSets: []*sqlrpcv1.Parameters{
+				params(20, "Frank"),
+				params(1, "Duplicate"),
+				params(21, "Grace"),
+			},
+		}))
+		require.Error(t, err)
+		var connectErr *connect.Error
+		require.True(t, errors.As(err, &connectErr))
+		assert.Equal(t, connect.CodeAlreadyExists, connectErr.Code())
+		assert.Contains(t, connectErr.Message(), "parameter set 1")
+
+		require.Len(t, connectErr.Details(), 1)
+		detail, err := connectErr.Details()[0].Value()
+		require.NoError(t, err)
+		errResp, ok := detail.(*sqlrpcv1.ErrorResponse)
+		require.True(t, ok)
+		require.NotNil(t, errResp.BatchIndex)
+		assert.Equal(t, int32(1), *errResp.BatchIndex)
+
+		assert.Equal(t, float64(5), count(), "no set of the failed batch was applied")
+	})
+
+	t.Run("typed", func(t *testing.T) {
+		text := func(s string) *sqlrpcv1.SqlValue {
+			return &sqlrpcv1.SqlValue{Value: &sqlrpcv1.SqlValue_TextValue{TextValue: s}}
+		}
+		res, err := client.TypedBatchExec(ctx, connect.NewRequest(&sqlrpcv1.TypedBatchExecRequest{
+			Database: "test",
+			Sql:      "INSERT INTO users (name) VALUES (?)",
+			ParameterSets: []*sqlrpcv1.TypedParameters{
+				{Positional: []*sqlrpcv1.SqlValue{text("Heidi")}},
+				{Positional: []*sqlrpcv1.SqlValue{text("Ivan")}},
+			},
+		}))
+		require.NoError(t, err)
+		assert.Len(t, res.Msg.Results, 2)
+		assert.Equal(t, float64(7), count())
+	})
+
+	t.Run("rejections", func(t *testing.T) {
+		for name, req := range map[string]*sqlrpcv1.BatchExecRequest{
+			"no parameter sets":   {Database: "test", Sql: "DELETE FROM users"},
+			"several statements":  {Database: "test", Sql: "DELETE FROM users; DELETE FROM users", ParameterSets: []*sqlrpcv1.Parameters{{}}},
+			"transaction control": {Database: "test", Sql: "BEGIN", ParameterSets: []*sqlrpcv1.Parameters{{}}},
+		} {
+			_, err := client.BatchExec(ctx, connect.NewRequest(req))
+			assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), name)
+		}
+		assert.Equal(t, float64(7), count())
+	})
+}
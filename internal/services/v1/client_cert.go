@@ -0,0 +1,87 @@
+package servicesv1
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+
+	"sqlite-server/internal/auth"
+)
+
+// Client certificate fields a certificate identity can be taken from.
+const (
+	CertIdentityCN    = "cn"    // Subject common name
+	CertIdentityDNS   = "dns"   // DNS subject alternative names
+	CertIdentityEmail = "email" // Email subject alternative names
+	CertIdentityURI   = "uri"   // URI subject alternative names, e.g. SPIFFE IDs
+)
+
+type clientCertKey struct{}
+
+// certCacheKey keys cached certificate identities in the auth cache. Being a distinct
+// type, it can never collide with an Authorization header value.
+type certCacheKey [sha256.Size]byte
+
+// ClientCertificateHandler makes the verified TLS client certificate of a request
+// available to the AuthInterceptor. Unverified certificates are ignored.
+func ClientCertificateHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 && len(r.TLS.VerifiedChains[0]) > 0 {
+			r = r.WithContext(context.WithValue(r.Context(), clientCertKey{}, r.TLS.VerifiedChains[0][0]))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// clientCertificate returns the verified client certificate of the request, if any.
+func clientCertificate(ctx context.Context) *x509.Certificate {
+	cert, _ := ctx.Value(clientCertKey{}).(*x509.Certificate)
+	return cert
+}
+
+// ValidCertIdentity reports whether field names a supported certificate identity field.
+func ValidCertIdentity(field string) bool {
+	switch field {
+	case CertIdentityCN, CertIdentityDNS, CertIdentityEmail, CertIdentityURI:
+		return true
+	}
+	return false
+}
+
+// certNames returns the candidate usernames of a certificate for an identity field.
+func certNames(cert *x509.Certificate, field string) []string {
+	switch field {
+	case CertIdentityDNS:
+		return cert.DNSNames
+	case CertIdentityEmail:
+		return cert.EmailAddresses
+	case CertIdentityURI:
+		names := make([]string, len(cert.URIs))
+		for i, u := range cert.URIs {
+			names[i] = u.String()
+		}
+		return names
+	}
+	if cert.Subject.CommonName == "" {
+		return nil
+	}
+	return []string{cert.Subject.CommonName}
+}
+
+// certClaims maps a verified client certificate to the first user named by its identity
+// field.
+func (a *AuthInterceptor) certClaims(ctx context.Context, cert *x509.Certificate) (*auth.UserClaims, error) {
+	for _, name := range certNames(cert, a.certIdentity) {
+		user, err := a.store.ValidateTrustedUser(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if user != nil {
+			user.ExpiresAt = cert.NotAfter
+			return user, nil
+		}
+	}
+	return nil, fmt.Errorf("client certificate %q maps to no user", cert.Subject)
+}
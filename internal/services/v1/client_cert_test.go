@@ -0,0 +1,126 @@
+package servicesv1
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"sqlite-server/internal/auth"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testClientCert(cn string, dnsNames ...string) *x509.Certificate {
+	return &x509.Certificate{
+		Raw:      []byte("cert:" + cn),
+		Subject:  pkix.Name{CommonName: cn},
+		DNSNames: dnsNames,
+		NotAfter: time.Now().Add(time.Hour),
+	}
+}
+
+func TestCertNames(t *testing.T) {
+	spiffe, _ := url.Parse("spiffe://example.org/ns/prod/sa/reporting")
+	cert := &x509.Certificate{
+		Subject:        pkix.Name{CommonName: "reporting"},
+		DNSNames:       []string{"reporting.internal", "reporting"},
+		EmailAddresses: []string{"reporting@example.org"},
+		URIs:           []*url.URL{spiffe},
+	}
+	assert.Equal(t, []string{"reporting"}, certNames(cert, ""))
+	assert.Equal(t, []string{"reporting"}, certNames(cert, CertIdentityCN))
+	assert.Equal(t, []string{"reporting.internal", "reporting"}, certNames(cert, CertIdentityDNS))
+	assert.Equal(t, []string{"reporting@example.org"}, certNames(cert, CertIdentityEmail))
+	assert.Equal(t, []string{"spiffe://example.org/ns/prod/sa/reporting"}, certNames(cert, CertIdentityURI))
+	assert.Empty(t, certNames(&x509.Certificate{}, CertIdentityCN))
+
+	assert.True(t, ValidCertIdentity(CertIdentityURI))
+	assert.False(t, ValidCertIdentity("serial"))
+}
+
+func TestClientCertificateHandler(t *testing.T) {
+	var seen *x509.Certificate
+	handler := ClientCertificateHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = clientCertificate(r.Context())
+	}))
+	cert := testClientCert("alice")
+
+	req := httptest.NewRequest("POST", "/", nil)
+	req.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Same(t, cert, seen)
+
+	// Certificates that were not verified do not count
+	req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Nil(t, seen)
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("POST", "/", nil))
+	assert.Nil(t, seen)
+}
+
+func TestAuthInterceptor_ClientCertificate(t *testing.T) {
+	store, password, _ := setupStore(t)
+	ctx := context.Background()
+	_, err := store.CreateUser(ctx, "reporting", "password123", sqlrpcv1.Role_ROLE_READ_ONLY)
+	require.NoError(t, err)
+
+	interceptor := NewAuthInterceptor(store)
+	interceptor.SetClientCertIdentity(CertIdentityDNS)
+	var seen *auth.UserClaims
+	unary := interceptor.WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		seen, _ = auth.FromContext(ctx)
+		return connect.NewResponse(&sqlrpcv1.QueryResponse{}), nil
+	})
+	call := func(cert *x509.Certificate, authHeader, procedure, sql string) error {
+		seen = nil
+		req := &mockRequest{AnyRequest: connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "app", Sql: sql}), spec: connect.Spec{Procedure: procedure}}
+		if authHeader != "" {
+			req.Header().Set("Authorization", authHeader)
+		}
+		callCtx := ctx
+		if cert != nil {
+			callCtx = context.WithValue(ctx, clientCertKey{}, cert)
+		}
+		_, err := unary(callCtx, req)
+		return err
+	}
+
+	t.Run("certificate maps to a user", func(t *testing.T) {
+		cert := testClientCert("ignored", "unknown.internal", "reporting")
+		require.NoError(t, call(cert, "", "/sqlrpc.v1.DatabaseService/Query", "SELECT 1"))
+		require.NotNil(t, seen)
+		assert.Equal(t, "reporting", seen.Username)
+		assert.Equal(t, sqlrpcv1.Role_ROLE_READ_ONLY, seen.Role)
+		assert.Equal(t, cert.NotAfter, seen.ExpiresAt)
+
+		err := call(cert, "", "/sqlrpc.v1.DatabaseService/Exec", "DELETE FROM t")
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err), "the user's role applies")
+	})
+
+	t.Run("unknown identity", func(t *testing.T) {
+		err := call(testClientCert("reporting", "nobody.internal"), "", "/sqlrpc.v1.DatabaseService/Query", "SELECT 1")
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("authorization header takes precedence", func(t *testing.T) {
+		basic := "Basic " + base64.StdEncoding.EncodeToString([]byte("testuser:"+password))
+		require.NoError(t, call(testClientCert("ignored", "reporting"), basic, "/sqlrpc.v1.DatabaseService/Query", "SELECT 1"))
+		assert.Equal(t, "testuser", seen.Username)
+	})
+
+	t.Run("no credentials", func(t *testing.T) {
+		err := call(nil, "", "/sqlrpc.v1.DatabaseService/Query", "SELECT 1")
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+}
@@ -0,0 +1,154 @@
+package servicesv1
+
+import (
+	"context"
+	"errors"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"sqlite-server/internal/auth"
+	"sqlite-server/internal/logging"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+	"sqlite-server/internal/sqldrivers"
+)
+
+// ReloadColumnPolicies hands the column policies in the store to the SQLite
+// authorizer of every database. It is called at startup and after every policy
+// change. Without a store there are none.
+func (s *DbServer) ReloadColumnPolicies(ctx context.Context) error {
+	if s.store == nil {
+		return nil
+	}
+	list, err := s.store.ListColumnPolicies(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	rules := make(map[string][]sqldrivers.ColumnRule)
+	for _, p := range list {
+		rule := sqldrivers.ColumnRule{
+			Table:  p.Table,
+			Column: p.Column,
+			Mask:   p.Action == sqlrpcv1.ColumnAction_COLUMN_ACTION_MASK,
+			User:   p.Username,
+		}
+		if p.Role != sqlrpcv1.Role_ROLE_UNSPECIFIED {
+			rule.Role = sessionRole(p.Role)
+		}
+		rules[p.Database] = append(rules[p.Database], rule)
+	}
+
+	s.policyMu.Lock()
+	defer s.policyMu.Unlock()
+	for database := range s.columnDatabases {
+		if _, ok := rules[database]; !ok {
+			sqldrivers.SetColumnRules(database, nil)
+		}
+	}
+	s.columnDatabases = make(map[string]struct{}, len(rules))
+	for database, r := range rules {
+		sqldrivers.SetColumnRules(database, r)
+		s.columnDatabases[database] = struct{}{}
+	}
+	return nil
+}
+
+// SetColumnPolicy denies or masks reads of a column for a role or a user. Setting
+// the same column and subject again replaces the action.
+func (s *AdminServer) SetColumnPolicy(ctx context.Context, req *connect.Request[sqlrpcv1.SetColumnPolicyRequest]) (*connect.Response[sqlrpcv1.SetColumnPolicyResponse], error) {
+	if s.authDisabled {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("column policies are disabled when running in no-auth mode"))
+	}
+	if err := protovalidate.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	msg := req.Msg
+
+	policy, err := s.store.SetColumnPolicy(ctx, auth.ColumnPolicy{
+		Database: msg.Database,
+		Table:    msg.Table,
+		Column:   msg.Column,
+		Action:   msg.Action,
+		Role:     msg.GetRole(),
+		Username: msg.GetUsername(),
+	})
+	if err != nil {
+		return nil, grantError(err)
+	}
+	if err := s.dbServer.ReloadColumnPolicies(ctx); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	by := "an administrator"
+	if claims, ok := auth.FromContext(ctx); ok {
+		by = claims.Username
+	}
+	rpcLog.InfoContext(ctx, "Column policy set", logging.KeyDatabase, msg.Database, "table", msg.Table, "column", msg.Column, "action", msg.Action.String(), "by", by)
+
+	return connect.NewResponse(&sqlrpcv1.SetColumnPolicyResponse{
+		Policy: toProtoColumnPolicy(policy),
+	}), nil
+}
+
+// DeleteColumnPolicy removes a column policy
+func (s *AdminServer) DeleteColumnPolicy(ctx context.Context, req *connect.Request[sqlrpcv1.DeleteColumnPolicyRequest]) (*connect.Response[sqlrpcv1.DeleteColumnPolicyResponse], error) {
+	if s.authDisabled {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("column policies are disabled when running in no-auth mode"))
+	}
+	if err := protovalidate.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.store.DeleteColumnPolicy(ctx, req.Msg.PolicyId); err != nil {
+		return nil, grantError(err)
+	}
+	if err := s.dbServer.ReloadColumnPolicies(ctx); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	by := "an administrator"
+	if claims, ok := auth.FromContext(ctx); ok {
+		by = claims.Username
+	}
+	rpcLog.InfoContext(ctx, "Column policy deleted", "policy_id", req.Msg.PolicyId, "by", by)
+
+	return connect.NewResponse(&sqlrpcv1.DeleteColumnPolicyResponse{
+		Success: true,
+	}), nil
+}
+
+// ListColumnPolicies returns the column policies, optionally of one database
+func (s *AdminServer) ListColumnPolicies(ctx context.Context, req *connect.Request[sqlrpcv1.ListColumnPoliciesRequest]) (*connect.Response[sqlrpcv1.ListColumnPoliciesResponse], error) {
+	if s.authDisabled {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("column policies are disabled when running in no-auth mode"))
+	}
+
+	policies, err := s.store.ListColumnPolicies(ctx, req.Msg.Database)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	pbPolicies := make([]*sqlrpcv1.ColumnPolicy, len(policies))
+	for i := range policies {
+		pbPolicies[i] = toProtoColumnPolicy(&policies[i])
+	}
+
+	return connect.NewResponse(&sqlrpcv1.ListColumnPoliciesResponse{
+		Policies: pbPolicies,
+	}), nil
+}
+
+func toProtoColumnPolicy(p *auth.ColumnPolicy) *sqlrpcv1.ColumnPolicy {
+	return &sqlrpcv1.ColumnPolicy{
+		Id:        p.ID,
+		Database:  p.Database,
+		Table:     p.Table,
+		Column:    p.Column,
+		Action:    p.Action,
+		Role:      p.Role,
+		Username:  p.Username,
+		CreatedAt: timestamppb.New(p.CreatedAt),
+	}
+}
@@ -0,0 +1,147 @@
+package servicesv1
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+	"sqlite-server/internal/sqldrivers"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestColumnPolicies(t *testing.T) {
+	admin, _, server := setupAdminTestServer(t)
+	require.NoError(t, server.MountDatabase(&sqlrpcv1.DatabaseConfig{Name: "crm", DbPath: filepath.Join(t.TempDir(), "crm.db")}))
+	t.Cleanup(func() { sqldrivers.SetColumnRules("crm", nil) })
+
+	adminCtx := adminContext(sqlrpcv1.Role_ROLE_ADMIN)
+	reader := userContext(2, "reader", sqlrpcv1.Role_ROLE_READ_ONLY)
+	writer := userContext(3, "writer", sqlrpcv1.Role_ROLE_READ_WRITE)
+
+	query := func(ctx context.Context, sql string) ([]*structpb.ListValue, error) {
+		resp, err := server.Query(ctx, connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "crm", Sql: sql}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Rows, nil
+	}
+	_, err := server.Exec(adminCtx, connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "crm", Sql: `
+		CREATE TABLE customers (id INTEGER PRIMARY KEY, name TEXT, email TEXT, card TEXT);
+		INSERT INTO customers (name, email, card) VALUES ('alice', 'alice@example.com', '4111')`}))
+	require.NoError(t, err)
+
+	set := func(req *sqlrpcv1.SetColumnPolicyRequest) *sqlrpcv1.ColumnPolicy {
+		t.Helper()
+		resp, err := admin.SetColumnPolicy(adminCtx, connect.NewRequest(req))
+		require.NoError(t, err)
+		return resp.Msg.Policy
+	}
+	deny := set(&sqlrpcv1.SetColumnPolicyRequest{
+		Database: "crm", Table: "customers", Column: "card", Action: sqlrpcv1.ColumnAction_COLUMN_ACTION_DENY,
+		Subject: &sqlrpcv1.SetColumnPolicyRequest_Role{Role: sqlrpcv1.Role_ROLE_READ_ONLY},
+	})
+	set(&sqlrpcv1.SetColumnPolicyRequest{
+		Database: "crm", Table: "customers", Column: "email", Action: sqlrpcv1.ColumnAction_COLUMN_ACTION_MASK,
+		Subject: &sqlrpcv1.SetColumnPolicyRequest_Username{Username: "writer"},
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		_, err := admin.SetColumnPolicy(adminCtx, connect.NewRequest(&sqlrpcv1.SetColumnPolicyRequest{
+			Database: "crm", Table: "customers", Column: "card", Action: sqlrpcv1.ColumnAction_COLUMN_ACTION_DENY,
+		}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "a subject is required")
+		_, err = admin.SetColumnPolicy(adminCtx, connect.NewRequest(&sqlrpcv1.SetColumnPolicyRequest{
+			Database: "crm", Table: "customers", Column: "card",
+			Subject: &sqlrpcv1.SetColumnPolicyRequest_Username{Username: "writer"},
+		}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "an action is required")
+	})
+
+	t.Run("deny", func(t *testing.T) {
+		_, err := query(reader, "SELECT card FROM customers")
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+		_, err = query(reader, "SELECT * FROM customers")
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		rows, err := query(reader, "SELECT name, email FROM customers")
+		require.NoError(t, err)
+		assert.Equal(t, "alice@example.com", rows[0].Values[1].GetStringValue())
+
+		rows, err = query(adminCtx, "SELECT card FROM customers")
+		require.NoError(t, err)
+		assert.Equal(t, "4111", rows[0].Values[0].GetStringValue(), "admins are not restricted")
+	})
+
+	t.Run("mask", func(t *testing.T) {
+		rows, err := query(writer, "SELECT name, email, card FROM customers")
+		require.NoError(t, err)
+		assert.Equal(t, "alice", rows[0].Values[0].GetStringValue())
+		assert.IsType(t, &structpb.Value_NullValue{}, rows[0].Values[1].Kind)
+		assert.Equal(t, "4111", rows[0].Values[2].GetStringValue())
+
+		typed, err := server.TypedQuery(writer, connect.NewRequest(&sqlrpcv1.TypedQueryRequest{Database: "crm", Sql: "SELECT email FROM customers"}))
+		require.NoError(t, err)
+		assert.True(t, typed.Msg.Rows[0].Values[0].GetNullValue())
+	})
+
+	t.Run("transactions", func(t *testing.T) {
+		begin, err := server.BeginTransaction(writer, connect.NewRequest(&sqlrpcv1.BeginTransactionRequest{Database: "crm"}))
+		require.NoError(t, err)
+		txID := begin.Msg.TransactionId
+		defer func() {
+			_, _ = server.RollbackTransaction(writer, connect.NewRequest(&sqlrpcv1.TransactionControlRequest{TransactionId: txID}))
+		}()
+
+		resp, err := server.TransactionQuery(writer, connect.NewRequest(&sqlrpcv1.TransactionQueryRequest{
+			TransactionId: txID, Sql: "SELECT email FROM customers",
+		}))
+		require.NoError(t, err)
+		assert.IsType(t, &structpb.Value_NullValue{}, resp.Msg.Rows[0].Values[0].Kind)
+	})
+
+	t.Run("file copies are refused", func(t *testing.T) {
+		_, err := server.BackupDatabase(writer, connect.NewRequest(&sqlrpcv1.BackupDatabaseRequest{Database: "crm"}))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("attachments", func(t *testing.T) {
+		frontPath := filepath.Join(t.TempDir(), "front.db")
+		require.NoError(t, server.MountDatabase(&sqlrpcv1.DatabaseConfig{Name: "front", DbPath: frontPath}))
+		require.NoError(t, server.store.UpsertDatabaseConfig(adminCtx, "front", frontPath, false, "{}"))
+		_, err := server.AttachDatabase(reader, connect.NewRequest(&sqlrpcv1.AttachDatabaseRequest{
+			ParentDatabase: "front",
+			Attachment:     &sqlrpcv1.Attachment{TargetDatabaseName: "crm", Alias: "c"},
+		}))
+		require.NoError(t, err)
+
+		front := func(ctx context.Context, sql string) ([]*structpb.ListValue, error) {
+			resp, err := server.Query(ctx, connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "front", Sql: sql}))
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg.Rows, nil
+		}
+		_, err = front(reader, "SELECT card FROM c.customers")
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err), "the policies of the attached database apply")
+		rows, err := front(writer, "SELECT email FROM customers")
+		require.NoError(t, err)
+		assert.IsType(t, &structpb.Value_NullValue{}, rows[0].Values[0].Kind)
+	})
+
+	listed, err := admin.ListColumnPolicies(adminCtx, connect.NewRequest(&sqlrpcv1.ListColumnPoliciesRequest{Database: "crm"}))
+	require.NoError(t, err)
+	assert.Len(t, listed.Msg.Policies, 2)
+
+	_, err = admin.DeleteColumnPolicy(adminCtx, connect.NewRequest(&sqlrpcv1.DeleteColumnPolicyRequest{PolicyId: deny.Id}))
+	require.NoError(t, err)
+	_, err = query(reader, "SELECT card FROM customers")
+	assert.NoError(t, err, "deleted policies stop applying")
+
+	_, err = admin.DeleteColumnPolicy(adminCtx, connect.NewRequest(&sqlrpcv1.DeleteColumnPolicyRequest{PolicyId: deny.Id}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
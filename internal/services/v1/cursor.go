@@ -0,0 +1,587 @@
+package servicesv1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"sqlite-server/internal/auth"
+	"sqlite-server/internal/logging"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+	"sqlite-server/internal/sqlclass"
+)
+
+// ===================================================================================
+// Server-Side Cursors
+// ===================================================================================
+
+// cursorBatchSize is the number of rows a fetch returns when the caller sets no limit,
+// and the batch size of resumable QueryStreams.
+const cursorBatchSize = 500
+
+// errCursorClosed is returned by fetches on a cursor closed while they waited for it.
+var errCursorClosed = errors.New("cursor id not found or expired")
+
+// maxCursors caps the cursors open on the server and maxCursorsPerUser those one
+// user can hold. Every cursor keeps a connection and a read transaction.
+var (
+	maxCursors        = 256
+	maxCursorsPerUser = 16
+)
+
+// cursorSession is an open query result held in the server's memory.
+//
+// The query runs in a read transaction that stays open with the cursor, so every
+// fetch reads the snapshot the cursor was opened on, however the database changes
+// in between.
+type cursorSession struct {
+	database string
+	sql      string
+	owner    string // Username of the caller who opened it; only they can use it
+
+	// expiry and timeout are guarded by DbServer.cursorMu. Every lookup extends
+	// the expiry by timeout.
+	expiry  time.Time
+	timeout time.Duration
+
+	// header describes the columns of the result.
+	header *sqlrpcv1.QueryResultHeader
+
+	// ctx is the statement context the transaction and its rows run under, and
+	// done unregisters it. It has no time limit: each call reading from the cursor
+	// bounds its own work through interruptOn.
+	ctx  context.Context
+	done func()
+
+	// mu serializes fetches and guards everything below.
+	mu       sync.Mutex
+	tx       *sql.Tx
+	q        querier // tx with the row policies of the owner applied
+	params   []any
+	rows     *sql.Rows
+	position int64 // rows fetched so far
+	closed   bool
+}
+
+// cursorOwner is the user cursors opened under ctx belong to.
+func cursorOwner(ctx context.Context) string {
+	if claims, ok := auth.FromContext(ctx); ok {
+		return claims.Username
+	}
+	return ""
+}
+
+// checkCursorLimits refuses a new cursor for owner once the server or the owner
+// holds as many as allowed. Without authentication only the server limit applies.
+// The caller must hold cursorMu.
+func (s *DbServer) checkCursorLimits(owner string) error {
+	if len(s.cursors) >= maxCursors {
+		return connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("too many open cursors (max %d)", maxCursors))
+	}
+	if owner == "" {
+		return nil
+	}
+	n := 0
+	for _, c := range s.cursors {
+		if c.owner == owner {
+			n++
+		}
+	}
+	if n >= maxCursorsPerUser {
+		return connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("too many open cursors for user (max %d)", maxCursorsPerUser))
+	}
+	return nil
+}
+
+// openCursor runs sqlQuery in a new read transaction and registers the cursor over
+// its result. Only queries that return rows and change nothing are accepted.
+func (s *DbServer) openCursor(ctx context.Context, reqID, database, sqlQuery string, paramsMsg *sqlrpcv1.Parameters, timeout time.Duration, requestedMs int32) (string, *cursorSession, error) {
+	if !IsReader(sqlQuery) || !sqlclass.Classify(sqlQuery).ReadOnly() {
+		return "", nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cursors only run read-only queries"))
+	}
+	params, err := convertParameters(sqlQuery, paramsMsg)
+	if err != nil {
+		return "", nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parameter error: %w", err))
+	}
+
+	owner := cursorOwner(ctx)
+	s.cursorMu.Lock()
+	err = s.checkCursorLimits(owner)
+	s.cursorMu.Unlock()
+	if err != nil {
+		return "", nil, err
+	}
+
+	db, err := s.dbManager.GetConnection(ctx, database, s.statementMode(ctx, database, sqlQuery))
+	if err != nil {
+		return "", nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	// The transaction and its rows must survive the end of this request, so they
+	// run under its values but not its cancellation. They are registered as a
+	// statement of the request, which CancelQuery closes. Otherwise they are
+	// released by CloseCursor, the last fetch or the Background Reaper.
+	cursorCtx, done := s.registerStatement(context.WithoutCancel(ctx), reqID, database, sqlQuery)
+	// Until it is open, a caller who gives up (e.g. waiting for a connection) or
+	// runs out of execution time abandons the cursor. Fetches are limited on
+	// their own, and idle time only by the cursor's timeout.
+	openCtx, stop := s.limitStatement(ctx, database, requestedMs)
+	defer stop()
+	defer context.AfterFunc(openCtx, func() { cancelStatement(cursorCtx, context.Cause(openCtx)) })()
+	tx, err := db.BeginTx(cursorCtx, nil)
+	if err != nil {
+		done()
+		return "", nil, makeUnaryError(statementError(cursorCtx, err), "BEGIN")
+	}
+	if err := s.bindSession(cursorCtx, tx, database); err != nil {
+		_ = tx.Rollback()
+		done()
+		return "", nil, makeUnaryError(statementError(cursorCtx, err), "BEGIN")
+	}
+
+	c := &cursorSession{
+		database: database,
+		sql:      sqlQuery,
+		owner:    owner,
+		timeout:  timeout,
+		ctx:      cursorCtx,
+		done:     done,
+		tx:       tx,
+		q:        s.restrict(ctx, database, tx),
+		params:   params,
+	}
+
+	// The first read pins the snapshot of the transaction.
+	c.rows, err = c.q.QueryContext(cursorCtx, sqlQuery, params...)
+	if err == nil {
+		c.header, err = cursorHeader(c.rows)
+	}
+	if err != nil {
+		c.close()
+		return "", nil, makeUnaryError(statementError(cursorCtx, err), sqlQuery)
+	}
+
+	id := genRequestID()
+	s.cursorMu.Lock()
+	if err := s.checkCursorLimits(owner); err != nil {
+		s.cursorMu.Unlock()
+		c.close()
+		return "", nil, err
+	}
+	c.expiry = time.Now().Add(timeout)
+	s.cursors[id] = c
+	s.cursorMu.Unlock()
+
+	// A cursor that ran out of time or was cancelled can only fail, so it goes
+	// from the registry and from ListActiveOperations at once.
+	context.AfterFunc(cursorCtx, func() { s.closeCursor(id) })
+
+	rpcLog.DebugContext(ctx, "Opened cursor", "cursor_id", id, "timeout", timeout)
+	return id, c, nil
+}
+
+// cursorHeader describes the columns of rows.
+func cursorHeader(rows *sql.Rows) (*sqlrpcv1.QueryResultHeader, error) {
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	affinities, declaredTypes, rawTypes, err := resolveColumnTypes(rows)
+	if err != nil {
+		return nil, err
+	}
+	return &sqlrpcv1.QueryResultHeader{
+		Columns:             cols,
+		ColumnAffinities:    affinities,
+		ColumnDeclaredTypes: declaredTypes,
+		ColumnRawTypes:      rawTypes,
+	}, nil
+}
+
+// cursor looks up an open cursor of the caller and extends its life. Expired
+// cursors are closed on the spot, even if the reaper has not got to them yet.
+func (s *DbServer) cursor(ctx context.Context, id string) (*cursorSession, time.Time, error) {
+	s.cursorMu.Lock()
+	c, ok := s.cursors[id]
+	if !ok {
+		s.cursorMu.Unlock()
+		return nil, time.Time{}, connect.NewError(connect.CodeNotFound, errCursorClosed)
+	}
+	// The result was read under the row and column policies of its owner
+	if c.owner != cursorOwner(ctx) {
+		s.cursorMu.Unlock()
+		return nil, time.Time{}, connect.NewError(connect.CodePermissionDenied, errors.New("cursor belongs to another user"))
+	}
+	if time.Now().After(c.expiry) {
+		delete(s.cursors, id)
+		s.cursorMu.Unlock()
+		c.close()
+		return nil, time.Time{}, connect.NewError(connect.CodeAborted, errors.New("cursor timed out"))
+	}
+	c.expiry = time.Now().Add(c.timeout)
+	expiry := c.expiry
+	s.cursorMu.Unlock()
+	return c, expiry, nil
+}
+
+// closeCursor removes a cursor from the registry and closes it. It reports whether
+// the cursor was open.
+func (s *DbServer) closeCursor(id string) bool {
+	s.cursorMu.Lock()
+	c, ok := s.cursors[id]
+	delete(s.cursors, id)
+	s.cursorMu.Unlock()
+	if ok {
+		c.close()
+	}
+	return ok
+}
+
+// CursorDatabase returns the database a cursor was opened on. It implements
+// CursorResolver so the AuthInterceptor can enforce per-database grants on calls
+// that only carry a cursor ID.
+func (s *DbServer) CursorDatabase(id string) (string, bool) {
+	s.cursorMu.Lock()
+	defer s.cursorMu.Unlock()
+	c, ok := s.cursors[id]
+	if !ok {
+		return "", false
+	}
+	return c.database, true
+}
+
+// cleanupExpiredCursors closes the cursors that have not been fetched from within
+// their timeout.
+func (s *DbServer) cleanupExpiredCursors() {
+	var toClose []*cursorSession
+
+	s.cursorMu.Lock()
+	now := time.Now()
+	for id, c := range s.cursors {
+		if now.After(c.expiry) {
+			rpcLog.Debug("Closing expired cursor", "cursor_id", id, logging.KeyDatabase, c.database)
+			toClose = append(toClose, c)
+			delete(s.cursors, id)
+		}
+	}
+	s.cursorMu.Unlock()
+
+	// A cursor being fetched from is closed once the fetch is done
+	for _, c := range toClose {
+		c.close()
+	}
+}
+
+// fetch reads up to n rows from the cursor. done is set once the result is
+// exhausted. The cursor must be closed after done or an error.
+func (c *cursorSession) fetch(n int) (rows []*structpb.ListValue, position int64, done bool, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil, 0, false, errCursorClosed
+	}
+	defer func() { err = statementError(c.ctx, err) }()
+
+	buf := getScanBuffer(len(c.header.Columns))
+	defer putbackBuffer(buf)
+
+	rows = make([]*structpb.ListValue, 0, min(n, cursorBatchSize))
+	for len(rows) < n {
+		if !c.rows.Next() {
+			done = true
+			err = c.rows.Err()
+			break
+		}
+		if err = c.rows.Scan(buf.args...); err != nil {
+			break
+		}
+		rows = append(rows, valuesToProto(buf.values, c.header.ColumnAffinities))
+	}
+	c.position += int64(len(rows))
+	if counter := streamedRows(c.ctx); counter != nil {
+		counter.Add(int64(len(rows)))
+	}
+	return rows, c.position, done, err
+}
+
+// seek moves the cursor back to position, which must not be past the rows already
+// fetched. Rows cannot be read twice, so the query is run again in the same
+// transaction, which reads the same snapshot, and the first position rows skipped.
+func (c *cursorSession) seek(position int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return errCursorClosed
+	}
+	if position == c.position {
+		return nil
+	}
+	if position < 0 || position > c.position {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("resume token is past the rows sent"))
+	}
+
+	_ = c.rows.Close()
+	rows, err := c.q.QueryContext(c.ctx, c.sql, c.params...)
+	if err != nil {
+		return statementError(c.ctx, err)
+	}
+	c.rows = rows
+	for c.position = 0; c.position < position; c.position++ {
+		if !rows.Next() {
+			if err := rows.Err(); err != nil {
+				return statementError(c.ctx, err)
+			}
+			return connect.NewError(connect.CodeDataLoss, errors.New("result changed since the resume token was issued"))
+		}
+	}
+	return nil
+}
+
+// close ends the read transaction of the cursor. It waits for a running fetch.
+func (c *cursorSession) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return
+	}
+	c.closed = true
+	if c.rows != nil {
+		_ = c.rows.Close()
+	}
+	_ = c.tx.Rollback()
+	c.done()
+}
+
+// interruptOn closes the cursor's statement when ctx, the statement context of a
+// call reading from it, is interrupted by its execution limit or CancelQuery, so a
+// fetch in progress stops at once. A call that ends because parent, its request,
+// ended leaves the cursor alone. The returned function stops watching ctx.
+func (c *cursorSession) interruptOn(parent, ctx context.Context) func() bool {
+	return context.AfterFunc(ctx, func() {
+		if parent.Err() == nil {
+			cancelStatement(c.ctx, context.Cause(ctx))
+		}
+	})
+}
+
+// OpenCursor handles the unary `OpenCursor` RPC. It runs a read-only query and
+// keeps its result open for FetchCursor.
+func (s *DbServer) OpenCursor(ctx context.Context, req *connect.Request[sqlrpcv1.OpenCursorRequest]) (*connect.Response[sqlrpcv1.OpenCursorResponse], error) {
+	msg := req.Msg
+	if err := protovalidate.Validate(msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := ValidateStatelessQuery(msg.Sql); err != nil {
+		return nil, err
+	}
+
+	timeout := defaultTxTimeout
+	if msg.Timeout != nil {
+		if d := msg.Timeout.AsDuration(); d > 0 {
+			timeout = d
+		}
+	}
+
+	id, c, err := s.openCursor(ctx, ensureRequestID(req.Header()), msg.Database, msg.Sql, msg.Parameters, timeout, 0)
+	if err != nil {
+		rpcLog.WarnContext(ctx, "OpenCursor failed", logging.Err(err))
+		return nil, err
+	}
+	return connect.NewResponse(&sqlrpcv1.OpenCursorResponse{
+		CursorId:  id,
+		Header:    c.header,
+		ExpiresAt: timestamppb.New(time.Now().Add(timeout)),
+	}), nil
+}
+
+// FetchCursor handles the unary `FetchCursor` RPC. Every fetch extends the life of
+// the cursor by its timeout; the one that exhausts the result closes it. Each fetch
+// may run for the execution limit of the database, and one that runs out of time
+// closes the cursor.
+func (s *DbServer) FetchCursor(ctx context.Context, req *connect.Request[sqlrpcv1.FetchCursorRequest]) (*connect.Response[sqlrpcv1.FetchCursorResponse], error) {
+	msg := req.Msg
+	if err := protovalidate.Validate(msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	c, expiry, err := s.cursor(ctx, msg.CursorId)
+	if err != nil {
+		return nil, err
+	}
+	n := int(msg.MaxRows)
+	if n == 0 {
+		n = cursorBatchSize
+	}
+
+	fetchCtx, stop := s.limitStatement(ctx, c.database, 0)
+	defer stop()
+	defer c.interruptOn(ctx, fetchCtx)()
+	rows, position, done, err := c.fetch(n)
+	if errors.Is(err, errCursorClosed) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if err != nil || done {
+		s.closeCursor(msg.CursorId)
+	}
+	if err != nil {
+		rpcLog.WarnContext(ctx, "FetchCursor failed", logging.Err(err))
+		return nil, makeUnaryError(err, c.sql)
+	}
+
+	return connect.NewResponse(&sqlrpcv1.FetchCursorResponse{
+		Rows:      rows,
+		Done:      done,
+		Position:  position,
+		ExpiresAt: timestamppb.New(expiry),
+	}), nil
+}
+
+// CloseCursor handles the unary `CloseCursor` RPC.
+func (s *DbServer) CloseCursor(ctx context.Context, req *connect.Request[sqlrpcv1.CloseCursorRequest]) (*connect.Response[sqlrpcv1.CloseCursorResponse], error) {
+	if err := protovalidate.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if _, _, err := s.cursor(ctx, req.Msg.CursorId); err != nil {
+		return nil, err
+	}
+	if !s.closeCursor(req.Msg.CursorId) {
+		return nil, connect.NewError(connect.CodeNotFound, errCursorClosed)
+	}
+	return connect.NewResponse(&sqlrpcv1.CloseCursorResponse{}), nil
+}
+
+// ===================================================================================
+// Resumable QueryStream
+// ===================================================================================
+
+// resumeToken identifies the position of a cursor after a batch.
+func resumeToken(id string, position int64) string {
+	return id + ":" + strconv.FormatInt(position, 10)
+}
+
+// parseResumeToken splits a token made by resumeToken.
+func parseResumeToken(token string) (string, int64, error) {
+	id, pos, ok := strings.Cut(token, ":")
+	position, err := strconv.ParseInt(pos, 10, 64)
+	if !ok || id == "" || err != nil {
+		return "", 0, connect.NewError(connect.CodeInvalidArgument, errors.New("malformed resume token"))
+	}
+	return id, position, nil
+}
+
+// resumableQueryStream serves a QueryStream through a cursor, so that a client whose
+// stream broke off can continue after the last batch it received. Each batch carries
+// the token to resume after it. The cursor is closed when the stream ends, unless
+// the client went away: then it is left for a resume, or to be reaped like any
+// other if the client does not come back.
+func (s *DbServer) resumableQueryStream(ctx context.Context, reqID string, msg *sqlrpcv1.QueryRequest, stream *connect.ServerStream[sqlrpcv1.QueryResponse]) error {
+	// The execution limit applies to this call, not to the life of the cursor
+	parent := ctx
+	ctx, done := s.startStatement(ctx, reqID, msg.Database, msg.Sql, msg.MaxExecutionTimeMs)
+	defer done()
+
+	var (
+		id  string
+		c   *cursorSession
+		err error
+	)
+	if msg.ResumeToken != "" {
+		var position int64
+		if id, position, err = parseResumeToken(msg.ResumeToken); err != nil {
+			return err
+		}
+		if c, _, err = s.cursor(ctx, id); err != nil {
+			return err
+		}
+		// The same query with other parameters would resume someone else's result
+		params, err := convertParameters(msg.Sql, msg.Parameters)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parameter error: %w", err))
+		}
+		if c.database != msg.Database || c.sql != msg.Sql || !reflect.DeepEqual(c.params, params) {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("resume token belongs to a different query"))
+		}
+		defer c.interruptOn(parent, ctx)()
+		if err = c.seek(position); errors.Is(err, errCursorClosed) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		if err != nil {
+			s.closeCursor(id)
+			if connect.CodeOf(err) == connect.CodeUnknown {
+				err = makeUnaryError(err, msg.Sql)
+			}
+			return err
+		}
+	} else {
+		if id, c, err = s.openCursor(ctx, reqID, msg.Database, msg.Sql, msg.Parameters, defaultTxTimeout, msg.MaxExecutionTimeMs); err != nil {
+			return err
+		}
+		defer c.interruptOn(parent, ctx)()
+	}
+
+	resumable := false
+	defer func() {
+		if !resumable {
+			s.closeCursor(id)
+		}
+	}()
+
+	// A failed send means the client is gone and may come back
+	writer := &statelessStreamWriter{stream: stream, rows: streamedRows(ctx)}
+	if err := writer.SendHeader(c.header); err != nil {
+		resumable = true
+		return err
+	}
+
+	startTime := time.Now()
+	var rowsRead int64
+	for {
+		if err := ctx.Err(); err != nil {
+			resumable = parent.Err() != nil
+			return stream.Send(&sqlrpcv1.QueryResponse{
+				Response: &sqlrpcv1.QueryResponse_Error{Error: makeStreamError(statementError(ctx, err), msg.Sql)},
+			})
+		}
+		// Every batch keeps the cursor alive
+		if _, _, err := s.cursor(ctx, id); err != nil {
+			return err
+		}
+
+		rows, position, exhausted, err := c.fetch(cursorBatchSize)
+		if errors.Is(err, errCursorClosed) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		if err != nil {
+			rpcLog.WarnContext(ctx, "Stream failed", logging.Err(err))
+			return stream.Send(&sqlrpcv1.QueryResponse{
+				Response: &sqlrpcv1.QueryResponse_Error{Error: makeStreamError(err, msg.Sql)},
+			})
+		}
+		if len(rows) > 0 {
+			rowsRead += int64(len(rows))
+			if err := writer.SendRowBatch(&sqlrpcv1.QueryResultRowBatch{Rows: rows, ResumeToken: resumeToken(id, position)}); err != nil {
+				resumable = true
+				return err
+			}
+		}
+		if exhausted {
+			break
+		}
+	}
+
+	return writer.SendComplete(&sqlrpcv1.ExecutionStats{
+		DurationMs: float64(time.Since(startTime).Milliseconds()),
+		RowsRead:   rowsRead,
+	})
+}
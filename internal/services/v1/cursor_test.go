@@ -0,0 +1,382 @@
+package servicesv1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+)
+
+// seedNumbers creates the table nums holding the integers 1 to n.
+func seedNumbers(t *testing.T, server *DbServer, n int) {
+	db, err := server.dbManager.GetConnection(context.Background(), "test", ModeRW)
+	require.NoError(t, err)
+	_, err = db.Exec(`
+		CREATE TABLE nums (n INTEGER PRIMARY KEY);
+		WITH RECURSIVE c(x) AS (SELECT 1 UNION ALL SELECT x + 1 FROM c WHERE x < ?)
+		INSERT INTO nums SELECT x FROM c;
+	`, n)
+	require.NoError(t, err)
+}
+
+// openCursors returns the number of registered cursors.
+func openCursors(server *DbServer) int {
+	server.cursorMu.Lock()
+	defer server.cursorMu.Unlock()
+	return len(server.cursors)
+}
+
+// receiveStream reads a QueryStream to its end and returns the numbers in its first
+// column and the resume tokens of its batches.
+func receiveStream(t *testing.T, stream *connect.ServerStreamForClient[sqlrpcv1.QueryResponse]) ([]int64, []string) {
+	var (
+		numbers  []int64
+		tokens   []string
+		complete bool
+	)
+	for stream.Receive() {
+		msg := stream.Msg()
+		require.Nil(t, msg.GetError())
+		if batch := msg.GetBatch(); batch != nil {
+			for _, row := range batch.Rows {
+				numbers = append(numbers, int64(row.Values[0].GetNumberValue()))
+			}
+			tokens = append(tokens, batch.ResumeToken)
+		}
+		complete = complete || msg.GetComplete() != nil
+	}
+	require.NoError(t, stream.Err())
+	assert.True(t, complete)
+	return numbers, tokens
+}
+
+func TestCursor(t *testing.T) {
+	client, server := setupTestServer(t)
+	ctx := context.Background()
+
+	open, err := client.OpenCursor(ctx, connect.NewRequest(&sqlrpcv1.OpenCursorRequest{
+		Database: "test",
+		Sql:      "SELECT id, name FROM users ORDER BY id",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id", "name"}, open.Msg.Header.Columns)
+	assert.True(t, open.Msg.ExpiresAt.AsTime().After(time.Now()))
+	id := open.Msg.CursorId
+
+	first, err := client.FetchCursor(ctx, connect.NewRequest(&sqlrpcv1.FetchCursorRequest{CursorId: id, MaxRows: 1}))
+	require.NoError(t, err)
+	require.Len(t, first.Msg.Rows, 1)
+	assert.Equal(t, "Alice", first.Msg.Rows[0].Values[1].GetStringValue())
+	assert.Equal(t, int64(1), first.Msg.Position)
+	assert.False(t, first.Msg.Done)
+
+	// Rows written after the cursor was opened are not in its snapshot
+	db, err := server.dbManager.GetConnection(ctx, "test", ModeRW)
+	require.NoError(t, err)
+	_, err = db.Exec("INSERT INTO users (id, name) VALUES (3, 'Carol')")
+	require.NoError(t, err)
+
+	rest, err := client.FetchCursor(ctx, connect.NewRequest(&sqlrpcv1.FetchCursorRequest{CursorId: id}))
+	require.NoError(t, err)
+	require.Len(t, rest.Msg.Rows, 1)
+	assert.Equal(t, "Bob", rest.Msg.Rows[0].Values[1].GetStringValue())
+	assert.Equal(t, int64(2), rest.Msg.Position)
+	assert.True(t, rest.Msg.Done)
+
+	// The exhausted cursor is closed
+	_, err = client.FetchCursor(ctx, connect.NewRequest(&sqlrpcv1.FetchCursorRequest{CursorId: id}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	assert.Zero(t, openCursors(server))
+}
+
+func TestCursor_Errors(t *testing.T) {
+	client, server := setupTestServer(t)
+	ctx := context.Background()
+
+	_, err := client.OpenCursor(ctx, connect.NewRequest(&sqlrpcv1.OpenCursorRequest{Database: "test", Sql: "DELETE FROM users"}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = client.OpenCursor(ctx, connect.NewRequest(&sqlrpcv1.OpenCursorRequest{Database: "missing", Sql: "SELECT 1"}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	_, err = client.OpenCursor(ctx, connect.NewRequest(&sqlrpcv1.OpenCursorRequest{Database: "test", Sql: "SELECT * FROM missing_table"}))
+	assert.Error(t, err)
+	assert.Zero(t, openCursors(server))
+
+	_, err = client.FetchCursor(ctx, connect.NewRequest(&sqlrpcv1.FetchCursorRequest{CursorId: "unknown"}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	open, err := client.OpenCursor(ctx, connect.NewRequest(&sqlrpcv1.OpenCursorRequest{Database: "test", Sql: "SELECT id FROM users"}))
+	require.NoError(t, err)
+	_, err = client.CloseCursor(ctx, connect.NewRequest(&sqlrpcv1.CloseCursorRequest{CursorId: open.Msg.CursorId}))
+	require.NoError(t, err)
+	_, err = client.CloseCursor(ctx, connect.NewRequest(&sqlrpcv1.CloseCursorRequest{CursorId: open.Msg.CursorId}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	_, err = client.FetchCursor(ctx, connect.NewRequest(&sqlrpcv1.FetchCursorRequest{CursorId: open.Msg.CursorId}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestCursor_Expiry(t *testing.T) {
+	client, server := setupTestServer(t)
+	ctx := context.Background()
+
+	expire := func(id string) {
+		server.cursorMu.Lock()
+		server.cursors[id].expiry = time.Now().Add(-time.Hour)
+		server.cursorMu.Unlock()
+	}
+	openCursor := func() string {
+		res, err := client.OpenCursor(ctx, connect.NewRequest(&sqlrpcv1.OpenCursorRequest{Database: "test", Sql: "SELECT id FROM users"}))
+		require.NoError(t, err)
+		return res.Msg.CursorId
+	}
+
+	// Reaped in the background
+	reaped := openCursor()
+	expire(reaped)
+	server.cleanupExpiredCursors()
+	_, ok := server.CursorDatabase(reaped)
+	assert.False(t, ok)
+
+	// Closed on its next fetch before the reaper gets to it
+	lazy := openCursor()
+	expire(lazy)
+	_, err := client.FetchCursor(ctx, connect.NewRequest(&sqlrpcv1.FetchCursorRequest{CursorId: lazy}))
+	assert.Equal(t, connect.CodeAborted, connect.CodeOf(err))
+	assert.Zero(t, openCursors(server))
+
+	// Fetching extends its life by the requested timeout
+	res, err := client.OpenCursor(ctx, connect.NewRequest(&sqlrpcv1.OpenCursorRequest{Database: "test", Sql: "SELECT id FROM users"}))
+	require.NoError(t, err)
+	db, ok := server.CursorDatabase(res.Msg.CursorId)
+	assert.True(t, ok)
+	assert.Equal(t, "test", db)
+	fetched, err := client.FetchCursor(ctx, connect.NewRequest(&sqlrpcv1.FetchCursorRequest{CursorId: res.Msg.CursorId, MaxRows: 1}))
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(defaultTxTimeout), fetched.Msg.ExpiresAt.AsTime(), time.Second)
+}
+
+func TestQueryStream_Resumable(t *testing.T) {
+	client, server := setupTestServer(t)
+	seedNumbers(t, server, 1200)
+	ctx := context.Background()
+	const query = "SELECT n FROM nums ORDER BY n"
+
+	stream, err := client.QueryStream(ctx, connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "test", Sql: query, Resumable: true}))
+	require.NoError(t, err)
+	numbers, tokens := receiveStream(t, stream)
+	assert.Len(t, numbers, 1200)
+	require.Len(t, tokens, 3)
+	for _, token := range tokens {
+		assert.NotEmpty(t, token)
+	}
+	// The completed stream closed its cursor
+	assert.Zero(t, openCursors(server))
+
+	// Only read-only queries can be resumed
+	stream, err = client.QueryStream(ctx, connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "test", Sql: "DELETE FROM nums", Resumable: true}))
+	require.NoError(t, err)
+	assert.False(t, stream.Receive())
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(stream.Err()))
+}
+
+func TestQueryStream_Resume(t *testing.T) {
+	client, server := setupTestServer(t)
+	seedNumbers(t, server, 1200)
+	ctx := context.Background()
+	const query = "SELECT n FROM nums ORDER BY n"
+
+	// open stands in for a resumable stream that broke off after sending fetched rows
+	open := func(fetched uint32) string {
+		res, err := client.OpenCursor(ctx, connect.NewRequest(&sqlrpcv1.OpenCursorRequest{Database: "test", Sql: query}))
+		require.NoError(t, err)
+		_, err = client.FetchCursor(ctx, connect.NewRequest(&sqlrpcv1.FetchCursorRequest{CursorId: res.Msg.CursorId, MaxRows: fetched}))
+		require.NoError(t, err)
+		return res.Msg.CursorId
+	}
+	resume := func(t *testing.T, sql, token string) *connect.ServerStreamForClient[sqlrpcv1.QueryResponse] {
+		stream, err := client.QueryStream(ctx, connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "test", Sql: sql, ResumeToken: token}))
+		require.NoError(t, err)
+		return stream
+	}
+
+	t.Run("from the last batch sent", func(t *testing.T) {
+		numbers, tokens := receiveStream(t, resume(t, query, resumeToken(open(500), 500)))
+		require.Len(t, numbers, 700)
+		assert.Equal(t, int64(501), numbers[0])
+		assert.Equal(t, int64(1200), numbers[699])
+		assert.Len(t, tokens, 2)
+	})
+
+	t.Run("from an earlier batch", func(t *testing.T) {
+		numbers, _ := receiveStream(t, resume(t, query, resumeToken(open(1000), 500)))
+		require.Len(t, numbers, 700)
+		assert.Equal(t, int64(501), numbers[0])
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		id := open(500)
+		// In order: the last one closes the cursor
+		for _, tc := range []struct {
+			name, sql, token string
+			code             connect.Code
+		}{
+			{"malformed", query, "garbage", connect.CodeInvalidArgument},
+			{"unknown cursor", query, resumeToken("unknown", 0), connect.CodeNotFound},
+			{"other query", "SELECT n FROM nums", resumeToken(id, 500), connect.CodeInvalidArgument},
+			{"past the rows", query, resumeToken(id, 1000), connect.CodeInvalidArgument},
+		} {
+			stream := resume(t, tc.sql, tc.token)
+			assert.False(t, stream.Receive(), tc.name)
+			assert.Equal(t, tc.code, connect.CodeOf(stream.Err()), tc.name)
+		}
+	})
+
+	t.Run("other parameters", func(t *testing.T) {
+		const filtered = "SELECT n FROM nums WHERE n > ? ORDER BY n"
+		params := func(above float64) *sqlrpcv1.Parameters {
+			return &sqlrpcv1.Parameters{Positional: []*structpb.Value{structpb.NewNumberValue(above)}}
+		}
+		res, err := client.OpenCursor(ctx, connect.NewRequest(&sqlrpcv1.OpenCursorRequest{Database: "test", Sql: filtered, Parameters: params(1000)}))
+		require.NoError(t, err)
+		token := resumeToken(res.Msg.CursorId, 0)
+
+		for name, p := range map[string]*sqlrpcv1.Parameters{"different": params(0), "missing": nil} {
+			stream, err := client.QueryStream(ctx, connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "test", Sql: filtered, Parameters: p, ResumeToken: token}))
+			require.NoError(t, err)
+			assert.False(t, stream.Receive(), name)
+			assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(stream.Err()), name)
+		}
+
+		stream, err := client.QueryStream(ctx, connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "test", Sql: filtered, Parameters: params(1000), ResumeToken: token}))
+		require.NoError(t, err)
+		numbers, _ := receiveStream(t, stream)
+		assert.Len(t, numbers, 200)
+	})
+
+	assert.Zero(t, openCursors(server))
+}
+
+// Cursor calls only carry the cursor ID, so the interceptors resolve its database.
+func TestCursor_Database(t *testing.T) {
+	_, server := setupTestServer(t)
+	id, _, err := server.openCursor(context.Background(), "cursor-1", "test", "SELECT 1", nil, time.Minute, 0)
+	require.NoError(t, err)
+	defer server.closeCursor(id)
+
+	assert.Equal(t, []string{"test"}, messageDatabases(&sqlrpcv1.FetchCursorRequest{CursorId: id}, server))
+	assert.Nil(t, messageDatabases(&sqlrpcv1.CloseCursorRequest{CursorId: "unknown"}, server))
+	assert.Nil(t, messageDatabases(&sqlrpcv1.FetchCursorRequest{CursorId: id}, nil))
+}
+
+func TestCursor_Owner(t *testing.T) {
+	_, server := setupTestServer(t)
+	alice := userContext(2, "alice", sqlrpcv1.Role_ROLE_READ_ONLY)
+	bob := userContext(3, "bob", sqlrpcv1.Role_ROLE_READ_ONLY)
+
+	id, _, err := server.openCursor(alice, "cursor-1", "test", "SELECT id FROM users", nil, time.Minute, 0)
+	require.NoError(t, err)
+
+	_, err = server.FetchCursor(bob, connect.NewRequest(&sqlrpcv1.FetchCursorRequest{CursorId: id}))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	_, err = server.CloseCursor(bob, connect.NewRequest(&sqlrpcv1.CloseCursorRequest{CursorId: id}))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	assert.Equal(t, 1, openCursors(server))
+
+	_, err = server.FetchCursor(alice, connect.NewRequest(&sqlrpcv1.FetchCursorRequest{CursorId: id, MaxRows: 1}))
+	require.NoError(t, err)
+	_, err = server.CloseCursor(alice, connect.NewRequest(&sqlrpcv1.CloseCursorRequest{CursorId: id}))
+	require.NoError(t, err)
+}
+
+func TestCursor_Limits(t *testing.T) {
+	_, server := setupTestServer(t)
+	defer func(total, perUser int) { maxCursors, maxCursorsPerUser = total, perUser }(maxCursors, maxCursorsPerUser)
+	maxCursors, maxCursorsPerUser = 2, 1
+	config, ok := server.dbManager.GetConfig("test")
+	require.True(t, ok)
+	config.MaxOpenConns = 4
+	require.NoError(t, server.UpdateDatabase(config))
+	alice := userContext(2, "alice", sqlrpcv1.Role_ROLE_READ_ONLY)
+	bob := userContext(3, "bob", sqlrpcv1.Role_ROLE_READ_ONLY)
+	carol := userContext(4, "carol", sqlrpcv1.Role_ROLE_READ_ONLY)
+
+	open := func(ctx context.Context) error {
+		_, _, err := server.openCursor(ctx, "cursor-1", "test", "SELECT id FROM users", nil, time.Minute, 0)
+		return err
+	}
+	require.NoError(t, open(alice))
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(open(alice)), "per user")
+	require.NoError(t, open(bob))
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(open(carol)), "server wide")
+	assert.Equal(t, 2, openCursors(server))
+}
+
+// Cursors are statements of the call that opened them: they are listed with it and
+// closed by CancelQuery. The execution limit bounds each call on them, not their
+// idle time.
+func TestCursor_Statement(t *testing.T) {
+	_, server := setupTestServer(t)
+	admin := NewAdminServer(nil, server, nil, false, "v0.0.1-test")
+	ctx := adminContext(sqlrpcv1.Role_ROLE_ADMIN)
+
+	_, _, err := server.openCursor(ctx, "cursor-1", "test", "SELECT id FROM users", nil, time.Minute, 0)
+	require.NoError(t, err)
+	ops, err := admin.ListActiveOperations(ctx, connect.NewRequest(&sqlrpcv1.ListActiveOperationsRequest{}))
+	require.NoError(t, err)
+	require.Len(t, ops.Msg.Statements, 1)
+	assert.Equal(t, "cursor-1", ops.Msg.Statements[0].RequestId)
+
+	_, err = admin.CancelQuery(ctx, connect.NewRequest(&sqlrpcv1.CancelQueryRequest{RequestId: "cursor-1"}))
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return openCursors(server) == 0 }, 5*time.Second, 10*time.Millisecond)
+	ops, err = admin.ListActiveOperations(ctx, connect.NewRequest(&sqlrpcv1.ListActiveOperationsRequest{}))
+	require.NoError(t, err)
+	assert.Empty(t, ops.Msg.Statements)
+
+	server.SetRoleExecutionLimits(map[sqlrpcv1.Role]time.Duration{sqlrpcv1.Role_ROLE_ADMIN: 100 * time.Millisecond})
+	defer server.SetRoleExecutionLimits(nil)
+	id, _, err := server.openCursor(ctx, "cursor-2", "test",
+		"WITH RECURSIVE c(x) AS (SELECT 1 UNION ALL SELECT x + 1 FROM c) SELECT x FROM c WHERE x <= 2 OR x < 0", nil, time.Minute, 0)
+	require.NoError(t, err)
+	fetch := func() (*connect.Response[sqlrpcv1.FetchCursorResponse], error) {
+		return server.FetchCursor(ctx, connect.NewRequest(&sqlrpcv1.FetchCursorRequest{CursorId: id, MaxRows: 1}))
+	}
+	_, err = fetch()
+	require.NoError(t, err)
+	time.Sleep(200 * time.Millisecond)
+	resp, err := fetch()
+	require.NoError(t, err, "idle time does not count against the execution limit")
+	assert.Equal(t, int64(2), resp.Msg.Position)
+
+	_, err = fetch()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "max execution time")
+	assert.Zero(t, openCursors(server), "a fetch that runs out of time closes the cursor")
+}
+
+// A resumable stream that fails rather than breaks off does not leave its cursor behind.
+func TestQueryStream_ResumableTimeout(t *testing.T) {
+	client, server := setupTestServer(t)
+	stream, err := client.QueryStream(context.Background(), connect.NewRequest(&sqlrpcv1.QueryRequest{
+		Database:           "test",
+		Sql:                "WITH RECURSIVE c(x) AS (SELECT 1 UNION ALL SELECT x + 1 FROM c) SELECT x FROM c",
+		Resumable:          true,
+		MaxExecutionTimeMs: 100,
+	}))
+	require.NoError(t, err)
+	var last *sqlrpcv1.QueryResponse
+	for stream.Receive() {
+		last = stream.Msg()
+	}
+	require.NoError(t, stream.Err())
+	require.NotNil(t, last.GetError())
+	assert.Contains(t, last.GetError().GetMessage(), "max execution time")
+	assert.Zero(t, openCursors(server))
+}
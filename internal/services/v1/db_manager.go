@@ -4,13 +4,18 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"log"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"sqlite-server/internal/logging"
 	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
 	"sqlite-server/internal/sqldrivers"
+	"sqlite-server/internal/tracing"
 )
 
 // DbManager handles the lifecycle of database connections, including:
@@ -26,9 +31,18 @@ type DbManager struct {
 	// cacheRO for Read-Only connections
 	cacheRO sync.Map
 
+	// quiesced holds the names of databases that must not open connections,
+	// e.g. while their file is being replaced by a restore.
+	quiesced sync.Map
+
 	// LRU Config
 	ttl time.Duration
 
+	// Pool cache counters, reported on /metrics
+	cacheHits   atomic.Int64
+	cacheMisses atomic.Int64
+	evictions   atomic.Int64
+
 	// Lifecycle management
 	shutdownCh chan struct{}
 	wg         sync.WaitGroup // Waits for background cleaner to exit
@@ -61,7 +75,7 @@ func NewDbManager(configs []*sqlrpcv1.DatabaseConfig) *DbManager {
 	// Validate Access on Startup (Transient check)
 	for _, cfg := range configs {
 		if err := mgr.validateConnection(cfg); err != nil {
-			log.Printf("WARNING: Failed to validate database %s: %v", cfg.Name, err)
+			dbLog.Warn("Failed to validate database", logging.KeyDatabase, cfg.Name, logging.Err(err))
 		}
 	}
 
@@ -91,7 +105,7 @@ func (m *DbManager) CloseAll() {
 	m.cacheRW.Range(func(key, value any) bool {
 		k := key.(string)
 		conn := value.(*cachedConnection)
-		log.Printf("Closing database connection: %s", k)
+		dbLog.Debug("Closing database connection", "pool", k)
 		conns = append(conns, conn.db)
 		m.cacheRW.Delete(key)
 		return true
@@ -100,7 +114,7 @@ func (m *DbManager) CloseAll() {
 	m.cacheRO.Range(func(key, value any) bool {
 		k := key.(string)
 		conn := value.(*cachedConnection)
-		log.Printf("Closing database connection: %s", k)
+		dbLog.Debug("Closing database connection", "pool", k)
 		conns = append(conns, conn.db)
 		m.cacheRO.Delete(key)
 		return true
@@ -118,6 +132,26 @@ func (m *DbManager) HasDatabase(name string) bool {
 	return exists
 }
 
+// GetConfig returns the configuration of a mounted database.
+func (m *DbManager) GetConfig(name string) (*sqlrpcv1.DatabaseConfig, bool) {
+	val, ok := m.configs.Load(name)
+	if !ok {
+		return nil, false
+	}
+	return val.(*sqlrpcv1.DatabaseConfig), true
+}
+
+// InMemory reports whether the database lives in memory. Every pool of an in-memory
+// database holds its own private copy, so reads cannot be moved to the RO pool.
+func (m *DbManager) InMemory(name string) bool {
+	val, ok := m.configs.Load(name)
+	if !ok {
+		return false
+	}
+	path := val.(*sqlrpcv1.DatabaseConfig).DbPath
+	return strings.Contains(path, ":memory:") || strings.Contains(path, "mode=memory")
+}
+
 // Mount adds a new database configuration.
 func (m *DbManager) Mount(config *sqlrpcv1.DatabaseConfig) error {
 	// 1. Validate before storing (Ping can block)
@@ -246,7 +280,7 @@ func (m *DbManager) DetachDatabase(parentName string, alias string) error {
 }
 
 func (m *DbManager) invalidateCache(name string) {
-	log.Printf("Invalidating connection cache for database: %s", name)
+	dbLog.Debug("Invalidating connection cache", logging.KeyDatabase, name)
 
 	// 1. Close and remove RW connection
 	if val, ok := m.cacheRW.LoadAndDelete(name); ok {
@@ -269,9 +303,62 @@ func (m *DbManager) List() []string {
 	return names
 }
 
+// Quiesce closes the cached pools of a database and of the databases that attach it,
+// and refuses new connections to them until the returned release function is called.
+func (m *DbManager) Quiesce(name string) (release func(), err error) {
+	if _, loaded := m.quiesced.LoadOrStore(name, struct{}{}); loaded {
+		return nil, fmt.Errorf("database '%s' is already quiesced", name)
+	}
+	m.invalidateWithParents(name)
+
+	return func() {
+		// Drop any pool that raced the quiesce before lifting it
+		m.invalidateWithParents(name)
+		m.quiesced.Delete(name)
+	}, nil
+}
+
+// invalidateWithParents closes the pools of a database and of the databases that
+// attach it.
+func (m *DbManager) invalidateWithParents(name string) {
+	m.invalidateCache(name)
+	for _, parent := range m.AttachedBy(name) {
+		m.invalidateCache(parent)
+	}
+}
+
+// AttachedBy returns the names of the databases that attach the named one.
+func (m *DbManager) AttachedBy(name string) []string {
+	var parents []string
+	m.configs.Range(func(key, value any) bool {
+		for _, att := range value.(*sqlrpcv1.DatabaseConfig).Attachments {
+			if att.TargetDatabaseName == name {
+				parents = append(parents, key.(string))
+				break
+			}
+		}
+		return true
+	})
+	return parents
+}
+
 // GetConnection returns a connection for the given database and mode.
 // It opens one if not found in cache.
 func (m *DbManager) GetConnection(ctx context.Context, name string, mode string) (*sql.DB, error) {
+	ctx, span := tracing.StartChild(ctx, "sqlite.acquire", trace.WithAttributes(
+		attribute.String("db.namespace", name),
+		attribute.String("sqlite.pool", mode),
+	))
+	db, err := m.getConnection(ctx, name, mode)
+	tracing.EndSpan(span, err)
+	return db, err
+}
+
+func (m *DbManager) getConnection(ctx context.Context, name string, mode string) (*sql.DB, error) {
+	if _, ok := m.quiesced.Load(name); ok {
+		return nil, fmt.Errorf("database '%s' is temporarily unavailable (maintenance in progress)", name)
+	}
+
 	// 1. Fast Path: Check Cache First
 	var cache *sync.Map
 	if mode == ModeRO {
@@ -288,8 +375,12 @@ func (m *DbManager) GetConnection(ctx context.Context, name string, mode string)
 		if now-atomic.LoadInt64(&item.lastUsed) > int64(time.Second) {
 			atomic.StoreInt64(&item.lastUsed, now)
 		}
+		m.cacheHits.Add(1)
+		trace.SpanFromContext(ctx).SetAttributes(attribute.Bool("sqlite.pool.cache_hit", true))
 		return item.db, nil
 	}
+	m.cacheMisses.Add(1)
+	trace.SpanFromContext(ctx).SetAttributes(attribute.Bool("sqlite.pool.cache_hit", false))
 
 	// 2. Slow Path: Config Check & Creation
 	val, ok := m.configs.Load(name)
@@ -297,6 +388,11 @@ func (m *DbManager) GetConnection(ctx context.Context, name string, mode string)
 		return nil, fmt.Errorf("database not found: %s", name)
 	}
 	config := val.(*sqlrpcv1.DatabaseConfig)
+	for _, att := range config.Attachments {
+		if _, ok := m.quiesced.Load(att.TargetDatabaseName); ok {
+			return nil, fmt.Errorf("database '%s' is temporarily unavailable (maintenance in progress on '%s')", name, att.TargetDatabaseName)
+		}
+	}
 
 	// Check context before heavy work
 	if ctx.Err() != nil {
@@ -338,6 +434,24 @@ func (m *DbManager) GetConnection(ctx context.Context, name string, mode string)
 	return db, nil
 }
 
+// CacheStats reports how often GetConnection found a cached pool, had to open one,
+// and how many idle pools the cleaner closed.
+func (m *DbManager) CacheStats() (hits, misses, evictions int64) {
+	return m.cacheHits.Load(), m.cacheMisses.Load(), m.evictions.Load()
+}
+
+// PoolStats calls fn with the connection statistics of every cached pool.
+func (m *DbManager) PoolStats(fn func(name, mode string, stats sql.DBStats)) {
+	m.cacheRW.Range(func(key, value any) bool {
+		fn(key.(string), ModeRW, value.(*cachedConnection).db.Stats())
+		return true
+	})
+	m.cacheRO.Range(func(key, value any) bool {
+		fn(key.(string), ModeRO, value.(*cachedConnection).db.Stats())
+		return true
+	})
+}
+
 func (m *DbManager) runCleaner() {
 	defer m.wg.Done() // Signal that this goroutine has exited
 
@@ -365,7 +479,8 @@ func (m *DbManager) evictStale() {
 		item := value.(*cachedConnection)
 		lastUsed := atomic.LoadInt64(&item.lastUsed)
 		if (now - lastUsed) > ttlNanos {
-			log.Printf("Evicting stale RW connection: %s", k)
+			dbLog.Debug("Evicting stale connection pool", "pool", k, "mode", ModeRW)
+			m.evictions.Add(1)
 			toClose = append(toClose, item.db)
 			m.cacheRW.Delete(k)
 		}
@@ -378,7 +493,8 @@ func (m *DbManager) evictStale() {
 		item := value.(*cachedConnection)
 		lastUsed := atomic.LoadInt64(&item.lastUsed)
 		if (now - lastUsed) > ttlNanos {
-			log.Printf("Evicting stale RO connection: %s", k)
+			dbLog.Debug("Evicting stale connection pool", "pool", k, "mode", ModeRO)
+			m.evictions.Add(1)
 			toClose = append(toClose, item.db)
 			m.cacheRO.Delete(k)
 		}
@@ -396,12 +512,13 @@ func (m *DbManager) resolveAttachments(config *sqlrpcv1.DatabaseConfig) []sqldri
 	for _, att := range config.Attachments {
 		val, ok := m.configs.Load(att.TargetDatabaseName)
 		if !ok {
-			log.Printf("WARNING: Atomic attachment failed: target database '%s' not found for parent '%s'", att.TargetDatabaseName, config.Name)
+			dbLog.Warn("Atomic attachment failed: target database not found", "target", att.TargetDatabaseName, logging.KeyDatabase, config.Name)
 			continue
 		}
 		target := val.(*sqlrpcv1.DatabaseConfig)
 		resolved = append(resolved, sqldrivers.AttachmentInfo{
 			Alias:    att.Alias,
+			Database: att.TargetDatabaseName,
 			Path:     target.DbPath,
 			Key:      target.Key,
 			ReadOnly: target.ReadOnly,
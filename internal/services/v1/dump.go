@@ -0,0 +1,262 @@
+package servicesv1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"sqlite-server/internal/logging"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+)
+
+// dumpChunkSize is the size above which dumped statements are sent.
+const dumpChunkSize = 64 << 10
+
+// dumpSQL is the statement text DumpDatabase is listed with in ListActiveOperations.
+const dumpSQL = "-- DumpDatabase"
+
+// DumpDatabase streams a `.dump`-style script of a database, read in one transaction
+// through the caller's row and column security.
+func (s *DbServer) DumpDatabase(ctx context.Context, req *connect.Request[sqlrpcv1.DumpDatabaseRequest], stream *connect.ServerStream[sqlrpcv1.DumpDatabaseResponse]) error {
+	reqID := ensureRequestID(req.Header())
+	stream.ResponseHeader().Set(headerRequestID, reqID)
+
+	if err := protovalidate.Validate(req.Msg); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	msg := req.Msg
+
+	w := &dumpWriter{send: func(sql string) error {
+		return stream.Send(&sqlrpcv1.DumpDatabaseResponse{Sql: sql})
+	}}
+	if err := s.dump(ctx, reqID, msg.Database, msg.Tables, msg.SchemaOnly, w); err != nil {
+		rpcLog.WarnContext(ctx, "Dump failed", logging.Err(err))
+		return err
+	}
+	return nil
+}
+
+// dump writes the script of database to w and flushes it. Failures are connect errors.
+func (s *DbServer) dump(ctx context.Context, reqID, database string, tables []string, schemaOnly bool, w *dumpWriter) error {
+	// Every pool of an in-memory database holds its own copy; the RW pool is the real one
+	mode := ModeRO
+	if s.dbManager.InMemory(database) {
+		mode = ModeRW
+	}
+	db, err := s.dbManager.GetConnection(ctx, database, mode)
+	if err != nil {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+
+	ctx, done := s.startStatement(ctx, reqID, database, dumpSQL, 0)
+	defer done()
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return makeUnaryError(statementError(ctx, err), dumpSQL)
+	}
+	defer tx.Rollback()
+	if err := s.bindSession(ctx, tx, database); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	if err := writeDump(ctx, s.restrict(ctx, database, tx), tables, schemaOnly, w); err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return err
+		}
+		return makeUnaryError(statementError(ctx, err), dumpSQL)
+	}
+	return w.Flush()
+}
+
+// dumpWriter buffers dumped statements and sends them in chunks of whole statements.
+type dumpWriter struct {
+	buf  strings.Builder
+	send func(string) error
+}
+
+// statement appends one statement to the script.
+func (w *dumpWriter) statement(sql string) error {
+	w.buf.WriteString(sql)
+	w.buf.WriteString(";\n")
+	if w.buf.Len() >= dumpChunkSize {
+		return w.Flush()
+	}
+	return nil
+}
+
+// Flush sends the buffered statements.
+func (w *dumpWriter) Flush() error {
+	if w.buf.Len() == 0 {
+		return nil
+	}
+	err := w.send(w.buf.String())
+	w.buf.Reset()
+	return err
+}
+
+// schemaObject is a row of sqlite_master.
+type schemaObject struct {
+	kind, name, table, sql string
+}
+
+// writeDump writes the script of the database behind q in the layout of the sqlite3
+// shell's .dump: each table followed by its rows, then indexes, triggers and views in
+// creation order, all in one transaction. If tables is not empty only those tables
+// and their indexes and triggers are written.
+func writeDump(ctx context.Context, q querier, tables []string, schemaOnly bool, w *dumpWriter) error {
+	rows, err := q.QueryContext(ctx, `SELECT type, name, tbl_name, sql FROM sqlite_master
+		WHERE sql IS NOT NULL AND name NOT LIKE 'sqlite_%' ORDER BY rowid`)
+	if err != nil {
+		return err
+	}
+	var objects []schemaObject
+	for rows.Next() {
+		var o schemaObject
+		if err := rows.Scan(&o.kind, &o.name, &o.table, &o.sql); err != nil {
+			rows.Close()
+			return err
+		}
+		objects = append(objects, o)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	wanted := func(o schemaObject) bool {
+		return len(tables) == 0 || (o.kind != "view" && slices.ContainsFunc(tables, func(t string) bool {
+			return strings.EqualFold(t, o.table)
+		}))
+	}
+	for _, t := range tables {
+		if !slices.ContainsFunc(objects, func(o schemaObject) bool { return o.kind == "table" && strings.EqualFold(o.name, t) }) {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("table not found: %s", t))
+		}
+	}
+
+	if err := w.statement("PRAGMA foreign_keys=OFF"); err != nil {
+		return err
+	}
+	if err := w.statement("BEGIN TRANSACTION"); err != nil {
+		return err
+	}
+	for _, o := range objects {
+		if o.kind != "table" || !wanted(o) {
+			continue
+		}
+		if err := w.statement(o.sql); err != nil {
+			return err
+		}
+		if schemaOnly || strings.HasPrefix(strings.ToUpper(o.sql), "CREATE VIRTUAL") {
+			continue
+		}
+		if err := dumpRows(ctx, q, o.name, w); err != nil {
+			return err
+		}
+	}
+	if !schemaOnly && len(tables) == 0 {
+		if err := dumpSequences(ctx, q, w); err != nil {
+			return err
+		}
+	}
+	for _, o := range objects {
+		if o.kind != "table" && wanted(o) {
+			if err := w.statement(o.sql); err != nil {
+				return err
+			}
+		}
+	}
+	return w.statement("COMMIT")
+}
+
+// dumpRows writes an INSERT statement for every row of table. SQLite's quote() renders
+// the values, so every storage class round-trips exactly. Generated columns are left
+// out, which needs the column list spelled out.
+func dumpRows(ctx context.Context, q querier, table string, w *dumpWriter) error {
+	rows, err := q.QueryContext(ctx, "SELECT name, hidden FROM pragma_table_xinfo(?) ORDER BY cid", table)
+	if err != nil {
+		return err
+	}
+	var columns []string
+	generated := false
+	for rows.Next() {
+		var name string
+		var hidden int
+		if err := rows.Scan(&name, &hidden); err != nil {
+			rows.Close()
+			return err
+		}
+		if hidden != 0 {
+			generated = true
+			continue
+		}
+		columns = append(columns, quoteIdentifier(name))
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	prefix := "INSERT INTO " + quoteIdentifier(table)
+	if generated {
+		prefix += "(" + strings.Join(columns, ",") + ")"
+	}
+	prefix += " VALUES("
+
+	values := make([]string, len(columns))
+	for i, c := range columns {
+		values[i] = "quote(" + c + ")"
+	}
+	rows, err = q.QueryContext(ctx, "SELECT "+strings.Join(values, "||','||")+" FROM "+quoteIdentifier(table))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var row string
+		if err := rows.Scan(&row); err != nil {
+			return err
+		}
+		if err := w.statement(prefix + row + ")"); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// dumpSequences writes the AUTOINCREMENT counters, if the database keeps any.
+func dumpSequences(ctx context.Context, q querier, w *dumpWriter) error {
+	rows, err := q.QueryContext(ctx, "SELECT 1 FROM sqlite_master WHERE name = 'sqlite_sequence'")
+	if err != nil {
+		return err
+	}
+	exists := rows.Next()
+	rows.Close()
+	if !exists {
+		return nil
+	}
+
+	rows, err = q.QueryContext(ctx, "SELECT 'INSERT INTO sqlite_sequence VALUES('||quote(name)||','||quote(seq)||')' FROM sqlite_sequence")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	if err := w.statement("DELETE FROM sqlite_sequence"); err != nil {
+		return err
+	}
+	for rows.Next() {
+		var stmt string
+		if err := rows.Scan(&stmt); err != nil {
+			return err
+		}
+		if err := w.statement(stmt); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
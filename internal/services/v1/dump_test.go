@@ -0,0 +1,97 @@
+package servicesv1
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+	"sqlite-server/internal/protos/sqlrpc/v1/sqlrpcv1connect"
+)
+
+// collectDump runs DumpDatabase and joins the streamed script.
+func collectDump(ctx context.Context, client sqlrpcv1connect.DatabaseServiceClient, req *sqlrpcv1.DumpDatabaseRequest) (string, error) {
+	stream, err := client.DumpDatabase(ctx, connect.NewRequest(req))
+	if err != nil {
+		return "", err
+	}
+	defer stream.Close()
+	var script strings.Builder
+	for stream.Receive() {
+		script.WriteString(stream.Msg().Sql)
+	}
+	return script.String(), stream.Err()
+}
+
+func TestDumpDatabase(t *testing.T) {
+	client, server := setupTestServer(t)
+	ctx := context.Background()
+
+	db, err := server.dbManager.GetConnection(ctx, "test", ModeRW)
+	require.NoError(t, err)
+	_, err = db.Exec(`
+		CREATE INDEX users_age ON users(age);
+		CREATE VIEW adults AS SELECT name FROM users WHERE age >= 18;
+		CREATE TABLE notes (id INTEGER PRIMARY KEY AUTOINCREMENT, body TEXT, size INTEGER GENERATED ALWAYS AS (length(body)));
+		INSERT INTO notes (body) VALUES ('it''s here'), ('second');
+	`)
+	require.NoError(t, err)
+
+	t.Run("restores into an empty database", func(t *testing.T) {
+		script, err := collectDump(ctx, client, &sqlrpcv1.DumpDatabaseRequest{Database: "test"})
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(script, "PRAGMA foreign_keys=OFF;\nBEGIN TRANSACTION;\n"))
+		assert.True(t, strings.HasSuffix(script, "COMMIT;\n"))
+		assert.Contains(t, script, `INSERT INTO "users" VALUES(1,'Alice',30,X'DEADBEEF',1);`)
+		assert.Contains(t, script, `INSERT INTO "notes"("id","body") VALUES(1,'it''s here');`)
+		assert.Contains(t, script, "INSERT INTO sqlite_sequence VALUES('notes',2);")
+		// Indexes and views follow the tables
+		assert.Greater(t, strings.Index(script, "CREATE INDEX users_age"), strings.Index(script, `INSERT INTO "notes"`))
+
+		restored, err := sql.Open("sqlite3", ":memory:")
+		require.NoError(t, err)
+		defer restored.Close()
+		restored.SetMaxOpenConns(1)
+		_, err = restored.Exec(script)
+		require.NoError(t, err)
+
+		var avatar []byte
+		require.NoError(t, restored.QueryRow("SELECT avatar FROM users WHERE id = 1").Scan(&avatar))
+		assert.Equal(t, []byte{0xde, 0xad, 0xbe, 0xef}, avatar)
+		var adults, size int
+		require.NoError(t, restored.QueryRow("SELECT count(*) FROM adults").Scan(&adults))
+		assert.Equal(t, 2, adults)
+		require.NoError(t, restored.QueryRow("SELECT size FROM notes WHERE id = 2").Scan(&size))
+		assert.Equal(t, 6, size)
+	})
+
+	t.Run("writes only the schema", func(t *testing.T) {
+		script, err := collectDump(ctx, client, &sqlrpcv1.DumpDatabaseRequest{Database: "test", SchemaOnly: true})
+		require.NoError(t, err)
+		assert.Contains(t, script, "CREATE TABLE users")
+		assert.NotContains(t, script, "INSERT")
+	})
+
+	t.Run("limits the dump to the named tables", func(t *testing.T) {
+		script, err := collectDump(ctx, client, &sqlrpcv1.DumpDatabaseRequest{Database: "test", Tables: []string{"USERS"}})
+		require.NoError(t, err)
+		assert.Contains(t, script, "CREATE INDEX users_age")
+		assert.NotContains(t, script, "notes")
+		assert.NotContains(t, script, "CREATE VIEW")
+	})
+
+	t.Run("rejects unknown tables", func(t *testing.T) {
+		_, err := collectDump(ctx, client, &sqlrpcv1.DumpDatabaseRequest{Database: "test", Tables: []string{"missing"}})
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+
+	t.Run("rejects unknown databases", func(t *testing.T) {
+		_, err := collectDump(ctx, client, &sqlrpcv1.DumpDatabaseRequest{Database: "nope"})
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+}
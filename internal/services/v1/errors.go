@@ -1,12 +1,23 @@
 package servicesv1
 
 import (
+	"context"
 	"errors"
-	"log"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"sqlite-server/internal/auth"
+	"sqlite-server/internal/logging"
 	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+	"sqlite-server/internal/sqlclass"
 
 	"connectrpc.com/connect"
 	"github.com/mattn/go-sqlite3"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // --- Error Handling Logic ---
@@ -37,6 +48,8 @@ func sqliteToConnectCode(sqliteCode sqlrpcv1.SqliteCode) connect.Code {
 		return connect.CodeResourceExhausted // or CodeAborted
 	case 11: // SQLITE_CORRUPT
 		return connect.CodeDataLoss
+	case 23: // SQLITE_AUTH (denied by the authorizer, e.g. a column policy)
+		return connect.CodePermissionDenied
 	default:
 		return connect.CodeInternal
 	}
@@ -46,31 +59,84 @@ func sqliteToConnectCode(sqliteCode sqlrpcv1.SqliteCode) connect.Code {
 // sqlrpcv1.ErrorResponse as detailed metadata. This allows the client to see
 // the 'sqlite_error_code' even in unary RPCs.
 func makeUnaryError(err error, sql string) *connect.Error {
-	code := extractSqliteCode(err)
+	return withErrorDetail(err, makeStreamError(err, sql))
+}
+
+// makeBatchError is makeUnaryError for the parameter set at index of a BatchExec.
+// The index is in the message and in the ErrorResponse detail.
+func makeBatchError(err error, sql string, index int) *connect.Error {
+	err = fmt.Errorf("parameter set %d: %w", index, err)
+	detailVal := makeStreamError(err, sql)
+	detailVal.BatchIndex = proto.Int32(int32(index))
+	return withErrorDetail(err, detailVal)
+}
+
+// withErrorDetail maps err to a connect error and attaches detailVal to it.
+func withErrorDetail(err error, detailVal *sqlrpcv1.ErrorResponse) *connect.Error {
+	code := detailVal.SqliteErrorCode
 	connectCode := connect.CodeInternal
 
 	// If we successfully extracted a code, try to map it to a better HTTP status
-	if code != 0 {
+	switch {
+	case code != 0:
 		connectCode = sqliteToConnectCode(code)
+	case errors.Is(err, context.DeadlineExceeded):
+		connectCode = connect.CodeDeadlineExceeded
+	case errors.Is(err, context.Canceled):
+		connectCode = connect.CodeCanceled
+	case errors.Is(err, sqlclass.ErrRowSecurity):
+		connectCode = connect.CodePermissionDenied
 	}
 
 	// Create the base Connect error
 	connectErr := connect.NewError(connectCode, err)
 
-	// Construct the structured ErrorResponse defined in your proto
-	detailVal := &sqlrpcv1.ErrorResponse{
-		Message:         err.Error(),
-		FailedSql:       sql,
-		SqliteErrorCode: code,
-	}
-
 	// Attach it as a "Detail" (standard gRPC error model)
 	if detail, detailErr := connect.NewErrorDetail(detailVal); detailErr == nil {
 		connectErr.AddDetail(detail)
 	} else {
-		log.Printf("Failed to attach error detail: %v", detailErr)
+		rpcLog.Error("Failed to attach error detail", logging.Err(detailErr))
+	}
+
+	return connectErr
+}
+
+// loginError maps a rejected password login to a connect error. Lockouts become
+// ResourceExhausted with a Retry-After header and a RetryInfo detail; anything else is
+// reported as invalid credentials.
+func loginError(err error) *connect.Error {
+	var locked *auth.LockedOutError
+	if !errors.As(err, &locked) {
+		return connect.NewError(connect.CodeUnauthenticated, errors.New("invalid credentials"))
+	}
+
+	connectErr := connect.NewError(connect.CodeResourceExhausted, locked)
+	connectErr.Meta().Set("Retry-After", strconv.Itoa(int(math.Ceil(locked.RetryAfter.Seconds()))))
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.RetryInfo{RetryDelay: durationpb.New(locked.RetryAfter)}); detailErr == nil {
+		connectErr.AddDetail(detail)
+	} else {
+		authLog.Error("Failed to attach error detail", logging.Err(detailErr))
 	}
+	return connectErr
+}
 
+// quotaError reports a call rejected by a rate limit as ResourceExhausted with a
+// QuotaFailure detail naming the limit and, when the limit frees up by itself, a
+// Retry-After header and a RetryInfo detail.
+func quotaError(subject, description string, retryAfter time.Duration) *connect.Error {
+	connectErr := connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("rate limit exceeded: %s", description))
+	details := []proto.Message{&errdetails.QuotaFailure{Violations: []*errdetails.QuotaFailure_Violation{{Subject: subject, Description: description}}}}
+	if retryAfter > 0 {
+		connectErr.Meta().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+	}
+	for _, d := range details {
+		if detail, detailErr := connect.NewErrorDetail(d); detailErr == nil {
+			connectErr.AddDetail(detail)
+		} else {
+			rpcLog.Error("Failed to attach error detail", logging.Err(detailErr))
+		}
+	}
 	return connectErr
 }
 
@@ -89,7 +155,7 @@ func sendAppError(stream transactionSender, reqID string, err error, sql string)
 		Response: &sqlrpcv1.TransactionResponse_Error{Error: errResp},
 	}
 	if err := stream.Send(res); err != nil {
-		log.Printf("Failed to send error to client: %v for reqID: %s", err, reqID)
+		rpcLog.Warn("Failed to send error to client", logging.KeyRequestID, reqID, logging.Err(err))
 	}
 }
 
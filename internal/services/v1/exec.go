@@ -2,12 +2,11 @@ package servicesv1
 
 import (
 	"context"
-	"log"
 
 	"buf.build/go/protovalidate"
 	"connectrpc.com/connect"
 
-	"sqlite-server/internal/auth"
+	"sqlite-server/internal/logging"
 	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
 )
 
@@ -16,7 +15,7 @@ func (s *DbServer) Exec(ctx context.Context, req *connect.Request[sqlrpcv1.Query
 	reqID := ensureRequestID(req.Header())
 
 	if err := protovalidate.Validate(req.Msg); err != nil {
-		log.Printf("[%s] Validation failed for Exec: %v", reqID, err)
+		rpcLog.WarnContext(ctx, "Validation failed for Exec", logging.Err(err))
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
@@ -24,19 +23,24 @@ func (s *DbServer) Exec(ctx context.Context, req *connect.Request[sqlrpcv1.Query
 		return nil, err
 	}
 
-	mode := ModeRW
-	if auth.IsReadOnly(ctx) {
-		mode = ModeRO
-	}
+	mode := s.statementMode(ctx, req.Msg.Database, req.Msg.Sql)
 
 	db, err := s.dbManager.GetConnection(ctx, req.Msg.Database, mode)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, err)
 	}
 
-	result, err := executeExecAndBuffer(ctx, db, req.Msg.Sql, req.Msg.Parameters)
+	ctx, done := s.startStatement(ctx, reqID, req.Msg.Database, req.Msg.Sql, req.Msg.MaxExecutionTimeMs)
+	defer done()
+	q, release, err := s.statelessQuerier(ctx, req.Msg.Database, db)
+	if err != nil {
+		return nil, makeUnaryError(statementError(ctx, err), req.Msg.Sql)
+	}
+	defer release()
+	result, err := executeExecAndBuffer(ctx, q, req.Msg.Sql, req.Msg.Parameters)
 	if err != nil {
-		log.Printf("[%s] Exec execution failed: %v", reqID, err)
+		err = statementError(ctx, err)
+		rpcLog.WarnContext(ctx, "Exec execution failed", logging.Err(err))
 		return nil, makeUnaryError(err, req.Msg.Sql)
 	}
 
@@ -50,7 +54,7 @@ func (s *DbServer) TypedExec(ctx context.Context, req *connect.Request[sqlrpcv1.
 	reqID := ensureRequestID(req.Header())
 
 	if err := protovalidate.Validate(req.Msg); err != nil {
-		log.Printf("[%s] Validation failed for TypedExec: %v", reqID, err)
+		rpcLog.WarnContext(ctx, "Validation failed for TypedExec", logging.Err(err))
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
@@ -58,19 +62,24 @@ func (s *DbServer) TypedExec(ctx context.Context, req *connect.Request[sqlrpcv1.
 		return nil, err
 	}
 
-	mode := ModeRW
-	if auth.IsReadOnly(ctx) {
-		mode = ModeRO
-	}
+	mode := s.statementMode(ctx, req.Msg.Database, req.Msg.Sql)
 
 	db, err := s.dbManager.GetConnection(ctx, req.Msg.Database, mode)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, err)
 	}
 
-	result, err := typedExecuteExecAndBuffer(ctx, db, req.Msg.Sql, req.Msg.Parameters)
+	ctx, done := s.startStatement(ctx, reqID, req.Msg.Database, req.Msg.Sql, req.Msg.MaxExecutionTimeMs)
+	defer done()
+	q, release, err := s.statelessQuerier(ctx, req.Msg.Database, db)
+	if err != nil {
+		return nil, makeUnaryError(statementError(ctx, err), req.Msg.Sql)
+	}
+	defer release()
+	result, err := typedExecuteExecAndBuffer(ctx, q, req.Msg.Sql, req.Msg.Parameters)
 	if err != nil {
-		log.Printf("[%s] TypedExec execution failed: %v", reqID, err)
+		err = statementError(ctx, err)
+		rpcLog.WarnContext(ctx, "TypedExec execution failed", logging.Err(err))
 		return nil, makeUnaryError(err, req.Msg.Sql)
 	}
 
@@ -0,0 +1,566 @@
+package servicesv1
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"sqlite-server/internal/logging"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+	"sqlite-server/internal/protos/sqlrpc/v1/sqlrpcv1connect"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// exportFormat describes the file an export is downloaded as.
+type exportFormat struct {
+	extension   string
+	contentType string
+}
+
+// exportFormats are the formats accepted by the format parameter of /export.
+var exportFormats = map[string]exportFormat{
+	"csv":    {".csv", "text/csv; charset=utf-8"},
+	"ndjson": {".ndjson", "application/x-ndjson"},
+	"sql":    {".sql", "application/sql; charset=utf-8"},
+	"sqlite": {".db", "application/vnd.sqlite3"},
+}
+
+// ExportHandler serves GET /export/{db} downloads. The query parameters are:
+//
+//   - sql: a read-only query whose results are exported. It may be omitted with
+//     format=sql, which downloads a DumpDatabase script, and with format=sqlite,
+//     which downloads a snapshot of the database.
+//   - params: the query parameters, a JSON array of positional ones or a JSON object
+//     of named ones.
+//   - format: csv (default), ndjson, sql or sqlite.
+//   - table: the table the sql and sqlite formats write the results into
+//     (default "export"). It also names the downloaded file.
+//
+// Requests are authorized by authorizer with the rules of the RPC they stand in for:
+// QueryStream, DumpDatabase or DownloadBackup. Results are streamed as they are read;
+// the sqlite format goes through a temporary file.
+func (s *DbServer) ExportHandler(authorizer HTTPAuthorizer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r = r.WithContext(newRequestScope(r.Context(), "/export", r.Header))
+		w.Header().Set(headerRequestID, r.Header.Get(headerRequestID))
+
+		params := r.URL.Query()
+		database := r.PathValue("db")
+		formatName := cmp.Or(params.Get("format"), "csv")
+		format, ok := exportFormats[formatName]
+		if !ok {
+			writeHTTPError(w, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown export format %q: use csv, ndjson, sql or sqlite", formatName)))
+			return
+		}
+
+		sqlQuery := params.Get("sql")
+		parameters, err := exportParameters(params.Get("params"))
+		if err != nil {
+			writeHTTPError(w, connect.NewError(connect.CodeInvalidArgument, err))
+			return
+		}
+		var procedure string
+		var msg proto.Message
+		var req connect.AnyRequest
+		switch {
+		case sqlQuery != "":
+			m := &sqlrpcv1.QueryRequest{Database: database, Sql: sqlQuery, Parameters: parameters}
+			procedure, msg, req = sqlrpcv1connect.DatabaseServiceQueryStreamProcedure, m, connect.NewRequest(m)
+		case formatName == "sql":
+			m := &sqlrpcv1.DumpDatabaseRequest{Database: database}
+			procedure, msg, req = sqlrpcv1connect.DatabaseServiceDumpDatabaseProcedure, m, connect.NewRequest(m)
+		case formatName == "sqlite":
+			m := &sqlrpcv1.DownloadBackupRequest{Database: database}
+			procedure, msg, req = sqlrpcv1connect.DatabaseServiceDownloadBackupProcedure, m, connect.NewRequest(m)
+		default:
+			writeHTTPError(w, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("the %s format needs a sql query", formatName)))
+			return
+		}
+
+		ctx, finish, err := authorizer.AuthorizeHTTP(r, procedure, req)
+		if err != nil {
+			writeHTTPError(w, err)
+			return
+		}
+		defer func() { finish(err) }()
+		setLimitHeaders(ctx, w.Header())
+		if err = protovalidate.Validate(msg); err != nil {
+			err = connect.NewError(connect.CodeInvalidArgument, err)
+			writeHTTPError(w, err)
+			return
+		}
+
+		body := &exportBody{
+			w:           w,
+			contentType: format.contentType,
+			filename:    cmp.Or(params.Get("table"), database) + format.extension,
+		}
+		table := cmp.Or(params.Get("table"), "export")
+		reqID := r.Header.Get(headerRequestID)
+		switch {
+		case sqlQuery != "":
+			err = s.exportQuery(ctx, reqID, database, sqlQuery, parameters, formatName, table, body)
+		case formatName == "sql":
+			err = s.dump(ctx, reqID, database, nil, false, &dumpWriter{send: body.writeString})
+		default:
+			err = s.exportSnapshot(ctx, database, body)
+		}
+		if err == nil {
+			return
+		}
+		rpcLog.WarnContext(ctx, "Export failed", logging.Err(err))
+		if body.started {
+			// The status is gone; cutting the connection keeps a truncated file from
+			// looking complete.
+			panic(http.ErrAbortHandler)
+		}
+		writeHTTPError(w, err)
+	})
+}
+
+// exportQuery streams the results of a read-only query to body in the given format.
+func (s *DbServer) exportQuery(ctx context.Context, reqID, database, sqlQuery string, parameters *sqlrpcv1.Parameters, format, table string, body *exportBody) error {
+	if err := ValidateStatelessQuery(sqlQuery); err != nil {
+		return err
+	}
+	if !IsReader(sqlQuery) || !IsReadOnly(sqlQuery) {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("exports only run read-only queries"))
+	}
+
+	db, err := s.dbManager.GetConnection(ctx, database, s.statementMode(ctx, database, sqlQuery))
+	if err != nil {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+	ctx, done := s.startStatement(ctx, reqID, database, sqlQuery, 0)
+	defer done()
+
+	var writer StreamWriter
+	var file *sqliteExportWriter
+	switch format {
+	case "csv":
+		writer = &csvExportWriter{body: body, csv: csv.NewWriter(body)}
+	case "ndjson":
+		writer = &ndjsonExportWriter{body: body}
+	case "sql":
+		writer = &sqlExportWriter{body: body, table: table}
+	case "sqlite":
+		if file, err = newSQLiteExportWriter(ctx, table); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		defer file.close()
+		writer = file
+	}
+
+	q, release, err := s.statelessQuerier(ctx, database, db)
+	if err == nil {
+		defer release()
+		err = streamQueryResults(ctx, q, sqlQuery, parameters, limitWriter(ctx, writer))
+	}
+	if connect.CodeOf(err) == connect.CodeResourceExhausted {
+		return err
+	}
+	if err != nil {
+		return makeUnaryError(statementError(ctx, err), sqlQuery)
+	}
+	if file != nil {
+		if err := file.db.Close(); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		return body.copyFile(file.path)
+	}
+	return nil
+}
+
+// exportParameters parses the params of an export: a JSON array binds positional
+// parameters, a JSON object named ones.
+func exportParameters(raw string) (*sqlrpcv1.Parameters, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	var v structpb.Value
+	if err := protojson.Unmarshal([]byte(raw), &v); err != nil {
+		return nil, fmt.Errorf("invalid params: %w", err)
+	}
+	switch k := v.Kind.(type) {
+	case *structpb.Value_ListValue:
+		return &sqlrpcv1.Parameters{Positional: k.ListValue.Values}, nil
+	case *structpb.Value_StructValue:
+		return &sqlrpcv1.Parameters{Named: k.StructValue.Fields}, nil
+	}
+	return nil, errors.New("params must be a JSON array or object")
+}
+
+// exportSnapshot sends a consistent copy of the database file, as DownloadBackup does.
+func (s *DbServer) exportSnapshot(ctx context.Context, database string, body *exportBody) error {
+	if err := s.requireUnrestricted(ctx, database); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp("", "sqlite-server-export-*.db")
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	tmp.Close()
+	defer os.Remove(tmp.Name())
+
+	if err := s.snapshotDatabase(ctx, database, tmp.Name()); err != nil {
+		return backupConnectError(err)
+	}
+	return body.copyFile(tmp.Name())
+}
+
+// exportBody is the response body of an export. The download headers are sent with
+// the first byte, so failures before it still get a plain error response.
+type exportBody struct {
+	w           http.ResponseWriter
+	contentType string
+	filename    string
+	started     bool
+}
+
+func (b *exportBody) Write(p []byte) (int, error) {
+	if !b.started {
+		b.started = true
+		b.w.Header().Set("Content-Type", b.contentType)
+		b.w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": b.filename}))
+	}
+	return b.w.Write(p)
+}
+
+// writeString writes s and pushes it to the client.
+func (b *exportBody) writeString(s string) error {
+	if _, err := io.WriteString(b, s); err != nil {
+		return err
+	}
+	b.flush()
+	return nil
+}
+
+// flush pushes the bytes written so far to the client.
+func (b *exportBody) flush() {
+	if f, ok := b.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// copyFile sends the file at path as the whole body.
+func (b *exportBody) copyFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	defer f.Close()
+	stat, err := f.Stat()
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	b.w.Header().Set("Content-Length", strconv.FormatInt(stat.Size(), 10))
+	if _, err := io.Copy(b, f); err != nil {
+		return connect.NewError(connect.CodeUnavailable, err)
+	}
+	return nil
+}
+
+// errExportDML is returned by the export writers for statements that do not return rows.
+var errExportDML = connect.NewError(connect.CodeInvalidArgument, errors.New("exports only run read-only queries"))
+
+// csvExportWriter writes a header record with the column names, then one record per
+// row. NULL is written as an empty field and BLOBs as base64.
+type csvExportWriter struct {
+	body   *exportBody
+	csv    *csv.Writer
+	record []string
+}
+
+func (w *csvExportWriter) SendHeader(h *sqlrpcv1.QueryResultHeader) error {
+	w.record = make([]string, len(h.Columns))
+	return w.csv.Write(h.Columns)
+}
+
+func (w *csvExportWriter) SendRowBatch(b *sqlrpcv1.QueryResultRowBatch) error {
+	for _, row := range b.Rows {
+		for i, v := range row.Values {
+			w.record[i] = exportText(v)
+		}
+		if err := w.csv.Write(w.record); err != nil {
+			return err
+		}
+	}
+	w.csv.Flush()
+	w.body.flush()
+	return w.csv.Error()
+}
+
+func (w *csvExportWriter) SendDMLResult(*sqlrpcv1.ExecResponse) error { return errExportDML }
+
+func (w *csvExportWriter) SendComplete(*sqlrpcv1.ExecutionStats) error {
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+// exportText renders a value as CSV text.
+func exportText(v *structpb.Value) string {
+	switch k := v.Kind.(type) {
+	case *structpb.Value_NumberValue:
+		return strconv.FormatFloat(k.NumberValue, 'f', -1, 64)
+	case *structpb.Value_StringValue:
+		return k.StringValue
+	case *structpb.Value_BoolValue:
+		return strconv.FormatBool(k.BoolValue)
+	}
+	return ""
+}
+
+// ndjsonExportWriter writes one JSON object per row, keyed by column name.
+type ndjsonExportWriter struct {
+	body *exportBody
+	keys [][]byte // The JSON encoded column names
+	buf  bytes.Buffer
+}
+
+func (w *ndjsonExportWriter) SendHeader(h *sqlrpcv1.QueryResultHeader) error {
+	w.keys = make([][]byte, len(h.Columns))
+	for i, c := range h.Columns {
+		w.keys[i], _ = json.Marshal(c)
+	}
+	return nil
+}
+
+func (w *ndjsonExportWriter) SendRowBatch(b *sqlrpcv1.QueryResultRowBatch) error {
+	w.buf.Reset()
+	for _, row := range b.Rows {
+		w.buf.WriteByte('{')
+		for i, v := range row.Values {
+			if i > 0 {
+				w.buf.WriteByte(',')
+			}
+			value, err := json.Marshal(v.AsInterface())
+			if err != nil {
+				return err
+			}
+			w.buf.Write(w.keys[i])
+			w.buf.WriteByte(':')
+			w.buf.Write(value)
+		}
+		w.buf.WriteString("}\n")
+	}
+	if _, err := w.body.Write(w.buf.Bytes()); err != nil {
+		return err
+	}
+	w.body.flush()
+	return nil
+}
+
+func (w *ndjsonExportWriter) SendDMLResult(*sqlrpcv1.ExecResponse) error { return errExportDML }
+
+func (w *ndjsonExportWriter) SendComplete(*sqlrpcv1.ExecutionStats) error { return nil }
+
+// sqlExportWriter writes a script that creates table and inserts the rows into it.
+type sqlExportWriter struct {
+	body       *exportBody
+	table      string
+	affinities []sqlrpcv1.ColumnAffinity
+	buf        bytes.Buffer
+}
+
+func (w *sqlExportWriter) SendHeader(h *sqlrpcv1.QueryResultHeader) error {
+	w.affinities = h.ColumnAffinities
+	return w.body.writeString("BEGIN TRANSACTION;\n" + exportTableDDL(w.table, h) + ";\n")
+}
+
+func (w *sqlExportWriter) SendRowBatch(b *sqlrpcv1.QueryResultRowBatch) error {
+	w.buf.Reset()
+	for _, row := range b.Rows {
+		w.buf.WriteString("INSERT INTO ")
+		w.buf.WriteString(quoteIdentifier(w.table))
+		w.buf.WriteString(" VALUES(")
+		for i, v := range row.Values {
+			if i > 0 {
+				w.buf.WriteByte(',')
+			}
+			w.buf.WriteString(sqlLiteral(v, w.affinities[i]))
+		}
+		w.buf.WriteString(");\n")
+	}
+	return w.body.writeString(w.buf.String())
+}
+
+func (w *sqlExportWriter) SendDMLResult(*sqlrpcv1.ExecResponse) error { return errExportDML }
+
+func (w *sqlExportWriter) SendComplete(*sqlrpcv1.ExecutionStats) error {
+	return w.body.writeString("COMMIT;\n")
+}
+
+// exportTableDDL is the CREATE TABLE statement of the table results are exported into,
+// with the declared types of the result columns.
+func exportTableDDL(table string, h *sqlrpcv1.QueryResultHeader) string {
+	columns := make([]string, len(h.Columns))
+	for i, c := range h.Columns {
+		columns[i] = quoteIdentifier(c)
+		if i < len(h.ColumnRawTypes) && h.ColumnRawTypes[i] != "" {
+			columns[i] += " " + h.ColumnRawTypes[i]
+		}
+	}
+	return "CREATE TABLE " + quoteIdentifier(table) + "(" + strings.Join(columns, ", ") + ")"
+}
+
+// sqlLiteral renders a value as an SQL literal. BLOB columns arrive base64 encoded.
+func sqlLiteral(v *structpb.Value, affinity sqlrpcv1.ColumnAffinity) string {
+	switch k := v.Kind.(type) {
+	case *structpb.Value_NumberValue:
+		return strconv.FormatFloat(k.NumberValue, 'f', -1, 64)
+	case *structpb.Value_BoolValue:
+		if k.BoolValue {
+			return "1"
+		}
+		return "0"
+	case *structpb.Value_StringValue:
+		if affinity == sqlrpcv1.ColumnAffinity_COLUMN_AFFINITY_BLOB {
+			if blob, err := base64.StdEncoding.DecodeString(k.StringValue); err == nil {
+				return "X'" + hex.EncodeToString(blob) + "'"
+			}
+		}
+		return "'" + strings.ReplaceAll(k.StringValue, "'", "''") + "'"
+	}
+	return "NULL"
+}
+
+// sqliteExportWriter inserts the rows into table in a new database file.
+type sqliteExportWriter struct {
+	ctx        context.Context
+	path       string
+	table      string
+	db         *sql.DB
+	tx         *sql.Tx
+	insert     *sql.Stmt
+	affinities []sqlrpcv1.ColumnAffinity
+	args       []any
+}
+
+func newSQLiteExportWriter(ctx context.Context, table string) (*sqliteExportWriter, error) {
+	tmp, err := os.CreateTemp("", "sqlite-server-export-*.db")
+	if err != nil {
+		return nil, err
+	}
+	tmp.Close()
+	db, err := sql.Open("sqlite3", "file:"+tmp.Name())
+	if err != nil {
+		os.Remove(tmp.Name())
+		return nil, err
+	}
+	return &sqliteExportWriter{ctx: ctx, path: tmp.Name(), table: table, db: db}, nil
+}
+
+// close releases the database and removes its file.
+func (w *sqliteExportWriter) close() {
+	if w.tx != nil {
+		w.tx.Rollback()
+	}
+	w.db.Close()
+	os.Remove(w.path)
+}
+
+func (w *sqliteExportWriter) SendHeader(h *sqlrpcv1.QueryResultHeader) error {
+	if _, err := w.db.ExecContext(w.ctx, exportTableDDL(w.table, h)); err != nil {
+		return err
+	}
+	tx, err := w.db.BeginTx(w.ctx, nil)
+	if err != nil {
+		return err
+	}
+	w.tx = tx
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(h.Columns)), ",")
+	if w.insert, err = tx.PrepareContext(w.ctx, "INSERT INTO "+quoteIdentifier(w.table)+" VALUES("+placeholders+")"); err != nil {
+		return err
+	}
+	w.affinities = h.ColumnAffinities
+	w.args = make([]any, len(h.Columns))
+	return nil
+}
+
+func (w *sqliteExportWriter) SendRowBatch(b *sqlrpcv1.QueryResultRowBatch) error {
+	for _, row := range b.Rows {
+		for i, v := range row.Values {
+			w.args[i] = v.AsInterface()
+			switch k := v.Kind.(type) {
+			case *structpb.Value_NumberValue:
+				if w.affinities[i] == sqlrpcv1.ColumnAffinity_COLUMN_AFFINITY_INTEGER {
+					w.args[i] = int64(k.NumberValue)
+				}
+			case *structpb.Value_StringValue:
+				if w.affinities[i] == sqlrpcv1.ColumnAffinity_COLUMN_AFFINITY_BLOB {
+					if blob, err := base64.StdEncoding.DecodeString(k.StringValue); err == nil {
+						w.args[i] = blob
+					}
+				}
+			}
+		}
+		if _, err := w.insert.ExecContext(w.ctx, w.args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *sqliteExportWriter) SendDMLResult(*sqlrpcv1.ExecResponse) error { return errExportDML }
+
+func (w *sqliteExportWriter) SendComplete(*sqlrpcv1.ExecutionStats) error {
+	err := w.tx.Commit()
+	w.tx = nil
+	return err
+}
+
+// writeHTTPError answers a plain HTTP request with the status the Connect protocol
+// uses for err's code and its message as text.
+func writeHTTPError(w http.ResponseWriter, err error) {
+	message := err.Error()
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		message = connectErr.Message()
+	}
+	http.Error(w, message, httpStatus(connect.CodeOf(err)))
+}
+
+// httpStatus maps a connect code to an HTTP status like the Connect protocol does.
+func httpStatus(code connect.Code) int {
+	switch code {
+	case connect.CodeCanceled:
+		return 499
+	case connect.CodeInvalidArgument, connect.CodeFailedPrecondition, connect.CodeOutOfRange:
+		return http.StatusBadRequest
+	case connect.CodeDeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case connect.CodeNotFound:
+		return http.StatusNotFound
+	case connect.CodeAlreadyExists, connect.CodeAborted:
+		return http.StatusConflict
+	case connect.CodePermissionDenied:
+		return http.StatusForbidden
+	case connect.CodeResourceExhausted:
+		return http.StatusTooManyRequests
+	case connect.CodeUnimplemented:
+		return http.StatusNotImplemented
+	case connect.CodeUnavailable:
+		return http.StatusServiceUnavailable
+	case connect.CodeUnauthenticated:
+		return http.StatusUnauthorized
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,199 @@
+package servicesv1
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"sqlite-server/internal/auth"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+)
+
+// setupExportServer serves the export handler of a setupTestServer database.
+func setupExportServer(t *testing.T, authorizer HTTPAuthorizer) string {
+	_, server := setupTestServer(t)
+	mux := http.NewServeMux()
+	mux.Handle("GET /export/{db}", server.ExportHandler(authorizer))
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	return ts.URL
+}
+
+// export downloads /export/{db} with the given query parameters.
+func export(t *testing.T, base, db string, params url.Values, header http.Header) (*http.Response, []byte) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, base+"/export/"+db+"?"+params.Encode(), nil)
+	require.NoError(t, err)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, body
+}
+
+// openDownload writes a downloaded database file to disk and opens it.
+func openDownload(t *testing.T, body []byte) *sql.DB {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "download.db")
+	require.NoError(t, os.WriteFile(path, body, 0o600))
+	db, err := sql.Open("sqlite3", path)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestExport(t *testing.T) {
+	base := setupExportServer(t, NewNoAuthInterceptor())
+	query := "SELECT id, name, age, avatar FROM users ORDER BY id"
+
+	t.Run("csv", func(t *testing.T) {
+		resp, body := export(t, base, "test", url.Values{"sql": {query}}, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+		assert.Equal(t, "text/csv; charset=utf-8", resp.Header.Get("Content-Type"))
+		assert.Equal(t, `attachment; filename=test.csv`, resp.Header.Get("Content-Disposition"))
+		assert.NotEmpty(t, resp.Header.Get(headerRequestID))
+		assert.Equal(t, "id,name,age,avatar\n1,Alice,30,3q2+7w==\n2,Bob,40,\n", string(body))
+	})
+
+	t.Run("binds parameters", func(t *testing.T) {
+		resp, body := export(t, base, "test", url.Values{"sql": {"SELECT name FROM users WHERE age > ?"}, "params": {"[35]"}}, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+		assert.Equal(t, "name\nBob\n", string(body))
+
+		resp, body = export(t, base, "test", url.Values{"sql": {"SELECT name FROM users WHERE age < :age"}, "params": {`{"age": 35}`}}, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+		assert.Equal(t, "name\nAlice\n", string(body))
+	})
+
+	t.Run("ndjson", func(t *testing.T) {
+		resp, body := export(t, base, "test", url.Values{"sql": {"SELECT id, name FROM users ORDER BY id"}, "format": {"ndjson"}}, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+		assert.Equal(t, "{\"id\":1,\"name\":\"Alice\"}\n{\"id\":2,\"name\":\"Bob\"}\n", string(body))
+	})
+
+	t.Run("sql", func(t *testing.T) {
+		resp, body := export(t, base, "test", url.Values{"sql": {query}, "format": {"sql"}, "table": {"people"}}, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+		assert.Equal(t, `attachment; filename=people.sql`, resp.Header.Get("Content-Disposition"))
+		assert.Contains(t, string(body), `CREATE TABLE "people"("id" INTEGER, "name" TEXT, "age" INTEGER, "avatar" BLOB);`)
+		assert.Contains(t, string(body), `INSERT INTO "people" VALUES(1,'Alice',30,X'deadbeef');`)
+
+		db, err := sql.Open("sqlite3", ":memory:")
+		require.NoError(t, err)
+		defer db.Close()
+		db.SetMaxOpenConns(1)
+		_, err = db.Exec(string(body))
+		require.NoError(t, err)
+		var count int
+		require.NoError(t, db.QueryRow("SELECT count(*) FROM people WHERE avatar IS NULL").Scan(&count))
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("sqlite", func(t *testing.T) {
+		resp, body := export(t, base, "test", url.Values{"sql": {query}, "format": {"sqlite"}}, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+		assert.Equal(t, "application/vnd.sqlite3", resp.Header.Get("Content-Type"))
+
+		var name string
+		var avatar []byte
+		require.NoError(t, openDownload(t, body).QueryRow("SELECT name, avatar FROM export WHERE id = 1").Scan(&name, &avatar))
+		assert.Equal(t, "Alice", name)
+		assert.Equal(t, []byte{0xde, 0xad, 0xbe, 0xef}, avatar)
+	})
+
+	t.Run("whole database", func(t *testing.T) {
+		resp, body := export(t, base, "test", url.Values{"format": {"sqlite"}}, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+		var count int
+		require.NoError(t, openDownload(t, body).QueryRow("SELECT count(*) FROM users").Scan(&count))
+		assert.Equal(t, 2, count)
+
+		resp, body = export(t, base, "test", url.Values{"format": {"sql"}}, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+		assert.True(t, strings.HasPrefix(string(body), "PRAGMA foreign_keys=OFF;\n"))
+		assert.Contains(t, string(body), `INSERT INTO "users" VALUES(2,'Bob',40,NULL,0);`)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			db     string
+			params url.Values
+			status int
+		}{
+			"unknown format":   {"test", url.Values{"sql": {query}, "format": {"xml"}}, http.StatusBadRequest},
+			"missing query":    {"test", url.Values{"format": {"csv"}}, http.StatusBadRequest},
+			"bad params":       {"test", url.Values{"sql": {query}, "params": {"1"}}, http.StatusBadRequest},
+			"write":            {"test", url.Values{"sql": {"DELETE FROM users"}}, http.StatusBadRequest},
+			"syntax error":     {"test", url.Values{"sql": {"SELECT FROM"}}, http.StatusBadRequest},
+			"unknown database": {"nope", url.Values{"sql": {query}}, http.StatusNotFound},
+		} {
+			t.Run(name, func(t *testing.T) {
+				resp, body := export(t, base, tc.db, tc.params, nil)
+				assert.Equal(t, tc.status, resp.StatusCode, string(body))
+				assert.Empty(t, resp.Header.Get("Content-Disposition"))
+			})
+		}
+	})
+}
+
+func TestExportAuthorization(t *testing.T) {
+	store, err := auth.NewMetaStore(filepath.Join(t.TempDir(), "meta.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { store.Close() })
+	_, err = store.CreateUser(context.Background(), "reader", "pass", sqlrpcv1.Role_ROLE_READ_ONLY)
+	require.NoError(t, err)
+
+	base := setupExportServer(t, NewAuthInterceptor(store))
+	reader := http.Header{}
+	reader.Set("Authorization", "Basic cmVhZGVyOnBhc3M=") // reader:pass
+
+	resp, body := export(t, base, "test", url.Values{"sql": {"SELECT name FROM users"}}, nil)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, string(body))
+
+	resp, body = export(t, base, "test", url.Values{"sql": {"SELECT name FROM users ORDER BY id"}}, reader)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	assert.Equal(t, "name\nAlice\nBob\n", string(body))
+
+	// A snapshot is a DownloadBackup, which takes read-write access
+	resp, body = export(t, base, "test", url.Values{"format": {"sqlite"}}, reader)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode, string(body))
+
+	resp, body = export(t, base, "test", url.Values{"sql": {"ATTACH 'x.db' AS x"}}, reader)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode, string(body))
+}
+
+func TestExportRateLimit(t *testing.T) {
+	store, err := auth.NewMetaStore(filepath.Join(t.TempDir(), "meta.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { store.Close() })
+	_, err = store.CreateUser(context.Background(), "reader", "pass", sqlrpcv1.Role_ROLE_READ_ONLY)
+	require.NoError(t, err)
+	_, err = store.SetRateLimit(context.Background(), "reader", "", auth.RateLimit{Database: "test", RowsPerMinute: 2})
+	require.NoError(t, err)
+
+	base := setupExportServer(t, NewRateLimiter(store).GuardHTTP(NewAuthInterceptor(store)))
+	reader := http.Header{}
+	reader.Set("Authorization", "Basic cmVhZGVyOnBhc3M=") // reader:pass
+
+	resp, body := export(t, base, "test", url.Values{"sql": {"SELECT name FROM users"}}, reader)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	assert.Equal(t, "2", resp.Header.Get(headerQuotaRows))
+
+	// The exported rows used the quota up
+	resp, body = export(t, base, "test", url.Values{"sql": {"SELECT name FROM users"}}, reader)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode, string(body))
+}
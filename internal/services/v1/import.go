@@ -0,0 +1,614 @@
+package servicesv1
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+
+	"sqlite-server/internal/logging"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+)
+
+const (
+	// defaultImportBatchSize is the records per transaction of an Import without batch_size.
+	defaultImportBatchSize = 1000
+
+	// defaultImportErrorSamples is the rejected records reported without max_error_samples.
+	defaultImportErrorSamples = 10
+
+	// maxImportLineBytes bounds a single NDJSON record.
+	maxImportLineBytes = 16 << 20
+)
+
+// errImportPayload is returned when a message does not fit the import's format.
+var errImportPayload = errors.New("unexpected message: after 'start', CSV and NDJSON imports send 'data' chunks and ROWS imports send 'rows'")
+
+// importRecord is one record of the input: positional values for CSV and rows, or
+// values by key for NDJSON. n is its 1-based position.
+type importRecord struct {
+	n      int64
+	values []any
+	named  map[string]any
+}
+
+// recordError rejects a single record; the import goes on.
+type recordError struct {
+	err error
+}
+
+func (e *recordError) Error() string { return e.err.Error() }
+
+// importSource yields the records of an Import stream, io.EOF after the last one.
+type importSource interface {
+	next() (importRecord, error)
+}
+
+// jsonText is a JSON object or array read from NDJSON, inserted as its text.
+type jsonText string
+
+// Import handles the client-streaming `Import` RPC.
+//
+// The records are inserted through one prepared INSERT, batch_size records per
+// transaction. Records that fail to parse or violate a constraint are rejected
+// and counted; any other error stops the import, keeping the batches already
+// committed.
+func (s *DbServer) Import(ctx context.Context, stream *connect.ClientStream[sqlrpcv1.ImportRequest]) (*connect.Response[sqlrpcv1.ImportResponse], error) {
+	reqID := ensureRequestID(stream.RequestHeader())
+
+	if !stream.Receive() {
+		if err := stream.Err(); err != nil {
+			return nil, err
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("import stream is empty"))
+	}
+	if err := protovalidate.Validate(stream.Msg()); err != nil {
+		rpcLog.WarnContext(ctx, "Validation failed for Import", logging.Err(err))
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	start := stream.Msg().GetStart()
+	if start == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("the first message must be 'start'"))
+	}
+
+	db, err := s.dbManager.GetConnection(ctx, start.Database, ModeRW)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	columns, err := tableColumns(ctx, db, start.Table)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if columns == nil && !start.CreateTable {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("table %q does not exist; set create_table to create it", start.Table))
+	}
+
+	imp := &importer{
+		server:     s,
+		reqID:      reqID,
+		db:         db,
+		start:      start,
+		exists:     columns != nil,
+		batchSize:  defaultImportBatchSize,
+		maxSamples: defaultImportErrorSamples,
+		result:     &sqlrpcv1.ImportResponse{},
+	}
+	if start.BatchSize > 0 {
+		imp.batchSize = int(start.BatchSize)
+	}
+	if start.MaxErrorSamples > 0 {
+		imp.maxSamples = int(start.MaxErrorSamples)
+	}
+
+	src, header, err := newImportSource(stream, start)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(start.Fields) > 0:
+		imp.fields = start.Fields
+	case header != nil:
+		imp.fields = header
+	case columns != nil:
+		imp.fields = columns
+	case start.Format != sqlrpcv1.ImportFormat_IMPORT_FORMAT_NDJSON:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("fields are required to create a table from records without a header"))
+	}
+
+	startTime := time.Now()
+	batch := make([]importRecord, 0, imp.batchSize)
+	for {
+		rec, err := src.next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		var rejected *recordError
+		if errors.As(err, &rejected) {
+			imp.reject(rec.n, rejected)
+			continue
+		}
+		if err != nil {
+			return nil, imp.fail(err)
+		}
+		rec, err = imp.resolve(rec)
+		if err != nil {
+			imp.reject(rec.n, err)
+			continue
+		}
+		batch = append(batch, rec)
+		if len(batch) == imp.batchSize {
+			if err := imp.flush(ctx, batch); err != nil {
+				return nil, imp.fail(err)
+			}
+			batch = batch[:0]
+		}
+	}
+	if len(batch) > 0 {
+		if err := imp.flush(ctx, batch); err != nil {
+			return nil, imp.fail(err)
+		}
+	}
+
+	result := imp.result
+	result.Columns = imp.columns
+	result.Stats = &sqlrpcv1.ExecutionStats{
+		DurationMs:  float64(time.Since(startTime).Milliseconds()),
+		RowsWritten: result.RowsAccepted,
+	}
+	rpcLog.InfoContext(ctx, "Import finished", logging.KeyDatabase, start.Database, "table", start.Table,
+		"accepted", result.RowsAccepted, "rejected", result.RowsRejected, "ignored", result.RowsIgnored)
+
+	res := connect.NewResponse(result)
+	res.Header().Set(headerRequestID, reqID)
+	return res, nil
+}
+
+// importer holds the state of one Import call.
+type importer struct {
+	server     *DbServer
+	reqID      string
+	db         *sql.DB
+	start      *sqlrpcv1.ImportStart
+	exists     bool // The table existed before the import
+	batchSize  int
+	maxSamples int
+
+	fields  []string // Source field names, in insert order
+	columns []string // Target columns, parallel to fields
+	insert  string   // INSERT statement, once the first batch is flushed
+
+	result *sqlrpcv1.ImportResponse
+}
+
+// resolve turns rec into the positional values of the INSERT. The fields of an
+// NDJSON import without fields are the keys of its first record.
+func (imp *importer) resolve(rec importRecord) (importRecord, error) {
+	if rec.named != nil {
+		if imp.fields == nil {
+			imp.fields = slices.Sorted(maps.Keys(rec.named))
+		}
+		values := make([]any, len(imp.fields))
+		for i, f := range imp.fields {
+			values[i] = rec.named[f]
+		}
+		rec.values = values
+	}
+	if len(rec.values) != len(imp.fields) {
+		return rec, &recordError{fmt.Errorf("record has %d fields, expected %d", len(rec.values), len(imp.fields))}
+	}
+	return rec, nil
+}
+
+// reject counts a rejected record and keeps its error as a sample.
+func (imp *importer) reject(n int64, err error) {
+	imp.result.RowsRejected++
+	if len(imp.result.Errors) < imp.maxSamples {
+		imp.result.Errors = append(imp.result.Errors, &sqlrpcv1.ImportRowError{Record: n, Message: err.Error()})
+	}
+}
+
+// fail converts an error that stops the import. Batches committed before it stay.
+func (imp *importer) fail(err error) error {
+	if imp.result.BatchesCommitted > 0 {
+		err = fmt.Errorf("import stopped after committing %d rows: %w", imp.result.RowsAccepted, err)
+	}
+	var connectErr *connect.Error
+	switch {
+	case errors.As(err, &connectErr):
+		return err
+	case errors.Is(err, errImportPayload):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return makeUnaryError(err, imp.insert)
+}
+
+// prepare builds the INSERT on the first flush, along with the CREATE TABLE of a
+// missing table whose column types are inferred from batch.
+func (imp *importer) prepare(ctx context.Context, batch []importRecord) (ddl string, err error) {
+	imp.columns = make([]string, len(imp.fields))
+	seen := make(map[string]bool, len(imp.fields))
+	for i, f := range imp.fields {
+		column := f
+		if mapped, ok := imp.start.ColumnMapping[f]; ok {
+			column = mapped
+		}
+		if seen[strings.ToLower(column)] {
+			return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("column %q is the target of more than one field", column))
+		}
+		seen[strings.ToLower(column)] = true
+		imp.columns[i] = column
+	}
+
+	quoted := make([]string, len(imp.columns))
+	for i, c := range imp.columns {
+		quoted[i] = quoteIdentifier(c)
+	}
+	if !imp.exists {
+		types := inferDeclaredTypes(batch, len(imp.columns), imp.start.Format == sqlrpcv1.ImportFormat_IMPORT_FORMAT_CSV)
+		defs := make([]string, len(quoted))
+		for i := range quoted {
+			defs[i] = quoted[i] + " " + strings.TrimPrefix(types[i].String(), "DECLARED_TYPE_")
+		}
+		ddl = fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s)", quoteIdentifier(imp.start.Table), strings.Join(defs, ", "))
+		imp.result.ColumnDeclaredTypes = types
+	}
+
+	verb := "INSERT"
+	switch imp.start.OnConflict {
+	case sqlrpcv1.ImportConflict_IMPORT_CONFLICT_IGNORE:
+		verb = "INSERT OR IGNORE"
+	case sqlrpcv1.ImportConflict_IMPORT_CONFLICT_REPLACE:
+		verb = "INSERT OR REPLACE"
+	}
+	imp.insert = fmt.Sprintf("%s INTO %s (%s) VALUES (%s)", verb, quoteIdentifier(imp.start.Table),
+		strings.Join(quoted, ", "), strings.TrimSuffix(strings.Repeat("?, ", len(quoted)), ", "))
+
+	return ddl, nil
+}
+
+// flush inserts batch in one transaction. Records that violate a constraint are
+// rejected without failing the batch.
+func (imp *importer) flush(ctx context.Context, batch []importRecord) (err error) {
+	var ddl string
+	if imp.insert == "" {
+		if ddl, err = imp.prepare(ctx, batch); err != nil {
+			return err
+		}
+	}
+
+	ctx, done := imp.server.startStatement(ctx, imp.reqID, imp.start.Database, imp.insert, 0)
+	defer done()
+	defer func() { err = statementError(ctx, err) }()
+
+	tx, insert, err := imp.server.beginRestricted(ctx, imp.db, imp.start.Database, imp.insert)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	if ddl != "" {
+		if _, err := tx.ExecContext(ctx, ddl); err != nil {
+			return err
+		}
+	}
+
+	stmt, err := tx.PrepareContext(ctx, insert)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	var accepted, ignored int64
+	type rejection struct {
+		n   int64
+		err error
+	}
+	var rejected []rejection
+	for _, rec := range batch {
+		res, err := stmt.ExecContext(ctx, rec.values...)
+		if err != nil {
+			if !isRecordLevel(err) {
+				return err
+			}
+			rejected = append(rejected, rejection{rec.n, err})
+			continue
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			ignored++
+		} else {
+			accepted++
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	tx = nil
+
+	imp.result.RowsAccepted += accepted
+	imp.result.RowsIgnored += ignored
+	for _, r := range rejected {
+		imp.reject(r.n, r.err)
+	}
+	imp.result.BatchesCommitted++
+	if ddl != "" {
+		imp.result.TableCreated = true
+		imp.exists = true
+	}
+	return nil
+}
+
+// isRecordLevel reports whether an insert failed because of the record itself:
+// a constraint violation, a datatype mismatch or an oversized value.
+func isRecordLevel(err error) bool {
+	switch extractSqliteCode(err) & 0xFF {
+	case 18, 19, 20, 25: // SQLITE_TOOBIG, SQLITE_CONSTRAINT, SQLITE_MISMATCH, SQLITE_RANGE
+		return true
+	}
+	return false
+}
+
+// tableColumns returns the columns of table in the main schema, or nil when it
+// does not exist.
+func tableColumns(ctx context.Context, db *sql.DB, table string) ([]string, error) {
+	rows, err := db.QueryContext(ctx, "SELECT name FROM pragma_table_info(?) ORDER BY cid", table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var columns []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		columns = append(columns, name)
+	}
+	return columns, rows.Err()
+}
+
+// inferDeclaredTypes picks a column type from the values of each column in batch.
+// Untyped CSV text counts as an integer or a real when it parses as one.
+func inferDeclaredTypes(batch []importRecord, columns int, csvText bool) []sqlrpcv1.DeclaredType {
+	const (
+		kindInt = 1 << iota
+		kindReal
+		kindBool
+		kindText
+		kindBlob
+		kindJSON
+	)
+	kinds := make([]int, columns)
+	for _, rec := range batch {
+		for i, v := range rec.values {
+			switch v := v.(type) {
+			case int64:
+				kinds[i] |= kindInt
+			case float64:
+				kinds[i] |= kindReal
+			case bool:
+				kinds[i] |= kindBool
+			case []byte:
+				kinds[i] |= kindBlob
+			case jsonText:
+				kinds[i] |= kindJSON
+			case string:
+				if !csvText {
+					kinds[i] |= kindText
+				} else if _, err := strconv.ParseInt(v, 10, 64); err == nil {
+					kinds[i] |= kindInt
+				} else if f, err := strconv.ParseFloat(v, 64); err == nil && !math.IsInf(f, 0) && !math.IsNaN(f) {
+					kinds[i] |= kindReal
+				} else {
+					kinds[i] |= kindText
+				}
+			}
+		}
+	}
+
+	types := make([]sqlrpcv1.DeclaredType, columns)
+	for i, k := range kinds {
+		switch {
+		case k == kindBool:
+			types[i] = sqlrpcv1.DeclaredType_DECLARED_TYPE_BOOLEAN
+		case k != 0 && k&^(kindInt|kindBool) == 0:
+			types[i] = sqlrpcv1.DeclaredType_DECLARED_TYPE_INTEGER
+		case k != 0 && k&^(kindInt|kindReal|kindBool) == 0:
+			types[i] = sqlrpcv1.DeclaredType_DECLARED_TYPE_REAL
+		case k == kindJSON:
+			types[i] = sqlrpcv1.DeclaredType_DECLARED_TYPE_JSON
+		case k == kindBlob:
+			types[i] = sqlrpcv1.DeclaredType_DECLARED_TYPE_BLOB
+		default:
+			types[i] = sqlrpcv1.DeclaredType_DECLARED_TYPE_TEXT
+		}
+	}
+	return types
+}
+
+// newImportSource returns the record reader for the import's format and, for a CSV
+// import with csv_header, the field names read from the header.
+func newImportSource(stream *connect.ClientStream[sqlrpcv1.ImportRequest], start *sqlrpcv1.ImportStart) (importSource, []string, error) {
+	chunks := &importChunks{stream: stream}
+	switch start.Format {
+	case sqlrpcv1.ImportFormat_IMPORT_FORMAT_CSV:
+		r := csv.NewReader(chunks)
+		r.FieldsPerRecord = -1
+		if start.CsvDelimiter != "" {
+			r.Comma, _ = utf8.DecodeRuneInString(start.CsvDelimiter)
+		}
+		var header []string
+		if start.CsvHeader {
+			var err error
+			header, err = r.Read()
+			var parseErr *csv.ParseError
+			switch {
+			case errors.Is(err, errImportPayload):
+				return nil, nil, connect.NewError(connect.CodeInvalidArgument, err)
+			case errors.As(err, &parseErr):
+				return nil, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid CSV header: %w", err))
+			case err != nil && !errors.Is(err, io.EOF):
+				return nil, nil, err
+			}
+		}
+		return &csvSource{r: r}, header, nil
+	case sqlrpcv1.ImportFormat_IMPORT_FORMAT_NDJSON:
+		scanner := bufio.NewScanner(chunks)
+		scanner.Buffer(make([]byte, 0, 64<<10), maxImportLineBytes)
+		return &ndjsonSource{scanner: scanner}, nil, nil
+	default:
+		return &rowSource{stream: stream}, nil, nil
+	}
+}
+
+// importChunks reads the data chunks of an Import stream as one byte stream.
+type importChunks struct {
+	stream *connect.ClientStream[sqlrpcv1.ImportRequest]
+	buf    []byte
+}
+
+func (c *importChunks) Read(p []byte) (int, error) {
+	for len(c.buf) == 0 {
+		if !c.stream.Receive() {
+			if err := c.stream.Err(); err != nil {
+				return 0, err
+			}
+			return 0, io.EOF
+		}
+		data, ok := c.stream.Msg().Payload.(*sqlrpcv1.ImportRequest_Data)
+		if !ok {
+			return 0, errImportPayload
+		}
+		c.buf = data.Data
+	}
+	n := copy(p, c.buf)
+	c.buf = c.buf[n:]
+	return n, nil
+}
+
+// csvSource reads CSV records. Empty fields are inserted as NULL.
+type csvSource struct {
+	r *csv.Reader
+	n int64
+}
+
+func (s *csvSource) next() (importRecord, error) {
+	fields, err := s.r.Read()
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			s.n++
+			return importRecord{n: s.n}, &recordError{err}
+		}
+		return importRecord{}, err
+	}
+	s.n++
+	values := make([]any, len(fields))
+	for i, f := range fields {
+		if f != "" {
+			values[i] = f
+		}
+	}
+	return importRecord{n: s.n, values: values}, nil
+}
+
+// ndjsonSource reads one JSON object per line. Blank lines are skipped.
+type ndjsonSource struct {
+	scanner *bufio.Scanner
+	n       int64
+}
+
+func (s *ndjsonSource) next() (importRecord, error) {
+	for s.scanner.Scan() {
+		line := bytes.TrimSpace(s.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		s.n++
+		dec := json.NewDecoder(bytes.NewReader(line))
+		dec.UseNumber()
+		var object map[string]any
+		if err := dec.Decode(&object); err != nil {
+			return importRecord{n: s.n}, &recordError{fmt.Errorf("invalid JSON object: %w", err)}
+		}
+		if object == nil {
+			return importRecord{n: s.n}, &recordError{errors.New("invalid JSON object: null")}
+		}
+		named := make(map[string]any, len(object))
+		for k, v := range object {
+			named[k] = jsonToSQL(v)
+		}
+		return importRecord{n: s.n, named: named}, nil
+	}
+	if err := s.scanner.Err(); err != nil {
+		return importRecord{}, err
+	}
+	return importRecord{}, io.EOF
+}
+
+// jsonToSQL converts a decoded JSON value to an insert argument. Objects and arrays
+// are inserted as JSON text.
+func jsonToSQL(v any) any {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]any, []any:
+		b, _ := json.Marshal(v)
+		return jsonText(b)
+	default:
+		return v
+	}
+}
+
+// rowSource reads the records of SqlRow batches.
+type rowSource struct {
+	stream *connect.ClientStream[sqlrpcv1.ImportRequest]
+	rows   []*sqlrpcv1.SqlRow
+	n      int64
+}
+
+func (s *rowSource) next() (importRecord, error) {
+	for len(s.rows) == 0 {
+		if !s.stream.Receive() {
+			if err := s.stream.Err(); err != nil {
+				return importRecord{}, err
+			}
+			return importRecord{}, io.EOF
+		}
+		batch, ok := s.stream.Msg().Payload.(*sqlrpcv1.ImportRequest_Rows)
+		if !ok {
+			return importRecord{}, errImportPayload
+		}
+		s.rows = batch.Rows.GetRows()
+	}
+	row := s.rows[0]
+	s.rows = s.rows[1:]
+	s.n++
+	values := make([]any, len(row.Values))
+	for i, v := range row.Values {
+		values[i] = sqlValueToAny(v)
+	}
+	return importRecord{n: s.n, values: values}, nil
+}
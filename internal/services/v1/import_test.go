@@ -0,0 +1,164 @@
+package servicesv1
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+	"sqlite-server/internal/protos/sqlrpc/v1/sqlrpcv1connect"
+)
+
+func TestImport(t *testing.T) {
+	client, _ := setupTestServer(t)
+	ctx := context.Background()
+
+	run := func(client sqlrpcv1connect.DatabaseServiceClient, msgs ...*sqlrpcv1.ImportRequest) (*sqlrpcv1.ImportResponse, error) {
+		stream := client.Import(ctx)
+		for _, m := range msgs {
+			if err := stream.Send(m); err != nil {
+				break
+			}
+		}
+		res, err := stream.CloseAndReceive()
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg, nil
+	}
+	start := func(s *sqlrpcv1.ImportStart) *sqlrpcv1.ImportRequest {
+		s.Database = "test"
+		return &sqlrpcv1.ImportRequest{Payload: &sqlrpcv1.ImportRequest_Start{Start: s}}
+	}
+	data := func(s string) *sqlrpcv1.ImportRequest {
+		return &sqlrpcv1.ImportRequest{Payload: &sqlrpcv1.ImportRequest_Data{Data: []byte(s)}}
+	}
+	rows := func(rows ...[]*sqlrpcv1.SqlValue) *sqlrpcv1.ImportRequest {
+		batch := &sqlrpcv1.ImportRowBatch{}
+		for _, r := range rows {
+			batch.Rows = append(batch.Rows, &sqlrpcv1.SqlRow{Values: r})
+		}
+		return &sqlrpcv1.ImportRequest{Payload: &sqlrpcv1.ImportRequest_Rows{Rows: batch}}
+	}
+	integer := func(i int64) *sqlrpcv1.SqlValue {
+		return &sqlrpcv1.SqlValue{Value: &sqlrpcv1.SqlValue_IntegerValue{IntegerValue: i}}
+	}
+	text := func(s string) *sqlrpcv1.SqlValue {
+		return &sqlrpcv1.SqlValue{Value: &sqlrpcv1.SqlValue_TextValue{TextValue: s}}
+	}
+	query := func(sql string) [][]any {
+		t.Helper()
+		res, err := client.Query(ctx, connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "test", Sql: sql}))
+		require.NoError(t, err)
+		var out [][]any
+		for _, r := range res.Msg.Rows {
+			out = append(out, r.AsSlice())
+		}
+		return out
+	}
+
+	t.Run("csv into an existing table", func(t *testing.T) {
+		res, err := run(client,
+			start(&sqlrpcv1.ImportStart{
+				Table:         "users",
+				Format:        sqlrpcv1.ImportFormat_IMPORT_FORMAT_CSV,
+				CsvHeader:     true,
+				ColumnMapping: map[string]string{"full_name": "name"},
+				BatchSize:     2,
+			}),
+			// Chunks split records anywhere
+			data("id,full_name,age\n10,Carol,2"),
+			data("5\n11,\"Dave, Jr.\",\n12,too,many,fields\n1,Duplicate,1\n"),
+			data("13,Erin,45"),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), res.RowsAccepted)
+		assert.Equal(t, int64(2), res.RowsRejected)
+		assert.Equal(t, int64(2), res.BatchesCommitted)
+		assert.Equal(t, []string{"id", "name", "age"}, res.Columns)
+		assert.False(t, res.TableCreated)
+		require.Len(t, res.Errors, 2)
+		assert.Equal(t, int64(3), res.Errors[0].Record)
+		assert.Contains(t, res.Errors[0].Message, "4 fields")
+		assert.Equal(t, int64(4), res.Errors[1].Record)
+		assert.Contains(t, res.Errors[1].Message, "UNIQUE")
+
+		assert.Equal(t, [][]any{{"Carol", 25.0}, {"Dave, Jr.", nil}, {"Erin", 45.0}},
+			query("SELECT name, age FROM users WHERE id >= 10 ORDER BY id"), "empty fields are NULL")
+	})
+
+	t.Run("ndjson creates the table", func(t *testing.T) {
+		res, err := run(client,
+			start(&sqlrpcv1.ImportStart{
+				Table:       "events",
+				Format:      sqlrpcv1.ImportFormat_IMPORT_FORMAT_NDJSON,
+				CreateTable: true,
+			}),
+			data(`{"id": 1, "kind": "click", "score": 1.5, "ok": true, "meta": {"a": 1}}`+"\n"),
+			data("not json\n\n"+`{"id": 2, "kind": "view", "score": 2, "ok": false, "meta": [1]}`),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), res.RowsAccepted)
+		assert.Equal(t, int64(1), res.RowsRejected)
+		assert.Equal(t, int64(2), res.Errors[0].Record)
+		assert.True(t, res.TableCreated)
+		assert.Equal(t, []string{"id", "kind", "meta", "ok", "score"}, res.Columns)
+		assert.Equal(t, []sqlrpcv1.DeclaredType{
+			sqlrpcv1.DeclaredType_DECLARED_TYPE_INTEGER,
+			sqlrpcv1.DeclaredType_DECLARED_TYPE_TEXT,
+			sqlrpcv1.DeclaredType_DECLARED_TYPE_JSON,
+			sqlrpcv1.DeclaredType_DECLARED_TYPE_BOOLEAN,
+			sqlrpcv1.DeclaredType_DECLARED_TYPE_REAL,
+		}, res.ColumnDeclaredTypes)
+
+		assert.Equal(t, [][]any{{1.0, "click", `{"a":1}`, 1.0, 1.5}, {2.0, "view", "[1]", 0.0, 2.0}},
+			query("SELECT id, kind, meta, ok + 0, score FROM events ORDER BY id"), "booleans are stored as 1 and 0")
+	})
+
+	t.Run("rows with conflict handling", func(t *testing.T) {
+		res, err := run(client,
+			start(&sqlrpcv1.ImportStart{
+				Table:      "users",
+				Format:     sqlrpcv1.ImportFormat_IMPORT_FORMAT_ROWS,
+				Fields:     []string{"id", "name"},
+				OnConflict: sqlrpcv1.ImportConflict_IMPORT_CONFLICT_IGNORE,
+			}),
+			rows([]*sqlrpcv1.SqlValue{integer(1), text("Ignored")}, []*sqlrpcv1.SqlValue{integer(20), text("Frank")}),
+			rows([]*sqlrpcv1.SqlValue{integer(21)}),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), res.RowsAccepted)
+		assert.Equal(t, int64(1), res.RowsIgnored)
+		assert.Equal(t, int64(1), res.RowsRejected)
+
+		res, err = run(client,
+			start(&sqlrpcv1.ImportStart{
+				Table:      "users",
+				Format:     sqlrpcv1.ImportFormat_IMPORT_FORMAT_ROWS,
+				Fields:     []string{"id", "name"},
+				OnConflict: sqlrpcv1.ImportConflict_IMPORT_CONFLICT_REPLACE,
+			}),
+			rows([]*sqlrpcv1.SqlValue{integer(1), text("Replaced")}),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), res.RowsAccepted)
+		assert.Equal(t, [][]any{{"Replaced"}}, query("SELECT name FROM users WHERE id = 1"))
+	})
+
+	t.Run("rejections", func(t *testing.T) {
+		_, err := run(client, start(&sqlrpcv1.ImportStart{Table: "missing", Format: sqlrpcv1.ImportFormat_IMPORT_FORMAT_CSV}), data("1\n"))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+		_, err = run(client, data("1\n"))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "the first message must be 'start'")
+
+		_, err = run(client, start(&sqlrpcv1.ImportStart{Table: "users", Format: sqlrpcv1.ImportFormat_IMPORT_FORMAT_ROWS}), data("1\n"))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "data chunks in a rows import")
+
+		_, err = run(client, start(&sqlrpcv1.ImportStart{Table: "fresh", Format: sqlrpcv1.ImportFormat_IMPORT_FORMAT_CSV, CreateTable: true}), data("1\n"))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "headerless CSV needs fields to create a table")
+	})
+}
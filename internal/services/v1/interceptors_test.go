@@ -1,23 +1,31 @@
 package servicesv1
 
 import (
+	"bytes"
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	"sqlite-server/internal/auth"
+	"sqlite-server/internal/logging"
 	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
 
 	"connectrpc.com/connect"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 )
 
 // Helper to setup store with a user and api key
@@ -125,12 +133,17 @@ type mockStreamingConn struct {
 	header http.Header
 	msg    any // Message to return on Receive
 	spec   connect.Spec
+	peer   connect.Peer
 }
 
 func (m *mockStreamingConn) Spec() connect.Spec {
 	return m.spec
 }
 
+func (m *mockStreamingConn) Peer() connect.Peer {
+	return m.peer
+}
+
 func (m *mockStreamingConn) RequestHeader() http.Header {
 	return m.header
 }
@@ -651,25 +664,52 @@ func TestNoAuthInterceptor(t *testing.T) {
 }
 
 func TestLoggingInterceptor(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, logging.Setup(&buf, logging.Config{Format: logging.FormatJSON}))
+	t.Cleanup(func() { require.NoError(t, logging.Setup(os.Stderr, logging.Config{})) })
+
 	interceptor := LoggingInterceptor()
 	next := func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		logging.AddAttrs(ctx, logging.KeyUser, "alice")
+		rpcLog.InfoContext(ctx, "inside handler")
 		return connect.NewResponse(&struct{}{}), nil
 	}
 
 	t.Run("Logs request", func(t *testing.T) {
-		middleware := interceptor(next)
-		req := connect.NewRequest(&struct{}{})
+		buf.Reset()
+		middleware := interceptor.WrapUnary(next)
+		req := connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "app", Sql: "SELECT 1"})
 		_, err := middleware(context.Background(), req)
 		assert.NoError(t, err)
+
+		reqID := req.Header().Get(headerRequestID)
+		assert.NotEmpty(t, reqID, "a missing request ID is generated for the handler")
+
+		var records []map[string]any
+		for line := range strings.Lines(buf.String()) {
+			var rec map[string]any
+			require.NoError(t, json.Unmarshal([]byte(line), &rec))
+			records = append(records, rec)
+		}
+		require.Len(t, records, 2)
+		assert.Equal(t, "inside handler", records[0]["msg"])
+		assert.Equal(t, reqID, records[0][logging.KeyRequestID])
+		assert.Equal(t, "app", records[0][logging.KeyDatabase])
+		assert.Equal(t, "Request handled", records[1]["msg"])
+		assert.Equal(t, "alice", records[1][logging.KeyUser], "attributes added by inner layers are visible")
+		assert.Equal(t, "ok", records[1]["code"])
+		assert.Contains(t, records[1], logging.KeyDuration)
 	})
 
 	t.Run("Disabled logging", func(t *testing.T) {
+		buf.Reset()
 		t.Setenv("SQLITE_SERVER_DISABLE_REQUEST_LOGGING", "true")
 		interceptorDisabled := LoggingInterceptor()
-		middleware := interceptorDisabled(next)
+		middleware := interceptorDisabled.WrapUnary(next)
 		req := connect.NewRequest(&struct{}{})
 		_, err := middleware(context.Background(), req)
 		assert.NoError(t, err)
+		assert.NotContains(t, buf.String(), "Request handled")
 	})
 }
 
@@ -700,3 +740,363 @@ func TestAuthInterceptor_WrapStreamingHandler_EdgeCases(t *testing.T) {
 		})
 	}
 }
+
+type mockTxResolver map[string]string
+
+func (m mockTxResolver) TransactionDatabase(id string) (string, bool) {
+	db, ok := m[id]
+	return db, ok
+}
+
+func TestAuthInterceptor_DatabaseGrants(t *testing.T) {
+	store, _, apiKey := setupStore(t)
+	ctx := context.Background()
+	_, err := store.GrantDatabaseAccess(ctx, "testuser", "", "tenant_a", sqlrpcv1.Role_ROLE_READ_WRITE)
+	require.NoError(t, err)
+	_, err = store.GrantDatabaseAccess(ctx, "testuser", "", "shared_*", sqlrpcv1.Role_ROLE_READ_ONLY)
+	require.NoError(t, err)
+
+	interceptor := NewAuthInterceptor(store)
+	middleware := interceptor.WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return connect.NewResponse(&struct{}{}), nil
+	})
+	call := func(procedure string, anyReq connect.AnyRequest) error {
+		req := &mockRequest{AnyRequest: anyReq, spec: connect.Spec{Procedure: procedure}}
+		req.Header().Set("Authorization", "Bearer "+apiKey)
+		_, err := middleware(ctx, req)
+		return err
+	}
+
+	tests := []struct {
+		name      string
+		procedure string
+		req       connect.AnyRequest
+		allowed   bool
+	}{
+		{"write on granted database", "/sqlrpc.v1.DatabaseService/Query", connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "tenant_a", Sql: "INSERT INTO t VALUES (1)"}), true},
+		{"read on glob grant", "/sqlrpc.v1.DatabaseService/Query", connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "shared_docs", Sql: "SELECT 1"}), true},
+		{"write on read-only grant", "/sqlrpc.v1.DatabaseService/Query", connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "shared_docs", Sql: "DELETE FROM t"}), false},
+		{"read on ungranted database", "/sqlrpc.v1.DatabaseService/Query", connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "tenant_b", Sql: "SELECT 1"}), false},
+		{"static route on ungranted database", "/sqlrpc.v1.DatabaseService/ListTables", connect.NewRequest(&sqlrpcv1.ListTablesRequest{Database: "tenant_b"}), false},
+		{"min role above grant", "/sqlrpc.v1.DatabaseService/Vacuum", connect.NewRequest(&sqlrpcv1.VacuumRequest{Database: "shared_docs"}), false},
+		{"fallback route on ungranted database", "/sqlrpc.v1.DatabaseService/Publish", connect.NewRequest(&sqlrpcv1.PublishRequest{Database: "tenant_b"}), false},
+		{"transaction script on ungranted database", "/sqlrpc.v1.DatabaseService/ExecuteTransaction", connect.NewRequest(&sqlrpcv1.ExecuteTransactionRequest{
+			Requests: []*sqlrpcv1.TransactionRequest{{Command: &sqlrpcv1.TransactionRequest_Begin{Begin: &sqlrpcv1.BeginRequest{Database: "tenant_b"}}}},
+		}), false},
+		{"attach ungranted target", "/sqlrpc.v1.DatabaseService/AttachDatabase", connect.NewRequest(&sqlrpcv1.AttachDatabaseRequest{
+			ParentDatabase: "tenant_a",
+			Attachment:     &sqlrpcv1.Attachment{TargetDatabaseName: "tenant_b", Alias: "b"},
+		}), false},
+		{"admin database route below manager", "/sqlrpc.v1.AdminService/CreateDatabase", connect.NewRequest(&sqlrpcv1.CreateDatabaseRequest{Name: "tenant_a"}), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := call(tt.procedure, tt.req)
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+			}
+		})
+	}
+
+	t.Run("transaction id resolves to its database", func(t *testing.T) {
+		msg := &sqlrpcv1.TransactionQueryRequest{TransactionId: "tx-shared", Sql: "UPDATE t SET a = 1"}
+		// Without a resolver only the global role is known.
+		assert.NoError(t, call("/sqlrpc.v1.DatabaseService/TransactionQuery", connect.NewRequest(msg)))
+
+		interceptor.SetTransactionResolver(mockTxResolver{"tx-shared": "shared_docs"})
+		defer interceptor.SetTransactionResolver(nil)
+		err := call("/sqlrpc.v1.DatabaseService/TransactionQuery", connect.NewRequest(msg))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("stream checks the begin database", func(t *testing.T) {
+		stream := interceptor.WrapStreamingHandler(func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+			var msg sqlrpcv1.TransactionRequest
+			return conn.Receive(&msg)
+		})
+		header := http.Header{"Authorization": {"Bearer " + apiKey}}
+		spec := connect.Spec{Procedure: "/sqlrpc.v1.DatabaseService/Transaction"}
+
+		err := stream(ctx, &mockStreamingConn{header: header, spec: spec, msg: &sqlrpcv1.TransactionRequest{
+			Command: &sqlrpcv1.TransactionRequest_Begin{Begin: &sqlrpcv1.BeginRequest{Database: "tenant_b"}},
+		}})
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		err = stream(ctx, &mockStreamingConn{header: header, spec: spec, msg: &sqlrpcv1.TransactionRequest{
+			Command: &sqlrpcv1.TransactionRequest_Begin{Begin: &sqlrpcv1.BeginRequest{Database: "tenant_a"}},
+		}})
+		assert.NoError(t, err)
+	})
+
+	t.Run("stream write uses the begin database", func(t *testing.T) {
+		msgs := []*sqlrpcv1.TransactionRequest{
+			{Command: &sqlrpcv1.TransactionRequest_Begin{Begin: &sqlrpcv1.BeginRequest{Database: "shared_docs"}}},
+			{Command: &sqlrpcv1.TransactionRequest_Query{Query: &sqlrpcv1.TransactionalQueryRequest{Sql: "DELETE FROM t"}}},
+		}
+		stream := interceptor.WrapStreamingHandler(func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+			for range msgs {
+				var msg sqlrpcv1.TransactionRequest
+				if err := conn.Receive(&msg); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		conn := &sequenceStreamingConn{
+			mockStreamingConn: mockStreamingConn{header: http.Header{"Authorization": {"Bearer " + apiKey}}},
+			msgs:              msgs,
+		}
+		err := stream(ctx, conn)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+}
+
+// Streams are authorized with the role the caller holds on their database, which
+// may be above the global one.
+func TestAuthInterceptor_StreamGrantAboveRole(t *testing.T) {
+	store, _, _ := setupStore(t)
+	ctx := context.Background()
+	_, err := store.CreateUser(ctx, "reader", "pass", sqlrpcv1.Role_ROLE_READ_ONLY)
+	require.NoError(t, err)
+	_, err = store.GrantDatabaseAccess(ctx, "reader", "", "tenant_a", sqlrpcv1.Role_ROLE_READ_WRITE)
+	require.NoError(t, err)
+
+	stream := NewAuthInterceptor(store).WrapStreamingHandler(func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		var msg sqlrpcv1.ImportRequest
+		return conn.Receive(&msg)
+	})
+	importInto := func(database string) error {
+		return stream(ctx, &mockStreamingConn{
+			header: http.Header{"Authorization": {"Basic cmVhZGVyOnBhc3M="}}, // reader:pass
+			spec:   connect.Spec{Procedure: "/sqlrpc.v1.DatabaseService/Import"},
+			msg:    &sqlrpcv1.ImportRequest{Payload: &sqlrpcv1.ImportRequest_Start{Start: &sqlrpcv1.ImportStart{Database: database}}},
+		})
+	}
+
+	assert.NoError(t, importInto("tenant_a"))
+	err = importInto("tenant_b")
+	require.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+}
+
+func TestAuthInterceptor_ScopedAPIKeys(t *testing.T) {
+	store, _, _ := setupStore(t)
+	ctx := context.Background()
+	owner, err := store.GetUserByUsername(ctx, "testuser")
+	require.NoError(t, err)
+	apiKey, _, err := store.CreateScopedApiKey(ctx, owner.ID, "ci", nil, &auth.ApiKeyScope{
+		Databases: []string{"tenant_a"},
+		Channels:  []string{"deploys"},
+		Families:  []sqlrpcv1.RpcFamily{sqlrpcv1.RpcFamily_RPC_FAMILY_QUERY, sqlrpcv1.RpcFamily_RPC_FAMILY_SUBSCRIBE},
+		MaxRole:   sqlrpcv1.Role_ROLE_READ_ONLY,
+	})
+	require.NoError(t, err)
+
+	interceptor := NewAuthInterceptor(store)
+	middleware := interceptor.WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return connect.NewResponse(&struct{}{}), nil
+	})
+
+	tests := []struct {
+		name      string
+		procedure string
+		req       connect.AnyRequest
+		allowed   bool
+	}{
+		{"read in scope", "/sqlrpc.v1.DatabaseService/Query", connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "tenant_a", Sql: "SELECT 1"}), true},
+		{"write above max role", "/sqlrpc.v1.DatabaseService/Query", connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "tenant_a", Sql: "DELETE FROM t"}), false},
+		{"database out of scope", "/sqlrpc.v1.DatabaseService/Query", connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "tenant_b", Sql: "SELECT 1"}), false},
+		{"family out of scope", "/sqlrpc.v1.DatabaseService/Publish", connect.NewRequest(&sqlrpcv1.PublishRequest{Database: "tenant_a", Channel: "deploys"}), false},
+		{"admin family out of scope", "/sqlrpc.v1.AdminService/ListDatabases", connect.NewRequest(&sqlrpcv1.ListDatabasesRequest{}), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &mockRequest{AnyRequest: tt.req, spec: connect.Spec{Procedure: tt.procedure}}
+			req.Header().Set("Authorization", "Bearer "+apiKey)
+			_, err := middleware(ctx, req)
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+			}
+		})
+	}
+
+	t.Run("subscribe checks the channel", func(t *testing.T) {
+		stream := interceptor.WrapStreamingHandler(func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+			var msg sqlrpcv1.SubscribeRequest
+			return conn.Receive(&msg)
+		})
+		header := http.Header{"Authorization": {"Bearer " + apiKey}}
+		spec := connect.Spec{Procedure: "/sqlrpc.v1.DatabaseService/Subscribe"}
+
+		err := stream(ctx, &mockStreamingConn{header: header, spec: spec, msg: &sqlrpcv1.SubscribeRequest{Database: "tenant_a", Channel: "deploys"}})
+		assert.NoError(t, err)
+
+		err = stream(ctx, &mockStreamingConn{header: header, spec: spec, msg: &sqlrpcv1.SubscribeRequest{Database: "tenant_a", Channel: "billing"}})
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("stream family out of scope", func(t *testing.T) {
+		stream := interceptor.WrapStreamingHandler(func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+			return nil
+		})
+		err := stream(ctx, &mockStreamingConn{
+			header: http.Header{"Authorization": {"Bearer " + apiKey}},
+			spec:   connect.Spec{Procedure: "/sqlrpc.v1.AdminService/ListUsers"},
+		})
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+}
+
+func TestAuthInterceptor_BasicAuthLockout(t *testing.T) {
+	store, password, _ := setupStore(t)
+	store.SetLockoutPolicy(auth.LockoutPolicy{UserAttempts: 1, IPAttempts: 10, BaseDelay: time.Minute, MaxDelay: time.Hour, ResetAfter: time.Hour})
+	interceptor := NewAuthInterceptor(store)
+	unary := interceptor.WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return connect.NewResponse(&sqlrpcv1.QueryResponse{}), nil
+	})
+	basic := func(password string) string {
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte("testuser:"+password))
+	}
+	call := func(password string) error {
+		req := &mockRequest{AnyRequest: connect.NewRequest(&sqlrpcv1.QueryRequest{}), spec: connect.Spec{Procedure: "/sqlrpc.v1.DatabaseService/Query"}}
+		req.Header().Set("Authorization", basic(password))
+		_, err := unary(context.Background(), req)
+		return err
+	}
+
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(call("wrong-1")), "within the free attempts")
+	err := call("wrong-2")
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+	var connectErr *connect.Error
+	require.ErrorAs(t, err, &connectErr)
+	assert.Equal(t, "60", connectErr.Meta().Get("Retry-After"))
+
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(call(password)), "the right password is refused while locked")
+
+	stream := interceptor.WrapStreamingHandler(func(ctx context.Context, conn connect.StreamingHandlerConn) error { return nil })
+	err = stream(context.Background(), &mockStreamingConn{
+		header: http.Header{"Authorization": {basic(password)}},
+		spec:   connect.Spec{Procedure: "/sqlrpc.v1.DatabaseService/Transaction"},
+		peer:   connect.Peer{Addr: "192.0.2.1:5000"},
+	})
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+
+	_, err = store.UnlockUser(context.Background(), "testuser", "")
+	require.NoError(t, err)
+	assert.NoError(t, call(password))
+}
+
+func TestAuthInterceptor_JWT(t *testing.T) {
+	store, _, apiKey := setupStore(t)
+	ctx := context.Background()
+	_, err := store.CreateUser(ctx, "alice", "password123", sqlrpcv1.Role_ROLE_READ_ONLY)
+	require.NoError(t, err)
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	jwksPath := filepath.Join(t.TempDir(), "jwks.json")
+	jwks := fmt.Sprintf(`{"keys":[{"kty":"OKP","crv":"Ed25519","kid":"k1","x":%q}]}`,
+		base64.RawURLEncoding.EncodeToString(key.Public().(ed25519.PublicKey)))
+	require.NoError(t, os.WriteFile(jwksPath, []byte(jwks), 0o600))
+	verifier, err := auth.NewJWTVerifier(ctx, auth.JWTConfig{JWKS: jwksPath, Issuer: "https://idp.test", Audience: "sqlite"})
+	require.NoError(t, err)
+
+	sign := func(claims map[string]any) string {
+		header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"EdDSA","kid":"k1"}`))
+		payload, err := json.Marshal(claims)
+		require.NoError(t, err)
+		signed := header + "." + base64.RawURLEncoding.EncodeToString(payload)
+		return signed + "." + base64.RawURLEncoding.EncodeToString(ed25519.Sign(key, []byte(signed)))
+	}
+	token := func(sub, role string, ttl time.Duration) string {
+		return sign(map[string]any{"iss": "https://idp.test", "aud": "sqlite", "sub": sub, "role": role, "exp": time.Now().Add(ttl).Unix()})
+	}
+
+	interceptor := NewAuthInterceptor(store)
+	interceptor.SetJWTVerifier(verifier)
+	var seen *auth.UserClaims
+	unary := interceptor.WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		seen, _ = auth.FromContext(ctx)
+		return connect.NewResponse(&sqlrpcv1.QueryResponse{}), nil
+	})
+	call := func(bearer, procedure string, msg connect.AnyRequest) error {
+		req := &mockRequest{AnyRequest: msg, spec: connect.Spec{Procedure: procedure}}
+		req.Header().Set("Authorization", "Bearer "+bearer)
+		_, err := unary(ctx, req)
+		return err
+	}
+
+	t.Run("valid token", func(t *testing.T) {
+		require.NoError(t, call(token("svc-reporting", "read_only", time.Hour), "/sqlrpc.v1.DatabaseService/Query", connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "app", Sql: "SELECT 1"})))
+		require.NotNil(t, seen)
+		assert.Equal(t, "svc-reporting", seen.Username)
+		assert.Equal(t, "https://idp.test", seen.Issuer)
+
+		err := call(token("svc-reporting", "read_only", time.Hour), "/sqlrpc.v1.DatabaseService/Exec", connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "app", Sql: "DELETE FROM t"}))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err), "the mapped role applies")
+	})
+
+	t.Run("invalid tokens", func(t *testing.T) {
+		forged := sign(map[string]any{"iss": "https://evil.test", "aud": "sqlite", "sub": "x", "role": "admin", "exp": time.Now().Add(time.Hour).Unix()})
+		err := call(forged, "/sqlrpc.v1.DatabaseService/Query", connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "app", Sql: "SELECT 1"}))
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("api keys still work", func(t *testing.T) {
+		require.NoError(t, call(apiKey, "/sqlrpc.v1.DatabaseService/Query", connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "app", Sql: "SELECT 1"})))
+		assert.Equal(t, "testuser", seen.Username)
+	})
+
+	t.Run("token identities do not own local accounts", func(t *testing.T) {
+		err := call(token("alice", "read_only", time.Hour), "/sqlrpc.v1.AdminService/UpdatePassword",
+			connect.NewRequest(&sqlrpcv1.UpdatePasswordRequest{Username: "alice", NewPassword: "takeover123"}))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("cached identities expire with the token", func(t *testing.T) {
+		short := token("svc-batch", "read_only", 2*time.Second)
+		require.NoError(t, call(short, "/sqlrpc.v1.DatabaseService/Query", connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "app", Sql: "SELECT 1"})))
+		time.Sleep(time.Until(seen.ExpiresAt) + 10*time.Millisecond)
+		err := call(short, "/sqlrpc.v1.DatabaseService/Query", connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "app", Sql: "SELECT 1"}))
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+}
+
+func TestPeerIP(t *testing.T) {
+	assert.Equal(t, "192.0.2.1", peerIP("192.0.2.1:5000"))
+	assert.Equal(t, "2001:db8::1", peerIP("[2001:db8::1]:443"))
+	assert.Equal(t, "", peerIP(""))
+}
+
+func TestRpcFamily(t *testing.T) {
+	assert.Equal(t, sqlrpcv1.RpcFamily_RPC_FAMILY_PUBLISH, rpcFamily("/sqlrpc.v1.DatabaseService/PublishBatch"))
+	assert.Equal(t, sqlrpcv1.RpcFamily_RPC_FAMILY_SUBSCRIBE, rpcFamily("/sqlrpc.v1.DatabaseService/Subscribe"))
+	assert.Equal(t, sqlrpcv1.RpcFamily_RPC_FAMILY_ADMIN, rpcFamily("/sqlrpc.v1.AdminService/CreateUser"))
+	assert.Equal(t, sqlrpcv1.RpcFamily_RPC_FAMILY_QUERY, rpcFamily("/sqlrpc.v1.DatabaseService/Query"))
+}
+
+// sequenceStreamingConn replays a fixed sequence of transaction commands.
+type sequenceStreamingConn struct {
+	mockStreamingConn
+	msgs []*sqlrpcv1.TransactionRequest
+}
+
+func (m *sequenceStreamingConn) Receive(dest any) error {
+	if len(m.msgs) == 0 {
+		return io.EOF
+	}
+	proto.Merge(dest.(*sqlrpcv1.TransactionRequest), m.msgs[0])
+	m.msgs = m.msgs[1:]
+	return nil
+}
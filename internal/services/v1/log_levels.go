@@ -0,0 +1,90 @@
+package servicesv1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+
+	"sqlite-server/internal/logging"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+)
+
+// GetLogLevels returns the default log level and the effective level of every subsystem.
+func (s *AdminServer) GetLogLevels(ctx context.Context, req *connect.Request[sqlrpcv1.GetLogLevelsRequest]) (*connect.Response[sqlrpcv1.GetLogLevelsResponse], error) {
+	defaultLevel, subsystems := currentLogLevels()
+	return connect.NewResponse(&sqlrpcv1.GetLogLevelsResponse{
+		DefaultLevel: defaultLevel,
+		Subsystems:   subsystems,
+	}), nil
+}
+
+// SetLogLevel changes the default log level, or the level of one subsystem. The change
+// is not persisted; restarting the server restores the levels given on the command line.
+func (s *AdminServer) SetLogLevel(ctx context.Context, req *connect.Request[sqlrpcv1.SetLogLevelRequest]) (*connect.Response[sqlrpcv1.SetLogLevelResponse], error) {
+	if err := protovalidate.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	name, level := req.Msg.Subsystem, req.Msg.Level
+
+	switch {
+	case name == "" && level == sqlrpcv1.LogLevel_LOG_LEVEL_UNSPECIFIED:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("a level is required to change the default level"))
+	case name == "":
+		logging.SetDefaultLevel(slogLevel(level))
+	case !logging.Known(name):
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("unknown log subsystem %q", name))
+	case level == sqlrpcv1.LogLevel_LOG_LEVEL_UNSPECIFIED:
+		logging.ClearLevel(name)
+	default:
+		logging.SetLevel(name, slogLevel(level))
+	}
+	authLog.InfoContext(ctx, "Log level changed", "target", name, "level", level.String())
+
+	defaultLevel, subsystems := currentLogLevels()
+	return connect.NewResponse(&sqlrpcv1.SetLogLevelResponse{
+		DefaultLevel: defaultLevel,
+		Subsystems:   subsystems,
+	}), nil
+}
+
+func currentLogLevels() (sqlrpcv1.LogLevel, []*sqlrpcv1.SubsystemLogLevel) {
+	var subsystems []*sqlrpcv1.SubsystemLogLevel
+	for _, s := range logging.Levels() {
+		subsystems = append(subsystems, &sqlrpcv1.SubsystemLogLevel{
+			Subsystem:  s.Name,
+			Level:      protoLogLevel(s.Level),
+			Overridden: s.Overridden,
+		})
+	}
+	return protoLogLevel(logging.DefaultLevel()), subsystems
+}
+
+// protoLogLevel maps a slog level to the closest LogLevel at or below it.
+func protoLogLevel(level slog.Level) sqlrpcv1.LogLevel {
+	switch {
+	case level < slog.LevelInfo:
+		return sqlrpcv1.LogLevel_LOG_LEVEL_DEBUG
+	case level < slog.LevelWarn:
+		return sqlrpcv1.LogLevel_LOG_LEVEL_INFO
+	case level < slog.LevelError:
+		return sqlrpcv1.LogLevel_LOG_LEVEL_WARN
+	default:
+		return sqlrpcv1.LogLevel_LOG_LEVEL_ERROR
+	}
+}
+
+func slogLevel(level sqlrpcv1.LogLevel) slog.Level {
+	switch level {
+	case sqlrpcv1.LogLevel_LOG_LEVEL_DEBUG:
+		return slog.LevelDebug
+	case sqlrpcv1.LogLevel_LOG_LEVEL_WARN:
+		return slog.LevelWarn
+	case sqlrpcv1.LogLevel_LOG_LEVEL_ERROR:
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
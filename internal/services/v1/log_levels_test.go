@@ -0,0 +1,74 @@
+package servicesv1
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"sqlite-server/internal/logging"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+)
+
+func TestLogLevels(t *testing.T) {
+	admin, _, _ := setupAdminTestServer(t)
+	ctx := context.Background()
+	t.Cleanup(func() {
+		logging.SetDefaultLevel(slog.LevelInfo)
+		logging.ClearLevel(logging.PubSub)
+	})
+
+	levelOf := func(subsystems []*sqlrpcv1.SubsystemLogLevel, name string) *sqlrpcv1.SubsystemLogLevel {
+		for _, s := range subsystems {
+			if s.Subsystem == name {
+				return s
+			}
+		}
+		t.Fatalf("subsystem %q not listed", name)
+		return nil
+	}
+
+	res, err := admin.GetLogLevels(ctx, connect.NewRequest(&sqlrpcv1.GetLogLevelsRequest{}))
+	require.NoError(t, err)
+	assert.Equal(t, sqlrpcv1.LogLevel_LOG_LEVEL_INFO, res.Msg.DefaultLevel)
+	assert.False(t, levelOf(res.Msg.Subsystems, logging.PubSub).Overridden)
+
+	t.Run("override a subsystem", func(t *testing.T) {
+		res, err := admin.SetLogLevel(ctx, connect.NewRequest(&sqlrpcv1.SetLogLevelRequest{
+			Subsystem: logging.PubSub,
+			Level:     sqlrpcv1.LogLevel_LOG_LEVEL_DEBUG,
+		}))
+		require.NoError(t, err)
+		pubsub := levelOf(res.Msg.Subsystems, logging.PubSub)
+		assert.True(t, pubsub.Overridden)
+		assert.Equal(t, sqlrpcv1.LogLevel_LOG_LEVEL_DEBUG, pubsub.Level)
+		assert.True(t, pubsubLog.Enabled(ctx, slog.LevelDebug))
+		assert.False(t, rpcLog.Enabled(ctx, slog.LevelDebug))
+	})
+
+	t.Run("change the default", func(t *testing.T) {
+		res, err := admin.SetLogLevel(ctx, connect.NewRequest(&sqlrpcv1.SetLogLevelRequest{Level: sqlrpcv1.LogLevel_LOG_LEVEL_ERROR}))
+		require.NoError(t, err)
+		assert.Equal(t, sqlrpcv1.LogLevel_LOG_LEVEL_ERROR, res.Msg.DefaultLevel)
+		assert.Equal(t, sqlrpcv1.LogLevel_LOG_LEVEL_ERROR, levelOf(res.Msg.Subsystems, logging.RPC).Level)
+		assert.Equal(t, sqlrpcv1.LogLevel_LOG_LEVEL_DEBUG, levelOf(res.Msg.Subsystems, logging.PubSub).Level)
+	})
+
+	t.Run("clear an override", func(t *testing.T) {
+		res, err := admin.SetLogLevel(ctx, connect.NewRequest(&sqlrpcv1.SetLogLevelRequest{Subsystem: logging.PubSub}))
+		require.NoError(t, err)
+		pubsub := levelOf(res.Msg.Subsystems, logging.PubSub)
+		assert.False(t, pubsub.Overridden)
+		assert.Equal(t, sqlrpcv1.LogLevel_LOG_LEVEL_ERROR, pubsub.Level)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		_, err := admin.SetLogLevel(ctx, connect.NewRequest(&sqlrpcv1.SetLogLevelRequest{Subsystem: "nosuch", Level: sqlrpcv1.LogLevel_LOG_LEVEL_DEBUG}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+		_, err = admin.SetLogLevel(ctx, connect.NewRequest(&sqlrpcv1.SetLogLevelRequest{}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+}
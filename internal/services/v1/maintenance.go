@@ -3,8 +3,8 @@ package servicesv1
 import (
 	"context"
 	"fmt"
-	"log"
 
+	"sqlite-server/internal/logging"
 	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
 
 	"connectrpc.com/connect"
@@ -32,7 +32,7 @@ func (s *DbServer) Vacuum(ctx context.Context, req *connect.Request[sqlrpcv1.Vac
 		query = "VACUUM"
 	}
 
-	log.Printf("[Maintenance] Executing %s on %s", query, dbName)
+	maintenanceLog.InfoContext(ctx, "Executing maintenance statement", logging.KeyDatabase, dbName, "sql", query)
 	if _, err := db.ExecContext(ctx, query, args...); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("vacuum failed: %w", err))
 	}
@@ -64,7 +64,7 @@ func (s *DbServer) Checkpoint(ctx context.Context, req *connect.Request[sqlrpcv1
 	}
 
 	query := fmt.Sprintf("PRAGMA wal_checkpoint(%s)", modeStr)
-	log.Printf("[Maintenance] Executing %s on %s", query, dbName)
+	maintenanceLog.InfoContext(ctx, "Executing maintenance statement", logging.KeyDatabase, dbName, "sql", query)
 
 	var busy int64
 	var logPages int64
@@ -99,7 +99,7 @@ func (s *DbServer) IntegrityCheck(ctx context.Context, req *connect.Request[sqlr
 	}
 
 	query := fmt.Sprintf("PRAGMA integrity_check(%d)", maxErrors)
-	log.Printf("[Maintenance] Executing %s on %s", query, dbName)
+	maintenanceLog.InfoContext(ctx, "Executing maintenance statement", logging.KeyDatabase, dbName, "sql", query)
 
 	rows, err := db.QueryContext(ctx, query)
 	if err != nil {
@@ -0,0 +1,301 @@
+package servicesv1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"sqlite-server/internal/metrics"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+	"sqlite-server/internal/pubsub"
+)
+
+// brokerBatchBuckets are histogram buckets for the number of publish requests per flush.
+var brokerBatchBuckets = []float64{1, 2, 5, 10, 25, 50, 100}
+
+// Metrics aggregates per-RPC and per-database telemetry for the /metrics endpoint.
+//
+// Request counts, latencies and row counts are recorded by the interceptor returned by
+// Interceptor. Everything owned by other components (transactions, pools, the broker)
+// is read from them at scrape time.
+type Metrics struct {
+	dbServer *DbServer
+
+	requests    *metrics.CounterVec
+	duration    *metrics.HistogramVec
+	rowsRead    *metrics.CounterVec
+	rowsWritten *metrics.CounterVec
+}
+
+// NewMetrics registers the server metrics on reg. broker may be nil when Pub/Sub is
+// disabled.
+func NewMetrics(reg *metrics.Registry, dbServer *DbServer, broker *pubsub.Broker) *Metrics {
+	m := &Metrics{
+		dbServer: dbServer,
+		requests: reg.NewCounterVec("sqlite_server_rpc_requests_total",
+			"RPCs handled, by service, method, database and status code.",
+			"service", "method", "database", "code"),
+		duration: reg.NewHistogramVec("sqlite_server_rpc_duration_seconds",
+			"RPC latency in seconds, by service, method and database.",
+			metrics.LatencyBuckets, "service", "method", "database"),
+		rowsRead: reg.NewCounterVec("sqlite_server_rows_read_total",
+			"Rows returned by queries, by database.", "database"),
+		rowsWritten: reg.NewCounterVec("sqlite_server_rows_written_total",
+			"Rows modified by statements, by database.", "database"),
+	}
+
+	// Transactions
+	reg.NewGaugeFunc("sqlite_server_transactions_active",
+		"ID-based transactions currently open, by database.",
+		[]string{"database"}, func(emit func(float64, ...string)) {
+			for name, count := range dbServer.activeTransactions() {
+				emit(float64(count), name)
+			}
+		})
+	reg.NewCounterFunc("sqlite_server_transactions_reaped_total",
+		"Transactions rolled back by the reaper after expiring.",
+		nil, func(emit func(float64, ...string)) {
+			emit(float64(dbServer.reaped.Load()))
+		})
+
+	// Connection pools
+	mgr := dbServer.dbManager
+	reg.NewCounterFunc("sqlite_server_pool_cache_hits_total",
+		"Connection requests served by a cached pool.",
+		nil, func(emit func(float64, ...string)) {
+			hits, _, _ := mgr.CacheStats()
+			emit(float64(hits))
+		})
+	reg.NewCounterFunc("sqlite_server_pool_cache_misses_total",
+		"Connection requests that had to open a pool.",
+		nil, func(emit func(float64, ...string)) {
+			_, misses, _ := mgr.CacheStats()
+			emit(float64(misses))
+		})
+	reg.NewCounterFunc("sqlite_server_pool_evictions_total",
+		"Idle pools closed by the cache cleaner.",
+		nil, func(emit func(float64, ...string)) {
+			_, _, evictions := mgr.CacheStats()
+			emit(float64(evictions))
+		})
+	reg.NewGaugeFunc("sqlite_server_pool_connections",
+		"Open connections per pool, by database, pool mode (rw/ro) and state (in_use/idle).",
+		[]string{"database", "pool", "state"}, func(emit func(float64, ...string)) {
+			mgr.PoolStats(func(name, mode string, stats sql.DBStats) {
+				emit(float64(stats.InUse), name, mode, "in_use")
+				emit(float64(stats.Idle), name, mode, "idle")
+			})
+		})
+
+	// Pub/Sub broker
+	if broker != nil {
+		batchSize := reg.NewHistogramVec("sqlite_server_broker_batch_size",
+			"Publish requests committed per broker flush.", brokerBatchBuckets)
+		flushDuration := reg.NewHistogramVec("sqlite_server_broker_flush_duration_seconds",
+			"Time taken by broker flushes in seconds.", metrics.LatencyBuckets)
+		broker.SetFlushObserver(func(size int, elapsed time.Duration) {
+			batchSize.With().Observe(float64(size))
+			flushDuration.With().Observe(elapsed.Seconds())
+		})
+
+		reg.NewGaugeFunc("sqlite_server_broker_queue_depth",
+			"Publish requests waiting for the broker batcher.",
+			nil, func(emit func(float64, ...string)) {
+				emit(float64(broker.QueueDepth()))
+			})
+		reg.NewGaugeFunc("sqlite_server_broker_subscribers",
+			"Live Pub/Sub listeners across all channels.",
+			nil, func(emit func(float64, ...string)) {
+				emit(float64(broker.SubscriberCount()))
+			})
+	}
+
+	return m
+}
+
+// activeTransactions counts the open ID-based transactions per database.
+func (s *DbServer) activeTransactions() map[string]int {
+	s.txMu.RLock()
+	defer s.txMu.RUnlock()
+	counts := make(map[string]int)
+	for _, session := range s.txRegistry {
+		counts[session.DBName]++
+	}
+	return counts
+}
+
+// ---------------------------------------------------------
+// Interceptor
+// ---------------------------------------------------------
+
+// Interceptor returns the interceptor recording request and row metrics. It should be
+// the outermost interceptor so rejected requests are counted too.
+func (m *Metrics) Interceptor() connect.Interceptor {
+	return &metricsInterceptor{m: m}
+}
+
+type metricsInterceptor struct {
+	m *Metrics
+}
+
+func (i *metricsInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		start := time.Now()
+		database := i.m.requestDatabase(req.Any())
+
+		resp, err := next(ctx, req)
+		if err == nil {
+			i.m.recordStats(database, resp.Any())
+		}
+		i.m.observe(req.Spec().Procedure, database, start, err)
+		return resp, err
+	}
+}
+
+func (i *metricsInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *metricsInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		start := time.Now()
+		wrapped := &metricsStreamWrapper{StreamingHandlerConn: conn, m: i.m}
+		err := next(ctx, wrapped)
+		i.m.observe(conn.Spec().Procedure, wrapped.database, start, err)
+		return err
+	}
+}
+
+// metricsStreamWrapper learns the database from the first request message that names
+// one and records the stats carried by response messages.
+type metricsStreamWrapper struct {
+	connect.StreamingHandlerConn
+	m        *Metrics
+	database string
+}
+
+func (w *metricsStreamWrapper) Receive(msg any) error {
+	if err := w.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	if w.database == "" {
+		w.database = w.m.requestDatabase(msg)
+	}
+	return nil
+}
+
+func (w *metricsStreamWrapper) Send(msg any) error {
+	w.m.recordStats(w.database, msg)
+	return w.StreamingHandlerConn.Send(msg)
+}
+
+// observe records the count and latency of one finished RPC.
+func (m *Metrics) observe(procedure, database string, start time.Time, err error) {
+	service, method := splitProcedure(procedure)
+	code := "ok"
+	if err != nil {
+		code = connect.CodeUnknown.String()
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			code = connectErr.Code().String()
+		}
+	}
+	m.requests.With(service, method, database, code).Inc()
+	m.duration.With(service, method, database).Observe(time.Since(start).Seconds())
+}
+
+// recordStats adds the rows reported by the ExecutionStats in a response.
+func (m *Metrics) recordStats(database string, msg any) {
+	forEachStats(msg, func(stats *sqlrpcv1.ExecutionStats) {
+		if stats.RowsRead > 0 {
+			m.rowsRead.With(database).Add(float64(stats.RowsRead))
+		}
+		if stats.RowsWritten > 0 {
+			m.rowsWritten.With(database).Add(float64(stats.RowsWritten))
+		}
+	})
+}
+
+// requestDatabase resolves the mounted database a DatabaseService request targets.
+// Unknown names map to "" so arbitrary input cannot create new series.
+func (m *Metrics) requestDatabase(msg any) string {
+	name := requestDatabaseName(msg)
+	if req, ok := msg.(interface{ GetTransactionId() string }); ok && name == "" {
+		name, _ = m.dbServer.TransactionDatabase(req.GetTransactionId())
+	}
+	if req, ok := msg.(interface{ GetCursorId() string }); ok && name == "" {
+		name, _ = m.dbServer.CursorDatabase(req.GetCursorId())
+	}
+	if name == "" || !m.dbServer.dbManager.HasDatabase(name) {
+		return ""
+	}
+	return name
+}
+
+// requestDatabaseName returns the database a request message names, if any.
+func requestDatabaseName(msg any) string {
+	switch req := msg.(type) {
+	case *sqlrpcv1.AttachDatabaseRequest:
+		return req.ParentDatabase
+	case *sqlrpcv1.DetachDatabaseRequest:
+		return req.ParentDatabase
+	case *sqlrpcv1.TransactionRequest:
+		return req.GetBegin().GetDatabase()
+	case *sqlrpcv1.ExecuteTransactionRequest:
+		if len(req.Requests) > 0 {
+			return req.Requests[0].GetBegin().GetDatabase()
+		}
+	case *sqlrpcv1.ImportRequest:
+		return req.GetStart().GetDatabase()
+	case interface{ GetDatabase() string }:
+		return req.GetDatabase()
+	}
+	return ""
+}
+
+// forEachStats calls fn for every ExecutionStats carried by a response message.
+func forEachStats(msg any, fn func(*sqlrpcv1.ExecutionStats)) {
+	switch res := msg.(type) {
+	case *sqlrpcv1.QueryResponse:
+		msg = res.GetComplete()
+	case *sqlrpcv1.TypedQueryResponse:
+		msg = res.GetComplete()
+	case *sqlrpcv1.TransactionResponse:
+		switch r := res.Response.(type) {
+		case *sqlrpcv1.TransactionResponse_QueryResult:
+			msg = r.QueryResult
+		case *sqlrpcv1.TransactionResponse_TypedQueryResult:
+			msg = r.TypedQueryResult
+		case *sqlrpcv1.TransactionResponse_ExecResult:
+			msg = r.ExecResult
+		case *sqlrpcv1.TransactionResponse_StreamResult:
+			msg = r.StreamResult.GetComplete()
+		case *sqlrpcv1.TransactionResponse_TypedStreamResult:
+			msg = r.TypedStreamResult.GetComplete()
+		default:
+			return
+		}
+	case *sqlrpcv1.ExecuteTransactionResponse:
+		for _, r := range res.Responses {
+			forEachStats(r, fn)
+		}
+		return
+	}
+	if s, ok := msg.(interface {
+		GetStats() *sqlrpcv1.ExecutionStats
+	}); ok && s.GetStats() != nil {
+		fn(s.GetStats())
+	}
+}
+
+// splitProcedure splits "/sqlrpc.v1.DatabaseService/Query" into "DatabaseService"
+// and "Query".
+func splitProcedure(procedure string) (string, string) {
+	service, method, _ := strings.Cut(strings.TrimPrefix(procedure, "/"), "/")
+	service = service[strings.LastIndex(service, ".")+1:]
+	return service, method
+}
@@ -0,0 +1,83 @@
+package servicesv1
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"sqlite-server/internal/metrics"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+	"sqlite-server/internal/protos/sqlrpc/v1/sqlrpcv1connect"
+)
+
+func TestMetricsInterceptor(t *testing.T) {
+	config := &sqlrpcv1.DatabaseConfig{Name: "test", DbPath: filepath.Join(t.TempDir(), "metrics.db")}
+	server := NewDbServer([]*sqlrpcv1.DatabaseConfig{config}, nil, nil)
+	t.Cleanup(server.Stop)
+
+	reg := metrics.NewRegistry()
+	m := NewMetrics(reg, server, nil)
+
+	mux := http.NewServeMux()
+	mux.Handle(sqlrpcv1connect.NewDatabaseServiceHandler(server, connect.WithInterceptors(
+		m.Interceptor(),
+		&testAuthInterceptor{},
+	)))
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	client := sqlrpcv1connect.NewDatabaseServiceClient(ts.Client(), ts.URL)
+	ctx := context.Background()
+
+	_, err := client.Exec(ctx, connect.NewRequest(&sqlrpcv1.QueryRequest{
+		Database: "test",
+		Sql:      "CREATE TABLE items (id INTEGER PRIMARY KEY)",
+	}))
+	require.NoError(t, err)
+	_, err = client.Exec(ctx, connect.NewRequest(&sqlrpcv1.QueryRequest{
+		Database: "test",
+		Sql:      "INSERT INTO items (id) VALUES (1), (2), (3)",
+	}))
+	require.NoError(t, err)
+
+	_, err = client.Query(ctx, connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "test", Sql: "SELECT id FROM items"}))
+	require.NoError(t, err)
+
+	stream, err := client.QueryStream(ctx, connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "test", Sql: "SELECT id FROM items"}))
+	require.NoError(t, err)
+	for stream.Receive() {
+	}
+	require.NoError(t, stream.Err())
+
+	_, err = client.Exec(ctx, connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "missing", Sql: "SELECT 1"}))
+	require.Error(t, err)
+
+	var sb strings.Builder
+	require.NoError(t, reg.Write(bufio.NewWriter(&sb), false))
+	out := sb.String()
+
+	assert.Contains(t, out, `sqlite_server_rpc_requests_total{service="DatabaseService",method="Exec",database="test",code="ok"} 2`)
+	assert.Contains(t, out, `sqlite_server_rpc_requests_total{service="DatabaseService",method="Query",database="test",code="ok"} 1`)
+	assert.Contains(t, out, `sqlite_server_rpc_requests_total{service="DatabaseService",method="QueryStream",database="test",code="ok"} 1`)
+	assert.Contains(t, out, `sqlite_server_rpc_requests_total{service="DatabaseService",method="Exec",database="",code="not_found"} 1`,
+		"unknown databases must not create their own series")
+	assert.Contains(t, out, `sqlite_server_rpc_duration_seconds_count{service="DatabaseService",method="Query",database="test"} 1`)
+	assert.Contains(t, out, `sqlite_server_rows_written_total{database="test"} 3`)
+	assert.Contains(t, out, `sqlite_server_rows_read_total{database="test"} 6`)
+	assert.Contains(t, out, `sqlite_server_pool_connections{database="test",pool="rw",state="idle"}`)
+	assert.Contains(t, out, "sqlite_server_pool_cache_misses_total ")
+	assert.NotContains(t, out, "sqlite_server_broker_", "broker metrics are only registered with a broker")
+}
+
+func TestSplitProcedure(t *testing.T) {
+	service, method := splitProcedure("/sqlrpc.v1.DatabaseService/Query")
+	assert.Equal(t, "DatabaseService", service)
+	assert.Equal(t, "Query", method)
+}
@@ -0,0 +1,187 @@
+package servicesv1
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"sqlite-server/internal/auth"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// streamTx is the open transaction of a bidirectional Transaction stream. Unlike
+// ID-based transactions it lives in the handler, so it is registered here only to
+// be listed and force rolled back.
+type streamTx struct {
+	database string
+	username string
+	started  time.Time
+
+	// expiry and lastSQL move with every message. Guarded by streamMu.
+	expiry  time.Time
+	lastSQL string
+
+	// kill ends the context the transaction runs under, which rolls it back and
+	// interrupts its running statement.
+	kill context.CancelCauseFunc
+}
+
+func (s *DbServer) registerStreamTx(id string, tx *streamTx) {
+	s.streamMu.Lock()
+	defer s.streamMu.Unlock()
+	if s.streamTxs == nil {
+		s.streamTxs = make(map[string]*streamTx)
+	}
+	s.streamTxs[id] = tx
+}
+
+// touchStreamTx records activity on a stream transaction: it pushes the idle
+// expiry back and, when sql is set, remembers the statement.
+func (s *DbServer) touchStreamTx(id, sql string) {
+	s.streamMu.Lock()
+	defer s.streamMu.Unlock()
+	if tx, ok := s.streamTxs[id]; ok {
+		tx.expiry = time.Now().Add(defaultTxTimeout)
+		if sql != "" {
+			tx.lastSQL = sql
+		}
+	}
+}
+
+func (s *DbServer) unregisterStreamTx(id string) {
+	s.streamMu.Lock()
+	defer s.streamMu.Unlock()
+	delete(s.streamTxs, id)
+}
+
+// ActiveStatements returns the stateless statements in flight on database (all
+// databases if empty), oldest first.
+func (s *DbServer) ActiveStatements(database string) []*sqlrpcv1.ActiveStatement {
+	s.stmtMu.Lock()
+	running := make([]*activeStatement, 0, len(s.statements))
+	for stmt := range s.statements {
+		if database == "" || stmt.database == database {
+			running = append(running, stmt)
+		}
+	}
+	s.stmtMu.Unlock()
+
+	slices.SortFunc(running, func(a, b *activeStatement) int { return a.started.Compare(b.started) })
+	now := time.Now()
+	out := make([]*sqlrpcv1.ActiveStatement, 0, len(running))
+	for _, stmt := range running {
+		out = append(out, &sqlrpcv1.ActiveStatement{
+			RequestId:    stmt.requestID,
+			Username:     stmt.username,
+			Database:     stmt.database,
+			Sql:          stmt.sql,
+			StartedAt:    timestamppb.New(stmt.started),
+			Elapsed:      durationpb.New(now.Sub(stmt.started)),
+			RowsStreamed: stmt.rows.Load(),
+		})
+	}
+	return out
+}
+
+// ActiveTransactions returns the open ID-based and stream transactions on database
+// (all databases if empty), oldest first.
+func (s *DbServer) ActiveTransactions(database string) []*sqlrpcv1.ActiveTransaction {
+	now := time.Now()
+	var out []*sqlrpcv1.ActiveTransaction
+	add := func(id string, kind sqlrpcv1.TransactionKind, db, username string, started, expiry time.Time, lastSQL string) {
+		if database != "" && db != database {
+			return
+		}
+		out = append(out, &sqlrpcv1.ActiveTransaction{
+			TransactionId: id,
+			Kind:          kind,
+			Username:      username,
+			Database:      db,
+			StartedAt:     timestamppb.New(started),
+			Age:           durationpb.New(now.Sub(started)),
+			ExpiresAt:     timestamppb.New(expiry),
+			LastSql:       lastSQL,
+		})
+	}
+
+	s.txMu.RLock()
+	for id, session := range s.txRegistry {
+		add(id, sqlrpcv1.TransactionKind_TRANSACTION_KIND_ID_BASED, session.DBName, session.Username, session.CreatedAt, session.Expiry, session.LastSQL)
+	}
+	s.txMu.RUnlock()
+
+	s.streamMu.Lock()
+	for id, tx := range s.streamTxs {
+		add(id, sqlrpcv1.TransactionKind_TRANSACTION_KIND_STREAM, tx.database, tx.username, tx.started, tx.expiry, tx.lastSQL)
+	}
+	s.streamMu.Unlock()
+
+	slices.SortFunc(out, func(a, b *sqlrpcv1.ActiveTransaction) int {
+		return cmp.Or(a.StartedAt.AsTime().Compare(b.StartedAt.AsTime()), cmp.Compare(a.TransactionId, b.TransactionId))
+	})
+	return out
+}
+
+// ForceRollback rolls back the open transaction with the given ID, whichever way
+// it is driven, and returns its kind. by names who asked, for the error a stream
+// client gets. The owner of an ID-based transaction finds it gone, as if reaped.
+func (s *DbServer) ForceRollback(id, by string) (sqlrpcv1.TransactionKind, error) {
+	s.txMu.Lock()
+	session, ok := s.txRegistry[id]
+	if ok {
+		delete(s.txRegistry, id)
+	}
+	s.txMu.Unlock()
+	if ok {
+		_ = session.Tx.Rollback()
+		endTxSpan(session.span, txOutcomeForced, nil)
+		return sqlrpcv1.TransactionKind_TRANSACTION_KIND_ID_BASED, nil
+	}
+
+	s.streamMu.Lock()
+	tx, ok := s.streamTxs[id]
+	if ok {
+		delete(s.streamTxs, id)
+	}
+	s.streamMu.Unlock()
+	if ok {
+		tx.kill(fmt.Errorf("transaction rolled back by %s", by))
+		return sqlrpcv1.TransactionKind_TRANSACTION_KIND_STREAM, nil
+	}
+
+	return sqlrpcv1.TransactionKind_TRANSACTION_KIND_UNSPECIFIED, fmt.Errorf("no open transaction with id %s", id)
+}
+
+// ListActiveOperations returns the statements in flight and the open transactions.
+func (s *AdminServer) ListActiveOperations(ctx context.Context, req *connect.Request[sqlrpcv1.ListActiveOperationsRequest]) (*connect.Response[sqlrpcv1.ListActiveOperationsResponse], error) {
+	return connect.NewResponse(&sqlrpcv1.ListActiveOperationsResponse{
+		Statements:   s.dbServer.ActiveStatements(req.Msg.Database),
+		Transactions: s.dbServer.ActiveTransactions(req.Msg.Database),
+	}), nil
+}
+
+// ForceRollbackTransaction rolls back another caller's open transaction.
+func (s *AdminServer) ForceRollbackTransaction(ctx context.Context, req *connect.Request[sqlrpcv1.ForceRollbackTransactionRequest]) (*connect.Response[sqlrpcv1.ForceRollbackTransactionResponse], error) {
+	if err := protovalidate.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	by := "an administrator"
+	if claims, ok := auth.FromContext(ctx); ok {
+		by = claims.Username
+	}
+	kind, err := s.dbServer.ForceRollback(req.Msg.TransactionId, by)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	rpcLog.InfoContext(ctx, "Forced transaction rollback", "rolled_back_tx_id", req.Msg.TransactionId, "kind", kind.String())
+
+	return connect.NewResponse(&sqlrpcv1.ForceRollbackTransactionResponse{Kind: kind}), nil
+}
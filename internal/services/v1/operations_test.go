@@ -0,0 +1,156 @@
+package servicesv1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminServer_ActiveStatements(t *testing.T) {
+	client, server := setupTestServer(t)
+	admin := NewAdminServer(nil, server, nil, false, "v0.0.1-test")
+	ctx := adminContext(sqlrpcv1.Role_ROLE_ADMIN)
+
+	// An endless stream of rows, read until it is cancelled
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		req := connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "test", Sql: "WITH RECURSIVE c(x) AS (SELECT 1 UNION ALL SELECT x + 1 FROM c) SELECT x FROM c"})
+		req.Header().Set(headerRequestID, "rows-1")
+		stream, err := client.QueryStream(context.Background(), req)
+		if err != nil {
+			return
+		}
+		for stream.Receive() {
+		}
+	}()
+
+	var stmt *sqlrpcv1.ActiveStatement
+	require.Eventually(t, func() bool {
+		resp, err := admin.ListActiveOperations(ctx, connect.NewRequest(&sqlrpcv1.ListActiveOperationsRequest{}))
+		require.NoError(t, err)
+		if len(resp.Msg.Statements) != 1 || resp.Msg.Statements[0].RowsStreamed == 0 {
+			return false
+		}
+		stmt = resp.Msg.Statements[0]
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, "rows-1", stmt.RequestId)
+	assert.Equal(t, "admin", stmt.Username)
+	assert.Equal(t, "test", stmt.Database)
+	assert.Contains(t, stmt.Sql, "RECURSIVE")
+	assert.Positive(t, stmt.Elapsed.AsDuration())
+
+	resp, err := admin.ListActiveOperations(ctx, connect.NewRequest(&sqlrpcv1.ListActiveOperationsRequest{Database: "other"}))
+	require.NoError(t, err)
+	assert.Empty(t, resp.Msg.Statements)
+
+	_, err = admin.CancelQuery(ctx, connect.NewRequest(&sqlrpcv1.CancelQueryRequest{RequestId: "rows-1"}))
+	require.NoError(t, err)
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("statement was not interrupted")
+	}
+
+	resp, err = admin.ListActiveOperations(ctx, connect.NewRequest(&sqlrpcv1.ListActiveOperationsRequest{}))
+	require.NoError(t, err)
+	assert.Empty(t, resp.Msg.Statements)
+}
+
+func TestAdminServer_ForceRollbackTransaction(t *testing.T) {
+	client, server := setupTestServer(t)
+	admin := NewAdminServer(nil, server, nil, false, "v0.0.1-test")
+	ctx := adminContext(sqlrpcv1.Role_ROLE_ADMIN)
+
+	listTransactions := func() []*sqlrpcv1.ActiveTransaction {
+		resp, err := admin.ListActiveOperations(ctx, connect.NewRequest(&sqlrpcv1.ListActiveOperationsRequest{Database: "test"}))
+		require.NoError(t, err)
+		return resp.Msg.Transactions
+	}
+
+	t.Run("id based", func(t *testing.T) {
+		begin, err := client.BeginTransaction(context.Background(), connect.NewRequest(&sqlrpcv1.BeginTransactionRequest{Database: "test"}))
+		require.NoError(t, err)
+		txID := begin.Msg.TransactionId
+		_, err = client.TransactionExec(context.Background(), connect.NewRequest(&sqlrpcv1.TransactionQueryRequest{
+			TransactionId: txID,
+			Sql:           "INSERT INTO users (name) VALUES ('ghost')",
+		}))
+		require.NoError(t, err)
+
+		txs := listTransactions()
+		require.Len(t, txs, 1)
+		assert.Equal(t, txID, txs[0].TransactionId)
+		assert.Equal(t, sqlrpcv1.TransactionKind_TRANSACTION_KIND_ID_BASED, txs[0].Kind)
+		assert.Equal(t, "admin", txs[0].Username)
+		assert.Equal(t, "INSERT INTO users (name) VALUES ('ghost')", txs[0].LastSql)
+		assert.True(t, txs[0].ExpiresAt.AsTime().After(time.Now()))
+
+		resp, err := admin.ForceRollbackTransaction(ctx, connect.NewRequest(&sqlrpcv1.ForceRollbackTransactionRequest{TransactionId: txID}))
+		require.NoError(t, err)
+		assert.Equal(t, sqlrpcv1.TransactionKind_TRANSACTION_KIND_ID_BASED, resp.Msg.Kind)
+		assert.Empty(t, listTransactions())
+
+		_, err = client.CommitTransaction(context.Background(), connect.NewRequest(&sqlrpcv1.TransactionControlRequest{TransactionId: txID}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+		rows, err := client.Query(context.Background(), connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "test", Sql: "SELECT * FROM users WHERE name = 'ghost'"}))
+		require.NoError(t, err)
+		assert.Empty(t, rows.Msg.Rows, "the insert was rolled back")
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		stream := client.Transaction(context.Background())
+		require.NoError(t, stream.Send(&sqlrpcv1.TransactionRequest{Command: &sqlrpcv1.TransactionRequest_Begin{
+			Begin: &sqlrpcv1.BeginRequest{Database: "test"},
+		}}))
+		res, err := stream.Receive()
+		require.NoError(t, err)
+		txID := res.GetBegin().TransactionId
+
+		require.NoError(t, stream.Send(&sqlrpcv1.TransactionRequest{Command: &sqlrpcv1.TransactionRequest_Exec{
+			Exec: &sqlrpcv1.TransactionalQueryRequest{Sql: "INSERT INTO users (name) VALUES ('ghost')"},
+		}}))
+		_, err = stream.Receive()
+		require.NoError(t, err)
+
+		txs := listTransactions()
+		require.Len(t, txs, 1)
+		assert.Equal(t, txID, txs[0].TransactionId)
+		assert.Equal(t, sqlrpcv1.TransactionKind_TRANSACTION_KIND_STREAM, txs[0].Kind)
+		assert.Equal(t, "INSERT INTO users (name) VALUES ('ghost')", txs[0].LastSql)
+
+		resp, err := admin.ForceRollbackTransaction(ctx, connect.NewRequest(&sqlrpcv1.ForceRollbackTransactionRequest{TransactionId: txID}))
+		require.NoError(t, err)
+		assert.Equal(t, sqlrpcv1.TransactionKind_TRANSACTION_KIND_STREAM, resp.Msg.Kind)
+		assert.Empty(t, listTransactions())
+
+		// The client learns about it with its next message
+		require.NoError(t, stream.Send(&sqlrpcv1.TransactionRequest{Command: &sqlrpcv1.TransactionRequest_Exec{
+			Exec: &sqlrpcv1.TransactionalQueryRequest{Sql: "INSERT INTO users (name) VALUES ('ghost')"},
+		}}))
+		_, err = stream.Receive()
+		assert.Equal(t, connect.CodeAborted, connect.CodeOf(err))
+		assert.ErrorContains(t, err, "rolled back by testadmin")
+		_ = stream.CloseRequest()
+
+		rows, err := client.Query(context.Background(), connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "test", Sql: "SELECT * FROM users WHERE name = 'ghost'"}))
+		require.NoError(t, err)
+		assert.Empty(t, rows.Msg.Rows, "the insert was rolled back")
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := admin.ForceRollbackTransaction(ctx, connect.NewRequest(&sqlrpcv1.ForceRollbackTransactionRequest{TransactionId: "missing"}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+		_, err = admin.ForceRollbackTransaction(ctx, connect.NewRequest(&sqlrpcv1.ForceRollbackTransactionRequest{}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+}
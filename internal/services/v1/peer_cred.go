@@ -0,0 +1,99 @@
+package servicesv1
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"sqlite-server/internal/auth"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+)
+
+// PeerIssuer is the UserClaims.Issuer of Unix peers that are mapped to a bare role.
+const PeerIssuer = "unix"
+
+// PeerCred holds the credentials of the process at the other end of a Unix socket, as
+// reported by the kernel when it connected.
+type PeerCred struct {
+	PID int32
+	UID uint32
+	GID uint32
+}
+
+// PeerIdentity is what a Unix UID maps to: a user of _meta.db or, when Username is
+// empty, an anonymous identity with Role.
+type PeerIdentity struct {
+	Username string
+	Role     sqlrpcv1.Role
+}
+
+type peerCredKey struct{}
+
+// peerCacheKey keys cached peer identities in the auth cache.
+type peerCacheKey uint32
+
+// PeerCredContext is an http.Server ConnContext hook that records the peer credentials
+// of Unix socket connections. Other connections are left alone.
+func PeerCredContext(ctx context.Context, c net.Conn) context.Context {
+	uc, ok := c.(*net.UnixConn)
+	if !ok {
+		return ctx
+	}
+	cred, err := readPeerCred(uc)
+	if err != nil {
+		authLog.Debug("Peer credentials unavailable", "error", err)
+		return ctx
+	}
+	return context.WithValue(ctx, peerCredKey{}, cred)
+}
+
+// peerCredentials returns the peer credentials of the connection, if any.
+func peerCredentials(ctx context.Context) (PeerCred, bool) {
+	cred, ok := ctx.Value(peerCredKey{}).(PeerCred)
+	return cred, ok
+}
+
+// ParsePeerMap parses a comma separated list of uid=username or uid=role:<role> pairs,
+// e.g. "1000=app,33=role:read_only".
+func ParsePeerMap(spec string) (map[uint32]PeerIdentity, error) {
+	if strings.TrimSpace(spec) == "" {
+		return nil, nil
+	}
+	peers := make(map[uint32]PeerIdentity)
+	for pair := range strings.SplitSeq(spec, ",") {
+		uidStr, target, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		uid, err := strconv.ParseUint(strings.TrimSpace(uidStr), 10, 32)
+		target = strings.TrimSpace(target)
+		if !ok || err != nil || target == "" {
+			return nil, fmt.Errorf("invalid peer mapping %q, want uid=username or uid=role:<role>", pair)
+		}
+		if name, isRole := strings.CutPrefix(target, "role:"); isRole {
+			role := sqlrpcv1.Role(sqlrpcv1.Role_value["ROLE_"+strings.ToUpper(name)])
+			if role == sqlrpcv1.Role_ROLE_UNSPECIFIED {
+				return nil, fmt.Errorf("invalid role in peer mapping %q", pair)
+			}
+			peers[uint32(uid)] = PeerIdentity{Role: role}
+		} else {
+			peers[uint32(uid)] = PeerIdentity{Username: target}
+		}
+	}
+	return peers, nil
+}
+
+// peerClaims maps the credentials of a Unix peer to its configured identity.
+func (a *AuthInterceptor) peerClaims(ctx context.Context, cred PeerCred) (*auth.UserClaims, error) {
+	peer, ok := a.peers[cred.UID]
+	if !ok {
+		return nil, fmt.Errorf("unix peer uid %d is not mapped", cred.UID)
+	}
+	if peer.Username == "" {
+		return &auth.UserClaims{Username: fmt.Sprintf("uid:%d", cred.UID), Role: peer.Role, Issuer: PeerIssuer}, nil
+	}
+	user, err := a.store.ValidateTrustedUser(ctx, peer.Username)
+	if err == nil && user == nil {
+		err = fmt.Errorf("unix peer uid %d maps to unknown user %q", cred.UID, peer.Username)
+	}
+	return user, err
+}
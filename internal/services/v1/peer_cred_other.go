@@ -0,0 +1,14 @@
+//go:build !linux
+// +build !linux
+
+package servicesv1
+
+import (
+	"errors"
+	"net"
+)
+
+// readPeerCred is only implemented on Linux (SO_PEERCRED).
+func readPeerCred(c *net.UnixConn) (PeerCred, error) {
+	return PeerCred{}, errors.ErrUnsupported
+}
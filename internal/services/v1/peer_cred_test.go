@@ -0,0 +1,119 @@
+package servicesv1
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"sqlite-server/internal/auth"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePeerMap(t *testing.T) {
+	peers, err := ParsePeerMap(" 1000=app, 33=role:read_only ,0=role:admin")
+	require.NoError(t, err)
+	assert.Equal(t, map[uint32]PeerIdentity{
+		1000: {Username: "app"},
+		33:   {Role: sqlrpcv1.Role_ROLE_READ_ONLY},
+		0:    {Role: sqlrpcv1.Role_ROLE_ADMIN},
+	}, peers)
+
+	peers, err = ParsePeerMap("")
+	require.NoError(t, err)
+	assert.Nil(t, peers)
+
+	for _, bad := range []string{"app=1000", "1000", "1000=", "-1=app", "1000=role:root"} {
+		_, err := ParsePeerMap(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestPeerCredContext(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("SO_PEERCRED is Linux only")
+	}
+	ln, err := net.Listen("unix", filepath.Join(t.TempDir(), "peer.sock"))
+	require.NoError(t, err)
+	defer ln.Close()
+
+	client, err := net.Dial("unix", ln.Addr().String())
+	require.NoError(t, err)
+	defer client.Close()
+	conn, err := ln.Accept()
+	require.NoError(t, err)
+	defer conn.Close()
+
+	cred, ok := peerCredentials(PeerCredContext(context.Background(), conn))
+	require.True(t, ok)
+	assert.Equal(t, uint32(os.Getuid()), cred.UID)
+	assert.Equal(t, int32(os.Getpid()), cred.PID)
+
+	tcp, _ := net.Pipe()
+	_, ok = peerCredentials(PeerCredContext(context.Background(), tcp))
+	assert.False(t, ok, "only Unix sockets carry peer credentials")
+}
+
+func TestAuthInterceptor_PeerCredentials(t *testing.T) {
+	store, _, _ := setupStore(t)
+	interceptor := NewAuthInterceptor(store)
+	interceptor.SetPeerIdentities(map[uint32]PeerIdentity{
+		1000: {Username: "testuser"},
+		1001: {Role: sqlrpcv1.Role_ROLE_READ_ONLY},
+		1002: {Username: "ghost"},
+	})
+	var seen *auth.UserClaims
+	unary := interceptor.WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		seen, _ = auth.FromContext(ctx)
+		return connect.NewResponse(&sqlrpcv1.QueryResponse{}), nil
+	})
+	call := func(ctx context.Context, procedure, sql string) error {
+		seen = nil
+		req := &mockRequest{AnyRequest: connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "app", Sql: sql}), spec: connect.Spec{Procedure: procedure}}
+		_, err := unary(ctx, req)
+		return err
+	}
+	peer := func(uid uint32) context.Context {
+		return context.WithValue(context.Background(), peerCredKey{}, PeerCred{UID: uid})
+	}
+
+	t.Run("uid maps to a user", func(t *testing.T) {
+		require.NoError(t, call(peer(1000), "/sqlrpc.v1.DatabaseService/Exec", "DELETE FROM t"))
+		require.NotNil(t, seen)
+		assert.Equal(t, "testuser", seen.Username)
+		assert.Equal(t, sqlrpcv1.Role_ROLE_READ_WRITE, seen.Role)
+	})
+
+	t.Run("uid maps to a role", func(t *testing.T) {
+		require.NoError(t, call(peer(1001), "/sqlrpc.v1.DatabaseService/Query", "SELECT 1"))
+		require.NotNil(t, seen)
+		assert.Equal(t, "uid:1001", seen.Username)
+		assert.Equal(t, PeerIssuer, seen.Issuer)
+
+		err := call(peer(1001), "/sqlrpc.v1.DatabaseService/Exec", "DELETE FROM t")
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("unmapped or unknown", func(t *testing.T) {
+		for _, uid := range []uint32{1002, 4242} {
+			err := call(peer(uid), "/sqlrpc.v1.DatabaseService/Query", "SELECT 1")
+			assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err), uid)
+		}
+	})
+
+	t.Run("ignored without a mapping", func(t *testing.T) {
+		interceptor := NewAuthInterceptor(store)
+		unary := interceptor.WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			return connect.NewResponse(&sqlrpcv1.QueryResponse{}), nil
+		})
+		req := &mockRequest{AnyRequest: connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "app", Sql: "SELECT 1"}), spec: connect.Spec{Procedure: "/sqlrpc.v1.DatabaseService/Query"}}
+		_, err := unary(peer(1000), req)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+}
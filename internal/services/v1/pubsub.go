@@ -3,12 +3,15 @@ package servicesv1
 import (
 	"context"
 	"fmt"
-	"log"
+	"sqlite-server/internal/logging"
 	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
 	"sqlite-server/internal/pubsub"
+	"sqlite-server/internal/tracing"
 	"time"
 
 	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -16,7 +19,7 @@ import (
 // It allows external gRPC/Connect clients to publish a single message to a specified
 // channel within a database. It enforces write authorization before processing.
 func (server *DbServer) Publish(ctx context.Context, request *connect.Request[sqlrpcv1.PublishRequest]) (*connect.Response[sqlrpcv1.PublishResponse], error) {
-	if err := AuthorizeWrite(ctx); err != nil {
+	if err := AuthorizeWrite(ctx, request.Msg.Database); err != nil {
 		return nil, err
 	}
 
@@ -30,7 +33,7 @@ func (server *DbServer) Publish(ctx context.Context, request *connect.Request[sq
 		DbName:  request.Msg.Database,
 	}
 
-	publishedIDs := server.broker.Publish(request.Msg.Database, []pubsub.MsgPayload{payload})
+	publishedIDs := server.broker.PublishContext(ctx, request.Msg.Database, []pubsub.MsgPayload{payload})
 	if len(publishedIDs) == 0 {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to publish message"))
 	}
@@ -44,7 +47,7 @@ func (server *DbServer) Publish(ctx context.Context, request *connect.Request[sq
 // It allows external gRPC/Connect clients to publish multiple messages
 // in a single request, improving performance by utilizing the broker's batching.
 func (server *DbServer) PublishBatch(ctx context.Context, request *connect.Request[sqlrpcv1.PublishBatchRequest]) (*connect.Response[sqlrpcv1.PublishBatchResponse], error) {
-	if err := AuthorizeWrite(ctx); err != nil {
+	if err := AuthorizeWrite(ctx, request.Msg.Database); err != nil {
 		return nil, err
 	}
 
@@ -61,7 +64,7 @@ func (server *DbServer) PublishBatch(ctx context.Context, request *connect.Reque
 		}
 	}
 
-	publishedIDs := server.broker.Publish(request.Msg.Database, items)
+	publishedIDs := server.broker.PublishContext(ctx, request.Msg.Database, items)
 	return connect.NewResponse(&sqlrpcv1.PublishBatchResponse{
 		MessageIds: publishedIDs,
 	}), nil
@@ -72,10 +75,8 @@ func (server *DbServer) PublishBatch(ctx context.Context, request *connect.Reque
 // It ensures durable delivery by first catching up on any missed messages
 // based on the provided subscriptionName, and then switching to the live Signal Hub.
 func (server *DbServer) Subscribe(ctx context.Context, request *connect.Request[sqlrpcv1.SubscribeRequest], stream *connect.ServerStream[sqlrpcv1.SubscribeResponse]) error {
-	log.Printf("[DEBUG] Subscribe called for %s on %s", request.Msg.Database, request.Msg.Channel)
 	// Subscribing is allowed for READ_ONLY users
-	if err := AuthorizeRead(ctx); err != nil {
-		log.Printf("[DEBUG] Subscribe authorization failed: %v", err)
+	if err := AuthorizeRead(ctx, request.Msg.Database); err != nil {
 		return err
 	}
 
@@ -83,8 +84,6 @@ func (server *DbServer) Subscribe(ctx context.Context, request *connect.Request[
 		return connect.NewError(connect.CodeNotFound, fmt.Errorf("database not found: %s", request.Msg.Database))
 	}
 
-	log.Printf("[DEBUG] Subscribe authorized")
-
 	databaseName := request.Msg.Database
 	channelName := request.Msg.Channel
 	subscriptionName := request.Msg.SubscriptionName
@@ -95,13 +94,12 @@ func (server *DbServer) Subscribe(ctx context.Context, request *connect.Request[
 	defer cleanup()
 
 	// 2. Catch up on missed messages from DB
-	log.Printf("[DEBUG] Subscribe: Starting catchUp")
 	lastSeenID, err := server.catchUp(ctx, databaseName, channelName, subscriptionName, stream)
 	if err != nil {
-		log.Printf("[DEBUG] Subscribe: catchUp failed: %v", err)
+		pubsubLog.WarnContext(ctx, "Subscription catch-up failed", "channel", channelName, logging.Err(err))
 		return err
 	}
-	log.Printf("[DEBUG] Subscribe: catchUp finished")
+	pubsubLog.DebugContext(ctx, "Subscription live", "channel", channelName, "subscription", subscriptionName, "last_id", lastSeenID)
 
 	for {
 		select {
@@ -111,17 +109,16 @@ func (server *DbServer) Subscribe(ctx context.Context, request *connect.Request[
 			// Deduplicate: If the signal message ID is <= lastSeenID, it means
 			// we already sent this message during the catchUp phase.
 			if signalMsg.ID <= lastSeenID {
-				log.Printf("[DEBUG] Subscribe skipped duplicate signal: ID %d (<= %d)", signalMsg.ID, lastSeenID)
 				continue
 			}
 
-			err := stream.Send(&sqlrpcv1.SubscribeResponse{
+			err := sendMessage(ctx, stream, &sqlrpcv1.SubscribeResponse{
 				Database:  signalMsg.DbName,
 				Channel:   signalMsg.Channel,
 				Payload:   signalMsg.Payload,
 				MessageId: signalMsg.ID,
 				CreatedAt: timestamppb.New(signalMsg.CreatedAt),
-			})
+			}, signalMsg.TraceParent)
 			if err != nil {
 				return err
 			}
@@ -142,34 +139,28 @@ func (server *DbServer) Subscribe(ctx context.Context, request *connect.Request[
 func (server *DbServer) catchUp(ctx context.Context, databaseName, channelName, subscriptionName string, stream *connect.ServerStream[sqlrpcv1.SubscribeResponse]) (int64, error) {
 	// Find last offset for this sub
 	var lastID int64
-	log.Printf("[DEBUG] catchUp: queryRow executing for sub %s", subscriptionName)
 	err := server.broker.GetDB().QueryRow("SELECT last_id FROM subscriptions WHERE name = ? AND db_name = ? AND channel = ?", subscriptionName, databaseName, channelName).Scan(&lastID)
 	if err != nil {
-		log.Printf("[DEBUG] catchUp: queryRow failed (likely not found): %v", err)
 		// Not found, start from 0 or latest? Let's start from 0 to ensure durability
 		lastID = 0
 	}
-	log.Printf("[DEBUG] catchUp: queryRow finished, lastID: %d", lastID)
-
-	log.Printf("[DEBUG] catchUp: queryContext executing")
-	rows, err := server.broker.GetDB().QueryContext(ctx, "SELECT id, channel, payload, created_at FROM messages WHERE db_source = ? AND channel = ? AND id > ? ORDER BY id ASC", databaseName, channelName, lastID)
+	rows, err := server.broker.GetDB().QueryContext(ctx, "SELECT id, channel, payload, created_at, COALESCE(traceparent, '') FROM messages WHERE db_source = ? AND channel = ? AND id > ? ORDER BY id ASC", databaseName, channelName, lastID)
 	if err != nil {
-		log.Printf("[DEBUG] catchUp: queryContext failed: %v", err)
 		return lastID, err
 	}
-	log.Printf("[DEBUG] catchUp: queryContext executed")
 	defer rows.Close()
 
 	for rows.Next() {
 		var signalMsg sqlrpcv1.SubscribeResponse
 		signalMsg.Database = databaseName
 		var createdAt time.Time
-		err := rows.Scan(&signalMsg.MessageId, &signalMsg.Channel, &signalMsg.Payload, &createdAt)
+		var traceParent string
+		err := rows.Scan(&signalMsg.MessageId, &signalMsg.Channel, &signalMsg.Payload, &createdAt, &traceParent)
 		if err != nil {
 			return lastID, err
 		}
 		signalMsg.CreatedAt = timestamppb.New(createdAt)
-		if err := stream.Send(&signalMsg); err != nil {
+		if err := sendMessage(ctx, stream, &signalMsg, traceParent); err != nil {
 			return lastID, err
 		}
 		lastID = signalMsg.MessageId
@@ -182,3 +173,29 @@ func (server *DbServer) catchUp(ctx context.Context, databaseName, channelName,
 	}
 	return lastID, rows.Err()
 }
+
+// sendMessage delivers one message inside a pubsub.deliver span. The span continues the
+// publisher's trace when the message carries one (linking back to the Subscribe call),
+// and otherwise nests under the Subscribe call itself.
+func sendMessage(ctx context.Context, stream *connect.ServerStream[sqlrpcv1.SubscribeResponse], msg *sqlrpcv1.SubscribeResponse, traceParent string) error {
+	parent := ctx
+	var links []trace.Link
+	if publisher := tracing.ContextWithTraceParent(context.Background(), traceParent); trace.SpanContextFromContext(publisher).IsValid() {
+		parent = publisher
+		links = append(links, trace.LinkFromContext(ctx))
+	}
+
+	spanCtx, span := tracing.StartChild(parent, "pubsub.deliver",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithLinks(links...),
+		trace.WithAttributes(
+			attribute.String("messaging.destination.name", msg.Channel),
+			attribute.Int64("messaging.message.id", msg.MessageId),
+			attribute.String("db.namespace", msg.Database),
+		),
+	)
+	msg.Traceparent = tracing.TraceParent(spanCtx)
+	err := stream.Send(msg)
+	tracing.EndSpan(span, err)
+	return err
+}
@@ -3,11 +3,12 @@ package servicesv1
 import (
 	"context"
 	"errors"
-	"log"
-	"regexp"
+	"sqlite-server/internal/logging"
 	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+	"sync/atomic"
 
 	"sqlite-server/internal/auth"
+	"sqlite-server/internal/sqlclass"
 
 	"buf.build/go/protovalidate"
 	"connectrpc.com/connect"
@@ -18,12 +19,18 @@ import (
 // statelessStreamWriter adapts a `QueryStream` (ServerStream).
 type statelessStreamWriter struct {
 	stream *connect.ServerStream[sqlrpcv1.QueryResponse]
+
+	// rows, when set, counts the rows sent.
+	rows *atomic.Int64
 }
 
 func (w *statelessStreamWriter) SendHeader(h *sqlrpcv1.QueryResultHeader) error {
 	return w.stream.Send(&sqlrpcv1.QueryResponse{Response: &sqlrpcv1.QueryResponse_Header{Header: h}})
 }
 func (w *statelessStreamWriter) SendRowBatch(b *sqlrpcv1.QueryResultRowBatch) error {
+	if w.rows != nil {
+		w.rows.Add(int64(len(b.Rows)))
+	}
 	return w.stream.Send(&sqlrpcv1.QueryResponse{Response: &sqlrpcv1.QueryResponse_Batch{Batch: b}})
 }
 func (w *statelessStreamWriter) SendDMLResult(r *sqlrpcv1.ExecResponse) error {
@@ -33,13 +40,10 @@ func (w *statelessStreamWriter) SendComplete(s *sqlrpcv1.ExecutionStats) error {
 	return w.stream.Send(&sqlrpcv1.QueryResponse{Response: &sqlrpcv1.QueryResponse_Complete{Complete: &sqlrpcv1.QueryComplete{Stats: s}}})
 }
 
-// Matches BEGIN, COMMIT, ROLLBACK, END, SAVEPOINT, RELEASE at the start of the string.
-// We use \b to ensure we don't match words like "BEGINNER".
-var txControlRegex = regexp.MustCompile(`(?i)^\s*(BEGIN|COMMIT|ROLLBACK|END|SAVEPOINT|RELEASE)\b`)
-
-// IsTransactionControl checks if the SQL string contains manual transaction management.
+// IsTransactionControl checks if any statement in the SQL string is manual transaction
+// management (BEGIN, COMMIT, ROLLBACK, END, SAVEPOINT, RELEASE).
 func IsTransactionControl(sql string) bool {
-	return txControlRegex.MatchString(sql)
+	return sqlclass.Classify(sql).TransactionControl
 }
 
 // ValidateStatelessQuery ensures that manual transaction commands are not
@@ -54,6 +58,19 @@ func ValidateStatelessQuery(sql string) error {
 	return nil
 }
 
+// statementMode picks the connection pool for a stateless statement. Callers without
+// write access always get the RO pool. Writers are sent there too when the classifier
+// proves the SQL cannot modify the database, keeping plain reads off the writer pool.
+func (s *DbServer) statementMode(ctx context.Context, database, sql string) string {
+	if auth.IsReadOnly(ctx, database) {
+		return ModeRO
+	}
+	if sqlclass.Classify(sql).ReadOnly() && !s.dbManager.InMemory(database) {
+		return ModeRO
+	}
+	return ModeRW
+}
+
 // Query handles the unary `Query` RPC.
 //
 // USE CASE:
@@ -74,7 +91,7 @@ func (s *DbServer) Query(ctx context.Context, req *connect.Request[sqlrpcv1.Quer
 
 	// 2. Validation: Strict Proto constraints
 	if err := protovalidate.Validate(req.Msg); err != nil {
-		log.Printf("[%s] Validation failed for Query: %v", reqID, err)
+		rpcLog.WarnContext(ctx, "Validation failed for Query", logging.Err(err))
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
@@ -86,19 +103,24 @@ func (s *DbServer) Query(ctx context.Context, req *connect.Request[sqlrpcv1.Quer
 
 	// 4. Routing: Find the correct DB pool
 
-	mode := ModeRW
-	if auth.IsReadOnly(ctx) {
-		mode = ModeRO
-	}
+	mode := s.statementMode(ctx, msg.Database, msg.Sql)
 	db, err := s.dbManager.GetConnection(ctx, msg.Database, mode)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, err)
 	}
 
-	// 5. Execution (Buffered)
-	result, err := executeQueryAndBuffer(ctx, db, msg.Sql, msg.Parameters)
+	// 5. Execution (Buffered), interruptible by the execution limit and CancelQuery
+	ctx, done := s.startStatement(ctx, reqID, msg.Database, msg.Sql, msg.MaxExecutionTimeMs)
+	defer done()
+	q, release, err := s.statelessQuerier(ctx, msg.Database, db)
 	if err != nil {
-		log.Printf("[%s] Query execution failed: %v", reqID, err)
+		return nil, makeUnaryError(statementError(ctx, err), msg.Sql)
+	}
+	defer release()
+	result, err := executeQueryAndBuffer(ctx, q, msg.Sql, msg.Parameters)
+	if err != nil {
+		err = statementError(ctx, err)
+		rpcLog.WarnContext(ctx, "Query execution failed", logging.Err(err))
 		return nil, makeUnaryError(err, msg.Sql)
 	}
 
@@ -132,7 +154,7 @@ func (s *DbServer) QueryStream(ctx context.Context, req *connect.Request[sqlrpcv
 
 	// 2. Validation
 	if err := protovalidate.Validate(req.Msg); err != nil {
-		log.Printf("[%s] Validation failed for QueryStream: %v", reqID, err)
+		rpcLog.WarnContext(ctx, "Validation failed for QueryStream", logging.Err(err))
 		return connect.NewError(connect.CodeInvalidArgument, err)
 	}
 	if err := ValidateStatelessQuery(req.Msg.Sql); err != nil {
@@ -140,24 +162,32 @@ func (s *DbServer) QueryStream(ctx context.Context, req *connect.Request[sqlrpcv
 	}
 
 	reqMsg := req.Msg
-
-	mode := ModeRW
-	if auth.IsReadOnly(ctx) {
-		mode = ModeRO
+	if reqMsg.Resumable || reqMsg.ResumeToken != "" {
+		return s.resumableQueryStream(ctx, reqID, reqMsg, stream)
 	}
+
+	mode := s.statementMode(ctx, reqMsg.Database, reqMsg.Sql)
 	db, err := s.dbManager.GetConnection(ctx, reqMsg.Database, mode)
 	if err != nil {
 		return connect.NewError(connect.CodeNotFound, err)
 	}
 
-	// 4. Setup Adapter: Wrap the specific ServerStream in our generic interface.
-	writer := &statelessStreamWriter{stream: stream}
-
-	// 5. Execution (Streaming)
-	// This blocks until the query finishes or the client disconnects.
-	err = streamQueryResults(ctx, db, reqMsg.Sql, reqMsg.Parameters, writer)
+	// 4. Execution (Streaming)
+	// This blocks until the query finishes, the client disconnects, the execution
+	// limit expires or an admin cancels it.
+	ctx, done := s.startStatement(ctx, reqID, reqMsg.Database, reqMsg.Sql, reqMsg.MaxExecutionTimeMs)
+	defer done()
+
+	// 5. Setup Adapter: Wrap the specific ServerStream in our generic interface.
+	writer := &statelessStreamWriter{stream: stream, rows: streamedRows(ctx)}
+	q, release, err := s.statelessQuerier(ctx, reqMsg.Database, db)
+	if err == nil {
+		defer release()
+		err = streamQueryResults(ctx, q, reqMsg.Sql, reqMsg.Parameters, writer)
+	}
 	if err != nil {
-		log.Printf("[%s] Stream failed: %v", reqID, err)
+		err = statementError(ctx, err)
+		rpcLog.WarnContext(ctx, "Stream failed", logging.Err(err))
 
 		// Instead of returning a gRPC error (which kills the stream with headers),
 
@@ -189,12 +219,18 @@ func (s *DbServer) QueryStream(ctx context.Context, req *connect.Request[sqlrpcv
 // typedStatelessStreamWriter adapts a TypedQueryStream ServerStream.
 type typedStatelessStreamWriter struct {
 	stream *connect.ServerStream[sqlrpcv1.TypedQueryResponse]
+
+	// rows, when set, counts the rows sent.
+	rows *atomic.Int64
 }
 
 func (w *typedStatelessStreamWriter) SendHeader(h *sqlrpcv1.TypedQueryResultHeader) error {
 	return w.stream.Send(&sqlrpcv1.TypedQueryResponse{Response: &sqlrpcv1.TypedQueryResponse_Header{Header: h}})
 }
 func (w *typedStatelessStreamWriter) SendRowBatch(b *sqlrpcv1.TypedQueryResultRowBatch) error {
+	if w.rows != nil {
+		w.rows.Add(int64(len(b.Rows)))
+	}
 	return w.stream.Send(&sqlrpcv1.TypedQueryResponse{Response: &sqlrpcv1.TypedQueryResponse_Batch{Batch: b}})
 }
 func (w *typedStatelessStreamWriter) SendDMLResult(r *sqlrpcv1.ExecResponse) error {
@@ -212,7 +248,7 @@ func (s *DbServer) TypedQuery(ctx context.Context, req *connect.Request[sqlrpcv1
 	reqID := ensureRequestID(req.Header())
 
 	if err := protovalidate.Validate(req.Msg); err != nil {
-		log.Printf("[%s] Validation failed for TypedQuery: %v", reqID, err)
+		rpcLog.WarnContext(ctx, "Validation failed for TypedQuery", logging.Err(err))
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
@@ -222,18 +258,23 @@ func (s *DbServer) TypedQuery(ctx context.Context, req *connect.Request[sqlrpcv1
 
 	msg := req.Msg
 
-	mode := ModeRW
-	if auth.IsReadOnly(ctx) {
-		mode = ModeRO
-	}
+	mode := s.statementMode(ctx, msg.Database, msg.Sql)
 	db, err := s.dbManager.GetConnection(ctx, msg.Database, mode)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, err)
 	}
 
-	result, err := typedExecuteQueryAndBuffer(ctx, db, msg.Sql, msg.Parameters)
+	ctx, done := s.startStatement(ctx, reqID, msg.Database, msg.Sql, msg.MaxExecutionTimeMs)
+	defer done()
+	q, release, err := s.statelessQuerier(ctx, msg.Database, db)
+	if err != nil {
+		return nil, makeUnaryError(statementError(ctx, err), msg.Sql)
+	}
+	defer release()
+	result, err := typedExecuteQueryAndBuffer(ctx, q, msg.Sql, msg.Parameters)
 	if err != nil {
-		log.Printf("[%s] TypedQuery execution failed: %v", reqID, err)
+		err = statementError(ctx, err)
+		rpcLog.WarnContext(ctx, "TypedQuery execution failed", logging.Err(err))
 		return nil, makeUnaryError(err, msg.Sql)
 	}
 
@@ -250,7 +291,7 @@ func (s *DbServer) TypedQueryStream(ctx context.Context, req *connect.Request[sq
 	stream.ResponseHeader().Set(headerRequestID, reqID)
 
 	if err := protovalidate.Validate(req.Msg); err != nil {
-		log.Printf("[%s] Validation failed for TypedQueryStream: %v", reqID, err)
+		rpcLog.WarnContext(ctx, "Validation failed for TypedQueryStream", logging.Err(err))
 		return connect.NewError(connect.CodeInvalidArgument, err)
 	}
 	if err := ValidateStatelessQuery(req.Msg.Sql); err != nil {
@@ -259,20 +300,24 @@ func (s *DbServer) TypedQueryStream(ctx context.Context, req *connect.Request[sq
 
 	msg := req.Msg
 
-	mode := ModeRW
-	if auth.IsReadOnly(ctx) {
-		mode = ModeRO
-	}
+	mode := s.statementMode(ctx, msg.Database, msg.Sql)
 	db, err := s.dbManager.GetConnection(ctx, msg.Database, mode)
 	if err != nil {
 		return connect.NewError(connect.CodeNotFound, err)
 	}
 
-	writer := &typedStatelessStreamWriter{stream: stream}
+	ctx, done := s.startStatement(ctx, reqID, msg.Database, msg.Sql, msg.MaxExecutionTimeMs)
+	defer done()
 
-	err = typedStreamQueryResults(ctx, db, msg.Sql, msg.Parameters, writer)
+	writer := &typedStatelessStreamWriter{stream: stream, rows: streamedRows(ctx)}
+	q, release, err := s.statelessQuerier(ctx, msg.Database, db)
+	if err == nil {
+		defer release()
+		err = typedStreamQueryResults(ctx, q, msg.Sql, msg.Parameters, writer)
+	}
 	if err != nil {
-		log.Printf("[%s] TypedStream failed: %v", reqID, err)
+		err = statementError(ctx, err)
+		rpcLog.WarnContext(ctx, "TypedStream failed", logging.Err(err))
 
 		errResp := makeStreamError(err, msg.Sql)
 		sendErr := stream.Send(&sqlrpcv1.TypedQueryResponse{
@@ -2,6 +2,7 @@ package servicesv1
 
 import (
 	"context"
+	"sqlite-server/internal/auth"
 	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
 	"testing"
 
@@ -268,3 +269,32 @@ func TestTypedQueryStream_Coverage(t *testing.T) {
 		}
 	})
 }
+
+func TestValidateStatelessQuery(t *testing.T) {
+	for _, sql := range []string{
+		"BEGIN",
+		"/* sneaky */ COMMIT",
+		"SELECT 1; ROLLBACK",
+		"INSERT INTO users (name) VALUES ('a'); END",
+	} {
+		err := ValidateStatelessQuery(sql)
+		require.Error(t, err, sql)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	}
+	assert.NoError(t, ValidateStatelessQuery("SELECT 'BEGIN'"))
+	assert.NoError(t, ValidateStatelessQuery("WITH x AS (SELECT 1) SELECT * FROM x"))
+}
+
+func TestDbServer_StatementMode(t *testing.T) {
+	_, server := setupTestServer(t)
+	writer := auth.NewContext(context.Background(), &auth.UserClaims{Role: sqlrpcv1.Role_ROLE_READ_WRITE})
+	reader := auth.NewContext(context.Background(), &auth.UserClaims{Role: sqlrpcv1.Role_ROLE_READ_ONLY})
+
+	assert.Equal(t, ModeRO, server.statementMode(writer, "test", "-- report\nSELECT * FROM users"))
+	assert.Equal(t, ModeRW, server.statementMode(writer, "test", "WITH x AS (SELECT 1) DELETE FROM users"))
+	assert.Equal(t, ModeRW, server.statementMode(writer, "test", "SELECT 1; UPDATE users SET age = 1"))
+	assert.Equal(t, ModeRO, server.statementMode(reader, "test", "DELETE FROM users"))
+
+	require.NoError(t, server.dbManager.Mount(&sqlrpcv1.DatabaseConfig{Name: "scratch", DbPath: ":memory:"}))
+	assert.Equal(t, ModeRW, server.statementMode(writer, "scratch", "SELECT 1"))
+}
@@ -9,57 +9,27 @@ import (
 	"math"
 	"regexp"
 	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+	"sqlite-server/internal/sqlclass"
+	"sqlite-server/internal/tracing"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
-var (
-	// Matches statements that start with SELECT, EXPLAIN, PRAGMA, or VALUES
-	readerPrefixRegex = regexp.MustCompile(`(?i)^\s*(SELECT|EXPLAIN|PRAGMA|VALUES)`)
-
-	// Matches the RETURNING keyword anywhere in the string (word boundary)
-	returningRegex = regexp.MustCompile(`(?i)RETURNING\b`)
-
-	// Matches BEGIN IMMEDIATE or BEGIN EXCLUSIVE
-	beginWriteRegex = regexp.MustCompile(`(?i)^\s*BEGIN\s+(IMMEDIATE|EXCLUSIVE)`)
-
-	// Matches standard read/control prefixes
-	readonlyPrefixRegex = regexp.MustCompile(`(?i)^\s*(SELECT|EXPLAIN|VALUES|BEGIN|COMMIT|ROLLBACK|SAVEPOINT|RELEASE)`)
-)
-
 // IsReader returns true if the statement returns data (Rows).
 // This determines if we should use QueryContext vs ExecContext.
 func IsReader(sql string) bool {
-	// 1. Check if it starts with standard read prefixes
-	if readerPrefixRegex.MatchString(sql) {
-		return true
-	}
-	// 2. Check if it's a DML statement with a RETURNING clause
-	if returningRegex.MatchString(sql) {
-		return true
-	}
-	return false
+	return sqlclass.Classify(sql).ReturnsRows
 }
 
 // IsReadOnly returns true if the statement is safe to run on a read-only connection.
 func IsReadOnly(sql string) bool {
-	// 1. Explicit write-lock beginnings are NOT read-only
-	if beginWriteRegex.MatchString(sql) {
-		return false
-	}
-	// 2. Must start with one of the allowed read-only/control prefixes
-	if !readonlyPrefixRegex.MatchString(sql) {
-		return false
-	}
-	// 3. Any statement with RETURNING is a write operation, NOT read-only
-	if returningRegex.MatchString(sql) {
-		return false
-	}
-	return true
+	return sqlclass.Classify(sql).ReadOnly()
 }
 
 // --- Parameter Handling ---
@@ -461,7 +431,10 @@ func putbackBuffer(buf *scanBuffer) {
 //     c. Iterate Rows (buffering up to `chunkSize`).
 //     d. Flush Batches.
 //     e. Send Complete w/ Stats.
-func streamQueryResults(ctx context.Context, q querier, sqlQuery string, paramsMsg *sqlrpcv1.Parameters, writer StreamWriter) error {
+func streamQueryResults(ctx context.Context, q querier, sqlQuery string, paramsMsg *sqlrpcv1.Parameters, writer StreamWriter) (err error) {
+	ctx, span := startStatementSpan(ctx, sqlQuery)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	startTime := time.Now()
 
 	params, err := convertParameters(sqlQuery, paramsMsg)
@@ -552,6 +525,7 @@ func streamQueryResults(ctx context.Context, q querier, sqlQuery string, paramsM
 	}
 
 	// Step 3: Complete
+	span.SetAttributes(attribute.Int64("db.response.returned_rows", rowsReadCount))
 	stats := &sqlrpcv1.ExecutionStats{
 		DurationMs: float64(time.Since(startTime).Milliseconds()),
 		RowsRead:   rowsReadCount,
@@ -566,7 +540,10 @@ func streamQueryResults(ctx context.Context, q querier, sqlQuery string, paramsM
 //
 // This function allocates memory proportional to the result set size.
 // It is intended for small, precise lookups only.
-func executeQueryAndBuffer(ctx context.Context, q querier, sqlQuery string, paramsMsg *sqlrpcv1.Parameters) (*sqlrpcv1.QueryResult, error) {
+func executeQueryAndBuffer(ctx context.Context, q querier, sqlQuery string, paramsMsg *sqlrpcv1.Parameters) (_ *sqlrpcv1.QueryResult, err error) {
+	ctx, span := startStatementSpan(ctx, sqlQuery)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	startTime := time.Now()
 	params, err := convertParameters(sqlQuery, paramsMsg)
 	if err != nil {
@@ -617,7 +594,10 @@ func executeQueryAndBuffer(ctx context.Context, q querier, sqlQuery string, para
 	return result, nil
 }
 
-func executeExecAndBuffer(ctx context.Context, q querier, sqlQuery string, paramsMsg *sqlrpcv1.Parameters) (*sqlrpcv1.ExecResponse, error) {
+func executeExecAndBuffer(ctx context.Context, q querier, sqlQuery string, paramsMsg *sqlrpcv1.Parameters) (_ *sqlrpcv1.ExecResponse, err error) {
+	ctx, span := startStatementSpan(ctx, sqlQuery)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	startTime := time.Now()
 	params, err := convertParameters(sqlQuery, paramsMsg)
 	if err != nil {
@@ -643,6 +623,15 @@ func executeExecAndBuffer(ctx context.Context, q querier, sqlQuery string, param
 	}, nil
 }
 
+// startStatementSpan starts the span covering one statement, from preparing it to
+// reading its last row. It is only recorded inside a traced request.
+func startStatementSpan(ctx context.Context, sqlQuery string) (context.Context, trace.Span) {
+	return tracing.StartChild(ctx, "sqlite.statement", trace.WithAttributes(
+		attribute.String("db.system.name", "sqlite"),
+		attribute.String("db.query.text", sqlQuery),
+	))
+}
+
 // maxSafeInteger is 2^53 - 1. Integers larger than this lose precision
 // when converted to float64 (the type used by Protobuf NumberValue).
 const maxSafeInteger = (1 << 53) - 1
@@ -934,7 +923,10 @@ func valuesToTypedProto(values []sql.RawBytes, affinities []sqlrpcv1.ColumnAffin
 
 // typedStreamQueryResults is the typed variant of streamQueryResults.
 // It uses TypedStreamWriter to send SqlRow instead of ListValue.
-func typedStreamQueryResults(ctx context.Context, q querier, sqlQuery string, paramsMsg *sqlrpcv1.TypedParameters, writer TypedStreamWriter) error {
+func typedStreamQueryResults(ctx context.Context, q querier, sqlQuery string, paramsMsg *sqlrpcv1.TypedParameters, writer TypedStreamWriter) (err error) {
+	ctx, span := startStatementSpan(ctx, sqlQuery)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	startTime := time.Now()
 
 	params, err := convertTypedParameters(sqlQuery, paramsMsg)
@@ -1023,6 +1015,7 @@ func typedStreamQueryResults(ctx context.Context, q querier, sqlQuery string, pa
 	}
 
 	// Step 3: Complete
+	span.SetAttributes(attribute.Int64("db.response.returned_rows", rowsReadCount))
 	stats := &sqlrpcv1.ExecutionStats{
 		DurationMs: float64(time.Since(startTime).Milliseconds()),
 		RowsRead:   rowsReadCount,
@@ -1032,7 +1025,10 @@ func typedStreamQueryResults(ctx context.Context, q querier, sqlQuery string, pa
 
 // typedExecuteQueryAndBuffer executes a query and returns a TypedQueryResult.
 // This is the typed variant of executeQueryAndBuffer.
-func typedExecuteQueryAndBuffer(ctx context.Context, q querier, sqlQuery string, paramsMsg *sqlrpcv1.TypedParameters) (*sqlrpcv1.TypedQueryResult, error) {
+func typedExecuteQueryAndBuffer(ctx context.Context, q querier, sqlQuery string, paramsMsg *sqlrpcv1.TypedParameters) (_ *sqlrpcv1.TypedQueryResult, err error) {
+	ctx, span := startStatementSpan(ctx, sqlQuery)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	startTime := time.Now()
 	params, err := convertTypedParameters(sqlQuery, paramsMsg)
 	if err != nil {
@@ -1083,7 +1079,10 @@ func typedExecuteQueryAndBuffer(ctx context.Context, q querier, sqlQuery string,
 	return result, nil
 }
 
-func typedExecuteExecAndBuffer(ctx context.Context, q querier, sqlQuery string, paramsMsg *sqlrpcv1.TypedParameters) (*sqlrpcv1.ExecResponse, error) {
+func typedExecuteExecAndBuffer(ctx context.Context, q querier, sqlQuery string, paramsMsg *sqlrpcv1.TypedParameters) (_ *sqlrpcv1.ExecResponse, err error) {
+	ctx, span := startStatementSpan(ctx, sqlQuery)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	startTime := time.Now()
 	params, err := convertTypedParameters(sqlQuery, paramsMsg)
 	if err != nil {
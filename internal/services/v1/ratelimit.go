@@ -0,0 +1,646 @@
+package servicesv1
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"sqlite-server/internal/auth"
+	"sqlite-server/internal/logging"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+)
+
+// Response headers reporting the state of the limits that apply to a call.
+const (
+	headerRateLimitLimit     = "X-RateLimit-Limit"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerQuotaRows          = "X-Quota-Rows-Remaining"
+	headerQuotaBytes         = "X-Quota-Bytes-Remaining"
+)
+
+const (
+	// rateLimitCacheTTL bounds how long the limits of an identity are cached, like the
+	// auth cache. Changes made through AdminService apply immediately.
+	rateLimitCacheTTL = 60 * time.Second
+
+	// quotaWindow is the period of the row and byte quotas.
+	quotaWindow = time.Minute
+)
+
+// RateLimiter is a connect.Interceptor enforcing the rate limits stored in _meta.db. It
+// must run after the AuthInterceptor, whose identity selects the limits. Identities
+// without a local user (JWTs, peers mapped to a role) get the limits set for their
+// issuer and username.
+//
+// Every matching limit is enforced on its own and shared by all calls it covers:
+//   - requests per second through a token bucket,
+//   - concurrent streams and transactions through counters,
+//   - returned rows and bytes per fixed one-minute window. The quota is checked before
+//     each response message, so the message that crosses it is still delivered.
+type RateLimiter struct {
+	store      *auth.MetaStore
+	txResolver TransactionResolver
+	now        func() time.Time
+
+	mu     sync.Mutex
+	cache  map[limitOwner]cachedLimits
+	states map[int64]*limitState
+}
+
+// limitOwner identifies the credentials whose limits are cached together.
+type limitOwner struct {
+	userID int64
+	keyID  string
+
+	issuer, username string
+}
+
+type cachedLimits struct {
+	limits   []auth.RateLimit
+	loadedAt time.Time
+}
+
+// limitState is the usage of one rate limit. Guarded by RateLimiter.mu.
+type limitState struct {
+	limit auth.RateLimit
+
+	tokens   float64
+	refilled time.Time
+
+	streams   int
+	txStreams int
+	txs       map[string]struct{} // ID-based transactions opened under the limit
+
+	window      time.Time
+	rows, bytes int64
+}
+
+// callKind describes what a call holds while it runs.
+type callKind struct {
+	stream      bool
+	transaction bool
+}
+
+// limitedCall is an admitted call and the limits it counts against.
+type limitedCall struct {
+	limiter *RateLimiter
+	kind    callKind
+	states  []*limitState
+}
+
+// NewRateLimiter creates a RateLimiter reading limits from store.
+func NewRateLimiter(store *auth.MetaStore) *RateLimiter {
+	return &RateLimiter{
+		store:  store,
+		now:    time.Now,
+		cache:  make(map[limitOwner]cachedLimits),
+		states: make(map[int64]*limitState),
+	}
+}
+
+// SetTransactionResolver registers the lookup that attributes ID-based transaction calls
+// to their database and tells which transactions are still open.
+func (l *RateLimiter) SetTransactionResolver(resolver TransactionResolver) {
+	l.txResolver = resolver
+}
+
+// Invalidate drops the cached limits so changes apply to the next call. Usage counters
+// are kept.
+func (l *RateLimiter) Invalidate() {
+	l.mu.Lock()
+	clear(l.cache)
+	l.mu.Unlock()
+}
+
+// limitsFor returns the limits of the caller's user and API key, or of its issuer and
+// username.
+func (l *RateLimiter) limitsFor(ctx context.Context, claims *auth.UserClaims) []auth.RateLimit {
+	owner := limitOwner{userID: claims.UserID, keyID: claims.KeyID}
+	if claims.Issuer != "" {
+		owner = limitOwner{issuer: claims.Issuer, username: claims.Username}
+	} else if claims.UserID == 0 {
+		return nil
+	}
+	now := l.now()
+
+	l.mu.Lock()
+	cached, ok := l.cache[owner]
+	l.mu.Unlock()
+	if ok && now.Sub(cached.loadedAt) < rateLimitCacheTTL {
+		return cached.limits
+	}
+
+	limits, err := l.store.RateLimitsFor(ctx, claims)
+	if err != nil {
+		// Keep enforcing what we had rather than failing the call
+		authLog.WarnContext(ctx, "Failed to load rate limits", logging.Err(err))
+		return cached.limits
+	}
+	l.mu.Lock()
+	l.cache[owner] = cachedLimits{limits: limits, loadedAt: now}
+	l.mu.Unlock()
+	return limits
+}
+
+// databases returns the databases a call targets. AdminService calls only count
+// against limits on "*".
+func (l *RateLimiter) databases(procedure string, msg any) []string {
+	if strings.HasPrefix(procedure, "/sqlrpc.v1.AdminService/") {
+		return nil
+	}
+	return messageDatabases(msg, l.txResolver)
+}
+
+// admit checks every limit that matches the call and, if none is exhausted, charges the
+// call to all of them. It returns nil without error when no limit applies.
+func (l *RateLimiter) admit(ctx context.Context, claims *auth.UserClaims, databases []string, kind callKind) (*limitedCall, error) {
+	var matched []auth.RateLimit
+	for _, limit := range l.limitsFor(ctx, claims) {
+		if len(databases) == 0 && limit.Matches("") {
+			matched = append(matched, limit)
+			continue
+		}
+		for _, name := range databases {
+			if limit.Matches(name) {
+				matched = append(matched, limit)
+				break
+			}
+		}
+	}
+	if len(matched) == 0 {
+		return nil, nil
+	}
+
+	now := l.now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	call := &limitedCall{limiter: l, kind: kind, states: make([]*limitState, len(matched))}
+	for i, limit := range matched {
+		st, ok := l.states[limit.ID]
+		if !ok {
+			st = &limitState{txs: make(map[string]struct{})}
+			l.states[limit.ID] = st
+		}
+		st.limit = limit
+		st.refill(now)
+		st.resetWindow(now)
+		call.states[i] = st
+
+		if err := st.check(kind, now, l.txResolver); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, st := range call.states {
+		if st.limit.RequestsPerSecond > 0 {
+			st.tokens--
+		}
+		if kind.stream {
+			st.streams++
+			if kind.transaction {
+				st.txStreams++
+			}
+		}
+	}
+	return call, nil
+}
+
+// refill adds the tokens earned since the last call, up to the burst size.
+func (st *limitState) refill(now time.Time) {
+	rate := st.limit.RequestsPerSecond
+	if rate <= 0 {
+		return
+	}
+	burst := float64(st.limit.Burst)
+	if burst == 0 {
+		burst = max(rate, 1)
+	}
+	if st.refilled.IsZero() {
+		st.tokens = burst
+	} else {
+		st.tokens = min(burst, st.tokens+now.Sub(st.refilled).Seconds()*rate)
+	}
+	st.refilled = now
+}
+
+// resetWindow starts a new quota window once the current one is over.
+func (st *limitState) resetWindow(now time.Time) {
+	if now.Sub(st.window) >= quotaWindow {
+		st.window, st.rows, st.bytes = now, 0, 0
+	}
+}
+
+// openTransactions counts the transactions held under the limit, forgetting ID-based
+// sessions that have ended.
+func (st *limitState) openTransactions(resolver TransactionResolver) int {
+	if resolver != nil {
+		for id := range st.txs {
+			if _, ok := resolver.TransactionDatabase(id); !ok {
+				delete(st.txs, id)
+			}
+		}
+	}
+	return len(st.txs) + st.txStreams
+}
+
+// check returns the quota error of the first exhausted dimension of the limit.
+func (st *limitState) check(kind callKind, now time.Time, resolver TransactionResolver) error {
+	limit := &st.limit
+	switch {
+	case limit.RequestsPerSecond > 0 && st.tokens < 1:
+		wait := time.Duration((1 - st.tokens) / limit.RequestsPerSecond * float64(time.Second))
+		return st.exceeded(fmt.Sprintf("%g requests per second", limit.RequestsPerSecond), wait)
+	case kind.stream && limit.MaxStreams > 0 && st.streams >= limit.MaxStreams:
+		return st.exceeded(fmt.Sprintf("%d concurrent streams", limit.MaxStreams), 0)
+	case kind.transaction && limit.MaxTransactions > 0 && st.openTransactions(resolver) >= limit.MaxTransactions:
+		return st.exceeded(fmt.Sprintf("%d concurrent transactions", limit.MaxTransactions), 0)
+	}
+	return st.checkQuota(now)
+}
+
+// checkQuota fails once the rows or bytes of the current window are used up.
+func (st *limitState) checkQuota(now time.Time) error {
+	limit := &st.limit
+	switch {
+	case limit.RowsPerMinute > 0 && st.rows >= limit.RowsPerMinute:
+		return st.exceeded(fmt.Sprintf("%d rows per minute", limit.RowsPerMinute), st.window.Add(quotaWindow).Sub(now))
+	case limit.BytesPerMinute > 0 && st.bytes >= limit.BytesPerMinute:
+		return st.exceeded(fmt.Sprintf("%d bytes per minute", limit.BytesPerMinute), st.window.Add(quotaWindow).Sub(now))
+	}
+	return nil
+}
+
+func (st *limitState) exceeded(what string, retryAfter time.Duration) error {
+	subject := "user:" + st.limit.Username
+	if st.limit.APIKeyID != "" {
+		subject = "api_key:" + st.limit.APIKeyID
+	}
+	return quotaError(subject, what+" on "+st.limit.Database, retryAfter)
+}
+
+// checkQuota fails once one of the call's limits has no rows or bytes left this window.
+func (c *limitedCall) checkQuota() error {
+	now := c.limiter.now()
+	c.limiter.mu.Lock()
+	defer c.limiter.mu.Unlock()
+	for _, st := range c.states {
+		st.resetWindow(now)
+		if err := st.checkQuota(now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// account charges a response message to the call's limits and reports the remaining
+// row and byte quotas in its ExecutionStats.
+func (c *limitedCall) account(msg any) {
+	rows := responseRows(msg)
+	var size int64
+	if m, ok := msg.(proto.Message); ok {
+		size = int64(proto.Size(m))
+	}
+
+	now := c.limiter.now()
+	c.limiter.mu.Lock()
+	for _, st := range c.states {
+		st.resetWindow(now)
+		st.rows += rows
+		st.bytes += size
+	}
+	rowsLeft, bytesLeft := c.remaining()
+	c.limiter.mu.Unlock()
+
+	if stats := responseStats(msg); stats != nil {
+		if rowsLeft >= 0 {
+			stats.RowsQuotaRemaining = proto.Int64(rowsLeft)
+		}
+		if bytesLeft >= 0 {
+			stats.BytesQuotaRemaining = proto.Int64(bytesLeft)
+		}
+	}
+}
+
+// remaining returns the smallest row and byte quotas left, or -1 when uncapped. The
+// caller holds the limiter lock.
+func (c *limitedCall) remaining() (rows, bytes int64) {
+	rows, bytes = -1, -1
+	for _, st := range c.states {
+		if st.limit.RowsPerMinute > 0 {
+			left := max(st.limit.RowsPerMinute-st.rows, 0)
+			if rows < 0 || left < rows {
+				rows = left
+			}
+		}
+		if st.limit.BytesPerMinute > 0 {
+			left := max(st.limit.BytesPerMinute-st.bytes, 0)
+			if bytes < 0 || left < bytes {
+				bytes = left
+			}
+		}
+	}
+	return rows, bytes
+}
+
+// setHeaders reports the tightest request rate and the remaining quotas.
+func (c *limitedCall) setHeaders(h http.Header) {
+	c.limiter.mu.Lock()
+	defer c.limiter.mu.Unlock()
+
+	var tightest *limitState
+	for _, st := range c.states {
+		if st.limit.RequestsPerSecond > 0 && (tightest == nil || st.tokens < tightest.tokens) {
+			tightest = st
+		}
+	}
+	if tightest != nil {
+		h.Set(headerRateLimitLimit, strconv.FormatFloat(tightest.limit.RequestsPerSecond, 'g', -1, 64))
+		h.Set(headerRateLimitRemaining, strconv.Itoa(int(math.Floor(max(tightest.tokens, 0)))))
+	}
+	rows, bytes := c.remaining()
+	if rows >= 0 {
+		h.Set(headerQuotaRows, strconv.FormatInt(rows, 10))
+	}
+	if bytes >= 0 {
+		h.Set(headerQuotaBytes, strconv.FormatInt(bytes, 10))
+	}
+}
+
+// recordTransaction counts an opened ID-based transaction until it ends.
+func (c *limitedCall) recordTransaction(id string) {
+	c.limiter.mu.Lock()
+	defer c.limiter.mu.Unlock()
+	for _, st := range c.states {
+		st.txs[id] = struct{}{}
+	}
+}
+
+// release gives back the stream and transaction slots of the call.
+func (c *limitedCall) release() {
+	if !c.kind.stream {
+		return
+	}
+	c.limiter.mu.Lock()
+	defer c.limiter.mu.Unlock()
+	for _, st := range c.states {
+		st.streams--
+		if c.kind.transaction {
+			st.txStreams--
+		}
+	}
+}
+
+// responseRows counts the result rows carried by a response message.
+func responseRows(msg any) int64 {
+	switch m := msg.(type) {
+	case *sqlrpcv1.QueryResult:
+		return int64(len(m.GetRows()))
+	case *sqlrpcv1.TypedQueryResult:
+		return int64(len(m.GetRows()))
+	case *sqlrpcv1.QueryResponse:
+		return int64(len(m.GetBatch().GetRows()))
+	case *sqlrpcv1.TypedQueryResponse:
+		return int64(len(m.GetBatch().GetRows()))
+	case *sqlrpcv1.FetchCursorResponse:
+		return int64(len(m.GetRows()))
+	case *sqlrpcv1.QueryResultRowBatch:
+		return int64(len(m.GetRows()))
+	case *sqlrpcv1.TransactionResponse:
+		return responseRows(m.GetQueryResult()) + responseRows(m.GetStreamResult()) +
+			responseRows(m.GetTypedQueryResult()) + responseRows(m.GetTypedStreamResult())
+	case *sqlrpcv1.ExecuteTransactionResponse:
+		var n int64
+		for _, r := range m.GetResponses() {
+			n += responseRows(r)
+		}
+		return n
+	}
+	return 0
+}
+
+// responseStats returns the ExecutionStats of a response message, if it has any.
+func responseStats(msg any) *sqlrpcv1.ExecutionStats {
+	switch m := msg.(type) {
+	case *sqlrpcv1.QueryResponse:
+		return m.GetComplete().GetStats()
+	case *sqlrpcv1.TypedQueryResponse:
+		return m.GetComplete().GetStats()
+	case *sqlrpcv1.TransactionResponse:
+		switch r := m.GetResponse().(type) {
+		case *sqlrpcv1.TransactionResponse_QueryResult:
+			return r.QueryResult.GetStats()
+		case *sqlrpcv1.TransactionResponse_StreamResult:
+			return responseStats(r.StreamResult)
+		case *sqlrpcv1.TransactionResponse_TypedQueryResult:
+			return r.TypedQueryResult.GetStats()
+		case *sqlrpcv1.TransactionResponse_TypedStreamResult:
+			return responseStats(r.TypedStreamResult)
+		}
+	case interface {
+		GetStats() *sqlrpcv1.ExecutionStats
+	}:
+		return m.GetStats()
+	}
+	return nil
+}
+
+// ---------------------------------------------------------
+// Interceptor
+// ---------------------------------------------------------
+
+// WrapUnary admits unary calls and charges their response.
+func (l *RateLimiter) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		claims, ok := auth.FromContext(ctx)
+		if !ok {
+			return next(ctx, req)
+		}
+		procedure := req.Spec().Procedure
+		kind := callKind{transaction: procedure == "/sqlrpc.v1.DatabaseService/BeginTransaction"}
+		call, err := l.admit(ctx, claims, l.databases(procedure, req.Any()), kind)
+		if err != nil {
+			return nil, err
+		}
+		if call == nil {
+			return next(ctx, req)
+		}
+
+		resp, err := next(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if m, ok := resp.Any().(*sqlrpcv1.BeginTransactionResponse); ok {
+			call.recordTransaction(m.GetTransactionId())
+		}
+		call.account(resp.Any())
+		call.setHeaders(resp.Header())
+		return resp, nil
+	}
+}
+
+// WrapStreamingClient is a no-op for server-side interceptors.
+func (l *RateLimiter) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler admits streams on their first message, which names the database,
+// and checks the row and byte quotas before every response message.
+func (l *RateLimiter) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		claims, ok := auth.FromContext(ctx)
+		if !ok {
+			return next(ctx, conn)
+		}
+		stream := &limitedStream{StreamingHandlerConn: conn, limiter: l, ctx: ctx, claims: claims}
+		defer stream.release()
+		return next(ctx, stream)
+	}
+}
+
+// limitedStream applies the caller's limits to a streaming call.
+type limitedStream struct {
+	connect.StreamingHandlerConn
+	limiter  *RateLimiter
+	ctx      context.Context
+	claims   *auth.UserClaims
+	admitted bool
+	call     *limitedCall
+}
+
+func (s *limitedStream) Receive(msg any) error {
+	if err := s.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	if s.admitted {
+		return nil
+	}
+	s.admitted = true
+
+	procedure := s.Spec().Procedure
+	kind := callKind{stream: true, transaction: procedure == "/sqlrpc.v1.DatabaseService/Transaction"}
+	call, err := s.limiter.admit(s.ctx, s.claims, s.limiter.databases(procedure, msg), kind)
+	if err != nil {
+		return err
+	}
+	s.call = call
+	if call != nil {
+		call.setHeaders(s.ResponseHeader())
+	}
+	return nil
+}
+
+func (s *limitedStream) Send(msg any) error {
+	if s.call != nil {
+		if err := s.call.checkQuota(); err != nil {
+			return err
+		}
+		s.call.account(msg)
+	}
+	return s.StreamingHandlerConn.Send(msg)
+}
+
+func (s *limitedStream) release() {
+	if s.call != nil {
+		s.call.release()
+	}
+}
+
+// ---------------------------------------------------------
+// Plain HTTP endpoints
+// ---------------------------------------------------------
+
+type limitedCallKey struct{}
+
+// httpStreams are the procedures whose plain HTTP stand-ins stream their result and
+// hold a stream slot while they run.
+var httpStreams = map[string]bool{
+	"/sqlrpc.v1.DatabaseService/QueryStream":    true,
+	"/sqlrpc.v1.DatabaseService/DumpDatabase":   true,
+	"/sqlrpc.v1.DatabaseService/DownloadBackup": true,
+}
+
+// GuardHTTP wraps the authorizer of the plain HTTP endpoints, such as the export
+// downloads and the REST gateway, so that the caller's limits apply to them as to the
+// RPCs they stand in for. The endpoints charge the rows they return through
+// accountHTTP and limitWriter.
+func (l *RateLimiter) GuardHTTP(next HTTPAuthorizer) HTTPAuthorizer {
+	return &limitedAuthorizer{next: next, limiter: l}
+}
+
+type limitedAuthorizer struct {
+	next    HTTPAuthorizer
+	limiter *RateLimiter
+}
+
+// AuthorizeHTTP authorizes the request with the wrapped authorizer, then admits it
+// under the caller's limits.
+func (a *limitedAuthorizer) AuthorizeHTTP(r *http.Request, procedure string, req connect.AnyRequest) (context.Context, func(error), error) {
+	ctx, finish, err := a.next.AuthorizeHTTP(r, procedure, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	claims, ok := auth.FromContext(ctx)
+	if !ok {
+		return ctx, finish, nil
+	}
+	call, err := a.limiter.admit(ctx, claims, a.limiter.databases(procedure, req.Any()), callKind{stream: httpStreams[procedure]})
+	if err != nil {
+		finish(err)
+		return nil, nil, err
+	}
+	if call == nil {
+		return ctx, finish, nil
+	}
+	return context.WithValue(ctx, limitedCallKey{}, call), func(err error) {
+		call.release()
+		finish(err)
+	}, nil
+}
+
+// accountHTTP charges the result of a plain HTTP request to the limits it was admitted
+// under and reports them in h. Like a unary response, the result that crosses a
+// quota is still delivered.
+func accountHTTP(ctx context.Context, h http.Header, msg any) {
+	if call, ok := ctx.Value(limitedCallKey{}).(*limitedCall); ok {
+		call.account(msg)
+		call.setHeaders(h)
+	}
+}
+
+// setLimitHeaders reports the limits the request of ctx was admitted under in h.
+func setLimitHeaders(ctx context.Context, h http.Header) {
+	if call, ok := ctx.Value(limitedCallKey{}).(*limitedCall); ok {
+		call.setHeaders(h)
+	}
+}
+
+// limitWriter charges the row batches written to w to the limits the request of ctx
+// was admitted under, and fails the batch after the one that used a quota up.
+func limitWriter(ctx context.Context, w StreamWriter) StreamWriter {
+	if call, ok := ctx.Value(limitedCallKey{}).(*limitedCall); ok {
+		return &limitedWriter{StreamWriter: w, call: call}
+	}
+	return w
+}
+
+type limitedWriter struct {
+	StreamWriter
+	call *limitedCall
+}
+
+func (w *limitedWriter) SendRowBatch(b *sqlrpcv1.QueryResultRowBatch) error {
+	if err := w.call.checkQuota(); err != nil {
+		return err
+	}
+	w.call.account(b)
+	return w.StreamWriter.SendRowBatch(b)
+}
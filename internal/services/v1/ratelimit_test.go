@@ -0,0 +1,319 @@
+package servicesv1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"sqlite-server/internal/auth"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// fakeTxResolver maps open transaction IDs to their database.
+type fakeTxResolver map[string]string
+
+func (r fakeTxResolver) TransactionDatabase(id string) (string, bool) {
+	db, ok := r[id]
+	return db, ok
+}
+
+// fakeCursorResolver also maps open cursor IDs to their database.
+type fakeCursorResolver struct{ fakeTxResolver }
+
+func (r fakeCursorResolver) CursorDatabase(id string) (string, bool) {
+	return r.TransactionDatabase(id)
+}
+
+// limitedStreamConn is a mockStreamingConn that also collects sent messages.
+type limitedStreamConn struct {
+	mockStreamingConn
+	responseHeader http.Header
+	sent           []any
+}
+
+func (c *limitedStreamConn) ResponseHeader() http.Header { return c.responseHeader }
+
+func (c *limitedStreamConn) Send(msg any) error {
+	c.sent = append(c.sent, msg)
+	return nil
+}
+
+// setupRateLimiter returns a limiter on a fresh store with a manual clock and the
+// context of testuser.
+func setupRateLimiter(t *testing.T) (*RateLimiter, *auth.MetaStore, context.Context, *time.Time) {
+	t.Helper()
+	store, _, _ := setupStore(t)
+	t.Cleanup(func() { store.Close() })
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := NewRateLimiter(store)
+	limiter.now = func() time.Time { return now }
+
+	// Look the user up through a throwaway limit
+	limit, err := store.SetRateLimit(context.Background(), "testuser", "", auth.RateLimit{Database: "unused"})
+	require.NoError(t, err)
+	require.NoError(t, store.DeleteRateLimit(context.Background(), limit.ID))
+
+	ctx := auth.NewContext(context.Background(), &auth.UserClaims{UserID: limit.UserID, Username: "testuser", Role: sqlrpcv1.Role_ROLE_READ_WRITE})
+	return limiter, store, ctx, &now
+}
+
+func setLimit(t *testing.T, store *auth.MetaStore, limiter *RateLimiter, limit auth.RateLimit) {
+	t.Helper()
+	_, err := store.SetRateLimit(context.Background(), "testuser", "", limit)
+	require.NoError(t, err)
+	limiter.Invalidate()
+}
+
+func queryRequest(procedure, database string) connect.AnyRequest {
+	return &mockRequest{
+		AnyRequest: connect.NewRequest(&sqlrpcv1.QueryRequest{Database: database, Sql: "SELECT 1"}),
+		spec:       connect.Spec{Procedure: procedure},
+	}
+}
+
+func rows(n int) []*structpb.ListValue {
+	out := make([]*structpb.ListValue, n)
+	for i := range out {
+		out[i] = &structpb.ListValue{Values: []*structpb.Value{structpb.NewNumberValue(float64(i))}}
+	}
+	return out
+}
+
+func TestRateLimiter_RequestsPerSecond(t *testing.T) {
+	limiter, store, ctx, now := setupRateLimiter(t)
+	setLimit(t, store, limiter, auth.RateLimit{RequestsPerSecond: 1, Burst: 2})
+	unary := limiter.WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return connect.NewResponse(&sqlrpcv1.QueryResult{}), nil
+	})
+	query := func() (connect.AnyResponse, error) {
+		return unary(ctx, queryRequest("/sqlrpc.v1.DatabaseService/Query", "app"))
+	}
+
+	resp, err := query()
+	require.NoError(t, err)
+	assert.Equal(t, "1", resp.Header().Get(headerRateLimitLimit))
+	assert.Equal(t, "1", resp.Header().Get(headerRateLimitRemaining))
+	_, err = query()
+	require.NoError(t, err)
+
+	_, err = query()
+	require.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+	var connectErr *connect.Error
+	require.True(t, errors.As(err, &connectErr))
+	assert.Equal(t, "1", connectErr.Meta().Get("Retry-After"))
+	require.Len(t, connectErr.Details(), 2)
+	detail, err := connectErr.Details()[0].Value()
+	require.NoError(t, err)
+	require.IsType(t, &errdetails.QuotaFailure{}, detail)
+	assert.Equal(t, "user:testuser", detail.(*errdetails.QuotaFailure).Violations[0].Subject)
+
+	// AdminService calls count against "*" limits too
+	_, err = unary(ctx, &mockRequest{AnyRequest: connect.NewRequest(&sqlrpcv1.ListUsersRequest{}), spec: connect.Spec{Procedure: "/sqlrpc.v1.AdminService/ListUsers"}})
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+
+	*now = now.Add(time.Second)
+	_, err = query()
+	require.NoError(t, err, "a token is earned back every second")
+}
+
+func TestRateLimiter_DatabasePatterns(t *testing.T) {
+	limiter, store, ctx, _ := setupRateLimiter(t)
+	setLimit(t, store, limiter, auth.RateLimit{Database: "tenant_*", RequestsPerSecond: 1})
+	unary := limiter.WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return connect.NewResponse(&sqlrpcv1.QueryResult{}), nil
+	})
+
+	for range 5 {
+		_, err := unary(ctx, queryRequest("/sqlrpc.v1.DatabaseService/Query", "app"))
+		require.NoError(t, err, "other databases are not limited")
+		_, err = unary(ctx, &mockRequest{AnyRequest: connect.NewRequest(&sqlrpcv1.ListUsersRequest{}), spec: connect.Spec{Procedure: "/sqlrpc.v1.AdminService/ListUsers"}})
+		require.NoError(t, err, "calls without a database only match *")
+	}
+
+	_, err := unary(ctx, queryRequest("/sqlrpc.v1.DatabaseService/Query", "tenant_a"))
+	require.NoError(t, err)
+	_, err = unary(ctx, queryRequest("/sqlrpc.v1.DatabaseService/Query", "tenant_b"))
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err), "the pattern shares one bucket")
+
+	t.Run("identities without a user", func(t *testing.T) {
+		jwt := auth.NewContext(context.Background(), &auth.UserClaims{Username: "testuser", Issuer: "https://idp.example", Role: sqlrpcv1.Role_ROLE_READ_ONLY})
+		peer := auth.NewContext(context.Background(), &auth.UserClaims{Username: "testuser", Issuer: PeerIssuer, Role: sqlrpcv1.Role_ROLE_READ_ONLY})
+		for range 3 {
+			_, err := unary(jwt, queryRequest("/sqlrpc.v1.DatabaseService/Query", "tenant_a"))
+			require.NoError(t, err, "the local user of the same name is a different identity")
+		}
+
+		_, err := store.SetRateLimit(context.Background(), "testuser", "", auth.RateLimit{Issuer: "https://idp.example", Database: "tenant_*", RequestsPerSecond: 1})
+		require.NoError(t, err)
+		limiter.Invalidate()
+		_, err = unary(jwt, queryRequest("/sqlrpc.v1.DatabaseService/Query", "tenant_a"))
+		require.NoError(t, err)
+		_, err = unary(jwt, queryRequest("/sqlrpc.v1.DatabaseService/Query", "tenant_b"))
+		assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err), "tokens are limited by issuer and subject")
+
+		_, err = unary(peer, queryRequest("/sqlrpc.v1.DatabaseService/Query", "tenant_a"))
+		require.NoError(t, err, "limits of one issuer do not apply to another")
+	})
+
+	t.Run("invalidate", func(t *testing.T) {
+		limits, err := store.ListRateLimits(context.Background(), "testuser", "tenant_*")
+		require.NoError(t, err)
+		require.NoError(t, store.DeleteRateLimit(context.Background(), limits[0].ID))
+		limiter.Invalidate()
+		_, err = unary(ctx, queryRequest("/sqlrpc.v1.DatabaseService/Query", "tenant_a"))
+		require.NoError(t, err)
+	})
+}
+
+func TestRateLimiter_Streams(t *testing.T) {
+	limiter, store, ctx, _ := setupRateLimiter(t)
+	setLimit(t, store, limiter, auth.RateLimit{MaxStreams: 1})
+	conn := func() *limitedStreamConn {
+		return &limitedStreamConn{
+			mockStreamingConn: mockStreamingConn{
+				msg:  &sqlrpcv1.QueryRequest{Database: "app", Sql: "SELECT 1"},
+				spec: connect.Spec{Procedure: "/sqlrpc.v1.DatabaseService/QueryStream"},
+			},
+			responseHeader: make(http.Header),
+		}
+	}
+
+	var nested error
+	var handler connect.StreamingHandlerFunc
+	handler = limiter.WrapStreamingHandler(func(ctx context.Context, stream connect.StreamingHandlerConn) error {
+		var req sqlrpcv1.QueryRequest
+		if err := stream.Receive(&req); err != nil {
+			return err
+		}
+		if nested == nil {
+			nested = errors.New("not run")
+			nested = handler(ctx, conn())
+		}
+		return nil
+	})
+
+	require.NoError(t, handler(ctx, conn()))
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(nested), "second concurrent stream")
+	require.NoError(t, handler(ctx, conn()), "the slot is released when the stream ends")
+}
+
+func TestRateLimiter_Transactions(t *testing.T) {
+	limiter, store, ctx, _ := setupRateLimiter(t)
+	resolver := fakeTxResolver{}
+	limiter.SetTransactionResolver(resolver)
+	setLimit(t, store, limiter, auth.RateLimit{MaxTransactions: 1})
+
+	unary := limiter.WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().Procedure == "/sqlrpc.v1.DatabaseService/BeginTransaction" {
+			resolver["tx1"] = "app"
+			return connect.NewResponse(&sqlrpcv1.BeginTransactionResponse{TransactionId: "tx1"}), nil
+		}
+		return connect.NewResponse(&sqlrpcv1.QueryResult{}), nil
+	})
+	begin := func() error {
+		_, err := unary(ctx, &mockRequest{
+			AnyRequest: connect.NewRequest(&sqlrpcv1.BeginTransactionRequest{Database: "app"}),
+			spec:       connect.Spec{Procedure: "/sqlrpc.v1.DatabaseService/BeginTransaction"},
+		})
+		return err
+	}
+
+	require.NoError(t, begin())
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(begin()))
+
+	// Calls inside the open transaction are not new transactions
+	_, err := unary(ctx, &mockRequest{
+		AnyRequest: connect.NewRequest(&sqlrpcv1.TransactionQueryRequest{TransactionId: "tx1", Sql: "SELECT 1"}),
+		spec:       connect.Spec{Procedure: "/sqlrpc.v1.DatabaseService/TransactionQuery"},
+	})
+	require.NoError(t, err)
+
+	delete(resolver, "tx1")
+	require.NoError(t, begin(), "ended transactions free their slot")
+}
+
+func TestRateLimiter_RowQuota(t *testing.T) {
+	limiter, store, ctx, now := setupRateLimiter(t)
+	setLimit(t, store, limiter, auth.RateLimit{RowsPerMinute: 3})
+
+	t.Run("unary", func(t *testing.T) {
+		unary := limiter.WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			return connect.NewResponse(&sqlrpcv1.QueryResult{Rows: rows(2), Stats: &sqlrpcv1.ExecutionStats{}}), nil
+		})
+		resp, err := unary(ctx, queryRequest("/sqlrpc.v1.DatabaseService/Query", "app"))
+		require.NoError(t, err)
+		assert.Equal(t, "1", resp.Header().Get(headerQuotaRows))
+		stats := resp.Any().(*sqlrpcv1.QueryResult).GetStats()
+		assert.Equal(t, int64(1), stats.GetRowsQuotaRemaining())
+		assert.Nil(t, stats.BytesQuotaRemaining, "bytes are not capped")
+
+		// The call crossing the quota is served, the next one is not
+		_, err = unary(ctx, queryRequest("/sqlrpc.v1.DatabaseService/Query", "app"))
+		require.NoError(t, err)
+		_, err = unary(ctx, queryRequest("/sqlrpc.v1.DatabaseService/Query", "app"))
+		assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+	})
+
+	*now = now.Add(quotaWindow)
+
+	t.Run("stream", func(t *testing.T) {
+		stream := &limitedStreamConn{
+			mockStreamingConn: mockStreamingConn{
+				msg:  &sqlrpcv1.QueryRequest{Database: "app", Sql: "SELECT 1"},
+				spec: connect.Spec{Procedure: "/sqlrpc.v1.DatabaseService/QueryStream"},
+			},
+			responseHeader: make(http.Header),
+		}
+		handler := limiter.WrapStreamingHandler(func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+			var req sqlrpcv1.QueryRequest
+			if err := conn.Receive(&req); err != nil {
+				return err
+			}
+			for range 3 {
+				batch := &sqlrpcv1.QueryResponse{Response: &sqlrpcv1.QueryResponse_Batch{Batch: &sqlrpcv1.QueryResultRowBatch{Rows: rows(2)}}}
+				if err := conn.Send(batch); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+
+		err := handler(ctx, stream)
+		assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+		assert.Len(t, stream.sent, 2, "batches are sent until the quota is used up")
+		assert.Equal(t, "3", stream.responseHeader.Get(headerQuotaRows))
+	})
+}
+
+func TestRateLimiter_CursorRows(t *testing.T) {
+	limiter, store, ctx, _ := setupRateLimiter(t)
+	limiter.SetTransactionResolver(fakeCursorResolver{fakeTxResolver{"cursor-1": "app"}})
+	setLimit(t, store, limiter, auth.RateLimit{Database: "app", RowsPerMinute: 3})
+
+	fetch := limiter.WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return connect.NewResponse(&sqlrpcv1.FetchCursorResponse{Rows: rows(2)}), nil
+	})
+	req := func() connect.AnyRequest {
+		return &mockRequest{
+			AnyRequest: connect.NewRequest(&sqlrpcv1.FetchCursorRequest{CursorId: "cursor-1"}),
+			spec:       connect.Spec{Procedure: "/sqlrpc.v1.DatabaseService/FetchCursor"},
+		}
+	}
+
+	resp, err := fetch(ctx, req())
+	require.NoError(t, err)
+	assert.Equal(t, "1", resp.Header().Get(headerQuotaRows))
+	_, err = fetch(ctx, req())
+	require.NoError(t, err)
+	_, err = fetch(ctx, req())
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+}
@@ -0,0 +1,1140 @@
+package servicesv1
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"context"
+	"crypto/tls"
+	"database/sql/driver"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/mattn/go-sqlite3"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"sqlite-server/internal/logging"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+	"sqlite-server/internal/protos/sqlrpc/v1/sqlrpcv1connect"
+	"sqlite-server/internal/sqlclass"
+)
+
+// Replication ships page-level changesets from a leader to read-only followers.
+//
+// The leader polls the files of every mounted database. When one changes, it reads the
+// WAL frames committed since the last changeset and streams the latest version of each
+// page they touch. A new follower first gets every page, from the database file
+// overlaid with the WAL. For each follower the leader holds a read transaction on the
+// database, which keeps SQLite from restarting the WAL over frames not yet sent. It is
+// released once a passive checkpoint copied every sent frame into the database file; a
+// WAL that restarts after frames were checkpointed unseen is resent in full.
+//
+// Followers write the pages into a staging file and copy it into their local database
+// with the online backup API, so local readers always see a complete transaction.
+//
+// The leader's metadata database is shipped the same way, under a name no database can
+// have. Followers take its users, API keys, grants, rate limits and row and column
+// policies, so they authorize reads like the leader.
+//
+// In-memory and encrypted databases are not replicated.
+
+var (
+	// replicationPollInterval is how often the leader checks databases for changes.
+	replicationPollInterval = 250 * time.Millisecond
+
+	// replicationHeartbeatInterval is the longest the leader stays silent on a stream.
+	replicationHeartbeatInterval = time.Second
+
+	// replicationRetryInterval is the initial delay before a follower reconnects.
+	replicationRetryInterval = time.Second
+
+	// replicationIdleTimeout is how long a follower waits for any message, heartbeats
+	// included, before it drops the stream and reconnects.
+	replicationIdleTimeout = 15 * time.Second
+)
+
+// replicationMaxRetryInterval caps the follower's reconnect backoff.
+const replicationMaxRetryInterval = 30 * time.Second
+
+// replicationPagesPerFrame bounds the size of a single ReplicationFrame message.
+const replicationPagesPerFrame = 256
+
+// replicationMetadata names the frames of the leader's metadata database. Database
+// names are alphanumeric, so it cannot collide with one.
+const replicationMetadata = ".meta"
+
+// ===================================================================================
+// Leader: Change Streaming
+// ===================================================================================
+
+// StreamReplication streams page-level changes of all mounted databases to a follower.
+func (s *AdminServer) StreamReplication(ctx context.Context, req *connect.Request[sqlrpcv1.StreamReplicationRequest], stream *connect.ServerStream[sqlrpcv1.ReplicationFrame]) error {
+	if s.follower != nil {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("this server is a read replica and cannot stream changes"))
+	}
+
+	s.followerCount.Add(1)
+	defer s.followerCount.Add(-1)
+	replicationLog.InfoContext(ctx, "Follower connected", "follower", req.Msg.FollowerId)
+	defer replicationLog.InfoContext(ctx, "Follower disconnected", "follower", req.Msg.FollowerId)
+
+	src := &replicationSource{dbManager: s.dbServer.dbManager, metaPath: s.store.Path(), states: make(map[string]*walState)}
+	defer src.close()
+	ticker := time.NewTicker(replicationPollInterval)
+	defer ticker.Stop()
+
+	lastSent := time.Time{}
+	for {
+		sent, err := src.sendChanges(ctx, stream.Send)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		if sent {
+			lastSent = time.Now()
+		} else if time.Since(lastSent) >= replicationHeartbeatInterval {
+			if err := stream.Send(&sqlrpcv1.ReplicationFrame{LeaderTime: timestamppb.Now()}); err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+			lastSent = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// replicationSource tracks what a single follower has received so far.
+type replicationSource struct {
+	dbManager *DbManager
+	metaPath  string // Metadata database; empty to only ship the mounted ones
+	states    map[string]*walState
+}
+
+// walState is how far a follower has got with a database.
+type walState struct {
+	fingerprint string
+	sequence    uint64
+	pageSize    uint32
+
+	// Position in the WAL: its salt, the frames sent and their running checksum
+	salt   [2]uint32
+	frames int64
+	sum    [2]uint32
+
+	// pins hold read transactions on the database, in turn. A reader keeps SQLite
+	// from restarting the WAL, so no frame is overwritten before it was sent.
+	pins   [2]*sqlite3.SQLiteConn
+	pin    int
+	pinned bool
+
+	// released is the fingerprint of the database file when the pin was released
+	released string
+}
+
+// sendChanges sends a changeset for every database whose files changed since the last
+// call and reports whether anything was sent. The metadata goes first, so a follower
+// has the policies of a database before its rows.
+func (r *replicationSource) sendChanges(ctx context.Context, send func(*sqlrpcv1.ReplicationFrame) error) (bool, error) {
+	names := r.dbManager.List()
+	slices.Sort(names)
+
+	paths := make(map[string]string, len(names)+1)
+	order := make([]string, 0, len(names)+1)
+	if r.metaPath != "" {
+		paths[replicationMetadata] = r.metaPath
+		order = append(order, replicationMetadata)
+	}
+	for _, name := range names {
+		config, ok := r.dbManager.GetConfig(name)
+		if !ok || config.IsEncrypted || r.dbManager.InMemory(name) {
+			continue
+		}
+		paths[name] = databaseFilePath(config.DbPath)
+		order = append(order, name)
+	}
+
+	sent := false
+	for _, name := range order {
+		path := paths[name]
+		fingerprint := fileFingerprint(path, path+"-wal")
+		if state := r.states[name]; state != nil && state.fingerprint == fingerprint {
+			continue
+		}
+
+		var sendErr error
+		n, err := r.sync(name, path, fingerprint, func(frame *sqlrpcv1.ReplicationFrame) error {
+			sendErr = send(frame)
+			return sendErr
+		})
+		sent = sent || n > 0
+		if sendErr != nil {
+			return sent, sendErr
+		}
+		if err != nil {
+			replicationLog.ErrorContext(ctx, "Failed to read database changes", logging.KeyDatabase, name, logging.Err(err))
+		}
+	}
+
+	// Forget databases that were unmounted, so a remount starts with a full snapshot
+	for name, state := range r.states {
+		if _, ok := paths[name]; !ok {
+			state.close()
+			delete(r.states, name)
+		}
+	}
+	return sent, nil
+}
+
+// close releases the read transactions held for the follower.
+func (r *replicationSource) close() {
+	for _, state := range r.states {
+		state.close()
+	}
+	clear(r.states)
+}
+
+// sync sends the changes of a database committed since the last call, or all of its
+// pages the first time, and returns the number of frames sent.
+func (r *replicationSource) sync(name, path, fingerprint string, send func(*sqlrpcv1.ReplicationFrame) error) (int, error) {
+	state := r.states[name]
+	full := state == nil
+	if full {
+		state = &walState{}
+	}
+	wasPinned := state.pinned
+	if err := state.repin(path); err != nil {
+		if full {
+			state.close()
+		}
+		return 0, err
+	}
+
+	wal, err := openWAL(path)
+	if err != nil {
+		if full {
+			state.close()
+		}
+		return 0, err
+	}
+	defer wal.close()
+
+	from, sum := state.frames, state.sum
+	if !full && wal.salt != state.salt {
+		// The WAL restarted. Frames written after the pin was released may have been
+		// checkpointed and overwritten; the database file then changed.
+		if !wasPinned && fileFingerprint(path) != state.released {
+			replicationLog.Info("WAL restarted while unpinned, resending database", logging.KeyDatabase, name)
+			full = true
+		}
+		from, sum = 0, wal.sum
+	}
+	if full {
+		from, sum = 0, wal.sum
+	}
+	commit, err := wal.readCommitted(from, sum)
+	if err != nil {
+		if r.states[name] == nil {
+			state.close()
+		}
+		return 0, err
+	}
+
+	var pages []pageRef
+	var pageSize, pageCount uint32
+	if full {
+		db, err := os.Open(path)
+		if err != nil {
+			if r.states[name] == nil {
+				state.close()
+			}
+			return 0, err
+		}
+		defer db.Close()
+		pages, pageSize, pageCount, err = snapshotPages(db, wal, commit)
+		if err != nil || pageCount == 0 {
+			if r.states[name] == nil {
+				state.close() // Empty database, nothing to ship yet
+			}
+			return 0, err
+		}
+		r.states[name] = state
+	} else if commit.frames > from {
+		pageSize, pageCount = wal.pageSize, commit.dbSize
+		pages = commit.changedPages(wal)
+	}
+
+	state.salt, state.frames, state.sum = wal.salt, commit.frames, commit.sum
+	state.fingerprint = fingerprint
+	sent := 0
+	if pages != nil {
+		state.pageSize = pageSize
+		state.sequence++
+		if sent, err = sendPages(name, pages, pageSize, pageCount, full, state.sequence, send); err != nil {
+			return sent, err
+		}
+	}
+	state.release(path)
+	return sent, nil
+}
+
+// repin starts a read transaction on the database and then ends the previous one.
+func (st *walState) repin(path string) error {
+	next := 1 - st.pin
+	conn, err := st.conn(next, path)
+	if err != nil {
+		return err
+	}
+	if _, err := conn.Exec("BEGIN", nil); err != nil {
+		return err
+	}
+	rows, err := conn.Query("SELECT count(*) FROM sqlite_master", nil)
+	if err == nil {
+		err = rows.Next(make([]driver.Value, 1))
+		rows.Close()
+	}
+	if err != nil {
+		_, _ = conn.Exec("ROLLBACK", nil)
+		return err
+	}
+	if st.pinned {
+		_, _ = st.pins[st.pin].Exec("ROLLBACK", nil)
+	}
+	st.pin, st.pinned = next, true
+	return nil
+}
+
+// release ends the read transaction once the follower has every frame of the WAL and
+// a passive checkpoint copied them all into the database file, so the WAL can restart.
+// Until the next change is read, the database file only changes if frames are
+// checkpointed that the follower has not seen.
+func (st *walState) release(path string) {
+	conn, err := st.conn(1-st.pin, path)
+	if err != nil {
+		return
+	}
+	rows, err := conn.Query("PRAGMA wal_checkpoint(PASSIVE)", nil)
+	if err != nil {
+		return
+	}
+	result := make([]driver.Value, 3)
+	err = rows.Next(result)
+	rows.Close()
+	if err != nil {
+		return
+	}
+	busy, log, checkpointed := result[0].(int64), result[1].(int64), result[2].(int64)
+	if busy != 0 || log != checkpointed || (log != st.frames && log > 0) {
+		return
+	}
+
+	// While pinned, nothing past the pin can be checkpointed
+	st.released = fileFingerprint(path)
+	_, _ = st.pins[st.pin].Exec("ROLLBACK", nil)
+	st.pinned = false
+}
+
+// conn returns the pin connection i, opening it if needed.
+func (st *walState) conn(i int, path string) (*sqlite3.SQLiteConn, error) {
+	if st.pins[i] == nil {
+		conn, err := (&sqlite3.SQLiteDriver{}).Open("file:" + path + "?_busy_timeout=10000")
+		if err != nil {
+			return nil, err
+		}
+		st.pins[i] = conn.(*sqlite3.SQLiteConn)
+	}
+	return st.pins[i], nil
+}
+
+func (st *walState) close() {
+	for i, conn := range st.pins {
+		if conn != nil {
+			conn.Close()
+			st.pins[i] = nil
+		}
+	}
+	st.pinned = false
+}
+
+// pageRef locates the content of a page in the database or WAL file.
+type pageRef struct {
+	number uint32
+	file   *os.File
+	offset int64
+}
+
+// snapshotPages lists every page of the database as of the last commit in the WAL.
+// Pages come from the WAL when it has them, else from the database file. The pins keep
+// the checkpointer from writing the database file pages that are not in the WAL.
+func snapshotPages(db *os.File, wal *walFile, commit walCommit) ([]pageRef, uint32, uint32, error) {
+	header := make([]byte, 100)
+	n, err := db.ReadAt(header, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, 0, 0, err
+	}
+	pageSize, pageCount := wal.pageSize, commit.dbSize
+	if pageCount == 0 {
+		if n < len(header) {
+			return nil, 0, 0, nil
+		}
+		pageSize = imagePageSize(header)
+		// The header's page count is valid if written by the same change as the counter
+		pageCount = binary.BigEndian.Uint32(header[28:])
+		if pageCount == 0 || !bytes.Equal(header[24:28], header[92:96]) {
+			info, err := db.Stat()
+			if err != nil {
+				return nil, 0, 0, err
+			}
+			pageCount = uint32(info.Size() / int64(pageSize))
+		}
+	}
+
+	pages := make([]pageRef, pageCount)
+	for i := range pages {
+		number := uint32(i + 1)
+		if offset, ok := commit.pages[number]; ok {
+			pages[i] = pageRef{number: number, file: wal.file, offset: offset}
+		} else {
+			pages[i] = pageRef{number: number, file: db, offset: int64(i) * int64(pageSize)}
+		}
+	}
+	return pages, pageSize, pageCount, nil
+}
+
+// sendPages reads the pages and sends them as one changeset, split into frames of
+// replicationPagesPerFrame. It returns the number of frames sent.
+func sendPages(name string, pages []pageRef, pageSize, pageCount uint32, full bool, sequence uint64, send func(*sqlrpcv1.ReplicationFrame) error) (int, error) {
+	// The follower applies the changeset once the last frame arrives
+	now := timestamppb.Now()
+	sent := 0
+	for start := 0; start == 0 || start < len(pages); start += replicationPagesPerFrame {
+		end := min(start+replicationPagesPerFrame, len(pages))
+		frame := &sqlrpcv1.ReplicationFrame{
+			Database:     name,
+			PageSize:     pageSize,
+			PageCount:    pageCount,
+			FullSnapshot: full,
+			Sequence:     sequence,
+			LeaderTime:   now,
+			Partial:      end < len(pages),
+		}
+		for _, ref := range pages[start:end] {
+			data := make([]byte, pageSize)
+			// Pages past the end of the file were never written
+			if _, err := ref.file.ReadAt(data, ref.offset); err != nil && !errors.Is(err, io.EOF) {
+				return sent, err
+			}
+			frame.Pages = append(frame.Pages, &sqlrpcv1.ReplicationPage{PageNumber: ref.number, Data: data})
+		}
+		if err := send(frame); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// imagePageSize reads the page size from a database header. SQLite stores 65536 as 1.
+func imagePageSize(image []byte) uint32 {
+	if len(image) < 100 {
+		return 0
+	}
+	size := uint32(image[16])<<8 | uint32(image[17])
+	if size == 1 {
+		size = 65536
+	}
+	return size
+}
+
+// databaseFilePath extracts the file path from a DbPath that may be a file: URI.
+func databaseFilePath(dbPath string) string {
+	path := strings.TrimPrefix(dbPath, "file:")
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	return path
+}
+
+// fileFingerprint summarizes the size and modification time of files. It is a cheap
+// change detector; the WAL decides what actually changed.
+func fileFingerprint(paths ...string) string {
+	var b strings.Builder
+	for _, p := range paths {
+		if info, err := os.Stat(p); err == nil {
+			fmt.Fprintf(&b, "%d:%d;", info.Size(), info.ModTime().UnixNano())
+		} else {
+			b.WriteString("-;")
+		}
+	}
+	return b.String()
+}
+
+// ===================================================================================
+// Leader: WAL Reading
+// ===================================================================================
+
+const (
+	walHeaderSize      = 32
+	walFrameHeaderSize = 24
+)
+
+// walFile is an open WAL file and its header. file is nil if the database has no WAL.
+type walFile struct {
+	file      *os.File
+	pageSize  uint32
+	salt      [2]uint32
+	sum       [2]uint32 // checksum of the header, where the frame checksums start
+	bigEndian bool      // byte order of the checksums
+}
+
+// walCommit is the result of reading a WAL up to its last complete transaction.
+type walCommit struct {
+	frames int64            // frames read, up to and including the last commit
+	sum    [2]uint32        // running checksum after them
+	dbSize uint32           // database size in pages after the last commit
+	pages  map[uint32]int64 // offset of the last version of each page read
+}
+
+// openWAL opens the WAL of the database at path and checks its header.
+func openWAL(path string) (*walFile, error) {
+	f, err := os.Open(path + "-wal")
+	if errors.Is(err, os.ErrNotExist) {
+		return &walFile{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	header := make([]byte, walHeaderSize)
+	if _, err := io.ReadFull(f, header); err != nil {
+		f.Close()
+		return &walFile{}, nil // Not written yet
+	}
+
+	magic := binary.BigEndian.Uint32(header)
+	w := &walFile{
+		file:      f,
+		pageSize:  binary.BigEndian.Uint32(header[8:]),
+		salt:      [2]uint32{binary.BigEndian.Uint32(header[16:]), binary.BigEndian.Uint32(header[20:])},
+		bigEndian: magic&1 == 1,
+	}
+	w.sum = w.checksum(header[:24], [2]uint32{})
+	if magic&^1 != 0x377f0682 || w.sum != [2]uint32{binary.BigEndian.Uint32(header[24:]), binary.BigEndian.Uint32(header[28:])} {
+		f.Close()
+		return &walFile{}, nil // Being reset; read it on the next change
+	}
+	return w, nil
+}
+
+func (w *walFile) close() {
+	if w.file != nil {
+		w.file.Close()
+	}
+}
+
+// checksum continues the WAL checksum s over b, as specified by the WAL file format.
+func (w *walFile) checksum(b []byte, s [2]uint32) [2]uint32 {
+	var order binary.ByteOrder = binary.LittleEndian
+	if w.bigEndian {
+		order = binary.BigEndian
+	}
+	for i := 0; i+8 <= len(b); i += 8 {
+		s[0] += order.Uint32(b[i:]) + s[1]
+		s[1] += order.Uint32(b[i+4:]) + s[0]
+	}
+	return s
+}
+
+// readCommitted reads the frames after the first from, whose running checksum is sum,
+// and returns the pages of the complete transactions among them. Like SQLite's
+// recovery, it stops at the first frame of another WAL generation or with a bad
+// checksum.
+func (w *walFile) readCommitted(from int64, sum [2]uint32) (walCommit, error) {
+	commit := walCommit{frames: from, sum: sum, pages: make(map[uint32]int64)}
+	if w.file == nil {
+		return commit, nil
+	}
+
+	frameSize := int64(walFrameHeaderSize) + int64(w.pageSize)
+	buf := make([]byte, frameSize)
+	pending := make(map[uint32]int64)
+	r := bufio.NewReaderSize(io.NewSectionReader(w.file, walHeaderSize+from*frameSize, math.MaxInt64), 1<<20)
+	for i := from; ; i++ {
+		if _, err := io.ReadFull(r, buf); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return commit, nil
+			}
+			return commit, err
+		}
+		if binary.BigEndian.Uint32(buf[8:]) != w.salt[0] || binary.BigEndian.Uint32(buf[12:]) != w.salt[1] {
+			return commit, nil
+		}
+		sum = w.checksum(buf[walFrameHeaderSize:], w.checksum(buf[:8], sum))
+		if sum != [2]uint32{binary.BigEndian.Uint32(buf[16:]), binary.BigEndian.Uint32(buf[20:])} {
+			return commit, nil
+		}
+
+		pending[binary.BigEndian.Uint32(buf)] = walHeaderSize + i*frameSize + walFrameHeaderSize
+		if dbSize := binary.BigEndian.Uint32(buf[4:]); dbSize > 0 {
+			maps.Copy(commit.pages, pending)
+			clear(pending)
+			commit.frames, commit.sum, commit.dbSize = i+1, sum, dbSize
+		}
+	}
+}
+
+// changedPages lists the pages the transactions changed, in page order. Pages past the
+// end of the database were truncated away.
+func (c walCommit) changedPages(wal *walFile) []pageRef {
+	pages := make([]pageRef, 0, len(c.pages))
+	for number, offset := range c.pages {
+		if number <= c.dbSize {
+			pages = append(pages, pageRef{number: number, file: wal.file, offset: offset})
+		}
+	}
+	slices.SortFunc(pages, func(a, b pageRef) int { return cmp.Compare(a.number, b.number) })
+	return pages
+}
+
+// ===================================================================================
+// Follower: Change Application
+// ===================================================================================
+
+// Follower replicates all databases of a leader into the local DbServer.
+type Follower struct {
+	dbServer *DbServer
+	client   sqlrpcv1connect.AdminServiceClient
+	leader   string
+	token    string
+	dbDir    string
+	id       string
+
+	mu             sync.Mutex
+	connected      bool
+	lastSync       time.Time
+	lastLeaderTime time.Time
+	images         map[string]*replicaImage
+
+	// accessControlHook runs after the leader's metadata was applied
+	accessControlHook func()
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// replicaImage is the follower's copy of a leader database, staged in a file next to
+// the local database.
+type replicaImage struct {
+	file      *os.File
+	pageSize  uint32
+	pageCount uint32
+	sequence  uint64 // sequence of the last received frame
+	applied   uint64 // sequence last copied into the local database
+	pending   bool   // more frames of the current sequence are expected
+}
+
+// NewFollower creates a follower that replicates from leader (host:port or a URL).
+// New databases are created in dbDir. tlsConfig is used for https leaders; nil uses
+// the system roots.
+func NewFollower(dbServer *DbServer, leader, token, dbDir string, tlsConfig *tls.Config) *Follower {
+	baseURL := leader
+	if !strings.Contains(baseURL, "://") {
+		baseURL = "http://" + baseURL
+	}
+	hostname, _ := os.Hostname()
+	return &Follower{
+		dbServer: dbServer,
+		client:   sqlrpcv1connect.NewAdminServiceClient(newReplicationClient(tlsConfig), baseURL),
+		leader:   leader,
+		token:    token,
+		dbDir:    dbDir,
+		id:       hostname,
+		images:   make(map[string]*replicaImage),
+	}
+}
+
+// newReplicationClient creates the HTTP client of a follower. The stream is long-lived,
+// so there is no overall timeout: connecting is bounded here and a silent leader is
+// detected by the idle timeout of the stream.
+func newReplicationClient(tlsConfig *tls.Config) *http.Client {
+	dialer := &net.Dialer{Timeout: 10 * time.Second, KeepAlive: 30 * time.Second}
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:                 http.ProxyFromEnvironment,
+			DialContext:           dialer.DialContext,
+			TLSClientConfig:       tlsConfig,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ResponseHeaderTimeout: 30 * time.Second,
+			ForceAttemptHTTP2:     true,
+		},
+	}
+}
+
+// SetAccessControlHook sets a function to run after the leader's users, keys, grants
+// and policies were applied, to drop what was cached from the previous ones. It must
+// be called before Start.
+func (f *Follower) SetAccessControlHook(hook func()) {
+	f.accessControlHook = hook
+}
+
+// Leader returns the address the follower replicates from.
+func (f *Follower) Leader() string {
+	return f.leader
+}
+
+// Start begins replicating in the background until Stop is called.
+func (f *Follower) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	f.cancel = cancel
+	f.done = make(chan struct{})
+
+	go func() {
+		defer close(f.done)
+		backoff := replicationRetryInterval
+		for {
+			connected, err := f.stream(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+			if connected {
+				backoff = replicationRetryInterval
+			}
+			if err != nil {
+				replicationLog.Warn("Stream from leader failed", "leader", f.leader, "retry_in", backoff, logging.Err(err))
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff = min(backoff*2, replicationMaxRetryInterval)
+		}
+	}()
+}
+
+// Stop ends replication and waits for the stream to close.
+func (f *Follower) Stop() {
+	if f.cancel == nil {
+		return
+	}
+	f.cancel()
+	<-f.done
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.closeImages()
+}
+
+// stream runs a single replication session against the leader. It reports whether the
+// leader accepted the stream.
+func (f *Follower) stream(ctx context.Context) (bool, error) {
+	req := connect.NewRequest(&sqlrpcv1.StreamReplicationRequest{FollowerId: f.id})
+	if f.token != "" {
+		req.Header().Set("Authorization", "Bearer "+f.token)
+	}
+
+	// The leader sends a heartbeat every replicationHeartbeatInterval; a stream that
+	// stays silent for much longer is dead
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	errIdle := fmt.Errorf("no message from leader for %s", replicationIdleTimeout)
+	idle := time.AfterFunc(replicationIdleTimeout, func() { cancel(errIdle) })
+	defer idle.Stop()
+
+	stream, err := f.client.StreamReplication(ctx, req)
+	if err != nil {
+		return false, idleError(ctx, err)
+	}
+	defer stream.Close()
+
+	// The first message confirms the leader accepted the stream
+	if !stream.Receive() {
+		return false, idleError(ctx, stream.Err())
+	}
+
+	f.mu.Lock()
+	// The leader starts every session with full snapshots
+	f.closeImages()
+	f.connected = true
+	f.mu.Unlock()
+	replicationLog.InfoContext(ctx, "Streaming from leader", "leader", f.leader)
+
+	defer func() {
+		f.mu.Lock()
+		f.connected = false
+		f.mu.Unlock()
+	}()
+
+	for {
+		idle.Reset(replicationIdleTimeout)
+		if err := f.apply(ctx, stream.Msg()); err != nil {
+			return true, err
+		}
+		if !stream.Receive() {
+			return true, idleError(ctx, stream.Err())
+		}
+	}
+}
+
+// idleError reports the idle timeout instead of the cancellation it caused.
+func idleError(ctx context.Context, err error) error {
+	if cause := context.Cause(ctx); cause != nil && !errors.Is(cause, context.Canceled) {
+		return cause
+	}
+	return err
+}
+
+// closeImages closes and forgets the staged images. Callers hold f.mu.
+func (f *Follower) closeImages() {
+	for _, img := range f.images {
+		img.file.Close()
+	}
+	clear(f.images)
+}
+
+// apply writes a frame into the staged image of its database and, once the changeset
+// is complete, copies the image into the local database.
+func (f *Follower) apply(ctx context.Context, frame *sqlrpcv1.ReplicationFrame) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.lastSync = time.Now()
+	if frame.Database == "" {
+		f.lastLeaderTime = frame.LeaderTime.AsTime()
+		return nil
+	}
+
+	// A snapshot split across frames only resets the image on its first frame
+	img := f.images[frame.Database]
+	if frame.FullSnapshot && (img == nil || !img.pending) {
+		file, err := f.stage(frame.Database)
+		if err != nil {
+			return err
+		}
+		if img != nil {
+			img.file.Close()
+		}
+		img = &replicaImage{file: file}
+		f.images[frame.Database] = img
+	} else if img == nil {
+		return fmt.Errorf("received changeset for %s before its snapshot", frame.Database)
+	}
+
+	for _, page := range frame.Pages {
+		if page.PageNumber == 0 || page.PageNumber > frame.PageCount || len(page.Data) != int(frame.PageSize) {
+			return fmt.Errorf("invalid page %d for %s", page.PageNumber, frame.Database)
+		}
+		if page.PageNumber == 1 {
+			// WAL databases carry WAL file format versions, which an immutable file
+			// cannot be opened with. Switch them to rollback journal versions.
+			page.Data[18], page.Data[19] = 1, 1
+		}
+		if _, err := img.file.WriteAt(page.Data, int64(page.PageNumber-1)*int64(frame.PageSize)); err != nil {
+			return fmt.Errorf("failed to stage changes to %s: %w", frame.Database, err)
+		}
+	}
+	img.pageSize, img.pageCount = frame.PageSize, frame.PageCount
+	img.sequence = frame.Sequence
+	img.pending = frame.Partial
+	if frame.Partial {
+		return nil
+	}
+
+	if err := img.file.Truncate(int64(frame.PageCount) * int64(frame.PageSize)); err != nil {
+		return fmt.Errorf("failed to stage changes to %s: %w", frame.Database, err)
+	}
+	restore := f.restore
+	if frame.Database == replicationMetadata {
+		restore = f.restoreAccessControl
+	}
+	if err := restore(ctx, frame.Database, img.file.Name()); err != nil {
+		return fmt.Errorf("failed to apply changes to %s: %w", frame.Database, err)
+	}
+	img.applied = frame.Sequence
+	f.lastLeaderTime = frame.LeaderTime.AsTime()
+	return nil
+}
+
+// stage creates the empty staging file of a database in dbDir.
+func (f *Follower) stage(name string) (*os.File, error) {
+	if err := os.MkdirAll(f.dbDir, 0o755); err != nil {
+		return nil, err
+	}
+	path, err := filepath.Abs(filepath.Join(f.dbDir, "."+name+".replica"))
+	if err != nil {
+		return nil, err
+	}
+	return os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o600)
+}
+
+// restore copies a staged database image into the local database, mounting it if
+// needed.
+func (f *Follower) restore(ctx context.Context, name, stagePath string) error {
+	mgr := f.dbServer.dbManager
+	if !mgr.HasDatabase(name) {
+		path, err := filepath.Abs(filepath.Join(f.dbDir, name+".db"))
+		if err != nil {
+			return err
+		}
+		config := &sqlrpcv1.DatabaseConfig{Name: name, DbPath: path}
+		if err := mgr.Mount(config); err != nil {
+			return err
+		}
+		replicationLog.InfoContext(ctx, "Mounted replica", logging.KeyDatabase, name, "path", config.DbPath)
+	}
+
+	// Only this follower writes the staging file, and never during the backup
+	src, err := (&sqlite3.SQLiteDriver{}).Open("file:" + stagePath + "?mode=ro&immutable=1")
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	srcConn := src.(*sqlite3.SQLiteConn)
+
+	db, err := mgr.GetConnection(ctx, name, ModeRW)
+	if err != nil {
+		return err
+	}
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn any) error {
+		destConn, ok := driverConn.(*sqlite3.SQLiteConn)
+		if !ok {
+			return fmt.Errorf("unexpected driver connection %T", driverConn)
+		}
+		backup, err := destConn.Backup("main", srcConn, "main")
+		if err != nil {
+			return err
+		}
+		if _, err := backup.Step(-1); err != nil {
+			backup.Finish()
+			return err
+		}
+		return backup.Finish()
+	})
+}
+
+// restoreAccessControl replaces the local users, keys, grants and policies with those
+// of a staged image of the leader's metadata database.
+func (f *Follower) restoreAccessControl(ctx context.Context, _, stagePath string) error {
+	s := f.dbServer
+	if s.store == nil {
+		return nil
+	}
+	if err := s.store.ReplaceAccessControl(ctx, stagePath); err != nil {
+		return err
+	}
+	if err := s.ReloadRowPolicies(ctx); err != nil {
+		return err
+	}
+	if err := s.ReloadColumnPolicies(ctx); err != nil {
+		return err
+	}
+	if f.accessControlHook != nil {
+		f.accessControlHook()
+	}
+	return nil
+}
+
+// Status reports the follower's replication state.
+func (f *Follower) Status() *sqlrpcv1.ReplicationStatus {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	status := &sqlrpcv1.ReplicationStatus{
+		Role:      sqlrpcv1.ReplicationRole_REPLICATION_ROLE_FOLLOWER,
+		Leader:    f.leader,
+		Connected: f.connected,
+	}
+	if !f.lastSync.IsZero() {
+		status.LastSyncTime = timestamppb.New(f.lastSync)
+	}
+	if !f.lastLeaderTime.IsZero() {
+		status.Lag = durationpb.New(max(time.Since(f.lastLeaderTime), 0))
+	}
+	for name, img := range f.images {
+		if name == replicationMetadata {
+			continue
+		}
+		status.Databases = append(status.Databases, &sqlrpcv1.DatabaseReplicationStatus{
+			Database:  name,
+			Sequence:  img.applied,
+			PageCount: img.pageCount,
+		})
+	}
+	slices.SortFunc(status.Databases, func(a, b *sqlrpcv1.DatabaseReplicationStatus) int {
+		return strings.Compare(a.Database, b.Database)
+	})
+	return status
+}
+
+// ===================================================================================
+// Follower: Write Rejection
+// ===================================================================================
+
+// leaderOnlyProcedures are procedures that always modify state on a follower.
+var leaderOnlyProcedures = map[string]bool{
+	"/sqlrpc.v1.DatabaseService/BeginTransaction":   true,
+	"/sqlrpc.v1.DatabaseService/Transaction":        true,
+	"/sqlrpc.v1.DatabaseService/ExecuteTransaction": true,
+	"/sqlrpc.v1.DatabaseService/Import":             true,
+	"/sqlrpc.v1.DatabaseService/Vacuum":             true,
+	"/sqlrpc.v1.DatabaseService/Checkpoint":         true,
+	"/sqlrpc.v1.DatabaseService/AttachDatabase":     true,
+	"/sqlrpc.v1.DatabaseService/DetachDatabase":     true,
+	"/sqlrpc.v1.DatabaseService/RestoreDatabase":    true,
+
+	"/sqlrpc.v1.AdminService/CreateDatabase":  true,
+	"/sqlrpc.v1.AdminService/DeleteDatabase":  true,
+	"/sqlrpc.v1.AdminService/UpdateDatabase":  true,
+	"/sqlrpc.v1.AdminService/MountDatabase":   true,
+	"/sqlrpc.v1.AdminService/UnMountDatabase": true,
+
+	// Access control is replicated from the leader, which would overwrite local changes
+	"/sqlrpc.v1.AdminService/CreateUser":           true,
+	"/sqlrpc.v1.AdminService/UpdateUserRole":       true,
+	"/sqlrpc.v1.AdminService/DeleteUser":           true,
+	"/sqlrpc.v1.AdminService/UpdatePassword":       true,
+	"/sqlrpc.v1.AdminService/CreateAPIKey":         true,
+	"/sqlrpc.v1.AdminService/DeleteAPIKey":         true,
+	"/sqlrpc.v1.AdminService/Login":                true,
+	"/sqlrpc.v1.AdminService/Logout":               true,
+	"/sqlrpc.v1.AdminService/GrantDatabaseAccess":  true,
+	"/sqlrpc.v1.AdminService/RevokeDatabaseAccess": true,
+	"/sqlrpc.v1.AdminService/SetRateLimit":         true,
+	"/sqlrpc.v1.AdminService/DeleteRateLimit":      true,
+	"/sqlrpc.v1.AdminService/CreateRowPolicy":      true,
+	"/sqlrpc.v1.AdminService/DeleteRowPolicy":      true,
+	"/sqlrpc.v1.AdminService/SetColumnPolicy":      true,
+	"/sqlrpc.v1.AdminService/DeleteColumnPolicy":   true,
+}
+
+// ReplicaInterceptor rejects writes on a follower so they cannot diverge from the leader.
+// Reads pass through untouched.
+type ReplicaInterceptor struct {
+	leader string
+}
+
+// NewReplicaInterceptor creates the write guard for a follower of leader.
+func NewReplicaInterceptor(leader string) *ReplicaInterceptor {
+	return &ReplicaInterceptor{leader: leader}
+}
+
+// check returns an error if the procedure or payload would write.
+func (i *ReplicaInterceptor) check(procedure string, msg any) error {
+	if leaderOnlyProcedures[procedure] {
+		return i.reject()
+	}
+	if sql, ok := requestSQL(msg); ok && !sqlclass.Classify(sql).ReadOnly() {
+		return i.reject()
+	}
+	return nil
+}
+
+func (i *ReplicaInterceptor) reject() error {
+	return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("read replica: writes must be sent to the leader at %s", i.leader))
+}
+
+// GuardHTTP wraps the authorizer of the plain HTTP endpoints, such as the REST
+// gateway, so that they reject writes like the RPCs do.
+func (i *ReplicaInterceptor) GuardHTTP(next HTTPAuthorizer) HTTPAuthorizer {
+	return &replicaAuthorizer{next: next, interceptor: i}
+}
+
+type replicaAuthorizer struct {
+	next        HTTPAuthorizer
+	interceptor *ReplicaInterceptor
+}
+
+// AuthorizeHTTP authorizes the request with the wrapped authorizer, then rejects it if
+// it would write.
+func (a *replicaAuthorizer) AuthorizeHTTP(r *http.Request, procedure string, req connect.AnyRequest) (context.Context, func(error), error) {
+	ctx, finish, err := a.next.AuthorizeHTTP(r, procedure, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := a.interceptor.check(procedure, req.Any()); err != nil {
+		finish(err)
+		return nil, nil, err
+	}
+	return ctx, finish, nil
+}
+
+// requestSQL extracts the SQL of stateless query, exec and batch payloads.
+func requestSQL(msg any) (string, bool) {
+	switch m := msg.(type) {
+	case *sqlrpcv1.QueryRequest:
+		return m.Sql, true
+	case *sqlrpcv1.TypedQueryRequest:
+		return m.Sql, true
+	case *sqlrpcv1.BatchExecRequest:
+		return m.Sql, true
+	case *sqlrpcv1.TypedBatchExecRequest:
+		return m.Sql, true
+	}
+	return "", false
+}
+
+// WrapUnary rejects unary writes.
+func (i *ReplicaInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := i.check(req.Spec().Procedure, req.Any()); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient is not implemented on the server side.
+func (i *ReplicaInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler rejects leader-only streams up front and inspects every
+// message received on the others.
+func (i *ReplicaInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if leaderOnlyProcedures[conn.Spec().Procedure] {
+			return i.reject()
+		}
+		return next(ctx, &replicaStreamWrapper{StreamingHandlerConn: conn, interceptor: i})
+	}
+}
+
+type replicaStreamWrapper struct {
+	connect.StreamingHandlerConn
+	interceptor *ReplicaInterceptor
+}
+
+func (w *replicaStreamWrapper) Receive(msg any) error {
+	if err := w.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	return w.interceptor.check(w.Spec().Procedure, msg)
+}
@@ -0,0 +1,409 @@
+package servicesv1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"sqlite-server/internal/auth"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+	"sqlite-server/internal/protos/sqlrpc/v1/sqlrpcv1connect"
+)
+
+// replicationNode is one in-process server of a leader/follower pair.
+type replicationNode struct {
+	dbServer *DbServer
+	admin    *AdminServer
+	db       sqlrpcv1connect.DatabaseServiceClient
+	adminAPI sqlrpcv1connect.AdminServiceClient
+	url      string
+}
+
+func startReplicationNode(t *testing.T, configs []*sqlrpcv1.DatabaseConfig, follower func(*DbServer) *Follower) *replicationNode {
+	store, err := auth.NewMetaStore(filepath.Join(t.TempDir(), "meta.db"))
+	require.NoError(t, err)
+	dbServer := NewDbServer(configs, store, nil)
+	admin := NewAdminServer(store, dbServer, nil, true, "v0.0.1-test")
+
+	interceptors := []connect.Interceptor{&testAuthInterceptor{}}
+	if follower != nil {
+		f := follower(dbServer)
+		admin.SetFollower(f)
+		interceptors = append(interceptors, NewReplicaInterceptor(f.Leader()))
+		f.Start()
+		t.Cleanup(f.Stop)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(sqlrpcv1connect.NewDatabaseServiceHandler(dbServer, connect.WithInterceptors(interceptors...)))
+	mux.Handle(sqlrpcv1connect.NewAdminServiceHandler(admin, connect.WithInterceptors(interceptors...)))
+	ts := httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
+
+	// Cleanups run in reverse: the HTTP server closes before the follower stops
+	t.Cleanup(func() {
+		dbServer.Stop()
+		store.Close()
+	})
+	t.Cleanup(ts.Close)
+
+	return &replicationNode{
+		dbServer: dbServer,
+		admin:    admin,
+		db:       sqlrpcv1connect.NewDatabaseServiceClient(http.DefaultClient, ts.URL),
+		adminAPI: sqlrpcv1connect.NewAdminServiceClient(http.DefaultClient, ts.URL),
+		url:      ts.URL,
+	}
+}
+
+func TestReplication_LeaderFollower(t *testing.T) {
+	oldPoll, oldHeartbeat := replicationPollInterval, replicationHeartbeatInterval
+	replicationPollInterval, replicationHeartbeatInterval = 20*time.Millisecond, 100*time.Millisecond
+	t.Cleanup(func() { replicationPollInterval, replicationHeartbeatInterval = oldPoll, oldHeartbeat })
+
+	ctx := context.Background()
+	leader := startReplicationNode(t, []*sqlrpcv1.DatabaseConfig{
+		{Name: "app", DbPath: filepath.Join(t.TempDir(), "app.db")},
+		{Name: "scratch", DbPath: ":memory:"},
+	}, nil)
+
+	leaderDB, err := leader.dbServer.dbManager.GetConnection(ctx, "app", ModeRW)
+	require.NoError(t, err)
+	_, err = leaderDB.Exec(`
+		CREATE TABLE items (id INTEGER PRIMARY KEY, payload BLOB);
+		INSERT INTO items (payload) VALUES (x'01');
+	`)
+	require.NoError(t, err)
+
+	followerDir := t.TempDir()
+	follower := startReplicationNode(t, nil, func(dbServer *DbServer) *Follower {
+		return NewFollower(dbServer, leader.url, "", followerDir, nil)
+	})
+
+	count := func() int {
+		res, err := follower.db.Query(ctx, connect.NewRequest(&sqlrpcv1.QueryRequest{
+			Database: "app",
+			Sql:      "SELECT count(*) FROM items",
+		}))
+		if err != nil {
+			return -1
+		}
+		return int(res.Msg.Rows[0].Values[0].GetNumberValue())
+	}
+
+	t.Run("initial snapshot", func(t *testing.T) {
+		require.Eventually(t, func() bool { return count() == 1 }, 5*time.Second, 20*time.Millisecond)
+		assert.FileExists(t, filepath.Join(followerDir, "app.db"))
+		assert.False(t, follower.dbServer.dbManager.HasDatabase("scratch"), "in-memory databases are not replicated")
+	})
+
+	t.Run("incremental changes", func(t *testing.T) {
+		_, err := leaderDB.Exec("INSERT INTO items (payload) VALUES (x'02'), (x'03')")
+		require.NoError(t, err)
+		require.Eventually(t, func() bool { return count() == 3 }, 5*time.Second, 20*time.Millisecond)
+	})
+
+	t.Run("changeset spanning several frames", func(t *testing.T) {
+		_, err := leaderDB.Exec("INSERT INTO items (payload) VALUES (randomblob(4 * 1024 * 1024))")
+		require.NoError(t, err)
+		require.Eventually(t, func() bool { return count() == 4 }, 5*time.Second, 20*time.Millisecond)
+
+		_, err = leaderDB.Exec("DELETE FROM items WHERE length(payload) > 1; VACUUM")
+		require.NoError(t, err)
+		require.Eventually(t, func() bool { return count() == 3 }, 5*time.Second, 20*time.Millisecond)
+	})
+
+	t.Run("server info reports replication state", func(t *testing.T) {
+		res, err := follower.adminAPI.GetServerInfo(ctx, connect.NewRequest(&sqlrpcv1.GetServerInfoRequest{}))
+		require.NoError(t, err)
+		status := res.Msg.Replication
+		assert.Equal(t, sqlrpcv1.ReplicationRole_REPLICATION_ROLE_FOLLOWER, status.Role)
+		assert.Equal(t, leader.url, status.Leader)
+		assert.True(t, status.Connected)
+		require.NotNil(t, status.Lag)
+		assert.Less(t, status.Lag.AsDuration(), 5*time.Second)
+		require.Len(t, status.Databases, 1)
+		assert.Equal(t, "app", status.Databases[0].Database)
+		assert.Greater(t, status.Databases[0].Sequence, uint64(1))
+
+		res, err = leader.adminAPI.GetServerInfo(ctx, connect.NewRequest(&sqlrpcv1.GetServerInfoRequest{}))
+		require.NoError(t, err)
+		assert.Equal(t, sqlrpcv1.ReplicationRole_REPLICATION_ROLE_LEADER, res.Msg.Replication.Role)
+		assert.Equal(t, int32(1), res.Msg.Replication.FollowerCount)
+	})
+
+	t.Run("follower rejects writes", func(t *testing.T) {
+		_, err := follower.db.Exec(ctx, connect.NewRequest(&sqlrpcv1.QueryRequest{
+			Database: "app",
+			Sql:      "INSERT INTO items (payload) VALUES (x'04')",
+		}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+		assert.Contains(t, err.Error(), leader.url)
+
+		_, err = follower.db.BeginTransaction(ctx, connect.NewRequest(&sqlrpcv1.BeginTransactionRequest{Database: "app"}))
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+		stream, err := follower.db.QueryStream(ctx, connect.NewRequest(&sqlrpcv1.QueryRequest{
+			Database: "app",
+			Sql:      "WITH x AS (SELECT 1) DELETE FROM items",
+		}))
+		require.NoError(t, err)
+		for stream.Receive() {
+		}
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(stream.Err()))
+
+		chained, err := follower.adminAPI.StreamReplication(ctx, connect.NewRequest(&sqlrpcv1.StreamReplicationRequest{}))
+		require.NoError(t, err)
+		assert.False(t, chained.Receive())
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(chained.Err()))
+	})
+
+	t.Run("follower serves reads", func(t *testing.T) {
+		stream, err := follower.db.QueryStream(ctx, connect.NewRequest(&sqlrpcv1.QueryRequest{
+			Database: "app",
+			Sql:      "SELECT id FROM items ORDER BY id",
+		}))
+		require.NoError(t, err)
+		for stream.Receive() {
+		}
+		assert.NoError(t, stream.Err())
+	})
+}
+
+func TestReplicationSource_WAL(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.db")
+	db, err := sql.Open("sqlite3", "file:"+path+"?_journal=WAL")
+	require.NoError(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+	_, err = db.Exec("PRAGMA wal_autocheckpoint = 0; CREATE TABLE items (id INTEGER PRIMARY KEY, payload BLOB)")
+	require.NoError(t, err)
+
+	src := &replicationSource{states: make(map[string]*walState)}
+	defer src.close()
+
+	// Apply the frames to an image and count the rows it has, like a follower would
+	image := make([]byte, 0)
+	sync := func() *sqlrpcv1.ReplicationFrame {
+		var last *sqlrpcv1.ReplicationFrame
+		_, err := src.sync("app", path, fileFingerprint(path, path+"-wal"), func(frame *sqlrpcv1.ReplicationFrame) error {
+			if frame.FullSnapshot && (last == nil || !last.Partial) {
+				image = image[:0]
+			}
+			size := int(frame.PageCount) * int(frame.PageSize)
+			image = append(image, make([]byte, max(size-len(image), 0))...)[:size]
+			for _, page := range frame.Pages {
+				copy(image[int(page.PageNumber-1)*int(frame.PageSize):], page.Data)
+			}
+			last = frame
+			return nil
+		})
+		require.NoError(t, err)
+		return last
+	}
+	count := func() int {
+		copyPath := filepath.Join(t.TempDir(), "copy.db")
+		image[18], image[19] = 1, 1
+		require.NoError(t, os.WriteFile(copyPath, image, 0o600))
+		copyDB, err := sql.Open("sqlite3", "file:"+copyPath+"?mode=ro&immutable=1")
+		require.NoError(t, err)
+		defer copyDB.Close()
+		var n int
+		require.NoError(t, copyDB.QueryRow("SELECT count(*) FROM items").Scan(&n))
+		return n
+	}
+	insert := func() {
+		_, err := db.Exec("INSERT INTO items (payload) VALUES (randomblob(100))")
+		require.NoError(t, err)
+	}
+	truncate := func() {
+		_, err := db.Exec("PRAGMA wal_checkpoint(TRUNCATE)")
+		require.NoError(t, err)
+	}
+
+	insert()
+	frame := sync()
+	require.NotNil(t, frame)
+	assert.True(t, frame.FullSnapshot)
+	assert.Equal(t, 1, count())
+
+	t.Run("incremental changes", func(t *testing.T) {
+		insert()
+		frame := sync()
+		require.NotNil(t, frame)
+		assert.False(t, frame.FullSnapshot)
+		assert.Less(t, len(frame.Pages), int(frame.PageCount))
+		assert.Equal(t, 2, count())
+		assert.False(t, src.states["app"].pinned, "the pin is released once the follower caught up")
+	})
+
+	t.Run("WAL restart after catching up", func(t *testing.T) {
+		truncate()
+		insert()
+		frame := sync()
+		require.NotNil(t, frame)
+		assert.False(t, frame.FullSnapshot)
+		assert.Equal(t, 3, count())
+	})
+
+	t.Run("WAL restart over unsent frames", func(t *testing.T) {
+		insert()
+		truncate()
+		insert()
+		frame := sync()
+		require.NotNil(t, frame)
+		assert.True(t, frame.FullSnapshot)
+		assert.Equal(t, 5, count())
+	})
+
+	t.Run("pinned WAL does not restart", func(t *testing.T) {
+		// A reader keeps the checkpoint from completing, so the pin is kept
+		reader, err := sql.Open("sqlite3", "file:"+path)
+		require.NoError(t, err)
+		defer reader.Close()
+		tx, err := reader.Begin()
+		require.NoError(t, err)
+		require.NoError(t, tx.QueryRow("SELECT count(*) FROM items").Scan(new(int)))
+		insert()
+		require.NotNil(t, sync())
+		require.NoError(t, tx.Rollback())
+		assert.True(t, src.states["app"].pinned)
+		salt := src.states["app"].salt
+
+		insert()
+		_, err = db.Exec("PRAGMA wal_checkpoint(PASSIVE)")
+		require.NoError(t, err)
+		insert()
+		frame := sync()
+		require.NotNil(t, frame)
+		assert.False(t, frame.FullSnapshot)
+		assert.Equal(t, salt, src.states["app"].salt)
+		assert.Equal(t, 8, count())
+	})
+}
+
+// silentLeader accepts replication streams and then stops sending.
+type silentLeader struct {
+	sqlrpcv1connect.UnimplementedAdminServiceHandler
+}
+
+func (silentLeader) StreamReplication(ctx context.Context, _ *connect.Request[sqlrpcv1.StreamReplicationRequest], stream *connect.ServerStream[sqlrpcv1.ReplicationFrame]) error {
+	if err := stream.Send(&sqlrpcv1.ReplicationFrame{LeaderTime: timestamppb.Now()}); err != nil {
+		return err
+	}
+	<-ctx.Done()
+	return nil
+}
+
+func TestFollower_IdleTimeout(t *testing.T) {
+	oldIdle := replicationIdleTimeout
+	replicationIdleTimeout = 100 * time.Millisecond
+	t.Cleanup(func() { replicationIdleTimeout = oldIdle })
+
+	mux := http.NewServeMux()
+	mux.Handle(sqlrpcv1connect.NewAdminServiceHandler(silentLeader{}))
+	ts := httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
+	defer ts.Close()
+
+	f := NewFollower(nil, ts.URL, "", t.TempDir(), nil)
+	connected, err := f.stream(context.Background())
+	assert.True(t, connected)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no message from leader")
+}
+
+func TestReplicaInterceptor_Check(t *testing.T) {
+	i := NewReplicaInterceptor("leader:50173")
+
+	tests := []struct {
+		procedure string
+		msg       any
+		allowed   bool
+	}{
+		{"/sqlrpc.v1.DatabaseService/Query", &sqlrpcv1.QueryRequest{Sql: "SELECT 1"}, true},
+		{"/sqlrpc.v1.DatabaseService/Query", &sqlrpcv1.QueryRequest{Sql: "DELETE FROM t"}, false},
+		{"/sqlrpc.v1.DatabaseService/TypedQuery", &sqlrpcv1.TypedQueryRequest{Sql: "PRAGMA user_version = 2"}, false},
+		{"/sqlrpc.v1.DatabaseService/Query", &sqlrpcv1.QueryRequest{Sql: "BEGIN IMMEDIATE"}, false},
+		{"/sqlrpc.v1.DatabaseService/ListTables", &sqlrpcv1.ListTablesRequest{}, true},
+		{"/sqlrpc.v1.DatabaseService/Vacuum", &sqlrpcv1.VacuumRequest{}, false},
+		{"/sqlrpc.v1.AdminService/CreateDatabase", &sqlrpcv1.CreateDatabaseRequest{}, false},
+		{"/sqlrpc.v1.AdminService/ListDatabases", &sqlrpcv1.ListDatabasesRequest{}, true},
+		{"/sqlrpc.v1.AdminService/CreateUser", &sqlrpcv1.CreateUserRequest{}, false},
+		{"/sqlrpc.v1.AdminService/Login", &sqlrpcv1.LoginRequest{}, false},
+		{"/sqlrpc.v1.AdminService/CreateRowPolicy", &sqlrpcv1.CreateRowPolicyRequest{}, false},
+		{"/sqlrpc.v1.AdminService/ListRowPolicies", &sqlrpcv1.ListRowPoliciesRequest{}, true},
+	}
+	for _, tt := range tests {
+		err := i.check(tt.procedure, tt.msg)
+		if tt.allowed {
+			assert.NoError(t, err, tt.procedure)
+		} else {
+			assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), tt.procedure)
+		}
+	}
+}
+
+func TestReplication_AccessControl(t *testing.T) {
+	oldPoll, oldHeartbeat := replicationPollInterval, replicationHeartbeatInterval
+	replicationPollInterval, replicationHeartbeatInterval = 20*time.Millisecond, 100*time.Millisecond
+	t.Cleanup(func() { replicationPollInterval, replicationHeartbeatInterval = oldPoll, oldHeartbeat })
+
+	ctx := context.Background()
+	leader := startReplicationNode(t, []*sqlrpcv1.DatabaseConfig{
+		{Name: "app", DbPath: filepath.Join(t.TempDir(), "app.db")},
+	}, nil)
+
+	leaderDB, err := leader.dbServer.dbManager.GetConnection(ctx, "app", ModeRW)
+	require.NoError(t, err)
+	_, err = leaderDB.Exec(`
+		CREATE TABLE orders (id INTEGER PRIMARY KEY, owner TEXT);
+		INSERT INTO orders (owner) VALUES ('alice'), ('bob');
+	`)
+	require.NoError(t, err)
+	aliceID, err := leader.dbServer.store.CreateUser(ctx, "alice", "secret", sqlrpcv1.Role_ROLE_READ_ONLY)
+	require.NoError(t, err)
+	policy, err := leader.dbServer.store.CreateRowPolicy(ctx, auth.RowPolicy{Database: "app", Table: "orders", Name: "own", Using: "owner = current_user()"})
+	require.NoError(t, err)
+
+	var applied atomic.Int32
+	follower := startReplicationNode(t, nil, func(dbServer *DbServer) *Follower {
+		f := NewFollower(dbServer, leader.url, "", t.TempDir(), nil)
+		f.SetAccessControlHook(func() { applied.Add(1) })
+		return f
+	})
+
+	alice := userContext(aliceID, "alice", sqlrpcv1.Role_ROLE_READ_ONLY)
+	count := func() int {
+		res, err := follower.dbServer.Query(alice, connect.NewRequest(&sqlrpcv1.QueryRequest{
+			Database: "app",
+			Sql:      "SELECT count(*) FROM orders",
+		}))
+		if err != nil {
+			return -1
+		}
+		return int(res.Msg.Rows[0].Values[0].GetNumberValue())
+	}
+
+	// The follower enforces the leader's users and policies
+	require.Eventually(t, func() bool { return count() == 1 }, 5*time.Second, 20*time.Millisecond)
+	claims, err := follower.dbServer.store.ValidateUser(ctx, "alice", "secret")
+	require.NoError(t, err)
+	assert.Equal(t, aliceID, claims.UserID)
+	assert.Positive(t, applied.Load())
+
+	require.NoError(t, leader.dbServer.store.DeleteRowPolicy(ctx, policy.ID))
+	require.Eventually(t, func() bool { return count() == 2 }, 5*time.Second, 20*time.Millisecond)
+}
@@ -0,0 +1,664 @@
+package servicesv1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"sqlite-server/internal/docs"
+	"sqlite-server/internal/logging"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+	"sqlite-server/internal/protos/sqlrpc/v1/sqlrpcv1connect"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// maxRESTBody is the largest request body the REST gateway reads.
+const maxRESTBody = 16 << 20
+
+// restReserved are the query parameters of the REST gateway that are not filters.
+var restReserved = map[string]bool{"select": true, "order": true, "limit": true, "offset": true}
+
+// restOperators maps the comparison operators of REST filters to SQL.
+var restOperators = map[string]string{
+	"eq":  "=",
+	"neq": "<>",
+	"gt":  ">",
+	"gte": ">=",
+	"lt":  "<",
+	"lte": "<=",
+}
+
+// restOrderModifiers maps the suffixes of order terms to SQL.
+var restOrderModifiers = map[string]string{
+	"asc":        "ASC",
+	"desc":       "DESC",
+	"nullsfirst": "NULLS FIRST",
+	"nullslast":  "NULLS LAST",
+}
+
+// RESTHandler serves the REST gateway, a JSON resource per table:
+//
+//   - GET /rest/{db}: the OpenAPI document of the database's routes.
+//   - GET, PATCH and DELETE /rest/{db}/{table}: read, update or delete the rows
+//     matching the filters. PATCH and DELETE need at least one filter.
+//   - POST /rest/{db}/{table}: insert a JSON object or an array of them.
+//   - GET, PATCH and DELETE /rest/{db}/{table}/{key}: the row with the given primary
+//     key, or rowid if the table has none.
+//
+// Filters are query parameters in the form column=operator.value, with the operators
+// eq, neq, gt, gte, lt, lte, like (GLOB, case-sensitive), ilike (LIKE), is (null, true
+// or false) and in, as in id=in.(1,2). A not. prefix negates them. The parameters
+// select, order (column.asc or column.desc, optionally followed by .nullsfirst or
+// .nullslast), limit and offset shape the result.
+//
+// Every request runs as one parameterized statement, authorized by authorizer as the
+// Query (reads) or Exec (writes) RPC carrying it, so roles, database grants, row and
+// column security and the audit log apply as they do to the RPC. Writes return the
+// rows they changed.
+func (s *DbServer) RESTHandler(authorizer HTTPAuthorizer) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /rest/{db}", func(w http.ResponseWriter, r *http.Request) {
+		s.serveRESTSpec(w, r, authorizer)
+	})
+	rows := func(w http.ResponseWriter, r *http.Request) {
+		s.serveREST(w, r, authorizer)
+	}
+	for _, method := range []string{"GET", "POST", "PATCH", "DELETE"} {
+		mux.HandleFunc(method+" /rest/{db}/{table}", rows)
+	}
+	for _, method := range []string{"GET", "PATCH", "DELETE"} {
+		mux.HandleFunc(method+" /rest/{db}/{table}/{key}", rows)
+	}
+	return mux
+}
+
+// serveRESTSpec answers with the OpenAPI document of a database, built from the
+// schema GetDatabaseSchema returns.
+func (s *DbServer) serveRESTSpec(w http.ResponseWriter, r *http.Request, authorizer HTTPAuthorizer) {
+	r = r.WithContext(newRequestScope(r.Context(), "/rest", r.Header))
+	w.Header().Set(headerRequestID, r.Header.Get(headerRequestID))
+
+	database := r.PathValue("db")
+	req := connect.NewRequest(&sqlrpcv1.GetDatabaseSchemaRequest{Database: database})
+	ctx, finish, err := authorizer.AuthorizeHTTP(r, sqlrpcv1connect.DatabaseServiceGetDatabaseSchemaProcedure, req)
+	if err != nil {
+		writeRESTError(w, err)
+		return
+	}
+	defer func() { finish(err) }()
+
+	res, err := s.GetDatabaseSchema(ctx, req)
+	if err != nil {
+		writeRESTError(w, err)
+		return
+	}
+	tables := make([]docs.RESTTable, len(res.Msg.Tables))
+	for i, t := range res.Msg.Tables {
+		tables[i] = docs.RESTTable{Schema: t, Key: restKeyColumn(t)}
+	}
+	spec, err := docs.RESTSpec(database, tables)
+	if err != nil {
+		err = connect.NewError(connect.CodeInternal, err)
+		writeRESTError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(spec)
+}
+
+// serveREST runs a row request of the REST gateway.
+func (s *DbServer) serveREST(w http.ResponseWriter, r *http.Request, authorizer HTTPAuthorizer) {
+	r = r.WithContext(newRequestScope(r.Context(), "/rest", r.Header))
+	reqID := r.Header.Get(headerRequestID)
+	w.Header().Set(headerRequestID, reqID)
+
+	database, table, key := r.PathValue("db"), r.PathValue("table"), r.PathValue("key")
+	keyed := strings.HasSuffix(r.Pattern, "{key}")
+
+	// The key column comes from the schema, which is only read once the caller is
+	// authorized. Until then the statement looks the row up by rowid, which reads and
+	// writes the same table; the body is kept to build it again.
+	var keyColumn string
+	var body []byte
+	if keyed {
+		keyColumn = "rowid"
+		if r.Body != nil {
+			var err error
+			if body, err = io.ReadAll(io.LimitReader(r.Body, maxRESTBody)); err != nil {
+				writeRESTError(w, connect.NewError(connect.CodeInvalidArgument, err))
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+		}
+	}
+	stmt, err := buildRESTStatement(r, table, keyColumn, key)
+	if err != nil {
+		writeRESTError(w, connect.NewError(connect.CodeInvalidArgument, err))
+		return
+	}
+
+	procedure := sqlrpcv1connect.DatabaseServiceQueryProcedure
+	if r.Method != http.MethodGet {
+		procedure = sqlrpcv1connect.DatabaseServiceExecProcedure
+	}
+	msg := &sqlrpcv1.QueryRequest{Database: database, Sql: stmt.sql, Parameters: stmt.parameters()}
+	ctx, finish, err := authorizer.AuthorizeHTTP(r, procedure, connect.NewRequest(msg))
+	if err != nil {
+		writeRESTError(w, err)
+		return
+	}
+	defer func() { finish(err) }()
+
+	if keyed {
+		if keyColumn, err = s.restKey(ctx, database, table); err == nil && keyColumn != "rowid" {
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			if stmt, err = buildRESTStatement(r, table, keyColumn, key); err != nil {
+				err = connect.NewError(connect.CodeInvalidArgument, err)
+			} else {
+				msg.Sql, msg.Parameters = stmt.sql, stmt.parameters()
+			}
+		}
+	}
+
+	var result *sqlrpcv1.QueryResult
+	if err == nil {
+		if err = protovalidate.Validate(msg); err != nil {
+			err = connect.NewError(connect.CodeInvalidArgument, err)
+		} else {
+			result, err = s.restExecute(ctx, reqID, msg)
+		}
+	}
+	if err == nil && keyed && len(result.Rows) == 0 {
+		err = connect.NewError(connect.CodeNotFound, fmt.Errorf("no row of %s has %s %s", table, keyColumn, key))
+	}
+	if err != nil {
+		rpcLog.WarnContext(ctx, "REST request failed", logging.Err(err))
+		writeRESTError(w, err)
+		return
+	}
+
+	accountHTTP(ctx, w.Header(), result)
+	status := http.StatusOK
+	if r.Method == http.MethodPost {
+		status = http.StatusCreated
+	}
+	writeRESTRows(w, status, result, keyed)
+}
+
+// restExecute runs the statement of a REST request like Query does.
+func (s *DbServer) restExecute(ctx context.Context, reqID string, msg *sqlrpcv1.QueryRequest) (*sqlrpcv1.QueryResult, error) {
+	db, err := s.dbManager.GetConnection(ctx, msg.Database, s.statementMode(ctx, msg.Database, msg.Sql))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	ctx, done := s.startStatement(ctx, reqID, msg.Database, msg.Sql, 0)
+	defer done()
+	q, release, err := s.statelessQuerier(ctx, msg.Database, db)
+	if err != nil {
+		return nil, makeUnaryError(statementError(ctx, err), msg.Sql)
+	}
+	defer release()
+	result, err := executeQueryAndBuffer(ctx, q, msg.Sql, msg.Parameters)
+	if err != nil {
+		return nil, makeUnaryError(statementError(ctx, err), msg.Sql)
+	}
+	return result, nil
+}
+
+// restKey returns the column the key routes of table look rows up by.
+func (s *DbServer) restKey(ctx context.Context, database, table string) (string, error) {
+	// Every pool of an in-memory database holds its own copy; the RW pool is the real one
+	mode := ModeRO
+	if s.dbManager.InMemory(database) {
+		mode = ModeRW
+	}
+	db, err := s.dbManager.GetConnection(ctx, database, mode)
+	if err != nil {
+		return "", connect.NewError(connect.CodeNotFound, err)
+	}
+	schema, err := s.buildTableSchema(ctx, db, table)
+	if err != nil {
+		return "", err
+	}
+	column := restKeyColumn(schema)
+	if column == "" {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("table %q has no single-column primary key: use filters", table))
+	}
+	return column, nil
+}
+
+// restKeyColumn returns the primary key column of a table, rowid if it has none, or ""
+// if its key spans several columns or it has no rowid.
+func restKeyColumn(t *sqlrpcv1.TableSchema) string {
+	var key []string
+	for _, c := range t.Columns {
+		if c.PrimaryKey {
+			key = append(key, c.Name)
+		}
+	}
+	switch {
+	case len(key) == 1:
+		return key[0]
+	case len(key) == 0 && !strings.Contains(strings.ToUpper(t.Sql), "WITHOUT ROWID"):
+		return "rowid"
+	}
+	return ""
+}
+
+// restStatement is the SQL statement of a REST request on table and its positional
+// parameters.
+type restStatement struct {
+	table string
+	sql   string
+	args  []*structpb.Value
+	hints map[string]sqlrpcv1.ColumnAffinity
+}
+
+// column returns a reference to a column of the table. It is qualified so that SQLite
+// rejects an unknown name instead of reading it as a string literal.
+func (st *restStatement) column(name string) string {
+	return quoteIdentifier(st.table) + "." + quoteIdentifier(name)
+}
+
+// bind adds a parameter and returns its placeholder.
+func (st *restStatement) bind(v *structpb.Value) string {
+	st.args = append(st.args, v)
+	return "?"
+}
+
+// bindJSON adds a value decoded from a JSON body. Integers are sent as text with an
+// INTEGER hint, which keeps them exact beyond 2^53; objects and arrays are stored as
+// JSON text.
+func (st *restStatement) bindJSON(v any) (string, error) {
+	switch v := v.(type) {
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			if st.hints == nil {
+				st.hints = map[string]sqlrpcv1.ColumnAffinity{}
+			}
+			st.hints[strconv.Itoa(len(st.args))] = sqlrpcv1.ColumnAffinity_COLUMN_AFFINITY_INTEGER
+			return st.bind(structpb.NewStringValue(v.String())), nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return "", err
+		}
+		return st.bind(structpb.NewNumberValue(f)), nil
+	case map[string]any, []any:
+		text, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return st.bind(structpb.NewStringValue(string(text))), nil
+	}
+	value, err := structpb.NewValue(v)
+	if err != nil {
+		return "", err
+	}
+	return st.bind(value), nil
+}
+
+// parameters returns the parameters as bound by convertParameters.
+func (st *restStatement) parameters() *sqlrpcv1.Parameters {
+	if len(st.args) == 0 {
+		return nil
+	}
+	return &sqlrpcv1.Parameters{Positional: st.args, Hints: st.hints}
+}
+
+// buildRESTStatement turns a REST request into one SQL statement. keyColumn and key
+// are set for the key routes.
+func buildRESTStatement(r *http.Request, table, keyColumn, key string) (*restStatement, error) {
+	query := r.URL.Query()
+	st := &restStatement{table: table}
+
+	returning, err := restSelect(st, query.Get("select"))
+	if err != nil {
+		return nil, err
+	}
+	var body any
+	if r.Method == http.MethodPost || r.Method == http.MethodPatch {
+		if body, err = restBody(r); err != nil {
+			return nil, err
+		}
+	}
+
+	var sql strings.Builder
+	switch r.Method {
+	case http.MethodGet:
+		sql.WriteString("SELECT " + returning + " FROM " + quoteIdentifier(table))
+	case http.MethodPost:
+		if err := restInsert(&sql, st, table, body); err != nil {
+			return nil, err
+		}
+	case http.MethodPatch:
+		row, ok := body.(map[string]any)
+		if !ok || len(row) == 0 {
+			return nil, errors.New("PATCH needs a JSON object of the columns to set")
+		}
+		sql.WriteString("UPDATE " + quoteIdentifier(table) + " SET ")
+		for i, column := range slices.Sorted(maps.Keys(row)) {
+			if i > 0 {
+				sql.WriteString(", ")
+			}
+			placeholder, err := st.bindJSON(row[column])
+			if err != nil {
+				return nil, fmt.Errorf("column %s: %w", column, err)
+			}
+			sql.WriteString(quoteIdentifier(column) + " = " + placeholder)
+		}
+	case http.MethodDelete:
+		sql.WriteString("DELETE FROM " + quoteIdentifier(table))
+	}
+
+	if r.Method != http.MethodPost {
+		where, err := restWhere(st, query, keyColumn, key)
+		if err != nil {
+			return nil, err
+		}
+		if where == "" && r.Method != http.MethodGet {
+			return nil, fmt.Errorf("%s needs at least one filter", r.Method)
+		}
+		if where != "" {
+			sql.WriteString(" WHERE " + where)
+		}
+	}
+
+	if r.Method == http.MethodGet {
+		order, err := restOrder(st, query.Get("order"))
+		if err != nil {
+			return nil, err
+		}
+		if order != "" {
+			sql.WriteString(" ORDER BY " + order)
+		}
+		limit, offset := query.Get("limit"), query.Get("offset")
+		if limit != "" || offset != "" {
+			n, err := restCount("limit", limit, -1)
+			if err != nil {
+				return nil, err
+			}
+			sql.WriteString(" LIMIT " + strconv.FormatInt(n, 10))
+			if n, err = restCount("offset", offset, 0); err != nil {
+				return nil, err
+			}
+			sql.WriteString(" OFFSET " + strconv.FormatInt(n, 10))
+		}
+	} else {
+		sql.WriteString(" RETURNING " + returning)
+	}
+
+	st.sql = sql.String()
+	return st, nil
+}
+
+// restInsert writes the INSERT of a POST body: an object or an array of objects with
+// the same columns.
+func restInsert(sql *strings.Builder, st *restStatement, table string, body any) error {
+	var rows []map[string]any
+	switch b := body.(type) {
+	case map[string]any:
+		rows = []map[string]any{b}
+	case []any:
+		for _, v := range b {
+			row, ok := v.(map[string]any)
+			if !ok {
+				return errors.New("POST needs a JSON object or an array of objects")
+			}
+			rows = append(rows, row)
+		}
+	}
+	if len(rows) == 0 {
+		return errors.New("POST needs a JSON object or an array of objects")
+	}
+
+	sql.WriteString("INSERT INTO " + quoteIdentifier(table))
+	columns := slices.Sorted(maps.Keys(rows[0]))
+	if len(columns) == 0 {
+		if len(rows) > 1 {
+			return errors.New("rows inserted together must set columns")
+		}
+		sql.WriteString(" DEFAULT VALUES")
+		return nil
+	}
+	quoted := make([]string, len(columns))
+	for i, c := range columns {
+		quoted[i] = quoteIdentifier(c)
+	}
+	sql.WriteString(" (" + strings.Join(quoted, ", ") + ") VALUES ")
+	for i, row := range rows {
+		if len(row) != len(columns) {
+			return fmt.Errorf("row %d does not set the columns of the first row", i)
+		}
+		if i > 0 {
+			sql.WriteString(", ")
+		}
+		sql.WriteByte('(')
+		for j, c := range columns {
+			v, ok := row[c]
+			if !ok {
+				return fmt.Errorf("row %d does not set the columns of the first row", i)
+			}
+			placeholder, err := st.bindJSON(v)
+			if err != nil {
+				return fmt.Errorf("row %d, column %s: %w", i, c, err)
+			}
+			if j > 0 {
+				sql.WriteString(", ")
+			}
+			sql.WriteString(placeholder)
+		}
+		sql.WriteByte(')')
+	}
+	return nil
+}
+
+// restBody decodes the JSON body of a request, keeping numbers exact.
+func restBody(r *http.Request) (any, error) {
+	dec := json.NewDecoder(io.LimitReader(r.Body, maxRESTBody))
+	dec.UseNumber()
+	var body any
+	if err := dec.Decode(&body); err != nil {
+		return nil, fmt.Errorf("invalid JSON body: %w", err)
+	}
+	return body, nil
+}
+
+// restSelect returns the result columns of the select parameter.
+func restSelect(st *restStatement, raw string) (string, error) {
+	if raw == "" || raw == "*" {
+		return "*", nil
+	}
+	var columns []string
+	for c := range strings.SplitSeq(raw, ",") {
+		c = strings.TrimSpace(c)
+		if c == "" {
+			return "", fmt.Errorf("invalid select %q", raw)
+		}
+		columns = append(columns, st.column(c))
+	}
+	return strings.Join(columns, ", "), nil
+}
+
+// restWhere returns the condition of the filters in query, and of the key for the key
+// routes. Filters are combined in the order of their columns so that the statement is
+// the same for the same request.
+func restWhere(st *restStatement, query url.Values, keyColumn, key string) (string, error) {
+	var conditions []string
+	if keyColumn != "" {
+		conditions = append(conditions, st.column(keyColumn)+" = "+st.bind(structpb.NewStringValue(key)))
+	}
+	for _, column := range slices.Sorted(maps.Keys(query)) {
+		if restReserved[column] {
+			continue
+		}
+		for _, filter := range query[column] {
+			condition, err := restCondition(st, st.column(column), filter)
+			if err != nil {
+				return "", fmt.Errorf("filter on %s: %w", column, err)
+			}
+			conditions = append(conditions, condition)
+		}
+	}
+	return strings.Join(conditions, " AND "), nil
+}
+
+// restCondition translates one operator.value filter on column.
+func restCondition(st *restStatement, column, filter string) (string, error) {
+	negate := false
+	if rest, ok := strings.CutPrefix(filter, "not."); ok {
+		negate, filter = true, rest
+	}
+	op, value, ok := strings.Cut(filter, ".")
+	if !ok {
+		return "", fmt.Errorf("%q is not operator.value", filter)
+	}
+
+	var condition string
+	switch op {
+	case "like":
+		condition = column + " GLOB " + st.bind(structpb.NewStringValue(value))
+	case "ilike":
+		condition = column + " LIKE " + st.bind(structpb.NewStringValue(strings.ReplaceAll(value, "*", "%")))
+	case "is":
+		switch strings.ToLower(value) {
+		case "null":
+			condition = column + " IS NULL"
+		case "true":
+			condition = column + " IS TRUE"
+		case "false":
+			condition = column + " IS FALSE"
+		default:
+			return "", fmt.Errorf("is takes null, true or false, not %q", value)
+		}
+	case "in":
+		list, ok := strings.CutPrefix(value, "(")
+		if list, ok = strings.CutSuffix(list, ")"); !ok || list == "" {
+			return "", fmt.Errorf("in takes a list like (a,b), not %q", value)
+		}
+		var placeholders []string
+		for item := range strings.SplitSeq(list, ",") {
+			placeholders = append(placeholders, st.bind(structpb.NewStringValue(item)))
+		}
+		condition = column + " IN (" + strings.Join(placeholders, ", ") + ")"
+	default:
+		sqlOp, ok := restOperators[op]
+		if !ok {
+			return "", fmt.Errorf("unknown operator %q", op)
+		}
+		condition = column + " " + sqlOp + " " + st.bind(structpb.NewStringValue(value))
+	}
+	if negate {
+		condition = "NOT (" + condition + ")"
+	}
+	return condition, nil
+}
+
+// restOrder returns the ORDER BY terms of the order parameter.
+func restOrder(st *restStatement, raw string) (string, error) {
+	if raw == "" {
+		return "", nil
+	}
+	var terms []string
+	for term := range strings.SplitSeq(raw, ",") {
+		parts := strings.Split(strings.TrimSpace(term), ".")
+		var modifiers []string
+		for len(parts) > 1 {
+			modifier, ok := restOrderModifiers[strings.ToLower(parts[len(parts)-1])]
+			if !ok {
+				break
+			}
+			modifiers = append([]string{modifier}, modifiers...)
+			parts = parts[:len(parts)-1]
+		}
+		name := strings.Join(parts, ".")
+		if name == "" {
+			return "", fmt.Errorf("invalid order %q", raw)
+		}
+		terms = append(terms, strings.Join(append([]string{st.column(name)}, modifiers...), " "))
+	}
+	return strings.Join(terms, ", "), nil
+}
+
+// restCount parses a limit or offset, which defaults to def.
+func restCount(name, raw string, def int64) (int64, error) {
+	if raw == "" {
+		return def, nil
+	}
+	n, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("%s must be a non-negative integer, not %q", name, raw)
+	}
+	return n, nil
+}
+
+// writeRESTRows answers with the rows of result as JSON objects keyed by column name:
+// the first one alone if single is set, or else an array of all of them.
+func writeRESTRows(w http.ResponseWriter, status int, result *sqlrpcv1.QueryResult, single bool) {
+	keys := make([][]byte, len(result.Columns))
+	for i, c := range result.Columns {
+		keys[i], _ = json.Marshal(c)
+	}
+	var buf bytes.Buffer
+	if !single {
+		buf.WriteByte('[')
+	}
+	for n, row := range result.Rows {
+		if n > 0 {
+			if single {
+				break
+			}
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('{')
+		for i, v := range row.Values {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			value, err := json.Marshal(v.AsInterface())
+			if err != nil {
+				writeRESTError(w, connect.NewError(connect.CodeInternal, err))
+				return
+			}
+			buf.Write(keys[i])
+			buf.WriteByte(':')
+			buf.Write(value)
+		}
+		buf.WriteByte('}')
+	}
+	if !single {
+		buf.WriteByte(']')
+	}
+	buf.WriteByte('\n')
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(buf.Bytes())
+}
+
+// writeRESTError answers a REST request with the status the Connect protocol uses for
+// err's code and a JSON body in the shape of a Connect error.
+func writeRESTError(w http.ResponseWriter, err error) {
+	code := connect.CodeOf(err)
+	message := err.Error()
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		message = connectErr.Message()
+	}
+	body, _ := json.Marshal(map[string]string{"code": code.String(), "message": message})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus(code))
+	w.Write(append(body, '\n'))
+}
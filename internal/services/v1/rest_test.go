@@ -0,0 +1,328 @@
+package servicesv1
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"sqlite-server/internal/auth"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+)
+
+// setupRESTServer serves the REST gateway of a setupTestServer database.
+func setupRESTServer(t *testing.T, authorizer HTTPAuthorizer) (string, *DbServer) {
+	_, server := setupTestServer(t)
+	ts := httptest.NewServer(server.RESTHandler(authorizer))
+	t.Cleanup(ts.Close)
+	return ts.URL, server
+}
+
+// rest sends a REST request and decodes the JSON response into out, if set.
+func rest(t *testing.T, method, url, body string, header http.Header, out any) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.NoError(t, err)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	if out != nil && resp.StatusCode < 300 {
+		require.NoError(t, json.Unmarshal(data, out), string(data))
+	}
+	return resp
+}
+
+func TestREST(t *testing.T) {
+	base, _ := setupRESTServer(t, NewNoAuthInterceptor())
+	users := base + "/rest/test/users"
+
+	t.Run("lists rows", func(t *testing.T) {
+		var rows []map[string]any
+		resp := rest(t, http.MethodGet, users+"?select=id,name&order=id.desc", "", nil, &rows)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+		assert.NotEmpty(t, resp.Header.Get(headerRequestID))
+		assert.Equal(t, []map[string]any{{"id": 2.0, "name": "Bob"}, {"id": 1.0, "name": "Alice"}}, rows)
+	})
+
+	t.Run("filters rows", func(t *testing.T) {
+		for query, want := range map[string][]string{
+			"age=gt.35":                 {"Bob"},
+			"age=gte.30&age=lt.40":      {"Alice"},
+			"name=neq.Alice":            {"Bob"},
+			"name=like.A*":              {"Alice"},
+			"name=like.a*":              nil,
+			"name=ilike.a*":             {"Alice"},
+			"avatar=is.null":            {"Bob"},
+			"avatar=not.is.null":        {"Alice"},
+			"id=in.(1,2)&order=id.asc":  {"Alice", "Bob"},
+			"order=name.desc&limit=1":   {"Bob"},
+			"order=id&limit=1&offset=1": {"Bob"},
+			"offset=1&order=id":         {"Bob"},
+		} {
+			var rows []map[string]any
+			resp := rest(t, http.MethodGet, users+"?select=name&"+query, "", nil, &rows)
+			require.Equal(t, http.StatusOK, resp.StatusCode, query)
+			var names []string
+			for _, row := range rows {
+				names = append(names, row["name"].(string))
+			}
+			assert.Equal(t, want, names, query)
+		}
+	})
+
+	t.Run("gets a row by key", func(t *testing.T) {
+		var row map[string]any
+		resp := rest(t, http.MethodGet, users+"/1?select=name,age", "", nil, &row)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, map[string]any{"name": "Alice", "age": 30.0}, row)
+
+		resp = rest(t, http.MethodGet, users+"/9", "", nil, nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("inserts rows", func(t *testing.T) {
+		var rows []map[string]any
+		resp := rest(t, http.MethodPost, users+"?select=id,name", `{"name": "Carol", "age": 25}`, nil, &rows)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, []map[string]any{{"id": 3.0, "name": "Carol"}}, rows)
+
+		// Integers beyond 2^53 are kept exact, and read back as strings like Query does
+		var batch []map[string]any
+		resp = rest(t, http.MethodPost, users+"?select=name,age", `[{"name": "Dan", "age": 9007199254740993}, {"name": "Eve", "age": null}]`, nil, &batch)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, []map[string]any{{"name": "Dan", "age": "9007199254740993"}, {"name": "Eve", "age": nil}}, batch)
+
+		var exact []map[string]any
+		rest(t, http.MethodGet, users+"?select=name&age=eq.9007199254740993", "", nil, &exact)
+		assert.Equal(t, []map[string]any{{"name": "Dan"}}, exact)
+	})
+
+	t.Run("updates rows", func(t *testing.T) {
+		var rows []map[string]any
+		resp := rest(t, http.MethodPatch, users+"?name=eq.Carol&select=name,age", `{"age": 26}`, nil, &rows)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, []map[string]any{{"name": "Carol", "age": 26.0}}, rows)
+
+		var row map[string]any
+		resp = rest(t, http.MethodPatch, users+"/3?select=age", `{"age": 27}`, nil, &row)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, map[string]any{"age": 27.0}, row)
+	})
+
+	t.Run("deletes rows", func(t *testing.T) {
+		var rows []map[string]any
+		resp := rest(t, http.MethodDelete, users+"?name=in.(Dan,Eve)&select=name", "", nil, &rows)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Len(t, rows, 2)
+
+		resp = rest(t, http.MethodDelete, users+"/3", "", nil, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		resp = rest(t, http.MethodDelete, users+"/3", "", nil, nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			method, path, body string
+			status             int
+		}{
+			"unknown operator":  {http.MethodGet, "/rest/test/users?age=about.30", "", http.StatusBadRequest},
+			"unknown column":    {http.MethodGet, "/rest/test/users?height=eq.1", "", http.StatusBadRequest},
+			"unknown select":    {http.MethodGet, "/rest/test/users?select=height", "", http.StatusBadRequest},
+			"unknown returning": {http.MethodPatch, "/rest/test/users/1?select=height", `{"age": 1}`, http.StatusBadRequest},
+			"bad limit":         {http.MethodGet, "/rest/test/users?limit=-1", "", http.StatusBadRequest},
+			"unfiltered delete": {http.MethodDelete, "/rest/test/users", "", http.StatusBadRequest},
+			"unfiltered update": {http.MethodPatch, "/rest/test/users", `{"age": 1}`, http.StatusBadRequest},
+			"empty update":      {http.MethodPatch, "/rest/test/users/1", `{}`, http.StatusBadRequest},
+			"invalid body":      {http.MethodPost, "/rest/test/users", `{"name":`, http.StatusBadRequest},
+			"mismatched rows":   {http.MethodPost, "/rest/test/users", `[{"name": "a"}, {"age": 1}]`, http.StatusBadRequest},
+			"constraint":        {http.MethodPost, "/rest/test/users", `{"id": 1, "name": "dup"}`, http.StatusConflict},
+			"unknown table":     {http.MethodGet, "/rest/test/missing/1", "", http.StatusNotFound},
+			"unknown database":  {http.MethodGet, "/rest/nope/users", "", http.StatusNotFound},
+			"insert at a key":   {http.MethodPost, "/rest/test/users/1", `{}`, http.StatusMethodNotAllowed},
+		} {
+			t.Run(name, func(t *testing.T) {
+				req, err := http.NewRequest(tc.method, base+tc.path, strings.NewReader(tc.body))
+				require.NoError(t, err)
+				resp, err := http.DefaultClient.Do(req)
+				require.NoError(t, err)
+				defer resp.Body.Close()
+				assert.Equal(t, tc.status, resp.StatusCode)
+				if tc.status != http.StatusMethodNotAllowed {
+					var body struct{ Code, Message string }
+					require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+					assert.NotEmpty(t, body.Message)
+				}
+			})
+		}
+	})
+}
+
+func TestRESTKeys(t *testing.T) {
+	base, server := setupRESTServer(t, NewNoAuthInterceptor())
+	db, err := server.dbManager.GetConnection(context.Background(), "test", ModeRW)
+	require.NoError(t, err)
+	_, err = db.Exec(`
+		CREATE TABLE tags (slug TEXT PRIMARY KEY, label TEXT) WITHOUT ROWID;
+		CREATE TABLE notes (body TEXT);
+		CREATE TABLE pairs (a INTEGER, b INTEGER, PRIMARY KEY (a, b));
+		INSERT INTO tags VALUES ('go', 'Go');
+		INSERT INTO notes VALUES ('first');
+		INSERT INTO pairs VALUES (1, 2);
+	`)
+	require.NoError(t, err)
+
+	var row map[string]any
+	resp := rest(t, http.MethodGet, base+"/rest/test/tags/go", "", nil, &row)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "Go", row["label"])
+
+	// The statement is built again with the key column once the caller is authorized
+	resp = rest(t, http.MethodPatch, base+"/rest/test/tags/go", `{"label": "Golang"}`, nil, &row)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "Golang", row["label"])
+
+	resp = rest(t, http.MethodGet, base+"/rest/test/notes/1", "", nil, &row)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "first", row["body"])
+
+	resp = rest(t, http.MethodGet, base+"/rest/test/pairs/1", "", nil, nil)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestRESTSpec(t *testing.T) {
+	base, _ := setupRESTServer(t, NewNoAuthInterceptor())
+
+	var spec struct {
+		OpenAPI    string                    `json:"openapi"`
+		Servers    []struct{ URL string }    `json:"servers"`
+		Paths      map[string]map[string]any `json:"paths"`
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]map[string]any `json:"properties"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	resp := rest(t, http.MethodGet, base+"/rest/test", "", nil, &spec)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "3.1.0", spec.OpenAPI)
+	assert.Equal(t, "/rest/test", spec.Servers[0].URL)
+	assert.Contains(t, spec.Paths["/users"], "post")
+	assert.Contains(t, spec.Paths["/users/{key}"], "get")
+	assert.Equal(t, "integer", spec.Components.Schemas["users"].Properties["id"]["type"])
+	assert.Equal(t, []any{"string", "null"}, spec.Components.Schemas["users"].Properties["name"]["type"])
+
+	resp = rest(t, http.MethodGet, base+"/rest/nope", "", nil, nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestRESTAuthorization(t *testing.T) {
+	store, err := auth.NewMetaStore(filepath.Join(t.TempDir(), "meta.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { store.Close() })
+	_, err = store.CreateUser(context.Background(), "reader", "pass", sqlrpcv1.Role_ROLE_READ_ONLY)
+	require.NoError(t, err)
+
+	_, err = store.CreateUser(context.Background(), "writer", "pass", sqlrpcv1.Role_ROLE_READ_WRITE)
+	require.NoError(t, err)
+
+	interceptor := NewAuthInterceptor(store)
+	interceptor.SetAuditor(NewAuditor(store, false))
+	base, _ := setupRESTServer(t, interceptor)
+	reader := http.Header{}
+	reader.Set("Authorization", "Basic cmVhZGVyOnBhc3M=") // reader:pass
+	writer := http.Header{}
+	writer.Set("Authorization", "Basic d3JpdGVyOnBhc3M=") // writer:pass
+
+	resp := rest(t, http.MethodGet, base+"/rest/test/users", "", nil, nil)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	// A missing table is not revealed to unauthenticated callers
+	resp = rest(t, http.MethodGet, base+"/rest/test/missing/1", "", nil, nil)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	var rows []map[string]any
+	resp = rest(t, http.MethodGet, base+"/rest/test/users?select=name&order=id", "", reader, &rows)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Len(t, rows, 2)
+
+	resp = rest(t, http.MethodPost, base+"/rest/test/users", `{"name": "Mallory"}`, reader, nil)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	resp = rest(t, http.MethodDelete, base+"/rest/test/users/1", "", reader, nil)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	resp = rest(t, http.MethodPost, base+"/rest/test/users", `{"name": "Carol"}`, writer, nil)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	// Writes are audited as the Exec they run as, rejected ones included
+	events, err := store.ListAuditEvents(context.Background(), auth.AuditFilter{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	assert.Equal(t, "writer", events[0].Actor)
+	assert.Equal(t, "/sqlrpc.v1.DatabaseService/Exec", events[0].RPC)
+	assert.Equal(t, "test", events[0].Database)
+	assert.Equal(t, `INSERT INTO "users" ("name") VALUES (?) RETURNING *`, events[0].SQL)
+	assert.Equal(t, "ok", events[0].Code)
+	assert.Equal(t, "reader", events[2].Actor)
+	assert.Equal(t, "permission_denied", events[2].Code)
+}
+
+func TestRESTOnFollower(t *testing.T) {
+	base, _ := setupRESTServer(t, NewReplicaInterceptor("leader:50173").GuardHTTP(NewNoAuthInterceptor()))
+
+	resp := rest(t, http.MethodGet, base+"/rest/test/users/1", "", nil, nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = rest(t, http.MethodPatch, base+"/rest/test/users/1", `{"age": 31}`, nil, nil)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	// Users and grants are replicated: readers are served under their own role
+	store, err := auth.NewMetaStore(filepath.Join(t.TempDir(), "meta.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { store.Close() })
+	_, err = store.CreateUser(context.Background(), "reader", "pass", sqlrpcv1.Role_ROLE_READ_ONLY)
+	require.NoError(t, err)
+	base, _ = setupRESTServer(t, NewReplicaInterceptor("leader:50173").GuardHTTP(NewAuthInterceptor(store)))
+	reader := http.Header{}
+	reader.Set("Authorization", "Basic cmVhZGVyOnBhc3M=") // reader:pass
+	resp = rest(t, http.MethodGet, base+"/rest/test/users/1", "", reader, nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestRESTRateLimit(t *testing.T) {
+	store, err := auth.NewMetaStore(filepath.Join(t.TempDir(), "meta.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { store.Close() })
+	_, err = store.CreateUser(context.Background(), "reader", "pass", sqlrpcv1.Role_ROLE_READ_ONLY)
+	require.NoError(t, err)
+	_, err = store.SetRateLimit(context.Background(), "reader", "", auth.RateLimit{Database: "test", RowsPerMinute: 3})
+	require.NoError(t, err)
+
+	base, _ := setupRESTServer(t, NewRateLimiter(store).GuardHTTP(NewAuthInterceptor(store)))
+	reader := http.Header{}
+	reader.Set("Authorization", "Basic cmVhZGVyOnBhc3M=") // reader:pass
+
+	var rows []map[string]any
+	resp := rest(t, http.MethodGet, base+"/rest/test/users", "", reader, &rows)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Len(t, rows, 2)
+	assert.Equal(t, "1", resp.Header.Get(headerQuotaRows))
+
+	// The request crossing the quota is served, the next one is not
+	resp = rest(t, http.MethodGet, base+"/rest/test/users", "", reader, nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = rest(t, http.MethodGet, base+"/rest/test/users/1", "", reader, nil)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+}
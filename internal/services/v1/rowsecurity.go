@@ -0,0 +1,353 @@
+package servicesv1
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"sqlite-server/internal/auth"
+	"sqlite-server/internal/logging"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+	"sqlite-server/internal/sqlclass"
+	"sqlite-server/internal/sqldrivers"
+)
+
+// ReloadRowPolicies reads the row-level security policies from the store. It is
+// called at startup and after every policy change. Without a store there are none.
+func (s *DbServer) ReloadRowPolicies(ctx context.Context) error {
+	if s.store == nil {
+		return nil
+	}
+	list, err := s.store.ListRowPolicies(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	// A row is visible when any policy of its table allows it
+	policies := make(map[string]map[string]string)
+	for _, p := range list {
+		tables := policies[p.Database]
+		if tables == nil {
+			tables = make(map[string]string)
+			policies[p.Database] = tables
+		}
+		table := p.Table
+		for name := range tables {
+			if strings.EqualFold(name, table) {
+				table = name
+				break
+			}
+		}
+		if pred, ok := tables[table]; ok {
+			tables[table] = pred + " OR (" + p.Using + ")"
+		} else {
+			tables[table] = "(" + p.Using + ")"
+		}
+	}
+
+	// Attachments reach the tables around the statement rewrite, so the authorizer
+	// guards them there
+	s.policyMu.Lock()
+	defer s.policyMu.Unlock()
+	for database := range s.rowPolicies {
+		if _, ok := policies[database]; !ok {
+			sqldrivers.SetRowPolicyTables(database, nil)
+		}
+	}
+	for database, tables := range policies {
+		sqldrivers.SetRowPolicyTables(database, slices.Collect(maps.Keys(tables)))
+	}
+	s.rowPolicies = policies
+	return nil
+}
+
+// policiesFor returns the row policies that apply to the caller on database, or nil
+// when there are none or the caller is an admin.
+func (s *DbServer) policiesFor(ctx context.Context, database string) map[string]string {
+	if claims, ok := auth.FromContext(ctx); ok && claims.RoleFor(database) >= sqlrpcv1.Role_ROLE_ADMIN {
+		return nil
+	}
+	s.policyMu.RLock()
+	defer s.policyMu.RUnlock()
+	return s.rowPolicies[database]
+}
+
+// policyQuerier rewrites every statement it runs with sqlclass.ApplyPolicies.
+type policyQuerier struct {
+	q        querier
+	policies map[string]string
+}
+
+func (p policyQuerier) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	query, err := p.rewrite(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return p.q.ExecContext(ctx, query, args...)
+}
+
+func (p policyQuerier) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	query, err := p.rewrite(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return p.q.QueryContext(ctx, query, args...)
+}
+
+// rewrite applies the policies to query. SQLite expands views and runs triggers
+// without the rewrite, so statements that reach a policy table through one of them
+// are refused first.
+func (p policyQuerier) rewrite(ctx context.Context, query string) (string, error) {
+	objects, err := schemaObjects(ctx, p.q)
+	if err != nil {
+		return "", err
+	}
+	if err := sqlclass.CheckBypasses(query, sqlclass.PolicyBypasses(objects, p.policies)); err != nil {
+		return "", err
+	}
+	return sqlclass.ApplyPolicies(query, p.policies)
+}
+
+// schemaObjects lists the views and triggers of main and temp. Temporary ones stay on
+// the pooled connection that created them.
+func schemaObjects(ctx context.Context, q querier) ([]sqlclass.SchemaObject, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT type, name, tbl_name, sql FROM main.sqlite_schema WHERE type IN ('view', 'trigger')
+		UNION ALL
+		SELECT type, name, tbl_name, sql FROM temp.sqlite_schema WHERE type IN ('view', 'trigger')`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var objects []sqlclass.SchemaObject
+	for rows.Next() {
+		var obj sqlclass.SchemaObject
+		if err := rows.Scan(&obj.Type, &obj.Name, &obj.Table, &obj.SQL); err != nil {
+			return nil, err
+		}
+		objects = append(objects, obj)
+	}
+	return objects, rows.Err()
+}
+
+// restrict applies the caller's row policies on database to the statements run
+// through q.
+func (s *DbServer) restrict(ctx context.Context, database string, q querier) querier {
+	policies := s.policiesFor(ctx, database)
+	if len(policies) == 0 {
+		return q
+	}
+	return policyQuerier{q: q, policies: policies}
+}
+
+// beginRestricted starts a transaction on db bound to the caller's identity for
+// statements prepared directly on it, which restrict cannot wrap. It returns query
+// with the caller's row policies on database applied.
+func (s *DbServer) beginRestricted(ctx context.Context, db *sql.DB, database, query string) (*sql.Tx, string, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := s.bindSession(ctx, tx, database); err != nil {
+		_ = tx.Rollback()
+		return nil, "", err
+	}
+	if policies := s.policiesFor(ctx, database); len(policies) > 0 {
+		if query, err = (policyQuerier{q: tx, policies: policies}).rewrite(ctx, query); err != nil {
+			_ = tx.Rollback()
+			return nil, "", err
+		}
+	}
+	return tx, query, nil
+}
+
+// requireUnrestricted refuses callers under row or column policies on database.
+// Backups and snapshots copy the database file, which no policy can filter.
+func (s *DbServer) requireUnrestricted(ctx context.Context, database string) error {
+	if len(s.policiesFor(ctx, database)) > 0 || sqldrivers.ColumnRulesApply(database, s.sessionFor(ctx, database)) {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("row or column policies on database %s do not allow copying its file", database))
+	}
+	return nil
+}
+
+// sessionFor is the identity current_user() and current_role() report to the caller
+// on database, with the caller's roles on the databases attached to it. It is NULL
+// for calls without claims.
+func (s *DbServer) sessionFor(ctx context.Context, database string) sqldrivers.Session {
+	claims, ok := auth.FromContext(ctx)
+	if !ok {
+		return sqldrivers.Session{}
+	}
+	session := sqldrivers.Session{User: claims.Username, Role: sessionRole(claims.RoleFor(database))}
+	if config, ok := s.dbManager.GetConfig(database); ok && len(config.Attachments) > 0 {
+		session.Roles = make(map[string]string, len(config.Attachments))
+		for _, att := range config.Attachments {
+			session.Roles[att.TargetDatabaseName] = sessionRole(claims.RoleFor(att.TargetDatabaseName))
+		}
+	}
+	return session
+}
+
+// sessionRole is role as current_role() reports it, e.g. "read_write".
+func sessionRole(role sqlrpcv1.Role) string {
+	return strings.ToLower(strings.TrimPrefix(role.String(), "ROLE_"))
+}
+
+// bindSession sets the caller's identity on the connection behind q, which must be a
+// *sql.Conn or *sql.Tx. Connections keep the identity of their last user, so every
+// statement path binds one before running anything.
+func (s *DbServer) bindSession(ctx context.Context, q sqldrivers.Execer, database string) error {
+	return sqldrivers.SetSession(ctx, q, s.sessionFor(ctx, database))
+}
+
+// statelessQuerier pins a connection of db for one stateless call, binds the caller's
+// identity to it and applies the caller's row policies. release returns the
+// connection to the pool.
+func (s *DbServer) statelessQuerier(ctx context.Context, database string, db *sql.DB) (q querier, release func(), err error) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.bindSession(ctx, conn, database); err != nil {
+		// Never hand a connection with an unknown identity to the next caller
+		_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+		_ = conn.Close()
+		dbLog.WarnContext(ctx, "Failed to bind session", logging.Err(err))
+		return nil, nil, err
+	}
+	return s.restrict(ctx, database, conn), func() { _ = conn.Close() }, nil
+}
+
+// CreateRowPolicy stores a row-level security policy after checking that its
+// predicate compiles against the table
+func (s *AdminServer) CreateRowPolicy(ctx context.Context, req *connect.Request[sqlrpcv1.CreateRowPolicyRequest]) (*connect.Response[sqlrpcv1.CreateRowPolicyResponse], error) {
+	if s.authDisabled {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("row policies are disabled when running in no-auth mode"))
+	}
+	if err := protovalidate.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	msg := req.Msg
+	if err := sqlclass.CheckPredicate(msg.Using); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	db, err := s.dbServer.dbManager.GetConnection(ctx, msg.Database, ModeRO)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	// Compile the statement the policy turns a read of the table into
+	check, err := sqlclass.ApplyPolicies(fmt.Sprintf("SELECT 1 FROM %s LIMIT 0", quoteIdentifier(msg.Table)), map[string]string{msg.Table: msg.Using})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	q, release, err := s.dbServer.statelessQuerier(ctx, msg.Database, db)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	defer release()
+	rows, err := q.QueryContext(ctx, check)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid row policy: %w", err))
+	}
+	_ = rows.Close()
+
+	policy, err := s.store.CreateRowPolicy(ctx, auth.RowPolicy{
+		Database: msg.Database,
+		Table:    msg.Table,
+		Name:     msg.Name,
+		Using:    msg.Using,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "already exists") {
+			return nil, connect.NewError(connect.CodeAlreadyExists, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err := s.dbServer.ReloadRowPolicies(ctx); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	by := "an administrator"
+	if claims, ok := auth.FromContext(ctx); ok {
+		by = claims.Username
+	}
+	rpcLog.InfoContext(ctx, "Row policy created", logging.KeyDatabase, msg.Database, "table", msg.Table, "policy", msg.Name, "by", by)
+
+	return connect.NewResponse(&sqlrpcv1.CreateRowPolicyResponse{
+		Policy: toProtoRowPolicy(policy),
+	}), nil
+}
+
+// DeleteRowPolicy removes a row policy
+func (s *AdminServer) DeleteRowPolicy(ctx context.Context, req *connect.Request[sqlrpcv1.DeleteRowPolicyRequest]) (*connect.Response[sqlrpcv1.DeleteRowPolicyResponse], error) {
+	if s.authDisabled {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("row policies are disabled when running in no-auth mode"))
+	}
+	if err := protovalidate.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.store.DeleteRowPolicy(ctx, req.Msg.PolicyId); err != nil {
+		return nil, grantError(err)
+	}
+	if err := s.dbServer.ReloadRowPolicies(ctx); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	by := "an administrator"
+	if claims, ok := auth.FromContext(ctx); ok {
+		by = claims.Username
+	}
+	rpcLog.InfoContext(ctx, "Row policy deleted", "policy_id", req.Msg.PolicyId, "by", by)
+
+	return connect.NewResponse(&sqlrpcv1.DeleteRowPolicyResponse{
+		Success: true,
+	}), nil
+}
+
+// ListRowPolicies returns the row policies, optionally of one database
+func (s *AdminServer) ListRowPolicies(ctx context.Context, req *connect.Request[sqlrpcv1.ListRowPoliciesRequest]) (*connect.Response[sqlrpcv1.ListRowPoliciesResponse], error) {
+	if s.authDisabled {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("row policies are disabled when running in no-auth mode"))
+	}
+
+	policies, err := s.store.ListRowPolicies(ctx, req.Msg.Database)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	pbPolicies := make([]*sqlrpcv1.RowPolicy, len(policies))
+	for i := range policies {
+		pbPolicies[i] = toProtoRowPolicy(&policies[i])
+	}
+
+	return connect.NewResponse(&sqlrpcv1.ListRowPoliciesResponse{
+		Policies: pbPolicies,
+	}), nil
+}
+
+func toProtoRowPolicy(p *auth.RowPolicy) *sqlrpcv1.RowPolicy {
+	return &sqlrpcv1.RowPolicy{
+		Id:        p.ID,
+		Database:  p.Database,
+		Table:     p.Table,
+		Name:      p.Name,
+		Using:     p.Using,
+		CreatedAt: timestamppb.New(p.CreatedAt),
+	}
+}
+
+// quoteIdentifier quotes a table name for SQLite.
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
@@ -0,0 +1,208 @@
+package servicesv1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestRowPolicies(t *testing.T) {
+	admin, _, server := setupAdminTestServer(t)
+	require.NoError(t, server.MountDatabase(&sqlrpcv1.DatabaseConfig{Name: "shop", DbPath: filepath.Join(t.TempDir(), "shop.db")}))
+
+	adminCtx := adminContext(sqlrpcv1.Role_ROLE_ADMIN)
+	alice := userContext(2, "alice", sqlrpcv1.Role_ROLE_READ_WRITE)
+	bob := userContext(3, "bob", sqlrpcv1.Role_ROLE_READ_WRITE)
+
+	exec := func(ctx context.Context, sql string) (*sqlrpcv1.ExecResponse, error) {
+		resp, err := server.Exec(ctx, connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "shop", Sql: sql}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg, nil
+	}
+	query := func(ctx context.Context, sql string) []*structpb.ListValue {
+		t.Helper()
+		resp, err := server.Query(ctx, connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "shop", Sql: sql}))
+		require.NoError(t, err)
+		return resp.Msg.Rows
+	}
+	count := func(ctx context.Context) float64 {
+		t.Helper()
+		return query(ctx, "SELECT count(*) FROM orders")[0].Values[0].GetNumberValue()
+	}
+
+	_, err := exec(adminCtx, `CREATE TABLE orders (id INTEGER PRIMARY KEY, owner TEXT, total INTEGER);
+		INSERT INTO orders (owner, total) VALUES ('alice', 10), ('alice', 20), ('bob', 30)`)
+	require.NoError(t, err)
+
+	t.Run("create validates the predicate", func(t *testing.T) {
+		for _, using := range []string{"nope = current_user()", "owner = ?", "1); DROP TABLE orders; (1"} {
+			_, err := admin.CreateRowPolicy(adminCtx, connect.NewRequest(&sqlrpcv1.CreateRowPolicyRequest{
+				Database: "shop", Table: "orders", Name: "bad", Using: using,
+			}))
+			assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), using)
+		}
+		_, err := admin.CreateRowPolicy(adminCtx, connect.NewRequest(&sqlrpcv1.CreateRowPolicyRequest{
+			Database: "shop", Table: "missing", Name: "bad", Using: "1",
+		}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	created, err := admin.CreateRowPolicy(adminCtx, connect.NewRequest(&sqlrpcv1.CreateRowPolicyRequest{
+		Database: "shop", Table: "orders", Name: "own", Using: "owner = current_user()",
+	}))
+	require.NoError(t, err)
+	policyID := created.Msg.Policy.Id
+
+	listed, err := admin.ListRowPolicies(adminCtx, connect.NewRequest(&sqlrpcv1.ListRowPoliciesRequest{Database: "shop"}))
+	require.NoError(t, err)
+	require.Len(t, listed.Msg.Policies, 1)
+	assert.Equal(t, "owner = current_user()", listed.Msg.Policies[0].Using)
+
+	t.Run("reads", func(t *testing.T) {
+		assert.Equal(t, float64(2), count(alice))
+		assert.Equal(t, float64(1), count(bob))
+		assert.Equal(t, float64(3), count(adminCtx), "admins bypass row policies")
+
+		who := query(alice, "SELECT current_user(), current_role()")[0].Values
+		assert.Equal(t, "alice", who[0].GetStringValue())
+		assert.Equal(t, "read_write", who[1].GetStringValue())
+
+		rows := query(bob, "SELECT total FROM orders WHERE id IN (SELECT id FROM orders)")
+		require.Len(t, rows, 1)
+		assert.Equal(t, float64(30), rows[0].Values[0].GetNumberValue())
+	})
+
+	t.Run("bypasses are rejected", func(t *testing.T) {
+		for _, sql := range []string{
+			"SELECT * FROM main.orders",
+			"CREATE VIEW every_order AS SELECT * FROM orders",
+			"INSERT OR REPLACE INTO orders (id, owner, total) VALUES (3, 'alice', 0)",
+		} {
+			_, err := server.Query(alice, connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "shop", Sql: sql}))
+			assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err), sql)
+		}
+	})
+
+	t.Run("views and triggers", func(t *testing.T) {
+		_, err := exec(adminCtx, `CREATE VIEW all_orders AS SELECT * FROM orders;
+			CREATE VIEW big_orders AS SELECT * FROM all_orders WHERE total > 0;
+			CREATE TABLE audit (note TEXT);
+			CREATE TRIGGER audit_copy AFTER INSERT ON audit BEGIN
+				INSERT INTO audit (note) SELECT owner FROM orders WHERE new.note = 'copy';
+			END;
+			CREATE VIEW answer AS SELECT 42 AS value;
+			CREATE TABLE notes (note TEXT);
+			CREATE TRIGGER notes_audit AFTER INSERT ON notes BEGIN INSERT INTO audit (note) VALUES (new.note); END`)
+		require.NoError(t, err)
+		defer func() {
+			_, err := exec(adminCtx, `DROP VIEW all_orders; DROP VIEW big_orders; DROP VIEW answer;
+				DROP TABLE audit; DROP TABLE notes`)
+			require.NoError(t, err)
+		}()
+
+		// SQLite expands views and runs triggers without the rewrite
+		for _, sql := range []string{
+			"SELECT * FROM all_orders",
+			"SELECT * FROM big_orders",
+			"SELECT o.id FROM orders o JOIN all_orders a ON a.id = o.id",
+			"INSERT INTO audit (note) VALUES ('copy')",
+			"INSERT INTO notes (note) VALUES ('copy')",
+			"CREATE TEMP VIEW mine AS SELECT * FROM big_orders",
+		} {
+			_, err := server.Query(alice, connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "shop", Sql: sql}))
+			assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err), "%s: %v", sql, err)
+		}
+
+		// Views and triggers that do not reach a policy table keep working
+		assert.Equal(t, float64(42), query(alice, "SELECT value FROM answer")[0].Values[0].GetNumberValue())
+		assert.Empty(t, query(alice, "SELECT note FROM audit"))
+		assert.Len(t, query(adminCtx, "SELECT * FROM all_orders"), 3, "admins bypass row policies")
+	})
+
+	t.Run("attachments", func(t *testing.T) {
+		frontPath := filepath.Join(t.TempDir(), "front.db")
+		require.NoError(t, server.MountDatabase(&sqlrpcv1.DatabaseConfig{Name: "front", DbPath: frontPath}))
+		require.NoError(t, server.store.UpsertDatabaseConfig(adminCtx, "front", frontPath, false, "{}"))
+		_, err := server.AttachDatabase(alice, connect.NewRequest(&sqlrpcv1.AttachDatabaseRequest{
+			ParentDatabase: "front",
+			Attachment:     &sqlrpcv1.Attachment{TargetDatabaseName: "shop", Alias: "s"},
+		}))
+		require.NoError(t, err)
+
+		for _, sql := range []string{
+			"SELECT * FROM s.orders",
+			"SELECT count(*) FROM orders", // Resolves to s.orders
+			"DELETE FROM s.orders",
+		} {
+			_, err := server.Query(alice, connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "front", Sql: sql}))
+			assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err), "%s: %v", sql, err)
+		}
+
+		resp, err := server.Query(adminCtx, connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "front", Sql: "SELECT count(*) FROM s.orders"}))
+		require.NoError(t, err)
+		assert.Equal(t, float64(3), resp.Msg.Rows[0].Values[0].GetNumberValue(), "admins of the attached database are not restricted")
+	})
+
+	t.Run("file copies are refused", func(t *testing.T) {
+		_, err := server.BackupDatabase(alice, connect.NewRequest(&sqlrpcv1.BackupDatabaseRequest{Database: "shop"}))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		_, err = server.store.CreateUser(adminCtx, "carol", "pass", sqlrpcv1.Role_ROLE_READ_WRITE)
+		require.NoError(t, err)
+		mux := http.NewServeMux()
+		mux.Handle("GET /export/{db}", server.ExportHandler(NewAuthInterceptor(server.store)))
+		ts := httptest.NewServer(mux)
+		defer ts.Close()
+		req, err := http.NewRequest(http.MethodGet, ts.URL+"/export/shop?format=sqlite", nil)
+		require.NoError(t, err)
+		req.SetBasicAuth("carol", "pass")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("writes", func(t *testing.T) {
+		res, err := exec(alice, "UPDATE orders SET total = total + 1")
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), res.Dml.RowsAffected)
+
+		res, err = exec(alice, "DELETE FROM orders WHERE total > 0")
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), res.Dml.RowsAffected)
+		assert.Equal(t, float64(1), count(adminCtx), "bob's order was not deleted")
+	})
+
+	t.Run("transactions", func(t *testing.T) {
+		begin, err := server.BeginTransaction(alice, connect.NewRequest(&sqlrpcv1.BeginTransactionRequest{Database: "shop"}))
+		require.NoError(t, err)
+		txID := begin.Msg.TransactionId
+		defer func() {
+			_, _ = server.RollbackTransaction(alice, connect.NewRequest(&sqlrpcv1.TransactionControlRequest{TransactionId: txID}))
+		}()
+
+		resp, err := server.TransactionQuery(alice, connect.NewRequest(&sqlrpcv1.TransactionQueryRequest{
+			TransactionId: txID, Sql: "SELECT owner FROM orders",
+		}))
+		require.NoError(t, err)
+		assert.Empty(t, resp.Msg.Rows, "alice's orders were deleted and bob's are hidden")
+	})
+
+	_, err = admin.DeleteRowPolicy(adminCtx, connect.NewRequest(&sqlrpcv1.DeleteRowPolicyRequest{PolicyId: policyID}))
+	require.NoError(t, err)
+	assert.Equal(t, float64(1), count(alice), "without policies every row is visible")
+
+	_, err = admin.DeleteRowPolicy(adminCtx, connect.NewRequest(&sqlrpcv1.DeleteRowPolicyRequest{PolicyId: policyID}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
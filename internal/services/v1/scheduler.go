@@ -0,0 +1,335 @@
+package servicesv1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"sqlite-server/internal/auth"
+	"sqlite-server/internal/cron"
+	"sqlite-server/internal/logging"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+)
+
+// schedulerTickInterval is how often the scheduler looks for due jobs. Cron expressions
+// have minute resolution, so jobs start within one interval of their scheduled time.
+var schedulerTickInterval = 10 * time.Second
+
+// defaultMaintenanceRunsLimit is the page size of ListMaintenanceRuns without a limit.
+const defaultMaintenanceRunsLimit = 100
+
+// maintenanceTasks lists the scheduled jobs in the order they run when several are due
+// on the same database at once.
+var maintenanceTasks = []sqlrpcv1.MaintenanceTask{
+	sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_CHECKPOINT,
+	sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_INTEGRITY_CHECK,
+	sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_VACUUM,
+	sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_BACKUP,
+}
+
+type maintenanceJobKey struct {
+	database string
+	task     sqlrpcv1.MaintenanceTask
+}
+
+// maintenanceJob is the parsed schedule of one task on one database.
+type maintenanceJob struct {
+	expr     string
+	schedule *cron.Schedule // nil if expr is invalid
+	next     time.Time
+}
+
+// Scheduler runs the maintenance jobs declared in the MaintenanceSchedule of each
+// mounted database and records their results in the MetaStore.
+//
+// DESIGN NOTES:
+//   - Schedules are read from the DbManager on every tick, so mounts, unmounts and
+//     UpdateDatabase calls take effect without restarting the scheduler.
+//   - Jobs of one database run one after another. If they are still running when the
+//     next batch is due, that batch is skipped rather than queued.
+//   - Jobs call the same handlers as the DatabaseService RPCs (Checkpoint, Vacuum,
+//     IntegrityCheck, BackupDatabase).
+type Scheduler struct {
+	dbServer *DbServer
+	store    *auth.MetaStore
+
+	mu      sync.Mutex
+	jobs    map[maintenanceJobKey]*maintenanceJob
+	running map[string]bool // Databases with jobs in flight
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	done   chan struct{}
+}
+
+// NewScheduler creates a maintenance scheduler. Call Start to begin running jobs.
+func NewScheduler(dbServer *DbServer, store *auth.MetaStore) *Scheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Scheduler{
+		dbServer: dbServer,
+		store:    store,
+		jobs:     make(map[maintenanceJobKey]*maintenanceJob),
+		running:  make(map[string]bool),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+// Start runs scheduled jobs in the background until Stop is called.
+func (s *Scheduler) Start() {
+	s.done = make(chan struct{})
+	go func() {
+		defer close(s.done)
+		ticker := time.NewTicker(schedulerTickInterval)
+		defer ticker.Stop()
+
+		s.tick(time.Now())
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case now := <-ticker.C:
+				s.tick(now)
+			}
+		}
+	}()
+}
+
+// Stop cancels running jobs and waits for them to return.
+func (s *Scheduler) Stop() {
+	s.cancel()
+	if s.done != nil {
+		<-s.done
+	}
+	s.wg.Wait()
+}
+
+// tick refreshes the schedules of every mounted database and starts the jobs due at now.
+// A job seen for the first time (or whose expression changed) is only planned, so it
+// first fires at its next activation after now.
+func (s *Scheduler) tick(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	type batch struct {
+		config *sqlrpcv1.MaintenanceSchedule
+		tasks  []sqlrpcv1.MaintenanceTask
+	}
+	due := make(map[string]*batch)
+	seen := make(map[maintenanceJobKey]bool)
+
+	for _, dbName := range s.dbServer.dbManager.List() {
+		config, ok := s.dbServer.dbManager.GetConfig(dbName)
+		if !ok || config.Maintenance == nil {
+			continue
+		}
+		for _, task := range maintenanceTasks {
+			expr := maintenanceExpr(config.Maintenance, task)
+			if expr == "" {
+				continue
+			}
+			key := maintenanceJobKey{database: dbName, task: task}
+			seen[key] = true
+
+			job := s.jobs[key]
+			if job == nil || job.expr != expr {
+				job = &maintenanceJob{expr: expr}
+				schedule, err := cron.Parse(expr)
+				if err != nil {
+					maintenanceLog.Warn("Ignoring invalid schedule", "task", maintenanceTaskLabel(task), logging.KeyDatabase, dbName, logging.Err(err))
+				} else {
+					job.schedule = schedule
+					job.next = schedule.Next(now)
+				}
+				s.jobs[key] = job
+				continue
+			}
+			if job.schedule == nil || job.next.IsZero() || now.Before(job.next) {
+				continue
+			}
+
+			job.next = job.schedule.Next(now)
+			if due[dbName] == nil {
+				due[dbName] = &batch{config: config.Maintenance}
+			}
+			due[dbName].tasks = append(due[dbName].tasks, task)
+		}
+	}
+
+	for key := range s.jobs {
+		if !seen[key] {
+			delete(s.jobs, key)
+		}
+	}
+
+	for dbName, b := range due {
+		if s.running[dbName] {
+			maintenanceLog.Warn("Skipping scheduled jobs: previous jobs are still running", logging.KeyDatabase, dbName)
+			continue
+		}
+		s.running[dbName] = true
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			for _, task := range b.tasks {
+				s.run(dbName, task, b.config)
+			}
+			s.mu.Lock()
+			delete(s.running, dbName)
+			s.mu.Unlock()
+		}()
+	}
+}
+
+// run executes one job and records its result.
+func (s *Scheduler) run(dbName string, task sqlrpcv1.MaintenanceTask, config *sqlrpcv1.MaintenanceSchedule) {
+	startedAt := time.Now()
+	message, err := s.execute(s.ctx, dbName, task, config)
+	run := &auth.MaintenanceRun{
+		Database:  dbName,
+		Task:      task,
+		StartedAt: startedAt,
+		Duration:  time.Since(startedAt),
+		Success:   err == nil,
+		Message:   message,
+	}
+	if err != nil {
+		run.Message = err.Error()
+		maintenanceLog.Error("Scheduled job failed", "task", maintenanceTaskLabel(task), logging.KeyDatabase, dbName, logging.Err(err))
+	}
+
+	// Record even if the scheduler is stopping, so cancelled jobs show up as failed
+	if err := s.store.RecordMaintenanceRun(context.Background(), run); err != nil {
+		maintenanceLog.Error("Failed to record maintenance run", "task", maintenanceTaskLabel(task), logging.KeyDatabase, dbName, logging.Err(err))
+	}
+}
+
+// execute runs a job through the matching DatabaseService handler.
+func (s *Scheduler) execute(ctx context.Context, dbName string, task sqlrpcv1.MaintenanceTask, config *sqlrpcv1.MaintenanceSchedule) (string, error) {
+	switch task {
+	case sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_CHECKPOINT:
+		res, err := s.dbServer.Checkpoint(ctx, connect.NewRequest(&sqlrpcv1.CheckpointRequest{
+			Database: dbName,
+			Mode:     sqlrpcv1.CheckpointMode_CHECKPOINT_MODE_TRUNCATE,
+		}))
+		if err != nil {
+			return "", err
+		}
+		if res.Msg.LogCheckpoints < 0 {
+			return "Database is not in WAL mode", nil
+		}
+		if res.Msg.BusyCheckpoints != 0 {
+			return "", fmt.Errorf("checkpoint blocked by active connections (%d of %d WAL pages checkpointed)",
+				res.Msg.CheckpointedPages, res.Msg.LogCheckpoints)
+		}
+		return fmt.Sprintf("Checkpointed %d WAL pages", res.Msg.CheckpointedPages), nil
+
+	case sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_VACUUM:
+		res, err := s.dbServer.Vacuum(ctx, connect.NewRequest(&sqlrpcv1.VacuumRequest{Database: dbName}))
+		if err != nil {
+			return "", err
+		}
+		return res.Msg.Message, nil
+
+	case sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_INTEGRITY_CHECK:
+		res, err := s.dbServer.IntegrityCheck(ctx, connect.NewRequest(&sqlrpcv1.IntegrityCheckRequest{Database: dbName}))
+		if err != nil {
+			return "", err
+		}
+		if !res.Msg.Success {
+			return "", fmt.Errorf("%s: %s", res.Msg.Message, strings.Join(res.Msg.Errors, "; "))
+		}
+		return res.Msg.Message, nil
+
+	case sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_BACKUP:
+		res, err := s.dbServer.BackupDatabase(ctx, connect.NewRequest(&sqlrpcv1.BackupDatabaseRequest{
+			Database:    dbName,
+			Compression: config.BackupCompression,
+		}))
+		if err != nil {
+			return "", err
+		}
+		message := fmt.Sprintf("Stored backup %s (%d bytes)", res.Msg.Backup.BackupId, res.Msg.Backup.SizeBytes)
+		if config.BackupRetention > 0 {
+			removed, err := s.dbServer.pruneBackups(dbName, int(config.BackupRetention))
+			if err != nil {
+				return "", fmt.Errorf("backup %s stored but pruning failed: %w", res.Msg.Backup.BackupId, err)
+			}
+			if removed > 0 {
+				message += fmt.Sprintf(", removed %d old backups", removed)
+			}
+		}
+		return message, nil
+	}
+	return "", fmt.Errorf("unknown maintenance task %v", task)
+}
+
+// maintenanceExpr returns the cron expression configured for a task.
+func maintenanceExpr(m *sqlrpcv1.MaintenanceSchedule, task sqlrpcv1.MaintenanceTask) string {
+	switch task {
+	case sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_CHECKPOINT:
+		return m.GetCheckpoint()
+	case sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_VACUUM:
+		return m.GetVacuum()
+	case sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_INTEGRITY_CHECK:
+		return m.GetIntegrityCheck()
+	case sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_BACKUP:
+		return m.GetBackup()
+	}
+	return ""
+}
+
+// maintenanceTaskLabel returns a task's name for logs (e.g. "integrity check").
+func maintenanceTaskLabel(task sqlrpcv1.MaintenanceTask) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimPrefix(task.String(), "MAINTENANCE_TASK_")), "_", " ")
+}
+
+// validateMaintenanceSchedule checks that every configured cron expression parses.
+func validateMaintenanceSchedule(m *sqlrpcv1.MaintenanceSchedule) error {
+	for _, task := range maintenanceTasks {
+		if expr := maintenanceExpr(m, task); expr != "" {
+			if _, err := cron.Parse(expr); err != nil {
+				return fmt.Errorf("invalid %s schedule: %w", maintenanceTaskLabel(task), err)
+			}
+		}
+	}
+	return nil
+}
+
+// ListMaintenanceRuns returns the recorded results of scheduled maintenance jobs.
+func (s *AdminServer) ListMaintenanceRuns(ctx context.Context, req *connect.Request[sqlrpcv1.ListMaintenanceRunsRequest]) (*connect.Response[sqlrpcv1.ListMaintenanceRunsResponse], error) {
+	if err := protovalidate.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	limit := int(req.Msg.Limit)
+	if limit == 0 {
+		limit = defaultMaintenanceRunsLimit
+	}
+	runs, err := s.store.ListMaintenanceRuns(ctx, req.Msg.Database, req.Msg.Task, limit)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res := &sqlrpcv1.ListMaintenanceRunsResponse{}
+	for _, run := range runs {
+		res.Runs = append(res.Runs, &sqlrpcv1.MaintenanceRun{
+			Id:        run.ID,
+			Database:  run.Database,
+			Task:      run.Task,
+			StartedAt: timestamppb.New(run.StartedAt),
+			Duration:  durationpb.New(run.Duration),
+			Success:   run.Success,
+			Message:   run.Message,
+		})
+	}
+	return connect.NewResponse(res), nil
+}
@@ -0,0 +1,138 @@
+package servicesv1
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+)
+
+func TestScheduler(t *testing.T) {
+	admin, store, dbServer := setupAdminTestServer(t)
+	dbServer.SetBackupDir(t.TempDir())
+	ctx := context.Background()
+
+	config := &sqlrpcv1.DatabaseConfig{Name: "app", DbPath: filepath.Join(t.TempDir(), "app.db")}
+	settings, err := protojson.Marshal(config)
+	require.NoError(t, err)
+	require.NoError(t, store.UpsertDatabaseConfig(ctx, "app", config.DbPath, true, string(settings)))
+	require.NoError(t, dbServer.dbManager.Mount(config))
+
+	db, err := dbServer.dbManager.GetConnection(ctx, "app", ModeRW)
+	require.NoError(t, err)
+	_, err = db.Exec("CREATE TABLE items (id INTEGER PRIMARY KEY); INSERT INTO items VALUES (1)")
+	require.NoError(t, err)
+
+	t.Run("UpdateDatabase rejects invalid cron expressions", func(t *testing.T) {
+		_, err := admin.UpdateDatabase(ctx, connect.NewRequest(&sqlrpcv1.UpdateDatabaseRequest{
+			Name:   "app",
+			Config: &sqlrpcv1.UpdateDatabaseConfig{Maintenance: &sqlrpcv1.MaintenanceSchedule{Vacuum: "every day"}},
+		}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	_, err = admin.UpdateDatabase(ctx, connect.NewRequest(&sqlrpcv1.UpdateDatabaseRequest{
+		Name: "app",
+		Config: &sqlrpcv1.UpdateDatabaseConfig{Maintenance: &sqlrpcv1.MaintenanceSchedule{
+			Checkpoint:        "* * * * *",
+			IntegrityCheck:    "*/2 * * * *",
+			Backup:            "* * * * *",
+			BackupCompression: sqlrpcv1.BackupCompression_BACKUP_COMPRESSION_GZIP,
+			BackupRetention:   2,
+		}},
+	}))
+	require.NoError(t, err)
+
+	scheduler := NewScheduler(dbServer, store)
+	t.Cleanup(scheduler.Stop)
+	base := time.Date(2025, 1, 15, 10, 0, 30, 0, time.Local)
+
+	listRuns := func(t *testing.T, req *sqlrpcv1.ListMaintenanceRunsRequest) []*sqlrpcv1.MaintenanceRun {
+		res, err := admin.ListMaintenanceRuns(ctx, connect.NewRequest(req))
+		require.NoError(t, err)
+		return res.Msg.Runs
+	}
+
+	t.Run("jobs run when due", func(t *testing.T) {
+		scheduler.tick(base)
+		scheduler.tick(base.Add(20 * time.Second))
+		scheduler.wg.Wait()
+		assert.Empty(t, listRuns(t, &sqlrpcv1.ListMaintenanceRunsRequest{}), "nothing is due before the next minute")
+
+		scheduler.tick(base.Add(40 * time.Second))
+		scheduler.wg.Wait()
+
+		runs := listRuns(t, &sqlrpcv1.ListMaintenanceRunsRequest{Database: "app"})
+		require.Len(t, runs, 2, "10:01 matches the checkpoint and backup schedules only")
+		assert.Equal(t, sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_BACKUP, runs[0].Task)
+		assert.Equal(t, sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_CHECKPOINT, runs[1].Task)
+		for _, run := range runs {
+			assert.True(t, run.Success, run.Message)
+			assert.Equal(t, "app", run.Database)
+			assert.NotNil(t, run.StartedAt)
+			assert.NotNil(t, run.Duration)
+		}
+		assert.Contains(t, runs[0].Message, "Stored backup")
+	})
+
+	t.Run("backups beyond retention are pruned", func(t *testing.T) {
+		for i := 2; i <= 3; i++ {
+			scheduler.tick(base.Add(time.Duration(i) * time.Minute))
+			scheduler.wg.Wait()
+		}
+
+		backups, err := dbServer.ListBackups(ctx, connect.NewRequest(&sqlrpcv1.ListBackupsRequest{Database: "app"}))
+		require.NoError(t, err)
+		assert.Len(t, backups.Msg.Backups, 2)
+		assert.Equal(t, sqlrpcv1.BackupCompression_BACKUP_COMPRESSION_GZIP, backups.Msg.Backups[0].Compression)
+
+		checks := listRuns(t, &sqlrpcv1.ListMaintenanceRunsRequest{
+			Database: "app",
+			Task:     sqlrpcv1.MaintenanceTask_MAINTENANCE_TASK_INTEGRITY_CHECK,
+		})
+		require.Len(t, checks, 1, "*/2 fires at 10:02 only")
+		assert.True(t, checks[0].Success)
+
+		assert.Len(t, listRuns(t, &sqlrpcv1.ListMaintenanceRunsRequest{Limit: 2}), 2)
+	})
+
+	t.Run("overlapping runs are skipped", func(t *testing.T) {
+		before := len(listRuns(t, &sqlrpcv1.ListMaintenanceRunsRequest{}))
+
+		scheduler.mu.Lock()
+		scheduler.running["app"] = true
+		scheduler.mu.Unlock()
+		scheduler.tick(base.Add(4 * time.Minute))
+		scheduler.wg.Wait()
+		scheduler.mu.Lock()
+		delete(scheduler.running, "app")
+		scheduler.mu.Unlock()
+
+		assert.Len(t, listRuns(t, &sqlrpcv1.ListMaintenanceRunsRequest{}), before)
+	})
+
+	t.Run("unmounted databases are dropped", func(t *testing.T) {
+		require.NoError(t, dbServer.dbManager.Unmount("app"))
+		scheduler.tick(base.Add(5 * time.Minute))
+		scheduler.wg.Wait()
+
+		scheduler.mu.Lock()
+		defer scheduler.mu.Unlock()
+		assert.Empty(t, scheduler.jobs)
+	})
+}
+
+func TestValidateMaintenanceSchedule(t *testing.T) {
+	assert.NoError(t, validateMaintenanceSchedule(nil))
+	assert.NoError(t, validateMaintenanceSchedule(&sqlrpcv1.MaintenanceSchedule{Vacuum: "@weekly", Backup: "0 3 * * *"}))
+
+	err := validateMaintenanceSchedule(&sqlrpcv1.MaintenanceSchedule{IntegrityCheck: "0 25 * * *"})
+	assert.ErrorContains(t, err, "invalid integrity check schedule")
+}
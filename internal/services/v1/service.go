@@ -42,11 +42,11 @@
 package servicesv1
 
 import (
-	"database/sql"
-	"log"
+	"context"
 	"time"
 
 	"sqlite-server/internal/auth"
+	"sqlite-server/internal/logging"
 	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
 	"sqlite-server/internal/pubsub"
 )
@@ -54,6 +54,18 @@ import (
 // headerRequestID is the standard HTTP header key used for distributed tracing.
 const headerRequestID = "X-Request-Id"
 
+// Subsystem loggers. Request handlers log with their context so the request
+// attributes set by LoggingInterceptor are attached.
+var (
+	rpcLog         = logging.For(logging.RPC)
+	txLog          = logging.For(logging.Tx)
+	dbLog          = logging.For(logging.DB)
+	authLog        = logging.For(logging.Auth)
+	pubsubLog      = logging.For(logging.PubSub)
+	replicationLog = logging.For(logging.Replication)
+	maintenanceLog = logging.For(logging.Maintenance)
+)
+
 // ===================================================================================
 // Transaction Registry & State Management
 // ===================================================================================
@@ -70,7 +82,7 @@ const reaperInterval = 5 * time.Second
 //  1. Iterates over the provided configuration list.
 //  2. Opens each SQLite database file (handling WAL mode, pragmas, etc.).
 //  3. Pings the database to verify file permissions and validity.
-//  4. "Fail-Fast": If ANY database fails to load, the application crashes intentionally (`log.Fatalf`).
+//  4. "Fail-Fast": If ANY database fails to load, the application crashes intentionally.
 //     It is better to crash at startup than to run in a partially broken state.
 //     It is better to crash at startup than to run in a partially broken state.
 //
@@ -79,13 +91,22 @@ func NewDbServer(configs []*sqlrpcv1.DatabaseConfig, store *auth.MetaStore, brok
 	// Initialize DbManager
 	mgr := NewDbManager(configs)
 
-	log.Printf("sqlite-server ready. Managed by DbManager.")
+	dbLog.Info("sqlite-server ready. Managed by DbManager.")
 	db := &DbServer{
 		dbManager:  mgr,
 		store:      store,
 		txRegistry: make(map[string]*TxSession),
+		cursors:    make(map[string]*cursorSession),
 		shutdownCh: make(chan struct{}),
 		broker:     broker,
+		backupDir:  "backups",
+	}
+
+	if err := db.ReloadRowPolicies(context.Background()); err != nil {
+		dbLog.Error("Failed to load row policies", logging.Err(err))
+	}
+	if err := db.ReloadColumnPolicies(context.Background()); err != nil {
+		dbLog.Error("Failed to load column policies", logging.Err(err))
 	}
 
 	// Start the Background Reaper to clean up zombie transactions.
@@ -139,6 +160,7 @@ func (s *DbServer) runReaper() {
 			return
 		case <-ticker.C:
 			s.cleanupExpiredTransactions()
+			s.cleanupExpiredCursors()
 		}
 	}
 }
@@ -154,22 +176,24 @@ func (s *DbServer) runReaper() {
  *    b. Deletes the entry from the map to free Go memory.
  */
 func (s *DbServer) cleanupExpiredTransactions() {
-	var toRollback []*sql.Tx
+	var toRollback []*TxSession
 
 	s.txMu.Lock()
 	now := time.Now()
 	for id, session := range s.txRegistry {
 		if now.After(session.Expiry) {
-			log.Printf("[Reaper] Rolling back expired transaction %s (DB: %s)", id, session.DBName)
-			toRollback = append(toRollback, session.Tx)
+			txLog.Warn("Rolling back expired transaction", logging.KeyTxID, id, logging.KeyDatabase, session.DBName)
+			s.reaped.Add(1)
+			toRollback = append(toRollback, session)
 			delete(s.txRegistry, id)
 		}
 	}
 	s.txMu.Unlock()
 
 	// Perform rollbacks outside of the lock to prevent deadlocks
-	for _, tx := range toRollback {
-		_ = tx.Rollback()
+	for _, session := range toRollback {
+		_ = session.Tx.Rollback()
+		endTxSpan(session.span, txOutcomeReaped, nil)
 	}
 }
 
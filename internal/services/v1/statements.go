@@ -0,0 +1,197 @@
+package servicesv1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"sqlite-server/internal/auth"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+)
+
+// activeStatement is a stateless statement in flight, registered so an admin can
+// interrupt it by the request ID of its call.
+type activeStatement struct {
+	requestID string
+	database  string
+	username  string
+	sql       string
+	started   time.Time
+	cancel    context.CancelCauseFunc
+
+	// rows counts the rows a streaming query has sent so far.
+	rows atomic.Int64
+}
+
+type statementKey struct{}
+
+// streamedRows returns the row counter of the statement ctx runs, for stream
+// writers to report progress to ListActiveOperations. It is nil outside one.
+func streamedRows(ctx context.Context) *atomic.Int64 {
+	if stmt, ok := ctx.Value(statementKey{}).(*activeStatement); ok {
+		return &stmt.rows
+	}
+	return nil
+}
+
+// SetRoleExecutionLimits caps how long statements of each role may run. Roles
+// without an entry are only limited by their database.
+func (s *DbServer) SetRoleExecutionLimits(limits map[sqlrpcv1.Role]time.Duration) {
+	s.roleLimits = limits
+}
+
+// executionLimit returns how long a statement on database may run: the smallest of
+// the database's and the caller's role limits, lowered further if the request asks
+// for less. Zero means no limit.
+func (s *DbServer) executionLimit(ctx context.Context, database string, requestedMs int32) time.Duration {
+	var limit time.Duration
+	lower := func(d time.Duration) {
+		if d > 0 && (limit == 0 || d < limit) {
+			limit = d
+		}
+	}
+	if config, ok := s.dbManager.GetConfig(database); ok {
+		lower(time.Duration(config.MaxExecutionTimeMs) * time.Millisecond)
+	}
+	if claims, ok := auth.FromContext(ctx); ok {
+		lower(s.roleLimits[claims.RoleFor(database)])
+	}
+	lower(time.Duration(requestedMs) * time.Millisecond)
+	return limit
+}
+
+// startStatement derives the context a stateless statement runs under: it expires
+// after the execution limit and can be cancelled through CancelQuery. The driver
+// interrupts the running statement (sqlite3_interrupt) when the context ends. The
+// returned function must be called once the statement is done.
+func (s *DbServer) startStatement(ctx context.Context, reqID, database, sql string, requestedMs int32) (context.Context, func()) {
+	ctx, stop := s.limitStatement(ctx, database, requestedMs)
+	ctx, done := s.registerStatement(ctx, reqID, database, sql)
+	return ctx, func() {
+		done()
+		stop()
+	}
+}
+
+// limitStatement derives a context that expires after the execution limit of a
+// statement on database.
+func (s *DbServer) limitStatement(ctx context.Context, database string, requestedMs int32) (context.Context, context.CancelFunc) {
+	limit := s.executionLimit(ctx, database, requestedMs)
+	if limit == 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeoutCause(ctx, limit,
+		fmt.Errorf("%w: statement exceeded the max execution time of %s", context.DeadlineExceeded, limit))
+}
+
+// registerStatement derives a context that CancelQuery can end and lists it with the
+// statements in flight, without a time limit. The returned function must be called
+// once the statement is done.
+func (s *DbServer) registerStatement(ctx context.Context, reqID, database, sql string) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(ctx)
+
+	stmt := &activeStatement{
+		requestID: reqID,
+		database:  database,
+		sql:       sql,
+		started:   time.Now(),
+		cancel:    cancel,
+	}
+	if claims, ok := auth.FromContext(ctx); ok {
+		stmt.username = claims.Username
+	}
+
+	s.stmtMu.Lock()
+	if s.statements == nil {
+		s.statements = make(map[*activeStatement]struct{})
+	}
+	s.statements[stmt] = struct{}{}
+	s.stmtMu.Unlock()
+
+	return context.WithValue(ctx, statementKey{}, stmt), func() {
+		s.stmtMu.Lock()
+		delete(s.statements, stmt)
+		s.stmtMu.Unlock()
+		cancel(nil)
+	}
+}
+
+// cancelStatement interrupts the statement ctx runs, as CancelQuery does.
+func cancelStatement(ctx context.Context, cause error) {
+	if stmt, ok := ctx.Value(statementKey{}).(*activeStatement); ok {
+		stmt.cancel(cause)
+	}
+}
+
+// statementError replaces the bare context error returned for an interrupted
+// statement with the reason it was interrupted.
+func statementError(ctx context.Context, err error) error {
+	if err == nil || ctx.Err() == nil {
+		return err
+	}
+	if cause := context.Cause(ctx); cause != nil {
+		return cause
+	}
+	return err
+}
+
+// CancelStatements interrupts the statements started by the request with the given
+// ID and returns how many were running. by names who asked, for the error the
+// interrupted call gets.
+func (s *DbServer) CancelStatements(reqID, by string) int {
+	s.stmtMu.Lock()
+	defer s.stmtMu.Unlock()
+
+	cause := fmt.Errorf("%w: statement cancelled by %s", context.Canceled, by)
+	n := 0
+	for stmt := range s.statements {
+		if stmt.requestID == reqID {
+			stmt.cancel(cause)
+			n++
+		}
+	}
+	return n
+}
+
+// ParseRoleLimits parses --role-max-execution-time, a comma separated list of
+// role=duration pairs such as "read_only=5s,read_write=1m".
+func ParseRoleLimits(spec string) (map[sqlrpcv1.Role]time.Duration, error) {
+	if strings.TrimSpace(spec) == "" {
+		return nil, nil
+	}
+	limits := make(map[sqlrpcv1.Role]time.Duration)
+	for pair := range strings.SplitSeq(spec, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		role := sqlrpcv1.Role(sqlrpcv1.Role_value["ROLE_"+strings.ToUpper(strings.TrimSpace(name))])
+		limit, err := time.ParseDuration(strings.TrimSpace(value))
+		if !ok || role == sqlrpcv1.Role_ROLE_UNSPECIFIED || err != nil || limit <= 0 {
+			return nil, fmt.Errorf("invalid role limit %q, want role=duration (e.g. read_only=5s)", pair)
+		}
+		limits[role] = limit
+	}
+	return limits, nil
+}
+
+// CancelQuery interrupts the statements of the call with the given X-Request-Id.
+func (s *AdminServer) CancelQuery(ctx context.Context, req *connect.Request[sqlrpcv1.CancelQueryRequest]) (*connect.Response[sqlrpcv1.CancelQueryResponse], error) {
+	if err := protovalidate.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	by := "an administrator"
+	if claims, ok := auth.FromContext(ctx); ok {
+		by = claims.Username
+	}
+	n := s.dbServer.CancelStatements(req.Msg.RequestId, by)
+	if n == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no running statement with request id %s", req.Msg.RequestId))
+	}
+	rpcLog.InfoContext(ctx, "Cancelled statements", "cancelled_request_id", req.Msg.RequestId, "count", n)
+
+	return connect.NewResponse(&sqlrpcv1.CancelQueryResponse{Cancelled: int32(n)}), nil
+}
@@ -0,0 +1,121 @@
+package servicesv1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"sqlite-server/internal/auth"
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// endlessSQL runs until it is interrupted.
+const endlessSQL = "WITH RECURSIVE c(x) AS (SELECT 1 UNION ALL SELECT x + 1 FROM c) SELECT count(*) FROM c"
+
+func TestParseRoleLimits(t *testing.T) {
+	limits, err := ParseRoleLimits(" read_only=5s, read_write=1m ")
+	require.NoError(t, err)
+	assert.Equal(t, map[sqlrpcv1.Role]time.Duration{
+		sqlrpcv1.Role_ROLE_READ_ONLY:  5 * time.Second,
+		sqlrpcv1.Role_ROLE_READ_WRITE: time.Minute,
+	}, limits)
+
+	limits, err = ParseRoleLimits("")
+	require.NoError(t, err)
+	assert.Nil(t, limits)
+
+	for _, bad := range []string{"read_only", "root=5s", "read_only=soon", "read_only=-1s", "read_only=0s"} {
+		_, err := ParseRoleLimits(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestDbServer_ExecutionLimit(t *testing.T) {
+	_, server := setupTestServer(t)
+	config, ok := server.dbManager.GetConfig("test")
+	require.True(t, ok)
+	config.MaxExecutionTimeMs = 2000
+	require.NoError(t, server.UpdateDatabase(config))
+	server.SetRoleExecutionLimits(map[sqlrpcv1.Role]time.Duration{sqlrpcv1.Role_ROLE_READ_ONLY: time.Second})
+
+	reader := auth.NewContext(context.Background(), &auth.UserClaims{Username: "r", Role: sqlrpcv1.Role_ROLE_READ_ONLY})
+	writer := auth.NewContext(context.Background(), &auth.UserClaims{Username: "w", Role: sqlrpcv1.Role_ROLE_READ_WRITE})
+
+	assert.Equal(t, 2*time.Second, server.executionLimit(writer, "test", 0), "database cap")
+	assert.Equal(t, time.Second, server.executionLimit(reader, "test", 0), "role cap is lower")
+	assert.Equal(t, 500*time.Millisecond, server.executionLimit(writer, "test", 500), "requests may ask for less")
+	assert.Equal(t, 2*time.Second, server.executionLimit(writer, "test", 60000), "but not for more")
+	assert.Equal(t, time.Duration(0), server.executionLimit(writer, "other", 0), "no limit")
+	assert.Equal(t, time.Second, server.executionLimit(reader, "other", 0))
+}
+
+func TestQuery_ExecutionTimeout(t *testing.T) {
+	client, _ := setupTestServer(t)
+	ctx := context.Background()
+
+	t.Run("unary", func(t *testing.T) {
+		start := time.Now()
+		_, err := client.Query(ctx, connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "test", Sql: endlessSQL, MaxExecutionTimeMs: 100}))
+		assert.Equal(t, connect.CodeDeadlineExceeded, connect.CodeOf(err))
+		assert.ErrorContains(t, err, "max execution time of 100ms")
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		stream, err := client.QueryStream(ctx, connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "test", Sql: endlessSQL, MaxExecutionTimeMs: 100}))
+		require.NoError(t, err)
+		var last *sqlrpcv1.QueryResponse
+		for stream.Receive() {
+			last = stream.Msg()
+		}
+		require.NoError(t, stream.Err())
+		require.NotNil(t, last.GetError())
+		assert.Contains(t, last.GetError().GetMessage(), "max execution time")
+	})
+
+	t.Run("typed exec", func(t *testing.T) {
+		_, err := client.TypedExec(ctx, connect.NewRequest(&sqlrpcv1.TypedQueryRequest{Database: "test", Sql: "CREATE TABLE big AS " + endlessSQL, MaxExecutionTimeMs: 100}))
+		assert.Equal(t, connect.CodeDeadlineExceeded, connect.CodeOf(err))
+	})
+}
+
+func TestAdminServer_CancelQuery(t *testing.T) {
+	client, server := setupTestServer(t)
+	admin := NewAdminServer(nil, server, nil, false, "v0.0.1-test")
+	ctx := adminContext(sqlrpcv1.Role_ROLE_ADMIN)
+
+	done := make(chan error, 1)
+	go func() {
+		req := connect.NewRequest(&sqlrpcv1.QueryRequest{Database: "test", Sql: endlessSQL})
+		req.Header().Set(headerRequestID, "slow-1")
+		_, err := client.Query(context.Background(), req)
+		done <- err
+	}()
+
+	require.Eventually(t, func() bool {
+		server.stmtMu.Lock()
+		defer server.stmtMu.Unlock()
+		return len(server.statements) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	resp, err := admin.CancelQuery(ctx, connect.NewRequest(&sqlrpcv1.CancelQueryRequest{RequestId: "slow-1"}))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), resp.Msg.Cancelled)
+
+	select {
+	case err := <-done:
+		assert.Equal(t, connect.CodeCanceled, connect.CodeOf(err))
+		assert.ErrorContains(t, err, "cancelled by testadmin")
+	case <-time.After(5 * time.Second):
+		t.Fatal("statement was not interrupted")
+	}
+
+	_, err = admin.CancelQuery(ctx, connect.NewRequest(&sqlrpcv1.CancelQueryRequest{RequestId: "slow-1"}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	_, err = admin.CancelQuery(ctx, connect.NewRequest(&sqlrpcv1.CancelQueryRequest{}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
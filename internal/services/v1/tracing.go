@@ -0,0 +1,39 @@
+package servicesv1
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"sqlite-server/internal/tracing"
+)
+
+// Outcomes recorded on sqlite.transaction spans.
+const (
+	txOutcomeCommit   = "commit"
+	txOutcomeRollback = "rollback"
+	txOutcomeAborted  = "aborted" // The stream or batch ended without COMMIT or ROLLBACK
+	txOutcomeExpired  = "expired" // Found past its expiry by a later call
+	txOutcomeReaped   = "reaped"  // Rolled back by the background reaper
+	txOutcomeForced   = "forced"  // Rolled back by an administrator
+)
+
+// startTxSpan starts the span covering a transaction's lifetime. Unlike most spans it
+// outlives the RPC that started it when the transaction is ID-based.
+func startTxSpan(ctx context.Context, database string) trace.Span {
+	_, span := tracing.StartChild(ctx, "sqlite.transaction", trace.WithAttributes(
+		attribute.String("db.system.name", "sqlite"),
+		attribute.String("db.namespace", database),
+	))
+	return span
+}
+
+// endTxSpan records how a transaction ended and ends its span. A nil span is ignored.
+func endTxSpan(span trace.Span, outcome string, err error) {
+	if span == nil {
+		return
+	}
+	span.SetAttributes(attribute.String("sqlite.transaction.outcome", outcome))
+	tracing.EndSpan(span, err)
+}
@@ -0,0 +1,181 @@
+package servicesv1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+	"sqlite-server/internal/protos/sqlrpc/v1/sqlrpcv1connect"
+	"sqlite-server/internal/pubsub"
+	"sqlite-server/internal/tracing"
+)
+
+const testTraceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func setupTracedServer(t *testing.T) (sqlrpcv1connect.DatabaseServiceClient, *DbServer, *tracetest.SpanRecorder) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+
+	dir := t.TempDir()
+	broker, err := pubsub.NewBroker(filepath.Join(dir, "broker.db"), 1)
+	require.NoError(t, err)
+	config := &sqlrpcv1.DatabaseConfig{Name: "test", DbPath: filepath.Join(dir, "trace.db")}
+	server := NewDbServer([]*sqlrpcv1.DatabaseConfig{config}, nil, broker)
+
+	mux := http.NewServeMux()
+	mux.Handle(sqlrpcv1connect.NewDatabaseServiceHandler(server, connect.WithInterceptors(
+		tracing.NewInterceptor(),
+		&testAuthInterceptor{},
+	)))
+	ts := httptest.NewServer(mux)
+	t.Cleanup(func() {
+		ts.Close()
+		server.Stop()
+		broker.Stop()
+	})
+	return sqlrpcv1connect.NewDatabaseServiceClient(ts.Client(), ts.URL), server, recorder
+}
+
+// tracedRequest returns a request continuing the trace in testTraceParent.
+func tracedRequest[T any](msg *T) *connect.Request[T] {
+	req := connect.NewRequest(msg)
+	req.Header().Set("traceparent", testTraceParent)
+	return req
+}
+
+func spansNamed(recorder *tracetest.SpanRecorder, name string) []sdktrace.ReadOnlySpan {
+	var spans []sdktrace.ReadOnlySpan
+	for _, span := range recorder.Ended() {
+		if span.Name() == name {
+			spans = append(spans, span)
+		}
+	}
+	return spans
+}
+
+func spanAttr(span sdktrace.ReadOnlySpan, key string) string {
+	for _, kv := range span.Attributes() {
+		if string(kv.Key) == key {
+			return kv.Value.Emit()
+		}
+	}
+	return ""
+}
+
+func TestTracing(t *testing.T) {
+	client, server, recorder := setupTracedServer(t)
+	ctx := context.Background()
+
+	_, err := client.Exec(ctx, connect.NewRequest(&sqlrpcv1.QueryRequest{
+		Database: "test",
+		Sql:      "CREATE TABLE items (id INTEGER PRIMARY KEY)",
+	}))
+	require.NoError(t, err)
+
+	t.Run("statements nest under the RPC span", func(t *testing.T) {
+		stream, err := client.QueryStream(ctx, tracedRequest(&sqlrpcv1.QueryRequest{Database: "test", Sql: "SELECT id FROM items"}))
+		require.NoError(t, err)
+		for stream.Receive() {
+		}
+		require.NoError(t, stream.Err())
+
+		rpc := spansNamed(recorder, "sqlrpc.v1.DatabaseService/QueryStream")
+		require.Len(t, rpc, 1)
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", rpc[0].SpanContext().TraceID().String())
+
+		acquire := spansNamed(recorder, "sqlite.acquire")
+		require.NotEmpty(t, acquire)
+		assert.Equal(t, rpc[0].SpanContext().SpanID(), acquire[len(acquire)-1].Parent().SpanID())
+		assert.Equal(t, "test", spanAttr(acquire[len(acquire)-1], "db.namespace"))
+
+		statements := spansNamed(recorder, "sqlite.statement")
+		require.Len(t, statements, 2, "the setup Exec is traced as a new root")
+		assert.Equal(t, rpc[0].SpanContext().SpanID(), statements[1].Parent().SpanID())
+		assert.Equal(t, "SELECT id FROM items", spanAttr(statements[1], "db.query.text"))
+	})
+
+	t.Run("transaction spans cover begin to commit", func(t *testing.T) {
+		begin, err := client.BeginTransaction(ctx, tracedRequest(&sqlrpcv1.BeginTransactionRequest{Database: "test"}))
+		require.NoError(t, err)
+		id := begin.Msg.TransactionId
+		_, err = client.TransactionExec(ctx, connect.NewRequest(&sqlrpcv1.TransactionQueryRequest{TransactionId: id, Sql: "INSERT INTO items (id) VALUES (1)"}))
+		require.NoError(t, err)
+		assert.Empty(t, spansNamed(recorder, "sqlite.transaction"), "the span stays open while the transaction does")
+
+		_, err = client.CommitTransaction(ctx, connect.NewRequest(&sqlrpcv1.TransactionControlRequest{TransactionId: id}))
+		require.NoError(t, err)
+
+		spans := spansNamed(recorder, "sqlite.transaction")
+		require.Len(t, spans, 1)
+		assert.Equal(t, txOutcomeCommit, spanAttr(spans[0], "sqlite.transaction.outcome"))
+		assert.Equal(t, spansNamed(recorder, "sqlrpc.v1.DatabaseService/BeginTransaction")[0].SpanContext().SpanID(), spans[0].Parent().SpanID())
+	})
+
+	t.Run("reaper rollbacks end the transaction span", func(t *testing.T) {
+		begin, err := client.BeginTransaction(ctx, tracedRequest(&sqlrpcv1.BeginTransactionRequest{Database: "test"}))
+		require.NoError(t, err)
+
+		server.txMu.Lock()
+		server.txRegistry[begin.Msg.TransactionId].Expiry = time.Now().Add(-time.Second)
+		server.txMu.Unlock()
+		server.cleanupExpiredTransactions()
+
+		spans := spansNamed(recorder, "sqlite.transaction")
+		require.Len(t, spans, 2)
+		assert.Equal(t, txOutcomeReaped, spanAttr(spans[1], "sqlite.transaction.outcome"))
+	})
+
+	t.Run("publish trace continues into subscriber delivery", func(t *testing.T) {
+		subCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		received := make(chan *sqlrpcv1.SubscribeResponse, 1)
+		go func() {
+			// Response headers only arrive with the first message, so the call blocks
+			stream, err := client.Subscribe(subCtx, connect.NewRequest(&sqlrpcv1.SubscribeRequest{Database: "test", Channel: "events"}))
+			if err == nil && stream.Receive() {
+				received <- stream.Msg()
+			}
+			close(received)
+		}()
+
+		// The subscription is live once the broker knows about the listener
+		require.Eventually(t, func() bool { return server.broker.SubscriberCount() == 1 }, time.Second, 5*time.Millisecond)
+
+		_, err := client.Publish(ctx, tracedRequest(&sqlrpcv1.PublishRequest{Database: "test", Channel: "events", Payload: "hello"}))
+		require.NoError(t, err)
+
+		var msg *sqlrpcv1.SubscribeResponse
+		select {
+		case msg = <-received:
+			require.NotNil(t, msg)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for delivery")
+		}
+		assert.Equal(t, "hello", msg.Payload)
+
+		delivered := trace.SpanContextFromContext(tracing.ContextWithTraceParent(ctx, msg.Traceparent))
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", delivered.TraceID().String())
+
+		publish := spansNamed(recorder, "sqlrpc.v1.DatabaseService/Publish")
+		require.Len(t, publish, 1)
+		require.Eventually(t, func() bool { return len(spansNamed(recorder, "pubsub.deliver")) == 1 }, time.Second, 5*time.Millisecond)
+		deliver := spansNamed(recorder, "pubsub.deliver")[0]
+		assert.Equal(t, delivered.SpanID(), deliver.SpanContext().SpanID())
+		assert.Equal(t, publish[0].SpanContext().SpanID(), deliver.Parent().SpanID())
+		assert.Equal(t, trace.SpanKindConsumer, deliver.SpanKind())
+	})
+}
@@ -5,13 +5,14 @@ import (
 	"database/sql"
 	"errors"
 	"io"
-	"log"
 	"sqlite-server/internal/auth"
+	"sqlite-server/internal/logging"
 	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
 	"time"
 
 	"buf.build/go/protovalidate"
 	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // transactionalStreamWriter adapts a `Transaction` (BidiStream).
@@ -124,14 +125,23 @@ func (s *DbServer) Transaction(ctx context.Context, stream *connect.BidiStream[s
 	// 1. Generate Session Trace ID
 	// We favor a server-generated ID here to uniquely identify this specific socket connection.
 	traceID := genRequestID()
-	log.Printf("[%s] Transaction stream connected", traceID)
+	ctx = logging.NewContext(ctx, logging.KeyTxID, traceID)
+	txLog.DebugContext(ctx, "Transaction stream connected")
 
 	// 1. Create a cancelable context for this stream session.
 	// This allows the heartbeat monitor to force-close the stream.
 	streamCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	// The transaction and its statements run under txCtx so ForceRollbackTransaction
+	// can end it: database/sql rolls back a transaction whose context is done.
+	txCtx, kill := context.WithCancelCause(ctx)
+	defer kill(nil)
+	defer s.unregisterStreamTx(traceID)
+
 	var tx *sql.Tx
+	var txq querier // tx under the caller's row policies
+	var txSpan trace.Span
 	var transactionDB string
 
 	// 2. Heartbeat/Idle Timeout Logic
@@ -143,7 +153,7 @@ func (s *DbServer) Transaction(ctx context.Context, stream *connect.BidiStream[s
 	go func() {
 		select {
 		case <-heartbeatTimer.C:
-			log.Printf("[%s] Idle timeout: No activity for %v. Closing stream.", traceID, defaultTxTimeout)
+			txLog.WarnContext(ctx, "Idle timeout, closing transaction stream", "idle", defaultTxTimeout)
 			cancel() // This will cause stream.Receive() to return an error
 		case <-streamCtx.Done():
 			// Stream finished normally or via error
@@ -166,13 +176,30 @@ func (s *DbServer) Transaction(ctx context.Context, stream *connect.BidiStream[s
 	// Ensures the DB lock is released no matter how the function exits.
 	defer func() {
 		if tx != nil {
-			log.Printf("[%s] Stream closing with active transaction, performing emergency rollback.", traceID)
+			txLog.WarnContext(ctx, "Stream closing with active transaction, performing emergency rollback")
 			_ = tx.Rollback()
+			endTxSpan(txSpan, txOutcomeAborted, nil)
 		}
 	}()
 
+	// forceRolledBack ends the stream once an admin has rolled the transaction back.
+	forceRolledBack := func() error {
+		if tx == nil || txCtx.Err() == nil {
+			return nil
+		}
+		cause := context.Cause(txCtx)
+		txLog.WarnContext(ctx, "Transaction was force rolled back", logging.Err(cause))
+		endTxSpan(txSpan, txOutcomeForced, nil)
+		tx = nil
+		return connect.NewError(connect.CodeAborted, cause)
+	}
+
 	// Event Loop
 	for {
+		if err := forceRolledBack(); err != nil {
+			return err
+		}
+
 		// Read next message from client
 		req, err := stream.Receive()
 		if err != nil {
@@ -180,22 +207,29 @@ func (s *DbServer) Transaction(ctx context.Context, stream *connect.BidiStream[s
 				// Client closed connection cleanly.
 				return nil
 			}
-			log.Printf("[%s] Stream receive error: %v", traceID, err)
+			txLog.WarnContext(ctx, "Stream receive error", logging.Err(err))
 			return err
 		}
 
 		// Check if we timed out while waiting for this message
 		if streamCtx.Err() != nil {
-			log.Printf("[%s] Stream context canceled (likely timeout). rejecting message.", traceID)
+			txLog.InfoContext(ctx, "Stream context canceled (likely timeout), rejecting message")
 			return streamCtx.Err()
 		}
 
+		if err := forceRolledBack(); err != nil {
+			return err
+		}
+
 		// HEARTBEAT: Reset the timer on every successful message received
 		resetHeartbeat()
+		if tx != nil {
+			s.touchStreamTx(traceID, transactionRequestSQL(req))
+		}
 
 		// Validate payload
 		if err := protovalidate.Validate(req); err != nil {
-			log.Printf("[%s] Validation failed: %v", traceID, err)
+			txLog.WarnContext(ctx, "Validation failed", logging.Err(err))
 			return connect.NewError(connect.CodeInvalidArgument, err)
 		}
 
@@ -210,7 +244,7 @@ func (s *DbServer) Transaction(ctx context.Context, stream *connect.BidiStream[s
 			transactionDB = cmd.Begin.Database
 
 			mode := ModeRW
-			if auth.IsReadOnly(ctx) {
+			if auth.IsReadOnly(ctx, transactionDB) {
 				mode = ModeRO
 			}
 			dbVal, errConn := s.dbManager.GetConnection(ctx, transactionDB, mode)
@@ -233,11 +267,28 @@ func (s *DbServer) Transaction(ctx context.Context, stream *connect.BidiStream[s
 			}
 
 			var err error
-			tx, err = db.BeginTx(ctx, txOpts) // Use BeginTx instead of Begin
+			tx, err = db.BeginTx(txCtx, txOpts) // Use BeginTx instead of Begin
 			if err != nil {
-				log.Printf("[%s] Failed to begin transaction: %v", traceID, err)
+				txLog.WarnContext(ctx, "Failed to begin transaction", logging.Err(err))
 				return connect.NewError(connect.CodeInternal, err)
 			}
+			if err := s.bindSession(txCtx, tx, transactionDB); err != nil {
+				txLog.WarnContext(ctx, "Failed to begin transaction", logging.Err(err))
+				return connect.NewError(connect.CodeInternal, err)
+			}
+			txq = s.restrict(ctx, transactionDB, tx)
+			txSpan = startTxSpan(ctx, transactionDB)
+
+			session := &streamTx{
+				database: transactionDB,
+				started:  time.Now(),
+				expiry:   time.Now().Add(defaultTxTimeout),
+				kill:     kill,
+			}
+			if claims, ok := auth.FromContext(ctx); ok {
+				session.username = claims.Username
+			}
+			s.registerStreamTx(traceID, session)
 
 			// Send Success + Session ID
 			// The client can now use `traceID` to debug server-side logs.
@@ -261,7 +312,7 @@ func (s *DbServer) Transaction(ctx context.Context, stream *connect.BidiStream[s
 			// Ensure the SQL string doesn't contain manual BEGIN/COMMIT/ROLLBACK.
 			// In an atomic script, users MUST use the structured 'commit' or 'rollback' fields.
 			if err := ValidateStatelessQuery(cmd.Query.Sql); err != nil {
-				log.Printf("[%s] Blocked manual transaction control in script query: %s", traceID, cmd.Query.Sql)
+				txLog.WarnContext(ctx, "Blocked manual transaction control in script query", "sql", cmd.Query.Sql)
 				return err // Return immediate protocol error
 			}
 
@@ -269,9 +320,9 @@ func (s *DbServer) Transaction(ctx context.Context, stream *connect.BidiStream[s
 			// Note: We do NOT return the error here. If a query fails (e.g., syntax error),
 			// we send an application-level error message and keep the stream/transaction alive.
 			// The client decides whether to fix the query or Rollback.
-			result, err := executeQueryAndBuffer(ctx, tx, cmd.Query.Sql, cmd.Query.Parameters)
+			result, err := executeQueryAndBuffer(txCtx, txq, cmd.Query.Sql, cmd.Query.Parameters)
 			if err != nil {
-				log.Printf("[%s] Query error: %v", traceID, err)
+				txLog.WarnContext(ctx, "Query error", logging.Err(err))
 				sendAppError(stream, traceID, err, cmd.Query.Sql)
 			} else {
 				_ = stream.Send(&sqlrpcv1.TransactionResponse{
@@ -292,7 +343,7 @@ func (s *DbServer) Transaction(ctx context.Context, stream *connect.BidiStream[s
 			// Ensure the SQL string doesn't contain manual BEGIN/COMMIT/ROLLBACK.
 			// In an atomic script, users MUST use the structured 'commit' or 'rollback' fields.
 			if err := ValidateStatelessQuery(cmd.QueryStream.Sql); err != nil {
-				log.Printf("[%s] Blocked manual transaction control in script query: %s", traceID, cmd.QueryStream.Sql)
+				txLog.WarnContext(ctx, "Blocked manual transaction control in script query", "sql", cmd.QueryStream.Sql)
 				return err // Return immediate protocol error
 			}
 
@@ -303,9 +354,9 @@ func (s *DbServer) Transaction(ctx context.Context, stream *connect.BidiStream[s
 			// Note: We do NOT return the error here. If a query fails (e.g., syntax error),
 			// we send an application-level error message and keep the stream/transaction alive.
 			// The client decides whether to fix the query or Rollback.
-			err := streamQueryResults(ctx, tx, cmd.QueryStream.Sql, cmd.QueryStream.Parameters, writer)
+			err := streamQueryResults(txCtx, txq, cmd.QueryStream.Sql, cmd.QueryStream.Parameters, writer)
 			if err != nil {
-				log.Printf("[%s] Query error: %v", traceID, err)
+				txLog.WarnContext(ctx, "Query error", logging.Err(err))
 				sendAppError(stream, traceID, err, cmd.QueryStream.Sql)
 			}
 			continue
@@ -319,7 +370,7 @@ func (s *DbServer) Transaction(ctx context.Context, stream *connect.BidiStream[s
 			if err := ValidateStatelessQuery(cmd.Exec.Sql); err != nil {
 				return err
 			}
-			result, err := executeExecAndBuffer(ctx, tx, cmd.Exec.Sql, cmd.Exec.Parameters)
+			result, err := executeExecAndBuffer(txCtx, txq, cmd.Exec.Sql, cmd.Exec.Parameters)
 			if err != nil {
 				sendAppError(stream, traceID, err, cmd.Exec.Sql)
 			} else {
@@ -336,7 +387,7 @@ func (s *DbServer) Transaction(ctx context.Context, stream *connect.BidiStream[s
 			if err := ValidateStatelessQuery(cmd.TypedExec.Sql); err != nil {
 				return err
 			}
-			result, err := typedExecuteExecAndBuffer(ctx, tx, cmd.TypedExec.Sql, cmd.TypedExec.Parameters)
+			result, err := typedExecuteExecAndBuffer(txCtx, txq, cmd.TypedExec.Sql, cmd.TypedExec.Parameters)
 			if err != nil {
 				sendAppError(stream, traceID, err, cmd.TypedExec.Sql)
 			} else {
@@ -357,7 +408,7 @@ func (s *DbServer) Transaction(ctx context.Context, stream *connect.BidiStream[s
 				continue
 			}
 
-			if _, err := tx.ExecContext(ctx, sql); err != nil {
+			if _, err := tx.ExecContext(txCtx, sql); err != nil {
 				// Sends ErrorResponse as a stream message
 				sendAppError(stream, traceID, err, sql)
 				continue
@@ -382,14 +433,16 @@ func (s *DbServer) Transaction(ctx context.Context, stream *connect.BidiStream[s
 			}
 
 			// Attempt to persist changes to disk
-			if err := tx.Commit(); err != nil {
+			err := tx.Commit()
+			endTxSpan(txSpan, txOutcomeCommit, err)
+			if err != nil {
 				tx = nil // Mark nil so defer doesn't try to rollback
-				log.Printf("[%s] Commit failed (disk I/O or constraint): %v", traceID, err)
+				txLog.ErrorContext(ctx, "Commit failed (disk I/O or constraint)", logging.Err(err))
 				return connect.NewError(connect.CodeInternal, err)
 			}
 
 			tx = nil // Successfully committed
-			log.Printf("[%s] Transaction committed successfully", traceID)
+			txLog.DebugContext(ctx, "Transaction committed")
 
 			_ = stream.Send(&sqlrpcv1.TransactionResponse{
 				Response: &sqlrpcv1.TransactionResponse_Commit{Commit: &sqlrpcv1.CommitResponse{Success: true}},
@@ -401,9 +454,10 @@ func (s *DbServer) Transaction(ctx context.Context, stream *connect.BidiStream[s
 		case *sqlrpcv1.TransactionRequest_Rollback:
 			if tx != nil {
 				_ = tx.Rollback()
+				endTxSpan(txSpan, txOutcomeRollback, nil)
 				tx = nil
 			}
-			log.Printf("[%s] Client requested rollback", traceID)
+			txLog.DebugContext(ctx, "Client requested rollback")
 			_ = stream.Send(&sqlrpcv1.TransactionResponse{
 				Response: &sqlrpcv1.TransactionResponse_Rollback{Rollback: &sqlrpcv1.RollbackResponse{Success: true}},
 			})
@@ -416,13 +470,13 @@ func (s *DbServer) Transaction(ctx context.Context, stream *connect.BidiStream[s
 			}
 
 			if err := ValidateStatelessQuery(cmd.TypedQuery.Sql); err != nil {
-				log.Printf("[%s] Blocked manual transaction control in typed query: %s", traceID, cmd.TypedQuery.Sql)
+				txLog.WarnContext(ctx, "Blocked manual transaction control in typed query", "sql", cmd.TypedQuery.Sql)
 				return err
 			}
 
-			result, err := typedExecuteQueryAndBuffer(ctx, tx, cmd.TypedQuery.Sql, cmd.TypedQuery.Parameters)
+			result, err := typedExecuteQueryAndBuffer(txCtx, txq, cmd.TypedQuery.Sql, cmd.TypedQuery.Parameters)
 			if err != nil {
-				log.Printf("[%s] Typed query error: %v", traceID, err)
+				txLog.WarnContext(ctx, "Typed query error", logging.Err(err))
 				sendAppError(stream, traceID, err, cmd.TypedQuery.Sql)
 			} else {
 				_ = stream.Send(&sqlrpcv1.TransactionResponse{
@@ -440,23 +494,43 @@ func (s *DbServer) Transaction(ctx context.Context, stream *connect.BidiStream[s
 			}
 
 			if err := ValidateStatelessQuery(cmd.TypedQueryStream.Sql); err != nil {
-				log.Printf("[%s] Blocked manual transaction control in typed stream query: %s", traceID, cmd.TypedQueryStream.Sql)
+				txLog.WarnContext(ctx, "Blocked manual transaction control in typed stream query", "sql", cmd.TypedQueryStream.Sql)
 				return err
 			}
 
 			// Use the typed transactional writer adapter
 			typedWriter := &typedTransactionalStreamWriter{stream: stream}
 
-			err := typedStreamQueryResults(ctx, tx, cmd.TypedQueryStream.Sql, cmd.TypedQueryStream.Parameters, typedWriter)
+			err := typedStreamQueryResults(txCtx, txq, cmd.TypedQueryStream.Sql, cmd.TypedQueryStream.Parameters, typedWriter)
 			if err != nil {
-				log.Printf("[%s] Typed stream query error: %v", traceID, err)
+				txLog.WarnContext(ctx, "Typed stream query error", logging.Err(err))
 				sendAppError(stream, traceID, err, cmd.TypedQueryStream.Sql)
 			}
 			continue
 
 		default:
-			log.Printf("[%s] Unknown command type", traceID)
+			txLog.WarnContext(ctx, "Unknown command type")
 			return connect.NewError(connect.CodeInvalidArgument, errors.New("unknown command"))
 		}
 	}
 }
+
+// transactionRequestSQL returns the statement a Transaction stream message runs,
+// empty for control messages.
+func transactionRequestSQL(req *sqlrpcv1.TransactionRequest) string {
+	switch cmd := req.Command.(type) {
+	case *sqlrpcv1.TransactionRequest_Query:
+		return cmd.Query.Sql
+	case *sqlrpcv1.TransactionRequest_QueryStream:
+		return cmd.QueryStream.Sql
+	case *sqlrpcv1.TransactionRequest_Exec:
+		return cmd.Exec.Sql
+	case *sqlrpcv1.TransactionRequest_TypedExec:
+		return cmd.TypedExec.Sql
+	case *sqlrpcv1.TransactionRequest_TypedQuery:
+		return cmd.TypedQuery.Sql
+	case *sqlrpcv1.TransactionRequest_TypedQueryStream:
+		return cmd.TypedQueryStream.Sql
+	}
+	return ""
+}
@@ -5,12 +5,13 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"log"
 
 	"buf.build/go/protovalidate"
 	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/trace"
 
 	"sqlite-server/internal/auth"
+	"sqlite-server/internal/logging"
 	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
 )
 
@@ -33,7 +34,7 @@ func (s *DbServer) ExecuteTransaction(ctx context.Context, req *connect.Request[
 
 	// 1. Fail-Fast Validation
 	if err := protovalidate.Validate(req.Msg); err != nil {
-		log.Printf("[%s] Validation failed: %v", reqID, err)
+		txLog.WarnContext(ctx, "Validation failed", logging.Err(err))
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
@@ -43,6 +44,8 @@ func (s *DbServer) ExecuteTransaction(ctx context.Context, req *connect.Request[
 	}
 
 	var tx *sql.Tx
+	var txq querier // tx under the caller's row policies
+	var txSpan trace.Span
 	var allResponses []*sqlrpcv1.TransactionResponse
 
 	// CRITICAL SAFETY: The defer block ensures that if the loop exits via 'return'
@@ -50,10 +53,11 @@ func (s *DbServer) ExecuteTransaction(ctx context.Context, req *connect.Request[
 	// the connection pool from being left in a "dirty" state.
 	defer func() {
 		if tx != nil {
-			log.Printf("[%s] Script interrupted or failed; rolling back transaction.", reqID)
+			txLog.WarnContext(ctx, "Script interrupted or failed; rolling back transaction")
 			// Use Background() for rollback to ensure it completes even if the
 			// request context is already timed out.
 			_ = tx.Rollback()
+			endTxSpan(txSpan, txOutcomeAborted, nil)
 		}
 	}()
 
@@ -75,7 +79,7 @@ func (s *DbServer) ExecuteTransaction(ctx context.Context, req *connect.Request[
 			}
 
 			mode := ModeRW
-			if auth.IsReadOnly(ctx) {
+			if auth.IsReadOnly(ctx, cmd.Begin.Database) {
 				mode = ModeRO
 			}
 			dbVal, errConn := s.dbManager.GetConnection(ctx, cmd.Begin.Database, mode)
@@ -97,6 +101,11 @@ func (s *DbServer) ExecuteTransaction(ctx context.Context, req *connect.Request[
 			if err != nil {
 				return nil, connect.NewError(connect.CodeInternal, err)
 			}
+			if err := s.bindSession(ctx, tx, cmd.Begin.Database); err != nil {
+				return nil, connect.NewError(connect.CodeInternal, err)
+			}
+			txq = s.restrict(ctx, cmd.Begin.Database, tx)
+			txSpan = startTxSpan(ctx, cmd.Begin.Database)
 
 			allResponses = append(allResponses, &sqlrpcv1.TransactionResponse{
 				Response: &sqlrpcv1.TransactionResponse_Begin{
@@ -125,11 +134,11 @@ func (s *DbServer) ExecuteTransaction(ctx context.Context, req *connect.Request[
 			// Ensure the SQL string doesn't contain manual BEGIN/COMMIT/ROLLBACK.
 			// In an atomic script, users MUST use the structured 'commit' or 'rollback' fields.
 			if err := ValidateStatelessQuery(sqlStr); err != nil {
-				log.Printf("[%s] Blocked manual transaction control in script query: %s", reqID, sqlStr)
+				txLog.WarnContext(ctx, "Blocked manual transaction control in script query", "sql", sqlStr)
 				return nil, err // Return immediate protocol error
 			}
 
-			result, err := executeQueryAndBuffer(ctx, tx, sqlStr, params)
+			result, err := executeQueryAndBuffer(ctx, txq, sqlStr, params)
 			if err != nil {
 				// We append the error to the response list so the client knows exactly which step (i) failed.
 				allResponses = append(allResponses, &sqlrpcv1.TransactionResponse{
@@ -161,11 +170,11 @@ func (s *DbServer) ExecuteTransaction(ctx context.Context, req *connect.Request[
 			}
 
 			if err := ValidateStatelessQuery(sqlStr); err != nil {
-				log.Printf("[%s] Blocked manual transaction control in script query: %s", reqID, sqlStr)
+				txLog.WarnContext(ctx, "Blocked manual transaction control in script query", "sql", sqlStr)
 				return nil, err
 			}
 
-			result, err := typedExecuteQueryAndBuffer(ctx, tx, sqlStr, params)
+			result, err := typedExecuteQueryAndBuffer(ctx, txq, sqlStr, params)
 			if err != nil {
 				allResponses = append(allResponses, &sqlrpcv1.TransactionResponse{
 					Response: &sqlrpcv1.TransactionResponse_Error{
@@ -187,11 +196,11 @@ func (s *DbServer) ExecuteTransaction(ctx context.Context, req *connect.Request[
 			sqlStr, params := cmd.Exec.Sql, cmd.Exec.Parameters
 
 			if err := ValidateStatelessQuery(sqlStr); err != nil {
-				log.Printf("[%s] Blocked manual transaction control in script exec: %s", reqID, sqlStr)
+				txLog.WarnContext(ctx, "Blocked manual transaction control in script exec", "sql", sqlStr)
 				return nil, err
 			}
 
-			result, err := executeExecAndBuffer(ctx, tx, sqlStr, params)
+			result, err := executeExecAndBuffer(ctx, txq, sqlStr, params)
 			if err != nil {
 				allResponses = append(allResponses, &sqlrpcv1.TransactionResponse{
 					Response: &sqlrpcv1.TransactionResponse_Error{Error: makeStreamError(err, sqlStr)},
@@ -211,11 +220,11 @@ func (s *DbServer) ExecuteTransaction(ctx context.Context, req *connect.Request[
 			sqlStr, params := cmd.TypedExec.Sql, cmd.TypedExec.Parameters
 
 			if err := ValidateStatelessQuery(sqlStr); err != nil {
-				log.Printf("[%s] Blocked manual transaction control in script exec: %s", reqID, sqlStr)
+				txLog.WarnContext(ctx, "Blocked manual transaction control in script exec", "sql", sqlStr)
 				return nil, err
 			}
 
-			result, err := typedExecuteExecAndBuffer(ctx, tx, sqlStr, params)
+			result, err := typedExecuteExecAndBuffer(ctx, txq, sqlStr, params)
 			if err != nil {
 				allResponses = append(allResponses, &sqlrpcv1.TransactionResponse{
 					Response: &sqlrpcv1.TransactionResponse_Error{Error: makeStreamError(err, sqlStr)},
@@ -260,7 +269,9 @@ func (s *DbServer) ExecuteTransaction(ctx context.Context, req *connect.Request[
 			if tx == nil {
 				return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("no active transaction to commit"))
 			}
-			if err := tx.Commit(); err != nil {
+			err := tx.Commit()
+			endTxSpan(txSpan, txOutcomeCommit, err)
+			if err != nil {
 				return nil, connect.NewError(connect.CodeInternal, err)
 			}
 			tx = nil // Successfully committed, disable defer rollback
@@ -272,6 +283,7 @@ func (s *DbServer) ExecuteTransaction(ctx context.Context, req *connect.Request[
 			// --- Handle ROLLBACK ---
 			if tx != nil {
 				_ = tx.Rollback()
+				endTxSpan(txSpan, txOutcomeRollback, nil)
 				tx = nil // Manually rolled back, disable defer rollback
 			}
 			allResponses = append(allResponses, &sqlrpcv1.TransactionResponse{
@@ -279,7 +291,7 @@ func (s *DbServer) ExecuteTransaction(ctx context.Context, req *connect.Request[
 			})
 
 		default:
-			log.Printf("[%s] Received unimplemented command type in script at index %d", reqID, i)
+			txLog.WarnContext(ctx, "Received unimplemented command type in script", "index", i)
 		}
 	}
 
@@ -4,8 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
-	"log"
 	"sqlite-server/internal/auth"
+	"sqlite-server/internal/logging"
 	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
 	"time"
 
@@ -27,7 +27,6 @@ import (
 //  4. Stores the Tx in `txRegistry` with a Timeout.
 //  5. Returns the ID to the client.
 func (s *DbServer) BeginTransaction(ctx context.Context, req *connect.Request[sqlrpcv1.BeginTransactionRequest]) (*connect.Response[sqlrpcv1.BeginTransactionResponse], error) {
-	reqID := ensureRequestID(req.Header())
 	msg := req.Msg
 
 	if err := protovalidate.Validate(msg); err != nil {
@@ -35,7 +34,7 @@ func (s *DbServer) BeginTransaction(ctx context.Context, req *connect.Request[sq
 	}
 
 	mode := ModeRW
-	if auth.IsReadOnly(ctx) {
+	if auth.IsReadOnly(ctx, msg.Database) {
 		mode = ModeRO
 	}
 	db, err := s.dbManager.GetConnection(ctx, msg.Database, mode)
@@ -66,7 +65,12 @@ func (s *DbServer) BeginTransaction(ctx context.Context, req *connect.Request[sq
 
 	tx, err := db.BeginTx(context.Background(), txOpts) // Use BeginTx instead of Begin
 	if err != nil {
-		log.Printf("[%s] BeginTransaction failed: %v", reqID, err)
+		txLog.WarnContext(ctx, "BeginTransaction failed", logging.Err(err))
+		return nil, makeUnaryError(err, "BEGIN")
+	}
+	if err := s.bindSession(ctx, tx, msg.Database); err != nil {
+		_ = tx.Rollback()
+		txLog.WarnContext(ctx, "BeginTransaction failed", logging.Err(err))
 		return nil, makeUnaryError(err, "BEGIN")
 	}
 
@@ -74,16 +78,23 @@ func (s *DbServer) BeginTransaction(ctx context.Context, req *connect.Request[sq
 	txID := genRequestID()
 	expiry := time.Now().Add(timeout)
 
-	s.txMu.Lock()
-	s.txRegistry[txID] = &TxSession{
+	session := &TxSession{
 		Tx:        tx,
 		Expiry:    expiry,
 		DBName:    msg.Database,
 		CreatedAt: time.Now(),
+		span:      startTxSpan(ctx, msg.Database),
 	}
+	if claims, ok := auth.FromContext(ctx); ok {
+		session.Username = claims.Username
+	}
+
+	s.txMu.Lock()
+	s.txRegistry[txID] = session
 	s.txMu.Unlock()
 
-	log.Printf("[%s] Started transaction session %s (timeout: %s)", reqID, txID, timeout)
+	logging.AddAttrs(ctx, logging.KeyTxID, txID)
+	txLog.DebugContext(ctx, "Started transaction session", "timeout", timeout)
 
 	return connect.NewResponse(&sqlrpcv1.BeginTransactionResponse{
 		TransactionId: txID,
@@ -91,6 +102,19 @@ func (s *DbServer) BeginTransaction(ctx context.Context, req *connect.Request[sq
 	}), nil
 }
 
+// TransactionDatabase returns the database an ID-based transaction was opened on.
+// It implements TransactionResolver so the AuthInterceptor can enforce per-database
+// grants on calls that only carry a transaction ID.
+func (s *DbServer) TransactionDatabase(id string) (string, bool) {
+	s.txMu.RLock()
+	defer s.txMu.RUnlock()
+	session, ok := s.txRegistry[id]
+	if !ok {
+		return "", false
+	}
+	return session.DBName, true
+}
+
 // TransactionQuery executes a SQL statement within an existing transaction context.
 //
 // HEARTBEAT MECHANISM:
@@ -115,7 +139,7 @@ func (s *DbServer) TransactionQuery(ctx context.Context, req *connect.Request[sq
 	// Ensure the SQL string doesn't contain manual BEGIN/COMMIT/ROLLBACK.
 	// In an atomic script, users MUST use the structured 'commit' or 'rollback' fields.
 	if err := ValidateStatelessQuery(msg.Sql); err != nil {
-		log.Printf("[%s] Blocked manual transaction control in script query: %s", reqID, msg.Sql)
+		txLog.WarnContext(ctx, "Blocked manual transaction control in script query", "sql", msg.Sql)
 		return nil, err // Return immediate protocol error
 	}
 
@@ -133,18 +157,20 @@ func (s *DbServer) TransactionQuery(ctx context.Context, req *connect.Request[sq
 		delete(s.txRegistry, msg.TransactionId)
 		s.txMu.Unlock()
 		_ = session.Tx.Rollback()
+		endTxSpan(session.span, txOutcomeExpired, nil)
 		return nil, connect.NewError(connect.CodeAborted, errors.New("transaction timed out"))
 	}
 
 	// 3. Heartbeat: Extend the session life
 	session.Expiry = time.Now().Add(defaultTxTimeout)
-	tx := session.Tx
+	session.LastSQL = msg.Sql
+	tx := s.restrict(ctx, session.DBName, session.Tx)
 	s.txMu.Unlock()
 
 	// 4. Execution
 	result, err := executeQueryAndBuffer(ctx, tx, msg.Sql, msg.Parameters)
 	if err != nil {
-		log.Printf("[%s] TransactionQuery failed: %v", reqID, err)
+		txLog.WarnContext(ctx, "TransactionQuery failed", logging.Err(err))
 		// Return error logic (mapped to SqliteCode Enum)
 		return nil, makeUnaryError(err, msg.Sql)
 	}
@@ -164,7 +190,7 @@ func (s *DbServer) TransactionExec(ctx context.Context, req *connect.Request[sql
 	}
 
 	if err := ValidateStatelessQuery(msg.Sql); err != nil {
-		log.Printf("[%s] Blocked manual transaction control in script query: %s", reqID, msg.Sql)
+		txLog.WarnContext(ctx, "Blocked manual transaction control in script query", "sql", msg.Sql)
 		return nil, err
 	}
 
@@ -181,18 +207,20 @@ func (s *DbServer) TransactionExec(ctx context.Context, req *connect.Request[sql
 		delete(s.txRegistry, msg.TransactionId)
 		s.txMu.Unlock()
 		_ = session.Tx.Rollback()
+		endTxSpan(session.span, txOutcomeExpired, nil)
 		return nil, connect.NewError(connect.CodeAborted, errors.New("transaction timed out"))
 	}
 
 	// 3. Heartbeat: Extend the session life
 	session.Expiry = time.Now().Add(defaultTxTimeout)
-	tx := session.Tx
+	session.LastSQL = msg.Sql
+	tx := s.restrict(ctx, session.DBName, session.Tx)
 	s.txMu.Unlock()
 
 	// 4. Execution
 	result, err := executeExecAndBuffer(ctx, tx, msg.Sql, msg.Parameters)
 	if err != nil {
-		log.Printf("[%s] TransactionExec failed: %v", reqID, err)
+		txLog.WarnContext(ctx, "TransactionExec failed", logging.Err(err))
 		return nil, makeUnaryError(err, msg.Sql)
 	}
 
@@ -231,7 +259,7 @@ func (s *DbServer) TransactionQueryStream(
 	// Ensure the SQL string doesn't contain manual BEGIN/COMMIT/ROLLBACK.
 	// In an atomic script, users MUST use the structured 'commit' or 'rollback' fields.
 	if err := ValidateStatelessQuery(req.Msg.Sql); err != nil {
-		log.Printf("[%s] Blocked manual transaction control in script query: %s", reqID, req.Msg.Sql)
+		txLog.WarnContext(ctx, "Blocked manual transaction control in script query", "sql", req.Msg.Sql)
 		return err // Return immediate protocol error
 	}
 
@@ -249,12 +277,14 @@ func (s *DbServer) TransactionQueryStream(
 		delete(s.txRegistry, req.Msg.TransactionId)
 		s.txMu.Unlock()
 		_ = session.Tx.Rollback()
+		endTxSpan(session.span, txOutcomeExpired, nil)
 		return connect.NewError(connect.CodeAborted, errors.New("transaction timed out"))
 	}
 
 	// 3. Heartbeat: Extend the session life
 	session.Expiry = time.Now().Add(defaultTxTimeout)
-	tx := session.Tx
+	session.LastSQL = req.Msg.Sql
+	tx := s.restrict(ctx, session.DBName, session.Tx)
 	s.txMu.Unlock()
 
 	// 4. Execution (Streaming)
@@ -262,7 +292,7 @@ func (s *DbServer) TransactionQueryStream(
 	writer := &statelessStreamWriter{stream: stream}
 	err := streamQueryResults(ctx, tx, req.Msg.Sql, req.Msg.Parameters, writer)
 	if err != nil {
-		log.Printf("[%s] Stream failed: %v", reqID, err)
+		txLog.WarnContext(ctx, "Stream failed", logging.Err(err))
 
 		// Instead of returning a gRPC error (which kills the stream with headers),
 		// 1. Create the proto error message and send it
@@ -309,8 +339,10 @@ func (s *DbServer) CommitTransaction(ctx context.Context, req *connect.Request[s
 	s.txMu.Unlock()
 
 	// Perform DB Commit
-	if err := session.Tx.Commit(); err != nil {
-		log.Printf("Commit failed for session %s: %v", msg.TransactionId, err)
+	err := session.Tx.Commit()
+	endTxSpan(session.span, txOutcomeCommit, err)
+	if err != nil {
+		txLog.ErrorContext(ctx, "Commit failed", logging.Err(err))
 		return nil, makeUnaryError(err, "COMMIT")
 	}
 
@@ -342,6 +374,7 @@ func (s *DbServer) RollbackTransaction(ctx context.Context, req *connect.Request
 	// Rollback outside the lock
 	if tx != nil {
 		_ = tx.Rollback()
+		endTxSpan(session.span, txOutcomeRollback, nil)
 	}
 
 	return connect.NewResponse(&sqlrpcv1.TransactionControlResponse{Success: true}), nil
@@ -360,7 +393,6 @@ func (s *DbServer) RollbackTransaction(ctx context.Context, req *connect.Request
 // (e.g., trying an operation, rolling back to a savepoint on failure, and
 // continuing) over standard HTTP/1.1 or HTTP/2 without a persistent TCP stream.
 func (s *DbServer) TransactionSavepoint(ctx context.Context, req *connect.Request[sqlrpcv1.TransactionSavepointRequest]) (*connect.Response[sqlrpcv1.SavepointResponse], error) {
-	reqID := ensureRequestID(req.Header())
 	msg := req.Msg
 
 	// 1. Fail-Fast Validation: Ensure the request satisfies .proto constraints.
@@ -384,6 +416,7 @@ func (s *DbServer) TransactionSavepoint(ctx context.Context, req *connect.Reques
 		delete(s.txRegistry, msg.TransactionId)
 		s.txMu.Unlock()
 		_ = session.Tx.Rollback() // Clean up SQLite resources immediately.
+		endTxSpan(session.span, txOutcomeExpired, nil)
 		return nil, connect.NewError(connect.CodeAborted, errors.New("transaction timed out"))
 	}
 
@@ -402,8 +435,7 @@ func (s *DbServer) TransactionSavepoint(ctx context.Context, req *connect.Reques
 	// 5. Database Execution: Execute the SAVEPOINT/RELEASE/ROLLBACK TO command.
 	// Savepoints in SQLite are executed like standard statements but affect transaction state.
 	if _, err := tx.ExecContext(ctx, sql); err != nil {
-		log.Printf("[%s] Savepoint operation '%s' failed for session %s: %v",
-			reqID, msg.Savepoint.Action, msg.TransactionId, err)
+		txLog.WarnContext(ctx, "Savepoint operation failed", "action", msg.Savepoint.Action.String(), logging.Err(err))
 
 		// Map native SQLite C-errors to structured Protobuf/Connect errors.
 		return nil, makeUnaryError(err, sql)
@@ -435,7 +467,7 @@ func (s *DbServer) TypedTransactionQuery(ctx context.Context, req *connect.Reque
 	}
 
 	if err := ValidateStatelessQuery(msg.Sql); err != nil {
-		log.Printf("[%s] Blocked manual transaction control in typed query: %s", reqID, msg.Sql)
+		txLog.WarnContext(ctx, "Blocked manual transaction control in typed query", "sql", msg.Sql)
 		return nil, err
 	}
 
@@ -452,18 +484,20 @@ func (s *DbServer) TypedTransactionQuery(ctx context.Context, req *connect.Reque
 		delete(s.txRegistry, msg.TransactionId)
 		s.txMu.Unlock()
 		_ = session.Tx.Rollback()
+		endTxSpan(session.span, txOutcomeExpired, nil)
 		return nil, connect.NewError(connect.CodeAborted, errors.New("transaction timed out"))
 	}
 
 	// 3. Heartbeat: Extend the session life
 	session.Expiry = time.Now().Add(defaultTxTimeout)
-	tx := session.Tx
+	session.LastSQL = msg.Sql
+	tx := s.restrict(ctx, session.DBName, session.Tx)
 	s.txMu.Unlock()
 
 	// 4. Execution using typed functions
 	result, err := typedExecuteQueryAndBuffer(ctx, tx, msg.Sql, msg.Parameters)
 	if err != nil {
-		log.Printf("[%s] TypedTransactionQuery failed: %v", reqID, err)
+		txLog.WarnContext(ctx, "TypedTransactionQuery failed", logging.Err(err))
 		return nil, makeUnaryError(err, msg.Sql)
 	}
 
@@ -489,7 +523,7 @@ func (s *DbServer) TypedTransactionQueryStream(
 	}
 
 	if err := ValidateStatelessQuery(req.Msg.Sql); err != nil {
-		log.Printf("[%s] Blocked manual transaction control in typed stream query: %s", reqID, req.Msg.Sql)
+		txLog.WarnContext(ctx, "Blocked manual transaction control in typed stream query", "sql", req.Msg.Sql)
 		return err
 	}
 
@@ -506,19 +540,21 @@ func (s *DbServer) TypedTransactionQueryStream(
 		delete(s.txRegistry, req.Msg.TransactionId)
 		s.txMu.Unlock()
 		_ = session.Tx.Rollback()
+		endTxSpan(session.span, txOutcomeExpired, nil)
 		return connect.NewError(connect.CodeAborted, errors.New("transaction timed out"))
 	}
 
 	// 3. Heartbeat: Extend the session life
 	session.Expiry = time.Now().Add(defaultTxTimeout)
-	tx := session.Tx
+	session.LastSQL = req.Msg.Sql
+	tx := s.restrict(ctx, session.DBName, session.Tx)
 	s.txMu.Unlock()
 
 	// 4. Execution (Streaming) using typed functions
 	writer := &typedStatelessStreamWriter{stream: stream}
 	err := typedStreamQueryResults(ctx, tx, req.Msg.Sql, req.Msg.Parameters, writer)
 	if err != nil {
-		log.Printf("[%s] TypedTransactionQueryStream failed: %v", reqID, err)
+		txLog.WarnContext(ctx, "TypedTransactionQueryStream failed", logging.Err(err))
 
 		errResp := makeStreamError(err, req.Msg.Sql)
 		sendErr := stream.Send(&sqlrpcv1.TypedQueryResponse{
@@ -547,7 +583,7 @@ func (s *DbServer) TypedTransactionExec(ctx context.Context, req *connect.Reques
 	}
 
 	if err := ValidateStatelessQuery(msg.Sql); err != nil {
-		log.Printf("[%s] Blocked manual transaction control in typed exec: %s", reqID, msg.Sql)
+		txLog.WarnContext(ctx, "Blocked manual transaction control in typed exec", "sql", msg.Sql)
 		return nil, err
 	}
 
@@ -564,18 +600,20 @@ func (s *DbServer) TypedTransactionExec(ctx context.Context, req *connect.Reques
 		delete(s.txRegistry, msg.TransactionId)
 		s.txMu.Unlock()
 		_ = session.Tx.Rollback()
+		endTxSpan(session.span, txOutcomeExpired, nil)
 		return nil, connect.NewError(connect.CodeAborted, errors.New("transaction timed out"))
 	}
 
 	// 3. Heartbeat: Extend the session life
 	session.Expiry = time.Now().Add(defaultTxTimeout)
-	tx := session.Tx
+	session.LastSQL = msg.Sql
+	tx := s.restrict(ctx, session.DBName, session.Tx)
 	s.txMu.Unlock()
 
 	// 4. Execute DML via typed exec buffer
 	result, err := typedExecuteExecAndBuffer(ctx, tx, msg.Sql, msg.Parameters)
 	if err != nil {
-		log.Printf("[%s] TypedTransactionExec failed: %v", reqID, err)
+		txLog.WarnContext(ctx, "TypedTransactionExec failed", logging.Err(err))
 		return nil, makeUnaryError(err, msg.Sql)
 	}
 
@@ -8,7 +8,10 @@ import (
 	"sqlite-server/internal/protos/sqlrpc/v1/sqlrpcv1connect"
 	"sqlite-server/internal/pubsub"
 	"sync"
+	"sync/atomic"
 	"time"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
 // querier is an interface abstraction for executing SQL commands.
@@ -40,9 +43,16 @@ type TxSession struct {
 	// Updated on every TransactionQuery (Heartbeat).
 	Expiry time.Time
 
-	// Metadata for debugging and logging.
+	// Metadata for debugging, logging and ListActiveOperations.
 	DBName    string
 	CreatedAt time.Time
+	Username  string
+
+	// LastSQL is the last statement run in the transaction. Guarded by txMu.
+	LastSQL string
+
+	// span covers the transaction from BEGIN until it is committed, rolled back or reaped.
+	span trace.Span
 }
 
 // DbServer is the concrete implementation of the DatabaseServiceHandler interface.
@@ -65,11 +75,42 @@ type DbServer struct {
 	txRegistry map[string]*TxSession
 	txMu       sync.RWMutex
 
+	// reaped counts the transactions rolled back by the reaper after expiring.
+	reaped atomic.Int64
+
+	// cursors maps a cursor ID to an open cursorSession. Reaped with the
+	// transactions once idle for longer than their timeout.
+	cursors  map[string]*cursorSession
+	cursorMu sync.Mutex
+
 	// shutdownCh signals the background reaper to stop during graceful shutdown.
 	shutdownCh chan struct{}
 
 	// broker handles Pub/Sub messaging.
 	broker *pubsub.Broker
+
+	// backupDir is the directory BackupDatabase stores backups in.
+	backupDir string
+
+	// roleLimits caps the execution time of stateless statements per role.
+	roleLimits map[sqlrpcv1.Role]time.Duration
+
+	// statements tracks the running stateless statements for CancelQuery.
+	statements map[*activeStatement]struct{}
+	stmtMu     sync.Mutex
+
+	// streamTxs tracks the open bidirectional Transaction streams by their
+	// transaction ID so admins can list and roll them back.
+	streamTxs map[string]*streamTx
+	streamMu  sync.Mutex
+
+	// rowPolicies holds the row-level security predicates of each database by
+	// table, the policies of a table already combined. Guarded by policyMu.
+	rowPolicies map[string]map[string]string
+	// columnDatabases are the databases given column rules by the last
+	// ReloadColumnPolicies, so the next one can clear those left without any.
+	columnDatabases map[string]struct{}
+	policyMu        sync.RWMutex
 }
 
 // StreamWriter is an interface that abstracts the mechanism of sending query results.
@@ -0,0 +1,332 @@
+// Package sqlclass classifies SQLite statements without touching a database.
+//
+// The classifier runs a small SQLite-compatible tokenizer over the whole input, so
+// comments, string literals, quoted identifiers, CTE prefixes (WITH ... INSERT) and
+// multi-statement strings are all seen the way SQLite sees them. It needs no schema,
+// which lets the auth interceptor classify a request before a connection is chosen.
+//
+// Classification is conservative: a statement whose verb is not recognised counts as
+// a write, so callers that gate on Write or ReadOnly fail closed.
+package sqlclass
+
+import "strings"
+
+// Info describes what executing a SQL string (all of its statements) may do.
+type Info struct {
+	// Statements is the number of non-empty statements in the input.
+	Statements int
+
+	// Write is set if any statement may modify data, schema or persistent settings.
+	Write bool
+
+	// Restricted is set if any statement is ATTACH, DETACH or VACUUM, which must go
+	// through the dedicated RPCs instead of raw SQL.
+	Restricted bool
+
+	// TransactionControl is set if any statement is BEGIN, COMMIT, END, ROLLBACK,
+	// SAVEPOINT or RELEASE.
+	TransactionControl bool
+
+	// WriteLock is set if any statement is BEGIN IMMEDIATE or BEGIN EXCLUSIVE.
+	WriteLock bool
+
+	// ReturnsRows is set if the last statement produces a result set. This matches
+	// the driver, which only surfaces the rows of the last statement.
+	ReturnsRows bool
+}
+
+// ReadOnly reports whether the input is safe to run on a read-only connection.
+func (i Info) ReadOnly() bool {
+	return !i.Write && !i.WriteLock
+}
+
+// pragmasWithReadArgument are pragmas that take an argument but only read.
+var pragmasWithReadArgument = map[string]bool{
+	"FOREIGN_KEY_CHECK": true,
+	"FOREIGN_KEY_LIST":  true,
+	"INDEX_INFO":        true,
+	"INDEX_LIST":        true,
+	"INDEX_XINFO":       true,
+	"INTEGRITY_CHECK":   true,
+	"QUICK_CHECK":       true,
+	"TABLE_INFO":        true,
+	"TABLE_LIST":        true,
+	"TABLE_XINFO":       true,
+}
+
+// pragmasWithSideEffects are pragmas that modify the database even without an argument.
+var pragmasWithSideEffects = map[string]bool{
+	"INCREMENTAL_VACUUM": true,
+	"OPTIMIZE":           true,
+	"SHRINK_MEMORY":      true,
+	"WAL_CHECKPOINT":     true,
+}
+
+// Classify tokenizes sql and classifies every statement in it.
+func Classify(sql string) Info {
+	var info Info
+	for _, stmt := range split(tokenize(sql)) {
+		info.Statements++
+		s := classifyStatement(stmt)
+		info.Write = info.Write || s.Write
+		info.Restricted = info.Restricted || s.Restricted
+		info.TransactionControl = info.TransactionControl || s.TransactionControl
+		info.WriteLock = info.WriteLock || s.WriteLock
+		info.ReturnsRows = s.ReturnsRows
+	}
+	return info
+}
+
+// classifyStatement classifies a single statement from its top-level keywords.
+func classifyStatement(stmt []token) Info {
+	info := Info{Statements: 1}
+	if stmt[0].kind != tokenWord {
+		info.Write = true
+		return info
+	}
+
+	verb := stmt[0].text
+	if verb == "WITH" {
+		verb = mainVerb(stmt)
+	}
+
+	switch verb {
+	case "SELECT", "VALUES":
+		info.ReturnsRows = true
+	case "EXPLAIN":
+		// EXPLAIN compiles the statement but never runs it
+		info.ReturnsRows = true
+		return info
+	case "PRAGMA":
+		info.ReturnsRows = true
+		info.Write = pragmaWrites(stmt)
+	case "INSERT", "REPLACE", "UPDATE", "DELETE",
+		"CREATE", "DROP", "ALTER", "REINDEX", "ANALYZE":
+		info.Write = true
+	case "ATTACH", "DETACH", "VACUUM":
+		info.Write = true
+		info.Restricted = true
+	case "BEGIN":
+		info.TransactionControl = true
+		if len(stmt) > 1 && (stmt[1].is("IMMEDIATE") || stmt[1].is("EXCLUSIVE")) {
+			info.WriteLock = true
+		}
+	case "COMMIT", "END", "ROLLBACK", "SAVEPOINT", "RELEASE":
+		info.TransactionControl = true
+	default:
+		info.Write = true
+	}
+
+	// RETURNING is only valid on DML and turns it into a row producer
+	for _, t := range topLevel(stmt) {
+		if t.is("RETURNING") {
+			info.Write = true
+			info.ReturnsRows = true
+			break
+		}
+	}
+	return info
+}
+
+// mainVerb finds the statement verb following a WITH clause: the first top-level
+// SELECT, VALUES, INSERT, REPLACE, UPDATE or DELETE. CTE bodies sit inside
+// parentheses and are skipped.
+func mainVerb(stmt []token) string {
+	for _, t := range topLevel(stmt[1:]) {
+		switch t.text {
+		case "SELECT", "VALUES", "INSERT", "REPLACE", "UPDATE", "DELETE":
+			if t.kind == tokenWord {
+				return t.text
+			}
+		}
+	}
+	return ""
+}
+
+// pragmaWrites reports whether a PRAGMA statement changes state. Assignments always
+// do; function-style calls only read for the known introspection pragmas.
+func pragmaWrites(stmt []token) bool {
+	name := ""
+	for i := 1; i < len(stmt); i++ {
+		t := stmt[i]
+		switch {
+		case t.kind == tokenWord || t.kind == tokenQuoted:
+			if i+1 < len(stmt) && stmt[i+1].is(".") {
+				continue // schema prefix
+			}
+			if name == "" {
+				name = t.text
+			}
+		case t.is("="):
+			return true
+		case t.is("("):
+			return !pragmasWithReadArgument[name]
+		}
+	}
+	return pragmasWithSideEffects[name]
+}
+
+// topLevel returns the tokens of stmt that are not nested inside parentheses.
+func topLevel(stmt []token) []token {
+	out := make([]token, 0, len(stmt))
+	depth := 0
+	for _, t := range stmt {
+		switch {
+		case t.is("("):
+			depth++
+		case t.is(")"):
+			if depth > 0 {
+				depth--
+			}
+		case depth == 0:
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// split groups tokens into statements on top-level semicolons. Semicolons inside a
+// CREATE TRIGGER body (BEGIN ... END) belong to the trigger and do not split.
+func split(tokens []token) [][]token {
+	var stmts [][]token
+	var cur []token
+	trigger, blockDepth := false, 0
+
+	for _, t := range tokens {
+		if t.is(";") && blockDepth == 0 {
+			if len(cur) > 0 {
+				stmts = append(stmts, cur)
+			}
+			cur, trigger = nil, false
+			continue
+		}
+		cur = append(cur, t)
+
+		if len(cur) <= 4 && t.is("TRIGGER") && cur[0].is("CREATE") {
+			trigger = true
+		}
+		if trigger && t.kind == tokenWord {
+			switch t.text {
+			case "BEGIN", "CASE":
+				blockDepth++
+			case "END":
+				if blockDepth > 0 {
+					blockDepth--
+				}
+			}
+		}
+	}
+	if len(cur) > 0 {
+		stmts = append(stmts, cur)
+	}
+	return stmts
+}
+
+// ---------------------------------------------------------
+// Tokenizer
+// ---------------------------------------------------------
+
+type tokenKind int
+
+const (
+	tokenWord   tokenKind = iota // keyword, bare identifier or number (upper-cased)
+	tokenQuoted                  // "identifier", `identifier` or [identifier] (upper-cased)
+	tokenString                  // 'string literal' (text dropped)
+	tokenPunct                   // single punctuation character
+)
+
+type token struct {
+	kind tokenKind
+	text string
+
+	// start and end are the byte offsets of the token in the input.
+	start, end int
+}
+
+// is reports whether t is the given keyword or punctuation.
+func (t token) is(s string) bool {
+	return (t.kind == tokenWord || t.kind == tokenPunct) && t.text == s
+}
+
+// tokenize splits sql into tokens, dropping whitespace and comments.
+// Unterminated comments, strings and identifiers run to the end of input.
+func tokenize(sql string) []token {
+	var tokens []token
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f' || c == '\v':
+			i++
+		case c == '-' && i+1 < len(sql) && sql[i+1] == '-':
+			end := strings.IndexByte(sql[i:], '\n')
+			if end < 0 {
+				return tokens
+			}
+			i += end + 1
+		case c == '/' && i+1 < len(sql) && sql[i+1] == '*':
+			end := strings.Index(sql[i+2:], "*/")
+			if end < 0 {
+				return tokens
+			}
+			i += end + 4
+		case c == '\'':
+			end := skipQuoted(sql, i, '\'')
+			tokens = append(tokens, token{kind: tokenString, start: i, end: end})
+			i = end
+		case c == '"' || c == '`':
+			end := skipQuoted(sql, i, c)
+			tokens = append(tokens, token{kind: tokenQuoted, text: strings.ToUpper(unquote(sql[i:end])), start: i, end: end})
+			i = end
+		case c == '[':
+			end := strings.IndexByte(sql[i:], ']')
+			if end < 0 {
+				tokens = append(tokens, token{kind: tokenQuoted, text: strings.ToUpper(sql[i+1:]), start: i, end: len(sql)})
+				return tokens
+			}
+			tokens = append(tokens, token{kind: tokenQuoted, text: strings.ToUpper(sql[i+1 : i+end]), start: i, end: i + end + 1})
+			i += end + 1
+		case isWordChar(c):
+			start := i
+			for i < len(sql) && isWordChar(sql[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenWord, text: strings.ToUpper(sql[start:i]), start: start, end: i})
+		default:
+			tokens = append(tokens, token{kind: tokenPunct, text: string(c), start: i, end: i + 1})
+			i++
+		}
+	}
+	return tokens
+}
+
+// skipQuoted returns the index just past the quoted run starting at sql[start].
+// A doubled quote character is an escaped quote.
+func skipQuoted(sql string, start int, quote byte) int {
+	for i := start + 1; i < len(sql); i++ {
+		if sql[i] == quote {
+			if i+1 < len(sql) && sql[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(sql)
+}
+
+// unquote strips the quotes of a quoted identifier and collapses doubled quotes.
+func unquote(s string) string {
+	q := s[:1]
+	s = s[1:]
+	if strings.HasSuffix(s, q) {
+		s = s[:len(s)-1]
+	}
+	return strings.ReplaceAll(s, q+q, q)
+}
+
+// isWordChar matches identifier and number characters. Bytes >= 0x80 are treated as
+// identifier characters, like SQLite does for UTF-8 input.
+func isWordChar(c byte) bool {
+	return c == '_' || c == '$' || c >= 0x80 ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
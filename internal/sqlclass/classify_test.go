@@ -0,0 +1,161 @@
+package sqlclass
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// corpus is a set of statements that keyword-prefix heuristics get wrong, or nearly do.
+var corpus = []struct {
+	sql  string
+	want Info
+}{
+	// Plain reads
+	{"SELECT * FROM users", Info{Statements: 1, ReturnsRows: true}},
+	{"  select * from users", Info{Statements: 1, ReturnsRows: true}},
+	{"VALUES (1), (2)", Info{Statements: 1, ReturnsRows: true}},
+	{"SELECT 'DELETE FROM users' AS s", Info{Statements: 1, ReturnsRows: true}},
+	{`SELECT "insert" FROM users`, Info{Statements: 1, ReturnsRows: true}},
+	{"SELECT [drop] FROM users", Info{Statements: 1, ReturnsRows: true}},
+	{"SELECT name AS returning_name FROM users", Info{Statements: 1, ReturnsRows: true}},
+	{"SELECT 'it''s; DROP TABLE users' FROM users", Info{Statements: 1, ReturnsRows: true}},
+
+	// Comments in front of or inside statements
+	{"-- just a read\nSELECT 1", Info{Statements: 1, ReturnsRows: true}},
+	{"/* leading */ DELETE FROM users", Info{Statements: 1, Write: true}},
+	{"/* SELECT */ UPDATE users SET name = 'x'", Info{Statements: 1, Write: true}},
+	{"SELECT 1 -- ; DROP TABLE users", Info{Statements: 1, ReturnsRows: true}},
+	{"SELECT 1 /* ; ATTACH 'x' AS y */", Info{Statements: 1, ReturnsRows: true}},
+	{"-- only a comment", Info{}},
+	{"", Info{}},
+
+	// Common table expressions
+	{"WITH cte AS (SELECT 1) SELECT * FROM cte", Info{Statements: 1, ReturnsRows: true}},
+	{"WITH cte AS (SELECT id FROM users) DELETE FROM users WHERE id IN cte", Info{Statements: 1, Write: true}},
+	{"WITH RECURSIVE c(n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM c WHERE n < 5) INSERT INTO nums SELECT n FROM c", Info{Statements: 1, Write: true}},
+	{"with a as materialized (select 1), b as (select 2) update users set name = 'x'", Info{Statements: 1, Write: true}},
+	{"WITH x AS (SELECT 1) INSERT INTO users (name) SELECT 'a' RETURNING id", Info{Statements: 1, Write: true, ReturnsRows: true}},
+
+	// RETURNING
+	{"INSERT INTO users (name) VALUES ('a') RETURNING id", Info{Statements: 1, Write: true, ReturnsRows: true}},
+	{"UPDATE users SET name = 'b' RETURNING *", Info{Statements: 1, Write: true, ReturnsRows: true}},
+	{"DELETE FROM users RETURNING id", Info{Statements: 1, Write: true, ReturnsRows: true}},
+
+	// PRAGMA reads and writes
+	{"PRAGMA foreign_keys", Info{Statements: 1, ReturnsRows: true}},
+	{"PRAGMA main.table_info(users)", Info{Statements: 1, ReturnsRows: true}},
+	{"PRAGMA integrity_check", Info{Statements: 1, ReturnsRows: true}},
+	{"PRAGMA user_version = 7", Info{Statements: 1, Write: true, ReturnsRows: true}},
+	{"PRAGMA main.journal_mode=DELETE", Info{Statements: 1, Write: true, ReturnsRows: true}},
+	{"PRAGMA writable_schema(1)", Info{Statements: 1, Write: true, ReturnsRows: true}},
+	{"PRAGMA wal_checkpoint", Info{Statements: 1, Write: true, ReturnsRows: true}},
+
+	// EXPLAIN never runs the explained statement
+	{"EXPLAIN QUERY PLAN DELETE FROM users", Info{Statements: 1, ReturnsRows: true}},
+	{"explain select 1", Info{Statements: 1, ReturnsRows: true}},
+
+	// Schema changes and maintenance
+	{"CREATE TABLE t (id INTEGER)", Info{Statements: 1, Write: true}},
+	{"CREATE TEMP VIEW v AS SELECT 1", Info{Statements: 1, Write: true}},
+	{"DROP INDEX IF EXISTS idx", Info{Statements: 1, Write: true}},
+	{"ALTER TABLE users ADD COLUMN age INT", Info{Statements: 1, Write: true}},
+	{"REPLACE INTO users (id, name) VALUES (1, 'a')", Info{Statements: 1, Write: true}},
+	{"ANALYZE", Info{Statements: 1, Write: true}},
+	{"REINDEX users", Info{Statements: 1, Write: true}},
+	{"CREATE TRIGGER trg AFTER INSERT ON users BEGIN UPDATE users SET name = CASE WHEN 1 THEN 'a' END; DELETE FROM users WHERE 0; END", Info{Statements: 1, Write: true}},
+
+	// Restricted statements
+	{"ATTACH DATABASE 'other.db' AS other", Info{Statements: 1, Write: true, Restricted: true}},
+	{"DETACH other", Info{Statements: 1, Write: true, Restricted: true}},
+	{"VACUUM INTO '/tmp/copy.db'", Info{Statements: 1, Write: true, Restricted: true}},
+	{"/* hidden */ vacuum", Info{Statements: 1, Write: true, Restricted: true}},
+
+	// Transaction control
+	{"BEGIN", Info{Statements: 1, TransactionControl: true}},
+	{"begin immediate", Info{Statements: 1, TransactionControl: true, WriteLock: true}},
+	{"BEGIN EXCLUSIVE TRANSACTION", Info{Statements: 1, TransactionControl: true, WriteLock: true}},
+	{"END", Info{Statements: 1, TransactionControl: true}},
+	{"SAVEPOINT sp1", Info{Statements: 1, TransactionControl: true}},
+	{"ROLLBACK TO sp1", Info{Statements: 1, TransactionControl: true}},
+
+	// Multi-statement strings
+	{"SELECT 1; DELETE FROM users", Info{Statements: 2, Write: true}},
+	{"DELETE FROM users; SELECT 1", Info{Statements: 2, Write: true, ReturnsRows: true}},
+	{"SELECT 1; ATTACH 'x.db' AS x", Info{Statements: 2, Write: true, Restricted: true}},
+	{"SELECT 1;;  ; SELECT 2;", Info{Statements: 2, ReturnsRows: true}},
+	{"SELECT 1; COMMIT", Info{Statements: 2, TransactionControl: true}},
+
+	// Unknown verbs fail closed
+	{"TRUNCATE TABLE users", Info{Statements: 1, Write: true}},
+	{"UPSERT INTO users VALUES (1)", Info{Statements: 1, Write: true}},
+	{"(SELECT 1)", Info{Statements: 1, Write: true}},
+
+	// Unterminated input
+	{"SELECT 'unterminated", Info{Statements: 1, ReturnsRows: true}},
+	{"SELECT 1 /* unterminated", Info{Statements: 1, ReturnsRows: true}},
+}
+
+func TestClassify_Corpus(t *testing.T) {
+	for _, tt := range corpus {
+		t.Run(tt.sql, func(t *testing.T) {
+			assert.Equal(t, tt.want, Classify(tt.sql))
+		})
+	}
+}
+
+func TestInfo_ReadOnly(t *testing.T) {
+	assert.True(t, Classify("SELECT 1").ReadOnly())
+	assert.True(t, Classify("BEGIN").ReadOnly())
+	assert.False(t, Classify("BEGIN IMMEDIATE").ReadOnly())
+	assert.False(t, Classify("WITH x AS (SELECT 1) DELETE FROM t").ReadOnly())
+}
+
+// divergences are deliberate disagreements with sqlite3_stmt_readonly.
+var divergences = map[string]bool{
+	// SQLite reports the explained statement, but EXPLAIN never executes it.
+	"EXPLAIN QUERY PLAN DELETE FROM users": true,
+	// Connection-level pragma settings leave the file untouched; we still treat them as writes.
+	"PRAGMA writable_schema(1)": true,
+}
+
+// TestClassify_MatchesSQLite checks the single-statement corpus against SQLite's own
+// sqlite3_stmt_readonly verdict. Transaction control is excluded because SQLite always
+// reports it as read-only.
+func TestClassify_MatchesSQLite(t *testing.T) {
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "corpus.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`
+		CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT);
+		CREATE TABLE nums (n INTEGER);
+		CREATE INDEX idx ON users(name);
+	`)
+	require.NoError(t, err)
+
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	for _, tt := range corpus {
+		if tt.want.Statements != 1 || tt.want.TransactionControl || tt.want.Restricted || divergences[tt.sql] {
+			continue
+		}
+		err := conn.Raw(func(driverConn any) error {
+			stmt, err := driverConn.(*sqlite3.SQLiteConn).Prepare(tt.sql)
+			if err != nil {
+				return nil // Not valid SQLite, nothing to compare against
+			}
+			defer stmt.Close()
+			assert.Equal(t, !stmt.(*sqlite3.SQLiteStmt).Readonly(), tt.want.Write, "sqlite3_stmt_readonly disagrees on %q", tt.sql)
+			return nil
+		})
+		require.NoError(t, err)
+	}
+}
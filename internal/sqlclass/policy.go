@@ -0,0 +1,393 @@
+package sqlclass
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrRowSecurity marks statements that cannot run under row-level security policies.
+var ErrRowSecurity = errors.New("row-level security")
+
+// ApplyPolicies rewrites sql so that its statements only see, update and delete the
+// rows of the tables in policies that satisfy the table's predicate. policies maps
+// table names, matched case-insensitively, to SQL expressions over the table's columns.
+//
+// Reads are restricted by shadowing each referenced table with a common table
+// expression of the same name that selects the visible rows from main. UPDATE and
+// DELETE target the real table, so the predicate is also added to their WHERE clause.
+// Statements that could get around the rewrite are rejected with ErrRowSecurity:
+// references qualified with main, REPLACE, OR REPLACE and upserts on a restricted
+// table, and any other kind of statement (CREATE VIEW, CREATE TABLE AS, DROP, ...) that names one.
+// Views and triggers that reach a restricted table are left to CheckBypasses.
+func ApplyPolicies(sql string, policies map[string]string) (string, error) {
+	if len(policies) == 0 {
+		return sql, nil
+	}
+	names := make(map[string]string, len(policies))
+	for name := range policies {
+		names[strings.ToUpper(name)] = name
+	}
+
+	type edit struct {
+		at   int
+		text string
+	}
+	var edits []edit
+	for _, stmt := range split(tokenize(sql)) {
+		tables, err := restrictedTables(stmt, names)
+		if err != nil {
+			return "", err
+		}
+		if len(tables) == 0 {
+			continue
+		}
+
+		verbAt := 0
+		if stmt[0].is("WITH") {
+			verbAt = mainVerbIndex(stmt)
+		}
+		verb := ""
+		if verbAt >= 0 && stmt[verbAt].kind == tokenWord {
+			verb = stmt[verbAt].text
+		}
+		switch verb {
+		case "SELECT", "VALUES", "INSERT", "REPLACE", "UPDATE", "DELETE":
+		case "EXPLAIN", "PRAGMA":
+			continue // Neither reads nor changes rows
+		default:
+			return "", fmt.Errorf("%w: %s statements cannot reference table %s", ErrRowSecurity, verb, tables[0])
+		}
+
+		ctes := make([]string, len(tables))
+		for i, table := range tables {
+			ctes[i] = fmt.Sprintf("%s AS (SELECT * FROM main.%s WHERE (%s))", quoteIdent(table), quoteIdent(table), policies[table])
+		}
+		switch {
+		case verbAt == 0:
+			edits = append(edits, edit{stmt[0].start, "WITH " + strings.Join(ctes, ", ") + " "})
+		case stmt[1].is("RECURSIVE"):
+			edits = append(edits, edit{stmt[1].end, " " + strings.Join(ctes, ", ") + ","})
+		default:
+			edits = append(edits, edit{stmt[0].end, " " + strings.Join(ctes, ", ") + ","})
+		}
+
+		targetAt := dmlTarget(stmt, verbAt)
+		if targetAt < 0 {
+			continue
+		}
+		table, ok := names[stmt[targetAt].text]
+		if !ok {
+			continue
+		}
+		// REPLACE deletes conflicting rows and an upsert updates them, visible or not
+		orReplace := verbAt+2 < len(stmt) && stmt[verbAt+1].is("OR") && stmt[verbAt+2].is("REPLACE")
+		if verb == "REPLACE" || orReplace || (verb == "INSERT" && hasUpsertUpdate(stmt[targetAt:])) {
+			return "", fmt.Errorf("%w: REPLACE and ON CONFLICT DO UPDATE are not allowed on table %s", ErrRowSecurity, table)
+		}
+		switch verb {
+		case "UPDATE", "DELETE":
+			where, stop := whereClause(stmt, targetAt)
+			pred := policies[table]
+			switch {
+			case where < 0 && stop < 0:
+				edits = append(edits, edit{stmt[len(stmt)-1].end, " WHERE (" + pred + ")"})
+			case where < 0:
+				edits = append(edits, edit{stmt[stop-1].end, " WHERE (" + pred + ")"})
+			case stop < 0:
+				edits = append(edits, edit{stmt[where].end, " (" + pred + ") AND ("}, edit{stmt[len(stmt)-1].end, ")"})
+			default:
+				edits = append(edits, edit{stmt[where].end, " (" + pred + ") AND ("}, edit{stmt[stop-1].end, ")"})
+			}
+		}
+	}
+
+	// Apply back to front so earlier offsets stay valid
+	slices.SortStableFunc(edits, func(a, b edit) int { return b.at - a.at })
+	for _, e := range edits {
+		sql = sql[:e.at] + e.text + sql[e.at:]
+	}
+	return sql, nil
+}
+
+// SchemaObject is a view or trigger as listed in sqlite_schema.
+type SchemaObject struct {
+	Type  string // "view" or "trigger"
+	Name  string
+	Table string // The table a trigger fires on
+	SQL   string
+}
+
+// PolicyBypasses finds the schema objects that reach the tables in policies around
+// the rewrite of ApplyPolicies: SQLite expands views and runs triggers as they were
+// defined. It returns, by upper-case name, the views that read a policy table and the
+// tables (or views, for INSTEAD OF triggers) with triggers that read or write one,
+// directly or through other such objects.
+func PolicyBypasses(objects []SchemaObject, policies map[string]string) map[string]string {
+	bypasses := make(map[string]string)
+	if len(policies) == 0 {
+		return bypasses
+	}
+	reached := func(names []string, tables bool) bool {
+		for _, name := range names {
+			if kind, ok := bypasses[name]; ok && (kind == "view" || tables) {
+				return true
+			}
+			for table := range policies {
+				if strings.EqualFold(table, name) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	refs := make([][]string, len(objects))
+	for i, obj := range objects {
+		refs[i] = objectReferences(obj)
+	}
+	// Objects can reach policy tables through each other, in any order
+	for changed := true; changed; {
+		changed = false
+		for i, obj := range objects {
+			switch strings.ToLower(obj.Type) {
+			case "view":
+				name := strings.ToUpper(obj.Name)
+				if bypasses[name] != "view" && reached(refs[i], false) {
+					bypasses[name], changed = "view", true
+				}
+			case "trigger":
+				// Writing to a table runs its triggers, reading it does not
+				table := strings.ToUpper(obj.Table)
+				if _, ok := bypasses[table]; !ok && reached(refs[i], true) {
+					bypasses[table], changed = "table", true
+				}
+			}
+		}
+	}
+	return bypasses
+}
+
+// objectReferences lists the names the body of a view or trigger refers to: what
+// follows AS in a view and ON table in a trigger.
+func objectReferences(obj SchemaObject) []string {
+	tokens := tokenize(obj.SQL)
+	start := len(tokens)
+	for i, t := range tokens {
+		if strings.EqualFold(obj.Type, "view") && t.is("AS") {
+			start = i + 1
+			break
+		}
+		if strings.EqualFold(obj.Type, "trigger") && t.is("ON") {
+			start = i + 2 // The table the trigger fires on
+			if start+1 < len(tokens) && tokens[start].is(".") {
+				start += 2
+			}
+			break
+		}
+	}
+	start = min(start, len(tokens))
+	var names []string
+	for _, t := range tokens[start:] {
+		if t.kind == tokenWord || t.kind == tokenQuoted {
+			names = append(names, t.text)
+		}
+	}
+	return names
+}
+
+// CheckBypasses rejects statements of sql that would reach a policy table around the
+// rewrite: those that name a view in bypasses, write to a table in bypasses, or are
+// neither queries nor writes (CREATE TRIGGER, CREATE VIEW, ...) and name either.
+// bypasses is the result of PolicyBypasses.
+func CheckBypasses(sql string, bypasses map[string]string) error {
+	if len(bypasses) == 0 {
+		return nil
+	}
+	for _, stmt := range split(tokenize(sql)) {
+		verbAt := 0
+		if stmt[0].is("WITH") {
+			verbAt = mainVerbIndex(stmt)
+		}
+		verb := ""
+		if verbAt >= 0 && stmt[verbAt].kind == tokenWord {
+			verb = stmt[verbAt].text
+		}
+		dml := false
+		switch verb {
+		case "SELECT", "VALUES", "INSERT", "REPLACE", "UPDATE", "DELETE":
+			dml = true
+		case "EXPLAIN", "PRAGMA":
+			continue // Neither reads nor changes rows
+		}
+
+		for i, t := range stmt {
+			if t.kind != tokenWord && t.kind != tokenQuoted {
+				continue
+			}
+			kind, ok := bypasses[t.text]
+			if !ok || (dml && kind != "view") {
+				continue
+			}
+			if i > 0 && stmt[i-1].is(".") && i >= 2 && stmt[i-2].text != "MAIN" && stmt[i-2].text != "TEMP" {
+				continue // An object of an attached database
+			}
+			return fmt.Errorf("%w: %s %s reaches a table with row policies", ErrRowSecurity, strings.ToLower(kind), t.text)
+		}
+		if !dml {
+			continue
+		}
+		if targetAt := dmlTarget(stmt, verbAt); targetAt >= 0 && targetAt < len(stmt) && bypasses[stmt[targetAt].text] != "" {
+			return fmt.Errorf("%w: the triggers of %s reach a table with row policies", ErrRowSecurity, stmt[targetAt].text)
+		}
+	}
+	return nil
+}
+
+// CheckPredicate validates a policy predicate: a single expression with balanced
+// parentheses and without parameters, so it can be spliced into any statement.
+func CheckPredicate(expr string) error {
+	tokens := tokenize(expr)
+	if len(tokens) == 0 {
+		return errors.New("predicate is empty")
+	}
+	depth := 0
+	for _, t := range tokens {
+		switch {
+		case t.is("("):
+			depth++
+		case t.is(")"):
+			depth--
+			if depth < 0 {
+				return errors.New("predicate has unbalanced parentheses")
+			}
+		case t.is(";"):
+			return errors.New("predicate must be a single expression")
+		case t.is("?"), t.is(":"), t.is("@"), t.kind == tokenWord && strings.HasPrefix(t.text, "$"):
+			return errors.New("predicate cannot use parameters")
+		}
+	}
+	if depth != 0 {
+		return errors.New("predicate has unbalanced parentheses")
+	}
+	return nil
+}
+
+// restrictedTables returns the policy tables stmt refers to, in order of first
+// reference. Names qualified with a schema other than main refer to the tables of
+// attached databases, which the SQLite authorizer guards; main-qualified names would
+// bypass the shadowing CTE and are rejected.
+func restrictedTables(stmt []token, names map[string]string) ([]string, error) {
+	var tables []string
+	for i, t := range stmt {
+		if t.kind != tokenWord && t.kind != tokenQuoted {
+			continue
+		}
+		table, ok := names[t.text]
+		if !ok {
+			continue
+		}
+		if t.kind == tokenWord && i+1 < len(stmt) && stmt[i+1].is("(") && !(i > 0 && stmt[i-1].is("INTO")) {
+			continue // A function call, not INSERT INTO t (columns)
+		}
+		if i > 0 && stmt[i-1].is(".") {
+			if i >= 2 && (stmt[i-2].kind == tokenWord || stmt[i-2].kind == tokenQuoted) && stmt[i-2].text == "MAIN" {
+				return nil, fmt.Errorf("%w: table %s cannot be qualified with main", ErrRowSecurity, table)
+			}
+			continue
+		}
+		if !slices.Contains(tables, table) {
+			tables = append(tables, table)
+		}
+	}
+	return tables, nil
+}
+
+// mainVerbIndex is mainVerb returning the position of the verb in stmt, or -1.
+func mainVerbIndex(stmt []token) int {
+	depth := 0
+	for i := 1; i < len(stmt); i++ {
+		t := stmt[i]
+		switch {
+		case t.is("("):
+			depth++
+		case t.is(")"):
+			depth--
+		case depth == 0 && t.kind == tokenWord:
+			switch t.text {
+			case "SELECT", "VALUES", "INSERT", "REPLACE", "UPDATE", "DELETE":
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// dmlTarget returns the position of the table name an INSERT, REPLACE, UPDATE or
+// DELETE at verbAt writes to, or -1 if it is not in main.
+func dmlTarget(stmt []token, verbAt int) int {
+	i := verbAt + 1
+	switch stmt[verbAt].text {
+	case "INSERT", "UPDATE":
+		if i < len(stmt) && stmt[i].is("OR") {
+			i += 2
+		}
+		if stmt[verbAt].text == "INSERT" {
+			i++ // INTO
+		}
+	case "REPLACE", "DELETE":
+		i++ // INTO, FROM
+	default:
+		return -1
+	}
+	if i >= len(stmt) {
+		return -1
+	}
+	if i+2 < len(stmt) && stmt[i+1].is(".") {
+		if stmt[i].text != "MAIN" {
+			return -1
+		}
+		i += 2
+	}
+	return i
+}
+
+// hasUpsertUpdate reports whether an INSERT has an ON CONFLICT ... DO UPDATE clause.
+func hasUpsertUpdate(stmt []token) bool {
+	top := topLevel(stmt)
+	for i := 0; i+1 < len(top); i++ {
+		if top[i].is("DO") && top[i+1].is("UPDATE") {
+			return true
+		}
+	}
+	return false
+}
+
+// whereClause finds, after the target of an UPDATE or DELETE, the position of its
+// top-level WHERE and of the first top-level keyword that ends the WHERE clause
+// (RETURNING, ORDER or LIMIT). Either is -1 when absent.
+func whereClause(stmt []token, targetAt int) (where, stop int) {
+	where, stop = -1, -1
+	depth := 0
+	for i := targetAt + 1; i < len(stmt); i++ {
+		t := stmt[i]
+		switch {
+		case t.is("("):
+			depth++
+		case t.is(")"):
+			depth--
+		case depth != 0:
+		case t.is("WHERE") && where < 0:
+			where = i
+		case t.is("RETURNING"), t.is("ORDER"), t.is("LIMIT"):
+			return where, i
+		}
+	}
+	return where, -1
+}
+
+// quoteIdent quotes an identifier for SQLite.
+func quoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
@@ -0,0 +1,146 @@
+package sqlclass
+
+import (
+	"errors"
+	"maps"
+	"testing"
+)
+
+func TestApplyPolicies(t *testing.T) {
+	policies := map[string]string{
+		"orders": "owner = current_user()",
+		"Notes":  "shared OR author = current_user()",
+	}
+	const orders = `"orders" AS (SELECT * FROM main."orders" WHERE (owner = current_user()))`
+	const notes = `"Notes" AS (SELECT * FROM main."Notes" WHERE (shared OR author = current_user()))`
+
+	tests := []struct {
+		name string
+		sql  string
+		want string
+	}{
+		{"unrelated", "SELECT * FROM users", "SELECT * FROM users"},
+		{"select", "SELECT * FROM orders", "WITH " + orders + " SELECT * FROM orders"},
+		{"case and quotes", `select * from "ORDERS" o join [notes] n on n.id = o.note`,
+			"WITH " + orders + ", " + notes + ` select * from "ORDERS" o join [notes] n on n.id = o.note`},
+		{"alias qualifier", "SELECT orders.id FROM orders", "WITH " + orders + " SELECT orders.id FROM orders"},
+		{"other schema", "SELECT * FROM archive.orders", "SELECT * FROM archive.orders"},
+		{"function of the same name", "SELECT orders(1)", "SELECT orders(1)"},
+		{"existing with", "WITH x AS (SELECT 1) SELECT * FROM orders, x",
+			"WITH " + orders + ", x AS (SELECT 1) SELECT * FROM orders, x"},
+		{"existing recursive with", "WITH RECURSIVE x(n) AS (SELECT 1) SELECT * FROM orders",
+			"WITH RECURSIVE " + orders + ", x(n) AS (SELECT 1) SELECT * FROM orders"},
+		{"insert select", "INSERT INTO archive SELECT * FROM orders",
+			"WITH " + orders + " INSERT INTO archive SELECT * FROM orders"},
+		{"insert", "INSERT OR IGNORE INTO orders (owner) VALUES ('a')",
+			"WITH " + orders + " INSERT OR IGNORE INTO orders (owner) VALUES ('a')"},
+		{"update", "UPDATE orders SET total = 0",
+			"WITH " + orders + " UPDATE orders SET total = 0 WHERE (owner = current_user())"},
+		{"update where", "UPDATE orders SET total = 0 WHERE id = 1 OR id = 2",
+			"WITH " + orders + " UPDATE orders SET total = 0 WHERE (owner = current_user()) AND ( id = 1 OR id = 2)"},
+		{"update returning", "UPDATE orders SET total = (SELECT 1 WHERE 1) RETURNING id",
+			"WITH " + orders + " UPDATE orders SET total = (SELECT 1 WHERE 1) WHERE (owner = current_user()) RETURNING id"},
+		{"delete where returning", "DELETE FROM main.orders WHERE id = 1 RETURNING id", ""},
+		{"delete", "DELETE FROM orders WHERE id IN (SELECT id FROM orders) RETURNING *",
+			"WITH " + orders + " DELETE FROM orders WHERE (owner = current_user()) AND ( id IN (SELECT id FROM orders)) RETURNING *"},
+		{"multiple statements", "SELECT 1; DELETE FROM notes",
+			"SELECT 1; WITH " + notes + " DELETE FROM notes WHERE (shared OR author = current_user())"},
+		{"explain", "EXPLAIN QUERY PLAN SELECT * FROM orders", "EXPLAIN QUERY PLAN SELECT * FROM orders"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ApplyPolicies(tt.sql, policies)
+			if tt.want == "" {
+				if !errors.Is(err, ErrRowSecurity) {
+					t.Fatalf("ApplyPolicies(%q) error = %v, want ErrRowSecurity", tt.sql, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ApplyPolicies(%q) error = %v", tt.sql, err)
+			}
+			if got != tt.want {
+				t.Errorf("ApplyPolicies(%q)\n got %q\nwant %q", tt.sql, got, tt.want)
+			}
+		})
+	}
+
+	for _, sql := range []string{
+		"SELECT * FROM main.orders",
+		`SELECT * FROM "main"."orders"`,
+		"REPLACE INTO orders VALUES (1)",
+		"INSERT OR REPLACE INTO orders VALUES (1)",
+		"UPDATE OR REPLACE orders SET id = 2",
+		"INSERT INTO orders VALUES (1) ON CONFLICT (id) DO UPDATE SET total = 0",
+		"CREATE VIEW all_orders AS SELECT * FROM orders",
+		"CREATE TABLE copy AS SELECT * FROM orders",
+		"DROP TABLE orders",
+	} {
+		if _, err := ApplyPolicies(sql, policies); !errors.Is(err, ErrRowSecurity) {
+			t.Errorf("ApplyPolicies(%q) error = %v, want ErrRowSecurity", sql, err)
+		}
+	}
+
+	if got, _ := ApplyPolicies("SELECT * FROM orders", nil); got != "SELECT * FROM orders" {
+		t.Errorf("no policies changed the statement: %q", got)
+	}
+}
+
+func TestPolicyBypasses(t *testing.T) {
+	policies := map[string]string{"orders": "owner = current_user()"}
+	objects := []SchemaObject{
+		{Type: "view", Name: "big_orders", Table: "big_orders", SQL: "CREATE VIEW big_orders AS SELECT * FROM all_orders WHERE total > 10"},
+		{Type: "view", Name: "all_orders", Table: "all_orders", SQL: `CREATE VIEW "all_orders" AS SELECT * FROM "Orders"`},
+		{Type: "view", Name: "answer", Table: "answer", SQL: "CREATE VIEW answer AS SELECT 42"},
+		{Type: "trigger", Name: "copy", Table: "audit", SQL: "CREATE TRIGGER copy AFTER INSERT ON audit BEGIN INSERT INTO log SELECT * FROM big_orders; END"},
+		{Type: "trigger", Name: "chain", Table: "notes", SQL: "CREATE TRIGGER chain AFTER INSERT ON notes BEGIN INSERT INTO audit VALUES (new.x); END"},
+		{Type: "trigger", Name: "stamp", Table: "orders", SQL: "CREATE TRIGGER stamp AFTER UPDATE ON orders BEGIN INSERT INTO log VALUES (1); END"},
+	}
+	got := PolicyBypasses(objects, policies)
+	want := map[string]string{"ALL_ORDERS": "view", "BIG_ORDERS": "view", "AUDIT": "table", "NOTES": "table"}
+	if !maps.Equal(got, want) {
+		t.Errorf("PolicyBypasses() = %v, want %v", got, want)
+	}
+
+	for _, sql := range []string{
+		"SELECT * FROM all_orders",
+		"SELECT * FROM orders JOIN main.big_orders USING (id)",
+		"INSERT INTO audit VALUES (1)",
+		"WITH x AS (SELECT 1) INSERT INTO notes SELECT * FROM x",
+		"DELETE FROM audit",
+		"CREATE TEMP VIEW mine AS SELECT * FROM big_orders",
+		"CREATE TRIGGER t AFTER INSERT ON answer BEGIN INSERT INTO notes VALUES (1); END",
+	} {
+		if err := CheckBypasses(sql, got); !errors.Is(err, ErrRowSecurity) {
+			t.Errorf("CheckBypasses(%q) error = %v, want ErrRowSecurity", sql, err)
+		}
+	}
+	for _, sql := range []string{
+		"SELECT * FROM orders",
+		"SELECT * FROM answer",
+		"SELECT * FROM audit JOIN notes",
+		"SELECT * FROM other.all_orders",
+		"UPDATE orders SET total = 0",
+	} {
+		if err := CheckBypasses(sql, got); err != nil {
+			t.Errorf("CheckBypasses(%q) = %v", sql, err)
+		}
+	}
+}
+
+func TestCheckPredicate(t *testing.T) {
+	for _, ok := range []string{
+		"owner = current_user()",
+		"tenant IN (SELECT tenant FROM members WHERE user = current_user()) OR current_role() = 'database_manager'",
+		"note <> ';'",
+	} {
+		if err := CheckPredicate(ok); err != nil {
+			t.Errorf("CheckPredicate(%q) = %v", ok, err)
+		}
+	}
+	for _, bad := range []string{"", "  -- nothing", "owner = ?", "owner = :me", "owner = @me", "owner = $me", "1); DROP TABLE x; (1", "(1", "1)"} {
+		if err := CheckPredicate(bad); err == nil {
+			t.Errorf("CheckPredicate(%q) = nil, want error", bad)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package sqldrivers
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// rowPolicyTables maps database names to the lower-case names of their tables that
+// have row-level security policies.
+var rowPolicyTables sync.Map
+
+// SetRowPolicyTables replaces the tables of a database that have row policies. The
+// server applies those policies by rewriting statements, which only covers the
+// tables of the connection's own database, so the authorizer refuses access to
+// them through an attachment instead.
+func SetRowPolicyTables(database string, tables []string) {
+	if len(tables) == 0 {
+		rowPolicyTables.Delete(database)
+		return
+	}
+	set := make(map[string]struct{}, len(tables))
+	for _, table := range tables {
+		set[strings.ToLower(table)] = struct{}{}
+	}
+	rowPolicyTables.Store(database, set)
+}
+
+// tableAccess is the authorizer result for action on table (and column, for reads)
+// in schema by session. schemas maps the schema names of the connection, main and
+// the aliases of its attachments, to the databases they hold; the column policies
+// and row policy tables of that database apply.
+func tableAccess(schemas map[string]string, session *Session, action int, table, column, schema string) int {
+	switch action {
+	case sqlite3.SQLITE_READ, sqlite3.SQLITE_INSERT, sqlite3.SQLITE_UPDATE, sqlite3.SQLITE_DELETE:
+	default:
+		return sqlite3.SQLITE_OK
+	}
+	if schema == "" {
+		// SQLite leaves out the schema of a table that is only counted when the
+		// statement does not name one, so it may be in any attachment
+		for name, database := range schemas {
+			if name != "main" && rowPolicyTable(database, session.on(database, true), table) {
+				return sqlite3.SQLITE_DENY
+			}
+		}
+		return sqlite3.SQLITE_OK
+	}
+	database, ok := schemas[schema]
+	if !ok {
+		return sqlite3.SQLITE_OK // temp
+	}
+	attached := schema != "main"
+	session = session.on(database, attached)
+
+	if attached {
+		// Callers without a role on the attached database cannot use it at all
+		if session != nil && (session.Role == "" || session.Role == "unspecified") {
+			return sqlite3.SQLITE_DENY
+		}
+		if rowPolicyTable(database, session, table) {
+			return sqlite3.SQLITE_DENY
+		}
+	}
+	if action == sqlite3.SQLITE_READ {
+		return columnAccess(database, session, table, column)
+	}
+	return sqlite3.SQLITE_OK
+}
+
+// rowPolicyTable reports whether table of database has row policies that apply to
+// session, which they do for everyone but admins.
+func rowPolicyTable(database string, session *Session, table string) bool {
+	if session != nil && session.Role == "admin" {
+		return false
+	}
+	value, ok := rowPolicyTables.Load(database)
+	if !ok {
+		return false
+	}
+	_, ok = value.(map[string]struct{})[strings.ToLower(table)]
+	return ok
+}
@@ -0,0 +1,90 @@
+package sqldrivers
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// ColumnRule denies or masks reads of Table.Column for the callers whose session has
+// Role, or for User. Exactly one of Role and User is set.
+type ColumnRule struct {
+	Table  string
+	Column string
+	Mask   bool   // Read the column as NULL instead of failing the statement
+	Role   string // As reported by current_role(), e.g. "read_only"
+	User   string
+}
+
+type columnKey struct {
+	table, column string
+}
+
+// columnRules maps database names to their rules by lower-case table and column.
+var columnRules sync.Map
+
+// SetColumnRules replaces the column rules of a database. They apply to statements
+// prepared afterwards on every connection of the database.
+func SetColumnRules(database string, rules []ColumnRule) {
+	if len(rules) == 0 {
+		columnRules.Delete(database)
+		return
+	}
+	index := make(map[columnKey][]ColumnRule, len(rules))
+	for _, r := range rules {
+		key := columnKey{strings.ToLower(r.Table), strings.ToLower(r.Column)}
+		index[key] = append(index[key], r)
+	}
+	columnRules.Store(database, index)
+}
+
+// ColumnRulesApply reports whether any column rule of database restricts session.
+// Like the authorizer, it treats the zero Session as restricted by every rule.
+func ColumnRulesApply(database string, session Session) bool {
+	if session.Role == "admin" {
+		return false
+	}
+	value, ok := columnRules.Load(database)
+	if !ok {
+		return false
+	}
+	unbound := session.User == "" && session.Role == ""
+	for _, rules := range value.(map[columnKey][]ColumnRule) {
+		for _, r := range rules {
+			if unbound || (r.Role != "" && r.Role == session.Role) || (r.User != "" && r.User == session.User) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// columnAccess is the authorizer result for a read of table.column on database by
+// session: SQLITE_DENY if a matching rule denies it, SQLITE_IGNORE (read as NULL) if
+// one masks it. Admins are not restricted. Connections without a session cannot
+// read columns that have any rule, so a path that forgets to bind one fails closed.
+func columnAccess(database string, session *Session, table, column string) int {
+	if session != nil && session.Role == "admin" {
+		return sqlite3.SQLITE_OK
+	}
+	value, ok := columnRules.Load(database)
+	if !ok {
+		return sqlite3.SQLITE_OK
+	}
+	rules := value.(map[columnKey][]ColumnRule)[columnKey{strings.ToLower(table), strings.ToLower(column)}]
+	if session == nil && len(rules) > 0 {
+		return sqlite3.SQLITE_DENY
+	}
+	result := sqlite3.SQLITE_OK
+	for _, r := range rules {
+		if (r.Role == "" || r.Role != session.Role) && (r.User == "" || r.User != session.User) {
+			continue
+		}
+		if !r.Mask {
+			return sqlite3.SQLITE_DENY
+		}
+		result = sqlite3.SQLITE_IGNORE
+	}
+	return result
+}
@@ -0,0 +1,73 @@
+package sqldrivers
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+)
+
+func TestColumnRules(t *testing.T) {
+	db, err := NewSqliteDb(&sqlrpcv1.DatabaseConfig{Name: "columns", DbPath: filepath.Join(t.TempDir(), "columns.db")}, false)
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+
+	_, err = db.Exec(`CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT, password_hash TEXT, ssn TEXT);
+		INSERT INTO users VALUES (1, 'alice', 'secret', '123-45-6789')`)
+	require.NoError(t, err)
+
+	SetColumnRules("columns", []ColumnRule{
+		{Table: "users", Column: "password_hash", Role: "read_only"},
+		{Table: "Users", Column: "SSN", Mask: true, Role: "read_only"},
+		{Table: "users", Column: "ssn", Mask: true, User: "bob"},
+	})
+	t.Cleanup(func() { SetColumnRules("columns", nil) })
+
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+	defer conn.Close()
+	as := func(user, role string) {
+		require.NoError(t, SetSession(ctx, conn, Session{User: user, Role: role}))
+	}
+	ssn := func() sql.NullString {
+		var v sql.NullString
+		require.NoError(t, conn.QueryRowContext(ctx, "SELECT ssn FROM users").Scan(&v))
+		return v
+	}
+
+	as("carol", "read_only")
+	_, err = conn.ExecContext(ctx, "SELECT password_hash FROM users")
+	assert.ErrorContains(t, err, "prohibited")
+	_, err = conn.ExecContext(ctx, "SELECT * FROM users")
+	assert.ErrorContains(t, err, "prohibited", "* reads every column")
+	_, err = conn.ExecContext(ctx, "SELECT id FROM users WHERE password_hash = 'secret'")
+	assert.Error(t, err, "filtering on a denied column is a read too")
+	assert.False(t, ssn().Valid, "masked columns read as NULL")
+
+	var name string
+	require.NoError(t, conn.QueryRowContext(ctx, "SELECT name FROM users").Scan(&name))
+	assert.Equal(t, "alice", name)
+
+	as("bob", "read_write")
+	assert.False(t, ssn().Valid, "user rules apply whatever the role")
+	_, err = conn.ExecContext(ctx, "SELECT password_hash FROM users")
+	assert.NoError(t, err)
+
+	as("dave", "read_write")
+	assert.Equal(t, "123-45-6789", ssn().String)
+
+	as("bob", "admin")
+	assert.Equal(t, "123-45-6789", ssn().String, "admins are never restricted")
+
+	require.NoError(t, SetSession(ctx, conn, Session{}))
+	_, err = conn.ExecContext(ctx, "SELECT ssn FROM users")
+	assert.ErrorContains(t, err, "prohibited", "connections without a session cannot read restricted columns")
+	require.NoError(t, conn.QueryRowContext(ctx, "SELECT name FROM users").Scan(&name))
+	assert.Equal(t, "alice", name, "nor only those")
+}
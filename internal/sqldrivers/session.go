@@ -0,0 +1,92 @@
+package sqldrivers
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"sync/atomic"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// sessionSecret authorizes sqlrpc_set_session. It never leaves the process, so SQL
+// sent by clients cannot change the identity its connection reports.
+var sessionSecret = rand.Text()
+
+// Session is the identity reported by current_user() and current_role().
+type Session struct {
+	User string
+	Role string // Lower case without the ROLE_ prefix, e.g. "read_write"
+
+	// Roles holds the roles on the databases attached to the connection, by
+	// database name. Attached databases without one are not readable.
+	Roles map[string]string
+}
+
+// on returns the session as it applies to database, which is the connection's own
+// database unless attached is set. It is nil when s is.
+func (s *Session) on(database string, attached bool) *Session {
+	if s == nil || !attached {
+		return s
+	}
+	return &Session{User: s.User, Role: s.Roles[database]}
+}
+
+// Execer is implemented by *sql.DB, *sql.Conn and *sql.Tx.
+type Execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// SetSession sets the identity of the connection behind q. q must be pinned to one
+// connection (a *sql.Conn or *sql.Tx); the zero Session resets it to NULL.
+func SetSession(ctx context.Context, q Execer, s Session) error {
+	roles, err := json.Marshal(s.Roles)
+	if err != nil {
+		return err
+	}
+	_, err = q.ExecContext(ctx, "SELECT sqlrpc_set_session(?, ?, ?, ?)", sessionSecret, s.User, s.Role, string(roles))
+	return err
+}
+
+// registerSessionFuncs adds current_user(), current_role() and the setter used by
+// SetSession to conn. Each connection has its own session, nil (NULL) until set.
+func registerSessionFuncs(conn *sqlite3.SQLiteConn) (*atomic.Pointer[Session], error) {
+	session := new(atomic.Pointer[Session])
+	if err := conn.RegisterFunc("sqlrpc_set_session", func(secret, user, role, roles string) (int64, error) {
+		if secret != sessionSecret {
+			return 0, errors.New("sqlrpc_set_session is reserved for the server")
+		}
+		if user == "" && role == "" {
+			session.Store(nil)
+			return 1, nil
+		}
+		s := &Session{User: user, Role: role}
+		if err := json.Unmarshal([]byte(roles), &s.Roles); err != nil {
+			return 0, err
+		}
+		session.Store(s)
+		return 1, nil
+	}, false); err != nil {
+		return nil, err
+	}
+	// Not pure: the result changes when the connection is handed to another caller
+	if err := conn.RegisterFunc("current_user", func() any {
+		if s := session.Load(); s != nil {
+			return s.User
+		}
+		return nil
+	}, false); err != nil {
+		return nil, err
+	}
+	if err := conn.RegisterFunc("current_role", func() any {
+		if s := session.Load(); s != nil {
+			return s.Role
+		}
+		return nil
+	}, false); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
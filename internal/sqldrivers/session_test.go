@@ -0,0 +1,51 @@
+package sqldrivers
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+)
+
+func TestSetSession(t *testing.T) {
+	db, err := NewSqliteDb(&sqlrpcv1.DatabaseConfig{Name: "session", DbPath: filepath.Join(t.TempDir(), "session.db")}, false)
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+
+	current := func(conn *sql.Conn) (user, role sql.NullString) {
+		require.NoError(t, conn.QueryRowContext(ctx, "SELECT current_user(), current_role()").Scan(&user, &role))
+		return user, role
+	}
+
+	a, err := db.Conn(ctx)
+	require.NoError(t, err)
+	defer a.Close()
+	b, err := db.Conn(ctx)
+	require.NoError(t, err)
+	defer b.Close()
+
+	user, role := current(a)
+	assert.False(t, user.Valid, "no session until one is set")
+	assert.False(t, role.Valid)
+
+	require.NoError(t, SetSession(ctx, a, Session{User: "alice", Role: "read_write"}))
+	user, role = current(a)
+	assert.Equal(t, "alice", user.String)
+	assert.Equal(t, "read_write", role.String)
+
+	user, _ = current(b)
+	assert.False(t, user.Valid, "sessions are per connection")
+
+	require.NoError(t, SetSession(ctx, a, Session{}))
+	user, _ = current(a)
+	assert.False(t, user.Valid)
+
+	_, err = a.ExecContext(ctx, "SELECT sqlrpc_set_session('guess', 'mallory', 'admin', 'null')")
+	assert.ErrorContains(t, err, "reserved for the server")
+}
@@ -4,7 +4,6 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"fmt"
-	"log"
 	"os"
 	"path/filepath"
 	"strings"
@@ -14,6 +13,7 @@ import (
 	"github.com/mattn/go-sqlite3"
 
 	"sqlite-server/internal/extensions"
+	"sqlite-server/internal/logging"
 	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
 	"sqlite-server/internal/pubsub"
 )
@@ -30,6 +30,7 @@ var (
 // AttachmentInfo represents a resolved database attachment.
 type AttachmentInfo struct {
 	Alias    string
+	Database string // Name of the attached database, whose policies apply to Alias
 	Path     string
 	Key      string
 	ReadOnly bool
@@ -43,8 +44,9 @@ func NewSqliteDbWithAttachments(config *sqlrpcv1.DatabaseConfig, readOnlySecured
 	// Resolve absolute path to avoid issues with relative paths in URI mode (file:...) via CGO
 	// Skip for in-memory databases and pre-formatted URIs.
 	if config.DbPath != ":memory:" && !strings.HasPrefix(config.DbPath, "file:") {
+		// Only write when it changes: configs are shared by concurrently opening pools
 		absPath, err := filepath.Abs(config.DbPath)
-		if err == nil {
+		if err == nil && absPath != config.DbPath {
 			config.DbPath = absPath
 		}
 	}
@@ -84,146 +86,153 @@ func NewSqliteDbWithAttachments(config *sqlrpcv1.DatabaseConfig, readOnlySecured
 		dsn += "&mode=ro"
 	}
 
-	// Determine which driver to use.
-	// Default is the standard "sqlite3" driver registered by the init() of the library.
-	driverName := "sqlite3"
-
-	// Handle Extensions, InitCommands, or GlobalBroker Hooks
-	// If extensions are specified OR InitCommands are present OR we need to inject Pub/Sub hooks,
-	// we must create and register a unique driver instance for this database configuration
-	// because these are properties of the driver/connection, not just the DSN.
-	if len(config.Extensions) > 0 || readOnlySecured || len(config.InitCommands) > 0 || len(attachments) > 0 || GlobalBroker != nil {
-		// Generate a unique driver name for this specific DB configuration to avoid collisions.
-		suffix := ""
-		if readOnlySecured {
-			suffix = "_ro"
-		}
-		// Use UnixNano to ensure unique driver registration for fresh ConnectHook on every reload
-		driverName = fmt.Sprintf("sqlite3_ext_%s%s_%d", config.Name, suffix, time.Now().UnixNano())
-
-		// Resolve Extensions
-		var resolvedExtensions []string
-		for _, ext := range config.Extensions {
-			if filepath.IsAbs(ext) {
-				resolvedExtensions = append(resolvedExtensions, ext)
+	// Every connection needs the session functions (current_user() and friends), and
+	// extensions, init commands and Pub/Sub hooks are properties of the
+	// driver/connection rather than the DSN, so we always create and register a
+	// unique driver instance for this database configuration.
+	// Generate a unique driver name for this specific DB configuration to avoid collisions.
+	suffix := ""
+	if readOnlySecured {
+		suffix = "_ro"
+	}
+	// Use UnixNano to ensure unique driver registration for fresh ConnectHook on every reload
+	driverName := fmt.Sprintf("sqlite3_ext_%s%s_%d", config.Name, suffix, time.Now().UnixNano())
+
+	// Resolve Extensions
+	var resolvedExtensions []string
+	for _, ext := range config.Extensions {
+		if filepath.IsAbs(ext) {
+			resolvedExtensions = append(resolvedExtensions, ext)
+		} else {
+			// Try to resolve as a managed extension first
+			absPath, err := extensions.ResolveExtensionPath(ext)
+			if err == nil {
+				resolvedExtensions = append(resolvedExtensions, absPath)
 			} else {
-				// Try to resolve as a managed extension first
-				absPath, err := extensions.ResolveExtensionPath(ext)
-				if err == nil {
-					resolvedExtensions = append(resolvedExtensions, absPath)
-				} else {
-					// Fallback to relative path from SQLITE_SERVER_EXTENSIONS dir as before
-					extDir := os.Getenv("SQLITE_SERVER_EXTENSIONS")
-					if extDir == "" {
-						extDir = "./extensions"
-					}
-					resolvedExtensions = append(resolvedExtensions, filepath.Join(extDir, ext))
+				// Fallback to relative path from SQLITE_SERVER_EXTENSIONS dir as before
+				extDir := os.Getenv("SQLITE_SERVER_EXTENSIONS")
+				if extDir == "" {
+					extDir = "./extensions"
 				}
+				resolvedExtensions = append(resolvedExtensions, filepath.Join(extDir, ext))
 			}
 		}
+	}
 
-		// Register the custom driver ONLY if it hasn't been registered yet.
-		registeredDriversMu.Lock()
-		if !registeredDrivers[driverName] {
-			sql.Register(driverName, &sqlite3.SQLiteDriver{
-				Extensions: resolvedExtensions,
-				ConnectHook: func(conn *sqlite3.SQLiteConn) error {
-					if readOnlySecured {
-						// Register Authorizer to deny writes
-						conn.RegisterAuthorizer(func(action int, arg1, arg2, arg3 string) int {
-							return ReadOnlyAuthorizer(action, arg1, arg2, arg3, "")
-						})
-					}
+	// Register the custom driver ONLY if it hasn't been registered yet.
+	registeredDriversMu.Lock()
+	if !registeredDrivers[driverName] {
+		sql.Register(driverName, &sqlite3.SQLiteDriver{
+			Extensions: resolvedExtensions,
+			ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+				session, err := registerSessionFuncs(conn)
+				if err != nil {
+					return fmt.Errorf("failed to register session functions: %w", err)
+				}
 
-					if GlobalBroker != nil {
-						// Register the scalar 'publish(channel, payload)' SQL function.
-						// This allows writing to the broker directly from SQLite triggers or queries.
-						// It returns the ID of the newly inserted message, or 0 on failure.
-						err := conn.RegisterFunc("publish", func(channelName, messagePayload string) int64 {
-							publishedIDs := GlobalBroker.Publish(config.Name, []pubsub.MsgPayload{{Channel: channelName, Payload: messagePayload}})
-							if len(publishedIDs) == 0 {
-								return 0
-							}
-							return publishedIDs[0]
-						}, true)
-						if err != nil {
-							log.Printf("[Broker] Failed to register publish func: %v", err)
+				// Deny writes on read-only pools and apply the column policies of the
+				// caller bound to the connection, in main and in every attachment
+				schemas := map[string]string{"main": config.Name}
+				for _, adb := range attachments {
+					schemas[adb.Alias] = adb.Database
+				}
+				conn.RegisterAuthorizer(func(action int, arg1, arg2, arg3 string) int {
+					if readOnlySecured {
+						if result := ReadOnlyAuthorizer(action, arg1, arg2, arg3, ""); result != sqlite3.SQLITE_OK {
+							return result
 						}
+					}
+					return tableAccess(schemas, session.Load(), action, arg1, arg2, arg3)
+				})
 
-						// Register the aggregate 'publish_batch(channel, payload)' SQL function.
-						// This is optimized for high-throughput scenarios where multiple messages
-						// are published within a single `INSERT ... SELECT` statement.
-						err = conn.RegisterAggregator("publish_batch", func() *BatchAggregator {
-							return &BatchAggregator{broker: GlobalBroker, dbName: config.Name}
-						}, true)
-						if err != nil {
-							log.Printf("[Broker] Failed to register aggregator: %v", err)
+				if GlobalBroker != nil {
+					// Register the scalar 'publish(channel, payload)' SQL function.
+					// This allows writing to the broker directly from SQLite triggers or queries.
+					// It returns the ID of the newly inserted message, or 0 on failure.
+					err := conn.RegisterFunc("publish", func(channelName, messagePayload string) int64 {
+						publishedIDs := GlobalBroker.Publish(config.Name, []pubsub.MsgPayload{{Channel: channelName, Payload: messagePayload}})
+						if len(publishedIDs) == 0 {
+							return 0
 						}
+						return publishedIDs[0]
+					}, true)
+					if err != nil {
+						logging.For(logging.PubSub).Error("Failed to register publish function", logging.Err(err))
+					}
 
-						// Register the 'vpubsub' Virtual Table if supported.
-						// This allows querying the Pub/Sub message history using standard SQL (SELECT * FROM...).
-						// NOTE: Requires the `sqlite_vtable` build tag to be included during compilation.
-						registerVPubSub(conn, config.Name)
-						// Automatically instantiate the virtual table named `vpubsub` so users don't have to manually create it
-						_, _ = conn.Exec("CREATE VIRTUAL TABLE IF NOT EXISTS vpubsub USING vpubsub;", nil)
+					// Register the aggregate 'publish_batch(channel, payload)' SQL function.
+					// This is optimized for high-throughput scenarios where multiple messages
+					// are published within a single `INSERT ... SELECT` statement.
+					err = conn.RegisterAggregator("publish_batch", func() *BatchAggregator {
+						return &BatchAggregator{broker: GlobalBroker, dbName: config.Name}
+					}, true)
+					if err != nil {
+						logging.For(logging.PubSub).Error("Failed to register publish aggregator", logging.Err(err))
 					}
 
-					// Execute Init Commands (e.g., PRAGMA)
-					for _, cmd := range config.InitCommands {
-						if _, err := conn.Exec(cmd, nil); err != nil {
-							return fmt.Errorf("failed to execute init command '%s': %w", cmd, err)
-						}
+					// Register the 'vpubsub' Virtual Table if supported.
+					// This allows querying the Pub/Sub message history using standard SQL (SELECT * FROM...).
+					// NOTE: Requires the `sqlite_vtable` build tag to be included during compilation.
+					registerVPubSub(conn, config.Name)
+					// Automatically instantiate the virtual table named `vpubsub` so users don't have to manually create it
+					_, _ = conn.Exec("CREATE VIRTUAL TABLE IF NOT EXISTS vpubsub USING vpubsub;", nil)
+				}
+
+				// Execute Init Commands (e.g., PRAGMA)
+				for _, cmd := range config.InitCommands {
+					if _, err := conn.Exec(cmd, nil); err != nil {
+						return fmt.Errorf("failed to execute init command '%s': %w", cmd, err)
 					}
+				}
 
-					// Attach additional databases
-					for _, adb := range attachments {
-						path := adb.Path
-						// Skip for in-memory databases and pre-formatted URIs.
-						if adb.Path != ":memory:" && !strings.HasPrefix(adb.Path, "file:") {
-							absPath, err := filepath.Abs(adb.Path)
-							if err == nil {
-								path = absPath
-							}
+				// Attach additional databases
+				for _, adb := range attachments {
+					path := adb.Path
+					// Skip for in-memory databases and pre-formatted URIs.
+					if adb.Path != ":memory:" && !strings.HasPrefix(adb.Path, "file:") {
+						absPath, err := filepath.Abs(adb.Path)
+						if err == nil {
+							path = absPath
 						}
+					}
 
-						// Construct URI if not already one
-						var dsn string
-						if strings.HasPrefix(path, "file:") {
-							dsn = path
-						} else {
-							dsn = fmt.Sprintf("file:%s", path)
-						}
+					// Construct URI if not already one
+					var dsn string
+					if strings.HasPrefix(path, "file:") {
+						dsn = path
+					} else {
+						dsn = fmt.Sprintf("file:%s", path)
+					}
 
-						// Force mode=ro if main connection is RO or adb is marked RO
-						if readOnlySecured || adb.ReadOnly {
-							sep := "?"
-							if strings.Contains(dsn, "?") {
-								sep = "&"
-							}
-							dsn += sep + "mode=ro"
+					// Force mode=ro if main connection is RO or adb is marked RO
+					if readOnlySecured || adb.ReadOnly {
+						sep := "?"
+						if strings.Contains(dsn, "?") {
+							sep = "&"
 						}
+						dsn += sep + "mode=ro"
+					}
 
-						// Handle Encryption
-						if adb.Key != "" {
-							sep := "?"
-							if strings.Contains(dsn, "?") {
-								sep = "&"
-							}
-							dsn += sep + "_key=" + adb.Key
+					// Handle Encryption
+					if adb.Key != "" {
+						sep := "?"
+						if strings.Contains(dsn, "?") {
+							sep = "&"
 						}
+						dsn += sep + "_key=" + adb.Key
+					}
 
-						attachCmd := fmt.Sprintf("ATTACH DATABASE '%s' AS '%s'", dsn, adb.Alias)
-						if _, err := conn.Exec(attachCmd, []driver.Value{}); err != nil {
-							return fmt.Errorf("failed to attach database '%s' (%s): %w", adb.Alias, adb.Path, err)
-						}
+					attachCmd := fmt.Sprintf("ATTACH DATABASE '%s' AS '%s'", dsn, adb.Alias)
+					if _, err := conn.Exec(attachCmd, []driver.Value{}); err != nil {
+						return fmt.Errorf("failed to attach database '%s' (%s): %w", adb.Alias, adb.Path, err)
 					}
-					return nil
-				},
-			})
-			registeredDrivers[driverName] = true
-		}
-		registeredDriversMu.Unlock()
+				}
+				return nil
+			},
+		})
+		registeredDrivers[driverName] = true
 	}
+	registeredDriversMu.Unlock()
 
 	// Loop over the map and append to DSN
 	for key, val := range config.Pragmas {
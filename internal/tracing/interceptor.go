@@ -0,0 +1,120 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+)
+
+// NewInterceptor returns a connect interceptor that continues the caller's trace from
+// the incoming traceparent header and wraps every handled RPC in a server span.
+func NewInterceptor() connect.Interceptor {
+	return &interceptor{}
+}
+
+type interceptor struct{}
+
+func (i *interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		ctx, span := startServerSpan(ctx, req.Spec().Procedure, req.Header())
+		setDatabase(span, req.Any())
+
+		resp, err := next(ctx, req)
+		endServerSpan(span, err)
+		return resp, err
+	}
+}
+
+func (i *interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, span := startServerSpan(ctx, conn.Spec().Procedure, conn.RequestHeader())
+		err := next(ctx, &streamConn{StreamingHandlerConn: conn, span: span})
+		endServerSpan(span, err)
+		return err
+	}
+}
+
+// streamConn tags the span with the database named by the first request message.
+type streamConn struct {
+	connect.StreamingHandlerConn
+	span   trace.Span
+	tagged bool
+}
+
+func (c *streamConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	if !c.tagged {
+		c.tagged = setDatabase(c.span, msg)
+	}
+	return nil
+}
+
+func startServerSpan(ctx context.Context, procedure string, header http.Header) (context.Context, trace.Span) {
+	ctx = propagator.Extract(ctx, propagation.HeaderCarrier(header))
+	name := strings.TrimPrefix(procedure, "/")
+	service, method, _ := strings.Cut(name, "/")
+	return Tracer().Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "connect_rpc"),
+			attribute.String("rpc.service", service),
+			attribute.String("rpc.method", method),
+		),
+	)
+}
+
+func endServerSpan(span trace.Span, err error) {
+	if err != nil {
+		code := connect.CodeUnknown
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			code = connectErr.Code()
+		}
+		span.SetAttributes(attribute.String("rpc.connect_rpc.error_code", code.String()))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// setDatabase records the database a request message targets, if it names one.
+func setDatabase(span trace.Span, msg any) bool {
+	var name string
+	switch req := msg.(type) {
+	case *sqlrpcv1.AttachDatabaseRequest:
+		name = req.ParentDatabase
+	case *sqlrpcv1.DetachDatabaseRequest:
+		name = req.ParentDatabase
+	case *sqlrpcv1.TransactionRequest:
+		name = req.GetBegin().GetDatabase()
+	case *sqlrpcv1.ExecuteTransactionRequest:
+		if len(req.Requests) > 0 {
+			name = req.Requests[0].GetBegin().GetDatabase()
+		}
+	case interface{ GetDatabase() string }:
+		name = req.GetDatabase()
+	}
+	if name == "" {
+		return false
+	}
+	span.SetAttributes(attribute.String("db.namespace", name))
+	return true
+}
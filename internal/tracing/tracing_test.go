@@ -0,0 +1,123 @@
+package tracing
+
+import (
+	"context"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	sqlrpcv1 "sqlite-server/internal/protos/sqlrpc/v1"
+)
+
+const remoteParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+	return recorder
+}
+
+func TestSetup(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		shutdown, err := Setup(context.Background(), Config{Exporter: ExporterNone})
+		require.NoError(t, err)
+		assert.NoError(t, shutdown(context.Background()))
+	})
+
+	t.Run("unknown exporter", func(t *testing.T) {
+		_, err := Setup(context.Background(), Config{Exporter: "zipkin"})
+		assert.ErrorContains(t, err, "unknown trace exporter")
+	})
+
+	t.Run("file exporter", func(t *testing.T) {
+		t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+		path := filepath.Join(t.TempDir(), "traces.jsonl")
+		shutdown, err := Setup(context.Background(), Config{Exporter: ExporterFile, File: path, ServiceVersion: "test"})
+		require.NoError(t, err)
+
+		_, span := Tracer().Start(context.Background(), "unit")
+		span.End()
+		require.NoError(t, shutdown(context.Background()))
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), `"Name":"unit"`)
+	})
+}
+
+func TestTraceParent(t *testing.T) {
+	assert.Empty(t, TraceParent(context.Background()))
+	assert.Equal(t, context.Background(), ContextWithTraceParent(context.Background(), ""))
+
+	ctx := ContextWithTraceParent(context.Background(), remoteParent)
+	assert.Equal(t, remoteParent, TraceParent(ctx))
+}
+
+func TestStartChild(t *testing.T) {
+	recorder := recordSpans(t)
+
+	_, span := StartChild(context.Background(), "orphan")
+	span.End()
+	assert.Empty(t, recorder.Ended(), "spans are not started without a parent")
+
+	ctx, parent := Tracer().Start(context.Background(), "parent")
+	_, child := StartChild(ctx, "child")
+	EndSpan(child, assert.AnError)
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "child", spans[0].Name())
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Equal(t, "Error", spans[0].Status().Code.String())
+}
+
+func TestInterceptor(t *testing.T) {
+	recorder := recordSpans(t)
+
+	var handlerSpan trace.SpanContext
+	unary := connect.NewUnaryHandler("/sqlrpc.v1.DatabaseService/ListTables",
+		func(ctx context.Context, req *connect.Request[sqlrpcv1.ListTablesRequest]) (*connect.Response[emptypb.Empty], error) {
+			handlerSpan = trace.SpanContextFromContext(ctx)
+			return nil, connect.NewError(connect.CodeNotFound, assert.AnError)
+		},
+		connect.WithInterceptors(NewInterceptor()),
+	)
+	ts := httptest.NewServer(unary)
+	t.Cleanup(ts.Close)
+
+	client := connect.NewClient[sqlrpcv1.ListTablesRequest, emptypb.Empty](ts.Client(), ts.URL+"/sqlrpc.v1.DatabaseService/ListTables")
+	req := connect.NewRequest(&sqlrpcv1.ListTablesRequest{Database: "main"})
+	req.Header().Set("traceparent", remoteParent)
+	_, err := client.CallUnary(context.Background(), req)
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "sqlrpc.v1.DatabaseService/ListTables", span.Name())
+	assert.Equal(t, trace.SpanKindServer, span.SpanKind())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String())
+	assert.True(t, span.Parent().IsRemote())
+	assert.Equal(t, span.SpanContext(), handlerSpan)
+
+	attrs := map[string]string{}
+	for _, kv := range span.Attributes() {
+		attrs[string(kv.Key)] = kv.Value.Emit()
+	}
+	assert.Equal(t, "ListTables", attrs["rpc.method"])
+	assert.Equal(t, "main", attrs["db.namespace"])
+	assert.Equal(t, "not_found", attrs["rpc.connect_rpc.error_code"])
+}
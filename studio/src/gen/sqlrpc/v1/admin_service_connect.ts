@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateAPIKeyRequest, CreateAPIKeyResponse, CreateDatabaseRequest, CreateDatabaseResponse, CreateUserRequest, CreateUserResponse, DeleteAPIKeyRequest, DeleteAPIKeyResponse, DeleteDatabaseRequest, DeleteDatabaseResponse, DeleteUserRequest, DeleteUserResponse, GetServerInfoRequest, ListAPIKeysRequest, ListAPIKeysResponse, ListDatabasesRequest, ListDatabasesResponse, ListUsersRequest, ListUsersResponse, LoginRequest, LoginResponse, LogoutRequest, LogoutResponse, MountDatabaseRequest, MountDatabaseResponse, ServerInfo, UnMountDatabaseRequest, UnMountDatabaseResponse, UpdateDatabaseRequest, UpdateDatabaseResponse, UpdatePasswordRequest, UpdatePasswordResponse, UpdateUserRoleRequest, UpdateUserRoleResponse } from "./admin_service_pb.js";
+import { CancelQueryRequest, CancelQueryResponse, CreateAPIKeyRequest, CreateAPIKeyResponse, CreateDatabaseRequest, CreateDatabaseResponse, CreateRowPolicyRequest, CreateRowPolicyResponse, CreateUserRequest, CreateUserResponse, DeleteAPIKeyRequest, DeleteAPIKeyResponse, DeleteColumnPolicyRequest, DeleteColumnPolicyResponse, DeleteDatabaseRequest, DeleteDatabaseResponse, DeleteRateLimitRequest, DeleteRateLimitResponse, DeleteRowPolicyRequest, DeleteRowPolicyResponse, DeleteUserRequest, DeleteUserResponse, ForceRollbackTransactionRequest, ForceRollbackTransactionResponse, GetLogLevelsRequest, GetLogLevelsResponse, GetServerInfoRequest, GrantDatabaseAccessRequest, GrantDatabaseAccessResponse, ListAPIKeysRequest, ListAPIKeysResponse, ListActiveOperationsRequest, ListActiveOperationsResponse, ListAuditEventsRequest, ListAuditEventsResponse, ListColumnPoliciesRequest, ListColumnPoliciesResponse, ListDatabaseGrantsRequest, ListDatabaseGrantsResponse, ListDatabasesRequest, ListDatabasesResponse, ListMaintenanceRunsRequest, ListMaintenanceRunsResponse, ListRateLimitsRequest, ListRateLimitsResponse, ListRowPoliciesRequest, ListRowPoliciesResponse, ListUsersRequest, ListUsersResponse, LoginRequest, LoginResponse, LogoutRequest, LogoutResponse, MountDatabaseRequest, MountDatabaseResponse, ReplicationFrame, RevokeDatabaseAccessRequest, RevokeDatabaseAccessResponse, ServerInfo, SetColumnPolicyRequest, SetColumnPolicyResponse, SetLogLevelRequest, SetLogLevelResponse, SetRateLimitRequest, SetRateLimitResponse, StreamReplicationRequest, UnMountDatabaseRequest, UnMountDatabaseResponse, UnlockUserRequest, UnlockUserResponse, UpdateDatabaseRequest, UpdateDatabaseResponse, UpdatePasswordRequest, UpdatePasswordResponse, UpdateUserRoleRequest, UpdateUserRoleResponse } from "./admin_service_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -70,6 +70,20 @@ export const AdminService = {
       O: DeleteUserResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * *
+     * User Management: Unlock.
+     * Clears the failed login attempts and lockout of a username and, optionally,
+     * of a client IP address.
+     *
+     * @generated from rpc sqlrpc.v1.AdminService.UnlockUser
+     */
+    unlockUser: {
+      name: "UnlockUser",
+      I: UnlockUserRequest,
+      O: UnlockUserResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * *
      * API Key Management: List.
@@ -109,6 +123,168 @@ export const AdminService = {
       O: DeleteAPIKeyResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * *
+     * Access Control: Grant.
+     * Assigns a per-database role to a user or a single API key. Granting the
+     * same database pattern again replaces the previous role.
+     *
+     * @generated from rpc sqlrpc.v1.AdminService.GrantDatabaseAccess
+     */
+    grantDatabaseAccess: {
+      name: "GrantDatabaseAccess",
+      I: GrantDatabaseAccessRequest,
+      O: GrantDatabaseAccessResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * *
+     * Access Control: Revoke.
+     * Removes a per-database grant immediately.
+     *
+     * @generated from rpc sqlrpc.v1.AdminService.RevokeDatabaseAccess
+     */
+    revokeDatabaseAccess: {
+      name: "RevokeDatabaseAccess",
+      I: RevokeDatabaseAccessRequest,
+      O: RevokeDatabaseAccessResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * *
+     * Access Control: List.
+     * Returns the per-database grants, optionally filtered by user or database.
+     *
+     * @generated from rpc sqlrpc.v1.AdminService.ListDatabaseGrants
+     */
+    listDatabaseGrants: {
+      name: "ListDatabaseGrants",
+      I: ListDatabaseGrantsRequest,
+      O: ListDatabaseGrantsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * *
+     * Rate Limits: Set.
+     * Caps the requests, concurrent streams and transactions, and returned rows
+     * and bytes of a user or a single API key on matching databases. Setting the
+     * same database pattern again replaces the previous limits.
+     *
+     * @generated from rpc sqlrpc.v1.AdminService.SetRateLimit
+     */
+    setRateLimit: {
+      name: "SetRateLimit",
+      I: SetRateLimitRequest,
+      O: SetRateLimitResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * *
+     * Rate Limits: Delete.
+     * Removes a rate limit immediately.
+     *
+     * @generated from rpc sqlrpc.v1.AdminService.DeleteRateLimit
+     */
+    deleteRateLimit: {
+      name: "DeleteRateLimit",
+      I: DeleteRateLimitRequest,
+      O: DeleteRateLimitResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * *
+     * Rate Limits: List.
+     * Returns the rate limits, optionally filtered by user or database.
+     *
+     * @generated from rpc sqlrpc.v1.AdminService.ListRateLimits
+     */
+    listRateLimits: {
+      name: "ListRateLimits",
+      I: ListRateLimitsRequest,
+      O: ListRateLimitsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * *
+     * Row Policies: Create.
+     * Restricts the rows of a table that non-admin callers can read, update and
+     * delete to those matching a predicate, which may call current_user() and
+     * current_role(). A row is visible when any policy of its table allows it.
+     *
+     * @generated from rpc sqlrpc.v1.AdminService.CreateRowPolicy
+     */
+    createRowPolicy: {
+      name: "CreateRowPolicy",
+      I: CreateRowPolicyRequest,
+      O: CreateRowPolicyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * *
+     * Row Policies: Delete.
+     * Removes a row policy immediately.
+     *
+     * @generated from rpc sqlrpc.v1.AdminService.DeleteRowPolicy
+     */
+    deleteRowPolicy: {
+      name: "DeleteRowPolicy",
+      I: DeleteRowPolicyRequest,
+      O: DeleteRowPolicyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * *
+     * Row Policies: List.
+     * Returns the row policies, optionally of a single database.
+     *
+     * @generated from rpc sqlrpc.v1.AdminService.ListRowPolicies
+     */
+    listRowPolicies: {
+      name: "ListRowPolicies",
+      I: ListRowPoliciesRequest,
+      O: ListRowPoliciesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * *
+     * Column Policies: Set.
+     * Denies or masks reads of a table column for a role or a single user on a
+     * database. Setting the same column and subject again replaces the action.
+     *
+     * @generated from rpc sqlrpc.v1.AdminService.SetColumnPolicy
+     */
+    setColumnPolicy: {
+      name: "SetColumnPolicy",
+      I: SetColumnPolicyRequest,
+      O: SetColumnPolicyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * *
+     * Column Policies: Delete.
+     * Removes a column policy immediately.
+     *
+     * @generated from rpc sqlrpc.v1.AdminService.DeleteColumnPolicy
+     */
+    deleteColumnPolicy: {
+      name: "DeleteColumnPolicy",
+      I: DeleteColumnPolicyRequest,
+      O: DeleteColumnPolicyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * *
+     * Column Policies: List.
+     * Returns the column policies, optionally of a single database.
+     *
+     * @generated from rpc sqlrpc.v1.AdminService.ListColumnPolicies
+     */
+    listColumnPolicies: {
+      name: "ListColumnPolicies",
+      I: ListColumnPoliciesRequest,
+      O: ListColumnPoliciesResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * *
      * Database Lifecycle: List.
@@ -188,6 +364,75 @@ export const AdminService = {
       O: UnMountDatabaseResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * *
+     * Maintenance: List runs.
+     * Returns the results of scheduled maintenance jobs, newest first.
+     *
+     * @generated from rpc sqlrpc.v1.AdminService.ListMaintenanceRuns
+     */
+    listMaintenanceRuns: {
+      name: "ListMaintenanceRuns",
+      I: ListMaintenanceRunsRequest,
+      O: ListMaintenanceRunsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * *
+     * Queries: Cancel.
+     * Interrupts the in-flight statements started by the request with the given
+     * X-Request-Id. The interrupted call fails with CANCELED.
+     *
+     * @generated from rpc sqlrpc.v1.AdminService.CancelQuery
+     */
+    cancelQuery: {
+      name: "CancelQuery",
+      I: CancelQueryRequest,
+      O: CancelQueryResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * *
+     * Queries: List active operations.
+     * Returns the statements running right now and every open transaction, both
+     * ID-based and bidirectional streams.
+     *
+     * @generated from rpc sqlrpc.v1.AdminService.ListActiveOperations
+     */
+    listActiveOperations: {
+      name: "ListActiveOperations",
+      I: ListActiveOperationsRequest,
+      O: ListActiveOperationsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * *
+     * Queries: Force rollback.
+     * Rolls back another caller's open transaction. Stream transactions end with
+     * ABORTED.
+     *
+     * @generated from rpc sqlrpc.v1.AdminService.ForceRollbackTransaction
+     */
+    forceRollbackTransaction: {
+      name: "ForceRollbackTransaction",
+      I: ForceRollbackTransactionRequest,
+      O: ForceRollbackTransactionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * *
+     * Audit: List events.
+     * Returns recorded administrative and data-changing operations, newest
+     * first.
+     *
+     * @generated from rpc sqlrpc.v1.AdminService.ListAuditEvents
+     */
+    listAuditEvents: {
+      name: "ListAuditEvents",
+      I: ListAuditEventsRequest,
+      O: ListAuditEventsResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * *
      * Platform Info.
@@ -201,6 +446,33 @@ export const AdminService = {
       O: ServerInfo,
       kind: MethodKind.Unary,
     },
+    /**
+     * *
+     * Logging: Get levels.
+     * Returns the default log level and the effective level of every subsystem.
+     *
+     * @generated from rpc sqlrpc.v1.AdminService.GetLogLevels
+     */
+    getLogLevels: {
+      name: "GetLogLevels",
+      I: GetLogLevelsRequest,
+      O: GetLogLevelsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * *
+     * Logging: Set level.
+     * Changes the default log level or overrides it for one subsystem. Changes
+     * take effect immediately and last until the server restarts.
+     *
+     * @generated from rpc sqlrpc.v1.AdminService.SetLogLevel
+     */
+    setLogLevel: {
+      name: "SetLogLevel",
+      I: SetLogLevelRequest,
+      O: SetLogLevelResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * *
      * Authentication: Login.
@@ -240,6 +512,21 @@ export const AdminService = {
       O: UpdatePasswordResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * *
+     * Replication: Stream changes.
+     * Streams page-level changes of every mounted database to a follower
+     * started with --role=follower. The first frame per database is a full
+     * snapshot; later frames only carry pages that changed.
+     *
+     * @generated from rpc sqlrpc.v1.AdminService.StreamReplication
+     */
+    streamReplication: {
+      name: "StreamReplication",
+      I: StreamReplicationRequest,
+      O: ReplicationFrame,
+      kind: MethodKind.ServerStreaming,
+    },
   }
 } as const;
 
@@ -7,7 +7,7 @@ import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
 import type { Duration, Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
-import type { Role } from "./enums_pb";
+import type { ColumnAction, LogLevel, MaintenanceTask, ReplicationRole, Role, RpcFamily, TransactionKind } from "./enums_pb";
 import { file_sqlrpc_v1_enums } from "./enums_pb";
 import type { DatabaseInfo, UpdateDatabaseConfig, User } from "./types_pb";
 import { file_sqlrpc_v1_types } from "./types_pb";
@@ -17,7 +17,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file sqlrpc/v1/admin_service.proto.
  */
 export const file_sqlrpc_v1_admin_service: GenFile = /*@__PURE__*/
-  fileDesc("Ch1zcWxycGMvdjEvYWRtaW5fc2VydmljZS5wcm90bxIJc3FscnBjLnYxIhIKEExpc3RVc2Vyc1JlcXVlc3QiMwoRTGlzdFVzZXJzUmVzcG9uc2USHgoFdXNlcnMYASADKAsyDy5zcWxycGMudjEuVXNlciKGAQoRQ3JlYXRlVXNlclJlcXVlc3QSLQoIdXNlcm5hbWUYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJBInCgRyb2xlGAIgASgOMg8uc3FscnBjLnYxLlJvbGVCCLpIBYIBAhABEhkKCHBhc3N3b3JkGAMgASgJQge6SARyAhAIImcKEkNyZWF0ZVVzZXJSZXNwb25zZRIPCgd1c2VyX2lkGAEgASgDEhAKCHVzZXJuYW1lGAIgASgJEi4KCmNyZWF0ZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIm8KFVVwZGF0ZVVzZXJSb2xlUmVxdWVzdBItCgh1c2VybmFtZRgBIAEoCUIbukgYchYQARhAMhBeW2EtekEtWjAtOV8tXSskEicKBHJvbGUYAiABKA4yDy5zcWxycGMudjEuUm9sZUIIukgFggECEAEiKQoWVXBkYXRlVXNlclJvbGVSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIkIKEURlbGV0ZVVzZXJSZXF1ZXN0Ei0KCHVzZXJuYW1lGAEgASgJQhu6SBhyFhABGEAyEF5bYS16QS1aMC05Xy1dKyQiJQoSRGVsZXRlVXNlclJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiSAoVVXBkYXRlUGFzc3dvcmRSZXF1ZXN0EhAKCHVzZXJuYW1lGAEgASgJEh0KDG5ld19wYXNzd29yZBgCIAEoCUIHukgEcgIQCCIpChZVcGRhdGVQYXNzd29yZFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiQwoSTGlzdEFQSUtleXNSZXF1ZXN0Ei0KCHVzZXJuYW1lGAEgASgJQhu6SBhyFhABGEAyEF5bYS16QS1aMC05Xy1dKyQiNgoTTGlzdEFQSUtleXNSZXNwb25zZRIfCgRrZXlzGAEgAygLMhEuc3FscnBjLnYxLkFQSUtleSKXAQoGQVBJS2V5EgoKAmlkGAEgASgJEhMKC2tleV9wcmV2aWV3GAIgASgJEgwKBG5hbWUYAyABKAkSLgoKY3JlYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKZXhwaXJlc19hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAijgEKE0NyZWF0ZUFQSUtleVJlcXVlc3QSLQoIdXNlcm5hbWUYASABKAlCG7pIGHIWEAEYQDIQXlthLXpBLVowLTlfLV0rJBIYCgRuYW1lGAIgASgJQgq6SAdyBRABGIABEi4KCmV4cGlyZXNfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIlwKFENyZWF0ZUFQSUtleVJlc3BvbnNlEg8KB2FwaV9rZXkYASABKAkSDgoGa2V5X2lkGAIgASgJEiMKCG1ldGFkYXRhGAMgASgLMhEuc3FscnBjLnYxLkFQSUtleSJAChNEZWxldGVBUElLZXlSZXF1ZXN0EhAKCHVzZXJuYW1lGAEgASgJEhcKBmtleV9pZBgCIAEoCUIHukgEcgIQASInChREZWxldGVBUElLZXlSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIhYKFExpc3REYXRhYmFzZXNSZXF1ZXN0IkMKFUxpc3REYXRhYmFzZXNSZXNwb25zZRIqCglkYXRhYmFzZXMYASADKAsyFy5zcWxycGMudjEuRGF0YWJhc2VJbmZvIrcCChVDcmVhdGVEYXRhYmFzZVJlcXVlc3QSKQoEbmFtZRgBIAEoCUIbukgYchYQAxhAMhBeW2EtekEtWjAtOV8tXSskEhQKDGlzX2VuY3J5cHRlZBgCIAEoCBILCgNrZXkYAyABKAkSEgoKZXh0ZW5zaW9ucxgEIAMoCRI+CgdwcmFnbWFzGAUgAygLMi0uc3FscnBjLnYxLkNyZWF0ZURhdGFiYXNlUmVxdWVzdC5QcmFnbWFzRW50cnkSFgoObWF4X29wZW5fY29ubnMYBiABKAUSFgoObWF4X2lkbGVfY29ubnMYByABKAUSHAoUY29ubl9tYXhfbGlmZXRpbWVfbXMYCCABKAUaLgoMUHJhZ21hc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiOgoWQ3JlYXRlRGF0YWJhc2VSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiewoVVXBkYXRlRGF0YWJhc2VSZXF1ZXN0EikKBG5hbWUYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJBI3CgZjb25maWcYAiABKAsyHy5zcWxycGMudjEuVXBkYXRlRGF0YWJhc2VDb25maWdCBrpIA8gBASI6ChZVcGRhdGVEYXRhYmFzZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCSJCChVEZWxldGVEYXRhYmFzZVJlcXVlc3QSKQoEbmFtZRgBIAEoCUIbukgYchYQAxhAMhBeW2EtekEtWjAtOV8tXSskIjoKFkRlbGV0ZURhdGFiYXNlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJIt8CChRNb3VudERhdGFiYXNlUmVxdWVzdBIpCgRuYW1lGAEgASgJQhu6SBhyFhADGEAyEF5bYS16QS1aMC05Xy1dKyQSFQoEcGF0aBgCIAEoCUIHukgEcgIQARIRCglyZWFkX29ubHkYAyABKAgSFAoMaXNfZW5jcnlwdGVkGAQgASgIEgsKA2tleRgFIAEoCRISCgpleHRlbnNpb25zGAYgAygJEj0KB3ByYWdtYXMYByADKAsyLC5zcWxycGMudjEuTW91bnREYXRhYmFzZVJlcXVlc3QuUHJhZ21hc0VudHJ5EhYKDm1heF9vcGVuX2Nvbm5zGAggASgFEhYKDm1heF9pZGxlX2Nvbm5zGAkgASgFEhwKFGNvbm5fbWF4X2xpZmV0aW1lX21zGAogASgFGi4KDFByYWdtYXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIjkKFU1vdW50RGF0YWJhc2VSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiQwoWVW5Nb3VudERhdGFiYXNlUmVxdWVzdBIpCgRuYW1lGAEgASgJQhu6SBhyFhADGEAyEF5bYS16QS1aMC05Xy1dKyQiOwoXVW5Nb3VudERhdGFiYXNlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJIhYKFEdldFNlcnZlckluZm9SZXF1ZXN0ItcBCgpTZXJ2ZXJJbmZvEg8KB3ZlcnNpb24YASABKAkSLwoLc2VydmVyX3RpbWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhQKDGFyY2hpdGVjdHVyZRgDIAEoCRISCgpnb192ZXJzaW9uGAQgASgJEhYKDnNxbGl0ZV92ZXJzaW9uGAUgASgJEhYKDmRhdGFiYXNlX2NvdW50GAYgASgFEhYKDnVwdGltZV9zZWNvbmRzGAcgASgDEhUKDWF1dGhfZGlzYWJsZWQYCCABKAgieQoMTG9naW5SZXF1ZXN0EhkKCHVzZXJuYW1lGAEgASgJQge6SARyAhABEhkKCHBhc3N3b3JkGAIgASgJQge6SARyAhABEjMKEHNlc3Npb25fZHVyYXRpb24YAyABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24ifwoNTG9naW5SZXNwb25zZRIPCgdhcGlfa2V5GAEgASgJEg4KBmtleV9pZBgCIAEoCRIuCgpleHBpcmVzX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIdCgR1c2VyGAQgASgLMg8uc3FscnBjLnYxLlVzZXIiMQoNTG9nb3V0UmVxdWVzdBIQCgh1c2VybmFtZRgBIAEoCRIOCgZrZXlfaWQYAiABKAkiIQoOTG9nb3V0UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCDLVCgoMQWRtaW5TZXJ2aWNlEkYKCUxpc3RVc2VycxIbLnNxbHJwYy52MS5MaXN0VXNlcnNSZXF1ZXN0Ghwuc3FscnBjLnYxLkxpc3RVc2Vyc1Jlc3BvbnNlEkkKCkNyZWF0ZVVzZXISHC5zcWxycGMudjEuQ3JlYXRlVXNlclJlcXVlc3QaHS5zcWxycGMudjEuQ3JlYXRlVXNlclJlc3BvbnNlElUKDlVwZGF0ZVVzZXJSb2xlEiAuc3FscnBjLnYxLlVwZGF0ZVVzZXJSb2xlUmVxdWVzdBohLnNxbHJwYy52MS5VcGRhdGVVc2VyUm9sZVJlc3BvbnNlEkkKCkRlbGV0ZVVzZXISHC5zcWxycGMudjEuRGVsZXRlVXNlclJlcXVlc3QaHS5zcWxycGMudjEuRGVsZXRlVXNlclJlc3BvbnNlEkwKC0xpc3RBUElLZXlzEh0uc3FscnBjLnYxLkxpc3RBUElLZXlzUmVxdWVzdBoeLnNxbHJwYy52MS5MaXN0QVBJS2V5c1Jlc3BvbnNlEk8KDENyZWF0ZUFQSUtleRIeLnNxbHJwYy52MS5DcmVhdGVBUElLZXlSZXF1ZXN0Gh8uc3FscnBjLnYxLkNyZWF0ZUFQSUtleVJlc3BvbnNlEk8KDERlbGV0ZUFQSUtleRIeLnNxbHJwYy52MS5EZWxldGVBUElLZXlSZXF1ZXN0Gh8uc3FscnBjLnYxLkRlbGV0ZUFQSUtleVJlc3BvbnNlElIKDUxpc3REYXRhYmFzZXMSHy5zcWxycGMudjEuTGlzdERhdGFiYXNlc1JlcXVlc3QaIC5zcWxycGMudjEuTGlzdERhdGFiYXNlc1Jlc3BvbnNlElUKDkNyZWF0ZURhdGFiYXNlEiAuc3FscnBjLnYxLkNyZWF0ZURhdGFiYXNlUmVxdWVzdBohLnNxbHJwYy52MS5DcmVhdGVEYXRhYmFzZVJlc3BvbnNlElUKDlVwZGF0ZURhdGFiYXNlEiAuc3FscnBjLnYxLlVwZGF0ZURhdGFiYXNlUmVxdWVzdBohLnNxbHJwYy52MS5VcGRhdGVEYXRhYmFzZVJlc3BvbnNlElUKDkRlbGV0ZURhdGFiYXNlEiAuc3FscnBjLnYxLkRlbGV0ZURhdGFiYXNlUmVxdWVzdBohLnNxbHJwYy52MS5EZWxldGVEYXRhYmFzZVJlc3BvbnNlElIKDU1vdW50RGF0YWJhc2USHy5zcWxycGMudjEuTW91bnREYXRhYmFzZVJlcXVlc3QaIC5zcWxycGMudjEuTW91bnREYXRhYmFzZVJlc3BvbnNlElgKD1VuTW91bnREYXRhYmFzZRIhLnNxbHJwYy52MS5Vbk1vdW50RGF0YWJhc2VSZXF1ZXN0GiIuc3FscnBjLnYxLlVuTW91bnREYXRhYmFzZVJlc3BvbnNlEkcKDUdldFNlcnZlckluZm8SHy5zcWxycGMudjEuR2V0U2VydmVySW5mb1JlcXVlc3QaFS5zcWxycGMudjEuU2VydmVySW5mbxI6CgVMb2dpbhIXLnNxbHJwYy52MS5Mb2dpblJlcXVlc3QaGC5zcWxycGMudjEuTG9naW5SZXNwb25zZRI9CgZMb2dvdXQSGC5zcWxycGMudjEuTG9nb3V0UmVxdWVzdBoZLnNxbHJwYy52MS5Mb2dvdXRSZXNwb25zZRJVCg5VcGRhdGVQYXNzd29yZBIgLnNxbHJwYy52MS5VcGRhdGVQYXNzd29yZFJlcXVlc3QaIS5zcWxycGMudjEuVXBkYXRlUGFzc3dvcmRSZXNwb25zZUIyWjBzcWxpdGUtc2VydmVyL2ludGVybmFsL3Byb3Rvcy9zcWxycGMvdjE7c3FscnBjdjFiBnByb3RvMw", [file_buf_validate_validate, file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_timestamp, file_sqlrpc_v1_enums, file_sqlrpc_v1_types]);
+  fileDesc("Ch1zcWxycGMvdjEvYWRtaW5fc2VydmljZS5wcm90bxIJc3FscnBjLnYxIhIKEExpc3RVc2Vyc1JlcXVlc3QiMwoRTGlzdFVzZXJzUmVzcG9uc2USHgoFdXNlcnMYASADKAsyDy5zcWxycGMudjEuVXNlciKGAQoRQ3JlYXRlVXNlclJlcXVlc3QSLQoIdXNlcm5hbWUYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJBInCgRyb2xlGAIgASgOMg8uc3FscnBjLnYxLlJvbGVCCLpIBYIBAhABEhkKCHBhc3N3b3JkGAMgASgJQge6SARyAhAIImcKEkNyZWF0ZVVzZXJSZXNwb25zZRIPCgd1c2VyX2lkGAEgASgDEhAKCHVzZXJuYW1lGAIgASgJEi4KCmNyZWF0ZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIm8KFVVwZGF0ZVVzZXJSb2xlUmVxdWVzdBItCgh1c2VybmFtZRgBIAEoCUIbukgYchYQARhAMhBeW2EtekEtWjAtOV8tXSskEicKBHJvbGUYAiABKA4yDy5zcWxycGMudjEuUm9sZUIIukgFggECEAEiKQoWVXBkYXRlVXNlclJvbGVSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIkIKEURlbGV0ZVVzZXJSZXF1ZXN0Ei0KCHVzZXJuYW1lGAEgASgJQhu6SBhyFhABGEAyEF5bYS16QS1aMC05Xy1dKyQiJQoSRGVsZXRlVXNlclJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiXgoRVW5sb2NrVXNlclJlcXVlc3QSLQoIdXNlcm5hbWUYASABKAlCG7pIGHIWEAEYQDIQXlthLXpBLVowLTlfLV0rJBIaCgljbGllbnRfaXAYAiABKAlCB7pIBHICGEAiJgoSVW5sb2NrVXNlclJlc3BvbnNlEhAKCHVubG9ja2VkGAEgASgIIkgKFVVwZGF0ZVBhc3N3b3JkUmVxdWVzdBIQCgh1c2VybmFtZRgBIAEoCRIdCgxuZXdfcGFzc3dvcmQYAiABKAlCB7pIBHICEAgiKQoWVXBkYXRlUGFzc3dvcmRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIkMKEkxpc3RBUElLZXlzUmVxdWVzdBItCgh1c2VybmFtZRgBIAEoCUIbukgYchYQARhAMhBeW2EtekEtWjAtOV8tXSskIjYKE0xpc3RBUElLZXlzUmVzcG9uc2USHwoEa2V5cxgBIAMoCzIRLnNxbHJwYy52MS5BUElLZXkivgEKBkFQSUtleRIKCgJpZBgBIAEoCRITCgtrZXlfcHJldmlldxgCIAEoCRIMCgRuYW1lGAMgASgJEi4KCmNyZWF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmV4cGlyZXNfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiUKBXNjb3BlGAYgASgLMhYuc3FscnBjLnYxLkFQSUtleVNjb3BlIpsBCgtBUElLZXlTY29wZRIbCglkYXRhYmFzZXMYASADKAlCCLpIBZIBAhBAEisKCG1heF9yb2xlGAIgASgOMg8uc3FscnBjLnYxLlJvbGVCCLpIBYIBAhABEiYKCGZhbWlsaWVzGAMgAygOMhQuc3FscnBjLnYxLlJwY0ZhbWlseRIaCghjaGFubmVscxgEIAMoCUIIukgFkgECEEAitQEKE0NyZWF0ZUFQSUtleVJlcXVlc3QSLQoIdXNlcm5hbWUYASABKAlCG7pIGHIWEAEYQDIQXlthLXpBLVowLTlfLV0rJBIYCgRuYW1lGAIgASgJQgq6SAdyBRABGIABEi4KCmV4cGlyZXNfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiUKBXNjb3BlGAQgASgLMhYuc3FscnBjLnYxLkFQSUtleVNjb3BlIlwKFENyZWF0ZUFQSUtleVJlc3BvbnNlEg8KB2FwaV9rZXkYASABKAkSDgoGa2V5X2lkGAIgASgJEiMKCG1ldGFkYXRhGAMgASgLMhEuc3FscnBjLnYxLkFQSUtleSJAChNEZWxldGVBUElLZXlSZXF1ZXN0EhAKCHVzZXJuYW1lGAEgASgJEhcKBmtleV9pZBgCIAEoCUIHukgEcgIQASInChREZWxldGVBUElLZXlSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIqIBCg1EYXRhYmFzZUdyYW50EgoKAmlkGAEgASgDEhAKCHVzZXJuYW1lGAIgASgJEhIKCmFwaV9rZXlfaWQYAyABKAkSEAoIZGF0YWJhc2UYBCABKAkSHQoEcm9sZRgFIAEoDjIPLnNxbHJwYy52MS5Sb2xlEi4KCmNyZWF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wItgBChpHcmFudERhdGFiYXNlQWNjZXNzUmVxdWVzdBIvCgh1c2VybmFtZRgBIAEoCUIbukgYchYQARhAMhBeW2EtekEtWjAtOV8tXSskSAASHQoKYXBpX2tleV9pZBgCIAEoCUIHukgEcgIQAUgAEi8KCGRhdGFiYXNlGAMgASgJQh26SBpyGBABGEAyEl5bYS16QS1aMC05Xyo/LV0rJBInCgRyb2xlGAQgASgOMg8uc3FscnBjLnYxLlJvbGVCCLpIBYIBAhABQhAKB2dyYW50ZWUSBbpIAggBIkYKG0dyYW50RGF0YWJhc2VBY2Nlc3NSZXNwb25zZRInCgVncmFudBgBIAEoCzIYLnNxbHJwYy52MS5EYXRhYmFzZUdyYW50IjgKG1Jldm9rZURhdGFiYXNlQWNjZXNzUmVxdWVzdBIZCghncmFudF9pZBgBIAEoA0IHukgEIgIgACIvChxSZXZva2VEYXRhYmFzZUFjY2Vzc1Jlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiPwoZTGlzdERhdGFiYXNlR3JhbnRzUmVxdWVzdBIQCgh1c2VybmFtZRgBIAEoCRIQCghkYXRhYmFzZRgCIAEoCSJGChpMaXN0RGF0YWJhc2VHcmFudHNSZXNwb25zZRIoCgZncmFudHMYASADKAsyGC5zcWxycGMudjEuRGF0YWJhc2VHcmFudCKNAgoJUmF0ZUxpbWl0EgoKAmlkGAEgASgDEhAKCHVzZXJuYW1lGAIgASgJEhIKCmFwaV9rZXlfaWQYAyABKAkSEAoIZGF0YWJhc2UYBCABKAkSGwoTcmVxdWVzdHNfcGVyX3NlY29uZBgFIAEoARINCgVidXJzdBgGIAEoBRITCgttYXhfc3RyZWFtcxgHIAEoBRIYChBtYXhfdHJhbnNhY3Rpb25zGAggASgFEhcKD3Jvd3NfcGVyX21pbnV0ZRgJIAEoAxIYChBieXRlc19wZXJfbWludXRlGAogASgDEi4KCmNyZWF0ZWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIvECChNTZXRSYXRlTGltaXRSZXF1ZXN0Ei8KCHVzZXJuYW1lGAEgASgJQhu6SBhyFhABGEAyEF5bYS16QS1aMC05Xy1dKyRIABIdCgphcGlfa2V5X2lkGAIgASgJQge6SARyAhABSAASLQoIZGF0YWJhc2UYAyABKAlCG7pIGHIWGEAyEl5bYS16QS1aMC05Xyo/LV0qJBIrChNyZXF1ZXN0c19wZXJfc2Vjb25kGAQgASgBQg66SAsSCSkAAAAAAAAAABIWCgVidXJzdBgFIAEoBUIHukgEGgIoABIcCgttYXhfc3RyZWFtcxgGIAEoBUIHukgEGgIoABIhChBtYXhfdHJhbnNhY3Rpb25zGAcgASgFQge6SAQaAigAEiAKD3Jvd3NfcGVyX21pbnV0ZRgIIAEoA0IHukgEIgIoABIhChBieXRlc19wZXJfbWludXRlGAkgASgDQge6SAQiAigAQhAKB3N1YmplY3QSBbpIAggBIjsKFFNldFJhdGVMaW1pdFJlc3BvbnNlEiMKBWxpbWl0GAEgASgLMhQuc3FscnBjLnYxLlJhdGVMaW1pdCIzChZEZWxldGVSYXRlTGltaXRSZXF1ZXN0EhkKCGxpbWl0X2lkGAEgASgDQge6SAQiAiAAIioKF0RlbGV0ZVJhdGVMaW1pdFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiOwoVTGlzdFJhdGVMaW1pdHNSZXF1ZXN0EhAKCHVzZXJuYW1lGAEgASgJEhAKCGRhdGFiYXNlGAIgASgJIj4KFkxpc3RSYXRlTGltaXRzUmVzcG9uc2USJAoGbGltaXRzGAEgAygLMhQuc3FscnBjLnYxLlJhdGVMaW1pdCKFAQoJUm93UG9saWN5EgoKAmlkGAEgASgDEhAKCGRhdGFiYXNlGAIgASgJEg0KBXRhYmxlGAMgASgJEgwKBG5hbWUYBCABKAkSDQoFdXNpbmcYBSABKAkSLgoKY3JlYXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiqAEKFkNyZWF0ZVJvd1BvbGljeVJlcXVlc3QSLQoIZGF0YWJhc2UYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJBIZCgV0YWJsZRgCIAEoCUIKukgHcgUQARiAARIpCgRuYW1lGAMgASgJQhu6SBhyFhABGEAyEF5bYS16QS1aMC05Xy1dKyQSGQoFdXNpbmcYBCABKAlCCrpIB3IFEAEYgCAiPwoXQ3JlYXRlUm93UG9saWN5UmVzcG9uc2USJAoGcG9saWN5GAEgASgLMhQuc3FscnBjLnYxLlJvd1BvbGljeSI0ChZEZWxldGVSb3dQb2xpY3lSZXF1ZXN0EhoKCXBvbGljeV9pZBgBIAEoA0IHukgEIgIgACIqChdEZWxldGVSb3dQb2xpY3lSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIioKFkxpc3RSb3dQb2xpY2llc1JlcXVlc3QSEAoIZGF0YWJhc2UYASABKAkiQQoXTGlzdFJvd1BvbGljaWVzUmVzcG9uc2USJgoIcG9saWNpZXMYASADKAsyFC5zcWxycGMudjEuUm93UG9saWN5ItUBCgxDb2x1bW5Qb2xpY3kSCgoCaWQYASABKAMSEAoIZGF0YWJhc2UYAiABKAkSDQoFdGFibGUYAyABKAkSDgoGY29sdW1uGAQgASgJEicKBmFjdGlvbhgFIAEoDjIXLnNxbHJwYy52MS5Db2x1bW5BY3Rpb24SHQoEcm9sZRgGIAEoDjIPLnNxbHJwYy52MS5Sb2xlEhAKCHVzZXJuYW1lGAcgASgJEi4KCmNyZWF0ZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIqUCChZTZXRDb2x1bW5Qb2xpY3lSZXF1ZXN0Ei0KCGRhdGFiYXNlGAEgASgJQhu6SBhyFhADGEAyEF5bYS16QS1aMC05Xy1dKyQSGQoFdGFibGUYAiABKAlCCrpIB3IFEAEYgAESGgoGY29sdW1uGAMgASgJQgq6SAdyBRABGIABEjMKBmFjdGlvbhgEIAEoDjIXLnNxbHJwYy52MS5Db2x1bW5BY3Rpb25CCrpIB4IBBBABIAASLQoEcm9sZRgFIAEoDjIPLnNxbHJwYy52MS5Sb2xlQgy6SAmCAQYQASAAIChIABIvCgh1c2VybmFtZRgGIAEoCUIbukgYchYQARhAMhBeW2EtekEtWjAtOV8tXSskSABCEAoHc3ViamVjdBIFukgCCAEiQgoXU2V0Q29sdW1uUG9saWN5UmVzcG9uc2USJwoGcG9saWN5GAEgASgLMhcuc3FscnBjLnYxLkNvbHVtblBvbGljeSI3ChlEZWxldGVDb2x1bW5Qb2xpY3lSZXF1ZXN0EhoKCXBvbGljeV9pZBgBIAEoA0IHukgEIgIgACItChpEZWxldGVDb2x1bW5Qb2xpY3lSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIi0KGUxpc3RDb2x1bW5Qb2xpY2llc1JlcXVlc3QSEAoIZGF0YWJhc2UYASABKAkiRwoaTGlzdENvbHVtblBvbGljaWVzUmVzcG9uc2USKQoIcG9saWNpZXMYASADKAsyFy5zcWxycGMudjEuQ29sdW1uUG9saWN5IhYKFExpc3REYXRhYmFzZXNSZXF1ZXN0IkMKFUxpc3REYXRhYmFzZXNSZXNwb25zZRIqCglkYXRhYmFzZXMYASADKAsyFy5zcWxycGMudjEuRGF0YWJhc2VJbmZvIt8CChVDcmVhdGVEYXRhYmFzZVJlcXVlc3QSKQoEbmFtZRgBIAEoCUIbukgYchYQAxhAMhBeW2EtekEtWjAtOV8tXSskEhQKDGlzX2VuY3J5cHRlZBgCIAEoCBILCgNrZXkYAyABKAkSEgoKZXh0ZW5zaW9ucxgEIAMoCRI+CgdwcmFnbWFzGAUgAygLMi0uc3FscnBjLnYxLkNyZWF0ZURhdGFiYXNlUmVxdWVzdC5QcmFnbWFzRW50cnkSFgoObWF4X29wZW5fY29ubnMYBiABKAUSFgoObWF4X2lkbGVfY29ubnMYByABKAUSHAoUY29ubl9tYXhfbGlmZXRpbWVfbXMYCCABKAUSJgoVbWF4X2V4ZWN1dGlvbl90aW1lX21zGAkgASgFQge6SAQaAigAGi4KDFByYWdtYXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIjoKFkNyZWF0ZURhdGFiYXNlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJInsKFVVwZGF0ZURhdGFiYXNlUmVxdWVzdBIpCgRuYW1lGAEgASgJQhu6SBhyFhADGEAyEF5bYS16QS1aMC05Xy1dKyQSNwoGY29uZmlnGAIgASgLMh8uc3FscnBjLnYxLlVwZGF0ZURhdGFiYXNlQ29uZmlnQga6SAPIAQEiOgoWVXBkYXRlRGF0YWJhc2VSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiQgoVRGVsZXRlRGF0YWJhc2VSZXF1ZXN0EikKBG5hbWUYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJCI6ChZEZWxldGVEYXRhYmFzZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCSKHAwoUTW91bnREYXRhYmFzZVJlcXVlc3QSKQoEbmFtZRgBIAEoCUIbukgYchYQAxhAMhBeW2EtekEtWjAtOV8tXSskEhUKBHBhdGgYAiABKAlCB7pIBHICEAESEQoJcmVhZF9vbmx5GAMgASgIEhQKDGlzX2VuY3J5cHRlZBgEIAEoCBILCgNrZXkYBSABKAkSEgoKZXh0ZW5zaW9ucxgGIAMoCRI9CgdwcmFnbWFzGAcgAygLMiwuc3FscnBjLnYxLk1vdW50RGF0YWJhc2VSZXF1ZXN0LlByYWdtYXNFbnRyeRIWCg5tYXhfb3Blbl9jb25ucxgIIAEoBRIWCg5tYXhfaWRsZV9jb25ucxgJIAEoBRIcChRjb25uX21heF9saWZldGltZV9tcxgKIAEoBRImChVtYXhfZXhlY3V0aW9uX3RpbWVfbXMYCyABKAVCB7pIBBoCKAAaLgoMUHJhZ21hc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiOQoVTW91bnREYXRhYmFzZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCSJDChZVbk1vdW50RGF0YWJhc2VSZXF1ZXN0EikKBG5hbWUYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJCI7ChdVbk1vdW50RGF0YWJhc2VSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAki1wEKDk1haW50ZW5hbmNlUnVuEgoKAmlkGAEgASgDEhAKCGRhdGFiYXNlGAIgASgJEigKBHRhc2sYAyABKA4yGi5zcWxycGMudjEuTWFpbnRlbmFuY2VUYXNrEi4KCnN0YXJ0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEisKCGR1cmF0aW9uGAUgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uEg8KB3N1Y2Nlc3MYBiABKAgSDwoHbWVzc2FnZRgHIAEoCSJ8ChpMaXN0TWFpbnRlbmFuY2VSdW5zUmVxdWVzdBIZCghkYXRhYmFzZRgBIAEoCUIHukgEcgIYQBIoCgR0YXNrGAIgASgOMhouc3FscnBjLnYxLk1haW50ZW5hbmNlVGFzaxIZCgVsaW1pdBgDIAEoBUIKukgHGgUY6AcoACJGChtMaXN0TWFpbnRlbmFuY2VSdW5zUmVzcG9uc2USJwoEcnVucxgBIAMoCzIZLnNxbHJwYy52MS5NYWludGVuYW5jZVJ1biI0ChJDYW5jZWxRdWVyeVJlcXVlc3QSHgoKcmVxdWVzdF9pZBgBIAEoCUIKukgHcgUQARiAASIoChNDYW5jZWxRdWVyeVJlc3BvbnNlEhEKCWNhbmNlbGxlZBgBIAEoBSIvChtMaXN0QWN0aXZlT3BlcmF0aW9uc1JlcXVlc3QSEAoIZGF0YWJhc2UYASABKAkiyQEKD0FjdGl2ZVN0YXRlbWVudBISCgpyZXF1ZXN0X2lkGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJEhAKCGRhdGFiYXNlGAMgASgJEgsKA3NxbBgEIAEoCRIuCgpzdGFydGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIqCgdlbGFwc2VkGAYgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uEhUKDXJvd3Nfc3RyZWFtZWQYByABKAMikwIKEUFjdGl2ZVRyYW5zYWN0aW9uEhYKDnRyYW5zYWN0aW9uX2lkGAEgASgJEigKBGtpbmQYAiABKA4yGi5zcWxycGMudjEuVHJhbnNhY3Rpb25LaW5kEhAKCHVzZXJuYW1lGAMgASgJEhAKCGRhdGFiYXNlGAQgASgJEi4KCnN0YXJ0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiYKA2FnZRgGIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhIuCgpleHBpcmVzX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIQCghsYXN0X3NxbBgIIAEoCSKCAQocTGlzdEFjdGl2ZU9wZXJhdGlvbnNSZXNwb25zZRIuCgpzdGF0ZW1lbnRzGAEgAygLMhouc3FscnBjLnYxLkFjdGl2ZVN0YXRlbWVudBIyCgx0cmFuc2FjdGlvbnMYAiADKAsyHC5zcWxycGMudjEuQWN0aXZlVHJhbnNhY3Rpb24iRQofRm9yY2VSb2xsYmFja1RyYW5zYWN0aW9uUmVxdWVzdBIiCg50cmFuc2FjdGlvbl9pZBgBIAEoCUIKukgHcgUQARiAASJMCiBGb3JjZVJvbGxiYWNrVHJhbnNhY3Rpb25SZXNwb25zZRIoCgRraW5kGAEgASgOMhouc3FscnBjLnYxLlRyYW5zYWN0aW9uS2luZCLnAQoKQXVkaXRFdmVudBIKCgJpZBgBIAEoAxIoCgR0aW1lGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgVhY3RvchgDIAEoCRISCgphcGlfa2V5X2lkGAQgASgJEgsKA3JwYxgFIAEoCRIQCghkYXRhYmFzZRgGIAEoCRILCgNzcWwYByABKAkSEgoKcGFyYW1ldGVycxgIIAEoCRIPCgdkZXRhaWxzGAkgASgJEgwKBGNvZGUYCiABKAkSDQoFZXJyb3IYCyABKAkSEgoKcmVxdWVzdF9pZBgMIAEoCSKEAgoWTGlzdEF1ZGl0RXZlbnRzUmVxdWVzdBIWCgVhY3RvchgBIAEoCUIHukgEcgIYQBIZCghkYXRhYmFzZRgCIAEoCUIHukgEcgIYQBIVCgNycGMYAyABKAlCCLpIBXIDGIABEikKBXNpbmNlGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIpCgV1bnRpbBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEwoLZmFpbGVkX29ubHkYBiABKAgSGgoJYmVmb3JlX2lkGAcgASgDQge6SAQiAigAEhkKBWxpbWl0GAggASgFQgq6SAcaBRjoBygAIkAKF0xpc3RBdWRpdEV2ZW50c1Jlc3BvbnNlEiUKBmV2ZW50cxgBIAMoCzIVLnNxbHJwYy52MS5BdWRpdEV2ZW50IhYKFEdldFNlcnZlckluZm9SZXF1ZXN0IooCCgpTZXJ2ZXJJbmZvEg8KB3ZlcnNpb24YASABKAkSLwoLc2VydmVyX3RpbWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhQKDGFyY2hpdGVjdHVyZRgDIAEoCRISCgpnb192ZXJzaW9uGAQgASgJEhYKDnNxbGl0ZV92ZXJzaW9uGAUgASgJEhYKDmRhdGFiYXNlX2NvdW50GAYgASgFEhYKDnVwdGltZV9zZWNvbmRzGAcgASgDEhUKDWF1dGhfZGlzYWJsZWQYCCABKAgSMQoLcmVwbGljYXRpb24YCSABKAsyHC5zcWxycGMudjEuUmVwbGljYXRpb25TdGF0dXMiFQoTR2V0TG9nTGV2ZWxzUmVxdWVzdCJeChFTdWJzeXN0ZW1Mb2dMZXZlbBIRCglzdWJzeXN0ZW0YASABKAkSIgoFbGV2ZWwYAiABKA4yEy5zcWxycGMudjEuTG9nTGV2ZWwSEgoKb3ZlcnJpZGRlbhgDIAEoCCJ0ChRHZXRMb2dMZXZlbHNSZXNwb25zZRIqCg1kZWZhdWx0X2xldmVsGAEgASgOMhMuc3FscnBjLnYxLkxvZ0xldmVsEjAKCnN1YnN5c3RlbXMYAiADKAsyHC5zcWxycGMudjEuU3Vic3lzdGVtTG9nTGV2ZWwiXgoSU2V0TG9nTGV2ZWxSZXF1ZXN0EhoKCXN1YnN5c3RlbRgBIAEoCUIHukgEcgIYQBIsCgVsZXZlbBgCIAEoDjITLnNxbHJwYy52MS5Mb2dMZXZlbEIIukgFggECEAEicwoTU2V0TG9nTGV2ZWxSZXNwb25zZRIqCg1kZWZhdWx0X2xldmVsGAEgASgOMhMuc3FscnBjLnYxLkxvZ0xldmVsEjAKCnN1YnN5c3RlbXMYAiADKAsyHC5zcWxycGMudjEuU3Vic3lzdGVtTG9nTGV2ZWwieQoMTG9naW5SZXF1ZXN0EhkKCHVzZXJuYW1lGAEgASgJQge6SARyAhABEhkKCHBhc3N3b3JkGAIgASgJQge6SARyAhABEjMKEHNlc3Npb25fZHVyYXRpb24YAyABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24ifwoNTG9naW5SZXNwb25zZRIPCgdhcGlfa2V5GAEgASgJEg4KBmtleV9pZBgCIAEoCRIuCgpleHBpcmVzX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIdCgR1c2VyGAQgASgLMg8uc3FscnBjLnYxLlVzZXIiMQoNTG9nb3V0UmVxdWVzdBIQCgh1c2VybmFtZRgBIAEoCRIOCgZrZXlfaWQYAiABKAkiIQoOTG9nb3V0UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCI5ChhTdHJlYW1SZXBsaWNhdGlvblJlcXVlc3QSHQoLZm9sbG93ZXJfaWQYASABKAlCCLpIBXIDGIABIjQKD1JlcGxpY2F0aW9uUGFnZRITCgtwYWdlX251bWJlchgBIAEoDRIMCgRkYXRhGAIgASgMIuEBChBSZXBsaWNhdGlvbkZyYW1lEhAKCGRhdGFiYXNlGAEgASgJEhEKCXBhZ2Vfc2l6ZRgCIAEoDRISCgpwYWdlX2NvdW50GAMgASgNEikKBXBhZ2VzGAQgAygLMhouc3FscnBjLnYxLlJlcGxpY2F0aW9uUGFnZRIVCg1mdWxsX3NuYXBzaG90GAUgASgIEhAKCHNlcXVlbmNlGAYgASgEEi8KC2xlYWRlcl90aW1lGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIPCgdwYXJ0aWFsGAggASgIIlMKGURhdGFiYXNlUmVwbGljYXRpb25TdGF0dXMSEAoIZGF0YWJhc2UYASABKAkSEAoIc2VxdWVuY2UYAiABKAQSEgoKcGFnZV9jb3VudBgDIAEoDSKNAgoRUmVwbGljYXRpb25TdGF0dXMSKAoEcm9sZRgBIAEoDjIaLnNxbHJwYy52MS5SZXBsaWNhdGlvblJvbGUSDgoGbGVhZGVyGAIgASgJEhEKCWNvbm5lY3RlZBgDIAEoCBImCgNsYWcYBCABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SMgoObGFzdF9zeW5jX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhYKDmZvbGxvd2VyX2NvdW50GAYgASgFEjcKCWRhdGFiYXNlcxgHIAMoCzIkLnNxbHJwYy52MS5EYXRhYmFzZVJlcGxpY2F0aW9uU3RhdHVzMuYZCgxBZG1pblNlcnZpY2USRgoJTGlzdFVzZXJzEhsuc3FscnBjLnYxLkxpc3RVc2Vyc1JlcXVlc3QaHC5zcWxycGMudjEuTGlzdFVzZXJzUmVzcG9uc2USSQoKQ3JlYXRlVXNlchIcLnNxbHJwYy52MS5DcmVhdGVVc2VyUmVxdWVzdBodLnNxbHJwYy52MS5DcmVhdGVVc2VyUmVzcG9uc2USVQoOVXBkYXRlVXNlclJvbGUSIC5zcWxycGMudjEuVXBkYXRlVXNlclJvbGVSZXF1ZXN0GiEuc3FscnBjLnYxLlVwZGF0ZVVzZXJSb2xlUmVzcG9uc2USSQoKRGVsZXRlVXNlchIcLnNxbHJwYy52MS5EZWxldGVVc2VyUmVxdWVzdBodLnNxbHJwYy52MS5EZWxldGVVc2VyUmVzcG9uc2USSQoKVW5sb2NrVXNlchIcLnNxbHJwYy52MS5VbmxvY2tVc2VyUmVxdWVzdBodLnNxbHJwYy52MS5VbmxvY2tVc2VyUmVzcG9uc2USTAoLTGlzdEFQSUtleXMSHS5zcWxycGMudjEuTGlzdEFQSUtleXNSZXF1ZXN0Gh4uc3FscnBjLnYxLkxpc3RBUElLZXlzUmVzcG9uc2USTwoMQ3JlYXRlQVBJS2V5Eh4uc3FscnBjLnYxLkNyZWF0ZUFQSUtleVJlcXVlc3QaHy5zcWxycGMudjEuQ3JlYXRlQVBJS2V5UmVzcG9uc2USTwoMRGVsZXRlQVBJS2V5Eh4uc3FscnBjLnYxLkRlbGV0ZUFQSUtleVJlcXVlc3QaHy5zcWxycGMudjEuRGVsZXRlQVBJS2V5UmVzcG9uc2USZAoTR3JhbnREYXRhYmFzZUFjY2VzcxIlLnNxbHJwYy52MS5HcmFudERhdGFiYXNlQWNjZXNzUmVxdWVzdBomLnNxbHJwYy52MS5HcmFudERhdGFiYXNlQWNjZXNzUmVzcG9uc2USZwoUUmV2b2tlRGF0YWJhc2VBY2Nlc3MSJi5zcWxycGMudjEuUmV2b2tlRGF0YWJhc2VBY2Nlc3NSZXF1ZXN0Gicuc3FscnBjLnYxLlJldm9rZURhdGFiYXNlQWNjZXNzUmVzcG9uc2USYQoSTGlzdERhdGFiYXNlR3JhbnRzEiQuc3FscnBjLnYxLkxpc3REYXRhYmFzZUdyYW50c1JlcXVlc3QaJS5zcWxycGMudjEuTGlzdERhdGFiYXNlR3JhbnRzUmVzcG9uc2USTwoMU2V0UmF0ZUxpbWl0Eh4uc3FscnBjLnYxLlNldFJhdGVMaW1pdFJlcXVlc3QaHy5zcWxycGMudjEuU2V0UmF0ZUxpbWl0UmVzcG9uc2USWAoPRGVsZXRlUmF0ZUxpbWl0EiEuc3FscnBjLnYxLkRlbGV0ZVJhdGVMaW1pdFJlcXVlc3QaIi5zcWxycGMudjEuRGVsZXRlUmF0ZUxpbWl0UmVzcG9uc2USVQoOTGlzdFJhdGVMaW1pdHMSIC5zcWxycGMudjEuTGlzdFJhdGVMaW1pdHNSZXF1ZXN0GiEuc3FscnBjLnYxLkxpc3RSYXRlTGltaXRzUmVzcG9uc2USWAoPQ3JlYXRlUm93UG9saWN5EiEuc3FscnBjLnYxLkNyZWF0ZVJvd1BvbGljeVJlcXVlc3QaIi5zcWxycGMudjEuQ3JlYXRlUm93UG9saWN5UmVzcG9uc2USWAoPRGVsZXRlUm93UG9saWN5EiEuc3FscnBjLnYxLkRlbGV0ZVJvd1BvbGljeVJlcXVlc3QaIi5zcWxycGMudjEuRGVsZXRlUm93UG9saWN5UmVzcG9uc2USWAoPTGlzdFJvd1BvbGljaWVzEiEuc3FscnBjLnYxLkxpc3RSb3dQb2xpY2llc1JlcXVlc3QaIi5zcWxycGMudjEuTGlzdFJvd1BvbGljaWVzUmVzcG9uc2USWAoPU2V0Q29sdW1uUG9saWN5EiEuc3FscnBjLnYxLlNldENvbHVtblBvbGljeVJlcXVlc3QaIi5zcWxycGMudjEuU2V0Q29sdW1uUG9saWN5UmVzcG9uc2USYQoSRGVsZXRlQ29sdW1uUG9saWN5EiQuc3FscnBjLnYxLkRlbGV0ZUNvbHVtblBvbGljeVJlcXVlc3QaJS5zcWxycGMudjEuRGVsZXRlQ29sdW1uUG9saWN5UmVzcG9uc2USYQoSTGlzdENvbHVtblBvbGljaWVzEiQuc3FscnBjLnYxLkxpc3RDb2x1bW5Qb2xpY2llc1JlcXVlc3QaJS5zcWxycGMudjEuTGlzdENvbHVtblBvbGljaWVzUmVzcG9uc2USUgoNTGlzdERhdGFiYXNlcxIfLnNxbHJwYy52MS5MaXN0RGF0YWJhc2VzUmVxdWVzdBogLnNxbHJwYy52MS5MaXN0RGF0YWJhc2VzUmVzcG9uc2USVQoOQ3JlYXRlRGF0YWJhc2USIC5zcWxycGMudjEuQ3JlYXRlRGF0YWJhc2VSZXF1ZXN0GiEuc3FscnBjLnYxLkNyZWF0ZURhdGFiYXNlUmVzcG9uc2USVQoOVXBkYXRlRGF0YWJhc2USIC5zcWxycGMudjEuVXBkYXRlRGF0YWJhc2VSZXF1ZXN0GiEuc3FscnBjLnYxLlVwZGF0ZURhdGFiYXNlUmVzcG9uc2USVQoORGVsZXRlRGF0YWJhc2USIC5zcWxycGMudjEuRGVsZXRlRGF0YWJhc2VSZXF1ZXN0GiEuc3FscnBjLnYxLkRlbGV0ZURhdGFiYXNlUmVzcG9uc2USUgoNTW91bnREYXRhYmFzZRIfLnNxbHJwYy52MS5Nb3VudERhdGFiYXNlUmVxdWVzdBogLnNxbHJwYy52MS5Nb3VudERhdGFiYXNlUmVzcG9uc2USWAoPVW5Nb3VudERhdGFiYXNlEiEuc3FscnBjLnYxLlVuTW91bnREYXRhYmFzZVJlcXVlc3QaIi5zcWxycGMudjEuVW5Nb3VudERhdGFiYXNlUmVzcG9uc2USZAoTTGlzdE1haW50ZW5hbmNlUnVucxIlLnNxbHJwYy52MS5MaXN0TWFpbnRlbmFuY2VSdW5zUmVxdWVzdBomLnNxbHJwYy52MS5MaXN0TWFpbnRlbmFuY2VSdW5zUmVzcG9uc2USTAoLQ2FuY2VsUXVlcnkSHS5zcWxycGMudjEuQ2FuY2VsUXVlcnlSZXF1ZXN0Gh4uc3FscnBjLnYxLkNhbmNlbFF1ZXJ5UmVzcG9uc2USZwoUTGlzdEFjdGl2ZU9wZXJhdGlvbnMSJi5zcWxycGMudjEuTGlzdEFjdGl2ZU9wZXJhdGlvbnNSZXF1ZXN0Gicuc3FscnBjLnYxLkxpc3RBY3RpdmVPcGVyYXRpb25zUmVzcG9uc2UScwoYRm9yY2VSb2xsYmFja1RyYW5zYWN0aW9uEiouc3FscnBjLnYxLkZvcmNlUm9sbGJhY2tUcmFuc2FjdGlvblJlcXVlc3QaKy5zcWxycGMudjEuRm9yY2VSb2xsYmFja1RyYW5zYWN0aW9uUmVzcG9uc2USWAoPTGlzdEF1ZGl0RXZlbnRzEiEuc3FscnBjLnYxLkxpc3RBdWRpdEV2ZW50c1JlcXVlc3QaIi5zcWxycGMudjEuTGlzdEF1ZGl0RXZlbnRzUmVzcG9uc2USRwoNR2V0U2VydmVySW5mbxIfLnNxbHJwYy52MS5HZXRTZXJ2ZXJJbmZvUmVxdWVzdBoVLnNxbHJwYy52MS5TZXJ2ZXJJbmZvEk8KDEdldExvZ0xldmVscxIeLnNxbHJwYy52MS5HZXRMb2dMZXZlbHNSZXF1ZXN0Gh8uc3FscnBjLnYxLkdldExvZ0xldmVsc1Jlc3BvbnNlEkwKC1NldExvZ0xldmVsEh0uc3FscnBjLnYxLlNldExvZ0xldmVsUmVxdWVzdBoeLnNxbHJwYy52MS5TZXRMb2dMZXZlbFJlc3BvbnNlEjoKBUxvZ2luEhcuc3FscnBjLnYxLkxvZ2luUmVxdWVzdBoYLnNxbHJwYy52MS5Mb2dpblJlc3BvbnNlEj0KBkxvZ291dBIYLnNxbHJwYy52MS5Mb2dvdXRSZXF1ZXN0Ghkuc3FscnBjLnYxLkxvZ291dFJlc3BvbnNlElUKDlVwZGF0ZVBhc3N3b3JkEiAuc3FscnBjLnYxLlVwZGF0ZVBhc3N3b3JkUmVxdWVzdBohLnNxbHJwYy52MS5VcGRhdGVQYXNzd29yZFJlc3BvbnNlElcKEVN0cmVhbVJlcGxpY2F0aW9uEiMuc3FscnBjLnYxLlN0cmVhbVJlcGxpY2F0aW9uUmVxdWVzdBobLnNxbHJwYy52MS5SZXBsaWNhdGlvbkZyYW1lMAFCMlowc3FsaXRlLXNlcnZlci9pbnRlcm5hbC9wcm90b3Mvc3FscnBjL3YxO3NxbHJwY3YxYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_timestamp, file_sqlrpc_v1_enums, file_sqlrpc_v1_types]);
 
 /**
  * *
@@ -224,6 +224,57 @@ export type DeleteUserResponse = Message<"sqlrpc.v1.DeleteUserResponse"> & {
 export const DeleteUserResponseSchema: GenMessage<DeleteUserResponse> = /*@__PURE__*/
   messageDesc(file_sqlrpc_v1_admin_service, 7);
 
+/**
+ * *
+ * Request to lift a login lockout.
+ *
+ * @generated from message sqlrpc.v1.UnlockUserRequest
+ */
+export type UnlockUserRequest = Message<"sqlrpc.v1.UnlockUserRequest"> & {
+  /**
+   * Username whose failed login attempts are cleared.
+   *
+   * @generated from field: string username = 1;
+   */
+  username: string;
+
+  /**
+   * Optional client IP address whose failed login attempts are cleared too.
+   *
+   * @generated from field: string client_ip = 2;
+   */
+  clientIp: string;
+};
+
+/**
+ * Describes the message sqlrpc.v1.UnlockUserRequest.
+ * Use `create(UnlockUserRequestSchema)` to create a new message.
+ */
+export const UnlockUserRequestSchema: GenMessage<UnlockUserRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 8);
+
+/**
+ * *
+ * Outcome of a lockout reset.
+ *
+ * @generated from message sqlrpc.v1.UnlockUserResponse
+ */
+export type UnlockUserResponse = Message<"sqlrpc.v1.UnlockUserResponse"> & {
+  /**
+   * True if the username or client IP had failed attempts recorded.
+   *
+   * @generated from field: bool unlocked = 1;
+   */
+  unlocked: boolean;
+};
+
+/**
+ * Describes the message sqlrpc.v1.UnlockUserResponse.
+ * Use `create(UnlockUserResponseSchema)` to create a new message.
+ */
+export const UnlockUserResponseSchema: GenMessage<UnlockUserResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 9);
+
 /**
  * *
  * Payload to modify a user's credential.
@@ -251,7 +302,7 @@ export type UpdatePasswordRequest = Message<"sqlrpc.v1.UpdatePasswordRequest"> &
  * Use `create(UpdatePasswordRequestSchema)` to create a new message.
  */
 export const UpdatePasswordRequestSchema: GenMessage<UpdatePasswordRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 8);
+  messageDesc(file_sqlrpc_v1_admin_service, 10);
 
 /**
  * *
@@ -273,7 +324,7 @@ export type UpdatePasswordResponse = Message<"sqlrpc.v1.UpdatePasswordResponse">
  * Use `create(UpdatePasswordResponseSchema)` to create a new message.
  */
 export const UpdatePasswordResponseSchema: GenMessage<UpdatePasswordResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 9);
+  messageDesc(file_sqlrpc_v1_admin_service, 11);
 
 /**
  * *
@@ -295,7 +346,7 @@ export type ListAPIKeysRequest = Message<"sqlrpc.v1.ListAPIKeysRequest"> & {
  * Use `create(ListAPIKeysRequestSchema)` to create a new message.
  */
 export const ListAPIKeysRequestSchema: GenMessage<ListAPIKeysRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 10);
+  messageDesc(file_sqlrpc_v1_admin_service, 12);
 
 /**
  * *
@@ -317,7 +368,7 @@ export type ListAPIKeysResponse = Message<"sqlrpc.v1.ListAPIKeysResponse"> & {
  * Use `create(ListAPIKeysResponseSchema)` to create a new message.
  */
 export const ListAPIKeysResponseSchema: GenMessage<ListAPIKeysResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 11);
+  messageDesc(file_sqlrpc_v1_admin_service, 13);
 
 /**
  * *
@@ -360,6 +411,13 @@ export type APIKey = Message<"sqlrpc.v1.APIKey"> & {
    * @generated from field: google.protobuf.Timestamp expires_at = 5;
    */
   expiresAt?: Timestamp;
+
+  /**
+   * Restrictions applied to the key. Unset if the key is unrestricted.
+   *
+   * @generated from field: sqlrpc.v1.APIKeyScope scope = 6;
+   */
+  scope?: APIKeyScope;
 };
 
 /**
@@ -367,7 +425,52 @@ export type APIKey = Message<"sqlrpc.v1.APIKey"> & {
  * Use `create(APIKeySchema)` to create a new message.
  */
 export const APIKeySchema: GenMessage<APIKey> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 12);
+  messageDesc(file_sqlrpc_v1_admin_service, 14);
+
+/**
+ * *
+ * Restrictions applied to a single API key on top of its owner's role.
+ * Empty fields leave that dimension unrestricted.
+ *
+ * @generated from message sqlrpc.v1.APIKeyScope
+ */
+export type APIKeyScope = Message<"sqlrpc.v1.APIKeyScope"> & {
+  /**
+   * Database names or glob patterns (e.g. tenant_*) the key may access.
+   *
+   * @generated from field: repeated string databases = 1;
+   */
+  databases: string[];
+
+  /**
+   * Highest role the key may act with (e.g. a read-only key for an admin).
+   *
+   * @generated from field: sqlrpc.v1.Role max_role = 2;
+   */
+  maxRole: Role;
+
+  /**
+   * RPC families the key may call.
+   *
+   * @generated from field: repeated sqlrpc.v1.RpcFamily families = 3;
+   */
+  families: RpcFamily[];
+
+  /**
+   * Pub/Sub channel names or glob patterns the key may publish or subscribe
+   * to.
+   *
+   * @generated from field: repeated string channels = 4;
+   */
+  channels: string[];
+};
+
+/**
+ * Describes the message sqlrpc.v1.APIKeyScope.
+ * Use `create(APIKeyScopeSchema)` to create a new message.
+ */
+export const APIKeyScopeSchema: GenMessage<APIKeyScope> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 15);
 
 /**
  * *
@@ -396,6 +499,14 @@ export type CreateAPIKeyRequest = Message<"sqlrpc.v1.CreateAPIKeyRequest"> & {
    * @generated from field: google.protobuf.Timestamp expires_at = 3;
    */
   expiresAt?: Timestamp;
+
+  /**
+   * Optional restrictions for the key. Omit for a key with the owner's full
+   * access.
+   *
+   * @generated from field: sqlrpc.v1.APIKeyScope scope = 4;
+   */
+  scope?: APIKeyScope;
 };
 
 /**
@@ -403,7 +514,7 @@ export type CreateAPIKeyRequest = Message<"sqlrpc.v1.CreateAPIKeyRequest"> & {
  * Use `create(CreateAPIKeyRequestSchema)` to create a new message.
  */
 export const CreateAPIKeyRequestSchema: GenMessage<CreateAPIKeyRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 13);
+  messageDesc(file_sqlrpc_v1_admin_service, 16);
 
 /**
  * *
@@ -439,7 +550,7 @@ export type CreateAPIKeyResponse = Message<"sqlrpc.v1.CreateAPIKeyResponse"> & {
  * Use `create(CreateAPIKeyResponseSchema)` to create a new message.
  */
 export const CreateAPIKeyResponseSchema: GenMessage<CreateAPIKeyResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 14);
+  messageDesc(file_sqlrpc_v1_admin_service, 17);
 
 /**
  * *
@@ -468,7 +579,7 @@ export type DeleteAPIKeyRequest = Message<"sqlrpc.v1.DeleteAPIKeyRequest"> & {
  * Use `create(DeleteAPIKeyRequestSchema)` to create a new message.
  */
 export const DeleteAPIKeyRequestSchema: GenMessage<DeleteAPIKeyRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 15);
+  messageDesc(file_sqlrpc_v1_admin_service, 18);
 
 /**
  * *
@@ -490,419 +601,1980 @@ export type DeleteAPIKeyResponse = Message<"sqlrpc.v1.DeleteAPIKeyResponse"> & {
  * Use `create(DeleteAPIKeyResponseSchema)` to create a new message.
  */
 export const DeleteAPIKeyResponseSchema: GenMessage<DeleteAPIKeyResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 16);
-
-/**
- * *
- * Unary request to retrieve the full catalog of tenant databases.
- *
- * @generated from message sqlrpc.v1.ListDatabasesRequest
- */
-export type ListDatabasesRequest = Message<"sqlrpc.v1.ListDatabasesRequest"> & {
-};
-
-/**
- * Describes the message sqlrpc.v1.ListDatabasesRequest.
- * Use `create(ListDatabasesRequestSchema)` to create a new message.
- */
-export const ListDatabasesRequestSchema: GenMessage<ListDatabasesRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 17);
+  messageDesc(file_sqlrpc_v1_admin_service, 19);
 
 /**
  * *
- * Catalog of databases managed by the platform.
+ * A per-database role assignment. Once a user holds any grant, their data
+ * plane access is limited to the databases matched by their grants.
  *
- * @generated from message sqlrpc.v1.ListDatabasesResponse
+ * @generated from message sqlrpc.v1.DatabaseGrant
  */
-export type ListDatabasesResponse = Message<"sqlrpc.v1.ListDatabasesResponse"> & {
+export type DatabaseGrant = Message<"sqlrpc.v1.DatabaseGrant"> & {
   /**
-   * Collection of discovered tenant databases.
+   * Unique grant identifier.
    *
-   * @generated from field: repeated sqlrpc.v1.DatabaseInfo databases = 1;
+   * @generated from field: int64 id = 1;
    */
-  databases: DatabaseInfo[];
-};
-
-/**
- * Describes the message sqlrpc.v1.ListDatabasesResponse.
- * Use `create(ListDatabasesResponseSchema)` to create a new message.
- */
-export const ListDatabasesResponseSchema: GenMessage<ListDatabasesResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 18);
+  id: bigint;
 
-/**
- * *
- * Payload to create a new managed database.
- *
- * @generated from message sqlrpc.v1.CreateDatabaseRequest
- */
-export type CreateDatabaseRequest = Message<"sqlrpc.v1.CreateDatabaseRequest"> & {
   /**
-   * Unique name for the database.
+   * Owner of the grant (for key grants, the owner of the key).
    *
-   * @generated from field: string name = 1;
+   * @generated from field: string username = 2;
    */
-  name: string;
+  username: string;
 
   /**
-   * Enable encryption (SQLCipher) if true.
+   * API key the grant is scoped to. Empty for user-wide grants.
    *
-   * @generated from field: bool is_encrypted = 2;
+   * @generated from field: string api_key_id = 3;
    */
-  isEncrypted: boolean;
+  apiKeyId: string;
 
   /**
-   * Encryption key for SQLCipher.
+   * Exact database name or glob pattern (e.g. tenant_*).
    *
-   * @generated from field: string key = 3;
+   * @generated from field: string database = 4;
    */
-  key: string;
+  database: string;
 
   /**
-   * List of extensions to preload.
+   * Role applied on matching databases.
    *
-   * @generated from field: repeated string extensions = 4;
+   * @generated from field: sqlrpc.v1.Role role = 5;
    */
-  extensions: string[];
+  role: Role;
 
   /**
-   * Map of PRAGMAs to apply.
+   * Timestamp when the grant was created.
    *
-   * @generated from field: map<string, string> pragmas = 5;
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
    */
-  pragmas: { [key: string]: string };
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message sqlrpc.v1.DatabaseGrant.
+ * Use `create(DatabaseGrantSchema)` to create a new message.
+ */
+export const DatabaseGrantSchema: GenMessage<DatabaseGrant> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 20);
 
+/**
+ * *
+ * Payload to assign a per-database role.
+ *
+ * @generated from message sqlrpc.v1.GrantDatabaseAccessRequest
+ */
+export type GrantDatabaseAccessRequest = Message<"sqlrpc.v1.GrantDatabaseAccessRequest"> & {
   /**
-   * Limit for concurrent connections.
+   * Identity receiving the grant.
    *
-   * @generated from field: int32 max_open_conns = 6;
+   * @generated from oneof sqlrpc.v1.GrantDatabaseAccessRequest.grantee
    */
-  maxOpenConns: number;
+  grantee: {
+    /**
+     * Grant applies to every credential of this user.
+     *
+     * @generated from field: string username = 1;
+     */
+    value: string;
+    case: "username";
+  } | {
+    /**
+     * Grant narrows a single API key.
+     *
+     * @generated from field: string api_key_id = 2;
+     */
+    value: string;
+    case: "apiKeyId";
+  } | { case: undefined; value?: undefined };
 
   /**
-   * Limit for idle connections.
+   * Exact database name or glob pattern (e.g. tenant_*).
    *
-   * @generated from field: int32 max_idle_conns = 7;
+   * @generated from field: string database = 3;
    */
-  maxIdleConns: number;
+  database: string;
 
   /**
-   * Connection lifetime in milliseconds.
+   * Role to apply on matching databases.
    *
-   * @generated from field: int32 conn_max_lifetime_ms = 8;
+   * @generated from field: sqlrpc.v1.Role role = 4;
    */
-  connMaxLifetimeMs: number;
+  role: Role;
 };
 
 /**
- * Describes the message sqlrpc.v1.CreateDatabaseRequest.
- * Use `create(CreateDatabaseRequestSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.GrantDatabaseAccessRequest.
+ * Use `create(GrantDatabaseAccessRequestSchema)` to create a new message.
  */
-export const CreateDatabaseRequestSchema: GenMessage<CreateDatabaseRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 19);
+export const GrantDatabaseAccessRequestSchema: GenMessage<GrantDatabaseAccessRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 21);
 
 /**
  * *
- * Confirmation of database creation.
+ * Confirms the stored grant.
  *
- * @generated from message sqlrpc.v1.CreateDatabaseResponse
+ * @generated from message sqlrpc.v1.GrantDatabaseAccessResponse
  */
-export type CreateDatabaseResponse = Message<"sqlrpc.v1.CreateDatabaseResponse"> & {
-  /**
-   * True if the creation succeeded.
-   *
-   * @generated from field: bool success = 1;
-   */
-  success: boolean;
-
+export type GrantDatabaseAccessResponse = Message<"sqlrpc.v1.GrantDatabaseAccessResponse"> & {
   /**
-   * Success or error message.
+   * The created or updated grant.
    *
-   * @generated from field: string message = 2;
+   * @generated from field: sqlrpc.v1.DatabaseGrant grant = 1;
    */
-  message: string;
+  grant?: DatabaseGrant;
 };
 
 /**
- * Describes the message sqlrpc.v1.CreateDatabaseResponse.
- * Use `create(CreateDatabaseResponseSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.GrantDatabaseAccessResponse.
+ * Use `create(GrantDatabaseAccessResponseSchema)` to create a new message.
  */
-export const CreateDatabaseResponseSchema: GenMessage<CreateDatabaseResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 20);
+export const GrantDatabaseAccessResponseSchema: GenMessage<GrantDatabaseAccessResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 22);
 
 /**
  * *
- * Payload to update a tenant's runtime configuration.
+ * Unary request to remove a per-database grant.
  *
- * @generated from message sqlrpc.v1.UpdateDatabaseRequest
+ * @generated from message sqlrpc.v1.RevokeDatabaseAccessRequest
  */
-export type UpdateDatabaseRequest = Message<"sqlrpc.v1.UpdateDatabaseRequest"> & {
+export type RevokeDatabaseAccessRequest = Message<"sqlrpc.v1.RevokeDatabaseAccessRequest"> & {
   /**
-   * Tenant name to modify.
-   *
-   * @generated from field: string name = 1;
-   */
-  name: string;
-
-  /**
-   * Partial configuration updates.
+   * Identifier of the grant to remove.
    *
-   * @generated from field: sqlrpc.v1.UpdateDatabaseConfig config = 2;
+   * @generated from field: int64 grant_id = 1;
    */
-  config?: UpdateDatabaseConfig;
+  grantId: bigint;
 };
 
 /**
- * Describes the message sqlrpc.v1.UpdateDatabaseRequest.
- * Use `create(UpdateDatabaseRequestSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.RevokeDatabaseAccessRequest.
+ * Use `create(RevokeDatabaseAccessRequestSchema)` to create a new message.
  */
-export const UpdateDatabaseRequestSchema: GenMessage<UpdateDatabaseRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 21);
+export const RevokeDatabaseAccessRequestSchema: GenMessage<RevokeDatabaseAccessRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 23);
 
 /**
  * *
- * Confirmation of database update.
+ * Confirms the successful removal of the grant.
  *
- * @generated from message sqlrpc.v1.UpdateDatabaseResponse
+ * @generated from message sqlrpc.v1.RevokeDatabaseAccessResponse
  */
-export type UpdateDatabaseResponse = Message<"sqlrpc.v1.UpdateDatabaseResponse"> & {
+export type RevokeDatabaseAccessResponse = Message<"sqlrpc.v1.RevokeDatabaseAccessResponse"> & {
   /**
-   * True if the update was persists successfully.
+   * True if the grant was removed successfully.
    *
    * @generated from field: bool success = 1;
    */
   success: boolean;
-
-  /**
-   * Success or error message.
-   *
-   * @generated from field: string message = 2;
-   */
-  message: string;
 };
 
 /**
- * Describes the message sqlrpc.v1.UpdateDatabaseResponse.
- * Use `create(UpdateDatabaseResponseSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.RevokeDatabaseAccessResponse.
+ * Use `create(RevokeDatabaseAccessResponseSchema)` to create a new message.
  */
-export const UpdateDatabaseResponseSchema: GenMessage<UpdateDatabaseResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 22);
+export const RevokeDatabaseAccessResponseSchema: GenMessage<RevokeDatabaseAccessResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 24);
 
 /**
  * *
- * Command to decommission a tenant database and wipe its data.
+ * Request to list per-database grants.
  *
- * @generated from message sqlrpc.v1.DeleteDatabaseRequest
+ * @generated from message sqlrpc.v1.ListDatabaseGrantsRequest
  */
-export type DeleteDatabaseRequest = Message<"sqlrpc.v1.DeleteDatabaseRequest"> & {
+export type ListDatabaseGrantsRequest = Message<"sqlrpc.v1.ListDatabaseGrantsRequest"> & {
   /**
-   * Tenant name to remove.
+   * Optional username filter.
    *
-   * @generated from field: string name = 1;
+   * @generated from field: string username = 1;
    */
-  name: string;
+  username: string;
+
+  /**
+   * Optional exact database pattern filter.
+   *
+   * @generated from field: string database = 2;
+   */
+  database: string;
 };
 
 /**
- * Describes the message sqlrpc.v1.DeleteDatabaseRequest.
- * Use `create(DeleteDatabaseRequestSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.ListDatabaseGrantsRequest.
+ * Use `create(ListDatabaseGrantsRequestSchema)` to create a new message.
  */
-export const DeleteDatabaseRequestSchema: GenMessage<DeleteDatabaseRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 23);
+export const ListDatabaseGrantsRequestSchema: GenMessage<ListDatabaseGrantsRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 25);
 
 /**
  * *
- * Confirms the successful destruction of the database.
+ * Catalog of per-database grants.
  *
- * @generated from message sqlrpc.v1.DeleteDatabaseResponse
+ * @generated from message sqlrpc.v1.ListDatabaseGrantsResponse
  */
-export type DeleteDatabaseResponse = Message<"sqlrpc.v1.DeleteDatabaseResponse"> & {
-  /**
-   * True if the deletion succeeded.
-   *
-   * @generated from field: bool success = 1;
-   */
-  success: boolean;
-
+export type ListDatabaseGrantsResponse = Message<"sqlrpc.v1.ListDatabaseGrantsResponse"> & {
   /**
-   * Success or error message.
+   * Collection of matching grants.
    *
-   * @generated from field: string message = 2;
+   * @generated from field: repeated sqlrpc.v1.DatabaseGrant grants = 1;
    */
-  message: string;
+  grants: DatabaseGrant[];
 };
 
 /**
- * Describes the message sqlrpc.v1.DeleteDatabaseResponse.
- * Use `create(DeleteDatabaseResponseSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.ListDatabaseGrantsResponse.
+ * Use `create(ListDatabaseGrantsResponseSchema)` to create a new message.
  */
-export const DeleteDatabaseResponseSchema: GenMessage<DeleteDatabaseResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 24);
+export const ListDatabaseGrantsResponseSchema: GenMessage<ListDatabaseGrantsResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 26);
 
 /**
  * *
- * Payload to register an existing SQLite file into the platform.
+ * Traffic limits of a user or API key. Every limit that matches a call applies
+ * on its own; zero values are unlimited.
  *
- * @generated from message sqlrpc.v1.MountDatabaseRequest
+ * @generated from message sqlrpc.v1.RateLimit
  */
-export type MountDatabaseRequest = Message<"sqlrpc.v1.MountDatabaseRequest"> & {
+export type RateLimit = Message<"sqlrpc.v1.RateLimit"> & {
   /**
-   * Unique name to assign to the mounted database.
+   * Unique limit identifier.
    *
-   * @generated from field: string name = 1;
+   * @generated from field: int64 id = 1;
    */
-  name: string;
+  id: bigint;
+
+  /**
+   * Owner of the limit (for key limits, the owner of the key).
+   *
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * API key the limit is scoped to. Empty for user-wide limits.
+   *
+   * @generated from field: string api_key_id = 3;
+   */
+  apiKeyId: string;
+
+  /**
+   * Exact database name or glob pattern. "*" also covers calls that do not
+   * target a database.
+   *
+   * @generated from field: string database = 4;
+   */
+  database: string;
+
+  /**
+   * Sustained calls per second.
+   *
+   * @generated from field: double requests_per_second = 5;
+   */
+  requestsPerSecond: number;
+
+  /**
+   * Calls allowed at once (token bucket size). 0 means one second's worth.
+   *
+   * @generated from field: int32 burst = 6;
+   */
+  burst: number;
+
+  /**
+   * Concurrent streaming calls.
+   *
+   * @generated from field: int32 max_streams = 7;
+   */
+  maxStreams: number;
+
+  /**
+   * Concurrent transactions (ID-based sessions and Transaction streams).
+   *
+   * @generated from field: int32 max_transactions = 8;
+   */
+  maxTransactions: number;
+
+  /**
+   * Result rows returned per minute.
+   *
+   * @generated from field: int64 rows_per_minute = 9;
+   */
+  rowsPerMinute: bigint;
+
+  /**
+   * Response bytes returned per minute.
+   *
+   * @generated from field: int64 bytes_per_minute = 10;
+   */
+  bytesPerMinute: bigint;
+
+  /**
+   * Timestamp when the limit was created.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 11;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message sqlrpc.v1.RateLimit.
+ * Use `create(RateLimitSchema)` to create a new message.
+ */
+export const RateLimitSchema: GenMessage<RateLimit> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 27);
+
+/**
+ * *
+ * Payload to set the limits of a user or API key.
+ *
+ * @generated from message sqlrpc.v1.SetRateLimitRequest
+ */
+export type SetRateLimitRequest = Message<"sqlrpc.v1.SetRateLimitRequest"> & {
+  /**
+   * Identity the limit applies to.
+   *
+   * @generated from oneof sqlrpc.v1.SetRateLimitRequest.subject
+   */
+  subject: {
+    /**
+     * Limit shared by every credential of this user.
+     *
+     * @generated from field: string username = 1;
+     */
+    value: string;
+    case: "username";
+  } | {
+    /**
+     * Limit of a single API key.
+     *
+     * @generated from field: string api_key_id = 2;
+     */
+    value: string;
+    case: "apiKeyId";
+  } | { case: undefined; value?: undefined };
+
+  /**
+   * Exact database name or glob pattern. Empty means "*".
+   *
+   * @generated from field: string database = 3;
+   */
+  database: string;
+
+  /**
+   * Sustained calls per second.
+   *
+   * @generated from field: double requests_per_second = 4;
+   */
+  requestsPerSecond: number;
+
+  /**
+   * Calls allowed at once (token bucket size). 0 means one second's worth.
+   *
+   * @generated from field: int32 burst = 5;
+   */
+  burst: number;
+
+  /**
+   * Concurrent streaming calls.
+   *
+   * @generated from field: int32 max_streams = 6;
+   */
+  maxStreams: number;
+
+  /**
+   * Concurrent transactions.
+   *
+   * @generated from field: int32 max_transactions = 7;
+   */
+  maxTransactions: number;
+
+  /**
+   * Result rows returned per minute.
+   *
+   * @generated from field: int64 rows_per_minute = 8;
+   */
+  rowsPerMinute: bigint;
+
+  /**
+   * Response bytes returned per minute.
+   *
+   * @generated from field: int64 bytes_per_minute = 9;
+   */
+  bytesPerMinute: bigint;
+};
+
+/**
+ * Describes the message sqlrpc.v1.SetRateLimitRequest.
+ * Use `create(SetRateLimitRequestSchema)` to create a new message.
+ */
+export const SetRateLimitRequestSchema: GenMessage<SetRateLimitRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 28);
+
+/**
+ * *
+ * Confirms the stored limit.
+ *
+ * @generated from message sqlrpc.v1.SetRateLimitResponse
+ */
+export type SetRateLimitResponse = Message<"sqlrpc.v1.SetRateLimitResponse"> & {
+  /**
+   * The created or updated limit.
+   *
+   * @generated from field: sqlrpc.v1.RateLimit limit = 1;
+   */
+  limit?: RateLimit;
+};
+
+/**
+ * Describes the message sqlrpc.v1.SetRateLimitResponse.
+ * Use `create(SetRateLimitResponseSchema)` to create a new message.
+ */
+export const SetRateLimitResponseSchema: GenMessage<SetRateLimitResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 29);
+
+/**
+ * *
+ * Unary request to remove a rate limit.
+ *
+ * @generated from message sqlrpc.v1.DeleteRateLimitRequest
+ */
+export type DeleteRateLimitRequest = Message<"sqlrpc.v1.DeleteRateLimitRequest"> & {
+  /**
+   * Identifier of the limit to remove.
+   *
+   * @generated from field: int64 limit_id = 1;
+   */
+  limitId: bigint;
+};
+
+/**
+ * Describes the message sqlrpc.v1.DeleteRateLimitRequest.
+ * Use `create(DeleteRateLimitRequestSchema)` to create a new message.
+ */
+export const DeleteRateLimitRequestSchema: GenMessage<DeleteRateLimitRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 30);
+
+/**
+ * *
+ * Confirms the successful removal of the limit.
+ *
+ * @generated from message sqlrpc.v1.DeleteRateLimitResponse
+ */
+export type DeleteRateLimitResponse = Message<"sqlrpc.v1.DeleteRateLimitResponse"> & {
+  /**
+   * True if the limit was removed successfully.
+   *
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+};
+
+/**
+ * Describes the message sqlrpc.v1.DeleteRateLimitResponse.
+ * Use `create(DeleteRateLimitResponseSchema)` to create a new message.
+ */
+export const DeleteRateLimitResponseSchema: GenMessage<DeleteRateLimitResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 31);
+
+/**
+ * *
+ * Request to list rate limits.
+ *
+ * @generated from message sqlrpc.v1.ListRateLimitsRequest
+ */
+export type ListRateLimitsRequest = Message<"sqlrpc.v1.ListRateLimitsRequest"> & {
+  /**
+   * Optional username filter.
+   *
+   * @generated from field: string username = 1;
+   */
+  username: string;
+
+  /**
+   * Optional exact database pattern filter.
+   *
+   * @generated from field: string database = 2;
+   */
+  database: string;
+};
+
+/**
+ * Describes the message sqlrpc.v1.ListRateLimitsRequest.
+ * Use `create(ListRateLimitsRequestSchema)` to create a new message.
+ */
+export const ListRateLimitsRequestSchema: GenMessage<ListRateLimitsRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 32);
+
+/**
+ * *
+ * Catalog of rate limits.
+ *
+ * @generated from message sqlrpc.v1.ListRateLimitsResponse
+ */
+export type ListRateLimitsResponse = Message<"sqlrpc.v1.ListRateLimitsResponse"> & {
+  /**
+   * Collection of matching limits.
+   *
+   * @generated from field: repeated sqlrpc.v1.RateLimit limits = 1;
+   */
+  limits: RateLimit[];
+};
+
+/**
+ * Describes the message sqlrpc.v1.ListRateLimitsResponse.
+ * Use `create(ListRateLimitsResponseSchema)` to create a new message.
+ */
+export const ListRateLimitsResponseSchema: GenMessage<ListRateLimitsResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 33);
+
+/**
+ * *
+ * A predicate limiting the rows of a table that non-admin callers can see.
+ *
+ * @generated from message sqlrpc.v1.RowPolicy
+ */
+export type RowPolicy = Message<"sqlrpc.v1.RowPolicy"> & {
+  /**
+   * Unique policy identifier.
+   *
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * Database the table belongs to.
+   *
+   * @generated from field: string database = 2;
+   */
+  database: string;
+
+  /**
+   * Table the policy applies to.
+   *
+   * @generated from field: string table = 3;
+   */
+  table: string;
+
+  /**
+   * Policy name, unique per table.
+   *
+   * @generated from field: string name = 4;
+   */
+  name: string;
+
+  /**
+   * SQL expression over the table's columns, e.g. "owner = current_user()".
+   *
+   * @generated from field: string using = 5;
+   */
+  using: string;
+
+  /**
+   * Timestamp when the policy was created.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message sqlrpc.v1.RowPolicy.
+ * Use `create(RowPolicySchema)` to create a new message.
+ */
+export const RowPolicySchema: GenMessage<RowPolicy> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 34);
+
+/**
+ * *
+ * Payload to create a row policy.
+ *
+ * @generated from message sqlrpc.v1.CreateRowPolicyRequest
+ */
+export type CreateRowPolicyRequest = Message<"sqlrpc.v1.CreateRowPolicyRequest"> & {
+  /**
+   * Database the table belongs to.
+   *
+   * @generated from field: string database = 1;
+   */
+  database: string;
+
+  /**
+   * Table in the main schema of the database.
+   *
+   * @generated from field: string table = 2;
+   */
+  table: string;
+
+  /**
+   * Policy name, unique per table.
+   *
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * SQL expression that must be true for a row to be visible. It cannot use
+   * parameters and must compile against the table.
+   *
+   * @generated from field: string using = 4;
+   */
+  using: string;
+};
+
+/**
+ * Describes the message sqlrpc.v1.CreateRowPolicyRequest.
+ * Use `create(CreateRowPolicyRequestSchema)` to create a new message.
+ */
+export const CreateRowPolicyRequestSchema: GenMessage<CreateRowPolicyRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 35);
+
+/**
+ * *
+ * Confirms the stored policy.
+ *
+ * @generated from message sqlrpc.v1.CreateRowPolicyResponse
+ */
+export type CreateRowPolicyResponse = Message<"sqlrpc.v1.CreateRowPolicyResponse"> & {
+  /**
+   * The created policy.
+   *
+   * @generated from field: sqlrpc.v1.RowPolicy policy = 1;
+   */
+  policy?: RowPolicy;
+};
+
+/**
+ * Describes the message sqlrpc.v1.CreateRowPolicyResponse.
+ * Use `create(CreateRowPolicyResponseSchema)` to create a new message.
+ */
+export const CreateRowPolicyResponseSchema: GenMessage<CreateRowPolicyResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 36);
+
+/**
+ * *
+ * Unary request to remove a row policy.
+ *
+ * @generated from message sqlrpc.v1.DeleteRowPolicyRequest
+ */
+export type DeleteRowPolicyRequest = Message<"sqlrpc.v1.DeleteRowPolicyRequest"> & {
+  /**
+   * Identifier of the policy to remove.
+   *
+   * @generated from field: int64 policy_id = 1;
+   */
+  policyId: bigint;
+};
+
+/**
+ * Describes the message sqlrpc.v1.DeleteRowPolicyRequest.
+ * Use `create(DeleteRowPolicyRequestSchema)` to create a new message.
+ */
+export const DeleteRowPolicyRequestSchema: GenMessage<DeleteRowPolicyRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 37);
+
+/**
+ * *
+ * Confirms the successful removal of the policy.
+ *
+ * @generated from message sqlrpc.v1.DeleteRowPolicyResponse
+ */
+export type DeleteRowPolicyResponse = Message<"sqlrpc.v1.DeleteRowPolicyResponse"> & {
+  /**
+   * True if the policy was removed successfully.
+   *
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+};
+
+/**
+ * Describes the message sqlrpc.v1.DeleteRowPolicyResponse.
+ * Use `create(DeleteRowPolicyResponseSchema)` to create a new message.
+ */
+export const DeleteRowPolicyResponseSchema: GenMessage<DeleteRowPolicyResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 38);
+
+/**
+ * *
+ * Request to list row policies.
+ *
+ * @generated from message sqlrpc.v1.ListRowPoliciesRequest
+ */
+export type ListRowPoliciesRequest = Message<"sqlrpc.v1.ListRowPoliciesRequest"> & {
+  /**
+   * Optional database filter.
+   *
+   * @generated from field: string database = 1;
+   */
+  database: string;
+};
+
+/**
+ * Describes the message sqlrpc.v1.ListRowPoliciesRequest.
+ * Use `create(ListRowPoliciesRequestSchema)` to create a new message.
+ */
+export const ListRowPoliciesRequestSchema: GenMessage<ListRowPoliciesRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 39);
+
+/**
+ * *
+ * Catalog of row policies.
+ *
+ * @generated from message sqlrpc.v1.ListRowPoliciesResponse
+ */
+export type ListRowPoliciesResponse = Message<"sqlrpc.v1.ListRowPoliciesResponse"> & {
+  /**
+   * Collection of matching policies.
+   *
+   * @generated from field: repeated sqlrpc.v1.RowPolicy policies = 1;
+   */
+  policies: RowPolicy[];
+};
+
+/**
+ * Describes the message sqlrpc.v1.ListRowPoliciesResponse.
+ * Use `create(ListRowPoliciesResponseSchema)` to create a new message.
+ */
+export const ListRowPoliciesResponseSchema: GenMessage<ListRowPoliciesResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 40);
+
+/**
+ * *
+ * Denies or masks reads of one column for a role or a user.
+ *
+ * @generated from message sqlrpc.v1.ColumnPolicy
+ */
+export type ColumnPolicy = Message<"sqlrpc.v1.ColumnPolicy"> & {
+  /**
+   * Unique policy identifier.
+   *
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * Database the table belongs to.
+   *
+   * @generated from field: string database = 2;
+   */
+  database: string;
+
+  /**
+   * Table of the column.
+   *
+   * @generated from field: string table = 3;
+   */
+  table: string;
+
+  /**
+   * Restricted column.
+   *
+   * @generated from field: string column = 4;
+   */
+  column: string;
+
+  /**
+   * What a read of the column does.
+   *
+   * @generated from field: sqlrpc.v1.ColumnAction action = 5;
+   */
+  action: ColumnAction;
+
+  /**
+   * Role the policy applies to. Unspecified for user policies.
+   *
+   * @generated from field: sqlrpc.v1.Role role = 6;
+   */
+  role: Role;
+
+  /**
+   * User the policy applies to. Empty for role policies.
+   *
+   * @generated from field: string username = 7;
+   */
+  username: string;
+
+  /**
+   * Timestamp when the policy was created.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 8;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message sqlrpc.v1.ColumnPolicy.
+ * Use `create(ColumnPolicySchema)` to create a new message.
+ */
+export const ColumnPolicySchema: GenMessage<ColumnPolicy> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 41);
+
+/**
+ * *
+ * Payload to set a column policy.
+ *
+ * @generated from message sqlrpc.v1.SetColumnPolicyRequest
+ */
+export type SetColumnPolicyRequest = Message<"sqlrpc.v1.SetColumnPolicyRequest"> & {
+  /**
+   * Database the table belongs to.
+   *
+   * @generated from field: string database = 1;
+   */
+  database: string;
+
+  /**
+   * Table in the main schema of the database.
+   *
+   * @generated from field: string table = 2;
+   */
+  table: string;
+
+  /**
+   * Restricted column.
+   *
+   * @generated from field: string column = 3;
+   */
+  column: string;
+
+  /**
+   * What a read of the column does.
+   *
+   * @generated from field: sqlrpc.v1.ColumnAction action = 4;
+   */
+  action: ColumnAction;
+
+  /**
+   * Callers the policy applies to.
+   *
+   * @generated from oneof sqlrpc.v1.SetColumnPolicyRequest.subject
+   */
+  subject: {
+    /**
+     * Every caller whose role on the database is exactly this one. Admins are
+     * never restricted.
+     *
+     * @generated from field: sqlrpc.v1.Role role = 5;
+     */
+    value: Role;
+    case: "role";
+  } | {
+    /**
+     * A single user.
+     *
+     * @generated from field: string username = 6;
+     */
+    value: string;
+    case: "username";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message sqlrpc.v1.SetColumnPolicyRequest.
+ * Use `create(SetColumnPolicyRequestSchema)` to create a new message.
+ */
+export const SetColumnPolicyRequestSchema: GenMessage<SetColumnPolicyRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 42);
+
+/**
+ * *
+ * Confirms the stored policy.
+ *
+ * @generated from message sqlrpc.v1.SetColumnPolicyResponse
+ */
+export type SetColumnPolicyResponse = Message<"sqlrpc.v1.SetColumnPolicyResponse"> & {
+  /**
+   * The created or updated policy.
+   *
+   * @generated from field: sqlrpc.v1.ColumnPolicy policy = 1;
+   */
+  policy?: ColumnPolicy;
+};
+
+/**
+ * Describes the message sqlrpc.v1.SetColumnPolicyResponse.
+ * Use `create(SetColumnPolicyResponseSchema)` to create a new message.
+ */
+export const SetColumnPolicyResponseSchema: GenMessage<SetColumnPolicyResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 43);
+
+/**
+ * *
+ * Unary request to remove a column policy.
+ *
+ * @generated from message sqlrpc.v1.DeleteColumnPolicyRequest
+ */
+export type DeleteColumnPolicyRequest = Message<"sqlrpc.v1.DeleteColumnPolicyRequest"> & {
+  /**
+   * Identifier of the policy to remove.
+   *
+   * @generated from field: int64 policy_id = 1;
+   */
+  policyId: bigint;
+};
+
+/**
+ * Describes the message sqlrpc.v1.DeleteColumnPolicyRequest.
+ * Use `create(DeleteColumnPolicyRequestSchema)` to create a new message.
+ */
+export const DeleteColumnPolicyRequestSchema: GenMessage<DeleteColumnPolicyRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 44);
+
+/**
+ * *
+ * Confirms the successful removal of the policy.
+ *
+ * @generated from message sqlrpc.v1.DeleteColumnPolicyResponse
+ */
+export type DeleteColumnPolicyResponse = Message<"sqlrpc.v1.DeleteColumnPolicyResponse"> & {
+  /**
+   * True if the policy was removed successfully.
+   *
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+};
+
+/**
+ * Describes the message sqlrpc.v1.DeleteColumnPolicyResponse.
+ * Use `create(DeleteColumnPolicyResponseSchema)` to create a new message.
+ */
+export const DeleteColumnPolicyResponseSchema: GenMessage<DeleteColumnPolicyResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 45);
+
+/**
+ * *
+ * Request to list column policies.
+ *
+ * @generated from message sqlrpc.v1.ListColumnPoliciesRequest
+ */
+export type ListColumnPoliciesRequest = Message<"sqlrpc.v1.ListColumnPoliciesRequest"> & {
+  /**
+   * Optional database filter.
+   *
+   * @generated from field: string database = 1;
+   */
+  database: string;
+};
+
+/**
+ * Describes the message sqlrpc.v1.ListColumnPoliciesRequest.
+ * Use `create(ListColumnPoliciesRequestSchema)` to create a new message.
+ */
+export const ListColumnPoliciesRequestSchema: GenMessage<ListColumnPoliciesRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 46);
+
+/**
+ * *
+ * Catalog of column policies.
+ *
+ * @generated from message sqlrpc.v1.ListColumnPoliciesResponse
+ */
+export type ListColumnPoliciesResponse = Message<"sqlrpc.v1.ListColumnPoliciesResponse"> & {
+  /**
+   * Collection of matching policies.
+   *
+   * @generated from field: repeated sqlrpc.v1.ColumnPolicy policies = 1;
+   */
+  policies: ColumnPolicy[];
+};
+
+/**
+ * Describes the message sqlrpc.v1.ListColumnPoliciesResponse.
+ * Use `create(ListColumnPoliciesResponseSchema)` to create a new message.
+ */
+export const ListColumnPoliciesResponseSchema: GenMessage<ListColumnPoliciesResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 47);
+
+/**
+ * *
+ * Unary request to retrieve the full catalog of tenant databases.
+ *
+ * @generated from message sqlrpc.v1.ListDatabasesRequest
+ */
+export type ListDatabasesRequest = Message<"sqlrpc.v1.ListDatabasesRequest"> & {
+};
+
+/**
+ * Describes the message sqlrpc.v1.ListDatabasesRequest.
+ * Use `create(ListDatabasesRequestSchema)` to create a new message.
+ */
+export const ListDatabasesRequestSchema: GenMessage<ListDatabasesRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 48);
+
+/**
+ * *
+ * Catalog of databases managed by the platform.
+ *
+ * @generated from message sqlrpc.v1.ListDatabasesResponse
+ */
+export type ListDatabasesResponse = Message<"sqlrpc.v1.ListDatabasesResponse"> & {
+  /**
+   * Collection of discovered tenant databases.
+   *
+   * @generated from field: repeated sqlrpc.v1.DatabaseInfo databases = 1;
+   */
+  databases: DatabaseInfo[];
+};
+
+/**
+ * Describes the message sqlrpc.v1.ListDatabasesResponse.
+ * Use `create(ListDatabasesResponseSchema)` to create a new message.
+ */
+export const ListDatabasesResponseSchema: GenMessage<ListDatabasesResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 49);
+
+/**
+ * *
+ * Payload to create a new managed database.
+ *
+ * @generated from message sqlrpc.v1.CreateDatabaseRequest
+ */
+export type CreateDatabaseRequest = Message<"sqlrpc.v1.CreateDatabaseRequest"> & {
+  /**
+   * Unique name for the database.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Enable encryption (SQLCipher) if true.
+   *
+   * @generated from field: bool is_encrypted = 2;
+   */
+  isEncrypted: boolean;
+
+  /**
+   * Encryption key for SQLCipher.
+   *
+   * @generated from field: string key = 3;
+   */
+  key: string;
+
+  /**
+   * List of extensions to preload.
+   *
+   * @generated from field: repeated string extensions = 4;
+   */
+  extensions: string[];
+
+  /**
+   * Map of PRAGMAs to apply.
+   *
+   * @generated from field: map<string, string> pragmas = 5;
+   */
+  pragmas: { [key: string]: string };
+
+  /**
+   * Limit for concurrent connections.
+   *
+   * @generated from field: int32 max_open_conns = 6;
+   */
+  maxOpenConns: number;
+
+  /**
+   * Limit for idle connections.
+   *
+   * @generated from field: int32 max_idle_conns = 7;
+   */
+  maxIdleConns: number;
+
+  /**
+   * Connection lifetime in milliseconds.
+   *
+   * @generated from field: int32 conn_max_lifetime_ms = 8;
+   */
+  connMaxLifetimeMs: number;
+
+  /**
+   * Statement execution time limit in milliseconds (0 means none).
+   *
+   * @generated from field: int32 max_execution_time_ms = 9;
+   */
+  maxExecutionTimeMs: number;
+};
+
+/**
+ * Describes the message sqlrpc.v1.CreateDatabaseRequest.
+ * Use `create(CreateDatabaseRequestSchema)` to create a new message.
+ */
+export const CreateDatabaseRequestSchema: GenMessage<CreateDatabaseRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 50);
+
+/**
+ * *
+ * Confirmation of database creation.
+ *
+ * @generated from message sqlrpc.v1.CreateDatabaseResponse
+ */
+export type CreateDatabaseResponse = Message<"sqlrpc.v1.CreateDatabaseResponse"> & {
+  /**
+   * True if the creation succeeded.
+   *
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+
+  /**
+   * Success or error message.
+   *
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message sqlrpc.v1.CreateDatabaseResponse.
+ * Use `create(CreateDatabaseResponseSchema)` to create a new message.
+ */
+export const CreateDatabaseResponseSchema: GenMessage<CreateDatabaseResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 51);
+
+/**
+ * *
+ * Payload to update a tenant's runtime configuration.
+ *
+ * @generated from message sqlrpc.v1.UpdateDatabaseRequest
+ */
+export type UpdateDatabaseRequest = Message<"sqlrpc.v1.UpdateDatabaseRequest"> & {
+  /**
+   * Tenant name to modify.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Partial configuration updates.
+   *
+   * @generated from field: sqlrpc.v1.UpdateDatabaseConfig config = 2;
+   */
+  config?: UpdateDatabaseConfig;
+};
+
+/**
+ * Describes the message sqlrpc.v1.UpdateDatabaseRequest.
+ * Use `create(UpdateDatabaseRequestSchema)` to create a new message.
+ */
+export const UpdateDatabaseRequestSchema: GenMessage<UpdateDatabaseRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 52);
+
+/**
+ * *
+ * Confirmation of database update.
+ *
+ * @generated from message sqlrpc.v1.UpdateDatabaseResponse
+ */
+export type UpdateDatabaseResponse = Message<"sqlrpc.v1.UpdateDatabaseResponse"> & {
+  /**
+   * True if the update was persists successfully.
+   *
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+
+  /**
+   * Success or error message.
+   *
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message sqlrpc.v1.UpdateDatabaseResponse.
+ * Use `create(UpdateDatabaseResponseSchema)` to create a new message.
+ */
+export const UpdateDatabaseResponseSchema: GenMessage<UpdateDatabaseResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 53);
+
+/**
+ * *
+ * Command to decommission a tenant database and wipe its data.
+ *
+ * @generated from message sqlrpc.v1.DeleteDatabaseRequest
+ */
+export type DeleteDatabaseRequest = Message<"sqlrpc.v1.DeleteDatabaseRequest"> & {
+  /**
+   * Tenant name to remove.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message sqlrpc.v1.DeleteDatabaseRequest.
+ * Use `create(DeleteDatabaseRequestSchema)` to create a new message.
+ */
+export const DeleteDatabaseRequestSchema: GenMessage<DeleteDatabaseRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 54);
+
+/**
+ * *
+ * Confirms the successful destruction of the database.
+ *
+ * @generated from message sqlrpc.v1.DeleteDatabaseResponse
+ */
+export type DeleteDatabaseResponse = Message<"sqlrpc.v1.DeleteDatabaseResponse"> & {
+  /**
+   * True if the deletion succeeded.
+   *
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+
+  /**
+   * Success or error message.
+   *
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message sqlrpc.v1.DeleteDatabaseResponse.
+ * Use `create(DeleteDatabaseResponseSchema)` to create a new message.
+ */
+export const DeleteDatabaseResponseSchema: GenMessage<DeleteDatabaseResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 55);
+
+/**
+ * *
+ * Payload to register an existing SQLite file into the platform.
+ *
+ * @generated from message sqlrpc.v1.MountDatabaseRequest
+ */
+export type MountDatabaseRequest = Message<"sqlrpc.v1.MountDatabaseRequest"> & {
+  /**
+   * Unique name to assign to the mounted database.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
 
   /**
    * Absolute physical path to the SQLite source file on the server.
    *
-   * @generated from field: string path = 2;
+   * @generated from field: string path = 2;
+   */
+  path: string;
+
+  /**
+   * Mount as read-only if true.
+   *
+   * @generated from field: bool read_only = 3;
+   */
+  readOnly: boolean;
+
+  /**
+   * Enable encryption (SQLCipher) if true.
+   *
+   * @generated from field: bool is_encrypted = 4;
+   */
+  isEncrypted: boolean;
+
+  /**
+   * Encryption key for SQLCipher.
+   *
+   * @generated from field: string key = 5;
+   */
+  key: string;
+
+  /**
+   * List of extensions to preload.
+   *
+   * @generated from field: repeated string extensions = 6;
+   */
+  extensions: string[];
+
+  /**
+   * Map of PRAGMAs to apply.
+   *
+   * @generated from field: map<string, string> pragmas = 7;
+   */
+  pragmas: { [key: string]: string };
+
+  /**
+   * Limit for concurrent connections.
+   *
+   * @generated from field: int32 max_open_conns = 8;
+   */
+  maxOpenConns: number;
+
+  /**
+   * Limit for idle connections.
+   *
+   * @generated from field: int32 max_idle_conns = 9;
+   */
+  maxIdleConns: number;
+
+  /**
+   * Connection lifetime in milliseconds.
+   *
+   * @generated from field: int32 conn_max_lifetime_ms = 10;
+   */
+  connMaxLifetimeMs: number;
+
+  /**
+   * Statement execution time limit in milliseconds (0 means none).
+   *
+   * @generated from field: int32 max_execution_time_ms = 11;
+   */
+  maxExecutionTimeMs: number;
+};
+
+/**
+ * Describes the message sqlrpc.v1.MountDatabaseRequest.
+ * Use `create(MountDatabaseRequestSchema)` to create a new message.
+ */
+export const MountDatabaseRequestSchema: GenMessage<MountDatabaseRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 56);
+
+/**
+ * *
+ * Confirmation of database mounting.
+ *
+ * @generated from message sqlrpc.v1.MountDatabaseResponse
+ */
+export type MountDatabaseResponse = Message<"sqlrpc.v1.MountDatabaseResponse"> & {
+  /**
+   * True if the mounting succeeded.
+   *
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+
+  /**
+   * Success or error message.
+   *
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message sqlrpc.v1.MountDatabaseResponse.
+ * Use `create(MountDatabaseResponseSchema)` to create a new message.
+ */
+export const MountDatabaseResponseSchema: GenMessage<MountDatabaseResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 57);
+
+/**
+ * *
+ * Command to remove a database registration without wiping data.
+ *
+ * @generated from message sqlrpc.v1.UnMountDatabaseRequest
+ */
+export type UnMountDatabaseRequest = Message<"sqlrpc.v1.UnMountDatabaseRequest"> & {
+  /**
+   * Tenant name to unmount.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message sqlrpc.v1.UnMountDatabaseRequest.
+ * Use `create(UnMountDatabaseRequestSchema)` to create a new message.
+ */
+export const UnMountDatabaseRequestSchema: GenMessage<UnMountDatabaseRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 58);
+
+/**
+ * *
+ * Confirms the successful unmounting of the database.
+ *
+ * @generated from message sqlrpc.v1.UnMountDatabaseResponse
+ */
+export type UnMountDatabaseResponse = Message<"sqlrpc.v1.UnMountDatabaseResponse"> & {
+  /**
+   * True if the unmounting succeeded.
+   *
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+
+  /**
+   * Success or error message.
+   *
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message sqlrpc.v1.UnMountDatabaseResponse.
+ * Use `create(UnMountDatabaseResponseSchema)` to create a new message.
+ */
+export const UnMountDatabaseResponseSchema: GenMessage<UnMountDatabaseResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 59);
+
+/**
+ * *
+ * Result of one scheduled maintenance job.
+ *
+ * @generated from message sqlrpc.v1.MaintenanceRun
+ */
+export type MaintenanceRun = Message<"sqlrpc.v1.MaintenanceRun"> & {
+  /**
+   * Run identifier.
+   *
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * Database the job ran against.
+   *
+   * @generated from field: string database = 2;
+   */
+  database: string;
+
+  /**
+   * Kind of job.
+   *
+   * @generated from field: sqlrpc.v1.MaintenanceTask task = 3;
+   */
+  task: MaintenanceTask;
+
+  /**
+   * Time the job started.
+   *
+   * @generated from field: google.protobuf.Timestamp started_at = 4;
+   */
+  startedAt?: Timestamp;
+
+  /**
+   * Time the job took.
+   *
+   * @generated from field: google.protobuf.Duration duration = 5;
+   */
+  duration?: Duration;
+
+  /**
+   * True if the job succeeded.
+   *
+   * @generated from field: bool success = 6;
+   */
+  success: boolean;
+
+  /**
+   * Outcome details or the error.
+   *
+   * @generated from field: string message = 7;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message sqlrpc.v1.MaintenanceRun.
+ * Use `create(MaintenanceRunSchema)` to create a new message.
+ */
+export const MaintenanceRunSchema: GenMessage<MaintenanceRun> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 60);
+
+/**
+ * *
+ * Request to list maintenance runs.
+ *
+ * @generated from message sqlrpc.v1.ListMaintenanceRunsRequest
+ */
+export type ListMaintenanceRunsRequest = Message<"sqlrpc.v1.ListMaintenanceRunsRequest"> & {
+  /**
+   * Optional database filter.
+   *
+   * @generated from field: string database = 1;
+   */
+  database: string;
+
+  /**
+   * Optional task filter.
+   *
+   * @generated from field: sqlrpc.v1.MaintenanceTask task = 2;
+   */
+  task: MaintenanceTask;
+
+  /**
+   * Maximum number of runs to return. Defaults to 100.
+   *
+   * @generated from field: int32 limit = 3;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message sqlrpc.v1.ListMaintenanceRunsRequest.
+ * Use `create(ListMaintenanceRunsRequestSchema)` to create a new message.
+ */
+export const ListMaintenanceRunsRequestSchema: GenMessage<ListMaintenanceRunsRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 61);
+
+/**
+ * *
+ * Maintenance runs, newest first.
+ *
+ * @generated from message sqlrpc.v1.ListMaintenanceRunsResponse
+ */
+export type ListMaintenanceRunsResponse = Message<"sqlrpc.v1.ListMaintenanceRunsResponse"> & {
+  /**
+   * Collection of matching runs.
+   *
+   * @generated from field: repeated sqlrpc.v1.MaintenanceRun runs = 1;
+   */
+  runs: MaintenanceRun[];
+};
+
+/**
+ * Describes the message sqlrpc.v1.ListMaintenanceRunsResponse.
+ * Use `create(ListMaintenanceRunsResponseSchema)` to create a new message.
+ */
+export const ListMaintenanceRunsResponseSchema: GenMessage<ListMaintenanceRunsResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 62);
+
+/**
+ * *
+ * Request to interrupt a running statement.
+ *
+ * @generated from message sqlrpc.v1.CancelQueryRequest
+ */
+export type CancelQueryRequest = Message<"sqlrpc.v1.CancelQueryRequest"> & {
+  /**
+   * X-Request-Id of the call running the statement.
+   *
+   * @generated from field: string request_id = 1;
+   */
+  requestId: string;
+};
+
+/**
+ * Describes the message sqlrpc.v1.CancelQueryRequest.
+ * Use `create(CancelQueryRequestSchema)` to create a new message.
+ */
+export const CancelQueryRequestSchema: GenMessage<CancelQueryRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 63);
+
+/**
+ * *
+ * Confirms the interruption.
+ *
+ * @generated from message sqlrpc.v1.CancelQueryResponse
+ */
+export type CancelQueryResponse = Message<"sqlrpc.v1.CancelQueryResponse"> & {
+  /**
+   * Number of statements interrupted (a reused request ID can match several).
+   *
+   * @generated from field: int32 cancelled = 1;
+   */
+  cancelled: number;
+};
+
+/**
+ * Describes the message sqlrpc.v1.CancelQueryResponse.
+ * Use `create(CancelQueryResponseSchema)` to create a new message.
+ */
+export const CancelQueryResponseSchema: GenMessage<CancelQueryResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 64);
+
+/**
+ * *
+ * Request to list in-flight statements and open transactions.
+ *
+ * @generated from message sqlrpc.v1.ListActiveOperationsRequest
+ */
+export type ListActiveOperationsRequest = Message<"sqlrpc.v1.ListActiveOperationsRequest"> & {
+  /**
+   * Optional: only operations on this database.
+   *
+   * @generated from field: string database = 1;
+   */
+  database: string;
+};
+
+/**
+ * Describes the message sqlrpc.v1.ListActiveOperationsRequest.
+ * Use `create(ListActiveOperationsRequestSchema)` to create a new message.
+ */
+export const ListActiveOperationsRequestSchema: GenMessage<ListActiveOperationsRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 65);
+
+/**
+ * *
+ * A stateless statement in flight.
+ *
+ * @generated from message sqlrpc.v1.ActiveStatement
+ */
+export type ActiveStatement = Message<"sqlrpc.v1.ActiveStatement"> & {
+  /**
+   * X-Request-Id of the call running the statement; pass it to CancelQuery.
+   *
+   * @generated from field: string request_id = 1;
+   */
+  requestId: string;
+
+  /**
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * @generated from field: string database = 3;
+   */
+  database: string;
+
+  /**
+   * @generated from field: string sql = 4;
+   */
+  sql: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp started_at = 5;
+   */
+  startedAt?: Timestamp;
+
+  /**
+   * Time spent running so far.
+   *
+   * @generated from field: google.protobuf.Duration elapsed = 6;
+   */
+  elapsed?: Duration;
+
+  /**
+   * Rows sent to the client so far (streaming queries only).
+   *
+   * @generated from field: int64 rows_streamed = 7;
+   */
+  rowsStreamed: bigint;
+};
+
+/**
+ * Describes the message sqlrpc.v1.ActiveStatement.
+ * Use `create(ActiveStatementSchema)` to create a new message.
+ */
+export const ActiveStatementSchema: GenMessage<ActiveStatement> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 66);
+
+/**
+ * *
+ * An open transaction.
+ *
+ * @generated from message sqlrpc.v1.ActiveTransaction
+ */
+export type ActiveTransaction = Message<"sqlrpc.v1.ActiveTransaction"> & {
+  /**
+   * Pass it to ForceRollbackTransaction.
+   *
+   * @generated from field: string transaction_id = 1;
    */
-  path: string;
+  transactionId: string;
 
   /**
-   * Mount as read-only if true.
-   *
-   * @generated from field: bool read_only = 3;
+   * @generated from field: sqlrpc.v1.TransactionKind kind = 2;
    */
-  readOnly: boolean;
+  kind: TransactionKind;
 
   /**
-   * Enable encryption (SQLCipher) if true.
-   *
-   * @generated from field: bool is_encrypted = 4;
+   * @generated from field: string username = 3;
    */
-  isEncrypted: boolean;
+  username: string;
 
   /**
-   * Encryption key for SQLCipher.
-   *
-   * @generated from field: string key = 5;
+   * @generated from field: string database = 4;
    */
-  key: string;
+  database: string;
 
   /**
-   * List of extensions to preload.
-   *
-   * @generated from field: repeated string extensions = 6;
+   * @generated from field: google.protobuf.Timestamp started_at = 5;
    */
-  extensions: string[];
+  startedAt?: Timestamp;
 
   /**
-   * Map of PRAGMAs to apply.
-   *
-   * @generated from field: map<string, string> pragmas = 7;
+   * @generated from field: google.protobuf.Duration age = 6;
    */
-  pragmas: { [key: string]: string };
+  age?: Duration;
 
   /**
-   * Limit for concurrent connections.
+   * When the transaction is rolled back unless the client sends another
+   * statement or heartbeat.
    *
-   * @generated from field: int32 max_open_conns = 8;
+   * @generated from field: google.protobuf.Timestamp expires_at = 7;
    */
-  maxOpenConns: number;
+  expiresAt?: Timestamp;
 
   /**
-   * Limit for idle connections.
+   * The last statement run in the transaction, empty if none yet.
    *
-   * @generated from field: int32 max_idle_conns = 9;
+   * @generated from field: string last_sql = 8;
    */
-  maxIdleConns: number;
+  lastSql: string;
+};
+
+/**
+ * Describes the message sqlrpc.v1.ActiveTransaction.
+ * Use `create(ActiveTransactionSchema)` to create a new message.
+ */
+export const ActiveTransactionSchema: GenMessage<ActiveTransaction> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 67);
 
+/**
+ * *
+ * Snapshot of the server's activity, oldest first.
+ *
+ * @generated from message sqlrpc.v1.ListActiveOperationsResponse
+ */
+export type ListActiveOperationsResponse = Message<"sqlrpc.v1.ListActiveOperationsResponse"> & {
   /**
-   * Connection lifetime in milliseconds.
-   *
-   * @generated from field: int32 conn_max_lifetime_ms = 10;
+   * @generated from field: repeated sqlrpc.v1.ActiveStatement statements = 1;
    */
-  connMaxLifetimeMs: number;
+  statements: ActiveStatement[];
+
+  /**
+   * @generated from field: repeated sqlrpc.v1.ActiveTransaction transactions = 2;
+   */
+  transactions: ActiveTransaction[];
 };
 
 /**
- * Describes the message sqlrpc.v1.MountDatabaseRequest.
- * Use `create(MountDatabaseRequestSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.ListActiveOperationsResponse.
+ * Use `create(ListActiveOperationsResponseSchema)` to create a new message.
  */
-export const MountDatabaseRequestSchema: GenMessage<MountDatabaseRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 25);
+export const ListActiveOperationsResponseSchema: GenMessage<ListActiveOperationsResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 68);
 
 /**
  * *
- * Confirmation of database mounting.
+ * Request to roll back another caller's transaction.
  *
- * @generated from message sqlrpc.v1.MountDatabaseResponse
+ * @generated from message sqlrpc.v1.ForceRollbackTransactionRequest
  */
-export type MountDatabaseResponse = Message<"sqlrpc.v1.MountDatabaseResponse"> & {
+export type ForceRollbackTransactionRequest = Message<"sqlrpc.v1.ForceRollbackTransactionRequest"> & {
   /**
-   * True if the mounting succeeded.
-   *
-   * @generated from field: bool success = 1;
+   * @generated from field: string transaction_id = 1;
    */
-  success: boolean;
+  transactionId: string;
+};
 
+/**
+ * Describes the message sqlrpc.v1.ForceRollbackTransactionRequest.
+ * Use `create(ForceRollbackTransactionRequestSchema)` to create a new message.
+ */
+export const ForceRollbackTransactionRequestSchema: GenMessage<ForceRollbackTransactionRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 69);
+
+/**
+ * *
+ * Confirms the rollback.
+ *
+ * @generated from message sqlrpc.v1.ForceRollbackTransactionResponse
+ */
+export type ForceRollbackTransactionResponse = Message<"sqlrpc.v1.ForceRollbackTransactionResponse"> & {
   /**
-   * Success or error message.
-   *
-   * @generated from field: string message = 2;
+   * @generated from field: sqlrpc.v1.TransactionKind kind = 1;
    */
-  message: string;
+  kind: TransactionKind;
 };
 
 /**
- * Describes the message sqlrpc.v1.MountDatabaseResponse.
- * Use `create(MountDatabaseResponseSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.ForceRollbackTransactionResponse.
+ * Use `create(ForceRollbackTransactionResponseSchema)` to create a new message.
  */
-export const MountDatabaseResponseSchema: GenMessage<MountDatabaseResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 26);
+export const ForceRollbackTransactionResponseSchema: GenMessage<ForceRollbackTransactionResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 70);
 
 /**
  * *
- * Command to remove a database registration without wiping data.
+ * One recorded administrative or data-changing operation.
  *
- * @generated from message sqlrpc.v1.UnMountDatabaseRequest
+ * @generated from message sqlrpc.v1.AuditEvent
  */
-export type UnMountDatabaseRequest = Message<"sqlrpc.v1.UnMountDatabaseRequest"> & {
+export type AuditEvent = Message<"sqlrpc.v1.AuditEvent"> & {
   /**
-   * Tenant name to unmount.
+   * Event identifier. Increases monotonically.
    *
-   * @generated from field: string name = 1;
+   * @generated from field: int64 id = 1;
    */
-  name: string;
+  id: bigint;
+
+  /**
+   * Time the operation finished.
+   *
+   * @generated from field: google.protobuf.Timestamp time = 2;
+   */
+  time?: Timestamp;
+
+  /**
+   * Username of the caller.
+   *
+   * @generated from field: string actor = 3;
+   */
+  actor: string;
+
+  /**
+   * API key the caller authenticated with, if any.
+   *
+   * @generated from field: string api_key_id = 4;
+   */
+  apiKeyId: string;
+
+  /**
+   * Full procedure name (e.g. "/sqlrpc.v1.AdminService/CreateUser").
+   *
+   * @generated from field: string rpc = 5;
+   */
+  rpc: string;
+
+  /**
+   * Database the operation targeted, if any.
+   *
+   * @generated from field: string database = 6;
+   */
+  database: string;
+
+  /**
+   * SQL statement, for data-changing statements.
+   *
+   * @generated from field: string sql = 7;
+   */
+  sql: string;
+
+  /**
+   * Bound parameters as JSON. Empty when parameters are redacted.
+   *
+   * @generated from field: string parameters = 8;
+   */
+  parameters: string;
+
+  /**
+   * Request payload as JSON with secrets redacted, for non-SQL operations.
+   *
+   * @generated from field: string details = 9;
+   */
+  details: string;
+
+  /**
+   * "ok", or the error code the operation failed with.
+   *
+   * @generated from field: string code = 10;
+   */
+  code: string;
+
+  /**
+   * Error message of failed operations.
+   *
+   * @generated from field: string error = 11;
+   */
+  error: string;
+
+  /**
+   * X-Request-Id of the request.
+   *
+   * @generated from field: string request_id = 12;
+   */
+  requestId: string;
 };
 
 /**
- * Describes the message sqlrpc.v1.UnMountDatabaseRequest.
- * Use `create(UnMountDatabaseRequestSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.AuditEvent.
+ * Use `create(AuditEventSchema)` to create a new message.
  */
-export const UnMountDatabaseRequestSchema: GenMessage<UnMountDatabaseRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 27);
+export const AuditEventSchema: GenMessage<AuditEvent> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 71);
 
 /**
  * *
- * Confirms the successful unmounting of the database.
+ * Filters for listing audit events. Empty fields match everything.
  *
- * @generated from message sqlrpc.v1.UnMountDatabaseResponse
+ * @generated from message sqlrpc.v1.ListAuditEventsRequest
  */
-export type UnMountDatabaseResponse = Message<"sqlrpc.v1.UnMountDatabaseResponse"> & {
+export type ListAuditEventsRequest = Message<"sqlrpc.v1.ListAuditEventsRequest"> & {
   /**
-   * True if the unmounting succeeded.
+   * Only events by this username.
    *
-   * @generated from field: bool success = 1;
+   * @generated from field: string actor = 1;
    */
-  success: boolean;
+  actor: string;
 
   /**
-   * Success or error message.
+   * Only events targeting this database.
    *
-   * @generated from field: string message = 2;
+   * @generated from field: string database = 2;
    */
-  message: string;
+  database: string;
+
+  /**
+   * Only events of this RPC, as a full procedure or a method name.
+   *
+   * @generated from field: string rpc = 3;
+   */
+  rpc: string;
+
+  /**
+   * Only events at or after this time.
+   *
+   * @generated from field: google.protobuf.Timestamp since = 4;
+   */
+  since?: Timestamp;
+
+  /**
+   * Only events before this time.
+   *
+   * @generated from field: google.protobuf.Timestamp until = 5;
+   */
+  until?: Timestamp;
+
+  /**
+   * Only failed or rejected operations.
+   *
+   * @generated from field: bool failed_only = 6;
+   */
+  failedOnly: boolean;
+
+  /**
+   * Only events with a smaller ID. Pass the last ID of a page to get the next.
+   *
+   * @generated from field: int64 before_id = 7;
+   */
+  beforeId: bigint;
+
+  /**
+   * Maximum number of events to return. Defaults to 100.
+   *
+   * @generated from field: int32 limit = 8;
+   */
+  limit: number;
 };
 
 /**
- * Describes the message sqlrpc.v1.UnMountDatabaseResponse.
- * Use `create(UnMountDatabaseResponseSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.ListAuditEventsRequest.
+ * Use `create(ListAuditEventsRequestSchema)` to create a new message.
  */
-export const UnMountDatabaseResponseSchema: GenMessage<UnMountDatabaseResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 28);
+export const ListAuditEventsRequestSchema: GenMessage<ListAuditEventsRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 72);
+
+/**
+ * *
+ * Audit events, newest first.
+ *
+ * @generated from message sqlrpc.v1.ListAuditEventsResponse
+ */
+export type ListAuditEventsResponse = Message<"sqlrpc.v1.ListAuditEventsResponse"> & {
+  /**
+   * Collection of matching events.
+   *
+   * @generated from field: repeated sqlrpc.v1.AuditEvent events = 1;
+   */
+  events: AuditEvent[];
+};
+
+/**
+ * Describes the message sqlrpc.v1.ListAuditEventsResponse.
+ * Use `create(ListAuditEventsResponseSchema)` to create a new message.
+ */
+export const ListAuditEventsResponseSchema: GenMessage<ListAuditEventsResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 73);
 
 /**
  * *
@@ -918,7 +2590,7 @@ export type GetServerInfoRequest = Message<"sqlrpc.v1.GetServerInfoRequest"> & {
  * Use `create(GetServerInfoRequestSchema)` to create a new message.
  */
 export const GetServerInfoRequestSchema: GenMessage<GetServerInfoRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 29);
+  messageDesc(file_sqlrpc_v1_admin_service, 74);
 
 /**
  * *
@@ -982,14 +2654,160 @@ export type ServerInfo = Message<"sqlrpc.v1.ServerInfo"> & {
    * @generated from field: bool auth_disabled = 8;
    */
   authDisabled: boolean;
+
+  /**
+   * Replication role and, on followers, how far behind the leader they are.
+   *
+   * @generated from field: sqlrpc.v1.ReplicationStatus replication = 9;
+   */
+  replication?: ReplicationStatus;
 };
 
 /**
  * Describes the message sqlrpc.v1.ServerInfo.
  * Use `create(ServerInfoSchema)` to create a new message.
  */
-export const ServerInfoSchema: GenMessage<ServerInfo> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 30);
+export const ServerInfoSchema: GenMessage<ServerInfo> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 75);
+
+/**
+ * *
+ * Unary request for the current log levels.
+ *
+ * @generated from message sqlrpc.v1.GetLogLevelsRequest
+ */
+export type GetLogLevelsRequest = Message<"sqlrpc.v1.GetLogLevelsRequest"> & {
+};
+
+/**
+ * Describes the message sqlrpc.v1.GetLogLevelsRequest.
+ * Use `create(GetLogLevelsRequestSchema)` to create a new message.
+ */
+export const GetLogLevelsRequestSchema: GenMessage<GetLogLevelsRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 76);
+
+/**
+ * *
+ * Effective log level of one subsystem.
+ *
+ * @generated from message sqlrpc.v1.SubsystemLogLevel
+ */
+export type SubsystemLogLevel = Message<"sqlrpc.v1.SubsystemLogLevel"> & {
+  /**
+   * Subsystem name (e.g. "pubsub", "auth").
+   *
+   * @generated from field: string subsystem = 1;
+   */
+  subsystem: string;
+
+  /**
+   * Level records of the subsystem must reach to be written.
+   *
+   * @generated from field: sqlrpc.v1.LogLevel level = 2;
+   */
+  level: LogLevel;
+
+  /**
+   * True if the level overrides the default rather than following it.
+   *
+   * @generated from field: bool overridden = 3;
+   */
+  overridden: boolean;
+};
+
+/**
+ * Describes the message sqlrpc.v1.SubsystemLogLevel.
+ * Use `create(SubsystemLogLevelSchema)` to create a new message.
+ */
+export const SubsystemLogLevelSchema: GenMessage<SubsystemLogLevel> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 77);
+
+/**
+ * *
+ * Current log levels.
+ *
+ * @generated from message sqlrpc.v1.GetLogLevelsResponse
+ */
+export type GetLogLevelsResponse = Message<"sqlrpc.v1.GetLogLevelsResponse"> & {
+  /**
+   * Level of subsystems without an override.
+   *
+   * @generated from field: sqlrpc.v1.LogLevel default_level = 1;
+   */
+  defaultLevel: LogLevel;
+
+  /**
+   * Every known subsystem, sorted by name.
+   *
+   * @generated from field: repeated sqlrpc.v1.SubsystemLogLevel subsystems = 2;
+   */
+  subsystems: SubsystemLogLevel[];
+};
+
+/**
+ * Describes the message sqlrpc.v1.GetLogLevelsResponse.
+ * Use `create(GetLogLevelsResponseSchema)` to create a new message.
+ */
+export const GetLogLevelsResponseSchema: GenMessage<GetLogLevelsResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 78);
+
+/**
+ * *
+ * Request to change a log level.
+ *
+ * @generated from message sqlrpc.v1.SetLogLevelRequest
+ */
+export type SetLogLevelRequest = Message<"sqlrpc.v1.SetLogLevelRequest"> & {
+  /**
+   * Subsystem to override. Empty changes the default level.
+   *
+   * @generated from field: string subsystem = 1;
+   */
+  subsystem: string;
+
+  /**
+   * New level. Unspecified clears the subsystem override.
+   *
+   * @generated from field: sqlrpc.v1.LogLevel level = 2;
+   */
+  level: LogLevel;
+};
+
+/**
+ * Describes the message sqlrpc.v1.SetLogLevelRequest.
+ * Use `create(SetLogLevelRequestSchema)` to create a new message.
+ */
+export const SetLogLevelRequestSchema: GenMessage<SetLogLevelRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 79);
+
+/**
+ * *
+ * Log levels after the change.
+ *
+ * @generated from message sqlrpc.v1.SetLogLevelResponse
+ */
+export type SetLogLevelResponse = Message<"sqlrpc.v1.SetLogLevelResponse"> & {
+  /**
+   * Level of subsystems without an override.
+   *
+   * @generated from field: sqlrpc.v1.LogLevel default_level = 1;
+   */
+  defaultLevel: LogLevel;
+
+  /**
+   * Every known subsystem, sorted by name.
+   *
+   * @generated from field: repeated sqlrpc.v1.SubsystemLogLevel subsystems = 2;
+   */
+  subsystems: SubsystemLogLevel[];
+};
+
+/**
+ * Describes the message sqlrpc.v1.SetLogLevelResponse.
+ * Use `create(SetLogLevelResponseSchema)` to create a new message.
+ */
+export const SetLogLevelResponseSchema: GenMessage<SetLogLevelResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 80);
 
 /**
  * *
@@ -1025,7 +2843,7 @@ export type LoginRequest = Message<"sqlrpc.v1.LoginRequest"> & {
  * Use `create(LoginRequestSchema)` to create a new message.
  */
 export const LoginRequestSchema: GenMessage<LoginRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 31);
+  messageDesc(file_sqlrpc_v1_admin_service, 81);
 
 /**
  * *
@@ -1068,7 +2886,7 @@ export type LoginResponse = Message<"sqlrpc.v1.LoginResponse"> & {
  * Use `create(LoginResponseSchema)` to create a new message.
  */
 export const LoginResponseSchema: GenMessage<LoginResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 32);
+  messageDesc(file_sqlrpc_v1_admin_service, 82);
 
 /**
  * *
@@ -1097,7 +2915,7 @@ export type LogoutRequest = Message<"sqlrpc.v1.LogoutRequest"> & {
  * Use `create(LogoutRequestSchema)` to create a new message.
  */
 export const LogoutRequestSchema: GenMessage<LogoutRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 33);
+  messageDesc(file_sqlrpc_v1_admin_service, 83);
 
 /**
  * *
@@ -1119,7 +2937,231 @@ export type LogoutResponse = Message<"sqlrpc.v1.LogoutResponse"> & {
  * Use `create(LogoutResponseSchema)` to create a new message.
  */
 export const LogoutResponseSchema: GenMessage<LogoutResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_admin_service, 34);
+  messageDesc(file_sqlrpc_v1_admin_service, 84);
+
+/**
+ * *
+ * Opens a replication stream from a follower to the leader.
+ *
+ * @generated from message sqlrpc.v1.StreamReplicationRequest
+ */
+export type StreamReplicationRequest = Message<"sqlrpc.v1.StreamReplicationRequest"> & {
+  /**
+   * Identifies the follower in leader logs and status.
+   *
+   * @generated from field: string follower_id = 1;
+   */
+  followerId: string;
+};
+
+/**
+ * Describes the message sqlrpc.v1.StreamReplicationRequest.
+ * Use `create(StreamReplicationRequestSchema)` to create a new message.
+ */
+export const StreamReplicationRequestSchema: GenMessage<StreamReplicationRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 85);
+
+/**
+ * *
+ * A single database page.
+ *
+ * @generated from message sqlrpc.v1.ReplicationPage
+ */
+export type ReplicationPage = Message<"sqlrpc.v1.ReplicationPage"> & {
+  /**
+   * 1-based page number.
+   *
+   * @generated from field: uint32 page_number = 1;
+   */
+  pageNumber: number;
+
+  /**
+   * Raw page content, page_size bytes long.
+   *
+   * @generated from field: bytes data = 2;
+   */
+  data: Uint8Array;
+};
+
+/**
+ * Describes the message sqlrpc.v1.ReplicationPage.
+ * Use `create(ReplicationPageSchema)` to create a new message.
+ */
+export const ReplicationPageSchema: GenMessage<ReplicationPage> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 86);
+
+/**
+ * *
+ * A page-level changeset for one database, or a heartbeat when database is
+ * empty. Large changesets are split across several frames sharing a sequence.
+ *
+ * @generated from message sqlrpc.v1.ReplicationFrame
+ */
+export type ReplicationFrame = Message<"sqlrpc.v1.ReplicationFrame"> & {
+  /**
+   * Database the pages belong to. Empty for heartbeats.
+   *
+   * @generated from field: string database = 1;
+   */
+  database: string;
+
+  /**
+   * Page size of the database in bytes.
+   *
+   * @generated from field: uint32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * Total page count after the change. Pages beyond it are truncated.
+   *
+   * @generated from field: uint32 page_count = 3;
+   */
+  pageCount: number;
+
+  /**
+   * Pages that differ from the previous sequence.
+   *
+   * @generated from field: repeated sqlrpc.v1.ReplicationPage pages = 4;
+   */
+  pages: ReplicationPage[];
+
+  /**
+   * True if the pages describe the whole database rather than a diff.
+   *
+   * @generated from field: bool full_snapshot = 5;
+   */
+  fullSnapshot: boolean;
+
+  /**
+   * Monotonic per-database change sequence.
+   *
+   * @generated from field: uint64 sequence = 6;
+   */
+  sequence: bigint;
+
+  /**
+   * Leader wall-clock time of the snapshot the frame was taken from.
+   *
+   * @generated from field: google.protobuf.Timestamp leader_time = 7;
+   */
+  leaderTime?: Timestamp;
+
+  /**
+   * True if more frames follow for this sequence. Followers apply the change
+   * once the last frame arrives.
+   *
+   * @generated from field: bool partial = 8;
+   */
+  partial: boolean;
+};
+
+/**
+ * Describes the message sqlrpc.v1.ReplicationFrame.
+ * Use `create(ReplicationFrameSchema)` to create a new message.
+ */
+export const ReplicationFrameSchema: GenMessage<ReplicationFrame> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 87);
+
+/**
+ * *
+ * Replication state of a single database on a follower.
+ *
+ * @generated from message sqlrpc.v1.DatabaseReplicationStatus
+ */
+export type DatabaseReplicationStatus = Message<"sqlrpc.v1.DatabaseReplicationStatus"> & {
+  /**
+   * Database name.
+   *
+   * @generated from field: string database = 1;
+   */
+  database: string;
+
+  /**
+   * Last applied change sequence.
+   *
+   * @generated from field: uint64 sequence = 2;
+   */
+  sequence: bigint;
+
+  /**
+   * Page count after the last applied change.
+   *
+   * @generated from field: uint32 page_count = 3;
+   */
+  pageCount: number;
+};
+
+/**
+ * Describes the message sqlrpc.v1.DatabaseReplicationStatus.
+ * Use `create(DatabaseReplicationStatusSchema)` to create a new message.
+ */
+export const DatabaseReplicationStatusSchema: GenMessage<DatabaseReplicationStatus> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 88);
+
+/**
+ * *
+ * Replication state of the server.
+ *
+ * @generated from message sqlrpc.v1.ReplicationStatus
+ */
+export type ReplicationStatus = Message<"sqlrpc.v1.ReplicationStatus"> & {
+  /**
+   * Whether the server is the leader or a read-only follower.
+   *
+   * @generated from field: sqlrpc.v1.ReplicationRole role = 1;
+   */
+  role: ReplicationRole;
+
+  /**
+   * Leader address the follower replicates from. Empty on the leader.
+   *
+   * @generated from field: string leader = 2;
+   */
+  leader: string;
+
+  /**
+   * True while the follower holds an open stream to the leader.
+   *
+   * @generated from field: bool connected = 3;
+   */
+  connected: boolean;
+
+  /**
+   * Age of the newest leader snapshot applied by the follower.
+   *
+   * @generated from field: google.protobuf.Duration lag = 4;
+   */
+  lag?: Duration;
+
+  /**
+   * Time the follower last heard from the leader.
+   *
+   * @generated from field: google.protobuf.Timestamp last_sync_time = 5;
+   */
+  lastSyncTime?: Timestamp;
+
+  /**
+   * Number of followers currently streaming from this leader.
+   *
+   * @generated from field: int32 follower_count = 6;
+   */
+  followerCount: number;
+
+  /**
+   * Per-database state on the follower.
+   *
+   * @generated from field: repeated sqlrpc.v1.DatabaseReplicationStatus databases = 7;
+   */
+  databases: DatabaseReplicationStatus[];
+};
+
+/**
+ * Describes the message sqlrpc.v1.ReplicationStatus.
+ * Use `create(ReplicationStatusSchema)` to create a new message.
+ */
+export const ReplicationStatusSchema: GenMessage<ReplicationStatus> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_admin_service, 89);
 
 /**
  * *
@@ -1179,6 +3221,19 @@ export const AdminService: GenService<{
     input: typeof DeleteUserRequestSchema;
     output: typeof DeleteUserResponseSchema;
   },
+  /**
+   * *
+   * User Management: Unlock.
+   * Clears the failed login attempts and lockout of a username and, optionally,
+   * of a client IP address.
+   *
+   * @generated from rpc sqlrpc.v1.AdminService.UnlockUser
+   */
+  unlockUser: {
+    methodKind: "unary";
+    input: typeof UnlockUserRequestSchema;
+    output: typeof UnlockUserResponseSchema;
+  },
   /**
    * *
    * API Key Management: List.
@@ -1215,6 +3270,156 @@ export const AdminService: GenService<{
     input: typeof DeleteAPIKeyRequestSchema;
     output: typeof DeleteAPIKeyResponseSchema;
   },
+  /**
+   * *
+   * Access Control: Grant.
+   * Assigns a per-database role to a user or a single API key. Granting the
+   * same database pattern again replaces the previous role.
+   *
+   * @generated from rpc sqlrpc.v1.AdminService.GrantDatabaseAccess
+   */
+  grantDatabaseAccess: {
+    methodKind: "unary";
+    input: typeof GrantDatabaseAccessRequestSchema;
+    output: typeof GrantDatabaseAccessResponseSchema;
+  },
+  /**
+   * *
+   * Access Control: Revoke.
+   * Removes a per-database grant immediately.
+   *
+   * @generated from rpc sqlrpc.v1.AdminService.RevokeDatabaseAccess
+   */
+  revokeDatabaseAccess: {
+    methodKind: "unary";
+    input: typeof RevokeDatabaseAccessRequestSchema;
+    output: typeof RevokeDatabaseAccessResponseSchema;
+  },
+  /**
+   * *
+   * Access Control: List.
+   * Returns the per-database grants, optionally filtered by user or database.
+   *
+   * @generated from rpc sqlrpc.v1.AdminService.ListDatabaseGrants
+   */
+  listDatabaseGrants: {
+    methodKind: "unary";
+    input: typeof ListDatabaseGrantsRequestSchema;
+    output: typeof ListDatabaseGrantsResponseSchema;
+  },
+  /**
+   * *
+   * Rate Limits: Set.
+   * Caps the requests, concurrent streams and transactions, and returned rows
+   * and bytes of a user or a single API key on matching databases. Setting the
+   * same database pattern again replaces the previous limits.
+   *
+   * @generated from rpc sqlrpc.v1.AdminService.SetRateLimit
+   */
+  setRateLimit: {
+    methodKind: "unary";
+    input: typeof SetRateLimitRequestSchema;
+    output: typeof SetRateLimitResponseSchema;
+  },
+  /**
+   * *
+   * Rate Limits: Delete.
+   * Removes a rate limit immediately.
+   *
+   * @generated from rpc sqlrpc.v1.AdminService.DeleteRateLimit
+   */
+  deleteRateLimit: {
+    methodKind: "unary";
+    input: typeof DeleteRateLimitRequestSchema;
+    output: typeof DeleteRateLimitResponseSchema;
+  },
+  /**
+   * *
+   * Rate Limits: List.
+   * Returns the rate limits, optionally filtered by user or database.
+   *
+   * @generated from rpc sqlrpc.v1.AdminService.ListRateLimits
+   */
+  listRateLimits: {
+    methodKind: "unary";
+    input: typeof ListRateLimitsRequestSchema;
+    output: typeof ListRateLimitsResponseSchema;
+  },
+  /**
+   * *
+   * Row Policies: Create.
+   * Restricts the rows of a table that non-admin callers can read, update and
+   * delete to those matching a predicate, which may call current_user() and
+   * current_role(). A row is visible when any policy of its table allows it.
+   *
+   * @generated from rpc sqlrpc.v1.AdminService.CreateRowPolicy
+   */
+  createRowPolicy: {
+    methodKind: "unary";
+    input: typeof CreateRowPolicyRequestSchema;
+    output: typeof CreateRowPolicyResponseSchema;
+  },
+  /**
+   * *
+   * Row Policies: Delete.
+   * Removes a row policy immediately.
+   *
+   * @generated from rpc sqlrpc.v1.AdminService.DeleteRowPolicy
+   */
+  deleteRowPolicy: {
+    methodKind: "unary";
+    input: typeof DeleteRowPolicyRequestSchema;
+    output: typeof DeleteRowPolicyResponseSchema;
+  },
+  /**
+   * *
+   * Row Policies: List.
+   * Returns the row policies, optionally of a single database.
+   *
+   * @generated from rpc sqlrpc.v1.AdminService.ListRowPolicies
+   */
+  listRowPolicies: {
+    methodKind: "unary";
+    input: typeof ListRowPoliciesRequestSchema;
+    output: typeof ListRowPoliciesResponseSchema;
+  },
+  /**
+   * *
+   * Column Policies: Set.
+   * Denies or masks reads of a table column for a role or a single user on a
+   * database. Setting the same column and subject again replaces the action.
+   *
+   * @generated from rpc sqlrpc.v1.AdminService.SetColumnPolicy
+   */
+  setColumnPolicy: {
+    methodKind: "unary";
+    input: typeof SetColumnPolicyRequestSchema;
+    output: typeof SetColumnPolicyResponseSchema;
+  },
+  /**
+   * *
+   * Column Policies: Delete.
+   * Removes a column policy immediately.
+   *
+   * @generated from rpc sqlrpc.v1.AdminService.DeleteColumnPolicy
+   */
+  deleteColumnPolicy: {
+    methodKind: "unary";
+    input: typeof DeleteColumnPolicyRequestSchema;
+    output: typeof DeleteColumnPolicyResponseSchema;
+  },
+  /**
+   * *
+   * Column Policies: List.
+   * Returns the column policies, optionally of a single database.
+   *
+   * @generated from rpc sqlrpc.v1.AdminService.ListColumnPolicies
+   */
+  listColumnPolicies: {
+    methodKind: "unary";
+    input: typeof ListColumnPoliciesRequestSchema;
+    output: typeof ListColumnPoliciesResponseSchema;
+  },
   /**
    * *
    * Database Lifecycle: List.
@@ -1288,6 +3493,70 @@ export const AdminService: GenService<{
     input: typeof UnMountDatabaseRequestSchema;
     output: typeof UnMountDatabaseResponseSchema;
   },
+  /**
+   * *
+   * Maintenance: List runs.
+   * Returns the results of scheduled maintenance jobs, newest first.
+   *
+   * @generated from rpc sqlrpc.v1.AdminService.ListMaintenanceRuns
+   */
+  listMaintenanceRuns: {
+    methodKind: "unary";
+    input: typeof ListMaintenanceRunsRequestSchema;
+    output: typeof ListMaintenanceRunsResponseSchema;
+  },
+  /**
+   * *
+   * Queries: Cancel.
+   * Interrupts the in-flight statements started by the request with the given
+   * X-Request-Id. The interrupted call fails with CANCELED.
+   *
+   * @generated from rpc sqlrpc.v1.AdminService.CancelQuery
+   */
+  cancelQuery: {
+    methodKind: "unary";
+    input: typeof CancelQueryRequestSchema;
+    output: typeof CancelQueryResponseSchema;
+  },
+  /**
+   * *
+   * Queries: List active operations.
+   * Returns the statements running right now and every open transaction, both
+   * ID-based and bidirectional streams.
+   *
+   * @generated from rpc sqlrpc.v1.AdminService.ListActiveOperations
+   */
+  listActiveOperations: {
+    methodKind: "unary";
+    input: typeof ListActiveOperationsRequestSchema;
+    output: typeof ListActiveOperationsResponseSchema;
+  },
+  /**
+   * *
+   * Queries: Force rollback.
+   * Rolls back another caller's open transaction. Stream transactions end with
+   * ABORTED.
+   *
+   * @generated from rpc sqlrpc.v1.AdminService.ForceRollbackTransaction
+   */
+  forceRollbackTransaction: {
+    methodKind: "unary";
+    input: typeof ForceRollbackTransactionRequestSchema;
+    output: typeof ForceRollbackTransactionResponseSchema;
+  },
+  /**
+   * *
+   * Audit: List events.
+   * Returns recorded administrative and data-changing operations, newest
+   * first.
+   *
+   * @generated from rpc sqlrpc.v1.AdminService.ListAuditEvents
+   */
+  listAuditEvents: {
+    methodKind: "unary";
+    input: typeof ListAuditEventsRequestSchema;
+    output: typeof ListAuditEventsResponseSchema;
+  },
   /**
    * *
    * Platform Info.
@@ -1300,6 +3569,31 @@ export const AdminService: GenService<{
     input: typeof GetServerInfoRequestSchema;
     output: typeof ServerInfoSchema;
   },
+  /**
+   * *
+   * Logging: Get levels.
+   * Returns the default log level and the effective level of every subsystem.
+   *
+   * @generated from rpc sqlrpc.v1.AdminService.GetLogLevels
+   */
+  getLogLevels: {
+    methodKind: "unary";
+    input: typeof GetLogLevelsRequestSchema;
+    output: typeof GetLogLevelsResponseSchema;
+  },
+  /**
+   * *
+   * Logging: Set level.
+   * Changes the default log level or overrides it for one subsystem. Changes
+   * take effect immediately and last until the server restarts.
+   *
+   * @generated from rpc sqlrpc.v1.AdminService.SetLogLevel
+   */
+  setLogLevel: {
+    methodKind: "unary";
+    input: typeof SetLogLevelRequestSchema;
+    output: typeof SetLogLevelResponseSchema;
+  },
   /**
    * *
    * Authentication: Login.
@@ -1336,6 +3630,20 @@ export const AdminService: GenService<{
     input: typeof UpdatePasswordRequestSchema;
     output: typeof UpdatePasswordResponseSchema;
   },
+  /**
+   * *
+   * Replication: Stream changes.
+   * Streams page-level changes of every mounted database to a follower
+   * started with --role=follower. The first frame per database is a full
+   * snapshot; later frames only carry pages that changed.
+   *
+   * @generated from rpc sqlrpc.v1.AdminService.StreamReplication
+   */
+  streamReplication: {
+    methodKind: "server_streaming";
+    input: typeof StreamReplicationRequestSchema;
+    output: typeof ReplicationFrameSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_sqlrpc_v1_admin_service, 0);
 
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { AttachDatabaseRequest, AttachDatabaseResponse, BeginTransactionRequest, BeginTransactionResponse, CheckpointRequest, CheckpointResponse, DetachDatabaseRequest, DetachDatabaseResponse, ExecResponse, ExecuteTransactionRequest, ExecuteTransactionResponse, ExplainResponse, GetDatabaseSchemaRequest, GetTableSchemaRequest, IntegrityCheckRequest, IntegrityCheckResponse, ListExtensionsRequest, ListExtensionsResponse, ListTablesRequest, ListTablesResponse, LoadExtensionRequest, LoadExtensionResponse, PublishBatchRequest, PublishBatchResponse, PublishRequest, PublishResponse, QueryRequest, QueryResponse, QueryResult, SavepointResponse, SubscribeRequest, SubscribeResponse, TransactionControlRequest, TransactionControlResponse, TransactionQueryRequest, TransactionRequest, TransactionResponse, TransactionSavepointRequest, TypedQueryRequest, TypedQueryResponse, TypedQueryResult, TypedTransactionQueryRequest, VacuumRequest, VacuumResponse } from "./db_service_pb.js";
+import { AttachDatabaseRequest, AttachDatabaseResponse, BackupDatabaseRequest, BackupDatabaseResponse, BatchExecRequest, BatchExecResponse, BeginTransactionRequest, BeginTransactionResponse, CheckpointRequest, CheckpointResponse, CloseCursorRequest, CloseCursorResponse, DetachDatabaseRequest, DetachDatabaseResponse, DownloadBackupRequest, DownloadBackupResponse, DumpDatabaseRequest, DumpDatabaseResponse, ExecResponse, ExecuteTransactionRequest, ExecuteTransactionResponse, ExplainResponse, FetchCursorRequest, FetchCursorResponse, GetDatabaseSchemaRequest, GetTableSchemaRequest, ImportRequest, ImportResponse, IntegrityCheckRequest, IntegrityCheckResponse, ListBackupsRequest, ListBackupsResponse, ListExtensionsRequest, ListExtensionsResponse, ListTablesRequest, ListTablesResponse, LoadExtensionRequest, LoadExtensionResponse, OpenCursorRequest, OpenCursorResponse, PublishBatchRequest, PublishBatchResponse, PublishRequest, PublishResponse, QueryRequest, QueryResponse, QueryResult, RestoreDatabaseRequest, RestoreDatabaseResponse, SavepointResponse, SubscribeRequest, SubscribeResponse, TransactionControlRequest, TransactionControlResponse, TransactionQueryRequest, TransactionRequest, TransactionResponse, TransactionSavepointRequest, TypedBatchExecRequest, TypedQueryRequest, TypedQueryResponse, TypedQueryResult, TypedTransactionQueryRequest, VacuumRequest, VacuumResponse } from "./db_service_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 import { DatabaseSchema, TableSchema } from "./types_pb.js";
 
@@ -171,6 +171,48 @@ export const DatabaseService = {
       O: TransactionControlResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * *
+     * Cursor Open.
+     * Runs a read-only query in a read transaction on the Read-Only pool and
+     * keeps its result open, pinned to that snapshot, for FetchCursor. Idle
+     * cursors are closed like ID-based transactions.
+     *
+     * @generated from rpc sqlrpc.v1.DatabaseService.OpenCursor
+     */
+    openCursor: {
+      name: "OpenCursor",
+      I: OpenCursorRequest,
+      O: OpenCursorResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * *
+     * Cursor Fetch.
+     * Returns the next rows of a cursor and extends its idle timeout. The cursor
+     * is closed once its last row has been fetched.
+     *
+     * @generated from rpc sqlrpc.v1.DatabaseService.FetchCursor
+     */
+    fetchCursor: {
+      name: "FetchCursor",
+      I: FetchCursorRequest,
+      O: FetchCursorResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * *
+     * Cursor Close.
+     * Closes a cursor, ending its read transaction.
+     *
+     * @generated from rpc sqlrpc.v1.DatabaseService.CloseCursor
+     */
+    closeCursor: {
+      name: "CloseCursor",
+      I: CloseCursorRequest,
+      O: CloseCursorResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * *
      * Atomic Script Execution.
@@ -186,6 +228,38 @@ export const DatabaseService = {
       O: ExecuteTransactionResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * *
+     * Bulk Write Endpoint.
+     * Prepares one statement and executes it once per parameter set inside a
+     * single implicit transaction. Either every set is applied or, when one
+     * fails, none is and the error reports the failing set's index.
+     *
+     * @generated from rpc sqlrpc.v1.DatabaseService.BatchExec
+     */
+    batchExec: {
+      name: "BatchExec",
+      I: BatchExecRequest,
+      O: BatchExecResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * *
+     * Bulk Import (Client Stream).
+     * The first message names the target table and the format; the following
+     * ones carry CSV or NDJSON chunks, or SqlRow batches. Records are inserted
+     * through one prepared statement and committed every batch_size records.
+     * Records that cannot be parsed or inserted are rejected and counted without
+     * stopping the import.
+     *
+     * @generated from rpc sqlrpc.v1.DatabaseService.Import
+     */
+    import: {
+      name: "Import",
+      I: ImportRequest,
+      O: ImportResponse,
+      kind: MethodKind.ClientStreaming,
+    },
     /**
      * *
      * Typed Read Endpoint (Unary).
@@ -214,6 +288,19 @@ export const DatabaseService = {
       O: ExecResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * *
+     * Typed Bulk Write Endpoint.
+     * BatchExec with strictly-typed parameter sets.
+     *
+     * @generated from rpc sqlrpc.v1.DatabaseService.TypedBatchExec
+     */
+    typedBatchExec: {
+      name: "TypedBatchExec",
+      I: TypedBatchExecRequest,
+      O: BatchExecResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * *
      * Typed Read Endpoint (Streaming).
@@ -402,6 +489,77 @@ export const DatabaseService = {
       O: DetachDatabaseResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * *
+     * Backup: Create.
+     * Takes a consistent snapshot with the SQLite online backup API and stores
+     * it in the server's backups directory, optionally gzip-compressed, along
+     * with a SHA-256 checksum.
+     *
+     * @generated from rpc sqlrpc.v1.DatabaseService.BackupDatabase
+     */
+    backupDatabase: {
+      name: "BackupDatabase",
+      I: BackupDatabaseRequest,
+      O: BackupDatabaseResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * *
+     * Backup: List.
+     * Lists the stored backups of a database, newest first.
+     *
+     * @generated from rpc sqlrpc.v1.DatabaseService.ListBackups
+     */
+    listBackups: {
+      name: "ListBackups",
+      I: ListBackupsRequest,
+      O: ListBackupsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * *
+     * Backup: Restore.
+     * Verifies a stored backup, quiesces the database, swaps the file and drops
+     * all cached connections. Fails while transactions are open on the database.
+     *
+     * @generated from rpc sqlrpc.v1.DatabaseService.RestoreDatabase
+     */
+    restoreDatabase: {
+      name: "RestoreDatabase",
+      I: RestoreDatabaseRequest,
+      O: RestoreDatabaseResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * *
+     * Backup: Download.
+     * Streams a stored backup, or a fresh snapshot when no backup is named, in
+     * chunks. The first message carries the backup metadata.
+     *
+     * @generated from rpc sqlrpc.v1.DatabaseService.DownloadBackup
+     */
+    downloadBackup: {
+      name: "DownloadBackup",
+      I: DownloadBackupRequest,
+      O: DownloadBackupResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * *
+     * Backup: Dump.
+     * Streams a `.dump`-style SQL script recreating the schema and data of a
+     * database, or of some of its tables. Row and column security applies to
+     * the data read.
+     *
+     * @generated from rpc sqlrpc.v1.DatabaseService.DumpDatabase
+     */
+    dumpDatabase: {
+      name: "DumpDatabase",
+      I: DumpDatabaseRequest,
+      O: DumpDatabaseResponse,
+      kind: MethodKind.ServerStreaming,
+    },
     /**
      * *
      * Extensions: List.
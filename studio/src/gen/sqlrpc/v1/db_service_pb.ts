@@ -7,7 +7,7 @@ import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
 import type { Duration, Empty, ListValue, Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_struct, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
-import type { CheckpointMode, ColumnAffinity, DeclaredType, SqliteCode, TransactionLockMode } from "./enums_pb";
+import type { BackupCompression, CheckpointMode, ColumnAffinity, DeclaredType, ImportConflict, ImportFormat, SqliteCode, TransactionLockMode } from "./enums_pb";
 import { file_sqlrpc_v1_enums } from "./enums_pb";
 import type { Attachment, DatabaseSchemaSchema, ExecutionStats, ExtensionInfo, Parameters, QueryPlanNode, SqlRow, TableSchemaSchema, TypedParameters } from "./types_pb";
 import { file_sqlrpc_v1_types } from "./types_pb";
@@ -17,7 +17,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file sqlrpc/v1/db_service.proto.
  */
 export const file_sqlrpc_v1_db_service: GenFile = /*@__PURE__*/
-  fileDesc("ChpzcWxycGMvdjEvZGJfc2VydmljZS5wcm90bxIJc3FscnBjLnYxIoEBCgxRdWVyeVJlcXVlc3QSLQoIZGF0YWJhc2UYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJBIXCgNzcWwYAiABKAlCCrpIB3IFEAEYgFASKQoKcGFyYW1ldGVycxgEIAEoCzIVLnNxbHJwYy52MS5QYXJhbWV0ZXJzIvoBCgtRdWVyeVJlc3VsdBIPCgdjb2x1bW5zGAEgAygJEjQKEWNvbHVtbl9hZmZpbml0aWVzGAIgAygOMhkuc3FscnBjLnYxLkNvbHVtbkFmZmluaXR5EjYKFWNvbHVtbl9kZWNsYXJlZF90eXBlcxgDIAMoDjIXLnNxbHJwYy52MS5EZWNsYXJlZFR5cGUSGAoQY29sdW1uX3Jhd190eXBlcxgEIAMoCRIoCgRyb3dzGAUgAygLMhouZ29vZ2xlLnByb3RvYnVmLkxpc3RWYWx1ZRIoCgVzdGF0cxgGIAEoCzIZLnNxbHJwYy52MS5FeGVjdXRpb25TdGF0cyJbCgxFeGVjUmVzcG9uc2USIQoDZG1sGAEgASgLMhQuc3FscnBjLnYxLkRNTFJlc3VsdBIoCgVzdGF0cxgCIAEoCzIZLnNxbHJwYy52MS5FeGVjdXRpb25TdGF0cyJkCglETUxSZXN1bHQSFQoNcm93c19hZmZlY3RlZBgBIAEoAxIWCg5sYXN0X2luc2VydF9pZBgCIAEoAxIoCgVzdGF0cxgDIAEoCzIZLnNxbHJwYy52MS5FeGVjdXRpb25TdGF0cyKsAQoXQmVnaW5UcmFuc2FjdGlvblJlcXVlc3QSLQoIZGF0YWJhc2UYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJBIqCgd0aW1lb3V0GAIgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uEjYKBG1vZGUYAyABKA4yHi5zcWxycGMudjEuVHJhbnNhY3Rpb25Mb2NrTW9kZUIIukgFggECEAEiYgoYQmVnaW5UcmFuc2FjdGlvblJlc3BvbnNlEhYKDnRyYW5zYWN0aW9uX2lkGAEgASgJEi4KCmV4cGlyZXNfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIoEBChdUcmFuc2FjdGlvblF1ZXJ5UmVxdWVzdBIiCg50cmFuc2FjdGlvbl9pZBgBIAEoCUIKukgHcgUQARiAARIXCgNzcWwYAiABKAlCCrpIB3IFEAEYgFASKQoKcGFyYW1ldGVycxgDIAEoCzIVLnNxbHJwYy52MS5QYXJhbWV0ZXJzInkKG1RyYW5zYWN0aW9uU2F2ZXBvaW50UmVxdWVzdBIiCg50cmFuc2FjdGlvbl9pZBgBIAEoCUIKukgHcgUQARiAARI2CglzYXZlcG9pbnQYAiABKAsyGy5zcWxycGMudjEuU2F2ZXBvaW50UmVxdWVzdEIGukgDyAEBIl4KEVNhdmVwb2ludFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDAoEbmFtZRgCIAEoCRIqCgZhY3Rpb24YAyABKA4yGi5zcWxycGMudjEuU2F2ZXBvaW50QWN0aW9uIj8KGVRyYW5zYWN0aW9uQ29udHJvbFJlcXVlc3QSIgoOdHJhbnNhY3Rpb25faWQYASABKAlCCrpIB3IFEAEYgAEiLQoaVHJhbnNhY3Rpb25Db250cm9sUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCL1AgoZRXhlY3V0ZVRyYW5zYWN0aW9uUmVxdWVzdBLXAgoIcmVxdWVzdHMYASADKAsyHS5zcWxycGMudjEuVHJhbnNhY3Rpb25SZXF1ZXN0QqUCukihAroBcwoadHJhbnNhY3Rpb24uc2VxdWVuY2Vfc3RhcnQSQVRoZSBmaXJzdCBjb21tYW5kIGluIGFuIEV4ZWN1dGVUcmFuc2FjdGlvbiBzY3JpcHQgbXVzdCBiZSAnYmVnaW4nGhJoYXModGhpc1swXS5iZWdpbim6AaABChh0cmFuc2FjdGlvbi5zZXF1ZW5jZV9lbmQSOlRoZSBzY3JpcHQgbXVzdCBleHBsaWNpdGx5IGVuZCB3aXRoICdjb21taXQnIG9yICdyb2xsYmFjaycaSGhhcyh0aGlzW3RoaXMuc2l6ZSgpIC0gMV0uY29tbWl0KSB8fCBoYXModGhpc1t0aGlzLnNpemUoKSAtIDFdLnJvbGxiYWNrKZIBBAgCEGQiTwoaRXhlY3V0ZVRyYW5zYWN0aW9uUmVzcG9uc2USMQoJcmVzcG9uc2VzGAEgAygLMh4uc3FscnBjLnYxLlRyYW5zYWN0aW9uUmVzcG9uc2UiiwEKEVR5cGVkUXVlcnlSZXF1ZXN0Ei0KCGRhdGFiYXNlGAEgASgJQhu6SBhyFhADGEAyEF5bYS16QS1aMC05Xy1dKyQSFwoDc3FsGAIgASgJQgq6SAdyBRABGIBQEi4KCnBhcmFtZXRlcnMYBCABKAsyGi5zcWxycGMudjEuVHlwZWRQYXJhbWV0ZXJzIosBChxUeXBlZFRyYW5zYWN0aW9uUXVlcnlSZXF1ZXN0EiIKDnRyYW5zYWN0aW9uX2lkGAEgASgJQgq6SAdyBRABGIABEhcKA3NxbBgCIAEoCUIKukgHcgUQARiAUBIuCgpwYXJhbWV0ZXJzGAMgASgLMhouc3FscnBjLnYxLlR5cGVkUGFyYW1ldGVycyL2AQoQVHlwZWRRdWVyeVJlc3VsdBIPCgdjb2x1bW5zGAEgAygJEjQKEWNvbHVtbl9hZmZpbml0aWVzGAIgAygOMhkuc3FscnBjLnYxLkNvbHVtbkFmZmluaXR5EjYKFWNvbHVtbl9kZWNsYXJlZF90eXBlcxgDIAMoDjIXLnNxbHJwYy52MS5EZWNsYXJlZFR5cGUSGAoQY29sdW1uX3Jhd190eXBlcxgEIAMoCRIfCgRyb3dzGAUgAygLMhEuc3FscnBjLnYxLlNxbFJvdxIoCgVzdGF0cxgGIAEoCzIZLnNxbHJwYy52MS5FeGVjdXRpb25TdGF0cyLVAQoNUXVlcnlSZXNwb25zZRIuCgZoZWFkZXIYASABKAsyHC5zcWxycGMudjEuUXVlcnlSZXN1bHRIZWFkZXJIABIvCgViYXRjaBgCIAEoCzIeLnNxbHJwYy52MS5RdWVyeVJlc3VsdFJvd0JhdGNoSAASLAoIY29tcGxldGUYAyABKAsyGC5zcWxycGMudjEuUXVlcnlDb21wbGV0ZUgAEikKBWVycm9yGAUgASgLMhguc3FscnBjLnYxLkVycm9yUmVzcG9uc2VIAEIKCghyZXNwb25zZSLkAQoSVHlwZWRRdWVyeVJlc3BvbnNlEjMKBmhlYWRlchgBIAEoCzIhLnNxbHJwYy52MS5UeXBlZFF1ZXJ5UmVzdWx0SGVhZGVySAASNAoFYmF0Y2gYAiABKAsyIy5zcWxycGMudjEuVHlwZWRRdWVyeVJlc3VsdFJvd0JhdGNoSAASLAoIY29tcGxldGUYAyABKAsyGC5zcWxycGMudjEuUXVlcnlDb21wbGV0ZUgAEikKBWVycm9yGAUgASgLMhguc3FscnBjLnYxLkVycm9yUmVzcG9uc2VIAEIKCghyZXNwb25zZSKsAQoRUXVlcnlSZXN1bHRIZWFkZXISDwoHY29sdW1ucxgBIAMoCRI0ChFjb2x1bW5fYWZmaW5pdGllcxgCIAMoDjIZLnNxbHJwYy52MS5Db2x1bW5BZmZpbml0eRI2ChVjb2x1bW5fZGVjbGFyZWRfdHlwZXMYAyADKA4yFy5zcWxycGMudjEuRGVjbGFyZWRUeXBlEhgKEGNvbHVtbl9yYXdfdHlwZXMYBCADKAkiPwoTUXVlcnlSZXN1bHRSb3dCYXRjaBIoCgRyb3dzGAEgAygLMhouZ29vZ2xlLnByb3RvYnVmLkxpc3RWYWx1ZSKxAQoWVHlwZWRRdWVyeVJlc3VsdEhlYWRlchIPCgdjb2x1bW5zGAEgAygJEjQKEWNvbHVtbl9hZmZpbml0aWVzGAIgAygOMhkuc3FscnBjLnYxLkNvbHVtbkFmZmluaXR5EjYKFWNvbHVtbl9kZWNsYXJlZF90eXBlcxgDIAMoDjIXLnNxbHJwYy52MS5EZWNsYXJlZFR5cGUSGAoQY29sdW1uX3Jhd190eXBlcxgEIAMoCSI7ChhUeXBlZFF1ZXJ5UmVzdWx0Um93QmF0Y2gSHwoEcm93cxgBIAMoCzIRLnNxbHJwYy52MS5TcWxSb3ciOQoNUXVlcnlDb21wbGV0ZRIoCgVzdGF0cxgBIAEoCzIZLnNxbHJwYy52MS5FeGVjdXRpb25TdGF0cyJmCg1FcnJvclJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkSEgoKZmFpbGVkX3NxbBgCIAEoCRIwChFzcWxpdGVfZXJyb3JfY29kZRgDIAEoDjIVLnNxbHJwYy52MS5TcWxpdGVDb2RlIs8EChJUcmFuc2FjdGlvblJlcXVlc3QSKAoFYmVnaW4YASABKAsyFy5zcWxycGMudjEuQmVnaW5SZXF1ZXN0SAASNQoFcXVlcnkYAiABKAsyJC5zcWxycGMudjEuVHJhbnNhY3Rpb25hbFF1ZXJ5UmVxdWVzdEgAEjwKDHF1ZXJ5X3N0cmVhbRgDIAEoCzIkLnNxbHJwYy52MS5UcmFuc2FjdGlvbmFsUXVlcnlSZXF1ZXN0SAASQAoLdHlwZWRfcXVlcnkYBCABKAsyKS5zcWxycGMudjEuVHlwZWRUcmFuc2FjdGlvbmFsUXVlcnlSZXF1ZXN0SAASRwoSdHlwZWRfcXVlcnlfc3RyZWFtGAUgASgLMikuc3FscnBjLnYxLlR5cGVkVHJhbnNhY3Rpb25hbFF1ZXJ5UmVxdWVzdEgAEjQKBGV4ZWMYBiABKAsyJC5zcWxycGMudjEuVHJhbnNhY3Rpb25hbFF1ZXJ5UmVxdWVzdEgAEj8KCnR5cGVkX2V4ZWMYByABKAsyKS5zcWxycGMudjEuVHlwZWRUcmFuc2FjdGlvbmFsUXVlcnlSZXF1ZXN0SAASMAoJc2F2ZXBvaW50GAggASgLMhsuc3FscnBjLnYxLlNhdmVwb2ludFJlcXVlc3RIABIoCgZjb21taXQYCSABKAsyFi5nb29nbGUucHJvdG9idWYuRW1wdHlIABIqCghyb2xsYmFjaxgKIAEoCzIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eUgAQhAKB2NvbW1hbmQSBbpIAggBIpQEChNUcmFuc2FjdGlvblJlc3BvbnNlEikKBWJlZ2luGAEgASgLMhguc3FscnBjLnYxLkJlZ2luUmVzcG9uc2VIABIuCgxxdWVyeV9yZXN1bHQYAiABKAsyFi5zcWxycGMudjEuUXVlcnlSZXN1bHRIABIxCg1zdHJlYW1fcmVzdWx0GAMgASgLMhguc3FscnBjLnYxLlF1ZXJ5UmVzcG9uc2VIABI5ChJ0eXBlZF9xdWVyeV9yZXN1bHQYBCABKAsyGy5zcWxycGMudjEuVHlwZWRRdWVyeVJlc3VsdEgAEjwKE3R5cGVkX3N0cmVhbV9yZXN1bHQYBSABKAsyHS5zcWxycGMudjEuVHlwZWRRdWVyeVJlc3BvbnNlSAASMQoJc2F2ZXBvaW50GAcgASgLMhwuc3FscnBjLnYxLlNhdmVwb2ludFJlc3BvbnNlSAASKwoGY29tbWl0GAggASgLMhkuc3FscnBjLnYxLkNvbW1pdFJlc3BvbnNlSAASLwoIcm9sbGJhY2sYCSABKAsyGy5zcWxycGMudjEuUm9sbGJhY2tSZXNwb25zZUgAEi4KC2V4ZWNfcmVzdWx0GAsgASgLMhcuc3FscnBjLnYxLkV4ZWNSZXNwb25zZUgAEikKBWVycm9yGAogASgLMhguc3FscnBjLnYxLkVycm9yUmVzcG9uc2VIAEIKCghyZXNwb25zZSI6Cg9FeHBsYWluUmVzcG9uc2USJwoFbm9kZXMYASADKAsyGC5zcWxycGMudjEuUXVlcnlQbGFuTm9kZSJCChFMaXN0VGFibGVzUmVxdWVzdBItCghkYXRhYmFzZRgBIAEoCUIbukgYchYQAxhAMhBeW2EtekEtWjAtOV8tXSskIikKEkxpc3RUYWJsZXNSZXNwb25zZRITCgt0YWJsZV9uYW1lcxgBIAMoCSJmChVHZXRUYWJsZVNjaGVtYVJlcXVlc3QSLQoIZGF0YWJhc2UYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJBIeCgp0YWJsZV9uYW1lGAIgASgJQgq6SAdyBRABGIACIkkKGEdldERhdGFiYXNlU2NoZW1hUmVxdWVzdBItCghkYXRhYmFzZRgBIAEoCUIbukgYchYQAxhAMhBeW2EtekEtWjAtOV8tXSskImQKDVZhY3V1bVJlcXVlc3QSLQoIZGF0YWJhc2UYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJBIWCglpbnRvX2ZpbGUYAiABKAlIAIgBAUIMCgpfaW50b19maWxlIjIKDlZhY3V1bVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCSJ1ChFDaGVja3BvaW50UmVxdWVzdBItCghkYXRhYmFzZRgBIAEoCUIbukgYchYQAxhAMhBeW2EtekEtWjAtOV8tXSskEjEKBG1vZGUYAiABKA4yGS5zcWxycGMudjEuQ2hlY2twb2ludE1vZGVCCLpIBYIBAhABIoUBChJDaGVja3BvaW50UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJEhgKEGJ1c3lfY2hlY2twb2ludHMYAyABKAMSFwoPbG9nX2NoZWNrcG9pbnRzGAQgASgDEhoKEmNoZWNrcG9pbnRlZF9wYWdlcxgFIAEoAyJuChVJbnRlZ3JpdHlDaGVja1JlcXVlc3QSLQoIZGF0YWJhc2UYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJBIXCgptYXhfZXJyb3JzGAIgASgFSACIAQFCDQoLX21heF9lcnJvcnMiSgoWSW50ZWdyaXR5Q2hlY2tSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkSDgoGZXJyb3JzGAMgAygJIoABChVBdHRhY2hEYXRhYmFzZVJlcXVlc3QSNAoPcGFyZW50X2RhdGFiYXNlGAEgASgJQhu6SBhyFhADGEAyEF5bYS16QS1aMC05Xy1dKyQSMQoKYXR0YWNobWVudBgCIAEoCzIVLnNxbHJwYy52MS5BdHRhY2htZW50Qga6SAPIAQEiOgoWQXR0YWNoRGF0YWJhc2VSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiZwoVRGV0YWNoRGF0YWJhc2VSZXF1ZXN0EjQKD3BhcmVudF9kYXRhYmFzZRgBIAEoCUIbukgYchYQAxhAMhBeW2EtekEtWjAtOV8tXSskEhgKBWFsaWFzGAIgASgJQgm6SAZyBBABGEAiOgoWRGV0YWNoRGF0YWJhc2VSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkidQoMQmVnaW5SZXF1ZXN0Ei0KCGRhdGFiYXNlGAEgASgJQhu6SBhyFhADGEAyEF5bYS16QS1aMC05Xy1dKyQSNgoEbW9kZRgCIAEoDjIeLnNxbHJwYy52MS5UcmFuc2FjdGlvbkxvY2tNb2RlQgi6SAWCAQIQASJfChlUcmFuc2FjdGlvbmFsUXVlcnlSZXF1ZXN0EhcKA3NxbBgBIAEoCUIKukgHcgUQARiAUBIpCgpwYXJhbWV0ZXJzGAIgASgLMhUuc3FscnBjLnYxLlBhcmFtZXRlcnMicwoQU2F2ZXBvaW50UmVxdWVzdBIpCgRuYW1lGAEgASgJQhu6SBhyFhABGEAyEF5bYS16QS1aMC05Xy1dKyQSNAoGYWN0aW9uGAIgASgOMhouc3FscnBjLnYxLlNhdmVwb2ludEFjdGlvbkIIukgFggECEAEiQgoNQmVnaW5SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEiAKDnRyYW5zYWN0aW9uX2lkGAIgASgJQgi6SAVyA7ABASIhCg5Db21taXRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIiMKEFJvbGxiYWNrUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCJpCh5UeXBlZFRyYW5zYWN0aW9uYWxRdWVyeVJlcXVlc3QSFwoDc3FsGAEgASgJQgq6SAdyBRABGIBQEi4KCnBhcmFtZXRlcnMYAiABKAsyGi5zcWxycGMudjEuVHlwZWRQYXJhbWV0ZXJzIjsKFUxpc3RFeHRlbnNpb25zUmVxdWVzdBIVCghkYXRhYmFzZRgBIAEoCUgAiAEBQgsKCV9kYXRhYmFzZSJGChZMaXN0RXh0ZW5zaW9uc1Jlc3BvbnNlEiwKCmV4dGVuc2lvbnMYASADKAsyGC5zcWxycGMudjEuRXh0ZW5zaW9uSW5mbyJjChRMb2FkRXh0ZW5zaW9uUmVxdWVzdBItCghkYXRhYmFzZRgBIAEoCUIbukgYchYQAxhAMhBeW2EtekEtWjAtOV8tXSskEhwKC2ZvbGRlcl9uYW1lGAIgASgJQge6SARyAhABIjkKFUxvYWRFeHRlbnNpb25SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiVAoLUHVibGlzaEl0ZW0SKwoHY2hhbm5lbBgBIAEoCUIaukgXchUQARhAMg9eW2EtekEtWjAtOV9dKyQSGAoHcGF5bG9hZBgCIAEoCUIHukgEcgIQASKGAQoOUHVibGlzaFJlcXVlc3QSLQoIZGF0YWJhc2UYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJBIrCgdjaGFubmVsGAIgASgJQhq6SBdyFRABGEAyD15bYS16QS1aMC05X10rJBIYCgdwYXlsb2FkGAMgASgJQge6SARyAhABInUKE1B1Ymxpc2hCYXRjaFJlcXVlc3QSLQoIZGF0YWJhc2UYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJBIvCgVpdGVtcxgCIAMoCzIWLnNxbHJwYy52MS5QdWJsaXNoSXRlbUIIukgFkgECCAEiJQoPUHVibGlzaFJlc3BvbnNlEhIKCm1lc3NhZ2VfaWQYASABKAMiKwoUUHVibGlzaEJhdGNoUmVzcG9uc2USEwoLbWVzc2FnZV9pZHMYASADKAMipgEKEFN1YnNjcmliZVJlcXVlc3QSLQoIZGF0YWJhc2UYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJBIrCgdjaGFubmVsGAIgASgJQhq6SBdyFRABGEAyD15bYS16QS1aMC05X10rJBI2ChFzdWJzY3JpcHRpb25fbmFtZRgDIAEoCUIbukgYchYQARhAMhBeW2EtekEtWjAtOV8tXSskIosBChFTdWJzY3JpYmVSZXNwb25zZRIQCghkYXRhYmFzZRgBIAEoCRIPCgdjaGFubmVsGAIgASgJEg8KB3BheWxvYWQYAyABKAkSEgoKbWVzc2FnZV9pZBgEIAEoAxIuCgpjcmVhdGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCqNAQoPU2F2ZXBvaW50QWN0aW9uEiAKHFNBVkVQT0lOVF9BQ1RJT05fVU5TUEVDSUZJRUQQABIbChdTQVZFUE9JTlRfQUNUSU9OX0NSRUFURRABEhwKGFNBVkVQT0lOVF9BQ1RJT05fUkVMRUFTRRACEh0KGVNBVkVQT0lOVF9BQ1RJT05fUk9MTEJBQ0sQAzKIFQoPRGF0YWJhc2VTZXJ2aWNlEjgKBVF1ZXJ5Ehcuc3FscnBjLnYxLlF1ZXJ5UmVxdWVzdBoWLnNxbHJwYy52MS5RdWVyeVJlc3VsdBI4CgRFeGVjEhcuc3FscnBjLnYxLlF1ZXJ5UmVxdWVzdBoXLnNxbHJwYy52MS5FeGVjUmVzcG9uc2USQgoLUXVlcnlTdHJlYW0SFy5zcWxycGMudjEuUXVlcnlSZXF1ZXN0Ghguc3FscnBjLnYxLlF1ZXJ5UmVzcG9uc2UwARJQCgtUcmFuc2FjdGlvbhIdLnNxbHJwYy52MS5UcmFuc2FjdGlvblJlcXVlc3QaHi5zcWxycGMudjEuVHJhbnNhY3Rpb25SZXNwb25zZSgBMAESWwoQQmVnaW5UcmFuc2FjdGlvbhIiLnNxbHJwYy52MS5CZWdpblRyYW5zYWN0aW9uUmVxdWVzdBojLnNxbHJwYy52MS5CZWdpblRyYW5zYWN0aW9uUmVzcG9uc2USTgoQVHJhbnNhY3Rpb25RdWVyeRIiLnNxbHJwYy52MS5UcmFuc2FjdGlvblF1ZXJ5UmVxdWVzdBoWLnNxbHJwYy52MS5RdWVyeVJlc3VsdBJOCg9UcmFuc2FjdGlvbkV4ZWMSIi5zcWxycGMudjEuVHJhbnNhY3Rpb25RdWVyeVJlcXVlc3QaFy5zcWxycGMudjEuRXhlY1Jlc3BvbnNlElgKFlRyYW5zYWN0aW9uUXVlcnlTdHJlYW0SIi5zcWxycGMudjEuVHJhbnNhY3Rpb25RdWVyeVJlcXVlc3QaGC5zcWxycGMudjEuUXVlcnlSZXNwb25zZTABElwKFFRyYW5zYWN0aW9uU2F2ZXBvaW50EiYuc3FscnBjLnYxLlRyYW5zYWN0aW9uU2F2ZXBvaW50UmVxdWVzdBocLnNxbHJwYy52MS5TYXZlcG9pbnRSZXNwb25zZRJgChFDb21taXRUcmFuc2FjdGlvbhIkLnNxbHJwYy52MS5UcmFuc2FjdGlvbkNvbnRyb2xSZXF1ZXN0GiUuc3FscnBjLnYxLlRyYW5zYWN0aW9uQ29udHJvbFJlc3BvbnNlEmIKE1JvbGxiYWNrVHJhbnNhY3Rpb24SJC5zcWxycGMudjEuVHJhbnNhY3Rpb25Db250cm9sUmVxdWVzdBolLnNxbHJwYy52MS5UcmFuc2FjdGlvbkNvbnRyb2xSZXNwb25zZRJhChJFeGVjdXRlVHJhbnNhY3Rpb24SJC5zcWxycGMudjEuRXhlY3V0ZVRyYW5zYWN0aW9uUmVxdWVzdBolLnNxbHJwYy52MS5FeGVjdXRlVHJhbnNhY3Rpb25SZXNwb25zZRJHCgpUeXBlZFF1ZXJ5Ehwuc3FscnBjLnYxLlR5cGVkUXVlcnlSZXF1ZXN0Ghsuc3FscnBjLnYxLlR5cGVkUXVlcnlSZXN1bHQSQgoJVHlwZWRFeGVjEhwuc3FscnBjLnYxLlR5cGVkUXVlcnlSZXF1ZXN0Ghcuc3FscnBjLnYxLkV4ZWNSZXNwb25zZRJRChBUeXBlZFF1ZXJ5U3RyZWFtEhwuc3FscnBjLnYxLlR5cGVkUXVlcnlSZXF1ZXN0Gh0uc3FscnBjLnYxLlR5cGVkUXVlcnlSZXNwb25zZTABEl0KFVR5cGVkVHJhbnNhY3Rpb25RdWVyeRInLnNxbHJwYy52MS5UeXBlZFRyYW5zYWN0aW9uUXVlcnlSZXF1ZXN0Ghsuc3FscnBjLnYxLlR5cGVkUXVlcnlSZXN1bHQSWAoUVHlwZWRUcmFuc2FjdGlvbkV4ZWMSJy5zcWxycGMudjEuVHlwZWRUcmFuc2FjdGlvblF1ZXJ5UmVxdWVzdBoXLnNxbHJwYy52MS5FeGVjUmVzcG9uc2USZwobVHlwZWRUcmFuc2FjdGlvblF1ZXJ5U3RyZWFtEicuc3FscnBjLnYxLlR5cGVkVHJhbnNhY3Rpb25RdWVyeVJlcXVlc3QaHS5zcWxycGMudjEuVHlwZWRRdWVyeVJlc3BvbnNlMAESPgoHRXhwbGFpbhIXLnNxbHJwYy52MS5RdWVyeVJlcXVlc3QaGi5zcWxycGMudjEuRXhwbGFpblJlc3BvbnNlEkgKDFR5cGVkRXhwbGFpbhIcLnNxbHJwYy52MS5UeXBlZFF1ZXJ5UmVxdWVzdBoaLnNxbHJwYy52MS5FeHBsYWluUmVzcG9uc2USSQoKTGlzdFRhYmxlcxIcLnNxbHJwYy52MS5MaXN0VGFibGVzUmVxdWVzdBodLnNxbHJwYy52MS5MaXN0VGFibGVzUmVzcG9uc2USSgoOR2V0VGFibGVTY2hlbWESIC5zcWxycGMudjEuR2V0VGFibGVTY2hlbWFSZXF1ZXN0GhYuc3FscnBjLnYxLlRhYmxlU2NoZW1hElMKEUdldERhdGFiYXNlU2NoZW1hEiMuc3FscnBjLnYxLkdldERhdGFiYXNlU2NoZW1hUmVxdWVzdBoZLnNxbHJwYy52MS5EYXRhYmFzZVNjaGVtYRI9CgZWYWN1dW0SGC5zcWxycGMudjEuVmFjdXVtUmVxdWVzdBoZLnNxbHJwYy52MS5WYWN1dW1SZXNwb25zZRJJCgpDaGVja3BvaW50Ehwuc3FscnBjLnYxLkNoZWNrcG9pbnRSZXF1ZXN0Gh0uc3FscnBjLnYxLkNoZWNrcG9pbnRSZXNwb25zZRJVCg5JbnRlZ3JpdHlDaGVjaxIgLnNxbHJwYy52MS5JbnRlZ3JpdHlDaGVja1JlcXVlc3QaIS5zcWxycGMudjEuSW50ZWdyaXR5Q2hlY2tSZXNwb25zZRJVCg5BdHRhY2hEYXRhYmFzZRIgLnNxbHJwYy52MS5BdHRhY2hEYXRhYmFzZVJlcXVlc3QaIS5zcWxycGMudjEuQXR0YWNoRGF0YWJhc2VSZXNwb25zZRJVCg5EZXRhY2hEYXRhYmFzZRIgLnNxbHJwYy52MS5EZXRhY2hEYXRhYmFzZVJlcXVlc3QaIS5zcWxycGMudjEuRGV0YWNoRGF0YWJhc2VSZXNwb25zZRJVCg5MaXN0RXh0ZW5zaW9ucxIgLnNxbHJwYy52MS5MaXN0RXh0ZW5zaW9uc1JlcXVlc3QaIS5zcWxycGMudjEuTGlzdEV4dGVuc2lvbnNSZXNwb25zZRJSCg1Mb2FkRXh0ZW5zaW9uEh8uc3FscnBjLnYxLkxvYWRFeHRlbnNpb25SZXF1ZXN0GiAuc3FscnBjLnYxLkxvYWRFeHRlbnNpb25SZXNwb25zZRJACgdQdWJsaXNoEhkuc3FscnBjLnYxLlB1Ymxpc2hSZXF1ZXN0Ghouc3FscnBjLnYxLlB1Ymxpc2hSZXNwb25zZRJPCgxQdWJsaXNoQmF0Y2gSHi5zcWxycGMudjEuUHVibGlzaEJhdGNoUmVxdWVzdBofLnNxbHJwYy52MS5QdWJsaXNoQmF0Y2hSZXNwb25zZRJICglTdWJzY3JpYmUSGy5zcWxycGMudjEuU3Vic2NyaWJlUmVxdWVzdBocLnNxbHJwYy52MS5TdWJzY3JpYmVSZXNwb25zZTABQjJaMHNxbGl0ZS1zZXJ2ZXIvaW50ZXJuYWwvcHJvdG9zL3NxbHJwYy92MTtzcWxycGN2MWIGcHJvdG8z", [file_buf_validate_validate, file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_struct, file_google_protobuf_timestamp, file_sqlrpc_v1_enums, file_sqlrpc_v1_types]);
+  fileDesc("ChpzcWxycGMvdjEvZGJfc2VydmljZS5wcm90bxIJc3FscnBjLnYxItwBCgxRdWVyeVJlcXVlc3QSLQoIZGF0YWJhc2UYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJBIXCgNzcWwYAiABKAlCCrpIB3IFEAEYgFASKQoKcGFyYW1ldGVycxgEIAEoCzIVLnNxbHJwYy52MS5QYXJhbWV0ZXJzEiYKFW1heF9leGVjdXRpb25fdGltZV9tcxgFIAEoBUIHukgEGgIoABIRCglyZXN1bWFibGUYBiABKAgSHgoMcmVzdW1lX3Rva2VuGAcgASgJQgi6SAVyAxiAAiL6AQoLUXVlcnlSZXN1bHQSDwoHY29sdW1ucxgBIAMoCRI0ChFjb2x1bW5fYWZmaW5pdGllcxgCIAMoDjIZLnNxbHJwYy52MS5Db2x1bW5BZmZpbml0eRI2ChVjb2x1bW5fZGVjbGFyZWRfdHlwZXMYAyADKA4yFy5zcWxycGMudjEuRGVjbGFyZWRUeXBlEhgKEGNvbHVtbl9yYXdfdHlwZXMYBCADKAkSKAoEcm93cxgFIAMoCzIaLmdvb2dsZS5wcm90b2J1Zi5MaXN0VmFsdWUSKAoFc3RhdHMYBiABKAsyGS5zcWxycGMudjEuRXhlY3V0aW9uU3RhdHMiWwoMRXhlY1Jlc3BvbnNlEiEKA2RtbBgBIAEoCzIULnNxbHJwYy52MS5ETUxSZXN1bHQSKAoFc3RhdHMYAiABKAsyGS5zcWxycGMudjEuRXhlY3V0aW9uU3RhdHMiZAoJRE1MUmVzdWx0EhUKDXJvd3NfYWZmZWN0ZWQYASABKAMSFgoObGFzdF9pbnNlcnRfaWQYAiABKAMSKAoFc3RhdHMYAyABKAsyGS5zcWxycGMudjEuRXhlY3V0aW9uU3RhdHMirAEKF0JlZ2luVHJhbnNhY3Rpb25SZXF1ZXN0Ei0KCGRhdGFiYXNlGAEgASgJQhu6SBhyFhADGEAyEF5bYS16QS1aMC05Xy1dKyQSKgoHdGltZW91dBgCIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhI2CgRtb2RlGAMgASgOMh4uc3FscnBjLnYxLlRyYW5zYWN0aW9uTG9ja01vZGVCCLpIBYIBAhABImIKGEJlZ2luVHJhbnNhY3Rpb25SZXNwb25zZRIWCg50cmFuc2FjdGlvbl9pZBgBIAEoCRIuCgpleHBpcmVzX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKBAQoXVHJhbnNhY3Rpb25RdWVyeVJlcXVlc3QSIgoOdHJhbnNhY3Rpb25faWQYASABKAlCCrpIB3IFEAEYgAESFwoDc3FsGAIgASgJQgq6SAdyBRABGIBQEikKCnBhcmFtZXRlcnMYAyABKAsyFS5zcWxycGMudjEuUGFyYW1ldGVycyJ5ChtUcmFuc2FjdGlvblNhdmVwb2ludFJlcXVlc3QSIgoOdHJhbnNhY3Rpb25faWQYASABKAlCCrpIB3IFEAEYgAESNgoJc2F2ZXBvaW50GAIgASgLMhsuc3FscnBjLnYxLlNhdmVwb2ludFJlcXVlc3RCBrpIA8gBASJeChFTYXZlcG9pbnRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEgwKBG5hbWUYAiABKAkSKgoGYWN0aW9uGAMgASgOMhouc3FscnBjLnYxLlNhdmVwb2ludEFjdGlvbiI/ChlUcmFuc2FjdGlvbkNvbnRyb2xSZXF1ZXN0EiIKDnRyYW5zYWN0aW9uX2lkGAEgASgJQgq6SAdyBRABGIABIi0KGlRyYW5zYWN0aW9uQ29udHJvbFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgi9QIKGUV4ZWN1dGVUcmFuc2FjdGlvblJlcXVlc3QS1wIKCHJlcXVlc3RzGAEgAygLMh0uc3FscnBjLnYxLlRyYW5zYWN0aW9uUmVxdWVzdEKlArpIoQK6AXMKGnRyYW5zYWN0aW9uLnNlcXVlbmNlX3N0YXJ0EkFUaGUgZmlyc3QgY29tbWFuZCBpbiBhbiBFeGVjdXRlVHJhbnNhY3Rpb24gc2NyaXB0IG11c3QgYmUgJ2JlZ2luJxoSaGFzKHRoaXNbMF0uYmVnaW4pugGgAQoYdHJhbnNhY3Rpb24uc2VxdWVuY2VfZW5kEjpUaGUgc2NyaXB0IG11c3QgZXhwbGljaXRseSBlbmQgd2l0aCAnY29tbWl0JyBvciAncm9sbGJhY2snGkhoYXModGhpc1t0aGlzLnNpemUoKSAtIDFdLmNvbW1pdCkgfHwgaGFzKHRoaXNbdGhpcy5zaXplKCkgLSAxXS5yb2xsYmFjaymSAQQIAhBkIk8KGkV4ZWN1dGVUcmFuc2FjdGlvblJlc3BvbnNlEjEKCXJlc3BvbnNlcxgBIAMoCzIeLnNxbHJwYy52MS5UcmFuc2FjdGlvblJlc3BvbnNlIr4BChBCYXRjaEV4ZWNSZXF1ZXN0Ei0KCGRhdGFiYXNlGAEgASgJQhu6SBhyFhADGEAyEF5bYS16QS1aMC05Xy1dKyQSFwoDc3FsGAIgASgJQgq6SAdyBRABGIBQEjoKDnBhcmFtZXRlcl9zZXRzGAMgAygLMhUuc3FscnBjLnYxLlBhcmFtZXRlcnNCC7pICJIBBQgBEJBOEiYKFW1heF9leGVjdXRpb25fdGltZV9tcxgEIAEoBUIHukgEGgIoACJkChFCYXRjaEV4ZWNSZXNwb25zZRIlCgdyZXN1bHRzGAEgAygLMhQuc3FscnBjLnYxLkRNTFJlc3VsdBIoCgVzdGF0cxgCIAEoCzIZLnNxbHJwYy52MS5FeGVjdXRpb25TdGF0cyKyAQoRT3BlbkN1cnNvclJlcXVlc3QSLQoIZGF0YWJhc2UYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJBIXCgNzcWwYAiABKAlCCrpIB3IFEAEYgFASKQoKcGFyYW1ldGVycxgDIAEoCzIVLnNxbHJwYy52MS5QYXJhbWV0ZXJzEioKB3RpbWVvdXQYBCABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24ihQEKEk9wZW5DdXJzb3JSZXNwb25zZRIRCgljdXJzb3JfaWQYASABKAkSLAoGaGVhZGVyGAIgASgLMhwuc3FscnBjLnYxLlF1ZXJ5UmVzdWx0SGVhZGVyEi4KCmV4cGlyZXNfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIk8KEkZldGNoQ3Vyc29yUmVxdWVzdBIdCgljdXJzb3JfaWQYASABKAlCCrpIB3IFEAEYgAESGgoIbWF4X3Jvd3MYAiABKA1CCLpIBSoDGJBOIo8BChNGZXRjaEN1cnNvclJlc3BvbnNlEigKBHJvd3MYASADKAsyGi5nb29nbGUucHJvdG9idWYuTGlzdFZhbHVlEgwKBGRvbmUYAiABKAgSEAoIcG9zaXRpb24YAyABKAMSLgoKZXhwaXJlc19hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiMwoSQ2xvc2VDdXJzb3JSZXF1ZXN0Eh0KCWN1cnNvcl9pZBgBIAEoCUIKukgHcgUQARiAASIVChNDbG9zZUN1cnNvclJlc3BvbnNlIrMBChFUeXBlZFF1ZXJ5UmVxdWVzdBItCghkYXRhYmFzZRgBIAEoCUIbukgYchYQAxhAMhBeW2EtekEtWjAtOV8tXSskEhcKA3NxbBgCIAEoCUIKukgHcgUQARiAUBIuCgpwYXJhbWV0ZXJzGAQgASgLMhouc3FscnBjLnYxLlR5cGVkUGFyYW1ldGVycxImChVtYXhfZXhlY3V0aW9uX3RpbWVfbXMYBSABKAVCB7pIBBoCKAAihQEKDUltcG9ydFJlcXVlc3QSJwoFc3RhcnQYASABKAsyFi5zcWxycGMudjEuSW1wb3J0U3RhcnRIABIOCgRkYXRhGAIgASgMSAASKQoEcm93cxgDIAEoCzIZLnNxbHJwYy52MS5JbXBvcnRSb3dCYXRjaEgAQhAKB3BheWxvYWQSBbpIAggBIoMECgtJbXBvcnRTdGFydBItCghkYXRhYmFzZRgBIAEoCUIbukgYchYQAxhAMhBeW2EtekEtWjAtOV8tXSskEhkKBXRhYmxlGAIgASgJQgq6SAdyBRABGIABEjMKBmZvcm1hdBgDIAEoDjIXLnNxbHJwYy52MS5JbXBvcnRGb3JtYXRCCrpIB4IBBBABIAASGQoGZmllbGRzGAQgAygJQgm6SAaSAQMQ0A8SWAoOY29sdW1uX21hcHBpbmcYBSADKAsyKS5zcWxycGMudjEuSW1wb3J0U3RhcnQuQ29sdW1uTWFwcGluZ0VudHJ5QhW6SBKaAQ8Q0A8iBHICEAEqBHICEAESEgoKY3N2X2hlYWRlchgGIAEoCBIeCg1jc3ZfZGVsaW1pdGVyGAcgASgJQge6SARyAhgBEhQKDGNyZWF0ZV90YWJsZRgIIAEoCBI4Cgtvbl9jb25mbGljdBgJIAEoDjIZLnNxbHJwYy52MS5JbXBvcnRDb25mbGljdEIIukgFggECEAESHwoKYmF0Y2hfc2l6ZRgKIAEoBUILukgIGgYYoI0GKAASJQoRbWF4X2Vycm9yX3NhbXBsZXMYCyABKAVCCrpIBxoFGOgHKAAaNAoSQ29sdW1uTWFwcGluZ0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiMQoOSW1wb3J0Um93QmF0Y2gSHwoEcm93cxgBIAMoCzIRLnNxbHJwYy52MS5TcWxSb3ciMQoOSW1wb3J0Um93RXJyb3ISDgoGcmVjb3JkGAEgASgDEg8KB21lc3NhZ2UYAiABKAkipAIKDkltcG9ydFJlc3BvbnNlEhUKDXJvd3NfYWNjZXB0ZWQYASABKAMSFQoNcm93c19yZWplY3RlZBgCIAEoAxIUCgxyb3dzX2lnbm9yZWQYAyABKAMSKQoGZXJyb3JzGAQgAygLMhkuc3FscnBjLnYxLkltcG9ydFJvd0Vycm9yEhUKDXRhYmxlX2NyZWF0ZWQYBSABKAgSDwoHY29sdW1ucxgGIAMoCRI2ChVjb2x1bW5fZGVjbGFyZWRfdHlwZXMYByADKA4yFy5zcWxycGMudjEuRGVjbGFyZWRUeXBlEhkKEWJhdGNoZXNfY29tbWl0dGVkGAggASgDEigKBXN0YXRzGAkgASgLMhkuc3FscnBjLnYxLkV4ZWN1dGlvblN0YXRzIsgBChVUeXBlZEJhdGNoRXhlY1JlcXVlc3QSLQoIZGF0YWJhc2UYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJBIXCgNzcWwYAiABKAlCCrpIB3IFEAEYgFASPwoOcGFyYW1ldGVyX3NldHMYAyADKAsyGi5zcWxycGMudjEuVHlwZWRQYXJhbWV0ZXJzQgu6SAiSAQUIARCQThImChVtYXhfZXhlY3V0aW9uX3RpbWVfbXMYBCABKAVCB7pIBBoCKAAiiwEKHFR5cGVkVHJhbnNhY3Rpb25RdWVyeVJlcXVlc3QSIgoOdHJhbnNhY3Rpb25faWQYASABKAlCCrpIB3IFEAEYgAESFwoDc3FsGAIgASgJQgq6SAdyBRABGIBQEi4KCnBhcmFtZXRlcnMYAyABKAsyGi5zcWxycGMudjEuVHlwZWRQYXJhbWV0ZXJzIvYBChBUeXBlZFF1ZXJ5UmVzdWx0Eg8KB2NvbHVtbnMYASADKAkSNAoRY29sdW1uX2FmZmluaXRpZXMYAiADKA4yGS5zcWxycGMudjEuQ29sdW1uQWZmaW5pdHkSNgoVY29sdW1uX2RlY2xhcmVkX3R5cGVzGAMgAygOMhcuc3FscnBjLnYxLkRlY2xhcmVkVHlwZRIYChBjb2x1bW5fcmF3X3R5cGVzGAQgAygJEh8KBHJvd3MYBSADKAsyES5zcWxycGMudjEuU3FsUm93EigKBXN0YXRzGAYgASgLMhkuc3FscnBjLnYxLkV4ZWN1dGlvblN0YXRzItUBCg1RdWVyeVJlc3BvbnNlEi4KBmhlYWRlchgBIAEoCzIcLnNxbHJwYy52MS5RdWVyeVJlc3VsdEhlYWRlckgAEi8KBWJhdGNoGAIgASgLMh4uc3FscnBjLnYxLlF1ZXJ5UmVzdWx0Um93QmF0Y2hIABIsCghjb21wbGV0ZRgDIAEoCzIYLnNxbHJwYy52MS5RdWVyeUNvbXBsZXRlSAASKQoFZXJyb3IYBSABKAsyGC5zcWxycGMudjEuRXJyb3JSZXNwb25zZUgAQgoKCHJlc3BvbnNlIuQBChJUeXBlZFF1ZXJ5UmVzcG9uc2USMwoGaGVhZGVyGAEgASgLMiEuc3FscnBjLnYxLlR5cGVkUXVlcnlSZXN1bHRIZWFkZXJIABI0CgViYXRjaBgCIAEoCzIjLnNxbHJwYy52MS5UeXBlZFF1ZXJ5UmVzdWx0Um93QmF0Y2hIABIsCghjb21wbGV0ZRgDIAEoCzIYLnNxbHJwYy52MS5RdWVyeUNvbXBsZXRlSAASKQoFZXJyb3IYBSABKAsyGC5zcWxycGMudjEuRXJyb3JSZXNwb25zZUgAQgoKCHJlc3BvbnNlIqwBChFRdWVyeVJlc3VsdEhlYWRlchIPCgdjb2x1bW5zGAEgAygJEjQKEWNvbHVtbl9hZmZpbml0aWVzGAIgAygOMhkuc3FscnBjLnYxLkNvbHVtbkFmZmluaXR5EjYKFWNvbHVtbl9kZWNsYXJlZF90eXBlcxgDIAMoDjIXLnNxbHJwYy52MS5EZWNsYXJlZFR5cGUSGAoQY29sdW1uX3Jhd190eXBlcxgEIAMoCSJVChNRdWVyeVJlc3VsdFJvd0JhdGNoEigKBHJvd3MYASADKAsyGi5nb29nbGUucHJvdG9idWYuTGlzdFZhbHVlEhQKDHJlc3VtZV90b2tlbhgCIAEoCSKxAQoWVHlwZWRRdWVyeVJlc3VsdEhlYWRlchIPCgdjb2x1bW5zGAEgAygJEjQKEWNvbHVtbl9hZmZpbml0aWVzGAIgAygOMhkuc3FscnBjLnYxLkNvbHVtbkFmZmluaXR5EjYKFWNvbHVtbl9kZWNsYXJlZF90eXBlcxgDIAMoDjIXLnNxbHJwYy52MS5EZWNsYXJlZFR5cGUSGAoQY29sdW1uX3Jhd190eXBlcxgEIAMoCSI7ChhUeXBlZFF1ZXJ5UmVzdWx0Um93QmF0Y2gSHwoEcm93cxgBIAMoCzIRLnNxbHJwYy52MS5TcWxSb3ciOQoNUXVlcnlDb21wbGV0ZRIoCgVzdGF0cxgBIAEoCzIZLnNxbHJwYy52MS5FeGVjdXRpb25TdGF0cyKQAQoNRXJyb3JSZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJEhIKCmZhaWxlZF9zcWwYAiABKAkSMAoRc3FsaXRlX2Vycm9yX2NvZGUYAyABKA4yFS5zcWxycGMudjEuU3FsaXRlQ29kZRIYCgtiYXRjaF9pbmRleBgEIAEoBUgAiAEBQg4KDF9iYXRjaF9pbmRleCLPBAoSVHJhbnNhY3Rpb25SZXF1ZXN0EigKBWJlZ2luGAEgASgLMhcuc3FscnBjLnYxLkJlZ2luUmVxdWVzdEgAEjUKBXF1ZXJ5GAIgASgLMiQuc3FscnBjLnYxLlRyYW5zYWN0aW9uYWxRdWVyeVJlcXVlc3RIABI8CgxxdWVyeV9zdHJlYW0YAyABKAsyJC5zcWxycGMudjEuVHJhbnNhY3Rpb25hbFF1ZXJ5UmVxdWVzdEgAEkAKC3R5cGVkX3F1ZXJ5GAQgASgLMikuc3FscnBjLnYxLlR5cGVkVHJhbnNhY3Rpb25hbFF1ZXJ5UmVxdWVzdEgAEkcKEnR5cGVkX3F1ZXJ5X3N0cmVhbRgFIAEoCzIpLnNxbHJwYy52MS5UeXBlZFRyYW5zYWN0aW9uYWxRdWVyeVJlcXVlc3RIABI0CgRleGVjGAYgASgLMiQuc3FscnBjLnYxLlRyYW5zYWN0aW9uYWxRdWVyeVJlcXVlc3RIABI/Cgp0eXBlZF9leGVjGAcgASgLMikuc3FscnBjLnYxLlR5cGVkVHJhbnNhY3Rpb25hbFF1ZXJ5UmVxdWVzdEgAEjAKCXNhdmVwb2ludBgIIAEoCzIbLnNxbHJwYy52MS5TYXZlcG9pbnRSZXF1ZXN0SAASKAoGY29tbWl0GAkgASgLMhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5SAASKgoIcm9sbGJhY2sYCiABKAsyFi5nb29nbGUucHJvdG9idWYuRW1wdHlIAEIQCgdjb21tYW5kEgW6SAIIASKUBAoTVHJhbnNhY3Rpb25SZXNwb25zZRIpCgViZWdpbhgBIAEoCzIYLnNxbHJwYy52MS5CZWdpblJlc3BvbnNlSAASLgoMcXVlcnlfcmVzdWx0GAIgASgLMhYuc3FscnBjLnYxLlF1ZXJ5UmVzdWx0SAASMQoNc3RyZWFtX3Jlc3VsdBgDIAEoCzIYLnNxbHJwYy52MS5RdWVyeVJlc3BvbnNlSAASOQoSdHlwZWRfcXVlcnlfcmVzdWx0GAQgASgLMhsuc3FscnBjLnYxLlR5cGVkUXVlcnlSZXN1bHRIABI8ChN0eXBlZF9zdHJlYW1fcmVzdWx0GAUgASgLMh0uc3FscnBjLnYxLlR5cGVkUXVlcnlSZXNwb25zZUgAEjEKCXNhdmVwb2ludBgHIAEoCzIcLnNxbHJwYy52MS5TYXZlcG9pbnRSZXNwb25zZUgAEisKBmNvbW1pdBgIIAEoCzIZLnNxbHJwYy52MS5Db21taXRSZXNwb25zZUgAEi8KCHJvbGxiYWNrGAkgASgLMhsuc3FscnBjLnYxLlJvbGxiYWNrUmVzcG9uc2VIABIuCgtleGVjX3Jlc3VsdBgLIAEoCzIXLnNxbHJwYy52MS5FeGVjUmVzcG9uc2VIABIpCgVlcnJvchgKIAEoCzIYLnNxbHJwYy52MS5FcnJvclJlc3BvbnNlSABCCgoIcmVzcG9uc2UiOgoPRXhwbGFpblJlc3BvbnNlEicKBW5vZGVzGAEgAygLMhguc3FscnBjLnYxLlF1ZXJ5UGxhbk5vZGUiQgoRTGlzdFRhYmxlc1JlcXVlc3QSLQoIZGF0YWJhc2UYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJCIpChJMaXN0VGFibGVzUmVzcG9uc2USEwoLdGFibGVfbmFtZXMYASADKAkiZgoVR2V0VGFibGVTY2hlbWFSZXF1ZXN0Ei0KCGRhdGFiYXNlGAEgASgJQhu6SBhyFhADGEAyEF5bYS16QS1aMC05Xy1dKyQSHgoKdGFibGVfbmFtZRgCIAEoCUIKukgHcgUQARiAAiJJChhHZXREYXRhYmFzZVNjaGVtYVJlcXVlc3QSLQoIZGF0YWJhc2UYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJCJkCg1WYWN1dW1SZXF1ZXN0Ei0KCGRhdGFiYXNlGAEgASgJQhu6SBhyFhADGEAyEF5bYS16QS1aMC05Xy1dKyQSFgoJaW50b19maWxlGAIgASgJSACIAQFCDAoKX2ludG9fZmlsZSIyCg5WYWN1dW1SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkidQoRQ2hlY2twb2ludFJlcXVlc3QSLQoIZGF0YWJhc2UYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJBIxCgRtb2RlGAIgASgOMhkuc3FscnBjLnYxLkNoZWNrcG9pbnRNb2RlQgi6SAWCAQIQASKFAQoSQ2hlY2twb2ludFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCRIYChBidXN5X2NoZWNrcG9pbnRzGAMgASgDEhcKD2xvZ19jaGVja3BvaW50cxgEIAEoAxIaChJjaGVja3BvaW50ZWRfcGFnZXMYBSABKAMibgoVSW50ZWdyaXR5Q2hlY2tSZXF1ZXN0Ei0KCGRhdGFiYXNlGAEgASgJQhu6SBhyFhADGEAyEF5bYS16QS1aMC05Xy1dKyQSFwoKbWF4X2Vycm9ycxgCIAEoBUgAiAEBQg0KC19tYXhfZXJyb3JzIkoKFkludGVncml0eUNoZWNrUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJEg4KBmVycm9ycxgDIAMoCSK4AQoKQmFja3VwSW5mbxIRCgliYWNrdXBfaWQYASABKAkSEAoIZGF0YWJhc2UYAiABKAkSLgoKY3JlYXRlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEgoKc2l6ZV9ieXRlcxgEIAEoAxIxCgtjb21wcmVzc2lvbhgFIAEoDjIcLnNxbHJwYy52MS5CYWNrdXBDb21wcmVzc2lvbhIOCgZzaGEyNTYYBiABKAkigwEKFUJhY2t1cERhdGFiYXNlUmVxdWVzdBItCghkYXRhYmFzZRgBIAEoCUIbukgYchYQAxhAMhBeW2EtekEtWjAtOV8tXSskEjsKC2NvbXByZXNzaW9uGAIgASgOMhwuc3FscnBjLnYxLkJhY2t1cENvbXByZXNzaW9uQgi6SAWCAQIQASI/ChZCYWNrdXBEYXRhYmFzZVJlc3BvbnNlEiUKBmJhY2t1cBgBIAEoCzIVLnNxbHJwYy52MS5CYWNrdXBJbmZvIkMKEkxpc3RCYWNrdXBzUmVxdWVzdBItCghkYXRhYmFzZRgBIAEoCUIbukgYchYQAxhAMhBeW2EtekEtWjAtOV8tXSskIj0KE0xpc3RCYWNrdXBzUmVzcG9uc2USJgoHYmFja3VwcxgBIAMoCzIVLnNxbHJwYy52MS5CYWNrdXBJbmZvInUKFlJlc3RvcmVEYXRhYmFzZVJlcXVlc3QSLQoIZGF0YWJhc2UYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJBIsCgliYWNrdXBfaWQYAiABKAlCGbpIFnIUEAEYQDIOXlthLXpBLVowLTldKyQiYgoXUmVzdG9yZURhdGFiYXNlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJEiUKBmJhY2t1cBgDIAEoCzIVLnNxbHJwYy52MS5CYWNrdXBJbmZvInIKFURvd25sb2FkQmFja3VwUmVxdWVzdBItCghkYXRhYmFzZRgBIAEoCUIbukgYchYQAxhAMhBeW2EtekEtWjAtOV8tXSskEioKCWJhY2t1cF9pZBgCIAEoCUIXukgUchIYQDIOXlthLXpBLVowLTldKiQiTgoWRG93bmxvYWRCYWNrdXBSZXNwb25zZRIlCgZiYWNrdXAYASABKAsyFS5zcWxycGMudjEuQmFja3VwSW5mbxINCgVjaHVuaxgCIAEoDCJ0ChNEdW1wRGF0YWJhc2VSZXF1ZXN0Ei0KCGRhdGFiYXNlGAEgASgJQhu6SBhyFhADGEAyEF5bYS16QS1aMC05Xy1dKyQSGQoGdGFibGVzGAIgAygJQgm6SAaSAQMQ6AcSEwoLc2NoZW1hX29ubHkYAyABKAgiIwoURHVtcERhdGFiYXNlUmVzcG9uc2USCwoDc3FsGAEgASgJIoABChVBdHRhY2hEYXRhYmFzZVJlcXVlc3QSNAoPcGFyZW50X2RhdGFiYXNlGAEgASgJQhu6SBhyFhADGEAyEF5bYS16QS1aMC05Xy1dKyQSMQoKYXR0YWNobWVudBgCIAEoCzIVLnNxbHJwYy52MS5BdHRhY2htZW50Qga6SAPIAQEiOgoWQXR0YWNoRGF0YWJhc2VSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiZwoVRGV0YWNoRGF0YWJhc2VSZXF1ZXN0EjQKD3BhcmVudF9kYXRhYmFzZRgBIAEoCUIbukgYchYQAxhAMhBeW2EtekEtWjAtOV8tXSskEhgKBWFsaWFzGAIgASgJQgm6SAZyBBABGEAiOgoWRGV0YWNoRGF0YWJhc2VSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkidQoMQmVnaW5SZXF1ZXN0Ei0KCGRhdGFiYXNlGAEgASgJQhu6SBhyFhADGEAyEF5bYS16QS1aMC05Xy1dKyQSNgoEbW9kZRgCIAEoDjIeLnNxbHJwYy52MS5UcmFuc2FjdGlvbkxvY2tNb2RlQgi6SAWCAQIQASJfChlUcmFuc2FjdGlvbmFsUXVlcnlSZXF1ZXN0EhcKA3NxbBgBIAEoCUIKukgHcgUQARiAUBIpCgpwYXJhbWV0ZXJzGAIgASgLMhUuc3FscnBjLnYxLlBhcmFtZXRlcnMicwoQU2F2ZXBvaW50UmVxdWVzdBIpCgRuYW1lGAEgASgJQhu6SBhyFhABGEAyEF5bYS16QS1aMC05Xy1dKyQSNAoGYWN0aW9uGAIgASgOMhouc3FscnBjLnYxLlNhdmVwb2ludEFjdGlvbkIIukgFggECEAEiQgoNQmVnaW5SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEiAKDnRyYW5zYWN0aW9uX2lkGAIgASgJQgi6SAVyA7ABASIhCg5Db21taXRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIiMKEFJvbGxiYWNrUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCJpCh5UeXBlZFRyYW5zYWN0aW9uYWxRdWVyeVJlcXVlc3QSFwoDc3FsGAEgASgJQgq6SAdyBRABGIBQEi4KCnBhcmFtZXRlcnMYAiABKAsyGi5zcWxycGMudjEuVHlwZWRQYXJhbWV0ZXJzIjsKFUxpc3RFeHRlbnNpb25zUmVxdWVzdBIVCghkYXRhYmFzZRgBIAEoCUgAiAEBQgsKCV9kYXRhYmFzZSJGChZMaXN0RXh0ZW5zaW9uc1Jlc3BvbnNlEiwKCmV4dGVuc2lvbnMYASADKAsyGC5zcWxycGMudjEuRXh0ZW5zaW9uSW5mbyJjChRMb2FkRXh0ZW5zaW9uUmVxdWVzdBItCghkYXRhYmFzZRgBIAEoCUIbukgYchYQAxhAMhBeW2EtekEtWjAtOV8tXSskEhwKC2ZvbGRlcl9uYW1lGAIgASgJQge6SARyAhABIjkKFUxvYWRFeHRlbnNpb25SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiVAoLUHVibGlzaEl0ZW0SKwoHY2hhbm5lbBgBIAEoCUIaukgXchUQARhAMg9eW2EtekEtWjAtOV9dKyQSGAoHcGF5bG9hZBgCIAEoCUIHukgEcgIQASKGAQoOUHVibGlzaFJlcXVlc3QSLQoIZGF0YWJhc2UYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJBIrCgdjaGFubmVsGAIgASgJQhq6SBdyFRABGEAyD15bYS16QS1aMC05X10rJBIYCgdwYXlsb2FkGAMgASgJQge6SARyAhABInUKE1B1Ymxpc2hCYXRjaFJlcXVlc3QSLQoIZGF0YWJhc2UYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJBIvCgVpdGVtcxgCIAMoCzIWLnNxbHJwYy52MS5QdWJsaXNoSXRlbUIIukgFkgECCAEiJQoPUHVibGlzaFJlc3BvbnNlEhIKCm1lc3NhZ2VfaWQYASABKAMiKwoUUHVibGlzaEJhdGNoUmVzcG9uc2USEwoLbWVzc2FnZV9pZHMYASADKAMipgEKEFN1YnNjcmliZVJlcXVlc3QSLQoIZGF0YWJhc2UYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJBIrCgdjaGFubmVsGAIgASgJQhq6SBdyFRABGEAyD15bYS16QS1aMC05X10rJBI2ChFzdWJzY3JpcHRpb25fbmFtZRgDIAEoCUIbukgYchYQARhAMhBeW2EtekEtWjAtOV8tXSskIqABChFTdWJzY3JpYmVSZXNwb25zZRIQCghkYXRhYmFzZRgBIAEoCRIPCgdjaGFubmVsGAIgASgJEg8KB3BheWxvYWQYAyABKAkSEgoKbWVzc2FnZV9pZBgEIAEoAxIuCgpjcmVhdGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBITCgt0cmFjZXBhcmVudBgGIAEoCSqNAQoPU2F2ZXBvaW50QWN0aW9uEiAKHFNBVkVQT0lOVF9BQ1RJT05fVU5TUEVDSUZJRUQQABIbChdTQVZFUE9JTlRfQUNUSU9OX0NSRUFURRABEhwKGFNBVkVQT0lOVF9BQ1RJT05fUkVMRUFTRRACEh0KGVNBVkVQT0lOVF9BQ1RJT05fUk9MTEJBQ0sQAzL1GwoPRGF0YWJhc2VTZXJ2aWNlEjgKBVF1ZXJ5Ehcuc3FscnBjLnYxLlF1ZXJ5UmVxdWVzdBoWLnNxbHJwYy52MS5RdWVyeVJlc3VsdBI4CgRFeGVjEhcuc3FscnBjLnYxLlF1ZXJ5UmVxdWVzdBoXLnNxbHJwYy52MS5FeGVjUmVzcG9uc2USQgoLUXVlcnlTdHJlYW0SFy5zcWxycGMudjEuUXVlcnlSZXF1ZXN0Ghguc3FscnBjLnYxLlF1ZXJ5UmVzcG9uc2UwARJQCgtUcmFuc2FjdGlvbhIdLnNxbHJwYy52MS5UcmFuc2FjdGlvblJlcXVlc3QaHi5zcWxycGMudjEuVHJhbnNhY3Rpb25SZXNwb25zZSgBMAESWwoQQmVnaW5UcmFuc2FjdGlvbhIiLnNxbHJwYy52MS5CZWdpblRyYW5zYWN0aW9uUmVxdWVzdBojLnNxbHJwYy52MS5CZWdpblRyYW5zYWN0aW9uUmVzcG9uc2USTgoQVHJhbnNhY3Rpb25RdWVyeRIiLnNxbHJwYy52MS5UcmFuc2FjdGlvblF1ZXJ5UmVxdWVzdBoWLnNxbHJwYy52MS5RdWVyeVJlc3VsdBJOCg9UcmFuc2FjdGlvbkV4ZWMSIi5zcWxycGMudjEuVHJhbnNhY3Rpb25RdWVyeVJlcXVlc3QaFy5zcWxycGMudjEuRXhlY1Jlc3BvbnNlElgKFlRyYW5zYWN0aW9uUXVlcnlTdHJlYW0SIi5zcWxycGMudjEuVHJhbnNhY3Rpb25RdWVyeVJlcXVlc3QaGC5zcWxycGMudjEuUXVlcnlSZXNwb25zZTABElwKFFRyYW5zYWN0aW9uU2F2ZXBvaW50EiYuc3FscnBjLnYxLlRyYW5zYWN0aW9uU2F2ZXBvaW50UmVxdWVzdBocLnNxbHJwYy52MS5TYXZlcG9pbnRSZXNwb25zZRJgChFDb21taXRUcmFuc2FjdGlvbhIkLnNxbHJwYy52MS5UcmFuc2FjdGlvbkNvbnRyb2xSZXF1ZXN0GiUuc3FscnBjLnYxLlRyYW5zYWN0aW9uQ29udHJvbFJlc3BvbnNlEmIKE1JvbGxiYWNrVHJhbnNhY3Rpb24SJC5zcWxycGMudjEuVHJhbnNhY3Rpb25Db250cm9sUmVxdWVzdBolLnNxbHJwYy52MS5UcmFuc2FjdGlvbkNvbnRyb2xSZXNwb25zZRJJCgpPcGVuQ3Vyc29yEhwuc3FscnBjLnYxLk9wZW5DdXJzb3JSZXF1ZXN0Gh0uc3FscnBjLnYxLk9wZW5DdXJzb3JSZXNwb25zZRJMCgtGZXRjaEN1cnNvchIdLnNxbHJwYy52MS5GZXRjaEN1cnNvclJlcXVlc3QaHi5zcWxycGMudjEuRmV0Y2hDdXJzb3JSZXNwb25zZRJMCgtDbG9zZUN1cnNvchIdLnNxbHJwYy52MS5DbG9zZUN1cnNvclJlcXVlc3QaHi5zcWxycGMudjEuQ2xvc2VDdXJzb3JSZXNwb25zZRJhChJFeGVjdXRlVHJhbnNhY3Rpb24SJC5zcWxycGMudjEuRXhlY3V0ZVRyYW5zYWN0aW9uUmVxdWVzdBolLnNxbHJwYy52MS5FeGVjdXRlVHJhbnNhY3Rpb25SZXNwb25zZRJGCglCYXRjaEV4ZWMSGy5zcWxycGMudjEuQmF0Y2hFeGVjUmVxdWVzdBocLnNxbHJwYy52MS5CYXRjaEV4ZWNSZXNwb25zZRI/CgZJbXBvcnQSGC5zcWxycGMudjEuSW1wb3J0UmVxdWVzdBoZLnNxbHJwYy52MS5JbXBvcnRSZXNwb25zZSgBEkcKClR5cGVkUXVlcnkSHC5zcWxycGMudjEuVHlwZWRRdWVyeVJlcXVlc3QaGy5zcWxycGMudjEuVHlwZWRRdWVyeVJlc3VsdBJCCglUeXBlZEV4ZWMSHC5zcWxycGMudjEuVHlwZWRRdWVyeVJlcXVlc3QaFy5zcWxycGMudjEuRXhlY1Jlc3BvbnNlElAKDlR5cGVkQmF0Y2hFeGVjEiAuc3FscnBjLnYxLlR5cGVkQmF0Y2hFeGVjUmVxdWVzdBocLnNxbHJwYy52MS5CYXRjaEV4ZWNSZXNwb25zZRJRChBUeXBlZFF1ZXJ5U3RyZWFtEhwuc3FscnBjLnYxLlR5cGVkUXVlcnlSZXF1ZXN0Gh0uc3FscnBjLnYxLlR5cGVkUXVlcnlSZXNwb25zZTABEl0KFVR5cGVkVHJhbnNhY3Rpb25RdWVyeRInLnNxbHJwYy52MS5UeXBlZFRyYW5zYWN0aW9uUXVlcnlSZXF1ZXN0Ghsuc3FscnBjLnYxLlR5cGVkUXVlcnlSZXN1bHQSWAoUVHlwZWRUcmFuc2FjdGlvbkV4ZWMSJy5zcWxycGMudjEuVHlwZWRUcmFuc2FjdGlvblF1ZXJ5UmVxdWVzdBoXLnNxbHJwYy52MS5FeGVjUmVzcG9uc2USZwobVHlwZWRUcmFuc2FjdGlvblF1ZXJ5U3RyZWFtEicuc3FscnBjLnYxLlR5cGVkVHJhbnNhY3Rpb25RdWVyeVJlcXVlc3QaHS5zcWxycGMudjEuVHlwZWRRdWVyeVJlc3BvbnNlMAESPgoHRXhwbGFpbhIXLnNxbHJwYy52MS5RdWVyeVJlcXVlc3QaGi5zcWxycGMudjEuRXhwbGFpblJlc3BvbnNlEkgKDFR5cGVkRXhwbGFpbhIcLnNxbHJwYy52MS5UeXBlZFF1ZXJ5UmVxdWVzdBoaLnNxbHJwYy52MS5FeHBsYWluUmVzcG9uc2USSQoKTGlzdFRhYmxlcxIcLnNxbHJwYy52MS5MaXN0VGFibGVzUmVxdWVzdBodLnNxbHJwYy52MS5MaXN0VGFibGVzUmVzcG9uc2USSgoOR2V0VGFibGVTY2hlbWESIC5zcWxycGMudjEuR2V0VGFibGVTY2hlbWFSZXF1ZXN0GhYuc3FscnBjLnYxLlRhYmxlU2NoZW1hElMKEUdldERhdGFiYXNlU2NoZW1hEiMuc3FscnBjLnYxLkdldERhdGFiYXNlU2NoZW1hUmVxdWVzdBoZLnNxbHJwYy52MS5EYXRhYmFzZVNjaGVtYRI9CgZWYWN1dW0SGC5zcWxycGMudjEuVmFjdXVtUmVxdWVzdBoZLnNxbHJwYy52MS5WYWN1dW1SZXNwb25zZRJJCgpDaGVja3BvaW50Ehwuc3FscnBjLnYxLkNoZWNrcG9pbnRSZXF1ZXN0Gh0uc3FscnBjLnYxLkNoZWNrcG9pbnRSZXNwb25zZRJVCg5JbnRlZ3JpdHlDaGVjaxIgLnNxbHJwYy52MS5JbnRlZ3JpdHlDaGVja1JlcXVlc3QaIS5zcWxycGMudjEuSW50ZWdyaXR5Q2hlY2tSZXNwb25zZRJVCg5BdHRhY2hEYXRhYmFzZRIgLnNxbHJwYy52MS5BdHRhY2hEYXRhYmFzZVJlcXVlc3QaIS5zcWxycGMudjEuQXR0YWNoRGF0YWJhc2VSZXNwb25zZRJVCg5EZXRhY2hEYXRhYmFzZRIgLnNxbHJwYy52MS5EZXRhY2hEYXRhYmFzZVJlcXVlc3QaIS5zcWxycGMudjEuRGV0YWNoRGF0YWJhc2VSZXNwb25zZRJVCg5CYWNrdXBEYXRhYmFzZRIgLnNxbHJwYy52MS5CYWNrdXBEYXRhYmFzZVJlcXVlc3QaIS5zcWxycGMudjEuQmFja3VwRGF0YWJhc2VSZXNwb25zZRJMCgtMaXN0QmFja3VwcxIdLnNxbHJwYy52MS5MaXN0QmFja3Vwc1JlcXVlc3QaHi5zcWxycGMudjEuTGlzdEJhY2t1cHNSZXNwb25zZRJYCg9SZXN0b3JlRGF0YWJhc2USIS5zcWxycGMudjEuUmVzdG9yZURhdGFiYXNlUmVxdWVzdBoiLnNxbHJwYy52MS5SZXN0b3JlRGF0YWJhc2VSZXNwb25zZRJXCg5Eb3dubG9hZEJhY2t1cBIgLnNxbHJwYy52MS5Eb3dubG9hZEJhY2t1cFJlcXVlc3QaIS5zcWxycGMudjEuRG93bmxvYWRCYWNrdXBSZXNwb25zZTABElEKDER1bXBEYXRhYmFzZRIeLnNxbHJwYy52MS5EdW1wRGF0YWJhc2VSZXF1ZXN0Gh8uc3FscnBjLnYxLkR1bXBEYXRhYmFzZVJlc3BvbnNlMAESVQoOTGlzdEV4dGVuc2lvbnMSIC5zcWxycGMudjEuTGlzdEV4dGVuc2lvbnNSZXF1ZXN0GiEuc3FscnBjLnYxLkxpc3RFeHRlbnNpb25zUmVzcG9uc2USUgoNTG9hZEV4dGVuc2lvbhIfLnNxbHJwYy52MS5Mb2FkRXh0ZW5zaW9uUmVxdWVzdBogLnNxbHJwYy52MS5Mb2FkRXh0ZW5zaW9uUmVzcG9uc2USQAoHUHVibGlzaBIZLnNxbHJwYy52MS5QdWJsaXNoUmVxdWVzdBoaLnNxbHJwYy52MS5QdWJsaXNoUmVzcG9uc2USTwoMUHVibGlzaEJhdGNoEh4uc3FscnBjLnYxLlB1Ymxpc2hCYXRjaFJlcXVlc3QaHy5zcWxycGMudjEuUHVibGlzaEJhdGNoUmVzcG9uc2USSAoJU3Vic2NyaWJlEhsuc3FscnBjLnYxLlN1YnNjcmliZVJlcXVlc3QaHC5zcWxycGMudjEuU3Vic2NyaWJlUmVzcG9uc2UwAUIyWjBzcWxpdGUtc2VydmVyL2ludGVybmFsL3Byb3Rvcy9zcWxycGMvdjE7c3FscnBjdjFiBnByb3RvMw", [file_buf_validate_validate, file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_struct, file_google_protobuf_timestamp, file_sqlrpc_v1_enums, file_sqlrpc_v1_types]);
 
 /**
  * *
@@ -46,6 +46,31 @@ export type QueryRequest = Message<"sqlrpc.v1.QueryRequest"> & {
    * @generated from field: sqlrpc.v1.Parameters parameters = 4;
    */
   parameters?: Parameters;
+
+  /**
+   * Execution time limit for this call in milliseconds. Capped by the limits
+   * of the database and the caller's role; 0 uses the cap.
+   *
+   * @generated from field: int32 max_execution_time_ms = 5;
+   */
+  maxExecutionTimeMs: number;
+
+  /**
+   * QueryStream only: read the result through a cursor, so that every row
+   * batch carries a resume_token. The query must be read-only.
+   *
+   * @generated from field: bool resumable = 6;
+   */
+  resumable: boolean;
+
+  /**
+   * QueryStream only: continue a resumable stream that broke off, after the
+   * batch that carried this token. The database and sql must be those of the
+   * original request. The stream starts over with the header.
+   *
+   * @generated from field: string resume_token = 7;
+   */
+  resumeToken: string;
 };
 
 /**
@@ -428,11 +453,11 @@ export const ExecuteTransactionResponseSchema: GenMessage<ExecuteTransactionResp
 
 /**
  * *
- * TypedQueryRequest executes a query using strictly-typed parameters.
+ * BatchExecRequest executes one statement once per parameter set.
  *
- * @generated from message sqlrpc.v1.TypedQueryRequest
+ * @generated from message sqlrpc.v1.BatchExecRequest
  */
-export type TypedQueryRequest = Message<"sqlrpc.v1.TypedQueryRequest"> & {
+export type BatchExecRequest = Message<"sqlrpc.v1.BatchExecRequest"> & {
   /**
    * Tenant target identifier for connection routing.
    *
@@ -441,576 +466,783 @@ export type TypedQueryRequest = Message<"sqlrpc.v1.TypedQueryRequest"> & {
   database: string;
 
   /**
-   * SQL statement to execute.
+   * The single SQL statement to execute.
    *
    * @generated from field: string sql = 2;
    */
   sql: string;
 
   /**
-   * Strictly-typed parameters.
+   * Parameters of each execution, in order.
    *
-   * @generated from field: sqlrpc.v1.TypedParameters parameters = 4;
+   * @generated from field: repeated sqlrpc.v1.Parameters parameter_sets = 3;
    */
-  parameters?: TypedParameters;
+  parameterSets: Parameters[];
+
+  /**
+   * Execution time limit for the whole batch in milliseconds. Capped by the
+   * limits of the database and the caller's role; 0 uses the cap.
+   *
+   * @generated from field: int32 max_execution_time_ms = 4;
+   */
+  maxExecutionTimeMs: number;
 };
 
 /**
- * Describes the message sqlrpc.v1.TypedQueryRequest.
- * Use `create(TypedQueryRequestSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.BatchExecRequest.
+ * Use `create(BatchExecRequestSchema)` to create a new message.
  */
-export const TypedQueryRequestSchema: GenMessage<TypedQueryRequest> = /*@__PURE__*/
+export const BatchExecRequestSchema: GenMessage<BatchExecRequest> = /*@__PURE__*/
   messageDesc(file_sqlrpc_v1_db_service, 13);
 
 /**
  * *
- * TypedTransactionQueryRequest executes a typed query within an active
- * transaction.
+ * BatchExecResponse holds the outcome of every execution of a committed batch.
  *
- * @generated from message sqlrpc.v1.TypedTransactionQueryRequest
+ * @generated from message sqlrpc.v1.BatchExecResponse
  */
-export type TypedTransactionQueryRequest = Message<"sqlrpc.v1.TypedTransactionQueryRequest"> & {
-  /**
-   * Absolute identifier for the active transaction.
-   *
-   * @generated from field: string transaction_id = 1;
-   */
-  transactionId: string;
-
+export type BatchExecResponse = Message<"sqlrpc.v1.BatchExecResponse"> & {
   /**
-   * SQL statement to execute.
+   * One result per parameter set, in request order.
    *
-   * @generated from field: string sql = 2;
+   * @generated from field: repeated sqlrpc.v1.DMLResult results = 1;
    */
-  sql: string;
+  results: DMLResult[];
 
   /**
-   * Strictly-typed parameters.
+   * Telemetry for the whole batch.
    *
-   * @generated from field: sqlrpc.v1.TypedParameters parameters = 3;
+   * @generated from field: sqlrpc.v1.ExecutionStats stats = 2;
    */
-  parameters?: TypedParameters;
+  stats?: ExecutionStats;
 };
 
 /**
- * Describes the message sqlrpc.v1.TypedTransactionQueryRequest.
- * Use `create(TypedTransactionQueryRequestSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.BatchExecResponse.
+ * Use `create(BatchExecResponseSchema)` to create a new message.
  */
-export const TypedTransactionQueryRequestSchema: GenMessage<TypedTransactionQueryRequest> = /*@__PURE__*/
+export const BatchExecResponseSchema: GenMessage<BatchExecResponse> = /*@__PURE__*/
   messageDesc(file_sqlrpc_v1_db_service, 14);
 
 /**
  * *
- * TypedQueryResult contains strictly-typed results and telemetry.
+ * OpenCursorRequest opens a cursor over the result of a read-only query.
  *
- * @generated from message sqlrpc.v1.TypedQueryResult
+ * @generated from message sqlrpc.v1.OpenCursorRequest
  */
-export type TypedQueryResult = Message<"sqlrpc.v1.TypedQueryResult"> & {
-  /**
-   * @generated from field: repeated string columns = 1;
-   */
-  columns: string[];
-
+export type OpenCursorRequest = Message<"sqlrpc.v1.OpenCursorRequest"> & {
   /**
-   * Affinities determined by the SQLite engine for each column.
-   *
-   * @generated from field: repeated sqlrpc.v1.ColumnAffinity column_affinities = 2;
-   */
-  columnAffinities: ColumnAffinity[];
-
-  /**
-   * Declared types from the table schema for each column.
+   * Tenant target identifier for connection routing.
    *
-   * @generated from field: repeated sqlrpc.v1.DeclaredType column_declared_types = 3;
+   * @generated from field: string database = 1;
    */
-  columnDeclaredTypes: DeclaredType[];
+  database: string;
 
   /**
-   * Raw type strings returned by the underlying driver.
+   * Read-only SQL statement to run.
    *
-   * @generated from field: repeated string column_raw_types = 4;
+   * @generated from field: string sql = 2;
    */
-  columnRawTypes: string[];
+  sql: string;
 
   /**
-   * Ordered list of typed rows.
+   * Parameters to bind to the statement.
    *
-   * @generated from field: repeated sqlrpc.v1.SqlRow rows = 5;
+   * @generated from field: sqlrpc.v1.Parameters parameters = 3;
    */
-  rows: SqlRow[];
+  parameters?: Parameters;
 
   /**
-   * Performance and execution telemetry.
+   * Optional time the cursor stays open without being fetched from.
    *
-   * @generated from field: sqlrpc.v1.ExecutionStats stats = 6;
+   * @generated from field: google.protobuf.Duration timeout = 4;
    */
-  stats?: ExecutionStats;
+  timeout?: Duration;
 };
 
 /**
- * Describes the message sqlrpc.v1.TypedQueryResult.
- * Use `create(TypedQueryResultSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.OpenCursorRequest.
+ * Use `create(OpenCursorRequestSchema)` to create a new message.
  */
-export const TypedQueryResultSchema: GenMessage<TypedQueryResult> = /*@__PURE__*/
+export const OpenCursorRequestSchema: GenMessage<OpenCursorRequest> = /*@__PURE__*/
   messageDesc(file_sqlrpc_v1_db_service, 15);
 
 /**
  * *
- * QueryResponse defines a chunked result from a streaming query.
+ * OpenCursorResponse identifies an open cursor and describes its columns.
  *
- * @generated from message sqlrpc.v1.QueryResponse
+ * @generated from message sqlrpc.v1.OpenCursorResponse
  */
-export type QueryResponse = Message<"sqlrpc.v1.QueryResponse"> & {
+export type OpenCursorResponse = Message<"sqlrpc.v1.OpenCursorResponse"> & {
   /**
-   * @generated from oneof sqlrpc.v1.QueryResponse.response
+   * Unique cursor identifier (UUID-v7 based).
+   *
+   * @generated from field: string cursor_id = 1;
    */
-  response: {
-    /**
-     * @generated from field: sqlrpc.v1.QueryResultHeader header = 1;
-     */
-    value: QueryResultHeader;
-    case: "header";
-  } | {
-    /**
-     * @generated from field: sqlrpc.v1.QueryResultRowBatch batch = 2;
-     */
-    value: QueryResultRowBatch;
-    case: "batch";
-  } | {
-    /**
-     * @generated from field: sqlrpc.v1.QueryComplete complete = 3;
-     */
-    value: QueryComplete;
-    case: "complete";
-  } | {
-    /**
-     * @generated from field: sqlrpc.v1.ErrorResponse error = 5;
-     */
-    value: ErrorResponse;
-    case: "error";
-  } | { case: undefined; value?: undefined };
+  cursorId: string;
+
+  /**
+   * Column metadata of the result.
+   *
+   * @generated from field: sqlrpc.v1.QueryResultHeader header = 2;
+   */
+  header?: QueryResultHeader;
+
+  /**
+   * Timestamp when the cursor is closed if it is not fetched from.
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 3;
+   */
+  expiresAt?: Timestamp;
 };
 
 /**
- * Describes the message sqlrpc.v1.QueryResponse.
- * Use `create(QueryResponseSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.OpenCursorResponse.
+ * Use `create(OpenCursorResponseSchema)` to create a new message.
  */
-export const QueryResponseSchema: GenMessage<QueryResponse> = /*@__PURE__*/
+export const OpenCursorResponseSchema: GenMessage<OpenCursorResponse> = /*@__PURE__*/
   messageDesc(file_sqlrpc_v1_db_service, 16);
 
 /**
  * *
- * TypedQueryResponse defines a chunked result from a typed streaming query.
+ * FetchCursorRequest reads the next rows of a cursor.
  *
- * @generated from message sqlrpc.v1.TypedQueryResponse
+ * @generated from message sqlrpc.v1.FetchCursorRequest
  */
-export type TypedQueryResponse = Message<"sqlrpc.v1.TypedQueryResponse"> & {
+export type FetchCursorRequest = Message<"sqlrpc.v1.FetchCursorRequest"> & {
   /**
-   * @generated from oneof sqlrpc.v1.TypedQueryResponse.response
+   * Identifier of the open cursor.
+   *
+   * @generated from field: string cursor_id = 1;
    */
-  response: {
-    /**
-     * Initial response containing column metadata.
-     *
-     * @generated from field: sqlrpc.v1.TypedQueryResultHeader header = 1;
-     */
-    value: TypedQueryResultHeader;
-    case: "header";
-  } | {
-    /**
-     * Batch of typed result rows.
-     *
-     * @generated from field: sqlrpc.v1.TypedQueryResultRowBatch batch = 2;
-     */
-    value: TypedQueryResultRowBatch;
-    case: "batch";
-  } | {
-    /**
-     * Final response indicating stream completion and stats.
-     *
-     * @generated from field: sqlrpc.v1.QueryComplete complete = 3;
-     */
-    value: QueryComplete;
-    case: "complete";
-  } | {
-    /**
-     * Error information if the query failed during streaming.
-     *
-     * @generated from field: sqlrpc.v1.ErrorResponse error = 5;
-     */
-    value: ErrorResponse;
-    case: "error";
-  } | { case: undefined; value?: undefined };
+  cursorId: string;
+
+  /**
+   * Maximum number of rows to return; 0 returns up to 500.
+   *
+   * @generated from field: uint32 max_rows = 2;
+   */
+  maxRows: number;
 };
 
 /**
- * Describes the message sqlrpc.v1.TypedQueryResponse.
- * Use `create(TypedQueryResponseSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.FetchCursorRequest.
+ * Use `create(FetchCursorRequestSchema)` to create a new message.
  */
-export const TypedQueryResponseSchema: GenMessage<TypedQueryResponse> = /*@__PURE__*/
+export const FetchCursorRequestSchema: GenMessage<FetchCursorRequest> = /*@__PURE__*/
   messageDesc(file_sqlrpc_v1_db_service, 17);
 
 /**
- * @generated from message sqlrpc.v1.QueryResultHeader
+ * *
+ * FetchCursorResponse holds the next rows of a cursor.
+ *
+ * @generated from message sqlrpc.v1.FetchCursorResponse
  */
-export type QueryResultHeader = Message<"sqlrpc.v1.QueryResultHeader"> & {
+export type FetchCursorResponse = Message<"sqlrpc.v1.FetchCursorResponse"> & {
   /**
-   * @generated from field: repeated string columns = 1;
+   * Collection of rows, where each row is a ListValue.
+   *
+   * @generated from field: repeated google.protobuf.ListValue rows = 1;
    */
-  columns: string[];
+  rows: ListValue[];
 
   /**
-   * @generated from field: repeated sqlrpc.v1.ColumnAffinity column_affinities = 2;
+   * Set once the result is exhausted; the cursor is then closed.
+   *
+   * @generated from field: bool done = 2;
    */
-  columnAffinities: ColumnAffinity[];
+  done: boolean;
 
   /**
-   * @generated from field: repeated sqlrpc.v1.DeclaredType column_declared_types = 3;
+   * Number of rows fetched from the cursor so far, these included.
+   *
+   * @generated from field: int64 position = 3;
    */
-  columnDeclaredTypes: DeclaredType[];
+  position: bigint;
 
   /**
-   * @generated from field: repeated string column_raw_types = 4;
+   * Timestamp when the cursor is closed if it is not fetched from again.
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 4;
    */
-  columnRawTypes: string[];
+  expiresAt?: Timestamp;
 };
 
 /**
- * Describes the message sqlrpc.v1.QueryResultHeader.
- * Use `create(QueryResultHeaderSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.FetchCursorResponse.
+ * Use `create(FetchCursorResponseSchema)` to create a new message.
  */
-export const QueryResultHeaderSchema: GenMessage<QueryResultHeader> = /*@__PURE__*/
+export const FetchCursorResponseSchema: GenMessage<FetchCursorResponse> = /*@__PURE__*/
   messageDesc(file_sqlrpc_v1_db_service, 18);
 
 /**
  * *
- * QueryResultRowBatch contains a collection of rows for an untyped query.
+ * CloseCursorRequest closes a cursor.
  *
- * @generated from message sqlrpc.v1.QueryResultRowBatch
+ * @generated from message sqlrpc.v1.CloseCursorRequest
  */
-export type QueryResultRowBatch = Message<"sqlrpc.v1.QueryResultRowBatch"> & {
+export type CloseCursorRequest = Message<"sqlrpc.v1.CloseCursorRequest"> & {
   /**
-   * Collection of rows, where each row is a ListValue.
+   * Identifier of the open cursor.
    *
-   * @generated from field: repeated google.protobuf.ListValue rows = 1;
+   * @generated from field: string cursor_id = 1;
    */
-  rows: ListValue[];
+  cursorId: string;
 };
 
 /**
- * Describes the message sqlrpc.v1.QueryResultRowBatch.
- * Use `create(QueryResultRowBatchSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.CloseCursorRequest.
+ * Use `create(CloseCursorRequestSchema)` to create a new message.
  */
-export const QueryResultRowBatchSchema: GenMessage<QueryResultRowBatch> = /*@__PURE__*/
+export const CloseCursorRequestSchema: GenMessage<CloseCursorRequest> = /*@__PURE__*/
   messageDesc(file_sqlrpc_v1_db_service, 19);
 
 /**
  * *
- * TypedQueryResultHeader provides metadata for a typed streaming query.
+ * CloseCursorResponse confirms that a cursor was closed.
  *
- * @generated from message sqlrpc.v1.TypedQueryResultHeader
+ * @generated from message sqlrpc.v1.CloseCursorResponse
  */
-export type TypedQueryResultHeader = Message<"sqlrpc.v1.TypedQueryResultHeader"> & {
+export type CloseCursorResponse = Message<"sqlrpc.v1.CloseCursorResponse"> & {
+};
+
+/**
+ * Describes the message sqlrpc.v1.CloseCursorResponse.
+ * Use `create(CloseCursorResponseSchema)` to create a new message.
+ */
+export const CloseCursorResponseSchema: GenMessage<CloseCursorResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 20);
+
+/**
+ * *
+ * TypedQueryRequest executes a query using strictly-typed parameters.
+ *
+ * @generated from message sqlrpc.v1.TypedQueryRequest
+ */
+export type TypedQueryRequest = Message<"sqlrpc.v1.TypedQueryRequest"> & {
   /**
-   * Ordered list of column names.
+   * Tenant target identifier for connection routing.
    *
-   * @generated from field: repeated string columns = 1;
+   * @generated from field: string database = 1;
    */
-  columns: string[];
+  database: string;
 
   /**
-   * Affinities determined by the SQLite engine for each column.
+   * SQL statement to execute.
    *
-   * @generated from field: repeated sqlrpc.v1.ColumnAffinity column_affinities = 2;
+   * @generated from field: string sql = 2;
    */
-  columnAffinities: ColumnAffinity[];
+  sql: string;
 
   /**
-   * Declared types from the table schema for each column.
+   * Strictly-typed parameters.
    *
-   * @generated from field: repeated sqlrpc.v1.DeclaredType column_declared_types = 3;
+   * @generated from field: sqlrpc.v1.TypedParameters parameters = 4;
    */
-  columnDeclaredTypes: DeclaredType[];
+  parameters?: TypedParameters;
 
   /**
-   * Raw type strings returned by the underlying driver.
+   * Execution time limit for this call in milliseconds. Capped by the limits
+   * of the database and the caller's role; 0 uses the cap.
    *
-   * @generated from field: repeated string column_raw_types = 4;
+   * @generated from field: int32 max_execution_time_ms = 5;
    */
-  columnRawTypes: string[];
+  maxExecutionTimeMs: number;
 };
 
 /**
- * Describes the message sqlrpc.v1.TypedQueryResultHeader.
- * Use `create(TypedQueryResultHeaderSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.TypedQueryRequest.
+ * Use `create(TypedQueryRequestSchema)` to create a new message.
  */
-export const TypedQueryResultHeaderSchema: GenMessage<TypedQueryResultHeader> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 20);
+export const TypedQueryRequestSchema: GenMessage<TypedQueryRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 21);
 
 /**
  * *
- * TypedQueryResultRowBatch contains a collection of rows for a typed query.
+ * ImportRequest is one message of an Import stream.
  *
- * @generated from message sqlrpc.v1.TypedQueryResultRowBatch
+ * @generated from message sqlrpc.v1.ImportRequest
  */
-export type TypedQueryResultRowBatch = Message<"sqlrpc.v1.TypedQueryResultRowBatch"> & {
+export type ImportRequest = Message<"sqlrpc.v1.ImportRequest"> & {
   /**
-   * Collection of strictly-typed rows.
-   *
-   * @generated from field: repeated sqlrpc.v1.SqlRow rows = 1;
+   * @generated from oneof sqlrpc.v1.ImportRequest.payload
    */
-  rows: SqlRow[];
+  payload: {
+    /**
+     * Target and options. Must be the first message and only sent once.
+     *
+     * @generated from field: sqlrpc.v1.ImportStart start = 1;
+     */
+    value: ImportStart;
+    case: "start";
+  } | {
+    /**
+     * Next chunk of CSV or NDJSON text. Chunks may split records anywhere.
+     *
+     * @generated from field: bytes data = 2;
+     */
+    value: Uint8Array;
+    case: "data";
+  } | {
+    /**
+     * Next records of an IMPORT_FORMAT_ROWS import.
+     *
+     * @generated from field: sqlrpc.v1.ImportRowBatch rows = 3;
+     */
+    value: ImportRowBatch;
+    case: "rows";
+  } | { case: undefined; value?: undefined };
 };
 
 /**
- * Describes the message sqlrpc.v1.TypedQueryResultRowBatch.
- * Use `create(TypedQueryResultRowBatchSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.ImportRequest.
+ * Use `create(ImportRequestSchema)` to create a new message.
  */
-export const TypedQueryResultRowBatchSchema: GenMessage<TypedQueryResultRowBatch> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 21);
+export const ImportRequestSchema: GenMessage<ImportRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 22);
 
 /**
  * *
- * QueryComplete signals the successful end of a streaming operation.
+ * ImportStart describes where and how Import inserts the records.
  *
- * @generated from message sqlrpc.v1.QueryComplete
+ * @generated from message sqlrpc.v1.ImportStart
  */
-export type QueryComplete = Message<"sqlrpc.v1.QueryComplete"> & {
+export type ImportStart = Message<"sqlrpc.v1.ImportStart"> & {
   /**
-   * Final execution telemetry.
+   * Tenant target identifier for connection routing.
    *
-   * @generated from field: sqlrpc.v1.ExecutionStats stats = 1;
+   * @generated from field: string database = 1;
    */
-  stats?: ExecutionStats;
+  database: string;
+
+  /**
+   * Table the records are inserted into.
+   *
+   * @generated from field: string table = 2;
+   */
+  table: string;
+
+  /**
+   * Encoding of the records.
+   *
+   * @generated from field: sqlrpc.v1.ImportFormat format = 3;
+   */
+  format: ImportFormat;
+
+  /**
+   * Names of the source fields: the CSV columns or SqlRow values in order, or
+   * the NDJSON keys to read. Defaults to the CSV header, the table's columns,
+   * or the keys of the first NDJSON object when the table does not exist yet.
+   *
+   * @generated from field: repeated string fields = 4;
+   */
+  fields: string[];
+
+  /**
+   * Renames source fields to table columns. Unmapped fields are inserted into
+   * the column of the same name.
+   *
+   * @generated from field: map<string, string> column_mapping = 5;
+   */
+  columnMapping: { [key: string]: string };
+
+  /**
+   * The first CSV record names the fields instead of holding data.
+   *
+   * @generated from field: bool csv_header = 6;
+   */
+  csvHeader: boolean;
+
+  /**
+   * CSV field delimiter. Defaults to a comma.
+   *
+   * @generated from field: string csv_delimiter = 7;
+   */
+  csvDelimiter: string;
+
+  /**
+   * Creates the table when it does not exist, with column types inferred
+   * from the first batch of records.
+   *
+   * @generated from field: bool create_table = 8;
+   */
+  createTable: boolean;
+
+  /**
+   * Handling of records that violate a uniqueness constraint.
+   *
+   * @generated from field: sqlrpc.v1.ImportConflict on_conflict = 9;
+   */
+  onConflict: ImportConflict;
+
+  /**
+   * Records per committed transaction. Defaults to 1000.
+   *
+   * @generated from field: int32 batch_size = 10;
+   */
+  batchSize: number;
+
+  /**
+   * Rejected records reported with their error. Defaults to 10.
+   *
+   * @generated from field: int32 max_error_samples = 11;
+   */
+  maxErrorSamples: number;
 };
 
 /**
- * Describes the message sqlrpc.v1.QueryComplete.
- * Use `create(QueryCompleteSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.ImportStart.
+ * Use `create(ImportStartSchema)` to create a new message.
  */
-export const QueryCompleteSchema: GenMessage<QueryComplete> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 22);
+export const ImportStartSchema: GenMessage<ImportStart> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 23);
 
 /**
- * @generated from message sqlrpc.v1.ErrorResponse
+ * *
+ * ImportRowBatch carries records of an IMPORT_FORMAT_ROWS import.
+ *
+ * @generated from message sqlrpc.v1.ImportRowBatch
  */
-export type ErrorResponse = Message<"sqlrpc.v1.ErrorResponse"> & {
+export type ImportRowBatch = Message<"sqlrpc.v1.ImportRowBatch"> & {
   /**
-   * Human-readable error message.
-   *
-   * @generated from field: string message = 1;
+   * @generated from field: repeated sqlrpc.v1.SqlRow rows = 1;
    */
-  message: string;
+  rows: SqlRow[];
+};
+
+/**
+ * Describes the message sqlrpc.v1.ImportRowBatch.
+ * Use `create(ImportRowBatchSchema)` to create a new message.
+ */
+export const ImportRowBatchSchema: GenMessage<ImportRowBatch> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 24);
 
+/**
+ * *
+ * ImportRowError reports a rejected record.
+ *
+ * @generated from message sqlrpc.v1.ImportRowError
+ */
+export type ImportRowError = Message<"sqlrpc.v1.ImportRowError"> & {
   /**
-   * The SQL statement that failed execution.
+   * 1-based position of the record in the input, not counting a CSV header.
    *
-   * @generated from field: string failed_sql = 2;
+   * @generated from field: int64 record = 1;
    */
-  failedSql: string;
+  record: bigint;
 
   /**
-   * Corresponding SQLite error code.
+   * Why the record was rejected.
    *
-   * @generated from field: sqlrpc.v1.SqliteCode sqlite_error_code = 3;
+   * @generated from field: string message = 2;
    */
-  sqliteErrorCode: SqliteCode;
+  message: string;
 };
 
 /**
- * Describes the message sqlrpc.v1.ErrorResponse.
- * Use `create(ErrorResponseSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.ImportRowError.
+ * Use `create(ImportRowErrorSchema)` to create a new message.
  */
-export const ErrorResponseSchema: GenMessage<ErrorResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 23);
+export const ImportRowErrorSchema: GenMessage<ImportRowError> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 25);
 
 /**
  * *
- * TransactionRequest defines a command in a multiplexed transaction stream.
+ * ImportResponse summarizes a finished import.
  *
- * @generated from message sqlrpc.v1.TransactionRequest
+ * @generated from message sqlrpc.v1.ImportResponse
  */
-export type TransactionRequest = Message<"sqlrpc.v1.TransactionRequest"> & {
+export type ImportResponse = Message<"sqlrpc.v1.ImportResponse"> & {
   /**
-   * @generated from oneof sqlrpc.v1.TransactionRequest.command
+   * Records inserted (or replacing an existing row).
+   *
+   * @generated from field: int64 rows_accepted = 1;
    */
-  command: {
-    /**
-     * Start the transaction (must be first).
-     *
-     * @generated from field: sqlrpc.v1.BeginRequest begin = 1;
-     */
-    value: BeginRequest;
-    case: "begin";
-  } | {
-    /**
-     * Untyped query execution within the transaction.
-     *
-     * @generated from field: sqlrpc.v1.TransactionalQueryRequest query = 2;
-     */
-    value: TransactionalQueryRequest;
-    case: "query";
-  } | {
-    /**
-     * Untyped streaming query execution within the transaction.
-     *
-     * @generated from field: sqlrpc.v1.TransactionalQueryRequest query_stream = 3;
-     */
-    value: TransactionalQueryRequest;
-    case: "queryStream";
-  } | {
-    /**
-     * Strictly-typed query execution within the transaction.
-     *
-     * @generated from field: sqlrpc.v1.TypedTransactionalQueryRequest typed_query = 4;
-     */
-    value: TypedTransactionalQueryRequest;
-    case: "typedQuery";
-  } | {
-    /**
-     * Strictly-typed streaming query execution within the transaction.
-     *
-     * @generated from field: sqlrpc.v1.TypedTransactionalQueryRequest typed_query_stream = 5;
-     */
-    value: TypedTransactionalQueryRequest;
-    case: "typedQueryStream";
-  } | {
-    /**
-     * Unary mutation operation (DML).
-     *
-     * @generated from field: sqlrpc.v1.TransactionalQueryRequest exec = 6;
-     */
-    value: TransactionalQueryRequest;
-    case: "exec";
-  } | {
-    /**
-     * Strictly-typed mutation operation (DML).
-     *
-     * @generated from field: sqlrpc.v1.TypedTransactionalQueryRequest typed_exec = 7;
-     */
-    value: TypedTransactionalQueryRequest;
-    case: "typedExec";
-  } | {
-    /**
-     * Transactional control: create/manage savepoints.
-     *
-     * @generated from field: sqlrpc.v1.SavepointRequest savepoint = 8;
-     */
-    value: SavepointRequest;
-    case: "savepoint";
-  } | {
-    /**
-     * Transactional control: commit the transaction.
-     *
-     * @generated from field: google.protobuf.Empty commit = 9;
-     */
-    value: Empty;
-    case: "commit";
-  } | {
-    /**
-     * Transactional control: rollback the transaction.
-     *
-     * @generated from field: google.protobuf.Empty rollback = 10;
-     */
-    value: Empty;
-    case: "rollback";
-  } | { case: undefined; value?: undefined };
+  rowsAccepted: bigint;
+
+  /**
+   * Records that could not be parsed or inserted.
+   *
+   * @generated from field: int64 rows_rejected = 2;
+   */
+  rowsRejected: bigint;
+
+  /**
+   * Records skipped by IMPORT_CONFLICT_IGNORE.
+   *
+   * @generated from field: int64 rows_ignored = 3;
+   */
+  rowsIgnored: bigint;
+
+  /**
+   * The first rejected records, up to max_error_samples.
+   *
+   * @generated from field: repeated sqlrpc.v1.ImportRowError errors = 4;
+   */
+  errors: ImportRowError[];
+
+  /**
+   * Whether the table was created by this import.
+   *
+   * @generated from field: bool table_created = 5;
+   */
+  tableCreated: boolean;
+
+  /**
+   * Table columns the fields were inserted into, in field order.
+   *
+   * @generated from field: repeated string columns = 6;
+   */
+  columns: string[];
+
+  /**
+   * Inferred types of the columns of a created table, parallel to columns.
+   *
+   * @generated from field: repeated sqlrpc.v1.DeclaredType column_declared_types = 7;
+   */
+  columnDeclaredTypes: DeclaredType[];
+
+  /**
+   * Transactions committed.
+   *
+   * @generated from field: int64 batches_committed = 8;
+   */
+  batchesCommitted: bigint;
+
+  /**
+   * Telemetry for the whole import.
+   *
+   * @generated from field: sqlrpc.v1.ExecutionStats stats = 9;
+   */
+  stats?: ExecutionStats;
 };
 
 /**
- * Describes the message sqlrpc.v1.TransactionRequest.
- * Use `create(TransactionRequestSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.ImportResponse.
+ * Use `create(ImportResponseSchema)` to create a new message.
  */
-export const TransactionRequestSchema: GenMessage<TransactionRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 24);
+export const ImportResponseSchema: GenMessage<ImportResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 26);
 
 /**
  * *
- * TransactionResponse defines a result in a multiplexed transaction stream.
+ * TypedBatchExecRequest executes one statement once per typed parameter set.
  *
- * @generated from message sqlrpc.v1.TransactionResponse
+ * @generated from message sqlrpc.v1.TypedBatchExecRequest
  */
-export type TransactionResponse = Message<"sqlrpc.v1.TransactionResponse"> & {
+export type TypedBatchExecRequest = Message<"sqlrpc.v1.TypedBatchExecRequest"> & {
   /**
-   * @generated from oneof sqlrpc.v1.TransactionResponse.response
+   * Tenant target identifier for connection routing.
+   *
+   * @generated from field: string database = 1;
    */
-  response: {
-    /**
-     * Confirmation of transaction start.
-     *
-     * @generated from field: sqlrpc.v1.BeginResponse begin = 1;
-     */
-    value: BeginResponse;
-    case: "begin";
-  } | {
-    /**
-     * Result for an untyped query.
-     *
-     * @generated from field: sqlrpc.v1.QueryResult query_result = 2;
-     */
-    value: QueryResult;
-    case: "queryResult";
-  } | {
-    /**
-     * Partial response for a streaming untyped query.
-     *
-     * @generated from field: sqlrpc.v1.QueryResponse stream_result = 3;
+  database: string;
+
+  /**
+   * The single SQL statement to execute.
+   *
+   * @generated from field: string sql = 2;
+   */
+  sql: string;
+
+  /**
+   * Strictly-typed parameters of each execution, in order.
+   *
+   * @generated from field: repeated sqlrpc.v1.TypedParameters parameter_sets = 3;
+   */
+  parameterSets: TypedParameters[];
+
+  /**
+   * Execution time limit for the whole batch in milliseconds. Capped by the
+   * limits of the database and the caller's role; 0 uses the cap.
+   *
+   * @generated from field: int32 max_execution_time_ms = 4;
+   */
+  maxExecutionTimeMs: number;
+};
+
+/**
+ * Describes the message sqlrpc.v1.TypedBatchExecRequest.
+ * Use `create(TypedBatchExecRequestSchema)` to create a new message.
+ */
+export const TypedBatchExecRequestSchema: GenMessage<TypedBatchExecRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 27);
+
+/**
+ * *
+ * TypedTransactionQueryRequest executes a typed query within an active
+ * transaction.
+ *
+ * @generated from message sqlrpc.v1.TypedTransactionQueryRequest
+ */
+export type TypedTransactionQueryRequest = Message<"sqlrpc.v1.TypedTransactionQueryRequest"> & {
+  /**
+   * Absolute identifier for the active transaction.
+   *
+   * @generated from field: string transaction_id = 1;
+   */
+  transactionId: string;
+
+  /**
+   * SQL statement to execute.
+   *
+   * @generated from field: string sql = 2;
+   */
+  sql: string;
+
+  /**
+   * Strictly-typed parameters.
+   *
+   * @generated from field: sqlrpc.v1.TypedParameters parameters = 3;
+   */
+  parameters?: TypedParameters;
+};
+
+/**
+ * Describes the message sqlrpc.v1.TypedTransactionQueryRequest.
+ * Use `create(TypedTransactionQueryRequestSchema)` to create a new message.
+ */
+export const TypedTransactionQueryRequestSchema: GenMessage<TypedTransactionQueryRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 28);
+
+/**
+ * *
+ * TypedQueryResult contains strictly-typed results and telemetry.
+ *
+ * @generated from message sqlrpc.v1.TypedQueryResult
+ */
+export type TypedQueryResult = Message<"sqlrpc.v1.TypedQueryResult"> & {
+  /**
+   * @generated from field: repeated string columns = 1;
+   */
+  columns: string[];
+
+  /**
+   * Affinities determined by the SQLite engine for each column.
+   *
+   * @generated from field: repeated sqlrpc.v1.ColumnAffinity column_affinities = 2;
+   */
+  columnAffinities: ColumnAffinity[];
+
+  /**
+   * Declared types from the table schema for each column.
+   *
+   * @generated from field: repeated sqlrpc.v1.DeclaredType column_declared_types = 3;
+   */
+  columnDeclaredTypes: DeclaredType[];
+
+  /**
+   * Raw type strings returned by the underlying driver.
+   *
+   * @generated from field: repeated string column_raw_types = 4;
+   */
+  columnRawTypes: string[];
+
+  /**
+   * Ordered list of typed rows.
+   *
+   * @generated from field: repeated sqlrpc.v1.SqlRow rows = 5;
+   */
+  rows: SqlRow[];
+
+  /**
+   * Performance and execution telemetry.
+   *
+   * @generated from field: sqlrpc.v1.ExecutionStats stats = 6;
+   */
+  stats?: ExecutionStats;
+};
+
+/**
+ * Describes the message sqlrpc.v1.TypedQueryResult.
+ * Use `create(TypedQueryResultSchema)` to create a new message.
+ */
+export const TypedQueryResultSchema: GenMessage<TypedQueryResult> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 29);
+
+/**
+ * *
+ * QueryResponse defines a chunked result from a streaming query.
+ *
+ * @generated from message sqlrpc.v1.QueryResponse
+ */
+export type QueryResponse = Message<"sqlrpc.v1.QueryResponse"> & {
+  /**
+   * @generated from oneof sqlrpc.v1.QueryResponse.response
+   */
+  response: {
+    /**
+     * @generated from field: sqlrpc.v1.QueryResultHeader header = 1;
      */
-    value: QueryResponse;
-    case: "streamResult";
+    value: QueryResultHeader;
+    case: "header";
   } | {
     /**
-     * Result for a strictly-typed query.
-     *
-     * @generated from field: sqlrpc.v1.TypedQueryResult typed_query_result = 4;
+     * @generated from field: sqlrpc.v1.QueryResultRowBatch batch = 2;
      */
-    value: TypedQueryResult;
-    case: "typedQueryResult";
+    value: QueryResultRowBatch;
+    case: "batch";
   } | {
     /**
-     * Partial response for a streaming strictly-typed query.
-     *
-     * @generated from field: sqlrpc.v1.TypedQueryResponse typed_stream_result = 5;
+     * @generated from field: sqlrpc.v1.QueryComplete complete = 3;
      */
-    value: TypedQueryResponse;
-    case: "typedStreamResult";
+    value: QueryComplete;
+    case: "complete";
   } | {
     /**
-     * Savepoint operation confirmation.
-     *
-     * @generated from field: sqlrpc.v1.SavepointResponse savepoint = 7;
+     * @generated from field: sqlrpc.v1.ErrorResponse error = 5;
      */
-    value: SavepointResponse;
-    case: "savepoint";
-  } | {
+    value: ErrorResponse;
+    case: "error";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message sqlrpc.v1.QueryResponse.
+ * Use `create(QueryResponseSchema)` to create a new message.
+ */
+export const QueryResponseSchema: GenMessage<QueryResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 30);
+
+/**
+ * *
+ * TypedQueryResponse defines a chunked result from a typed streaming query.
+ *
+ * @generated from message sqlrpc.v1.TypedQueryResponse
+ */
+export type TypedQueryResponse = Message<"sqlrpc.v1.TypedQueryResponse"> & {
+  /**
+   * @generated from oneof sqlrpc.v1.TypedQueryResponse.response
+   */
+  response: {
     /**
-     * Commit operation confirmation.
+     * Initial response containing column metadata.
      *
-     * @generated from field: sqlrpc.v1.CommitResponse commit = 8;
+     * @generated from field: sqlrpc.v1.TypedQueryResultHeader header = 1;
      */
-    value: CommitResponse;
-    case: "commit";
+    value: TypedQueryResultHeader;
+    case: "header";
   } | {
     /**
-     * Rollback operation confirmation.
+     * Batch of typed result rows.
      *
-     * @generated from field: sqlrpc.v1.RollbackResponse rollback = 9;
+     * @generated from field: sqlrpc.v1.TypedQueryResultRowBatch batch = 2;
      */
-    value: RollbackResponse;
-    case: "rollback";
+    value: TypedQueryResultRowBatch;
+    case: "batch";
   } | {
     /**
-     * Result for a mutation operation.
+     * Final response indicating stream completion and stats.
      *
-     * @generated from field: sqlrpc.v1.ExecResponse exec_result = 11;
+     * @generated from field: sqlrpc.v1.QueryComplete complete = 3;
      */
-    value: ExecResponse;
-    case: "execResult";
+    value: QueryComplete;
+    case: "complete";
   } | {
     /**
-     * Encapsulated error message if a command fails.
+     * Error information if the query failed during streaming.
      *
-     * @generated from field: sqlrpc.v1.ErrorResponse error = 10;
+     * @generated from field: sqlrpc.v1.ErrorResponse error = 5;
      */
     value: ErrorResponse;
     case: "error";
@@ -1018,132 +1250,852 @@ export type TransactionResponse = Message<"sqlrpc.v1.TransactionResponse"> & {
 };
 
 /**
- * Describes the message sqlrpc.v1.TransactionResponse.
- * Use `create(TransactionResponseSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.TypedQueryResponse.
+ * Use `create(TypedQueryResponseSchema)` to create a new message.
  */
-export const TransactionResponseSchema: GenMessage<TransactionResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 25);
+export const TypedQueryResponseSchema: GenMessage<TypedQueryResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 31);
+
+/**
+ * @generated from message sqlrpc.v1.QueryResultHeader
+ */
+export type QueryResultHeader = Message<"sqlrpc.v1.QueryResultHeader"> & {
+  /**
+   * @generated from field: repeated string columns = 1;
+   */
+  columns: string[];
+
+  /**
+   * @generated from field: repeated sqlrpc.v1.ColumnAffinity column_affinities = 2;
+   */
+  columnAffinities: ColumnAffinity[];
+
+  /**
+   * @generated from field: repeated sqlrpc.v1.DeclaredType column_declared_types = 3;
+   */
+  columnDeclaredTypes: DeclaredType[];
+
+  /**
+   * @generated from field: repeated string column_raw_types = 4;
+   */
+  columnRawTypes: string[];
+};
+
+/**
+ * Describes the message sqlrpc.v1.QueryResultHeader.
+ * Use `create(QueryResultHeaderSchema)` to create a new message.
+ */
+export const QueryResultHeaderSchema: GenMessage<QueryResultHeader> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 32);
+
+/**
+ * *
+ * QueryResultRowBatch contains a collection of rows for an untyped query.
+ *
+ * @generated from message sqlrpc.v1.QueryResultRowBatch
+ */
+export type QueryResultRowBatch = Message<"sqlrpc.v1.QueryResultRowBatch"> & {
+  /**
+   * Collection of rows, where each row is a ListValue.
+   *
+   * @generated from field: repeated google.protobuf.ListValue rows = 1;
+   */
+  rows: ListValue[];
+
+  /**
+   * Token that resumes a resumable QueryStream after this batch.
+   *
+   * @generated from field: string resume_token = 2;
+   */
+  resumeToken: string;
+};
+
+/**
+ * Describes the message sqlrpc.v1.QueryResultRowBatch.
+ * Use `create(QueryResultRowBatchSchema)` to create a new message.
+ */
+export const QueryResultRowBatchSchema: GenMessage<QueryResultRowBatch> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 33);
+
+/**
+ * *
+ * TypedQueryResultHeader provides metadata for a typed streaming query.
+ *
+ * @generated from message sqlrpc.v1.TypedQueryResultHeader
+ */
+export type TypedQueryResultHeader = Message<"sqlrpc.v1.TypedQueryResultHeader"> & {
+  /**
+   * Ordered list of column names.
+   *
+   * @generated from field: repeated string columns = 1;
+   */
+  columns: string[];
+
+  /**
+   * Affinities determined by the SQLite engine for each column.
+   *
+   * @generated from field: repeated sqlrpc.v1.ColumnAffinity column_affinities = 2;
+   */
+  columnAffinities: ColumnAffinity[];
+
+  /**
+   * Declared types from the table schema for each column.
+   *
+   * @generated from field: repeated sqlrpc.v1.DeclaredType column_declared_types = 3;
+   */
+  columnDeclaredTypes: DeclaredType[];
+
+  /**
+   * Raw type strings returned by the underlying driver.
+   *
+   * @generated from field: repeated string column_raw_types = 4;
+   */
+  columnRawTypes: string[];
+};
+
+/**
+ * Describes the message sqlrpc.v1.TypedQueryResultHeader.
+ * Use `create(TypedQueryResultHeaderSchema)` to create a new message.
+ */
+export const TypedQueryResultHeaderSchema: GenMessage<TypedQueryResultHeader> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 34);
+
+/**
+ * *
+ * TypedQueryResultRowBatch contains a collection of rows for a typed query.
+ *
+ * @generated from message sqlrpc.v1.TypedQueryResultRowBatch
+ */
+export type TypedQueryResultRowBatch = Message<"sqlrpc.v1.TypedQueryResultRowBatch"> & {
+  /**
+   * Collection of strictly-typed rows.
+   *
+   * @generated from field: repeated sqlrpc.v1.SqlRow rows = 1;
+   */
+  rows: SqlRow[];
+};
+
+/**
+ * Describes the message sqlrpc.v1.TypedQueryResultRowBatch.
+ * Use `create(TypedQueryResultRowBatchSchema)` to create a new message.
+ */
+export const TypedQueryResultRowBatchSchema: GenMessage<TypedQueryResultRowBatch> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 35);
+
+/**
+ * *
+ * QueryComplete signals the successful end of a streaming operation.
+ *
+ * @generated from message sqlrpc.v1.QueryComplete
+ */
+export type QueryComplete = Message<"sqlrpc.v1.QueryComplete"> & {
+  /**
+   * Final execution telemetry.
+   *
+   * @generated from field: sqlrpc.v1.ExecutionStats stats = 1;
+   */
+  stats?: ExecutionStats;
+};
+
+/**
+ * Describes the message sqlrpc.v1.QueryComplete.
+ * Use `create(QueryCompleteSchema)` to create a new message.
+ */
+export const QueryCompleteSchema: GenMessage<QueryComplete> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 36);
+
+/**
+ * @generated from message sqlrpc.v1.ErrorResponse
+ */
+export type ErrorResponse = Message<"sqlrpc.v1.ErrorResponse"> & {
+  /**
+   * Human-readable error message.
+   *
+   * @generated from field: string message = 1;
+   */
+  message: string;
+
+  /**
+   * The SQL statement that failed execution.
+   *
+   * @generated from field: string failed_sql = 2;
+   */
+  failedSql: string;
+
+  /**
+   * Corresponding SQLite error code.
+   *
+   * @generated from field: sqlrpc.v1.SqliteCode sqlite_error_code = 3;
+   */
+  sqliteErrorCode: SqliteCode;
+
+  /**
+   * Index of the failing parameter set of a BatchExec.
+   *
+   * @generated from field: optional int32 batch_index = 4;
+   */
+  batchIndex?: number;
+};
+
+/**
+ * Describes the message sqlrpc.v1.ErrorResponse.
+ * Use `create(ErrorResponseSchema)` to create a new message.
+ */
+export const ErrorResponseSchema: GenMessage<ErrorResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 37);
+
+/**
+ * *
+ * TransactionRequest defines a command in a multiplexed transaction stream.
+ *
+ * @generated from message sqlrpc.v1.TransactionRequest
+ */
+export type TransactionRequest = Message<"sqlrpc.v1.TransactionRequest"> & {
+  /**
+   * @generated from oneof sqlrpc.v1.TransactionRequest.command
+   */
+  command: {
+    /**
+     * Start the transaction (must be first).
+     *
+     * @generated from field: sqlrpc.v1.BeginRequest begin = 1;
+     */
+    value: BeginRequest;
+    case: "begin";
+  } | {
+    /**
+     * Untyped query execution within the transaction.
+     *
+     * @generated from field: sqlrpc.v1.TransactionalQueryRequest query = 2;
+     */
+    value: TransactionalQueryRequest;
+    case: "query";
+  } | {
+    /**
+     * Untyped streaming query execution within the transaction.
+     *
+     * @generated from field: sqlrpc.v1.TransactionalQueryRequest query_stream = 3;
+     */
+    value: TransactionalQueryRequest;
+    case: "queryStream";
+  } | {
+    /**
+     * Strictly-typed query execution within the transaction.
+     *
+     * @generated from field: sqlrpc.v1.TypedTransactionalQueryRequest typed_query = 4;
+     */
+    value: TypedTransactionalQueryRequest;
+    case: "typedQuery";
+  } | {
+    /**
+     * Strictly-typed streaming query execution within the transaction.
+     *
+     * @generated from field: sqlrpc.v1.TypedTransactionalQueryRequest typed_query_stream = 5;
+     */
+    value: TypedTransactionalQueryRequest;
+    case: "typedQueryStream";
+  } | {
+    /**
+     * Unary mutation operation (DML).
+     *
+     * @generated from field: sqlrpc.v1.TransactionalQueryRequest exec = 6;
+     */
+    value: TransactionalQueryRequest;
+    case: "exec";
+  } | {
+    /**
+     * Strictly-typed mutation operation (DML).
+     *
+     * @generated from field: sqlrpc.v1.TypedTransactionalQueryRequest typed_exec = 7;
+     */
+    value: TypedTransactionalQueryRequest;
+    case: "typedExec";
+  } | {
+    /**
+     * Transactional control: create/manage savepoints.
+     *
+     * @generated from field: sqlrpc.v1.SavepointRequest savepoint = 8;
+     */
+    value: SavepointRequest;
+    case: "savepoint";
+  } | {
+    /**
+     * Transactional control: commit the transaction.
+     *
+     * @generated from field: google.protobuf.Empty commit = 9;
+     */
+    value: Empty;
+    case: "commit";
+  } | {
+    /**
+     * Transactional control: rollback the transaction.
+     *
+     * @generated from field: google.protobuf.Empty rollback = 10;
+     */
+    value: Empty;
+    case: "rollback";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message sqlrpc.v1.TransactionRequest.
+ * Use `create(TransactionRequestSchema)` to create a new message.
+ */
+export const TransactionRequestSchema: GenMessage<TransactionRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 38);
+
+/**
+ * *
+ * TransactionResponse defines a result in a multiplexed transaction stream.
+ *
+ * @generated from message sqlrpc.v1.TransactionResponse
+ */
+export type TransactionResponse = Message<"sqlrpc.v1.TransactionResponse"> & {
+  /**
+   * @generated from oneof sqlrpc.v1.TransactionResponse.response
+   */
+  response: {
+    /**
+     * Confirmation of transaction start.
+     *
+     * @generated from field: sqlrpc.v1.BeginResponse begin = 1;
+     */
+    value: BeginResponse;
+    case: "begin";
+  } | {
+    /**
+     * Result for an untyped query.
+     *
+     * @generated from field: sqlrpc.v1.QueryResult query_result = 2;
+     */
+    value: QueryResult;
+    case: "queryResult";
+  } | {
+    /**
+     * Partial response for a streaming untyped query.
+     *
+     * @generated from field: sqlrpc.v1.QueryResponse stream_result = 3;
+     */
+    value: QueryResponse;
+    case: "streamResult";
+  } | {
+    /**
+     * Result for a strictly-typed query.
+     *
+     * @generated from field: sqlrpc.v1.TypedQueryResult typed_query_result = 4;
+     */
+    value: TypedQueryResult;
+    case: "typedQueryResult";
+  } | {
+    /**
+     * Partial response for a streaming strictly-typed query.
+     *
+     * @generated from field: sqlrpc.v1.TypedQueryResponse typed_stream_result = 5;
+     */
+    value: TypedQueryResponse;
+    case: "typedStreamResult";
+  } | {
+    /**
+     * Savepoint operation confirmation.
+     *
+     * @generated from field: sqlrpc.v1.SavepointResponse savepoint = 7;
+     */
+    value: SavepointResponse;
+    case: "savepoint";
+  } | {
+    /**
+     * Commit operation confirmation.
+     *
+     * @generated from field: sqlrpc.v1.CommitResponse commit = 8;
+     */
+    value: CommitResponse;
+    case: "commit";
+  } | {
+    /**
+     * Rollback operation confirmation.
+     *
+     * @generated from field: sqlrpc.v1.RollbackResponse rollback = 9;
+     */
+    value: RollbackResponse;
+    case: "rollback";
+  } | {
+    /**
+     * Result for a mutation operation.
+     *
+     * @generated from field: sqlrpc.v1.ExecResponse exec_result = 11;
+     */
+    value: ExecResponse;
+    case: "execResult";
+  } | {
+    /**
+     * Encapsulated error message if a command fails.
+     *
+     * @generated from field: sqlrpc.v1.ErrorResponse error = 10;
+     */
+    value: ErrorResponse;
+    case: "error";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message sqlrpc.v1.TransactionResponse.
+ * Use `create(TransactionResponseSchema)` to create a new message.
+ */
+export const TransactionResponseSchema: GenMessage<TransactionResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 39);
+
+/**
+ * *
+ * ExplainResponse provides the breakdown of the query execution plan.
+ *
+ * @generated from message sqlrpc.v1.ExplainResponse
+ */
+export type ExplainResponse = Message<"sqlrpc.v1.ExplainResponse"> & {
+  /**
+   * @generated from field: repeated sqlrpc.v1.QueryPlanNode nodes = 1;
+   */
+  nodes: QueryPlanNode[];
+};
+
+/**
+ * Describes the message sqlrpc.v1.ExplainResponse.
+ * Use `create(ExplainResponseSchema)` to create a new message.
+ */
+export const ExplainResponseSchema: GenMessage<ExplainResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 40);
+
+/**
+ * *
+ * ListTablesRequest lists all tables in a tenant database.
+ *
+ * @generated from message sqlrpc.v1.ListTablesRequest
+ */
+export type ListTablesRequest = Message<"sqlrpc.v1.ListTablesRequest"> & {
+  /**
+   * Tenant target identifier for connection routing.
+   *
+   * @generated from field: string database = 1;
+   */
+  database: string;
+};
+
+/**
+ * Describes the message sqlrpc.v1.ListTablesRequest.
+ * Use `create(ListTablesRequestSchema)` to create a new message.
+ */
+export const ListTablesRequestSchema: GenMessage<ListTablesRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 41);
+
+/**
+ * *
+ * ListTablesResponse contains the names of discovered tables.
+ *
+ * @generated from message sqlrpc.v1.ListTablesResponse
+ */
+export type ListTablesResponse = Message<"sqlrpc.v1.ListTablesResponse"> & {
+  /**
+   * @generated from field: repeated string table_names = 1;
+   */
+  tableNames: string[];
+};
+
+/**
+ * Describes the message sqlrpc.v1.ListTablesResponse.
+ * Use `create(ListTablesResponseSchema)` to create a new message.
+ */
+export const ListTablesResponseSchema: GenMessage<ListTablesResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 42);
+
+/**
+ * *
+ * GetTableSchemaRequest retrieves structure for a specific table.
+ *
+ * @generated from message sqlrpc.v1.GetTableSchemaRequest
+ */
+export type GetTableSchemaRequest = Message<"sqlrpc.v1.GetTableSchemaRequest"> & {
+  /**
+   * Tenant target identifier for connection routing.
+   *
+   * @generated from field: string database = 1;
+   */
+  database: string;
+
+  /**
+   * Target table name to inspect.
+   *
+   * @generated from field: string table_name = 2;
+   */
+  tableName: string;
+};
+
+/**
+ * Describes the message sqlrpc.v1.GetTableSchemaRequest.
+ * Use `create(GetTableSchemaRequestSchema)` to create a new message.
+ */
+export const GetTableSchemaRequestSchema: GenMessage<GetTableSchemaRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 43);
+
+/**
+ * *
+ * GetDatabaseSchemaRequest retrieves structure for the entire database.
+ *
+ * @generated from message sqlrpc.v1.GetDatabaseSchemaRequest
+ */
+export type GetDatabaseSchemaRequest = Message<"sqlrpc.v1.GetDatabaseSchemaRequest"> & {
+  /**
+   * Tenant target identifier for connection routing.
+   *
+   * @generated from field: string database = 1;
+   */
+  database: string;
+};
+
+/**
+ * Describes the message sqlrpc.v1.GetDatabaseSchemaRequest.
+ * Use `create(GetDatabaseSchemaRequestSchema)` to create a new message.
+ */
+export const GetDatabaseSchemaRequestSchema: GenMessage<GetDatabaseSchemaRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 44);
+
+/**
+ * *
+ * VacuumRequest rebuilt the database to reclaim space.
+ *
+ * @generated from message sqlrpc.v1.VacuumRequest
+ */
+export type VacuumRequest = Message<"sqlrpc.v1.VacuumRequest"> & {
+  /**
+   * Tenant target identifier for connection routing.
+   *
+   * @generated from field: string database = 1;
+   */
+  database: string;
+
+  /**
+   * Optional target file to vacuum into.
+   *
+   * @generated from field: optional string into_file = 2;
+   */
+  intoFile?: string;
+};
+
+/**
+ * Describes the message sqlrpc.v1.VacuumRequest.
+ * Use `create(VacuumRequestSchema)` to create a new message.
+ */
+export const VacuumRequestSchema: GenMessage<VacuumRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 45);
+
+/**
+ * *
+ * VacuumResponse confirms the database rebuild completion.
+ *
+ * @generated from message sqlrpc.v1.VacuumResponse
+ */
+export type VacuumResponse = Message<"sqlrpc.v1.VacuumResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message sqlrpc.v1.VacuumResponse.
+ * Use `create(VacuumResponseSchema)` to create a new message.
+ */
+export const VacuumResponseSchema: GenMessage<VacuumResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 46);
+
+/**
+ * *
+ * CheckpointRequest synchronizes WAL with the main database file.
+ *
+ * @generated from message sqlrpc.v1.CheckpointRequest
+ */
+export type CheckpointRequest = Message<"sqlrpc.v1.CheckpointRequest"> & {
+  /**
+   * Tenant target identifier for connection routing.
+   *
+   * @generated from field: string database = 1;
+   */
+  database: string;
+
+  /**
+   * Synchronize strategy.
+   *
+   * @generated from field: sqlrpc.v1.CheckpointMode mode = 2;
+   */
+  mode: CheckpointMode;
+};
+
+/**
+ * Describes the message sqlrpc.v1.CheckpointRequest.
+ * Use `create(CheckpointRequestSchema)` to create a new message.
+ */
+export const CheckpointRequestSchema: GenMessage<CheckpointRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 47);
+
+/**
+ * *
+ * CheckpointResponse provides statistics about the WAL synchronization.
+ *
+ * @generated from message sqlrpc.v1.CheckpointResponse
+ */
+export type CheckpointResponse = Message<"sqlrpc.v1.CheckpointResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+
+  /**
+   * @generated from field: int64 busy_checkpoints = 3;
+   */
+  busyCheckpoints: bigint;
+
+  /**
+   * @generated from field: int64 log_checkpoints = 4;
+   */
+  logCheckpoints: bigint;
+
+  /**
+   * @generated from field: int64 checkpointed_pages = 5;
+   */
+  checkpointedPages: bigint;
+};
+
+/**
+ * Describes the message sqlrpc.v1.CheckpointResponse.
+ * Use `create(CheckpointResponseSchema)` to create a new message.
+ */
+export const CheckpointResponseSchema: GenMessage<CheckpointResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 48);
+
+/**
+ * *
+ * IntegrityCheckRequest searches for physical corruption in the database.
+ *
+ * @generated from message sqlrpc.v1.IntegrityCheckRequest
+ */
+export type IntegrityCheckRequest = Message<"sqlrpc.v1.IntegrityCheckRequest"> & {
+  /**
+   * Tenant target identifier for connection routing.
+   *
+   * @generated from field: string database = 1;
+   */
+  database: string;
+
+  /**
+   * Limits the number of error strings returned.
+   *
+   * @generated from field: optional int32 max_errors = 2;
+   */
+  maxErrors?: number;
+};
+
+/**
+ * Describes the message sqlrpc.v1.IntegrityCheckRequest.
+ * Use `create(IntegrityCheckRequestSchema)` to create a new message.
+ */
+export const IntegrityCheckRequestSchema: GenMessage<IntegrityCheckRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 49);
+
+/**
+ * *
+ * IntegrityCheckResponse identifies any corruption found.
+ *
+ * @generated from message sqlrpc.v1.IntegrityCheckResponse
+ */
+export type IntegrityCheckResponse = Message<"sqlrpc.v1.IntegrityCheckResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+
+  /**
+   * List of physical or logical structural errors found.
+   *
+   * @generated from field: repeated string errors = 3;
+   */
+  errors: string[];
+};
+
+/**
+ * Describes the message sqlrpc.v1.IntegrityCheckResponse.
+ * Use `create(IntegrityCheckResponseSchema)` to create a new message.
+ */
+export const IntegrityCheckResponseSchema: GenMessage<IntegrityCheckResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 50);
+
+/**
+ * *
+ * BackupInfo describes a stored backup.
+ *
+ * @generated from message sqlrpc.v1.BackupInfo
+ */
+export type BackupInfo = Message<"sqlrpc.v1.BackupInfo"> & {
+  /**
+   * Backup identifier, unique per database and ordered by creation time.
+   *
+   * @generated from field: string backup_id = 1;
+   */
+  backupId: string;
+
+  /**
+   * Database the backup was taken from.
+   *
+   * @generated from field: string database = 2;
+   */
+  database: string;
+
+  /**
+   * Time the snapshot was taken.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 3;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * Size of the stored file in bytes (compressed size if compressed).
+   *
+   * @generated from field: int64 size_bytes = 4;
+   */
+  sizeBytes: bigint;
 
-/**
- * *
- * ExplainResponse provides the breakdown of the query execution plan.
- *
- * @generated from message sqlrpc.v1.ExplainResponse
- */
-export type ExplainResponse = Message<"sqlrpc.v1.ExplainResponse"> & {
   /**
-   * @generated from field: repeated sqlrpc.v1.QueryPlanNode nodes = 1;
+   * Compression applied to the stored file.
+   *
+   * @generated from field: sqlrpc.v1.BackupCompression compression = 5;
    */
-  nodes: QueryPlanNode[];
+  compression: BackupCompression;
+
+  /**
+   * Hex-encoded SHA-256 of the stored file.
+   *
+   * @generated from field: string sha256 = 6;
+   */
+  sha256: string;
 };
 
 /**
- * Describes the message sqlrpc.v1.ExplainResponse.
- * Use `create(ExplainResponseSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.BackupInfo.
+ * Use `create(BackupInfoSchema)` to create a new message.
  */
-export const ExplainResponseSchema: GenMessage<ExplainResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 26);
+export const BackupInfoSchema: GenMessage<BackupInfo> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 51);
 
 /**
  * *
- * ListTablesRequest lists all tables in a tenant database.
+ * BackupDatabaseRequest takes a new backup.
  *
- * @generated from message sqlrpc.v1.ListTablesRequest
+ * @generated from message sqlrpc.v1.BackupDatabaseRequest
  */
-export type ListTablesRequest = Message<"sqlrpc.v1.ListTablesRequest"> & {
+export type BackupDatabaseRequest = Message<"sqlrpc.v1.BackupDatabaseRequest"> & {
   /**
    * Tenant target identifier for connection routing.
    *
    * @generated from field: string database = 1;
    */
   database: string;
+
+  /**
+   * Compression to apply to the stored file.
+   *
+   * @generated from field: sqlrpc.v1.BackupCompression compression = 2;
+   */
+  compression: BackupCompression;
 };
 
 /**
- * Describes the message sqlrpc.v1.ListTablesRequest.
- * Use `create(ListTablesRequestSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.BackupDatabaseRequest.
+ * Use `create(BackupDatabaseRequestSchema)` to create a new message.
  */
-export const ListTablesRequestSchema: GenMessage<ListTablesRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 27);
+export const BackupDatabaseRequestSchema: GenMessage<BackupDatabaseRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 52);
 
 /**
  * *
- * ListTablesResponse contains the names of discovered tables.
+ * BackupDatabaseResponse returns the stored backup.
  *
- * @generated from message sqlrpc.v1.ListTablesResponse
+ * @generated from message sqlrpc.v1.BackupDatabaseResponse
  */
-export type ListTablesResponse = Message<"sqlrpc.v1.ListTablesResponse"> & {
+export type BackupDatabaseResponse = Message<"sqlrpc.v1.BackupDatabaseResponse"> & {
   /**
-   * @generated from field: repeated string table_names = 1;
+   * @generated from field: sqlrpc.v1.BackupInfo backup = 1;
    */
-  tableNames: string[];
+  backup?: BackupInfo;
 };
 
 /**
- * Describes the message sqlrpc.v1.ListTablesResponse.
- * Use `create(ListTablesResponseSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.BackupDatabaseResponse.
+ * Use `create(BackupDatabaseResponseSchema)` to create a new message.
  */
-export const ListTablesResponseSchema: GenMessage<ListTablesResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 28);
+export const BackupDatabaseResponseSchema: GenMessage<BackupDatabaseResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 53);
 
 /**
  * *
- * GetTableSchemaRequest retrieves structure for a specific table.
+ * ListBackupsRequest lists the backups of a database.
  *
- * @generated from message sqlrpc.v1.GetTableSchemaRequest
+ * @generated from message sqlrpc.v1.ListBackupsRequest
  */
-export type GetTableSchemaRequest = Message<"sqlrpc.v1.GetTableSchemaRequest"> & {
+export type ListBackupsRequest = Message<"sqlrpc.v1.ListBackupsRequest"> & {
   /**
    * Tenant target identifier for connection routing.
    *
    * @generated from field: string database = 1;
    */
   database: string;
-
-  /**
-   * Target table name to inspect.
-   *
-   * @generated from field: string table_name = 2;
-   */
-  tableName: string;
 };
 
 /**
- * Describes the message sqlrpc.v1.GetTableSchemaRequest.
- * Use `create(GetTableSchemaRequestSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.ListBackupsRequest.
+ * Use `create(ListBackupsRequestSchema)` to create a new message.
  */
-export const GetTableSchemaRequestSchema: GenMessage<GetTableSchemaRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 29);
+export const ListBackupsRequestSchema: GenMessage<ListBackupsRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 54);
 
 /**
  * *
- * GetDatabaseSchemaRequest retrieves structure for the entire database.
+ * ListBackupsResponse holds the stored backups, newest first.
  *
- * @generated from message sqlrpc.v1.GetDatabaseSchemaRequest
+ * @generated from message sqlrpc.v1.ListBackupsResponse
  */
-export type GetDatabaseSchemaRequest = Message<"sqlrpc.v1.GetDatabaseSchemaRequest"> & {
+export type ListBackupsResponse = Message<"sqlrpc.v1.ListBackupsResponse"> & {
   /**
-   * Tenant target identifier for connection routing.
-   *
-   * @generated from field: string database = 1;
+   * @generated from field: repeated sqlrpc.v1.BackupInfo backups = 1;
    */
-  database: string;
+  backups: BackupInfo[];
 };
 
 /**
- * Describes the message sqlrpc.v1.GetDatabaseSchemaRequest.
- * Use `create(GetDatabaseSchemaRequestSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.ListBackupsResponse.
+ * Use `create(ListBackupsResponseSchema)` to create a new message.
  */
-export const GetDatabaseSchemaRequestSchema: GenMessage<GetDatabaseSchemaRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 30);
+export const ListBackupsResponseSchema: GenMessage<ListBackupsResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 55);
 
 /**
  * *
- * VacuumRequest rebuilt the database to reclaim space.
+ * RestoreDatabaseRequest replaces a database with a stored backup.
  *
- * @generated from message sqlrpc.v1.VacuumRequest
+ * @generated from message sqlrpc.v1.RestoreDatabaseRequest
  */
-export type VacuumRequest = Message<"sqlrpc.v1.VacuumRequest"> & {
+export type RestoreDatabaseRequest = Message<"sqlrpc.v1.RestoreDatabaseRequest"> & {
   /**
    * Tenant target identifier for connection routing.
    *
@@ -1152,27 +2104,27 @@ export type VacuumRequest = Message<"sqlrpc.v1.VacuumRequest"> & {
   database: string;
 
   /**
-   * Optional target file to vacuum into.
+   * Backup to restore, as returned by BackupDatabase or ListBackups.
    *
-   * @generated from field: optional string into_file = 2;
+   * @generated from field: string backup_id = 2;
    */
-  intoFile?: string;
+  backupId: string;
 };
 
 /**
- * Describes the message sqlrpc.v1.VacuumRequest.
- * Use `create(VacuumRequestSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.RestoreDatabaseRequest.
+ * Use `create(RestoreDatabaseRequestSchema)` to create a new message.
  */
-export const VacuumRequestSchema: GenMessage<VacuumRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 31);
+export const RestoreDatabaseRequestSchema: GenMessage<RestoreDatabaseRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 56);
 
 /**
  * *
- * VacuumResponse confirms the database rebuild completion.
+ * RestoreDatabaseResponse confirms the restore.
  *
- * @generated from message sqlrpc.v1.VacuumResponse
+ * @generated from message sqlrpc.v1.RestoreDatabaseResponse
  */
-export type VacuumResponse = Message<"sqlrpc.v1.VacuumResponse"> & {
+export type RestoreDatabaseResponse = Message<"sqlrpc.v1.RestoreDatabaseResponse"> & {
   /**
    * @generated from field: bool success = 1;
    */
@@ -1182,22 +2134,29 @@ export type VacuumResponse = Message<"sqlrpc.v1.VacuumResponse"> & {
    * @generated from field: string message = 2;
    */
   message: string;
+
+  /**
+   * The backup that was restored.
+   *
+   * @generated from field: sqlrpc.v1.BackupInfo backup = 3;
+   */
+  backup?: BackupInfo;
 };
 
 /**
- * Describes the message sqlrpc.v1.VacuumResponse.
- * Use `create(VacuumResponseSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.RestoreDatabaseResponse.
+ * Use `create(RestoreDatabaseResponseSchema)` to create a new message.
  */
-export const VacuumResponseSchema: GenMessage<VacuumResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 32);
+export const RestoreDatabaseResponseSchema: GenMessage<RestoreDatabaseResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 57);
 
 /**
  * *
- * CheckpointRequest synchronizes WAL with the main database file.
+ * DownloadBackupRequest selects the backup to download.
  *
- * @generated from message sqlrpc.v1.CheckpointRequest
+ * @generated from message sqlrpc.v1.DownloadBackupRequest
  */
-export type CheckpointRequest = Message<"sqlrpc.v1.CheckpointRequest"> & {
+export type DownloadBackupRequest = Message<"sqlrpc.v1.DownloadBackupRequest"> & {
   /**
    * Tenant target identifier for connection routing.
    *
@@ -1206,67 +2165,57 @@ export type CheckpointRequest = Message<"sqlrpc.v1.CheckpointRequest"> & {
   database: string;
 
   /**
-   * Synchronize strategy.
+   * Stored backup to download. If empty, a fresh uncompressed snapshot is
+   * taken and streamed without being stored.
    *
-   * @generated from field: sqlrpc.v1.CheckpointMode mode = 2;
+   * @generated from field: string backup_id = 2;
    */
-  mode: CheckpointMode;
+  backupId: string;
 };
 
 /**
- * Describes the message sqlrpc.v1.CheckpointRequest.
- * Use `create(CheckpointRequestSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.DownloadBackupRequest.
+ * Use `create(DownloadBackupRequestSchema)` to create a new message.
  */
-export const CheckpointRequestSchema: GenMessage<CheckpointRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 33);
+export const DownloadBackupRequestSchema: GenMessage<DownloadBackupRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 58);
 
 /**
  * *
- * CheckpointResponse provides statistics about the WAL synchronization.
+ * DownloadBackupResponse carries one chunk of the backup file.
  *
- * @generated from message sqlrpc.v1.CheckpointResponse
+ * @generated from message sqlrpc.v1.DownloadBackupResponse
  */
-export type CheckpointResponse = Message<"sqlrpc.v1.CheckpointResponse"> & {
-  /**
-   * @generated from field: bool success = 1;
-   */
-  success: boolean;
-
-  /**
-   * @generated from field: string message = 2;
-   */
-  message: string;
-
-  /**
-   * @generated from field: int64 busy_checkpoints = 3;
-   */
-  busyCheckpoints: bigint;
-
+export type DownloadBackupResponse = Message<"sqlrpc.v1.DownloadBackupResponse"> & {
   /**
-   * @generated from field: int64 log_checkpoints = 4;
+   * Backup metadata, set on the first message only.
+   *
+   * @generated from field: sqlrpc.v1.BackupInfo backup = 1;
    */
-  logCheckpoints: bigint;
+  backup?: BackupInfo;
 
   /**
-   * @generated from field: int64 checkpointed_pages = 5;
+   * Next chunk of the file.
+   *
+   * @generated from field: bytes chunk = 2;
    */
-  checkpointedPages: bigint;
+  chunk: Uint8Array;
 };
 
 /**
- * Describes the message sqlrpc.v1.CheckpointResponse.
- * Use `create(CheckpointResponseSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.DownloadBackupResponse.
+ * Use `create(DownloadBackupResponseSchema)` to create a new message.
  */
-export const CheckpointResponseSchema: GenMessage<CheckpointResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 34);
+export const DownloadBackupResponseSchema: GenMessage<DownloadBackupResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 59);
 
 /**
  * *
- * IntegrityCheckRequest searches for physical corruption in the database.
+ * DumpDatabaseRequest selects what DumpDatabase writes.
  *
- * @generated from message sqlrpc.v1.IntegrityCheckRequest
+ * @generated from message sqlrpc.v1.DumpDatabaseRequest
  */
-export type IntegrityCheckRequest = Message<"sqlrpc.v1.IntegrityCheckRequest"> & {
+export type DumpDatabaseRequest = Message<"sqlrpc.v1.DumpDatabaseRequest"> & {
   /**
    * Tenant target identifier for connection routing.
    *
@@ -1275,51 +2224,49 @@ export type IntegrityCheckRequest = Message<"sqlrpc.v1.IntegrityCheckRequest"> &
   database: string;
 
   /**
-   * Limits the number of error strings returned.
+   * Tables to dump, with their indexes and triggers. If empty, the whole
+   * schema is dumped, views included.
    *
-   * @generated from field: optional int32 max_errors = 2;
+   * @generated from field: repeated string tables = 2;
    */
-  maxErrors?: number;
+  tables: string[];
+
+  /**
+   * Writes the CREATE statements only.
+   *
+   * @generated from field: bool schema_only = 3;
+   */
+  schemaOnly: boolean;
 };
 
 /**
- * Describes the message sqlrpc.v1.IntegrityCheckRequest.
- * Use `create(IntegrityCheckRequestSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.DumpDatabaseRequest.
+ * Use `create(DumpDatabaseRequestSchema)` to create a new message.
  */
-export const IntegrityCheckRequestSchema: GenMessage<IntegrityCheckRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 35);
+export const DumpDatabaseRequestSchema: GenMessage<DumpDatabaseRequest> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 60);
 
 /**
  * *
- * IntegrityCheckResponse identifies any corruption found.
+ * DumpDatabaseResponse carries the next statements of the script.
  *
- * @generated from message sqlrpc.v1.IntegrityCheckResponse
+ * @generated from message sqlrpc.v1.DumpDatabaseResponse
  */
-export type IntegrityCheckResponse = Message<"sqlrpc.v1.IntegrityCheckResponse"> & {
-  /**
-   * @generated from field: bool success = 1;
-   */
-  success: boolean;
-
-  /**
-   * @generated from field: string message = 2;
-   */
-  message: string;
-
+export type DumpDatabaseResponse = Message<"sqlrpc.v1.DumpDatabaseResponse"> & {
   /**
-   * List of physical or logical structural errors found.
+   * One or more complete statements, each ending in ";\n".
    *
-   * @generated from field: repeated string errors = 3;
+   * @generated from field: string sql = 1;
    */
-  errors: string[];
+  sql: string;
 };
 
 /**
- * Describes the message sqlrpc.v1.IntegrityCheckResponse.
- * Use `create(IntegrityCheckResponseSchema)` to create a new message.
+ * Describes the message sqlrpc.v1.DumpDatabaseResponse.
+ * Use `create(DumpDatabaseResponseSchema)` to create a new message.
  */
-export const IntegrityCheckResponseSchema: GenMessage<IntegrityCheckResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 36);
+export const DumpDatabaseResponseSchema: GenMessage<DumpDatabaseResponse> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_db_service, 61);
 
 /**
  * *
@@ -1348,7 +2295,7 @@ export type AttachDatabaseRequest = Message<"sqlrpc.v1.AttachDatabaseRequest"> &
  * Use `create(AttachDatabaseRequestSchema)` to create a new message.
  */
 export const AttachDatabaseRequestSchema: GenMessage<AttachDatabaseRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 37);
+  messageDesc(file_sqlrpc_v1_db_service, 62);
 
 /**
  * *
@@ -1373,7 +2320,7 @@ export type AttachDatabaseResponse = Message<"sqlrpc.v1.AttachDatabaseResponse">
  * Use `create(AttachDatabaseResponseSchema)` to create a new message.
  */
 export const AttachDatabaseResponseSchema: GenMessage<AttachDatabaseResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 38);
+  messageDesc(file_sqlrpc_v1_db_service, 63);
 
 /**
  * *
@@ -1402,7 +2349,7 @@ export type DetachDatabaseRequest = Message<"sqlrpc.v1.DetachDatabaseRequest"> &
  * Use `create(DetachDatabaseRequestSchema)` to create a new message.
  */
 export const DetachDatabaseRequestSchema: GenMessage<DetachDatabaseRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 39);
+  messageDesc(file_sqlrpc_v1_db_service, 64);
 
 /**
  * *
@@ -1427,7 +2374,7 @@ export type DetachDatabaseResponse = Message<"sqlrpc.v1.DetachDatabaseResponse">
  * Use `create(DetachDatabaseResponseSchema)` to create a new message.
  */
 export const DetachDatabaseResponseSchema: GenMessage<DetachDatabaseResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 40);
+  messageDesc(file_sqlrpc_v1_db_service, 65);
 
 /**
  * *
@@ -1456,7 +2403,7 @@ export type BeginRequest = Message<"sqlrpc.v1.BeginRequest"> & {
  * Use `create(BeginRequestSchema)` to create a new message.
  */
 export const BeginRequestSchema: GenMessage<BeginRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 41);
+  messageDesc(file_sqlrpc_v1_db_service, 66);
 
 /**
  * *
@@ -1485,7 +2432,7 @@ export type TransactionalQueryRequest = Message<"sqlrpc.v1.TransactionalQueryReq
  * Use `create(TransactionalQueryRequestSchema)` to create a new message.
  */
 export const TransactionalQueryRequestSchema: GenMessage<TransactionalQueryRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 42);
+  messageDesc(file_sqlrpc_v1_db_service, 67);
 
 /**
  * *
@@ -1514,7 +2461,7 @@ export type SavepointRequest = Message<"sqlrpc.v1.SavepointRequest"> & {
  * Use `create(SavepointRequestSchema)` to create a new message.
  */
 export const SavepointRequestSchema: GenMessage<SavepointRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 43);
+  messageDesc(file_sqlrpc_v1_db_service, 68);
 
 /**
  * *
@@ -1543,7 +2490,7 @@ export type BeginResponse = Message<"sqlrpc.v1.BeginResponse"> & {
  * Use `create(BeginResponseSchema)` to create a new message.
  */
 export const BeginResponseSchema: GenMessage<BeginResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 44);
+  messageDesc(file_sqlrpc_v1_db_service, 69);
 
 /**
  * *
@@ -1565,7 +2512,7 @@ export type CommitResponse = Message<"sqlrpc.v1.CommitResponse"> & {
  * Use `create(CommitResponseSchema)` to create a new message.
  */
 export const CommitResponseSchema: GenMessage<CommitResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 45);
+  messageDesc(file_sqlrpc_v1_db_service, 70);
 
 /**
  * *
@@ -1587,7 +2534,7 @@ export type RollbackResponse = Message<"sqlrpc.v1.RollbackResponse"> & {
  * Use `create(RollbackResponseSchema)` to create a new message.
  */
 export const RollbackResponseSchema: GenMessage<RollbackResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 46);
+  messageDesc(file_sqlrpc_v1_db_service, 71);
 
 /**
  * *
@@ -1617,7 +2564,7 @@ export type TypedTransactionalQueryRequest = Message<"sqlrpc.v1.TypedTransaction
  * Use `create(TypedTransactionalQueryRequestSchema)` to create a new message.
  */
 export const TypedTransactionalQueryRequestSchema: GenMessage<TypedTransactionalQueryRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 47);
+  messageDesc(file_sqlrpc_v1_db_service, 72);
 
 /**
  * *
@@ -1639,7 +2586,7 @@ export type ListExtensionsRequest = Message<"sqlrpc.v1.ListExtensionsRequest"> &
  * Use `create(ListExtensionsRequestSchema)` to create a new message.
  */
 export const ListExtensionsRequestSchema: GenMessage<ListExtensionsRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 48);
+  messageDesc(file_sqlrpc_v1_db_service, 73);
 
 /**
  * *
@@ -1661,7 +2608,7 @@ export type ListExtensionsResponse = Message<"sqlrpc.v1.ListExtensionsResponse">
  * Use `create(ListExtensionsResponseSchema)` to create a new message.
  */
 export const ListExtensionsResponseSchema: GenMessage<ListExtensionsResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 49);
+  messageDesc(file_sqlrpc_v1_db_service, 74);
 
 /**
  * *
@@ -1690,7 +2637,7 @@ export type LoadExtensionRequest = Message<"sqlrpc.v1.LoadExtensionRequest"> & {
  * Use `create(LoadExtensionRequestSchema)` to create a new message.
  */
 export const LoadExtensionRequestSchema: GenMessage<LoadExtensionRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 50);
+  messageDesc(file_sqlrpc_v1_db_service, 75);
 
 /**
  * *
@@ -1715,7 +2662,7 @@ export type LoadExtensionResponse = Message<"sqlrpc.v1.LoadExtensionResponse"> &
  * Use `create(LoadExtensionResponseSchema)` to create a new message.
  */
 export const LoadExtensionResponseSchema: GenMessage<LoadExtensionResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 51);
+  messageDesc(file_sqlrpc_v1_db_service, 76);
 
 /**
  * *
@@ -1740,7 +2687,7 @@ export type PublishItem = Message<"sqlrpc.v1.PublishItem"> & {
  * Use `create(PublishItemSchema)` to create a new message.
  */
 export const PublishItemSchema: GenMessage<PublishItem> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 52);
+  messageDesc(file_sqlrpc_v1_db_service, 77);
 
 /**
  * *
@@ -1770,7 +2717,7 @@ export type PublishRequest = Message<"sqlrpc.v1.PublishRequest"> & {
  * Use `create(PublishRequestSchema)` to create a new message.
  */
 export const PublishRequestSchema: GenMessage<PublishRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 53);
+  messageDesc(file_sqlrpc_v1_db_service, 78);
 
 /**
  * *
@@ -1795,7 +2742,7 @@ export type PublishBatchRequest = Message<"sqlrpc.v1.PublishBatchRequest"> & {
  * Use `create(PublishBatchRequestSchema)` to create a new message.
  */
 export const PublishBatchRequestSchema: GenMessage<PublishBatchRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 54);
+  messageDesc(file_sqlrpc_v1_db_service, 79);
 
 /**
  * *
@@ -1815,7 +2762,7 @@ export type PublishResponse = Message<"sqlrpc.v1.PublishResponse"> & {
  * Use `create(PublishResponseSchema)` to create a new message.
  */
 export const PublishResponseSchema: GenMessage<PublishResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 55);
+  messageDesc(file_sqlrpc_v1_db_service, 80);
 
 /**
  * *
@@ -1835,7 +2782,7 @@ export type PublishBatchResponse = Message<"sqlrpc.v1.PublishBatchResponse"> & {
  * Use `create(PublishBatchResponseSchema)` to create a new message.
  */
 export const PublishBatchResponseSchema: GenMessage<PublishBatchResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 56);
+  messageDesc(file_sqlrpc_v1_db_service, 81);
 
 /**
  * *
@@ -1865,7 +2812,7 @@ export type SubscribeRequest = Message<"sqlrpc.v1.SubscribeRequest"> & {
  * Use `create(SubscribeRequestSchema)` to create a new message.
  */
 export const SubscribeRequestSchema: GenMessage<SubscribeRequest> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 57);
+  messageDesc(file_sqlrpc_v1_db_service, 82);
 
 /**
  * *
@@ -1898,6 +2845,14 @@ export type SubscribeResponse = Message<"sqlrpc.v1.SubscribeResponse"> & {
    * @generated from field: google.protobuf.Timestamp created_at = 5;
    */
   createdAt?: Timestamp;
+
+  /**
+   * W3C traceparent of the server's delivery span, continuing the publisher's
+   * trace. Empty when the message was published without trace context.
+   *
+   * @generated from field: string traceparent = 6;
+   */
+  traceparent: string;
 };
 
 /**
@@ -1905,7 +2860,7 @@ export type SubscribeResponse = Message<"sqlrpc.v1.SubscribeResponse"> & {
  * Use `create(SubscribeResponseSchema)` to create a new message.
  */
 export const SubscribeResponseSchema: GenMessage<SubscribeResponse> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_db_service, 58);
+  messageDesc(file_sqlrpc_v1_db_service, 83);
 
 /**
  * *
@@ -2100,6 +3055,45 @@ export const DatabaseService: GenService<{
     input: typeof TransactionControlRequestSchema;
     output: typeof TransactionControlResponseSchema;
   },
+  /**
+   * *
+   * Cursor Open.
+   * Runs a read-only query in a read transaction on the Read-Only pool and
+   * keeps its result open, pinned to that snapshot, for FetchCursor. Idle
+   * cursors are closed like ID-based transactions.
+   *
+   * @generated from rpc sqlrpc.v1.DatabaseService.OpenCursor
+   */
+  openCursor: {
+    methodKind: "unary";
+    input: typeof OpenCursorRequestSchema;
+    output: typeof OpenCursorResponseSchema;
+  },
+  /**
+   * *
+   * Cursor Fetch.
+   * Returns the next rows of a cursor and extends its idle timeout. The cursor
+   * is closed once its last row has been fetched.
+   *
+   * @generated from rpc sqlrpc.v1.DatabaseService.FetchCursor
+   */
+  fetchCursor: {
+    methodKind: "unary";
+    input: typeof FetchCursorRequestSchema;
+    output: typeof FetchCursorResponseSchema;
+  },
+  /**
+   * *
+   * Cursor Close.
+   * Closes a cursor, ending its read transaction.
+   *
+   * @generated from rpc sqlrpc.v1.DatabaseService.CloseCursor
+   */
+  closeCursor: {
+    methodKind: "unary";
+    input: typeof CloseCursorRequestSchema;
+    output: typeof CloseCursorResponseSchema;
+  },
   /**
    * *
    * Atomic Script Execution.
@@ -2114,6 +3108,36 @@ export const DatabaseService: GenService<{
     input: typeof ExecuteTransactionRequestSchema;
     output: typeof ExecuteTransactionResponseSchema;
   },
+  /**
+   * *
+   * Bulk Write Endpoint.
+   * Prepares one statement and executes it once per parameter set inside a
+   * single implicit transaction. Either every set is applied or, when one
+   * fails, none is and the error reports the failing set's index.
+   *
+   * @generated from rpc sqlrpc.v1.DatabaseService.BatchExec
+   */
+  batchExec: {
+    methodKind: "unary";
+    input: typeof BatchExecRequestSchema;
+    output: typeof BatchExecResponseSchema;
+  },
+  /**
+   * *
+   * Bulk Import (Client Stream).
+   * The first message names the target table and the format; the following
+   * ones carry CSV or NDJSON chunks, or SqlRow batches. Records are inserted
+   * through one prepared statement and committed every batch_size records.
+   * Records that cannot be parsed or inserted are rejected and counted without
+   * stopping the import.
+   *
+   * @generated from rpc sqlrpc.v1.DatabaseService.Import
+   */
+  import: {
+    methodKind: "client_streaming";
+    input: typeof ImportRequestSchema;
+    output: typeof ImportResponseSchema;
+  },
   /**
    * *
    * Typed Read Endpoint (Unary).
@@ -2140,6 +3164,18 @@ export const DatabaseService: GenService<{
     input: typeof TypedQueryRequestSchema;
     output: typeof ExecResponseSchema;
   },
+  /**
+   * *
+   * Typed Bulk Write Endpoint.
+   * BatchExec with strictly-typed parameter sets.
+   *
+   * @generated from rpc sqlrpc.v1.DatabaseService.TypedBatchExec
+   */
+  typedBatchExec: {
+    methodKind: "unary";
+    input: typeof TypedBatchExecRequestSchema;
+    output: typeof BatchExecResponseSchema;
+  },
   /**
    * *
    * Typed Read Endpoint (Streaming).
@@ -2314,6 +3350,72 @@ export const DatabaseService: GenService<{
     input: typeof DetachDatabaseRequestSchema;
     output: typeof DetachDatabaseResponseSchema;
   },
+  /**
+   * *
+   * Backup: Create.
+   * Takes a consistent snapshot with the SQLite online backup API and stores
+   * it in the server's backups directory, optionally gzip-compressed, along
+   * with a SHA-256 checksum.
+   *
+   * @generated from rpc sqlrpc.v1.DatabaseService.BackupDatabase
+   */
+  backupDatabase: {
+    methodKind: "unary";
+    input: typeof BackupDatabaseRequestSchema;
+    output: typeof BackupDatabaseResponseSchema;
+  },
+  /**
+   * *
+   * Backup: List.
+   * Lists the stored backups of a database, newest first.
+   *
+   * @generated from rpc sqlrpc.v1.DatabaseService.ListBackups
+   */
+  listBackups: {
+    methodKind: "unary";
+    input: typeof ListBackupsRequestSchema;
+    output: typeof ListBackupsResponseSchema;
+  },
+  /**
+   * *
+   * Backup: Restore.
+   * Verifies a stored backup, quiesces the database, swaps the file and drops
+   * all cached connections. Fails while transactions are open on the database.
+   *
+   * @generated from rpc sqlrpc.v1.DatabaseService.RestoreDatabase
+   */
+  restoreDatabase: {
+    methodKind: "unary";
+    input: typeof RestoreDatabaseRequestSchema;
+    output: typeof RestoreDatabaseResponseSchema;
+  },
+  /**
+   * *
+   * Backup: Download.
+   * Streams a stored backup, or a fresh snapshot when no backup is named, in
+   * chunks. The first message carries the backup metadata.
+   *
+   * @generated from rpc sqlrpc.v1.DatabaseService.DownloadBackup
+   */
+  downloadBackup: {
+    methodKind: "server_streaming";
+    input: typeof DownloadBackupRequestSchema;
+    output: typeof DownloadBackupResponseSchema;
+  },
+  /**
+   * *
+   * Backup: Dump.
+   * Streams a `.dump`-style SQL script recreating the schema and data of a
+   * database, or of some of its tables. Row and column security applies to
+   * the data read.
+   *
+   * @generated from rpc sqlrpc.v1.DatabaseService.DumpDatabase
+   */
+  dumpDatabase: {
+    methodKind: "server_streaming";
+    input: typeof DumpDatabaseRequestSchema;
+    output: typeof DumpDatabaseResponseSchema;
+  },
   /**
    * *
    * Extensions: List.
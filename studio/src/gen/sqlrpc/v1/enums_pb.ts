@@ -9,7 +9,7 @@ import { enumDesc, fileDesc } from "@bufbuild/protobuf/codegenv2";
  * Describes the file sqlrpc/v1/enums.proto.
  */
 export const file_sqlrpc_v1_enums: GenFile = /*@__PURE__*/
-  fileDesc("ChVzcWxycGMvdjEvZW51bXMucHJvdG8SCXNxbHJwYy52MSrWBAoKU3FsaXRlQ29kZRINCglTUUxJVEVfT0sQABIQCgxTUUxJVEVfRVJST1IQARITCg9TUUxJVEVfSU5URVJOQUwQAhIPCgtTUUxJVEVfUEVSTRADEhAKDFNRTElURV9BQk9SVBAEEg8KC1NRTElURV9CVVNZEAUSEQoNU1FMSVRFX0xPQ0tFRBAGEhAKDFNRTElURV9OT01FTRAHEhMKD1NRTElURV9SRUFET05MWRAIEhQKEFNRTElURV9JTlRFUlJVUFQQCRIQCgxTUUxJVEVfSU9FUlIQChISCg5TUUxJVEVfQ09SUlVQVBALEhMKD1NRTElURV9OT1RGT1VORBAMEg8KC1NRTElURV9GVUxMEA0SEwoPU1FMSVRFX0NBTlRPUEVOEA4SEwoPU1FMSVRFX1BST1RPQ09MEA8SEAoMU1FMSVRFX0VNUFRZEBASEQoNU1FMSVRFX1NDSEVNQRAREhEKDVNRTElURV9UT09CSUcQEhIVChFTUUxJVEVfQ09OU1RSQUlOVBATEhMKD1NRTElURV9NSVNNQVRDSBAUEhEKDVNRTElURV9NSVNVU0UQFRIQCgxTUUxJVEVfTk9MRlMQFhIPCgtTUUxJVEVfQVVUSBAXEhEKDVNRTElURV9GT1JNQVQQGBIQCgxTUUxJVEVfUkFOR0UQGRIRCg1TUUxJVEVfTk9UQURCEBoSEQoNU1FMSVRFX05PVElDRRAbEhIKDlNRTElURV9XQVJOSU5HEBwSDgoKU1FMSVRFX1JPVxBkEg8KC1NRTElURV9ET05FEGUqqgEKE1RyYW5zYWN0aW9uTG9ja01vZGUSJQohVFJBTlNBQ1RJT05fTE9DS19NT0RFX1VOU1BFQ0lGSUVEEAASIgoeVFJBTlNBQ1RJT05fTE9DS19NT0RFX0RFRkVSUkVEEAESIwofVFJBTlNBQ1RJT05fTE9DS19NT0RFX0lNTUVESUFURRACEiMKH1RSQU5TQUNUSU9OX0xPQ0tfTU9ERV9FWENMVVNJVkUQAyqjAQoOQ2hlY2twb2ludE1vZGUSHwobQ0hFQ0tQT0lOVF9NT0RFX1VOU1BFQ0lGSUVEEAASGwoXQ0hFQ0tQT0lOVF9NT0RFX1BBU1NJVkUQARIYChRDSEVDS1BPSU5UX01PREVfRlVMTBACEhsKF0NIRUNLUE9JTlRfTU9ERV9SRVNUQVJUEAMSHAoYQ0hFQ0tQT0lOVF9NT0RFX1RSVU5DQVRFEAQquQEKDkNvbHVtbkFmZmluaXR5Eh8KG0NPTFVNTl9BRkZJTklUWV9VTlNQRUNJRklFRBAAEhsKF0NPTFVNTl9BRkZJTklUWV9JTlRFR0VSEAESGAoUQ09MVU1OX0FGRklOSVRZX1RFWFQQAhIYChRDT0xVTU5fQUZGSU5JVFlfQkxPQhADEhgKFENPTFVNTl9BRkZJTklUWV9SRUFMEAQSGwoXQ09MVU1OX0FGRklOSVRZX05VTUVSSUMQBSq/BwoMRGVjbGFyZWRUeXBlEh0KGURFQ0xBUkVEX1RZUEVfVU5TUEVDSUZJRUQQABIVChFERUNMQVJFRF9UWVBFX0lOVBABEhkKFURFQ0xBUkVEX1RZUEVfSU5URUdFUhACEhkKFURFQ0xBUkVEX1RZUEVfVElOWUlOVBADEhoKFkRFQ0xBUkVEX1RZUEVfU01BTExJTlQQBBIbChdERUNMQVJFRF9UWVBFX01FRElVTUlOVBAFEhgKFERFQ0xBUkVEX1RZUEVfQklHSU5UEAYSIgoeREVDTEFSRURfVFlQRV9VTlNJR05FRF9CSUdfSU5UEAcSFgoSREVDTEFSRURfVFlQRV9JTlQyEAgSFgoSREVDTEFSRURfVFlQRV9JTlQ4EAkSGwoXREVDTEFSRURfVFlQRV9DSEFSQUNURVIQChIZChVERUNMQVJFRF9UWVBFX1ZBUkNIQVIQCxIjCh9ERUNMQVJFRF9UWVBFX1ZBUllJTkdfQ0hBUkFDVEVSEAwSFwoTREVDTEFSRURfVFlQRV9OQ0hBUhANEiIKHkRFQ0xBUkVEX1RZUEVfTkFUSVZFX0NIQVJBQ1RFUhAOEhoKFkRFQ0xBUkVEX1RZUEVfTlZBUkNIQVIQDxIWChJERUNMQVJFRF9UWVBFX1RFWFQQEBIWChJERUNMQVJFRF9UWVBFX0NMT0IQERIWChJERUNMQVJFRF9UWVBFX0JMT0IQEhIWChJERUNMQVJFRF9UWVBFX1JFQUwQExIYChRERUNMQVJFRF9UWVBFX0RPVUJMRRAUEiIKHkRFQ0xBUkVEX1RZUEVfRE9VQkxFX1BSRUNJU0lPThAVEhcKE0RFQ0xBUkVEX1RZUEVfRkxPQVQQFhIZChVERUNMQVJFRF9UWVBFX05VTUVSSUMQFxIZChVERUNMQVJFRF9UWVBFX0RFQ0lNQUwQGBIZChVERUNMQVJFRF9UWVBFX0JPT0xFQU4QGRIWChJERUNMQVJFRF9UWVBFX0RBVEUQGhIaChZERUNMQVJFRF9UWVBFX0RBVEVUSU1FEBsSGwoXREVDTEFSRURfVFlQRV9USU1FU1RBTVAQHBIWChJERUNMQVJFRF9UWVBFX1RJTUUQHxIWChJERUNMQVJFRF9UWVBFX1lFQVIQIBIWChJERUNMQVJFRF9UWVBFX0pTT04QHRIWChJERUNMQVJFRF9UWVBFX1VVSUQQHhIWChJERUNMQVJFRF9UWVBFX0NIQVIQIRIVChFERUNMQVJFRF9UWVBFX1hNTBAiKnAKBFJvbGUSFAoQUk9MRV9VTlNQRUNJRklFRBAAEhIKDlJPTEVfUkVBRF9PTkxZEAoSEwoPUk9MRV9SRUFEX1dSSVRFEBQSGQoVUk9MRV9EQVRBQkFTRV9NQU5BR0VSEB4SDgoKUk9MRV9BRE1JThAoQjJaMHNxbGl0ZS1zZXJ2ZXIvaW50ZXJuYWwvcHJvdG9zL3NxbHJwYy92MTtzcWxycGN2MWIGcHJvdG8z");
+  fileDesc("ChVzcWxycGMvdjEvZW51bXMucHJvdG8SCXNxbHJwYy52MSrWBAoKU3FsaXRlQ29kZRINCglTUUxJVEVfT0sQABIQCgxTUUxJVEVfRVJST1IQARITCg9TUUxJVEVfSU5URVJOQUwQAhIPCgtTUUxJVEVfUEVSTRADEhAKDFNRTElURV9BQk9SVBAEEg8KC1NRTElURV9CVVNZEAUSEQoNU1FMSVRFX0xPQ0tFRBAGEhAKDFNRTElURV9OT01FTRAHEhMKD1NRTElURV9SRUFET05MWRAIEhQKEFNRTElURV9JTlRFUlJVUFQQCRIQCgxTUUxJVEVfSU9FUlIQChISCg5TUUxJVEVfQ09SUlVQVBALEhMKD1NRTElURV9OT1RGT1VORBAMEg8KC1NRTElURV9GVUxMEA0SEwoPU1FMSVRFX0NBTlRPUEVOEA4SEwoPU1FMSVRFX1BST1RPQ09MEA8SEAoMU1FMSVRFX0VNUFRZEBASEQoNU1FMSVRFX1NDSEVNQRAREhEKDVNRTElURV9UT09CSUcQEhIVChFTUUxJVEVfQ09OU1RSQUlOVBATEhMKD1NRTElURV9NSVNNQVRDSBAUEhEKDVNRTElURV9NSVNVU0UQFRIQCgxTUUxJVEVfTk9MRlMQFhIPCgtTUUxJVEVfQVVUSBAXEhEKDVNRTElURV9GT1JNQVQQGBIQCgxTUUxJVEVfUkFOR0UQGRIRCg1TUUxJVEVfTk9UQURCEBoSEQoNU1FMSVRFX05PVElDRRAbEhIKDlNRTElURV9XQVJOSU5HEBwSDgoKU1FMSVRFX1JPVxBkEg8KC1NRTElURV9ET05FEGUqqgEKE1RyYW5zYWN0aW9uTG9ja01vZGUSJQohVFJBTlNBQ1RJT05fTE9DS19NT0RFX1VOU1BFQ0lGSUVEEAASIgoeVFJBTlNBQ1RJT05fTE9DS19NT0RFX0RFRkVSUkVEEAESIwofVFJBTlNBQ1RJT05fTE9DS19NT0RFX0lNTUVESUFURRACEiMKH1RSQU5TQUNUSU9OX0xPQ0tfTU9ERV9FWENMVVNJVkUQAyqjAQoOQ2hlY2twb2ludE1vZGUSHwobQ0hFQ0tQT0lOVF9NT0RFX1VOU1BFQ0lGSUVEEAASGwoXQ0hFQ0tQT0lOVF9NT0RFX1BBU1NJVkUQARIYChRDSEVDS1BPSU5UX01PREVfRlVMTBACEhsKF0NIRUNLUE9JTlRfTU9ERV9SRVNUQVJUEAMSHAoYQ0hFQ0tQT0lOVF9NT0RFX1RSVU5DQVRFEAQquQEKDkNvbHVtbkFmZmluaXR5Eh8KG0NPTFVNTl9BRkZJTklUWV9VTlNQRUNJRklFRBAAEhsKF0NPTFVNTl9BRkZJTklUWV9JTlRFR0VSEAESGAoUQ09MVU1OX0FGRklOSVRZX1RFWFQQAhIYChRDT0xVTU5fQUZGSU5JVFlfQkxPQhADEhgKFENPTFVNTl9BRkZJTklUWV9SRUFMEAQSGwoXQ09MVU1OX0FGRklOSVRZX05VTUVSSUMQBSq/BwoMRGVjbGFyZWRUeXBlEh0KGURFQ0xBUkVEX1RZUEVfVU5TUEVDSUZJRUQQABIVChFERUNMQVJFRF9UWVBFX0lOVBABEhkKFURFQ0xBUkVEX1RZUEVfSU5URUdFUhACEhkKFURFQ0xBUkVEX1RZUEVfVElOWUlOVBADEhoKFkRFQ0xBUkVEX1RZUEVfU01BTExJTlQQBBIbChdERUNMQVJFRF9UWVBFX01FRElVTUlOVBAFEhgKFERFQ0xBUkVEX1RZUEVfQklHSU5UEAYSIgoeREVDTEFSRURfVFlQRV9VTlNJR05FRF9CSUdfSU5UEAcSFgoSREVDTEFSRURfVFlQRV9JTlQyEAgSFgoSREVDTEFSRURfVFlQRV9JTlQ4EAkSGwoXREVDTEFSRURfVFlQRV9DSEFSQUNURVIQChIZChVERUNMQVJFRF9UWVBFX1ZBUkNIQVIQCxIjCh9ERUNMQVJFRF9UWVBFX1ZBUllJTkdfQ0hBUkFDVEVSEAwSFwoTREVDTEFSRURfVFlQRV9OQ0hBUhANEiIKHkRFQ0xBUkVEX1RZUEVfTkFUSVZFX0NIQVJBQ1RFUhAOEhoKFkRFQ0xBUkVEX1RZUEVfTlZBUkNIQVIQDxIWChJERUNMQVJFRF9UWVBFX1RFWFQQEBIWChJERUNMQVJFRF9UWVBFX0NMT0IQERIWChJERUNMQVJFRF9UWVBFX0JMT0IQEhIWChJERUNMQVJFRF9UWVBFX1JFQUwQExIYChRERUNMQVJFRF9UWVBFX0RPVUJMRRAUEiIKHkRFQ0xBUkVEX1RZUEVfRE9VQkxFX1BSRUNJU0lPThAVEhcKE0RFQ0xBUkVEX1RZUEVfRkxPQVQQFhIZChVERUNMQVJFRF9UWVBFX05VTUVSSUMQFxIZChVERUNMQVJFRF9UWVBFX0RFQ0lNQUwQGBIZChVERUNMQVJFRF9UWVBFX0JPT0xFQU4QGRIWChJERUNMQVJFRF9UWVBFX0RBVEUQGhIaChZERUNMQVJFRF9UWVBFX0RBVEVUSU1FEBsSGwoXREVDTEFSRURfVFlQRV9USU1FU1RBTVAQHBIWChJERUNMQVJFRF9UWVBFX1RJTUUQHxIWChJERUNMQVJFRF9UWVBFX1lFQVIQIBIWChJERUNMQVJFRF9UWVBFX0pTT04QHRIWChJERUNMQVJFRF9UWVBFX1VVSUQQHhIWChJERUNMQVJFRF9UWVBFX0NIQVIQIRIVChFERUNMQVJFRF9UWVBFX1hNTBAiKnAKBFJvbGUSFAoQUk9MRV9VTlNQRUNJRklFRBAAEhIKDlJPTEVfUkVBRF9PTkxZEAoSEwoPUk9MRV9SRUFEX1dSSVRFEBQSGQoVUk9MRV9EQVRBQkFTRV9NQU5BR0VSEB4SDgoKUk9MRV9BRE1JThAoKoUBCglScGNGYW1pbHkSGgoWUlBDX0ZBTUlMWV9VTlNQRUNJRklFRBAAEhQKEFJQQ19GQU1JTFlfUVVFUlkQARIWChJSUENfRkFNSUxZX1BVQkxJU0gQAhIYChRSUENfRkFNSUxZX1NVQlNDUklCRRADEhQKEFJQQ19GQU1JTFlfQURNSU4QBCpxChFCYWNrdXBDb21wcmVzc2lvbhIiCh5CQUNLVVBfQ09NUFJFU1NJT05fVU5TUEVDSUZJRUQQABIbChdCQUNLVVBfQ09NUFJFU1NJT05fTk9ORRABEhsKF0JBQ0tVUF9DT01QUkVTU0lPTl9HWklQEAIqtAEKD01haW50ZW5hbmNlVGFzaxIgChxNQUlOVEVOQU5DRV9UQVNLX1VOU1BFQ0lGSUVEEAASHwobTUFJTlRFTkFOQ0VfVEFTS19DSEVDS1BPSU5UEAESGwoXTUFJTlRFTkFOQ0VfVEFTS19WQUNVVU0QAhIkCiBNQUlOVEVOQU5DRV9UQVNLX0lOVEVHUklUWV9DSEVDSxADEhsKF01BSU5URU5BTkNFX1RBU0tfQkFDS1VQEAQqbwoPUmVwbGljYXRpb25Sb2xlEiAKHFJFUExJQ0FUSU9OX1JPTEVfVU5TUEVDSUZJRUQQABIbChdSRVBMSUNBVElPTl9ST0xFX0xFQURFUhABEh0KGVJFUExJQ0FUSU9OX1JPTEVfRk9MTE9XRVIQAipdCgxDb2x1bW5BY3Rpb24SHQoZQ09MVU1OX0FDVElPTl9VTlNQRUNJRklFRBAAEhYKEkNPTFVNTl9BQ1RJT05fREVOWRABEhYKEkNPTFVNTl9BQ1RJT05fTUFTSxACKm8KD1RyYW5zYWN0aW9uS2luZBIgChxUUkFOU0FDVElPTl9LSU5EX1VOU1BFQ0lGSUVEEAASHQoZVFJBTlNBQ1RJT05fS0lORF9JRF9CQVNFRBABEhsKF1RSQU5TQUNUSU9OX0tJTkRfU1RSRUFNEAIqdwoITG9nTGV2ZWwSGQoVTE9HX0xFVkVMX1VOU1BFQ0lGSUVEEAASEwoPTE9HX0xFVkVMX0RFQlVHEAESEgoOTE9HX0xFVkVMX0lORk8QAhISCg5MT0dfTEVWRUxfV0FSThADEhMKD0xPR19MRVZFTF9FUlJPUhAEKnYKDEltcG9ydEZvcm1hdBIdChlJTVBPUlRfRk9STUFUX1VOU1BFQ0lGSUVEEAASFQoRSU1QT1JUX0ZPUk1BVF9DU1YQARIYChRJTVBPUlRfRk9STUFUX05ESlNPThACEhYKEklNUE9SVF9GT1JNQVRfUk9XUxADKoUBCg5JbXBvcnRDb25mbGljdBIfChtJTVBPUlRfQ09ORkxJQ1RfVU5TUEVDSUZJRUQQABIZChVJTVBPUlRfQ09ORkxJQ1RfQUJPUlQQARIaChZJTVBPUlRfQ09ORkxJQ1RfSUdOT1JFEAISGwoXSU1QT1JUX0NPTkZMSUNUX1JFUExBQ0UQA0IyWjBzcWxpdGUtc2VydmVyL2ludGVybmFsL3Byb3Rvcy9zcWxycGMvdjE7c3FscnBjdjFiBnByb3RvMw");
 
 /**
  * buf:lint:ignore ENUM_VALUE_PREFIX
@@ -720,3 +720,365 @@ export enum Role {
 export const RoleSchema: GenEnum<Role> = /*@__PURE__*/
   enumDesc(file_sqlrpc_v1_enums, 5);
 
+/**
+ * *
+ * RpcFamily groups platform RPCs so API keys can be scoped to a subset of
+ * the API surface.
+ *
+ * @generated from enum sqlrpc.v1.RpcFamily
+ */
+export enum RpcFamily {
+  /**
+   * @generated from enum value: RPC_FAMILY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Queries, executions, transactions, introspection and maintenance on
+   * tenant databases.
+   *
+   * @generated from enum value: RPC_FAMILY_QUERY = 1;
+   */
+  QUERY = 1,
+
+  /**
+   * Pub/Sub message publishing (Publish, PublishBatch).
+   *
+   * @generated from enum value: RPC_FAMILY_PUBLISH = 2;
+   */
+  PUBLISH = 2,
+
+  /**
+   * Pub/Sub subscriptions (Subscribe).
+   *
+   * @generated from enum value: RPC_FAMILY_SUBSCRIBE = 3;
+   */
+  SUBSCRIBE = 3,
+
+  /**
+   * Control plane calls on the AdminService.
+   *
+   * @generated from enum value: RPC_FAMILY_ADMIN = 4;
+   */
+  ADMIN = 4,
+}
+
+/**
+ * Describes the enum sqlrpc.v1.RpcFamily.
+ */
+export const RpcFamilySchema: GenEnum<RpcFamily> = /*@__PURE__*/
+  enumDesc(file_sqlrpc_v1_enums, 6);
+
+/**
+ * *
+ * BackupCompression is the compression applied to a stored backup file.
+ *
+ * @generated from enum sqlrpc.v1.BackupCompression
+ */
+export enum BackupCompression {
+  /**
+   * Default value, stored uncompressed.
+   *
+   * @generated from enum value: BACKUP_COMPRESSION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Stored as a plain SQLite database file.
+   *
+   * @generated from enum value: BACKUP_COMPRESSION_NONE = 1;
+   */
+  NONE = 1,
+
+  /**
+   * Stored gzip-compressed.
+   *
+   * @generated from enum value: BACKUP_COMPRESSION_GZIP = 2;
+   */
+  GZIP = 2,
+}
+
+/**
+ * Describes the enum sqlrpc.v1.BackupCompression.
+ */
+export const BackupCompressionSchema: GenEnum<BackupCompression> = /*@__PURE__*/
+  enumDesc(file_sqlrpc_v1_enums, 7);
+
+/**
+ * *
+ * MaintenanceTask is a kind of scheduled maintenance job.
+ *
+ * @generated from enum sqlrpc.v1.MaintenanceTask
+ */
+export enum MaintenanceTask {
+  /**
+   * @generated from enum value: MAINTENANCE_TASK_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * PRAGMA wal_checkpoint(TRUNCATE).
+   *
+   * @generated from enum value: MAINTENANCE_TASK_CHECKPOINT = 1;
+   */
+  CHECKPOINT = 1,
+
+  /**
+   * VACUUM.
+   *
+   * @generated from enum value: MAINTENANCE_TASK_VACUUM = 2;
+   */
+  VACUUM = 2,
+
+  /**
+   * PRAGMA integrity_check.
+   *
+   * @generated from enum value: MAINTENANCE_TASK_INTEGRITY_CHECK = 3;
+   */
+  INTEGRITY_CHECK = 3,
+
+  /**
+   * Backup into the server's backup directory.
+   *
+   * @generated from enum value: MAINTENANCE_TASK_BACKUP = 4;
+   */
+  BACKUP = 4,
+}
+
+/**
+ * Describes the enum sqlrpc.v1.MaintenanceTask.
+ */
+export const MaintenanceTaskSchema: GenEnum<MaintenanceTask> = /*@__PURE__*/
+  enumDesc(file_sqlrpc_v1_enums, 8);
+
+/**
+ * *
+ * ReplicationRole is the part a server plays in leader/follower replication.
+ *
+ * @generated from enum sqlrpc.v1.ReplicationRole
+ */
+export enum ReplicationRole {
+  /**
+   * @generated from enum value: REPLICATION_ROLE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Accepts writes and streams changes to followers.
+   *
+   * @generated from enum value: REPLICATION_ROLE_LEADER = 1;
+   */
+  LEADER = 1,
+
+  /**
+   * Applies changes streamed from a leader and serves reads only.
+   *
+   * @generated from enum value: REPLICATION_ROLE_FOLLOWER = 2;
+   */
+  FOLLOWER = 2,
+}
+
+/**
+ * Describes the enum sqlrpc.v1.ReplicationRole.
+ */
+export const ReplicationRoleSchema: GenEnum<ReplicationRole> = /*@__PURE__*/
+  enumDesc(file_sqlrpc_v1_enums, 9);
+
+/**
+ * *
+ * ColumnAction is what happens when a restricted caller reads a column.
+ *
+ * @generated from enum sqlrpc.v1.ColumnAction
+ */
+export enum ColumnAction {
+  /**
+   * @generated from enum value: COLUMN_ACTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Statements that read the column fail with permission_denied.
+   *
+   * @generated from enum value: COLUMN_ACTION_DENY = 1;
+   */
+  DENY = 1,
+
+  /**
+   * The column reads as NULL.
+   *
+   * @generated from enum value: COLUMN_ACTION_MASK = 2;
+   */
+  MASK = 2,
+}
+
+/**
+ * Describes the enum sqlrpc.v1.ColumnAction.
+ */
+export const ColumnActionSchema: GenEnum<ColumnAction> = /*@__PURE__*/
+  enumDesc(file_sqlrpc_v1_enums, 10);
+
+/**
+ * *
+ * TransactionKind tells how a client drives a transaction.
+ *
+ * @generated from enum sqlrpc.v1.TransactionKind
+ */
+export enum TransactionKind {
+  /**
+   * @generated from enum value: TRANSACTION_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * BeginTransaction followed by unary calls carrying the transaction ID.
+   *
+   * @generated from enum value: TRANSACTION_KIND_ID_BASED = 1;
+   */
+  ID_BASED = 1,
+
+  /**
+   * A bidirectional Transaction stream.
+   *
+   * @generated from enum value: TRANSACTION_KIND_STREAM = 2;
+   */
+  STREAM = 2,
+}
+
+/**
+ * Describes the enum sqlrpc.v1.TransactionKind.
+ */
+export const TransactionKindSchema: GenEnum<TransactionKind> = /*@__PURE__*/
+  enumDesc(file_sqlrpc_v1_enums, 11);
+
+/**
+ * *
+ * LogLevel is the minimum severity of server log records.
+ *
+ * @generated from enum sqlrpc.v1.LogLevel
+ */
+export enum LogLevel {
+  /**
+   * Default value. In SetLogLevel it clears a subsystem override.
+   *
+   * @generated from enum value: LOG_LEVEL_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Verbose diagnostics.
+   *
+   * @generated from enum value: LOG_LEVEL_DEBUG = 1;
+   */
+  DEBUG = 1,
+
+  /**
+   * Normal operation.
+   *
+   * @generated from enum value: LOG_LEVEL_INFO = 2;
+   */
+  INFO = 2,
+
+  /**
+   * Unexpected but handled conditions.
+   *
+   * @generated from enum value: LOG_LEVEL_WARN = 3;
+   */
+  WARN = 3,
+
+  /**
+   * Failures.
+   *
+   * @generated from enum value: LOG_LEVEL_ERROR = 4;
+   */
+  ERROR = 4,
+}
+
+/**
+ * Describes the enum sqlrpc.v1.LogLevel.
+ */
+export const LogLevelSchema: GenEnum<LogLevel> = /*@__PURE__*/
+  enumDesc(file_sqlrpc_v1_enums, 12);
+
+/**
+ * *
+ * ImportFormat is the encoding of the records sent to Import.
+ *
+ * @generated from enum sqlrpc.v1.ImportFormat
+ */
+export enum ImportFormat {
+  /**
+   * @generated from enum value: IMPORT_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * RFC 4180 CSV text sent in data chunks.
+   *
+   * @generated from enum value: IMPORT_FORMAT_CSV = 1;
+   */
+  CSV = 1,
+
+  /**
+   * One JSON object per line, sent in data chunks.
+   *
+   * @generated from enum value: IMPORT_FORMAT_NDJSON = 2;
+   */
+  NDJSON = 2,
+
+  /**
+   * Typed SqlRow batches.
+   *
+   * @generated from enum value: IMPORT_FORMAT_ROWS = 3;
+   */
+  ROWS = 3,
+}
+
+/**
+ * Describes the enum sqlrpc.v1.ImportFormat.
+ */
+export const ImportFormatSchema: GenEnum<ImportFormat> = /*@__PURE__*/
+  enumDesc(file_sqlrpc_v1_enums, 13);
+
+/**
+ * *
+ * ImportConflict is what Import does with a record that violates a uniqueness
+ * constraint.
+ *
+ * @generated from enum sqlrpc.v1.ImportConflict
+ */
+export enum ImportConflict {
+  /**
+   * Default value, same as IMPORT_CONFLICT_ABORT.
+   *
+   * @generated from enum value: IMPORT_CONFLICT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The record is rejected and reported.
+   *
+   * @generated from enum value: IMPORT_CONFLICT_ABORT = 1;
+   */
+  ABORT = 1,
+
+  /**
+   * The record is skipped silently (INSERT OR IGNORE).
+   *
+   * @generated from enum value: IMPORT_CONFLICT_IGNORE = 2;
+   */
+  IGNORE = 2,
+
+  /**
+   * The record replaces the existing row (INSERT OR REPLACE).
+   *
+   * @generated from enum value: IMPORT_CONFLICT_REPLACE = 3;
+   */
+  REPLACE = 3,
+}
+
+/**
+ * Describes the enum sqlrpc.v1.ImportConflict.
+ */
+export const ImportConflictSchema: GenEnum<ImportConflict> = /*@__PURE__*/
+  enumDesc(file_sqlrpc_v1_enums, 14);
+
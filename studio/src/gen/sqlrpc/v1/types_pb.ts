@@ -7,7 +7,7 @@ import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
 import type { Value } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_struct } from "@bufbuild/protobuf/wkt";
-import type { ColumnAffinity, Role } from "./enums_pb";
+import type { BackupCompression, ColumnAffinity, Role } from "./enums_pb";
 import { file_sqlrpc_v1_enums } from "./enums_pb";
 import type { Message } from "@bufbuild/protobuf";
 
@@ -15,7 +15,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file sqlrpc/v1/types.proto.
  */
 export const file_sqlrpc_v1_types: GenFile = /*@__PURE__*/
-  fileDesc("ChVzcWxycGMvdjEvdHlwZXMucHJvdG8SCXNxbHJwYy52MSJDCgRVc2VyEgoKAmlkGAEgASgDEhAKCHVzZXJuYW1lGAIgASgJEh0KBHJvbGUYAyABKA4yDy5zcWxycGMudjEuUm9sZSKgAQoMRGF0YWJhc2VJbmZvEgwKBG5hbWUYASABKAkSDAoEcGF0aBgCIAEoCRISCgppc19tYW5hZ2VkGAMgASgIEjIKE2N1cnJlbnRfYXR0YWNobWVudHMYBCADKAsyFS5zcWxycGMudjEuQXR0YWNobWVudBIRCglyZWFkX29ubHkYBSABKAgSGQoRbG9hZGVkX2V4dGVuc2lvbnMYBiADKAkiTgoORXhlY3V0aW9uU3RhdHMSEwoLZHVyYXRpb25fbXMYASABKAESEQoJcm93c19yZWFkGAIgASgDEhQKDHJvd3Nfd3JpdHRlbhgDIAEoAyKZAQoNRXh0ZW5zaW9uSW5mbxIMCgRuYW1lGAEgASgJEg8KB3ZlcnNpb24YAiABKAkSEwoLZm9sZGVyX25hbWUYAyABKAkSEQoJaXNfbG9hZGVkGAQgASgIEhEKCWZpbGVfcGF0aBgFIAEoCRITCgtkZXNjcmlwdGlvbhgGIAEoCRIZChFkb2N1bWVudGF0aW9uX3VybBgHIAEoCSL6BAoORGF0YWJhc2VDb25maWcSKQoEbmFtZRgBIAEoCUIbukgYchYQAxhAMhBeW2EtekEtWjAtOV8tXSskEhgKB2RiX3BhdGgYAiABKAlCB7pIBHICEAESFAoMaXNfZW5jcnlwdGVkGAMgASgIEgsKA2tleRgEIAEoCRIRCglyZWFkX29ubHkYBSABKAgSEgoKZXh0ZW5zaW9ucxgGIAMoCRI3CgdwcmFnbWFzGAcgAygLMiYuc3FscnBjLnYxLkRhdGFiYXNlQ29uZmlnLlByYWdtYXNFbnRyeRIWCg5tYXhfb3Blbl9jb25ucxgIIAEoBRIWCg5tYXhfaWRsZV9jb25ucxgJIAEoBRIcChRjb25uX21heF9saWZldGltZV9tcxgKIAEoBRL1AQoNaW5pdF9jb21tYW5kcxgLIAMoCULdAbpI2QGSAdUBItIBugHOAQojaW5pdF9jb21tYW5kcy5wcmV2ZW50X2F0dGFjaF9kZXRhY2gSYUFUVEFDSCBvciBERVRBQ0ggY29tbWFuZHMgYXJlIG5vdCBhbGxvd2VkIGluIGluaXRfY29tbWFuZHMuIFVzZSBhdHRhY2hlZF9kYXRhYmFzZXMgZmllbGQgaW5zdGVhZC4aRCF0aGlzLm1hdGNoZXMoJyg/aSleXFxzKkFUVEFDSCcpICYmICF0aGlzLm1hdGNoZXMoJyg/aSleXFxzKkRFVEFDSCcpEioKC2F0dGFjaG1lbnRzGAwgAygLMhUuc3FscnBjLnYxLkF0dGFjaG1lbnQaLgoMUHJhZ21hc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEi4QMKFFVwZGF0ZURhdGFiYXNlQ29uZmlnEhYKCXJlYWRfb25seRgBIAEoCEgAiAEBEjEKCmV4dGVuc2lvbnMYAiABKAsyGC5zcWxycGMudjEuRXh0ZW5zaW9uTGlzdEgBiAEBEioKB3ByYWdtYXMYAyABKAsyFC5zcWxycGMudjEuUHJhZ21hTWFwSAKIAQESGwoObWF4X29wZW5fY29ubnMYBCABKAVIA4gBARIbCg5tYXhfaWRsZV9jb25ucxgFIAEoBUgEiAEBEiEKFGNvbm5fbWF4X2xpZmV0aW1lX21zGAYgASgFSAWIAQESNgoNaW5pdF9jb21tYW5kcxgHIAEoCzIaLnNxbHJwYy52MS5Jbml0Q29tbWFuZExpc3RIBogBARIzCgthdHRhY2htZW50cxgIIAEoCzIZLnNxbHJwYy52MS5BdHRhY2htZW50TGlzdEgHiAEBQgwKCl9yZWFkX29ubHlCDQoLX2V4dGVuc2lvbnNCCgoIX3ByYWdtYXNCEQoPX21heF9vcGVuX2Nvbm5zQhEKD19tYXhfaWRsZV9jb25uc0IXChVfY29ubl9tYXhfbGlmZXRpbWVfbXNCEAoOX2luaXRfY29tbWFuZHNCDgoMX2F0dGFjaG1lbnRzInMKCkF0dGFjaG1lbnQSOQoUdGFyZ2V0X2RhdGFiYXNlX25hbWUYASABKAlCG7pIGHIWEAMYQDIQXlthLXpBLVowLTlfLV0rJBIqCgVhbGlhcxgCIAEoCUIbukgYchYQARhAMhBeW2EtekEtWjAtOV8tXSskIjcKDkF0dGFjaG1lbnRMaXN0EiUKBnZhbHVlcxgBIAMoCzIVLnNxbHJwYy52MS5BdHRhY2htZW50Ih8KDUV4dGVuc2lvbkxpc3QSDgoGdmFsdWVzGAEgAygJImwKCVByYWdtYU1hcBIwCgZ2YWx1ZXMYASADKAsyIC5zcWxycGMudjEuUHJhZ21hTWFwLlZhbHVlc0VudHJ5Gi0KC1ZhbHVlc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEihwIKD0luaXRDb21tYW5kTGlzdBLzAQoGdmFsdWVzGAEgAygJQuIBukjeAZIB2gEi1wG6AdMBCihpbml0X2NvbW1hbmRzX2xpc3QucHJldmVudF9hdHRhY2hfZGV0YWNoEmFBVFRBQ0ggb3IgREVUQUNIIGNvbW1hbmRzIGFyZSBub3QgYWxsb3dlZCBpbiBpbml0X2NvbW1hbmRzLiBVc2UgYXR0YWNoZWRfZGF0YWJhc2VzIGZpZWxkIGluc3RlYWQuGkQhdGhpcy5tYXRjaGVzKCcoP2kpXlxccypBVFRBQ0gnKSAmJiAhdGhpcy5tYXRjaGVzKCcoP2kpXlxccypERVRBQ0gnKSKEAQoIU3FsVmFsdWUSFwoNaW50ZWdlcl92YWx1ZRgBIAEoA0gAEhQKCnJlYWxfdmFsdWUYAiABKAFIABIUCgp0ZXh0X3ZhbHVlGAMgASgJSAASFAoKYmxvYl92YWx1ZRgEIAEoDEgAEhQKCm51bGxfdmFsdWUYBSABKAhIAEIHCgV2YWx1ZSItCgZTcWxSb3cSIwoGdmFsdWVzGAEgAygLMhMuc3FscnBjLnYxLlNxbFZhbHVlIs8BCg9UeXBlZFBhcmFtZXRlcnMSMgoKcG9zaXRpb25hbBgBIAMoCzITLnNxbHJwYy52MS5TcWxWYWx1ZUIJukgGkgEDEOgHEkUKBW5hbWVkGAIgAygLMiUuc3FscnBjLnYxLlR5cGVkUGFyYW1ldGVycy5OYW1lZEVudHJ5Qg+6SAyaAQkQ6AciBHICEAEaQQoKTmFtZWRFbnRyeRILCgNrZXkYASABKAkSIgoFdmFsdWUYAiABKAsyEy5zcWxycGMudjEuU3FsVmFsdWU6AjgBIsECCgpQYXJhbWV0ZXJzEioKCnBvc2l0aW9uYWwYASADKAsyFi5nb29nbGUucHJvdG9idWYuVmFsdWUSLwoFbmFtZWQYAiADKAsyIC5zcWxycGMudjEuUGFyYW1ldGVycy5OYW1lZEVudHJ5EkcKBWhpbnRzGAUgAygLMiAuc3FscnBjLnYxLlBhcmFtZXRlcnMuSGludHNFbnRyeUIWukgTmgEQEOgHIgRyAhABKgWCAQIQARpECgpOYW1lZEVudHJ5EgsKA2tleRgBIAEoCRIlCgV2YWx1ZRgCIAEoCzIWLmdvb2dsZS5wcm90b2J1Zi5WYWx1ZToCOAEaRwoKSGludHNFbnRyeRILCgNrZXkYASABKAkSKAoFdmFsdWUYAiABKA4yGS5zcWxycGMudjEuQ29sdW1uQWZmaW5pdHk6AjgBIj4KDVF1ZXJ5UGxhbk5vZGUSCgoCaWQYASABKAUSEQoJcGFyZW50X2lkGAIgASgFEg4KBmRldGFpbBgDIAEoCSLaAQoLVGFibGVTY2hlbWESDAoEbmFtZRgBIAEoCRILCgNzcWwYAiABKAkSKAoHY29sdW1ucxgDIAMoCzIXLnNxbHJwYy52MS5Db2x1bW5TY2hlbWESJwoHaW5kZXhlcxgEIAMoCzIWLnNxbHJwYy52MS5JbmRleFNjaGVtYRIxCgxmb3JlaWduX2tleXMYBSADKAsyGy5zcWxycGMudjEuRm9yZWlnbktleVNjaGVtYRIqCgh0cmlnZ2VycxgGIAMoCzIYLnNxbHJwYy52MS5UcmlnZ2VyU2NoZW1hImgKDENvbHVtblNjaGVtYRIMCgRuYW1lGAEgASgJEgwKBHR5cGUYAiABKAkSEAoIbm90X251bGwYAyABKAgSFQoNZGVmYXVsdF92YWx1ZRgEIAEoCRITCgtwcmltYXJ5X2tleRgFIAEoCCJJCgtJbmRleFNjaGVtYRIMCgRuYW1lGAEgASgJEg4KBnVuaXF1ZRgCIAEoCBIPCgdjb2x1bW5zGAMgAygJEgsKA3NxbBgEIAEoCSJ7ChBGb3JlaWduS2V5U2NoZW1hEgoKAmlkGAEgASgFEg0KBXRhYmxlGAIgASgJEhMKC2Zyb21fY29sdW1uGAMgASgJEhEKCXRvX2NvbHVtbhgEIAEoCRIRCglvbl91cGRhdGUYBSABKAkSEQoJb25fZGVsZXRlGAYgASgJIioKDVRyaWdnZXJTY2hlbWESDAoEbmFtZRgBIAEoCRILCgNzcWwYAiABKAkiOAoORGF0YWJhc2VTY2hlbWESJgoGdGFibGVzGAEgAygLMhYuc3FscnBjLnYxLlRhYmxlU2NoZW1hQjJaMHNxbGl0ZS1zZXJ2ZXIvaW50ZXJuYWwvcHJvdG9zL3NxbHJwYy92MTtzcWxycGN2MWIGcHJvdG8z", [file_buf_validate_validate, file_google_protobuf_struct, file_sqlrpc_v1_enums]);
+  fileDesc("ChVzcWxycGMvdjEvdHlwZXMucHJvdG8SCXNxbHJwYy52MSJDCgRVc2VyEgoKAmlkGAEgASgDEhAKCHVzZXJuYW1lGAIgASgJEh0KBHJvbGUYAyABKA4yDy5zcWxycGMudjEuUm9sZSKgAQoMRGF0YWJhc2VJbmZvEgwKBG5hbWUYASABKAkSDAoEcGF0aBgCIAEoCRISCgppc19tYW5hZ2VkGAMgASgIEjIKE2N1cnJlbnRfYXR0YWNobWVudHMYBCADKAsyFS5zcWxycGMudjEuQXR0YWNobWVudBIRCglyZWFkX29ubHkYBSABKAgSGQoRbG9hZGVkX2V4dGVuc2lvbnMYBiADKAkiyAEKDkV4ZWN1dGlvblN0YXRzEhMKC2R1cmF0aW9uX21zGAEgASgBEhEKCXJvd3NfcmVhZBgCIAEoAxIUCgxyb3dzX3dyaXR0ZW4YAyABKAMSIQoUcm93c19xdW90YV9yZW1haW5pbmcYBCABKANIAIgBARIiChVieXRlc19xdW90YV9yZW1haW5pbmcYBSABKANIAYgBAUIXChVfcm93c19xdW90YV9yZW1haW5pbmdCGAoWX2J5dGVzX3F1b3RhX3JlbWFpbmluZyKZAQoNRXh0ZW5zaW9uSW5mbxIMCgRuYW1lGAEgASgJEg8KB3ZlcnNpb24YAiABKAkSEwoLZm9sZGVyX25hbWUYAyABKAkSEQoJaXNfbG9hZGVkGAQgASgIEhEKCWZpbGVfcGF0aBgFIAEoCRITCgtkZXNjcmlwdGlvbhgGIAEoCRIZChFkb2N1bWVudGF0aW9uX3VybBgHIAEoCSLXBQoORGF0YWJhc2VDb25maWcSKQoEbmFtZRgBIAEoCUIbukgYchYQAxhAMhBeW2EtekEtWjAtOV8tXSskEhgKB2RiX3BhdGgYAiABKAlCB7pIBHICEAESFAoMaXNfZW5jcnlwdGVkGAMgASgIEgsKA2tleRgEIAEoCRIRCglyZWFkX29ubHkYBSABKAgSEgoKZXh0ZW5zaW9ucxgGIAMoCRI3CgdwcmFnbWFzGAcgAygLMiYuc3FscnBjLnYxLkRhdGFiYXNlQ29uZmlnLlByYWdtYXNFbnRyeRIWCg5tYXhfb3Blbl9jb25ucxgIIAEoBRIWCg5tYXhfaWRsZV9jb25ucxgJIAEoBRIcChRjb25uX21heF9saWZldGltZV9tcxgKIAEoBRL1AQoNaW5pdF9jb21tYW5kcxgLIAMoCULdAbpI2QGSAdUBItIBugHOAQojaW5pdF9jb21tYW5kcy5wcmV2ZW50X2F0dGFjaF9kZXRhY2gSYUFUVEFDSCBvciBERVRBQ0ggY29tbWFuZHMgYXJlIG5vdCBhbGxvd2VkIGluIGluaXRfY29tbWFuZHMuIFVzZSBhdHRhY2hlZF9kYXRhYmFzZXMgZmllbGQgaW5zdGVhZC4aRCF0aGlzLm1hdGNoZXMoJyg/aSleXFxzKkFUVEFDSCcpICYmICF0aGlzLm1hdGNoZXMoJyg/aSleXFxzKkRFVEFDSCcpEioKC2F0dGFjaG1lbnRzGAwgAygLMhUuc3FscnBjLnYxLkF0dGFjaG1lbnQSMwoLbWFpbnRlbmFuY2UYDSABKAsyHi5zcWxycGMudjEuTWFpbnRlbmFuY2VTY2hlZHVsZRImChVtYXhfZXhlY3V0aW9uX3RpbWVfbXMYDiABKAVCB7pIBBoCKAAaLgoMUHJhZ21hc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEi3gEKE01haW50ZW5hbmNlU2NoZWR1bGUSHAoKY2hlY2twb2ludBgBIAEoCUIIukgFcgMYgAESGAoGdmFjdXVtGAIgASgJQgi6SAVyAxiAARIhCg9pbnRlZ3JpdHlfY2hlY2sYAyABKAlCCLpIBXIDGIABEhgKBmJhY2t1cBgEIAEoCUIIukgFcgMYgAESOAoSYmFja3VwX2NvbXByZXNzaW9uGAUgASgOMhwuc3FscnBjLnYxLkJhY2t1cENvbXByZXNzaW9uEhgKEGJhY2t1cF9yZXRlbnRpb24YBiABKA0i8gQKFFVwZGF0ZURhdGFiYXNlQ29uZmlnEhYKCXJlYWRfb25seRgBIAEoCEgAiAEBEjEKCmV4dGVuc2lvbnMYAiABKAsyGC5zcWxycGMudjEuRXh0ZW5zaW9uTGlzdEgBiAEBEioKB3ByYWdtYXMYAyABKAsyFC5zcWxycGMudjEuUHJhZ21hTWFwSAKIAQESGwoObWF4X29wZW5fY29ubnMYBCABKAVIA4gBARIbCg5tYXhfaWRsZV9jb25ucxgFIAEoBUgEiAEBEiEKFGNvbm5fbWF4X2xpZmV0aW1lX21zGAYgASgFSAWIAQESNgoNaW5pdF9jb21tYW5kcxgHIAEoCzIaLnNxbHJwYy52MS5Jbml0Q29tbWFuZExpc3RIBogBARIzCgthdHRhY2htZW50cxgIIAEoCzIZLnNxbHJwYy52MS5BdHRhY2htZW50TGlzdEgHiAEBEjgKC21haW50ZW5hbmNlGAkgASgLMh4uc3FscnBjLnYxLk1haW50ZW5hbmNlU2NoZWR1bGVICIgBARIrChVtYXhfZXhlY3V0aW9uX3RpbWVfbXMYCiABKAVCB7pIBBoCKABICYgBAUIMCgpfcmVhZF9vbmx5Qg0KC19leHRlbnNpb25zQgoKCF9wcmFnbWFzQhEKD19tYXhfb3Blbl9jb25uc0IRCg9fbWF4X2lkbGVfY29ubnNCFwoVX2Nvbm5fbWF4X2xpZmV0aW1lX21zQhAKDl9pbml0X2NvbW1hbmRzQg4KDF9hdHRhY2htZW50c0IOCgxfbWFpbnRlbmFuY2VCGAoWX21heF9leGVjdXRpb25fdGltZV9tcyJzCgpBdHRhY2htZW50EjkKFHRhcmdldF9kYXRhYmFzZV9uYW1lGAEgASgJQhu6SBhyFhADGEAyEF5bYS16QS1aMC05Xy1dKyQSKgoFYWxpYXMYAiABKAlCG7pIGHIWEAEYQDIQXlthLXpBLVowLTlfLV0rJCI3Cg5BdHRhY2htZW50TGlzdBIlCgZ2YWx1ZXMYASADKAsyFS5zcWxycGMudjEuQXR0YWNobWVudCIfCg1FeHRlbnNpb25MaXN0Eg4KBnZhbHVlcxgBIAMoCSJsCglQcmFnbWFNYXASMAoGdmFsdWVzGAEgAygLMiAuc3FscnBjLnYxLlByYWdtYU1hcC5WYWx1ZXNFbnRyeRotCgtWYWx1ZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIocCCg9Jbml0Q29tbWFuZExpc3QS8wEKBnZhbHVlcxgBIAMoCULiAbpI3gGSAdoBItcBugHTAQooaW5pdF9jb21tYW5kc19saXN0LnByZXZlbnRfYXR0YWNoX2RldGFjaBJhQVRUQUNIIG9yIERFVEFDSCBjb21tYW5kcyBhcmUgbm90IGFsbG93ZWQgaW4gaW5pdF9jb21tYW5kcy4gVXNlIGF0dGFjaGVkX2RhdGFiYXNlcyBmaWVsZCBpbnN0ZWFkLhpEIXRoaXMubWF0Y2hlcygnKD9pKV5cXHMqQVRUQUNIJykgJiYgIXRoaXMubWF0Y2hlcygnKD9pKV5cXHMqREVUQUNIJykihAEKCFNxbFZhbHVlEhcKDWludGVnZXJfdmFsdWUYASABKANIABIUCgpyZWFsX3ZhbHVlGAIgASgBSAASFAoKdGV4dF92YWx1ZRgDIAEoCUgAEhQKCmJsb2JfdmFsdWUYBCABKAxIABIUCgpudWxsX3ZhbHVlGAUgASgISABCBwoFdmFsdWUiLQoGU3FsUm93EiMKBnZhbHVlcxgBIAMoCzITLnNxbHJwYy52MS5TcWxWYWx1ZSLPAQoPVHlwZWRQYXJhbWV0ZXJzEjIKCnBvc2l0aW9uYWwYASADKAsyEy5zcWxycGMudjEuU3FsVmFsdWVCCbpIBpIBAxDoBxJFCgVuYW1lZBgCIAMoCzIlLnNxbHJwYy52MS5UeXBlZFBhcmFtZXRlcnMuTmFtZWRFbnRyeUIPukgMmgEJEOgHIgRyAhABGkEKCk5hbWVkRW50cnkSCwoDa2V5GAEgASgJEiIKBXZhbHVlGAIgASgLMhMuc3FscnBjLnYxLlNxbFZhbHVlOgI4ASLBAgoKUGFyYW1ldGVycxIqCgpwb3NpdGlvbmFsGAEgAygLMhYuZ29vZ2xlLnByb3RvYnVmLlZhbHVlEi8KBW5hbWVkGAIgAygLMiAuc3FscnBjLnYxLlBhcmFtZXRlcnMuTmFtZWRFbnRyeRJHCgVoaW50cxgFIAMoCzIgLnNxbHJwYy52MS5QYXJhbWV0ZXJzLkhpbnRzRW50cnlCFrpIE5oBEBDoByIEcgIQASoFggECEAEaRAoKTmFtZWRFbnRyeRILCgNrZXkYASABKAkSJQoFdmFsdWUYAiABKAsyFi5nb29nbGUucHJvdG9idWYuVmFsdWU6AjgBGkcKCkhpbnRzRW50cnkSCwoDa2V5GAEgASgJEigKBXZhbHVlGAIgASgOMhkuc3FscnBjLnYxLkNvbHVtbkFmZmluaXR5OgI4ASI+Cg1RdWVyeVBsYW5Ob2RlEgoKAmlkGAEgASgFEhEKCXBhcmVudF9pZBgCIAEoBRIOCgZkZXRhaWwYAyABKAki2gEKC1RhYmxlU2NoZW1hEgwKBG5hbWUYASABKAkSCwoDc3FsGAIgASgJEigKB2NvbHVtbnMYAyADKAsyFy5zcWxycGMudjEuQ29sdW1uU2NoZW1hEicKB2luZGV4ZXMYBCADKAsyFi5zcWxycGMudjEuSW5kZXhTY2hlbWESMQoMZm9yZWlnbl9rZXlzGAUgAygLMhsuc3FscnBjLnYxLkZvcmVpZ25LZXlTY2hlbWESKgoIdHJpZ2dlcnMYBiADKAsyGC5zcWxycGMudjEuVHJpZ2dlclNjaGVtYSJoCgxDb2x1bW5TY2hlbWESDAoEbmFtZRgBIAEoCRIMCgR0eXBlGAIgASgJEhAKCG5vdF9udWxsGAMgASgIEhUKDWRlZmF1bHRfdmFsdWUYBCABKAkSEwoLcHJpbWFyeV9rZXkYBSABKAgiSQoLSW5kZXhTY2hlbWESDAoEbmFtZRgBIAEoCRIOCgZ1bmlxdWUYAiABKAgSDwoHY29sdW1ucxgDIAMoCRILCgNzcWwYBCABKAkiewoQRm9yZWlnbktleVNjaGVtYRIKCgJpZBgBIAEoBRINCgV0YWJsZRgCIAEoCRITCgtmcm9tX2NvbHVtbhgDIAEoCRIRCgl0b19jb2x1bW4YBCABKAkSEQoJb25fdXBkYXRlGAUgASgJEhEKCW9uX2RlbGV0ZRgGIAEoCSIqCg1UcmlnZ2VyU2NoZW1hEgwKBG5hbWUYASABKAkSCwoDc3FsGAIgASgJIjgKDkRhdGFiYXNlU2NoZW1hEiYKBnRhYmxlcxgBIAMoCzIWLnNxbHJwYy52MS5UYWJsZVNjaGVtYUIyWjBzcWxpdGUtc2VydmVyL2ludGVybmFsL3Byb3Rvcy9zcWxycGMvdjE7c3FscnBjdjFiBnByb3RvMw", [file_buf_validate_validate, file_google_protobuf_struct, file_sqlrpc_v1_enums]);
 
 /**
  * *
@@ -138,6 +138,22 @@ export type ExecutionStats = Message<"sqlrpc.v1.ExecutionStats"> & {
    * @generated from field: int64 rows_written = 3;
    */
   rowsWritten: bigint;
+
+  /**
+   * Result rows the caller may still receive this minute. Only set when a
+   * rate limit caps returned rows.
+   *
+   * @generated from field: optional int64 rows_quota_remaining = 4;
+   */
+  rowsQuotaRemaining?: bigint;
+
+  /**
+   * Response bytes the caller may still receive this minute. Only set when a
+   * rate limit caps returned bytes.
+   *
+   * @generated from field: optional int64 bytes_quota_remaining = 5;
+   */
+  bytesQuotaRemaining?: bigint;
 };
 
 /**
@@ -303,6 +319,21 @@ export type DatabaseConfig = Message<"sqlrpc.v1.DatabaseConfig"> & {
    * @generated from field: repeated sqlrpc.v1.Attachment attachments = 12;
    */
   attachments: Attachment[];
+
+  /**
+   * Scheduled maintenance jobs.
+   *
+   * @generated from field: sqlrpc.v1.MaintenanceSchedule maintenance = 13;
+   */
+  maintenance?: MaintenanceSchedule;
+
+  /**
+   * Longest a stateless statement may run before it is interrupted. 0 means
+   * no limit. Requests can only ask for less.
+   *
+   * @generated from field: int32 max_execution_time_ms = 14;
+   */
+  maxExecutionTimeMs: number;
 };
 
 /**
@@ -312,6 +343,67 @@ export type DatabaseConfig = Message<"sqlrpc.v1.DatabaseConfig"> & {
 export const DatabaseConfigSchema: GenMessage<DatabaseConfig> = /*@__PURE__*/
   messageDesc(file_sqlrpc_v1_types, 4);
 
+/**
+ * *
+ * MaintenanceSchedule holds the cron expressions of a database's scheduled
+ * maintenance jobs. Expressions use the standard five fields (minute, hour,
+ * day of month, month, day of week) in server local time, or a descriptor such
+ * as @daily. An empty expression disables the job.
+ *
+ * @generated from message sqlrpc.v1.MaintenanceSchedule
+ */
+export type MaintenanceSchedule = Message<"sqlrpc.v1.MaintenanceSchedule"> & {
+  /**
+   * Schedule for PRAGMA wal_checkpoint(TRUNCATE).
+   *
+   * @generated from field: string checkpoint = 1;
+   */
+  checkpoint: string;
+
+  /**
+   * Schedule for VACUUM.
+   *
+   * @generated from field: string vacuum = 2;
+   */
+  vacuum: string;
+
+  /**
+   * Schedule for PRAGMA integrity_check.
+   *
+   * @generated from field: string integrity_check = 3;
+   */
+  integrityCheck: string;
+
+  /**
+   * Schedule for backups into the server's backup directory.
+   *
+   * @generated from field: string backup = 4;
+   */
+  backup: string;
+
+  /**
+   * Compression of scheduled backups.
+   *
+   * @generated from field: sqlrpc.v1.BackupCompression backup_compression = 5;
+   */
+  backupCompression: BackupCompression;
+
+  /**
+   * Number of backups to keep; older ones are deleted after each scheduled
+   * backup. Zero keeps every backup.
+   *
+   * @generated from field: uint32 backup_retention = 6;
+   */
+  backupRetention: number;
+};
+
+/**
+ * Describes the message sqlrpc.v1.MaintenanceSchedule.
+ * Use `create(MaintenanceScheduleSchema)` to create a new message.
+ */
+export const MaintenanceScheduleSchema: GenMessage<MaintenanceSchedule> = /*@__PURE__*/
+  messageDesc(file_sqlrpc_v1_types, 5);
+
 /**
  * *
  * UpdateDatabaseConfig allows for non-destructive updates to a tenant's
@@ -375,6 +467,20 @@ export type UpdateDatabaseConfig = Message<"sqlrpc.v1.UpdateDatabaseConfig"> & {
    * @generated from field: optional sqlrpc.v1.AttachmentList attachments = 8;
    */
   attachments?: AttachmentList;
+
+  /**
+   * New maintenance schedule, replacing the current one.
+   *
+   * @generated from field: optional sqlrpc.v1.MaintenanceSchedule maintenance = 9;
+   */
+  maintenance?: MaintenanceSchedule;
+
+  /**
+   * New statement execution time limit in milliseconds (0 removes it).
+   *
+   * @generated from field: optional int32 max_execution_time_ms = 10;
+   */
+  maxExecutionTimeMs?: number;
 };
 
 /**
@@ -382,7 +488,7 @@ export type UpdateDatabaseConfig = Message<"sqlrpc.v1.UpdateDatabaseConfig"> & {
  * Use `create(UpdateDatabaseConfigSchema)` to create a new message.
  */
 export const UpdateDatabaseConfigSchema: GenMessage<UpdateDatabaseConfig> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_types, 5);
+  messageDesc(file_sqlrpc_v1_types, 6);
 
 /**
  * *
@@ -412,7 +518,7 @@ export type Attachment = Message<"sqlrpc.v1.Attachment"> & {
  * Use `create(AttachmentSchema)` to create a new message.
  */
 export const AttachmentSchema: GenMessage<Attachment> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_types, 6);
+  messageDesc(file_sqlrpc_v1_types, 7);
 
 /**
  * *
@@ -434,7 +540,7 @@ export type AttachmentList = Message<"sqlrpc.v1.AttachmentList"> & {
  * Use `create(AttachmentListSchema)` to create a new message.
  */
 export const AttachmentListSchema: GenMessage<AttachmentList> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_types, 7);
+  messageDesc(file_sqlrpc_v1_types, 8);
 
 /**
  * *
@@ -456,7 +562,7 @@ export type ExtensionList = Message<"sqlrpc.v1.ExtensionList"> & {
  * Use `create(ExtensionListSchema)` to create a new message.
  */
 export const ExtensionListSchema: GenMessage<ExtensionList> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_types, 8);
+  messageDesc(file_sqlrpc_v1_types, 9);
 
 /**
  * *
@@ -478,7 +584,7 @@ export type PragmaMap = Message<"sqlrpc.v1.PragmaMap"> & {
  * Use `create(PragmaMapSchema)` to create a new message.
  */
 export const PragmaMapSchema: GenMessage<PragmaMap> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_types, 9);
+  messageDesc(file_sqlrpc_v1_types, 10);
 
 /**
  * *
@@ -501,7 +607,7 @@ export type InitCommandList = Message<"sqlrpc.v1.InitCommandList"> & {
  * Use `create(InitCommandListSchema)` to create a new message.
  */
 export const InitCommandListSchema: GenMessage<InitCommandList> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_types, 10);
+  messageDesc(file_sqlrpc_v1_types, 11);
 
 /**
  * *
@@ -562,7 +668,7 @@ export type SqlValue = Message<"sqlrpc.v1.SqlValue"> & {
  * Use `create(SqlValueSchema)` to create a new message.
  */
 export const SqlValueSchema: GenMessage<SqlValue> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_types, 11);
+  messageDesc(file_sqlrpc_v1_types, 12);
 
 /**
  * *
@@ -584,7 +690,7 @@ export type SqlRow = Message<"sqlrpc.v1.SqlRow"> & {
  * Use `create(SqlRowSchema)` to create a new message.
  */
 export const SqlRowSchema: GenMessage<SqlRow> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_types, 12);
+  messageDesc(file_sqlrpc_v1_types, 13);
 
 /**
  * *
@@ -613,7 +719,7 @@ export type TypedParameters = Message<"sqlrpc.v1.TypedParameters"> & {
  * Use `create(TypedParametersSchema)` to create a new message.
  */
 export const TypedParametersSchema: GenMessage<TypedParameters> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_types, 13);
+  messageDesc(file_sqlrpc_v1_types, 14);
 
 /**
  * *
@@ -650,7 +756,7 @@ export type Parameters = Message<"sqlrpc.v1.Parameters"> & {
  * Use `create(ParametersSchema)` to create a new message.
  */
 export const ParametersSchema: GenMessage<Parameters> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_types, 14);
+  messageDesc(file_sqlrpc_v1_types, 15);
 
 /**
  * *
@@ -686,7 +792,7 @@ export type QueryPlanNode = Message<"sqlrpc.v1.QueryPlanNode"> & {
  * Use `create(QueryPlanNodeSchema)` to create a new message.
  */
 export const QueryPlanNodeSchema: GenMessage<QueryPlanNode> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_types, 15);
+  messageDesc(file_sqlrpc_v1_types, 16);
 
 /**
  * *
@@ -743,7 +849,7 @@ export type TableSchema = Message<"sqlrpc.v1.TableSchema"> & {
  * Use `create(TableSchemaSchema)` to create a new message.
  */
 export const TableSchemaSchema: GenMessage<TableSchema> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_types, 16);
+  messageDesc(file_sqlrpc_v1_types, 17);
 
 /**
  * *
@@ -793,7 +899,7 @@ export type ColumnSchema = Message<"sqlrpc.v1.ColumnSchema"> & {
  * Use `create(ColumnSchemaSchema)` to create a new message.
  */
 export const ColumnSchemaSchema: GenMessage<ColumnSchema> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_types, 17);
+  messageDesc(file_sqlrpc_v1_types, 18);
 
 /**
  * *
@@ -836,7 +942,7 @@ export type IndexSchema = Message<"sqlrpc.v1.IndexSchema"> & {
  * Use `create(IndexSchemaSchema)` to create a new message.
  */
 export const IndexSchemaSchema: GenMessage<IndexSchema> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_types, 18);
+  messageDesc(file_sqlrpc_v1_types, 19);
 
 /**
  * *
@@ -893,7 +999,7 @@ export type ForeignKeySchema = Message<"sqlrpc.v1.ForeignKeySchema"> & {
  * Use `create(ForeignKeySchemaSchema)` to create a new message.
  */
 export const ForeignKeySchemaSchema: GenMessage<ForeignKeySchema> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_types, 19);
+  messageDesc(file_sqlrpc_v1_types, 20);
 
 /**
  * *
@@ -922,7 +1028,7 @@ export type TriggerSchema = Message<"sqlrpc.v1.TriggerSchema"> & {
  * Use `create(TriggerSchemaSchema)` to create a new message.
  */
 export const TriggerSchemaSchema: GenMessage<TriggerSchema> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_types, 20);
+  messageDesc(file_sqlrpc_v1_types, 21);
 
 /**
  * *
@@ -945,5 +1051,5 @@ export type DatabaseSchema = Message<"sqlrpc.v1.DatabaseSchema"> & {
  * Use `create(DatabaseSchemaSchema)` to create a new message.
  */
 export const DatabaseSchemaSchema: GenMessage<DatabaseSchema> = /*@__PURE__*/
-  messageDesc(file_sqlrpc_v1_types, 21);
+  messageDesc(file_sqlrpc_v1_types, 22);
 
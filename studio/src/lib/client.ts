@@ -41,6 +41,42 @@ export function getAuthHeaders(): Record<string, string> {
     };
 }
 
+// Export Downloads
+export type ExportFormat = "csv" | "ndjson" | "sql" | "sqlite";
+
+const EXPORT_EXTENSIONS: Record<ExportFormat, string> = {
+    csv: "csv",
+    ndjson: "ndjson",
+    sql: "sql",
+    sqlite: "db",
+};
+
+/**
+ * Downloads the results of a query through the server's /export endpoint.
+ * The request carries the same credentials as the RPC calls.
+ */
+export async function downloadExport(dbName: string, format: ExportFormat, sql: string, params: string) {
+    const base = (import.meta.env.PUBLIC_SQLITE_SERVER_URL || "/").replace(/\/$/, "");
+    const query = new URLSearchParams({ sql, format });
+    if (params.trim()) query.set("params", params);
+
+    const response = await fetch(`${base}/export/${encodeURIComponent(dbName)}?${query}`, {
+        headers: getAuthHeaders(),
+    });
+    if (!response.ok) {
+        throw new Error((await response.text()) || `Export failed with status ${response.status}`);
+    }
+
+    const url = URL.createObjectURL(await response.blob());
+    const link = document.createElement("a");
+    link.href = url;
+    link.download = `${dbName}.${EXPORT_EXTENSIONS[format]}`;
+    document.body.appendChild(link);
+    link.click();
+    link.remove();
+    URL.revokeObjectURL(url);
+}
+
 // Active Transaction Tracking (for global logout with rollback)
 export const ACTIVE_TX_KEY = "sqlite-server-active-tx";
 
@@ -3,6 +3,7 @@ import { toJson } from "@bufbuild/protobuf";
 import { ValueSchema } from "@bufbuild/protobuf/wkt";
 import { DeclaredType } from "../gen/sqlrpc/v1/enums_pb";
 import type { QueryPlanNode } from "../gen/sqlrpc/v1/types_pb";
+import { downloadExport, type ExportFormat } from "./client";
 
 export interface NotebookCard {
     id: string;
@@ -126,6 +127,23 @@ export class NotebookManager {
             }
         });
 
+        // Download buttons of the result panel
+        this.resultContainer.addEventListener('click', async (e) => {
+            const button = (e.target as HTMLElement).closest('.download-btn') as HTMLButtonElement | null;
+            if (!button) return;
+            const card = this.cards.find(c => c.id === this.selectedCardId);
+            if (!card) return;
+
+            button.disabled = true;
+            try {
+                await downloadExport(this.dbName, button.dataset.format as ExportFormat, card.sql, card.params);
+            } catch (err: any) {
+                alert('Download failed: ' + err.message);
+            } finally {
+                button.disabled = false;
+            }
+        });
+
         // Auto-save & inputs
         this.cardContainer.addEventListener('input', (e) => {
             if (this.readOnly) return;
@@ -563,6 +581,7 @@ export class NotebookManager {
         this.resultContainer.innerHTML = `
             <div class="result-header">
                 <strong>Result</strong>
+                ${this.pageType === 'query' && card.result?.columns ? this.generateDownloadHTML() : ''}
                 ${card.executionTimeMs ? `<span class="badge success">${card.executionTimeMs}ms</span>` : ''}
             </div>
             <div class="result-body">
@@ -615,6 +634,18 @@ export class NotebookManager {
         `;
     }
 
+    // Re-runs the query of the card through /export in each format
+    private generateDownloadHTML(): string {
+        return `
+            <div class="download-actions">
+                <span class="download-label">Download as…</span>
+                <button class="btn btn-sm btn-secondary download-btn" data-format="csv">CSV</button>
+                <button class="btn btn-sm btn-secondary download-btn" data-format="ndjson">NDJSON</button>
+                <button class="btn btn-sm btn-secondary download-btn" data-format="sql">SQL</button>
+                <button class="btn btn-sm btn-secondary download-btn" data-format="sqlite">SQLite</button>
+            </div>`;
+    }
+
     private generateResultHTML(card: NotebookCard): string {
         if (!card.result) return '';
 